@@ -0,0 +1,319 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ErrNoCSVFiles is returned when a CSV table is created for a pattern that matches no files.
+var ErrNoCSVFiles = errors.NewKind("no CSV files match %s")
+
+// ErrCSVRecord is returned when a record of a CSV file can't be read as a row of its table.
+var ErrCSVRecord = errors.NewKind("%s, record %d: %s")
+
+// csvNull is the value of NULL fields in CSV files, as written by SELECT ... INTO OUTFILE.
+const csvNull = `\N`
+
+// csvInferenceRecords is the number of records that the types of the columns of a CSV table are inferred from.
+const csvInferenceRecords = 100
+
+// CSVOptions are the options of a CSV table.
+type CSVOptions struct {
+	// Comma is the field delimiter, which is a comma if it's zero.
+	Comma rune
+	// Header is whether the first record of each file is a header with the names of the columns.
+	Header bool
+	// Schema is the schema of the table. When it's nil, the columns are named after the header, or c1, c2... when
+	// the files have no header, and their types are inferred from the first records of the first file: columns whose
+	// values are all integers are BIGINT, columns whose values are all numbers are DOUBLE, and the others are
+	// LONGTEXT.
+	Schema sql.Schema
+}
+
+// CSVTable is a read-only table whose rows are the records of one or more CSV files, each of which is a partition of
+// the table. Fields that are \N, or empty fields of columns that aren't strings, are NULL.
+type CSVTable struct {
+	name    string
+	files   []string
+	schema  sql.Schema
+	options CSVOptions
+	// columns are the indexes of the projected columns, or nil if every column is read
+	columns []int
+}
+
+var _ sql.Table = (*CSVTable)(nil)
+var _ sql.ProjectedTable = (*CSVTable)(nil)
+var _ sql.PartitionCounter = (*CSVTable)(nil)
+
+// NewCSVTable returns a new CSVTable with the name given, whose rows are those of the files that match the pattern
+// given, as matched by filepath.Glob.
+func NewCSVTable(name, pattern string, options CSVOptions) (*CSVTable, error) {
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, ErrNoCSVFiles.New(pattern)
+	}
+	sort.Strings(files)
+
+	t := &CSVTable{name: name, files: files, options: options}
+	if options.Schema != nil {
+		t.schema = make(sql.Schema, len(options.Schema))
+		for i, col := range options.Schema {
+			c := *col
+			c.Source = name
+			t.schema[i] = &c
+		}
+	} else if t.schema, err = t.inferSchema(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Name implements the sql.Nameable interface.
+func (t *CSVTable) Name() string {
+	return t.name
+}
+
+// String implements the sql.Table interface.
+func (t *CSVTable) String() string {
+	return t.name
+}
+
+// Schema implements the sql.Table interface.
+func (t *CSVTable) Schema() sql.Schema {
+	return t.schema
+}
+
+// Files returns the paths of the files of the table.
+func (t *CSVTable) Files() []string {
+	return t.files
+}
+
+// Partitions implements the sql.Table interface.
+func (t *CSVTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	partitions := make([]sql.Partition, len(t.files))
+	for i, file := range t.files {
+		partitions[i] = filePartition(file)
+	}
+	return sql.PartitionsToPartitionIter(partitions...), nil
+}
+
+// PartitionCount implements the sql.PartitionCounter interface.
+func (t *CSVTable) PartitionCount(*sql.Context) (int64, error) {
+	return int64(len(t.files)), nil
+}
+
+// PartitionRows implements the sql.Table interface.
+func (t *CSVTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	path := string(partition.Key())
+	r, f, err := t.open(path)
+	if err != nil {
+		return nil, err
+	}
+	iter := &csvRowIter{
+		table:   t,
+		path:    path,
+		file:    f,
+		reader:  r,
+		columns: allColumns(t.schema, t.columns),
+	}
+	if t.options.Header {
+		if _, err := iter.read(); err != nil && err != io.EOF {
+			_ = f.Close()
+			return nil, err
+		}
+	}
+	return iter, nil
+}
+
+// WithProjection implements the sql.ProjectedTable interface. Only the fields of the projected columns are converted
+// to the types of their columns.
+func (t *CSVTable) WithProjection(colNames []string) sql.Table {
+	columns, err := projectedColumns(t.name, t.schema, colNames)
+	if err != nil {
+		panic(err)
+	}
+	nt := *t
+	nt.columns = columns
+	return &nt
+}
+
+// open opens the CSV file given.
+func (t *CSVTable) open(path string) (*csv.Reader, *os.File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	r := csv.NewReader(f)
+	if t.options.Comma != 0 {
+		r.Comma = t.options.Comma
+	}
+	r.ReuseRecord = true
+	// Records with the wrong number of fields are reported along with their table
+	r.FieldsPerRecord = -1
+	return r, f, nil
+}
+
+// inferSchema returns the schema of the table from the first records of its first file.
+func (t *CSVTable) inferSchema() (sql.Schema, error) {
+	r, f, err := t.open(t.files[0])
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r.ReuseRecord = false
+
+	var names []string
+	if t.options.Header {
+		if names, err = r.Read(); err != nil {
+			if err == io.EOF {
+				return nil, ErrCSVRecord.New(t.files[0], 1, "missing header")
+			}
+			return nil, err
+		}
+	}
+
+	var records [][]string
+	for len(records) < csvInferenceRecords {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	if names == nil {
+		if len(records) == 0 {
+			return nil, ErrCSVRecord.New(t.files[0], 1, "no records to infer the columns from")
+		}
+		for i := range records[0] {
+			names = append(names, fmt.Sprintf("c%d", i+1))
+		}
+	}
+
+	schema := make(sql.Schema, len(names))
+	for i, name := range names {
+		schema[i] = &sql.Column{
+			Name:     name,
+			Type:     inferCSVType(records, i),
+			Nullable: true,
+			Source:   t.name,
+		}
+	}
+	return schema, nil
+}
+
+// inferCSVType returns the type of the values of the column given in the records given.
+func inferCSVType(records [][]string, column int) sql.Type {
+	integers, numbers := true, true
+	var values int
+	for _, record := range records {
+		if column >= len(record) || record[column] == "" || record[column] == csvNull {
+			continue
+		}
+		values++
+		if _, err := strconv.ParseInt(record[column], 10, 64); err != nil {
+			integers = false
+		}
+		if _, err := strconv.ParseFloat(record[column], 64); err != nil {
+			numbers = false
+		}
+	}
+	switch {
+	case values == 0:
+		return sql.LongText
+	case integers:
+		return sql.Int64
+	case numbers:
+		return sql.Float64
+	default:
+		return sql.LongText
+	}
+}
+
+// filePartition is a partition of a table stored in a file, whose key is the path of the file.
+type filePartition string
+
+func (p filePartition) Key() []byte {
+	return []byte(p)
+}
+
+// csvRowIter returns the rows of a CSV file.
+type csvRowIter struct {
+	table   *CSVTable
+	path    string
+	file    *os.File
+	reader  *csv.Reader
+	columns []int
+	// record is the number of the last record read, counting the header
+	record int
+}
+
+var _ sql.RowIter = (*csvRowIter)(nil)
+
+func (i *csvRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	record, err := i.read()
+	if err != nil {
+		return nil, err
+	}
+	if len(record) != len(i.table.schema) {
+		return nil, ErrCSVRecord.New(i.path, i.record, fmt.Sprintf("expected %d fields, found %d", len(i.table.schema), len(record)))
+	}
+
+	row := make(sql.Row, len(i.table.schema))
+	for _, j := range i.columns {
+		field := record[j]
+		col := i.table.schema[j]
+		if field == csvNull || (field == "" && !sql.IsText(col.Type)) {
+			continue
+		}
+		if row[j], err = col.Type.Convert(field); err != nil {
+			return nil, ErrCSVRecord.New(i.path, i.record, fmt.Sprintf("column %s: %s", col.Name, err))
+		}
+	}
+	return row, nil
+}
+
+// read returns the next record of the file.
+func (i *csvRowIter) read() ([]string, error) {
+	record, err := i.reader.Read()
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("%s: %w", i.path, err)
+	}
+	i.record++
+	return record, err
+}
+
+func (i *csvRowIter) Close(*sql.Context) error {
+	return i.file.Close()
+}
@@ -0,0 +1,114 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func writeCSVFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "csv")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	for name, contents := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(contents), 0644))
+	}
+	return dir
+}
+
+func TestCSVTable(t *testing.T) {
+	require := require.New(t)
+	dir := writeCSVFiles(t, map[string]string{
+		"sales-1.csv": "region,units,price,note\neast,3,1.5,\"first, sale\"\nwest,\\N,2,\n",
+		"sales-2.csv": "region,units,price,note\neast,4,2.25,\nnorth,1,3,last\n",
+		"other.csv":   "a\n1\n",
+	})
+
+	table, err := NewCSVTable("sales", filepath.Join(dir, "sales-*.csv"), CSVOptions{Header: true})
+	require.NoError(err)
+	require.Equal(sql.Schema{
+		{Name: "region", Type: sql.LongText, Nullable: true, Source: "sales"},
+		{Name: "units", Type: sql.Int64, Nullable: true, Source: "sales"},
+		{Name: "price", Type: sql.Float64, Nullable: true, Source: "sales"},
+		{Name: "note", Type: sql.LongText, Nullable: true, Source: "sales"},
+	}, table.Schema())
+	require.Len(table.Files(), 2)
+
+	query := newTestEngine(t, table)
+	require.Equal([]sql.Row{
+		{"east", int64(3), 1.5, "first, sale"},
+		{"west", nil, float64(2), ""},
+		{"east", int64(4), 2.25, ""},
+		{"north", int64(1), float64(3), "last"},
+	}, query("SELECT * FROM sales"))
+	require.Equal([]sql.Row{
+		{"east", float64(7), 9.0 + 4.5},
+		{"north", float64(1), float64(3)},
+	}, query("SELECT region, SUM(units), SUM(units * price) FROM sales WHERE units IS NOT NULL GROUP BY region ORDER BY region"))
+
+	// Only the projected columns are read
+	projected := table.WithProjection([]string{"units"})
+	rows, err := sql.NodeToRows(sql.NewEmptyContext(), planTable(projected))
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{nil, int64(3), nil, nil},
+		{nil, nil, nil, nil},
+		{nil, int64(4), nil, nil},
+		{nil, int64(1), nil, nil},
+	}, rows)
+}
+
+func TestCSVTableSchema(t *testing.T) {
+	require := require.New(t)
+	dir := writeCSVFiles(t, map[string]string{
+		"data.csv": "1;2020-01-02\n2;\n",
+		"bad.csv":  "1;2020-01-02;3\nx;2020-01-02\n",
+	})
+
+	// Without a header, the columns are numbered
+	table, err := NewCSVTable("data", filepath.Join(dir, "data.csv"), CSVOptions{Comma: ';'})
+	require.NoError(err)
+	require.Equal("c1", table.Schema()[0].Name)
+	require.Equal("c2", table.Schema()[1].Name)
+
+	schema := sql.Schema{
+		{Name: "id", Type: sql.Int32},
+		{Name: "day", Type: sql.Date, Nullable: true},
+	}
+	table, err = NewCSVTable("data", filepath.Join(dir, "data.csv"), CSVOptions{Comma: ';', Schema: schema})
+	require.NoError(err)
+	require.Equal("data", table.Schema()[0].Source)
+	require.Equal("", schema[0].Source)
+
+	query := newTestEngine(t, table)
+	require.Equal([]sql.Row{{int32(2)}}, query("SELECT id FROM data WHERE day IS NULL"))
+
+	// Records that don't match the schema are reported with their file
+	table, err = NewCSVTable("bad", filepath.Join(dir, "bad.csv"), CSVOptions{Comma: ';', Schema: schema})
+	require.NoError(err)
+	_, err = sql.NodeToRows(sql.NewEmptyContext(), planTable(table))
+	require.True(ErrCSVRecord.Is(err))
+	require.Contains(err.Error(), "record 1: expected 2 fields, found 3")
+
+	_, err = NewCSVTable("missing", filepath.Join(dir, "missing-*.csv"), CSVOptions{})
+	require.True(ErrNoCSVFiles.Is(err))
+}
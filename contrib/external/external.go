@@ -0,0 +1,115 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package external provides read-only tables whose rows are stored outside of the engine: CSV files, Parquet files
+// and tables of other MySQL servers. The tables may be added to any database, such as a memory.Database, to query
+// external data right away:
+//
+//	db := memory.NewDatabase("analytics")
+//	events, err := external.NewCSVTable("events", "/data/events-*.csv", external.CSVOptions{Header: true})
+//	if err != nil {
+//		return err
+//	}
+//	db.AddTable("events", events)
+//
+// Parquet tables are opened the same way, with OpenParquetTable.
+//
+// The tables implement the pushdown interfaces of the engine where the format allows it: all of them read only the
+// columns a query uses, Parquet tables skip the row groups whose statistics rule out the filters of the query, and
+// federated tables send the filters of the query to the remote server.
+package external
+
+import (
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// ErrColumnNotFound is returned when a projection names a column that the table doesn't have.
+var ErrColumnNotFound = errors.NewKind("column %s not found in table %s")
+
+// The schemas of the tables of this package have the name of their table as the Source of their columns.
+
+// projectedColumns returns the indexes in the schema of the table given of the columns given, or nil if no columns are
+// given, which means that every column is read.
+func projectedColumns(table string, schema sql.Schema, columns []string) ([]int, error) {
+	if len(columns) == 0 {
+		return nil, nil
+	}
+	indexes := make([]int, len(columns))
+	for i, name := range columns {
+		indexes[i] = schema.IndexOf(name, table)
+		if indexes[i] == -1 {
+			return nil, ErrColumnNotFound.New(name, table)
+		}
+	}
+	return indexes, nil
+}
+
+// allColumns returns the indexes of the columns read by a table with the schema given and the projection given,
+// which are all of its columns when there's no projection.
+func allColumns(schema sql.Schema, projection []int) []int {
+	if projection != nil {
+		return projection
+	}
+	indexes := make([]int, len(schema))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	return indexes
+}
+
+// handledFilters returns the filters given that only refer to the columns of the table given, and for which
+// |handled| returns true. A nil |handled| accepts every filter.
+func handledFilters(table string, schema sql.Schema, filters []sql.Expression, handled func(sql.Expression) bool) []sql.Expression {
+	var result []sql.Expression
+	for _, f := range filters {
+		ownFields := true
+		sql.Inspect(f, func(e sql.Expression) bool {
+			switch e := e.(type) {
+			case *expression.GetField:
+				if !strings.EqualFold(e.Table(), table) || !schema.Contains(e.Name(), table) {
+					ownFields = false
+				}
+			case *plan.Subquery:
+				// Subqueries may refer to the fields of other tables, which aren't in the rows of this one
+				ownFields = false
+			}
+			return ownFields
+		})
+		if ownFields && (handled == nil || handled(f)) {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// filterRow returns whether the row given satisfies all the filters given.
+func filterRow(ctx *sql.Context, filters []sql.Expression, row sql.Row) (bool, error) {
+	for _, f := range filters {
+		result, err := f.Eval(ctx, row)
+		if err != nil {
+			return false, err
+		}
+		result, _ = sql.ConvertToBool(result)
+		if result != true {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// newTestEngine returns a function that runs queries on an engine with a database named db that has the tables given.
+func newTestEngine(t *testing.T, tables ...sql.Table) func(query string) []sql.Row {
+	db := memory.NewDatabase("db")
+	for _, table := range tables {
+		db.AddTable(table.Name(), table)
+	}
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+
+	return func(query string) []sql.Row {
+		sess := sql.NewBaseSession()
+		sess.SetCurrentDatabase("db")
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sess))
+		_, iter, err := e.Query(ctx, query)
+		require.NoError(t, err, query)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(t, err, query)
+		return rows
+	}
+}
+
+// planTable returns a node that reads all the rows of the table given.
+func planTable(table sql.Table) sql.Node {
+	return plan.NewResolvedTable(table, nil, nil)
+}
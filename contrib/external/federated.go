@@ -0,0 +1,369 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bytes"
+	"context"
+	gosql "database/sql"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// FederatedTable is a read-only table whose rows are those of a table of another MySQL server, like a table of the
+// FEDERATED storage engine. The table has a single partition, whose rows are read with a SELECT statement on the
+// remote server.
+//
+// The table reads only the columns used by a query, and implements sql.FilteredTable: the filters of the query that
+// are comparisons of columns with literals, IS NULL or IN tests, or their combinations, are sent to the remote server in
+// the WHERE clause of the SELECT statement. Since the remote server may compare strings with another collation, the
+// rows it returns are filtered again by the table.
+type FederatedTable struct {
+	db     *gosql.DB
+	name   string
+	remote string
+	schema sql.Schema
+	// projection are the indexes of the projected columns, or nil if every column is read
+	projection []int
+	filters    []sql.Expression
+}
+
+var _ sql.Table = (*FederatedTable)(nil)
+var _ sql.ProjectedTable = (*FederatedTable)(nil)
+var _ sql.FilteredTable = (*FederatedTable)(nil)
+
+// NewFederatedTable returns a new FederatedTable with the name given, whose rows are those of the table given of the
+// remote server of the database handle given, such as one opened with the github.com/go-sql-driver/mysql driver. The
+// remote table may be qualified with its database. The schema of the table is that of the remote table, as given by
+// SHOW CREATE TABLE when the table is created.
+func NewFederatedTable(ctx context.Context, db *gosql.DB, name, remoteTable string) (*FederatedTable, error) {
+	remote := quoteQualifiedIdentifier(remoteTable)
+	var tableName, createTable string
+	err := db.QueryRowContext(ctx, "SHOW CREATE TABLE "+remote).Scan(&tableName, &createTable)
+	if err == gosql.ErrNoRows {
+		return nil, sql.ErrTableNotFound.New(remoteTable)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := parse.Parse(sql.NewEmptyContext(), createTable)
+	if err != nil {
+		return nil, err
+	}
+	create, ok := node.(*plan.CreateTable)
+	if !ok {
+		return nil, fmt.Errorf("unexpected result of SHOW CREATE TABLE %s: %s", remoteTable, createTable)
+	}
+
+	// The remote server fills in the defaults and generated values of its rows, which are never written locally
+	schema := create.PkSchema().Schema.Copy()
+	for _, col := range schema {
+		col.Source = name
+		col.Default = nil
+		col.AutoIncrement = false
+	}
+	return &FederatedTable{db: db, name: name, remote: remote, schema: schema}, nil
+}
+
+// Name implements the sql.Nameable interface.
+func (t *FederatedTable) Name() string {
+	return t.name
+}
+
+// String implements the sql.Table interface.
+func (t *FederatedTable) String() string {
+	return t.name
+}
+
+// Schema implements the sql.Table interface.
+func (t *FederatedTable) Schema() sql.Schema {
+	return t.schema
+}
+
+// Partitions implements the sql.Table interface.
+func (t *FederatedTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return sql.PartitionsToPartitionIter(federatedPartition{}), nil
+}
+
+// PartitionRows implements the sql.Table interface.
+func (t *FederatedTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	query, columns := t.query()
+	rows, err := t.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &federatedRowIter{table: t, rows: rows, columns: columns}, nil
+}
+
+// WithProjection implements the sql.ProjectedTable interface.
+func (t *FederatedTable) WithProjection(colNames []string) sql.Table {
+	projection, err := projectedColumns(t.name, t.schema, colNames)
+	if err != nil {
+		panic(err)
+	}
+	nt := *t
+	nt.projection = projection
+	return &nt
+}
+
+// HandledFilters implements the sql.FilteredTable interface. Every filter on the columns of the table is handled,
+// whether or not it's sent to the remote server.
+func (t *FederatedTable) HandledFilters(filters []sql.Expression) []sql.Expression {
+	return handledFilters(t.name, t.schema, filters, nil)
+}
+
+// WithFilters implements the sql.FilteredTable interface.
+func (t *FederatedTable) WithFilters(_ *sql.Context, filters []sql.Expression) sql.Table {
+	nt := *t
+	nt.filters = filters
+	return &nt
+}
+
+// query returns the SELECT statement that reads the rows of the table from the remote server, along with the indexes
+// of the columns it returns.
+func (t *FederatedTable) query() (string, []int) {
+	columns := allColumns(t.schema, t.projection)
+	if t.projection != nil && len(t.filters) > 0 {
+		read := make(map[int]bool)
+		for _, i := range columns {
+			read[i] = true
+		}
+		for i := range t.schema {
+			if !read[i] && t.filtersUse(i) {
+				columns = append(columns[:len(columns):len(columns)], i)
+			}
+		}
+	}
+
+	names := make([]string, len(columns))
+	for i, col := range columns {
+		names[i] = quoteIdentifier(t.schema[col].Name)
+	}
+	query := fmt.Sprintf("SELECT %s FROM %s", strings.Join(names, ", "), t.remote)
+
+	var conditions []string
+	for _, f := range t.filters {
+		conditions = append(conditions, remoteConditions(t.schema, f)...)
+	}
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	return query, columns
+}
+
+// filtersUse returns whether the filters of the table use the column given.
+func (t *FederatedTable) filtersUse(column int) bool {
+	var uses bool
+	for _, f := range t.filters {
+		sql.Inspect(f, func(e sql.Expression) bool {
+			if gf, ok := e.(*expression.GetField); ok && gf.Index() == column {
+				uses = true
+			}
+			return !uses
+		})
+	}
+	return uses
+}
+
+// remoteConditions returns the conditions of the WHERE clause of the remote server for the filter given: the
+// conjuncts of the filter that can be written in SQL. The conditions return every row that satisfies the filter, and
+// possibly others.
+func remoteConditions(schema sql.Schema, filter sql.Expression) []string {
+	if and, ok := filter.(*expression.And); ok {
+		return append(remoteConditions(schema, and.Left), remoteConditions(schema, and.Right)...)
+	}
+	if cond, ok := remoteSQL(schema, filter); ok {
+		return []string{cond}
+	}
+	return nil
+}
+
+// remoteSQL returns the SQL of the expression given, and false if it can't be written in SQL for the remote server.
+func remoteSQL(schema sql.Schema, e sql.Expression) (string, bool) {
+	binary := func(op string, left, right sql.Expression) (string, bool) {
+		l, ok := remoteSQL(schema, left)
+		if !ok {
+			return "", false
+		}
+		r, ok := remoteSQL(schema, right)
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("(%s %s %s)", l, op, r), true
+	}
+
+	switch e := e.(type) {
+	case *expression.GetField:
+		if e.Index() >= len(schema) {
+			return "", false
+		}
+		return quoteIdentifier(schema[e.Index()].Name), true
+	case *expression.Literal:
+		return literalSQL(e)
+	case *expression.And:
+		return binary("AND", e.Left, e.Right)
+	case *expression.Or:
+		return binary("OR", e.Left, e.Right)
+	case *expression.Not:
+		s, ok := remoteSQL(schema, e.Child)
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("(NOT %s)", s), true
+	case *expression.IsNull:
+		s, ok := remoteSQL(schema, e.Child)
+		if !ok {
+			return "", false
+		}
+		return fmt.Sprintf("(%s IS NULL)", s), true
+	case *expression.Equals:
+		return binary("=", e.Left(), e.Right())
+	case *expression.NullSafeEquals:
+		return binary("<=>", e.Left(), e.Right())
+	case *expression.LessThan:
+		return binary("<", e.Left(), e.Right())
+	case *expression.LessThanOrEqual:
+		return binary("<=", e.Left(), e.Right())
+	case *expression.GreaterThan:
+		return binary(">", e.Left(), e.Right())
+	case *expression.GreaterThanOrEqual:
+		return binary(">=", e.Left(), e.Right())
+	case *expression.InTuple:
+		tuple, ok := e.Right().(expression.Tuple)
+		if !ok {
+			return "", false
+		}
+		left, ok := remoteSQL(schema, e.Left())
+		if !ok {
+			return "", false
+		}
+		values := make([]string, len(tuple))
+		for i, v := range tuple {
+			if values[i], ok = remoteSQL(schema, v); !ok {
+				return "", false
+			}
+		}
+		return fmt.Sprintf("(%s IN (%s))", left, strings.Join(values, ", ")), true
+	default:
+		return "", false
+	}
+}
+
+// literalSQL returns the SQL of the literal given, and false if its type can't be written as a literal.
+func literalSQL(lit *expression.Literal) (string, bool) {
+	if lit.Value() == nil {
+		return "NULL", true
+	}
+	typ := lit.Type()
+	if !sql.IsNumber(typ) && !sql.IsText(typ) && !sql.IsTime(typ) {
+		return "", false
+	}
+	v, err := typ.SQL(lit.Value())
+	if err != nil {
+		return "", false
+	}
+	var buf bytes.Buffer
+	v.EncodeSQL(&buf)
+	return buf.String(), true
+}
+
+// quoteIdentifier returns the identifier given quoted with backticks.
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// quoteQualifiedIdentifier returns the table name given, which may be qualified with its database, quoted with
+// backticks.
+func quoteQualifiedIdentifier(name string) string {
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		return quoteIdentifier(name[:i]) + "." + quoteIdentifier(name[i+1:])
+	}
+	return quoteIdentifier(name)
+}
+
+// federatedPartition is the only partition of a FederatedTable.
+type federatedPartition struct{}
+
+func (federatedPartition) Key() []byte {
+	return nil
+}
+
+// federatedRowIter returns the rows of a FederatedTable read from the remote server.
+type federatedRowIter struct {
+	table   *FederatedTable
+	rows    *gosql.Rows
+	columns []int
+}
+
+var _ sql.RowIter = (*federatedRowIter)(nil)
+
+func (i *federatedRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	values := make([]interface{}, len(i.columns))
+	dest := make([]interface{}, len(i.columns))
+	for j := range values {
+		dest[j] = &values[j]
+	}
+
+	for {
+		if !i.rows.Next() {
+			if err := i.rows.Err(); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+		if err := i.rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		row := make(sql.Row, len(i.table.schema))
+		for j, col := range i.columns {
+			typ := i.table.schema[col].Type
+			v := values[j]
+			// Values are sent as text by the remote server, except for binary strings
+			if b, ok := v.([]byte); ok && !sql.IsBlob(typ) {
+				v = string(b)
+			}
+			var err error
+			if row[col], err = typ.Convert(v); err != nil {
+				return nil, err
+			}
+		}
+
+		ok, err := filterRow(ctx, i.table.filters, row)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if i.table.projection != nil {
+			for _, col := range i.columns[len(i.table.projection):] {
+				row[col] = nil
+			}
+		}
+		return row, nil
+	}
+}
+
+func (i *federatedRowIter) Close(*sql.Context) error {
+	return i.rows.Close()
+}
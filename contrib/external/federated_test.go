@@ -0,0 +1,125 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"context"
+	gosql "database/sql"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// startRemoteServer starts a server with an empty database named remote, and returns a handle to it.
+func startRemoteServer(t *testing.T) *gosql.DB {
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.NoError(t, l.Close())
+
+	e := sqle.NewDefault(memory.NewMemoryDBProvider(memory.NewDatabase("remote")))
+	s, err := server.NewDefaultServer(server.Config{Protocol: "tcp", Address: fmt.Sprintf("localhost:%d", port)}, e)
+	require.NoError(t, err)
+	go s.Start()
+	t.Cleanup(func() { s.Close() })
+
+	db, err := gosql.Open("mysql", fmt.Sprintf("root:@tcp(localhost:%d)/remote", port))
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	for i := 0; db.Ping() != nil; i++ {
+		require.Less(t, i, 50, "server didn't start")
+		time.Sleep(100 * time.Millisecond)
+	}
+	return db
+}
+
+func TestFederatedTable(t *testing.T) {
+	require := require.New(t)
+	remote := startRemoteServer(t)
+	for _, query := range []string{
+		"CREATE TABLE orders (id BIGINT PRIMARY KEY, customer VARCHAR(20) NOT NULL, total DECIMAL(10,2), placed DATETIME, data BLOB)",
+		"INSERT INTO orders VALUES (1, 'ann', 10.50, '2022-01-02 10:00:00', 'x'), (2, 'bob', NULL, NULL, NULL), (3, 'ann', 3.25, '2022-02-03 04:05:06', 'yz')",
+	} {
+		_, err := remote.Exec(query)
+		require.NoError(err, query)
+	}
+
+	table, err := NewFederatedTable(context.Background(), remote, "orders", "remote.orders")
+	require.NoError(err)
+	require.Len(table.Schema(), 5)
+	require.True(table.Schema()[0].PrimaryKey)
+	require.Equal("orders", table.Schema()[0].Source)
+
+	query := newTestEngine(t, table)
+	placed := time.Date(2022, 1, 2, 10, 0, 0, 0, time.UTC)
+	rows := query("SELECT id, customer, total, placed, data FROM orders ORDER BY id")
+	require.Len(rows, 3)
+	require.Equal(sql.Row{int64(1), "ann", "10.50", placed, "x"}, sql.Row{rows[0][0], rows[0][1], fmt.Sprint(rows[0][2]), rows[0][3], rows[0][4]})
+	require.Equal(sql.Row{int64(2), "bob", nil, nil, nil}, rows[1])
+
+	require.Equal([]sql.Row{{int64(3)}}, query("SELECT id FROM orders WHERE customer = 'ann' AND placed > '2022-01-31'"))
+	require.Equal([]sql.Row{{"bob"}}, query("SELECT customer FROM orders WHERE total IS NULL"))
+	require.Equal([]sql.Row{{"ann", int64(2)}, {"bob", int64(1)}}, query("SELECT customer, COUNT(*) FROM orders GROUP BY customer ORDER BY customer"))
+
+	_, err = NewFederatedTable(context.Background(), remote, "missing", "missing")
+	require.Error(err)
+}
+
+func TestFederatedTableQuery(t *testing.T) {
+	require := require.New(t)
+	table := &FederatedTable{
+		name:   "t",
+		remote: quoteQualifiedIdentifier("db.remote"),
+		schema: sql.Schema{
+			{Name: "id", Type: sql.Int64, Source: "t"},
+			{Name: "name", Type: sql.Text, Source: "t"},
+			{Name: "odd`name", Type: sql.Int64, Source: "t"},
+		},
+	}
+	id := expression.NewGetFieldWithTable(0, sql.Int64, "t", "id", false)
+	name := expression.NewGetFieldWithTable(1, sql.Text, "t", "name", false)
+	odd := expression.NewGetFieldWithTable(2, sql.Int64, "t", "odd`name", false)
+
+	query, columns := table.query()
+	require.Equal("SELECT `id`, `name`, `odd``name` FROM `db`.`remote`", query)
+	require.Equal([]int{0, 1, 2}, columns)
+
+	filtered := table.WithProjection([]string{"id"}).(*FederatedTable).WithFilters(nil, []sql.Expression{
+		expression.NewAnd(
+			expression.NewEquals(name, expression.NewLiteral("it's", sql.Text)),
+			// Functions aren't sent to the remote server, but the other conjuncts are
+			expression.NewEquals(expression.NewUnresolvedFunction("lower", false, nil, name), expression.NewLiteral("x", sql.Text)),
+		),
+		expression.NewOr(
+			expression.NewLessThan(id, expression.NewLiteral(int64(3), sql.Int64)),
+			expression.NewIsNull(odd),
+		),
+		expression.NewInTuple(id, expression.NewTuple(expression.NewLiteral(int64(1), sql.Int64), expression.NewLiteral(int64(2), sql.Int64))),
+		expression.NewNot(expression.NewEquals(expression.NewUnresolvedFunction("lower", false, nil, name), expression.NewLiteral("x", sql.Text))),
+	}).(*FederatedTable)
+	query, columns = filtered.query()
+	require.Equal("SELECT `id`, `name`, `odd``name` FROM `db`.`remote` WHERE (`name` = 'it\\'s') AND ((`id` < 3) OR (`odd``name` IS NULL)) AND (`id` IN (1, 2))", query)
+	require.Equal([]int{0, 1, 2}, columns)
+}
@@ -0,0 +1,573 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// ErrParquetSchemaMismatch is returned when the files of a Parquet table don't have the same columns.
+var ErrParquetSchemaMismatch = errors.NewKind("the columns of Parquet file %d of table %s don't match those of its first file")
+
+// ErrParquetValue is returned when a Parquet file returns a value that doesn't match the type of its column.
+var ErrParquetValue = errors.NewKind("unexpected value %v of type %T for Parquet column %s of type %s")
+
+// ParquetType is the physical type of a column of a Parquet file.
+type ParquetType byte
+
+const (
+	ParquetBoolean ParquetType = iota
+	ParquetInt32
+	ParquetInt64
+	// ParquetInt96 is the type of legacy timestamps, written by Impala and Spark.
+	ParquetInt96
+	ParquetFloat
+	ParquetDouble
+	ParquetByteArray
+	ParquetFixedLenByteArray
+)
+
+// String returns the name of the physical type in Parquet schemas.
+func (t ParquetType) String() string {
+	switch t {
+	case ParquetBoolean:
+		return "BOOLEAN"
+	case ParquetInt32:
+		return "INT32"
+	case ParquetInt64:
+		return "INT64"
+	case ParquetInt96:
+		return "INT96"
+	case ParquetFloat:
+		return "FLOAT"
+	case ParquetDouble:
+		return "DOUBLE"
+	case ParquetByteArray:
+		return "BYTE_ARRAY"
+	case ParquetFixedLenByteArray:
+		return "FIXED_LEN_BYTE_ARRAY"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ParquetLogicalType is the logical type of a column of a Parquet file, which tells how the values of its physical
+// type are interpreted.
+type ParquetLogicalType byte
+
+const (
+	// ParquetNoLogicalType is the logical type of columns that are read as their physical type.
+	ParquetNoLogicalType ParquetLogicalType = iota
+	// ParquetString is the logical type of UTF-8 byte arrays.
+	ParquetString
+	// ParquetJSON is the logical type of byte arrays of JSON documents.
+	ParquetJSON
+	// ParquetDate is the logical type of INT32 days since the Unix epoch.
+	ParquetDate
+	// ParquetTimestampMillis is the logical type of INT64 milliseconds since the Unix epoch.
+	ParquetTimestampMillis
+	// ParquetTimestampMicros is the logical type of INT64 microseconds since the Unix epoch.
+	ParquetTimestampMicros
+)
+
+// ParquetColumn is a column of a Parquet file. Only flat schemas are supported: the columns are the leaves of the
+// schema of the file, and none of them are repeated.
+type ParquetColumn struct {
+	Name    string
+	Type    ParquetType
+	Logical ParquetLogicalType
+	// Optional is whether the values of the column may be null.
+	Optional bool
+}
+
+// ParquetColumnStatistics are the statistics of a column in a row group. The values are those of the physical type of
+// the column, as returned by ParquetRowReader.
+type ParquetColumnStatistics struct {
+	// Min and Max are the minimum and maximum values of the column in the row group, or nil if they aren't known.
+	Min, Max interface{}
+	// NullCount is the number of null values of the column in the row group, or -1 if it isn't known.
+	NullCount int64
+}
+
+// ParquetRowGroup is the metadata of a row group of a Parquet file.
+type ParquetRowGroup struct {
+	NumRows int64
+	// Columns are the statistics of the columns of the file in the row group, in the order of the columns of the file,
+	// or nil if the row group has no statistics.
+	Columns []ParquetColumnStatistics
+}
+
+// ParquetFile is a Parquet file. OpenParquetFile and NewParquetFile read the files of flat schemas with the common
+// encodings and codecs, and integrators may adapt the Parquet library of their choice to this interface to read the
+// others.
+type ParquetFile interface {
+	// Columns returns the columns of the file.
+	Columns() []ParquetColumn
+	// RowGroups returns the metadata of the row groups of the file.
+	RowGroups() []ParquetRowGroup
+	// ReadRowGroup returns a reader of the values of the columns given, by their index, of the rows of the row group
+	// given.
+	ReadRowGroup(ctx *sql.Context, rowGroup int, columns []int) (ParquetRowReader, error)
+}
+
+// ParquetRowReader reads the values of the rows of a row group of a Parquet file.
+type ParquetRowReader interface {
+	// Next returns the values of the columns read of the next row, in the order they were given to ReadRowGroup, or
+	// io.EOF when there are no more rows. Values are nil, or of the Go type of the physical type of their column: bool,
+	// int32, int64, [12]byte for INT96, float32, float64 and []byte for byte arrays.
+	Next() ([]interface{}, error)
+	Close() error
+}
+
+// ParquetTable is a read-only table whose rows are those of one or more Parquet files with the same columns. Each row
+// group of the files is a partition of the table.
+//
+// The table reads only the columns used by a query, and implements sql.FilteredTable: row groups whose statistics
+// show that none of their rows satisfy the filters of the query aren't read at all, and the other row groups are
+// filtered row by row.
+type ParquetTable struct {
+	name    string
+	files   []ParquetFile
+	columns []ParquetColumn
+	schema  sql.Schema
+	// projection are the indexes of the projected columns, or nil if every column is read
+	projection []int
+	filters    []sql.Expression
+}
+
+var _ sql.Table = (*ParquetTable)(nil)
+var _ sql.ProjectedTable = (*ParquetTable)(nil)
+var _ sql.FilteredTable = (*ParquetTable)(nil)
+var _ sql.PartitionCounter = (*ParquetTable)(nil)
+
+// NewParquetTable returns a new ParquetTable with the name given, whose rows are those of the files given.
+func NewParquetTable(name string, files ...ParquetFile) (*ParquetTable, error) {
+	if len(files) == 0 {
+		return nil, fmt.Errorf("Parquet table %s has no files", name)
+	}
+	columns := files[0].Columns()
+	for i, f := range files[1:] {
+		other := f.Columns()
+		if len(other) != len(columns) {
+			return nil, ErrParquetSchemaMismatch.New(i+2, name)
+		}
+		for j := range columns {
+			if columns[j] != other[j] {
+				return nil, ErrParquetSchemaMismatch.New(i+2, name)
+			}
+		}
+	}
+
+	schema := make(sql.Schema, len(columns))
+	for i, col := range columns {
+		schema[i] = &sql.Column{
+			Name:     col.Name,
+			Type:     parquetSQLType(col),
+			Nullable: col.Optional,
+			Source:   name,
+		}
+	}
+	return &ParquetTable{name: name, files: files, columns: columns, schema: schema}, nil
+}
+
+// parquetSQLType returns the SQL type of the values of the Parquet column given.
+func parquetSQLType(col ParquetColumn) sql.Type {
+	switch col.Logical {
+	case ParquetString:
+		return sql.LongText
+	case ParquetJSON:
+		return sql.JSON
+	case ParquetDate:
+		return sql.Date
+	case ParquetTimestampMillis, ParquetTimestampMicros:
+		return sql.Datetime
+	}
+	switch col.Type {
+	case ParquetBoolean:
+		return sql.Boolean
+	case ParquetInt32:
+		return sql.Int32
+	case ParquetInt64:
+		return sql.Int64
+	case ParquetInt96:
+		return sql.Datetime
+	case ParquetFloat:
+		return sql.Float32
+	case ParquetDouble:
+		return sql.Float64
+	default:
+		return sql.LongBlob
+	}
+}
+
+// Name implements the sql.Nameable interface.
+func (t *ParquetTable) Name() string {
+	return t.name
+}
+
+// String implements the sql.Table interface.
+func (t *ParquetTable) String() string {
+	return t.name
+}
+
+// Schema implements the sql.Table interface.
+func (t *ParquetTable) Schema() sql.Schema {
+	return t.schema
+}
+
+// Partitions implements the sql.Table interface. Row groups that the filters of the table rule out have no partition.
+func (t *ParquetTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	var partitions []sql.Partition
+	for i, f := range t.files {
+		for j, rg := range f.RowGroups() {
+			if rg.NumRows == 0 || t.skipRowGroup(rg) {
+				continue
+			}
+			partitions = append(partitions, rowGroupPartition{file: i, rowGroup: j})
+		}
+	}
+	return sql.PartitionsToPartitionIter(partitions...), nil
+}
+
+// PartitionCount implements the sql.PartitionCounter interface.
+func (t *ParquetTable) PartitionCount(*sql.Context) (int64, error) {
+	var count int64
+	for _, f := range t.files {
+		count += int64(len(f.RowGroups()))
+	}
+	return count, nil
+}
+
+// PartitionRows implements the sql.Table interface.
+func (t *ParquetTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	p, ok := partition.(rowGroupPartition)
+	if !ok {
+		var err error
+		if p, err = parseRowGroupPartition(partition.Key()); err != nil {
+			return nil, err
+		}
+	}
+	if p.file >= len(t.files) || p.rowGroup >= len(t.files[p.file].RowGroups()) {
+		return nil, sql.ErrPartitionNotFound.New(partition.Key())
+	}
+
+	// The columns of the filters are read along with the projected ones
+	columns := allColumns(t.schema, t.projection)
+	if t.projection != nil {
+		read := make(map[int]bool)
+		for _, i := range columns {
+			read[i] = true
+		}
+		for _, f := range t.filters {
+			sql.Inspect(f, func(e sql.Expression) bool {
+				if gf, ok := e.(*expression.GetField); ok && !read[gf.Index()] {
+					read[gf.Index()] = true
+					columns = append(columns[:len(columns):len(columns)], gf.Index())
+				}
+				return true
+			})
+		}
+	}
+
+	reader, err := t.files[p.file].ReadRowGroup(ctx, p.rowGroup, columns)
+	if err != nil {
+		return nil, err
+	}
+	return &parquetRowIter{table: t, reader: reader, columns: columns}, nil
+}
+
+// WithProjection implements the sql.ProjectedTable interface.
+func (t *ParquetTable) WithProjection(colNames []string) sql.Table {
+	projection, err := projectedColumns(t.name, t.schema, colNames)
+	if err != nil {
+		panic(err)
+	}
+	nt := *t
+	nt.projection = projection
+	return &nt
+}
+
+// HandledFilters implements the sql.FilteredTable interface. Every filter on the columns of the table is handled.
+func (t *ParquetTable) HandledFilters(filters []sql.Expression) []sql.Expression {
+	return handledFilters(t.name, t.schema, filters, nil)
+}
+
+// WithFilters implements the sql.FilteredTable interface.
+func (t *ParquetTable) WithFilters(_ *sql.Context, filters []sql.Expression) sql.Table {
+	nt := *t
+	nt.filters = filters
+	return &nt
+}
+
+// skipRowGroup returns whether the statistics of the row group given show that none of its rows satisfy one of the
+// filters of the table.
+func (t *ParquetTable) skipRowGroup(rg ParquetRowGroup) bool {
+	if len(rg.Columns) != len(t.columns) {
+		return false
+	}
+	for _, f := range t.filters {
+		if t.excludes(rg, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// excludes returns whether the statistics of the row group given show that none of its rows satisfy the filter given.
+// Only comparisons of a column with a literal, IS NULL and their conjunctions and disjunctions are considered.
+func (t *ParquetTable) excludes(rg ParquetRowGroup, filter sql.Expression) bool {
+	switch f := filter.(type) {
+	case *expression.And:
+		return t.excludes(rg, f.Left) || t.excludes(rg, f.Right)
+	case *expression.Or:
+		return t.excludes(rg, f.Left) && t.excludes(rg, f.Right)
+	case *expression.IsNull:
+		gf, ok := f.Child.(*expression.GetField)
+		return ok && rg.Columns[gf.Index()].NullCount == 0
+	case expression.Comparer:
+		return t.excludesComparison(rg, f)
+	default:
+		return false
+	}
+}
+
+// excludesComparison returns whether the statistics of the row group given show that none of its rows satisfy the
+// comparison given.
+func (t *ParquetTable) excludesComparison(rg ParquetRowGroup, c expression.Comparer) bool {
+	flipped := false
+	gf, ok := c.Left().(*expression.GetField)
+	lit, isLit := c.Right().(*expression.Literal)
+	if !ok || !isLit {
+		gf, ok = c.Right().(*expression.GetField)
+		lit, isLit = c.Left().(*expression.Literal)
+		flipped = true
+	}
+	if !ok || !isLit || lit.Value() == nil {
+		return false
+	}
+
+	typ := t.schema[gf.Index()].Type
+	stats := rg.Columns[gf.Index()]
+	// Strings are LONGTEXT, whose binary collation orders them like the statistics of byte arrays
+	if stats.Min == nil || stats.Max == nil {
+		return false
+	}
+	col := t.columns[gf.Index()]
+	min, err := parquetValue(col, stats.Min)
+	if err != nil {
+		return false
+	}
+	max, err := parquetValue(col, stats.Max)
+	if err != nil {
+		return false
+	}
+	v, err := typ.Convert(lit.Value())
+	if err != nil {
+		return false
+	}
+	vsMin, err := typ.Compare(v, min)
+	if err != nil {
+		return false
+	}
+	vsMax, err := typ.Compare(v, max)
+	if err != nil {
+		return false
+	}
+
+	// Whether no value of the column is less than v, less than or equal to v, and so on. With the column on the
+	// right, v < col is col > v, and so on.
+	noneLess, noneLessOrEqual := vsMin <= 0, vsMin < 0
+	noneGreater, noneGreaterOrEqual := vsMax >= 0, vsMax > 0
+	if flipped {
+		noneLess, noneGreater = noneGreater, noneLess
+		noneLessOrEqual, noneGreaterOrEqual = noneGreaterOrEqual, noneLessOrEqual
+	}
+	switch c.(type) {
+	case *expression.Equals, *expression.NullSafeEquals:
+		return vsMin < 0 || vsMax > 0
+	case *expression.LessThan:
+		return noneLess
+	case *expression.LessThanOrEqual:
+		return noneLessOrEqual
+	case *expression.GreaterThan:
+		return noneGreater
+	case *expression.GreaterThanOrEqual:
+		return noneGreaterOrEqual
+	default:
+		return false
+	}
+}
+
+// parquetValue converts the value given, of the physical type of the column given, to a value of the SQL type of the
+// column.
+func parquetValue(col ParquetColumn, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	mismatch := func() error {
+		return ErrParquetValue.New(v, v, col.Name, col.Type)
+	}
+
+	switch col.Type {
+	case ParquetBoolean:
+		b, ok := v.(bool)
+		if !ok {
+			return nil, mismatch()
+		}
+		if b {
+			return int8(1), nil
+		}
+		return int8(0), nil
+	case ParquetInt32:
+		n, ok := v.(int32)
+		if !ok {
+			return nil, mismatch()
+		}
+		if col.Logical == ParquetDate {
+			return time.Unix(int64(n)*24*60*60, 0).UTC(), nil
+		}
+		return n, nil
+	case ParquetInt64:
+		n, ok := v.(int64)
+		if !ok {
+			return nil, mismatch()
+		}
+		switch col.Logical {
+		case ParquetTimestampMillis:
+			return time.Unix(0, n*int64(time.Millisecond)).UTC(), nil
+		case ParquetTimestampMicros:
+			return time.Unix(0, n*int64(time.Microsecond)).UTC(), nil
+		}
+		return n, nil
+	case ParquetInt96:
+		b, ok := v.([12]byte)
+		if !ok {
+			return nil, mismatch()
+		}
+		// The nanoseconds of the day, followed by the Julian day
+		nanos := int64(binary.LittleEndian.Uint64(b[:8]))
+		days := int64(binary.LittleEndian.Uint32(b[8:])) - julianDayOfUnixEpoch
+		return time.Unix(days*24*60*60, nanos).UTC(), nil
+	case ParquetFloat:
+		f, ok := v.(float32)
+		if !ok {
+			return nil, mismatch()
+		}
+		return f, nil
+	case ParquetDouble:
+		f, ok := v.(float64)
+		if !ok {
+			return nil, mismatch()
+		}
+		return f, nil
+	default:
+		b, ok := v.([]byte)
+		if !ok {
+			return nil, mismatch()
+		}
+		switch col.Logical {
+		case ParquetString:
+			return string(b), nil
+		case ParquetJSON:
+			return sql.JSON.Convert(string(b))
+		}
+		return b, nil
+	}
+}
+
+// julianDayOfUnixEpoch is the Julian day of January 1st, 1970.
+const julianDayOfUnixEpoch = 2440588
+
+// rowGroupPartition is a row group of a file of a ParquetTable, whose key is the index of the file and the index of
+// the row group, separated by a slash.
+type rowGroupPartition struct {
+	file, rowGroup int
+}
+
+func (p rowGroupPartition) Key() []byte {
+	return []byte(fmt.Sprintf("%d/%d", p.file, p.rowGroup))
+}
+
+// parseRowGroupPartition returns the row group partition with the key given.
+func parseRowGroupPartition(key []byte) (rowGroupPartition, error) {
+	parts := strings.Split(string(key), "/")
+	if len(parts) == 2 {
+		file, ferr := strconv.Atoi(parts[0])
+		rowGroup, rerr := strconv.Atoi(parts[1])
+		if ferr == nil && rerr == nil {
+			return rowGroupPartition{file: file, rowGroup: rowGroup}, nil
+		}
+	}
+	return rowGroupPartition{}, sql.ErrPartitionNotFound.New(key)
+}
+
+// parquetRowIter returns the rows of a row group of a Parquet table.
+type parquetRowIter struct {
+	table   *ParquetTable
+	reader  ParquetRowReader
+	columns []int
+}
+
+var _ sql.RowIter = (*parquetRowIter)(nil)
+
+func (i *parquetRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		values, err := i.reader.Next()
+		if err != nil {
+			return nil, err
+		}
+		if len(values) != len(i.columns) {
+			return nil, fmt.Errorf("Parquet table %s: expected %d values per row, found %d", i.table.name, len(i.columns), len(values))
+		}
+
+		row := make(sql.Row, len(i.table.schema))
+		for j, col := range i.columns {
+			if row[col], err = parquetValue(i.table.columns[col], values[j]); err != nil {
+				return nil, err
+			}
+		}
+		ok, err := filterRow(ctx, i.table.filters, row)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		// The columns that were only read for the filters aren't returned
+		if i.table.projection != nil {
+			for _, col := range i.columns[len(i.table.projection):] {
+				row[col] = nil
+			}
+		}
+		return row, nil
+	}
+}
+
+func (i *parquetRowIter) Close(*sql.Context) error {
+	return i.reader.Close()
+}
@@ -0,0 +1,1102 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ErrNoParquetFiles is returned when a Parquet table is opened for a pattern that matches no files.
+var ErrNoParquetFiles = errors.NewKind("no Parquet files match %s")
+
+// ErrParquetFormat is returned when a file isn't a valid Parquet file.
+var ErrParquetFormat = errors.NewKind("%s is not a valid Parquet file: %s")
+
+// ErrParquetUnsupported is returned when a Parquet file uses a feature that OpenParquetFile doesn't support.
+var ErrParquetUnsupported = errors.NewKind("%s: %s is not supported")
+
+// parquetMagic starts and ends Parquet files.
+const parquetMagic = "PAR1"
+
+// The repetitions of the fields of Parquet schemas, which are required otherwise.
+const (
+	parquetOptional int32 = 1
+	parquetRepeated int32 = 2
+)
+
+// The converted types of the fields of Parquet schemas, which older writers use instead of logical types.
+const (
+	parquetConvertedUTF8            int32 = 0
+	parquetConvertedEnum            int32 = 4
+	parquetConvertedDecimal         int32 = 5
+	parquetConvertedDate            int32 = 6
+	parquetConvertedTimestampMillis int32 = 9
+	parquetConvertedTimestampMicros int32 = 10
+	parquetConvertedUint8           int32 = 11
+	parquetConvertedUint64          int32 = 14
+	parquetConvertedJSON            int32 = 19
+)
+
+// The compression codecs of the column chunks of Parquet files.
+const (
+	parquetUncompressed int32 = 0
+	parquetSnappy       int32 = 1
+	parquetGzip         int32 = 2
+)
+
+// The types of the pages of Parquet files. Pages of other types are skipped.
+const (
+	parquetDataPage       int32 = 0
+	parquetDictionaryPage int32 = 2
+	parquetDataPageV2     int32 = 3
+)
+
+// The encodings of the values and levels of the pages of Parquet files.
+const (
+	parquetPlain           int32 = 0
+	parquetPlainDictionary int32 = 2
+	parquetRLE             int32 = 3
+	parquetRLEDictionary   int32 = 8
+)
+
+// parquetFile is a Parquet file read by OpenParquetFile or NewParquetFile.
+type parquetFile struct {
+	name string
+	// r reads the file, or is nil when the file is opened by ReadRowGroup
+	r         io.ReaderAt
+	size      int64
+	columns   []ParquetColumn
+	leaves    []parquetLeaf
+	rowGroups []parquetRowGroup
+}
+
+var _ ParquetFile = (*parquetFile)(nil)
+
+// parquetLeaf is what the metadata of a Parquet file tells about one of its columns, besides its ParquetColumn.
+type parquetLeaf struct {
+	typeLength int
+	// signed is whether the statistics of the column are ordered like the values of its SQL type. Unsigned integers
+	// and decimals are read as the signed integers and byte arrays they are stored as, which are ordered differently.
+	signed bool
+}
+
+// parquetRowGroup is the metadata of a row group of a Parquet file.
+type parquetRowGroup struct {
+	numRows int64
+	chunks  []parquetColumnChunk
+}
+
+// parquetColumnChunk is the metadata of the values of a column in a row group.
+type parquetColumnChunk struct {
+	external             bool
+	codec                int32
+	numValues            int64
+	totalCompressedSize  int64
+	dataPageOffset       int64
+	dictionaryPageOffset int64
+	hasDictionary        bool
+	// min and max are the encoded minimum and maximum values of the statistics of the chunk, which are the deprecated
+	// ones when legacyStatistics is set
+	min, max         []byte
+	legacyStatistics bool
+	nullCount        int64
+}
+
+// OpenParquetTable returns a new ParquetTable with the name given, whose rows are those of the files that match the
+// pattern given, as matched by filepath.Glob. The files are read by OpenParquetFile.
+func OpenParquetTable(name, pattern string) (*ParquetTable, error) {
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, ErrNoParquetFiles.New(pattern)
+	}
+	sort.Strings(paths)
+
+	files := make([]ParquetFile, len(paths))
+	for i, path := range paths {
+		if files[i], err = OpenParquetFile(path); err != nil {
+			return nil, err
+		}
+	}
+	return NewParquetTable(name, files...)
+}
+
+// OpenParquetFile reads the metadata of the Parquet file at the path given. The file is opened again each time one of
+// its row groups is read, so it isn't kept open.
+//
+// The columns of the file must be flat, as described by ParquetColumn. Their pages may be version 1 or 2 data pages,
+// compressed with Snappy or gzip or not at all, whose values are PLAIN or dictionary encoded. Booleans may also be RLE
+// encoded. Other encodings and codecs return ErrParquetUnsupported when the row groups that use them are read.
+func OpenParquetFile(path string) (ParquetFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	pf, err := readParquetMetadata(path, f, info.Size())
+	if err != nil {
+		return nil, err
+	}
+	return pf, nil
+}
+
+// NewParquetFile reads the metadata of the Parquet file of the size given that |r| reads, as OpenParquetFile does.
+// Row groups are read from |r| too, which must remain readable while the file is used.
+func NewParquetFile(name string, r io.ReaderAt, size int64) (ParquetFile, error) {
+	pf, err := readParquetMetadata(name, r, size)
+	if err != nil {
+		return nil, err
+	}
+	pf.r = r
+	return pf, nil
+}
+
+// readParquetMetadata reads the footer of the Parquet file given.
+func readParquetMetadata(name string, r io.ReaderAt, size int64) (*parquetFile, error) {
+	if size < int64(2*len(parquetMagic)+4) {
+		return nil, ErrParquetFormat.New(name, "file too short")
+	}
+	var head [4]byte
+	if _, err := r.ReadAt(head[:], 0); err != nil {
+		return nil, err
+	}
+	var tail [8]byte
+	if _, err := r.ReadAt(tail[:], size-8); err != nil {
+		return nil, err
+	}
+	if string(head[:]) != parquetMagic || string(tail[4:]) != parquetMagic {
+		return nil, ErrParquetFormat.New(name, "no PAR1 magic number")
+	}
+	length := int64(binary.LittleEndian.Uint32(tail[:4]))
+	if length > size-8-int64(len(parquetMagic)) {
+		return nil, ErrParquetFormat.New(name, "footer longer than the file")
+	}
+	footer := make([]byte, length)
+	if _, err := r.ReadAt(footer, size-8-length); err != nil {
+		return nil, err
+	}
+
+	f := &parquetFile{name: name, size: size}
+	if err := f.readFileMetadata(&thriftReader{b: footer}); err != nil {
+		if ErrParquetUnsupported.Is(err) {
+			return nil, err
+		}
+		return nil, ErrParquetFormat.New(name, err.Error())
+	}
+	return f, nil
+}
+
+// Columns implements the ParquetFile interface.
+func (f *parquetFile) Columns() []ParquetColumn {
+	return f.columns
+}
+
+// RowGroups implements the ParquetFile interface.
+func (f *parquetFile) RowGroups() []ParquetRowGroup {
+	rowGroups := make([]ParquetRowGroup, len(f.rowGroups))
+	for i, rg := range f.rowGroups {
+		rowGroups[i].NumRows = rg.numRows
+		rowGroups[i].Columns = make([]ParquetColumnStatistics, len(rg.chunks))
+		for j, chunk := range rg.chunks {
+			rowGroups[i].Columns[j] = f.statistics(j, chunk)
+		}
+	}
+	return rowGroups
+}
+
+// ReadRowGroup implements the ParquetFile interface. The column chunks read are decoded in memory at once.
+func (f *parquetFile) ReadRowGroup(_ *sql.Context, rowGroup int, columns []int) (ParquetRowReader, error) {
+	if rowGroup < 0 || rowGroup >= len(f.rowGroups) {
+		return nil, fmt.Errorf("%s: row group %d not found", f.name, rowGroup)
+	}
+	rg := f.rowGroups[rowGroup]
+
+	r := f.r
+	if r == nil {
+		file, err := os.Open(f.name)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	values := make([][]interface{}, len(columns))
+	for i, col := range columns {
+		if col < 0 || col >= len(f.columns) {
+			return nil, fmt.Errorf("%s: column %d not found", f.name, col)
+		}
+		var err error
+		if values[i], err = f.readColumnChunk(r, col, rg.chunks[col], rg.numRows); err != nil {
+			return nil, err
+		}
+	}
+	return &parquetValuesReader{values: values, numRows: int(rg.numRows)}, nil
+}
+
+// readColumnChunk returns the values of the column chunk given, which are nil for null values.
+func (f *parquetFile) readColumnChunk(r io.ReaderAt, col int, chunk parquetColumnChunk, numRows int64) ([]interface{}, error) {
+	column := f.columns[col]
+	if chunk.external {
+		return nil, ErrParquetUnsupported.New(f.name, "column chunks in other files")
+	}
+	if chunk.numValues != numRows {
+		return nil, ErrParquetFormat.New(f.name, fmt.Sprintf("column %s has %d values in a row group of %d rows", column.Name, chunk.numValues, numRows))
+	}
+
+	start := chunk.dataPageOffset
+	if chunk.hasDictionary && chunk.dictionaryPageOffset < start {
+		start = chunk.dictionaryPageOffset
+	}
+	if start < int64(len(parquetMagic)) || chunk.totalCompressedSize < 0 || start+chunk.totalCompressedSize > f.size {
+		return nil, ErrParquetFormat.New(f.name, fmt.Sprintf("column chunk of %s out of the file", column.Name))
+	}
+	data := make([]byte, chunk.totalCompressedSize)
+	if _, err := r.ReadAt(data, start); err != nil {
+		return nil, err
+	}
+
+	d := &parquetColumnDecoder{file: f, column: column, leaf: f.leaves[col], codec: chunk.codec}
+	values := make([]interface{}, 0, numRows)
+	for int64(len(values)) < numRows {
+		if len(data) == 0 {
+			return nil, ErrParquetFormat.New(f.name, fmt.Sprintf("column %s has fewer values than rows", column.Name))
+		}
+		var err error
+		if data, values, err = d.readPage(data, values); err != nil {
+			return nil, err
+		}
+	}
+	if int64(len(values)) != numRows {
+		return nil, ErrParquetFormat.New(f.name, fmt.Sprintf("column %s has more values than rows", column.Name))
+	}
+	return values, nil
+}
+
+// parquetValuesReader is a ParquetRowReader of decoded column chunks.
+type parquetValuesReader struct {
+	values  [][]interface{}
+	numRows int
+	row     int
+}
+
+var _ ParquetRowReader = (*parquetValuesReader)(nil)
+
+func (r *parquetValuesReader) Next() ([]interface{}, error) {
+	if r.row >= r.numRows {
+		return nil, io.EOF
+	}
+	row := make([]interface{}, len(r.values))
+	for i, values := range r.values {
+		row[i] = values[r.row]
+	}
+	r.row++
+	return row, nil
+}
+
+func (r *parquetValuesReader) Close() error {
+	return nil
+}
+
+// parquetColumnDecoder decodes the pages of a column chunk.
+type parquetColumnDecoder struct {
+	file       *parquetFile
+	column     ParquetColumn
+	leaf       parquetLeaf
+	codec      int32
+	dictionary []interface{}
+}
+
+// readPage decodes the page that |data| starts with, appending its values to the values given. It returns the data
+// that follows the page, and the values.
+func (d *parquetColumnDecoder) readPage(data []byte, values []interface{}) ([]byte, []interface{}, error) {
+	var h parquetPageHeader
+	r := &thriftReader{b: data}
+	if err := h.read(r); err != nil {
+		return nil, nil, d.formatError(err.Error())
+	}
+	data = data[r.pos:]
+	if h.compressedSize < 0 || int(h.compressedSize) > len(data) {
+		return nil, nil, d.formatError("page longer than its column chunk")
+	}
+	page, data := data[:h.compressedSize], data[h.compressedSize:]
+
+	switch h.typ {
+	case parquetDictionaryPage:
+		b, err := d.decompress(page, int(h.uncompressedSize))
+		if err != nil {
+			return nil, nil, err
+		}
+		if h.encoding != parquetPlain && h.encoding != parquetPlainDictionary {
+			return nil, nil, d.unsupported(fmt.Sprintf("dictionary encoding %d", h.encoding))
+		}
+		d.dictionary, _, err = d.decodePlain(b, int(h.numValues))
+		return data, values, err
+
+	case parquetDataPage:
+		b, err := d.decompress(page, int(h.uncompressedSize))
+		if err != nil {
+			return nil, nil, err
+		}
+		var levels []int
+		if d.column.Optional {
+			if h.definitionLevelEncoding != parquetRLE {
+				return nil, nil, d.unsupported(fmt.Sprintf("definition level encoding %d", h.definitionLevelEncoding))
+			}
+			if len(b) < 4 {
+				return nil, nil, d.formatError("truncated definition levels")
+			}
+			n := binary.LittleEndian.Uint32(b)
+			if uint64(n) > uint64(len(b)-4) {
+				return nil, nil, d.formatError("truncated definition levels")
+			}
+			if levels, err = decodeHybrid(b[4:4+n], 1, int(h.numValues)); err != nil {
+				return nil, nil, d.formatError(err.Error())
+			}
+			b = b[4+n:]
+		}
+		values, err = d.appendValues(values, b, h.encoding, int(h.numValues), levels)
+		return data, values, err
+
+	case parquetDataPageV2:
+		if h.repetitionLevelsLength != 0 {
+			return nil, nil, d.formatError("repetition levels in a flat column")
+		}
+		if h.definitionLevelsLength < 0 || int(h.definitionLevelsLength) > len(page) {
+			return nil, nil, d.formatError("truncated definition levels")
+		}
+		var levels []int
+		if d.column.Optional {
+			var err error
+			if levels, err = decodeHybrid(page[:h.definitionLevelsLength], 1, int(h.numValues)); err != nil {
+				return nil, nil, d.formatError(err.Error())
+			}
+		}
+		b := page[h.definitionLevelsLength:]
+		if h.compressed {
+			var err error
+			if b, err = d.decompress(b, int(h.uncompressedSize-h.definitionLevelsLength)); err != nil {
+				return nil, nil, err
+			}
+		}
+		values, err := d.appendValues(values, b, h.encoding, int(h.numValues), levels)
+		return data, values, err
+
+	default:
+		return data, values, nil
+	}
+}
+
+// appendValues decodes the values of a data page with the encoding given, which has the number of values given
+// including nulls, and appends them to the values given. The definition levels tell which values are null: there are
+// none when they're nil.
+func (d *parquetColumnDecoder) appendValues(values []interface{}, b []byte, encoding int32, numValues int, levels []int) ([]interface{}, error) {
+	if numValues < 0 {
+		return nil, d.formatError("negative number of values")
+	}
+	n := numValues
+	if levels != nil {
+		n = 0
+		for _, l := range levels {
+			if l > 1 {
+				return nil, d.formatError("definition level greater than 1")
+			}
+			n += l
+		}
+	}
+
+	var decoded []interface{}
+	var err error
+	switch encoding {
+	case parquetPlain:
+		decoded, _, err = d.decodePlain(b, n)
+	case parquetPlainDictionary, parquetRLEDictionary:
+		if d.dictionary == nil {
+			return nil, d.formatError("dictionary encoded page without a dictionary")
+		}
+		if len(b) == 0 {
+			if n > 0 {
+				return nil, d.formatError("truncated dictionary indexes")
+			}
+			break
+		}
+		var indexes []int
+		if indexes, err = decodeHybrid(b[1:], int(b[0]), n); err != nil {
+			return nil, d.formatError(err.Error())
+		}
+		decoded = make([]interface{}, n)
+		for i, index := range indexes {
+			if index >= len(d.dictionary) {
+				return nil, d.formatError("dictionary index out of range")
+			}
+			decoded[i] = d.dictionary[index]
+		}
+	case parquetRLE:
+		if d.column.Type != ParquetBoolean {
+			return nil, d.unsupported(fmt.Sprintf("RLE encoding of %s values", d.column.Type))
+		}
+		if len(b) < 4 {
+			return nil, d.formatError("truncated values")
+		}
+		var bits []int
+		if bits, err = decodeHybrid(b[4:], 1, n); err != nil {
+			return nil, d.formatError(err.Error())
+		}
+		decoded = make([]interface{}, n)
+		for i, bit := range bits {
+			decoded[i] = bit == 1
+		}
+	default:
+		return nil, d.unsupported(fmt.Sprintf("value encoding %d", encoding))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if levels == nil {
+		return append(values, decoded...), nil
+	}
+	for _, l := range levels {
+		if l == 0 {
+			values = append(values, nil)
+		} else {
+			values = append(values, decoded[0])
+			decoded = decoded[1:]
+		}
+	}
+	return values, nil
+}
+
+// decodePlain decodes the number of PLAIN encoded values given, and returns them with the bytes that follow them.
+func (d *parquetColumnDecoder) decodePlain(b []byte, n int) ([]interface{}, []byte, error) {
+	if n < 0 {
+		return nil, nil, d.formatError("negative number of values")
+	}
+	truncated := d.formatError("truncated values")
+	fixed := func(size int) error {
+		if size <= 0 || n > len(b)/size {
+			return truncated
+		}
+		return nil
+	}
+
+	values := make([]interface{}, n)
+	switch d.column.Type {
+	case ParquetBoolean:
+		if n > len(b)*8 {
+			return nil, nil, truncated
+		}
+		for i := range values {
+			values[i] = b[i/8]>>(i%8)&1 == 1
+		}
+		return values, b[(n+7)/8:], nil
+	case ParquetInt32:
+		if err := fixed(4); err != nil {
+			return nil, nil, err
+		}
+		for i := range values {
+			values[i] = int32(binary.LittleEndian.Uint32(b[4*i:]))
+		}
+		return values, b[4*n:], nil
+	case ParquetInt64:
+		if err := fixed(8); err != nil {
+			return nil, nil, err
+		}
+		for i := range values {
+			values[i] = int64(binary.LittleEndian.Uint64(b[8*i:]))
+		}
+		return values, b[8*n:], nil
+	case ParquetInt96:
+		if err := fixed(12); err != nil {
+			return nil, nil, err
+		}
+		for i := range values {
+			var v [12]byte
+			copy(v[:], b[12*i:])
+			values[i] = v
+		}
+		return values, b[12*n:], nil
+	case ParquetFloat:
+		if err := fixed(4); err != nil {
+			return nil, nil, err
+		}
+		for i := range values {
+			values[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[4*i:]))
+		}
+		return values, b[4*n:], nil
+	case ParquetDouble:
+		if err := fixed(8); err != nil {
+			return nil, nil, err
+		}
+		for i := range values {
+			values[i] = math.Float64frombits(binary.LittleEndian.Uint64(b[8*i:]))
+		}
+		return values, b[8*n:], nil
+	case ParquetByteArray:
+		for i := range values {
+			if len(b) < 4 {
+				return nil, nil, truncated
+			}
+			length := binary.LittleEndian.Uint32(b)
+			if uint64(length) > uint64(len(b)-4) {
+				return nil, nil, truncated
+			}
+			values[i] = b[4 : 4+length]
+			b = b[4+length:]
+		}
+		return values, b, nil
+	default:
+		size := d.leaf.typeLength
+		if err := fixed(size); err != nil {
+			return nil, nil, err
+		}
+		for i := range values {
+			values[i] = b[size*i : size*(i+1)]
+		}
+		return values, b[size*n:], nil
+	}
+}
+
+// decompress returns the page data given, decompressed with the codec of the column chunk to the size given.
+func (d *parquetColumnDecoder) decompress(b []byte, size int) ([]byte, error) {
+	var err error
+	switch d.codec {
+	case parquetUncompressed:
+	case parquetSnappy:
+		b, err = snappyDecode(b)
+	case parquetGzip:
+		var r *gzip.Reader
+		if r, err = gzip.NewReader(bytes.NewReader(b)); err == nil {
+			b, err = ioutil.ReadAll(r)
+		}
+	default:
+		return nil, d.unsupported(fmt.Sprintf("compression codec %d", d.codec))
+	}
+	if err != nil {
+		return nil, d.formatError(err.Error())
+	}
+	if len(b) != size {
+		return nil, d.formatError(fmt.Sprintf("page of %d bytes instead of %d", len(b), size))
+	}
+	return b, nil
+}
+
+func (d *parquetColumnDecoder) formatError(reason string) error {
+	return ErrParquetFormat.New(d.file.name, fmt.Sprintf("column %s: %s", d.column.Name, reason))
+}
+
+func (d *parquetColumnDecoder) unsupported(feature string) error {
+	return ErrParquetUnsupported.New(d.file.name, feature)
+}
+
+// decodeHybrid decodes the number of values given of the bit width given from the RLE / bit-packing hybrid encoding,
+// in which levels and dictionary indexes are encoded. The encoding is a sequence of runs, each of which is either a
+// value repeated a number of times, or groups of 8 values packed in as many bits as they need each.
+func decodeHybrid(b []byte, bitWidth, n int) ([]int, error) {
+	if bitWidth < 0 || bitWidth > 32 {
+		return nil, fmt.Errorf("bit width %d out of range", bitWidth)
+	}
+	values := make([]int, 0, n)
+	byteWidth := (bitWidth + 7) / 8
+	for len(values) < n {
+		header, k := binary.Uvarint(b)
+		if k <= 0 {
+			return nil, fmt.Errorf("truncated runs")
+		}
+		b = b[k:]
+
+		if header&1 == 1 {
+			groups := header >> 1
+			if bitWidth > 0 && groups > uint64(len(b)) {
+				return nil, fmt.Errorf("truncated runs")
+			}
+			size := int(groups) * bitWidth
+			if size > len(b) {
+				return nil, fmt.Errorf("truncated runs")
+			}
+			count := n - len(values)
+			if groups*8 < uint64(count) {
+				count = int(groups) * 8
+			}
+			for i := 0; i < count; i++ {
+				v := 0
+				for j := 0; j < bitWidth; j++ {
+					bit := i*bitWidth + j
+					v |= int(b[bit/8]>>(bit%8)&1) << j
+				}
+				values = append(values, v)
+			}
+			b = b[size:]
+		} else {
+			count := header >> 1
+			if byteWidth > len(b) {
+				return nil, fmt.Errorf("truncated runs")
+			}
+			v := 0
+			for i := 0; i < byteWidth; i++ {
+				v |= int(b[i]) << (8 * i)
+			}
+			b = b[byteWidth:]
+			for i := uint64(0); i < count && len(values) < n; i++ {
+				values = append(values, v)
+			}
+		}
+	}
+	return values, nil
+}
+
+// readFileMetadata reads the FileMetaData struct of the footer of the file.
+func (f *parquetFile) readFileMetadata(r *thriftReader) error {
+	var schema []parquetSchemaElement
+	err := r.readStruct(func(id int16, typ byte) error {
+		switch {
+		case id == 2 && typ == thriftList:
+			return r.readList(func(typ byte) error {
+				if typ != thriftStruct {
+					return fmt.Errorf("schema element of type %d", typ)
+				}
+				var e parquetSchemaElement
+				if err := e.read(r); err != nil {
+					return err
+				}
+				schema = append(schema, e)
+				return nil
+			})
+		case id == 4 && typ == thriftList:
+			return r.readList(func(typ byte) error {
+				if typ != thriftStruct {
+					return fmt.Errorf("row group of type %d", typ)
+				}
+				var rg parquetRowGroup
+				if err := rg.read(r); err != nil {
+					return err
+				}
+				f.rowGroups = append(f.rowGroups, rg)
+				return nil
+			})
+		default:
+			return r.skip(typ)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	// Flat schemas are a root with a leaf for each column
+	if len(schema) == 0 || int(schema[0].numChildren) != len(schema)-1 {
+		return ErrParquetUnsupported.New(f.name, "nested columns")
+	}
+	for _, e := range schema[1:] {
+		if e.numChildren != 0 || !e.hasType {
+			return ErrParquetUnsupported.New(f.name, "nested columns")
+		}
+		if e.repetition == parquetRepeated {
+			return ErrParquetUnsupported.New(f.name, "repeated columns")
+		}
+		f.columns = append(f.columns, e.column())
+		f.leaves = append(f.leaves, parquetLeaf{typeLength: int(e.typeLength), signed: e.signed()})
+	}
+
+	for i := range f.rowGroups {
+		rg := &f.rowGroups[i]
+		if len(rg.chunks) != len(f.columns) {
+			return fmt.Errorf("row group %d has %d columns instead of %d", i, len(rg.chunks), len(f.columns))
+		}
+	}
+	return nil
+}
+
+// statistics returns the statistics of the chunk given of the column given. The deprecated minimum and maximum values
+// of byte arrays aren't used, because they were ordered as signed bytes.
+func (f *parquetFile) statistics(col int, chunk parquetColumnChunk) ParquetColumnStatistics {
+	column, leaf := f.columns[col], f.leaves[col]
+	stats := ParquetColumnStatistics{NullCount: chunk.nullCount}
+	if !column.Optional {
+		stats.NullCount = 0
+	}
+	if !leaf.signed || chunk.min == nil || chunk.max == nil {
+		return stats
+	}
+	if chunk.legacyStatistics && (column.Type == ParquetByteArray || column.Type == ParquetFixedLenByteArray) {
+		return stats
+	}
+	min, minOk := parquetStatisticsValue(column, leaf, chunk.min)
+	max, maxOk := parquetStatisticsValue(column, leaf, chunk.max)
+	if minOk && maxOk {
+		stats.Min, stats.Max = min, max
+	}
+	return stats
+}
+
+// parquetStatisticsValue decodes a minimum or maximum value of the statistics of the column given, which are PLAIN
+// encoded, except for byte arrays that have no length.
+func parquetStatisticsValue(column ParquetColumn, leaf parquetLeaf, b []byte) (interface{}, bool) {
+	switch column.Type {
+	case ParquetBoolean:
+		return len(b) == 1 && b[0] != 0, len(b) == 1
+	case ParquetInt32:
+		if len(b) != 4 {
+			return nil, false
+		}
+		return int32(binary.LittleEndian.Uint32(b)), true
+	case ParquetInt64:
+		if len(b) != 8 {
+			return nil, false
+		}
+		return int64(binary.LittleEndian.Uint64(b)), true
+	case ParquetFloat:
+		if len(b) != 4 {
+			return nil, false
+		}
+		v := math.Float32frombits(binary.LittleEndian.Uint32(b))
+		return v, !math.IsNaN(float64(v))
+	case ParquetDouble:
+		if len(b) != 8 {
+			return nil, false
+		}
+		v := math.Float64frombits(binary.LittleEndian.Uint64(b))
+		return v, !math.IsNaN(v)
+	case ParquetByteArray:
+		return b, true
+	case ParquetFixedLenByteArray:
+		return b, len(b) == leaf.typeLength
+	default:
+		// INT96 values have no defined order
+		return nil, false
+	}
+}
+
+// parquetSchemaElement is a SchemaElement struct of the metadata of a Parquet file.
+type parquetSchemaElement struct {
+	typ           ParquetType
+	hasType       bool
+	typeLength    int32
+	repetition    int32
+	name          string
+	numChildren   int32
+	convertedType int32
+	logical       ParquetLogicalType
+	hasLogical    bool
+	// unsigned is whether the logical type is an unsigned integer or a decimal, whose statistics are ordered unlike
+	// the values read
+	unsigned bool
+}
+
+func (e *parquetSchemaElement) read(r *thriftReader) error {
+	e.convertedType = -1
+	return r.readStruct(func(id int16, typ byte) error {
+		var err error
+		switch {
+		case id == 1 && typ == thriftI32:
+			var v int32
+			v, err = r.i32()
+			e.typ, e.hasType = ParquetType(v), v >= 0 && v <= int32(ParquetFixedLenByteArray)
+		case id == 2 && typ == thriftI32:
+			e.typeLength, err = r.i32()
+		case id == 3 && typ == thriftI32:
+			e.repetition, err = r.i32()
+		case id == 4 && typ == thriftBinary:
+			e.name, err = r.string()
+		case id == 5 && typ == thriftI32:
+			e.numChildren, err = r.i32()
+		case id == 6 && typ == thriftI32:
+			e.convertedType, err = r.i32()
+		case id == 10 && typ == thriftStruct:
+			err = e.readLogicalType(r)
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+// readLogicalType reads the LogicalType union of a schema element.
+func (e *parquetSchemaElement) readLogicalType(r *thriftReader) error {
+	return r.readStruct(func(id int16, typ byte) error {
+		e.hasLogical = true
+		switch id {
+		case 1, 4:
+			// STRING and ENUM
+			e.logical = ParquetString
+		case 5:
+			// DECIMAL
+			e.unsigned = true
+		case 6:
+			e.logical = ParquetDate
+		case 8:
+			if typ != thriftStruct {
+				return r.skip(typ)
+			}
+			// TIMESTAMP, whose unit is its second field. Nanoseconds are read as plain integers.
+			return r.readStruct(func(id int16, typ byte) error {
+				if id != 2 || typ != thriftStruct {
+					return r.skip(typ)
+				}
+				return r.readStruct(func(id int16, typ byte) error {
+					switch id {
+					case 1:
+						e.logical = ParquetTimestampMillis
+					case 2:
+						e.logical = ParquetTimestampMicros
+					}
+					return r.skip(typ)
+				})
+			})
+		case 10:
+			if typ != thriftStruct {
+				return r.skip(typ)
+			}
+			// INTEGER, whose signedness is its second field
+			return r.readStruct(func(id int16, typ byte) error {
+				if id == 2 && typ == thriftFalse {
+					e.unsigned = true
+				}
+				return r.skip(typ)
+			})
+		case 12:
+			e.logical = ParquetJSON
+		}
+		return r.skip(typ)
+	})
+}
+
+// column returns the ParquetColumn of the schema element, whose logical type is the one of the element, or else the
+// one of its converted type. Logical types that don't apply to the physical type of the element are ignored.
+func (e *parquetSchemaElement) column() ParquetColumn {
+	logical := e.logical
+	if !e.hasLogical {
+		switch e.convertedType {
+		case parquetConvertedUTF8, parquetConvertedEnum:
+			logical = ParquetString
+		case parquetConvertedJSON:
+			logical = ParquetJSON
+		case parquetConvertedDate:
+			logical = ParquetDate
+		case parquetConvertedTimestampMillis:
+			logical = ParquetTimestampMillis
+		case parquetConvertedTimestampMicros:
+			logical = ParquetTimestampMicros
+		}
+	}
+
+	switch {
+	case (logical == ParquetString || logical == ParquetJSON) && e.typ != ParquetByteArray,
+		logical == ParquetDate && e.typ != ParquetInt32,
+		(logical == ParquetTimestampMillis || logical == ParquetTimestampMicros) && e.typ != ParquetInt64:
+		logical = ParquetNoLogicalType
+	}
+	return ParquetColumn{
+		Name:     e.name,
+		Type:     e.typ,
+		Logical:  logical,
+		Optional: e.repetition == parquetOptional,
+	}
+}
+
+// signed returns whether the statistics of the element are ordered like the values of its SQL type.
+func (e *parquetSchemaElement) signed() bool {
+	if e.unsigned {
+		return false
+	}
+	if !e.hasLogical {
+		if e.convertedType == parquetConvertedDecimal {
+			return false
+		}
+		if e.convertedType >= parquetConvertedUint8 && e.convertedType <= parquetConvertedUint64 {
+			return false
+		}
+	}
+	return true
+}
+
+func (rg *parquetRowGroup) read(r *thriftReader) error {
+	return r.readStruct(func(id int16, typ byte) error {
+		var err error
+		switch {
+		case id == 1 && typ == thriftList:
+			err = r.readList(func(typ byte) error {
+				if typ != thriftStruct {
+					return fmt.Errorf("column chunk of type %d", typ)
+				}
+				var chunk parquetColumnChunk
+				if err := chunk.read(r); err != nil {
+					return err
+				}
+				rg.chunks = append(rg.chunks, chunk)
+				return nil
+			})
+		case id == 3 && typ == thriftI64:
+			rg.numRows, err = r.i64()
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
+
+// read reads a ColumnChunk struct, and its ColumnMetaData.
+func (c *parquetColumnChunk) read(r *thriftReader) error {
+	c.nullCount = -1
+	return r.readStruct(func(id int16, typ byte) error {
+		switch {
+		case id == 1 && typ == thriftBinary:
+			path, err := r.binary()
+			c.external = len(path) > 0
+			return err
+		case id == 3 && typ == thriftStruct:
+			return r.readStruct(func(id int16, typ byte) error {
+				var err error
+				switch {
+				case id == 4 && typ == thriftI32:
+					c.codec, err = r.i32()
+				case id == 5 && typ == thriftI64:
+					c.numValues, err = r.i64()
+				case id == 7 && typ == thriftI64:
+					c.totalCompressedSize, err = r.i64()
+				case id == 9 && typ == thriftI64:
+					c.dataPageOffset, err = r.i64()
+				case id == 11 && typ == thriftI64:
+					c.dictionaryPageOffset, err = r.i64()
+					c.hasDictionary = true
+				case id == 12 && typ == thriftStruct:
+					err = c.readStatistics(r)
+				default:
+					err = r.skip(typ)
+				}
+				return err
+			})
+		default:
+			return r.skip(typ)
+		}
+	})
+}
+
+// readStatistics reads a Statistics struct. The min_value and max_value fields are preferred over the deprecated min
+// and max fields.
+func (c *parquetColumnChunk) readStatistics(r *thriftReader) error {
+	var min, max, minValue, maxValue []byte
+	err := r.readStruct(func(id int16, typ byte) error {
+		var err error
+		switch {
+		case id == 1 && typ == thriftBinary:
+			max, err = r.binary()
+		case id == 2 && typ == thriftBinary:
+			min, err = r.binary()
+		case id == 3 && typ == thriftI64:
+			c.nullCount, err = r.i64()
+		case id == 5 && typ == thriftBinary:
+			maxValue, err = r.binary()
+		case id == 6 && typ == thriftBinary:
+			minValue, err = r.binary()
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if minValue != nil && maxValue != nil {
+		c.min, c.max = minValue, maxValue
+	} else if min != nil && max != nil {
+		c.min, c.max, c.legacyStatistics = min, max, true
+	}
+	return nil
+}
+
+// parquetPageHeader is a PageHeader struct, with the fields of the header of its type.
+type parquetPageHeader struct {
+	typ                     int32
+	uncompressedSize        int32
+	compressedSize          int32
+	numValues               int32
+	encoding                int32
+	definitionLevelEncoding int32
+	definitionLevelsLength  int32
+	repetitionLevelsLength  int32
+	compressed              bool
+}
+
+func (h *parquetPageHeader) read(r *thriftReader) error {
+	h.compressed = true
+	return r.readStruct(func(id int16, typ byte) error {
+		var err error
+		switch {
+		case id == 1 && typ == thriftI32:
+			h.typ, err = r.i32()
+		case id == 2 && typ == thriftI32:
+			h.uncompressedSize, err = r.i32()
+		case id == 3 && typ == thriftI32:
+			h.compressedSize, err = r.i32()
+		case (id == 5 || id == 7) && typ == thriftStruct:
+			// DataPageHeader and DictionaryPageHeader start alike
+			err = r.readStruct(func(id int16, typ byte) error {
+				var err error
+				switch {
+				case id == 1 && typ == thriftI32:
+					h.numValues, err = r.i32()
+				case id == 2 && typ == thriftI32:
+					h.encoding, err = r.i32()
+				case id == 3 && typ == thriftI32:
+					h.definitionLevelEncoding, err = r.i32()
+				default:
+					err = r.skip(typ)
+				}
+				return err
+			})
+		case id == 8 && typ == thriftStruct:
+			err = r.readStruct(func(id int16, typ byte) error {
+				var err error
+				switch {
+				case id == 1 && typ == thriftI32:
+					h.numValues, err = r.i32()
+				case id == 4 && typ == thriftI32:
+					h.encoding, err = r.i32()
+				case id == 5 && typ == thriftI32:
+					h.definitionLevelsLength, err = r.i32()
+				case id == 6 && typ == thriftI32:
+					h.repetitionLevelsLength, err = r.i32()
+				case id == 7 && (typ == thriftTrue || typ == thriftFalse):
+					h.compressed = typ == thriftTrue
+				default:
+					err = r.skip(typ)
+				}
+				return err
+			})
+		default:
+			err = r.skip(typ)
+		}
+		return err
+	})
+}
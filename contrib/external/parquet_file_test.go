@@ -0,0 +1,583 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"io/ioutil"
+	"math"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestParquetFile(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir("", "parquet")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	columns := []ParquetColumn{
+		{Name: "id", Type: ParquetInt64},
+		{Name: "name", Type: ParquetByteArray, Logical: ParquetString, Optional: true},
+		{Name: "day", Type: ParquetInt32, Logical: ParquetDate, Optional: true},
+		{Name: "score", Type: ParquetDouble, Optional: true},
+		{Name: "at", Type: ParquetInt64, Logical: ParquetTimestampMicros, Optional: true},
+		{Name: "ok", Type: ParquetBoolean},
+	}
+	writeParquetFile(t, filepath.Join(dir, "people-1.parquet"), columns, [][]parquetTestChunk{
+		{
+			{values: []interface{}{int64(1), int64(2)}},
+			{values: []interface{}{[]byte("ann"), []byte("bob")}, dictionary: true, codec: parquetGzip},
+			{values: []interface{}{int32(0), int32(1)}, codec: parquetSnappy, v2: true},
+			{values: []interface{}{1.5, nil}},
+			{values: []interface{}{int64(0), int64(1500000)}, codec: parquetGzip, v2: true},
+			{values: []interface{}{true, false}},
+		},
+		{
+			{values: []interface{}{int64(3), int64(4)}, codec: parquetSnappy},
+			{values: []interface{}{[]byte("cat"), nil}, dictionary: true, codec: parquetSnappy, v2: true},
+			{values: []interface{}{int32(2), nil}},
+			{values: []interface{}{2.5, 3.5}, v2: true},
+			{values: []interface{}{nil, nil}},
+			{values: []interface{}{true, true}, v2: true},
+		},
+	})
+	writeParquetFile(t, filepath.Join(dir, "people-2.parquet"), columns, [][]parquetTestChunk{
+		{
+			{values: []interface{}{int64(5)}},
+			{values: []interface{}{[]byte("dan")}},
+			{values: []interface{}{int32(365)}, dictionary: true},
+			{values: []interface{}{4.5}},
+			{values: []interface{}{int64(86400000000)}},
+			{values: []interface{}{false}},
+		},
+	})
+
+	f, err := OpenParquetFile(filepath.Join(dir, "people-1.parquet"))
+	require.NoError(err)
+	require.Equal(columns, f.Columns())
+	rowGroups := f.RowGroups()
+	require.Len(rowGroups, 2)
+	require.Equal(int64(2), rowGroups[0].NumRows)
+	require.Equal(ParquetColumnStatistics{Min: int64(1), Max: int64(2), NullCount: 0}, rowGroups[0].Columns[0])
+	require.Equal(ParquetColumnStatistics{Min: []byte("cat"), Max: []byte("cat"), NullCount: 1}, rowGroups[1].Columns[1])
+	require.Equal(ParquetColumnStatistics{NullCount: 2}, rowGroups[1].Columns[4])
+
+	table, err := OpenParquetTable("people", filepath.Join(dir, "people-*.parquet"))
+	require.NoError(err)
+	query := newTestEngine(t, table)
+	require.Equal([]sql.Row{
+		{int64(1), "ann", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), 1.5, time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), int8(1)},
+		{int64(2), "bob", time.Date(1970, 1, 2, 0, 0, 0, 0, time.UTC), nil, time.Date(1970, 1, 1, 0, 0, 1, int(500*time.Millisecond), time.UTC), int8(0)},
+		{int64(3), "cat", time.Date(1970, 1, 3, 0, 0, 0, 0, time.UTC), 2.5, nil, int8(1)},
+		{int64(4), nil, nil, 3.5, nil, int8(1)},
+		{int64(5), "dan", time.Date(1971, 1, 1, 0, 0, 0, 0, time.UTC), 4.5, time.Date(1970, 1, 2, 0, 0, 0, 0, time.UTC), int8(0)},
+	}, query("SELECT * FROM people ORDER BY id"))
+	require.Equal([]sql.Row{{"cat"}, {"dan"}}, query("SELECT name FROM people WHERE id > 2 AND score > 2 AND name IS NOT NULL ORDER BY id"))
+
+	_, err = OpenParquetTable("people", filepath.Join(dir, "other-*.parquet"))
+	require.True(ErrNoParquetFiles.Is(err))
+
+	require.NoError(ioutil.WriteFile(filepath.Join(dir, "text.parquet"), []byte("id,name\n1,ann\n"), 0644))
+	_, err = OpenParquetFile(filepath.Join(dir, "text.parquet"))
+	require.True(ErrParquetFormat.Is(err))
+
+	// ZSTD isn't supported
+	writeParquetFile(t, filepath.Join(dir, "zstd.parquet"), columns[:1], [][]parquetTestChunk{
+		{{values: []interface{}{int64(1)}, codec: 6}},
+	})
+	f, err = OpenParquetFile(filepath.Join(dir, "zstd.parquet"))
+	require.NoError(err)
+	_, err = f.ReadRowGroup(sql.NewContext(context.Background()), 0, []int{0})
+	require.True(ErrParquetUnsupported.Is(err))
+}
+
+func TestDecodeHybrid(t *testing.T) {
+	require := require.New(t)
+
+	// The example of the bit-packed run of the Parquet format specification
+	values, err := decodeHybrid([]byte{0x03, 0x88, 0xc6, 0xfa}, 3, 8)
+	require.NoError(err)
+	require.Equal([]int{0, 1, 2, 3, 4, 5, 6, 7}, values)
+
+	// A run of three 300s, followed by a bit-packed run whose padding is ignored
+	values, err = decodeHybrid([]byte{0x06, 0x2c, 0x01, 0x03, 0x05, 0, 0, 0, 0, 0, 0, 0, 0}, 9, 4)
+	require.NoError(err)
+	require.Equal([]int{300, 300, 300, 5}, values)
+
+	_, err = decodeHybrid([]byte{0x03, 0x88}, 3, 8)
+	require.Error(err)
+}
+
+func TestSnappyDecode(t *testing.T) {
+	require := require.New(t)
+
+	// A literal followed by a copy that overlaps the bytes it writes
+	b, err := snappyDecode([]byte{0x0c, 0x08, 'a', 'b', 'c', 0x15, 0x03})
+	require.NoError(err)
+	require.Equal("abcabcabcabc", string(b))
+
+	long := strings.Repeat("0123456789", 10)
+	b, err = snappyDecode(append([]byte{100, 60 << 2, 99}, long...))
+	require.NoError(err)
+	require.Equal(long, string(b))
+
+	_, err = snappyDecode([]byte{0x0c, 0x08, 'a', 'b', 'c', 0x15, 0x04})
+	require.Error(err)
+	_, err = snappyDecode([]byte{0x0d, 0x08, 'a', 'b', 'c', 0x15, 0x03})
+	require.Error(err)
+}
+
+// parquetTestChunk is a column chunk of a Parquet file written by writeParquetFile.
+type parquetTestChunk struct {
+	// values are the values of the physical type of the column, or nil for nulls
+	values []interface{}
+	codec  int32
+	// dictionary is whether the values are dictionary encoded, rather than PLAIN encoded
+	dictionary bool
+	// v2 is whether the values are in a version 2 data page
+	v2 bool
+}
+
+// writeParquetFile writes a Parquet file with the columns given, whose row groups have the column chunks given. Each
+// chunk has a data page, after a dictionary page when it's dictionary encoded, and statistics.
+func writeParquetFile(t *testing.T, path string, columns []ParquetColumn, rowGroups [][]parquetTestChunk) {
+	var file bytes.Buffer
+	file.WriteString(parquetMagic)
+
+	meta := &thriftWriter{}
+	meta.beginStruct()
+	meta.i32(1, 1)
+	meta.list(2, thriftStruct, len(columns)+1)
+	meta.beginStruct()
+	meta.binary(4, []byte("schema"))
+	meta.i32(5, int32(len(columns)))
+	meta.endStruct()
+	for _, col := range columns {
+		meta.beginStruct()
+		meta.i32(1, int32(col.Type))
+		if col.Optional {
+			meta.i32(3, parquetOptional)
+		} else {
+			meta.i32(3, 0)
+		}
+		meta.binary(4, []byte(col.Name))
+		// Strings have both a converted and a logical type, dates only a converted type, and timestamps only a logical
+		// type
+		switch col.Logical {
+		case ParquetString:
+			meta.i32(6, parquetConvertedUTF8)
+			meta.structField(10, func() {
+				meta.structField(1, func() {})
+			})
+		case ParquetJSON:
+			meta.i32(6, parquetConvertedJSON)
+		case ParquetDate:
+			meta.i32(6, parquetConvertedDate)
+		case ParquetTimestampMillis, ParquetTimestampMicros:
+			unit := int16(1)
+			if col.Logical == ParquetTimestampMicros {
+				unit = 2
+			}
+			meta.structField(10, func() {
+				meta.structField(8, func() {
+					meta.bool(1, true)
+					meta.structField(2, func() {
+						meta.structField(unit, func() {})
+					})
+				})
+			})
+		}
+		meta.endStruct()
+	}
+
+	var numRows int64
+	for _, chunks := range rowGroups {
+		numRows += int64(len(chunks[0].values))
+	}
+	meta.i64(3, numRows)
+
+	meta.list(4, thriftStruct, len(rowGroups))
+	for _, chunks := range rowGroups {
+		meta.beginStruct()
+		meta.list(1, thriftStruct, len(chunks))
+		for i, chunk := range chunks {
+			writeParquetTestChunk(&file, meta, columns[i], chunk)
+		}
+		meta.i64(2, int64(file.Len()))
+		meta.i64(3, int64(len(chunks[0].values)))
+		meta.endStruct()
+	}
+	meta.binary(6, []byte("go-mysql-server"))
+	meta.endStruct()
+
+	file.Write(meta.Bytes())
+	var length [4]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(meta.Len()))
+	file.Write(length[:])
+	file.WriteString(parquetMagic)
+	require.NoError(t, ioutil.WriteFile(path, file.Bytes(), 0644))
+}
+
+// writeParquetTestChunk writes the pages of the column chunk given to the file given, and its ColumnChunk struct to
+// the metadata given.
+func writeParquetTestChunk(file *bytes.Buffer, meta *thriftWriter, col ParquetColumn, chunk parquetTestChunk) {
+	var values []interface{}
+	var levels []int
+	var nulls int
+	for _, v := range chunk.values {
+		if v == nil {
+			levels = append(levels, 0)
+			nulls++
+		} else {
+			levels = append(levels, 1)
+			values = append(values, v)
+		}
+	}
+
+	start := int64(file.Len())
+	dictionaryOffset := int64(-1)
+	encoding := parquetPlain
+	encoded := parquetTestPlain(col, values)
+	if chunk.dictionary {
+		var dictionary []interface{}
+		indexes := make([]int, len(values))
+		for i, v := range values {
+			indexes[i] = -1
+			for j, d := range dictionary {
+				if reflect.DeepEqual(v, d) {
+					indexes[i] = j
+				}
+			}
+			if indexes[i] == -1 {
+				indexes[i] = len(dictionary)
+				dictionary = append(dictionary, v)
+			}
+		}
+
+		dictionaryOffset = start
+		page := parquetTestCompress(chunk.codec, parquetTestPlain(col, dictionary))
+		header := &thriftWriter{}
+		header.beginStruct()
+		header.i32(1, parquetDictionaryPage)
+		header.i32(2, int32(len(parquetTestPlain(col, dictionary))))
+		header.i32(3, int32(len(page)))
+		header.structField(7, func() {
+			header.i32(1, int32(len(dictionary)))
+			header.i32(2, parquetPlain)
+		})
+		header.endStruct()
+		file.Write(header.Bytes())
+		file.Write(page)
+
+		encoding = parquetRLEDictionary
+		bitWidth := bits.Len(uint(len(dictionary) - 1))
+		encoded = append([]byte{byte(bitWidth)}, parquetTestBitPacked(indexes, bitWidth)...)
+	}
+
+	dataOffset := int64(file.Len())
+	header := &thriftWriter{}
+	header.beginStruct()
+	if chunk.v2 {
+		// The levels of version 2 pages aren't compressed, nor prefixed with their length
+		var levelBytes []byte
+		if col.Optional {
+			levelBytes = parquetTestRuns(levels)
+		}
+		compressed := parquetTestCompress(chunk.codec, encoded)
+		header.i32(1, parquetDataPageV2)
+		header.i32(2, int32(len(levelBytes)+len(encoded)))
+		header.i32(3, int32(len(levelBytes)+len(compressed)))
+		header.structField(8, func() {
+			header.i32(1, int32(len(chunk.values)))
+			header.i32(2, int32(nulls))
+			header.i32(3, int32(len(chunk.values)))
+			header.i32(4, encoding)
+			header.i32(5, int32(len(levelBytes)))
+			header.i32(6, 0)
+		})
+		header.endStruct()
+		file.Write(header.Bytes())
+		file.Write(levelBytes)
+		file.Write(compressed)
+	} else {
+		page := encoded
+		if col.Optional {
+			levelBytes := parquetTestRuns(levels)
+			page = make([]byte, 4, 4+len(levelBytes)+len(encoded))
+			binary.LittleEndian.PutUint32(page, uint32(len(levelBytes)))
+			page = append(append(page, levelBytes...), encoded...)
+		}
+		compressed := parquetTestCompress(chunk.codec, page)
+		header.i32(1, parquetDataPage)
+		header.i32(2, int32(len(page)))
+		header.i32(3, int32(len(compressed)))
+		header.structField(5, func() {
+			header.i32(1, int32(len(chunk.values)))
+			header.i32(2, encoding)
+			header.i32(3, parquetRLE)
+			header.i32(4, parquetRLE)
+		})
+		header.endStruct()
+		file.Write(header.Bytes())
+		file.Write(compressed)
+	}
+	size := int64(file.Len()) - start
+
+	meta.beginStruct()
+	meta.i64(2, start)
+	meta.structField(3, func() {
+		meta.i32(1, int32(col.Type))
+		meta.list(2, thriftI32, 1)
+		meta.varint(int64(encoding))
+		meta.list(3, thriftBinary, 1)
+		meta.uvarint(uint64(len(col.Name)))
+		meta.WriteString(col.Name)
+		meta.i32(4, chunk.codec)
+		meta.i64(5, int64(len(chunk.values)))
+		meta.i64(6, size)
+		meta.i64(7, size)
+		meta.i64(9, dataOffset)
+		if dictionaryOffset >= 0 {
+			meta.i64(11, dictionaryOffset)
+		}
+		meta.structField(12, func() {
+			meta.i64(3, int64(nulls))
+			if min, max := parquetTestMinMax(col, values); min != nil {
+				meta.binary(5, max)
+				meta.binary(6, min)
+			}
+		})
+	})
+	meta.endStruct()
+}
+
+// parquetTestPlain returns the PLAIN encoding of the values given.
+func parquetTestPlain(col ParquetColumn, values []interface{}) []byte {
+	var b []byte
+	if col.Type == ParquetBoolean {
+		b = make([]byte, (len(values)+7)/8)
+	}
+	for i, v := range values {
+		switch v := v.(type) {
+		case bool:
+			if v {
+				b[i/8] |= 1 << (i % 8)
+			}
+		case int32:
+			b = append(b, 0, 0, 0, 0)
+			binary.LittleEndian.PutUint32(b[len(b)-4:], uint32(v))
+		case int64:
+			b = append(b, 0, 0, 0, 0, 0, 0, 0, 0)
+			binary.LittleEndian.PutUint64(b[len(b)-8:], uint64(v))
+		case float64:
+			b = append(b, 0, 0, 0, 0, 0, 0, 0, 0)
+			binary.LittleEndian.PutUint64(b[len(b)-8:], math.Float64bits(v))
+		case []byte:
+			b = append(b, 0, 0, 0, 0)
+			binary.LittleEndian.PutUint32(b[len(b)-4:], uint32(len(v)))
+			b = append(b, v...)
+		}
+	}
+	return b
+}
+
+// parquetTestMinMax returns the encoded minimum and maximum of the values given, or nils if there are none.
+func parquetTestMinMax(col ParquetColumn, values []interface{}) ([]byte, []byte) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	min, max := values[0], values[0]
+	less := func(a, b interface{}) bool {
+		switch a := a.(type) {
+		case bool:
+			return !a && b.(bool)
+		case int32:
+			return a < b.(int32)
+		case int64:
+			return a < b.(int64)
+		case float64:
+			return a < b.(float64)
+		default:
+			return bytes.Compare(a.([]byte), b.([]byte)) < 0
+		}
+	}
+	for _, v := range values {
+		if less(v, min) {
+			min = v
+		}
+		if less(max, v) {
+			max = v
+		}
+	}
+	encode := func(v interface{}) []byte {
+		if b, ok := v.([]byte); ok {
+			return b
+		}
+		if b, ok := v.(bool); ok {
+			if b {
+				return []byte{1}
+			}
+			return []byte{0}
+		}
+		return parquetTestPlain(col, []interface{}{v})
+	}
+	return encode(min), encode(max)
+}
+
+// parquetTestRuns returns the definition levels given encoded as runs of repeated values.
+func parquetTestRuns(levels []int) []byte {
+	var b []byte
+	for len(levels) > 0 {
+		n := 1
+		for n < len(levels) && levels[n] == levels[0] {
+			n++
+		}
+		b = append(b, byte(n<<1), byte(levels[0]))
+		levels = levels[n:]
+	}
+	return b
+}
+
+// parquetTestBitPacked returns the values given encoded as a run of bit-packed values of the bit width given.
+func parquetTestBitPacked(values []int, bitWidth int) []byte {
+	groups := (len(values) + 7) / 8
+	b := make([]byte, 1+groups*bitWidth)
+	b[0] = byte(groups<<1 | 1)
+	for i, v := range values {
+		for j := 0; j < bitWidth; j++ {
+			bit := i*bitWidth + j
+			b[1+bit/8] |= byte(v>>j&1) << (bit % 8)
+		}
+	}
+	return b
+}
+
+// parquetTestCompress compresses the page given with the codec given. Snappy pages are encoded as literals, and
+// unsupported codecs leave pages as they are.
+func parquetTestCompress(codec int32, page []byte) []byte {
+	switch codec {
+	case parquetSnappy:
+		var b [binary.MaxVarintLen64]byte
+		compressed := append([]byte(nil), b[:binary.PutUvarint(b[:], uint64(len(page)))]...)
+		for len(page) > 0 {
+			n := len(page)
+			if n > 60 {
+				n = 60
+			}
+			compressed = append(append(compressed, byte(n-1)<<2), page[:n]...)
+			page = page[n:]
+		}
+		return compressed
+	case parquetGzip:
+		var buf bytes.Buffer
+		w := gzip.NewWriter(&buf)
+		w.Write(page)
+		w.Close()
+		return buf.Bytes()
+	default:
+		return page
+	}
+}
+
+// thriftWriter encodes values of the Thrift compact protocol.
+type thriftWriter struct {
+	bytes.Buffer
+	// last are the IDs of the last fields written of the structs being written
+	last []int16
+}
+
+func (w *thriftWriter) uvarint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	w.Write(b[:binary.PutUvarint(b[:], v)])
+}
+
+func (w *thriftWriter) varint(v int64) {
+	w.uvarint(uint64(v<<1) ^ uint64(v>>63))
+}
+
+func (w *thriftWriter) beginStruct() {
+	w.last = append(w.last, 0)
+}
+
+func (w *thriftWriter) endStruct() {
+	w.WriteByte(thriftStop)
+	w.last = w.last[:len(w.last)-1]
+}
+
+func (w *thriftWriter) field(id int16, typ byte) {
+	last := &w.last[len(w.last)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		w.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		w.WriteByte(typ)
+		w.varint(int64(id))
+	}
+	*last = id
+}
+
+func (w *thriftWriter) i32(id int16, v int32) {
+	w.field(id, thriftI32)
+	w.varint(int64(v))
+}
+
+func (w *thriftWriter) i64(id int16, v int64) {
+	w.field(id, thriftI64)
+	w.varint(v)
+}
+
+func (w *thriftWriter) binary(id int16, b []byte) {
+	w.field(id, thriftBinary)
+	w.uvarint(uint64(len(b)))
+	w.Write(b)
+}
+
+func (w *thriftWriter) bool(id int16, v bool) {
+	if v {
+		w.field(id, thriftTrue)
+	} else {
+		w.field(id, thriftFalse)
+	}
+}
+
+// list writes the header of a list field, whose elements are written next.
+func (w *thriftWriter) list(id int16, typ byte, n int) {
+	w.field(id, thriftList)
+	if n < 15 {
+		w.WriteByte(byte(n)<<4 | typ)
+	} else {
+		w.WriteByte(0xf0 | typ)
+		w.uvarint(uint64(n))
+	}
+}
+
+func (w *thriftWriter) structField(id int16, write func()) {
+	w.field(id, thriftStruct)
+	w.beginStruct()
+	write()
+	w.endStruct()
+}
@@ -0,0 +1,205 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"encoding/binary"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestParquetTable(t *testing.T) {
+	require := require.New(t)
+	columns := []ParquetColumn{
+		{Name: "id", Type: ParquetInt64},
+		{Name: "name", Type: ParquetByteArray, Logical: ParquetString, Optional: true},
+		{Name: "day", Type: ParquetInt32, Logical: ParquetDate, Optional: true},
+		{Name: "score", Type: ParquetDouble, Optional: true},
+	}
+	first := &memParquetFile{columns: columns, rowGroups: [][][]interface{}{
+		{
+			{int64(1), []byte("ann"), int32(0), 1.5},
+			{int64(2), []byte("bob"), int32(1), nil},
+		},
+		{
+			{int64(3), []byte("cat"), int32(2), 2.5},
+			{int64(4), nil, nil, 3.5},
+		},
+	}}
+	second := &memParquetFile{columns: columns, rowGroups: [][][]interface{}{
+		{
+			{int64(5), []byte("dan"), int32(365), 4.5},
+		},
+	}}
+
+	table, err := NewParquetTable("people", first, second)
+	require.NoError(err)
+	require.Equal(sql.Schema{
+		{Name: "id", Type: sql.Int64, Source: "people"},
+		{Name: "name", Type: sql.LongText, Nullable: true, Source: "people"},
+		{Name: "day", Type: sql.Date, Nullable: true, Source: "people"},
+		{Name: "score", Type: sql.Float64, Nullable: true, Source: "people"},
+	}, table.Schema())
+
+	query := newTestEngine(t, table)
+	require.Equal([]sql.Row{
+		{int64(1), "ann", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), 1.5},
+		{int64(2), "bob", time.Date(1970, 1, 2, 0, 0, 0, 0, time.UTC), nil},
+		{int64(3), "cat", time.Date(1970, 1, 3, 0, 0, 0, 0, time.UTC), 2.5},
+		{int64(4), nil, nil, 3.5},
+		{int64(5), "dan", time.Date(1971, 1, 1, 0, 0, 0, 0, time.UTC), 4.5},
+	}, query("SELECT * FROM people ORDER BY id"))
+	require.Equal([][]int{{0, 0, 1, 2, 3}, {1, 0, 1, 2, 3}}, first.reads)
+
+	// The row groups whose statistics rule out the filter aren't read, and only the columns used are read
+	first.reads, second.reads = nil, nil
+	require.Equal([]sql.Row{{"cat"}}, query("SELECT name FROM people WHERE id > 2 AND score < 3"))
+	require.Equal([][]int{{1, 1, 0, 3}}, first.reads)
+	require.Empty(second.reads)
+
+	first.reads, second.reads = nil, nil
+	require.Equal([]sql.Row{{int64(5)}}, query("SELECT id FROM people WHERE '1970-06-01' <= day"))
+	require.Empty(first.reads)
+	require.Len(second.reads, 1)
+
+	first.reads, second.reads = nil, nil
+	require.Equal([]sql.Row{{int64(2)}, {int64(5)}}, query("SELECT id FROM people WHERE name = 'bob' OR name = 'dan' ORDER BY id"))
+	require.Len(first.reads, 1)
+	require.Len(second.reads, 1)
+
+	first.reads, second.reads = nil, nil
+	require.Equal([]sql.Row{{int64(4)}}, query("SELECT id FROM people WHERE name IS NULL"))
+	require.Len(first.reads, 1)
+	require.Empty(second.reads)
+
+	_, err = NewParquetTable("mismatch", first, &memParquetFile{columns: columns[:2]})
+	require.True(ErrParquetSchemaMismatch.Is(err))
+}
+
+func TestParquetValue(t *testing.T) {
+	require := require.New(t)
+
+	var int96 [12]byte
+	binary.LittleEndian.PutUint64(int96[:8], uint64(time.Hour+time.Second))
+	binary.LittleEndian.PutUint32(int96[8:], julianDayOfUnixEpoch+1)
+	v, err := parquetValue(ParquetColumn{Name: "t", Type: ParquetInt96}, int96)
+	require.NoError(err)
+	require.Equal(time.Date(1970, 1, 2, 1, 0, 1, 0, time.UTC), v)
+
+	v, err = parquetValue(ParquetColumn{Name: "t", Type: ParquetInt64, Logical: ParquetTimestampMillis}, int64(1500))
+	require.NoError(err)
+	require.Equal(time.Date(1970, 1, 1, 0, 0, 1, int(500*time.Millisecond), time.UTC), v)
+
+	v, err = parquetValue(ParquetColumn{Name: "b", Type: ParquetBoolean}, true)
+	require.NoError(err)
+	require.Equal(int8(1), v)
+
+	v, err = parquetValue(ParquetColumn{Name: "b", Type: ParquetByteArray}, []byte{1, 2})
+	require.NoError(err)
+	require.Equal([]byte{1, 2}, v)
+
+	_, err = parquetValue(ParquetColumn{Name: "i", Type: ParquetInt32}, int64(1))
+	require.True(ErrParquetValue.Is(err))
+}
+
+// memParquetFile is a Parquet file whose row groups are in memory, and which records the row groups and columns read,
+// each read being the index of the row group followed by the indexes of the columns.
+type memParquetFile struct {
+	columns   []ParquetColumn
+	rowGroups [][][]interface{}
+
+	mu    sync.Mutex
+	reads [][]int
+}
+
+var _ ParquetFile = (*memParquetFile)(nil)
+
+func (f *memParquetFile) Columns() []ParquetColumn {
+	return f.columns
+}
+
+// RowGroups returns row groups with the minimum, maximum and number of nulls of each column.
+func (f *memParquetFile) RowGroups() []ParquetRowGroup {
+	rowGroups := make([]ParquetRowGroup, len(f.rowGroups))
+	for i, rows := range f.rowGroups {
+		rowGroups[i].NumRows = int64(len(rows))
+		rowGroups[i].Columns = make([]ParquetColumnStatistics, len(f.columns))
+		for j, col := range f.columns {
+			stats := &rowGroups[i].Columns[j]
+			typ := parquetSQLType(col)
+			for _, row := range rows {
+				if row[j] == nil {
+					stats.NullCount++
+					continue
+				}
+				v, _ := parquetValue(col, row[j])
+				if stats.Min == nil {
+					stats.Min, stats.Max = row[j], row[j]
+				}
+				if min, _ := parquetValue(col, stats.Min); less(typ, v, min) {
+					stats.Min = row[j]
+				}
+				if max, _ := parquetValue(col, stats.Max); less(typ, max, v) {
+					stats.Max = row[j]
+				}
+			}
+		}
+	}
+	return rowGroups
+}
+
+func less(typ sql.Type, a, b interface{}) bool {
+	cmp, err := typ.Compare(a, b)
+	return err == nil && cmp < 0
+}
+
+func (f *memParquetFile) ReadRowGroup(_ *sql.Context, rowGroup int, columns []int) (ParquetRowReader, error) {
+	f.mu.Lock()
+	f.reads = append(f.reads, append([]int{rowGroup}, columns...))
+	f.mu.Unlock()
+
+	var rows [][]interface{}
+	for _, row := range f.rowGroups[rowGroup] {
+		values := make([]interface{}, len(columns))
+		for i, col := range columns {
+			values[i] = row[col]
+		}
+		rows = append(rows, values)
+	}
+	return &memParquetRowReader{rows}, nil
+}
+
+type memParquetRowReader struct {
+	rows [][]interface{}
+}
+
+func (r *memParquetRowReader) Next() ([]interface{}, error) {
+	if len(r.rows) == 0 {
+		return nil, io.EOF
+	}
+	row := r.rows[0]
+	r.rows = r.rows[1:]
+	return row, nil
+}
+
+func (r *memParquetRowReader) Close() error {
+	return nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// The types of the values of the Thrift compact protocol, in which the metadata of Parquet files is serialized. The
+// values of boolean fields are their type.
+const (
+	thriftStop   byte = 0
+	thriftTrue   byte = 1
+	thriftFalse  byte = 2
+	thriftByte   byte = 3
+	thriftI16    byte = 4
+	thriftI32    byte = 5
+	thriftI64    byte = 6
+	thriftDouble byte = 7
+	thriftBinary byte = 8
+	thriftList   byte = 9
+	thriftSet    byte = 10
+	thriftMap    byte = 11
+	thriftStruct byte = 12
+)
+
+// thriftMaxDepth is the maximum nesting of the structs and collections that a thriftReader reads.
+const thriftMaxDepth = 64
+
+// thriftReader decodes values of the Thrift compact protocol.
+type thriftReader struct {
+	b     []byte
+	pos   int
+	depth int
+}
+
+func (r *thriftReader) byte() (byte, error) {
+	if r.pos >= len(r.b) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	r.pos++
+	return r.b[r.pos-1], nil
+}
+
+func (r *thriftReader) uvarint() (uint64, error) {
+	v, n := binary.Uvarint(r.b[r.pos:])
+	if n <= 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	r.pos += n
+	return v, nil
+}
+
+// varint reads a zigzag encoded integer, which is how every integer type wider than a byte is encoded.
+func (r *thriftReader) varint() (int64, error) {
+	v, err := r.uvarint()
+	return int64(v>>1) ^ -int64(v&1), err
+}
+
+func (r *thriftReader) i32() (int32, error) {
+	v, err := r.varint()
+	if err == nil && (v < math.MinInt32 || v > math.MaxInt32) {
+		return 0, fmt.Errorf("thrift: i32 out of range: %d", v)
+	}
+	return int32(v), err
+}
+
+func (r *thriftReader) i64() (int64, error) {
+	return r.varint()
+}
+
+func (r *thriftReader) binary() ([]byte, error) {
+	n, err := r.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(r.b)-r.pos) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b := r.b[r.pos : r.pos+int(n)]
+	r.pos += int(n)
+	return b, nil
+}
+
+func (r *thriftReader) string() (string, error) {
+	b, err := r.binary()
+	return string(b), err
+}
+
+// readStruct reads a struct, calling |field| with the ID and the type of each of its fields. |field| must read the
+// value of the field, or skip it.
+func (r *thriftReader) readStruct(field func(id int16, typ byte) error) error {
+	if r.depth++; r.depth > thriftMaxDepth {
+		return fmt.Errorf("thrift: values nested too deeply")
+	}
+	defer func() { r.depth-- }()
+
+	var last int16
+	for {
+		header, err := r.byte()
+		if err != nil {
+			return err
+		}
+		typ := header & 0x0f
+		if typ == thriftStop {
+			return nil
+		}
+		// The ID is the delta from the previous field's in the header, or follows it when the delta doesn't fit
+		id := last + int16(header>>4)
+		if header>>4 == 0 {
+			v, err := r.varint()
+			if err != nil {
+				return err
+			}
+			id = int16(v)
+		}
+		last = id
+		if err := field(id, typ); err != nil {
+			return err
+		}
+	}
+}
+
+// readList reads a list or a set, calling |elem| with the type of its elements for each of them. Unlike fields,
+// boolean elements have a byte for their value.
+func (r *thriftReader) readList(elem func(typ byte) error) error {
+	if r.depth++; r.depth > thriftMaxDepth {
+		return fmt.Errorf("thrift: values nested too deeply")
+	}
+	defer func() { r.depth-- }()
+
+	header, err := r.byte()
+	if err != nil {
+		return err
+	}
+	n, typ := uint64(header>>4), header&0x0f
+	if n == 15 {
+		if n, err = r.uvarint(); err != nil {
+			return err
+		}
+	}
+	for i := uint64(0); i < n; i++ {
+		if err := elem(typ); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// skip skips a field of the type given.
+func (r *thriftReader) skip(typ byte) error {
+	switch typ {
+	case thriftTrue, thriftFalse:
+		return nil
+	case thriftByte:
+		_, err := r.byte()
+		return err
+	case thriftI16, thriftI32, thriftI64:
+		_, err := r.uvarint()
+		return err
+	case thriftDouble:
+		if len(r.b)-r.pos < 8 {
+			return io.ErrUnexpectedEOF
+		}
+		r.pos += 8
+		return nil
+	case thriftBinary:
+		_, err := r.binary()
+		return err
+	case thriftList, thriftSet:
+		return r.readList(r.skipElem)
+	case thriftMap:
+		n, err := r.uvarint()
+		if err != nil || n == 0 {
+			return err
+		}
+		types, err := r.byte()
+		if err != nil {
+			return err
+		}
+		for i := uint64(0); i < n; i++ {
+			if err := r.skipElem(types >> 4); err != nil {
+				return err
+			}
+			if err := r.skipElem(types & 0x0f); err != nil {
+				return err
+			}
+		}
+		return nil
+	case thriftStruct:
+		return r.readStruct(func(_ int16, typ byte) error {
+			return r.skip(typ)
+		})
+	default:
+		return fmt.Errorf("thrift: unknown type %d", typ)
+	}
+}
+
+// skipElem skips an element of a collection of the type given.
+func (r *thriftReader) skipElem(typ byte) error {
+	if typ == thriftTrue || typ == thriftFalse {
+		_, err := r.byte()
+		return err
+	}
+	return r.skip(typ)
+}
@@ -17,10 +17,12 @@ package sqle
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
@@ -39,6 +41,31 @@ type Config struct {
 	// disabled, and including any users here will enable authentication. All users in this list will have full access.
 	// This field is only temporary, and will be removed as development on users and authentication continues.
 	TemporaryUsers []TemporaryUser
+	// SchemaChangeListener, if set, is notified before and after every DDL statement that changes tables or databases.
+	SchemaChangeListener sql.SchemaChangeListener
+	// QueryRewriters rewrite the text of every query before it's parsed. They're applied in the order given, each to
+	// the output of the one before it.
+	QueryRewriters []sql.QueryRewriter
+	// PlanRewriters rewrite the plan of every query after it's analyzed. They're applied in the order given, each to
+	// the output of the one before it.
+	PlanRewriters []sql.PlanRewriter
+	// QueryRouter, if set, decides whether each query is executed locally or forwarded to another backend, once its
+	// plan has been rewritten by the PlanRewriters.
+	QueryRouter sql.QueryRouter
+	// RetryPolicy, if set, retries the statements whose transaction fails to commit with sql.ErrTransactionConflict.
+	RetryPolicy *RetryPolicy
+	// DisablePanicRecovery lets the panics of queries crash the process, rather than returning them as errors, which
+	// is useful to debug them.
+	DisablePanicRecovery bool
+	// Logger, if set, receives the entries logged by the engine, rather than logrus' standard logger. It's installed
+	// for the whole process with sql.UseLogger.
+	Logger sql.Logger
+	// QueryLogRedaction is how the text of queries is redacted in the entries logged about them. It's set for the whole
+	// process with sql.SetQueryLogRedaction.
+	QueryLogRedaction sql.QueryLogRedaction
+	// FeatureFlags turns feature flags on or off for the queries of the engine, unless their session sets them in
+	// optimizer_switch.
+	FeatureFlags sql.FeatureSwitches
 }
 
 // TemporaryUser is a user that will be added to the engine. This is for temporary use while the remaining features
@@ -50,12 +77,28 @@ type TemporaryUser struct {
 
 // Engine is a SQL engine.
 type Engine struct {
-	Analyzer          *analyzer.Analyzer
-	LS                *sql.LockSubsystem
+	Analyzer *analyzer.Analyzer
+	LS       *sql.LockSubsystem
+	// StatusVariables holds the status variables reported by SHOW STATUS, which only count the queries of this engine.
+	StatusVariables   *sql.StatusVariables
 	ProcessList       sql.ProcessList
 	MemoryManager     *sql.MemoryManager
 	BackgroundThreads *sql.BackgroundThreads
 	IsReadOnly        bool
+	// SchemaChangeListener is notified of the changes made by DDL statements, if not nil.
+	SchemaChangeListener sql.SchemaChangeListener
+	// QueryRewriters rewrite the text of queries before they're parsed, in order.
+	QueryRewriters []sql.QueryRewriter
+	// PlanRewriters rewrite the plans of queries after they're analyzed, in order.
+	PlanRewriters []sql.PlanRewriter
+	// QueryRouter decides where queries are executed after their plans are rewritten, if not nil.
+	QueryRouter sql.QueryRouter
+	// RetryPolicy retries statements that fail with a transaction conflict, if not nil.
+	RetryPolicy *RetryPolicy
+	// DisablePanicRecovery lets the panics of queries crash the process, rather than returning them as errors.
+	DisablePanicRecovery bool
+	// FeatureFlags turns feature flags on or off for the queries of the engine, unless their session sets them.
+	FeatureFlags sql.FeatureSwitches
 }
 
 type ColumnWithRawDefault struct {
@@ -69,9 +112,29 @@ type ColumnWithRawDefault struct {
 func New(a *analyzer.Analyzer, cfg *Config) *Engine {
 	var versionPostfix string
 	var isReadOnly bool
+	var schemaChangeListener sql.SchemaChangeListener
+	var queryRewriters []sql.QueryRewriter
+	var planRewriters []sql.PlanRewriter
+	var queryRouter sql.QueryRouter
+	var retryPolicy *RetryPolicy
+	var disablePanicRecovery bool
+	var featureFlags sql.FeatureSwitches
 	if cfg != nil {
 		versionPostfix = cfg.VersionPostfix
 		isReadOnly = cfg.IsReadOnly
+		schemaChangeListener = cfg.SchemaChangeListener
+		queryRewriters = cfg.QueryRewriters
+		planRewriters = cfg.PlanRewriters
+		queryRouter = cfg.QueryRouter
+		retryPolicy = cfg.RetryPolicy
+		disablePanicRecovery = cfg.DisablePanicRecovery
+		featureFlags = cfg.FeatureFlags
+		if cfg.Logger != nil {
+			sql.UseLogger(cfg.Logger)
+		}
+		if cfg.QueryLogRedaction != sql.QueryLogRedactNone {
+			sql.SetQueryLogRedaction(cfg.QueryLogRedaction)
+		}
 		if cfg.IncludeRootAccount {
 			a.Catalog.GrantTables.AddRootAccount()
 		}
@@ -80,7 +143,7 @@ func New(a *analyzer.Analyzer, cfg *Config) *Engine {
 		}
 	}
 
-	ls := sql.NewLockSubsystem()
+	ls := a.Catalog.LockSubsystem
 
 	a.Catalog.RegisterFunction(
 		sql.FunctionN{
@@ -90,12 +153,20 @@ func New(a *analyzer.Analyzer, cfg *Config) *Engine {
 	a.Catalog.RegisterFunction(function.GetLockingFuncs(ls)...)
 
 	return &Engine{
-		Analyzer:          a,
-		MemoryManager:     sql.NewMemoryManager(sql.ProcessMemory),
-		ProcessList:       NewProcessList(),
-		LS:                ls,
-		BackgroundThreads: sql.NewBackgroundThreads(),
-		IsReadOnly:        isReadOnly,
+		Analyzer:             a,
+		MemoryManager:        sql.NewMemoryManager(sql.ProcessMemory),
+		ProcessList:          NewProcessList(),
+		LS:                   ls,
+		StatusVariables:      a.Catalog.StatusVariables,
+		BackgroundThreads:    sql.NewBackgroundThreads(),
+		IsReadOnly:           isReadOnly,
+		SchemaChangeListener: schemaChangeListener,
+		QueryRewriters:       queryRewriters,
+		PlanRewriters:        planRewriters,
+		QueryRouter:          queryRouter,
+		RetryPolicy:          retryPolicy,
+		DisablePanicRecovery: disablePanicRecovery,
+		FeatureFlags:         featureFlags,
 	}
 }
 
@@ -105,11 +176,53 @@ func NewDefault(pro sql.DatabaseProvider) *Engine {
 	return New(a, nil)
 }
 
+// NewWithConfig creates a new Engine for the databases of the provider given, with the settings of the engine config
+// given. It's the same as creating its analyzer with analyzer.Builder.WithEngineConfig and calling New, with a memory
+// manager that uses the memory settings of the config.
+func NewWithConfig(pro sql.DatabaseProvider, cfg *sql.EngineConfig) *Engine {
+	a := analyzer.NewBuilder(pro).WithEngineConfig(cfg).Build()
+	e := New(a, &Config{
+		VersionPostfix:       cfg.VersionPostfix,
+		IsReadOnly:           cfg.ReadOnly,
+		DisablePanicRecovery: cfg.DisablePanicRecovery,
+		FeatureFlags:         cfg.FeatureFlags,
+	})
+	e.MemoryManager = cfg.NewMemoryManager()
+	return e
+}
+
 // AnalyzeQuery analyzes a query and returns its Schema.
 func (e *Engine) AnalyzeQuery(
 	ctx *sql.Context,
 	query string,
 ) (sql.Schema, error) {
+	prepared, err := e.PrepareQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return prepared.Schema, nil
+}
+
+// PreparedStatement is a statement that PrepareQuery parsed and analyzed once, to be executed any number of times
+// with ExecutePrepared, with different values for its parameters.
+type PreparedStatement struct {
+	// Query is the text of the statement, as rewritten by the engine's QueryRewriters.
+	Query string
+	// Parsed is the parsed statement, whose parameters are bind variables named v1, v2 and so on, in order.
+	Parsed sql.Node
+	// Schema is the schema of the rows of the statement.
+	Schema sql.Schema
+}
+
+// PrepareQuery parses the query given and analyzes it, without executing it, to check that it's valid and find the
+// schema of its rows. The parameters of the query are left unbound until it's executed.
+func (e *Engine) PrepareQuery(ctx *sql.Context, query string) (*PreparedStatement, error) {
+	e.applyEngineOptions(ctx)
+	query, err := e.RewriteQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
 	parsed, err := parse.Parse(ctx, query)
 	if err != nil {
 		return nil, err
@@ -120,7 +233,23 @@ func (e *Engine) AnalyzeQuery(
 		return nil, err
 	}
 
-	return analyzed.Schema(), nil
+	analyzed, err = e.rewritePlan(ctx, query, analyzed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PreparedStatement{Query: query, Parsed: parsed, Schema: analyzed.Schema()}, nil
+}
+
+// ExecutePrepared executes a statement returned by PrepareQuery with the bindings given for its parameters. The
+// statement isn't parsed again, but it's analyzed again with the values of its parameters, which the analyzer can use
+// to choose indexes, and with the current schemas of the tables it reads.
+func (e *Engine) ExecutePrepared(
+	ctx *sql.Context,
+	prepared *PreparedStatement,
+	bindings map[string]sql.Expression,
+) (sql.Schema, sql.RowIter, error) {
+	return e.QueryNodeWithBindings(ctx, prepared.Query, prepared.Parsed, bindings)
 }
 
 // Query executes a query. If parsed is non-nil, it will be used instead of parsing the query from text.
@@ -138,26 +267,61 @@ func (e *Engine) QueryWithBindings(
 }
 
 // QueryNodeWithBindings executes the query given with the bindings provided. If parsed is non-nil, it will be used
-// instead of parsing the query from text.
+// instead of parsing the query from text, and the engine's QueryRewriters are not applied to the query: callers that
+// parse queries themselves should parse the result of RewriteQuery. Unless DisablePanicRecovery is set, panics while
+// running the query, including while iterating its rows, are returned as sql.ErrInternal errors.
 func (e *Engine) QueryNodeWithBindings(
 	ctx *sql.Context,
 	query string,
 	parsed sql.Node,
 	bindings map[string]sql.Expression,
+) (schema sql.Schema, iter sql.RowIter, err error) {
+	if e.DisablePanicRecovery {
+		return e.queryNodeWithBindings(ctx, query, parsed, bindings)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			schema, iter, err = nil, nil, sql.PanicToError(ctx.GetLogger(), r)
+		}
+	}()
+	schema, iter, err = e.queryNodeWithBindings(ctx, query, parsed, bindings)
+	if err != nil {
+		return nil, nil, err
+	}
+	return schema, sql.NewPanicRecoveringIter(iter), nil
+}
+
+func (e *Engine) queryNodeWithBindings(
+	ctx *sql.Context,
+	query string,
+	parsed sql.Node,
+	bindings map[string]sql.Expression,
 ) (sql.Schema, sql.RowIter, error) {
-	var (
-		analyzed sql.Node
-		iter     sql.RowIter
-		err      error
-	)
+	e.applyEngineOptions(ctx)
 
+	var err error
 	if parsed == nil {
+		query, err = e.RewriteQuery(ctx, query)
+		if err != nil {
+			return nil, nil, err
+		}
+
 		parsed, err = parse.Parse(ctx, query)
 		if err != nil {
 			return nil, nil, err
 		}
 	}
 
+	if execute, ok := parsed.(*plan.ExecuteQuery); ok {
+		parsed, bindings, err = e.preparedQuery(ctx, execute)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	countStatement(ctx, parsed)
+
 	err = e.readOnlyCheck(parsed)
 	if err != nil {
 		return nil, nil, err
@@ -168,6 +332,83 @@ func (e *Engine) QueryNodeWithBindings(
 		return nil, nil, err
 	}
 
+	retry, err := e.retriesStatement(ctx, parsed)
+	if err != nil {
+		return nil, nil, err
+	}
+	if retry {
+		return e.queryWithRetries(ctx, query, parsed, bindings, transactionDatabase)
+	}
+
+	return e.executeQuery(ctx, query, parsed, bindings, transactionDatabase)
+}
+
+// preparedQuery returns the statement an EXECUTE statement executes, prepared by a PREPARE statement of the session,
+// and the bindings of its parameters to the values of the user variables of the EXECUTE statement. The statement is
+// then executed as if it were the query, so it's checked and committed like the statements executed directly.
+func (e *Engine) preparedQuery(ctx *sql.Context, execute *plan.ExecuteQuery) (sql.Node, map[string]sql.Expression, error) {
+	prepared, err := e.Analyzer.Catalog.PreparedQueries.Get(ctx.ID(), execute.Name, "EXECUTE")
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(execute.Bindings) != prepared.ParamCount {
+		return nil, nil, sql.ErrInvalidArgument.New("EXECUTE")
+	}
+
+	bindings := make(map[string]sql.Expression, len(execute.Bindings))
+	for i, b := range execute.Bindings {
+		typ, val, err := ctx.GetUserVariable(ctx, b.(*expression.UserVar).Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		bindings[fmt.Sprintf("v%d", i+1)] = expression.NewLiteral(val, typ)
+	}
+	return prepared.Statement, bindings, nil
+}
+
+// applyEngineOptions makes the feature flags of the engine the defaults of the context given, for the analysis and the
+// execution of its query, and has the query count its statements and reads in the status variables of the engine.
+// Feature flags are left unchanged by engines that set none.
+func (e *Engine) applyEngineOptions(ctx *sql.Context) {
+	if len(e.FeatureFlags) > 0 {
+		ctx.ApplyOpts(sql.WithFeatureDefaults(e.FeatureFlags))
+	}
+	ctx.ApplyOpts(sql.WithStatusVariables(e.StatusVariables))
+}
+
+// executeQuery analyzes and executes the parsed query given in the transaction started for it, if any, and returns
+// its schema and rows. The transaction is committed once the rows are closed if the session is in autocommit mode, and
+// the statement is recorded in the statement statistics of the catalog.
+func (e *Engine) executeQuery(
+	ctx *sql.Context,
+	query string,
+	parsed sql.Node,
+	bindings map[string]sql.Expression,
+	transactionDatabase string,
+) (sql.Schema, sql.RowIter, error) {
+	var (
+		analyzed sql.Node
+		iter     sql.RowIter
+		err      error
+	)
+
+	started := time.Now()
+	stats := e.Analyzer.Catalog.StatementStatistics
+
+	ctx, cancelDeadline, err := withMaxExecutionTime(ctx, parsed, started)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The deadline is released once the rows are closed, unless the statement fails before it has rows
+	hasRows := false
+	if cancelDeadline != nil {
+		defer func() {
+			if !hasRows {
+				cancelDeadline()
+			}
+		}()
+	}
+
 	if len(bindings) > 0 {
 		parsed, err = plan.ApplyBindings(ctx, parsed, bindings)
 		if err != nil {
@@ -177,13 +418,44 @@ func (e *Engine) QueryNodeWithBindings(
 
 	analyzed, err = e.Analyzer.Analyze(ctx, parsed, nil)
 	if err != nil {
+		recordFailedStatement(ctx, stats, query, started, err)
+		return nil, nil, err
+	}
+
+	analyzed, err = e.rewritePlan(ctx, query, analyzed)
+	if err != nil {
+		recordFailedStatement(ctx, stats, query, started, err)
+		return nil, nil, err
+	}
+
+	analyzed, forwarded, err := e.routeQuery(ctx, query, analyzed)
+	if err != nil {
+		recordFailedStatement(ctx, stats, query, started, err)
 		return nil, nil, err
 	}
 
-	iter, err = analyzed.RowIter(ctx, nil)
+	if forwarded == nil {
+		err = e.readOnlyServerCheck(ctx, analyzed)
+		if err != nil {
+			recordFailedStatement(ctx, stats, query, started, err)
+			return nil, nil, err
+		}
+	}
+
+	statementCtx, endStatement := plan.BeginStatement(ctx)
+	if forwarded != nil {
+		iter = forwarded
+	} else {
+		iter, err = e.execute(statementCtx, query, analyzed)
+	}
 	if err != nil {
+		recordFailedStatement(ctx, stats, query, started, err)
 		return nil, nil, err
 	}
+	iter = endStatement(iter)
+	if cancelDeadline != nil {
+		iter = &maxExecutionTimeIter{childIter: iter, ctx: statementCtx, cancel: cancelDeadline}
+	}
 
 	autoCommit, err := isSessionAutocommit(ctx)
 	if err != nil {
@@ -194,9 +466,62 @@ func (e *Engine) QueryNodeWithBindings(
 		iter = transactionCommittingIter{iter, transactionDatabase}
 	}
 
+	iter, err = newSlowQueryIter(ctx, started, iter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if stats != nil {
+		iter = newStatementStatisticsIter(ctx, stats, query, analyzed, started, iter)
+	}
+
+	hasRows = true
 	return analyzed.Schema(), iter, nil
 }
 
+// countStatement updates the status variables that count the statements executed with the parsed statement given.
+func countStatement(ctx *sql.Context, parsed sql.Node) {
+	sv := ctx.StatusVariables()
+	sv.Increment(ctx, "Questions", 1)
+	sv.Increment(ctx, "Queries", 1)
+	if name := plan.StatementStatusVariable(parsed); name != "" {
+		sv.Increment(ctx, name, 1)
+	}
+}
+
+// RewriteQuery returns the query given as rewritten by each of the engine's QueryRewriters, in order.
+func (e *Engine) RewriteQuery(ctx *sql.Context, query string) (string, error) {
+	for _, rewriter := range e.QueryRewriters {
+		var err error
+		query, err = rewriter.RewriteQuery(ctx, query)
+		if err != nil {
+			return "", err
+		}
+	}
+	return query, nil
+}
+
+// rewritePlan returns the analyzed node given as rewritten by each of the engine's PlanRewriters, in order.
+func (e *Engine) rewritePlan(ctx *sql.Context, query string, analyzed sql.Node) (sql.Node, error) {
+	for _, rewriter := range e.PlanRewriters {
+		var err error
+		analyzed, err = rewriter.RewritePlan(ctx, query, analyzed)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return analyzed, nil
+}
+
+// routeQuery returns the node to execute locally in place of the analyzed node given, or the rows of the query when
+// the QueryRouter of the engine forwarded it to another backend.
+func (e *Engine) routeQuery(ctx *sql.Context, query string, analyzed sql.Node) (sql.Node, sql.RowIter, error) {
+	if e.QueryRouter == nil {
+		return analyzed, nil, nil
+	}
+	return e.QueryRouter.RouteQuery(ctx, query, analyzed)
+}
+
 const (
 	fakeReadCommittedEnvVar = "READ_COMMITTED_HACK"
 )
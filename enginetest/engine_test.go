@@ -17,9 +17,11 @@ package enginetest_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pmezard/go-difflib/difflib"
 	"github.com/stretchr/testify/assert"
@@ -33,6 +35,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/information_schema"
 	"github.com/dolthub/go-mysql-server/sql/parse"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
@@ -76,6 +79,38 @@ func TestUse(t *testing.T) {
 	enginetest.TestUse(t, enginetest.NewDefaultMemoryHarness())
 }
 
+func TestExecuteScript(t *testing.T) {
+	enginetest.TestExecuteScript(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestSchemaChangeListener(t *testing.T) {
+	enginetest.TestSchemaChangeListener(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestSchemaVersions(t *testing.T) {
+	enginetest.TestSchemaVersions(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestAlterTableProgress(t *testing.T) {
+	enginetest.TestAlterTableProgress(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestShowCreateTableRoundTrip(t *testing.T) {
+	enginetest.TestShowCreateTableRoundTrip(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestLowerCaseTableNames(t *testing.T) {
+	enginetest.TestLowerCaseTableNames(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestCreateIndexProgress(t *testing.T) {
+	enginetest.TestCreateIndexProgress(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestInterleavedDDL(t *testing.T) {
+	enginetest.TestInterleavedDDL(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestNoDatabaseSelected(t *testing.T) {
 	enginetest.TestNoDatabaseSelected(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -240,6 +275,1281 @@ b (2/6 partitions)
 	require.ElementsMatch(expected, rows)
 }
 
+func TestStageProgress(t *testing.T) {
+	require := require.New(t)
+
+	addr := "127.0.0.1:34567"
+
+	p := sqle.NewProcessList()
+	sess := sql.NewBaseSessionWithClientServer("0.0.0.0:3306", sql.Client{Address: addr, User: "foo"}, 1)
+	ctx := sql.NewContext(context.Background(), sql.WithPid(1), sql.WithSession(sess), sql.WithProcessList(p))
+
+	ctx, err := p.AddProcess(ctx, "ALTER TABLE foo MODIFY COLUMN a bigint")
+	require.NoError(err)
+	ctx.ReportStageProgress(sql.StageAlteringTable, 0, 10)
+	ctx.ReportStageProgress(sql.StageAlteringTable, 4, 10)
+
+	ctx2 := sql.NewContext(context.Background(), sql.WithPid(2), sql.WithSession(sess), sql.WithProcessList(p))
+	ctx2, err = p.AddProcess(ctx2, "SELECT bar")
+	require.NoError(err)
+
+	n := plan.NewShowProcessList()
+	n.Database = "foo"
+
+	iter, err := n.RowIter(ctx, nil)
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+
+	expected := []sql.Row{
+		{int64(1), "foo", addr, "foo", "Query", int64(0), "altering table (4/10)", "ALTER TABLE foo MODIFY COLUMN a bigint"},
+		{int64(1), "foo", addr, "foo", "Query", int64(0), "running", "SELECT bar"},
+	}
+	require.ElementsMatch(expected, rows)
+
+	provider := sql.NewDatabaseProvider(information_schema.NewPerformanceSchemaDatabase())
+	e := sqle.NewDefault(provider)
+	_, iter, err = e.Query(ctx2, "SELECT thread_id, event_id, event_name, work_completed, work_estimated, nesting_event_type FROM performance_schema.events_stages_current")
+	require.NoError(err)
+	rows, err = sql.RowIterToRows(ctx2, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{uint64(1), uint64(1), sql.StageAlteringTable, uint64(4), uint64(10), "STATEMENT"}}, rows)
+}
+
+func TestNamedLocks(t *testing.T) {
+	require := require.New(t)
+
+	provider := sql.NewDatabaseProvider(information_schema.NewPerformanceSchemaDatabase())
+	e := sqle.NewDefault(provider)
+	query := func(ctx *sql.Context, q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+
+	sess1 := sql.NewBaseSessionWithClientServer("address", sql.Client{User: "foo", Address: "127.0.0.1:34567"}, 1)
+	ctx1 := sql.NewContext(context.Background(), sql.WithSession(sess1))
+	sess2 := sql.NewBaseSessionWithClientServer("address", sql.Client{User: "foo", Address: "127.0.0.1:34568"}, 2)
+	ctx2 := sql.NewContext(context.Background(), sql.WithSession(sess2))
+
+	require.Equal([]sql.Row{{int8(1), int8(1)}}, query(ctx1, "SELECT GET_LOCK('a', 0), GET_LOCK('b', 0)"))
+	require.Equal([]sql.Row{{int8(0), int8(0), uint32(1)}}, query(ctx2, "SELECT GET_LOCK('a', 0), IS_FREE_LOCK('a'), IS_USED_LOCK('a')"))
+	require.Equal([]sql.Row{{int8(1)}}, query(ctx1, "SELECT RELEASE_LOCK('b')"))
+	require.Equal([]sql.Row{{"USER LEVEL LOCK", "a", "EXCLUSIVE", "GRANTED", uint64(1)}},
+		query(ctx2, "SELECT object_type, object_name, lock_type, lock_status, owner_thread_id FROM performance_schema.metadata_locks"))
+
+	// A GET_LOCK waiting for a lock stops when its query is killed
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	killedCtx := ctx2.WithContext(cancelCtx)
+	_, iter, err := e.Query(killedCtx, "SELECT GET_LOCK('a', -1)")
+	if err == nil {
+		_, err = sql.RowIterToRows(killedCtx, iter)
+	}
+	require.Error(err)
+
+	_, err = e.LS.ReleaseAll(ctx1)
+	require.NoError(err)
+	require.Empty(query(ctx2, "SELECT * FROM performance_schema.metadata_locks"))
+	require.Equal([]sql.Row{{int32(0)}}, query(ctx2, "SELECT BENCHMARK(10, MD5('abc'))"))
+}
+
+// blockingTable is a table whose rows are read like over the network: reading them blocks until the context of the
+// query reading them is done.
+type blockingTable struct {
+	sql.Table
+	closed chan struct{}
+}
+
+func (t *blockingTable) PartitionRows(ctx *sql.Context, p sql.Partition) (sql.RowIter, error) {
+	return &blockingIter{closed: t.closed}, nil
+}
+
+type blockingIter struct {
+	closed chan struct{}
+}
+
+func (i *blockingIter) Next(ctx *sql.Context) (sql.Row, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (i *blockingIter) Close(*sql.Context) error {
+	close(i.closed)
+	return nil
+}
+
+func TestQueryInterruption(t *testing.T) {
+	newEngine := func() (*sqle.Engine, *blockingTable, *sql.Context) {
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+		ctx.SetCurrentDatabase("mydb")
+		db := memory.NewDatabase("mydb")
+		rows := memory.NewPartitionedTable("t", sql.NewPrimaryKeySchema(sql.Schema{{Name: "i", Type: sql.Int64, Source: "t"}}), 1)
+		require.NoError(t, rows.Insert(ctx, sql.NewRow(int64(1))))
+		table := &blockingTable{Table: rows, closed: make(chan struct{})}
+		db.AddTable("t", table)
+		return sqle.NewDefault(sql.NewDatabaseProvider(db)), table, ctx
+	}
+	requireClosed := func(t *testing.T, table *blockingTable) {
+		select {
+		case <-table.closed:
+		case <-time.After(time.Second):
+			t.Errorf("expecting the rows of the table to be closed")
+		}
+	}
+
+	t.Run("max_execution_time", func(t *testing.T) {
+		require := require.New(t)
+		e, table, ctx := newEngine()
+
+		_, iter, err := e.Query(ctx, "SET max_execution_time = 10")
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+
+		_, iter, err = e.Query(ctx, "SELECT * FROM t")
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.Error(err)
+		require.True(sql.ErrMaxExecutionTimeExceeded.Is(err), "unexpected error %v", err)
+		requireClosed(t, table)
+
+		// The session can still run queries after one timed out
+		_, iter, err = e.Query(ctx, "SELECT 1")
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		require.Equal([]sql.Row{{int8(1)}}, rows)
+	})
+
+	t.Run("killed query", func(t *testing.T) {
+		require := require.New(t)
+		e, table, ctx := newEngine()
+
+		cancelCtx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+		killedCtx := ctx.WithContext(cancelCtx)
+		_, iter, err := e.Query(killedCtx, "SELECT * FROM t")
+		require.NoError(err)
+		_, err = sql.RowIterToRows(killedCtx, iter)
+		require.Equal(context.Canceled, err)
+		requireClosed(t, table)
+	})
+}
+
+func TestReadOnlyServer(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+	ctx.SetCurrentDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb")))
+	defer func() {
+		require.NoError(sql.SystemVariables.SetGlobal("read_only", 0))
+		require.NoError(sql.SystemVariables.SetGlobal("super_read_only", 0))
+	}()
+
+	run := func(query string) error {
+		_, iter, err := e.Query(ctx, query)
+		if err != nil {
+			return err
+		}
+		_, err = sql.RowIterToRows(ctx, iter)
+		return err
+	}
+	requireReadOnly := func(query string, option string) {
+		err := run(query)
+		require.Error(err, query)
+		require.True(sql.ErrReadOnlyServer.Is(err), "unexpected error %v", err)
+		require.Contains(err.Error(), option)
+		mysqlErr, _, _ := sql.CastSQLError(err)
+		require.Equal(mysql.EROptionPreventsStatement, mysqlErr.Number())
+	}
+
+	for _, query := range []string{
+		"CREATE TABLE t (i INT PRIMARY KEY)",
+		"INSERT INTO t VALUES (1)",
+		"CREATE PROCEDURE reader() SELECT * FROM t",
+		"CREATE PROCEDURE writer() BEGIN SELECT * FROM t; INSERT INTO t VALUES (2); END",
+	} {
+		require.NoError(run(query), query)
+	}
+
+	// read_only doesn't apply to users with the SUPER privilege, which every user has without grant tables
+	require.NoError(run("SET GLOBAL read_only = 1"))
+	require.NoError(run("INSERT INTO t VALUES (3)"))
+
+	require.NoError(run("SET GLOBAL super_read_only = 1"))
+	requireReadOnly("INSERT INTO t VALUES (4)", "--super-read-only")
+	requireReadOnly("DELETE FROM t", "--super-read-only")
+	requireReadOnly("CREATE TABLE t2 (i INT PRIMARY KEY)", "--super-read-only")
+	requireReadOnly("CALL writer()", "--super-read-only")
+	for _, query := range []string{
+		"SELECT * FROM t",
+		"SHOW TABLES",
+		"CALL reader()",
+		"SET GLOBAL super_read_only = 0",
+	} {
+		require.NoError(run(query), query)
+	}
+
+	// Read-only sessions reject modifications regardless of the privileges of their user
+	ctx.Session.SetReadOnly(true)
+	requireReadOnly("INSERT INTO t VALUES (5)", "--read-only")
+	requireReadOnly("DROP TABLE t", "--read-only")
+	require.NoError(run("SELECT * FROM t"))
+	ctx.Session.SetReadOnly(false)
+	require.NoError(run("INSERT INTO t VALUES (5)"))
+}
+
+func TestSysSchema(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("mydb")
+	db.EnablePrimaryKeyIndexes()
+	provider := sql.NewDatabaseProvider(
+		db,
+		information_schema.NewPerformanceSchemaDatabase(),
+		information_schema.NewSysSchemaDatabase(),
+	)
+	e := sqle.NewDefault(provider)
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sql.NewBaseSession()))
+	ctx.SetCurrentDatabase("mydb")
+	query := func(q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+
+	query("CREATE TABLE t (i BIGINT PRIMARY KEY, v BIGINT)")
+	query("INSERT INTO t VALUES (1, 1), (2, 2), (3, 3)")
+	query("REPLACE INTO t VALUES (3, 4), (4, 4)")
+	query("INSERT INTO t VALUES (1, 1) ON DUPLICATE KEY UPDATE v = 5")
+	query("UPDATE t SET v = 0 WHERE i > 2")
+	query("DELETE FROM t WHERE i = 4")
+	query("SELECT * FROM t WHERE v = 0")
+	query("SELECT * FROM t WHERE v = 1")
+	query("SELECT * FROM t WHERE i = 1")
+	_, _, err := e.Query(ctx, "SELECT * FROM nosuchtable")
+	require.Error(err)
+
+	require.Equal([]sql.Row{{"mydb", "t", uint64(5), uint64(3), uint64(2)}},
+		query("SELECT table_schema, table_name, rows_inserted, rows_updated, rows_deleted FROM sys.schema_table_statistics"))
+
+	require.Equal([]sql.Row{
+		{"select * from t where v = ?", "mydb", "*", uint64(2), uint64(0), uint64(1)},
+		{"select * from t where i = ?", "mydb", "", uint64(1), uint64(0), uint64(1)},
+		{"select * from nosuchtable", "mydb", "", uint64(1), uint64(1), uint64(0)},
+	}, query("SELECT query, db, full_scan, exec_count, err_count, rows_sent FROM sys.statement_analysis WHERE query LIKE 'select * from%' ORDER BY exec_count DESC, query DESC"))
+
+	require.Equal([]sql.Row{{"insert into t values (?, ?), (?, ?), (?, ?)", uint64(1), uint64(3)}},
+		query("SELECT digest_text, count_star, sum_rows_affected FROM performance_schema.events_statements_summary_by_digest WHERE digest_text LIKE 'insert into t values (?, ?), %'"))
+}
+
+func TestInformationSchemaExtensions(t *testing.T) {
+	require := require.New(t)
+
+	// databasesRowIter returns a row for every database of the catalog
+	databasesRowIter := func(ctx *sql.Context, c sql.Catalog) (sql.RowIter, error) {
+		var rows []sql.Row
+		for _, db := range c.AllDatabases() {
+			rows = append(rows, sql.Row{db.Name(), "main"})
+		}
+		return sql.RowsToRowIter(rows...), nil
+	}
+	schema := sql.Schema{
+		{Name: "database_name", Type: sql.LongText},
+		{Name: "branch", Type: sql.LongText},
+	}
+	engines := information_schema.NewTable(information_schema.EnginesTableName, sql.Schema{{Name: "engine", Type: sql.LongText}},
+		func(*sql.Context, sql.Catalog) (sql.RowIter, error) {
+			return sql.RowsToRowIter(sql.Row{"custom"}), nil
+		})
+
+	provider := sql.NewDatabaseProvider(
+		memory.NewDatabase("mydb"),
+		information_schema.NewInformationSchemaDatabase(
+			information_schema.NewTable("branches", schema, databasesRowIter),
+			engines,
+		),
+		information_schema.NewSystemDatabase("my_schema", information_schema.NewTable("databases", schema, databasesRowIter)),
+	)
+	e := sqle.NewDefault(provider)
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+	query := func(q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err, q)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err, q)
+		return rows
+	}
+
+	expected := []sql.Row{{"information_schema", "main"}, {"my_schema", "main"}, {"mydb", "main"}}
+	require.Equal(expected, query("SELECT * FROM information_schema.branches ORDER BY 1"))
+	require.Equal(expected, query("SELECT * FROM my_schema.databases ORDER BY 1"))
+	require.Equal([]sql.Row{{"custom"}}, query("SELECT * FROM information_schema.engines"))
+	require.Equal([]sql.Row{{"columns"}}, query("SELECT table_name FROM information_schema.tables WHERE table_schema = 'information_schema' AND table_name = 'columns'"))
+
+	_, _, err := e.Query(ctx, "INSERT INTO my_schema.databases VALUES ('a', 'b')")
+	require.Error(err)
+
+	_, _, err = e.Query(ctx, "SHOW BRANCHES")
+	require.True(sql.ErrUnsupportedFeature.Is(err))
+	parse.RegisterShowExtension("branches", parse.NewShowQueryExtension("SELECT branch, database_name FROM information_schema.branches ORDER BY 2"))
+	require.Equal([]sql.Row{{"main", "information_schema"}, {"main", "my_schema"}, {"main", "mydb"}}, query("SHOW BRANCHES"))
+	require.Equal([]sql.Row{{"main", "information_schema"}, {"main", "my_schema"}, {"main", "mydb"}}, query("show branches"))
+}
+
+func TestSuggestIndexes(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("mydb")
+	db.EnablePrimaryKeyIndexes()
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+	for _, q := range []string{
+		"CREATE TABLE customers (id BIGINT PRIMARY KEY, name VARCHAR(50), city VARCHAR(50), age INT, INDEX (name))",
+		"CREATE TABLE orders (id BIGINT PRIMARY KEY, customer_id BIGINT, city VARCHAR(50), total INT)",
+	} {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err)
+		_, err = sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+	}
+
+	suggestions, err := e.SuggestIndexes(ctx, []string{
+		"SELECT * FROM customers WHERE city = 'Seattle' AND age > 30",
+		"SELECT * FROM customers WHERE age BETWEEN 20 AND 30 AND city = 'Portland'",
+		"SELECT * FROM customers WHERE name = 'Ann'",
+		"SELECT * FROM customers WHERE id = 1",
+		"SELECT c.name, o.total FROM customers c JOIN orders o ON c.id = o.customer_id",
+		"SELECT c.name, o.total FROM customers c JOIN orders o ON c.city = o.city",
+		"SELECT * FROM orders WHERE total > 100",
+		"SELECT * FROM customers WHERE city = name",
+	})
+	require.NoError(err)
+	require.Equal([]sqle.IndexSuggestion{
+		{Database: "mydb", Table: "customers", Columns: []string{"city", "age"}, Queries: 2},
+		{Database: "mydb", Table: "orders", Columns: []string{"city"}, Queries: 1},
+		{Database: "mydb", Table: "orders", Columns: []string{"customer_id"}, Queries: 1},
+		{Database: "mydb", Table: "orders", Columns: []string{"total"}, Queries: 1},
+	}, suggestions)
+	require.Equal("CREATE INDEX `idx_city_age` ON `mydb`.`customers` (`city`, `age`)", suggestions[0].String())
+}
+
+type replaceQueryRewriter struct {
+	old, new string
+}
+
+func (r replaceQueryRewriter) RewriteQuery(_ *sql.Context, query string) (string, error) {
+	return strings.ReplaceAll(query, r.old, r.new), nil
+}
+
+// ownerPlanRewriter filters the rows of the owned table read by SELECT statements to those whose owner column is the
+// given owner.
+type ownerPlanRewriter struct {
+	table, owner string
+}
+
+func (r ownerPlanRewriter) RewritePlan(_ *sql.Context, query string, analyzed sql.Node) (sql.Node, error) {
+	if !strings.HasPrefix(strings.ToUpper(query), "SELECT") {
+		return analyzed, nil
+	}
+	return plan.TransformUp(analyzed, func(n sql.Node) (sql.Node, error) {
+		rt, ok := n.(*plan.ResolvedTable)
+		if !ok || !strings.EqualFold(rt.Name(), r.table) {
+			return n, nil
+		}
+		idx := rt.Schema().IndexOf("owner", rt.Name())
+		if idx < 0 {
+			return nil, fmt.Errorf("owner column is required to read %s", r.table)
+		}
+		owner := expression.NewGetFieldWithTable(idx, sql.LongText, rt.Name(), "owner", false)
+		return plan.NewFilter(expression.NewEquals(owner, expression.NewLiteral(r.owner, sql.LongText)), rt), nil
+	})
+}
+
+func TestQueryRewriters(t *testing.T) {
+	require := require.New(t)
+
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb"))), &sqle.Config{
+		QueryRewriters: []sql.QueryRewriter{
+			replaceQueryRewriter{"LIMIT ALL", "LIMIT 100"},
+			replaceQueryRewriter{"LIMIT 100", "LIMIT 2"},
+		},
+		PlanRewriters: []sql.PlanRewriter{
+			ownerPlanRewriter{table: "secrets", owner: "alice"},
+		},
+	})
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+	query := func(q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err, q)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err, q)
+		return rows
+	}
+
+	query("CREATE TABLE secrets (id BIGINT PRIMARY KEY, owner VARCHAR(20) NOT NULL, secret VARCHAR(20))")
+	query("INSERT INTO secrets VALUES (1, 'alice', 'a'), (2, 'bob', 'b'), (3, 'alice', 'c'), (4, 'alice', 'd')")
+
+	// Both query rewriters apply, in order, and the plan rewriter hides rows owned by others.
+	require.Equal([]sql.Row{{int64(1), "alice", "a"}, {int64(3), "alice", "c"}},
+		query("SELECT * FROM secrets ORDER BY id LIMIT ALL"))
+
+	rewritten, err := e.RewriteQuery(ctx, "SELECT 1 LIMIT ALL")
+	require.NoError(err)
+	require.Equal("SELECT 1 LIMIT 2", rewritten)
+
+	schema, err := e.AnalyzeQuery(ctx, "SELECT secret FROM secrets LIMIT ALL")
+	require.NoError(err)
+	require.Len(schema, 1)
+}
+
+func TestColumnMasks(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("mydb")
+	db.EnablePrimaryKeyIndexes()
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	e.Analyzer.Catalog.GrantTables.AddSuperUser("admin", "")
+	newCtx := func(user string, id uint32) *sql.Context {
+		sess := sql.NewBaseSessionWithClientServer("address", sql.Client{User: user, Address: "127.0.0.1:34567"}, id)
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sess))
+		ctx.SetCurrentDatabase("mydb")
+		return ctx
+	}
+	query := func(ctx *sql.Context, q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err, q)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err, q)
+		return rows
+	}
+
+	admin := newCtx("admin", 1)
+	query(admin, "CREATE USER app")
+	query(admin, "GRANT ALL ON mydb.* TO app")
+	query(admin, "CREATE TABLE people (id BIGINT PRIMARY KEY, name VARCHAR(20), ssn VARCHAR(11), salary BIGINT, INDEX (ssn))")
+	query(admin, "INSERT INTO people VALUES (1, 'alice', '123-45-6789', 100), (2, 'bob', '987-65-4321', 200)")
+	query(admin, "CREATE TABLE copies (id BIGINT PRIMARY KEY, ssn VARCHAR(11), salary BIGINT)")
+
+	e.Analyzer.Catalog.ColumnMasks.Set(sql.ColumnMask{Database: "mydb", Table: "people", Column: "ssn", Masker: sql.RedactMask{KeepSuffix: 4}})
+	e.Analyzer.Catalog.ColumnMasks.Set(sql.ColumnMask{Database: "mydb", Table: "people", Column: "salary", Masker: sql.NullMask})
+
+	app := newCtx("app", 2)
+	require.Equal([]sql.Row{
+		{int64(1), "alice", "XXXXXXX6789", nil},
+		{int64(2), "bob", "XXXXXXX4321", nil},
+	}, query(app, "SELECT * FROM people ORDER BY id"))
+
+	// Filters and functions only see masked values, even where an index could be used
+	require.Empty(query(app, "SELECT id FROM people WHERE ssn = '123-45-6789'"))
+	require.Equal([]sql.Row{{"xxxxxxx6789"}}, query(app, "SELECT LOWER(p.ssn) FROM people p WHERE p.id = 1"))
+
+	// Rows copied by a session are masked too
+	query(app, "INSERT INTO copies SELECT id, ssn, salary FROM people")
+	require.Equal([]sql.Row{
+		{int64(1), "XXXXXXX6789", nil},
+		{int64(2), "XXXXXXX4321", nil},
+	}, query(admin, "SELECT * FROM copies ORDER BY id"))
+
+	// Updates change the stored rows
+	query(app, "UPDATE people SET name = 'carol' WHERE id = 2")
+	require.Equal([]sql.Row{{int64(2), "carol", "987-65-4321", int64(200)}}, query(admin, "SELECT * FROM people WHERE id = 2"))
+
+	require.NoError(e.Analyzer.Catalog.ColumnMasks.Drop("mydb", "people", "SSN"))
+	require.True(sql.ErrColumnMaskNotFound.Is(e.Analyzer.Catalog.ColumnMasks.Drop("mydb", "people", "ssn")))
+	require.Equal([]sql.Row{{"123-45-6789", nil}}, query(app, "SELECT ssn, salary FROM people WHERE id = 1"))
+}
+
+func TestProcedureSecurityContext(t *testing.T) {
+	require := require.New(t)
+
+	e := sqle.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb")))
+	e.Analyzer.Catalog.GrantTables.AddSuperUser("admin", "")
+	newCtx := func(user string, id uint32) *sql.Context {
+		sess := sql.NewBaseSessionWithClientServer("address", sql.Client{User: user, Address: "127.0.0.1:34567"}, id)
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sess))
+		ctx.SetCurrentDatabase("mydb")
+		return ctx
+	}
+	query := func(ctx *sql.Context, q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err, q)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err, q)
+		return rows
+	}
+
+	admin := newCtx("admin", 1)
+	query(admin, "CREATE USER app")
+	query(admin, "GRANT ALL ON mydb.* TO app")
+
+	app := newCtx("app", 2)
+	query(app, "CREATE TABLE secrets (id BIGINT PRIMARY KEY, secret VARCHAR(20))")
+	query(app, "INSERT INTO secrets VALUES (1, 'hunter2')")
+	query(app, "CREATE DEFINER = `admin` PROCEDURE definer_secrets() SQL SECURITY DEFINER SELECT secret, CURRENT_USER() FROM secrets")
+	query(app, "CREATE DEFINER = `admin` PROCEDURE invoker_secrets() SQL SECURITY INVOKER SELECT secret, CURRENT_USER() FROM secrets")
+	query(app, "CREATE PROCEDURE no_definer_secrets() SELECT secret FROM secrets")
+
+	e.Analyzer.Catalog.ColumnMasks.Set(sql.ColumnMask{Database: "mydb", Table: "secrets", Column: "secret", Masker: sql.RedactMask{}})
+
+	require.Equal([]sql.Row{{"XXXXXXX"}}, query(app, "SELECT secret FROM secrets"))
+	require.Equal([]sql.Row{{"hunter2", "admin@%"}}, query(app, "CALL definer_secrets()"))
+	require.Equal([]sql.Row{{"XXXXXXX", "app@127.0.0.1:34567"}}, query(app, "CALL invoker_secrets()"))
+	require.Equal([]sql.Row{{"XXXXXXX"}}, query(app, "CALL no_definer_secrets()"))
+
+	// The security context of the session is restored after the call
+	require.Equal([]sql.Row{{"app@127.0.0.1:34567", "app@127.0.0.1:34567"}}, query(app, "SELECT USER(), CURRENT_USER()"))
+}
+
+// TestGlobalStatusVariables tests the global status variables of an engine, which every session of the engine adds to,
+// and no other engine does. The counters of sessions are tested by enginetest.StatusVariableScripts.
+func TestGlobalStatusVariables(t *testing.T) {
+	require := require.New(t)
+
+	e := sqle.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb")))
+	other := sqle.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb")))
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+	query := func(e *sqle.Engine, q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err, q)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err, q)
+		return rows
+	}
+	status := func(e *sqle.Engine, scope, name string) string {
+		rows := query(e, "SHOW "+scope+" STATUS LIKE '"+name+"'")
+		require.Len(rows, 1)
+		return rows[0][1].(string)
+	}
+
+	query(e, "CREATE TABLE t (id BIGINT PRIMARY KEY, v BIGINT)")
+	query(e, "SELECT * FROM t")
+	query(e, "SELECT v FROM t WHERE v > 10")
+	require.Equal("2", status(e, "GLOBAL", "Com_select"))
+	require.Equal("0", status(other, "GLOBAL", "Com_select"))
+
+	// Status variables that are only global report their global value in the session scope
+	require.Equal(status(e, "GLOBAL", "Connections"), status(e, "SESSION", "Connections"))
+
+	rows := query(e, "SHOW STATUS WHERE Variable_name = 'Uptime'")
+	require.Len(rows, 1)
+	require.Equal("Uptime", rows[0][0])
+}
+
+// flushRecorder is a sql.FlushHandler that records the options it's notified of, and fails FLUSH HOSTS.
+type flushRecorder struct {
+	options []sql.FlushOption
+}
+
+func (r *flushRecorder) Flush(ctx *sql.Context, option sql.FlushOption) error {
+	if option.Name == "HOSTS" {
+		return fmt.Errorf("cannot flush hosts")
+	}
+	r.options = append(r.options, option)
+	return nil
+}
+
+// TestFlushHandler tests that FLUSH statements are passed to the FlushHandler of the catalog. What they flush is tested
+// by enginetest.StatusVariableScripts.
+func TestFlushHandler(t *testing.T) {
+	require := require.New(t)
+
+	e := sqle.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb")))
+	recorder := &flushRecorder{}
+	e.Analyzer.Catalog.FlushHandler = recorder
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+	query := func(q string) ([]sql.Row, error) {
+		_, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+
+	_, err := query("CREATE TABLE t (id BIGINT PRIMARY KEY)")
+	require.NoError(err)
+	version := e.Analyzer.Catalog.SchemaVersion("mydb", "t")
+
+	rows, err := query("FLUSH TABLES t")
+	require.NoError(err)
+	require.Equal([]sql.Row{{sql.NewOkResult(0)}}, rows)
+	require.NotEqual(version, e.Analyzer.Catalog.SchemaVersion("mydb", "t"))
+
+	_, err = query("FLUSH LOCAL STATUS, LOGS, PRIVILEGES")
+	require.NoError(err)
+	_, err = query("FLUSH HOSTS")
+	require.Error(err)
+
+	require.Equal([]sql.FlushOption{
+		{Kind: sql.FlushKind_Tables, Name: "TABLES", Tables: []sql.FlushTable{{Name: "t"}}},
+		{Kind: sql.FlushKind_Status, Name: "STATUS"},
+		{Kind: sql.FlushKind_Logs, Name: "LOGS"},
+		{Kind: sql.FlushKind_Privileges, Name: "PRIVILEGES"},
+	}, recorder.options)
+}
+
+func TestAdminCommands(t *testing.T) {
+	require := require.New(t)
+
+	e := sqle.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb")))
+	newCtx := func(user string, id uint32) *sql.Context {
+		sess := sql.NewBaseSessionWithClientServer("address", sql.Client{User: user, Address: "127.0.0.1:34567"}, id)
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sess))
+		ctx.SetCurrentDatabase("mydb")
+		return ctx
+	}
+	exec := func(ctx *sql.Context, q string) error {
+		_, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return err
+		}
+		_, err = sql.RowIterToRows(ctx, iter)
+		return err
+	}
+	admin, app := newCtx("admin", 1), newCtx("app", 2)
+
+	require.True(sql.ErrAdminCommandNotSupported.Is(exec(app, "RESET SLAVE")))
+
+	var resets [][]string
+	e.Analyzer.Catalog.AdminCommands.Register(sql.AdminCommand{
+		Name:      sql.AdminCommand_ResetReplica,
+		Privilege: "Reload_priv",
+		Run: func(ctx *sql.Context, args []string) error {
+			resets = append(resets, args)
+			return nil
+		},
+	})
+
+	// Every account has every privilege while the grant tables are disabled
+	require.NoError(exec(app, "RESET REPLICA"))
+
+	e.Analyzer.Catalog.GrantTables.AddSuperUser("admin", "")
+	require.NoError(exec(admin, "CREATE USER app"))
+	err := exec(app, "RESET REPLICA ALL")
+	require.True(sql.ErrAdminCommandDenied.Is(err))
+	require.Contains(err.Error(), "RELOAD")
+	require.NoError(exec(admin, "RESET SLAVE ALL"))
+
+	require.Equal([][]string{nil, {"ALL"}}, resets)
+}
+
+type testTransaction struct{}
+
+func (testTransaction) String() string   { return "test transaction" }
+func (testTransaction) IsReadOnly() bool { return false }
+
+// transactionDatabase is a memory database that supports transactions, counting their rollbacks.
+type transactionDatabase struct {
+	*memory.Database
+	rollbacks int
+}
+
+var _ sql.TransactionDatabase = (*transactionDatabase)(nil)
+
+func (d *transactionDatabase) StartTransaction(*sql.Context, sql.TransactionCharacteristic) (sql.Transaction, error) {
+	return testTransaction{}, nil
+}
+
+func (d *transactionDatabase) CommitTransaction(*sql.Context, sql.Transaction) error {
+	return nil
+}
+
+func (d *transactionDatabase) Rollback(*sql.Context, sql.Transaction) error {
+	d.rollbacks++
+	return nil
+}
+
+func (d *transactionDatabase) CreateSavepoint(*sql.Context, sql.Transaction, string) error {
+	return nil
+}
+
+func (d *transactionDatabase) RollbackToSavepoint(*sql.Context, sql.Transaction, string) error {
+	return nil
+}
+
+func (d *transactionDatabase) ReleaseSavepoint(*sql.Context, sql.Transaction, string) error {
+	return nil
+}
+
+// conflictingSession is a session whose next |conflicts| commits fail with a transaction conflict.
+type conflictingSession struct {
+	*sql.BaseSession
+	conflicts int
+}
+
+func (s *conflictingSession) CommitTransaction(*sql.Context, string, sql.Transaction) error {
+	if s.conflicts > 0 {
+		s.conflicts--
+		return sql.ErrTransactionConflict.New("concurrent commit")
+	}
+	return nil
+}
+
+func TestRetryPolicy(t *testing.T) {
+	require := require.New(t)
+
+	db := &transactionDatabase{Database: memory.NewDatabase("mydb")}
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(db)), &sqle.Config{
+		RetryPolicy: &sqle.RetryPolicy{MaxAttempts: 3, Backoff: time.Millisecond},
+	})
+	sess := &conflictingSession{BaseSession: sql.NewBaseSession()}
+	ctx := sql.NewContext(context.Background(), sql.WithSession(sess))
+	ctx.SetCurrentDatabase("mydb")
+	query := func(q string) ([]sql.Row, error) {
+		_, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+	mustQuery := func(q string) []sql.Row {
+		rows, err := query(q)
+		require.NoError(err, q)
+		return rows
+	}
+
+	mustQuery("CREATE TABLE t (id BIGINT PRIMARY KEY, v BIGINT)")
+	mustQuery("INSERT INTO t VALUES (1, 0)")
+
+	// Statements are retried in a new transaction until they commit
+	sess.conflicts = 2
+	mustQuery("UPDATE t SET v = 1 WHERE id = 1")
+	require.Equal(0, sess.conflicts)
+	require.Equal(2, db.rollbacks)
+	require.Equal([]sql.Row{{int64(1)}}, mustQuery("SELECT v FROM t"))
+
+	// ...up to MaxAttempts times
+	sess.conflicts = 4
+	_, err := query("UPDATE t SET v = 2 WHERE id = 1")
+	require.True(sql.ErrTransactionConflict.Is(err), "unexpected error %v", err)
+	require.Equal(1, sess.conflicts)
+
+	// SELECT statements aren't retried
+	sess.conflicts = 1
+	_, err = query("SELECT v FROM t")
+	require.True(sql.ErrTransactionConflict.Is(err), "unexpected error %v", err)
+}
+
+// panicExpression is an expression that panics when it's evaluated.
+type panicExpression struct{}
+
+var _ sql.Expression = panicExpression{}
+
+func (panicExpression) Resolved() bool             { return true }
+func (panicExpression) String() string             { return "panic()" }
+func (panicExpression) Type() sql.Type             { return sql.Int64 }
+func (panicExpression) IsNullable() bool           { return false }
+func (panicExpression) Children() []sql.Expression { return nil }
+func (panicExpression) Eval(*sql.Context, sql.Row) (interface{}, error) {
+	panic("panic() evaluated")
+}
+
+func (e panicExpression) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return e, nil
+}
+
+// panicPlanRewriter panics while rewriting the plan of the query "SELECT 'rewrite'", and rewrites the plan of the
+// query "SELECT 'eval'" to panic while its rows are read.
+type panicPlanRewriter struct{}
+
+func (panicPlanRewriter) RewritePlan(_ *sql.Context, query string, analyzed sql.Node) (sql.Node, error) {
+	switch query {
+	case "SELECT 'rewrite'":
+		panic("rewriting panicked")
+	case "SELECT 'eval'":
+		return plan.NewProject([]sql.Expression{panicExpression{}}, analyzed), nil
+	default:
+		return analyzed, nil
+	}
+}
+
+func TestPanicRecovery(t *testing.T) {
+	// newQuery returns a function that runs a query on a new engine with the panicking plan rewriter
+	newQuery := func(disableRecovery bool) func(q string) ([]sql.Row, error) {
+		e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb"))), &sqle.Config{
+			PlanRewriters:        []sql.PlanRewriter{panicPlanRewriter{}},
+			DisablePanicRecovery: disableRecovery,
+		})
+		ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+		return func(q string) ([]sql.Row, error) {
+			_, iter, err := e.Query(ctx, q)
+			if err != nil {
+				return nil, err
+			}
+			return sql.RowIterToRows(ctx, iter)
+		}
+	}
+
+	t.Run("recovered", func(t *testing.T) {
+		require := require.New(t)
+		query := newQuery(false)
+
+		_, err := query("SELECT 'rewrite'")
+		require.True(sql.ErrInternal.Is(err), "unexpected error %v", err)
+		require.Contains(err.Error(), "rewriting panicked")
+
+		_, err = query("SELECT 'eval'")
+		require.True(sql.ErrInternal.Is(err), "unexpected error %v", err)
+		require.Contains(err.Error(), "panic() evaluated")
+
+		// The engine keeps running queries
+		rows, err := query("SELECT 1")
+		require.NoError(err)
+		require.Equal([]sql.Row{{int8(1)}}, rows)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		query := newQuery(true)
+		require.Panics(t, func() {
+			_, _ = query("SELECT 'rewrite'")
+		})
+		require.Panics(t, func() {
+			_, _ = query("SELECT 'eval'")
+		})
+	})
+}
+
+func TestTableStatisticsAutoRefresh(t *testing.T) {
+	require := require.New(t)
+	defer func() {
+		require.NoError(sql.SystemVariables.SetGlobal("innodb_stats_auto_recalc", int8(1)))
+	}()
+
+	db := memory.NewDatabase("mydb")
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+	rows := func(q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err, q)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err, q)
+		return rows
+	}
+
+	rows("CREATE TABLE t (id BIGINT PRIMARY KEY, v BIGINT)")
+	for i := 0; i < 100; i++ {
+		rows(fmt.Sprintf("INSERT INTO t VALUES (%d, %d)", i, i))
+	}
+	table, ok, err := db.GetTableInsensitive(ctx, "t")
+	require.NoError(err)
+	require.True(ok)
+	mt := table.(*memory.Table)
+
+	// Tables that were never analyzed have no statistics to refresh
+	require.Equal(0, mt.TimesAnalyzed())
+
+	require.Equal([]sql.Row{{"mydb.t", "analyze", "status", "OK"}}, rows("ANALYZE TABLE t"))
+	require.Equal(1, mt.TimesAnalyzed())
+
+	// The statistics are refreshed once more than 10% of the rows changed
+	rows("UPDATE t SET v = v + 1 WHERE id < 6")
+	rows("DELETE FROM t WHERE id >= 96")
+	stale, err := e.Analyzer.Catalog.TableStatistics.IsStale(ctx, "mydb", mt)
+	require.NoError(err)
+	require.False(stale)
+	require.Equal(1, mt.TimesAnalyzed())
+
+	rows("INSERT INTO t VALUES (200, 200)")
+	require.Equal(2, mt.TimesAnalyzed())
+	changed, ok, err := e.Analyzer.Catalog.TableStatistics.RowsChanged(ctx, "mydb", mt)
+	require.NoError(err)
+	require.True(ok)
+	require.Equal(uint64(0), changed)
+
+	// Stale statistics aren't refreshed with innodb_stats_auto_recalc disabled
+	rows("SET GLOBAL innodb_stats_auto_recalc = 0")
+	rows("DELETE FROM t WHERE id < 50")
+	stale, err = e.Analyzer.Catalog.TableStatistics.IsStale(ctx, "mydb", mt)
+	require.NoError(err)
+	require.True(stale)
+	require.Equal(2, mt.TimesAnalyzed())
+
+	rows("SET GLOBAL innodb_stats_auto_recalc = 1")
+	rows("INSERT INTO t VALUES (300, 300)")
+	require.Equal(3, mt.TimesAnalyzed())
+}
+
+// shardRouter forwards the queries that read or write the sharded table to a backend, which returns the rows given,
+// and fails the queries that drop tables.
+type shardRouter struct {
+	table     string
+	rows      []sql.Row
+	forwarded []string
+}
+
+func (r *shardRouter) RouteQuery(_ *sql.Context, query string, analyzed sql.Node) (sql.Node, sql.RowIter, error) {
+	dropsTable, sharded := false, false
+	plan.Inspect(analyzed, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.DropTable:
+			dropsTable = true
+		case *plan.ResolvedTable:
+			sharded = sharded || strings.EqualFold(n.Name(), r.table)
+		}
+		return true
+	})
+	if dropsTable {
+		return nil, nil, fmt.Errorf("tables can't be dropped")
+	}
+	if !sharded {
+		return analyzed, nil, nil
+	}
+	r.forwarded = append(r.forwarded, query)
+	return analyzed, sql.RowsToRowIter(r.rows...), nil
+}
+
+func TestQueryRouter(t *testing.T) {
+	require := require.New(t)
+
+	router := &shardRouter{table: "sharded", rows: []sql.Row{{int64(10)}, {int64(20)}}}
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb"))), &sqle.Config{
+		QueryRouter: router,
+	})
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+	query := func(q string) ([]sql.Row, error) {
+		_, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+	mustQuery := func(q string) []sql.Row {
+		rows, err := query(q)
+		require.NoError(err, q)
+		return rows
+	}
+
+	mustQuery("CREATE TABLE sharded (i BIGINT PRIMARY KEY)")
+	mustQuery("CREATE TABLE t (i BIGINT PRIMARY KEY)")
+	mustQuery("INSERT INTO t VALUES (1)")
+	router.forwarded = nil
+
+	// Queries of the sharded table return the rows of the backend, while the others are executed locally
+	require.Equal([]sql.Row{{int64(10)}, {int64(20)}}, mustQuery("SELECT i FROM sharded"))
+	require.Equal([]sql.Row{{int64(1)}}, mustQuery("SELECT i FROM t"))
+	require.Equal([]string{"SELECT i FROM sharded"}, router.forwarded)
+
+	// Forwarded writes aren't rejected by read-only sessions, unlike local ones
+	ctx.Session.SetReadOnly(true)
+	mustQuery("INSERT INTO sharded VALUES (3)")
+	_, err := query("INSERT INTO t VALUES (3)")
+	require.True(sql.ErrReadOnlyServer.Is(err), "unexpected error %v", err)
+	ctx.Session.SetReadOnly(false)
+
+	_, err = query("DROP TABLE t")
+	require.EqualError(err, "tables can't be dropped")
+}
+
+func TestNewWithConfig(t *testing.T) {
+	require := require.New(t)
+
+	e := sqle.NewWithConfig(sql.NewDatabaseProvider(memory.NewDatabase("mydb")), sql.NewEngineConfig(
+		sql.WithParallelism(4),
+		sql.WithDeterministicOrder(),
+		sql.WithSlowAnalysisThreshold(time.Second),
+		sql.WithReadOnly(),
+		sql.WithoutPanicRecovery(),
+		sql.WithVersionPostfix("test"),
+	))
+	defer e.Close()
+
+	require.Equal(4, e.Analyzer.Parallelism)
+	require.True(e.Analyzer.DeterministicOrder)
+	require.Equal(time.Second, e.Analyzer.SlowAnalysisThreshold)
+	require.True(e.IsReadOnly)
+	require.True(e.DisablePanicRecovery)
+
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+	_, iter, err := e.Query(ctx, "SELECT VERSION()")
+	require.NoError(err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Len(rows, 1)
+	require.Contains(rows[0][0], "test")
+
+	_, _, err = e.Query(ctx, "CREATE TABLE t (i int primary key)")
+	require.Error(err)
+
+	// The defaults are the same as the ones of NewDefault
+	e = sqle.NewWithConfig(sql.NewDatabaseProvider(memory.NewDatabase("mydb")), sql.NewEngineConfig())
+	defer e.Close()
+	d := sqle.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb")))
+	defer d.Close()
+	require.Equal(d.Analyzer.Parallelism, e.Analyzer.Parallelism)
+	require.Equal(d.Analyzer.DeterministicOrder, e.Analyzer.DeterministicOrder)
+	require.Equal(d.Analyzer.Debug, e.Analyzer.Debug)
+	require.Equal(d.IsReadOnly, e.IsReadOnly)
+	require.Equal(d.DisablePanicRecovery, e.DisablePanicRecovery)
+}
+
+func TestPrepareQuery(t *testing.T) {
+	require := require.New(t)
+
+	e := sqle.New(analyzer.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb"))), &sqle.Config{
+		QueryRewriters: []sql.QueryRewriter{replaceQueryRewriter{"LIMIT ALL", "LIMIT 2"}},
+	})
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+
+	execute := func(prepared *sqle.PreparedStatement, bindings map[string]sql.Expression) []sql.Row {
+		_, iter, err := e.ExecutePrepared(ctx, prepared, bindings)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+
+	create, err := e.PrepareQuery(ctx, "CREATE TABLE t (i BIGINT PRIMARY KEY, s VARCHAR(20))")
+	require.NoError(err)
+	execute(create, nil)
+
+	insert, err := e.PrepareQuery(ctx, "INSERT INTO t VALUES (?, ?)")
+	require.NoError(err)
+	for i, s := range []string{"a", "b", "c", "d"} {
+		execute(insert, map[string]sql.Expression{
+			"v1": expression.NewLiteral(int64(i), sql.Int64),
+			"v2": expression.NewLiteral(s, sql.LongText),
+		})
+	}
+
+	// The query is rewritten and parsed once, and its parameters are bound on each execution
+	sel, err := e.PrepareQuery(ctx, "SELECT s FROM t WHERE i >= ? ORDER BY i LIMIT ALL")
+	require.NoError(err)
+	require.Equal("SELECT s FROM t WHERE i >= ? ORDER BY i LIMIT 2", sel.Query)
+	require.Len(sel.Schema, 1)
+	require.Equal("s", sel.Schema[0].Name)
+	require.Equal([]sql.Row{{"a"}, {"b"}}, execute(sel, map[string]sql.Expression{"v1": expression.NewLiteral(int64(0), sql.Int64)}))
+	require.Equal([]sql.Row{{"c"}, {"d"}}, execute(sel, map[string]sql.Expression{"v1": expression.NewLiteral(int64(2), sql.Int64)}))
+
+	// Statements that aren't valid fail to prepare
+	_, err = e.PrepareQuery(ctx, "SELECT * FROM nonexistent WHERE i = ?")
+	require.True(sql.ErrTableNotFound.Is(err))
+}
+
+func TestPreparedQueriesPerSession(t *testing.T) {
+	require := require.New(t)
+
+	e := sqle.NewDefault(sql.NewDatabaseProvider(memory.NewDatabase("mydb")))
+	newCtx := func(id uint32) *sql.Context {
+		sess := sql.NewBaseSessionWithClientServer("address", sql.Client{User: "root", Address: "127.0.0.1:34567"}, id)
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sess))
+		ctx.SetCurrentDatabase("mydb")
+		return ctx
+	}
+	query := func(ctx *sql.Context, q string) ([]sql.Row, error) {
+		_, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+	mustQuery := func(ctx *sql.Context, q string) []sql.Row {
+		rows, err := query(ctx, q)
+		require.NoError(err, q)
+		return rows
+	}
+	ctx1, ctx2 := newCtx(1), newCtx(2)
+
+	mustQuery(ctx1, "CREATE TABLE t (i BIGINT PRIMARY KEY)")
+	mustQuery(ctx1, "PREPARE ins FROM 'INSERT INTO t VALUES (?)'")
+
+	// Statements are only visible to the session that prepared them
+	_, err := query(ctx2, "EXECUTE ins USING @i")
+	require.True(sql.ErrUnknownPreparedStatement.Is(err))
+
+	// The statements executed are checked like the ones executed directly
+	e.IsReadOnly = true
+	_, err = query(ctx1, "EXECUTE ins USING @i")
+	require.True(sql.ErrNotAuthorized.Is(err))
+	e.IsReadOnly = false
+
+	require.NoError(ctx1.SetUserVariable(ctx1, "i", int64(7)))
+	mustQuery(ctx1, "EXECUTE ins USING @i")
+	require.Equal([]sql.Row{{int64(7)}}, mustQuery(ctx2, "SELECT * FROM t"))
+
+	e.Analyzer.Catalog.PreparedQueries.DeallocateAll(1)
+	_, err = query(ctx1, "EXECUTE ins USING @i")
+	require.True(sql.ErrUnknownPreparedStatement.Is(err))
+}
+
+// TestEngineFeatureFlags tests the feature flags that engines are configured with. Setting them in sessions is tested by
+// VariableQueries.
+func TestEngineFeatureFlags(t *testing.T) {
+	require := require.New(t)
+
+	e := sqle.NewWithConfig(sql.NewDatabaseProvider(memory.NewDatabase("mydb")), sql.NewEngineConfig(
+		sql.WithFeatureFlag(sql.FeatureRecursiveCte, false),
+	))
+	defer e.Close()
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+	query := func(q string) ([]sql.Row, error) {
+		_, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+	mustQuery := func(q string) []sql.Row {
+		rows, err := query(q)
+		require.NoError(err, q)
+		return rows
+	}
+
+	const recursive = "WITH RECURSIVE n (i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM n WHERE i < 3) SELECT i FROM n"
+
+	// The engine turns the flag off for its queries
+	_, err := query(recursive)
+	require.True(sql.ErrFeatureDisabled.Is(err))
+
+	// The session turns it back on
+	mustQuery("SET optimizer_switch = 'recursive_cte=on'")
+	require.Equal([]sql.Row{{int64(1)}, {int64(2)}, {int64(3)}}, mustQuery(recursive))
+
+	// Setting it to default restores the default of the engine
+	mustQuery("SET optimizer_switch = 'recursive_cte=default'")
+	_, err = query(recursive)
+	require.True(sql.ErrFeatureDisabled.Is(err))
+
+	// So does setting all of them to DEFAULT
+	mustQuery("SET optimizer_switch = 'recursive_cte=on'")
+	mustQuery(recursive)
+	mustQuery("SET optimizer_switch = DEFAULT")
+	_, err = query(recursive)
+	require.True(sql.ErrFeatureDisabled.Is(err))
+}
+
+func TestOptimizerSwitchPlans(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("mydb")
+	db.EnablePrimaryKeyIndexes()
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	ctx := enginetest.NewContext(enginetest.NewDefaultMemoryHarness())
+	query := func(q string) []sql.Row {
+		_, iter, err := e.Query(ctx, q)
+		require.NoError(err, q)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err, q)
+		return rows
+	}
+	plan := func(q string) string {
+		var sb strings.Builder
+		for _, row := range query("EXPLAIN " + q) {
+			sb.WriteString(row[0].(string))
+			sb.WriteString("\n")
+		}
+		return sb.String()
+	}
+
+	query("CREATE TABLE a (i BIGINT PRIMARY KEY, j BIGINT)")
+	query("CREATE TABLE b (k BIGINT PRIMARY KEY)")
+	query("INSERT INTO a VALUES (1, 1), (2, 2), (3, 3)")
+	query("INSERT INTO b VALUES (1), (3)")
+
+	const disjunction = "SELECT i FROM a WHERE i = 1 OR i = 3"
+	const semijoin = "SELECT i FROM a WHERE EXISTS (SELECT * FROM b WHERE b.k = a.j)"
+	const derived = "SELECT i FROM (SELECT i FROM a) d WHERE i > 1"
+
+	require.Contains(plan(disjunction), "IndexedTableAccess")
+	require.Contains(plan(semijoin), "decorrelated_subquery")
+	require.Regexp("(?s)SubqueryAlias.*Filter", plan(derived))
+
+	query("SET optimizer_switch = 'index_merge=off,semijoin=off,derived_condition_pushdown=off'")
+	require.NotContains(plan(disjunction), "IndexedTableAccess")
+	require.NotContains(plan(semijoin), "decorrelated_subquery")
+	require.NotRegexp("(?s)SubqueryAlias.*Filter", plan(derived))
+
+	// The plans still return the same rows
+	require.Equal([]sql.Row{{int64(1)}, {int64(3)}}, query(disjunction+" ORDER BY i"))
+	require.Equal([]sql.Row{{int64(1)}, {int64(3)}}, query(semijoin+" ORDER BY i"))
+	require.Equal([]sql.Row{{int64(2)}, {int64(3)}}, query(derived+" ORDER BY i"))
+}
+
+func TestRowSecurity(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("mydb")
+	db.EnablePrimaryKeyIndexes()
+	e := sqle.NewDefault(sql.NewDatabaseProvider(db))
+	e.Analyzer.Catalog.GrantTables.AddSuperUser("admin", "")
+	newCtx := func(user string, id uint32) *sql.Context {
+		sess := sql.NewBaseSessionWithClientServer("address", sql.Client{User: user, Address: "127.0.0.1:34567"}, id)
+		ctx := sql.NewContext(context.Background(), sql.WithSession(sess))
+		ctx.SetCurrentDatabase("mydb")
+		return ctx
+	}
+	query := func(ctx *sql.Context, q string) ([]sql.Row, error) {
+		_, iter, err := e.Query(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		return sql.RowIterToRows(ctx, iter)
+	}
+	mustQuery := func(ctx *sql.Context, q string) []sql.Row {
+		rows, err := query(ctx, q)
+		require.NoError(err, q)
+		return rows
+	}
+
+	admin := newCtx("admin", 1)
+	mustQuery(admin, "CREATE USER app")
+	mustQuery(admin, "GRANT ALL ON mydb.* TO app")
+	mustQuery(admin, "CREATE TABLE accounts (id BIGINT PRIMARY KEY, tenant VARCHAR(20) NOT NULL, balance BIGINT)")
+	mustQuery(admin, "INSERT INTO accounts VALUES (1, 'a', 10), (2, 'b', 20), (3, 'a', 30)")
+
+	require.NoError(e.Analyzer.Catalog.RowSecurity.Add(sql.RowSecurityPolicy{
+		Name:      "tenant_isolation",
+		Database:  "mydb",
+		Table:     "accounts",
+		Predicate: "tenant = @tenant",
+	}))
+	require.True(sql.ErrRowSecurityPolicyExists.Is(e.Analyzer.Catalog.RowSecurity.Add(sql.RowSecurityPolicy{
+		Name:      "TENANT_ISOLATION",
+		Database:  "mydb",
+		Table:     "accounts",
+		Predicate: "1 = 1",
+	})))
+
+	app := newCtx("app", 2)
+	mustQuery(app, "SET @tenant = 'a'")
+
+	require.Equal([]sql.Row{{int64(1), "a", int64(10)}, {int64(3), "a", int64(30)}},
+		mustQuery(app, "SELECT * FROM accounts ORDER BY id"))
+	require.Equal([]sql.Row{{int64(1)}}, mustQuery(app, "SELECT x.id FROM accounts x WHERE x.balance < 20"))
+	require.Equal([]sql.Row{{int64(2)}}, mustQuery(app, "SELECT COUNT(*) FROM (SELECT id FROM accounts) sq"))
+
+	// Writes only reach visible rows, and can't create rows the session couldn't read
+	mustQuery(app, "UPDATE accounts SET balance = balance + 1")
+	mustQuery(app, "DELETE FROM accounts WHERE balance > 30")
+	mustQuery(app, "INSERT INTO accounts VALUES (4, 'a', 40)")
+
+	_, err := query(app, "INSERT INTO accounts VALUES (5, 'b', 50)")
+	require.True(sql.ErrRowSecurityViolation.Is(err), "unexpected error %v", err)
+	_, err = query(app, "UPDATE accounts SET tenant = 'b' WHERE id = 1")
+	require.True(sql.ErrRowSecurityViolation.Is(err), "unexpected error %v", err)
+
+	// Sessions of users with the SUPER privilege bypass row security
+	require.Equal([]sql.Row{
+		{int64(1), "a", int64(11)},
+		{int64(2), "b", int64(20)},
+		{int64(4), "a", int64(40)},
+	}, mustQuery(admin, "SELECT * FROM accounts ORDER BY id"))
+
+	require.NoError(e.Analyzer.Catalog.RowSecurity.Drop("mydb", "accounts", "tenant_isolation"))
+	require.True(sql.ErrRowSecurityPolicyNotFound.Is(e.Analyzer.Catalog.RowSecurity.Drop("mydb", "accounts", "tenant_isolation")))
+	require.Len(mustQuery(app, "SELECT * FROM accounts"), 3)
+}
+
 // TODO: this was an analyzer test, but we don't have a mock process list for it to use, so it has to be here
 func TestTrackProcess(t *testing.T) {
 	require := require.New(t)
@@ -17,6 +17,7 @@ package enginetest
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"testing"
@@ -188,6 +189,90 @@ func TestQueryPlans(t *testing.T, harness Harness) {
 	}
 }
 
+// TestTPCHQueries runs the queries of the TPC-H benchmark on the tables of TPCHTables, and checks that they return as
+// many rows as expected, and the same rows whether the tables have secondary indexes or not.
+func TestTPCHQueries(t *testing.T, harness Harness) {
+	engine := NewGeneratedEngine(t, harness, TPCHTables, TPCHScaleFactor)
+	defer engine.Close()
+
+	db := harness.NewDatabase("mydb")
+	CreateGeneratedData(t, harness, db, TPCHTables, TPCHScaleFactor)
+	unindexed := NewEngineWithDbs(t, harness, []sql.Database{db})
+	defer unindexed.Close()
+
+	for _, tt := range TPCHQueries {
+		t.Run(tt.Name, func(t *testing.T) {
+			if sh, ok := harness.(SkippingHarness); ok {
+				if sh.SkipQueryTest(tt.Query) {
+					t.Skipf("Skipping query %s", tt.Name)
+				}
+			}
+
+			rows, err := tpchQueryRows(harness, engine, tt.Query)
+			if tt.Skip {
+				require.False(t, err == nil && len(rows) == tt.ExpectedRows, "%s is skipped, but it succeeds", tt.Name)
+				t.Skipf("Skipping query %s", tt.Name)
+			}
+			require.NoError(t, err)
+			require.Len(t, rows, tt.ExpectedRows)
+
+			expected, err := tpchQueryRows(harness, unindexed, tt.Query)
+			require.NoError(t, err)
+			// The queries either order their rows or return a single one
+			require.Equal(t, tpchResult(expected), tpchResult(rows))
+		})
+	}
+}
+
+// TestTPCHQueryPlans checks the plans of the queries of the TPC-H benchmark on the tables of TPCHTables with their
+// secondary indexes.
+func TestTPCHQueryPlans(t *testing.T, harness Harness) {
+	engine := NewGeneratedEngine(t, harness, TPCHTables, TPCHScaleFactor)
+	defer engine.Close()
+
+	for _, tt := range TPCHQueries {
+		if tt.Skip {
+			continue
+		}
+		t.Run(tt.Name, func(t *testing.T) {
+			TestQueryPlan(t, NewContextWithEngine(harness, engine), engine, harness, tt.Query, tt.ExpectedPlan)
+		})
+	}
+}
+
+// tpchQueryRows returns the rows of the query given, checking that it releases the memory it takes.
+func tpchQueryRows(harness Harness, e *sqle.Engine, query string) ([]sql.Row, error) {
+	ctx := NewContext(harness)
+	_, iter, err := e.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := sql.RowIterToRows(ctx, iter)
+	if err != nil {
+		return nil, err
+	}
+	if n := ctx.Memory.NumCaches(); n != 0 {
+		return nil, fmt.Errorf("%d caches weren't disposed of", n)
+	}
+	return rows, nil
+}
+
+// tpchResult returns the rows given as a string to compare them by. Decimals are compared by their values, and floats
+// are rounded, because their sums depend on the order of the rows summed.
+func tpchResult(rows []sql.Row) string {
+	rounded := make([]sql.Row, len(rows))
+	for i, row := range rows {
+		rounded[i] = make(sql.Row, len(row))
+		for j, v := range row {
+			if f, ok := v.(float64); ok {
+				v = strconv.FormatFloat(f, 'g', 10, 64)
+			}
+			rounded[i][j] = v
+		}
+	}
+	return fmt.Sprint(rounded)
+}
+
 // Tests a variety of queries against databases and tables provided by the given harness.
 func TestVersionedQueries(t *testing.T, harness Harness) {
 	if _, ok := harness.(VersionedDBHarness); !ok {
@@ -999,7 +1084,18 @@ func TestScripts(t *testing.T, harness Harness) {
 	}
 }
 
+func TestMysqldump(t *testing.T, harness Harness) {
+	for _, script := range MysqldumpScripts {
+		TestScript(t, harness, script)
+	}
+}
+
 func TestUsersAndPrivileges(t *testing.T, harness Harness) {
+	clientHarness, ok := harness.(ClientHarness)
+	if !ok {
+		t.Skip("Cannot run TestUsersAndPrivileges without a ClientHarness")
+	}
+
 	for _, script := range UserPrivTests {
 		t.Run(script.Name, func(t *testing.T) {
 			myDb := harness.NewDatabase("mydb")
@@ -1007,7 +1103,33 @@ func TestUsersAndPrivileges(t *testing.T, harness Harness) {
 			e := NewEngineWithDbs(t, harness, databases)
 			defer e.Close()
 			e.Analyzer.Catalog.GrantTables.AddRootAccount()
-			TestScriptWithEngine(t, e, harness, script)
+
+			// Each account runs its statements in its own session, the setup script as root
+			contexts := make(map[sql.Client]*sql.Context)
+			contextFor := func(user, host string) *sql.Context {
+				if user == "" {
+					user, host = "root", "localhost"
+				}
+				client := sql.Client{User: user, Address: host}
+				if _, ok := contexts[client]; !ok {
+					contexts[client] = NewContextWithClient(clientHarness, client)
+				}
+				return contexts[client]
+			}
+
+			for _, statement := range script.SetUpScript {
+				RunQueryWithContext(t, e, contextFor("", ""), statement)
+			}
+			for _, assertion := range script.Assertions {
+				ctx := contextFor(assertion.User, assertion.Host)
+				if assertion.ExpectedErr != nil {
+					t.Run(assertion.Query, func(t *testing.T) {
+						AssertErrWithCtx(t, e, ctx, assertion.Query, assertion.ExpectedErr)
+					})
+				} else {
+					TestQueryWithContext(t, ctx, e, assertion.Query, assertion.Expected, nil, nil)
+				}
+			}
 		})
 	}
 }
@@ -1641,11 +1763,54 @@ func TestRenameTable(t *testing.T, harness Harness) {
 	require.Error(err)
 	require.True(sql.ErrTableAlreadyExists.Is(err))
 
+	t.Run("swap", func(t *testing.T) {
+		TestQuery(t, harness, e, "SELECT COUNT(*) FROM niltable", []sql.Row{{6}}, nil, nil)
+		TestQuery(t, harness, e, "RENAME TABLE emptytable TO niltable, niltable TO emptytable", []sql.Row(nil), nil, nil)
+		TestQuery(t, harness, e, "SELECT COUNT(*) FROM niltable", []sql.Row{{0}}, nil, nil)
+		TestQuery(t, harness, e, "SELECT COUNT(*) FROM emptytable", []sql.Row{{6}}, nil, nil)
+		TestQuery(t, harness, e, "RENAME TABLE emptytable TO tmp, niltable TO emptytable, tmp TO niltable", []sql.Row(nil), nil, nil)
+		TestQuery(t, harness, e, "SELECT COUNT(*) FROM niltable", []sql.Row{{6}}, nil, nil)
+		TestQuery(t, harness, e, "SELECT COUNT(*) FROM emptytable", []sql.Row{{0}}, nil, nil)
+	})
+
+	t.Run("atomic", func(t *testing.T) {
+		AssertErr(t, e, harness, "RENAME TABLE newTableName TO t1, othertable2 TO niltable", sql.ErrTableAlreadyExists)
+		AssertErr(t, e, harness, "RENAME TABLE newTableName TO t1, t1 TO t2, t1 TO t3", sql.ErrTableNotFound)
+		AssertErr(t, e, harness, "RENAME TABLE newTableName TO t1, othertable2 TO t1", sql.ErrTableAlreadyExists)
+
+		_, ok, err = db.GetTableInsensitive(NewContext(harness), "newTableName")
+		require.NoError(err)
+		require.True(ok)
+		_, ok, err = db.GetTableInsensitive(NewContext(harness), "t1")
+		require.NoError(err)
+		require.False(ok)
+	})
+
+	t.Run("across databases", func(t *testing.T) {
+		foo, err := e.Analyzer.Catalog.Database("foo")
+		require.NoError(err)
+
+		TestQuery(t, harness, e, "RENAME TABLE newTableName TO foo.moved", []sql.Row(nil), nil, nil)
+
+		_, ok, err = db.GetTableInsensitive(NewContext(harness), "newTableName")
+		require.NoError(err)
+		require.False(ok)
+		_, ok, err = foo.GetTableInsensitive(NewContext(harness), "moved")
+		require.NoError(err)
+		require.True(ok)
+
+		TestQuery(t, harness, e, "SELECT COUNT(*) FROM foo.moved", []sql.Row{{3}}, nil, nil)
+		TestQuery(t, harness, e, "RENAME TABLE foo.moved TO mydb.newTableName", []sql.Row(nil), nil, nil)
+		TestQuery(t, harness, e, "SELECT COUNT(*) FROM newTableName", []sql.Row{{3}}, nil, nil)
+
+		AssertErr(t, e, harness, "RENAME TABLE newTableName TO mysql.newTableName", sql.ErrMoveTableNotSupported)
+		AssertErr(t, e, harness, "RENAME TABLE newTableName TO nodb.newTableName", sql.ErrDatabaseNotFound)
+	})
+
 	t.Run("no database selected", func(t *testing.T) {
 		ctx := NewContext(harness)
 		ctx.SetCurrentDatabase("")
 
-		t.Skip("broken")
 		TestQueryWithContext(t, ctx, e, "RENAME TABLE mydb.emptytable TO mydb.emptytable2", []sql.Row(nil), nil, nil)
 
 		_, ok, err = db.GetTableInsensitive(NewContext(harness), "emptytable")
@@ -1656,7 +1821,8 @@ func TestRenameTable(t *testing.T, harness Harness) {
 		require.NoError(err)
 		require.True(ok)
 
-		_, _, err = e.Query(NewContext(harness), "RENAME TABLE mydb.emptytable2 TO emptytable3")
+		ctx.SetCurrentDatabase("")
+		_, _, err = e.Query(ctx, "RENAME TABLE mydb.emptytable2 TO emptytable3")
 		require.Error(err)
 		require.True(sql.ErrNoDatabaseSelected.Is(err))
 	})
@@ -2232,6 +2398,194 @@ func TestDropDatabase(t *testing.T, harness Harness) {
 	})
 }
 
+// roundTripSchemas are table definitions using the clauses that SHOW CREATE TABLE must reproduce, beyond the ones used
+// by the tables of the test databases.
+var roundTripSchemas = []string{
+	"CREATE TABLE parent (id bigint PRIMARY KEY, a varchar(20) NOT NULL COMMENT 'it''s a', b int, KEY b (b), UNIQUE KEY ab (a, b))",
+	"CREATE TABLE child (id bigint PRIMARY KEY, pid bigint, b int, KEY zz (b), KEY aa (pid, b), CONSTRAINT fk_child_parent FOREIGN KEY (pid) REFERENCES parent (id) ON DELETE CASCADE, CONSTRAINT fk_child_b FOREIGN KEY (b) REFERENCES parent (b)) COMMENT 'child rows'",
+	"CREATE TABLE charsets (pk int PRIMARY KEY, s varchar(10) CHARACTER SET utf8mb4 COLLATE utf8mb4_0900_ai_ci, t text COLLATE utf8mb4_bin, b binary(4), c char(3) DEFAULT 'abc') DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_0900_ai_ci",
+	"CREATE TABLE options (pk int PRIMARY KEY AUTO_INCREMENT, d decimal(10,2) DEFAULT 1.50, e enum('X','y') NOT NULL, CONSTRAINT chk CHECK (d > 0)) AUTO_INCREMENT=10 COMMENT='options'",
+}
+
+// TestShowCreateTableRoundTrip recreates every table of the test databases, and the tables of roundTripSchemas, from
+// the output of SHOW CREATE TABLE, and checks that SHOW CREATE TABLE shows the same statement for the recreated tables.
+func TestShowCreateTableRoundTrip(t *testing.T, harness Harness) {
+	e := NewEngine(t, harness)
+	defer e.Close()
+
+	ctx := NewContext(harness)
+	RunQueryWithContext(t, e, ctx, "SET foreign_key_checks = 0")
+	RunQueryWithContext(t, e, ctx, "CREATE DATABASE roundtrip_schemas")
+	ctx.SetCurrentDatabase("roundtrip_schemas")
+	for _, schema := range roundTripSchemas {
+		RunQueryWithContext(t, e, ctx, schema)
+	}
+
+	showCreateTable := func(db, table string) string {
+		rows, err := sql.RowIterToRows(ctx, mustQuery(t, e, ctx, fmt.Sprintf("SHOW CREATE TABLE `%s`.`%s`", db, table)))
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		return rows[0][1].(string)
+	}
+
+	for _, db := range []string{"mydb", "foo", "roundtrip_schemas"} {
+		rows, err := sql.RowIterToRows(ctx, mustQuery(t, e, ctx, fmt.Sprintf(
+			"SELECT table_name FROM information_schema.tables WHERE table_schema = '%s' AND table_type = 'BASE TABLE' ORDER BY 1", db)))
+		require.NoError(t, err)
+
+		copyDb := "roundtrip_" + db
+		RunQueryWithContext(t, e, ctx, "CREATE DATABASE "+copyDb)
+		for _, row := range rows {
+			table := row[0].(string)
+			t.Run(db+"."+table, func(t *testing.T) {
+				create := showCreateTable(db, table)
+				ctx.SetCurrentDatabase(copyDb)
+				RunQueryWithContext(t, e, ctx, create)
+				require.Equal(t, create, showCreateTable(copyDb, table))
+			})
+		}
+	}
+}
+
+// TestLowerCaseTableNames runs scripts with each mode of the lower_case_table_names system variable, which is set for
+// the whole process, so these scripts can't be run with the other scripts.
+func TestLowerCaseTableNames(t *testing.T, harness Harness) {
+	scripts := map[int64]ScriptTest{
+		sql.LowerCaseTableNames_AsGiven: {
+			Name: "names stored as given",
+			SetUpScript: []string{
+				"CREATE TABLE Users (id BIGINT PRIMARY KEY)",
+				"CREATE TABLE users (id BIGINT PRIMARY KEY)",
+				"INSERT INTO Users VALUES (1)",
+				"INSERT INTO users VALUES (2)",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT id FROM Users",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "SELECT id FROM users",
+					Expected: []sql.Row{{2}},
+				},
+				{
+					Query:       "SELECT id FROM USERS",
+					ExpectedErr: sql.ErrTableNotFound,
+				},
+				{
+					Query:       "SELECT id FROM MYDB.Users",
+					ExpectedErr: sql.ErrDatabaseNotFound,
+				},
+				{
+					Query:    "SELECT table_name FROM INFORMATION_SCHEMA.TABLES WHERE table_schema = 'mydb' AND table_name LIKE 'users' ORDER BY 1",
+					Expected: []sql.Row{{"Users"}, {"users"}},
+				},
+			},
+		},
+		sql.LowerCaseTableNames_Lowered: {
+			Name: "names stored in lower case",
+			SetUpScript: []string{
+				"CREATE DATABASE MyShop",
+				"CREATE TABLE Users (id BIGINT PRIMARY KEY)",
+				"INSERT INTO USERS VALUES (1)",
+				"CREATE VIEW ActiveUsers AS SELECT * FROM Users",
+				"RENAME TABLE Users TO Customers",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT id FROM MYDB.CUSTOMERS",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "SELECT schema_name FROM information_schema.schemata WHERE schema_name LIKE 'myshop'",
+					Expected: []sql.Row{{"myshop"}},
+				},
+				{
+					Query:    "SELECT table_name, table_type FROM information_schema.tables WHERE table_schema = 'mydb' AND table_name <> 'myview' ORDER BY 1",
+					Expected: []sql.Row{{"activeusers", "VIEW"}, {"customers", "BASE TABLE"}},
+				},
+				{
+					Query:       "CREATE TABLE CUSTOMERS (id BIGINT PRIMARY KEY)",
+					ExpectedErr: sql.ErrTableAlreadyExists,
+				},
+			},
+		},
+		sql.LowerCaseTableNames_Insensitive: {
+			Name: "names stored as given and compared case-insensitively",
+			SetUpScript: []string{
+				"CREATE TABLE Users (id BIGINT PRIMARY KEY)",
+				"INSERT INTO USERS VALUES (1)",
+			},
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT uSeRs.id FROM users",
+					Expected: []sql.Row{{1}},
+				},
+				{
+					Query:    "SELECT table_name FROM information_schema.tables WHERE table_schema = 'mydb' AND table_name LIKE 'users'",
+					Expected: []sql.Row{{"Users"}},
+				},
+				{
+					Query:       "CREATE TABLE users (id BIGINT PRIMARY KEY)",
+					ExpectedErr: sql.ErrTableAlreadyExists,
+				},
+				{
+					Query:    "CREATE TABLE IF NOT EXISTS users (id BIGINT PRIMARY KEY)",
+					Expected: []sql.Row{},
+				},
+			},
+		},
+	}
+
+	defer func() {
+		require.NoError(t, sql.SystemVariables.AssignValues(map[string]interface{}{"lower_case_table_names": sql.LowerCaseTableNames_Insensitive}))
+	}()
+	for _, mode := range []int64{sql.LowerCaseTableNames_AsGiven, sql.LowerCaseTableNames_Lowered, sql.LowerCaseTableNames_Insensitive} {
+		require.NoError(t, sql.SystemVariables.AssignValues(map[string]interface{}{"lower_case_table_names": mode}))
+		TestScript(t, harness, scripts[mode])
+	}
+
+	// Databases and tables that integrators created with names in upper case are shown in lower case
+	require.NoError(t, sql.SystemVariables.AssignValues(map[string]interface{}{"lower_case_table_names": sql.LowerCaseTableNames_Lowered}))
+	t.Run("existing names shown in lower case", func(t *testing.T) {
+		db := harness.NewDatabase("Legacy")
+		_, err := harness.NewTable(db, "Orders", sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "id", Type: sql.Int64, Source: "Orders", PrimaryKey: true},
+		}))
+		require.NoError(t, err)
+		e := NewEngineWithDbs(t, harness, []sql.Database{db})
+		defer e.Close()
+		TestScriptWithEngine(t, e, harness, ScriptTest{
+			Name: "existing names shown in lower case",
+			Assertions: []ScriptTestAssertion{
+				{
+					Query:    "SELECT table_schema, table_name FROM information_schema.tables WHERE table_schema LIKE 'legacy'",
+					Expected: []sql.Row{{"legacy", "orders"}},
+				},
+				{
+					Query:    "SELECT table_schema, table_name, column_name FROM information_schema.columns WHERE table_schema LIKE 'legacy'",
+					Expected: []sql.Row{{"legacy", "orders", "id"}},
+				},
+				{
+					Query:    "SELECT schema_name FROM information_schema.schemata WHERE schema_name LIKE 'legacy'",
+					Expected: []sql.Row{{"legacy"}},
+				},
+				{
+					Query:    "SELECT id FROM legacy.orders",
+					Expected: []sql.Row{},
+				},
+			},
+		})
+	})
+}
+
+// mustQuery runs the query given, and returns its rows.
+func mustQuery(t *testing.T, e *sqle.Engine, ctx *sql.Context, query string) sql.RowIter {
+	_, iter, err := e.Query(ctx, query)
+	require.NoError(t, err)
+	return iter
+}
+
 func TestCreateForeignKeys(t *testing.T, harness Harness) {
 	require := require.New(t)
 
@@ -3002,6 +3356,65 @@ func TestWindowFunctions(t *testing.T, harness Harness) {
 	AssertErr(t, e, harness, "SELECT a, lag(a, -1) over (partition by c) FROM t1", window.ErrInvalidLagOffset)
 	AssertErr(t, e, harness, "SELECT a, lag(a, 's') over (partition by c) FROM t1", window.ErrInvalidLagOffset)
 
+	TestQuery(t, harness, e, `SELECT a, lead(a) over (partition by c order by a) FROM t1 order by a`, []sql.Row{
+		{0, 2},
+		{1, nil},
+		{2, 3},
+		{3, 4},
+		{4, 5},
+		{5, nil},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, lead(a, 2, -1) over (partition by c order by a) FROM t1 order by a`, []sql.Row{
+		{0, 3},
+		{1, -1},
+		{2, 4},
+		{3, 5},
+		{4, -1},
+		{5, -1},
+	}, nil, nil)
+
+	AssertErr(t, e, harness, "SELECT a, lead(a, -1) over (partition by c) FROM t1", window.ErrInvalidLeadOffset)
+
+	TestQuery(t, harness, e, `SELECT a, last_value(a) over (partition by c order by a) FROM t1 order by a`, []sql.Row{
+		{0, 0},
+		{1, 1},
+		{2, 2},
+		{3, 3},
+		{4, 4},
+		{5, 5},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, last_value(a) over (partition by c order by a rows between current row and unbounded following) FROM t1 order by a`, []sql.Row{
+		{0, 5},
+		{1, 1},
+		{2, 5},
+		{3, 5},
+		{4, 5},
+		{5, 5},
+	}, nil, nil)
+
+	TestQuery(t, harness, e, `SELECT a, nth_value(a, 2) over (partition by c order by a) FROM t1 order by a`, []sql.Row{
+		{0, nil},
+		{1, nil},
+		{2, 2},
+		{3, 2},
+		{4, 2},
+		{5, 2},
+	}, nil, nil)
+
+	// the default frame of each row ends with its last peer
+	TestQuery(t, harness, e, `SELECT a, nth_value(b, 2) over (partition by c order by b) FROM t1 order by a`, []sql.Row{
+		{0, 0},
+		{1, nil},
+		{2, 0},
+		{3, 0},
+		{4, 0},
+		{5, 0},
+	}, nil, nil)
+
+	AssertErr(t, e, harness, "SELECT a, nth_value(a, 0) over (partition by c) FROM t1", window.ErrInvalidNthValueRow)
+
 }
 
 func TestNaturalJoin(t *testing.T, harness Harness) {
@@ -3383,6 +3796,211 @@ func TestUse(t *testing.T, harness Harness) {
 	require.Equal("foo", ctx.GetCurrentDatabase())
 }
 
+func TestExecuteScript(t *testing.T, harness Harness) {
+	require := require.New(t)
+	e := NewEngine(t, harness)
+	defer e.Close()
+
+	ctx := NewContext(harness)
+	script := "-- a script with a stored procedure\n" +
+		"CREATE TABLE script_t (a int primary key);\n" +
+		"DELIMITER $$\n" +
+		"CREATE PROCEDURE script_p(x int)\n" +
+		"BEGIN\n" +
+		"  INSERT INTO script_t VALUES (x);\n" +
+		"  INSERT INTO script_t VALUES (x + 1);\n" +
+		"END$$\n" +
+		"DELIMITER ;\n" +
+		"CALL script_p(1); CALL script_p(10);\n"
+	require.NoError(e.ExecuteScript(ctx, script))
+	TestQueryWithContext(t, ctx, e, "SELECT * FROM script_t ORDER BY a", []sql.Row{{1}, {2}, {10}, {11}}, nil, nil)
+
+	// Execution stops at the first failing statement, which is reported with its line
+	err := e.ExecuteScript(ctx, "INSERT INTO script_t VALUES (20);\n\nINSERT INTO\n  missing VALUES (1);\nINSERT INTO script_t VALUES (21);")
+	require.Error(err)
+	require.True(sqle.ErrScriptStatement.Is(err))
+	require.True(sql.ErrTableNotFound.Is(err))
+	require.Equal("line 3: table not found: missing", err.Error())
+	TestQueryWithContext(t, ctx, e, "SELECT * FROM script_t WHERE a >= 20", []sql.Row{{20}}, nil, nil)
+
+	err = e.ExecuteScript(ctx, "SELECT 1;\nSELECT 'unterminated;")
+	require.True(parse.ErrUnterminatedScript.Is(err))
+}
+
+// schemaChangeRecorder is a sql.SchemaChangeListener that records the changes it's notified of, and fails the
+// statements that change the table named failTable.
+type schemaChangeRecorder struct {
+	before    [][]sql.SchemaChange
+	after     [][]sql.SchemaChange
+	errs      []error
+	failTable string
+}
+
+func (r *schemaChangeRecorder) BeforeSchemaChange(ctx *sql.Context, query string, changes []sql.SchemaChange) error {
+	r.before = append(r.before, append([]sql.SchemaChange(nil), changes...))
+	for _, change := range changes {
+		if change.Table == r.failTable {
+			return fmt.Errorf("cannot change %s", change.Table)
+		}
+	}
+	return nil
+}
+
+func (r *schemaChangeRecorder) AfterSchemaChange(ctx *sql.Context, query string, changes []sql.SchemaChange, err error) error {
+	r.after = append(r.after, changes)
+	r.errs = append(r.errs, err)
+	return nil
+}
+
+func TestSchemaChangeListener(t *testing.T, harness Harness) {
+	require := require.New(t)
+	e := NewEngine(t, harness)
+	defer e.Close()
+
+	recorder := &schemaChangeRecorder{failTable: "locked"}
+	e.SchemaChangeListener = recorder
+	ctx := NewContext(harness)
+
+	RunQueryWithContext(t, e, ctx, "CREATE TABLE t (a int primary key)")
+	RunQueryWithContext(t, e, ctx, "ALTER TABLE t ADD COLUMN b int, ADD INDEX b_idx (b)")
+	RunQueryWithContext(t, e, ctx, "RENAME TABLE t TO u")
+	RunQueryWithContext(t, e, ctx, "DROP TABLE u")
+	TestQueryWithContext(t, ctx, e, "SELECT * FROM mytable WHERE i = 1", []sql.Row{{1, "first row"}}, nil, nil)
+
+	schemaA := sql.Schema{{Name: "a", Type: sql.Int32, Source: "t", PrimaryKey: true}}
+	schemaAB := sql.Schema{
+		{Name: "a", Type: sql.Int32, Source: "t", PrimaryKey: true},
+		{Name: "b", Type: sql.Int32, Source: "t", Nullable: true},
+	}
+	expected := [][]sql.SchemaChange{
+		{{Kind: sql.SchemaChangeKind_CreateTable, Database: "mydb", Table: "t", NewDatabase: "mydb", NewTable: "t", NewSchema: schemaA}},
+		{{Kind: sql.SchemaChangeKind_AlterTable, Database: "mydb", Table: "t", NewDatabase: "mydb", NewTable: "t", OldSchema: schemaA, NewSchema: schemaAB}},
+		{{Kind: sql.SchemaChangeKind_RenameTable, Database: "mydb", Table: "t", NewDatabase: "mydb", NewTable: "u", OldSchema: schemaAB, NewSchema: schemaAB}},
+		{{Kind: sql.SchemaChangeKind_DropTable, Database: "mydb", Table: "u", OldSchema: schemaAB}},
+	}
+	require.Equal(expected, recorder.after)
+	require.Equal([]error{nil, nil, nil, nil}, recorder.errs)
+	require.Len(recorder.before, 4)
+	require.Nil(recorder.before[1][0].NewSchema)
+	require.Equal(schemaA, recorder.before[1][0].OldSchema)
+
+	// A failing statement is reported with its error
+	RunQueryWithContext(t, e, ctx, "CREATE TABLE v (a int primary key, index ka (a))")
+	_, _, err := e.Query(ctx, "ALTER TABLE v ADD INDEX ka (a)")
+	require.True(sql.ErrDuplicateKeyName.Is(err))
+	require.Len(recorder.after, 6)
+	require.Equal(err, recorder.errs[5])
+	require.Nil(recorder.after[5][0].NewSchema)
+
+	// An error from the listener aborts the statement before it's executed
+	_, _, err = e.Query(ctx, "CREATE TABLE locked (a int primary key)")
+	require.Error(err)
+	require.Len(recorder.before, 7)
+	require.Len(recorder.after, 6)
+	AssertErrWithCtx(t, e, ctx, "SELECT * FROM locked", sql.ErrTableNotFound)
+}
+
+func TestSchemaVersions(t *testing.T, harness Harness) {
+	require := require.New(t)
+	e := NewEngine(t, harness)
+	defer e.Close()
+
+	ctx := NewContext(harness)
+	catalog := e.Analyzer.Catalog
+
+	parsed, err := parse.Parse(ctx, "SELECT * FROM mytable WHERE i IN (SELECT i2 FROM othertable)")
+	require.NoError(err)
+	node, err := e.Analyzer.Analyze(ctx, parsed, nil)
+	require.NoError(err)
+	versions := catalog.SchemaVersions(ctx, node)
+	require.Len(versions, 2)
+	require.Equal(versions, catalog.SchemaVersions(ctx, parsed))
+	require.True(catalog.IsSchemaCurrent(versions))
+
+	// DDL on other tables leaves the versions unchanged
+	RunQueryWithContext(t, e, ctx, "CREATE TABLE t (a int primary key)")
+	RunQueryWithContext(t, e, ctx, "INSERT INTO mytable VALUES (10, 'tenth row')")
+	require.True(catalog.IsSchemaCurrent(versions))
+
+	version := catalog.SchemaVersion("mydb", "othertable")
+	RunQueryWithContext(t, e, ctx, "ALTER TABLE othertable ADD COLUMN c int")
+	require.NotEqual(version, catalog.SchemaVersion("MYDB", "OtherTable"))
+	require.False(catalog.IsSchemaCurrent(versions))
+
+	// Renames change the versions of both names
+	versions = catalog.SchemaVersions(ctx, node)
+	version = catalog.SchemaVersion("mydb", "u")
+	RunQueryWithContext(t, e, ctx, "RENAME TABLE t TO u")
+	require.NotEqual(version, catalog.SchemaVersion("mydb", "u"))
+	require.True(catalog.IsSchemaCurrent(versions))
+
+	// Dropping a database changes the versions of all its tables
+	RunQueryWithContext(t, e, ctx, "CREATE DATABASE versions_db")
+	RunQueryWithContext(t, e, ctx, "CREATE TABLE versions_db.t (a int primary key)")
+	version = catalog.SchemaVersion("versions_db", "t")
+	RunQueryWithContext(t, e, ctx, "DROP DATABASE versions_db")
+	require.NotEqual(version, catalog.SchemaVersion("versions_db", "t"))
+	require.True(catalog.IsSchemaCurrent(versions))
+}
+
+// stageRecordingProcessList is a process list that records the progress of the stages reported to it.
+type stageRecordingProcessList struct {
+	*sqle.ProcessList
+	stages []sql.Progress
+}
+
+func (pl *stageRecordingProcessList) UpdateStageProgress(pid uint64, stage string, done, total int64) {
+	pl.stages = append(pl.stages, sql.Progress{Name: stage, Done: done, Total: total})
+	pl.ProcessList.UpdateStageProgress(pid, stage, done, total)
+}
+
+func TestAlterTableProgress(t *testing.T, harness Harness) {
+	require := require.New(t)
+	e := NewEngine(t, harness)
+	defer e.Close()
+
+	altering := func(done int64) sql.Progress {
+		return sql.Progress{Name: sql.StageAlteringTable, Done: done, Total: 3}
+	}
+	for _, query := range []string{
+		"ALTER TABLE mytable ADD COLUMN c int NOT NULL DEFAULT 1",
+		"ALTER TABLE mytable MODIFY COLUMN c bigint",
+		"ALTER TABLE mytable DROP COLUMN c",
+	} {
+		t.Run(query, func(t *testing.T) {
+			pl := &stageRecordingProcessList{ProcessList: sqle.NewProcessList()}
+			ctx := NewContext(harness)
+			ctx.ApplyOpts(sql.WithProcessList(pl))
+			ctx, err := pl.AddProcess(ctx, query)
+			require.NoError(err)
+
+			RunQueryWithContext(t, e, ctx, query)
+			require.Equal([]sql.Progress{altering(0), altering(1), altering(2), altering(3)}, pl.stages)
+		})
+	}
+}
+
+func TestCreateIndexProgress(t *testing.T, harness Harness) {
+	require := require.New(t)
+	e := NewEngine(t, harness)
+	defer e.Close()
+
+	query := "CREATE INDEX idx_s ON mytable (s) ALGORITHM=INPLACE"
+	pl := &stageRecordingProcessList{ProcessList: sqle.NewProcessList()}
+	ctx := NewContext(harness)
+	ctx.ApplyOpts(sql.WithProcessList(pl))
+	ctx, err := pl.AddProcess(ctx, query)
+	require.NoError(err)
+
+	RunQueryWithContext(t, e, ctx, query)
+	require.NotEmpty(pl.stages)
+	for _, stage := range pl.stages {
+		require.Equal(sql.StageBuildingIndex, stage.Name)
+		require.Equal(int64(3), stage.Total)
+	}
+	require.Equal(int64(3), pl.stages[len(pl.stages)-1].Done)
+}
+
 func TestNoDatabaseSelected(t *testing.T, harness Harness) {
 	e := NewEngine(t, harness)
 	defer e.Close()
@@ -3395,6 +4013,69 @@ func TestNoDatabaseSelected(t *testing.T, harness Harness) {
 	AssertErrWithCtx(t, e, ctx, "show triggers", sql.ErrNoDatabaseSelected)
 }
 
+// TestInterleavedDDL tests queries that run while other sessions change the tables they use.
+func TestInterleavedDDL(t *testing.T, harness Harness) {
+	require := require.New(t)
+	e := NewEngine(t, harness)
+	defer e.Close()
+
+	// A query keeps reading the table it started with after another session drops it
+	ctx := NewContext(harness)
+	_, iter, err := e.Query(ctx, "SELECT i FROM mytable ORDER BY i")
+	require.NoError(err)
+	row, err := iter.Next(ctx)
+	require.NoError(err)
+	require.Equal(sql.NewRow(int64(1)), row)
+
+	RunQueryWithContext(t, e, NewContext(harness), "DROP TABLE mytable")
+
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Equal([]sql.Row{{int64(2)}, {int64(3)}}, rows)
+	AssertErrWithCtx(t, e, NewContext(harness), "SELECT i FROM mytable", sql.ErrTableNotFound)
+
+	// Queries listing tables skip those dropped while they run, rather than failing
+	done := make(chan struct{})
+	ddlErrs := make(chan error, 1)
+	go func() {
+		defer close(ddlErrs)
+		ctx := NewContext(harness)
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			for _, query := range []string{"CREATE TABLE interleaved (pk int primary key)", "DROP TABLE interleaved"} {
+				_, iter, err := e.Query(ctx, query)
+				if err == nil {
+					_, err = sql.RowIterToRows(ctx, iter)
+				}
+				if err != nil {
+					ddlErrs <- err
+					return
+				}
+			}
+		}
+	}()
+
+	for i := 0; i < 50; i++ {
+		for _, query := range []string{
+			"SELECT COUNT(*) FROM information_schema.tables",
+			"SELECT COUNT(*) FROM information_schema.table_constraints",
+			"SHOW TABLE STATUS",
+		} {
+			ctx := NewContext(harness)
+			_, iter, err := e.Query(ctx, query)
+			require.NoError(err, query)
+			_, err = sql.RowIterToRows(ctx, iter)
+			require.NoError(err, query)
+		}
+	}
+	close(done)
+	require.NoError(<-ddlErrs)
+}
+
 func TestSessionSelectLimit(t *testing.T, harness Harness) {
 	q := []QueryTest{
 		{
@@ -3859,6 +4540,139 @@ func NewColumnDefaultValue(expr sql.Expression, outType sql.Type, representsLite
 	return cdv
 }
 
+// TestIndexRangeLookups verifies that the lookups returned by an index for a set of ranges return exactly the rows of
+// the table contained within those ranges, and report those same ranges back.
+func TestIndexRangeLookups(t *testing.T, harness Harness) {
+	if ih, ok := harness.(IndexHarness); !ok || !ih.SupportsNativeIndexCreation() {
+		t.Skip("harness does not support native indexes")
+	}
+
+	e := NewEngine(t, harness)
+	defer e.Close()
+	ctx := NewContext(harness)
+
+	RunQuery(t, e, harness, "CREATE TABLE ranges (pk BIGINT PRIMARY KEY, x BIGINT, y BIGINT, INDEX xy (x, y))")
+	var values []string
+	pk := 0
+	for _, x := range []string{"0", "1", "2", "3", "4", "NULL"} {
+		for _, y := range []string{"0", "1", "2", "3", "4", "NULL"} {
+			values = append(values, fmt.Sprintf("(%d, %s, %s)", pk, x, y))
+			pk++
+		}
+	}
+	RunQuery(t, e, harness, "INSERT INTO ranges VALUES "+strings.Join(values, ", "))
+
+	db, err := e.Analyzer.Catalog.Database("mydb")
+	require.NoError(t, err)
+	table, ok, err := db.GetTableInsensitive(ctx, "ranges")
+	require.NoError(t, err)
+	require.True(t, ok)
+	indexedTable, ok := table.(sql.IndexedTable)
+	if !ok {
+		t.Skip("table does not implement sql.IndexedTable")
+	}
+	indexes, err := indexedTable.GetIndexes(ctx)
+	require.NoError(t, err)
+	var idx sql.Index
+	for _, index := range indexes {
+		if strings.ToLower(index.ID()) == "xy" {
+			idx = index
+		}
+	}
+	require.NotNil(t, idx)
+
+	var colIndexes []int
+	for _, expr := range idx.Expressions() {
+		colIndexes = append(colIndexes, table.Schema().IndexOf(expr[strings.LastIndex(expr, ".")+1:], table.Name()))
+	}
+	allRows := tableRows(t, ctx, table)
+	require.Len(t, allRows, pk)
+
+	typ := sql.Int64
+	all := sql.AllRangeColumnExpr(typ)
+	tests := []struct {
+		name   string
+		ranges sql.RangeCollection
+	}{
+		{"equality on all columns", sql.RangeCollection{
+			{sql.ClosedRangeColumnExpr(2, 2, typ), sql.ClosedRangeColumnExpr(3, 3, typ)},
+		}},
+		{"equality on prefix", sql.RangeCollection{
+			{sql.ClosedRangeColumnExpr(2, 2, typ), all},
+		}},
+		{"open range", sql.RangeCollection{
+			{sql.OpenRangeColumnExpr(1, 3, typ), all},
+		}},
+		{"closed range", sql.RangeCollection{
+			{sql.ClosedRangeColumnExpr(1, 3, typ), sql.ClosedRangeColumnExpr(0, 2, typ)},
+		}},
+		{"half-open ranges", sql.RangeCollection{
+			{sql.CustomRangeColumnExpr(1, 3, sql.Closed, sql.Open, typ), sql.CustomRangeColumnExpr(1, 3, sql.Open, sql.Closed, typ)},
+		}},
+		{"unbounded ranges", sql.RangeCollection{
+			{sql.LessThanRangeColumnExpr(2, typ), sql.GreaterOrEqualRangeColumnExpr(3, typ)},
+			{sql.GreaterThanRangeColumnExpr(3, typ), sql.LessOrEqualRangeColumnExpr(1, typ)},
+		}},
+		{"multiple ranges", sql.RangeCollection{
+			{sql.ClosedRangeColumnExpr(0, 0, typ), all},
+			{sql.ClosedRangeColumnExpr(2, 2, typ), sql.ClosedRangeColumnExpr(1, 1, typ)},
+			{sql.ClosedRangeColumnExpr(4, 4, typ), sql.OpenRangeColumnExpr(0, 4, typ)},
+		}},
+		{"NULL values", sql.RangeCollection{
+			{sql.ClosedRangeColumnExpr(nil, nil, typ), all},
+			{sql.ClosedRangeColumnExpr(1, 1, typ), sql.ClosedRangeColumnExpr(nil, nil, typ)},
+		}},
+		{"all values", sql.RangeCollection{
+			{all, all},
+		}},
+		{"no values", sql.RangeCollection{
+			{sql.EmptyRangeColumnExpr(typ), all},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lookup, err := idx.NewLookup(ctx, tt.ranges...)
+			require.NoError(t, err)
+			if lookup == nil {
+				t.Skip("index does not support these ranges")
+			}
+
+			expectedRanges, err := sql.SortRanges(tt.ranges...)
+			require.NoError(t, err)
+			actualRanges, err := sql.SortRanges(lookup.Ranges()...)
+			require.NoError(t, err)
+			ok, err := sql.RangeCollection(expectedRanges).Equals(actualRanges)
+			require.NoError(t, err)
+			assert.True(t, ok, "expected ranges %s, got %s", tt.ranges.DebugString(), lookup.Ranges().DebugString())
+
+			var expected []sql.Row
+			for _, row := range allRows {
+				key := make([]interface{}, len(colIndexes))
+				for i, colIdx := range colIndexes {
+					key[i] = row[colIdx]
+				}
+				ok, err := tt.ranges.Contains(key)
+				require.NoError(t, err)
+				if ok {
+					expected = append(expected, row)
+				}
+			}
+
+			actual := tableRows(t, ctx, indexedTable.WithIndexLookup(lookup))
+			assert.ElementsMatch(t, expected, actual)
+		})
+	}
+}
+
+func tableRows(t *testing.T, ctx *sql.Context, table sql.Table) []sql.Row {
+	partitions, err := table.Partitions(ctx)
+	require.NoError(t, err)
+	rows, err := sql.RowIterToRows(ctx, sql.NewTableRowIter(ctx, table, partitions))
+	require.NoError(t, err)
+	return rows
+}
+
 func TestColumnDefaults(t *testing.T, harness Harness) {
 	require := require.New(t)
 	e := NewEngine(t, harness)
@@ -4153,6 +4967,34 @@ func TestColumnDefaults(t *testing.T, harness Harness) {
 		TestQuery(t, harness, e, "SELECT * FROM t32", []sql.Row{{1, ""}, {2, ""}, {3, ""}}, nil, nil)
 	})
 
+	t.Run("Default expressions evaluated in column order", func(t *testing.T) {
+		TestQuery(t, harness, e, "CREATE TABLE t33(pk BIGINT PRIMARY KEY, v1 BIGINT DEFAULT (pk + 1), v2 BIGINT DEFAULT (v1 * 2), v3 BIGINT DEFAULT (v4 + 1), v4 BIGINT DEFAULT 5)", []sql.Row(nil), nil, nil)
+		RunQuery(t, e, harness, "INSERT INTO t33 (pk) VALUES (1)")
+		RunQuery(t, e, harness, "INSERT INTO t33 (v4, v1, pk) VALUES (10, 20, 2)")
+		RunQuery(t, e, harness, "INSERT INTO t33 VALUES (3, DEFAULT, DEFAULT, DEFAULT, DEFAULT)")
+		RunQuery(t, e, harness, "INSERT INTO t33 (pk, v4) VALUES (4, DEFAULT), (5, 6)")
+		TestQuery(t, harness, e, "SELECT * FROM t33 ORDER BY 1", []sql.Row{
+			{1, 2, 4, 6, 5},
+			{2, 20, 40, 11, 10},
+			{3, 4, 8, 6, 5},
+			{4, 5, 10, 6, 5},
+			{5, 6, 12, 7, 6},
+		}, nil, nil)
+	})
+
+	t.Run("DEFAULT function", func(t *testing.T) {
+		TestQuery(t, harness, e, "CREATE TABLE t34(pk BIGINT PRIMARY KEY, v1 BIGINT DEFAULT 5, v2 VARCHAR(10) DEFAULT 'abc', v3 BIGINT, v4 BIGINT DEFAULT (pk * 2))", []sql.Row(nil), nil, nil)
+		RunQuery(t, e, harness, "INSERT INTO t34 VALUES (1, 7, CONCAT(DEFAULT(v2), 'd'), DEFAULT(v3), DEFAULT)")
+		TestQuery(t, harness, e, "SELECT * FROM t34", []sql.Row{{1, 7, "abcd", nil, 2}}, nil, nil)
+		TestQuery(t, harness, e, "SELECT pk, DEFAULT(v1), DEFAULT(v2), DEFAULT(v3) FROM t34", []sql.Row{{1, 5, "abc", nil}}, nil, nil)
+
+		RunQuery(t, e, harness, "UPDATE t34 SET v1 = DEFAULT(v1), v2 = DEFAULT, pk = 3, v4 = DEFAULT")
+		TestQuery(t, harness, e, "SELECT * FROM t34", []sql.Row{{3, 5, "abc", nil, 6}}, nil, nil)
+
+		RunQuery(t, e, harness, "INSERT INTO t34 (pk, v1) VALUES (3, 0) ON DUPLICATE KEY UPDATE v1 = GREATEST(DEFAULT(v1), 8), v2 = CONCAT(DEFAULT(v2), 'e')")
+		TestQuery(t, harness, e, "SELECT * FROM t34", []sql.Row{{3, 8, "abce", nil, 6}}, nil, nil)
+	})
+
 	t.Run("Invalid literal for column type", func(t *testing.T) {
 		AssertErr(t, e, harness, "CREATE TABLE t999(pk BIGINT PRIMARY KEY, v1 INT UNSIGNED DEFAULT -1)", sql.ErrIncompatibleDefaultType)
 	})
@@ -4233,6 +5075,23 @@ func TestColumnDefaults(t *testing.T, harness Harness) {
 		TestQuery(t, harness, e, "CREATE TABLE t1007(pk BIGINT DEFAULT (v2) PRIMARY KEY, v1 BIGINT DEFAULT (pk), v2 BIGINT)", []sql.Row(nil), nil, nil)
 		AssertErr(t, e, harness, "ALTER TABLE t1007 MODIFY COLUMN v1 BIGINT DEFAULT (pk) FIRST", sql.ErrInvalidDefaultValueOrder)
 	})
+
+	t.Run("Reference to AUTO_INCREMENT column", func(t *testing.T) {
+		AssertErr(t, e, harness, "CREATE TABLE t999(pk BIGINT PRIMARY KEY AUTO_INCREMENT, v1 BIGINT DEFAULT (pk + 1))", sql.ErrInvalidDefaultValueAutoIncrement)
+		TestQuery(t, harness, e, "CREATE TABLE t1010(pk BIGINT PRIMARY KEY AUTO_INCREMENT, v1 BIGINT)", []sql.Row(nil), nil, nil)
+		AssertErr(t, e, harness, "ALTER TABLE t1010 ADD COLUMN v2 BIGINT DEFAULT (pk * 2)", sql.ErrInvalidDefaultValueAutoIncrement)
+		AssertErr(t, e, harness, "ALTER TABLE t1010 MODIFY COLUMN v1 BIGINT DEFAULT (pk)", sql.ErrInvalidDefaultValueAutoIncrement)
+	})
+
+	t.Run("DEFAULT function errors", func(t *testing.T) {
+		TestQuery(t, harness, e, "CREATE TABLE t1011(pk BIGINT PRIMARY KEY, v1 BIGINT NOT NULL, v2 BIGINT DEFAULT (pk + 1))", []sql.Row(nil), nil, nil)
+		AssertErr(t, e, harness, "SELECT DEFAULT(v1) FROM t1011", sql.ErrInsertIntoNonNullableDefaultNullColumn)
+		AssertErr(t, e, harness, "INSERT INTO t1011 VALUES (1, DEFAULT(v1), 2)", sql.ErrInsertIntoNonNullableDefaultNullColumn)
+		AssertErr(t, e, harness, "SELECT DEFAULT(v2) FROM t1011", sql.ErrDefaultFunctionExpressionDefault)
+		AssertErr(t, e, harness, "INSERT INTO t1011 VALUES (1, 1, DEFAULT(v2))", sql.ErrDefaultFunctionExpressionDefault)
+		AssertErr(t, e, harness, "UPDATE t1011 SET v1 = DEFAULT", sql.ErrInsertIntoNonNullableDefaultNullColumn)
+		AssertErr(t, e, harness, "SELECT DEFAULT(v3) FROM t1011", sql.ErrColumnNotFound)
+	})
 }
 
 func TestPersist(t *testing.T, harness Harness, newPersistableSess func(ctx *sql.Context) sql.PersistableSession) {
@@ -4311,6 +5170,16 @@ func NewContext(harness Harness) *sql.Context {
 	return ctx
 }
 
+// NewContextWithClient returns a new context for the client given, with a session of its own.
+func NewContextWithClient(harness ClientHarness, client sql.Client) *sql.Context {
+	ctx := harness.NewContextWithClient(client)
+	if ctx.GetCurrentDatabase() == "" {
+		ctx.SetCurrentDatabase("mydb")
+	}
+	ctx.ApplyOpts(sql.WithPid(atomic.AddUint64(&pid, 1)))
+	return ctx
+}
+
 func NewSession(harness Harness) *sql.Context {
 	th, ok := harness.(TransactionHarness)
 	if !ok {
@@ -4361,7 +5230,11 @@ func NewEngineWithDbs(t *testing.T, harness Harness, databases []sql.Database) *
 
 	var a *analyzer.Analyzer
 	if harness.Parallelism() > 1 {
-		a = analyzer.NewBuilder(provider).WithParallelism(harness.Parallelism()).Build()
+		builder := analyzer.NewBuilder(provider).WithParallelism(harness.Parallelism())
+		if dh, ok := harness.(DeterministicOrderHarness); ok && dh.DeterministicOrder() {
+			builder = builder.WithDeterministicOrder()
+		}
+		a = builder.Build()
 	} else {
 		a = analyzer.NewDefault(provider)
 	}
@@ -4567,3 +5440,21 @@ func widenJSONArray(narrow []interface{}) (wide []interface{}) {
 	}
 	return
 }
+
+func TestMultibyteScripts(t *testing.T, harness Harness) {
+	for _, script := range MultibyteScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestHandlerScripts(t *testing.T, harness Harness) {
+	for _, script := range HandlerScripts {
+		TestScript(t, harness, script)
+	}
+}
+
+func TestStatusVariableScripts(t *testing.T, harness Harness) {
+	for _, script := range StatusVariableScripts {
+		TestScript(t, harness, script)
+	}
+}
@@ -0,0 +1,455 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// generatedDataSeed is the seed of the random values of generated tables, which are the same every time.
+const generatedDataSeed = 1992
+
+// GeneratedTable is a table of synthetic rows, whose number grows with a scale factor, to test the engine with more
+// data than the canonical test tables have, such as in performance tests and evaluations of the quality of query
+// plans.
+type GeneratedTable struct {
+	// Name is the name of the table.
+	Name string
+	// Schema is the schema of the table.
+	Schema sql.PrimaryKeySchema
+	// Rows returns the number of rows of the table at the scale factor given.
+	Rows func(scaleFactor float64) int
+	// Row returns the row of the table at the index given, from zero to its number of rows, at the scale factor given.
+	// Its random values must come from the generator given, for the rows to be the same every time.
+	Row func(r *rand.Rand, scaleFactor float64, i int) sql.Row
+	// Indexes are the CREATE INDEX statements of the secondary indexes of the table.
+	Indexes []string
+}
+
+// CreateGeneratedData creates the tables given in the database given, with their rows at the scale factor given.
+func CreateGeneratedData(t *testing.T, harness Harness, db sql.Database, tables []GeneratedTable, scaleFactor float64) {
+	for _, gt := range tables {
+		wrapInTransaction(t, db, harness, func() {
+			table, err := harness.NewTable(db, gt.Name, gt.Schema)
+			require.NoError(t, err)
+
+			r := rand.New(rand.NewSource(generatedDataSeed))
+			numRows := gt.Rows(scaleFactor)
+			rows := make([]sql.Row, numRows)
+			for i := range rows {
+				rows[i] = gt.Row(r, scaleFactor, i)
+			}
+			InsertRows(t, NewContext(harness), mustInsertableTable(t, table), rows...)
+		})
+	}
+}
+
+// CreateGeneratedIndexes creates the secondary indexes of the tables given with the engine given, if the harness
+// supports native indexes.
+func CreateGeneratedIndexes(t *testing.T, harness Harness, e *sqle.Engine, tables []GeneratedTable) {
+	if ih, ok := harness.(IndexHarness); !ok || !ih.SupportsNativeIndexCreation() {
+		return
+	}
+	for _, gt := range tables {
+		for _, q := range gt.Indexes {
+			ctx := NewContext(harness)
+			_, iter, err := e.Query(ctx, q)
+			require.NoError(t, err)
+			_, err = sql.RowIterToRows(ctx, iter)
+			require.NoError(t, err)
+		}
+	}
+}
+
+// NewGeneratedEngine returns a new engine with a database named mydb with the tables given, their rows at the scale
+// factor given, and their secondary indexes.
+func NewGeneratedEngine(t *testing.T, harness Harness, tables []GeneratedTable, scaleFactor float64) *sqle.Engine {
+	db := harness.NewDatabase("mydb")
+	CreateGeneratedData(t, harness, db, tables, scaleFactor)
+	e := NewEngineWithDbs(t, harness, []sql.Database{db})
+	CreateGeneratedIndexes(t, harness, e, tables)
+	return e
+}
+
+// scaledRows returns the number of rows of a table with the number of rows given at scale factor one, at the scale
+// factor given. Every table has at least the minimum number of rows given.
+func scaledRows(rows int, min int, scaleFactor float64) int {
+	scaled := int(float64(rows) * scaleFactor)
+	if scaled < min {
+		return min
+	}
+	return scaled
+}
+
+var (
+	// tpchStartDate is the date of the first orders.
+	tpchStartDate = time.Date(1992, time.January, 1, 0, 0, 0, 0, time.UTC)
+	// tpchCurrentDate is the date orders are shipped, or not, as of.
+	tpchCurrentDate = time.Date(1995, time.June, 17, 0, 0, 0, 0, time.UTC)
+)
+
+const (
+	// tpchOrderDays is the number of days orders are placed in.
+	tpchOrderDays = 2405
+	// tpchSuppliersPerPart is the number of suppliers of every part.
+	tpchSuppliersPerPart = 4
+	// tpchLinesPerOrder is the number of line items of every order.
+	tpchLinesPerOrder = 4
+)
+
+var (
+	tpchRegions = []string{"AFRICA", "AMERICA", "ASIA", "EUROPE", "MIDDLE EAST"}
+	tpchNations = []struct {
+		name   string
+		region int
+	}{
+		{"ALGERIA", 0}, {"ARGENTINA", 1}, {"BRAZIL", 1}, {"CANADA", 1}, {"EGYPT", 4},
+		{"ETHIOPIA", 0}, {"FRANCE", 3}, {"GERMANY", 3}, {"INDIA", 2}, {"INDONESIA", 2},
+		{"IRAN", 4}, {"IRAQ", 4}, {"JAPAN", 2}, {"JORDAN", 4}, {"KENYA", 0},
+		{"MOROCCO", 0}, {"MOZAMBIQUE", 0}, {"PERU", 1}, {"CHINA", 2}, {"ROMANIA", 3},
+		{"SAUDI ARABIA", 4}, {"VIETNAM", 2}, {"RUSSIA", 3}, {"UNITED KINGDOM", 3}, {"UNITED STATES", 1},
+	}
+	tpchColors        = []string{"almond", "antique", "aquamarine", "azure", "beige", "bisque", "black", "blanched", "blue", "blush", "brown", "burlywood", "chartreuse", "chocolate", "coral", "cornflower", "cream", "cyan", "dark", "deep", "dim", "dodger", "drab", "firebrick", "forest", "frosted", "gainsboro", "ghost", "goldenrod", "green", "grey", "honeydew"}
+	tpchTypeSizes     = []string{"STANDARD", "SMALL", "MEDIUM", "LARGE", "ECONOMY", "PROMO"}
+	tpchTypeFinishes  = []string{"ANODIZED", "BURNISHED", "PLATED", "POLISHED", "BRUSHED"}
+	tpchTypeMaterials = []string{"TIN", "NICKEL", "BRASS", "STEEL", "COPPER"}
+	tpchContainerSize = []string{"SM", "LG", "MED", "JUMBO", "WRAP"}
+	tpchContainers    = []string{"CASE", "BOX", "BAG", "JAR", "PKG", "PACK", "CAN", "DRUM"}
+	tpchSegments      = []string{"AUTOMOBILE", "BUILDING", "FURNITURE", "MACHINERY", "HOUSEHOLD"}
+	tpchPriorities    = []string{"1-URGENT", "2-HIGH", "3-MEDIUM", "4-NOT SPECIFIED", "5-LOW"}
+	tpchInstructions  = []string{"DELIVER IN PERSON", "COLLECT COD", "NONE", "TAKE BACK RETURN"}
+	tpchModes         = []string{"REG AIR", "AIR", "RAIL", "SHIP", "TRUCK", "MAIL", "FOB"}
+	tpchWords         = []string{"furiously", "quickly", "carefully", "blithely", "slyly", "final", "regular", "express", "pending", "ironic", "special", "bold", "even", "silent", "unusual", "packages", "requests", "accounts", "deposits", "foxes", "ideas", "theodolites", "pinto", "beans", "instructions", "dependencies", "excuses", "platelets", "asymptotes", "courts", "dolphins", "sleep", "wake", "nag", "haggle", "use", "boost", "affix", "detect", "integrate", "cajole", "among", "across", "above", "along"}
+)
+
+// TPCHTables are the tables of a schema like that of the TPC-H benchmark, whose rows are generated like those of its
+// dbgen tool, although not identical to them. At scale factor one they have the same number of rows as the tables of
+// TPC-H, with orders of four line items and parts sold by four suppliers each, and at scale factor 0.01 the largest
+// one has 60000 rows.
+var TPCHTables = []GeneratedTable{
+	{
+		Name: "region",
+		Schema: sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "r_regionkey", Type: sql.Int64, Source: "region", PrimaryKey: true},
+			{Name: "r_name", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 25), Source: "region"},
+			{Name: "r_comment", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 152), Source: "region"},
+		}),
+		Rows: func(float64) int { return len(tpchRegions) },
+		Row: func(r *rand.Rand, sf float64, i int) sql.Row {
+			return sql.NewRow(int64(i), tpchRegions[i], tpchText(r, 31, 115))
+		},
+	},
+	{
+		Name: "nation",
+		Schema: sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "n_nationkey", Type: sql.Int64, Source: "nation", PrimaryKey: true},
+			{Name: "n_name", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 25), Source: "nation"},
+			{Name: "n_regionkey", Type: sql.Int64, Source: "nation"},
+			{Name: "n_comment", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 152), Source: "nation"},
+		}),
+		Rows: func(float64) int { return len(tpchNations) },
+		Row: func(r *rand.Rand, sf float64, i int) sql.Row {
+			return sql.NewRow(int64(i), tpchNations[i].name, int64(tpchNations[i].region), tpchText(r, 31, 114))
+		},
+		Indexes: []string{"CREATE INDEX n_regionkey ON nation (n_regionkey)"},
+	},
+	{
+		Name: "part",
+		Schema: sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "p_partkey", Type: sql.Int64, Source: "part", PrimaryKey: true},
+			{Name: "p_name", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 55), Source: "part"},
+			{Name: "p_mfgr", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 25), Source: "part"},
+			{Name: "p_brand", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 10), Source: "part"},
+			{Name: "p_type", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 25), Source: "part"},
+			{Name: "p_size", Type: sql.Int32, Source: "part"},
+			{Name: "p_container", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 10), Source: "part"},
+			{Name: "p_retailprice", Type: sql.MustCreateDecimalType(15, 2), Source: "part"},
+			{Name: "p_comment", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 23), Source: "part"},
+		}),
+		Rows: tpchParts,
+		Row: func(r *rand.Rand, sf float64, i int) sql.Row {
+			key := int64(i + 1)
+			names := make([]string, 5)
+			for j := range names {
+				names[j] = tpchColors[r.Intn(len(tpchColors))]
+			}
+			mfgr := r.Intn(5) + 1
+			return sql.NewRow(
+				key,
+				strings.Join(names, " "),
+				fmt.Sprintf("Manufacturer#%d", mfgr),
+				fmt.Sprintf("Brand#%d%d", mfgr, r.Intn(5)+1),
+				tpchTypeSizes[r.Intn(len(tpchTypeSizes))]+" "+tpchTypeFinishes[r.Intn(len(tpchTypeFinishes))]+" "+tpchTypeMaterials[r.Intn(len(tpchTypeMaterials))],
+				int32(r.Intn(50)+1),
+				tpchContainerSize[r.Intn(len(tpchContainerSize))]+" "+tpchContainers[r.Intn(len(tpchContainers))],
+				decimal.New(90000+(key/10)%20001+100*(key%1000), -2),
+				tpchText(r, 5, 22),
+			)
+		},
+	},
+	{
+		Name: "supplier",
+		Schema: sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "s_suppkey", Type: sql.Int64, Source: "supplier", PrimaryKey: true},
+			{Name: "s_name", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 25), Source: "supplier"},
+			{Name: "s_address", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 40), Source: "supplier"},
+			{Name: "s_nationkey", Type: sql.Int64, Source: "supplier"},
+			{Name: "s_phone", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 15), Source: "supplier"},
+			{Name: "s_acctbal", Type: sql.MustCreateDecimalType(15, 2), Source: "supplier"},
+			{Name: "s_comment", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 101), Source: "supplier"},
+		}),
+		Rows: tpchSuppliers,
+		Row: func(r *rand.Rand, sf float64, i int) sql.Row {
+			key := int64(i + 1)
+			nation := int64(r.Intn(len(tpchNations)))
+			return sql.NewRow(
+				key,
+				fmt.Sprintf("Supplier#%09d", key),
+				tpchAddress(r),
+				nation,
+				tpchPhone(r, nation),
+				tpchPrice(r, -99999, 999999),
+				tpchText(r, 25, 100),
+			)
+		},
+		Indexes: []string{"CREATE INDEX s_nationkey ON supplier (s_nationkey)"},
+	},
+	{
+		Name: "partsupp",
+		Schema: sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "ps_partkey", Type: sql.Int64, Source: "partsupp", PrimaryKey: true},
+			{Name: "ps_suppkey", Type: sql.Int64, Source: "partsupp", PrimaryKey: true},
+			{Name: "ps_availqty", Type: sql.Int32, Source: "partsupp"},
+			{Name: "ps_supplycost", Type: sql.MustCreateDecimalType(15, 2), Source: "partsupp"},
+			{Name: "ps_comment", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 199), Source: "partsupp"},
+		}),
+		Rows: func(sf float64) int { return tpchParts(sf) * tpchSuppliersPerPart },
+		Row: func(r *rand.Rand, sf float64, i int) sql.Row {
+			part := int64(i/tpchSuppliersPerPart + 1)
+			return sql.NewRow(
+				part,
+				tpchPartSupplier(sf, part, i%tpchSuppliersPerPart),
+				int32(r.Intn(9999)+1),
+				tpchPrice(r, 100, 100000),
+				tpchText(r, 49, 198),
+			)
+		},
+		Indexes: []string{"CREATE INDEX ps_suppkey ON partsupp (ps_suppkey)"},
+	},
+	{
+		Name: "customer",
+		Schema: sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "c_custkey", Type: sql.Int64, Source: "customer", PrimaryKey: true},
+			{Name: "c_name", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 25), Source: "customer"},
+			{Name: "c_address", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 40), Source: "customer"},
+			{Name: "c_nationkey", Type: sql.Int64, Source: "customer"},
+			{Name: "c_phone", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 15), Source: "customer"},
+			{Name: "c_acctbal", Type: sql.MustCreateDecimalType(15, 2), Source: "customer"},
+			{Name: "c_mktsegment", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 10), Source: "customer"},
+			{Name: "c_comment", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 117), Source: "customer"},
+		}),
+		Rows: tpchCustomers,
+		Row: func(r *rand.Rand, sf float64, i int) sql.Row {
+			key := int64(i + 1)
+			nation := int64(r.Intn(len(tpchNations)))
+			return sql.NewRow(
+				key,
+				fmt.Sprintf("Customer#%09d", key),
+				tpchAddress(r),
+				nation,
+				tpchPhone(r, nation),
+				tpchPrice(r, -99999, 999999),
+				tpchSegments[r.Intn(len(tpchSegments))],
+				tpchText(r, 29, 116),
+			)
+		},
+		Indexes: []string{"CREATE INDEX c_nationkey ON customer (c_nationkey)"},
+	},
+	{
+		Name: "orders",
+		Schema: sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "o_orderkey", Type: sql.Int64, Source: "orders", PrimaryKey: true},
+			{Name: "o_custkey", Type: sql.Int64, Source: "orders"},
+			{Name: "o_orderstatus", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 1), Source: "orders"},
+			{Name: "o_totalprice", Type: sql.MustCreateDecimalType(15, 2), Source: "orders"},
+			{Name: "o_orderdate", Type: sql.Date, Source: "orders"},
+			{Name: "o_orderpriority", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 15), Source: "orders"},
+			{Name: "o_clerk", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 15), Source: "orders"},
+			{Name: "o_shippriority", Type: sql.Int32, Source: "orders"},
+			{Name: "o_comment", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 79), Source: "orders"},
+		}),
+		Rows: tpchOrders,
+		Row: func(r *rand.Rand, sf float64, i int) sql.Row {
+			key := int64(i + 1)
+			date := tpchOrderDate(key)
+			status := "P"
+			if date.AddDate(0, 0, 121).Before(tpchCurrentDate) {
+				status = "F"
+			} else if date.After(tpchCurrentDate) {
+				status = "O"
+			}
+			return sql.NewRow(
+				key,
+				int64(r.Intn(tpchCustomers(sf))+1),
+				status,
+				tpchPrice(r, 100000, 50000000),
+				date,
+				tpchPriorities[r.Intn(len(tpchPriorities))],
+				fmt.Sprintf("Clerk#%09d", r.Intn(scaledRows(1000, 1, sf))+1),
+				int32(0),
+				tpchText(r, 19, 78),
+			)
+		},
+		Indexes: []string{
+			"CREATE INDEX o_custkey ON orders (o_custkey)",
+			"CREATE INDEX o_orderdate ON orders (o_orderdate)",
+		},
+	},
+	{
+		Name: "lineitem",
+		Schema: sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "l_orderkey", Type: sql.Int64, Source: "lineitem", PrimaryKey: true},
+			{Name: "l_partkey", Type: sql.Int64, Source: "lineitem"},
+			{Name: "l_suppkey", Type: sql.Int64, Source: "lineitem"},
+			{Name: "l_linenumber", Type: sql.Int32, Source: "lineitem", PrimaryKey: true},
+			{Name: "l_quantity", Type: sql.MustCreateDecimalType(15, 2), Source: "lineitem"},
+			{Name: "l_extendedprice", Type: sql.MustCreateDecimalType(15, 2), Source: "lineitem"},
+			{Name: "l_discount", Type: sql.MustCreateDecimalType(15, 2), Source: "lineitem"},
+			{Name: "l_tax", Type: sql.MustCreateDecimalType(15, 2), Source: "lineitem"},
+			{Name: "l_returnflag", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 1), Source: "lineitem"},
+			{Name: "l_linestatus", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 1), Source: "lineitem"},
+			{Name: "l_shipdate", Type: sql.Date, Source: "lineitem"},
+			{Name: "l_commitdate", Type: sql.Date, Source: "lineitem"},
+			{Name: "l_receiptdate", Type: sql.Date, Source: "lineitem"},
+			{Name: "l_shipinstruct", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 25), Source: "lineitem"},
+			{Name: "l_shipmode", Type: sql.MustCreateStringWithDefaults(sqltypes.Char, 10), Source: "lineitem"},
+			{Name: "l_comment", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 44), Source: "lineitem"},
+		}),
+		Rows: func(sf float64) int { return tpchOrders(sf) * tpchLinesPerOrder },
+		Row: func(r *rand.Rand, sf float64, i int) sql.Row {
+			order := int64(i/tpchLinesPerOrder + 1)
+			part := int64(r.Intn(tpchParts(sf)) + 1)
+			quantity := int64(r.Intn(50) + 1)
+			orderDate := tpchOrderDate(order)
+			shipDate := orderDate.AddDate(0, 0, r.Intn(121)+1)
+			receiptDate := shipDate.AddDate(0, 0, r.Intn(30)+1)
+			returnFlag := "N"
+			if !receiptDate.After(tpchCurrentDate) {
+				returnFlag = []string{"R", "A"}[r.Intn(2)]
+			}
+			lineStatus := "O"
+			if !shipDate.After(tpchCurrentDate) {
+				lineStatus = "F"
+			}
+			return sql.NewRow(
+				order,
+				part,
+				tpchPartSupplier(sf, part, r.Intn(tpchSuppliersPerPart)),
+				int32(i%tpchLinesPerOrder+1),
+				decimal.New(quantity, 0),
+				decimal.New(quantity*(90000+(part/10)%20001+100*(part%1000)), -2),
+				decimal.New(int64(r.Intn(11)), -2),
+				decimal.New(int64(r.Intn(9)), -2),
+				returnFlag,
+				lineStatus,
+				shipDate,
+				orderDate.AddDate(0, 0, r.Intn(61)+30),
+				receiptDate,
+				tpchInstructions[r.Intn(len(tpchInstructions))],
+				tpchModes[r.Intn(len(tpchModes))],
+				tpchText(r, 10, 43),
+			)
+		},
+		Indexes: []string{
+			"CREATE INDEX l_partkey_suppkey ON lineitem (l_partkey, l_suppkey)",
+			"CREATE INDEX l_suppkey ON lineitem (l_suppkey)",
+			"CREATE INDEX l_shipdate ON lineitem (l_shipdate)",
+		},
+	},
+}
+
+// tpchParts, tpchSuppliers, tpchCustomers and tpchOrders return the number of rows of their tables at the scale factor
+// given.
+func tpchParts(sf float64) int {
+	return scaledRows(200000, 1, sf)
+}
+
+func tpchSuppliers(sf float64) int {
+	return scaledRows(10000, tpchSuppliersPerPart, sf)
+}
+
+func tpchCustomers(sf float64) int {
+	return scaledRows(150000, 1, sf)
+}
+
+func tpchOrders(sf float64) int {
+	return scaledRows(1500000, 1, sf)
+}
+
+// tpchPartSupplier returns the key of one of the suppliers of the part given, which are the suppliers with the keys
+// following that of the part.
+func tpchPartSupplier(sf float64, part int64, i int) int64 {
+	return (part+int64(i))%int64(tpchSuppliers(sf)) + 1
+}
+
+// tpchOrderDate returns the date of the order given, which depends only on its key so that its line items are shipped
+// after it.
+func tpchOrderDate(order int64) time.Time {
+	return tpchStartDate.AddDate(0, 0, int(order*7919%tpchOrderDays))
+}
+
+// tpchPrice returns a random price between the numbers of cents given.
+func tpchPrice(r *rand.Rand, min, max int64) decimal.Decimal {
+	return decimal.New(min+r.Int63n(max-min+1), -2)
+}
+
+// tpchPhone returns a random phone number in the nation given.
+func tpchPhone(r *rand.Rand, nation int64) string {
+	return fmt.Sprintf("%02d-%03d-%03d-%04d", nation+10, r.Intn(900)+100, r.Intn(900)+100, r.Intn(9000)+1000)
+}
+
+// tpchAddress returns a random address.
+func tpchAddress(r *rand.Rand) string {
+	const chars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789 ,"
+	b := make([]byte, r.Intn(31)+10)
+	for i := range b {
+		b[i] = chars[r.Intn(len(chars))]
+	}
+	return string(b)
+}
+
+// tpchText returns random words with a length between the ones given.
+func tpchText(r *rand.Rand, min, max int) string {
+	length := min + r.Intn(max-min+1)
+	var sb strings.Builder
+	for sb.Len() < length {
+		if sb.Len() > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(tpchWords[r.Intn(len(tpchWords))])
+	}
+	return sb.String()[:length]
+}
@@ -0,0 +1,112 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import "github.com/dolthub/go-mysql-server/sql"
+
+// HandlerScripts test the HANDLER statements, which read the rows of a table through a cursor of the session, in the
+// natural order of the table or in the order of one of its indexes. They need tables with primary key indexes.
+var HandlerScripts = []ScriptTest{
+	{
+		Name: "HANDLER reads rows in natural and index order",
+		SetUpScript: []string{
+			"CREATE TABLE t (id BIGINT PRIMARY KEY, v BIGINT, INDEX idx_v (v))",
+			// Rows are inserted one at a time so that the natural order of the table is the order they're inserted in
+			"INSERT INTO t VALUES (1, 30)",
+			"INSERT INTO t VALUES (2, 10)",
+			"INSERT INTO t VALUES (3, 20)",
+			"INSERT INTO t VALUES (4, 20)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "HANDLER h READ FIRST",
+				ExpectedErr: sql.ErrUnknownTableHandler,
+			},
+			{
+				Query:    "HANDLER t OPEN AS h",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:       "HANDLER t OPEN AS h",
+				ExpectedErr: sql.ErrDuplicateAliasOrTable,
+			},
+			{
+				Query:    "HANDLER h READ `PRIMARY` FIRST",
+				Expected: []sql.Row{{int64(1), int64(30)}},
+			},
+			{
+				Query:    "HANDLER h READ `PRIMARY` NEXT LIMIT 2",
+				Expected: []sql.Row{{int64(2), int64(10)}, {int64(3), int64(20)}},
+			},
+			{
+				Query:    "HANDLER h READ `PRIMARY` PREV",
+				Expected: []sql.Row{{int64(2), int64(10)}},
+			},
+			{
+				Query:    "HANDLER h READ `PRIMARY` LAST",
+				Expected: []sql.Row{{int64(4), int64(20)}},
+			},
+			{
+				Query:    "HANDLER h READ `PRIMARY` NEXT",
+				Expected: []sql.Row{},
+			},
+			{
+				// Rows with equal keys are read in the order of their values
+				Query:    "HANDLER h READ idx_v = (20) LIMIT 5",
+				Expected: []sql.Row{{int64(3), int64(20)}, {int64(4), int64(20)}},
+			},
+			{
+				Query:    "HANDLER h READ idx_v NEXT",
+				Expected: []sql.Row{{int64(1), int64(30)}},
+			},
+			{
+				Query:    "HANDLER h READ idx_v <= (20) LIMIT 2",
+				Expected: []sql.Row{{int64(4), int64(20)}, {int64(3), int64(20)}},
+			},
+			{
+				Query:    "HANDLER h READ idx_v PREV",
+				Expected: []sql.Row{{int64(2), int64(10)}},
+			},
+			{
+				Query:    "HANDLER h READ idx_v > (20)",
+				Expected: []sql.Row{{int64(1), int64(30)}},
+			},
+			{
+				Query:    "HANDLER h READ FIRST WHERE v = 20 LIMIT 10",
+				Expected: []sql.Row{{int64(3), int64(20)}, {int64(4), int64(20)}},
+			},
+			{
+				Query:    "HANDLER h READ FIRST",
+				Expected: []sql.Row{{int64(1), int64(30)}},
+			},
+			{
+				Query:    "HANDLER h READ NEXT",
+				Expected: []sql.Row{{int64(2), int64(10)}},
+			},
+			{
+				Query:       "HANDLER h READ missing FIRST",
+				ExpectedErr: sql.ErrIndexNotFound,
+			},
+			{
+				Query:    "HANDLER h CLOSE",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:       "HANDLER h CLOSE",
+				ExpectedErr: sql.ErrUnknownTableHandler,
+			},
+		},
+	},
+}
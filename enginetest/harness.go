@@ -70,6 +70,16 @@ type ForeignKeyHarness interface {
 	SupportsForeignKeys() bool
 }
 
+// DeterministicOrderHarness is an extension to Harness that lets an integrator run parallel queries with their rows
+// returned in the same order as serial queries, so that the results of queries without an ORDER BY are reliable to
+// compare. The tables of the harness must return their partitions in a stable order.
+type DeterministicOrderHarness interface {
+	Harness
+	// DeterministicOrder returns whether the engines of this harness should return the rows of parallel queries in a
+	// deterministic order.
+	DeterministicOrder() bool
+}
+
 // VersionedDBHarness is an extension to Harness that lets an integrator test their implementation of versioned (AS OF)
 // queries. Integrators must implement sql.VersionedDatabase. For each table version being created, there will be a
 // call to NewTableAsOf, some number of Delete and Insert operations, and then a call to SnapshotTable.
@@ -99,6 +109,14 @@ type TransactionHarness interface {
 	NewSession() *sql.Context
 }
 
+// ClientHarness is an extension to Harness that lets the privilege tests run statements as the accounts they test.
+type ClientHarness interface {
+	Harness
+	// NewContextWithClient returns a context with a new Session for the client given, rather than reusing an existing
+	// session from previous calls to NewContext()
+	NewContextWithClient(client sql.Client) *sql.Context
+}
+
 type ReadOnlyDatabaseHarness interface {
 	Harness
 
@@ -1211,7 +1211,7 @@ var InsertIgnoreScripts = []ScriptTest{
 				Expected: []sql.Row{
 					{sql.OkResult{RowsAffected: 1}},
 				},
-				ExpectedWarning: mysql.ERUnknownError,
+				ExpectedWarning: mysql.ERDataTooLong,
 			},
 			{
 				Query: "SELECT * FROM t2",
@@ -1221,6 +1221,49 @@ var InsertIgnoreScripts = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "String lengths are counted in characters for multibyte strings",
+		SetUpScript: []string{
+			"CREATE TABLE t1 (pk int primary key, v1 varchar(2), v2 char(3))",
+			"CREATE TABLE t2 (pk int primary key, v1 varbinary(4))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "INSERT INTO t1 VALUES (1, '日本', 'äöü')",
+				Expected: []sql.Row{
+					{sql.OkResult{RowsAffected: 1}},
+				},
+			},
+			{
+				Query:       "INSERT INTO t1 VALUES (2, '日本語', 'ä')",
+				ExpectedErr: sql.ErrLengthBeyondLimit,
+			},
+			{
+				Query: "INSERT IGNORE INTO t1 VALUES (2, '日本語', 'äöüß')",
+				Expected: []sql.Row{
+					{sql.OkResult{RowsAffected: 1}},
+				},
+				ExpectedWarning: mysql.ERDataTooLong,
+			},
+			{
+				Query: "SELECT pk, v1, v2, LENGTH(v1), CHAR_LENGTH(v1), LEFT(v2, 2), SUBSTRING(v2, 2) FROM t1 ORDER BY pk",
+				Expected: []sql.Row{
+					{1, "日本", "äöü", int32(6), int32(2), "äö", "öü"},
+					{2, "日本", "äöü", int32(6), int32(2), "äö", "öü"},
+				},
+			},
+			{
+				Query:       "INSERT INTO t2 VALUES (1, 'äöü')",
+				ExpectedErr: sql.ErrLengthBeyondLimit,
+			},
+			{
+				Query: "INSERT INTO t2 VALUES (1, 'äö')",
+				Expected: []sql.Row{
+					{sql.OkResult{RowsAffected: 1}},
+				},
+			},
+		},
+	},
 	{
 		Name: "Insert Ignore works correctly with ON DUPLICATE UPDATE",
 		SetUpScript: []string{
@@ -1247,7 +1290,7 @@ var InsertIgnoreScripts = []ScriptTest{
 				Expected: []sql.Row{
 					{sql.OkResult{RowsAffected: 1}},
 				},
-				ExpectedWarning: mysql.ERUnknownError,
+				ExpectedWarning: mysql.ERDataTooLong,
 			},
 			{
 				Query: "SELECT * FROM t2",
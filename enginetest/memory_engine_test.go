@@ -110,6 +110,110 @@ func TestSpatialQueries(t *testing.T) {
 	}
 }
 
+// TestQueriesDeterministicOrder checks that parallel queries return their rows in the same order as serial queries
+// when the harness asks for a deterministic order, even without an ORDER BY.
+func TestQueriesDeterministicOrder(t *testing.T) {
+	serial := enginetest.NewMemoryHarness("serial", 1, testNumPartitions, true, nil)
+	parallel := enginetest.NewMemoryHarness("deterministic", 2, testNumPartitions, true, nil)
+	parallel.UseDeterministicOrder()
+	// Both engines read the same tables, since the partitions rows are inserted into vary between tables
+	dbs := enginetest.CreateTestData(t, serial)
+	serialEngine := enginetest.NewEngineWithDbs(t, serial, dbs)
+	parallelEngine := enginetest.NewEngineWithDbs(t, parallel, dbs)
+
+	for _, q := range []string{
+		"SELECT * FROM mytable",
+		"SELECT i, s FROM mytable WHERE i > 1",
+		"SELECT a.i, b.s2 FROM mytable a JOIN othertable b ON a.i = b.i2",
+		"SELECT i FROM mytable UNION ALL SELECT i2 FROM othertable",
+	} {
+		t.Run(q, func(t *testing.T) {
+			expected := queryRows(t, serial, serialEngine, q)
+			for i := 0; i < 10; i++ {
+				require.Equal(t, expected, queryRows(t, parallel, parallelEngine, q))
+			}
+		})
+	}
+}
+
+// TestGeneratedData checks that the rows of generated tables scale with their scale factor, are the same every time,
+// and reference each other.
+func TestGeneratedData(t *testing.T) {
+	harness := enginetest.NewMemoryHarness("generated", 1, testNumPartitions, true, nil)
+	e := enginetest.NewGeneratedEngine(t, harness, enginetest.TPCHTables, 0.001)
+
+	for _, tt := range []struct {
+		query    string
+		expected []sql.Row
+	}{
+		{
+			query: `SELECT (SELECT COUNT(*) FROM region), (SELECT COUNT(*) FROM nation), (SELECT COUNT(*) FROM part),
+				(SELECT COUNT(*) FROM supplier), (SELECT COUNT(*) FROM partsupp), (SELECT COUNT(*) FROM customer),
+				(SELECT COUNT(*) FROM orders), (SELECT COUNT(*) FROM lineitem)`,
+			expected: []sql.Row{{5, 25, 200, 10, 800, 150, 1500, 6000}},
+		},
+		{
+			query:    "SELECT COUNT(*) FROM lineitem JOIN partsupp ON l_partkey = ps_partkey AND l_suppkey = ps_suppkey JOIN orders ON l_orderkey = o_orderkey",
+			expected: []sql.Row{{6000}},
+		},
+		{
+			query:    "SELECT COUNT(*) FROM orders JOIN customer ON o_custkey = c_custkey JOIN nation ON c_nationkey = n_nationkey JOIN region ON n_regionkey = r_regionkey",
+			expected: []sql.Row{{1500}},
+		},
+		{
+			query:    "SELECT COUNT(*) FROM lineitem JOIN orders ON l_orderkey = o_orderkey WHERE l_shipdate <= o_orderdate OR l_receiptdate <= l_shipdate",
+			expected: []sql.Row{{0}},
+		},
+	} {
+		t.Run(tt.query, func(t *testing.T) {
+			enginetest.TestQuery(t, harness, e, tt.query, tt.expected, nil, nil)
+		})
+	}
+
+	// Generating the tables again returns the same rows. Decimals are compared by their values.
+	again := enginetest.NewGeneratedEngine(t, harness, enginetest.TPCHTables, 0.001)
+	for _, q := range []string{
+		"SELECT * FROM part ORDER BY p_partkey",
+		"SELECT * FROM orders ORDER BY o_orderkey",
+		"SELECT * FROM lineitem ORDER BY l_orderkey, l_linenumber",
+	} {
+		require.True(t, fmt.Sprint(queryRows(t, harness, e, q)) == fmt.Sprint(queryRows(t, harness, again, q)), q)
+	}
+}
+
+func TestTPCHQueries(t *testing.T) {
+	for _, parallelism := range parallelVals {
+		testName := fmt.Sprintf("parallelism=%v", parallelism)
+		harness := enginetest.NewMemoryHarness(testName, parallelism, testNumPartitions, true, nil)
+		if parallelism > 1 {
+			// Sums of decimals are floats, which depend on the order of the rows summed, and the partitions of parallel
+			// queries are summed in any order, so the revenues of q15 aren't always equal to their maximum
+			for _, tt := range enginetest.TPCHQueries {
+				if tt.Name == "q15" {
+					harness.QueriesToSkip(tt.Query)
+				}
+			}
+		}
+		t.Run(testName, func(t *testing.T) {
+			enginetest.TestTPCHQueries(t, harness)
+		})
+	}
+}
+
+func TestTPCHQueryPlans(t *testing.T) {
+	enginetest.TestTPCHQueryPlans(t, enginetest.NewMemoryHarness("tpch", 1, testNumPartitions, true, nil))
+}
+
+// queryRows returns the rows the query given returns on the engine given.
+func queryRows(t *testing.T, harness enginetest.Harness, e *sqle.Engine, q string) []sql.Row {
+	ctx := enginetest.NewContext(harness)
+	_, iter, err := e.Query(ctx, q)
+	require.NoError(t, err)
+	rows, err := sql.RowIterToRows(ctx, iter)
+	require.NoError(t, err)
+	return rows
+}
+
 // TestQueriesSimple runs the canonical test queries against a single threaded index enabled harness.
 func TestQueriesSimple(t *testing.T) {
 	enginetest.TestQueries(t, enginetest.NewMemoryHarness("simple", 1, testNumPartitions, true, nil))
@@ -330,6 +434,30 @@ func TestQueryErrors(t *testing.T) {
 	enginetest.TestQueryErrors(t, enginetest.NewDefaultMemoryHarness())
 }
 
+func TestServerQueries(t *testing.T) {
+	harness := enginetest.NewMemoryHarness("default", 1, testNumPartitions, true, nil)
+	harness.QueriesToSkip(
+		// The user of the connection isn't the user of the harness context
+		"SELECT USER()",
+		"SELECT CURRENT_USER()",
+		"SELECT CURRENT_USER",
+		"SELECT CURRENT_user",
+		// TODO: the columns of these derived tables take the types of the first row, which the values of other rows
+		//  can't be sent as
+		`SELECT * FROM (values row(1+1,2+2), row(floor(1.5),concat("a","b"))) a order by 1`,
+		`SELECT * FROM (values row(1+1,2+2), row(floor(1.5),concat("a","b"))) a (c,d) order by 1`,
+		`SELECT column_0 FROM (values row(1+1,2+2), row(floor(1.5),concat("a","b"))) a order by 1`,
+		`SELECT a.column_0, b.column_1 FROM (values row(1+1,2+2), row(floor(1.5),concat("a","b"))) a
+			join (values row(2,4), row(1.0,"ab")) b on a.column_0 = b.column_0 and a.column_0 = b.column_0
+			order by 1`,
+	)
+	enginetest.TestServerQueries(t, harness)
+}
+
+func TestServerQueryErrors(t *testing.T) {
+	enginetest.TestServerQueryErrors(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestInfoSchema(t *testing.T) {
 	enginetest.TestInfoSchema(t, enginetest.NewMemoryHarness("default", 1, testNumPartitions, true, mergableIndexDriver))
 }
@@ -432,7 +560,7 @@ func TestUsersAndPrivileges(t *testing.T) {
 	enginetest.TestUsersAndPrivileges(t, enginetest.NewMemoryHarness("default", 1, testNumPartitions, true, mergableIndexDriver))
 
 	// Grab a free port
-	ctx := sql.NewEmptyContext()
+	ctx := enginetest.NewContextWithClient(enginetest.NewDefaultMemoryHarness(), sql.Client{User: "root", Address: "localhost"})
 	listener, err := net.Listen("tcp", ":0")
 	require.NoError(t, err)
 	port := listener.Addr().(*net.TCPAddr).Port
@@ -529,6 +657,10 @@ func TestBrokenTriggers(t *testing.T) {
 	}
 }
 
+func TestMysqldump(t *testing.T) {
+	enginetest.TestMysqldump(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestStoredProcedures(t *testing.T) {
 	enginetest.TestStoredProcedures(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -645,6 +777,10 @@ func TestInnerNestedInNaturalJoins(t *testing.T) {
 	enginetest.TestInnerNestedInNaturalJoins(t, enginetest.NewDefaultMemoryHarness())
 }
 
+func TestIndexRangeLookups(t *testing.T) {
+	enginetest.TestIndexRangeLookups(t, enginetest.NewDefaultMemoryHarness())
+}
+
 func TestColumnDefaults(t *testing.T) {
 	enginetest.TestColumnDefaults(t, enginetest.NewDefaultMemoryHarness())
 }
@@ -754,3 +890,15 @@ func findTable(dbs []sql.Database, tableName string) (sql.Database, sql.Table) {
 	}
 	return nil, nil
 }
+
+func TestMultibyteScripts(t *testing.T) {
+	enginetest.TestMultibyteScripts(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestHandlerScripts(t *testing.T) {
+	enginetest.TestHandlerScripts(t, enginetest.NewDefaultMemoryHarness())
+}
+
+func TestStatusVariableScripts(t *testing.T) {
+	enginetest.TestStatusVariableScripts(t, enginetest.NewDefaultMemoryHarness())
+}
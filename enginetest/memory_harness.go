@@ -31,6 +31,7 @@ type MemoryHarness struct {
 	indexDriverInitializer IndexDriverInitalizer
 	driver                 sql.IndexDriver
 	nativeIndexSupport     bool
+	deterministicOrder     bool
 	skippedQueries         map[string]struct{}
 	session                sql.Session
 }
@@ -86,6 +87,7 @@ var _ VersionedDBHarness = (*MemoryHarness)(nil)
 var _ ForeignKeyHarness = (*MemoryHarness)(nil)
 var _ KeylessTableHarness = (*MemoryHarness)(nil)
 var _ ReadOnlyDatabaseHarness = (*MemoryHarness)(nil)
+var _ DeterministicOrderHarness = (*MemoryHarness)(nil)
 var _ SkippingHarness = (*SkippingMemoryHarness)(nil)
 
 type SkippingMemoryHarness struct {
@@ -112,6 +114,17 @@ func (m *MemoryHarness) Parallelism() int {
 	return m.parallelism
 }
 
+// UseDeterministicOrder makes the engines of this harness return the rows of parallel queries in the same order as
+// serial queries.
+func (m *MemoryHarness) UseDeterministicOrder() {
+	m.deterministicOrder = true
+}
+
+// DeterministicOrder implements DeterministicOrderHarness.
+func (m *MemoryHarness) DeterministicOrder() bool {
+	return m.deterministicOrder
+}
+
 func (m *MemoryHarness) NewContext() *sql.Context {
 	if m.session == nil {
 		m.session = NewBaseSession()
@@ -126,6 +139,18 @@ func (m *MemoryHarness) NewContext() *sql.Context {
 	)
 }
 
+func (m *MemoryHarness) NewContextWithClient(client sql.Client) *sql.Context {
+	session := sql.NewBaseSessionWithClientServer("address", client, 1)
+	if m.driver != nil {
+		session.GetIndexRegistry().RegisterIndexDriver(m.driver)
+	}
+
+	return sql.NewContext(
+		context.Background(),
+		sql.WithSession(session),
+	)
+}
+
 func (m *MemoryHarness) NewTableAsOf(db sql.VersionedDatabase, name string, schema sql.PrimaryKeySchema, asOf interface{}) sql.Table {
 	table := memory.NewPartitionedTable(name, schema, m.numTablePartitions)
 	if m.nativeIndexSupport {
@@ -0,0 +1,145 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import "github.com/dolthub/go-mysql-server/sql"
+
+// MultibyteScripts test the string functions and types with strings that have multibyte characters, including the
+// 4-byte characters of utf8mb4 such as emoji. Lengths and positions are counted in characters, except by the functions
+// that count bytes.
+var MultibyteScripts = []ScriptTest{
+	{
+		Name: "string functions count characters, not bytes",
+		SetUpScript: []string{
+			"create table t (pk int primary key, s varchar(20))",
+			"insert into t values (1, 'a😀b🐬c'), (2, 'héllo wörld'), (3, '日本語テキスト')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select pk, char_length(s), length(s), bit_length(s) from t order by pk",
+				Expected: []sql.Row{{1, int32(5), int32(11), 88}, {2, int32(11), int32(13), 104}, {3, int32(7), int32(21), 168}},
+			},
+			{
+				Query:    "select reverse(s) from t order by pk",
+				Expected: []sql.Row{{"c🐬b😀a"}, {"dlröw olléh"}, {"トスキテ語本日"}},
+			},
+			{
+				Query:    "select substring(s, 2, 3), substring(s, -2), mid(s, 4, 1) from t where pk = 1",
+				Expected: []sql.Row{{"😀b🐬", "🐬c", "🐬"}},
+			},
+			{
+				Query:    "select left(s, 2), right(s, 2) from t order by pk",
+				Expected: []sql.Row{{"a😀", "🐬c"}, {"hé", "ld"}, {"日本", "スト"}},
+			},
+			{
+				Query:    "select locate('🐬', s), instr(s, 'c'), locate('b', s, 3), locate('😀', s, 3) from t where pk = 1",
+				Expected: []sql.Row{{int32(4), int32(5), int32(3), int32(0)}},
+			},
+			{
+				Query:    "select lpad(s, 7, '🎉'), rpad(s, 3, '-'), rpad('x', 4, '😀🐬') from t where pk = 1",
+				Expected: []sql.Row{{"🎉🎉a😀b🐬c", "a😀b", "x😀🐬😀"}},
+			},
+			{
+				Query:    "select upper(s), lower('ÀÉÎ😀') from t where pk = 2",
+				Expected: []sql.Row{{"HÉLLO WÖRLD", "àéî😀"}},
+			},
+			{
+				Query:    "select repeat('😀', 3), replace(s, '😀', ':)') from t where pk = 1",
+				Expected: []sql.Row{{"😀😀😀", "a:)b🐬c"}},
+			},
+			{
+				Query:    "select trim('😀' from '😀😀x😀'), ltrim('  😀'), rtrim('😀  ') from dual",
+				Expected: []sql.Row{{"x", "😀", "😀"}},
+			},
+			{
+				Query:    "select substring_index('a😀b😀c', '😀', 2), substring_index('a😀b😀c', '😀', -1) from dual",
+				Expected: []sql.Row{{"a😀b", "c"}},
+			},
+			{
+				Query:    "select concat(s, '🐬'), concat_ws('😀', 'a', 'b') from t where pk = 1",
+				Expected: []sql.Row{{"a😀b🐬c🐬", "a😀b"}},
+			},
+			{
+				Query:    "select hex('😀'), unhex('F09F9880'), to_base64('😀'), from_base64('8J+YgA==') from dual",
+				Expected: []sql.Row{{"F09F9880", []byte("😀"), "8J+YgA==", "😀"}},
+			},
+			{
+				Query:    "select ascii('😀'), soundex('😀a'), split('a😀b😀c', '😀') from dual",
+				Expected: []sql.Row{{uint8(240), "A000", []interface{}{"a", "b", "c"}}},
+			},
+			{
+				Query:    "select s from t where s like 'a_b%' order by pk",
+				Expected: []sql.Row{{"a😀b🐬c"}},
+			},
+			{
+				Query:    "select s from t where s like '%__ w_rld' order by pk",
+				Expected: []sql.Row{{"héllo wörld"}},
+			},
+		},
+	},
+	{
+		Name: "string types limit the number of characters, not bytes",
+		SetUpScript: []string{
+			"create table t (pk int primary key, c char(3), v varchar(3), b varbinary(4))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "insert into t values (1, '😀😀😀', '🐬🐬🐬', '😀')",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "select c, v, char_length(c), length(v), length(b) from t",
+				Expected: []sql.Row{{"😀😀😀", "🐬🐬🐬", int32(3), int32(12), int32(4)}},
+			},
+			{
+				Query:       "insert into t values (2, '😀😀😀😀', 'a', 'a')",
+				ExpectedErr: sql.ErrLengthBeyondLimit,
+			},
+			{
+				Query:       "insert into t values (2, 'a', 'a', '😀a')",
+				ExpectedErr: sql.ErrLengthBeyondLimit,
+			},
+			{
+				Query:    "update t set v = concat(left(v, 1), '日本') where pk = 1",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "select v from t where v = '🐬日本'",
+				Expected: []sql.Row{{"🐬日本"}},
+			},
+		},
+	},
+	{
+		Name: "utf8mb3 can't store 4-byte characters",
+		SetUpScript: []string{
+			"create table t (pk int primary key, s varchar(10) character set utf8mb3)",
+			"insert into t values (1, 'héllo 日本')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "select s, char_length(s) from t",
+				Expected: []sql.Row{{"héllo 日本", int32(8)}},
+			},
+			{
+				Query:       "insert into t values (2, 'a😀')",
+				ExpectedErr: sql.ErrIncorrectStringValue,
+			},
+			{
+				Query:       "update t set s = concat(s, '🐬')",
+				ExpectedErr: sql.ErrIncorrectStringValue,
+			},
+		},
+	},
+}
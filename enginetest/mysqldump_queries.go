@@ -0,0 +1,152 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// dumpedCreateTable is the CREATE TABLE statement produced for the table in MysqldumpScripts, which the restore
+// script feeds back to the engine.
+const dumpedCreateTable = "CREATE TABLE `we``ird` (\n" +
+	"  `i``d` int NOT NULL,\n" +
+	"  `v` varchar(20) DEFAULT \"it's\" COMMENT 'the ''v'' column',\n" +
+	"  `e` enum('a''b','c') DEFAULT \"c\",\n" +
+	"  PRIMARY KEY (`i``d`),\n" +
+	"  KEY `idx``v` (`v`)\n" +
+	") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"
+
+// MysqldumpScripts replay the statements mysqldump sends to the server while dumping a database, and the statements
+// in the dump file it writes, which are run when the dump is restored.
+var MysqldumpScripts = []ScriptTest{
+	{
+		Name: "mysqldump session",
+		SetUpScript: []string{
+			"CREATE TABLE `we``ird` (`i``d` int primary key, v varchar(20) default 'it''s' comment 'the ''v'' column', e enum('a''b','c') default 'c', index `idx``v` (v))",
+			"INSERT INTO `we``ird` VALUES (1, 'a', 'a''b'), (2, 'it''s', 'c')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "/*!40100 SET @@SQL_MODE='' */",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "/*!40103 SET TIME_ZONE='+00:00' */",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "FLUSH /*!40101 LOCAL */ TABLES",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    "FLUSH TABLES WITH READ LOCK",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    "SET SESSION TRANSACTION ISOLATION LEVEL REPEATABLE READ",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "START TRANSACTION /*!40100 WITH CONSISTENT SNAPSHOT */",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SET @MYSQLDUMP_TEMP_LOG_BIN = @@SESSION.SQL_LOG_BIN",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SET @@SESSION.SQL_LOG_BIN= 0",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "UNLOCK TABLES",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SHOW TABLES LIKE 'we`ird'",
+				Expected: []sql.Row{{"we`ird"}},
+			},
+			{
+				Query:    "SET SQL_QUOTE_SHOW_CREATE=1",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE `we``ird`",
+				Expected: []sql.Row{{"we`ird", dumpedCreateTable}},
+			},
+			{
+				Query:    "SELECT /*!40001 SQL_NO_CACHE */ * FROM `we``ird`",
+				Expected: []sql.Row{{1, "a", "a'b"}, {2, "it's", "c"}},
+			},
+			{
+				Query:    "SHOW TRIGGERS LIKE 'we`ird'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SET @@SESSION.SQL_LOG_BIN = @MYSQLDUMP_TEMP_LOG_BIN",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @@SESSION.SQL_LOG_BIN",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
+	{
+		Name: "mysqldump restore",
+		SetUpScript: []string{
+			"/*!40101 SET @OLD_CHARACTER_SET_CLIENT=@@CHARACTER_SET_CLIENT */",
+			"/*!50503 SET NAMES utf8mb4 */",
+			"/*!40103 SET @OLD_TIME_ZONE=@@TIME_ZONE */",
+			"/*!40103 SET TIME_ZONE='+00:00' */",
+			"/*!40014 SET @OLD_UNIQUE_CHECKS=@@UNIQUE_CHECKS, UNIQUE_CHECKS=0 */",
+			"/*!40014 SET @OLD_FOREIGN_KEY_CHECKS=@@FOREIGN_KEY_CHECKS, FOREIGN_KEY_CHECKS=0 */",
+			"/*!40101 SET @OLD_SQL_MODE=@@SQL_MODE, SQL_MODE='NO_AUTO_VALUE_ON_ZERO' */",
+			"/*!40111 SET @OLD_SQL_NOTES=@@SQL_NOTES, SQL_NOTES=0 */",
+			"SET @MYSQLDUMP_TEMP_LOG_BIN = @@SESSION.SQL_LOG_BIN",
+			"SET @@SESSION.SQL_LOG_BIN= 0",
+			"DROP TABLE IF EXISTS `we``ird`",
+			"/*!40101 SET @saved_cs_client     = @@character_set_client */",
+			dumpedCreateTable,
+			"/*!40101 SET character_set_client = @saved_cs_client */",
+			"LOCK TABLES `we``ird` WRITE",
+			"/*!40000 ALTER TABLE `we``ird` DISABLE KEYS */",
+			"INSERT INTO `we``ird` VALUES (1,'a','a\\'b'),(2,'it\\'s','c')",
+			"/*!40000 ALTER TABLE `we``ird` ENABLE KEYS */",
+			"UNLOCK TABLES",
+			"SET @@SESSION.SQL_LOG_BIN = @MYSQLDUMP_TEMP_LOG_BIN",
+			"/*!40103 SET TIME_ZONE=@OLD_TIME_ZONE */",
+			"/*!40101 SET SQL_MODE=@OLD_SQL_MODE */",
+			"/*!40014 SET FOREIGN_KEY_CHECKS=@OLD_FOREIGN_KEY_CHECKS */",
+			"/*!40014 SET UNIQUE_CHECKS=@OLD_UNIQUE_CHECKS */",
+			"/*!40101 SET CHARACTER_SET_CLIENT=@OLD_CHARACTER_SET_CLIENT */",
+			"/*!40111 SET SQL_NOTES=@OLD_SQL_NOTES */",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM `we``ird` ORDER BY 1",
+				Expected: []sql.Row{{1, "a", "a'b"}, {2, "it's", "c"}},
+			},
+			{
+				Query:    "SHOW CREATE TABLE `we``ird`",
+				Expected: []sql.Row{{"we`ird", dumpedCreateTable}},
+			},
+			{
+				Query:       "/*!40000 ALTER TABLE `missing` DISABLE KEYS */",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+		},
+	},
+}
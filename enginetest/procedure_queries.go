@@ -267,6 +267,32 @@ END;`,
 			},
 		},
 	},
+	{
+		Name: "statements of a procedure are atomic on their own",
+		SetUpScript: []string{
+			"CREATE TABLE t1(pk BIGINT PRIMARY KEY)",
+			"CREATE TABLE t2(pk BIGINT PRIMARY KEY)",
+			`CREATE PROCEDURE p1(x BIGINT)
+BEGIN
+	INSERT INTO t1 VALUES (x), (x + 1);
+	INSERT INTO t2 VALUES (x), (x + 1), (x);
+END;`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:       "CALL p1(1)",
+				ExpectedErr: sql.ErrPrimaryKeyViolation,
+			},
+			{
+				Query:    "SELECT * FROM t1 ORDER BY pk",
+				Expected: []sql.Row{{int64(1)}, {int64(2)}},
+			},
+			{
+				Query:    "SELECT * FROM t2 ORDER BY pk",
+				Expected: []sql.Row{},
+			},
+		},
+	},
 	{
 		Name: "SELECT with JOIN and table aliases",
 		SetUpScript: []string{
@@ -1126,4 +1152,24 @@ var ProcedureShowStatus = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "mysql.proc",
+		SetUpScript: []string{
+			"CREATE PROCEDURE p1(IN x INT) COMMENT 'hi' DETERMINISTIC SELECT x",
+			"CREATE definer=`user` PROCEDURE p2() SQL SECURITY INVOKER READS SQL DATA SELECT 7",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT db, name, type, sql_data_access, is_deterministic, security_type, param_list, body, definer, `comment` FROM mysql.proc",
+				Expected: []sql.Row{
+					{"mydb", "p1", "PROCEDURE", "CONTAINS_SQL", "YES", "DEFINER", "IN x INT", "select x from dual", "", "hi"},
+					{"mydb", "p2", "PROCEDURE", "READS_SQL_DATA", "NO", "INVOKER", "", "select 7 from dual", "user", ""},
+				},
+			},
+			{
+				Query:    "SELECT name FROM mysql.proc WHERE db = 'mydb' AND type = 'PROCEDURE' AND name LIKE 'p2%'",
+				Expected: []sql.Row{{"p2"}},
+			},
+		},
+	},
 }
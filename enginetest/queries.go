@@ -93,6 +93,18 @@ var SpatialQueryTests = []QueryTest{
 		Query:    `SELECT ST_GEOMFROMWKB(ST_ASWKB(POLYGON(LINESTRING(POINT(0,0),POINT(2,2),POINT(1,1),POINT(0,0)))))`,
 		Expected: []sql.Row{{sql.Polygon{Lines: []sql.Linestring{{Points: []sql.Point{{X: 0, Y: 0}, {X: 2, Y: 2}, {X: 1, Y: 1}, {X: 0, Y: 0}}}}}}},
 	},
+	{
+		Query:    `SELECT ST_ASTEXT(ST_GEOMFROMWKB(0x0101000000000000000000F03F0000000000000040))`,
+		Expected: []sql.Row{{"POINT(1 2)"}},
+	},
+	{
+		Query:    `SELECT ST_ASTEXT(ST_GEOMETRYFROMWKB(ST_ASWKB(l))) from line_table`,
+		Expected: []sql.Row{{"LINESTRING(1 2,3 4)"}, {"LINESTRING(1 2,3 4,5 6)"}},
+	},
+	{
+		Query:    `SELECT ST_ASTEXT(ST_POLYGONFROMWKB(ST_ASBINARY(p))) from polygon_table`,
+		Expected: []sql.Row{{"POLYGON((0 0,0 1,1 1,0 0))"}},
+	},
 	{
 		Query:    `SELECT ST_ASWKT(p) from point_table`,
 		Expected: []sql.Row{{"POINT(1 2)"}},
@@ -124,6 +136,18 @@ var SpatialQueryTests = []QueryTest{
 		Query:    `SELECT ST_GEOMFROMTEXT(ST_ASWKT(POLYGON(LINESTRING(POINT(1.2, 3.4),POINT(2.5, -6.7),POINT(33, 44),POINT(1.2,3.4)))))`,
 		Expected: []sql.Row{{sql.Polygon{Lines: []sql.Linestring{{Points: []sql.Point{{X: 1.2, Y: 3.4}, {X: 2.5, Y: -6.7}, {X: 33, Y: 44}, {X: 1.2, Y: 3.4}}}}}}},
 	},
+	{
+		Query:    `SELECT ST_ASTEXT(ST_POINTFROMTEXT('POINT(1.5 -2)'))`,
+		Expected: []sql.Row{{"POINT(1.5 -2)"}},
+	},
+	{
+		Query:    `SELECT ST_ASTEXT(ST_LINESTRINGFROMTEXT(ST_ASTEXT(l))) from line_table`,
+		Expected: []sql.Row{{"LINESTRING(1 2,3 4)"}, {"LINESTRING(1 2,3 4,5 6)"}},
+	},
+	{
+		Query:    `SELECT ST_ASTEXT(ST_POLYFROMTEXT(ST_ASTEXT(p))) from polygon_table`,
+		Expected: []sql.Row{{"POLYGON((0 0,0 1,1 1,0 0))"}},
+	},
 	{
 		Query:    `SELECT ST_X(POINT(1,2))`,
 		Expected: []sql.Row{{1.0}},
@@ -222,14 +246,27 @@ var SpatialQueryTests = []QueryTest{
 	{
 		Query: `SELECT ST_ASGEOJSON(ST_GEOMFROMGEOJSON(s)) from stringtogeojson_table`,
 		Expected: []sql.Row{
-			{sql.JSONDocument{Val: map[string]interface{}{"type": "Point", "coordinates": [2]float64{2, 1}}}},
-			{sql.JSONDocument{Val: map[string]interface{}{"type": "Point", "coordinates": [2]float64{56.789, 123.45}}}},
-			{sql.JSONDocument{Val: map[string]interface{}{"type": "LineString", "coordinates": [][2]float64{{2, 1}, {4, 3}}}}},
-			{sql.JSONDocument{Val: map[string]interface{}{"type": "LineString", "coordinates": [][2]float64{{2.345, 1.23}, {4.56, 3.56789}}}}},
-			{sql.JSONDocument{Val: map[string]interface{}{"type": "Polygon", "coordinates": [][][2]float64{{{2.2, 1.1}, {4.4, 3.3}, {6.6, 5.5}, {2.2, 1.1}}}}}},
+			{sql.JSONDocument{Val: map[string]interface{}{"type": "Point", "coordinates": [2]float64{1, 2}}}},
+			{sql.JSONDocument{Val: map[string]interface{}{"type": "Point", "coordinates": [2]float64{123.45, 56.789}}}},
+			{sql.JSONDocument{Val: map[string]interface{}{"type": "LineString", "coordinates": [][2]float64{{1, 2}, {3, 4}}}}},
+			{sql.JSONDocument{Val: map[string]interface{}{"type": "LineString", "coordinates": [][2]float64{{1.23, 2.345}, {3.56789, 4.56}}}}},
+			{sql.JSONDocument{Val: map[string]interface{}{"type": "Polygon", "coordinates": [][][2]float64{{{1.1, 2.2}, {3.3, 4.4}, {5.5, 6.6}, {1.1, 2.2}}}}}},
 			{sql.JSONDocument{Val: map[string]interface{}{"type": "Polygon", "coordinates": [][][2]float64{{{0, 0}, {1, 1}, {2, 2}, {0, 0}}}}}},
 		},
 	},
+	{
+		Query: `SELECT ST_ASGEOJSON(ST_GEOMFROMGEOJSON(s), 1, 3) from stringtogeojson_table where i = 3`,
+		Expected: []sql.Row{
+			{sql.JSONDocument{Val: map[string]interface{}{"type": "LineString", "coordinates": [][2]float64{{1.2, 2.3}, {3.6, 4.6}}, "bbox": [4]float64{1.2, 2.3, 3.6, 4.6},
+				"crs": map[string]interface{}{"type": "name", "properties": map[string]interface{}{"name": "EPSG:4326"}}}}},
+		},
+	},
+	{
+		Query: `SELECT ST_GEOMFROMGEOJSON(s, 1, 0) from stringtogeojson_table where i = 2`,
+		Expected: []sql.Row{
+			{sql.Linestring{SRID: 0, Points: []sql.Point{{SRID: 0, X: 1, Y: 2}, {SRID: 0, X: 3, Y: 4}}}},
+		},
+	},
 	{
 		Query: `SELECT ST_GEOMFROMGEOJSON(ST_ASGEOJSON(p)) from point_table`,
 		Expected: []sql.Row{
@@ -954,6 +991,30 @@ var QueryTests = []QueryTest{
 			{3, 1},
 		},
 	},
+	{
+		Query: "WITH mt (s,i) as materialized (select i+1, concat(s,'!') FROM mytable) SELECT a.s, b.i FROM mt a join mt b on a.s = b.s order by 1",
+		Expected: []sql.Row{
+			{2, "first row!"},
+			{3, "second row!"},
+			{4, "third row!"},
+		},
+	},
+	{
+		Query: "WITH sub AS NOT MATERIALIZED (SELECT i2, s2 FROM othertable) SELECT /*+ JOIN_ORDER(mytable, sub) */ s2, i2, i FROM mytable INNER JOIN sub ON i2 = i",
+		Expected: []sql.Row{
+			{"third", 1, 1},
+			{"second", 2, 2},
+			{"first", 3, 3},
+		},
+	},
+	{
+		Query: "WITH c AS MATERIALIZED (SELECT i FROM mytable) SELECT i, (SELECT COUNT(*) FROM c WHERE c.i < mytable.i) FROM mytable",
+		Expected: []sql.Row{
+			{1, 0},
+			{2, 1},
+			{3, 2},
+		},
+	},
 	{
 		// In this case, the parser and analyzer collaborate to place the filter below the WINDOW function,
 		// and the window sees the filtered rows.
@@ -1925,6 +1986,32 @@ var QueryTests = []QueryTest{
 		},
 		Expected: []sql.Row{{int64(3)}},
 	},
+	{
+		Query: "SELECT i FROM mytable ORDER BY i LIMIT ? OFFSET ?;",
+		Bindings: map[string]sql.Expression{
+			"v1": expression.NewLiteral(1, sql.Int8),
+			"v2": expression.NewLiteral(1, sql.Int8),
+		},
+		Expected: []sql.Row{{int64(2)}},
+	},
+	{
+		Query: "SELECT i FROM mytable WHERE i = (SELECT i FROM mytable ORDER BY i DESC LIMIT ?);",
+		Bindings: map[string]sql.Expression{
+			"v1": expression.NewLiteral(1, sql.Int8),
+		},
+		Expected: []sql.Row{{int64(3)}},
+	},
+	{
+		Query: "SELECT i FROM mytable ORDER BY i LIMIT ?;",
+		Bindings: map[string]sql.Expression{
+			"v1": expression.NewPlus(expression.NewLiteral(1, sql.Int8), expression.NewLiteral(1, sql.Int8)),
+		},
+		Expected: []sql.Row{{int64(1)}, {int64(2)}},
+	},
+	{
+		Query:    "SELECT i FROM mytable ORDER BY i LIMIT 1, 18446744073709551615;",
+		Expected: []sql.Row{{int64(2)}, {int64(3)}},
+	},
 	{
 		Query:    "SELECT i FROM mytable WHERE i NOT IN (SELECT i FROM (SELECT * FROM (SELECT i as i, s as s FROM mytable) f) s)",
 		Expected: []sql.Row{},
@@ -3337,6 +3424,10 @@ var QueryTests = []QueryTest{
 		Query:    `SELECT RAND(i) from mytable order by i`,
 		Expected: []sql.Row{{0.6046602879796196}, {0.16729663442585624}, {0.7199826688373036}},
 	},
+	{
+		Query:    `SELECT RAND(100) from mytable order by i`,
+		Expected: []sql.Row{{0.8165026937796166}, {0.960260894506087}, {0.06044147573193435}},
+	},
 	{
 		Query: `SELECT RAND(100) = RAND(100)`,
 		Expected: []sql.Row{
@@ -4267,6 +4358,58 @@ var QueryTests = []QueryTest{
 		Query:    "SELECT DATE_ADD('9999-12-31 23:59:59', INTERVAL 1 DAY)",
 		Expected: []sql.Row{{nil}},
 	},
+	{
+		Query:    "SELECT DATE_SUB('0000-01-01', INTERVAL 1 DAY)",
+		Expected: []sql.Row{{nil}},
+	},
+	{
+		Query:    "SELECT 'not a date' + INTERVAL 1 DAY",
+		Expected: []sql.Row{{nil}},
+	},
+	{
+		Query:    "SELECT DATE_ADD('2018-05-02', INTERVAL '1:2:3' HOUR_MINUTE)",
+		Expected: []sql.Row{{nil}},
+	},
+	{
+		Query: "SELECT date_col + INTERVAL 1 DAY FROM datetime_table ORDER BY i",
+		Expected: []sql.Row{
+			{time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)},
+			{time.Date(2020, time.January, 4, 0, 0, 0, 0, time.UTC)},
+			{time.Date(2020, time.January, 8, 0, 0, 0, 0, time.UTC)},
+		},
+	},
+	{
+		Query:    "SELECT i FROM datetime_table WHERE datetime_col - INTERVAL 12 HOUR > date_col ORDER BY i",
+		Expected: []sql.Row{{int64(1)}, {int64(2)}},
+	},
+	{
+		Query:    "SELECT i FROM datetime_table WHERE date_col >= '2020-01-08' - INTERVAL 1 WEEK ORDER BY i",
+		Expected: []sql.Row{{int64(2)}, {int64(3)}},
+	},
+	{
+		Query:    "SELECT DATE_ADD('2018-05-02 23:00:00', INTERVAL '1:30' HOUR_MINUTE)",
+		Expected: []sql.Row{{time.Date(2018, time.May, 3, 0, 30, 0, 0, time.UTC)}},
+	},
+	{
+		Query:    "SELECT DATE_ADD('2018-05-02', INTERVAL '-1 12' DAY_HOUR)",
+		Expected: []sql.Row{{time.Date(2018, time.April, 30, 12, 0, 0, 0, time.UTC)}},
+	},
+	{
+		Query:    "SELECT DATE_ADD('2018-05-02', INTERVAL '1.5' SECOND_MICROSECOND)",
+		Expected: []sql.Row{{time.Date(2018, time.May, 2, 0, 0, 1, 500000000, time.UTC)}},
+	},
+	{
+		Query:    "SELECT DATE_ADD('2018-05-02', INTERVAL 1.5 SECOND)",
+		Expected: []sql.Row{{time.Date(2018, time.May, 2, 0, 0, 1, 500000000, time.UTC)}},
+	},
+	{
+		Query:    "SELECT DATE_ADD('2018-01-31', INTERVAL '1-1' YEAR_MONTH)",
+		Expected: []sql.Row{{time.Date(2019, time.February, 28, 0, 0, 0, 0, time.UTC)}},
+	},
+	{
+		Query:    "SELECT '2018-05-02' + INTERVAL 1000000 YEAR",
+		Expected: []sql.Row{{nil}},
+	},
 	{
 		Query:    `SELECT t.date_col FROM (SELECT CONVERT('2019-06-06 00:00:00', DATETIME) AS date_col) t WHERE t.date_col > '0000-01-01 00:00:00'`,
 		Expected: []sql.Row{{time.Date(2019, time.June, 6, 0, 0, 0, 0, time.UTC)}},
@@ -5716,6 +5859,24 @@ var QueryTests = []QueryTest{
 			{1, 0, 1, 10, 10},
 		},
 	},
+	{
+		Query: "SELECT pk,pk1,pk2 FROM one_pk LEFT JOIN two_pk ON one_pk.c1=two_pk.c1 AND two_pk.pk2=1 ORDER BY 1,2,3",
+		Expected: []sql.Row{
+			{0, nil, nil},
+			{1, 0, 1},
+			{2, nil, nil},
+			{3, 1, 1},
+		},
+	},
+	{
+		Query: "SELECT pk,pk1,pk2 FROM one_pk RIGHT JOIN two_pk ON one_pk.c1=two_pk.c1 AND one_pk.pk>1 ORDER BY 2,3",
+		Expected: []sql.Row{
+			{nil, 0, 0},
+			{nil, 0, 1},
+			{2, 1, 0},
+			{3, 1, 1},
+		},
+	},
 	{
 		Query: "SELECT pk,pk1,pk2 FROM one_pk JOIN two_pk ON pk1-pk>0 AND pk2<1",
 		Expected: []sql.Row{
@@ -6285,6 +6446,26 @@ var QueryTests = []QueryTest{
 		Query:    `SELECT EXISTS (SELECT pk FROM one_pk WHERE pk > 4)`,
 		Expected: []sql.Row{{false}},
 	},
+	{
+		Query:    `SELECT i FROM mytable t1 WHERE EXISTS (SELECT * FROM othertable t2 WHERE t2.i2 = t1.i AND t2.s2 <> 'first') ORDER BY i`,
+		Expected: []sql.Row{{1}, {2}},
+	},
+	{
+		Query:    `SELECT i FROM mytable t1 WHERE t1.i IN (SELECT i2 FROM othertable t2 WHERE t2.i2 = t1.i AND t2.s2 <> 'first') ORDER BY i`,
+		Expected: []sql.Row{{1}, {2}},
+	},
+	{
+		Query:    `SELECT i FROM mytable t1 WHERE i = (SELECT MAX(i2) FROM othertable t2 WHERE t2.i2 = t1.i AND t2.s2 <> 'second') ORDER BY i`,
+		Expected: []sql.Row{{1}, {3}},
+	},
+	{
+		Query:    `SELECT pk FROM one_pk WHERE c1 < (SELECT AVG(c2) FROM two_pk WHERE two_pk.pk1 = one_pk.pk AND two_pk.pk2 = 1) ORDER BY pk`,
+		Expected: []sql.Row{{0}, {1}},
+	},
+	{
+		Query:    `SELECT pk FROM one_pk WHERE 0 = (SELECT COUNT(*) FROM two_pk WHERE two_pk.pk1 = one_pk.pk) ORDER BY pk`,
+		Expected: []sql.Row{{2}, {3}},
+	},
 	{
 		Query:    `START TRANSACTION READ ONLY`,
 		Expected: []sql.Row{},
@@ -6294,16 +6475,16 @@ var QueryTests = []QueryTest{
 		Expected: []sql.Row{},
 	},
 	{
-		Query:    `SHOW STATUS`,
-		Expected: []sql.Row{},
+		Query:    `SHOW STATUS LIKE 'Innodb_row_lock_waits'`,
+		Expected: []sql.Row{{"Innodb_row_lock_waits", "0"}},
 	},
 	{
-		Query:    `SHOW GLOBAL STATUS`,
-		Expected: []sql.Row{},
+		Query:    `SHOW GLOBAL STATUS LIKE 'Innodb_buffer_pool_pages_%'`,
+		Expected: []sql.Row{{"Innodb_buffer_pool_pages_data", "0"}, {"Innodb_buffer_pool_pages_free", "0"}, {"Innodb_buffer_pool_pages_total", "0"}},
 	},
 	{
-		Query:    `SHOW SESSION STATUS`,
-		Expected: []sql.Row{},
+		Query:    `SHOW SESSION STATUS WHERE Variable_name = 'Aborted_clients'`,
+		Expected: []sql.Row{{"Aborted_clients", "0"}},
 	},
 	{
 		Query: `SELECT a.* FROM mytable a, mytable b where a.i = b.i`,
@@ -6470,6 +6651,41 @@ var QueryTests = []QueryTest{
 			{2, "second row"},
 			{2, "second row"}},
 	},
+	{
+		Query: `SELECT * FROM mytable a, othertable b WHERE (a.i = 1 AND b.i2 = 2) OR (a.i = 3 AND b.i2 = 1) ORDER BY 1`,
+		Expected: []sql.Row{
+			{1, "first row", "second", 2},
+			{3, "third row", "third", 1},
+		},
+	},
+	{
+		Query: `SELECT a.i, b.s2 FROM mytable a, othertable b WHERE (a.i IN (1, 2) AND b.s2 = 'first') OR (a.i BETWEEN 3 AND 4 AND b.s2 = 'third') ORDER BY 1`,
+		Expected: []sql.Row{
+			{1, "first"},
+			{2, "first"},
+			{3, "third"},
+		},
+	},
+	{
+		Query: `SELECT * FROM mytable a LEFT JOIN othertable b ON a.i = b.i2 WHERE (a.i = 1 AND b.s2 = 'third') OR (a.i = 2 AND b.s2 IS NULL)`,
+		Expected: []sql.Row{
+			{1, "first row", "third", 1},
+		},
+	},
+	{
+		Query: `SELECT * FROM mytable a JOIN othertable b ON a.i = b.i2 WHERE (a.s = 'first row' AND b.s2 = 'third') OR (a.s = 'third row' AND b.s2 = 'first') ORDER BY 1`,
+		Expected: []sql.Row{
+			{1, "first row", "third", 1},
+			{3, "third row", "first", 3},
+		},
+	},
+	{
+		Query: `SELECT * FROM mytable WHERE i = ABS(-2) OR i BETWEEN 2 + 1 AND 4 ORDER BY 1`,
+		Expected: []sql.Row{
+			{2, "second row"},
+			{3, "third row"},
+		},
+	},
 	{
 		Query: `SELECT a.* FROM mytable a CROSS JOIN mytable b CROSS JOIN mytable c CROSS JOIN mytable d where a.i = b.i AND b.s = c.s`,
 		Expected: []sql.Row{
@@ -7232,8 +7448,8 @@ var InfoSchemaQueries = []QueryTest{
 				"  `i` bigint NOT NULL,\n" +
 				"  `s` varchar(20) NOT NULL COMMENT 'column s',\n" +
 				"  PRIMARY KEY (`i`),\n" +
-				"  KEY `mytable_i_s` (`i`,`s`),\n" +
-				"  UNIQUE KEY `mytable_s` (`s`)\n" +
+				"  UNIQUE KEY `mytable_s` (`s`),\n" +
+				"  KEY `mytable_i_s` (`i`,`s`)\n" +
 				") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"},
 		},
 	},
@@ -28,11 +28,13 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Project(t1.i)\n" +
 			" └─ IndexedJoin(t1.i = (t2.i + 1))\n" +
 			"     ├─ Filter(t2.i = 1)\n" +
-			"     │   └─ TableAlias(t2)\n" +
-			"     │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     │   └─ Projected table access on [i]\n" +
+			"     │       └─ TableAlias(t2)\n" +
+			"     │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"     └─ Filter(t1.i = 2)\n" +
-			"         └─ TableAlias(t1)\n" +
-			"             └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"         └─ Projected table access on [i]\n" +
+			"             └─ TableAlias(t1)\n" +
+			"                 └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
@@ -41,9 +43,11 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Sort(row_number() over (order by i desc) ASC)\n" +
 			" └─ Project(row_number() over ( order by [mytable.i, idx=0, type=BIGINT, nullable=false] DESC) as row_number() over (order by i desc), i2)\n" +
 			"     └─ Window(row_number() over ( order by [mytable.i, idx=0, type=BIGINT, nullable=false] DESC), mytable.i as i2)\n" +
-			"         └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"             ├─ Table(mytable)\n" +
-			"             └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"         └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"             ├─ Projected table access on [i]\n" +
+			"             │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"             └─ Projected table access on [i2]\n" +
+			"                 └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
@@ -84,8 +88,10 @@ var PlanTests = []QueryPlanTest{
 			"     └─ Window(row_number() over ( order by [mytable.i, idx=0, type=BIGINT, nullable=false] DESC), mytable.i as i2)\n" +
 			"         └─ IndexedJoin(mytable.i = othertable.i2)\n" +
 			"             ├─ Filter(mytable.i = 2)\n" +
-			"             │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
-			"             └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"             │   └─ Projected table access on [i]\n" +
+			"             │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"             └─ Projected table access on [i2]\n" +
+			"                 └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
@@ -96,11 +102,13 @@ var PlanTests = []QueryPlanTest{
 			"     └─ Project(t1.i, \"hello\")\n" +
 			"         └─ IndexedJoin(t1.i = (t2.i + 1))\n" +
 			"             ├─ Filter(t2.i = 1)\n" +
-			"             │   └─ TableAlias(t2)\n" +
-			"             │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"             │   └─ Projected table access on [i]\n" +
+			"             │       └─ TableAlias(t2)\n" +
+			"             │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"             └─ Filter(t1.i = 2)\n" +
-			"                 └─ TableAlias(t1)\n" +
-			"                     └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"                 └─ Projected table access on [i]\n" +
+			"                     └─ TableAlias(t1)\n" +
+			"                         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
@@ -122,11 +130,13 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Project(t1.i)\n" +
 			" └─ IndexedJoin(t1.i = (t2.i + 1))\n" +
 			"     ├─ Filter(t2.i = 1)\n" +
-			"     │   └─ TableAlias(t2)\n" +
-			"     │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     │   └─ Projected table access on [i]\n" +
+			"     │       └─ TableAlias(t2)\n" +
+			"     │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"     └─ Filter(t1.i = 2)\n" +
-			"         └─ TableAlias(t1)\n" +
-			"             └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"         └─ Projected table access on [i]\n" +
+			"             └─ TableAlias(t1)\n" +
+			"                 └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
@@ -134,11 +144,13 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Project(t1.i)\n" +
 			" └─ IndexedJoin(t1.i = (t2.i + 1))\n" +
 			"     ├─ Filter(t2.i = 1)\n" +
-			"     │   └─ TableAlias(t2)\n" +
-			"     │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     │   └─ Projected table access on [i]\n" +
+			"     │       └─ TableAlias(t2)\n" +
+			"     │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"     └─ Filter(t1.i = 2)\n" +
-			"         └─ TableAlias(t1)\n" +
-			"             └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"         └─ Projected table access on [i]\n" +
+			"             └─ TableAlias(t1)\n" +
+			"                 └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
@@ -146,36 +158,44 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Project(t1.i)\n" +
 			" └─ IndexedJoin(t1.i = (t2.i + 1))\n" +
 			"     ├─ Filter(t2.i = 1)\n" +
-			"     │   └─ TableAlias(t2)\n" +
-			"     │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     │   └─ Projected table access on [i]\n" +
+			"     │       └─ TableAlias(t2)\n" +
+			"     │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"     └─ Filter(t1.i = 2)\n" +
-			"         └─ TableAlias(t1)\n" +
-			"             └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"         └─ Projected table access on [i]\n" +
+			"             └─ TableAlias(t1)\n" +
+			"                 └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT i, i2, s2 FROM mytable INNER JOIN othertable ON i = i2`,
 		ExpectedPlan: "Project(mytable.i, othertable.i2, othertable.s2)\n" +
-			" └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"     ├─ Table(mytable)\n" +
-			"     └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			" └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"     ├─ Projected table access on [i]\n" +
+			"     │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [i2 s2]\n" +
+			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT i, i2, s2 FROM mytable INNER JOIN othertable ON i = i2 OR s = s2`,
 		ExpectedPlan: "Project(mytable.i, othertable.i2, othertable.s2)\n" +
 			" └─ IndexedJoin((mytable.i = othertable.i2) OR (mytable.s = othertable.s2))\n" +
-			"     ├─ Table(mytable)\n" +
+			"     ├─ Projected table access on [i s]\n" +
+			"     │   └─ Table(mytable)\n" +
 			"     └─ Concat\n" +
-			"         ├─ IndexedTableAccess(othertable on [othertable.i2])\n" +
-			"         └─ IndexedTableAccess(othertable on [othertable.s2])\n" +
+			"         ├─ Projected table access on [i2 s2]\n" +
+			"         │   └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"         └─ Projected table access on [i2 s2]\n" +
+			"             └─ IndexedTableAccess(othertable on [othertable.s2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT i, i2, s2 FROM mytable INNER JOIN othertable ot ON i = i2 OR s = s2`,
 		ExpectedPlan: "Project(mytable.i, ot.i2, ot.s2)\n" +
 			" └─ IndexedJoin((mytable.i = ot.i2) OR (mytable.s = ot.s2))\n" +
-			"     ├─ Table(mytable)\n" +
+			"     ├─ Projected table access on [i s]\n" +
+			"     │   └─ Table(mytable)\n" +
 			"     └─ TableAlias(ot)\n" +
 			"         └─ Concat\n" +
 			"             ├─ IndexedTableAccess(othertable on [othertable.i2])\n" +
@@ -186,22 +206,29 @@ var PlanTests = []QueryPlanTest{
 		Query: `SELECT i, i2, s2 FROM mytable INNER JOIN othertable ON i = i2 OR SUBSTRING_INDEX(s, ' ', 1) = s2`,
 		ExpectedPlan: "Project(mytable.i, othertable.i2, othertable.s2)\n" +
 			" └─ IndexedJoin((mytable.i = othertable.i2) OR (SUBSTRING_INDEX(mytable.s, \" \", &{1 {257}}) = othertable.s2))\n" +
-			"     ├─ Table(mytable)\n" +
+			"     ├─ Projected table access on [i s]\n" +
+			"     │   └─ Table(mytable)\n" +
 			"     └─ Concat\n" +
-			"         ├─ IndexedTableAccess(othertable on [othertable.i2])\n" +
-			"         └─ IndexedTableAccess(othertable on [othertable.s2])\n" +
+			"         ├─ Projected table access on [i2 s2]\n" +
+			"         │   └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"         └─ Projected table access on [i2 s2]\n" +
+			"             └─ IndexedTableAccess(othertable on [othertable.s2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT i, i2, s2 FROM mytable INNER JOIN othertable ON i = i2 OR SUBSTRING_INDEX(s, ' ', 1) = s2 OR SUBSTRING_INDEX(s, ' ', 2) = s2`,
 		ExpectedPlan: "Project(mytable.i, othertable.i2, othertable.s2)\n" +
 			" └─ IndexedJoin(((mytable.i = othertable.i2) OR (SUBSTRING_INDEX(mytable.s, \" \", &{1 {257}}) = othertable.s2)) OR (SUBSTRING_INDEX(mytable.s, \" \", &{2 {257}}) = othertable.s2))\n" +
-			"     ├─ Table(mytable)\n" +
+			"     ├─ Projected table access on [i s]\n" +
+			"     │   └─ Table(mytable)\n" +
 			"     └─ Concat\n" +
 			"         ├─ Concat\n" +
-			"         │   ├─ IndexedTableAccess(othertable on [othertable.i2])\n" +
-			"         │   └─ IndexedTableAccess(othertable on [othertable.s2])\n" +
-			"         └─ IndexedTableAccess(othertable on [othertable.s2])\n" +
+			"         │   ├─ Projected table access on [i2 s2]\n" +
+			"         │   │   └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"         │   └─ Projected table access on [i2 s2]\n" +
+			"         │       └─ IndexedTableAccess(othertable on [othertable.s2])\n" +
+			"         └─ Projected table access on [i2 s2]\n" +
+			"             └─ IndexedTableAccess(othertable on [othertable.s2])\n" +
 			"",
 	},
 	{
@@ -209,13 +236,17 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Distinct\n" +
 			" └─ Union\n" +
 			"     ├─ Project(mytable.i, othertable.i2, othertable.s2)\n" +
-			"     │   └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"     │       ├─ Table(mytable)\n" +
-			"     │       └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     │   └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"     │       ├─ Projected table access on [i]\n" +
+			"     │       │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     │       └─ Projected table access on [i2 s2]\n" +
+			"     │           └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"     └─ Project(mytable.i, othertable.i2, othertable.s2)\n" +
-			"         └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"             ├─ Table(mytable)\n" +
-			"             └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"         └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"             ├─ Projected table access on [i]\n" +
+			"             │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"             └─ Projected table access on [i2 s2]\n" +
+			"                 └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
@@ -224,11 +255,14 @@ var PlanTests = []QueryPlanTest{
 			" └─ IndexedJoin(sub.i = ot.i2)\n" +
 			"     ├─ SubqueryAlias(sub)\n" +
 			"     │   └─ Project(mytable.i, othertable.i2, othertable.s2)\n" +
-			"     │       └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"     │           ├─ Table(mytable)\n" +
-			"     │           └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
-			"     └─ TableAlias(ot)\n" +
-			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     │       └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"     │           ├─ Projected table access on [i]\n" +
+			"     │           │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     │           └─ Projected table access on [i2 s2]\n" +
+			"     │               └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     └─ Projected table access on [i2 s2]\n" +
+			"         └─ TableAlias(ot)\n" +
+			"             └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
@@ -237,11 +271,14 @@ var PlanTests = []QueryPlanTest{
 			" └─ IndexedJoin(sub.i = ot.i2)\n" +
 			"     ├─ SubqueryAlias(sub)\n" +
 			"     │   └─ Project(mytable.i, othertable.i2, othertable.s2)\n" +
-			"     │       └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"     │           ├─ Table(mytable)\n" +
-			"     │           └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
-			"     └─ TableAlias(ot)\n" +
-			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     │       └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"     │           ├─ Projected table access on [i]\n" +
+			"     │           │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     │           └─ Projected table access on [i2 s2]\n" +
+			"     │               └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     └─ Projected table access on [i2 s2]\n" +
+			"         └─ TableAlias(ot)\n" +
+			"             └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
@@ -249,26 +286,31 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Project(sub.i, sub.i2, sub.s2, ot.i2, ot.s2)\n" +
 			" └─ LeftJoin(sub.i = ot.i2)\n" +
 			"     ├─ Filter(ot.i2 > 0)\n" +
-			"     │   └─ TableAlias(ot)\n" +
-			"     │       └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     │   └─ Projected table access on [i2 s2]\n" +
+			"     │       └─ TableAlias(ot)\n" +
+			"     │           └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"     └─ HashLookup(child: (sub.i), lookup: (ot.i2))\n" +
 			"         └─ CachedResults\n" +
 			"             └─ SubqueryAlias(sub)\n" +
 			"                 └─ Project(mytable.i, othertable.i2, othertable.s2)\n" +
-			"                     └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"                         ├─ Table(mytable)\n" +
+			"                     └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"                         ├─ Projected table access on [i]\n" +
+			"                         │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"                         └─ Filter(NOT((convert(othertable.s2, signed) = 0)))\n" +
-			"                             └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"                             └─ Projected table access on [i2 s2]\n" +
+			"                                 └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
 		Query: `select /*+ JOIN_ORDER( i, k, j ) */  * from one_pk i join one_pk k on i.pk = k.pk join (select pk, rand() r from one_pk) j on i.pk = j.pk`,
 		ExpectedPlan: "IndexedJoin(i.pk = j.pk)\n" +
-			" ├─ IndexedJoin(i.pk = k.pk)\n" +
-			" │   ├─ TableAlias(i)\n" +
-			" │   │   └─ Table(one_pk)\n" +
-			" │   └─ TableAlias(k)\n" +
-			" │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			" ├─ MergeJoin(i.pk = k.pk)\n" +
+			" │   ├─ Projected table access on [pk c1 c2 c3 c4 c5]\n" +
+			" │   │   └─ TableAlias(i)\n" +
+			" │   │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			" │   └─ Projected table access on [pk c1 c2 c3 c4 c5]\n" +
+			" │       └─ TableAlias(k)\n" +
+			" │           └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			" └─ HashLookup(child: (j.pk), lookup: (i.pk))\n" +
 			"     └─ CachedResults\n" +
 			"         └─ SubqueryAlias(j)\n" +
@@ -285,12 +327,15 @@ var PlanTests = []QueryPlanTest{
 			"     └─ Project((sub.i + 10), ot.s2)\n" +
 			"         └─ IndexedJoin(sub.i = ot.i2)\n" +
 			"             ├─ SubqueryAlias(sub)\n" +
-			"             │   └─ Project(mytable.i)\n" +
-			"             │       └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"             │           ├─ Table(mytable)\n" +
-			"             │           └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
-			"             └─ TableAlias(ot)\n" +
-			"                 └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"             │   └─ Project(mytable.i, othertable.i2)\n" +
+			"             │       └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"             │           ├─ Projected table access on [i]\n" +
+			"             │           │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"             │           └─ Projected table access on [i2 s2]\n" +
+			"             │               └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"             └─ Projected table access on [s2 i2]\n" +
+			"                 └─ TableAlias(ot)\n" +
+			"                     └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
@@ -299,113 +344,164 @@ var PlanTests = []QueryPlanTest{
 			" └─ Filter(selfjoin.i IN (Project(1)\n" +
 			"     └─ Table(dual)\n" +
 			"    ))\n" +
-			"     └─ IndexedJoin(mytable.i = selfjoin.i)\n" +
-			"         ├─ Table(mytable)\n" +
-			"         └─ TableAlias(selfjoin)\n" +
-			"             └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ MergeJoin(mytable.i = selfjoin.i)\n" +
+			"         ├─ Projected table access on [i]\n" +
+			"         │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"         └─ Projected table access on [i]\n" +
+			"             └─ TableAlias(selfjoin)\n" +
+			"                 └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM mytable INNER JOIN othertable ON i = i2`,
 		ExpectedPlan: "Project(othertable.s2, othertable.i2, mytable.i)\n" +
-			" └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"     ├─ Table(mytable)\n" +
-			"     └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			" └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"     ├─ Projected table access on [i]\n" +
+			"     │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [s2 i2]\n" +
+			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT i, i2, s2 FROM othertable JOIN mytable ON i = i2`,
 		ExpectedPlan: "Project(mytable.i, othertable.i2, othertable.s2)\n" +
-			" └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"     ├─ Table(othertable)\n" +
-			"     └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			" └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"     ├─ Projected table access on [i2 s2]\n" +
+			"     │   └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM othertable JOIN mytable ON i = i2`,
 		ExpectedPlan: "Project(othertable.s2, othertable.i2, mytable.i)\n" +
-			" └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"     ├─ Table(othertable)\n" +
-			"     └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			" └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"     ├─ Projected table access on [s2 i2]\n" +
+			"     │   └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM othertable JOIN mytable ON i = i2`,
 		ExpectedPlan: "Project(othertable.s2, othertable.i2, mytable.i)\n" +
-			" └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"     ├─ Table(othertable)\n" +
-			"     └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			" └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"     ├─ Projected table access on [s2 i2]\n" +
+			"     │   └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM othertable JOIN mytable ON i = i2 LIMIT 1`,
 		ExpectedPlan: "Limit(1)\n" +
 			" └─ Project(othertable.s2, othertable.i2, mytable.i)\n" +
-			"     └─ IndexedJoin(mytable.i = othertable.i2)\n" +
-			"         ├─ Table(othertable)\n" +
-			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ MergeJoin(mytable.i = othertable.i2)\n" +
+			"         ├─ Projected table access on [s2 i2]\n" +
+			"         │   └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"         └─ Projected table access on [i]\n" +
+			"             └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT i, i2, s2 FROM mytable INNER JOIN othertable ON i2 = i`,
 		ExpectedPlan: "Project(mytable.i, othertable.i2, othertable.s2)\n" +
-			" └─ IndexedJoin(othertable.i2 = mytable.i)\n" +
-			"     ├─ Table(mytable)\n" +
-			"     └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			" └─ MergeJoin(othertable.i2 = mytable.i)\n" +
+			"     ├─ Projected table access on [i]\n" +
+			"     │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [i2 s2]\n" +
+			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT s2, i2, i FROM mytable INNER JOIN othertable ON i2 = i`,
 		ExpectedPlan: "Project(othertable.s2, othertable.i2, mytable.i)\n" +
-			" └─ IndexedJoin(othertable.i2 = mytable.i)\n" +
-			"     ├─ Table(mytable)\n" +
-			"     └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			" └─ MergeJoin(othertable.i2 = mytable.i)\n" +
+			"     ├─ Projected table access on [i]\n" +
+			"     │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [s2 i2]\n" +
+			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM MYTABLE JOIN OTHERTABLE ON i = i2 AND NOT (s2 <=> s)`,
-		ExpectedPlan: "IndexedJoin((mytable.i = othertable.i2) AND (NOT((othertable.s2 <=> mytable.s))))\n" +
-			" ├─ Table(mytable)\n" +
-			" └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+		ExpectedPlan: "MergeJoin((mytable.i = othertable.i2) AND (NOT((othertable.s2 <=> mytable.s))))\n" +
+			" ├─ Projected table access on [i s]\n" +
+			" │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			" └─ Projected table access on [s2 i2]\n" +
+			"     └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM MYTABLE JOIN OTHERTABLE ON i = i2 AND NOT (s2 = s)`,
-		ExpectedPlan: "IndexedJoin((mytable.i = othertable.i2) AND (NOT((othertable.s2 = mytable.s))))\n" +
-			" ├─ Table(mytable)\n" +
-			" └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+		ExpectedPlan: "MergeJoin((mytable.i = othertable.i2) AND (NOT((othertable.s2 = mytable.s))))\n" +
+			" ├─ Projected table access on [i s]\n" +
+			" │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			" └─ Projected table access on [s2 i2]\n" +
+			"     └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM MYTABLE JOIN OTHERTABLE ON i = i2 AND CONCAT(s, s2) IS NOT NULL`,
-		ExpectedPlan: "IndexedJoin((mytable.i = othertable.i2) AND (NOT(concat(mytable.s, othertable.s2) IS NULL)))\n" +
-			" ├─ Table(mytable)\n" +
-			" └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+		ExpectedPlan: "MergeJoin((mytable.i = othertable.i2) AND (NOT(concat(mytable.s, othertable.s2) IS NULL)))\n" +
+			" ├─ Projected table access on [i s]\n" +
+			" │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			" └─ Projected table access on [s2 i2]\n" +
+			"     └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM MYTABLE JOIN OTHERTABLE ON i = i2 AND s > s2`,
-		ExpectedPlan: "InnerJoin((mytable.i = othertable.i2) AND (mytable.s > othertable.s2))\n" +
+		ExpectedPlan: "MergeJoin((mytable.i = othertable.i2) AND (mytable.s > othertable.s2))\n" +
 			" ├─ Projected table access on [i s]\n" +
-			" │   └─ Table(mytable)\n" +
+			" │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			" └─ Projected table access on [s2 i2]\n" +
-			"     └─ Table(othertable)\n" +
+			"     └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM MYTABLE JOIN OTHERTABLE ON i = i2 AND NOT(s > s2)`,
-		ExpectedPlan: "InnerJoin((mytable.i = othertable.i2) AND (NOT((mytable.s > othertable.s2))))\n" +
+		ExpectedPlan: "MergeJoin((mytable.i = othertable.i2) AND (NOT((mytable.s > othertable.s2))))\n" +
 			" ├─ Projected table access on [i s]\n" +
-			" │   └─ Table(mytable)\n" +
+			" │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			" └─ Projected table access on [s2 i2]\n" +
-			"     └─ Table(othertable)\n" +
+			"     └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"",
+	},
+	{
+		Query: `WITH sub AS NOT MATERIALIZED (SELECT i2, s2 FROM othertable) SELECT /*+ JOIN_ORDER(mytable, sub) */ s2, i2, i FROM mytable INNER JOIN sub ON i2 = i`,
+		ExpectedPlan: "Project(sub.s2, sub.i2, mytable.i)\n" +
+			" └─ HashJoin(sub.i2 = mytable.i)\n" +
+			"     ├─ Projected table access on [i]\n" +
+			"     │   └─ Table(mytable)\n" +
+			"     └─ SubqueryAlias(sub)\n" +
+			"         └─ Project(othertable.i2, othertable.s2)\n" +
+			"             └─ Projected table access on [i2 s2]\n" +
+			"                 └─ Table(othertable)\n" +
+			"",
+	},
+	{
+		Query: `WITH c AS MATERIALIZED (SELECT i FROM mytable) SELECT i, (SELECT COUNT(*) FROM c WHERE c.i < mytable.i) FROM mytable`,
+		ExpectedPlan: "Project(mytable.i, (GroupBy\n" +
+			" ├─ SelectedExprs(COUNT(*))\n" +
+			" ├─ Grouping()\n" +
+			" └─ Filter(c.i < mytable.i)\n" +
+			"     └─ SubqueryAlias(c)\n" +
+			"         └─ CachedResults\n" +
+			"             └─ Project(mytable.i)\n" +
+			"                 └─ Projected table access on [i]\n" +
+			"                     └─ Table(mytable)\n" +
+			") as (SELECT COUNT(*) FROM c WHERE c.i < mytable.i))\n" +
+			" └─ Projected table access on [i]\n" +
+			"     └─ Table(mytable)\n" +
 			"",
 	},
 	{
 		Query: `SELECT /*+ JOIN_ORDER(mytable, othertable) */ s2, i2, i FROM mytable INNER JOIN (SELECT * FROM othertable) othertable ON i2 = i`,
 		ExpectedPlan: "Project(othertable.s2, othertable.i2, mytable.i)\n" +
 			" └─ InnerJoin(othertable.i2 = mytable.i)\n" +
-			"     ├─ Table(mytable)\n" +
+			"     ├─ Projected table access on [i]\n" +
+			"     │   └─ Table(mytable)\n" +
 			"     └─ HashLookup(child: (othertable.i2), lookup: (mytable.i))\n" +
 			"         └─ CachedResults\n" +
 			"             └─ SubqueryAlias(othertable)\n" +
@@ -417,7 +513,8 @@ var PlanTests = []QueryPlanTest{
 		Query: `SELECT s2, i2, i FROM mytable LEFT JOIN (SELECT * FROM othertable) othertable ON i2 = i`,
 		ExpectedPlan: "Project(othertable.s2, othertable.i2, mytable.i)\n" +
 			" └─ LeftJoin(othertable.i2 = mytable.i)\n" +
-			"     ├─ Table(mytable)\n" +
+			"     ├─ Projected table access on [i]\n" +
+			"     │   └─ Table(mytable)\n" +
 			"     └─ HashLookup(child: (othertable.i2), lookup: (mytable.i))\n" +
 			"         └─ CachedResults\n" +
 			"             └─ SubqueryAlias(othertable)\n" +
@@ -452,21 +549,25 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Project(a.i, a.s)\n" +
 			" └─ IndexedJoin(a.i = b.s)\n" +
 			"     ├─ Filter(NOT(a.s IS NULL))\n" +
-			"     │   └─ TableAlias(a)\n" +
-			"     │       └─ IndexedTableAccess(mytable on [mytable.s])\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(mytable on [mytable.s])\n" +
+			"     │   └─ Projected table access on [i s]\n" +
+			"     │       └─ TableAlias(a)\n" +
+			"     │           └─ IndexedTableAccess(mytable on [mytable.s])\n" +
+			"     └─ Projected table access on [s]\n" +
+			"         └─ TableAlias(b)\n" +
+			"             └─ IndexedTableAccess(mytable on [mytable.s])\n" +
 			"",
 	},
 	{
 		Query: `SELECT /*+ JOIN_ORDER(b, a) */ a.* FROM mytable a inner join mytable b on (a.i = b.s) WHERE a.s is not null`,
 		ExpectedPlan: "Project(a.i, a.s)\n" +
 			" └─ IndexedJoin(a.i = b.s)\n" +
-			"     ├─ TableAlias(b)\n" +
-			"     │   └─ Table(mytable)\n" +
+			"     ├─ Projected table access on [s]\n" +
+			"     │   └─ TableAlias(b)\n" +
+			"     │       └─ Table(mytable)\n" +
 			"     └─ Filter(NOT(a.s IS NULL))\n" +
-			"         └─ TableAlias(a)\n" +
-			"             └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"         └─ Projected table access on [i s]\n" +
+			"             └─ TableAlias(a)\n" +
+			"                 └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
@@ -474,10 +575,12 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Project(a.i, a.s)\n" +
 			" └─ IndexedJoin(a.i = b.s)\n" +
 			"     ├─ Filter(NOT((a.s HASH IN (\"1\", \"2\", \"3\", \"4\"))))\n" +
-			"     │   └─ TableAlias(a)\n" +
-			"     │       └─ IndexedTableAccess(mytable on [mytable.s])\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(mytable on [mytable.s])\n" +
+			"     │   └─ Projected table access on [i s]\n" +
+			"     │       └─ TableAlias(a)\n" +
+			"     │           └─ IndexedTableAccess(mytable on [mytable.s])\n" +
+			"     └─ Projected table access on [s]\n" +
+			"         └─ TableAlias(b)\n" +
+			"             └─ IndexedTableAccess(mytable on [mytable.s])\n" +
 			"",
 	},
 	{
@@ -485,10 +588,12 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Project(a.i, a.s)\n" +
 			" └─ IndexedJoin(a.i = b.s)\n" +
 			"     ├─ Filter(a.i HASH IN (1, 2, 3, 4))\n" +
-			"     │   └─ TableAlias(a)\n" +
-			"     │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(mytable on [mytable.s])\n" +
+			"     │   └─ Projected table access on [i s]\n" +
+			"     │       └─ TableAlias(a)\n" +
+			"     │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [s]\n" +
+			"         └─ TableAlias(b)\n" +
+			"             └─ IndexedTableAccess(mytable on [mytable.s])\n" +
 			"",
 	},
 	{
@@ -620,11 +725,13 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT a.* FROM mytable a, mytable b where a.i = b.i`,
 		ExpectedPlan: "Project(a.i, a.s)\n" +
-			" └─ IndexedJoin(a.i = b.i)\n" +
-			"     ├─ TableAlias(a)\n" +
-			"     │   └─ Table(mytable)\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			" └─ MergeJoin(a.i = b.i)\n" +
+			"     ├─ Projected table access on [i s]\n" +
+			"     │   └─ TableAlias(a)\n" +
+			"     │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ TableAlias(b)\n" +
+			"             └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
@@ -702,32 +809,36 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT a.* FROM mytable a, mytable b, mytable c, mytable d where a.i = b.i AND b.i = c.i AND c.i = d.i AND c.i = 2`,
 		ExpectedPlan: "Project(a.i, a.s)\n" +
-			" └─ IndexedJoin(a.i = b.i)\n" +
-			"     ├─ TableAlias(a)\n" +
-			"     │   └─ Table(mytable)\n" +
-			"     └─ IndexedJoin(b.i = c.i)\n" +
-			"         ├─ TableAlias(b)\n" +
-			"         │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
-			"         └─ IndexedJoin(c.i = d.i)\n" +
-			"             ├─ Filter(c.i = 2)\n" +
-			"             │   └─ TableAlias(c)\n" +
-			"             │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
-			"             └─ TableAlias(d)\n" +
-			"                 └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			" └─ IndexedJoin(c.i = d.i)\n" +
+			"     ├─ IndexedJoin(b.i = c.i)\n" +
+			"     │   ├─ Filter(c.i = 2)\n" +
+			"     │   │   └─ Projected table access on [i]\n" +
+			"     │   │       └─ TableAlias(c)\n" +
+			"     │   │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     │   └─ IndexedJoin(a.i = b.i)\n" +
+			"     │       ├─ Projected table access on [i]\n" +
+			"     │       │   └─ TableAlias(b)\n" +
+			"     │       │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     │       └─ Projected table access on [i s]\n" +
+			"     │           └─ TableAlias(a)\n" +
+			"     │               └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ TableAlias(d)\n" +
+			"             └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT a.* FROM mytable a, mytable b, mytable c, mytable d where a.i = b.i AND b.i = c.i AND (c.i = d.s OR c.i = 2)`,
 		ExpectedPlan: "Project(a.i, a.s)\n" +
 			" └─ InnerJoin((c.i = d.s) OR (c.i = 2))\n" +
-			"     ├─ InnerJoin(b.i = c.i)\n" +
-			"     │   ├─ InnerJoin(a.i = b.i)\n" +
+			"     ├─ HashJoin(b.i = c.i)\n" +
+			"     │   ├─ MergeJoin(a.i = b.i)\n" +
 			"     │   │   ├─ Projected table access on [i s]\n" +
 			"     │   │   │   └─ TableAlias(a)\n" +
-			"     │   │   │       └─ Table(mytable)\n" +
+			"     │   │   │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"     │   │   └─ Projected table access on [i]\n" +
 			"     │   │       └─ TableAlias(b)\n" +
-			"     │   │           └─ Table(mytable)\n" +
+			"     │   │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"     │   └─ Projected table access on [i]\n" +
 			"     │       └─ TableAlias(c)\n" +
 			"     │           └─ Table(mytable)\n" +
@@ -740,14 +851,14 @@ var PlanTests = []QueryPlanTest{
 		Query: `SELECT a.* FROM mytable a, mytable b, mytable c, mytable d where a.i = b.i AND b.i = c.i`,
 		ExpectedPlan: "Project(a.i, a.s)\n" +
 			" └─ CrossJoin\n" +
-			"     ├─ InnerJoin(b.i = c.i)\n" +
-			"     │   ├─ InnerJoin(a.i = b.i)\n" +
+			"     ├─ HashJoin(b.i = c.i)\n" +
+			"     │   ├─ MergeJoin(a.i = b.i)\n" +
 			"     │   │   ├─ Projected table access on [i s]\n" +
 			"     │   │   │   └─ TableAlias(a)\n" +
-			"     │   │   │       └─ Table(mytable)\n" +
+			"     │   │   │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"     │   │   └─ Projected table access on [i]\n" +
 			"     │   │       └─ TableAlias(b)\n" +
-			"     │   │           └─ Table(mytable)\n" +
+			"     │   │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"     │   └─ Projected table access on [i]\n" +
 			"     │       └─ TableAlias(c)\n" +
 			"     │           └─ Table(mytable)\n" +
@@ -758,19 +869,22 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT a.* FROM mytable a CROSS JOIN mytable b where a.i = b.i`,
 		ExpectedPlan: "Project(a.i, a.s)\n" +
-			" └─ IndexedJoin(a.i = b.i)\n" +
-			"     ├─ TableAlias(a)\n" +
-			"     │   └─ Table(mytable)\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			" └─ MergeJoin(a.i = b.i)\n" +
+			"     ├─ Projected table access on [i s]\n" +
+			"     │   └─ TableAlias(a)\n" +
+			"     │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ TableAlias(b)\n" +
+			"             └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT a.* FROM mytable a CROSS JOIN mytable b where a.i = b.i OR a.i = b.s`,
 		ExpectedPlan: "Project(a.i, a.s)\n" +
 			" └─ IndexedJoin((a.i = b.i) OR (a.i = b.s))\n" +
-			"     ├─ TableAlias(a)\n" +
-			"     │   └─ Table(mytable)\n" +
+			"     ├─ Projected table access on [i s]\n" +
+			"     │   └─ TableAlias(a)\n" +
+			"     │       └─ Table(mytable)\n" +
 			"     └─ TableAlias(b)\n" +
 			"         └─ Concat\n" +
 			"             ├─ IndexedTableAccess(mytable on [mytable.i])\n" +
@@ -828,32 +942,36 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT a.* FROM mytable a CROSS JOIN mytable b CROSS JOIN mytable c CROSS JOIN mytable d where a.i = b.i AND b.i = c.i AND c.i = d.i AND c.i = 2`,
 		ExpectedPlan: "Project(a.i, a.s)\n" +
-			" └─ IndexedJoin(a.i = b.i)\n" +
-			"     ├─ TableAlias(a)\n" +
-			"     │   └─ Table(mytable)\n" +
-			"     └─ IndexedJoin(b.i = c.i)\n" +
-			"         ├─ TableAlias(b)\n" +
-			"         │   └─ IndexedTableAccess(mytable on [mytable.i])\n" +
-			"         └─ IndexedJoin(c.i = d.i)\n" +
-			"             ├─ Filter(c.i = 2)\n" +
-			"             │   └─ TableAlias(c)\n" +
-			"             │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
-			"             └─ TableAlias(d)\n" +
-			"                 └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			" └─ IndexedJoin(c.i = d.i)\n" +
+			"     ├─ IndexedJoin(b.i = c.i)\n" +
+			"     │   ├─ Filter(c.i = 2)\n" +
+			"     │   │   └─ Projected table access on [i]\n" +
+			"     │   │       └─ TableAlias(c)\n" +
+			"     │   │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     │   └─ IndexedJoin(a.i = b.i)\n" +
+			"     │       ├─ Projected table access on [i]\n" +
+			"     │       │   └─ TableAlias(b)\n" +
+			"     │       │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     │       └─ Projected table access on [i s]\n" +
+			"     │           └─ TableAlias(a)\n" +
+			"     │               └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ TableAlias(d)\n" +
+			"             └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT a.* FROM mytable a CROSS JOIN mytable b CROSS JOIN mytable c CROSS JOIN mytable d where a.i = b.i AND b.i = c.i AND (c.i = d.s OR c.i = 2)`,
 		ExpectedPlan: "Project(a.i, a.s)\n" +
 			" └─ InnerJoin((c.i = d.s) OR (c.i = 2))\n" +
-			"     ├─ InnerJoin(b.i = c.i)\n" +
-			"     │   ├─ InnerJoin(a.i = b.i)\n" +
+			"     ├─ HashJoin(b.i = c.i)\n" +
+			"     │   ├─ MergeJoin(a.i = b.i)\n" +
 			"     │   │   ├─ Projected table access on [i s]\n" +
 			"     │   │   │   └─ TableAlias(a)\n" +
-			"     │   │   │       └─ Table(mytable)\n" +
+			"     │   │   │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"     │   │   └─ Projected table access on [i]\n" +
 			"     │   │       └─ TableAlias(b)\n" +
-			"     │   │           └─ Table(mytable)\n" +
+			"     │   │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"     │   └─ Projected table access on [i]\n" +
 			"     │       └─ TableAlias(c)\n" +
 			"     │           └─ Table(mytable)\n" +
@@ -866,14 +984,14 @@ var PlanTests = []QueryPlanTest{
 		Query: `SELECT a.* FROM mytable a CROSS JOIN mytable b CROSS JOIN mytable c CROSS JOIN mytable d where a.i = b.i AND b.s = c.s`,
 		ExpectedPlan: "Project(a.i, a.s)\n" +
 			" └─ CrossJoin\n" +
-			"     ├─ InnerJoin(b.s = c.s)\n" +
-			"     │   ├─ InnerJoin(a.i = b.i)\n" +
+			"     ├─ HashJoin(b.s = c.s)\n" +
+			"     │   ├─ MergeJoin(a.i = b.i)\n" +
 			"     │   │   ├─ Projected table access on [i s]\n" +
 			"     │   │   │   └─ TableAlias(a)\n" +
-			"     │   │   │       └─ Table(mytable)\n" +
+			"     │   │   │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"     │   │   └─ Projected table access on [s i]\n" +
 			"     │   │       └─ TableAlias(b)\n" +
-			"     │   │           └─ Table(mytable)\n" +
+			"     │   │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"     │   └─ Projected table access on [s]\n" +
 			"     │       └─ TableAlias(c)\n" +
 			"     │           └─ Table(mytable)\n" +
@@ -886,10 +1004,12 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Project(a.i, a.s)\n" +
 			" └─ IndexedJoin(a.i = b.s)\n" +
 			"     ├─ Filter(a.i BETWEEN 10 AND 20)\n" +
-			"     │   └─ TableAlias(a)\n" +
-			"     │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
-			"     └─ TableAlias(b)\n" +
-			"         └─ IndexedTableAccess(mytable on [mytable.s])\n" +
+			"     │   └─ Projected table access on [i s]\n" +
+			"     │       └─ TableAlias(a)\n" +
+			"     │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [s]\n" +
+			"         └─ TableAlias(b)\n" +
+			"             └─ IndexedTableAccess(mytable on [mytable.s])\n" +
 			"",
 	},
 	{
@@ -918,7 +1038,8 @@ var PlanTests = []QueryPlanTest{
 			"     ├─ SubqueryAlias(othertable)\n" +
 			"     │   └─ Projected table access on [s2 i2]\n" +
 			"     │       └─ Table(othertable)\n" +
-			"     └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
@@ -928,7 +1049,8 @@ var PlanTests = []QueryPlanTest{
 			"     ├─ SubqueryAlias(othertable)\n" +
 			"     │   └─ Projected table access on [s2 i2]\n" +
 			"     │       └─ Table(othertable)\n" +
-			"     └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
@@ -957,7 +1079,8 @@ var PlanTests = []QueryPlanTest{
 			"     │   └─ Filter(othertable.s2 > \"a\")\n" +
 			"     │       └─ Projected table access on [s2 i2]\n" +
 			"     │           └─ IndexedTableAccess(othertable on [othertable.s2])\n" +
-			"     └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
@@ -988,63 +1111,77 @@ var PlanTests = []QueryPlanTest{
 			"     └─ Projected table access on [i2]\n" +
 			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"))\n" +
-			" └─ Table(mytable)\n" +
+			" └─ Projected table access on [i s]\n" +
+			"     └─ Table(mytable)\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM mytable mt INNER JOIN othertable ot ON mt.i = ot.i2 AND mt.i > 2`,
 		ExpectedPlan: "IndexedJoin(mt.i = ot.i2)\n" +
 			" ├─ Filter(mt.i > 2)\n" +
-			" │   └─ TableAlias(mt)\n" +
-			" │       └─ IndexedTableAccess(mytable on [mytable.i])\n" +
-			" └─ TableAlias(ot)\n" +
-			"     └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			" │   └─ Projected table access on [i s]\n" +
+			" │       └─ TableAlias(mt)\n" +
+			" │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			" └─ Projected table access on [s2 i2]\n" +
+			"     └─ TableAlias(ot)\n" +
+			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT /*+ JOIN_ORDER(mt, o) */ * FROM mytable mt INNER JOIN one_pk o ON mt.i = o.pk AND mt.s = o.c2`,
 		ExpectedPlan: "IndexedJoin((mt.i = o.pk) AND (mt.s = o.c2))\n" +
-			" ├─ TableAlias(mt)\n" +
-			" │   └─ Table(mytable)\n" +
-			" └─ TableAlias(o)\n" +
-			"     └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			" ├─ Projected table access on [i s]\n" +
+			" │   └─ TableAlias(mt)\n" +
+			" │       └─ Table(mytable)\n" +
+			" └─ Projected table access on [pk c1 c2 c3 c4 c5]\n" +
+			"     └─ TableAlias(o)\n" +
+			"         └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
 	{
 		Query: `SELECT i, i2, s2 FROM mytable RIGHT JOIN othertable ON i = i2 - 1`,
 		ExpectedPlan: "Project(mytable.i, othertable.i2, othertable.s2)\n" +
 			" └─ RightIndexedJoin(mytable.i = (othertable.i2 - 1))\n" +
-			"     ├─ Table(othertable)\n" +
-			"     └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     ├─ Projected table access on [i2 s2]\n" +
+			"     │   └─ Table(othertable)\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT * FROM tabletest, mytable mt INNER JOIN othertable ot ON mt.i = ot.i2`,
 		ExpectedPlan: "CrossJoin\n" +
-			" ├─ Table(tabletest)\n" +
+			" ├─ Projected table access on [i s]\n" +
+			" │   └─ Table(tabletest)\n" +
 			" └─ IndexedJoin(mt.i = ot.i2)\n" +
-			"     ├─ TableAlias(mt)\n" +
-			"     │   └─ Table(mytable)\n" +
-			"     └─ TableAlias(ot)\n" +
-			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"     ├─ Projected table access on [i s]\n" +
+			"     │   └─ TableAlias(mt)\n" +
+			"     │       └─ Table(mytable)\n" +
+			"     └─ Projected table access on [s2 i2]\n" +
+			"         └─ TableAlias(ot)\n" +
+			"             └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT t1.timestamp FROM reservedWordsTable t1 JOIN reservedWordsTable t2 ON t1.TIMESTAMP = t2.tImEstamp`,
 		ExpectedPlan: "Project(t1.Timestamp)\n" +
-			" └─ IndexedJoin(t1.Timestamp = t2.Timestamp)\n" +
-			"     ├─ TableAlias(t1)\n" +
-			"     │   └─ Table(reservedWordsTable)\n" +
-			"     └─ TableAlias(t2)\n" +
-			"         └─ IndexedTableAccess(reservedWordsTable on [reservedWordsTable.Timestamp])\n" +
+			" └─ MergeJoin(t1.Timestamp = t2.Timestamp)\n" +
+			"     ├─ Projected table access on [Timestamp]\n" +
+			"     │   └─ TableAlias(t1)\n" +
+			"     │       └─ IndexedTableAccess(reservedWordsTable on [reservedWordsTable.Timestamp])\n" +
+			"     └─ Projected table access on [Timestamp]\n" +
+			"         └─ TableAlias(t2)\n" +
+			"             └─ IndexedTableAccess(reservedWordsTable on [reservedWordsTable.Timestamp])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk JOIN two_pk ON one_pk.pk=two_pk.pk1 AND one_pk.pk=two_pk.pk2`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			" └─ IndexedJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
-			"     ├─ Table(one_pk)\n" +
-			"     └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			" └─ MergeJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1060,51 +1197,63 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk opk JOIN two_pk tpk ON opk.pk=tpk.pk1 AND opk.pk=tpk.pk2`,
 		ExpectedPlan: "Project(opk.pk, tpk.pk1, tpk.pk2)\n" +
-			" └─ IndexedJoin((opk.pk = tpk.pk1) AND (opk.pk = tpk.pk2))\n" +
-			"     ├─ TableAlias(opk)\n" +
-			"     │   └─ Table(one_pk)\n" +
-			"     └─ TableAlias(tpk)\n" +
-			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			" └─ MergeJoin((opk.pk = tpk.pk1) AND (opk.pk = tpk.pk2))\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ TableAlias(opk)\n" +
+			"     │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ TableAlias(tpk)\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk JOIN two_pk ON one_pk.pk=two_pk.pk1 AND one_pk.pk=two_pk.pk2`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			" └─ IndexedJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
-			"     ├─ Table(one_pk)\n" +
-			"     └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			" └─ MergeJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk LEFT JOIN two_pk ON one_pk.pk <=> two_pk.pk1 AND one_pk.pk = two_pk.pk2`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
 			" └─ LeftIndexedJoin((one_pk.pk <=> two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
-			"     ├─ Table(one_pk)\n" +
-			"     └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ Table(one_pk)\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk LEFT JOIN two_pk ON one_pk.pk = two_pk.pk1 AND one_pk.pk <=> two_pk.pk2`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			" └─ LeftIndexedJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk <=> two_pk.pk2))\n" +
-			"     ├─ Table(one_pk)\n" +
-			"     └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			" └─ LeftMergeJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk <=> two_pk.pk2))\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk LEFT JOIN two_pk ON one_pk.pk <=> two_pk.pk1 AND one_pk.pk <=> two_pk.pk2`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
 			" └─ LeftIndexedJoin((one_pk.pk <=> two_pk.pk1) AND (one_pk.pk <=> two_pk.pk2))\n" +
-			"     ├─ Table(one_pk)\n" +
-			"     └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ Table(one_pk)\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk RIGHT JOIN two_pk ON one_pk.pk=two_pk.pk1 AND one_pk.pk=two_pk.pk2`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			" └─ RightIndexedJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
-			"     ├─ Table(two_pk)\n" +
-			"     └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			" └─ LeftMergeJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
+			"     ├─ Projected table access on [pk1 pk2]\n" +
+			"     │   └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ Projected table access on [pk]\n" +
+			"         └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
 	{
@@ -1185,26 +1334,35 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT * FROM datetime_table dt1 join datetime_table dt2 on dt1.timestamp_col = dt2.timestamp_col`,
 		ExpectedPlan: "IndexedJoin(dt1.timestamp_col = dt2.timestamp_col)\n" +
-			" ├─ TableAlias(dt1)\n" +
-			" │   └─ Table(datetime_table)\n" +
-			" └─ TableAlias(dt2)\n" +
-			"     └─ IndexedTableAccess(datetime_table on [datetime_table.timestamp_col])\n",
+			" ├─ Projected table access on [i date_col datetime_col timestamp_col time_col]\n" +
+			" │   └─ TableAlias(dt1)\n" +
+			" │       └─ Table(datetime_table)\n" +
+			" └─ Projected table access on [i date_col datetime_col timestamp_col time_col]\n" +
+			"     └─ TableAlias(dt2)\n" +
+			"         └─ IndexedTableAccess(datetime_table on [datetime_table.timestamp_col])\n" +
+			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table dt1 join datetime_table dt2 on dt1.date_col = dt2.timestamp_col`,
 		ExpectedPlan: "IndexedJoin(dt1.date_col = dt2.timestamp_col)\n" +
-			" ├─ TableAlias(dt1)\n" +
-			" │   └─ Table(datetime_table)\n" +
-			" └─ TableAlias(dt2)\n" +
-			"     └─ IndexedTableAccess(datetime_table on [datetime_table.timestamp_col])\n",
+			" ├─ Projected table access on [i date_col datetime_col timestamp_col time_col]\n" +
+			" │   └─ TableAlias(dt1)\n" +
+			" │       └─ Table(datetime_table)\n" +
+			" └─ Projected table access on [i date_col datetime_col timestamp_col time_col]\n" +
+			"     └─ TableAlias(dt2)\n" +
+			"         └─ IndexedTableAccess(datetime_table on [datetime_table.timestamp_col])\n" +
+			"",
 	},
 	{
 		Query: `SELECT * FROM datetime_table dt1 join datetime_table dt2 on dt1.datetime_col = dt2.timestamp_col`,
 		ExpectedPlan: "IndexedJoin(dt1.datetime_col = dt2.timestamp_col)\n" +
-			" ├─ TableAlias(dt1)\n" +
-			" │   └─ Table(datetime_table)\n" +
-			" └─ TableAlias(dt2)\n" +
-			"     └─ IndexedTableAccess(datetime_table on [datetime_table.timestamp_col])\n",
+			" ├─ Projected table access on [i date_col datetime_col timestamp_col time_col]\n" +
+			" │   └─ TableAlias(dt1)\n" +
+			" │       └─ Table(datetime_table)\n" +
+			" └─ Projected table access on [i date_col datetime_col timestamp_col time_col]\n" +
+			"     └─ TableAlias(dt2)\n" +
+			"         └─ IndexedTableAccess(datetime_table on [datetime_table.timestamp_col])\n" +
+			"",
 	},
 	{
 		Query: `SELECT dt1.i FROM datetime_table dt1
@@ -1213,10 +1371,13 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Sort(dt1.i ASC)\n" +
 			" └─ Project(dt1.i)\n" +
 			"     └─ IndexedJoin(dt1.date_col = DATE(DATE_SUB(dt2.timestamp_col, INTERVAL 2 DAY)))\n" +
-			"         ├─ TableAlias(dt2)\n" +
-			"         │   └─ Table(datetime_table)\n" +
-			"         └─ TableAlias(dt1)\n" +
-			"             └─ IndexedTableAccess(datetime_table on [datetime_table.date_col])\n",
+			"         ├─ Projected table access on [timestamp_col]\n" +
+			"         │   └─ TableAlias(dt2)\n" +
+			"         │       └─ Table(datetime_table)\n" +
+			"         └─ Projected table access on [i date_col]\n" +
+			"             └─ TableAlias(dt1)\n" +
+			"                 └─ IndexedTableAccess(datetime_table on [datetime_table.date_col])\n" +
+			"",
 	},
 	{
 		Query: `SELECT dt1.i FROM datetime_table dt1
@@ -1227,10 +1388,13 @@ var PlanTests = []QueryPlanTest{
 			"     └─ TopN(Limit: [(3 + 0)]; dt1.i ASC)\n" +
 			"         └─ Project(dt1.i)\n" +
 			"             └─ IndexedJoin(dt1.date_col = DATE(DATE_SUB(dt2.timestamp_col, INTERVAL 2 DAY)))\n" +
-			"                 ├─ TableAlias(dt2)\n" +
-			"                 │   └─ Table(datetime_table)\n" +
-			"                 └─ TableAlias(dt1)\n" +
-			"                     └─ IndexedTableAccess(datetime_table on [datetime_table.date_col])\n",
+			"                 ├─ Projected table access on [timestamp_col]\n" +
+			"                 │   └─ TableAlias(dt2)\n" +
+			"                 │       └─ Table(datetime_table)\n" +
+			"                 └─ Projected table access on [i date_col]\n" +
+			"                     └─ TableAlias(dt1)\n" +
+			"                         └─ IndexedTableAccess(datetime_table on [datetime_table.date_col])\n" +
+			"",
 	},
 	{
 		Query: `SELECT dt1.i FROM datetime_table dt1
@@ -1240,10 +1404,13 @@ var PlanTests = []QueryPlanTest{
 			" └─ TopN(Limit: [3]; dt1.i ASC)\n" +
 			"     └─ Project(dt1.i)\n" +
 			"         └─ IndexedJoin(dt1.date_col = DATE(DATE_SUB(dt2.timestamp_col, INTERVAL 2 DAY)))\n" +
-			"             ├─ TableAlias(dt2)\n" +
-			"             │   └─ Table(datetime_table)\n" +
-			"             └─ TableAlias(dt1)\n" +
-			"                 └─ IndexedTableAccess(datetime_table on [datetime_table.date_col])\n",
+			"             ├─ Projected table access on [timestamp_col]\n" +
+			"             │   └─ TableAlias(dt2)\n" +
+			"             │       └─ Table(datetime_table)\n" +
+			"             └─ Projected table access on [i date_col]\n" +
+			"                 └─ TableAlias(dt1)\n" +
+			"                     └─ IndexedTableAccess(datetime_table on [datetime_table.date_col])\n" +
+			"",
 	},
 	{
 		Query: `SELECT pk FROM one_pk
@@ -1251,12 +1418,15 @@ var PlanTests = []QueryPlanTest{
 						JOIN two_pk tpk2 ON tpk2.pk1=TPK.pk2 AND TPK2.pk2=tpk.pk1`,
 		ExpectedPlan: "Project(one_pk.pk)\n" +
 			" └─ IndexedJoin((one_pk.pk = tpk.pk1) AND (one_pk.pk = tpk.pk2))\n" +
-			"     ├─ Table(one_pk)\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ Table(one_pk)\n" +
 			"     └─ IndexedJoin((tpk2.pk1 = tpk.pk2) AND (tpk2.pk2 = tpk.pk1))\n" +
-			"         ├─ TableAlias(tpk)\n" +
-			"         │   └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
-			"         └─ TableAlias(tpk2)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"         ├─ Projected table access on [pk2 pk1]\n" +
+			"         │   └─ TableAlias(tpk)\n" +
+			"         │       └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(tpk2)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1266,12 +1436,15 @@ var PlanTests = []QueryPlanTest{
 						JOIN two_pk tpk2 ON tpk2.pk1=TPK.pk2 AND TPK2.pk2=tpk.pk1`,
 		ExpectedPlan: "Project(one_pk.pk)\n" +
 			" └─ IndexedJoin((tpk2.pk1 = tpk.pk2) AND (tpk2.pk2 = tpk.pk1))\n" +
-			"     ├─ IndexedJoin((one_pk.pk = tpk.pk1) AND (one_pk.pk = tpk.pk2))\n" +
-			"     │   ├─ TableAlias(tpk)\n" +
-			"     │   │   └─ Table(two_pk)\n" +
-			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
-			"     └─ TableAlias(tpk2)\n" +
-			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     ├─ MergeJoin((one_pk.pk = tpk.pk1) AND (one_pk.pk = tpk.pk2))\n" +
+			"     │   ├─ Projected table access on [pk1 pk2]\n" +
+			"     │   │   └─ TableAlias(tpk)\n" +
+			"     │   │       └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     │   └─ Projected table access on [pk]\n" +
+			"     │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ TableAlias(tpk2)\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1281,12 +1454,15 @@ var PlanTests = []QueryPlanTest{
 						LEFT JOIN two_pk tpk2 ON tpk2.pk1=TPK.pk2 AND TPK2.pk2=tpk.pk1`,
 		ExpectedPlan: "Project(one_pk.pk)\n" +
 			" └─ LeftIndexedJoin((tpk2.pk1 = tpk.pk2) AND (tpk2.pk2 = tpk.pk1))\n" +
-			"     ├─ IndexedJoin((one_pk.pk = tpk.pk1) AND (one_pk.pk = tpk.pk2))\n" +
-			"     │   ├─ TableAlias(tpk)\n" +
-			"     │   │   └─ Table(two_pk)\n" +
-			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
-			"     └─ TableAlias(tpk2)\n" +
-			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     ├─ MergeJoin((one_pk.pk = tpk.pk1) AND (one_pk.pk = tpk.pk2))\n" +
+			"     │   ├─ Projected table access on [pk1 pk2]\n" +
+			"     │   │   └─ TableAlias(tpk)\n" +
+			"     │   │       └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     │   └─ Projected table access on [pk]\n" +
+			"     │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ TableAlias(tpk2)\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1297,12 +1473,15 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Sort(one_pk.pk ASC)\n" +
 			" └─ Project(one_pk.pk, tpk.pk1, tpk2.pk1, tpk.pk2, tpk2.pk2)\n" +
 			"     └─ IndexedJoin(((one_pk.pk - 1) = tpk2.pk1) AND (one_pk.pk = tpk2.pk2))\n" +
-			"         ├─ IndexedJoin((one_pk.pk = tpk.pk1) AND ((one_pk.pk - 1) = tpk.pk2))\n" +
-			"         │   ├─ Table(one_pk)\n" +
-			"         │   └─ TableAlias(tpk)\n" +
-			"         │       └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
-			"         └─ TableAlias(tpk2)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"         ├─ MergeJoin((one_pk.pk = tpk.pk1) AND ((one_pk.pk - 1) = tpk.pk2))\n" +
+			"         │   ├─ Projected table access on [pk]\n" +
+			"         │   │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         │   └─ Projected table access on [pk1 pk2]\n" +
+			"         │       └─ TableAlias(tpk)\n" +
+			"         │           └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(tpk2)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1311,12 +1490,15 @@ var PlanTests = []QueryPlanTest{
 						LEFT JOIN two_pk tpk2 ON tpk2.pk1=TPK.pk2 AND TPK2.pk2=tpk.pk1`,
 		ExpectedPlan: "Project(one_pk.pk)\n" +
 			" └─ LeftIndexedJoin((tpk2.pk1 = tpk.pk2) AND (tpk2.pk2 = tpk.pk1))\n" +
-			"     ├─ LeftIndexedJoin((one_pk.pk = tpk.pk1) AND (one_pk.pk = tpk.pk2))\n" +
-			"     │   ├─ Table(one_pk)\n" +
-			"     │   └─ TableAlias(tpk)\n" +
-			"     │       └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
-			"     └─ TableAlias(tpk2)\n" +
-			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     ├─ LeftMergeJoin((one_pk.pk = tpk.pk1) AND (one_pk.pk = tpk.pk2))\n" +
+			"     │   ├─ Projected table access on [pk]\n" +
+			"     │   │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     │   └─ Projected table access on [pk2 pk1]\n" +
+			"     │       └─ TableAlias(tpk)\n" +
+			"     │           └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ TableAlias(tpk2)\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1325,12 +1507,15 @@ var PlanTests = []QueryPlanTest{
 						JOIN two_pk tpk2 ON tpk2.pk1=TPK.pk2 AND TPK2.pk2=tpk.pk1`,
 		ExpectedPlan: "Project(one_pk.pk)\n" +
 			" └─ IndexedJoin((tpk2.pk1 = tpk.pk2) AND (tpk2.pk2 = tpk.pk1))\n" +
-			"     ├─ LeftIndexedJoin((one_pk.pk = tpk.pk1) AND (one_pk.pk = tpk.pk2))\n" +
-			"     │   ├─ Table(one_pk)\n" +
-			"     │   └─ TableAlias(tpk)\n" +
-			"     │       └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
-			"     └─ TableAlias(tpk2)\n" +
-			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     ├─ LeftMergeJoin((one_pk.pk = tpk.pk1) AND (one_pk.pk = tpk.pk2))\n" +
+			"     │   ├─ Projected table access on [pk]\n" +
+			"     │   │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     │   └─ Projected table access on [pk2 pk1]\n" +
+			"     │       └─ TableAlias(tpk)\n" +
+			"     │           └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ TableAlias(tpk2)\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1339,12 +1524,15 @@ var PlanTests = []QueryPlanTest{
 						LEFT JOIN two_pk tpk2 ON tpk2.pk1=TPK.pk2 AND TPK2.pk2=tpk.pk1`,
 		ExpectedPlan: "Project(one_pk.pk)\n" +
 			" └─ LeftIndexedJoin((tpk2.pk1 = tpk.pk2) AND (tpk2.pk2 = tpk.pk1))\n" +
-			"     ├─ IndexedJoin((one_pk.pk = tpk.pk1) AND (one_pk.pk = tpk.pk2))\n" +
-			"     │   ├─ Table(one_pk)\n" +
-			"     │   └─ TableAlias(tpk)\n" +
-			"     │       └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
-			"     └─ TableAlias(tpk2)\n" +
-			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     ├─ MergeJoin((one_pk.pk = tpk.pk1) AND (one_pk.pk = tpk.pk2))\n" +
+			"     │   ├─ Projected table access on [pk]\n" +
+			"     │   │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     │   └─ Projected table access on [pk2 pk1]\n" +
+			"     │       └─ TableAlias(tpk)\n" +
+			"     │           └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ TableAlias(tpk2)\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1353,44 +1541,55 @@ var PlanTests = []QueryPlanTest{
 						RIGHT JOIN two_pk tpk2 ON tpk.pk1=TPk2.pk2 AND tpk.pk2=TPK2.pk1`,
 		ExpectedPlan: "Project(one_pk.pk)\n" +
 			" └─ RightIndexedJoin((tpk.pk1 = tpk2.pk2) AND (tpk.pk2 = tpk2.pk1))\n" +
-			"     ├─ TableAlias(tpk2)\n" +
-			"     │   └─ Table(two_pk)\n" +
+			"     ├─ Projected table access on [pk2 pk1]\n" +
+			"     │   └─ TableAlias(tpk2)\n" +
+			"     │       └─ Table(two_pk)\n" +
 			"     └─ RightIndexedJoin((one_pk.pk = tpk.pk1) AND (one_pk.pk = tpk.pk2))\n" +
-			"         ├─ TableAlias(tpk)\n" +
-			"         │   └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
-			"         └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         ├─ Projected table access on [pk1 pk2]\n" +
+			"         │   └─ TableAlias(tpk)\n" +
+			"         │       └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"         └─ Projected table access on [pk]\n" +
+			"             └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
 	{
 		Query: `SELECT i,pk1,pk2 FROM mytable JOIN two_pk ON i-1=pk1 AND i-2=pk2`,
 		ExpectedPlan: "Project(mytable.i, two_pk.pk1, two_pk.pk2)\n" +
 			" └─ IndexedJoin(((mytable.i - 1) = two_pk.pk1) AND ((mytable.i - 2) = two_pk.pk2))\n" +
-			"     ├─ Table(mytable)\n" +
-			"     └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     ├─ Projected table access on [i]\n" +
+			"     │   └─ Table(mytable)\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk LEFT JOIN two_pk ON pk=pk1`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			" └─ LeftIndexedJoin(one_pk.pk = two_pk.pk1)\n" +
-			"     ├─ Table(one_pk)\n" +
-			"     └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			" └─ LeftMergeJoin(one_pk.pk = two_pk.pk1)\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,i,f FROM one_pk LEFT JOIN niltable ON pk=i`,
 		ExpectedPlan: "Project(one_pk.pk, niltable.i, niltable.f)\n" +
 			" └─ LeftIndexedJoin(one_pk.pk = niltable.i)\n" +
-			"     ├─ Table(one_pk)\n" +
-			"     └─ IndexedTableAccess(niltable on [niltable.i])\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ Table(one_pk)\n" +
+			"     └─ Projected table access on [i f]\n" +
+			"         └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,i,f FROM one_pk RIGHT JOIN niltable ON pk=i`,
 		ExpectedPlan: "Project(one_pk.pk, niltable.i, niltable.f)\n" +
 			" └─ RightIndexedJoin(one_pk.pk = niltable.i)\n" +
-			"     ├─ Table(niltable)\n" +
-			"     └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     ├─ Projected table access on [i f]\n" +
+			"     │   └─ Table(niltable)\n" +
+			"     └─ Projected table access on [pk]\n" +
+			"         └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
 	{
@@ -1399,20 +1598,25 @@ var PlanTests = []QueryPlanTest{
 						RIGHT JOIN niltable nt2 ON pk=nt2.i + 1`,
 		ExpectedPlan: "Project(one_pk.pk, nt.i, nt2.i)\n" +
 			" └─ RightIndexedJoin(one_pk.pk = (nt2.i + 1))\n" +
-			"     ├─ TableAlias(nt2)\n" +
-			"     │   └─ Table(niltable)\n" +
+			"     ├─ Projected table access on [i]\n" +
+			"     │   └─ TableAlias(nt2)\n" +
+			"     │       └─ Table(niltable)\n" +
 			"     └─ RightIndexedJoin(one_pk.pk = nt.i)\n" +
-			"         ├─ TableAlias(nt)\n" +
-			"         │   └─ Table(niltable)\n" +
-			"         └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         ├─ Projected table access on [i]\n" +
+			"         │   └─ TableAlias(nt)\n" +
+			"         │       └─ Table(niltable)\n" +
+			"         └─ Projected table access on [pk]\n" +
+			"             └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,i,f FROM one_pk LEFT JOIN niltable ON pk=i AND f IS NOT NULL`,
 		ExpectedPlan: "Project(one_pk.pk, niltable.i, niltable.f)\n" +
 			" └─ LeftIndexedJoin((one_pk.pk = niltable.i) AND (NOT(niltable.f IS NULL)))\n" +
-			"     ├─ Table(one_pk)\n" +
-			"     └─ IndexedTableAccess(niltable on [niltable.i])\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ Table(one_pk)\n" +
+			"     └─ Projected table access on [i f]\n" +
+			"         └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
@@ -1430,8 +1634,10 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Project(one_pk.pk, niltable.i, niltable.f)\n" +
 			" └─ Filter(NOT(niltable.f IS NULL))\n" +
 			"     └─ LeftIndexedJoin(one_pk.pk = niltable.i)\n" +
-			"         ├─ Table(one_pk)\n" +
-			"         └─ IndexedTableAccess(niltable on [niltable.i])\n" +
+			"         ├─ Projected table access on [pk]\n" +
+			"         │   └─ Table(one_pk)\n" +
+			"         └─ Projected table access on [i f]\n" +
+			"             └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
@@ -1439,8 +1645,10 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Project(one_pk.pk, niltable.i, niltable.f)\n" +
 			" └─ Filter(niltable.i2 > 1)\n" +
 			"     └─ LeftIndexedJoin(one_pk.pk = niltable.i)\n" +
-			"         ├─ Table(one_pk)\n" +
-			"         └─ IndexedTableAccess(niltable on [niltable.i])\n" +
+			"         ├─ Projected table access on [pk]\n" +
+			"         │   └─ Table(one_pk)\n" +
+			"         └─ Projected table access on [i f i2]\n" +
+			"             └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
@@ -1448,8 +1656,10 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Project(one_pk.pk, niltable.i, niltable.f)\n" +
 			" └─ Filter(niltable.i > 1)\n" +
 			"     └─ LeftIndexedJoin(one_pk.pk = niltable.i)\n" +
-			"         ├─ Table(one_pk)\n" +
-			"         └─ IndexedTableAccess(niltable on [niltable.i])\n" +
+			"         ├─ Projected table access on [pk]\n" +
+			"         │   └─ Table(one_pk)\n" +
+			"         └─ Projected table access on [i f]\n" +
+			"             └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
@@ -1457,8 +1667,10 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Project(one_pk.pk, niltable.i, niltable.f)\n" +
 			" └─ LeftIndexedJoin(one_pk.pk = niltable.i)\n" +
 			"     ├─ Filter(one_pk.c1 > 10)\n" +
-			"     │   └─ Table(one_pk)\n" +
-			"     └─ IndexedTableAccess(niltable on [niltable.i])\n" +
+			"     │   └─ Projected table access on [pk c1]\n" +
+			"     │       └─ Table(one_pk)\n" +
+			"     └─ Projected table access on [i f]\n" +
+			"         └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
@@ -1466,8 +1678,10 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Project(one_pk.pk, niltable.i, niltable.f)\n" +
 			" └─ RightIndexedJoin(one_pk.pk = niltable.i)\n" +
 			"     ├─ Filter(NOT(niltable.f IS NULL))\n" +
-			"     │   └─ Table(niltable)\n" +
-			"     └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     │   └─ Projected table access on [i f]\n" +
+			"     │       └─ Table(niltable)\n" +
+			"     └─ Projected table access on [pk]\n" +
+			"         └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
 	{
@@ -1475,8 +1689,10 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Project(one_pk.pk, niltable.i, niltable.f)\n" +
 			" └─ LeftIndexedJoin(one_pk.pk = niltable.i)\n" +
 			"     ├─ Filter(one_pk.pk > 1)\n" +
-			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
-			"     └─ IndexedTableAccess(niltable on [niltable.i])\n" +
+			"     │   └─ Projected table access on [pk]\n" +
+			"     │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [i f]\n" +
+			"         └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
@@ -1484,35 +1700,43 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Project(one_pk.pk, niltable.i, niltable.f)\n" +
 			" └─ Filter(one_pk.pk > 0)\n" +
 			"     └─ RightIndexedJoin(one_pk.pk = niltable.i)\n" +
-			"         ├─ Table(niltable)\n" +
-			"         └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         ├─ Projected table access on [i f]\n" +
+			"         │   └─ Table(niltable)\n" +
+			"         └─ Projected table access on [pk]\n" +
+			"             └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk JOIN two_pk ON pk=pk1`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			" └─ IndexedJoin(one_pk.pk = two_pk.pk1)\n" +
-			"     ├─ Table(one_pk)\n" +
-			"     └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			" └─ MergeJoin(one_pk.pk = two_pk.pk1)\n" +
+			"     ├─ Projected table access on [pk]\n" +
+			"     │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ Projected table access on [pk1 pk2]\n" +
+			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT /*+ JOIN_ORDER(two_pk, one_pk) */ pk,pk1,pk2 FROM one_pk JOIN two_pk ON pk=pk1`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			" └─ IndexedJoin(one_pk.pk = two_pk.pk1)\n" +
-			"     ├─ Table(two_pk)\n" +
-			"     └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			" └─ MergeJoin(one_pk.pk = two_pk.pk1)\n" +
+			"     ├─ Projected table access on [pk1 pk2]\n" +
+			"     │   └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ Projected table access on [pk]\n" +
+			"         └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
 	{
 		Query: `SELECT a.pk1,a.pk2,b.pk1,b.pk2 FROM two_pk a JOIN two_pk b ON a.pk1=b.pk1 AND a.pk2=b.pk2 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(a.pk1 ASC, a.pk2 ASC, b.pk1 ASC)\n" +
 			" └─ Project(a.pk1, a.pk2, b.pk1, b.pk2)\n" +
-			"     └─ IndexedJoin((a.pk1 = b.pk1) AND (a.pk2 = b.pk2))\n" +
-			"         ├─ TableAlias(a)\n" +
-			"         │   └─ Table(two_pk)\n" +
-			"         └─ TableAlias(b)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ MergeJoin((a.pk1 = b.pk1) AND (a.pk2 = b.pk2))\n" +
+			"         ├─ Projected table access on [pk1 pk2]\n" +
+			"         │   └─ TableAlias(a)\n" +
+			"         │       └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(b)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1520,21 +1744,25 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Sort(a.pk1 ASC, a.pk2 ASC, b.pk1 ASC)\n" +
 			" └─ Project(a.pk1, a.pk2, b.pk1, b.pk2)\n" +
 			"     └─ IndexedJoin((a.pk1 = b.pk2) AND (a.pk2 = b.pk1))\n" +
-			"         ├─ TableAlias(a)\n" +
-			"         │   └─ Table(two_pk)\n" +
-			"         └─ TableAlias(b)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"         ├─ Projected table access on [pk1 pk2]\n" +
+			"         │   └─ TableAlias(a)\n" +
+			"         │       └─ Table(two_pk)\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(b)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT a.pk1,a.pk2,b.pk1,b.pk2 FROM two_pk a JOIN two_pk b ON b.pk1=a.pk1 AND a.pk2=b.pk2 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(a.pk1 ASC, a.pk2 ASC, b.pk1 ASC)\n" +
 			" └─ Project(a.pk1, a.pk2, b.pk1, b.pk2)\n" +
-			"     └─ IndexedJoin((b.pk1 = a.pk1) AND (a.pk2 = b.pk2))\n" +
-			"         ├─ TableAlias(a)\n" +
-			"         │   └─ Table(two_pk)\n" +
-			"         └─ TableAlias(b)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ MergeJoin((b.pk1 = a.pk1) AND (a.pk2 = b.pk2))\n" +
+			"         ├─ Projected table access on [pk1 pk2]\n" +
+			"         │   └─ TableAlias(a)\n" +
+			"         │       └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(b)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1542,21 +1770,25 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Sort(a.pk1 ASC, a.pk2 ASC, b.pk1 ASC)\n" +
 			" └─ Project(a.pk1, a.pk2, b.pk1, b.pk2)\n" +
 			"     └─ IndexedJoin(((a.pk1 + 1) = b.pk1) AND ((a.pk2 + 1) = b.pk2))\n" +
-			"         ├─ TableAlias(a)\n" +
-			"         │   └─ Table(two_pk)\n" +
-			"         └─ TableAlias(b)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"         ├─ Projected table access on [pk1 pk2]\n" +
+			"         │   └─ TableAlias(a)\n" +
+			"         │       └─ Table(two_pk)\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(b)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT a.pk1,a.pk2,b.pk1,b.pk2 FROM two_pk a, two_pk b WHERE a.pk1=b.pk1 AND a.pk2=b.pk2 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(a.pk1 ASC, a.pk2 ASC, b.pk1 ASC)\n" +
 			" └─ Project(a.pk1, a.pk2, b.pk1, b.pk2)\n" +
-			"     └─ IndexedJoin((a.pk1 = b.pk1) AND (a.pk2 = b.pk2))\n" +
-			"         ├─ TableAlias(a)\n" +
-			"         │   └─ Table(two_pk)\n" +
-			"         └─ TableAlias(b)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ MergeJoin((a.pk1 = b.pk1) AND (a.pk2 = b.pk2))\n" +
+			"         ├─ Projected table access on [pk1 pk2]\n" +
+			"         │   └─ TableAlias(a)\n" +
+			"         │       └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(b)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1564,61 +1796,73 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Sort(a.pk1 ASC, a.pk2 ASC, b.pk1 ASC)\n" +
 			" └─ Project(a.pk1, a.pk2, b.pk1, b.pk2)\n" +
 			"     └─ IndexedJoin((a.pk1 = b.pk2) AND (a.pk2 = b.pk1))\n" +
-			"         ├─ TableAlias(a)\n" +
-			"         │   └─ Table(two_pk)\n" +
-			"         └─ TableAlias(b)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"         ├─ Projected table access on [pk1 pk2]\n" +
+			"         │   └─ TableAlias(a)\n" +
+			"         │       └─ Table(two_pk)\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(b)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT one_pk.c5,pk1,pk2 FROM one_pk JOIN two_pk ON pk=pk1 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(one_pk.c5 ASC, two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
 			" └─ Project(one_pk.c5, two_pk.pk1, two_pk.pk2)\n" +
-			"     └─ IndexedJoin(one_pk.pk = two_pk.pk1)\n" +
-			"         ├─ Table(one_pk)\n" +
-			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ MergeJoin(one_pk.pk = two_pk.pk1)\n" +
+			"         ├─ Projected table access on [c5 pk]\n" +
+			"         │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT opk.c5,pk1,pk2 FROM one_pk opk JOIN two_pk tpk ON opk.pk=tpk.pk1 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(opk.c5 ASC, tpk.pk1 ASC, tpk.pk2 ASC)\n" +
 			" └─ Project(opk.c5, tpk.pk1, tpk.pk2)\n" +
-			"     └─ IndexedJoin(opk.pk = tpk.pk1)\n" +
-			"         ├─ TableAlias(opk)\n" +
-			"         │   └─ Table(one_pk)\n" +
-			"         └─ TableAlias(tpk)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ MergeJoin(opk.pk = tpk.pk1)\n" +
+			"         ├─ Projected table access on [c5 pk]\n" +
+			"         │   └─ TableAlias(opk)\n" +
+			"         │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(tpk)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT opk.c5,pk1,pk2 FROM one_pk opk JOIN two_pk tpk ON pk=pk1 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(opk.c5 ASC, tpk.pk1 ASC, tpk.pk2 ASC)\n" +
 			" └─ Project(opk.c5, tpk.pk1, tpk.pk2)\n" +
-			"     └─ IndexedJoin(opk.pk = tpk.pk1)\n" +
-			"         ├─ TableAlias(opk)\n" +
-			"         │   └─ Table(one_pk)\n" +
-			"         └─ TableAlias(tpk)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ MergeJoin(opk.pk = tpk.pk1)\n" +
+			"         ├─ Projected table access on [c5 pk]\n" +
+			"         │   └─ TableAlias(opk)\n" +
+			"         │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(tpk)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT opk.c5,pk1,pk2 FROM one_pk opk, two_pk tpk WHERE pk=pk1 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(opk.c5 ASC, tpk.pk1 ASC, tpk.pk2 ASC)\n" +
 			" └─ Project(opk.c5, tpk.pk1, tpk.pk2)\n" +
-			"     └─ IndexedJoin(opk.pk = tpk.pk1)\n" +
-			"         ├─ TableAlias(opk)\n" +
-			"         │   └─ Table(one_pk)\n" +
-			"         └─ TableAlias(tpk)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ MergeJoin(opk.pk = tpk.pk1)\n" +
+			"         ├─ Projected table access on [c5 pk]\n" +
+			"         │   └─ TableAlias(opk)\n" +
+			"         │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(tpk)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT one_pk.c5,pk1,pk2 FROM one_pk,two_pk WHERE pk=pk1 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(one_pk.c5 ASC, two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
 			" └─ Project(one_pk.c5, two_pk.pk1, two_pk.pk2)\n" +
-			"     └─ IndexedJoin(one_pk.pk = two_pk.pk1)\n" +
-			"         ├─ Table(one_pk)\n" +
-			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ MergeJoin(one_pk.pk = two_pk.pk1)\n" +
+			"         ├─ Projected table access on [c5 pk]\n" +
+			"         │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1626,8 +1870,10 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Sort(one_pk.pk ASC)\n" +
 			" └─ Project(one_pk.pk, niltable.i, niltable.f)\n" +
 			"     └─ LeftIndexedJoin(one_pk.pk = niltable.i)\n" +
-			"         ├─ Table(one_pk)\n" +
-			"         └─ IndexedTableAccess(niltable on [niltable.i])\n" +
+			"         ├─ Projected table access on [pk]\n" +
+			"         │   └─ Table(one_pk)\n" +
+			"         └─ Projected table access on [i f]\n" +
+			"             └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
@@ -1636,8 +1882,10 @@ var PlanTests = []QueryPlanTest{
 			" └─ Project(one_pk.pk, niltable.i, niltable.f)\n" +
 			"     └─ Filter(NOT(niltable.f IS NULL))\n" +
 			"         └─ LeftIndexedJoin(one_pk.pk = niltable.i)\n" +
-			"             ├─ Table(one_pk)\n" +
-			"             └─ IndexedTableAccess(niltable on [niltable.i])\n" +
+			"             ├─ Projected table access on [pk]\n" +
+			"             │   └─ Table(one_pk)\n" +
+			"             └─ Projected table access on [i f]\n" +
+			"                 └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
@@ -1646,8 +1894,10 @@ var PlanTests = []QueryPlanTest{
 			" └─ Project(one_pk.pk, niltable.i, niltable.f)\n" +
 			"     └─ LeftIndexedJoin(one_pk.pk = niltable.i)\n" +
 			"         ├─ Filter(one_pk.pk > 1)\n" +
-			"         │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
-			"         └─ IndexedTableAccess(niltable on [niltable.i])\n" +
+			"         │   └─ Projected table access on [pk]\n" +
+			"         │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [i f]\n" +
+			"             └─ IndexedTableAccess(niltable on [niltable.i])\n" +
 			"",
 	},
 	{
@@ -1655,8 +1905,10 @@ var PlanTests = []QueryPlanTest{
 		ExpectedPlan: "Sort(niltable.i ASC, niltable.f ASC)\n" +
 			" └─ Project(one_pk.pk, niltable.i, niltable.f)\n" +
 			"     └─ RightIndexedJoin(one_pk.pk = niltable.i)\n" +
-			"         ├─ Table(niltable)\n" +
-			"         └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         ├─ Projected table access on [i f]\n" +
+			"         │   └─ Table(niltable)\n" +
+			"         └─ Projected table access on [pk]\n" +
+			"             └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
 	{
@@ -1665,8 +1917,10 @@ var PlanTests = []QueryPlanTest{
 			" └─ Project(one_pk.pk, niltable.i, niltable.f)\n" +
 			"     └─ RightIndexedJoin(one_pk.pk = niltable.i)\n" +
 			"         ├─ Filter(NOT(niltable.f IS NULL))\n" +
-			"         │   └─ Table(niltable)\n" +
-			"         └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         │   └─ Projected table access on [i f]\n" +
+			"         │       └─ Table(niltable)\n" +
+			"         └─ Projected table access on [pk]\n" +
+			"             └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
 	{
@@ -1675,8 +1929,10 @@ var PlanTests = []QueryPlanTest{
 			" └─ Project(one_pk.pk, niltable.i, niltable.f)\n" +
 			"     └─ Filter(one_pk.pk > 0)\n" +
 			"         └─ RightIndexedJoin(one_pk.pk = niltable.i)\n" +
-			"             ├─ Table(niltable)\n" +
-			"             └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"             ├─ Projected table access on [i f]\n" +
+			"             │   └─ Table(niltable)\n" +
+			"             └─ Projected table access on [pk]\n" +
+			"                 └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
 	{
@@ -1694,9 +1950,11 @@ var PlanTests = []QueryPlanTest{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk JOIN two_pk ON one_pk.pk=two_pk.pk1 AND one_pk.pk=two_pk.pk2 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(one_pk.pk ASC, two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
 			" └─ Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			"     └─ IndexedJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
-			"         ├─ Table(one_pk)\n" +
-			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ MergeJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
+			"         ├─ Projected table access on [pk]\n" +
+			"         │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
@@ -1725,56 +1983,66 @@ var PlanTests = []QueryPlanTest{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk LEFT JOIN two_pk ON one_pk.pk=two_pk.pk1 AND one_pk.pk=two_pk.pk2 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(one_pk.pk ASC, two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
 			" └─ Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			"     └─ LeftIndexedJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
-			"         ├─ Table(one_pk)\n" +
-			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ LeftMergeJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
+			"         ├─ Projected table access on [pk]\n" +
+			"         │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk LEFT JOIN two_pk ON pk=pk1 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(one_pk.pk ASC, two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
 			" └─ Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			"     └─ LeftIndexedJoin(one_pk.pk = two_pk.pk1)\n" +
-			"         ├─ Table(one_pk)\n" +
-			"         └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ LeftMergeJoin(one_pk.pk = two_pk.pk1)\n" +
+			"         ├─ Projected table access on [pk]\n" +
+			"         │   └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk RIGHT JOIN two_pk ON one_pk.pk=two_pk.pk1 AND one_pk.pk=two_pk.pk2 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(one_pk.pk ASC, two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
 			" └─ Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			"     └─ RightIndexedJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
-			"         ├─ Table(two_pk)\n" +
-			"         └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"     └─ LeftMergeJoin((one_pk.pk = two_pk.pk1) AND (one_pk.pk = two_pk.pk2))\n" +
+			"         ├─ Projected table access on [pk1 pk2]\n" +
+			"         │   └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"         └─ Projected table access on [pk]\n" +
+			"             └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk opk JOIN two_pk tpk ON opk.pk=tpk.pk1 AND opk.pk=tpk.pk2 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(opk.pk ASC, tpk.pk1 ASC, tpk.pk2 ASC)\n" +
 			" └─ Project(opk.pk, tpk.pk1, tpk.pk2)\n" +
-			"     └─ IndexedJoin((opk.pk = tpk.pk1) AND (opk.pk = tpk.pk2))\n" +
-			"         ├─ TableAlias(opk)\n" +
-			"         │   └─ Table(one_pk)\n" +
-			"         └─ TableAlias(tpk)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ MergeJoin((opk.pk = tpk.pk1) AND (opk.pk = tpk.pk2))\n" +
+			"         ├─ Projected table access on [pk]\n" +
+			"         │   └─ TableAlias(opk)\n" +
+			"         │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(tpk)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk opk JOIN two_pk tpk ON pk=tpk.pk1 AND pk=tpk.pk2 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(opk.pk ASC, tpk.pk1 ASC, tpk.pk2 ASC)\n" +
 			" └─ Project(opk.pk, tpk.pk1, tpk.pk2)\n" +
-			"     └─ IndexedJoin((opk.pk = tpk.pk1) AND (opk.pk = tpk.pk2))\n" +
-			"         ├─ TableAlias(opk)\n" +
-			"         │   └─ Table(one_pk)\n" +
-			"         └─ TableAlias(tpk)\n" +
-			"             └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"     └─ MergeJoin((opk.pk = tpk.pk1) AND (opk.pk = tpk.pk2))\n" +
+			"         ├─ Projected table access on [pk]\n" +
+			"         │   └─ TableAlias(opk)\n" +
+			"         │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         └─ Projected table access on [pk1 pk2]\n" +
+			"             └─ TableAlias(tpk)\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"",
 	},
 	{
 		Query: `SELECT pk,pk1,pk2 FROM one_pk,two_pk WHERE one_pk.c1=two_pk.c1 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(one_pk.pk ASC, two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
 			" └─ Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
-			"     └─ InnerJoin(one_pk.c1 = two_pk.c1)\n" +
+			"     └─ HashJoin(one_pk.c1 = two_pk.c1)\n" +
 			"         ├─ Projected table access on [pk c1]\n" +
 			"         │   └─ Table(one_pk)\n" +
 			"         └─ Projected table access on [pk1 pk2 c1]\n" +
@@ -1785,7 +2053,7 @@ var PlanTests = []QueryPlanTest{
 		Query: `SELECT pk,pk1,pk2,one_pk.c1 AS foo, two_pk.c1 AS bar FROM one_pk JOIN two_pk ON one_pk.c1=two_pk.c1 ORDER BY 1,2,3`,
 		ExpectedPlan: "Sort(one_pk.pk ASC, two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
 			" └─ Project(one_pk.pk, two_pk.pk1, two_pk.pk2, one_pk.c1 as foo, two_pk.c1 as bar)\n" +
-			"     └─ InnerJoin(one_pk.c1 = two_pk.c1)\n" +
+			"     └─ HashJoin(one_pk.c1 = two_pk.c1)\n" +
 			"         ├─ Projected table access on [pk c1]\n" +
 			"         │   └─ Table(one_pk)\n" +
 			"         └─ Projected table access on [pk1 pk2 c1]\n" +
@@ -1795,7 +2063,7 @@ var PlanTests = []QueryPlanTest{
 	{
 		Query: `SELECT pk,pk1,pk2,one_pk.c1 AS foo,two_pk.c1 AS bar FROM one_pk JOIN two_pk ON one_pk.c1=two_pk.c1 WHERE one_pk.c1=10`,
 		ExpectedPlan: "Project(one_pk.pk, two_pk.pk1, two_pk.pk2, one_pk.c1 as foo, two_pk.c1 as bar)\n" +
-			" └─ InnerJoin(one_pk.c1 = two_pk.c1)\n" +
+			" └─ HashJoin(one_pk.c1 = two_pk.c1)\n" +
 			"     ├─ Filter(one_pk.c1 = 10)\n" +
 			"     │   └─ Projected table access on [pk c1]\n" +
 			"     │       └─ Table(one_pk)\n" +
@@ -1803,6 +2071,28 @@ var PlanTests = []QueryPlanTest{
 			"         └─ Table(two_pk)\n" +
 			"",
 	},
+	{
+		Query: `SELECT pk,pk1,pk2 FROM one_pk LEFT JOIN two_pk ON one_pk.c1=two_pk.c1 AND two_pk.pk2=1 ORDER BY 1,2,3`,
+		ExpectedPlan: "Sort(one_pk.pk ASC, two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
+			" └─ Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
+			"     └─ LeftHashJoin((one_pk.c1 = two_pk.c1) AND (two_pk.pk2 = 1))\n" +
+			"         ├─ Projected table access on [pk c1]\n" +
+			"         │   └─ Table(one_pk)\n" +
+			"         └─ Projected table access on [pk1 pk2 c1]\n" +
+			"             └─ Table(two_pk)\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk,pk1,pk2 FROM one_pk RIGHT JOIN two_pk ON one_pk.c1=two_pk.c1 AND one_pk.pk>1 ORDER BY 2,3`,
+		ExpectedPlan: "Sort(two_pk.pk1 ASC, two_pk.pk2 ASC)\n" +
+			" └─ Project(one_pk.pk, two_pk.pk1, two_pk.pk2)\n" +
+			"     └─ RightHashJoin((one_pk.c1 = two_pk.c1) AND (one_pk.pk > 1))\n" +
+			"         ├─ Projected table access on [pk c1]\n" +
+			"         │   └─ Table(one_pk)\n" +
+			"         └─ Projected table access on [pk1 pk2 c1]\n" +
+			"             └─ Table(two_pk)\n" +
+			"",
+	},
 	{
 		Query: `SELECT pk,pk2 FROM one_pk t1, two_pk t2 WHERE pk=1 AND pk2=1 ORDER BY 1,2`,
 		ExpectedPlan: "Sort(t1.pk ASC, t2.pk2 ASC)\n" +
@@ -1848,8 +2138,9 @@ var PlanTests = []QueryPlanTest{
 			"         └─ Projected table access on [i2]\n" +
 			"             └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"    ) IS NULL)))\n" +
-			"     └─ TableAlias(mt)\n" +
-			"         └─ Table(mytable)\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ TableAlias(mt)\n" +
+			"             └─ Table(mytable)\n" +
 			"",
 	},
 	{
@@ -1866,8 +2157,9 @@ var PlanTests = []QueryPlanTest{
 			"         └─ Projected table access on [i2]\n" +
 			"             └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
 			"    ) IS NULL)))\n" +
-			"     └─ TableAlias(mt)\n" +
-			"         └─ Table(mytable)\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ TableAlias(mt)\n" +
+			"             └─ Table(mytable)\n" +
 			"",
 	},
 	{
@@ -1881,11 +2173,13 @@ var PlanTests = []QueryPlanTest{
 			"    ) as (SELECT pk from one_pk where pk = 1 limit 1))\n" +
 			"     └─ CrossJoin\n" +
 			"         ├─ Filter(t1.pk = 1)\n" +
-			"         │   └─ TableAlias(t1)\n" +
-			"         │       └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
+			"         │   └─ Projected table access on [pk]\n" +
+			"         │       └─ TableAlias(t1)\n" +
+			"         │           └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"         └─ Filter(t2.pk2 = 1)\n" +
-			"             └─ TableAlias(t2)\n" +
-			"                 └─ Table(two_pk)\n" +
+			"             └─ Projected table access on [pk2]\n" +
+			"                 └─ TableAlias(t2)\n" +
+			"                     └─ Table(two_pk)\n" +
 			"",
 	},
 	{
@@ -1975,8 +2269,8 @@ var PlanTests = []QueryPlanTest{
 			" └─ Update Join\n" +
 			"     └─ UpdateSource(SET two_pk.c1 = (two_pk.c1 + 1))\n" +
 			"         └─ Project(one_pk.pk, one_pk.c1, one_pk.c2, one_pk.c3, one_pk.c4, one_pk.c5, two_pk.pk1, two_pk.pk2, two_pk.c1, two_pk.c2, two_pk.c3, two_pk.c4, two_pk.c5)\n" +
-			"             └─ IndexedJoin(one_pk.pk = two_pk.pk1)\n" +
-			"                 ├─ Table(two_pk)\n" +
+			"             └─ MergeJoin(one_pk.pk = two_pk.pk1)\n" +
+			"                 ├─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
 			"                 └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
@@ -1997,21 +2291,25 @@ var PlanTests = []QueryPlanTest{
 		Query: `SELECT a.* FROM invert_pk as a, invert_pk as b WHERE a.y = b.z`,
 		ExpectedPlan: "Project(a.x, a.y, a.z)\n" +
 			" └─ IndexedJoin(a.y = b.z)\n" +
-			"     ├─ TableAlias(b)\n" +
-			"     │   └─ Table(invert_pk)\n" +
-			"     └─ TableAlias(a)\n" +
-			"         └─ IndexedTableAccess(invert_pk on [invert_pk.y,invert_pk.z,invert_pk.x])\n" +
+			"     ├─ Projected table access on [z]\n" +
+			"     │   └─ TableAlias(b)\n" +
+			"     │       └─ Table(invert_pk)\n" +
+			"     └─ Projected table access on [x y z]\n" +
+			"         └─ TableAlias(a)\n" +
+			"             └─ IndexedTableAccess(invert_pk on [invert_pk.y,invert_pk.z,invert_pk.x])\n" +
 			"",
 	},
 	{
 		Query: `SELECT a.* FROM invert_pk as a, invert_pk as b WHERE a.y = b.z AND a.z = 2`,
 		ExpectedPlan: "Project(a.x, a.y, a.z)\n" +
-			" └─ IndexedJoin(a.y = b.z)\n" +
-			"     ├─ TableAlias(b)\n" +
-			"     │   └─ Table(invert_pk)\n" +
-			"     └─ Filter(a.z = 2)\n" +
-			"         └─ TableAlias(a)\n" +
-			"             └─ IndexedTableAccess(invert_pk on [invert_pk.y,invert_pk.z,invert_pk.x])\n" +
+			" └─ HashJoin(a.y = b.z)\n" +
+			"     ├─ Filter(a.z = 2)\n" +
+			"     │   └─ Projected table access on [x y z]\n" +
+			"     │       └─ TableAlias(a)\n" +
+			"     │           └─ Table(invert_pk)\n" +
+			"     └─ Projected table access on [z]\n" +
+			"         └─ TableAlias(b)\n" +
+			"             └─ Table(invert_pk)\n" +
 			"",
 	},
 	{
@@ -2042,6 +2340,204 @@ var PlanTests = []QueryPlanTest{
 			"     └─ IndexedTableAccess(one_pk on [one_pk.pk])\n" +
 			"",
 	},
+	{
+		Query: `SELECT i, (SELECT s2 FROM othertable WHERE i2 = i) FROM mytable`,
+		ExpectedPlan: "Project(mytable.i, (Project(othertable.s2)\n" +
+			" └─ Filter(othertable.i2 = mytable.i)\n" +
+			"     └─ Projected table access on [s2 i2]\n" +
+			"         └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			") as (SELECT s2 FROM othertable WHERE i2 = i))\n" +
+			" └─ Projected table access on [i]\n" +
+			"     └─ Table(mytable)\n" +
+			"",
+	},
+	{
+		Query: `UPDATE mytable SET s = 'updated' WHERE i IN (SELECT i2 FROM othertable WHERE s2 = 'first')`,
+		ExpectedPlan: "Update\n" +
+			" └─ UpdateSource(SET mytable.s = \"updated\")\n" +
+			"     └─ IndexedInSubqueryFilter(mytable.i IN ((Project(othertable.i2)\n" +
+			"         └─ Projected table access on [i2 s2]\n" +
+			"             └─ Filter(othertable.s2 = \"first\")\n" +
+			"                 └─ IndexedTableAccess(othertable on [othertable.s2])\n" +
+			"        )))\n" +
+			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"",
+	},
+	{
+		Query: `DELETE FROM mytable WHERE i IN (SELECT i2 FROM othertable WHERE s2 <> 'first')`,
+		ExpectedPlan: "Delete\n" +
+			" └─ IndexedInSubqueryFilter(mytable.i IN ((Project(othertable.i2)\n" +
+			"     └─ Projected table access on [i2 s2]\n" +
+			"         └─ Filter(NOT((othertable.s2 = \"first\")))\n" +
+			"             └─ IndexedTableAccess(othertable on [othertable.s2])\n" +
+			"    )))\n" +
+			"     └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"",
+	},
+	{
+		// Each table gets the filter implied by a disjunction over several tables, and an index lookup for it
+		Query: `SELECT * FROM mytable a, othertable b WHERE (a.i = 1 AND b.i2 = 2) OR (a.i = 3 AND b.i2 = 1)`,
+		ExpectedPlan: "Filter(((a.i = 1) AND (b.i2 = 2)) OR ((a.i = 3) AND (b.i2 = 1)))\n" +
+			" └─ CrossJoin\n" +
+			"     ├─ Filter((a.i = 1) OR (a.i = 3))\n" +
+			"     │   └─ Projected table access on [i s]\n" +
+			"     │       └─ TableAlias(a)\n" +
+			"     │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"     └─ Filter((b.i2 = 2) OR (b.i2 = 1))\n" +
+			"         └─ Projected table access on [s2 i2]\n" +
+			"             └─ TableAlias(b)\n" +
+			"                 └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"",
+	},
+	{
+		Query: `SELECT a.i, b.s2 FROM mytable a, othertable b WHERE (a.i IN (1, 2) AND b.s2 = 'first') OR (a.i BETWEEN 3 AND 4 AND b.s2 = 'third')`,
+		ExpectedPlan: "Project(a.i, b.s2)\n" +
+			" └─ Filter(((a.i HASH IN (1, 2)) AND (b.s2 = \"first\")) OR ((a.i BETWEEN 3 AND 4) AND (b.s2 = \"third\")))\n" +
+			"     └─ CrossJoin\n" +
+			"         ├─ Filter((a.i HASH IN (1, 2)) OR (a.i BETWEEN 3 AND 4))\n" +
+			"         │   └─ Projected table access on [i]\n" +
+			"         │       └─ TableAlias(a)\n" +
+			"         │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"         └─ Filter((b.s2 = \"first\") OR (b.s2 = \"third\"))\n" +
+			"             └─ Projected table access on [s2]\n" +
+			"                 └─ TableAlias(b)\n" +
+			"                     └─ IndexedTableAccess(othertable on [othertable.s2])\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM mytable a JOIN othertable b ON a.i = b.i2 WHERE (a.s = 'first row' AND b.s2 = 'third') OR (a.s = 'third row' AND b.s2 = 'first')`,
+		ExpectedPlan: "Filter(((a.s = \"first row\") AND (b.s2 = \"third\")) OR ((a.s = \"third row\") AND (b.s2 = \"first\")))\n" +
+			" └─ IndexedJoin(a.i = b.i2)\n" +
+			"     ├─ Filter((a.s = \"first row\") OR (a.s = \"third row\"))\n" +
+			"     │   └─ Projected table access on [i s]\n" +
+			"     │       └─ TableAlias(a)\n" +
+			"     │           └─ IndexedTableAccess(mytable on [mytable.s])\n" +
+			"     └─ Filter((b.s2 = \"third\") OR (b.s2 = \"first\"))\n" +
+			"         └─ Projected table access on [s2 i2]\n" +
+			"             └─ TableAlias(b)\n" +
+			"                 └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"",
+	},
+	{
+		// Implied filters are not pushed to the secondary table of a left join
+		Query: `SELECT * FROM mytable a LEFT JOIN othertable b ON a.i = b.i2 WHERE (a.i = 1 AND b.s2 = 'third') OR (a.i = 2 AND b.s2 IS NULL)`,
+		ExpectedPlan: "Project(a.i, a.s, b.s2, b.i2)\n" +
+			" └─ Filter(((a.i = 1) AND (b.s2 = \"third\")) OR ((a.i = 2) AND b.s2 IS NULL))\n" +
+			"     └─ LeftIndexedJoin(a.i = b.i2)\n" +
+			"         ├─ Filter((a.i = 1) OR (a.i = 2))\n" +
+			"         │   └─ Projected table access on [i s]\n" +
+			"         │       └─ TableAlias(a)\n" +
+			"         │           └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"         └─ Projected table access on [s2 i2]\n" +
+			"             └─ TableAlias(b)\n" +
+			"                 └─ IndexedTableAccess(othertable on [othertable.i2])\n" +
+			"",
+	},
+	{
+		Query: `SELECT * FROM mytable WHERE i = ABS(-2) OR i BETWEEN 2 + 1 AND 4`,
+		ExpectedPlan: "Filter((mytable.i = 2) OR (mytable.i BETWEEN 3 AND 4))\n" +
+			" └─ Projected table access on [i s]\n" +
+			"     └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"",
+	},
+	{
+		Query: `SELECT i, count(*) FROM mytable GROUP BY i`,
+		ExpectedPlan: "Project(mytable.i, COUNT(*) as count(*))\n" +
+			" └─ GroupBy(sorted)\n" +
+			"     ├─ SelectedExprs(mytable.i, COUNT(*))\n" +
+			"     ├─ Grouping(mytable.i)\n" +
+			"     └─ Projected table access on [i]\n" +
+			"         └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"",
+	},
+	{
+		Query: `SELECT i FROM mytable t1 WHERE i = (SELECT MAX(i2) FROM othertable t2 WHERE t2.i2 = t1.i AND t2.s2 <> 'second') ORDER BY i`,
+		ExpectedPlan: "Sort(t1.i ASC)\n" +
+			" └─ Project(t1.i)\n" +
+			"     └─ IndexedJoin((t1.i = decorrelated_subquery_1.k0) AND (t1.i = decorrelated_subquery_1.v))\n" +
+			"         ├─ SubqueryAlias(decorrelated_subquery_1)\n" +
+			"         │   └─ Project(k0, MAX(t2.i2) as v)\n" +
+			"         │       └─ GroupBy\n" +
+			"         │           ├─ SelectedExprs(t2.i2 as k0, MAX(t2.i2))\n" +
+			"         │           ├─ Grouping(t2.i2)\n" +
+			"         │           └─ Filter(NOT((t2.s2 = \"second\")))\n" +
+			"         │               └─ Projected table access on [i2 s2]\n" +
+			"         │                   └─ TableAlias(t2)\n" +
+			"         │                       └─ IndexedTableAccess(othertable on [othertable.s2])\n" +
+			"         └─ Projected table access on [i]\n" +
+			"             └─ TableAlias(t1)\n" +
+			"                 └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"",
+	},
+	{
+		Query: `SELECT pk FROM one_pk WHERE c1 < (SELECT AVG(c2) FROM two_pk WHERE two_pk.pk1 = one_pk.pk AND two_pk.pk2 = 1) ORDER BY pk`,
+		ExpectedPlan: "Sort(one_pk.pk ASC)\n" +
+			" └─ Project(one_pk.pk)\n" +
+			"     └─ Project(one_pk.pk, one_pk.c1)\n" +
+			"         └─ HashJoin((one_pk.pk = decorrelated_subquery_1.k0) AND (one_pk.c1 < decorrelated_subquery_1.v))\n" +
+			"             ├─ Projected table access on [pk c1]\n" +
+			"             │   └─ Table(one_pk)\n" +
+			"             └─ CachedResults\n" +
+			"                 └─ SubqueryAlias(decorrelated_subquery_1)\n" +
+			"                     └─ Project(k0, AVG(two_pk.c2) as v)\n" +
+			"                         └─ GroupBy\n" +
+			"                             ├─ SelectedExprs(two_pk.pk1 as k0, AVG(two_pk.c2))\n" +
+			"                             ├─ Grouping(two_pk.pk1)\n" +
+			"                             └─ Filter(two_pk.pk2 = 1)\n" +
+			"                                 └─ Projected table access on [pk1 c2 pk2]\n" +
+			"                                     └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"",
+	},
+	{
+		Query: `SELECT i FROM mytable t1 WHERE t1.i IN (SELECT i2 FROM othertable t2 WHERE t2.i2 = t1.i AND t2.s2 <> 'first') ORDER BY i`,
+		ExpectedPlan: "Sort(t1.i ASC)\n" +
+			" └─ Project(t1.i)\n" +
+			"     └─ IndexedJoin((t1.i = decorrelated_subquery_1.k0) AND (t1.i = decorrelated_subquery_1.v))\n" +
+			"         ├─ SubqueryAlias(decorrelated_subquery_1)\n" +
+			"         │   └─ Distinct\n" +
+			"         │       └─ Project(t2.i2 as k0, t2.i2 as v)\n" +
+			"         │           └─ Filter(NOT((t2.s2 = \"first\")))\n" +
+			"         │               └─ Projected table access on [i2 s2]\n" +
+			"         │                   └─ TableAlias(t2)\n" +
+			"         │                       └─ IndexedTableAccess(othertable on [othertable.s2])\n" +
+			"         └─ Projected table access on [i]\n" +
+			"             └─ TableAlias(t1)\n" +
+			"                 └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"",
+	},
+	{
+		Query: `SELECT i FROM mytable t1 WHERE EXISTS (SELECT * FROM othertable t2 WHERE t2.i2 = t1.i AND t2.s2 <> 'first') ORDER BY i`,
+		ExpectedPlan: "Sort(t1.i ASC)\n" +
+			" └─ Project(t1.i)\n" +
+			"     └─ IndexedJoin(t1.i = decorrelated_subquery_1.k0)\n" +
+			"         ├─ SubqueryAlias(decorrelated_subquery_1)\n" +
+			"         │   └─ Distinct\n" +
+			"         │       └─ Project(t2.i2 as k0)\n" +
+			"         │           └─ Filter(NOT((t2.s2 = \"first\")))\n" +
+			"         │               └─ Projected table access on [i2 s2]\n" +
+			"         │                   └─ TableAlias(t2)\n" +
+			"         │                       └─ IndexedTableAccess(othertable on [othertable.s2])\n" +
+			"         └─ Projected table access on [i]\n" +
+			"             └─ TableAlias(t1)\n" +
+			"                 └─ IndexedTableAccess(mytable on [mytable.i])\n" +
+			"",
+	},
+	{
+		// COUNT returns 0 rather than NULL for the rows without matches, which a join wouldn't return
+		Query: `SELECT pk FROM one_pk WHERE 0 = (SELECT COUNT(*) FROM two_pk WHERE two_pk.pk1 = one_pk.pk) ORDER BY pk`,
+		ExpectedPlan: "Sort(one_pk.pk ASC)\n" +
+			" └─ Project(one_pk.pk)\n" +
+			"     └─ Filter(0 = (GroupBy\n" +
+			"         ├─ SelectedExprs(COUNT(*))\n" +
+			"         ├─ Grouping()\n" +
+			"         └─ Filter(two_pk.pk1 = one_pk.pk)\n" +
+			"             └─ Projected table access on [pk1 pk2]\n" +
+			"                 └─ IndexedTableAccess(two_pk on [two_pk.pk1,two_pk.pk2])\n" +
+			"        ))\n" +
+			"         └─ Projected table access on [pk]\n" +
+			"             └─ Table(one_pk)\n" +
+			"",
+	},
 }
 
 // Queries where the query planner produces a correct (results) but suboptimal plan.
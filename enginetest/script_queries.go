@@ -15,11 +15,13 @@
 package enginetest
 
 import (
+	"github.com/dolthub/vitess/go/mysql"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql/analyzer"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -442,6 +444,95 @@ var ScriptTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "UUID_SHORT",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    `SELECT UUID_SHORT() < UUID_SHORT()`,
+				Expected: []sql.Row{{true}},
+			},
+		},
+	},
+	{
+		Name: "Binary UUID keys",
+		SetUpScript: []string{
+			"CREATE TABLE uuid_keys (id BINARY(16) PRIMARY KEY, n INT)",
+			`INSERT INTO uuid_keys VALUES
+				(UUID_TO_BIN('6ccd780c-baba-1026-9564-5b8c656024db', 1), 1),
+				(UUID_TO_BIN('6ccd780d-baba-1026-9564-5b8c656024db', 1), 2),
+				(UUID_TO_BIN('00000000-0000-1027-9564-5b8c656024db', 1), 3)`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// Swapping the time parts orders the keys by time
+				Query:    `SELECT n FROM uuid_keys ORDER BY id`,
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				Query:    `SELECT n FROM uuid_keys WHERE id = UUID_TO_BIN('6ccd780d-baba-1026-9564-5b8c656024db', 1)`,
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    `SELECT n FROM uuid_keys WHERE id IN (UUID_TO_BIN('6ccd780c-baba-1026-9564-5b8c656024db', 1), UUID_TO_BIN('00000000-0000-1027-9564-5b8c656024db', 1)) ORDER BY id`,
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    `SELECT n FROM uuid_keys WHERE id > UUID_TO_BIN('6ccd780c-baba-1026-9564-5b8c656024db', 1) ORDER BY id`,
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query: `SELECT BIN_TO_UUID(id, 1) FROM uuid_keys WHERE id BETWEEN UUID_TO_BIN('6ccd780d-baba-1026-9564-5b8c656024db', 1) AND UUID_TO_BIN('00000000-0000-1027-9564-5b8c656024db', 1) ORDER BY id`,
+				Expected: []sql.Row{
+					{"6ccd780d-baba-1026-9564-5b8c656024db"},
+					{"00000000-0000-1027-9564-5b8c656024db"},
+				},
+			},
+			{
+				Query:    `SELECT BIN_TO_UUID(id, NULL) FROM uuid_keys WHERE n = 3`,
+				Expected: []sql.Row{{"10270000-0000-0000-9564-5b8c656024db"}},
+			},
+		},
+	},
+	{
+		Name: "Encryption and compression functions",
+		SetUpScript: []string{
+			"SET @iv = RANDOM_BYTES(16)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    `SELECT AES_DECRYPT(AES_ENCRYPT('secret', 'key'), 'key')`,
+				Expected: []sql.Row{{"secret"}},
+			},
+			{
+				Query:    `SELECT AES_ENCRYPT(NULL, 'key'), AES_DECRYPT('not encrypted', 'key')`,
+				Expected: []sql.Row{{nil, nil}},
+			},
+			{
+				Query:    `SET block_encryption_mode = 'aes-256-cbc'`,
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    `SELECT AES_DECRYPT(AES_ENCRYPT('secret', 'key', @iv), 'key', @iv)`,
+				Expected: []sql.Row{{"secret"}},
+			},
+			{
+				Query:       `SELECT AES_ENCRYPT('secret', 'key')`,
+				ExpectedErr: function.ErrMissingInitVector,
+			},
+			{
+				Query:       `SELECT RANDOM_BYTES(0)`,
+				ExpectedErr: function.ErrRandomBytesLength,
+			},
+			{
+				Query:    `SELECT UNCOMPRESS(COMPRESS('text')), UNCOMPRESSED_LENGTH(COMPRESS('text')), COMPRESS('')`,
+				Expected: []sql.Row{{"text", uint32(4), ""}},
+			},
+			{
+				Query:    `SELECT SHA2('abc', 224), SHA2('abc', 1)`,
+				Expected: []sql.Row{{"23097d223405d8228642a477bda255b32aadbce4bda0b3f7e36c9da7", nil}},
+			},
+		},
+	},
 	{
 		Name: "CrossDB Queries",
 		SetUpScript: []string{
@@ -1405,13 +1496,1371 @@ var ScriptTests = []ScriptTest{
 						"  `c` int NOT NULL,\n" +
 						"  `d` varchar(10),\n" +
 						"  PRIMARY KEY (`c`),\n" +
-						"  UNIQUE KEY `t2.d` (`d`),\n" +
+						"  UNIQUE KEY `t2du` (`d`),\n" +
 						"  CONSTRAINT `fk1` FOREIGN KEY (`d`) REFERENCES `t1` (`b`)\n" +
 						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"},
 				},
 			},
 		},
 	},
+	{
+		Name: "conditional comments",
+		SetUpScript: []string{
+			"CREATE TABLE t (a int primary key)",
+			"INSERT INTO t VALUES (1), (2), (3)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT 1 /*!80000 + 1 */",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:    "SELECT 1 /*!90000 + 1 */",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT 1 /*!100000 + 1 */",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "SELECT '/*!90000 not a comment */'",
+				Expected: []sql.Row{{"/*!90000 not a comment */"}},
+			},
+			{
+				Query:    "SELECT /*+ JOIN_ORDER(t) */ COUNT(*) FROM t /*+ misplaced */",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				Query:    "/*!90000 SELECT 1 */",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "mysql system database",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT COUNT(*) FROM mysql.help_topic",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "SELECT name, description FROM mysql.help_topic WHERE name = 'SELECT'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT db, name FROM mysql.proc",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT Host, Db, User FROM mysql.db",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM mysql.time_zone_name",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "USE mysql",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM help_keyword",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "USE mydb",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "named constraints",
+		SetUpScript: []string{
+			"CREATE TABLE parent (a int PRIMARY KEY)",
+			"CREATE TABLE child (x int PRIMARY KEY, y int, z int, CONSTRAINT fk1 FOREIGN KEY (y) REFERENCES parent(a), CONSTRAINT ck1 CHECK (z > 0), CONSTRAINT uq1 UNIQUE (z))",
+			"ALTER TABLE child ADD CONSTRAINT ck2 CHECK (z < 100)",
+			"ALTER TABLE child ADD CONSTRAINT uq2 UNIQUE (y, z)",
+			"ALTER TABLE child ADD CONSTRAINT `uq 3` UNIQUE KEY (y), ADD CONSTRAINT uq4 UNIQUE uq5 (x, y)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT constraint_name, constraint_type FROM information_schema.table_constraints WHERE table_name = 'child' ORDER BY constraint_name",
+				Expected: []sql.Row{
+					{"PRIMARY", "PRIMARY KEY"},
+					{"ck1", "CHECK"},
+					{"ck2", "CHECK"},
+					{"fk1", "FOREIGN KEY"},
+					{"uq 3", "UNIQUE"},
+					{"uq1", "UNIQUE"},
+					{"uq2", "UNIQUE"},
+					{"uq5", "UNIQUE"},
+				},
+			},
+			{
+				Query:       "ALTER TABLE child ADD CONSTRAINT ck1 CHECK (z < 50)",
+				ExpectedErr: sql.ErrCheckConstraintDuplicateName,
+			},
+			{
+				Query:       "ALTER TABLE child ADD CONSTRAINT CK1 FOREIGN KEY (z) REFERENCES parent(a)",
+				ExpectedErr: sql.ErrForeignKeyDuplicateName,
+			},
+			{
+				Query:       "ALTER TABLE child ADD CONSTRAINT uq1 UNIQUE (y)",
+				ExpectedErr: sql.ErrDuplicateKeyName,
+			},
+			{
+				Query:    "ALTER TABLE child DROP CONSTRAINT uq1",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "ALTER TABLE child DROP CONSTRAINT ck2",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "ALTER TABLE child DROP CONSTRAINT fk1",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "ALTER TABLE child DROP CONSTRAINT fk1",
+				ExpectedErr: sql.ErrUnknownConstraint,
+			},
+			{
+				Query:    "ALTER TABLE child ADD CONSTRAINT uq2 CHECK (y > 0)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "ALTER TABLE child DROP CONSTRAINT uq2",
+				ExpectedErr: sql.ErrMultipleConstraintsWithSameName,
+			},
+			{
+				Query: "SELECT constraint_name, constraint_type FROM information_schema.table_constraints WHERE table_name = 'child' ORDER BY constraint_name",
+				Expected: []sql.Row{
+					{"PRIMARY", "PRIMARY KEY"},
+					{"ck1", "CHECK"},
+					{"uq 3", "UNIQUE"},
+					{"uq2", "CHECK"},
+					{"uq2", "UNIQUE"},
+					{"uq5", "UNIQUE"},
+				},
+			},
+			{
+				// Text that looks like a constraint name inside a literal doesn't name the index
+				Query:    "ALTER TABLE child ADD CONSTRAINT ck3 CHECK (z <> 'add constraint bogus unique'), ADD CONSTRAINT uq6 UNIQUE (x, z)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT constraint_name FROM information_schema.table_constraints WHERE table_name = 'child' AND constraint_type = 'UNIQUE' ORDER BY 1",
+				Expected: []sql.Row{{"uq 3"}, {"uq2"}, {"uq5"}, {"uq6"}},
+			},
+		},
+	},
+	{
+		Name: "view dependencies",
+		SetUpScript: []string{
+			"CREATE TABLE t1 (a int PRIMARY KEY, b int)",
+			"CREATE TABLE t2 (c int PRIMARY KEY)",
+			"CREATE VIEW v1 AS SELECT a, b FROM t1 WHERE a IN (SELECT c FROM t2)",
+			"CREATE VIEW v2 AS WITH cte AS (SELECT a FROM v1) SELECT * FROM cte, mydb.t2",
+			"INSERT INTO t1 VALUES (1, 1), (2, 2)",
+			"INSERT INTO t2 VALUES (1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT view_schema, view_name, table_schema, table_name FROM information_schema.view_table_usage WHERE view_name IN ('v1', 'v2') ORDER BY 1, 2, 3, 4",
+				Expected: []sql.Row{
+					{"mydb", "v1", "mydb", "t1"},
+					{"mydb", "v1", "mydb", "t2"},
+					{"mydb", "v2", "mydb", "t2"},
+					{"mydb", "v2", "mydb", "v1"},
+				},
+			},
+			{
+				Query:    "SELECT * FROM v2",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				Query:    "ALTER TABLE t1 DROP COLUMN b",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "SELECT * FROM v1",
+				ExpectedErr: sql.ErrViewInvalid,
+			},
+			{
+				Query:       "SELECT * FROM v2",
+				ExpectedErr: sql.ErrViewInvalid,
+			},
+			{
+				Query:    "ALTER TABLE t1 ADD COLUMN b int",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * FROM v1",
+				Expected: []sql.Row{{1, nil}},
+			},
+			{
+				Query:    "DROP TABLE t2",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "SELECT * FROM v1",
+				ExpectedErr: sql.ErrViewInvalid,
+			},
+			{
+				Query: "SELECT view_name, table_name FROM information_schema.view_table_usage WHERE view_name = 'v1' ORDER BY 2",
+				Expected: []sql.Row{
+					{"v1", "t1"},
+					{"v1", "t2"},
+				},
+			},
+		},
+	},
+	{
+		Name: "idempotent DDL",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk BIGINT PRIMARY KEY, v1 BIGINT)",
+			"CREATE PROCEDURE p1() SELECT 1",
+			"CREATE TRIGGER tr1 BEFORE INSERT ON t FOR EACH ROW SET new.v1 = new.v1 + 1",
+			"CREATE VIEW v AS SELECT 1",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:           "CREATE TABLE IF NOT EXISTS t (pk BIGINT PRIMARY KEY)",
+				Expected:        []sql.Row{},
+				ExpectedWarning: mysql.ERTableExists,
+			},
+			{
+				Query:           "CREATE VIEW IF NOT EXISTS v AS SELECT 2",
+				Expected:        []sql.Row{},
+				ExpectedWarning: mysql.ERTableExists,
+			},
+			{
+				Query:    "SELECT * FROM v",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:           "CREATE PROCEDURE IF NOT EXISTS p1() SELECT 2",
+				Expected:        []sql.Row{{sql.NewOkResult(0)}},
+				ExpectedWarning: 1304,
+			},
+			{
+				Query:    "CALL p1()",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "CREATE OR REPLACE PROCEDURE p1() SELECT 2",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    "CALL p1()",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:       "CREATE TRIGGER tr1 BEFORE INSERT ON t FOR EACH ROW SET new.v1 = new.v1 + 2",
+				ExpectedErr: sql.ErrTriggerAlreadyExists,
+			},
+			{
+				Query:           "CREATE TRIGGER IF NOT EXISTS tr1 BEFORE INSERT ON t FOR EACH ROW SET new.v1 = new.v1 + 2",
+				Expected:        []sql.Row{{sql.NewOkResult(0)}},
+				ExpectedWarning: 1359,
+			},
+			{
+				Query:    "CREATE OR REPLACE TRIGGER tr1 BEFORE INSERT ON t FOR EACH ROW SET new.v1 = new.v1 + 10",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    "INSERT INTO t VALUES (1, 1)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM t",
+				Expected: []sql.Row{{1, 11}},
+			},
+			{
+				Query:    "CREATE INDEX IF NOT EXISTS idx ON t (v1)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:           "CREATE INDEX IF NOT EXISTS idx ON t (v1)",
+				Expected:        []sql.Row{},
+				ExpectedWarning: mysql.ERDupKeyName,
+			},
+			{
+				Query:       "CREATE INDEX idx ON t (v1)",
+				ExpectedErr: sql.ErrDuplicateKeyName,
+			},
+			{
+				Query:    "DROP INDEX IF EXISTS idx ON t",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:           "ALTER TABLE t DROP INDEX IF EXISTS idx",
+				Expected:        []sql.Row{},
+				ExpectedWarning: mysql.ERCantDropFieldOrKey,
+			},
+			{
+				Query:           "DROP TRIGGER IF EXISTS tr2",
+				Expected:        []sql.Row{},
+				ExpectedWarning: 1360,
+			},
+			{
+				Query:           "DROP PROCEDURE IF EXISTS p2",
+				Expected:        []sql.Row{},
+				ExpectedWarning: 1305,
+			},
+			{
+				Query:           "DROP VIEW IF EXISTS v2",
+				Expected:        []sql.Row{},
+				ExpectedWarning: mysql.ERBadTable,
+			},
+			{
+				Query:           "DROP TABLE IF EXISTS t2",
+				Expected:        []sql.Row{},
+				ExpectedWarning: mysql.ERBadTable,
+			},
+		},
+	},
+	{
+		Name: "ON UPDATE CURRENT_TIMESTAMP columns",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk int PRIMARY KEY, v int, ts TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP, dt DATETIME(6) ON UPDATE CURRENT_TIMESTAMP(6))",
+			"INSERT INTO t VALUES (1, 1, '2020-01-01 00:00:00', '2020-01-01 00:00:00'), (2, 2, '2020-01-01 00:00:00', NULL)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SHOW CREATE TABLE t",
+				Expected: []sql.Row{
+					{"t", "CREATE TABLE `t` (\n" +
+						"  `pk` int NOT NULL,\n" +
+						"  `v` int,\n" +
+						"  `ts` timestamp DEFAULT CURRENT_TIMESTAMP() ON UPDATE CURRENT_TIMESTAMP(),\n" +
+						"  `dt` datetime ON UPDATE CURRENT_TIMESTAMP(6),\n" +
+						"  PRIMARY KEY (`pk`)\n" +
+						") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"},
+				},
+			},
+			{
+				Query:    "SELECT column_name, extra FROM information_schema.columns WHERE table_name = 't' AND column_name IN ('ts', 'dt') ORDER BY 1",
+				Expected: []sql.Row{{"dt", "on update CURRENT_TIMESTAMP(6)"}, {"ts", "on update CURRENT_TIMESTAMP"}},
+			},
+			{
+				Query:    "UPDATE t SET v = 1 WHERE pk = 1",
+				Expected: []sql.Row{{newUpdateResult(1, 0)}},
+			},
+			{
+				Query:    "SELECT pk, ts = '2020-01-01 00:00:00', dt = '2020-01-01 00:00:00' FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, true, true}, {2, true, nil}},
+			},
+			{
+				Query:    "UPDATE t SET v = v + 10 WHERE pk = 1",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "SELECT pk, ts > '2020-01-01 00:00:00', dt > '2020-01-01 00:00:00' FROM t ORDER BY pk",
+				Expected: []sql.Row{{1, true, true}, {2, false, nil}},
+			},
+			{
+				Query:    "UPDATE t SET v = v + 10, ts = '2021-01-01 00:00:00' WHERE pk = 2",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "SELECT pk, v, ts = '2021-01-01 00:00:00', dt IS NOT NULL FROM t WHERE pk = 2",
+				Expected: []sql.Row{{2, 12, true, true}},
+			},
+			{
+				Query:    "INSERT INTO t (pk, v) VALUES (3, 3)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT pk, ts IS NOT NULL, dt IS NULL FROM t WHERE pk = 3",
+				Expected: []sql.Row{{3, true, true}},
+			},
+			{
+				Query:    "INSERT INTO t (pk, v, ts) VALUES (3, 3, '2020-01-01 00:00:00') ON DUPLICATE KEY UPDATE v = 4",
+				Expected: []sql.Row{{sql.NewOkResult(2)}},
+			},
+			{
+				Query:    "SELECT pk, v, ts > '2020-01-01 00:00:00', dt IS NOT NULL FROM t WHERE pk = 3",
+				Expected: []sql.Row{{3, 4, true, true}},
+			},
+			{
+				Query:       "CREATE TABLE t2 (pk int PRIMARY KEY, v int ON UPDATE CURRENT_TIMESTAMP)",
+				ExpectedErr: sql.ErrInvalidOnUpdate,
+			},
+			{
+				Query:       "CREATE TABLE t2 (pk int PRIMARY KEY, d DATE ON UPDATE CURRENT_TIMESTAMP)",
+				ExpectedErr: sql.ErrInvalidOnUpdate,
+			},
+		},
+	},
+	{
+		Name: "TIMESTAMP columns with explicit_defaults_for_timestamp disabled",
+		SetUpScript: []string{
+			"SET explicit_defaults_for_timestamp = 0",
+			"CREATE TABLE t (pk int PRIMARY KEY, v int, ts1 TIMESTAMP, ts2 TIMESTAMP, ts3 TIMESTAMP NULL)",
+			"INSERT INTO t (pk, v) VALUES (1, 1)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT column_name, is_nullable, extra FROM information_schema.columns WHERE table_name = 't' AND column_name LIKE 'ts%' ORDER BY 1",
+				Expected: []sql.Row{{"ts1", "NO", "on update CURRENT_TIMESTAMP"}, {"ts2", "NO", ""}, {"ts3", "YES", ""}},
+			},
+			{
+				Query:    "SELECT pk, ts1 IS NOT NULL, ts2 = '0000-00-00 00:00:00', ts3 IS NULL FROM t",
+				Expected: []sql.Row{{1, true, true, true}},
+			},
+			{
+				Query:    "INSERT INTO t VALUES (2, 2, NULL, NULL, NULL)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT pk, ts1 IS NOT NULL, ts2 IS NOT NULL, ts3 IS NULL FROM t WHERE pk = 2",
+				Expected: []sql.Row{{2, true, true, true}},
+			},
+			{
+				Query:    "SET explicit_defaults_for_timestamp = 1",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "CREATE TABLE t2 (pk int PRIMARY KEY, ts TIMESTAMP)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT column_name, is_nullable, extra FROM information_schema.columns WHERE table_name = 't2' AND column_name = 'ts'",
+				Expected: []sql.Row{{"ts", "YES", ""}},
+			},
+		},
+	},
+	{
+		Name: "lookup joins with batched lookups",
+		SetUpScript: []string{
+			"CREATE TABLE xy (x BIGINT PRIMARY KEY, y BIGINT);",
+			"CREATE TABLE uv (u BIGINT PRIMARY KEY, v BIGINT);",
+			"INSERT INTO xy VALUES (1,10), (2,20), (3,30), (4,40), (5,50);",
+			"INSERT INTO uv VALUES (1,100), (3,300), (5,500), (6,600);",
+			"SET lookup_join_batch_size = 2;",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT x, y, v FROM xy JOIN uv ON x = u ORDER BY x",
+				Expected: []sql.Row{{1, 10, 100}, {3, 30, 300}, {5, 50, 500}},
+			},
+			{
+				Query:    "SELECT x, y, v FROM xy LEFT JOIN uv ON x = u ORDER BY x",
+				Expected: []sql.Row{{1, 10, 100}, {2, 20, nil}, {3, 30, 300}, {4, 40, nil}, {5, 50, 500}},
+			},
+			{
+				Query:    "SELECT a.x, b.x FROM xy a JOIN xy b ON a.x = b.x - 1 ORDER BY a.x",
+				Expected: []sql.Row{{1, 2}, {2, 3}, {3, 4}, {4, 5}},
+			},
+			{
+				Query:    "SELECT x, v FROM xy JOIN uv ON x = u WHERE x > 1 ORDER BY x LIMIT 1",
+				Expected: []sql.Row{{3, 300}},
+			},
+			{
+				Query:    "SELECT @@lookup_join_batch_size",
+				Expected: []sql.Row{{2}},
+			},
+		},
+	},
+	{
+		Name: "checksum table",
+		SetUpScript: []string{
+			"CREATE TABLE c (a INT, b BIGINT PRIMARY KEY)",
+			"INSERT INTO c VALUES (5, 7), (NULL, 8)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "CHECKSUM TABLE c",
+				Expected: []sql.Row{{"mydb.c", int64(1027802294)}},
+			},
+			{
+				Query:    "CHECKSUM TABLE mydb.c, c EXTENDED",
+				Expected: []sql.Row{{"mydb.c", int64(1027802294)}, {"mydb.c", int64(1027802294)}},
+			},
+			{
+				Query:    "CHECKSUM TABLE c QUICK",
+				Expected: []sql.Row{{"mydb.c", nil}},
+			},
+			{
+				Query:           "CHECKSUM TABLE nosuchtable",
+				Expected:        []sql.Row{{"mydb.nosuchtable", nil}},
+				ExpectedWarning: mysql.ERNoSuchTable,
+			},
+			{
+				Query:    "UPDATE c SET a = 6 WHERE b = 7",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				Query:    "CHECKSUM TABLE c",
+				Expected: []sql.Row{{"mydb.c", int64(870438630)}},
+			},
+		},
+	},
+	{
+		Name: "optimize and repair table",
+		SetUpScript: []string{
+			"CREATE TABLE t (i BIGINT PRIMARY KEY)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "OPTIMIZE TABLE t",
+				Expected: []sql.Row{{"mydb.t", "optimize", "note", "The storage engine for the table doesn't support optimize"}},
+			},
+			{
+				Query: "OPTIMIZE LOCAL TABLE t, nosuchtable",
+				Expected: []sql.Row{
+					{"mydb.t", "optimize", "note", "The storage engine for the table doesn't support optimize"},
+					{"mydb.nosuchtable", "optimize", "Error", "Table 'mydb.nosuchtable' doesn't exist"},
+					{"mydb.nosuchtable", "optimize", "status", "Operation failed"},
+				},
+			},
+			{
+				Query:    "REPAIR NO_WRITE_TO_BINLOG TABLE mydb.t QUICK EXTENDED",
+				Expected: []sql.Row{{"mydb.t", "repair", "note", "The storage engine for the table doesn't support repair"}},
+			},
+			{
+				Query:       "OPTIMIZE TABLE t QUICK",
+				ExpectedErr: sql.ErrSyntaxError,
+			},
+		},
+	},
+	{
+		Name: "create and drop index with algorithm and lock clauses",
+		SetUpScript: []string{
+			"CREATE TABLE t (i BIGINT PRIMARY KEY, v BIGINT)",
+			"INSERT INTO t VALUES (1, 1), (2, 2), (3, 3)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "CREATE INDEX v1 ON t (v) ALGORITHM=INPLACE LOCK=NONE",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "CREATE INDEX v1 ON t (v)",
+				ExpectedErr: sql.ErrDuplicateKeyName,
+			},
+			{
+				Query:    "DROP INDEX v1 ON t ALGORITHM = DEFAULT LOCK = DEFAULT",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "CREATE INDEX v1 ON t (v) ALGORITHM=COPY LOCK=NONE",
+				ExpectedErr: sql.ErrAlterOperationNotSupportedReason,
+			},
+			{
+				Query:    "CREATE UNIQUE INDEX v1 ON t (v) LOCK SHARED ALGORITHM COPY",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT i FROM t WHERE v = 2",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:       "CREATE INDEX v2 ON t (v) ALGORITHM=SLOW",
+				ExpectedErr: sql.ErrUnknownAlterAlgorithm,
+			},
+		},
+	},
+	{
+		Name: "spatial columns restricted to an SRID",
+		SetUpScript: []string{
+			"CREATE TABLE places (i BIGINT PRIMARY KEY, p POINT NOT NULL SRID 4326, l LINESTRING SRID 0)",
+			"INSERT INTO places VALUES (1, ST_GEOMFROMTEXT('POINT(1 2)', 4326), LINESTRING(POINT(0, 0), POINT(1, 1)))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT i, ST_SRID(p), ST_X(p), ST_SRID(l) FROM places",
+				Expected: []sql.Row{{1, uint32(4326), 1.0, uint32(0)}},
+			},
+			{
+				Query:       "INSERT INTO places VALUES (2, POINT(1, 2), NULL)",
+				ExpectedErr: sql.ErrNotMatchingSRID,
+			},
+			{
+				Query:       "UPDATE places SET l = ST_SRID(l, 4326)",
+				ExpectedErr: sql.ErrNotMatchingSRID,
+			},
+			{
+				Query:    "SELECT column_name, srs_id FROM information_schema.columns WHERE table_name = 'places' ORDER BY 1",
+				Expected: []sql.Row{{"i", nil}, {"l", uint32(0)}, {"p", uint32(4326)}},
+			},
+			{
+				Query: "SHOW CREATE TABLE places",
+				Expected: []sql.Row{{"places", "CREATE TABLE `places` (\n" +
+					"  `i` bigint NOT NULL,\n" +
+					"  `p` point NOT NULL /*!80003 SRID 4326 */,\n" +
+					"  `l` linestring /*!80003 SRID 0 */,\n" +
+					"  PRIMARY KEY (`i`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}},
+			},
+			{
+				Query:    "SELECT ST_SRID(ST_TRANSFORM(p, 4326)) FROM places",
+				Expected: []sql.Row{{uint32(4326)}},
+			},
+			{
+				Query:       "SELECT ST_TRANSFORM(p, 0) FROM places",
+				ExpectedErr: function.ErrTransformTargetNotSupported,
+			},
+			{
+				Query:       "SELECT ST_TRANSFORM(l, 4326) FROM places",
+				ExpectedErr: function.ErrTransformSourceNotSupported,
+			},
+			{
+				Query:    "SELECT ST_SRID(ST_TRANSFORM(p, 4230)), ST_SRID(ST_TRANSFORM(p, 3857)) FROM places",
+				Expected: []sql.Row{{uint32(4230), uint32(3857)}},
+			},
+			{
+				Query:       "SELECT ST_TRANSFORM(p, 1234) FROM places",
+				ExpectedErr: sql.ErrInvalidSRID,
+			},
+			{
+				Query:    "SELECT srs_id, srs_name, organization FROM information_schema.st_spatial_reference_systems WHERE srs_id IN (3857, 4230, 4326) ORDER BY 1",
+				Expected: []sql.Row{{uint32(3857), "WGS 84 / Pseudo-Mercator", "EPSG"}, {uint32(4230), "ED50", "EPSG"}, {uint32(4326), "WGS 84", "EPSG"}},
+			},
+			{
+				Query:    "ALTER TABLE places ADD COLUMN q POINT SRID 0",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT srs_id FROM information_schema.columns WHERE table_name = 'places' AND column_name = 'q'",
+				Expected: []sql.Row{{uint32(0)}},
+			},
+		},
+	},
+	{
+		Name: "table options and foreign key metadata",
+		SetUpScript: []string{
+			"CREATE TABLE parent (id BIGINT PRIMARY KEY, u BIGINT, UNIQUE KEY u_idx (u))",
+			"CREATE TABLE child (id BIGINT PRIMARY KEY AUTO_INCREMENT, pid BIGINT, u BIGINT, KEY z (u), UNIQUE KEY pu (pid, u), KEY a (pid), " +
+				"CONSTRAINT fk_pid FOREIGN KEY (pid) REFERENCES parent (id) ON DELETE CASCADE, " +
+				"CONSTRAINT fk_u FOREIGN KEY (u) REFERENCES parent (u)) AUTO_INCREMENT=7 COLLATE=utf8mb4_bin COMMENT='child''s rows'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// Keys are shown in the order they were declared in
+				Query: "SHOW CREATE TABLE child",
+				Expected: []sql.Row{{"child", "CREATE TABLE `child` (\n" +
+					"  `id` bigint NOT NULL AUTO_INCREMENT,\n" +
+					"  `pid` bigint,\n" +
+					"  `u` bigint,\n" +
+					"  PRIMARY KEY (`id`),\n" +
+					"  KEY `z` (`u`),\n" +
+					"  UNIQUE KEY `pu` (`pid`,`u`),\n" +
+					"  KEY `a` (`pid`),\n" +
+					"  CONSTRAINT `fk_pid` FOREIGN KEY (`pid`) REFERENCES `parent` (`id`) ON DELETE CASCADE,\n" +
+					"  CONSTRAINT `fk_u` FOREIGN KEY (`u`) REFERENCES `parent` (`u`)\n" +
+					") ENGINE=InnoDB AUTO_INCREMENT=7 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='child''s rows'"}},
+			},
+			{
+				Query:    "SELECT table_collation, table_comment FROM information_schema.tables WHERE table_name = 'child'",
+				Expected: []sql.Row{{"utf8mb4_bin", "child's rows"}},
+			},
+			{
+				Query: "SELECT constraint_name, unique_constraint_name, update_rule, delete_rule, table_name, referenced_table_name " +
+					"FROM information_schema.referential_constraints ORDER BY 1",
+				Expected: []sql.Row{
+					{"fk_pid", "PRIMARY", "NO ACTION", "CASCADE", "child", "parent"},
+					{"fk_u", "u_idx", "NO ACTION", "NO ACTION", "child", "parent"},
+				},
+			},
+			{
+				Query:    "CREATE TABLE child_copy LIKE child",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT table_collation, table_comment FROM information_schema.tables WHERE table_name = 'child_copy'",
+				Expected: []sql.Row{{"utf8mb4_bin", "child's rows"}},
+			},
+		},
+	},
+	{
+		Name: "row format, engine attributes and column comments",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk BIGINT PRIMARY KEY COMMENT 'the key', v VARCHAR(10) COMMENT 'the value') " +
+				"ROW_FORMAT=COMPRESSED COMMENT='a table' ENGINE_ATTRIBUTE='{\"k\": \"v\"}'",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SHOW CREATE TABLE t",
+				Expected: []sql.Row{{"t", "CREATE TABLE `t` (\n" +
+					"  `pk` bigint NOT NULL COMMENT 'the key',\n" +
+					"  `v` varchar(10) COMMENT 'the value',\n" +
+					"  PRIMARY KEY (`pk`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=COMPRESSED COMMENT='a table' ENGINE_ATTRIBUTE='{\"k\": \"v\"}'"}},
+			},
+			{
+				Query:    "SELECT row_format, create_options, table_comment FROM information_schema.tables WHERE table_name = 't'",
+				Expected: []sql.Row{{"Compressed", "row_format=COMPRESSED", "a table"}},
+			},
+			{
+				Query:    "SELECT column_name, column_comment FROM information_schema.columns WHERE table_name = 't' ORDER BY 1",
+				Expected: []sql.Row{{"pk", "the key"}, {"v", "the value"}},
+			},
+			{
+				Query:    "SELECT engine_attribute, secondary_engine_attribute FROM information_schema.tables_extensions WHERE table_name = 't'",
+				Expected: []sql.Row{{`{"k": "v"}`, nil}},
+			},
+			{
+				Query:       "CREATE TABLE u (pk BIGINT PRIMARY KEY) ROW_FORMAT=WIDE",
+				ExpectedErr: sql.ErrInvalidValue,
+			},
+		},
+	},
+	{
+		Name: "spatial predicates",
+		SetUpScript: []string{
+			"CREATE TABLE regions (name VARCHAR(20) PRIMARY KEY, region POLYGON NOT NULL)",
+			"INSERT INTO regions VALUES ('west', ST_GEOMFROMTEXT('POLYGON((0 0,4 0,4 4,0 4,0 0))')), ('east', ST_GEOMFROMTEXT('POLYGON((4 0,8 0,8 4,4 4,4 0))'))",
+			"CREATE TABLE places (i BIGINT PRIMARY KEY, p POINT NOT NULL)",
+			"INSERT INTO places VALUES (1, POINT(1, 1)), (2, POINT(6, 2)), (3, POINT(4, 2)), (4, POINT(9, 9))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT r.name, p.i FROM regions r JOIN places p ON ST_CONTAINS(r.region, p.p) ORDER BY 1, 2",
+				Expected: []sql.Row{{"east", 2}, {"west", 1}},
+			},
+			{
+				Query:    "SELECT r.name, p.i FROM regions r JOIN places p ON ST_INTERSECTS(r.region, p.p) ORDER BY 1, 2",
+				Expected: []sql.Row{{"east", 2}, {"east", 3}, {"west", 1}, {"west", 3}},
+			},
+			{
+				Query:    "SELECT i FROM places WHERE ST_WITHIN(p, ST_GEOMFROMTEXT('POLYGON((0 0,8 0,8 4,0 4,0 0))')) ORDER BY 1",
+				Expected: []sql.Row{{1}, {2}, {3}},
+			},
+			{
+				Query:    "SELECT a.name, b.name FROM regions a JOIN regions b ON ST_TOUCHES(a.region, b.region) ORDER BY 1",
+				Expected: []sql.Row{{"east", "west"}, {"west", "east"}},
+			},
+			{
+				Query:    "SELECT MBRCONTAINS(ST_GEOMFROMTEXT('LINESTRING(0 0,4 4)'), POINT(1, 3)), ST_CONTAINS(ST_GEOMFROMTEXT('LINESTRING(0 0,4 4)'), POINT(1, 3))",
+				Expected: []sql.Row{{true, false}},
+			},
+			{
+				Query:    "SELECT MBRINTERSECTS(region, NULL) FROM regions WHERE name = 'west'",
+				Expected: []sql.Row{{nil}},
+			},
+			{
+				Query:       "SELECT ST_INTERSECTS(region, ST_GEOMFROMTEXT('POINT(1 1)', 4326)) FROM regions",
+				ExpectedErr: function.ErrDiffSRIDs,
+			},
+		},
+	},
+	{
+		Name: "spatial measurements",
+		SetUpScript: []string{
+			"CREATE TABLE parcels (name VARCHAR(20) PRIMARY KEY, parcel POLYGON NOT NULL)",
+			"INSERT INTO parcels VALUES ('square', ST_GEOMFROMTEXT('POLYGON((0 0,4 0,4 4,0 4,0 0))')), ('strip', ST_GEOMFROMTEXT('POLYGON((6 0,8 0,8 1,6 1,6 0))'))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT name, ST_AREA(parcel), ST_ASTEXT(ST_CENTROID(parcel)) FROM parcels ORDER BY 1",
+				Expected: []sql.Row{{"square", 16.0, "POINT(2 2)"}, {"strip", 2.0, "POINT(7 0.5)"}},
+			},
+			{
+				Query:    "SELECT a.name, b.name, ST_DISTANCE(a.parcel, b.parcel) FROM parcels a JOIN parcels b ON a.name < b.name",
+				Expected: []sql.Row{{"square", "strip", 2.0}},
+			},
+			{
+				Query:    "SELECT name FROM parcels WHERE ST_DISTANCE(parcel, POINT(5, 0)) <= 1 ORDER BY 1",
+				Expected: []sql.Row{{"square"}, {"strip"}},
+			},
+			{
+				Query:    "SELECT ST_LENGTH(ST_GEOMFROMTEXT('LINESTRING(0 0,3 4,3 6)')), ST_LENGTH(POINT(1, 2)), ST_AREA(NULL)",
+				Expected: []sql.Row{{7.0, nil, nil}},
+			},
+			{
+				Query:       "SELECT ST_AREA(ST_GEOMFROMTEXT('POLYGON((0 0,0 1,1 1,0 0))', 4326))",
+				ExpectedErr: function.ErrNotImplementedForGeographicSRS,
+			},
+		},
+	},
+	{
+		Name: "quoted identifiers with special characters",
+		SetUpScript: []string{
+			"CREATE TABLE `my table` (`a b` INT PRIMARY KEY, `c``d` INT, `e.f` INT DEFAULT (`a b` + 1), CONSTRAINT `chk 1` CHECK (`c``d` > 0))",
+			"INSERT INTO `my table` (`a b`, `c``d`) VALUES (1, 2)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT `a b` AS `x y`, `c``d`, `e.f` FROM `my table`",
+				Expected: []sql.Row{{1, 2, 2}},
+			},
+			{
+				Query:    "SELECT `x y` FROM (SELECT `a b` AS `x y` FROM `my table`) `sub query`",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query: "SHOW CREATE TABLE `my table`",
+				Expected: []sql.Row{{"my table", "CREATE TABLE `my table` (\n" +
+					"  `a b` int NOT NULL,\n" +
+					"  `c``d` int,\n" +
+					"  `e.f` int DEFAULT ((`a b` + 1)),\n" +
+					"  PRIMARY KEY (`a b`),\n" +
+					"  CONSTRAINT `chk 1` CHECK ((`c``d` > 0))\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}},
+			},
+			{
+				Query:       "INSERT INTO `my table` (`a b`, `c``d`) VALUES (2, 0)",
+				ExpectedErr: sql.ErrCheckConstraintViolated,
+			},
+			{
+				Query:    "CREATE DATABASE `my-db`",
+				Expected: []sql.Row{{sql.OkResult{RowsAffected: 1}}},
+			},
+			{
+				Query:    "CREATE TABLE `my-db`.`select` (`from` INT PRIMARY KEY)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT `from` FROM `my-db`.`select`",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "identifier length limits",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk INT PRIMARY KEY)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "CREATE TABLE tttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttt (tttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttt INT PRIMARY KEY)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:       "CREATE TABLE ttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttt (pk INT PRIMARY KEY)",
+				ExpectedErr: sql.ErrTooLongIdentifier,
+			},
+			{
+				Query:       "CREATE TABLE u (ttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttt INT PRIMARY KEY)",
+				ExpectedErr: sql.ErrTooLongIdentifier,
+			},
+			{
+				Query:       "CREATE DATABASE ttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttt",
+				ExpectedErr: sql.ErrTooLongIdentifier,
+			},
+			{
+				Query:       "CREATE VIEW ttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttt AS SELECT 1",
+				ExpectedErr: sql.ErrTooLongIdentifier,
+			},
+			{
+				Query:       "ALTER TABLE t ADD COLUMN ttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttt INT",
+				ExpectedErr: sql.ErrTooLongIdentifier,
+			},
+			{
+				Query:       "ALTER TABLE t RENAME COLUMN pk TO ttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttt",
+				ExpectedErr: sql.ErrTooLongIdentifier,
+			},
+			{
+				Query:       "CREATE INDEX ttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttt ON t (pk)",
+				ExpectedErr: sql.ErrTooLongIdentifier,
+			},
+			{
+				Query:       "RENAME TABLE t TO ttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttttt",
+				ExpectedErr: sql.ErrTooLongIdentifier,
+			},
+		},
+	},
+	{
+		Name: "double quoted identifiers with ANSI_QUOTES",
+		SetUpScript: []string{
+			"CREATE TABLE t (pk INT PRIMARY KEY, `a\"b` VARCHAR(10))",
+			"INSERT INTO t VALUES (1, 'x')",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    `SELECT "a""b" FROM t`,
+				Expected: []sql.Row{{`a"b`}},
+			},
+			{
+				Query:    "SET sql_mode = 'ANSI_QUOTES'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    `SELECT "a""b", 'a"b' FROM "t" WHERE "pk" = 1`,
+				Expected: []sql.Row{{"x", `a"b`}},
+			},
+			{
+				Query:    "CREATE TABLE \"u v\" (\"w`x\" INT PRIMARY KEY)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT `w``x` FROM `u v`",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SET sql_mode = 'STRICT_TRANS_TABLES,NO_ENGINE_SUBSTITUTION'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    `SELECT "pk" FROM t`,
+				Expected: []sql.Row{{"pk"}},
+			},
+		},
+	},
+	{
+		Name: "spatial indexes",
+		SetUpScript: []string{
+			"CREATE TABLE places (pk INT PRIMARY KEY, p POINT NOT NULL SRID 0, SPATIAL INDEX idx_p (p))",
+			"INSERT INTO places VALUES (1, POINT(1, 1)), (2, POINT(5, 5)), (3, POINT(2, 3)), (4, POINT(9, 0))",
+			"CREATE TABLE roads (pk INT PRIMARY KEY, r LINESTRING NOT NULL)",
+			"INSERT INTO roads VALUES (1, ST_GEOMFROMTEXT('LINESTRING(0 0,1 1)')), (2, ST_GEOMFROMTEXT('LINESTRING(6 6,8 8)'))",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SHOW CREATE TABLE places",
+				Expected: []sql.Row{{"places", "CREATE TABLE `places` (\n" +
+					"  `pk` int NOT NULL,\n" +
+					"  `p` point NOT NULL /*!80003 SRID 0 */,\n" +
+					"  PRIMARY KEY (`pk`),\n" +
+					"  SPATIAL KEY `idx_p` (`p`)\n" +
+					") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"}},
+			},
+			{
+				Query: "SHOW INDEXES FROM places",
+				Expected: []sql.Row{
+					{"places", 0, "PRIMARY", 1, "pk", nil, 0, nil, nil, "", "BTREE", "", "", "YES", nil},
+					{"places", 1, "idx_p", 1, "p", nil, 0, nil, nil, "", "SPATIAL", "", "", "YES", nil},
+				},
+			},
+			{
+				Query: "EXPLAIN SELECT pk FROM places WHERE ST_CONTAINS(ST_GEOMFROMTEXT('POLYGON((0 0,4 0,4 4,0 4,0 0))'), p)",
+				Expected: []sql.Row{
+					{"Project(places.pk)"},
+					{" └─ FilterST_CONTAINS({0 [{0 [{0 0 0} {0 4 0} {0 4 4} {0 0 4} {0 0 0}]}]},places.p)"},
+					{"     └─ Projected table access on [pk p]"},
+					{"         └─ IndexedTableAccess(places on [places.p])"},
+				},
+			},
+			{
+				Query:    "SELECT pk FROM places WHERE ST_CONTAINS(ST_GEOMFROMTEXT('POLYGON((0 0,4 0,4 4,0 4,0 0))'), p) ORDER BY 1",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "SELECT pk FROM places WHERE ST_WITHIN(p, ST_GEOMFROMTEXT('POLYGON((1 1,6 1,6 6,1 6,1 1))')) ORDER BY 1",
+				Expected: []sql.Row{{2}, {3}},
+			},
+			{
+				Query:    "SELECT pk FROM places WHERE MBRCONTAINS(ST_GEOMFROMTEXT('LINESTRING(0 0,9 4)'), p) ORDER BY 1",
+				Expected: []sql.Row{{1}, {3}},
+			},
+			{
+				Query:    "SELECT pk FROM places WHERE ST_INTERSECTS(p, POINT(5, 5)) OR ST_INTERSECTS(p, POINT(9, 0)) ORDER BY 1",
+				Expected: []sql.Row{{2}, {4}},
+			},
+			{
+				Query:    "INSERT INTO places VALUES (5, POINT(3, 3))",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT pk FROM places WHERE MBRINTERSECTS(p, ST_GEOMFROMTEXT('POLYGON((0 0,4 0,4 4,0 4,0 0))')) ORDER BY 1",
+				Expected: []sql.Row{{1}, {3}, {5}},
+			},
+			{
+				Query:    "CREATE SPATIAL INDEX idx_r ON roads (r)",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT pk FROM roads WHERE ST_INTERSECTS(r, POINT(7, 7))",
+				Expected: []sql.Row{{2}},
+			},
+			{
+				Query:       "CREATE TABLE t1 (pk INT PRIMARY KEY, p POINT, SPATIAL INDEX (p))",
+				ExpectedErr: sql.ErrSpatialIndexNullable,
+			},
+			{
+				Query:       "CREATE SPATIAL INDEX idx_pk ON places (pk)",
+				ExpectedErr: sql.ErrSpatialIndexColumnType,
+			},
+			{
+				Query:       "ALTER TABLE places ADD SPATIAL INDEX idx_both (pk, p)",
+				ExpectedErr: sql.ErrSpatialIndexKeyParts,
+			},
+		},
+	},
+	{
+		Name: "geometries in the internal format",
+		SetUpScript: []string{
+			"CREATE TABLE geoms (pk INT PRIMARY KEY, p POINT, l LINESTRING)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "INSERT INTO geoms VALUES (1, X'000000000101000000000000000000F03F0000000000000040', X'E61000000102000000020000000000000000000040000000000000F03F00000000000010400000000000000840')",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT ST_ASWKT(p), ST_ASWKT(l), ST_SRID(l) FROM geoms",
+				Expected: []sql.Row{{"POINT(1 2)", "LINESTRING(1 2,3 4)", uint32(4326)}},
+			},
+			{
+				Query:       "INSERT INTO geoms VALUES (2, X'000000000101000000000000000000F03F', NULL)",
+				ExpectedErr: sql.ErrInvalidGeometryData,
+			},
+			{
+				Query:       "INSERT INTO geoms VALUES (2, X'00000000010200000000000000', NULL)",
+				ExpectedErr: sql.ErrNotPoint,
+			},
+		},
+	},
+	{
+		Name: "recursive common table expressions",
+		SetUpScript: []string{
+			"CREATE TABLE employees (id INT PRIMARY KEY, name VARCHAR(20), manager_id INT)",
+			"INSERT INTO employees VALUES (1, 'ann', NULL), (2, 'bob', 1), (3, 'cat', 1), (4, 'dan', 3), (5, 'eve', 4)",
+			"CREATE TABLE parts (assembly VARCHAR(10), part VARCHAR(10), quantity INT, PRIMARY KEY (assembly, part))",
+			"INSERT INTO parts VALUES ('bike', 'wheel', 2), ('wheel', 'spoke', 32), ('wheel', 'rim', 1), ('bike', 'frame', 1)",
+			"CREATE TABLE edges (src INT, dst INT, PRIMARY KEY (src, dst))",
+			"INSERT INTO edges VALUES (1, 2), (2, 3), (3, 1), (3, 4)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "WITH RECURSIVE cte (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM cte WHERE n < 5) SELECT * FROM cte",
+				Expected: []sql.Row{{int64(1)}, {int64(2)}, {int64(3)}, {int64(4)}, {int64(5)}},
+			},
+			{
+				Query: `WITH RECURSIVE chain AS (
+					SELECT id, name, 1 AS depth FROM employees WHERE manager_id IS NULL
+					UNION ALL
+					SELECT e.id, e.name, c.depth + 1 FROM employees e JOIN chain c ON e.manager_id = c.id
+				) SELECT * FROM chain ORDER BY id`,
+				Expected: []sql.Row{
+					{int64(1), "ann", int64(1)},
+					{int64(2), "bob", int64(2)},
+					{int64(3), "cat", int64(2)},
+					{int64(4), "dan", int64(3)},
+					{int64(5), "eve", int64(4)},
+				},
+			},
+			{
+				Query: `WITH RECURSIVE bom (part, quantity) AS (
+					SELECT part, quantity FROM parts WHERE assembly = 'bike'
+					UNION ALL
+					SELECT p.part, p.quantity * b.quantity FROM parts p JOIN bom b ON p.assembly = b.part
+				) SELECT part, SUM(quantity) FROM bom GROUP BY part ORDER BY part`,
+				Expected: []sql.Row{{"frame", float64(1)}, {"rim", float64(2)}, {"spoke", float64(64)}, {"wheel", float64(2)}},
+			},
+			{
+				Query: `WITH RECURSIVE managers AS (
+					SELECT id, manager_id FROM employees WHERE name = 'eve'
+					UNION ALL
+					SELECT e.id, e.manager_id FROM employees e, managers m WHERE e.id = m.manager_id
+				), names AS (SELECT name FROM employees JOIN managers ON employees.id = managers.id)
+				SELECT * FROM names ORDER BY name`,
+				Expected: []sql.Row{{"ann"}, {"cat"}, {"dan"}, {"eve"}},
+			},
+			{
+				Query:    "WITH RECURSIVE reachable (node) AS (SELECT 1 UNION SELECT dst FROM edges JOIN reachable ON src = node) SELECT * FROM reachable ORDER BY node",
+				Expected: []sql.Row{{int64(1)}, {int64(2)}, {int64(3)}, {int64(4)}},
+			},
+			{
+				Query:    "WITH RECURSIVE cte AS (SELECT 1 AS n) SELECT * FROM cte",
+				Expected: []sql.Row{{int8(1)}},
+			},
+			{
+				Query:    "SELECT (WITH RECURSIVE cte (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM cte WHERE n < 10) SELECT MAX(n) FROM cte)",
+				Expected: []sql.Row{{int64(10)}},
+			},
+			{
+				// Only the WITH clause of the subquery is recursive
+				Query:    "WITH tens (n) AS (SELECT 10 UNION ALL SELECT 20) SELECT n, (WITH RECURSIVE cte (m) AS (SELECT 1 UNION ALL SELECT m + 1 FROM cte WHERE m < 3) SELECT MAX(m) FROM cte) FROM tens ORDER BY n",
+				Expected: []sql.Row{{int64(10), int64(3)}, {int64(20), int64(3)}},
+			},
+			{
+				Query:    "WITH cte AS (SELECT 'with recursive cte' AS s) SELECT * FROM cte",
+				Expected: []sql.Row{{"with recursive cte"}},
+			},
+			{
+				Query:    "SET @@cte_max_recursion_depth = 10",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:       "WITH RECURSIVE walk (node) AS (SELECT 1 UNION ALL SELECT dst FROM edges JOIN walk ON src = node) SELECT * FROM walk",
+				ExpectedErr: sql.ErrCteMaxRecursionDepth,
+			},
+			{
+				Query:       "WITH RECURSIVE cte AS (SELECT * FROM cte) SELECT * FROM cte",
+				ExpectedErr: sql.ErrCteRecursionRequiresUnion,
+			},
+			{
+				Query:       "WITH RECURSIVE cte (n) AS (SELECT n + 1 FROM cte UNION ALL SELECT 1) SELECT * FROM cte",
+				ExpectedErr: sql.ErrCteRecursionRequiresNonRecursiveFirst,
+			},
+			{
+				Query:       "WITH RECURSIVE cte (n) AS (SELECT 1 UNION ALL SELECT MAX(n) + 1 FROM cte) SELECT * FROM cte",
+				ExpectedErr: sql.ErrCteRecursionForbidsAggregation,
+			},
+			{
+				Query:       "WITH RECURSIVE cte (n) AS (SELECT 1 UNION ALL SELECT a.n + 1 FROM cte a JOIN cte b ON a.n = b.n WHERE a.n < 5) SELECT * FROM cte",
+				ExpectedErr: sql.ErrCteRecursionRequiresSingleReference,
+			},
+			{
+				Query:       "WITH RECURSIVE cte (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM employees WHERE id IN (SELECT n FROM cte)) SELECT * FROM cte",
+				ExpectedErr: sql.ErrCteRecursionRequiresSingleReference,
+			},
+		},
+	},
+	{
+		Name: "materialization hints of common table expressions",
+		SetUpScript: []string{
+			"CREATE TABLE materialized (materialized INT PRIMARY KEY)",
+			"INSERT INTO materialized VALUES (1), (2)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "WITH c AS MATERIALIZED (SELECT materialized FROM materialized), d (n) AS NOT MATERIALIZED (SELECT 'x as materialized (y)') SELECT * FROM c, d ORDER BY 1",
+				Expected: []sql.Row{{1, "x as materialized (y)"}, {2, "x as materialized (y)"}},
+			},
+			{
+				Query:    "SELECT 'c as not materialized (select 1)' FROM materialized WHERE materialized = 1",
+				Expected: []sql.Row{{"c as not materialized (select 1)"}},
+			},
+			{
+				Query:    "SET optimizer_switch = 'cte_materialization=off'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:       "WITH c AS MATERIALIZED (SELECT 1) SELECT * FROM c",
+				ExpectedErr: sql.ErrFeatureDisabled,
+			},
+			{
+				Query:    "WITH c AS (SELECT 'as materialized (select 1)') SELECT * FROM c",
+				Expected: []sql.Row{{"as materialized (select 1)"}},
+			},
+		},
+	},
+	{
+		Name: "window frames",
+		SetUpScript: []string{
+			"CREATE TABLE sales (id INT PRIMARY KEY, region VARCHAR(10), day DATE, amount INT)",
+			`INSERT INTO sales VALUES (1, 'east', '2022-01-01', 10), (2, 'east', '2022-01-03', 20), (3, 'east', '2022-01-08', 30),
+				(4, 'east', '2022-01-09', 40), (5, 'east', '2022-01-20', 50), (6, 'west', '2022-01-01', 5), (7, 'west', '2022-01-02', NULL),
+				(8, 'west', '2022-01-02', 15)`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "SELECT id, SUM(amount) OVER (PARTITION BY region ORDER BY day, id ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW) FROM sales ORDER BY id",
+				Expected: []sql.Row{
+					{1, float64(10)}, {2, float64(30)}, {3, float64(60)}, {4, float64(100)}, {5, float64(150)},
+					{6, float64(5)}, {7, float64(5)}, {8, float64(20)},
+				},
+			},
+			{
+				Query: "SELECT id, AVG(amount) OVER (PARTITION BY region ORDER BY day, id ROWS BETWEEN 2 PRECEDING AND CURRENT ROW) FROM sales ORDER BY id",
+				Expected: []sql.Row{
+					{1, float64(10)}, {2, float64(15)}, {3, float64(20)}, {4, float64(30)}, {5, float64(40)},
+					{6, float64(5)}, {7, float64(5)}, {8, float64(10)},
+				},
+			},
+			{
+				Query: "SELECT id, SUM(amount) OVER (PARTITION BY region ORDER BY day RANGE BETWEEN INTERVAL 7 DAY PRECEDING AND CURRENT ROW) FROM sales ORDER BY id",
+				Expected: []sql.Row{
+					{1, float64(10)}, {2, float64(30)}, {3, float64(60)}, {4, float64(90)}, {5, float64(50)},
+					{6, float64(5)}, {7, float64(20)}, {8, float64(20)},
+				},
+			},
+			{
+				Query: "SELECT id, COUNT(*) OVER (PARTITION BY region ORDER BY amount DESC RANGE BETWEEN 10 PRECEDING AND 10 FOLLOWING) FROM sales ORDER BY id",
+				Expected: []sql.Row{
+					{1, int64(2)}, {2, int64(3)}, {3, int64(3)}, {4, int64(3)}, {5, int64(2)},
+					{6, int64(2)}, {7, int64(1)}, {8, int64(2)},
+				},
+			},
+			{
+				Query: "SELECT id, MAX(amount) OVER (PARTITION BY region ORDER BY day RANGE CURRENT ROW) FROM sales ORDER BY id",
+				Expected: []sql.Row{
+					{1, 10}, {2, 20}, {3, 30}, {4, 40}, {5, 50},
+					{6, 5}, {7, 15}, {8, 15},
+				},
+			},
+			{
+				Query: "SELECT id, SUM(amount) OVER (PARTITION BY region ORDER BY id ROWS BETWEEN 2 FOLLOWING AND 3 FOLLOWING) FROM sales ORDER BY id",
+				Expected: []sql.Row{
+					{1, float64(70)}, {2, float64(90)}, {3, float64(50)}, {4, nil}, {5, nil},
+					{6, float64(15)}, {7, nil}, {8, nil},
+				},
+			},
+			{
+				Query: "SELECT id, FIRST_VALUE(amount) OVER (PARTITION BY region ORDER BY id ROWS BETWEEN 1 FOLLOWING AND UNBOUNDED FOLLOWING) FROM sales ORDER BY id",
+				Expected: []sql.Row{
+					{1, 20}, {2, 30}, {3, 40}, {4, 50}, {5, nil},
+					{6, nil}, {7, 15}, {8, nil},
+				},
+			},
+			{
+				// Ranking functions ignore frames
+				Query: "SELECT id, ROW_NUMBER() OVER (PARTITION BY region ORDER BY id ROWS BETWEEN 1 FOLLOWING AND 2 FOLLOWING) FROM sales ORDER BY id",
+				Expected: []sql.Row{
+					{1, 1}, {2, 2}, {3, 3}, {4, 4}, {5, 5},
+					{6, 1}, {7, 2}, {8, 3},
+				},
+			},
+			{
+				Query:       "SELECT SUM(amount) OVER (PARTITION BY region ORDER BY day RANGE 1 PRECEDING) FROM sales",
+				ExpectedErr: sql.ErrWindowRangeFrameTemporalType,
+			},
+			{
+				Query:       "SELECT SUM(amount) OVER (PARTITION BY region ORDER BY amount RANGE INTERVAL 1 DAY PRECEDING) FROM sales",
+				ExpectedErr: sql.ErrWindowRangeFrameNumericType,
+			},
+			{
+				Query:       "SELECT SUM(amount) OVER (PARTITION BY region ORDER BY amount, id RANGE 1 PRECEDING) FROM sales",
+				ExpectedErr: sql.ErrWindowRangeFrameOrderBy,
+			},
+			{
+				Query:       "SELECT SUM(amount) OVER (PARTITION BY region ORDER BY day ROWS INTERVAL 1 DAY PRECEDING) FROM sales",
+				ExpectedErr: sql.ErrInvalidWindowFrameOffset,
+			},
+		},
+	},
+	{
+		Name: "Star schema joins start with the most selective dimension",
+		SetUpScript: []string{
+			"CREATE TABLE products (id BIGINT PRIMARY KEY, category VARCHAR(20));",
+			"CREATE TABLE stores (id BIGINT PRIMARY KEY, region VARCHAR(20));",
+			"CREATE TABLE sales (id BIGINT PRIMARY KEY, product_id BIGINT, store_id BIGINT, amount BIGINT, INDEX sales_product (product_id), INDEX sales_store (store_id));",
+			"INSERT INTO products VALUES (1, 'books'), (2, 'books'), (3, 'games'), (4, 'games'), (5, 'music'), (6, 'music'), (7, 'tools'), (8, 'tools');",
+			"INSERT INTO stores VALUES (1, 'east'), (2, 'west'), (3, 'north');",
+			"INSERT INTO sales VALUES (1,1,1,10), (2,2,2,20), (3,3,3,30), (4,4,1,40), (5,5,2,50), (6,6,3,60), (7,7,1,70), (8,8,2,80), (9,1,3,90), (10,2,1,100), (11,3,2,110), (12,4,3,120), (13,5,1,130), (14,6,2,140), (15,7,3,150), (16,8,1,160), (17,1,2,170), (18,2,3,180), (19,3,1,190), (20,4,2,200), (21,5,3,210), (22,6,1,220), (23,7,2,230), (24,8,3,240);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				// Only a quarter of the products are games, so joining them first keeps the intermediate results smaller
+				// than joining the fewer stores first
+				Query: "EXPLAIN SELECT sales.id, stores.region FROM sales JOIN stores ON sales.store_id = stores.id JOIN products ON sales.product_id = products.id WHERE products.category = 'games'",
+				Expected: []sql.Row{
+					{"Project(sales.id, stores.region)"},
+					{" └─ IndexedJoin(sales.product_id = products.id)"},
+					{"     ├─ Filter(products.category = \"games\")"},
+					{"     │   └─ Projected table access on [category id]"},
+					{"     │       └─ Table(products)"},
+					{"     └─ IndexedJoin(sales.store_id = stores.id)"},
+					{"         ├─ Projected table access on [id store_id product_id]"},
+					{"         │   └─ IndexedTableAccess(sales on [sales.product_id])"},
+					{"         └─ Projected table access on [region id]"},
+					{"             └─ IndexedTableAccess(stores on [stores.id])"},
+				},
+			},
+			{
+				Query: "SELECT sales.id, stores.region FROM sales JOIN stores ON sales.store_id = stores.id JOIN products ON sales.product_id = products.id WHERE products.category = 'games' ORDER BY sales.id",
+				Expected: []sql.Row{
+					{3, "north"}, {4, "east"}, {11, "west"}, {12, "north"}, {19, "east"}, {20, "west"},
+				},
+			},
+			{
+				Query: "EXPLAIN SELECT sales.id, products.category FROM products JOIN sales ON sales.product_id = products.id JOIN stores ON sales.store_id = stores.id WHERE stores.region = 'west'",
+				Expected: []sql.Row{
+					{"Project(sales.id, products.category)"},
+					{" └─ IndexedJoin(sales.store_id = stores.id)"},
+					{"     ├─ Filter(stores.region = \"west\")"},
+					{"     │   └─ Projected table access on [region id]"},
+					{"     │       └─ Table(stores)"},
+					{"     └─ IndexedJoin(sales.product_id = products.id)"},
+					{"         ├─ Projected table access on [id product_id store_id]"},
+					{"         │   └─ IndexedTableAccess(sales on [sales.store_id])"},
+					{"         └─ Projected table access on [category id]"},
+					{"             └─ IndexedTableAccess(products on [products.id])"},
+				},
+			},
+			{
+				Query: "SELECT sales.id, products.category FROM products JOIN sales ON sales.product_id = products.id JOIN stores ON sales.store_id = stores.id WHERE stores.region = 'west' ORDER BY sales.id",
+				Expected: []sql.Row{
+					{2, "books"}, {5, "music"}, {8, "tools"}, {11, "games"}, {14, "music"}, {17, "books"}, {20, "games"}, {23, "tools"},
+				},
+			},
+		},
+	},
+	{
+		Name: "ANALYZE TABLE collects the histograms of columns",
+		SetUpScript: []string{
+			"CREATE TABLE scores (id BIGINT PRIMARY KEY, player VARCHAR(20), score BIGINT, details JSON);",
+			`INSERT INTO scores VALUES (1, 'ann', 10, '{}'), (2, 'bob', 20, '{}'), (3, 'ann', NULL, '{}'), (4, 'cat', 20, '{}');`,
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT COLUMN_NAME FROM information_schema.COLUMN_STATISTICS WHERE TABLE_NAME = 'scores'",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "ANALYZE TABLE scores",
+				Expected: []sql.Row{{"mydb.scores", "analyze", "status", "OK"}},
+			},
+			{
+				Query: `SELECT COLUMN_NAME, JSON_UNQUOTE(JSON_EXTRACT(HISTOGRAM, '$."histogram-type"')), JSON_UNQUOTE(JSON_EXTRACT(HISTOGRAM, '$."data-type"')), JSON_UNQUOTE(JSON_EXTRACT(HISTOGRAM, '$.buckets')), JSON_UNQUOTE(JSON_EXTRACT(HISTOGRAM, '$."null-values"'))
+					FROM information_schema.COLUMN_STATISTICS WHERE SCHEMA_NAME = 'mydb' AND TABLE_NAME = 'scores' ORDER BY 1`,
+				Expected: []sql.Row{
+					{"id", "singleton", "int", "[[1,0.25],[2,0.5],[3,0.75],[4,1]]", "0"},
+					{"player", "singleton", "string", `[["ann",0.5],["bob",0.75],["cat",1]]`, "0"},
+					{"score", "singleton", "int", "[[10,0.25],[20,0.75]]", "0.25"},
+				},
+			},
+		},
+	},
+	{
+		Name: "PREPARE, EXECUTE and DEALLOCATE PREPARE statements",
+		SetUpScript: []string{
+			"CREATE TABLE prices (id BIGINT PRIMARY KEY, item VARCHAR(20), price BIGINT);",
+			"INSERT INTO prices VALUES (1, 'pen', 2), (2, 'book', 15), (3, 'lamp', 30);",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "PREPARE cheap FROM 'SELECT item FROM prices WHERE price < ? ORDER BY id'",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    "SET @max = 20",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "EXECUTE cheap USING @max",
+				Expected: []sql.Row{{"pen"}, {"book"}},
+			},
+			{
+				Query:    "SET @max = 5",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "EXECUTE cheap USING @max",
+				Expected: []sql.Row{{"pen"}},
+			},
+			{
+				Query:       "EXECUTE cheap",
+				ExpectedErr: sql.ErrInvalidArgument,
+			},
+			{
+				Query:    "SET @insert = CONCAT('INSERT INTO prices VALUES (', 4, ', ?, ?)'), @item = 'desk', @price = 50",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "PREPARE ins FROM @insert",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    "EXECUTE ins USING @item, @price",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SELECT * FROM prices WHERE id = 4",
+				Expected: []sql.Row{{4, "desk", 50}},
+			},
+			{
+				Query:       "PREPARE bad FROM 'SELECT * FROM nonexistent'",
+				ExpectedErr: sql.ErrTableNotFound,
+			},
+			{
+				Query:    "DEALLOCATE PREPARE cheap",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:       "EXECUTE cheap USING @max",
+				ExpectedErr: sql.ErrUnknownPreparedStatement,
+			},
+			{
+				Query:       "DROP PREPARE cheap",
+				ExpectedErr: sql.ErrUnknownPreparedStatement,
+			},
+		},
+	},
 }
 
 var CreateCheckConstraintsScripts = []ScriptTest{
@@ -0,0 +1,307 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	gosql "database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-errors.v1"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/server"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ServerHarness runs the queries of the test suites through a server.Server that serves the engine of another
+// harness, with the github.com/go-sql-driver/mysql driver, to test the handler and the MySQL wire protocol along with
+// the engine. The results of the queries are compared as the text the server sends for each value, the columns by
+// their names and the names the driver gives to their types, and errors by their MySQL error codes and messages.
+type ServerHarness struct {
+	harness Harness
+	engine  *sqle.Engine
+	server  *server.Server
+	db      *gosql.DB
+}
+
+// NewServerHarness creates an engine with the test data of the harness given, starts a server for it on a random port
+// of localhost and connects to the server.
+func NewServerHarness(t *testing.T, harness Harness) *ServerHarness {
+	engine := NewEngine(t, harness)
+	createIndexes(t, harness, engine)
+	createForeignKeys(t, harness, engine)
+
+	serverConfig := server.Config{
+		Protocol:       "tcp",
+		Address:        "localhost:0",
+		MaxConnections: 1000,
+	}
+	s, err := server.NewDefaultServer(serverConfig, engine)
+	require.NoError(t, err)
+	go func() {
+		_ = s.Start()
+	}()
+
+	db, err := gosql.Open("mysql", fmt.Sprintf("root:@tcp(%s)/mydb", s.Listener.Addr()))
+	require.NoError(t, err)
+	// The state of a session, such as its variables, only lives in its connection
+	db.SetMaxOpenConns(1)
+	require.NoError(t, db.Ping())
+
+	// The tests of the engine register this view in the session of their context
+	_, err = db.Exec("CREATE VIEW myview AS SELECT * FROM mytable")
+	require.NoError(t, err)
+
+	return &ServerHarness{
+		harness: harness,
+		engine:  engine,
+		server:  s,
+		db:      db,
+	}
+}
+
+// Close closes the connection, the server and the engine of the harness.
+func (h *ServerHarness) Close() {
+	_ = h.db.Close()
+	_ = h.server.Close()
+	_ = h.engine.Close()
+}
+
+// TestQuery runs the query given through the server and checks that it returns the rows expected, and the columns
+// expected if given, as the engine would send them. Queries with bindings are skipped, since bindings can't be sent
+// with the text protocol.
+func (h *ServerHarness) TestQuery(t *testing.T, q string, expected []sql.Row, expectedCols []*sql.Column, bindings map[string]sql.Expression) {
+	t.Run(q, func(t *testing.T) {
+		if sh, ok := h.harness.(SkippingHarness); ok {
+			if sh.SkipQueryTest(q) {
+				t.Skipf("Skipping query %s", q)
+			}
+		}
+		if len(bindings) > 0 {
+			t.Skipf("Skipping query with bindings %s", q)
+		}
+
+		require := require.New(t)
+		sch, err := h.engine.AnalyzeQuery(NewContextWithEngine(h.harness, h.engine), q)
+		require.NoError(err, "Unexpected error for query %s", q)
+		if expectedCols == nil {
+			expectedCols = sch
+		}
+
+		// Statements without a result set, such as SET, return an OK packet and no rows
+		if len(sch) == 0 || sql.IsOkResultSchema(sch) {
+			_, err := h.db.Exec(q)
+			require.NoError(err, "Unexpected error for query %s", q)
+			return
+		}
+
+		// The server can't send values that don't convert to the types of their columns
+		var expectedText []sql.Row
+		for _, row := range expected {
+			expectedRow := make(sql.Row, len(row))
+			for i, v := range row {
+				expectedRow[i], err = serverText(sch[i].Type, v)
+				require.NoError(err, "Unexpected value for column %s of query %s", sch[i].Name, q)
+			}
+			expectedText = append(expectedText, expectedRow)
+		}
+
+		rows, err := h.db.Query(q)
+		require.NoError(err, "Unexpected error for query %s", q)
+		defer rows.Close()
+
+		cols, err := rows.ColumnTypes()
+		require.NoError(err)
+		require.Len(cols, len(expectedCols), "Unexpected columns for query %s", q)
+		for i, col := range cols {
+			require.Equal(expectedCols[i].Name, col.Name(), "Unexpected column name for query %s", q)
+			require.Equal(serverTypeName(expectedCols[i].Type), col.DatabaseTypeName(), "Unexpected type of column %s for query %s", col.Name(), q)
+		}
+
+		var actual []sql.Row
+		for rows.Next() {
+			values := make([]interface{}, len(cols))
+			pointers := make([]interface{}, len(cols))
+			for i := range values {
+				pointers[i] = &values[i]
+			}
+			require.NoError(rows.Scan(pointers...))
+
+			row := make(sql.Row, len(values))
+			for i, v := range values {
+				if v != nil {
+					row[i] = string(v.([]byte))
+				}
+			}
+			actual = append(actual, row)
+		}
+		require.NoError(rows.Err(), "Unexpected error for query %s", q)
+
+		// The times of SHOW statements are when the test data was created
+		upperQuery := strings.ToUpper(q)
+		if strings.HasPrefix(upperQuery, "SHOW ") {
+			for i, col := range sch {
+				if sql.IsTime(col.Type) {
+					for _, row := range actual {
+						row[i] = nil
+					}
+					for _, row := range expectedText {
+						row[i] = nil
+					}
+				}
+			}
+		}
+
+		if strings.Contains(upperQuery, "ORDER BY ") || len(expected) <= 1 {
+			require.Equal(expectedText, actual, "Unexpected result for query %s", q)
+		} else {
+			require.ElementsMatch(expectedText, actual, "Unexpected result for query %s", q)
+		}
+	})
+}
+
+// TestQueryError runs the query given through the server and checks that it returns the MySQL error the engine
+// returns for it, which must be of the kind expected if given, or have the message expected if given.
+func (h *ServerHarness) TestQueryError(t *testing.T, q string, bindings map[string]sql.Expression, expectedErrKind *errors.Kind, expectedErrStr string) {
+	t.Run(q, func(t *testing.T) {
+		if sh, ok := h.harness.(SkippingHarness); ok {
+			if sh.SkipQueryTest(q) {
+				t.Skipf("Skipping query %s", q)
+			}
+		}
+		if len(bindings) > 0 {
+			t.Skipf("Skipping query with bindings %s", q)
+		}
+
+		require := require.New(t)
+		ctx := NewContextWithEngine(h.harness, h.engine)
+		_, iter, err := h.engine.Query(ctx, q)
+		if err == nil {
+			_, err = sql.RowIterToRows(ctx, iter)
+		}
+		require.Error(err)
+		expectedErr, orig, _ := sql.CastSQLError(err)
+		if expectedErrKind != nil {
+			require.True(expectedErrKind.Is(orig), "Expected error of type %s but got %s", expectedErrKind, err)
+		} else if expectedErrStr != "" {
+			require.Equal(expectedErrStr, orig.Error())
+		}
+
+		rows, err := h.db.Query(q)
+		if err == nil {
+			for rows.Next() {
+			}
+			err = rows.Err()
+			require.NoError(rows.Close())
+		}
+		require.Error(err, "Expected an error for query %s", q)
+		mysqlErr, ok := err.(*mysql.MySQLError)
+		require.True(ok, "Expected a MySQL error but got %s", err)
+		require.Equal(uint16(expectedErr.Num), mysqlErr.Number, "Unexpected error code for query %s: %s", q, mysqlErr.Message)
+		require.Equal(expectedErr.Message, mysqlErr.Message)
+	})
+}
+
+// serverText returns the text the server sends for the value given of a column of the type given, or nil for NULL.
+func serverText(typ sql.Type, v interface{}) (text interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v can't be converted to %s: %v", v, typ, r)
+		}
+	}()
+
+	if v == sql.Null {
+		return nil, nil
+	}
+	val, err := typ.SQL(v)
+	if err != nil || val.IsNull() {
+		return nil, err
+	}
+	return val.ToString(), nil
+}
+
+// serverTypeNames are the names the github.com/go-sql-driver/mysql driver gives to the types of the columns the
+// server sends for each type.
+var serverTypeNames = map[query.Type]string{
+	sqltypes.Null:       "NULL",
+	sqltypes.Int8:       "TINYINT",
+	sqltypes.Uint8:      "TINYINT",
+	sqltypes.Int16:      "SMALLINT",
+	sqltypes.Uint16:     "SMALLINT",
+	sqltypes.Int24:      "MEDIUMINT",
+	sqltypes.Uint24:     "MEDIUMINT",
+	sqltypes.Int32:      "INT",
+	sqltypes.Uint32:     "INT",
+	sqltypes.Int64:      "BIGINT",
+	sqltypes.Uint64:     "BIGINT",
+	sqltypes.Float32:    "FLOAT",
+	sqltypes.Float64:    "DOUBLE",
+	sqltypes.Timestamp:  "TIMESTAMP",
+	sqltypes.Date:       "DATE",
+	sqltypes.Time:       "TIME",
+	sqltypes.Datetime:   "DATETIME",
+	sqltypes.Year:       "YEAR",
+	sqltypes.Decimal:    "DECIMAL",
+	sqltypes.Text:       "TEXT",
+	sqltypes.Blob:       "BLOB",
+	sqltypes.VarChar:    "VARCHAR",
+	sqltypes.VarBinary:  "VARBINARY",
+	sqltypes.Char:       "CHAR",
+	sqltypes.Binary:     "BINARY",
+	sqltypes.Bit:        "BIT",
+	sqltypes.Enum:       "CHAR",
+	sqltypes.Set:        "CHAR",
+	sqltypes.Geometry:   "GEOMETRY",
+	sqltypes.TypeJSON:   "JSON",
+	sqltypes.Expression: "",
+}
+
+// serverTypeName returns the name the github.com/go-sql-driver/mysql driver gives to the type of a column of the type
+// given.
+func serverTypeName(typ sql.Type) string {
+	return serverTypeNames[typ.Type()]
+}
+
+// TestServerQueries runs the query tests through a server for the engine of the harness given.
+func TestServerQueries(t *testing.T, harness Harness) {
+	h := NewServerHarness(t, harness)
+	defer h.Close()
+
+	for _, tt := range QueryTests {
+		h.TestQuery(t, tt.Query, tt.Expected, tt.ExpectedColumns, tt.Bindings)
+	}
+
+	if keyless, ok := harness.(KeylessTableHarness); ok && keyless.SupportsKeylessTables() {
+		for _, tt := range KeylessQueries {
+			h.TestQuery(t, tt.Query, tt.Expected, tt.ExpectedColumns, tt.Bindings)
+		}
+	}
+}
+
+// TestServerQueryErrors runs the query error tests through a server for the engine of the harness given.
+func TestServerQueryErrors(t *testing.T, harness Harness) {
+	h := NewServerHarness(t, harness)
+	defer h.Close()
+
+	for _, tt := range errorQueries {
+		h.TestQueryError(t, tt.Query, tt.Bindings, tt.ExpectedErr, tt.ExpectedErrStr)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import "github.com/dolthub/go-mysql-server/sql"
+
+// StatusVariableScripts test the counters of statements, handler reads, opened tables and slow queries that SHOW STATUS
+// reports. FLUSH STATUS
+// resets the counters of the session, so the scripts count from zero.
+var StatusVariableScripts = []ScriptTest{
+	{
+		Name: "statements and row reads are counted per session",
+		SetUpScript: []string{
+			"CREATE TABLE t (id BIGINT PRIMARY KEY, v BIGINT)",
+			"INSERT INTO t VALUES (1, 10), (2, 20), (3, 30)",
+			"FLUSH STATUS",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SHOW SESSION STATUS LIKE 'Com_select'",
+				Expected: []sql.Row{{"Com_select", "0"}},
+			},
+			{
+				Query:    "SELECT * FROM t",
+				Expected: []sql.Row{{int64(1), int64(10)}, {int64(2), int64(20)}, {int64(3), int64(30)}},
+			},
+			{
+				Query:    "SELECT v FROM t WHERE v > 10",
+				Expected: []sql.Row{{int64(20)}, {int64(30)}},
+			},
+			{
+				Query:    "SHOW SESSION STATUS LIKE 'Com_select'",
+				Expected: []sql.Row{{"Com_select", "2"}},
+			},
+			{
+				Query:    "FLUSH LOCAL STATUS",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT * FROM t",
+				Expected: []sql.Row{{int64(1), int64(10)}, {int64(2), int64(20)}, {int64(3), int64(30)}},
+			},
+			{
+				Query:    "SHOW STATUS WHERE Variable_name IN ('Com_select', 'Handler_read_rnd_next')",
+				Expected: []sql.Row{{"Com_select", "1"}, {"Handler_read_rnd_next", "3"}},
+			},
+			{
+				Query:    "INSERT INTO t VALUES (4, 40)",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				Query:    "SHOW SESSION STATUS LIKE 'Com_insert'",
+				Expected: []sql.Row{{"Com_insert", "1"}},
+			},
+		},
+	},
+	{
+		Name: "tables are counted as opened until they're flushed",
+		SetUpScript: []string{
+			"CREATE TABLE t (id BIGINT PRIMARY KEY)",
+			"FLUSH TABLES",
+			"FLUSH STATUS",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM t",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SELECT * FROM t",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SHOW STATUS WHERE Variable_name IN ('Open_tables', 'Opened_tables')",
+				Expected: []sql.Row{{"Open_tables", "1"}, {"Opened_tables", "1"}},
+			},
+			{
+				Query:    "FLUSH TABLES t",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    "SHOW STATUS LIKE 'Open_tables'",
+				Expected: []sql.Row{{"Open_tables", "0"}},
+			},
+			{
+				Query:    "SELECT * FROM t",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SHOW STATUS WHERE Variable_name IN ('Open_tables', 'Opened_tables')",
+				Expected: []sql.Row{{"Open_tables", "1"}, {"Opened_tables", "2"}},
+			},
+		},
+	},
+	{
+		Name: "statements that take longer than long_query_time are slow queries",
+		SetUpScript: []string{
+			"FLUSH STATUS",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SELECT 1",
+				Expected: []sql.Row{{int8(1)}},
+			},
+			{
+				Query:    "SHOW SESSION STATUS LIKE 'Slow_queries'",
+				Expected: []sql.Row{{"Slow_queries", "0"}},
+			},
+			{
+				Query:    "SET long_query_time = 0",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT 1",
+				Expected: []sql.Row{{int8(1)}},
+			},
+			{
+				// Like in MySQL, the SET statement is measured against the new value too
+				Query:    "SHOW SESSION STATUS LIKE 'Slow_queries'",
+				Expected: []sql.Row{{"Slow_queries", "2"}},
+			},
+		},
+	},
+}
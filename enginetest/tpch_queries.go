@@ -0,0 +1,1161 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+// TPCHScaleFactor is the scale factor of the tables of TPCHTables the TPC-H queries are tested with.
+const TPCHScaleFactor = 0.001
+
+// TPCHQueryTest is a query of the TPC-H benchmark, run on the tables of TPCHTables to check that the engine executes
+// complex analytic queries, and that the way it plans them doesn't change unnoticed.
+type TPCHQueryTest struct {
+	// Name is the name of the query in the benchmark, from q1 to q22.
+	Name string
+	// Query is the query, written for MySQL, with the substitution parameters of the validation of the benchmark. Its
+	// date literals are strings, and it uses YEAR() instead of EXTRACT(), which the parser doesn't support.
+	Query string
+	// ExpectedRows is the number of rows of the correct result of the query at TPCHScaleFactor.
+	ExpectedRows int
+	// ExpectedPlan is the plan of the query when the tables have their secondary indexes.
+	ExpectedPlan string
+	// Skip is whether the engine can't execute the query correctly yet. Skipped queries are still run, and their tests
+	// fail once they return as many rows as expected, so that they're noticed.
+	Skip bool
+}
+
+// TPCHQueries are the 22 queries of the TPC-H benchmark.
+var TPCHQueries = []TPCHQueryTest{
+	{
+		Name: "q1",
+		Query: `select
+	l_returnflag,
+	l_linestatus,
+	sum(l_quantity) as sum_qty,
+	sum(l_extendedprice) as sum_base_price,
+	sum(l_extendedprice * (1 - l_discount)) as sum_disc_price,
+	sum(l_extendedprice * (1 - l_discount) * (1 + l_tax)) as sum_charge,
+	avg(l_quantity) as avg_qty,
+	avg(l_extendedprice) as avg_price,
+	avg(l_discount) as avg_disc,
+	count(*) as count_order
+from
+	lineitem
+where
+	l_shipdate <= '1998-12-01' - interval '90' day
+group by
+	l_returnflag,
+	l_linestatus
+order by
+	l_returnflag,
+	l_linestatus`,
+		ExpectedRows: 4,
+		ExpectedPlan: "Sort(lineitem.l_returnflag ASC, lineitem.l_linestatus ASC)\n" +
+			" └─ Project(lineitem.l_returnflag, lineitem.l_linestatus, SUM(lineitem.l_quantity) as sum_qty, SUM(lineitem.l_extendedprice) as sum_base_price, SUM((lineitem.l_extendedprice * (1 - lineitem.l_discount))) as sum_disc_price, SUM(((lineitem.l_extendedprice * (1 - lineitem.l_discount)) * (1 + lineitem.l_tax))) as sum_charge, AVG(lineitem.l_quantity) as avg_qty, AVG(lineitem.l_extendedprice) as avg_price, AVG(lineitem.l_discount) as avg_disc, COUNT(*) as count_order)\n" +
+			"     └─ GroupBy\n" +
+			"         ├─ SelectedExprs(lineitem.l_returnflag, lineitem.l_linestatus, SUM(lineitem.l_quantity), SUM(lineitem.l_extendedprice), SUM((lineitem.l_extendedprice * (1 - lineitem.l_discount))), SUM(((lineitem.l_extendedprice * (1 - lineitem.l_discount)) * (1 + lineitem.l_tax))), AVG(lineitem.l_quantity), AVG(lineitem.l_extendedprice), AVG(lineitem.l_discount), COUNT(*))\n" +
+			"         ├─ Grouping(lineitem.l_returnflag, lineitem.l_linestatus)\n" +
+			"         └─ Filter(lineitem.l_shipdate <= 1998-09-02 00:00:00 +0000 UTC)\n" +
+			"             └─ Projected table access on [l_returnflag l_linestatus l_quantity l_extendedprice l_discount l_tax l_shipdate]\n" +
+			"                 └─ IndexedTableAccess(lineitem on [lineitem.l_shipdate])\n" +
+			"",
+	},
+	{
+		Name: "q2",
+		Query: `select
+	s_acctbal,
+	s_name,
+	n_name,
+	p_partkey,
+	p_mfgr,
+	s_address,
+	s_phone,
+	s_comment
+from
+	part,
+	supplier,
+	partsupp,
+	nation,
+	region
+where
+	p_partkey = ps_partkey
+	and s_suppkey = ps_suppkey
+	and p_size = 15
+	and p_type like '%BRASS'
+	and s_nationkey = n_nationkey
+	and n_regionkey = r_regionkey
+	and r_name = 'EUROPE'
+	and ps_supplycost = (
+		select
+			min(ps_supplycost)
+		from
+			partsupp,
+			supplier,
+			nation,
+			region
+		where
+			p_partkey = ps_partkey
+			and s_suppkey = ps_suppkey
+			and s_nationkey = n_nationkey
+			and n_regionkey = r_regionkey
+			and r_name = 'EUROPE'
+	)
+order by
+	s_acctbal desc,
+	n_name,
+	s_name,
+	p_partkey
+limit 100`,
+		ExpectedRows: 1,
+		ExpectedPlan: "Limit(100)\n" +
+			" └─ TopN(Limit: [100]; supplier.s_acctbal DESC, nation.n_name ASC, supplier.s_name ASC, part.p_partkey ASC)\n" +
+			"     └─ Project(supplier.s_acctbal, supplier.s_name, nation.n_name, part.p_partkey, part.p_mfgr, supplier.s_address, supplier.s_phone, supplier.s_comment)\n" +
+			"         └─ Filter(partsupp.ps_supplycost = (Project(MIN(partsupp.ps_supplycost) as min(ps_supplycost))\n" +
+			"             └─ GroupBy\n" +
+			"                 ├─ SelectedExprs(MIN(partsupp.ps_supplycost))\n" +
+			"                 ├─ Grouping()\n" +
+			"                 └─ Filter(part.p_partkey = partsupp.ps_partkey)\n" +
+			"                     └─ IndexedJoin(nation.n_regionkey = region.r_regionkey)\n" +
+			"                         ├─ Projected table access on [r_name r_regionkey]\n" +
+			"                         │   └─ Filter(region.r_name = \"EUROPE\")\n" +
+			"                         │       └─ Table(region)\n" +
+			"                         └─ IndexedJoin(supplier.s_nationkey = nation.n_nationkey)\n" +
+			"                             ├─ Projected table access on [n_nationkey n_regionkey]\n" +
+			"                             │   └─ IndexedTableAccess(nation on [nation.n_regionkey])\n" +
+			"                             └─ IndexedJoin(supplier.s_suppkey = partsupp.ps_suppkey)\n" +
+			"                                 ├─ Projected table access on [s_suppkey s_nationkey]\n" +
+			"                                 │   └─ IndexedTableAccess(supplier on [supplier.s_nationkey])\n" +
+			"                                 └─ Projected table access on [ps_supplycost ps_partkey ps_suppkey]\n" +
+			"                                     └─ IndexedTableAccess(partsupp on [partsupp.ps_suppkey])\n" +
+			"            ))\n" +
+			"             └─ HashJoin(nation.n_regionkey = region.r_regionkey)\n" +
+			"                 ├─ HashJoin(supplier.s_nationkey = nation.n_nationkey)\n" +
+			"                 │   ├─ HashJoin((part.p_partkey = partsupp.ps_partkey) AND (supplier.s_suppkey = partsupp.ps_suppkey))\n" +
+			"                 │   │   ├─ CrossJoin\n" +
+			"                 │   │   │   ├─ Filter((part.p_size = 15) AND part.p_type LIKE \"%BRASS\")\n" +
+			"                 │   │   │   │   └─ Projected table access on [p_partkey p_mfgr p_size p_type]\n" +
+			"                 │   │   │   │       └─ Table(part)\n" +
+			"                 │   │   │   └─ Projected table access on [s_acctbal s_name s_address s_phone s_comment s_suppkey s_nationkey]\n" +
+			"                 │   │   │       └─ Table(supplier)\n" +
+			"                 │   │   └─ Projected table access on [ps_supplycost ps_partkey ps_suppkey]\n" +
+			"                 │   │       └─ Table(partsupp)\n" +
+			"                 │   └─ Projected table access on [n_name n_nationkey n_regionkey]\n" +
+			"                 │       └─ Table(nation)\n" +
+			"                 └─ Filter(region.r_name = \"EUROPE\")\n" +
+			"                     └─ Projected table access on [r_name r_regionkey]\n" +
+			"                         └─ Table(region)\n" +
+			"",
+	},
+	{
+		Name: "q3",
+		Query: `select
+	l_orderkey,
+	sum(l_extendedprice * (1 - l_discount)) as revenue,
+	o_orderdate,
+	o_shippriority
+from
+	customer,
+	orders,
+	lineitem
+where
+	c_mktsegment = 'BUILDING'
+	and c_custkey = o_custkey
+	and l_orderkey = o_orderkey
+	and o_orderdate < '1995-03-15'
+	and l_shipdate > '1995-03-15'
+group by
+	l_orderkey,
+	o_orderdate,
+	o_shippriority
+order by
+	revenue desc,
+	o_orderdate
+limit 10`,
+		ExpectedRows: 10,
+		ExpectedPlan: "Limit(10)\n" +
+			" └─ TopN(Limit: [10]; revenue DESC, orders.o_orderdate ASC)\n" +
+			"     └─ Project(lineitem.l_orderkey, SUM((lineitem.l_extendedprice * (1 - lineitem.l_discount))) as revenue, orders.o_orderdate, orders.o_shippriority)\n" +
+			"         └─ GroupBy\n" +
+			"             ├─ SelectedExprs(lineitem.l_orderkey, SUM((lineitem.l_extendedprice * (1 - lineitem.l_discount))), orders.o_orderdate, orders.o_shippriority)\n" +
+			"             ├─ Grouping(lineitem.l_orderkey, orders.o_orderdate, orders.o_shippriority)\n" +
+			"             └─ IndexedJoin(customer.c_custkey = orders.o_custkey)\n" +
+			"                 ├─ Filter(customer.c_mktsegment = \"BUILDING\")\n" +
+			"                 │   └─ Projected table access on [c_mktsegment c_custkey]\n" +
+			"                 │       └─ Table(customer)\n" +
+			"                 └─ IndexedJoin(lineitem.l_orderkey = orders.o_orderkey)\n" +
+			"                     ├─ Filter(orders.o_orderdate < \"1995-03-15\")\n" +
+			"                     │   └─ Projected table access on [o_orderdate o_shippriority o_orderkey o_custkey]\n" +
+			"                     │       └─ IndexedTableAccess(orders on [orders.o_custkey])\n" +
+			"                     └─ Filter(lineitem.l_shipdate > \"1995-03-15\")\n" +
+			"                         └─ Projected table access on [l_orderkey l_extendedprice l_discount l_shipdate l_linenumber]\n" +
+			"                             └─ IndexedTableAccess(lineitem on [lineitem.l_orderkey,lineitem.l_linenumber])\n" +
+			"",
+	},
+	{
+		Name: "q4",
+		Query: `select
+	o_orderpriority,
+	count(*) as order_count
+from
+	orders
+where
+	o_orderdate >= '1993-07-01'
+	and o_orderdate < '1993-07-01' + interval '3' month
+	and exists (
+		select
+			*
+		from
+			lineitem
+		where
+			l_orderkey = o_orderkey
+			and l_commitdate < l_receiptdate
+	)
+group by
+	o_orderpriority
+order by
+	o_orderpriority`,
+		ExpectedRows: 5,
+		ExpectedPlan: "Sort(orders.o_orderpriority ASC)\n" +
+			" └─ Project(orders.o_orderpriority, COUNT(*) as order_count)\n" +
+			"     └─ GroupBy\n" +
+			"         ├─ SelectedExprs(orders.o_orderpriority, COUNT(*))\n" +
+			"         ├─ Grouping(orders.o_orderpriority)\n" +
+			"         └─ Project(orders.o_orderkey, orders.o_orderdate, orders.o_orderpriority)\n" +
+			"             └─ InnerJoin(orders.o_orderkey = decorrelated_subquery_1.k0)\n" +
+			"                 ├─ Filter((orders.o_orderdate >= \"1993-07-01\") AND (orders.o_orderdate < 1993-10-01 00:00:00 +0000 UTC))\n" +
+			"                 │   └─ Projected table access on [o_orderpriority o_orderkey o_orderdate]\n" +
+			"                 │       └─ IndexedTableAccess(orders on [orders.o_orderdate])\n" +
+			"                 └─ HashLookup(child: (decorrelated_subquery_1.k0), lookup: (orders.o_orderkey))\n" +
+			"                     └─ CachedResults\n" +
+			"                         └─ SubqueryAlias(decorrelated_subquery_1)\n" +
+			"                             └─ Distinct\n" +
+			"                                 └─ Project(lineitem.l_orderkey as k0)\n" +
+			"                                     └─ Filter(lineitem.l_commitdate < lineitem.l_receiptdate)\n" +
+			"                                         └─ Projected table access on [l_orderkey l_commitdate l_receiptdate]\n" +
+			"                                             └─ Table(lineitem)\n" +
+			"",
+	},
+	{
+		Name: "q5",
+		Query: `select
+	n_name,
+	sum(l_extendedprice * (1 - l_discount)) as revenue
+from
+	customer,
+	orders,
+	lineitem,
+	supplier,
+	nation,
+	region
+where
+	c_custkey = o_custkey
+	and l_orderkey = o_orderkey
+	and l_suppkey = s_suppkey
+	and c_nationkey = s_nationkey
+	and s_nationkey = n_nationkey
+	and n_regionkey = r_regionkey
+	and r_name = 'ASIA'
+	and o_orderdate >= '1994-01-01'
+	and o_orderdate < '1994-01-01' + interval '1' year
+group by
+	n_name
+order by
+	revenue desc`,
+		ExpectedRows: 1,
+		ExpectedPlan: "Sort(revenue DESC)\n" +
+			" └─ Project(nation.n_name, SUM((lineitem.l_extendedprice * (1 - lineitem.l_discount))) as revenue)\n" +
+			"     └─ GroupBy\n" +
+			"         ├─ SelectedExprs(nation.n_name, SUM((lineitem.l_extendedprice * (1 - lineitem.l_discount))))\n" +
+			"         ├─ Grouping(nation.n_name)\n" +
+			"         └─ IndexedJoin(nation.n_regionkey = region.r_regionkey)\n" +
+			"             ├─ Filter(region.r_name = \"ASIA\")\n" +
+			"             │   └─ Projected table access on [r_name r_regionkey]\n" +
+			"             │       └─ Table(region)\n" +
+			"             └─ IndexedJoin(supplier.s_nationkey = nation.n_nationkey)\n" +
+			"                 ├─ Projected table access on [n_name n_nationkey n_regionkey]\n" +
+			"                 │   └─ IndexedTableAccess(nation on [nation.n_regionkey])\n" +
+			"                 └─ IndexedJoin((lineitem.l_suppkey = supplier.s_suppkey) AND (customer.c_nationkey = supplier.s_nationkey))\n" +
+			"                     ├─ Projected table access on [s_nationkey s_suppkey]\n" +
+			"                     │   └─ IndexedTableAccess(supplier on [supplier.s_nationkey])\n" +
+			"                     └─ IndexedJoin(customer.c_custkey = orders.o_custkey)\n" +
+			"                         ├─ Projected table access on [c_custkey c_nationkey]\n" +
+			"                         │   └─ IndexedTableAccess(customer on [customer.c_nationkey])\n" +
+			"                         └─ IndexedJoin(lineitem.l_orderkey = orders.o_orderkey)\n" +
+			"                             ├─ Filter((orders.o_orderdate >= \"1994-01-01\") AND (orders.o_orderdate < 1995-01-01 00:00:00 +0000 UTC))\n" +
+			"                             │   └─ Projected table access on [o_orderdate o_custkey o_orderkey]\n" +
+			"                             │       └─ IndexedTableAccess(orders on [orders.o_custkey])\n" +
+			"                             └─ Projected table access on [l_extendedprice l_discount l_suppkey l_orderkey]\n" +
+			"                                 └─ IndexedTableAccess(lineitem on [lineitem.l_suppkey])\n" +
+			"",
+	},
+	{
+		Name: "q6",
+		Query: `select
+	sum(l_extendedprice * l_discount) as revenue
+from
+	lineitem
+where
+	l_shipdate >= '1994-01-01'
+	and l_shipdate < '1994-01-01' + interval '1' year
+	and l_discount between .06 - 0.01 and .06 + 0.01
+	and l_quantity < 24`,
+		ExpectedRows: 1,
+		ExpectedPlan: "Project(SUM((lineitem.l_extendedprice * lineitem.l_discount)) as revenue)\n" +
+			" └─ GroupBy\n" +
+			"     ├─ SelectedExprs(SUM((lineitem.l_extendedprice * lineitem.l_discount)))\n" +
+			"     ├─ Grouping()\n" +
+			"     └─ Filter((((lineitem.l_shipdate >= \"1994-01-01\") AND (lineitem.l_shipdate < 1995-01-01 00:00:00 +0000 UTC)) AND (lineitem.l_discount BETWEEN 0.049999999999999996 AND 0.06999999999999999)) AND (lineitem.l_quantity < 24))\n" +
+			"         └─ Projected table access on [l_extendedprice l_discount l_shipdate l_quantity]\n" +
+			"             └─ IndexedTableAccess(lineitem on [lineitem.l_shipdate])\n" +
+			"",
+	},
+	{
+		Name: "q7",
+		Query: `select
+	supp_nation,
+	cust_nation,
+	l_year,
+	sum(volume) as revenue
+from
+	(
+		select
+			n1.n_name as supp_nation,
+			n2.n_name as cust_nation,
+			year(l_shipdate) as l_year,
+			l_extendedprice * (1 - l_discount) as volume
+		from
+			supplier,
+			lineitem,
+			orders,
+			customer,
+			nation n1,
+			nation n2
+		where
+			s_suppkey = l_suppkey
+			and o_orderkey = l_orderkey
+			and c_custkey = o_custkey
+			and s_nationkey = n1.n_nationkey
+			and c_nationkey = n2.n_nationkey
+			and (
+				(n1.n_name = 'FRANCE' and n2.n_name = 'GERMANY')
+				or (n1.n_name = 'GERMANY' and n2.n_name = 'FRANCE')
+			)
+			and l_shipdate between '1995-01-01' and '1996-12-31'
+	) as shipping
+group by
+	supp_nation,
+	cust_nation,
+	l_year
+order by
+	supp_nation,
+	cust_nation,
+	l_year`,
+		ExpectedRows: 0,
+		ExpectedPlan: "Sort(shipping.supp_nation ASC, shipping.cust_nation ASC, shipping.l_year ASC)\n" +
+			" └─ Project(shipping.supp_nation, shipping.cust_nation, shipping.l_year, SUM(shipping.volume) as revenue)\n" +
+			"     └─ GroupBy\n" +
+			"         ├─ SelectedExprs(shipping.supp_nation, shipping.cust_nation, shipping.l_year, SUM(shipping.volume))\n" +
+			"         ├─ Grouping(shipping.supp_nation, shipping.cust_nation, shipping.l_year)\n" +
+			"         └─ SubqueryAlias(shipping)\n" +
+			"             └─ Project(n1.n_name as supp_nation, n2.n_name as cust_nation, YEAR(lineitem.l_shipdate) as l_year, (lineitem.l_extendedprice * (1 - lineitem.l_discount)) as volume)\n" +
+			"                 └─ Filter(((n1.n_name = \"FRANCE\") AND (n2.n_name = \"GERMANY\")) OR ((n1.n_name = \"GERMANY\") AND (n2.n_name = \"FRANCE\")))\n" +
+			"                     └─ IndexedJoin(customer.c_nationkey = n2.n_nationkey)\n" +
+			"                         ├─ Filter((n2.n_name = \"GERMANY\") OR (n2.n_name = \"FRANCE\"))\n" +
+			"                         │   └─ Projected table access on [n_name n_nationkey]\n" +
+			"                         │       └─ TableAlias(n2)\n" +
+			"                         │           └─ Table(nation)\n" +
+			"                         └─ IndexedJoin(customer.c_custkey = orders.o_custkey)\n" +
+			"                             ├─ Projected table access on [c_custkey c_nationkey]\n" +
+			"                             │   └─ IndexedTableAccess(customer on [customer.c_nationkey])\n" +
+			"                             └─ IndexedJoin(orders.o_orderkey = lineitem.l_orderkey)\n" +
+			"                                 ├─ Projected table access on [o_orderkey o_custkey]\n" +
+			"                                 │   └─ IndexedTableAccess(orders on [orders.o_custkey])\n" +
+			"                                 └─ IndexedJoin(supplier.s_suppkey = lineitem.l_suppkey)\n" +
+			"                                     ├─ Filter(lineitem.l_shipdate BETWEEN \"1995-01-01\" AND \"1996-12-31\")\n" +
+			"                                     │   └─ Projected table access on [l_shipdate l_extendedprice l_discount l_suppkey l_orderkey l_linenumber]\n" +
+			"                                     │       └─ IndexedTableAccess(lineitem on [lineitem.l_orderkey,lineitem.l_linenumber])\n" +
+			"                                     └─ IndexedJoin(supplier.s_nationkey = n1.n_nationkey)\n" +
+			"                                         ├─ Projected table access on [s_nationkey s_suppkey]\n" +
+			"                                         │   └─ IndexedTableAccess(supplier on [supplier.s_suppkey])\n" +
+			"                                         └─ Filter((n1.n_name = \"FRANCE\") OR (n1.n_name = \"GERMANY\"))\n" +
+			"                                             └─ Projected table access on [n_name n_nationkey]\n" +
+			"                                                 └─ TableAlias(n1)\n" +
+			"                                                     └─ IndexedTableAccess(nation on [nation.n_nationkey])\n" +
+			"",
+	},
+	{
+		Name: "q8",
+		Query: `select
+	o_year,
+	sum(case
+		when nation = 'BRAZIL' then volume
+		else 0
+	end) / sum(volume) as mkt_share
+from
+	(
+		select
+			year(o_orderdate) as o_year,
+			l_extendedprice * (1 - l_discount) as volume,
+			n2.n_name as nation
+		from
+			part,
+			supplier,
+			lineitem,
+			orders,
+			customer,
+			nation n1,
+			nation n2,
+			region
+		where
+			p_partkey = l_partkey
+			and s_suppkey = l_suppkey
+			and l_orderkey = o_orderkey
+			and o_custkey = c_custkey
+			and c_nationkey = n1.n_nationkey
+			and n1.n_regionkey = r_regionkey
+			and r_name = 'AMERICA'
+			and s_nationkey = n2.n_nationkey
+			and o_orderdate between '1995-01-01' and '1996-12-31'
+			and p_type = 'ECONOMY ANODIZED STEEL'
+	) as all_nations
+group by
+	o_year
+order by
+	o_year`,
+		ExpectedRows: 2,
+		ExpectedPlan: "Sort(all_nations.o_year ASC)\n" +
+			" └─ Project(all_nations.o_year, (SUM(CASE  WHEN (all_nations.nation = \"BRAZIL\") THEN all_nations.volume ELSE 0 END) / SUM(all_nations.volume)) as mkt_share)\n" +
+			"     └─ GroupBy\n" +
+			"         ├─ SelectedExprs(all_nations.o_year, SUM(CASE  WHEN (all_nations.nation = \"BRAZIL\") THEN all_nations.volume ELSE 0 END), SUM(all_nations.volume))\n" +
+			"         ├─ Grouping(all_nations.o_year)\n" +
+			"         └─ SubqueryAlias(all_nations)\n" +
+			"             └─ Project(YEAR(orders.o_orderdate) as o_year, (lineitem.l_extendedprice * (1 - lineitem.l_discount)) as volume, n2.n_name as nation)\n" +
+			"                 └─ HashJoin(n1.n_regionkey = region.r_regionkey)\n" +
+			"                     ├─ HashJoin(supplier.s_nationkey = n2.n_nationkey)\n" +
+			"                     │   ├─ HashJoin(customer.c_nationkey = n1.n_nationkey)\n" +
+			"                     │   │   ├─ HashJoin(orders.o_custkey = customer.c_custkey)\n" +
+			"                     │   │   │   ├─ HashJoin(lineitem.l_orderkey = orders.o_orderkey)\n" +
+			"                     │   │   │   │   ├─ HashJoin((part.p_partkey = lineitem.l_partkey) AND (supplier.s_suppkey = lineitem.l_suppkey))\n" +
+			"                     │   │   │   │   │   ├─ CrossJoin\n" +
+			"                     │   │   │   │   │   │   ├─ Filter(part.p_type = \"ECONOMY ANODIZED STEEL\")\n" +
+			"                     │   │   │   │   │   │   │   └─ Projected table access on [p_partkey p_type]\n" +
+			"                     │   │   │   │   │   │   │       └─ Table(part)\n" +
+			"                     │   │   │   │   │   │   └─ Projected table access on [s_nationkey s_suppkey]\n" +
+			"                     │   │   │   │   │   │       └─ Table(supplier)\n" +
+			"                     │   │   │   │   │   └─ Projected table access on [l_extendedprice l_discount l_orderkey l_partkey l_suppkey]\n" +
+			"                     │   │   │   │   │       └─ Table(lineitem)\n" +
+			"                     │   │   │   │   └─ Filter(orders.o_orderdate BETWEEN \"1995-01-01\" AND \"1996-12-31\")\n" +
+			"                     │   │   │   │       └─ Projected table access on [o_orderdate o_custkey o_orderkey]\n" +
+			"                     │   │   │   │           └─ IndexedTableAccess(orders on [orders.o_orderdate])\n" +
+			"                     │   │   │   └─ Projected table access on [c_nationkey c_custkey]\n" +
+			"                     │   │   │       └─ Table(customer)\n" +
+			"                     │   │   └─ Projected table access on [n_regionkey n_nationkey]\n" +
+			"                     │   │       └─ TableAlias(n1)\n" +
+			"                     │   │           └─ Table(nation)\n" +
+			"                     │   └─ Projected table access on [n_name n_nationkey]\n" +
+			"                     │       └─ TableAlias(n2)\n" +
+			"                     │           └─ Table(nation)\n" +
+			"                     └─ Filter(region.r_name = \"AMERICA\")\n" +
+			"                         └─ Projected table access on [r_regionkey r_name]\n" +
+			"                             └─ Table(region)\n" +
+			"",
+	},
+	{
+		Name: "q9",
+		Query: `select
+	nation,
+	o_year,
+	sum(amount) as sum_profit
+from
+	(
+		select
+			n_name as nation,
+			year(o_orderdate) as o_year,
+			l_extendedprice * (1 - l_discount) - ps_supplycost * l_quantity as amount
+		from
+			part,
+			supplier,
+			lineitem,
+			partsupp,
+			orders,
+			nation
+		where
+			s_suppkey = l_suppkey
+			and ps_suppkey = l_suppkey
+			and ps_partkey = l_partkey
+			and p_partkey = l_partkey
+			and o_orderkey = l_orderkey
+			and s_nationkey = n_nationkey
+			and p_name like '%green%'
+	) as profit
+group by
+	nation,
+	o_year
+order by
+	nation,
+	o_year desc`,
+		ExpectedRows: 56,
+		ExpectedPlan: "Sort(profit.nation ASC, profit.o_year DESC)\n" +
+			" └─ Project(profit.nation, profit.o_year, SUM(profit.amount) as sum_profit)\n" +
+			"     └─ GroupBy\n" +
+			"         ├─ SelectedExprs(profit.nation, profit.o_year, SUM(profit.amount))\n" +
+			"         ├─ Grouping(profit.nation, profit.o_year)\n" +
+			"         └─ SubqueryAlias(profit)\n" +
+			"             └─ Project(nation.n_name as nation, YEAR(orders.o_orderdate) as o_year, ((lineitem.l_extendedprice * (1 - lineitem.l_discount)) - (partsupp.ps_supplycost * lineitem.l_quantity)) as amount)\n" +
+			"                 └─ HashJoin(supplier.s_nationkey = nation.n_nationkey)\n" +
+			"                     ├─ HashJoin(orders.o_orderkey = lineitem.l_orderkey)\n" +
+			"                     │   ├─ HashJoin((partsupp.ps_suppkey = lineitem.l_suppkey) AND (partsupp.ps_partkey = lineitem.l_partkey))\n" +
+			"                     │   │   ├─ HashJoin((supplier.s_suppkey = lineitem.l_suppkey) AND (part.p_partkey = lineitem.l_partkey))\n" +
+			"                     │   │   │   ├─ CrossJoin\n" +
+			"                     │   │   │   │   ├─ Filterpart.p_name LIKE \"%green%\"\n" +
+			"                     │   │   │   │   │   └─ Projected table access on [p_partkey p_name]\n" +
+			"                     │   │   │   │   │       └─ Table(part)\n" +
+			"                     │   │   │   │   └─ Projected table access on [s_nationkey s_suppkey]\n" +
+			"                     │   │   │   │       └─ Table(supplier)\n" +
+			"                     │   │   │   └─ Projected table access on [l_extendedprice l_discount l_quantity l_orderkey l_suppkey l_partkey]\n" +
+			"                     │   │   │       └─ Table(lineitem)\n" +
+			"                     │   │   └─ Projected table access on [ps_supplycost ps_suppkey ps_partkey]\n" +
+			"                     │   │       └─ Table(partsupp)\n" +
+			"                     │   └─ Projected table access on [o_orderdate o_orderkey]\n" +
+			"                     │       └─ Table(orders)\n" +
+			"                     └─ Projected table access on [n_name n_nationkey]\n" +
+			"                         └─ Table(nation)\n" +
+			"",
+	},
+	{
+		Name: "q10",
+		Query: `select
+	c_custkey,
+	c_name,
+	sum(l_extendedprice * (1 - l_discount)) as revenue,
+	c_acctbal,
+	n_name,
+	c_address,
+	c_phone,
+	c_comment
+from
+	customer,
+	orders,
+	lineitem,
+	nation
+where
+	c_custkey = o_custkey
+	and l_orderkey = o_orderkey
+	and o_orderdate >= '1993-10-01'
+	and o_orderdate < '1993-10-01' + interval '3' month
+	and l_returnflag = 'R'
+	and c_nationkey = n_nationkey
+group by
+	c_custkey,
+	c_name,
+	c_acctbal,
+	c_phone,
+	n_name,
+	c_address,
+	c_comment
+order by
+	revenue desc
+limit 20`,
+		ExpectedRows: 20,
+		ExpectedPlan: "Limit(20)\n" +
+			" └─ TopN(Limit: [20]; revenue DESC)\n" +
+			"     └─ Project(customer.c_custkey, customer.c_name, SUM((lineitem.l_extendedprice * (1 - lineitem.l_discount))) as revenue, customer.c_acctbal, nation.n_name, customer.c_address, customer.c_phone, customer.c_comment)\n" +
+			"         └─ GroupBy\n" +
+			"             ├─ SelectedExprs(customer.c_custkey, customer.c_name, SUM((lineitem.l_extendedprice * (1 - lineitem.l_discount))), customer.c_acctbal, nation.n_name, customer.c_address, customer.c_phone, customer.c_comment)\n" +
+			"             ├─ Grouping(customer.c_custkey, customer.c_name, customer.c_acctbal, customer.c_phone, nation.n_name, customer.c_address, customer.c_comment)\n" +
+			"             └─ IndexedJoin(customer.c_nationkey = nation.n_nationkey)\n" +
+			"                 ├─ Projected table access on [n_name n_nationkey]\n" +
+			"                 │   └─ Table(nation)\n" +
+			"                 └─ IndexedJoin(customer.c_custkey = orders.o_custkey)\n" +
+			"                     ├─ Projected table access on [c_custkey c_name c_acctbal c_address c_phone c_comment c_nationkey]\n" +
+			"                     │   └─ IndexedTableAccess(customer on [customer.c_nationkey])\n" +
+			"                     └─ IndexedJoin(lineitem.l_orderkey = orders.o_orderkey)\n" +
+			"                         ├─ Filter((orders.o_orderdate >= \"1993-10-01\") AND (orders.o_orderdate < 1994-01-01 00:00:00 +0000 UTC))\n" +
+			"                         │   └─ Projected table access on [o_orderdate o_orderkey o_custkey]\n" +
+			"                         │       └─ IndexedTableAccess(orders on [orders.o_custkey])\n" +
+			"                         └─ Filter(lineitem.l_returnflag = \"R\")\n" +
+			"                             └─ Projected table access on [l_extendedprice l_discount l_returnflag l_orderkey l_linenumber]\n" +
+			"                                 └─ IndexedTableAccess(lineitem on [lineitem.l_orderkey,lineitem.l_linenumber])\n" +
+			"",
+	},
+	{
+		Name: "q11",
+		Query: `select
+	ps_partkey,
+	sum(ps_supplycost * ps_availqty) as value
+from
+	partsupp,
+	supplier,
+	nation
+where
+	ps_suppkey = s_suppkey
+	and s_nationkey = n_nationkey
+	and n_name = 'GERMANY'
+group by
+	ps_partkey having
+		sum(ps_supplycost * ps_availqty) > (
+			select
+				sum(ps_supplycost * ps_availqty) * 0.0001
+			from
+				partsupp,
+				supplier,
+				nation
+			where
+				ps_suppkey = s_suppkey
+				and s_nationkey = n_nationkey
+				and n_name = 'GERMANY'
+		)
+order by
+	value desc`,
+		ExpectedRows: 0,
+		ExpectedPlan: "Sort(value DESC)\n" +
+			" └─ Having((value > (Project((SUM((partsupp.ps_supplycost * partsupp.ps_availqty)) * 0.0001) as sum(ps_supplycost * ps_availqty) * 0.0001)\n" +
+			"     └─ GroupBy\n" +
+			"         ├─ SelectedExprs(SUM((partsupp.ps_supplycost * partsupp.ps_availqty)))\n" +
+			"         ├─ Grouping()\n" +
+			"         └─ IndexedJoin(partsupp.ps_suppkey = supplier.s_suppkey)\n" +
+			"             ├─ IndexedJoin(supplier.s_nationkey = nation.n_nationkey)\n" +
+			"             │   ├─ Projected table access on [s_nationkey s_suppkey]\n" +
+			"             │   │   └─ Table(supplier)\n" +
+			"             │   └─ Projected table access on [n_name n_nationkey]\n" +
+			"             │       └─ Filter(nation.n_name = \"GERMANY\")\n" +
+			"             │           └─ IndexedTableAccess(nation on [nation.n_nationkey])\n" +
+			"             └─ Projected table access on [ps_supplycost ps_availqty ps_suppkey]\n" +
+			"                 └─ IndexedTableAccess(partsupp on [partsupp.ps_suppkey])\n" +
+			"    )))\n" +
+			"     └─ Project(partsupp.ps_partkey, SUM((partsupp.ps_supplycost * partsupp.ps_availqty)) as value)\n" +
+			"         └─ GroupBy\n" +
+			"             ├─ SelectedExprs(partsupp.ps_partkey, SUM((partsupp.ps_supplycost * partsupp.ps_availqty)))\n" +
+			"             ├─ Grouping(partsupp.ps_partkey)\n" +
+			"             └─ HashJoin(supplier.s_nationkey = nation.n_nationkey)\n" +
+			"                 ├─ MergeJoin(partsupp.ps_suppkey = supplier.s_suppkey)\n" +
+			"                 │   ├─ Projected table access on [ps_supplycost ps_availqty ps_suppkey ps_partkey]\n" +
+			"                 │   │   └─ IndexedTableAccess(partsupp on [partsupp.ps_suppkey])\n" +
+			"                 │   └─ Projected table access on [s_nationkey s_suppkey]\n" +
+			"                 │       └─ IndexedTableAccess(supplier on [supplier.s_suppkey])\n" +
+			"                 └─ Filter(nation.n_name = \"GERMANY\")\n" +
+			"                     └─ Projected table access on [n_name n_nationkey]\n" +
+			"                         └─ Table(nation)\n" +
+			"",
+	},
+	{
+		Name: "q12",
+		Query: `select
+	l_shipmode,
+	sum(case
+		when o_orderpriority = '1-URGENT'
+			or o_orderpriority = '2-HIGH'
+			then 1
+		else 0
+	end) as high_line_count,
+	sum(case
+		when o_orderpriority <> '1-URGENT'
+			and o_orderpriority <> '2-HIGH'
+			then 1
+		else 0
+	end) as low_line_count
+from
+	orders,
+	lineitem
+where
+	o_orderkey = l_orderkey
+	and l_shipmode in ('MAIL', 'SHIP')
+	and l_commitdate < l_receiptdate
+	and l_shipdate < l_commitdate
+	and l_receiptdate >= '1994-01-01'
+	and l_receiptdate < '1994-01-01' + interval '1' year
+group by
+	l_shipmode
+order by
+	l_shipmode`,
+		ExpectedRows: 2,
+		ExpectedPlan: "Sort(lineitem.l_shipmode ASC)\n" +
+			" └─ Project(lineitem.l_shipmode, SUM(CASE  WHEN ((orders.o_orderpriority = \"1-URGENT\") OR (orders.o_orderpriority = \"2-HIGH\")) THEN 1 ELSE 0 END) as high_line_count, SUM(CASE  WHEN ((NOT((orders.o_orderpriority = \"1-URGENT\"))) AND (NOT((orders.o_orderpriority = \"2-HIGH\")))) THEN 1 ELSE 0 END) as low_line_count)\n" +
+			"     └─ GroupBy\n" +
+			"         ├─ SelectedExprs(lineitem.l_shipmode, SUM(CASE  WHEN ((orders.o_orderpriority = \"1-URGENT\") OR (orders.o_orderpriority = \"2-HIGH\")) THEN 1 ELSE 0 END), SUM(CASE  WHEN ((NOT((orders.o_orderpriority = \"1-URGENT\"))) AND (NOT((orders.o_orderpriority = \"2-HIGH\")))) THEN 1 ELSE 0 END))\n" +
+			"         ├─ Grouping(lineitem.l_shipmode)\n" +
+			"         └─ MergeJoin(orders.o_orderkey = lineitem.l_orderkey)\n" +
+			"             ├─ Projected table access on [o_orderpriority o_orderkey]\n" +
+			"             │   └─ IndexedTableAccess(orders on [orders.o_orderkey])\n" +
+			"             └─ Filter(((((lineitem.l_shipmode HASH IN (\"MAIL\", \"SHIP\")) AND (lineitem.l_commitdate < lineitem.l_receiptdate)) AND (lineitem.l_shipdate < lineitem.l_commitdate)) AND (lineitem.l_receiptdate >= \"1994-01-01\")) AND (lineitem.l_receiptdate < 1995-01-01 00:00:00 +0000 UTC))\n" +
+			"                 └─ Projected table access on [l_shipmode l_commitdate l_receiptdate l_shipdate l_orderkey l_linenumber]\n" +
+			"                     └─ IndexedTableAccess(lineitem on [lineitem.l_orderkey,lineitem.l_linenumber])\n" +
+			"",
+	},
+	{
+		Name: "q13",
+		Query: `select
+	c_count,
+	count(*) as custdist
+from
+	(
+		select
+			c_custkey,
+			count(o_orderkey) as c_count
+		from
+			customer left outer join orders on
+				c_custkey = o_custkey
+				and o_comment not like '%special%requests%'
+		group by
+			c_custkey
+	) as c_orders
+group by
+	c_count
+order by
+	custdist desc,
+	c_count desc`,
+		ExpectedRows: 17,
+		ExpectedPlan: "Sort(custdist DESC, c_orders.c_count DESC)\n" +
+			" └─ Project(c_orders.c_count, COUNT(*) as custdist)\n" +
+			"     └─ GroupBy\n" +
+			"         ├─ SelectedExprs(c_orders.c_count, COUNT(*))\n" +
+			"         ├─ Grouping(c_orders.c_count)\n" +
+			"         └─ SubqueryAlias(c_orders)\n" +
+			"             └─ Project(customer.c_custkey, COUNT(orders.o_orderkey) as c_count)\n" +
+			"                 └─ GroupBy\n" +
+			"                     ├─ SelectedExprs(customer.c_custkey, COUNT(orders.o_orderkey))\n" +
+			"                     ├─ Grouping(customer.c_custkey)\n" +
+			"                     └─ LeftMergeJoin((customer.c_custkey = orders.o_custkey) AND (NOT(orders.o_comment LIKE \"%special%requests%\")))\n" +
+			"                         ├─ Projected table access on [c_custkey]\n" +
+			"                         │   └─ IndexedTableAccess(customer on [customer.c_custkey])\n" +
+			"                         └─ Projected table access on [o_orderkey o_custkey o_comment]\n" +
+			"                             └─ IndexedTableAccess(orders on [orders.o_custkey])\n" +
+			"",
+	},
+	{
+		Name: "q14",
+		Query: `select
+	100.00 * sum(case
+		when p_type like 'PROMO%'
+			then l_extendedprice * (1 - l_discount)
+		else 0
+	end) / sum(l_extendedprice * (1 - l_discount)) as promo_revenue
+from
+	lineitem,
+	part
+where
+	l_partkey = p_partkey
+	and l_shipdate >= '1995-09-01'
+	and l_shipdate < '1995-09-01' + interval '1' month`,
+		ExpectedRows: 1,
+		ExpectedPlan: "Project(((100 * SUM(CASE  WHEN part.p_type LIKE \"PROMO%\" THEN (lineitem.l_extendedprice * (1 - lineitem.l_discount)) ELSE 0 END)) / SUM((lineitem.l_extendedprice * (1 - lineitem.l_discount)))) as promo_revenue)\n" +
+			" └─ GroupBy\n" +
+			"     ├─ SelectedExprs(SUM(CASE  WHEN part.p_type LIKE \"PROMO%\" THEN (lineitem.l_extendedprice * (1 - lineitem.l_discount)) ELSE 0 END), SUM((lineitem.l_extendedprice * (1 - lineitem.l_discount))))\n" +
+			"     ├─ Grouping()\n" +
+			"     └─ MergeJoin(lineitem.l_partkey = part.p_partkey)\n" +
+			"         ├─ Projected table access on [p_type p_partkey]\n" +
+			"         │   └─ IndexedTableAccess(part on [part.p_partkey])\n" +
+			"         └─ Filter((lineitem.l_shipdate >= \"1995-09-01\") AND (lineitem.l_shipdate < 1995-10-01 00:00:00 +0000 UTC))\n" +
+			"             └─ Projected table access on [l_extendedprice l_discount l_shipdate l_partkey l_suppkey]\n" +
+			"                 └─ IndexedTableAccess(lineitem on [lineitem.l_partkey,lineitem.l_suppkey])\n" +
+			"",
+	},
+	{
+		// The revenue0 view of the benchmark is a common table expression instead
+		Name: "q15",
+		Query: `with revenue0 (supplier_no, total_revenue) as (
+	select
+		l_suppkey,
+		sum(l_extendedprice * (1 - l_discount))
+	from
+		lineitem
+	where
+		l_shipdate >= '1996-01-01'
+		and l_shipdate < '1996-01-01' + interval '3' month
+	group by
+		l_suppkey
+)
+select
+	s_suppkey,
+	s_name,
+	s_address,
+	s_phone,
+	total_revenue
+from
+	supplier,
+	revenue0
+where
+	s_suppkey = supplier_no
+	and total_revenue = (
+		select
+			max(total_revenue)
+		from
+			revenue0
+	)
+order by
+	s_suppkey`,
+		ExpectedRows: 1,
+		ExpectedPlan: "Sort(supplier.s_suppkey ASC)\n" +
+			" └─ Project(supplier.s_suppkey, supplier.s_name, supplier.s_address, supplier.s_phone, revenue0.total_revenue)\n" +
+			"     └─ Filter(revenue0.total_revenue = (Project(MAX(revenue0.total_revenue) as max(total_revenue))\n" +
+			"         └─ GroupBy\n" +
+			"             ├─ SelectedExprs(MAX(revenue0.total_revenue))\n" +
+			"             ├─ Grouping()\n" +
+			"             └─ SubqueryAlias(revenue0)\n" +
+			"                 └─ GroupBy\n" +
+			"                     ├─ SelectedExprs(lineitem.l_suppkey, SUM((lineitem.l_extendedprice * (1 - lineitem.l_discount))))\n" +
+			"                     ├─ Grouping(lineitem.l_suppkey)\n" +
+			"                     └─ Projected table access on [l_suppkey l_extendedprice l_discount l_shipdate]\n" +
+			"                         └─ Filter((lineitem.l_shipdate >= \"1996-01-01\") AND (lineitem.l_shipdate < 1996-04-01 00:00:00 +0000 UTC))\n" +
+			"                             └─ IndexedTableAccess(lineitem on [lineitem.l_shipdate])\n" +
+			"        ))\n" +
+			"         └─ IndexedJoin(supplier.s_suppkey = revenue0.supplier_no)\n" +
+			"             ├─ SubqueryAlias(revenue0)\n" +
+			"             │   └─ GroupBy\n" +
+			"             │       ├─ SelectedExprs(lineitem.l_suppkey, SUM((lineitem.l_extendedprice * (1 - lineitem.l_discount))))\n" +
+			"             │       ├─ Grouping(lineitem.l_suppkey)\n" +
+			"             │       └─ Filter((lineitem.l_shipdate >= \"1996-01-01\") AND (lineitem.l_shipdate < 1996-04-01 00:00:00 +0000 UTC))\n" +
+			"             │           └─ Projected table access on [l_suppkey l_extendedprice l_discount l_shipdate]\n" +
+			"             │               └─ IndexedTableAccess(lineitem on [lineitem.l_shipdate])\n" +
+			"             └─ Projected table access on [s_suppkey s_name s_address s_phone]\n" +
+			"                 └─ IndexedTableAccess(supplier on [supplier.s_suppkey])\n" +
+			"",
+	},
+	{
+		Name: "q16",
+		Query: `select
+	p_brand,
+	p_type,
+	p_size,
+	count(distinct ps_suppkey) as supplier_cnt
+from
+	partsupp,
+	part
+where
+	p_partkey = ps_partkey
+	and p_brand <> 'Brand#45'
+	and p_type not like 'MEDIUM POLISHED%'
+	and p_size in (49, 14, 23, 45, 19, 3, 36, 9)
+	and ps_suppkey not in (
+		select
+			s_suppkey
+		from
+			supplier
+		where
+			s_comment like '%Customer%Complaints%'
+	)
+group by
+	p_brand,
+	p_type,
+	p_size
+order by
+	supplier_cnt desc,
+	p_brand,
+	p_type,
+	p_size`,
+		ExpectedRows: 31,
+		ExpectedPlan: "Sort(supplier_cnt DESC, part.p_brand ASC, part.p_type ASC, part.p_size ASC)\n" +
+			" └─ Project(part.p_brand, part.p_type, part.p_size, COUNTDISTINCT(partsupp.ps_suppkey) as supplier_cnt)\n" +
+			"     └─ GroupBy\n" +
+			"         ├─ SelectedExprs(part.p_brand, part.p_type, part.p_size, COUNTDISTINCT(partsupp.ps_suppkey))\n" +
+			"         ├─ Grouping(part.p_brand, part.p_type, part.p_size)\n" +
+			"         └─ Filter(NOT((partsupp.ps_suppkey IN (Project(supplier.s_suppkey)\n" +
+			"             └─ Projected table access on [s_suppkey s_comment]\n" +
+			"                 └─ Filtersupplier.s_comment LIKE \"%Customer%Complaints%\"\n" +
+			"                     └─ Table(supplier)\n" +
+			"            ))))\n" +
+			"             └─ MergeJoin(part.p_partkey = partsupp.ps_partkey)\n" +
+			"                 ├─ Filter(((NOT((part.p_brand = \"Brand#45\"))) AND (NOT(part.p_type LIKE \"MEDIUM POLISHED%\"))) AND (part.p_size HASH IN (49, 14, 23, 45, 19, 3, 36, 9)))\n" +
+			"                 │   └─ Projected table access on [p_brand p_type p_size p_partkey]\n" +
+			"                 │       └─ IndexedTableAccess(part on [part.p_partkey])\n" +
+			"                 └─ Projected table access on [ps_suppkey ps_partkey]\n" +
+			"                     └─ IndexedTableAccess(partsupp on [partsupp.ps_partkey,partsupp.ps_suppkey])\n" +
+			"",
+	},
+	{
+		Name: "q17",
+		Query: `select
+	sum(l_extendedprice) / 7.0 as avg_yearly
+from
+	lineitem,
+	part
+where
+	p_partkey = l_partkey
+	and p_brand = 'Brand#23'
+	and p_container = 'MED BOX'
+	and l_quantity < (
+		select
+			0.2 * avg(l_quantity)
+		from
+			lineitem
+		where
+			l_partkey = p_partkey
+	)`,
+		ExpectedRows: 1,
+		ExpectedPlan: "Project((SUM(lineitem.l_extendedprice) / 7) as avg_yearly)\n" +
+			" └─ GroupBy\n" +
+			"     ├─ SelectedExprs(SUM(lineitem.l_extendedprice))\n" +
+			"     ├─ Grouping()\n" +
+			"     └─ Filter(lineitem.l_quantity < (Project((0.2 * AVG(lineitem.l_quantity)) as 0.2 * avg(l_quantity))\n" +
+			"         └─ GroupBy\n" +
+			"             ├─ SelectedExprs(AVG(lineitem.l_quantity))\n" +
+			"             ├─ Grouping()\n" +
+			"             └─ Filter(lineitem.l_partkey = part.p_partkey)\n" +
+			"                 └─ Projected table access on [l_quantity l_partkey l_suppkey]\n" +
+			"                     └─ IndexedTableAccess(lineitem on [lineitem.l_partkey,lineitem.l_suppkey])\n" +
+			"        ))\n" +
+			"         └─ MergeJoin(part.p_partkey = lineitem.l_partkey)\n" +
+			"             ├─ Filter((part.p_brand = \"Brand#23\") AND (part.p_container = \"MED BOX\"))\n" +
+			"             │   └─ Projected table access on [p_partkey p_brand p_container]\n" +
+			"             │       └─ IndexedTableAccess(part on [part.p_partkey])\n" +
+			"             └─ Projected table access on [l_extendedprice l_quantity l_partkey l_suppkey]\n" +
+			"                 └─ IndexedTableAccess(lineitem on [lineitem.l_partkey,lineitem.l_suppkey])\n" +
+			"",
+	},
+	{
+		// The orders of TPCHTables have four line items of at most 50 items each, so the threshold of the quantity is
+		// 150 instead of 300. The SUM() of the HAVING clause of the subquery is resolved as the one of the outer query,
+		// so the result is wrong.
+		Name: "q18",
+		Query: `select
+	c_name,
+	c_custkey,
+	o_orderkey,
+	o_orderdate,
+	o_totalprice,
+	sum(l_quantity)
+from
+	customer,
+	orders,
+	lineitem
+where
+	o_orderkey in (
+		select
+			l_orderkey
+		from
+			lineitem
+		group by
+			l_orderkey having
+				sum(l_quantity) > 150
+	)
+	and c_custkey = o_custkey
+	and o_orderkey = l_orderkey
+group by
+	c_name,
+	c_custkey,
+	o_orderkey,
+	o_orderdate,
+	o_totalprice
+order by
+	o_totalprice desc,
+	o_orderdate
+limit 100`,
+		ExpectedRows: 68,
+		Skip:         true,
+	},
+	{
+		Name: "q19",
+		Query: `select
+	sum(l_extendedprice* (1 - l_discount)) as revenue
+from
+	lineitem,
+	part
+where
+	(
+		p_partkey = l_partkey
+		and p_brand = 'Brand#12'
+		and p_container in ('SM CASE', 'SM BOX', 'SM PACK', 'SM PKG')
+		and l_quantity >= 1 and l_quantity <= 1 + 10
+		and p_size between 1 and 5
+		and l_shipmode in ('AIR', 'AIR REG')
+		and l_shipinstruct = 'DELIVER IN PERSON'
+	)
+	or
+	(
+		p_partkey = l_partkey
+		and p_brand = 'Brand#23'
+		and p_container in ('MED BAG', 'MED BOX', 'MED PKG', 'MED PACK')
+		and l_quantity >= 10 and l_quantity <= 10 + 10
+		and p_size between 1 and 10
+		and l_shipmode in ('AIR', 'AIR REG')
+		and l_shipinstruct = 'DELIVER IN PERSON'
+	)
+	or
+	(
+		p_partkey = l_partkey
+		and p_brand = 'Brand#34'
+		and p_container in ('LG CASE', 'LG BOX', 'LG PACK', 'LG PKG')
+		and l_quantity >= 20 and l_quantity <= 20 + 10
+		and p_size between 1 and 15
+		and l_shipmode in ('AIR', 'AIR REG')
+		and l_shipinstruct = 'DELIVER IN PERSON'
+	)`,
+		ExpectedRows: 1,
+		ExpectedPlan: "Project(SUM((lineitem.l_extendedprice * (1 - lineitem.l_discount))) as revenue)\n" +
+			" └─ GroupBy\n" +
+			"     ├─ SelectedExprs(SUM((lineitem.l_extendedprice * (1 - lineitem.l_discount))))\n" +
+			"     ├─ Grouping()\n" +
+			"     └─ InnerJoin((((((((((part.p_partkey = lineitem.l_partkey) AND (part.p_brand = \"Brand#12\")) AND (part.p_container IN (\"SM CASE\", \"SM BOX\", \"SM PACK\", \"SM PKG\"))) AND (lineitem.l_quantity >= 1)) AND (lineitem.l_quantity <= (1 + 10))) AND (part.p_size BETWEEN 1 AND 5)) AND (lineitem.l_shipmode IN (\"AIR\", \"AIR REG\"))) AND (lineitem.l_shipinstruct = \"DELIVER IN PERSON\")) OR ((((((((part.p_partkey = lineitem.l_partkey) AND (part.p_brand = \"Brand#23\")) AND (part.p_container IN (\"MED BAG\", \"MED BOX\", \"MED PKG\", \"MED PACK\"))) AND (lineitem.l_quantity >= 10)) AND (lineitem.l_quantity <= (10 + 10))) AND (part.p_size BETWEEN 1 AND 10)) AND (lineitem.l_shipmode IN (\"AIR\", \"AIR REG\"))) AND (lineitem.l_shipinstruct = \"DELIVER IN PERSON\"))) OR ((((((((part.p_partkey = lineitem.l_partkey) AND (part.p_brand = \"Brand#34\")) AND (part.p_container IN (\"LG CASE\", \"LG BOX\", \"LG PACK\", \"LG PKG\"))) AND (lineitem.l_quantity >= 20)) AND (lineitem.l_quantity <= (20 + 10))) AND (part.p_size BETWEEN 1 AND 15)) AND (lineitem.l_shipmode IN (\"AIR\", \"AIR REG\"))) AND (lineitem.l_shipinstruct = \"DELIVER IN PERSON\")))\n" +
+			"         ├─ Projected table access on [l_extendedprice l_discount l_partkey l_quantity l_shipmode l_shipinstruct]\n" +
+			"         │   └─ Table(lineitem)\n" +
+			"         └─ Projected table access on [p_partkey p_brand p_container p_size]\n" +
+			"             └─ Table(part)\n" +
+			"",
+	},
+	{
+		Name: "q20",
+		Query: `select
+	s_name,
+	s_address
+from
+	supplier,
+	nation
+where
+	s_suppkey in (
+		select
+			ps_suppkey
+		from
+			partsupp
+		where
+			ps_partkey in (
+				select
+					p_partkey
+				from
+					part
+				where
+					p_name like 'forest%'
+			)
+			and ps_availqty > (
+				select
+					0.5 * sum(l_quantity)
+				from
+					lineitem
+				where
+					l_partkey = ps_partkey
+					and l_suppkey = ps_suppkey
+					and l_shipdate >= '1994-01-01'
+					and l_shipdate < '1994-01-01' + interval '1' year
+			)
+	)
+	and s_nationkey = n_nationkey
+	and n_name = 'CANADA'
+order by
+	s_name`,
+		ExpectedRows: 0,
+		ExpectedPlan: "Sort(supplier.s_name ASC)\n" +
+			" └─ Project(supplier.s_name, supplier.s_address)\n" +
+			"     └─ Filter(supplier.s_suppkey IN (Project(partsupp.ps_suppkey)\n" +
+			"         └─ Filter((partsupp.ps_partkey IN (Project(part.p_partkey)\n" +
+			"             └─ Projected table access on [p_partkey p_name]\n" +
+			"                 └─ Filterpart.p_name LIKE \"forest%\"\n" +
+			"                     └─ Table(part)\n" +
+			"            )) AND (partsupp.ps_availqty > (Project((0.5 * SUM(lineitem.l_quantity)) as 0.5 * sum(l_quantity))\n" +
+			"             └─ GroupBy\n" +
+			"                 ├─ SelectedExprs(SUM(lineitem.l_quantity))\n" +
+			"                 ├─ Grouping()\n" +
+			"                 └─ Filter((lineitem.l_partkey = partsupp.ps_partkey) AND (lineitem.l_suppkey = partsupp.ps_suppkey))\n" +
+			"                     └─ Projected table access on [l_quantity l_partkey l_suppkey l_shipdate]\n" +
+			"                         └─ Filter((lineitem.l_shipdate >= \"1994-01-01\") AND (lineitem.l_shipdate < 1995-01-01 00:00:00 +0000 UTC))\n" +
+			"                             └─ IndexedTableAccess(lineitem on [lineitem.l_shipdate])\n" +
+			"            )))\n" +
+			"             └─ Projected table access on [ps_suppkey ps_partkey ps_availqty]\n" +
+			"                 └─ Table(partsupp)\n" +
+			"        ))\n" +
+			"         └─ MergeJoin(supplier.s_nationkey = nation.n_nationkey)\n" +
+			"             ├─ Projected table access on [s_name s_address s_suppkey s_nationkey]\n" +
+			"             │   └─ IndexedTableAccess(supplier on [supplier.s_nationkey])\n" +
+			"             └─ Filter(nation.n_name = \"CANADA\")\n" +
+			"                 └─ Projected table access on [n_name n_nationkey]\n" +
+			"                     └─ IndexedTableAccess(nation on [nation.n_nationkey])\n" +
+			"",
+	},
+	{
+		// EXISTS subqueries that select every column fail, because they have more than one column
+		Name: "q21",
+		Query: `select
+	s_name,
+	count(*) as numwait
+from
+	supplier,
+	lineitem l1,
+	orders,
+	nation
+where
+	s_suppkey = l1.l_suppkey
+	and o_orderkey = l1.l_orderkey
+	and o_orderstatus = 'F'
+	and l1.l_receiptdate > l1.l_commitdate
+	and exists (
+		select
+			*
+		from
+			lineitem l2
+		where
+			l2.l_orderkey = l1.l_orderkey
+			and l2.l_suppkey <> l1.l_suppkey
+	)
+	and not exists (
+		select
+			*
+		from
+			lineitem l3
+		where
+			l3.l_orderkey = l1.l_orderkey
+			and l3.l_suppkey <> l1.l_suppkey
+			and l3.l_receiptdate > l3.l_commitdate
+	)
+	and s_nationkey = n_nationkey
+	and n_name = 'SAUDI ARABIA'
+group by
+	s_name
+order by
+	numwait desc,
+	s_name
+limit 100`,
+		ExpectedRows: 3,
+		Skip:         true,
+	},
+	{
+		// EXISTS subqueries that select every column fail, because they have more than one column
+		Name: "q22",
+		Query: `select
+	cntrycode,
+	count(*) as numcust,
+	sum(c_acctbal) as totacctbal
+from
+	(
+		select
+			substring(c_phone from 1 for 2) as cntrycode,
+			c_acctbal
+		from
+			customer
+		where
+			substring(c_phone from 1 for 2) in
+				('13', '31', '23', '29', '30', '18', '17')
+			and c_acctbal > (
+				select
+					avg(c_acctbal)
+				from
+					customer
+				where
+					c_acctbal > 0.00
+					and substring(c_phone from 1 for 2) in
+						('13', '31', '23', '29', '30', '18', '17')
+			)
+			and not exists (
+				select
+					*
+				from
+					orders
+				where
+					o_custkey = c_custkey
+			)
+	) as custsale
+group by
+	cntrycode
+order by
+	cntrycode`,
+		ExpectedRows: 0,
+		Skip:         true,
+	},
+}
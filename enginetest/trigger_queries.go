@@ -1274,6 +1274,58 @@ end;`,
 			},
 		},
 	},
+	{
+		Name: "changes of triggers are discarded along with their failed statement",
+		SetUpScript: []string{
+			"create table a (x int primary key)",
+			"create table b (y int primary key)",
+			"create table c (z int primary key)",
+			"create trigger before_insert_a before insert on a for each row insert into b values (new.x)",
+			"create trigger after_insert_a after insert on a for each row insert into c values (new.x div 10)",
+		},
+		Assertions: []ScriptTestAssertion{
+			{
+				Query: "insert into a values (1)",
+				Expected: []sql.Row{
+					{sql.OkResult{RowsAffected: 1}},
+				},
+			},
+			{
+				// the after trigger fails on the third row, once the changes to a and b have been made
+				Query:       "insert into a values (10), (20), (21)",
+				ExpectedErr: sql.ErrPrimaryKeyViolation,
+			},
+			{
+				Query:    "select x from a order by 1",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select y from b order by 1",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select z from c order by 1",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				// the before trigger fails on the second row, once the first one has been inserted
+				Query:       "insert into a values (30), (1)",
+				ExpectedErr: sql.ErrPrimaryKeyViolation,
+			},
+			{
+				Query:    "select x from a order by 1",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select y from b order by 1",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select z from c order by 1",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
 	// Information schema scripts
 	{
 		Name: "infoschema for multiple triggers before and after insert, with precedes / follows",
@@ -17,17 +17,36 @@ package enginetest
 import (
 	"time"
 
+	"gopkg.in/src-d/go-errors.v1"
+
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
+// UserPrivilegeTest is a test of the user and privilege systems. Its setup script runs as root@localhost, and each of
+// its assertions runs as the account it names, or as root@localhost if it names none.
+type UserPrivilegeTest struct {
+	Name        string
+	SetUpScript []string
+	Assertions  []UserPrivilegeTestAssertion
+}
+
+// UserPrivilegeTestAssertion is a statement of a UserPrivilegeTest, with the account that runs it.
+type UserPrivilegeTestAssertion struct {
+	User        string
+	Host        string
+	Query       string
+	Expected    []sql.Row
+	ExpectedErr *errors.Kind
+}
+
 // UserPrivTests test the user, authentication, and privilege systems.
-var UserPrivTests = []ScriptTest{
+var UserPrivTests = []UserPrivilegeTest{
 	{
 		Name: "Basic user creation",
 		SetUpScript: []string{
 			"CREATE USER testuser@`127.0.0.1`;",
 		},
-		Assertions: []ScriptTestAssertion{
+		Assertions: []UserPrivilegeTestAssertion{
 			{
 				Query:       "CREATE USER testuser@`127.0.0.1`;",
 				ExpectedErr: sql.ErrUserCreationFailure,
@@ -108,4 +127,317 @@ var UserPrivTests = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "Basic user deletion",
+		SetUpScript: []string{
+			"CREATE USER testuser@`127.0.0.1`;",
+			"CREATE USER testuser2@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				Query:       "DROP USER testuser@`127.0.0.1`, testuser3@localhost;",
+				ExpectedErr: sql.ErrUserDeletionFailure,
+			},
+			{
+				Query:    "DROP USER IF EXISTS testuser@`127.0.0.1`, testuser3@localhost;",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:       "DROP USER testuser@`127.0.0.1`;",
+				ExpectedErr: sql.ErrUserDeletionFailure,
+			},
+			{
+				Query: "SELECT Host, User FROM mysql.user;",
+				Expected: []sql.Row{
+					{"localhost", "root"},
+					{"localhost", "testuser2"},
+				},
+			},
+		},
+	},
+	{
+		Name: "User creation with authentication plugins",
+		SetUpScript: []string{
+			"CREATE USER native@localhost IDENTIFIED WITH mysql_native_password BY 'pass';",
+			"CREATE USER sha@localhost IDENTIFIED WITH caching_sha2_password BY 'pass';",
+			"CREATE USER ldap@localhost IDENTIFIED WITH authentication_ldap_simple AS 'cn=ldap,dc=example';",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				Query: "SELECT User, plugin, authentication_string FROM mysql.user WHERE User <> 'root' ORDER BY User;",
+				Expected: []sql.Row{
+					{"ldap", "authentication_ldap_simple", "cn=ldap,dc=example"},
+					{"native", "mysql_native_password", "*196BDEDE2AE4F84CA44C47D54D78478C7E2BD7B7"},
+					{"sha", "caching_sha2_password", "AE14CB0CE00D17BA7D62AF1E07D0EDE444299CC8D2E0ADEEB0ECD90FFBDE5803"},
+				},
+			},
+		},
+	},
+	{
+		Name: "Table privileges",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v BIGINT);",
+			"INSERT INTO test VALUES (1, 10), (2, 20);",
+			"CREATE USER tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT * FROM test;",
+				ExpectedErr: sql.ErrTableAccessDenied,
+			},
+			{
+				Query:    "GRANT SELECT ON mydb.test TO tester@localhost;",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT * FROM test ORDER BY pk;",
+				Expected: []sql.Row{{int64(1), int64(10)}, {int64(2), int64(20)}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "INSERT INTO test VALUES (3, 30);",
+				ExpectedErr: sql.ErrTableAccessDenied,
+			},
+			{
+				Query:    "GRANT INSERT ON mydb.* TO tester@localhost;",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "INSERT INTO test VALUES (3, 30);",
+				Expected: []sql.Row{{sql.NewOkResult(1)}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "UPDATE test SET v = 0;",
+				ExpectedErr: sql.ErrTableAccessDenied,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "DROP TABLE test;",
+				ExpectedErr: sql.ErrTableAccessDenied,
+			},
+			{
+				User:  "tester",
+				Host:  "localhost",
+				Query: "SHOW GRANTS;",
+				Expected: []sql.Row{
+					{"GRANT USAGE ON *.* TO `tester`@`localhost`"},
+					{"GRANT INSERT ON `mydb`.* TO `tester`@`localhost`"},
+					{"GRANT SELECT ON `mydb`.`test` TO `tester`@`localhost`"},
+				},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SHOW GRANTS FOR root@localhost;",
+				ExpectedErr: sql.ErrDatabaseAccessDenied,
+			},
+			{
+				Query:    "SELECT Host, Db, User, Select_priv, Insert_priv FROM mysql.db;",
+				Expected: []sql.Row{{"localhost", "mydb", "tester", "N", "Y"}},
+			},
+			{
+				Query:    "REVOKE SELECT ON mydb.test FROM tester@localhost;",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT * FROM test;",
+				ExpectedErr: sql.ErrTableAccessDenied,
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM mysql.tables_priv;",
+				Expected: []sql.Row{{int64(0)}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "GRANT INSERT ON mydb.* TO tester@localhost;",
+				ExpectedErr: sql.ErrDatabaseAccessDenied,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "CREATE USER other@localhost;",
+				ExpectedErr: sql.ErrPrivilegeDenied,
+			},
+		},
+	},
+	{
+		Name: "Column privileges",
+		SetUpScript: []string{
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v BIGINT);",
+			"INSERT INTO test VALUES (1, 10), (2, 20);",
+			"CREATE USER tester@localhost;",
+			"GRANT SELECT (v), UPDATE (v) ON mydb.test TO tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "SELECT t.v FROM test t ORDER BY t.v;",
+				Expected: []sql.Row{{int64(10)}, {int64(20)}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT pk FROM test;",
+				ExpectedErr: sql.ErrColumnAccessDenied,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT * FROM test;",
+				ExpectedErr: sql.ErrColumnAccessDenied,
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "UPDATE test SET v = v + 1;",
+				Expected: []sql.Row{{newUpdateResult(2, 2)}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "UPDATE test SET pk = pk + 10;",
+				ExpectedErr: sql.ErrColumnAccessDenied,
+			},
+			{
+				Query: "SHOW GRANTS FOR tester@localhost;",
+				Expected: []sql.Row{
+					{"GRANT USAGE ON *.* TO `tester`@`localhost`"},
+					{"GRANT SELECT (`v`), UPDATE (`v`) ON `mydb`.`test` TO `tester`@`localhost`"},
+				},
+			},
+			{
+				Query:    "SELECT Table_name, Column_name, Column_priv FROM mysql.columns_priv;",
+				Expected: []sql.Row{{"test", "v", "Select,Update"}},
+			},
+			{
+				Query:       "GRANT INSERT (v) ON mydb.* TO tester@localhost;",
+				ExpectedErr: sql.ErrIllegalGrant,
+			},
+			{
+				Query:       "GRANT SELECT ON mydb.test TO nobody@localhost;",
+				ExpectedErr: sql.ErrGrantUserNotFound,
+			},
+			{
+				Query:       "SHOW GRANTS FOR nobody@localhost;",
+				ExpectedErr: sql.ErrNoSuchGrant,
+			},
+		},
+	},
+	{
+		Name: "UPDATE requires SELECT on the columns it reads",
+		SetUpScript: []string{
+			"CREATE TABLE secret (pk BIGINT PRIMARY KEY, v BIGINT);",
+			"INSERT INTO secret VALUES (1, 42), (2, 43);",
+			"CREATE USER tester@localhost;",
+			"GRANT UPDATE ON mydb.secret TO tester@localhost;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "UPDATE secret SET pk = pk WHERE v = 43;",
+				ExpectedErr: sql.ErrTableAccessDenied,
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "UPDATE secret SET v = v + 1;",
+				ExpectedErr: sql.ErrTableAccessDenied,
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "UPDATE secret SET v = 0;",
+				Expected: []sql.Row{{newUpdateResult(2, 2)}},
+			},
+			{
+				Query:    "GRANT SELECT (pk) ON mydb.secret TO tester@localhost;",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "UPDATE secret SET v = 1 WHERE pk = 1;",
+				Expected: []sql.Row{{newUpdateResult(1, 1)}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "UPDATE secret SET pk = pk WHERE v = 1;",
+				ExpectedErr: sql.ErrColumnAccessDenied,
+			},
+			{
+				Query:    "SELECT * FROM secret ORDER BY pk;",
+				Expected: []sql.Row{{int64(1), int64(1)}, {int64(2), int64(0)}},
+			},
+		},
+	},
+	{
+		Name: "Global privileges and REVOKE ALL",
+		SetUpScript: []string{
+			"CREATE USER tester@localhost;",
+			"CREATE USER other@localhost;",
+			"GRANT ALL ON *.* TO tester@localhost WITH GRANT OPTION;",
+		},
+		Assertions: []UserPrivilegeTestAssertion{
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "CREATE TABLE test (pk BIGINT PRIMARY KEY);",
+				Expected: []sql.Row{},
+			},
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "GRANT SELECT ON mydb.test TO other@localhost;",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				User:     "other",
+				Host:     "localhost",
+				Query:    "SELECT * FROM test;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "SHOW GRANTS FOR tester@localhost;",
+				Expected: []sql.Row{{"GRANT ALL PRIVILEGES ON *.* TO `tester`@`localhost` WITH GRANT OPTION"}},
+			},
+			{
+				Query:    "REVOKE ALL PRIVILEGES, GRANT OPTION FROM tester@localhost;",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				User:        "tester",
+				Host:        "localhost",
+				Query:       "SELECT * FROM test;",
+				ExpectedErr: sql.ErrTableAccessDenied,
+			},
+			{
+				Query:    "SHOW GRANTS FOR tester@localhost;",
+				Expected: []sql.Row{{"GRANT USAGE ON *.* TO `tester`@`localhost`"}},
+			},
+			{
+				Query:    "DROP USER other@localhost;",
+				Expected: []sql.Row{{sql.NewOkResult(0)}},
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM mysql.tables_priv;",
+				Expected: []sql.Row{{int64(0)}},
+			},
+		},
+	},
 }
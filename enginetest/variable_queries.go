@@ -332,6 +332,50 @@ var VariableQueries = []ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "optimizer_switch sets the feature flags of the session",
+		Assertions: []ScriptTestAssertion{
+			{
+				Query:    "SET optimizer_switch = 'recursive_cte=off'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:       "WITH RECURSIVE n (i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM n WHERE i < 3) SELECT i FROM n",
+				ExpectedErr: sql.ErrFeatureDisabled,
+			},
+			{
+				// Flags that aren't registered can't be set, but the MySQL flags the engine doesn't have are ignored
+				Query:       "SET optimizer_switch = 'nonexistent=on'",
+				ExpectedErr: sql.ErrUnknownFeatureFlag,
+			},
+			{
+				Query:    "SET optimizer_switch = 'derived_merge=off,mrr=on'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				// As in MySQL, setting some flags leaves the others as they were
+				Query:    "SET optimizer_switch = 'semijoin=off,index_merge=off'",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @@optimizer_switch",
+				Expected: []sql.Row{{"index_merge=off,recursive_cte=off,semijoin=off"}},
+			},
+			{
+				// Setting it to DEFAULT resets all of them
+				Query:    "SET optimizer_switch = DEFAULT",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:    "SELECT @@optimizer_switch",
+				Expected: []sql.Row{{""}},
+			},
+			{
+				Query:    "WITH RECURSIVE n (i) AS (SELECT 1 UNION ALL SELECT i + 1 FROM n WHERE i < 3) SELECT i FROM n",
+				Expected: []sql.Row{{int64(1)}, {int64(2)}, {int64(3)}},
+			},
+		},
+	},
 	//TODO: do not override tables with user-var-like names...but why would you do this??
 	//{
 	//	Name: "user var table name no conflict",
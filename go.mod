@@ -36,4 +36,6 @@ require (
 
 replace github.com/oliveagle/jsonpath => github.com/dolthub/jsonpath v0.0.0-20210609232853-d49537a30474
 
+replace github.com/dolthub/vitess => ./third_party/vitess
+
 go 1.15
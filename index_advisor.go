@@ -0,0 +1,377 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// IndexSuggestion is an index that would let the engine use index lookups for queries in a workload that currently
+// scan a table.
+type IndexSuggestion struct {
+	Database string
+	Table    string
+	Columns  []string
+	// Queries is the number of queries in the workload that would benefit from this index.
+	Queries int
+}
+
+// String returns the CREATE INDEX statement for this suggestion.
+func (s IndexSuggestion) String() string {
+	columns := make([]string, len(s.Columns))
+	for i, col := range s.Columns {
+		columns[i] = fmt.Sprintf("`%s`", col)
+	}
+	name := "idx_" + strings.ToLower(strings.Join(s.Columns, "_"))
+	return fmt.Sprintf("CREATE INDEX `%s` ON `%s`.`%s` (%s)", name, s.Database, s.Table, strings.Join(columns, ", "))
+}
+
+// SuggestIndexes analyzes each of the queries given, without executing them, and returns the indexes that would let
+// the engine replace table scans with index lookups. Suggestions are made from the columns compared against constant
+// values in filters, and from the columns of equality join conditions in joins that don't use an index. They are
+// ordered by the number of queries that would benefit from them, most first.
+func (e *Engine) SuggestIndexes(ctx *sql.Context, queries []string) ([]IndexSuggestion, error) {
+	suggestions := make(map[string]*IndexSuggestion)
+	for _, query := range queries {
+		parsed, err := parse.Parse(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+
+		analyzed, err := e.Analyzer.Analyze(ctx, parsed, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		advisor := newIndexAdvisor()
+		advisor.inspect(analyzed)
+		for _, s := range advisor.suggestions(ctx) {
+			key := strings.ToLower(fmt.Sprintf("%s.%s(%s)", s.Database, s.Table, strings.Join(s.Columns, ",")))
+			if existing, ok := suggestions[key]; ok {
+				existing.Queries++
+			} else {
+				s.Queries = 1
+				suggestions[key] = &s
+			}
+		}
+	}
+
+	result := make([]IndexSuggestion, 0, len(suggestions))
+	for _, s := range suggestions {
+		result = append(result, *s)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Queries != result[j].Queries {
+			return result[i].Queries > result[j].Queries
+		}
+		if result[i].Database != result[j].Database {
+			return result[i].Database < result[j].Database
+		}
+		if result[i].Table != result[j].Table {
+			return result[i].Table < result[j].Table
+		}
+		return strings.Join(result[i].Columns, ",") < strings.Join(result[j].Columns, ",")
+	})
+	return result, nil
+}
+
+// advisedTable is a table accessed by a query, along with the columns that an index on it could be used for.
+type advisedTable struct {
+	database  string
+	table     sql.Table
+	indexed   bool
+	eqCols    []string
+	rangeCols []string
+}
+
+// indexAdvisor collects the tables of a single analyzed query and the columns that indexes could be used for.
+type indexAdvisor struct {
+	tables map[string]*advisedTable
+	order  []string
+}
+
+func newIndexAdvisor() *indexAdvisor {
+	return &indexAdvisor{tables: make(map[string]*advisedTable)}
+}
+
+func (a *indexAdvisor) inspect(node sql.Node) {
+	// Tables are gathered first, so that the filters and joins referencing them can be attributed.
+	plan.Inspect(node, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.TableAlias:
+			a.addTable(n.Name(), n.Child)
+		case *plan.IndexedTableAccess:
+			a.addTable(n.Name(), n)
+		case *plan.ResolvedTable:
+			a.addTable(n.Name(), n)
+		}
+		return true
+	})
+
+	plan.Inspect(node, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.Filter:
+			for _, e := range splitConjunction(n.Expression) {
+				a.addFilter(e)
+			}
+		case plan.JoinNode:
+			secondary := n.Right()
+			if n.JoinType() == plan.JoinTypeRight {
+				secondary = n.Left()
+			}
+			secondaryTables := make(map[string]bool)
+			plan.Inspect(secondary, func(n sql.Node) bool {
+				if nameable, ok := n.(sql.Nameable); ok {
+					secondaryTables[strings.ToLower(nameable.Name())] = true
+				}
+				return true
+			})
+			for _, e := range splitConjunction(n.JoinCond()) {
+				a.addJoinCondition(e, secondaryTables)
+			}
+		}
+
+		if exprs, ok := n.(sql.Expressioner); ok {
+			for _, e := range exprs.Expressions() {
+				sql.Inspect(e, func(e sql.Expression) bool {
+					if sq, ok := e.(*plan.Subquery); ok {
+						a.inspect(sq.Query)
+					}
+					return true
+				})
+			}
+		}
+		return true
+	})
+}
+
+func (a *indexAdvisor) addTable(name string, node sql.Node) {
+	name = strings.ToLower(name)
+	if _, ok := a.tables[name]; ok {
+		return
+	}
+
+	for {
+		decorated, ok := node.(*plan.DecoratedNode)
+		if !ok {
+			break
+		}
+		node = decorated.Child
+	}
+
+	var rt *plan.ResolvedTable
+	indexed := false
+	switch n := node.(type) {
+	case *plan.IndexedTableAccess:
+		rt, indexed = n.ResolvedTable, true
+	case *plan.ResolvedTable:
+		rt = n
+	default:
+		return
+	}
+	if rt.Database == nil {
+		return
+	}
+
+	a.tables[name] = &advisedTable{database: rt.Database.Name(), table: rt.Table, indexed: indexed}
+	a.order = append(a.order, name)
+}
+
+// addFilter records the column of the filter expression given if it compares a column against a constant value.
+func (a *indexAdvisor) addFilter(e sql.Expression) {
+	var field sql.Expression
+	var values []sql.Expression
+	isEquality := false
+	switch e := e.(type) {
+	case *expression.Equals, *expression.NullSafeEquals:
+		cmp := e.(expression.Comparer)
+		field, values, isEquality = cmp.Left(), []sql.Expression{cmp.Right()}, true
+		if _, ok := field.(*expression.GetField); !ok {
+			field, values[0] = cmp.Right(), cmp.Left()
+		}
+	case *expression.InTuple, *expression.HashInTuple:
+		cmp := e.(expression.Comparer)
+		field, values, isEquality = cmp.Left(), []sql.Expression{cmp.Right()}, true
+	case *expression.GreaterThan, *expression.GreaterThanOrEqual, *expression.LessThan, *expression.LessThanOrEqual:
+		cmp := e.(expression.Comparer)
+		field, values = cmp.Left(), []sql.Expression{cmp.Right()}
+		if _, ok := field.(*expression.GetField); !ok {
+			field, values[0] = cmp.Right(), cmp.Left()
+		}
+	case *expression.Between:
+		field, values = e.Val, []sql.Expression{e.Lower, e.Upper}
+	default:
+		return
+	}
+
+	gf, ok := field.(*expression.GetField)
+	if !ok {
+		return
+	}
+	for _, value := range values {
+		if referencesColumns(value) {
+			return
+		}
+	}
+
+	t, ok := a.tables[strings.ToLower(gf.Table())]
+	if !ok || t.indexed {
+		return
+	}
+	if isEquality {
+		t.eqCols = appendColumn(t.eqCols, gf.Name())
+	} else {
+		t.rangeCols = appendColumn(t.rangeCols, gf.Name())
+	}
+}
+
+// addJoinCondition records the column of the secondary table of a join if the expression given is an equality between
+// it and a column of another table.
+func (a *indexAdvisor) addJoinCondition(e sql.Expression, secondaryTables map[string]bool) {
+	var left, right sql.Expression
+	switch e := e.(type) {
+	case *expression.Equals:
+		left, right = e.Left(), e.Right()
+	case *expression.NullSafeEquals:
+		left, right = e.Left(), e.Right()
+	default:
+		return
+	}
+
+	leftField, ok := left.(*expression.GetField)
+	if !ok {
+		return
+	}
+	rightField, ok := right.(*expression.GetField)
+	if !ok || strings.EqualFold(leftField.Table(), rightField.Table()) {
+		return
+	}
+
+	for _, gf := range []*expression.GetField{leftField, rightField} {
+		if !secondaryTables[strings.ToLower(gf.Table())] {
+			continue
+		}
+		if t, ok := a.tables[strings.ToLower(gf.Table())]; ok && !t.indexed {
+			t.eqCols = appendColumn(t.eqCols, gf.Name())
+		}
+	}
+}
+
+// suggestions returns an index suggestion for each table that has columns an index could be used for, unless the
+// table already has an index on those columns.
+func (a *indexAdvisor) suggestions(ctx *sql.Context) []IndexSuggestion {
+	var suggestions []IndexSuggestion
+	seen := make(map[string]bool)
+	for _, name := range a.order {
+		t := a.tables[name]
+		columns := append([]string(nil), t.eqCols...)
+		for _, col := range t.rangeCols {
+			if !containsColumn(columns, col) {
+				columns = append(columns, col)
+				break
+			}
+		}
+		if len(columns) == 0 || hasIndexWithPrefix(ctx, t.table, columns) {
+			continue
+		}
+
+		key := strings.ToLower(fmt.Sprintf("%s.%s(%s)", t.database, t.table.Name(), strings.Join(columns, ",")))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		suggestions = append(suggestions, IndexSuggestion{
+			Database: t.database,
+			Table:    t.table.Name(),
+			Columns:  columns,
+		})
+	}
+	return suggestions
+}
+
+// hasIndexWithPrefix returns whether the table given has an index whose leading columns are the columns given.
+func hasIndexWithPrefix(ctx *sql.Context, table sql.Table, columns []string) bool {
+	indexedTable, ok := table.(sql.IndexedTable)
+	if !ok {
+		return false
+	}
+	indexes, err := indexedTable.GetIndexes(ctx)
+	if err != nil {
+		return false
+	}
+
+	for _, idx := range indexes {
+		exprs := idx.Expressions()
+		if len(exprs) < len(columns) {
+			continue
+		}
+		matches := true
+		for i, col := range columns {
+			if !strings.EqualFold(exprs[i][strings.LastIndex(exprs[i], ".")+1:], col) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+func splitConjunction(e sql.Expression) []sql.Expression {
+	if e == nil {
+		return nil
+	}
+	if and, ok := e.(*expression.And); ok {
+		return append(splitConjunction(and.Left), splitConjunction(and.Right)...)
+	}
+	return []sql.Expression{e}
+}
+
+func referencesColumns(e sql.Expression) bool {
+	found := false
+	sql.Inspect(e, func(e sql.Expression) bool {
+		switch e.(type) {
+		case *expression.GetField, *plan.Subquery:
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+func appendColumn(columns []string, col string) []string {
+	if containsColumn(columns, col) {
+		return columns
+	}
+	return append(columns, col)
+}
+
+func containsColumn(columns []string, col string) bool {
+	for _, c := range columns {
+		if strings.EqualFold(c, col) {
+			return true
+		}
+	}
+	return false
+}
@@ -284,12 +284,22 @@ func GetConnInode(c *net.TCPConn) (n uint64, err error) {
 		return 0, ErrSocketCheckNotImplemented.New()
 	}
 
-	f, err := c.File()
+	// Use the raw file descriptor rather than c.File(), which duplicates the descriptor and puts the socket into
+	// blocking mode. A blocked read on the connection would then prevent it from being closed by another goroutine.
+	rawConn, err := c.SyscallConn()
 	if err != nil {
 		return
 	}
 
-	socketStr := fmt.Sprintf("/proc/%d/fd/%d", os.Getpid(), f.Fd())
+	var fd uintptr
+	err = rawConn.Control(func(f uintptr) {
+		fd = f
+	})
+	if err != nil {
+		return
+	}
+
+	socketStr := fmt.Sprintf("/proc/%d/fd/%d", os.Getpid(), fd)
 	socketLnk, err := os.Readlink(socketStr)
 	if err != nil {
 		return
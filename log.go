@@ -18,15 +18,19 @@ import (
 	vtlog "github.com/dolthub/vitess/go/vt/log"
 	"github.com/golang/glog"
 	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/go-mysql-server/sql"
 )
 
 const ConnectionIdLogField = "connectionID"
 const ConnectTimeLogKey = "connectTime"
+const QueryIdLogField = "queryID"
+const UserLogField = "user"
 
 func init() {
 	// V quickly checks if the logging verbosity meets a threshold.
 	vtlog.V = func(level glog.Level) glog.Verbose {
-		lvl := logrus.GetLevel()
+		lvl := sql.GetLogger().GetLevel()
 		switch int32(level) {
 		case 0:
 			return glog.Verbose(lvl == logrus.InfoLevel)
@@ -45,47 +49,67 @@ func init() {
 	vtlog.Flush = func() {}
 
 	// Info formats arguments like fmt.Print.
-	vtlog.Info = logrus.Info
+	vtlog.Info = func(args ...interface{}) {
+		sql.GetLogger().Info(args...)
+	}
 	// Infof formats arguments like fmt.Printf.
-	vtlog.Infof = logrus.Infof
+	vtlog.Infof = func(format string, args ...interface{}) {
+		sql.GetLogger().Infof(format, args...)
+	}
 	// InfoDepth formats arguments like fmt.Print and uses depth to choose which call frame to log.
 	vtlog.InfoDepth = func(_ int, args ...interface{}) {
-		logrus.Info(args...)
+		sql.GetLogger().Info(args...)
 	}
 
 	// Warning formats arguments like fmt.Print.
-	vtlog.Warning = logrus.Warning
+	vtlog.Warning = func(args ...interface{}) {
+		sql.GetLogger().Warning(args...)
+	}
 	// Warningf formats arguments like fmt.Printf.
-	vtlog.Warningf = logrus.Warningf
+	vtlog.Warningf = func(format string, args ...interface{}) {
+		sql.GetLogger().Warningf(format, args...)
+	}
 	// WarningDepth formats arguments like fmt.Print and uses depth to choose which call frame to log.
 	vtlog.WarningDepth = func(depth int, args ...interface{}) {
-		logrus.Warning(args...)
+		sql.GetLogger().Warning(args...)
 	}
 
 	// Error formats arguments like fmt.Print.
-	vtlog.Error = logrus.Error
+	vtlog.Error = func(args ...interface{}) {
+		sql.GetLogger().Error(args...)
+	}
 	// Errorf formats arguments like fmt.Printf.
-	vtlog.Errorf = logrus.Errorf
+	vtlog.Errorf = func(format string, args ...interface{}) {
+		sql.GetLogger().Errorf(format, args...)
+	}
 	// ErrorDepth formats arguments like fmt.Print and uses depth to choose which call frame to log.
 	vtlog.ErrorDepth = func(_ int, args ...interface{}) {
-		logrus.Error(args...)
+		sql.GetLogger().Error(args...)
 	}
 
 	// Exit formats arguments like fmt.Print.
-	vtlog.Exit = logrus.Panic
+	vtlog.Exit = func(args ...interface{}) {
+		sql.GetLogger().Panic(args...)
+	}
 	// Exitf formats arguments like fmt.Printf.
-	vtlog.Exitf = logrus.Panicf
+	vtlog.Exitf = func(format string, args ...interface{}) {
+		sql.GetLogger().Panicf(format, args...)
+	}
 	// ExitDepth formats arguments like fmt.Print and uses depth to choose which call frame to log.
 	vtlog.ExitDepth = func(_ int, args ...interface{}) {
-		logrus.Panic(args...)
+		sql.GetLogger().Panic(args...)
 	}
 
 	// Fatal formats arguments like fmt.Print.
-	vtlog.Fatal = logrus.Fatal
+	vtlog.Fatal = func(args ...interface{}) {
+		sql.GetLogger().Fatal(args...)
+	}
 	// Fatalf formats arguments like fmt.Printf
-	vtlog.Fatalf = logrus.Fatalf
+	vtlog.Fatalf = func(format string, args ...interface{}) {
+		sql.GetLogger().Fatalf(format, args...)
+	}
 	// FatalDepth formats arguments like fmt.Print and uses depth to choose which call frame to log.
 	vtlog.FatalDepth = func(_ int, args ...interface{}) {
-		logrus.Fatal(args...)
+		sql.GetLogger().Fatal(args...)
 	}
 }
@@ -0,0 +1,69 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"context"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// withMaxExecutionTime returns a copy of the context given whose deadline is the max_execution_time of the session
+// after the time given, along with the function that releases the deadline. Like MySQL, the variable is in
+// milliseconds, and only SELECT statements time out: the context given and a nil function are returned for other
+// statements, and when the variable is 0.
+func withMaxExecutionTime(ctx *sql.Context, parsed sql.Node, started time.Time) (*sql.Context, context.CancelFunc, error) {
+	if plan.StatementStatusVariable(parsed) != "Com_select" {
+		return ctx, nil, nil
+	}
+	val, err := ctx.GetSessionVariable(ctx, "max_execution_time")
+	if err != nil {
+		return nil, nil, err
+	}
+	val, err = sql.Int64.Convert(val)
+	if err != nil {
+		return nil, nil, err
+	}
+	if millis := val.(int64); millis > 0 {
+		deadlineCtx, cancel := context.WithDeadline(ctx.Context, started.Add(time.Duration(millis)*time.Millisecond))
+		return ctx.WithContext(deadlineCtx), cancel, nil
+	}
+	return ctx, nil, nil
+}
+
+// maxExecutionTimeIter wraps the rows of a statement that has a deadline, reading them with the statement's context so
+// that the tables it reads stop at the deadline, and releasing the deadline once they're closed.
+type maxExecutionTimeIter struct {
+	childIter sql.RowIter
+	ctx       *sql.Context
+	cancel    context.CancelFunc
+}
+
+var _ sql.RowIter = (*maxExecutionTimeIter)(nil)
+
+func (m *maxExecutionTimeIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := m.childIter.Next(m.ctx)
+	if err != nil && m.ctx.Err() == context.DeadlineExceeded {
+		return nil, sql.ErrMaxExecutionTimeExceeded.New()
+	}
+	return row, err
+}
+
+func (m *maxExecutionTimeIter) Close(ctx *sql.Context) error {
+	defer m.cancel()
+	return m.childIter.Close(ctx)
+}
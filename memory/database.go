@@ -16,6 +16,7 @@ package memory
 
 import (
 	"strings"
+	"sync"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -35,13 +36,17 @@ var _ sql.Database = (*Database)(nil)
 var _ sql.TableCreator = (*Database)(nil)
 var _ sql.TableDropper = (*Database)(nil)
 var _ sql.TableRenamer = (*Database)(nil)
+var _ sql.MultiTableRenamer = (*Database)(nil)
+var _ sql.TableMover = (*Database)(nil)
 var _ sql.TriggerDatabase = (*Database)(nil)
 var _ sql.StoredProcedureDatabase = (*Database)(nil)
 var _ sql.ViewDatabase = (*Database)(nil)
 
 // BaseDatabase is an in-memory database that can't store views, only for testing the engine
 type BaseDatabase struct {
-	name              string
+	name string
+	// mu guards tables, which are looked up by queries while other sessions create and drop them
+	mu                sync.RWMutex
 	tables            map[string]sql.Table
 	triggers          []sql.TriggerDefinition
 	storedProcedures  []sql.StoredProcedureDetails
@@ -79,15 +84,25 @@ func (d *BaseDatabase) Name() string {
 
 // Tables returns all tables in the database.
 func (d *BaseDatabase) Tables() map[string]sql.Table {
-	return d.tables
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	tables := make(map[string]sql.Table, len(d.tables))
+	for name, table := range d.tables {
+		tables[name] = table
+	}
+	return tables
 }
 
 func (d *BaseDatabase) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
 	tbl, ok := sql.GetTableInsensitive(tblName, d.tables)
 	return tbl, ok, nil
 }
 
 func (d *BaseDatabase) GetTableNames(ctx *sql.Context) ([]string, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
 	tblNames := make([]string, 0, len(d.tables))
 	for k := range d.tables {
 		tblNames = append(tblNames, k)
@@ -144,16 +159,21 @@ func (db *HistoryDatabase) AddTableAsOf(name string, t sql.Table, asOf interface
 	}
 
 	db.Revisions[strings.ToLower(name)][asOf] = t
-	db.tables[name] = t
+	db.AddTable(name, t)
 }
 
 // AddTable adds a new table to the database.
 func (d *BaseDatabase) AddTable(name string, t sql.Table) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
 	d.tables[name] = t
 }
 
 // CreateTable creates a table with the given name and schema
 func (d *BaseDatabase) CreateTable(ctx *sql.Context, name string, schema sql.PrimaryKeySchema) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
 	_, ok := d.tables[name]
 	if ok {
 		return sql.ErrTableAlreadyExists.New(name)
@@ -169,6 +189,9 @@ func (d *BaseDatabase) CreateTable(ctx *sql.Context, name string, schema sql.Pri
 
 // DropTable drops the table with the given name
 func (d *BaseDatabase) DropTable(ctx *sql.Context, name string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
 	_, ok := d.tables[name]
 	if !ok {
 		return sql.ErrTableNotFound.New(name)
@@ -179,6 +202,9 @@ func (d *BaseDatabase) DropTable(ctx *sql.Context, name string) error {
 }
 
 func (d *BaseDatabase) RenameTable(ctx *sql.Context, oldName, newName string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
 	tbl, ok := d.tables[oldName]
 	if !ok {
 		// Should be impossible (engine already checks this condition)
@@ -197,6 +223,74 @@ func (d *BaseDatabase) RenameTable(ctx *sql.Context, oldName, newName string) er
 	return nil
 }
 
+// RenameTables implements sql.MultiTableRenamer.
+func (d *BaseDatabase) RenameTables(ctx *sql.Context, renames []sql.TableRename) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	tables := make([]sql.Table, len(renames))
+	remaining := make(map[string]struct{}, len(d.tables))
+	for name := range d.tables {
+		remaining[name] = struct{}{}
+	}
+	for i, rename := range renames {
+		tbl, ok := d.tables[rename.OldName]
+		if !ok {
+			return sql.ErrTableNotFound.New(rename.OldName)
+		}
+		tables[i] = tbl
+		delete(remaining, rename.OldName)
+	}
+	for _, rename := range renames {
+		if _, ok := remaining[rename.NewName]; ok {
+			return sql.ErrTableAlreadyExists.New(rename.NewName)
+		}
+		remaining[rename.NewName] = struct{}{}
+	}
+
+	for _, rename := range renames {
+		delete(d.tables, rename.OldName)
+	}
+	for i, rename := range renames {
+		tables[i].(*Table).name = rename.NewName
+		d.tables[rename.NewName] = tables[i]
+	}
+
+	return nil
+}
+
+// MoveTable implements sql.TableMover. Tables can be moved to any other in-memory database.
+func (d *BaseDatabase) MoveTable(ctx *sql.Context, oldName string, to sql.Database, newName string) error {
+	target, ok := to.(MemoryDatabase)
+	if !ok {
+		return sql.ErrMoveTableNotSupported.New(d.name, to.Name())
+	}
+
+	// The target is checked before locking this database, which may be the target
+	_, ok, err := target.GetTableInsensitive(ctx, newName)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return sql.ErrTableAlreadyExists.New(newName)
+	}
+
+	d.mu.Lock()
+	tbl, ok := d.tables[oldName]
+	if ok {
+		delete(d.tables, oldName)
+	}
+	d.mu.Unlock()
+	if !ok {
+		return sql.ErrTableNotFound.New(oldName)
+	}
+
+	tbl.(*Table).name = newName
+	target.AddTable(newName, tbl)
+
+	return nil
+}
+
 func (d *BaseDatabase) GetTriggers(ctx *sql.Context) ([]sql.TriggerDefinition, error) {
 	var triggers []sql.TriggerDefinition
 	for _, def := range d.triggers {
@@ -37,6 +37,7 @@ type Index struct {
 }
 
 var _ sql.Index = (*Index)(nil)
+var _ sql.OrderedIndex = (*Index)(nil)
 
 func (idx *Index) Database() string                    { return idx.DB }
 func (idx *Index) Driver() string                      { return idx.DriverName }
@@ -151,6 +152,18 @@ func (idx *Index) NewLookup(ctx *sql.Context, ranges ...sql.Range) (sql.IndexLoo
 	return NewIndexLookup(ctx, idx, rangeCollectionExpr, ranges...), nil
 }
 
+// NewOrderedLookup implements the interface sql.OrderedIndex. The table reads the rows of all its partitions at once
+// for ordered lookups, as a single partition, to sort them together.
+func (idx *Index) NewOrderedLookup(ctx *sql.Context, ranges ...sql.Range) (sql.IndexLookup, error) {
+	lookup, err := idx.NewLookup(ctx, ranges...)
+	if lookup == nil || err != nil {
+		return lookup, err
+	}
+	ordered := *lookup.(*IndexLookup)
+	ordered.ordered = true
+	return &ordered, nil
+}
+
 // ColumnExpressionTypes implements the interface sql.Index.
 func (idx *Index) ColumnExpressionTypes(*sql.Context) []sql.ColumnExpressionType {
 	cets := make([]sql.ColumnExpressionType, len(idx.Exprs))
@@ -16,6 +16,7 @@ package memory
 
 import (
 	"io"
+	"sort"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -24,6 +25,9 @@ type IndexLookup struct {
 	Expr   sql.Expression
 	idx    ExpressionsIndex
 	ranges sql.RangeCollection
+	// ordered is whether the rows of the lookup are returned in the order of the index, as an ordered lookup of
+	// sql.OrderedIndex.
+	ordered bool
 }
 
 var _ sql.IndexLookup = (*IndexLookup)(nil)
@@ -40,7 +44,7 @@ func (eil *IndexLookup) String() string {
 	return eil.idx.ID()
 }
 
-func (eil *IndexLookup) Values(p sql.Partition) (sql.IndexValueIter, error) {
+func (eil *IndexLookup) Values(ctx *sql.Context, p sql.Partition) (sql.IndexValueIter, error) {
 	return &indexValIter{
 		tbl:             eil.idx.MemTable(),
 		partition:       p,
@@ -66,6 +70,72 @@ func (eil *IndexLookup) Ranges() sql.RangeCollection {
 	return eil.ranges
 }
 
+// orderedRows returns the rows of all the partitions of the table of the lookup that match it, sorted by the values of
+// the expressions of its index, with NULL values first. Rows with equal values keep the order of the table.
+func (eil *IndexLookup) orderedRows(ctx *sql.Context) ([]sql.Row, error) {
+	tbl := eil.idx.MemTable()
+	exprs := eil.idx.ColumnExpressions()
+
+	var rows []sql.Row
+	var keys []sql.Row
+	for _, k := range tbl.partitionKeys {
+		for _, row := range tbl.partitions[string(k)] {
+			res, err := sql.EvaluateCondition(ctx, eil.Expr, row)
+			if err != nil {
+				return nil, err
+			}
+			if !sql.IsTrue(res) {
+				continue
+			}
+
+			key := make(sql.Row, len(exprs))
+			for i, expr := range exprs {
+				key[i], err = expr.Eval(ctx, row)
+				if err != nil {
+					return nil, err
+				}
+			}
+			rows = append(rows, row)
+			keys = append(keys, key)
+		}
+	}
+
+	var sortErr error
+	order := make([]int, len(rows))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := keys[order[i]], keys[order[j]]
+		for k, expr := range exprs {
+			if a[k] == nil || b[k] == nil {
+				if a[k] == nil && b[k] == nil {
+					continue
+				}
+				return a[k] == nil
+			}
+			cmp, err := expr.Type().Compare(a[k], b[k])
+			if err != nil {
+				sortErr = err
+				return false
+			}
+			if cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	sorted := make([]sql.Row, len(rows))
+	for i, pos := range order {
+		sorted[i] = rows[pos]
+	}
+	return sorted, nil
+}
+
 // indexValIter does a very simple and verifiable iteration over the table values for a given index. It does this
 // by iterating over all the table rows for a Partition and evaluating each of them for inclusion in the index. This is
 // not an efficient way to store an index, and is only suitable for testing the correctness of index code in the engine.
@@ -77,8 +147,8 @@ type indexValIter struct {
 	i               int
 }
 
-func (u *indexValIter) Next(*sql.Context) ([]byte, error) {
-	err := u.initValues()
+func (u *indexValIter) Next(ctx *sql.Context) ([]byte, error) {
+	err := u.initValues(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +162,7 @@ func (u *indexValIter) Next(*sql.Context) ([]byte, error) {
 	return nil, io.EOF
 }
 
-func (u *indexValIter) initValues() error {
+func (u *indexValIter) initValues(ctx *sql.Context) error {
 	if u.values == nil {
 		rows, ok := u.tbl.partitions[string(u.partition.Key())]
 		if !ok {
@@ -100,7 +170,7 @@ func (u *indexValIter) initValues() error {
 		}
 
 		for i, row := range rows {
-			res, err := sql.EvaluateCondition(sql.NewEmptyContext(), u.matchExpression, row)
+			res, err := sql.EvaluateCondition(ctx, u.matchExpression, row)
 			if err != nil {
 				return err
 			}
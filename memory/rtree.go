@@ -0,0 +1,191 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"math"
+	"sort"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// rtreeMaxEntries is the most entries a node of an rtree has before it's split in two.
+const rtreeMaxEntries = 8
+
+// rtreeMinEntries is the fewest entries each of the two nodes a node is split into gets.
+const rtreeMinEntries = rtreeMaxEntries / 2
+
+// rtree is an R-tree of the positions of rows in a partition, keyed by the minimum bounding rectangles of their
+// geometries. Nodes are split with the quadratic algorithm from Guttman's paper introducing R-trees.
+type rtree struct {
+	root *rtreeNode
+}
+
+// rtreeNode is a node of an rtree. The entries of a leaf node are rows, and the entries of other nodes are nodes.
+type rtreeNode struct {
+	leaf    bool
+	entries []rtreeEntry
+}
+
+// rtreeEntry is an entry of an rtreeNode, and the rectangle that contains the rectangles of everything under it.
+type rtreeEntry struct {
+	box   sql.BoundingBox
+	child *rtreeNode
+	pos   int
+}
+
+func newRTree() *rtree {
+	return &rtree{root: &rtreeNode{leaf: true}}
+}
+
+// Insert adds the row at the position given, whose geometry has the minimum bounding rectangle given, to the tree.
+func (t *rtree) Insert(box sql.BoundingBox, pos int) {
+	sibling := t.root.insert(rtreeEntry{box: box, pos: pos})
+	if sibling != nil {
+		t.root = &rtreeNode{entries: []rtreeEntry{
+			{box: t.root.bounds(), child: t.root},
+			{box: sibling.bounds(), child: sibling},
+		}}
+	}
+}
+
+// Search returns the positions of the rows whose rectangles intersect the rectangle given, in ascending order.
+func (t *rtree) Search(box sql.BoundingBox) []int {
+	var positions []int
+	t.root.search(box, &positions)
+	sort.Ints(positions)
+	return positions
+}
+
+// insert adds the entry given to the leaf under this node whose rectangle needs the least enlargement to contain it.
+// If this node then has too many entries, it's split, and the new node with some of its entries is returned.
+func (n *rtreeNode) insert(e rtreeEntry) *rtreeNode {
+	if n.leaf {
+		n.entries = append(n.entries, e)
+	} else {
+		i := n.chooseSubtree(e.box)
+		child := n.entries[i].child
+		sibling := child.insert(e)
+		n.entries[i].box = child.bounds()
+		if sibling != nil {
+			n.entries = append(n.entries, rtreeEntry{box: sibling.bounds(), child: sibling})
+		}
+	}
+
+	if len(n.entries) > rtreeMaxEntries {
+		return n.split()
+	}
+	return nil
+}
+
+// chooseSubtree returns the entry of this node whose rectangle needs the least enlargement to contain the rectangle
+// given, choosing the entry with the smallest rectangle among those that need the same enlargement.
+func (n *rtreeNode) chooseSubtree(box sql.BoundingBox) int {
+	best := 0
+	bestEnlargement, bestArea := math.Inf(1), math.Inf(1)
+	for i, e := range n.entries {
+		area := e.box.Area()
+		enlargement := e.box.Union(box).Area() - area
+		if enlargement < bestEnlargement || (enlargement == bestEnlargement && area < bestArea) {
+			best, bestEnlargement, bestArea = i, enlargement, area
+		}
+	}
+	return best
+}
+
+// split divides the entries of this node between this node and a new node, which is returned. The two entries that
+// would waste the most area in the same node seed the two nodes, and the others are then added one at a time, each
+// time taking the entry with the strongest preference for one of the nodes.
+func (n *rtreeNode) split() *rtreeNode {
+	entries := n.entries
+	seedA, seedB := 0, 1
+	worst := math.Inf(-1)
+	for i := range entries {
+		for j := i + 1; j < len(entries); j++ {
+			waste := entries[i].box.Union(entries[j].box).Area() - entries[i].box.Area() - entries[j].box.Area()
+			if waste > worst {
+				seedA, seedB, worst = i, j, waste
+			}
+		}
+	}
+
+	a := []rtreeEntry{entries[seedA]}
+	b := []rtreeEntry{entries[seedB]}
+	boxA, boxB := entries[seedA].box, entries[seedB].box
+	var rest []rtreeEntry
+	for i, e := range entries {
+		if i != seedA && i != seedB {
+			rest = append(rest, e)
+		}
+	}
+
+	for len(rest) > 0 {
+		// Once a node needs every remaining entry to have enough entries, it gets them all.
+		if len(a)+len(rest) == rtreeMinEntries {
+			a = append(a, rest...)
+			break
+		}
+		if len(b)+len(rest) == rtreeMinEntries {
+			b = append(b, rest...)
+			break
+		}
+
+		next, nextDiff := 0, math.Inf(-1)
+		for i, e := range rest {
+			diff := math.Abs((boxA.Union(e.box).Area() - boxA.Area()) - (boxB.Union(e.box).Area() - boxB.Area()))
+			if diff > nextDiff {
+				next, nextDiff = i, diff
+			}
+		}
+		e := rest[next]
+		rest = append(rest[:next], rest[next+1:]...)
+
+		growA := boxA.Union(e.box).Area() - boxA.Area()
+		growB := boxB.Union(e.box).Area() - boxB.Area()
+		if growA < growB || (growA == growB && (boxA.Area() < boxB.Area() || (boxA.Area() == boxB.Area() && len(a) <= len(b)))) {
+			a = append(a, e)
+			boxA = boxA.Union(e.box)
+		} else {
+			b = append(b, e)
+			boxB = boxB.Union(e.box)
+		}
+	}
+
+	n.entries = a
+	return &rtreeNode{leaf: n.leaf, entries: b}
+}
+
+// bounds returns the rectangle that contains the rectangles of every entry of this node.
+func (n *rtreeNode) bounds() sql.BoundingBox {
+	box := n.entries[0].box
+	for _, e := range n.entries[1:] {
+		box = box.Union(e.box)
+	}
+	return box
+}
+
+// search appends the positions of the rows under this node whose rectangles intersect the rectangle given.
+func (n *rtreeNode) search(box sql.BoundingBox, positions *[]int) {
+	for _, e := range n.entries {
+		if !e.box.Intersects(box) {
+			continue
+		}
+		if n.leaf {
+			*positions = append(*positions, e.pos)
+		} else {
+			e.child.search(box, positions)
+		}
+	}
+}
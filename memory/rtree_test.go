@@ -0,0 +1,72 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func randomBox(r *rand.Rand) sql.BoundingBox {
+	x, y := r.Float64()*100, r.Float64()*100
+	return sql.BoundingBox{MinX: x, MinY: y, MaxX: x + r.Float64()*5, MaxY: y + r.Float64()*5}
+}
+
+func TestRTree(t *testing.T) {
+	require := require.New(t)
+	r := rand.New(rand.NewSource(1))
+
+	tree := newRTree()
+	require.Empty(tree.Search(sql.BoundingBox{MaxX: 100, MaxY: 100}))
+
+	boxes := make([]sql.BoundingBox, 1000)
+	for i := range boxes {
+		boxes[i] = randomBox(r)
+		tree.Insert(boxes[i], i)
+	}
+
+	for i := 0; i < 100; i++ {
+		query := randomBox(r)
+		query.MaxX += 10
+		query.MaxY += 10
+
+		var expected []int
+		for pos, box := range boxes {
+			if box.Intersects(query) {
+				expected = append(expected, pos)
+			}
+		}
+		require.Equal(expected, tree.Search(query))
+	}
+
+	all := tree.Search(sql.BoundingBox{MinX: -1, MinY: -1, MaxX: 200, MaxY: 200})
+	require.Len(all, len(boxes))
+	require.False(tree.root.leaf)
+}
+
+func TestRTreePoints(t *testing.T) {
+	require := require.New(t)
+	tree := newRTree()
+	for i := 0; i < 20; i++ {
+		tree.Insert(sql.BoundingBox{MinX: float64(i), MinY: float64(i), MaxX: float64(i), MaxY: float64(i)}, i)
+	}
+	require.Equal([]int{3, 4, 5}, tree.Search(sql.BoundingBox{MinX: 3, MinY: 0, MaxX: 5, MaxY: 10}))
+	require.Equal([]int{7}, tree.Search(sql.BoundingBox{MinX: 7, MinY: 7, MaxX: 7, MaxY: 7}))
+	require.Empty(tree.Search(sql.BoundingBox{MinX: 7.5, MinY: 7.5, MaxX: 7.6, MaxY: 7.6}))
+}
@@ -0,0 +1,137 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// SpatialIndex is a SPATIAL index of a Table. Lookups find the rows of each partition with an R-tree of the minimum
+// bounding rectangles of their geometries, which is built from the rows of the partition when the lookup is used. Like
+// Index, this is meant as a reference implementation for testing, not as an efficient index.
+type SpatialIndex struct {
+	Index
+}
+
+var _ sql.SpatialIndex = (*SpatialIndex)(nil)
+
+// IndexType implements the interface sql.Index.
+func (idx *SpatialIndex) IndexType() string {
+	return "SPATIAL"
+}
+
+// NewLookup implements the interface sql.Index. Spatial indexes can't look up ranges of geometries, so the lookup is
+// always nil.
+func (idx *SpatialIndex) NewLookup(ctx *sql.Context, ranges ...sql.Range) (sql.IndexLookup, error) {
+	return nil, nil
+}
+
+// NewSpatialLookup implements the interface sql.SpatialIndex.
+func (idx *SpatialIndex) NewSpatialLookup(ctx *sql.Context, bounds sql.BoundingBox) (sql.IndexLookup, error) {
+	if idx.CommentStr == CommentPreventingIndexBuilding {
+		return nil, nil
+	}
+	return &SpatialIndexLookup{idx: idx, bounds: bounds}, nil
+}
+
+// SpatialIndexLookup is a lookup of a SpatialIndex for the rows whose geometries' minimum bounding rectangles
+// intersect a rectangle.
+type SpatialIndexLookup struct {
+	idx    *SpatialIndex
+	bounds sql.BoundingBox
+}
+
+var _ sql.DriverIndexLookup = (*SpatialIndexLookup)(nil)
+
+func (l *SpatialIndexLookup) String() string {
+	return l.idx.ID()
+}
+
+// Index implements the interface sql.IndexLookup.
+func (l *SpatialIndexLookup) Index() sql.Index {
+	return l.idx
+}
+
+// Ranges implements the interface sql.IndexLookup.
+func (l *SpatialIndexLookup) Ranges() sql.RangeCollection {
+	return nil
+}
+
+// Bounds returns the rectangle that the rows' rectangles are looked up for.
+func (l *SpatialIndexLookup) Bounds() sql.BoundingBox {
+	return l.bounds
+}
+
+// Indexes implements the interface sql.DriverIndexLookup.
+func (l *SpatialIndexLookup) Indexes() []string {
+	return []string{l.idx.ID()}
+}
+
+// Values implements the interface sql.DriverIndexLookup.
+func (l *SpatialIndexLookup) Values(ctx *sql.Context, p sql.Partition) (sql.IndexValueIter, error) {
+	rows, ok := l.idx.Tbl.partitions[string(p.Key())]
+	if !ok {
+		return nil, sql.ErrPartitionNotFound.New(p.Key())
+	}
+
+	tree := newRTree()
+	for i, row := range rows {
+		v, err := l.idx.Exprs[0].Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if box, ok := sql.GeometryBoundingBox(v); ok {
+			tree.Insert(box, i)
+		}
+	}
+
+	positions := tree.Search(l.bounds)
+	values := make([][]byte, len(positions))
+	for i, pos := range positions {
+		encoded, err := EncodeIndexValue(&IndexValue{Pos: pos})
+		if err != nil {
+			return nil, err
+		}
+		values[i] = encoded
+	}
+	return &spatialIndexValIter{values: values}, nil
+}
+
+// DebugString returns the lookup as a string, with the rectangle it looks up.
+func (l *SpatialIndexLookup) DebugString() string {
+	b := l.bounds
+	return fmt.Sprintf("%s: [(%v, %v), (%v, %v)]", l.idx.ID(), b.MinX, b.MinY, b.MaxX, b.MaxY)
+}
+
+// spatialIndexValIter iterates over the encoded positions of the rows a SpatialIndexLookup found in a partition.
+type spatialIndexValIter struct {
+	values [][]byte
+	i      int
+}
+
+func (s *spatialIndexValIter) Next(*sql.Context) ([]byte, error) {
+	if s.i >= len(s.values) {
+		return nil, io.EOF
+	}
+	s.i++
+	return s.values[s.i-1], nil
+}
+
+func (s *spatialIndexValIter) Close(*sql.Context) error {
+	return nil
+}
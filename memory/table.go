@@ -19,9 +19,9 @@ import (
 	"encoding/gob"
 	"fmt"
 	"io"
-	"sort"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	errors "gopkg.in/src-d/go-errors.v1"
@@ -36,9 +36,11 @@ type Table struct {
 	name             string
 	schema           sql.PrimaryKeySchema
 	indexes          map[string]sql.Index
+	indexOrder       []string
 	foreignKeys      []sql.ForeignKeyConstraint
 	checks           []sql.CheckDefinition
 	pkIndexesEnabled bool
+	options          sql.TableOptions
 
 	// pushdown info
 	filters    []sql.Expression // currently unused, filter pushdown is significantly broken right now
@@ -58,6 +60,12 @@ type Table struct {
 	// AUTO_INCREMENT bookkeeping
 	autoIncVal interface{}
 	autoColIdx int
+
+	// timesAnalyzed is the number of times the table was analyzed, shared by its copies
+	timesAnalyzed *int
+	// histograms are the histograms of the columns of the table collected the last time it was analyzed, by lower case
+	// column name, shared by its copies
+	histograms map[string]*sql.Histogram
 }
 
 var _ sql.Table = (*Table)(nil)
@@ -69,13 +77,19 @@ var _ sql.TruncateableTable = (*Table)(nil)
 var _ sql.DriverIndexableTable = (*Table)(nil)
 var _ sql.AlterableTable = (*Table)(nil)
 var _ sql.IndexAlterableTable = (*Table)(nil)
+var _ sql.AsyncIndexAlterableTable = (*Table)(nil)
 var _ sql.IndexedTable = (*Table)(nil)
+var _ sql.BatchedIndexAddressableTable = (*Table)(nil)
 var _ sql.ForeignKeyAlterableTable = (*Table)(nil)
 var _ sql.ForeignKeyTable = (*Table)(nil)
 var _ sql.CheckAlterableTable = (*Table)(nil)
 var _ sql.CheckTable = (*Table)(nil)
+var _ sql.TableOptionsAlterableTable = (*Table)(nil)
 var _ sql.AutoIncrementTable = (*Table)(nil)
 var _ sql.StatisticsTable = (*Table)(nil)
+var _ sql.ColumnStatisticsTable = (*Table)(nil)
+var _ sql.AnalyzableTable = (*Table)(nil)
+var _ sql.HistogramTable = (*Table)(nil)
 var _ sql.ProjectedTable = (*Table)(nil)
 var _ sql.PrimaryKeyAlterableTable = (*Table)(nil)
 var _ sql.PrimaryKeyTable = (*Table)(nil)
@@ -117,6 +131,9 @@ func NewPartitionedTable(name string, schema sql.PrimaryKeySchema, numPartitions
 		partitionKeys: keys,
 		autoIncVal:    autoIncVal,
 		autoColIdx:    autoIncIdx,
+		options:       sql.TableOptions{Collation: sql.Collation_Default},
+		timesAnalyzed: new(int),
+		histograms:    make(map[string]*sql.Histogram),
 	}
 }
 
@@ -147,6 +164,10 @@ func (t *Table) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
 			keys = append(keys, k)
 		}
 	}
+	// The rows of all the partitions are read at once for ordered lookups, with the first partition
+	if lookup, ok := t.lookup.(*IndexLookup); ok && lookup.ordered && len(keys) > 1 {
+		keys = keys[:1]
+	}
 	return &partitionIter{keys: keys}, nil
 }
 
@@ -162,10 +183,22 @@ func (t *Table) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.Ro
 		return nil, sql.ErrPartitionNotFound.New(partition.Key())
 	}
 
+	if lookup, ok := t.lookup.(*IndexLookup); ok && lookup.ordered {
+		rows, err := lookup.orderedRows(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return &tableIter{
+			rows:    rows,
+			columns: t.columns,
+			filters: t.filters,
+		}, nil
+	}
+
 	var values sql.IndexValueIter
 	if t.lookup != nil {
 		var err error
-		values, err = t.lookup.(sql.DriverIndexLookup).Values(partition)
+		values, err = t.lookup.(sql.DriverIndexLookup).Values(ctx, partition)
 		if err != nil {
 			return nil, err
 		}
@@ -193,6 +226,80 @@ func (t *Table) NumRows(ctx *sql.Context) (uint64, error) {
 	return count, nil
 }
 
+// ColumnCardinality implements the sql.ColumnStatisticsTable interface. Like the number of rows of memory tables, the
+// cardinalities of their columns are always up to date, since they're counted every time they're asked for.
+func (t *Table) ColumnCardinality(ctx *sql.Context, column string) (uint64, bool, error) {
+	idx := -1
+	for i, col := range t.schema.Schema {
+		if strings.EqualFold(col.Name, column) {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return 0, false, nil
+	}
+
+	distinct := make(map[uint64]struct{})
+	for _, rows := range t.partitions {
+		for _, row := range rows {
+			if row[idx] == nil {
+				continue
+			}
+			hash, err := sql.HashOf(sql.NewRow(row[idx]))
+			if err != nil {
+				return 0, false, err
+			}
+			distinct[hash] = struct{}{}
+		}
+	}
+	return uint64(len(distinct)), true, nil
+}
+
+// AnalyzeTable implements the sql.AnalyzableTable interface. The number of rows of memory tables and the cardinalities
+// of their columns are always up to date, so analyzing one only collects the histograms of its columns, and counts the
+// times it's analyzed.
+func (t *Table) AnalyzeTable(ctx *sql.Context) error {
+	histograms := make(map[string]*sql.Histogram)
+	for i, col := range t.schema.Schema {
+		if !sql.HistogramSupported(col.Type) {
+			continue
+		}
+
+		var values []interface{}
+		for _, key := range t.partitionKeys {
+			for _, row := range t.partitions[string(key)] {
+				values = append(values, row[i])
+			}
+		}
+		h, err := sql.NewHistogram(ctx, col.Type, values, sql.DefaultHistogramBuckets)
+		if err != nil {
+			return err
+		}
+		histograms[strings.ToLower(col.Name)] = h
+	}
+
+	for name := range t.histograms {
+		delete(t.histograms, name)
+	}
+	for name, h := range histograms {
+		t.histograms[name] = h
+	}
+	*t.timesAnalyzed++
+	return nil
+}
+
+// ColumnHistogram implements the sql.HistogramTable interface.
+func (t *Table) ColumnHistogram(ctx *sql.Context, column string) (*sql.Histogram, bool, error) {
+	h, ok := t.histograms[strings.ToLower(column)]
+	return h, ok, nil
+}
+
+// TimesAnalyzed returns the number of times the table was analyzed.
+func (t *Table) TimesAnalyzed() int {
+	return *t.timesAnalyzed
+}
+
 func (t *Table) DataLength(ctx *sql.Context) (uint64, error) {
 	var numBytesPerRow uint64 = 0
 	for _, col := range t.schema.Schema {
@@ -601,6 +708,7 @@ func (t *Table) insertValueInRows(ctx *sql.Context, idx int, colDefault *sql.Col
 
 func (t *Table) DropColumn(ctx *sql.Context, columnName string) error {
 	droppedCol := t.dropColumnFromSchema(ctx, columnName)
+	progress := t.newRewriteProgress(ctx)
 	for k, p := range t.partitions {
 		newP := make([]sql.Row, len(p))
 		for i, row := range p {
@@ -608,6 +716,7 @@ func (t *Table) DropColumn(ctx *sql.Context, columnName string) error {
 			newRow = append(newRow, row[:droppedCol]...)
 			newRow = append(newRow, row[droppedCol+1:]...)
 			newP[i] = newRow
+			progress.rowDone()
 		}
 		t.partitions[k] = newP
 	}
@@ -670,6 +779,7 @@ func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Co
 		}
 	}
 
+	progress := t.newRewriteProgress(ctx)
 	for k, p := range t.partitions {
 		newP := make([]sql.Row, len(p))
 		for i, row := range p {
@@ -685,6 +795,7 @@ func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Co
 			newRow = append(newRow, newVal)
 			newRow = append(newRow, oldRowWithoutVal[newIdx:]...)
 			newP[i] = newRow
+			progress.rowDone()
 		}
 		t.partitions[k] = newP
 	}
@@ -710,6 +821,24 @@ func (t *Table) ModifyColumn(ctx *sql.Context, columnName string, column *sql.Co
 	return nil
 }
 
+// rewriteProgress reports the progress of the rewrite of the rows of a table by an ALTER TABLE statement.
+type rewriteProgress struct {
+	ctx         *sql.Context
+	done, total int64
+}
+
+func (t *Table) newRewriteProgress(ctx *sql.Context) *rewriteProgress {
+	total, _ := t.NumRows(ctx)
+	p := &rewriteProgress{ctx: ctx, total: int64(total)}
+	ctx.ReportStageProgress(sql.StageAlteringTable, 0, p.total)
+	return p
+}
+
+func (p *rewriteProgress) rowDone() {
+	p.done++
+	p.ctx.ReportStageProgress(sql.StageAlteringTable, p.done, p.total)
+}
+
 // PrimaryKeySchema implements sql.PrimaryKeyAlterableTable
 func (t *Table) PrimaryKeySchema() sql.PrimaryKeySchema {
 	return t.schema
@@ -892,17 +1021,13 @@ func (t *Table) GetIndexes(ctx *sql.Context) ([]sql.Index, error) {
 		}
 	}
 
-	nonPrimaryIndexes := make([]sql.Index, len(t.indexes))
-	var i int
-	for _, index := range t.indexes {
-		nonPrimaryIndexes[i] = index
-		i++
+	// Indexes are returned in the order they were created in, so that SHOW CREATE TABLE shows them in the order they
+	// were declared in, rather than with unique indexes first as MySQL does
+	for _, name := range t.indexOrder {
+		indexes = append(indexes, t.indexes[name])
 	}
-	sort.Slice(nonPrimaryIndexes, func(i, j int) bool {
-		return nonPrimaryIndexes[i].ID() < nonPrimaryIndexes[j].ID()
-	})
 
-	return append(indexes, nonPrimaryIndexes...), nil
+	return indexes, nil
 }
 
 // GetForeignKeys implements sql.ForeignKeyTable
@@ -912,16 +1037,8 @@ func (t *Table) GetForeignKeys(_ *sql.Context) ([]sql.ForeignKeyConstraint, erro
 
 // CreateForeignKey implements sql.ForeignKeyAlterableTable. Foreign partitionKeys are not enforced on update / delete.
 func (t *Table) CreateForeignKey(_ *sql.Context, fkName string, columns []string, referencedTable string, referencedColumns []string, onUpdate, onDelete sql.ForeignKeyReferenceOption) error {
-	for _, key := range t.foreignKeys {
-		if key.Name == fkName {
-			return fmt.Errorf("Constraint %s already exists", fkName)
-		}
-	}
-
-	for _, key := range t.checks {
-		if key.Name == fkName {
-			return fmt.Errorf("constraint %s already exists", fkName)
-		}
+	if t.hasConstraint(fkName) {
+		return sql.ErrForeignKeyDuplicateName.New(fkName)
 	}
 
 	t.foreignKeys = append(t.foreignKeys, sql.ForeignKeyConstraint{
@@ -943,13 +1060,13 @@ func (t *Table) DropForeignKey(ctx *sql.Context, fkName string) error {
 
 func (t *Table) dropConstraint(ctx *sql.Context, name string) error {
 	for i, key := range t.foreignKeys {
-		if key.Name == name {
+		if strings.EqualFold(key.Name, name) {
 			t.foreignKeys = append(t.foreignKeys[:i], t.foreignKeys[i+1:]...)
 			return nil
 		}
 	}
 	for i, key := range t.checks {
-		if key.Name == name {
+		if strings.EqualFold(key.Name, name) {
 			t.checks = append(t.checks[:i], t.checks[i+1:]...)
 			return nil
 		}
@@ -957,6 +1074,33 @@ func (t *Table) dropConstraint(ctx *sql.Context, name string) error {
 	return nil
 }
 
+// hasConstraint returns whether the table has a foreign key or check constraint with the name given. Foreign keys and
+// checks share a namespace, and their names are case-insensitive.
+func (t *Table) hasConstraint(name string) bool {
+	for _, key := range t.foreignKeys {
+		if strings.EqualFold(key.Name, name) {
+			return true
+		}
+	}
+	for _, check := range t.checks {
+		if strings.EqualFold(check.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTableOptions implements sql.TableOptionsTable
+func (t *Table) GetTableOptions(_ *sql.Context) (sql.TableOptions, error) {
+	return t.options, nil
+}
+
+// SetTableOptions implements sql.TableOptionsAlterableTable
+func (t *Table) SetTableOptions(_ *sql.Context, options sql.TableOptions) error {
+	t.options = options
+	return nil
+}
+
 // GetChecks implements sql.CheckTable
 func (t *Table) GetChecks(_ *sql.Context) ([]sql.CheckDefinition, error) {
 	return t.checks, nil
@@ -970,16 +1114,8 @@ func (t *Table) CreateCheck(_ *sql.Context, check *sql.CheckDefinition) error {
 		toInsert.Name = t.generateCheckName()
 	}
 
-	for _, key := range t.checks {
-		if key.Name == toInsert.Name {
-			return fmt.Errorf("constraint %s already exists", toInsert.Name)
-		}
-	}
-
-	for _, key := range t.foreignKeys {
-		if key.Name == toInsert.Name {
-			return fmt.Errorf("constraint %s already exists", toInsert.Name)
-		}
+	if t.hasConstraint(toInsert.Name) {
+		return sql.ErrCheckConstraintDuplicateName.New(toInsert.Name)
 	}
 
 	t.checks = append(t.checks, toInsert)
@@ -993,9 +1129,10 @@ func (t *Table) DropCheck(ctx *sql.Context, chName string) error {
 }
 
 func (t *Table) createIndex(name string, columns []sql.IndexColumn, constraint sql.IndexConstraint, comment string) (sql.Index, error) {
-	if t.indexes[name] != nil {
-		// TODO: extract a standard error type for this
-		return nil, fmt.Errorf("Error: index already exists")
+	for existing := range t.indexes {
+		if strings.EqualFold(existing, name) {
+			return nil, sql.ErrDuplicateKeyName.New(name)
+		}
 	}
 
 	exprs := make([]sql.Expression, len(columns))
@@ -1004,7 +1141,7 @@ func (t *Table) createIndex(name string, columns []sql.IndexColumn, constraint s
 		exprs[i] = expression.NewGetFieldWithTable(idx, field.Type, t.name, field.Name, field.Nullable)
 	}
 
-	return &Index{
+	index := Index{
 		DB:         "",
 		DriverName: "",
 		Tbl:        t,
@@ -1013,7 +1150,11 @@ func (t *Table) createIndex(name string, columns []sql.IndexColumn, constraint s
 		Name:       name,
 		Unique:     constraint == sql.IndexConstraint_Unique,
 		CommentStr: comment,
-	}, nil
+	}
+	if constraint == sql.IndexConstraint_Spatial {
+		return &SpatialIndex{index}, nil
+	}
+	return &index, nil
 }
 
 // getField returns the index and column index with the name given, if it exists, or -1, nil otherwise.
@@ -1028,37 +1169,116 @@ func (t *Table) getField(col string) (int, *sql.Column) {
 
 // CreateIndex implements sql.IndexAlterableTable
 func (t *Table) CreateIndex(ctx *sql.Context, indexName string, using sql.IndexUsing, constraint sql.IndexConstraint, columns []sql.IndexColumn, comment string) error {
-	if t.indexes == nil {
-		t.indexes = make(map[string]sql.Index)
+	index, err := t.createIndex(indexName, columns, constraint, comment)
+	if err != nil {
+		return err
 	}
 
+	t.addIndex(indexName, index)
+	return nil
+}
+
+// StartIndexBuild implements sql.AsyncIndexAlterableTable. The index is added once every row of the table has been
+// read in the background.
+func (t *Table) StartIndexBuild(ctx *sql.Context, indexName string, using sql.IndexUsing, constraint sql.IndexConstraint, columns []sql.IndexColumn, comment string, lock sql.IndexLock) (sql.IndexBuild, error) {
 	index, err := t.createIndex(indexName, columns, constraint, comment)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	partitions := make([][]sql.Row, 0, len(t.partitionKeys))
+	var total int64
+	for _, key := range t.partitionKeys {
+		rows := t.partitions[string(key)]
+		partitions = append(partitions, rows)
+		total += int64(len(rows))
+	}
+
+	build := &indexBuild{total: total, done: make(chan struct{})}
+	go func() {
+		defer close(build.done)
+		for _, rows := range partitions {
+			for range rows {
+				if err := ctx.Err(); err != nil {
+					build.err = err
+					return
+				}
+				atomic.AddInt64(&build.indexed, 1)
+			}
+		}
+		t.addIndex(indexName, index)
+	}()
+	return build, nil
+}
+
+// indexBuild is an index of a Table being built in the background.
+type indexBuild struct {
+	indexed int64
+	total   int64
+	done    chan struct{}
+	err     error
+}
+
+var _ sql.IndexBuild = (*indexBuild)(nil)
+
+// Progress implements sql.IndexBuild
+func (b *indexBuild) Progress() (int64, int64) {
+	return atomic.LoadInt64(&b.indexed), b.total
+}
+
+// Done implements sql.IndexBuild
+func (b *indexBuild) Done() <-chan struct{} {
+	return b.done
+}
+
+// Err implements sql.IndexBuild
+func (b *indexBuild) Err() error {
+	return b.err
+}
+
+// addIndex adds the index given to the indexes of the table, after the existing ones.
+func (t *Table) addIndex(indexName string, index sql.Index) {
+	if t.indexes == nil {
+		t.indexes = make(map[string]sql.Index)
+	}
 	t.indexes[indexName] = index
-	return nil
+	t.indexOrder = append(t.indexOrder[:len(t.indexOrder):len(t.indexOrder)], indexName)
 }
 
 // DropIndex implements sql.IndexAlterableTable
 func (t *Table) DropIndex(ctx *sql.Context, indexName string) error {
-	for name := range t.indexes {
-		if name == indexName {
-			delete(t.indexes, name)
+	if _, ok := t.indexes[indexName]; !ok {
+		return nil
+	}
+	delete(t.indexes, indexName)
+
+	indexOrder := make([]string, 0, len(t.indexOrder)-1)
+	for _, name := range t.indexOrder {
+		if name != indexName {
+			indexOrder = append(indexOrder, name)
 		}
 	}
+	t.indexOrder = indexOrder
 	return nil
 }
 
 // RenameIndex implements sql.IndexAlterableTable
 func (t *Table) RenameIndex(ctx *sql.Context, fromIndexName string, toIndexName string) error {
-	for name, index := range t.indexes {
+	index, ok := t.indexes[fromIndexName]
+	if !ok {
+		return nil
+	}
+	delete(t.indexes, fromIndexName)
+	t.indexes[toIndexName] = index
+
+	indexOrder := make([]string, len(t.indexOrder))
+	for i, name := range t.indexOrder {
 		if name == fromIndexName {
-			delete(t.indexes, name)
-			t.indexes[toIndexName] = index
+			name = toIndexName
 		}
+		indexOrder[i] = name
 	}
+	t.indexOrder = indexOrder
 	return nil
 }
 
@@ -1074,6 +1294,23 @@ func (t *Table) WithIndexLookup(lookup sql.IndexLookup) sql.Table {
 	return &nt
 }
 
+// IndexLookupRowIters implements the sql.BatchedIndexAddressableTable interface.
+func (t *Table) IndexLookupRowIters(ctx *sql.Context, lookups []sql.IndexLookup) ([]sql.RowIter, error) {
+	iters := make([]sql.RowIter, len(lookups))
+	for i, lookup := range lookups {
+		table := t.WithIndexLookup(lookup)
+		partitions, err := table.Partitions(ctx)
+		if err != nil {
+			for _, iter := range iters[:i] {
+				_ = iter.Close(ctx)
+			}
+			return nil, err
+		}
+		iters[i] = sql.NewTableRowIter(ctx, table, partitions)
+	}
+	return iters, nil
+}
+
 // IndexKeyValues implements the sql.IndexableTable interface.
 func (t *Table) IndexKeyValues(
 	ctx *sql.Context,
@@ -1165,6 +1402,7 @@ func copyschema(sch sql.Schema) sql.Schema {
 			Name:          c.Name,
 			Type:          c.Type,
 			Default:       c.Default,
+			OnUpdate:      c.OnUpdate,
 			AutoIncrement: c.AutoIncrement,
 			Nullable:      c.Nullable,
 			Source:        c.Source,
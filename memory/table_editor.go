@@ -32,6 +32,7 @@ type tableEditor struct {
 
 var _ sql.RowReplacer = (*tableEditor)(nil)
 var _ sql.RowUpdater = (*tableEditor)(nil)
+var _ sql.PartialRowUpdater = (*tableEditor)(nil)
 var _ sql.RowInserter = (*tableEditor)(nil)
 var _ sql.RowDeleter = (*tableEditor)(nil)
 
@@ -128,12 +129,40 @@ func (t *tableEditor) Update(ctx *sql.Context, oldRow sql.Row, newRow sql.Row) e
 		return err
 	}
 
+	return t.update(oldRow, newRow, t.pkColsDiffer(oldRow, newRow))
+}
+
+// UpdateColumns implements the sql.PartialRowUpdater interface. Only the changed columns are copied from the new
+// row, and the primary key is only checked for duplicates when one of its columns changed.
+func (t *tableEditor) UpdateColumns(ctx *sql.Context, oldRow sql.Row, newRow sql.Row, changed sql.ColumnSet) error {
+	if err := checkRow(t.table.schema.Schema, oldRow); err != nil {
+		return err
+	}
+	if err := checkRow(t.table.schema.Schema, newRow); err != nil {
+		return err
+	}
+
+	row := oldRow.Copy()
+	pkChanged := false
+	for i, col := range t.table.schema.Schema {
+		if changed.Contains(i) {
+			row[i] = newRow[i]
+			pkChanged = pkChanged || col.PrimaryKey
+		}
+	}
+
+	return t.update(oldRow, row, pkChanged)
+}
+
+// update replaces the old row given with the new one, checking that the new primary key isn't a duplicate if it
+// changed.
+func (t *tableEditor) update(oldRow sql.Row, newRow sql.Row, pkChanged bool) error {
 	err := t.ea.Delete(oldRow)
 	if err != nil {
 		return err
 	}
 
-	if t.pkColsDiffer(oldRow, newRow) {
+	if pkChanged {
 		partitionRow, added, err := t.ea.Get(newRow)
 		if err != nil {
 			return err
@@ -71,7 +71,7 @@ func (i dummyLookup) String() string {
 	panic("index")
 }
 
-func (i *dummyLookup) Values(partition sql.Partition) (sql.IndexValueIter, error) {
+func (i *dummyLookup) Values(ctx *sql.Context, partition sql.Partition) (sql.IndexValueIter, error) {
 	key := string(partition.Key())
 	values, ok := i.values[key]
 	if !ok {
@@ -335,6 +335,126 @@ func TestIndexed(t *testing.T) {
 	}
 }
 
+func TestOrderedIndexLookup(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewPartitionedTable("ordered", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Source: "ordered", Type: sql.Int64, Nullable: true},
+		{Name: "s", Source: "ordered", Type: sql.Text},
+	}), 3)
+	for _, row := range []sql.Row{
+		{int64(3), "a"},
+		{int64(1), "b"},
+		{nil, "c"},
+		{int64(2), "d"},
+		{int64(1), "e"},
+		{int64(4), "f"},
+	} {
+		require.NoError(table.Insert(ctx, row))
+	}
+
+	idx := &memory.Index{
+		Tbl:       table,
+		TableName: "ordered",
+		Name:      "idx_i",
+		Exprs:     []sql.Expression{expression.NewGetFieldWithTable(0, sql.Int64, "ordered", "i", true)},
+	}
+
+	// The rows of all the partitions are returned in order, with NULL values first
+	lookup, err := idx.NewOrderedLookup(ctx, sql.Range{sql.AllRangeColumnExpr(sql.Int64)})
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{nil, "c"},
+		{int64(1), "b"},
+		{int64(1), "e"},
+		{int64(2), "d"},
+		{int64(3), "a"},
+		{int64(4), "f"},
+	}, getAllRows(t, table.WithIndexLookup(lookup)))
+
+	lookup, err = idx.NewOrderedLookup(ctx, sql.Range{sql.ClosedRangeColumnExpr(int64(1), int64(3), sql.Int64)})
+	require.NoError(err)
+	require.Equal([]sql.Row{
+		{int64(1), "b"},
+		{int64(1), "e"},
+		{int64(2), "d"},
+		{int64(3), "a"},
+	}, getAllRows(t, table.WithIndexLookup(lookup)))
+}
+
+func TestColumnCardinality(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewPartitionedTable("cardinality", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Source: "cardinality", Type: sql.Int64, Nullable: true},
+		{Name: "s", Source: "cardinality", Type: sql.Text},
+	}), 2)
+	for _, row := range []sql.Row{
+		{int64(1), "a"},
+		{int64(1), "b"},
+		{nil, "b"},
+		{int64(2), "b"},
+	} {
+		require.NoError(table.Insert(ctx, row))
+	}
+
+	// NULL values aren't counted, and equal values are counted once across partitions
+	cardinality, ok, err := table.ColumnCardinality(ctx, "i")
+	require.NoError(err)
+	require.True(ok)
+	require.Equal(uint64(2), cardinality)
+
+	cardinality, ok, err = table.ColumnCardinality(ctx, "S")
+	require.NoError(err)
+	require.True(ok)
+	require.Equal(uint64(2), cardinality)
+
+	_, ok, err = table.ColumnCardinality(ctx, "missing")
+	require.NoError(err)
+	require.False(ok)
+}
+
+func TestColumnHistogram(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	table := memory.NewPartitionedTable("histogram", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Source: "histogram", Type: sql.Int64, Nullable: true},
+		{Name: "j", Source: "histogram", Type: sql.JSON, Nullable: true},
+	}), 2)
+	for _, row := range []sql.Row{
+		{int64(2), nil},
+		{int64(1), nil},
+		{nil, nil},
+		{int64(2), nil},
+	} {
+		require.NoError(table.Insert(ctx, row))
+	}
+
+	// There are no histograms until the table is analyzed
+	_, ok, err := table.ColumnHistogram(ctx, "i")
+	require.NoError(err)
+	require.False(ok)
+
+	require.NoError(table.AnalyzeTable(ctx))
+	histogram, ok, err := table.ColumnHistogram(ctx, "I")
+	require.NoError(err)
+	require.True(ok)
+	require.Equal(sql.HistogramSingleton, histogram.Type)
+	require.Equal(0.25, histogram.NullFraction)
+	require.Equal([]sql.HistogramBucket{
+		{LowerBound: int64(1), UpperBound: int64(1), CumulativeFrequency: 0.25, Distinct: 1},
+		{LowerBound: int64(2), UpperBound: int64(2), CumulativeFrequency: 0.75, Distinct: 1},
+	}, histogram.Buckets)
+
+	// JSON columns have no histograms
+	_, ok, err = table.ColumnHistogram(ctx, "j")
+	require.NoError(err)
+	require.False(ok)
+}
+
 func getAllRows(t *testing.T, table sql.Table) []sql.Row {
 	var require = require.New(t)
 
@@ -420,3 +540,39 @@ func TestTableIndexKeyValueIter(t *testing.T) {
 		})
 	}
 }
+
+func TestTableUpdateColumns(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	table := memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Type: sql.Int64, PrimaryKey: true, Source: "foo"},
+		{Name: "a", Type: sql.Int64, Nullable: true, Source: "foo"},
+		{Name: "b", Type: sql.Text, Nullable: true, Source: "foo"},
+	}))
+
+	inserter := table.Inserter(ctx)
+	require.NoError(inserter.Insert(ctx, sql.NewRow(int64(1), int64(1), "one")))
+	require.NoError(inserter.Insert(ctx, sql.NewRow(int64(2), int64(2), "two")))
+	require.NoError(inserter.Close(ctx))
+
+	updater, ok := table.Updater(ctx).(sql.PartialRowUpdater)
+	require.True(ok)
+
+	// Only the changed columns are taken from the new row
+	changed := sql.NewColumnSet(3)
+	changed.Add(1)
+	require.NoError(updater.UpdateColumns(ctx, sql.NewRow(int64(1), int64(1), "one"), sql.NewRow(int64(1), int64(10), "ignored"), changed))
+	require.NoError(updater.Close(ctx))
+
+	// Changing the primary key checks for duplicates
+	updater = table.Updater(ctx).(sql.PartialRowUpdater)
+	changed = sql.NewColumnSet(3)
+	changed.Add(0)
+	err := updater.UpdateColumns(ctx, sql.NewRow(int64(2), int64(2), "two"), sql.NewRow(int64(1), int64(2), "two"), changed)
+	require.True(sql.ErrPrimaryKeyViolation.Is(err), "unexpected error %v", err)
+
+	require.ElementsMatch([]sql.Row{
+		{int64(1), int64(10), "one"},
+		{int64(2), int64(2), "two"},
+	}, getAllRows(t, table))
+}
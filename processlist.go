@@ -19,8 +19,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/sirupsen/logrus"
-
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
@@ -206,6 +204,24 @@ func (pl *ProcessList) RemovePartitionProgress(pid uint64, tableName, partitionN
 	delete(tablePg.PartitionsProgress, partitionName)
 }
 
+// UpdateStageProgress sets the stage of the process with the given pid, and the
+// work done and estimated for it. If the process does not exist, it will do nothing.
+func (pl *ProcessList) UpdateStageProgress(pid uint64, stage string, done, total int64) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+
+	p, ok := pl.procs[pid]
+	if !ok {
+		return
+	}
+
+	if p.Stage.Name != stage {
+		p.Stage = sql.StageProgress{Progress: sql.Progress{Name: stage}, StartedAt: time.Now()}
+	}
+	p.Stage.Done = done
+	p.Stage.Total = total
+}
+
 // Kill terminates all queries for a given connection id.
 func (pl *ProcessList) Kill(connID uint32) {
 	pl.mu.Lock()
@@ -213,7 +229,7 @@ func (pl *ProcessList) Kill(connID uint32) {
 
 	for pid, proc := range pl.procs {
 		if proc.Connection == connID {
-			logrus.Infof("kill query: pid %d", pid)
+			sql.GetLogger().Infof("kill query: pid %d", pid)
 			proc.Done()
 			delete(pl.procs, pid)
 		}
@@ -112,6 +112,34 @@ func TestProcessList(t *testing.T) {
 	require.True(ok)
 }
 
+func TestProcessListStageProgress(t *testing.T) {
+	require := require.New(t)
+
+	p := NewProcessList()
+	sess := sql.NewBaseSessionWithClientServer("0.0.0.0:3306", sql.Client{Address: "127.0.0.1:34567", User: "foo"}, 1)
+	ctx := sql.NewContext(context.Background(), sql.WithPid(1), sql.WithSession(sess))
+	ctx, err := p.AddProcess(ctx, "ALTER TABLE foo DROP COLUMN bar")
+	require.NoError(err)
+
+	p.UpdateStageProgress(ctx.Pid(), "stage/sql/altering table", 0, 5)
+	startedAt := p.procs[1].Stage.StartedAt
+	require.False(startedAt.IsZero())
+
+	p.UpdateStageProgress(ctx.Pid(), "stage/sql/altering table", 2, 5)
+	require.Equal(sql.StageProgress{
+		Progress:  sql.Progress{Name: "stage/sql/altering table", Done: 2, Total: 5},
+		StartedAt: startedAt,
+	}, p.procs[1].Stage)
+	require.Equal("altering table (2/5)", p.procs[1].Stage.String())
+
+	p.UpdateStageProgress(ctx.Pid(), "stage/sql/committing", 0, -1)
+	require.Equal("committing (0/?)", p.procs[1].Stage.String())
+
+	// Unknown processes are ignored
+	p.UpdateStageProgress(2, "stage/sql/altering table", 0, 5)
+	require.Len(p.procs, 1)
+}
+
 func sortByPid(slice []sql.Process) {
 	sort.Slice(slice, func(i, j int) bool {
 		return slice[i].Pid < slice[j].Pid
@@ -0,0 +1,55 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// readOnlyServerCheck returns an error if the analyzed statement given modifies rows or schemas while the server is
+// read-only. Like MySQL, super_read_only rejects such statements for every user, while read_only doesn't apply to users
+// with the SUPER privilege, which every user has when the grant tables are disabled. Read-only sessions reject them
+// regardless of the privileges of their user. Statements that only modify temporary tables are always allowed.
+// Statements of stored procedures are checked when they're called, so that the procedures that only read data may be
+// called on read-only servers.
+func (e *Engine) readOnlyServerCheck(ctx *sql.Context, analyzed sql.Node) error {
+	if !plan.ClassifyStatement(analyzed).ModifiesData() || plan.ModifiesOnlyTemporaryTables(analyzed) {
+		return nil
+	}
+	if globalBoolVariable("super_read_only") {
+		return sql.ErrReadOnlyServer.New("--super-read-only")
+	}
+	if ctx.Session.IsReadOnly() {
+		return sql.ErrReadOnlyServer.New("--read-only")
+	}
+	if globalBoolVariable("read_only") && e.Analyzer.Catalog.GrantTables.Enabled {
+		sc := ctx.SecurityContext()
+		if !e.Analyzer.Catalog.GrantTables.HasSuperPrivilege(sc.User, sc.Host) {
+			return sql.ErrReadOnlyServer.New("--read-only")
+		}
+	}
+	return nil
+}
+
+// globalBoolVariable returns whether the global boolean system variable given is set.
+func globalBoolVariable(name string) bool {
+	_, val, ok := sql.SystemVariables.GetGlobal(name)
+	if !ok {
+		return false
+	}
+	v, ok := val.(int8)
+	return ok && v != 0
+}
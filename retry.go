@@ -0,0 +1,133 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// RetryPolicy configures how the engine retries statements whose transaction fails to commit because it conflicts with
+// another transaction, as reported by integrators with sql.ErrTransactionConflict. Only INSERT, REPLACE, UPDATE and
+// DELETE statements run in autocommit mode, outside of an explicit transaction, are retried: the transaction of the
+// failed attempt is rolled back, and the statement is run again in a new one. These statements are run to completion
+// before their result is returned, so that a conflict is known before any of their rows are.
+type RetryPolicy struct {
+	// MaxAttempts is the number of times a statement is run before its conflict error is returned, including the first.
+	MaxAttempts int
+	// Backoff is how long to wait before the first retry. Each following retry waits twice as long as the one before.
+	Backoff time.Duration
+	// MaxBackoff, if not zero, is the longest to wait before a retry.
+	MaxBackoff time.Duration
+}
+
+// backoff returns how long to wait before the retry given, the first retry being 1.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	backoff := p.Backoff
+	for i := 1; i < retry; i++ {
+		backoff *= 2
+		if p.MaxBackoff > 0 && backoff >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	return backoff
+}
+
+// retriesStatement returns whether the parsed statement given is retried on conflicts.
+func (e *Engine) retriesStatement(ctx *sql.Context, parsed sql.Node) (bool, error) {
+	if e.RetryPolicy == nil || e.RetryPolicy.MaxAttempts <= 1 {
+		return false, nil
+	}
+
+	switch parsed.(type) {
+	case *plan.InsertInto, *plan.Update, *plan.DeleteFrom:
+	default:
+		return false, nil
+	}
+
+	// Statements of explicit transactions can't be retried on their own
+	if ctx.GetIgnoreAutoCommit() {
+		return false, nil
+	}
+	return isSessionAutocommit(ctx)
+}
+
+// queryWithRetries executes the parsed statement given, and commits its transaction, as many times as the engine's
+// RetryPolicy allows while that fails with a transaction conflict.
+func (e *Engine) queryWithRetries(
+	ctx *sql.Context,
+	query string,
+	parsed sql.Node,
+	bindings map[string]sql.Expression,
+	transactionDatabase string,
+) (sql.Schema, sql.RowIter, error) {
+	for attempt := 1; ; attempt++ {
+		schema, iter, err := e.executeQuery(ctx, query, parsed, bindings, transactionDatabase)
+		var rows []sql.Row
+		if err == nil {
+			rows, err = sql.RowIterToRows(ctx, iter)
+		}
+		if err == nil {
+			return schema, sql.RowsToRowIter(rows...), nil
+		}
+		if !sql.ErrTransactionConflict.Is(err) || attempt >= e.RetryPolicy.MaxAttempts {
+			return nil, nil, err
+		}
+
+		ctx.GetLogger().WithError(err).Debugf("retrying statement after transaction conflict, attempt %d", attempt)
+		if err := e.rollbackTransaction(ctx, transactionDatabase); err != nil {
+			return nil, nil, err
+		}
+
+		timer := time.NewTimer(e.RetryPolicy.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		transactionDatabase, err = e.beginTransaction(ctx, parsed)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+// rollbackTransaction rolls back the current transaction of the session, if any, so that a new one may begin.
+func (e *Engine) rollbackTransaction(ctx *sql.Context, transactionDatabase string) error {
+	tx := ctx.GetTransaction()
+	if tx == nil {
+		return nil
+	}
+	defer ctx.SetTransaction(nil)
+
+	if transactionDatabase == "" {
+		return nil
+	}
+	database, err := e.Analyzer.Catalog.Database(transactionDatabase)
+	if err != nil {
+		return err
+	}
+	if tdb, ok := database.(sql.TransactionDatabase); ok {
+		return tdb.Rollback(ctx, tx)
+	}
+	return nil
+}
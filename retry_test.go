@@ -0,0 +1,31 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{MaxAttempts: 10, Backoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+	require.Equal(t, 10*time.Millisecond, p.backoff(1))
+	require.Equal(t, 20*time.Millisecond, p.backoff(2))
+	require.Equal(t, 40*time.Millisecond, p.backoff(3))
+	require.Equal(t, 50*time.Millisecond, p.backoff(4))
+	require.Equal(t, 50*time.Millisecond, p.backoff(20))
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// execute returns the row iterator of the analyzed node given. If it's a DDL statement, the engine's
+// SchemaChangeListener is notified of the changes it makes, and the schema versions of the tables it changes are
+// bumped, so that anything cached about their schemas is invalidated.
+func (e *Engine) execute(ctx *sql.Context, query string, analyzed sql.Node) (sql.RowIter, error) {
+	changes := plan.SchemaChanges(ctx, analyzed)
+	if len(changes) == 0 {
+		return analyzed.RowIter(ctx, nil)
+	}
+	defer e.bumpSchemaVersions(changes)
+
+	if e.SchemaChangeListener == nil {
+		return analyzed.RowIter(ctx, nil)
+	}
+
+	for i := range changes {
+		if changes[i].Kind == sql.SchemaChangeKind_CreateTable {
+			continue
+		}
+		changes[i].OldSchema = e.tableSchema(ctx, changes[i].Database, changes[i].Table)
+	}
+
+	if err := e.SchemaChangeListener.BeforeSchemaChange(ctx, query, changes); err != nil {
+		return nil, err
+	}
+
+	iter, err := analyzed.RowIter(ctx, nil)
+	if err == nil {
+		for i := range changes {
+			switch changes[i].Kind {
+			case sql.SchemaChangeKind_DropTable, sql.SchemaChangeKind_DropDatabase:
+			default:
+				changes[i].NewSchema = e.tableSchema(ctx, changes[i].NewDatabase, changes[i].NewTable)
+			}
+		}
+	}
+
+	listenerErr := e.SchemaChangeListener.AfterSchemaChange(ctx, query, changes, err)
+	if err != nil {
+		return nil, err
+	}
+	if listenerErr != nil {
+		_ = iter.Close(ctx)
+		return nil, listenerErr
+	}
+	return iter, nil
+}
+
+// bumpSchemaVersions bumps the schema versions of the tables and databases of the changes given, under both their
+// old and new names. Versions are bumped even when a statement fails, since it may have been partially applied.
+func (e *Engine) bumpSchemaVersions(changes []sql.SchemaChange) {
+	for _, c := range changes {
+		e.Analyzer.Catalog.BumpSchemaVersion(c.Database, c.Table)
+		if c.NewDatabase != "" && (c.NewDatabase != c.Database || c.NewTable != c.Table) {
+			e.Analyzer.Catalog.BumpSchemaVersion(c.NewDatabase, c.NewTable)
+		}
+	}
+}
+
+// tableSchema returns the schema of the table named in the database named, or nil if there's no such table.
+func (e *Engine) tableSchema(ctx *sql.Context, db, table string) sql.Schema {
+	if table == "" {
+		return nil
+	}
+
+	database, err := e.Analyzer.Catalog.Database(db)
+	if err != nil {
+		return nil
+	}
+	t, ok, err := database.GetTableInsensitive(ctx, table)
+	if err != nil || !ok {
+		return nil
+	}
+	return t.Schema()
+}
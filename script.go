@@ -0,0 +1,52 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+)
+
+// ErrScriptStatement is returned by ExecuteScript when one of the statements of the script fails.
+var ErrScriptStatement = errors.NewKind("line %d")
+
+// ExecuteScript runs every statement of a SQL script in order, such as the contents of a .sql file or a dump. The
+// script is split into statements with parse.SplitScript, so it may use the DELIMITER command to define stored
+// programs. The results of the statements are discarded. Execution stops at the first statement that fails, and the
+// error returned is an ErrScriptStatement with the line that statement starts on, wrapping the statement's error.
+func (e *Engine) ExecuteScript(ctx *sql.Context, script string) error {
+	statements, err := parse.SplitScript(script)
+	if err != nil {
+		return err
+	}
+
+	for _, stmt := range statements {
+		if err := e.execScriptStatement(ctx, stmt.Query); err != nil {
+			return ErrScriptStatement.Wrap(err, stmt.Line)
+		}
+	}
+	return nil
+}
+
+func (e *Engine) execScriptStatement(ctx *sql.Context, query string) error {
+	_, iter, err := e.Query(ctx, query)
+	if err != nil {
+		return err
+	}
+	_, err = sql.RowIterToRows(ctx, iter)
+	return err
+}
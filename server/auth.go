@@ -0,0 +1,205 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"strings"
+
+	"github.com/dolthub/vitess/go/mysql"
+
+	"github.com/dolthub/go-mysql-server/sql/grant_tables"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// AuthPlugin authenticates the clients that connect as the accounts that use it, which are the accounts whose plugin,
+// set with CREATE USER ... IDENTIFIED WITH, is the name the plugin is registered with in Config.AuthPlugins. The
+// accounts that use mysql_native_password are always authenticated by the grant tables.
+type AuthPlugin interface {
+	// ClientPlugin returns the name of the authentication method that the client is asked to switch to, such as
+	// mysql_clear_password. It can't be mysql_native_password.
+	ClientPlugin() string
+	// Authenticate reads the response of the client from the connection, once it has switched to the method of
+	// ClientPlugin, and returns whether it proves that the client has the credentials of the account of the user and
+	// host given, whose authentication string is given.
+	Authenticate(c *mysql.Conn, user string, host string, authString string) (bool, error)
+}
+
+// CredentialValidator validates the password of a client for the account of the user and host given, such as against
+// an external directory.
+type CredentialValidator func(user string, host string, password string) (bool, error)
+
+// DefaultAuthPlugins are the plugins that are registered on every server, by the name accounts use them with. Plugins
+// registered with the same name in Config.AuthPlugins replace them.
+var DefaultAuthPlugins = map[string]AuthPlugin{
+	"caching_sha2_password": CachingSha2PasswordPlugin{},
+}
+
+// CachingSha2PasswordPlugin authenticates the accounts that use caching_sha2_password, the default authentication
+// plugin of MySQL 8. Clients are sent a new nonce to scramble their password with, and are told that they are
+// authenticated as soon as the scramble matches, which MySQL calls fast authentication. Otherwise, clients that are
+// connected over TLS or a unix socket are asked for their password in clear text, which MySQL calls full
+// authentication, and the others are rejected, since the server has no RSA key pair to encrypt the password with. The
+// authentication string of the account must be the one CREATE USER ... IDENTIFIED WITH caching_sha2_password BY
+// stores.
+type CachingSha2PasswordPlugin struct{}
+
+var _ AuthPlugin = CachingSha2PasswordPlugin{}
+
+// ClientPlugin implements the interface AuthPlugin.
+func (CachingSha2PasswordPlugin) ClientPlugin() string {
+	return mysql.CachingSha2Password
+}
+
+// Authenticate implements the interface AuthPlugin.
+func (CachingSha2PasswordPlugin) Authenticate(c *mysql.Conn, user string, host string, authString string) (bool, error) {
+	nonce := c.AuthPluginData()
+	if nonce == nil {
+		// The client wasn't sent a nonce, so its scramble could be a replayed one
+		return false, nil
+	}
+
+	scramble, err := c.ReadPacket()
+	if err != nil {
+		return false, err
+	}
+	if len(authString) == 0 {
+		// no password is set, therefore the client must not give one
+		return len(scramble) == 0, nil
+	}
+
+	// Some clients, such as the Go MySQL driver before 1.7, scramble with the terminator of the nonce too
+	terminated := append(append([]byte(nil), nonce...), 0)
+	if validateCachingSha2Password(scramble, nonce, authString) || validateCachingSha2Password(scramble, terminated, authString) {
+		return true, c.WriteAuthMoreData([]byte{mysql.CachingSha2FastAuthSuccess})
+	}
+	if !isSecureConn(c) {
+		return false, nil
+	}
+
+	if err := c.WriteAuthMoreData([]byte{mysql.CachingSha2PerformFullAuth}); err != nil {
+		return false, err
+	}
+	password, err := mysql.AuthServerReadPacketString(c)
+	if err != nil {
+		return false, err
+	}
+	return strings.EqualFold(plan.AuthenticationCachingSha2Password(password).Password(), authString), nil
+}
+
+// isSecureConn returns whether the client of the connection given can send its password in clear text, which it can
+// over TLS or a unix socket.
+func isSecureConn(c *mysql.Conn) bool {
+	return c.Capabilities&mysql.CapabilityClientSSL != 0 || c.Conn.LocalAddr().Network() == "unix"
+}
+
+// validateCachingSha2Password validates the scramble of the password of a client for "caching_sha2_password", with
+// the nonce given, against the double SHA-256 of the password.
+func validateCachingSha2Password(scramble, nonce []byte, authString string) bool {
+	// SERVER: recv(scramble)
+	// 		   hash_stage1=xor(scramble, sha256(hash, nonce))
+	// 		   candidate_hash2=sha256(hash_stage1)
+	// 		   check(candidate_hash2==hash)
+	hash, err := hex.DecodeString(authString)
+	if err != nil || len(scramble) != sha256.Size || len(hash) != sha256.Size {
+		return false
+	}
+
+	crypt := sha256.New()
+	crypt.Write(hash)
+	crypt.Write(nonce)
+	stage1Hash := crypt.Sum(nil)
+	for i := range stage1Hash {
+		stage1Hash[i] ^= scramble[i]
+	}
+	candidateHash2 := sha256.Sum256(stage1Hash)
+
+	return bytes.Equal(candidateHash2[:], hash)
+}
+
+// clearPasswordPlugin asks clients for their password in clear text, and validates it with a CredentialValidator.
+type clearPasswordPlugin struct {
+	validate CredentialValidator
+}
+
+var _ AuthPlugin = clearPasswordPlugin{}
+
+// NewClearPasswordPlugin returns a plugin that asks clients for their password in clear text, with
+// mysql_clear_password, and validates it with the validator given. It's how integrators validate the credentials of
+// the accounts that use it themselves. Since the password is sent as is, it's only used over TLS unless
+// Config.AllowClearTextWithoutTLS is set.
+func NewClearPasswordPlugin(validate CredentialValidator) AuthPlugin {
+	return clearPasswordPlugin{validate: validate}
+}
+
+// ClientPlugin implements the interface AuthPlugin.
+func (p clearPasswordPlugin) ClientPlugin() string {
+	return mysql.MysqlClearPassword
+}
+
+// Authenticate implements the interface AuthPlugin.
+func (p clearPasswordPlugin) Authenticate(c *mysql.Conn, user string, host string, authString string) (bool, error) {
+	password, err := mysql.AuthServerReadPacketString(c)
+	if err != nil {
+		return false, err
+	}
+	return p.validate(user, host, password)
+}
+
+// authServer authenticates the accounts that use mysql_native_password with the grant tables, and the other ones with
+// the plugins they use.
+type authServer struct {
+	*grant_tables.GrantTables
+	plugins map[string]AuthPlugin
+}
+
+var _ mysql.AuthServer = (*authServer)(nil)
+
+// newAuthServer returns an authServer with the default plugins and the plugins given.
+func newAuthServer(grantTables *grant_tables.GrantTables, plugins map[string]AuthPlugin) *authServer {
+	a := &authServer{GrantTables: grantTables, plugins: make(map[string]AuthPlugin)}
+	for name, plugin := range DefaultAuthPlugins {
+		a.plugins[name] = plugin
+	}
+	for name, plugin := range plugins {
+		a.plugins[name] = plugin
+	}
+	return a
+}
+
+// AuthMethod implements the interface mysql.AuthServer.
+func (a *authServer) AuthMethod(user string) (string, error) {
+	if a.Enabled {
+		if plugin, ok := a.plugins[a.AccountPlugin(user)]; ok {
+			return plugin.ClientPlugin(), nil
+		}
+	}
+	return a.GrantTables.AuthMethod(user)
+}
+
+// Negotiate implements the interface mysql.AuthServer. This is called when the method used is not
+// "mysql_native_password".
+func (a *authServer) Negotiate(c *mysql.Conn, user string, remoteAddr net.Addr) (mysql.Getter, error) {
+	return a.ValidateWith(user, remoteAddr, func(host string, plugin string, authString string) (bool, error) {
+		p, ok := a.plugins[plugin]
+		if !ok {
+			return false, nil
+		}
+		return p.Authenticate(c, user, host, authString)
+	})
+}
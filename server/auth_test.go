@@ -0,0 +1,255 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	gosql "database/sql"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestAuthPlugins(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	e.Analyzer.Catalog.GrantTables.AddRootAccount()
+
+	port, err := getFreePort()
+	require.NoError(err)
+	var validated []string
+	s, err := NewDefaultServer(Config{
+		Protocol: "tcp",
+		Address:  "localhost:" + port,
+		AuthPlugins: map[string]AuthPlugin{
+			"directory_password": NewClearPasswordPlugin(func(user string, host string, password string) (bool, error) {
+				validated = append(validated, user+"@"+host)
+				return password == "directory-"+user, nil
+			}),
+		},
+		AllowClearTextWithoutTLS: true,
+	}, e)
+	require.NoError(err)
+	go s.Start()
+	defer s.Close()
+
+	ping := func(user string, password string, params string) error {
+		db, err := gosql.Open("mysql", fmt.Sprintf("%s:%s@tcp(localhost:%s)/test%s", user, password, port, params))
+		require.NoError(err)
+		defer db.Close()
+		return db.PingContext(context.Background())
+	}
+
+	db, conn := openTestConn(t, fmt.Sprintf("root:@tcp(localhost:%s)/test", port))
+	defer db.Close()
+	for _, query := range []string{
+		"CREATE USER sha@'%' IDENTIFIED WITH caching_sha2_password BY 'secret'",
+		"CREATE USER nopass@'%' IDENTIFIED WITH caching_sha2_password",
+		"CREATE USER ldap@'%' IDENTIFIED WITH directory_password AS 'cn=ldap'",
+		"CREATE USER unknown@'%' IDENTIFIED WITH unknown_plugin",
+	} {
+		_, err := conn.ExecContext(context.Background(), query)
+		require.NoError(err, query)
+	}
+
+	// caching_sha2_password accounts are checked against the scramble of their password
+	require.NoError(ping("sha", "secret", ""))
+	require.Error(ping("sha", "wrong", ""))
+	require.Error(ping("sha", "", ""))
+	require.NoError(ping("nopass", "", ""))
+	require.Error(ping("nopass", "secret", ""))
+
+	// Integrators validate the credentials of the accounts of their plugins
+	require.NoError(ping("ldap", "directory-ldap", "?allowCleartextPasswords=true"))
+	require.Error(ping("ldap", "secret", "?allowCleartextPasswords=true"))
+	require.Equal([]string{"ldap@127.0.0.1", "ldap@127.0.0.1"}, validated)
+
+	// Accounts of plugins that aren't registered can't connect
+	require.Error(ping("unknown", "", ""))
+}
+
+// scrambleCachingSha2 returns the scramble of a password that clients send for a nonce with caching_sha2_password.
+func scrambleCachingSha2(password string, nonce []byte) []byte {
+	sha256Sum := func(b []byte) []byte {
+		sum := sha256.Sum256(b)
+		return sum[:]
+	}
+	// XOR(SHA256(password), SHA256(SHA256(SHA256(password)), nonce))
+	s1 := sha256Sum([]byte(password))
+	s2 := sha256Sum(s1)
+	s3 := sha256Sum(append(s2, nonce...))
+	for i := range s1 {
+		s1[i] ^= s3[i]
+	}
+	return s1
+}
+
+// cachingSha2Client authenticates with caching_sha2_password like libmysqlclient does, which requires the switch to
+// the plugin to carry a nonce.
+type cachingSha2Client struct {
+	t    *testing.T
+	conn net.Conn
+	seq  byte
+}
+
+// connectCachingSha2 connects to the server at the address given as the user given, and returns the client and the
+// nonce it was switched to caching_sha2_password with.
+func connectCachingSha2(t *testing.T, network, address, user string) (*cachingSha2Client, []byte) {
+	conn, err := net.Dial(network, address)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	c := &cachingSha2Client{t: t, conn: conn}
+
+	greeting := c.readPacket()
+	// A HandshakeV10 packet
+	require.Equal(t, byte(10), greeting[0])
+
+	capabilities := uint32(mysql.CapabilityClientLongPassword | mysql.CapabilityClientProtocol41 |
+		mysql.CapabilityClientSecureConnection | mysql.CapabilityClientPluginAuth)
+	response := make([]byte, 32)
+	binary.LittleEndian.PutUint32(response, capabilities)
+	binary.LittleEndian.PutUint32(response[4:], 1<<24)
+	response[8] = 33
+	response = append(response, user...)
+	response = append(response, 0, 0)
+	response = append(response, "mysql_native_password\x00"...)
+	c.writePacket(response)
+
+	switchRequest := c.readPacket()
+	require.Equal(t, byte(mysql.AuthSwitchRequestPacket), switchRequest[0])
+	require.True(t, bytes.HasPrefix(switchRequest[1:], []byte("caching_sha2_password\x00")))
+	data := switchRequest[len("caching_sha2_password")+2:]
+	// libmysqlclient rejects anything but a 20 byte nonce and its terminator
+	require.Len(t, data, 21)
+	require.Equal(t, byte(0), data[20])
+	return c, data[:20]
+}
+
+func (c *cachingSha2Client) writePacket(payload []byte) {
+	header := []byte{byte(len(payload)), byte(len(payload) >> 8), byte(len(payload) >> 16), c.seq}
+	_, err := c.conn.Write(append(header, payload...))
+	require.NoError(c.t, err)
+	c.seq++
+}
+
+// readPacket reads the next packet of the server, checking its sequence number, and returns its payload.
+func (c *cachingSha2Client) readPacket() []byte {
+	header := make([]byte, 4)
+	_, err := io.ReadFull(c.conn, header)
+	require.NoError(c.t, err)
+	require.Equal(c.t, c.seq, header[3])
+	c.seq++
+	payload := make([]byte, int(header[0])|int(header[1])<<8|int(header[2])<<16)
+	_, err = io.ReadFull(c.conn, payload)
+	require.NoError(c.t, err)
+	return payload
+}
+
+func TestCachingSha2PasswordNonce(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("unix sockets are not supported on windows")
+	}
+
+	require := require.New(t)
+	e := setupMemDB(require)
+	e.Analyzer.Catalog.GrantTables.AddRootAccount()
+
+	port, err := getFreePort()
+	require.NoError(err)
+	dir, err := ioutil.TempDir("", "gms-socket")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "mysql.sock")
+
+	s, err := NewDefaultServer(Config{
+		Protocol:  "tcp",
+		Address:   "localhost:" + port,
+		Socket:    socket,
+		TLSConfig: newTestTLSConfig(t),
+	}, e)
+	require.NoError(err)
+	go s.Start()
+	defer s.Close()
+
+	db, conn := openTestConn(t, fmt.Sprintf("root:@tcp(localhost:%s)/test", port))
+	defer db.Close()
+	_, err = conn.ExecContext(context.Background(), "CREATE USER sha@'%' IDENTIFIED WITH caching_sha2_password BY 'secret'")
+	require.NoError(err)
+
+	// Fast authentication: the scramble of the password for the nonce sent is enough
+	c, nonce := connectCachingSha2(t, "tcp", "localhost:"+port, "sha")
+	scramble := scrambleCachingSha2("secret", nonce)
+	c.writePacket(scramble)
+	require.Equal([]byte{mysql.AuthMoreDataPacket, mysql.CachingSha2FastAuthSuccess}, c.readPacket())
+	require.Equal(byte(mysql.OKPacket), c.readPacket()[0])
+
+	// Each connection is sent a new nonce, so the scramble can't be replayed
+	c, otherNonce := connectCachingSha2(t, "tcp", "localhost:"+port, "sha")
+	require.NotEqual(nonce, otherNonce)
+	c.writePacket(scramble)
+	require.Equal(byte(mysql.ErrPacket), c.readPacket()[0])
+
+	// Over a unix socket, the client is asked for its password instead
+	c, _ = connectCachingSha2(t, "unix", socket, "sha")
+	c.writePacket(scramble)
+	require.Equal([]byte{mysql.AuthMoreDataPacket, mysql.CachingSha2PerformFullAuth}, c.readPacket())
+	c.writePacket([]byte("secret\x00"))
+	require.Equal(byte(mysql.OKPacket), c.readPacket()[0])
+
+	c, _ = connectCachingSha2(t, "unix", socket, "sha")
+	c.writePacket(scramble)
+	require.Equal([]byte{mysql.AuthMoreDataPacket, mysql.CachingSha2PerformFullAuth}, c.readPacket())
+	c.writePacket([]byte("wrong\x00"))
+	require.Equal(byte(mysql.ErrPacket), c.readPacket()[0])
+
+	// The nonce is sent over TLS too
+	tlsDB, err := gosql.Open("mysql", fmt.Sprintf("sha:secret@tcp(localhost:%s)/test?tls=skip-verify", port))
+	require.NoError(err)
+	defer tlsDB.Close()
+	var one int
+	require.NoError(tlsDB.QueryRow("SELECT 1").Scan(&one))
+	require.Equal(1, one)
+}
+
+func TestValidateCachingSha2Password(t *testing.T) {
+	require := require.New(t)
+
+	authString := plan.AuthenticationCachingSha2Password("secret").Password()
+	sum := sha256.Sum256([]byte("secret"))
+	require.Equal(fmt.Sprintf("%X", sha256.Sum256(sum[:])), authString)
+	nonce := []byte("0123456789abcdefghij")
+
+	require.True(validateCachingSha2Password(scrambleCachingSha2("secret", nonce), nonce, authString))
+	require.True(validateCachingSha2Password(scrambleCachingSha2("secret", nil), nil, authString))
+	require.False(validateCachingSha2Password(scrambleCachingSha2("secret", nonce), nil, authString))
+	require.False(validateCachingSha2Password(scrambleCachingSha2("wrong", nonce), nonce, authString))
+	require.False(validateCachingSha2Password(nil, nonce, authString))
+	require.False(validateCachingSha2Password(scrambleCachingSha2("secret", nonce), nonce, "not hex"))
+}
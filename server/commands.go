@@ -0,0 +1,70 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/sirupsen/logrus"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ComStatistics implements mysql.Handler. It returns the status string sent in response to COM_STATISTICS, in the
+// same format MySQL uses.
+func (h *Handler) ComStatistics(c *mysql.Conn) string {
+	return h.statistics()
+}
+
+// ComDebug implements mysql.Handler. It writes the state of the server to the log in response to COM_DEBUG.
+func (h *Handler) ComDebug(c *mysql.Conn) error {
+	sql.GetLogger().WithField("statistics", h.statistics()).Info("COM_DEBUG")
+	for _, p := range h.e.ProcessList.Processes() {
+		sql.GetLogger().WithFields(logrus.Fields{
+			sqle.ConnectionIdLogField: p.Connection,
+			sqle.QueryIdLogField:      p.Pid,
+			sqle.UserLogField:         p.User,
+			"query":                   sql.RedactQueryForLog(p.Query),
+			"seconds":                 p.Seconds(),
+		}).Info("COM_DEBUG process")
+	}
+	return nil
+}
+
+// statistics returns the status string of COM_STATISTICS, which is made of the global values of the status variables
+// of the engine.
+func (h *Handler) statistics() string {
+	sv := h.e.StatusVariables
+	uptime := sv.GlobalValue("Uptime")
+	questions := sv.GlobalValue("Questions")
+	var qps float64
+	if uptime > 0 {
+		qps = float64(questions) / float64(uptime)
+	}
+
+	return fmt.Sprintf(
+		"Uptime: %d  Threads: %d  Questions: %d  Slow queries: %d  Opens: %d  Flush tables: %d  Open tables: %d  Queries per second avg: %.3f",
+		uptime,
+		sv.GlobalValue("Threads_connected"),
+		questions,
+		sv.GlobalValue("Slow_queries"),
+		sv.GlobalValue("Opened_tables"),
+		sv.GlobalValue("Flush_commands"),
+		sv.GlobalValue("Open_tables"),
+		qps,
+	)
+}
@@ -36,7 +36,12 @@ type DoneFunc func()
 
 // DefaultSessionBuilder is a SessionBuilder that returns a base session.
 func DefaultSessionBuilder(ctx context.Context, c *mysql.Conn, addr string) (sql.Session, error) {
-	client := sql.Client{Address: c.RemoteAddr().String(), User: c.User, Capabilities: c.Capabilities}
+	address := c.RemoteAddr().String()
+	// Connections over a unix domain socket are always local, and authenticate as localhost accounts
+	if c.RemoteAddr().Network() == "unix" {
+		address = "localhost"
+	}
+	client := sql.Client{Address: address, User: c.User, Capabilities: c.Capabilities}
 	return sql.NewBaseSessionWithClientServer(addr, client, c.ConnectionID), nil
 }
 
@@ -58,6 +63,9 @@ type SessionManager struct {
 	builder     SessionBuilder
 	sessions    map[uint32]*managedSession
 	pid         uint64
+	// adminCommands are the admin commands of the server, such as SHUTDOWN, which act on it rather than on every
+	// server of the engine.
+	adminCommands *sql.AdminCommands
 }
 
 // NewSessionManager creates a SessionManager with the given SessionBuilder.
@@ -70,14 +78,15 @@ func NewSessionManager(
 	addr string,
 ) *SessionManager {
 	return &SessionManager{
-		addr:        addr,
-		tracer:      tracer,
-		hasDBFunc:   hasDBFunc,
-		memory:      memory,
-		processlist: processlist,
-		mu:          new(sync.Mutex),
-		builder:     builder,
-		sessions:    make(map[uint32]*managedSession),
+		addr:          addr,
+		tracer:        tracer,
+		hasDBFunc:     hasDBFunc,
+		memory:        memory,
+		processlist:   processlist,
+		mu:            new(sync.Mutex),
+		builder:       builder,
+		sessions:      make(map[uint32]*managedSession),
+		adminCommands: sql.NewAdminCommands(),
 	}
 }
 
@@ -107,6 +116,7 @@ func (s *SessionManager) NewSession(ctx context.Context, conn *mysql.Conn) error
 
 	s.sessions[conn.ConnectionID].session.SetLogger(
 		logger.WithField(sqle.ConnectionIdLogField, conn.ConnectionID).
+			WithField(sqle.UserLogField, conn.User).
 			WithField(sqle.ConnectTimeLogKey, time.Now()),
 	)
 
@@ -179,6 +189,7 @@ func (s *SessionManager) NewContextWithQuery(conn *mysql.Conn, query string) (*s
 		sql.WithServices(sql.Services{
 			KillConnection: s.killConnection,
 			LoadInfile:     conn.LoadInfile,
+			AdminCommands:  s.adminCommands,
 		}),
 	)
 
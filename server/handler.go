@@ -15,6 +15,7 @@
 package server
 
 import (
+	"context"
 	"io"
 	"net"
 	"regexp"
@@ -28,7 +29,6 @@ import (
 	"github.com/dolthub/vitess/go/vt/proto/query"
 	"github.com/go-kit/kit/metrics/discard"
 	"github.com/opentracing/opentracing-go"
-	"github.com/sirupsen/logrus"
 	"gopkg.in/src-d/go-errors.v1"
 
 	sqle "github.com/dolthub/go-mysql-server"
@@ -51,6 +51,10 @@ var ErrUnsupportedOperation = errors.NewKind("unsupported operation")
 // TODO parametrize
 const rowsBatch = 100
 
+// rowsBatchMaxBytes is the size of the values of a batch of rows past which the batch is sent without waiting for more
+// rows, so that rows with large TEXT and BLOB values don't pile up in memory.
+const rowsBatchMaxBytes = 16 * 1024 * 1024
+
 var tcpCheckerSleepDuration time.Duration = 1 * time.Second
 
 type MultiStmtMode int
@@ -68,6 +72,16 @@ type Handler struct {
 	readTimeout       time.Duration
 	disableMultiStmts bool
 	sel               ServerEventListener
+
+	// prepared holds the prepared statements of each connection by their statement IDs. It's guarded by mu.
+	prepared map[uint32]map[uint32]*sqle.PreparedStatement
+
+	// The fields below are guarded by mu and are used to drain the handler on shutdown.
+	conns        map[uint32]*mysql.Conn
+	inFlight     int
+	shuttingDown bool
+	drained      chan struct{}
+	closed       chan struct{}
 }
 
 // NewHandler creates a new Handler given a SQLe engine.
@@ -78,6 +92,8 @@ func NewHandler(e *sqle.Engine, sm *SessionManager, rt time.Duration, disableMul
 		readTimeout:       rt,
 		disableMultiStmts: disableMultiStmts,
 		sel:               listener,
+		conns:             make(map[uint32]*mysql.Conn),
+		prepared:          make(map[uint32]map[uint32]*sqle.PreparedStatement),
 	}
 }
 
@@ -87,37 +103,81 @@ func (h *Handler) NewConnection(c *mysql.Conn) {
 		h.sel.ClientConnected()
 	}
 
+	h.mu.Lock()
+	h.conns[c.ConnectionID] = c
+	h.e.StatusVariables.Increment(nil, "Connections", 1)
+	h.e.StatusVariables.SetGlobal("Threads_connected", int64(len(h.conns)))
+	h.e.StatusVariables.RaiseGlobal("Max_used_connections", int64(len(h.conns)))
+	h.mu.Unlock()
+
 	c.DisableClientMultiStatements = h.disableMultiStmts
-	logrus.WithField(sqle.ConnectionIdLogField, c.ConnectionID).WithField("DisableClientMultiStatements", c.DisableClientMultiStatements).Infof("NewConnection")
+	sql.GetLogger().WithField(sqle.ConnectionIdLogField, c.ConnectionID).WithField("DisableClientMultiStatements", c.DisableClientMultiStatements).Infof("NewConnection")
 }
 
 func (h *Handler) ComInitDB(c *mysql.Conn, schemaName string) error {
 	return h.sm.SetDB(c, schemaName)
 }
 
-func (h *Handler) ComPrepare(c *mysql.Conn, query string) ([]*query.Field, error) {
+// ComPrepare parses and analyzes a statement prepared by the client with COM_STMT_PREPARE, and returns the fields of
+// its rows. The parsed statement is kept for the connection, under the statement ID the connection gave it, so that
+// it's not parsed again each time it's executed.
+func (h *Handler) ComPrepare(c *mysql.Conn, query string) (fields []*query.Field, err error) {
+	defer h.recoverPanic(c, &err)
+
 	ctx, err := h.sm.NewContextWithQuery(c, query)
 	if err != nil {
 		return nil, err
 	}
-	schema, err := h.e.AnalyzeQuery(ctx, query)
+	prepared, err := h.e.PrepareQuery(ctx, query)
 	if err != nil {
 		return nil, err
 	}
-	if sql.IsOkResultSchema(schema) {
+	h.addPreparedStatement(c, c.StatementID, prepared)
+
+	if sql.IsOkResultSchema(prepared.Schema) {
 		return nil, nil
 	}
-	return schemaToFields(schema), nil
+	return schemaToFields(prepared.Schema), nil
 }
 
+// ComStmtExecute executes a prepared statement with the values of its parameters the client sent with
+// COM_STMT_EXECUTE. Its rows are sent in the binary protocol.
 func (h *Handler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
-
-	_, err := h.errorWrappedDoQuery(c, prepare.PrepareStmt, MultiStmtModeOff, prepare.BindVars, func(res *sqltypes.Result, more bool) error {
+	prepared := h.preparedStatement(c, prepare.StatementID)
+	_, err := h.errorWrappedDoQuery(c, prepare.PrepareStmt, prepared, MultiStmtModeOff, prepare.BindVars, func(res *sqltypes.Result, more bool) error {
 		return callback(res)
 	})
 	return err
 }
 
+// addPreparedStatement keeps the prepared statement given for the connection given, under the statement ID given.
+// The statements the client closed since the last one was prepared are dropped, since the connection doesn't report
+// COM_STMT_CLOSE to the handler.
+func (h *Handler) addPreparedStatement(c *mysql.Conn, id uint32, prepared *sqle.PreparedStatement) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	stmts := h.prepared[c.ConnectionID]
+	if stmts == nil {
+		stmts = make(map[uint32]*sqle.PreparedStatement)
+		h.prepared[c.ConnectionID] = stmts
+	}
+	for stmtID := range stmts {
+		if _, ok := c.PrepareData[stmtID]; !ok {
+			delete(stmts, stmtID)
+		}
+	}
+	stmts[id] = prepared
+}
+
+// preparedStatement returns the prepared statement of the connection given with the statement ID given, or nil if
+// there is none, in which case the statement is parsed again from its text.
+func (h *Handler) preparedStatement(c *mysql.Conn, id uint32) *sqle.PreparedStatement {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.prepared[c.ConnectionID][id]
+}
+
 func (h *Handler) ComResetConnection(c *mysql.Conn) {
 	// TODO: handle reset logic
 }
@@ -136,10 +196,26 @@ func (h *Handler) ConnectionClosed(c *mysql.Conn) {
 	// If connection was closed, kill its associated queries.
 	ctx.ProcessList.Kill(c.ConnectionID)
 	if err := h.e.Analyzer.Catalog.UnlockTables(ctx, c.ConnectionID); err != nil {
-		logrus.Errorf("unable to unlock tables on session close: %s", err)
+		sql.GetLogger().Errorf("unable to unlock tables on session close: %s", err)
 	}
+	h.e.Analyzer.Catalog.TableHandlers.CloseAll(c.ConnectionID)
+	h.e.Analyzer.Catalog.PreparedQueries.DeallocateAll(c.ConnectionID)
+	if _, err := h.e.LS.ReleaseAll(ctx); err != nil {
+		sql.GetLogger().Errorf("unable to release named locks on session close: %s", err)
+	}
+
+	h.mu.Lock()
+	delete(h.conns, c.ConnectionID)
+	delete(h.prepared, c.ConnectionID)
+	h.e.StatusVariables.SetGlobal("Threads_connected", int64(len(h.conns)))
+	h.e.StatusVariables.RemoveSession(c.ConnectionID)
+	if h.closed != nil && len(h.conns) == 0 {
+		close(h.closed)
+		h.closed = nil
+	}
+	h.mu.Unlock()
 
-	logrus.WithField(sqle.ConnectionIdLogField, c.ConnectionID).Infof("ConnectionClosed")
+	sql.GetLogger().WithField(sqle.ConnectionIdLogField, c.ConnectionID).Infof("ConnectionClosed")
 }
 
 func (h *Handler) ComMultiQuery(
@@ -147,7 +223,7 @@ func (h *Handler) ComMultiQuery(
 	query string,
 	callback func(*sqltypes.Result, bool) error,
 ) (string, error) {
-	return h.errorWrappedDoQuery(c, query, MultiStmtModeOn, nil, callback)
+	return h.errorWrappedDoQuery(c, query, nil, MultiStmtModeOn, nil, callback)
 }
 
 // ComQuery executes a SQL query on the SQLe engine.
@@ -156,7 +232,7 @@ func (h *Handler) ComQuery(
 	query string,
 	callback func(*sqltypes.Result, bool) error,
 ) error {
-	_, err := h.errorWrappedDoQuery(c, query, MultiStmtModeOff, nil, callback)
+	_, err := h.errorWrappedDoQuery(c, query, nil, MultiStmtModeOff, nil, callback)
 	return err
 }
 
@@ -272,6 +348,7 @@ var queryLoggingRegex = regexp.MustCompile(`[\r\n\t ]+`)
 func (h *Handler) doQuery(
 	c *mysql.Conn,
 	query string,
+	prepared *sqle.PreparedStatement,
 	mode MultiStmtMode,
 	bindings map[string]*query.BindVariable,
 	callback func(*sqltypes.Result, bool) error,
@@ -281,13 +358,35 @@ func (h *Handler) doQuery(
 		return "", err
 	}
 
+	maxPacket, err := maxAllowedPacket(ctx)
+	if err != nil {
+		return "", err
+	}
+	if queryPacketSize(query, bindings) > maxPacket {
+		return "", sql.ErrPacketTooLarge.New()
+	}
+
 	var remainder string
 	var parsed sql.Node
-	if mode == MultiStmtModeOn {
-		var prequery string
-		parsed, prequery, remainder, _ = parse.ParseOne(ctx, query)
-		if prequery != "" {
-			query = prequery
+	if prepared != nil {
+		// Prepared statements were rewritten and parsed when they were prepared
+		query, parsed = prepared.Query, prepared.Parsed
+	} else {
+		if mode == MultiStmtModeOn {
+			var prequery string
+			parsed, prequery, remainder, _ = parse.ParseOne(ctx, query)
+			if prequery != "" {
+				query = prequery
+			}
+		}
+
+		rewritten, err := h.e.RewriteQuery(ctx, query)
+		if err != nil {
+			return remainder, err
+		}
+		if rewritten != query {
+			query = rewritten
+			parsed = nil
 		}
 	}
 
@@ -295,7 +394,8 @@ func (h *Handler) doQuery(
 	more := remainder != ""
 
 	ctx.SetLogger(ctx.GetLogger().
-		WithField("query", string(queryLoggingRegex.ReplaceAll([]byte(query), []byte(" ")))))
+		WithField(sqle.QueryIdLogField, ctx.Pid()).
+		WithField("query", string(queryLoggingRegex.ReplaceAll([]byte(sql.RedactQueryForLog(query)), []byte(" ")))))
 	ctx.GetLogger().Debugf("Starting query")
 
 	finish := observeQuery(ctx, query)
@@ -313,7 +413,10 @@ func (h *Handler) doQuery(
 	start := time.Now()
 
 	if parsed == nil {
-		parsed, _ = parse.Parse(ctx, query)
+		parsed, err = parse.Parse(ctx, query)
+		if err != nil {
+			return remainder, err
+		}
 	}
 
 	ctx.GetLogger().Tracef("beginning execution")
@@ -330,13 +433,25 @@ func (h *Handler) doQuery(
 	oCtx := ctx
 	eg, ctx := ctx.NewErrgroup()
 
+	// Start watching the client connection before the query is planned, so that a client that goes away during
+	// analysis or a long first row still cancels the query's context.
+	pollCtx, cancelF := ctx.NewSubContext()
+	eg.Go(func() error {
+		return h.pollForClosedConnection(pollCtx, c)
+	})
+
 	schema, rows, err := h.e.QueryNodeWithBindings(ctx, query, parsed, sqlBindings)
 	if err != nil {
+		cancelF()
+		if werr := eg.Wait(); werr != nil {
+			err = werr
+		}
 		ctx.GetLogger().WithError(err).Warn("error running query")
 		return remainder, err
 	}
 
 	var r *sqltypes.Result
+	var rBytes int
 	var proccesedAtLeastOneBatch bool
 
 	// Reads rows from the row reading goroutine
@@ -347,7 +462,7 @@ func (h *Handler) doQuery(
 		for {
 			select {
 			case <-ctx.Done():
-				return nil
+				return closeCanceledRowIter(ctx, rows)
 			default:
 				row, err := rows.Next(ctx)
 				if err != nil {
@@ -363,17 +478,12 @@ func (h *Handler) doQuery(
 				select {
 				case rowChan <- row:
 				case <-ctx.Done():
-					return nil
+					return closeCanceledRowIter(ctx, rows)
 				}
 			}
 		}
 	})
 
-	pollCtx, cancelF := ctx.NewSubContext()
-	eg.Go(func() error {
-		return h.pollForClosedConnection(pollCtx, c)
-	})
-
 	// Default waitTime is one minute if there is no timeout configured, in which case
 	// it will loop to iterate again unless the socket died by the OS timeout or other problems.
 	// If there is a timeout, it will be enforced to ensure that Vitess has a chance to
@@ -394,11 +504,12 @@ func (h *Handler) doQuery(
 				r = &sqltypes.Result{Fields: schemaToFields(schema)}
 			}
 
-			if r.RowsAffected == rowsBatch {
+			if r.RowsAffected == rowsBatch || rBytes >= rowsBatchMaxBytes {
 				if err := callback(r, more); err != nil {
 					return err
 				}
 				r = nil
+				rBytes = 0
 				proccesedAtLeastOneBatch = true
 				continue
 			}
@@ -418,14 +529,18 @@ func (h *Handler) doQuery(
 					continue
 				}
 
-				outputRow, err := rowToSQL(schema, row)
+				outputRow, rowBytes, err := rowToSQL(schema, row)
 				if err != nil {
 					return err
 				}
+				if int64(rowBytes) > maxPacket {
+					return sql.ErrPacketTooLarge.New()
+				}
 
 				ctx.GetLogger().Tracef("spooling result row %s", outputRow)
 				r.Rows = append(r.Rows, outputRow)
 				r.RowsAffected++
+				rBytes += rowBytes
 			case <-timer.C:
 				if h.readTimeout != 0 {
 					// Cancel and return so Vitess can call the CloseConnection callback
@@ -473,6 +588,16 @@ func (h *Handler) doQuery(
 	return remainder, callback(r, more)
 }
 
+// closeCanceledRowIter closes |rows| after the query context has been canceled, e.g. because the client went away.
+// The iterator still needs to be closed so that integrators can release any resources held by it, but errors from
+// closing are only logged, since the cancellation cause is the error reported for the query.
+func closeCanceledRowIter(ctx *sql.Context, rows sql.RowIter) error {
+	if err := rows.Close(ctx); err != nil {
+		ctx.GetLogger().WithError(err).Warn("error closing row iter")
+	}
+	return nil
+}
+
 // See https://dev.mysql.com/doc/internals/en/status-flags.html
 func setConnStatusFlags(ctx *sql.Context, c *mysql.Conn) error {
 	ok, err := isSessionAutocommit(ctx)
@@ -506,16 +631,25 @@ func isSessionAutocommit(ctx *sql.Context) (bool, error) {
 func (h *Handler) errorWrappedDoQuery(
 	c *mysql.Conn,
 	query string,
+	prepared *sqle.PreparedStatement,
 	mode MultiStmtMode,
 	bindings map[string]*query.BindVariable,
 	callback func(*sqltypes.Result, bool) error,
 ) (string, error) {
+	if err := h.beginQuery(); err != nil {
+		return "", err
+	}
+	defer h.endQuery()
+
 	start := time.Now()
 	if h.sel != nil {
 		h.sel.QueryStarted()
 	}
 
-	remainder, err := h.doQuery(c, query, mode, bindings, callback)
+	remainder, err := func() (remainder string, err error) {
+		defer h.recoverPanic(c, &err)
+		return h.doQuery(c, query, prepared, mode, bindings, callback)
+	}()
 	err, _, ok := sql.CastSQLError(err)
 
 	var retErr error
@@ -530,6 +664,102 @@ func (h *Handler) errorWrappedDoQuery(
 	return remainder, retErr
 }
 
+// recoverPanic stores a panic of the query of the connection given in |err| as an sql.ErrInternal error, logging its
+// stack, unless the engine's panic recovery is disabled. It must be deferred.
+func (h *Handler) recoverPanic(c *mysql.Conn, err *error) {
+	if h.e.DisablePanicRecovery {
+		return
+	}
+	if r := recover(); r != nil {
+		*err = sql.PanicToError(sql.GetLogger().WithField(sqle.ConnectionIdLogField, c.ConnectionID), r)
+	}
+}
+
+// beginQuery registers a query as in flight, or returns an error if the handler is shutting down and no longer
+// accepts new queries.
+func (h *Handler) beginQuery() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.shuttingDown {
+		return errServerShutdown()
+	}
+	h.inFlight++
+	h.e.StatusVariables.SetGlobal("Threads_running", int64(h.inFlight))
+	return nil
+}
+
+// endQuery marks a query started with beginQuery as finished.
+func (h *Handler) endQuery() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.inFlight--
+	h.e.StatusVariables.SetGlobal("Threads_running", int64(h.inFlight))
+	if h.drained != nil && h.inFlight == 0 {
+		close(h.drained)
+		h.drained = nil
+	}
+}
+
+func errServerShutdown() error {
+	return mysql.NewSQLError(mysql.ERServerShutdown, mysql.SSServerShutdown, "Server shutdown in progress")
+}
+
+// drain stops the handler from accepting new queries and waits for the queries currently in flight to finish. If
+// |ctx| is done before that happens, the remaining queries are killed and ctx.Err() is returned.
+func (h *Handler) drain(ctx context.Context) error {
+	h.mu.Lock()
+	h.shuttingDown = true
+	drained := make(chan struct{})
+	if h.inFlight == 0 {
+		close(drained)
+	} else {
+		h.drained = drained
+	}
+	h.mu.Unlock()
+
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+	}
+
+	h.mu.Lock()
+	for id := range h.conns {
+		h.e.ProcessList.Kill(id)
+	}
+	h.mu.Unlock()
+
+	return ctx.Err()
+}
+
+// closeConnections closes every client connection, and waits for the listener to report them closed so that the
+// end-of-session logic in ConnectionClosed has run for each of them, or until |ctx| is done.
+func (h *Handler) closeConnections(ctx context.Context) error {
+	h.mu.Lock()
+	closed := make(chan struct{})
+	if len(h.conns) == 0 {
+		close(closed)
+	} else {
+		h.closed = closed
+	}
+	conns := make([]*mysql.Conn, 0, len(h.conns))
+	for _, c := range h.conns {
+		conns = append(conns, c)
+	}
+	h.mu.Unlock()
+
+	for _, c := range conns {
+		c.Close()
+	}
+
+	select {
+	case <-closed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Periodically polls the connection socket to determine if it is has been closed by the client, returning an error
 // if it has been. Meant to be run in an errgroup from the query handler routine. Returns immediately with no error
 // on platforms that can't support TCP socket checks.
@@ -619,29 +849,75 @@ func (h *Handler) WarningCount(c *mysql.Conn) uint16 {
 	return 0
 }
 
-func rowToSQL(s sql.Schema, row sql.Row) ([]sqltypes.Value, error) {
+// maxAllowedPacket returns the value of max_allowed_packet for the session of the context given.
+func maxAllowedPacket(ctx *sql.Context) (int64, error) {
+	val, err := ctx.GetSessionVariable(ctx, "max_allowed_packet")
+	if err != nil {
+		return 0, err
+	}
+	return val.(int64), nil
+}
+
+// queryPacketSize returns the size in bytes of the query given and its bound values, as sent by the client. Packets
+// larger than 16MB are split by the client and reassembled by the connection before they reach the handler, so this is
+// the size of the whole query.
+func queryPacketSize(query string, bindings map[string]*query.BindVariable) int64 {
+	size := int64(len(query))
+	for _, b := range bindings {
+		size += int64(len(b.Value))
+	}
+	return size
+}
+
+// rowToSQL converts the row given to the values sent to the client, and returns their size in bytes. The LobValues of
+// TEXT and BLOB columns are given as streamed values, so that vitess writes them to the client as they're read rather
+// than reading them into their values.
+func rowToSQL(s sql.Schema, row sql.Row) ([]sqltypes.Value, int, error) {
 	o := make([]sqltypes.Value, len(row))
+	size := 0
 	var err error
 	for i, v := range row {
-		if v == nil {
+		// Some expressions return sql.Null rather than nil for NULL
+		if v == nil || v == sql.Null {
 			o[i] = sqltypes.NULL
 			continue
 		}
 
+		if lob, ok := v.(sql.LobValue); ok && isStreamedLob(s[i].Type, lob) {
+			o[i] = sqltypes.MakeStreamed(s[i].Type.Type(), lob)
+			size += int(lob.Size())
+			continue
+		}
+
 		o[i], err = s[i].Type.SQL(v)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
+		size += o[i].Len()
 	}
 
-	return o, nil
+	return o, size, nil
+}
+
+// isStreamedLob returns whether the LobValue given is streamed to the client in a column of the type given, which it
+// is if the column is TEXT or BLOB and the value fits in it.
+func isStreamedLob(typ sql.Type, lob sql.LobValue) bool {
+	st, ok := typ.(sql.StringType)
+	if !ok || (st.Type() != sqltypes.Text && st.Type() != sqltypes.Blob) {
+		return false
+	}
+	return lob.Size() <= st.MaxByteLength()
 }
 
 func schemaToFields(s sql.Schema) []*query.Field {
 	fields := make([]*query.Field, len(s))
 	for i, c := range s {
+		// String columns report the id of their collation, so that clients decode the 4-byte characters of utf8mb4
+		// instead of assuming the 3-byte utf8 of the default character set
 		var charset uint32 = mysql.CharacterSetUtf8
-		if sql.IsBlob(c.Type) {
+		if st, ok := c.Type.(sql.StringType); ok {
+			charset = uint32(st.Collation().ID())
+		} else if sql.IsBlob(c.Type) {
 			charset = mysql.CharacterSetBinary
 		}
 
@@ -18,6 +18,9 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"runtime"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -28,6 +31,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 )
@@ -202,6 +206,77 @@ func TestHandlerComPrepare(t *testing.T) {
 	}
 }
 
+func TestHandlerComStmtExecute(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	dummyConn := &mysql.Conn{ConnectionID: 1, PrepareData: make(map[uint32]*mysql.PrepareData)}
+	handler := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo",
+		),
+		0,
+		false,
+		nil,
+	)
+	handler.NewConnection(dummyConn)
+	require.NoError(handler.ComInitDB(dummyConn, "test"))
+
+	// prepare registers a statement as the connection does before calling ComPrepare
+	prepare := func(statement string) *mysql.PrepareData {
+		dummyConn.StatementID++
+		data := &mysql.PrepareData{
+			StatementID: dummyConn.StatementID,
+			PrepareStmt: statement,
+			ParamsCount: 1,
+			BindVars:    make(map[string]*query.BindVariable),
+		}
+		dummyConn.PrepareData[data.StatementID] = data
+		_, err := handler.ComPrepare(dummyConn, statement)
+		require.NoError(err)
+		return data
+	}
+	execute := func(data *mysql.PrepareData, param int64) [][]sqltypes.Value {
+		data.BindVars["v1"] = sqltypes.Int64BindVariable(param)
+		var rows [][]sqltypes.Value
+		require.NoError(handler.ComStmtExecute(dummyConn, data, func(res *sqltypes.Result) error {
+			rows = append(rows, res.Rows...)
+			return nil
+		}))
+		return rows
+	}
+
+	selectStmt := prepare("select c1 from test where c1 < ? order by c1")
+	prepared := handler.preparedStatement(dummyConn, selectStmt.StatementID)
+	require.NotNil(prepared)
+	require.Equal("select c1 from test where c1 < ? order by c1", prepared.Query)
+
+	// The statement is executed with the values of each execution
+	require.Equal([][]sqltypes.Value{{sqltypes.NewInt32(0)}, {sqltypes.NewInt32(1)}}, execute(selectStmt, 2))
+	require.Len(execute(selectStmt, 5), 5)
+	require.Same(prepared, handler.preparedStatement(dummyConn, selectStmt.StatementID))
+
+	// Statements closed by the client are dropped once another one is prepared
+	delete(dummyConn.PrepareData, selectStmt.StatementID)
+	countStmt := prepare("select count(*) from test where c1 >= ?")
+	require.Nil(handler.preparedStatement(dummyConn, selectStmt.StatementID))
+	require.Equal([][]sqltypes.Value{{sqltypes.NewInt64(10)}}, execute(countStmt, 1000))
+
+	// Statements that fail to prepare aren't kept
+	dummyConn.StatementID++
+	_, err := handler.ComPrepare(dummyConn, "select * from nonexistent where c1 = ?")
+	require.Error(err)
+	require.Nil(handler.preparedStatement(dummyConn, dummyConn.StatementID))
+
+	handler.ConnectionClosed(dummyConn)
+	require.Nil(handler.preparedStatement(dummyConn, countStmt.StatementID))
+}
+
 type TestListener struct {
 	Connections int
 	Queries     int
@@ -374,12 +449,16 @@ func TestSchemaToFields(t *testing.T) {
 		{Name: "foo", Type: sql.Blob},
 		{Name: "bar", Type: sql.Text},
 		{Name: "baz", Type: sql.Int64},
+		{Name: "qux", Type: sql.MustCreateStringWithDefaults(sqltypes.VarChar, 10)},
+		{Name: "quux", Type: sql.MustCreateString(sqltypes.Char, 10, sql.Collation_utf8mb3_general_ci)},
 	}
 
 	expected := []*query.Field{
 		{Name: "foo", Type: query.Type_BLOB, Charset: mysql.CharacterSetBinary},
-		{Name: "bar", Type: query.Type_TEXT, Charset: mysql.CharacterSetUtf8},
+		{Name: "bar", Type: query.Type_TEXT, Charset: uint32(sql.Collation_Default.ID())},
 		{Name: "baz", Type: query.Type_INT64, Charset: mysql.CharacterSetUtf8},
+		{Name: "qux", Type: query.Type_VARCHAR, Charset: uint32(sql.Collation_Default.ID())},
+		{Name: "quux", Type: query.Type_CHAR, Charset: mysql.CharacterSetUtf8},
 	}
 
 	fields := schemaToFields(schema)
@@ -442,6 +521,50 @@ func TestHandlerTimeout(t *testing.T) {
 	require.NoError(err)
 }
 
+func TestHandlerMaxAllowedPacket(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+	handler := NewHandler(
+		e, NewSessionManager(testSessionBuilder,
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			sql.NewMemoryManager(nil),
+			sqle.NewProcessList(),
+			"foo"),
+		0,
+		false,
+		nil,
+	)
+
+	conn := newConn(1)
+	handler.NewConnection(conn)
+	handler.ComInitDB(conn, "test")
+
+	noop := func(res *sqltypes.Result, more bool) error {
+		return nil
+	}
+	err := handler.ComQuery(conn, "SET max_allowed_packet = 1024", noop)
+	require.NoError(err)
+
+	// queries larger than max_allowed_packet
+	err = handler.ComQuery(conn, fmt.Sprintf("SELECT '%s'", strings.Repeat("a", 1024)), noop)
+	require.Error(err)
+	require.Equal(mysql.ERNetPacketTooLarge, err.(*mysql.SQLError).Num)
+
+	// rows larger than max_allowed_packet
+	err = handler.ComQuery(conn, "SELECT REPEAT('a', 2048)", noop)
+	require.Error(err)
+	require.Equal(mysql.ERNetPacketTooLarge, err.(*mysql.SQLError).Num)
+
+	var rows int
+	err = handler.ComQuery(conn, "SELECT REPEAT('a', 512)", func(res *sqltypes.Result, more bool) error {
+		rows += len(res.Rows)
+		return nil
+	})
+	require.NoError(err)
+	require.Equal(1, rows)
+}
+
 func TestOkClosedConnection(t *testing.T) {
 	require := require.New(t)
 	e := setupMemDB(require)
@@ -482,6 +605,112 @@ func TestOkClosedConnection(t *testing.T) {
 	require.NoError(err)
 }
 
+func TestClientDisconnectCancelsQuery(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("socket state checks are only implemented on linux")
+	}
+
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	db, err := e.Analyzer.Catalog.Database("test")
+	require.NoError(err)
+	inner, ok, err := db.GetTableInsensitive(sql.NewEmptyContext(), "test")
+	require.NoError(err)
+	require.True(ok)
+	tracked := &iterTrackingTable{Table: inner}
+	db.(*memory.Database).AddTable("tracked", tracked)
+
+	prevSleep := tcpCheckerSleepDuration
+	tcpCheckerSleepDuration = 50 * time.Millisecond
+	defer func() {
+		tcpCheckerSleepDuration = prevSleep
+	}()
+
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(err)
+	defer l.Close()
+
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		serverSide, err := l.Accept()
+		if err == nil {
+			accepted <- serverSide
+		}
+		close(accepted)
+	}()
+
+	clientSide, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(err)
+	defer clientSide.Close()
+
+	h := NewHandler(
+		e,
+		NewSessionManager(
+			testSessionBuilder,
+			opentracing.NoopTracer{},
+			func(db string) bool { return db == "test" },
+			e.MemoryManager,
+			e.ProcessList,
+			"foo",
+		),
+		0,
+		false,
+		nil,
+	)
+	c := newConnWithNetConn(1, clientSide)
+	h.NewConnection(c)
+	require.NoError(h.ComInitDB(c, "test"))
+
+	// Hang up from the other end of the socket shortly after the query starts.
+	go func() {
+		serverSide, ok := <-accepted
+		if !ok {
+			return
+		}
+		time.Sleep(200 * time.Millisecond)
+		_ = serverSide.Close()
+	}()
+
+	// An unbounded three-way cross join over 1010 rows would scan for minutes if it weren't canceled.
+	start := time.Now()
+	err = h.ComQuery(c, "SELECT COUNT(*) FROM tracked a, tracked b, tracked c", func(res *sqltypes.Result, more bool) error {
+		return nil
+	})
+	require.Error(err)
+	require.Contains(err.Error(), "connection was closed")
+	require.Less(int64(time.Since(start)), int64(10*time.Second))
+	require.Greater(atomic.LoadInt32(&tracked.opened), int32(0))
+	require.Equal(atomic.LoadInt32(&tracked.opened), atomic.LoadInt32(&tracked.closed), "all row iterators should be closed")
+	assertNoConnProcesses(t, e, c.ConnectionID)
+}
+
+// iterTrackingTable is a table that counts how many row iterators are opened and closed on it.
+type iterTrackingTable struct {
+	sql.Table
+	opened int32
+	closed int32
+}
+
+func (t *iterTrackingTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	iter, err := t.Table.PartitionRows(ctx, partition)
+	if err != nil {
+		return nil, err
+	}
+	atomic.AddInt32(&t.opened, 1)
+	return &closeTrackingIter{RowIter: iter, closed: &t.closed}, nil
+}
+
+type closeTrackingIter struct {
+	sql.RowIter
+	closed *int32
+}
+
+func (i *closeTrackingIter) Close(ctx *sql.Context) error {
+	atomic.AddInt32(i.closed, 1)
+	return i.RowIter.Close(ctx)
+}
+
 func TestBindingsToExprs(t *testing.T) {
 	type tc struct {
 		Name     string
@@ -109,15 +109,21 @@ type mockConn struct {
 func (c *mockConn) Close() error { return nil }
 
 func newConn(id uint32) *mysql.Conn {
+	// Set conn so it does not panic when we close it
+	return newConnWithNetConn(id, new(mockConn))
+}
+
+// newConnWithNetConn returns a mysql.Conn backed by the network connection given, for tests that need the handler
+// to inspect the underlying socket.
+func newConnWithNetConn(id uint32, netConn net.Conn) *mysql.Conn {
 	conn := &mysql.Conn{
 		ConnectionID: id,
 	}
 
-	// Set conn so it does not panic when we close it
 	val := reflect.ValueOf(conn).Elem()
 	field := val.FieldByName("Conn")
 	field = reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem()
-	field.Set(reflect.ValueOf(new(mockConn)))
+	field.Set(reflect.ValueOf(netConn))
 
 	return conn
 }
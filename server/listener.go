@@ -15,12 +15,50 @@
 package server
 
 import (
+	"crypto/tls"
 	"net"
+	"os"
+	"sync"
+	"time"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
 )
 
+// ErrSocketInUse is returned when the unix socket file to listen on is in use by another process.
+var ErrSocketInUse = errors.NewKind("unix socket %s is in use by another process")
+
+// errListenerClosed is returned by Listener.Accept after the listener has been closed.
+var errListenerClosed = errors.NewKind("listener closed")
+
+// maxAcceptRetryDelay is the longest a listener waits before accepting connections again after an error.
+var maxAcceptRetryDelay = time.Second
+
+// DefaultSocketPermissions are the file permissions of the unix socket file when none are configured. This matches
+// the permissions of the socket file created by MySQL.
+const DefaultSocketPermissions os.FileMode = 0777
+
+// ListenerAddress is a protocol and address pair for the server to listen on.
+type ListenerAddress struct {
+	// Protocol for the listener, as accepted by net.Listen, e.g. "tcp" or "unix".
+	Protocol string
+	// Address of the listener.
+	Address string
+	// TLSConfig is the configuration for TLS on this listener. If |nil|, the listener uses Config.TLSConfig.
+	TLSConfig *tls.Config
+	// RequireSecureTransport requires the connections to this listener to be TLS, even if Config.RequireSecureTransport
+	// isn't set.
+	RequireSecureTransport bool
+}
+
+// Listener is a net.Listener that retries the errors of Accept, such as running out of file descriptors, with a
+// backoff until it's closed. The vitess listener that accepts its connections stops on the first error.
 type Listener struct {
 	net.Listener
-	h *Handler
+	h         *Handler
+	done      chan struct{}
+	closeOnce sync.Once
 }
 
 // NewListener creates a new Listener.
@@ -29,9 +67,138 @@ func NewListener(protocol, address string, handler *Handler) (*Listener, error)
 	if err != nil {
 		return nil, err
 	}
-	return &Listener{l, handler}, nil
+	return newListener(l, handler), nil
+}
+
+func newListener(l net.Listener, handler *Handler) *Listener {
+	return &Listener{Listener: l, h: handler, done: make(chan struct{})}
 }
 
+// Accept implements net.Listener.
 func (l *Listener) Accept() (net.Conn, error) {
-	return l.Listener.Accept()
+	var delay time.Duration
+	for {
+		conn, err := l.Listener.Accept()
+		if err == nil {
+			return conn, nil
+		}
+		select {
+		case <-l.done:
+			return nil, errListenerClosed.New()
+		default:
+		}
+
+		if delay == 0 {
+			delay = 5 * time.Millisecond
+		} else {
+			delay *= 2
+		}
+		if delay > maxAcceptRetryDelay {
+			delay = maxAcceptRetryDelay
+		}
+		sql.GetLogger().WithError(err).Warnf("error accepting connection on %s, retrying in %v", l.Addr(), delay)
+
+		select {
+		case <-time.After(delay):
+		case <-l.done:
+			return nil, errListenerClosed.New()
+		}
+	}
+}
+
+// Close implements net.Listener.
+func (l *Listener) Close() error {
+	var err error
+	l.closeOnce.Do(func() {
+		close(l.done)
+		err = l.Listener.Close()
+	})
+	return err
+}
+
+// configuredListener is a Listener for one of the addresses of a Config, along with its TLS configuration.
+type configuredListener struct {
+	*Listener
+	// tlsConfig is the configuration for TLS on the listener. If |nil|, TLS is not supported.
+	tlsConfig *tls.Config
+	// requireSecureTransport rejects the connections that don't use TLS.
+	requireSecureTransport bool
+}
+
+// newListenersForConfig creates the listeners for all the addresses in the config given. The first one is the primary
+// listener of the server.
+func newListenersForConfig(cfg Config, handler *Handler) ([]configuredListener, error) {
+	var addrs []ListenerAddress
+	if cfg.Address != "" || cfg.Socket == "" {
+		addrs = append(addrs, ListenerAddress{Protocol: cfg.Protocol, Address: cfg.Address})
+	}
+	addrs = append(addrs, cfg.AdditionalListeners...)
+
+	var listeners []configuredListener
+	closeAll := func() {
+		for _, l := range listeners {
+			_ = l.Close()
+		}
+	}
+
+	for _, addr := range addrs {
+		l, err := NewListener(addr.Protocol, addr.Address, handler)
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		tlsConfig := addr.TLSConfig
+		if tlsConfig == nil {
+			tlsConfig = cfg.TLSConfig
+		}
+		listeners = append(listeners, configuredListener{
+			Listener:               l,
+			tlsConfig:              tlsConfig,
+			requireSecureTransport: addr.RequireSecureTransport || cfg.RequireSecureTransport,
+		})
+	}
+
+	if cfg.Socket != "" {
+		perm := cfg.SocketPermissions
+		if perm == 0 {
+			perm = DefaultSocketPermissions
+		}
+		l, err := NewUnixSocketListener(cfg.Socket, perm)
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		// Like in MySQL, connections over the unix socket are secure without TLS
+		listeners = append(listeners, configuredListener{Listener: newListener(l, handler), tlsConfig: cfg.TLSConfig})
+	}
+
+	return listeners, nil
+}
+
+// NewUnixSocketListener listens on the unix domain socket at |path| and sets the permissions of the socket file to
+// |perm|. A socket file left behind by a process that is no longer listening on it is removed first, but an error is
+// returned if another process is still accepting connections on it.
+func NewUnixSocketListener(path string, perm os.FileMode) (net.Listener, error) {
+	if fi, err := os.Stat(path); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		conn, err := net.DialTimeout("unix", path, time.Second)
+		if err == nil {
+			_ = conn.Close()
+			return nil, ErrSocketInUse.New(path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(path, perm); err != nil {
+		_ = l.Close()
+		return nil, err
+	}
+
+	return l, nil
 }
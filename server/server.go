@@ -15,12 +15,15 @@
 package server
 
 import (
+	"context"
+	"strings"
 	"time"
 
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/opentracing/opentracing-go"
 
 	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/sql"
 )
 
 type ServerEventListener interface {
@@ -45,6 +48,13 @@ func NewServer(cfg Config, e *sqle.Engine, sb SessionBuilder, listener ServerEve
 		tracer = opentracing.NoopTracer{}
 	}
 
+	if cfg.Logger != nil {
+		sql.UseLogger(cfg.Logger)
+	}
+	if cfg.QueryLogRedaction != sql.QueryLogRedactNone {
+		sql.SetQueryLogRedaction(cfg.QueryLogRedaction)
+	}
+
 	if cfg.ConnReadTimeout < 0 {
 		cfg.ConnReadTimeout = 0
 	}
@@ -69,42 +79,147 @@ func NewServer(cfg Config, e *sqle.Engine, sb SessionBuilder, listener ServerEve
 		cfg.DisableClientMultiStatements,
 		listener,
 	)
-	l, err := NewListener(cfg.Protocol, cfg.Address, handler)
+	listeners, err := newListenersForConfig(cfg, handler)
 	if err != nil {
 		return nil, err
 	}
 
-	listenerCfg := mysql.ListenerConfig{
-		Listener:           l,
-		AuthServer:         e.Analyzer.Catalog.GrantTables,
-		Handler:            handler,
-		ConnReadTimeout:    cfg.ConnReadTimeout,
-		ConnWriteTimeout:   cfg.ConnWriteTimeout,
-		MaxConns:           cfg.MaxConnections,
-		ConnReadBufferSize: mysql.DefaultConnBufferSize,
+	// Each address gets its own vitess listener, so that TLS is configured for each of them, and the listeners share
+	// the connection IDs of the handler.
+	authServer := newAuthServer(e.Analyzer.Catalog.GrantTables, cfg.AuthPlugins)
+	connectionIDs := mysql.NewConnectionIDGenerator()
+	var vtListeners []*mysql.Listener
+	for _, l := range listeners {
+		listenerCfg := mysql.ListenerConfig{
+			Listener:           l.Listener,
+			AuthServer:         authServer,
+			Handler:            handler,
+			ConnReadTimeout:    cfg.ConnReadTimeout,
+			ConnWriteTimeout:   cfg.ConnWriteTimeout,
+			MaxConns:           cfg.MaxConnections,
+			ConnReadBufferSize: mysql.DefaultConnBufferSize,
+			ConnectionIDs:      connectionIDs,
+		}
+		vtListnr, err := mysql.NewListenerWithConfig(listenerCfg)
+		if err != nil {
+			for _, l := range listeners {
+				_ = l.Close()
+			}
+			return nil, err
+		}
+
+		if cfg.Version != "" {
+			vtListnr.ServerVersion = cfg.Version
+		}
+		vtListnr.TLSConfig = l.tlsConfig
+		vtListnr.RequireSecureTransport = l.requireSecureTransport
+		vtListnr.AllowClearTextWithoutTLS = cfg.AllowClearTextWithoutTLS
+		vtListeners = append(vtListeners, vtListnr)
 	}
-	vtListnr, err := mysql.NewListenerWithConfig(listenerCfg)
-	if err != nil {
-		return nil, err
-	}
-
-	if cfg.Version != "" {
-		vtListnr.ServerVersion = cfg.Version
+	vtListnr := vtListeners[0]
+
+	s := &Server{Listener: vtListnr, listeners: vtListeners, h: handler, startTime: time.Now()}
+	if cfg.AdminAddress != "" {
+		s.admin, err = newAdminServer(s, cfg.AdminAddress)
+		if err != nil {
+			s.closeListeners()
+			return nil, err
+		}
 	}
-	vtListnr.TLSConfig = cfg.TLSConfig
-	vtListnr.RequireSecureTransport = cfg.RequireSecureTransport
 
-	return &Server{Listener: vtListnr, h: handler}, nil
+	return s, nil
 }
 
 // Start starts accepting connections on the server.
 func (s *Server) Start() error {
+	if s.admin != nil {
+		go s.admin.serve()
+	}
+	s.h.mu.Lock()
+	s.started = true
+	s.h.mu.Unlock()
+	s.h.sm.adminCommands.Register(sql.AdminCommand{
+		Name:      sql.AdminCommand_Shutdown,
+		Privilege: "Shutdown_priv",
+		Run:       s.runShutdownStatement,
+	})
+	for _, l := range s.listeners[1:] {
+		go l.Accept()
+	}
 	s.Listener.Accept()
 	return nil
 }
 
+// closeListeners stops every listener of the server from accepting connections.
+func (s *Server) closeListeners() {
+	for _, l := range s.listeners {
+		l.Close()
+	}
+}
+
+// runShutdownStatement runs the SHUTDOWN statement. The statement returns right away, since the shutdown waits for
+// the queries in flight to finish, including the statement itself.
+func (s *Server) runShutdownStatement(ctx *sql.Context, args []string) error {
+	if len(args) != 0 {
+		return sql.ErrUnsupportedSyntax.New("SHUTDOWN " + strings.Join(args, " "))
+	}
+	sql.GetLogger().WithField(sqle.ConnectionIdLogField, ctx.ID()).Info("SHUTDOWN statement received, shutting down server")
+	go func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownStatementTimeout)
+		defer cancel()
+		if err := s.Shutdown(shutdownCtx); err != nil {
+			sql.GetLogger().WithError(err).Warn("error shutting down server")
+		}
+	}()
+	return nil
+}
+
 // Close closes the server connection.
 func (s *Server) Close() error {
-	s.Listener.Close()
+	s.h.mu.Lock()
+	s.started = false
+	s.h.mu.Unlock()
+	s.h.sm.adminCommands.Unregister(sql.AdminCommand_Shutdown)
+	s.closeListeners()
+	if s.admin != nil {
+		return s.admin.close()
+	}
 	return nil
 }
+
+// Shutdown gracefully shuts down the server. It stops accepting new connections, rejects new queries on existing
+// connections with ER_SERVER_SHUTDOWN and waits for the queries in flight to finish. If |ctx| is done before they
+// finish, the remaining queries are canceled. Finally every client connection is closed and its session ended,
+// as if the client had disconnected. Returns ctx.Err() if the deadline was reached before the server was drained.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.h.sm.adminCommands.Unregister(sql.AdminCommand_Shutdown)
+	for _, l := range s.listeners {
+		l.Shutdown()
+	}
+
+	drainErr := s.h.drain(ctx)
+	if drainErr != nil {
+		// Canceled queries still need a moment to unwind before their connections are closed, but we have
+		// already run out of time, so give them a fresh deadline rather than waiting forever.
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), shutdownCloseTimeout)
+		defer cancel()
+	}
+
+	closeErr := s.h.closeConnections(ctx)
+	if s.admin != nil {
+		_ = s.admin.close()
+	}
+
+	if drainErr != nil {
+		return drainErr
+	}
+	return closeErr
+}
+
+// shutdownStatementTimeout is how long the shutdown started by a SHUTDOWN statement waits for the queries in flight to
+// finish before canceling them.
+var shutdownStatementTimeout = 30 * time.Second
+
+// shutdownCloseTimeout is how long Shutdown waits for connections to close after its own deadline has passed.
+var shutdownCloseTimeout = 5 * time.Second
@@ -16,6 +16,7 @@ package server
 
 import (
 	"crypto/tls"
+	"os"
 	"time"
 
 	"github.com/dolthub/vitess/go/mysql"
@@ -26,8 +27,13 @@ import (
 
 // Server is a MySQL server for SQLe engines.
 type Server struct {
-	Listener *mysql.Listener
-	h        *Handler
+	// Listener is the listener of the primary address of the server.
+	Listener  *mysql.Listener
+	listeners []*mysql.Listener
+	h         *Handler
+	admin     *adminServer
+	startTime time.Time
+	started   bool
 }
 
 // Config for the mysql server.
@@ -36,6 +42,15 @@ type Config struct {
 	Protocol string
 	// Address of the server.
 	Address string
+	// Socket is the path of a unix domain socket the server listens on in addition to Address. If empty, no unix
+	// socket is created. If Address is empty and Socket is set, the server only listens on the socket.
+	Socket string
+	// SocketPermissions are the file permissions of the unix socket file. Defaults to DefaultSocketPermissions.
+	SocketPermissions os.FileMode
+	// AdditionalListeners are further addresses the server listens on, e.g. to serve a loopback address and an
+	// external interface from one server. Each of them can have its own TLS configuration, such as to only serve TLS
+	// on the external interface.
+	AdditionalListeners []ListenerAddress
 	// Tracer to use in the server. By default, a noop tracer will be used if
 	// no tracer is provided.
 	Tracer opentracing.Tracer
@@ -47,10 +62,18 @@ type Config struct {
 	ConnWriteTimeout time.Duration
 	// MaxConnections is the maximum number of simultaneous connections that the server will allow.
 	MaxConnections uint64
-	// TLSConfig is the configuration for TLS on this server. If |nil|, TLS is not supported.
+	// TLSConfig is the configuration for TLS on this server, for the listeners that don't have their own. If |nil|,
+	// TLS is only supported on the listeners that have their own configuration.
 	TLSConfig *tls.Config
-	// RequestSecureTransport will require incoming connections to be TLS. Requires non-|nil| TLSConfig.
+	// RequestSecureTransport will require incoming connections to every listener but the unix socket to be TLS.
+	// Requires non-|nil| TLSConfig.
 	RequireSecureTransport bool
+	// AuthPlugins are the authentication plugins of the accounts that don't use mysql_native_password, by the name of
+	// the plugin the accounts are created with, in addition to DefaultAuthPlugins.
+	AuthPlugins map[string]AuthPlugin
+	// AllowClearTextWithoutTLS allows the accounts that use AuthPlugins to authenticate over connections without TLS,
+	// where their credentials may be sent in clear text.
+	AllowClearTextWithoutTLS bool
 	// DisableClientMultiStatements will prevent processing of incoming
 	// queries as if they contain more than one query. This processing
 	// currently works in some simple cases, but breaks in the presence of
@@ -61,6 +84,15 @@ type Config struct {
 	DisableClientMultiStatements bool
 	// NoDefaults prevents using persisted configuration for new server sessions
 	NoDefaults bool
+	// AdminAddress is the TCP address of an optional HTTP listener serving health check and status endpoints:
+	// /livez, /readyz and /status. If empty, no admin listener is started.
+	AdminAddress string
+	// Logger, if set, receives the entries logged by the server and its engine, rather than logrus' standard logger.
+	// It's installed for the whole process with sql.UseLogger.
+	Logger sql.Logger
+	// QueryLogRedaction is how the text of queries is redacted in the entries logged about them. It's set for the whole
+	// process with sql.SetQueryLogRedaction.
+	QueryLogRedaction sql.QueryLogRedaction
 }
 
 func (c Config) NewConfig() (Config, error) {
@@ -0,0 +1,667 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	gosql "database/sql"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/dolthub/vitess/go/mysql"
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/require"
+
+	sqle "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func startTestServer(t *testing.T) (*Server, string) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	port, err := getFreePort()
+	require.NoError(err)
+
+	s, err := NewDefaultServer(Config{Protocol: "tcp", Address: "localhost:" + port}, e)
+	require.NoError(err)
+	go s.Start()
+
+	return s, fmt.Sprintf("root:@tcp(localhost:%s)/test", port)
+}
+
+func openTestConn(t *testing.T, dsn string) (*gosql.DB, *gosql.Conn) {
+	db, err := gosql.Open("mysql", dsn)
+	require.NoError(t, err)
+	conn, err := db.Conn(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, conn.PingContext(context.Background()))
+	return db, conn
+}
+
+func TestServerShutdownWaitsForQueries(t *testing.T) {
+	require := require.New(t)
+	s, dsn := startTestServer(t)
+
+	busyDB, busy := openTestConn(t, dsn)
+	defer busyDB.Close()
+	idleDB, idle := openTestConn(t, dsn)
+	defer idleDB.Close()
+
+	queryErr := make(chan error, 1)
+	go func() {
+		_, err := busy.ExecContext(context.Background(), "SELECT SLEEP(1)")
+		queryErr <- err
+	}()
+	time.Sleep(200 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	require.NoError(s.Shutdown(ctx))
+
+	// The query in flight ran to completion, and both connections were closed afterwards.
+	require.NoError(<-queryErr)
+	_, err := idle.ExecContext(context.Background(), "SELECT 1")
+	require.Error(err)
+	_, err = busy.ExecContext(context.Background(), "SELECT 1")
+	require.Error(err)
+	require.Empty(s.h.conns)
+
+	// No new connections are accepted.
+	db, err := gosql.Open("mysql", dsn)
+	require.NoError(err)
+	defer db.Close()
+	require.Error(db.Ping())
+}
+
+func TestServerShutdownCancelsQueriesAfterDeadline(t *testing.T) {
+	require := require.New(t)
+	s, dsn := startTestServer(t)
+
+	busyDB, busy := openTestConn(t, dsn)
+	defer busyDB.Close()
+
+	queryErr := make(chan error, 1)
+	go func() {
+		_, err := busy.ExecContext(context.Background(), "SELECT SLEEP(30)")
+		queryErr <- err
+	}()
+	time.Sleep(200 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	require.Equal(context.DeadlineExceeded, s.Shutdown(ctx))
+
+	select {
+	case err := <-queryErr:
+		require.Error(err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("query was not canceled by shutdown")
+	}
+	require.Less(int64(time.Since(start)), int64(10*time.Second))
+	require.Empty(s.h.conns)
+}
+
+func TestServerShutdownStatement(t *testing.T) {
+	require := require.New(t)
+	s, dsn := startTestServer(t)
+
+	db, conn := openTestConn(t, dsn)
+	defer db.Close()
+
+	_, err := conn.ExecContext(context.Background(), "RESET MASTER")
+	require.Error(err)
+	require.Contains(err.Error(), "RESET MASTER is not supported")
+
+	_, err = conn.ExecContext(context.Background(), "SHUTDOWN")
+	require.NoError(err)
+
+	require.Eventually(func() bool {
+		s.h.mu.Lock()
+		defer s.h.mu.Unlock()
+		return s.h.shuttingDown && len(s.h.conns) == 0
+	}, 10*time.Second, 10*time.Millisecond)
+	_, ok := s.h.sm.adminCommands.Get("shutdown")
+	require.False(ok)
+}
+
+func TestServerShutdownStatementIsPerServer(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	newServer := func() (*Server, string) {
+		port, err := getFreePort()
+		require.NoError(err)
+		s, err := NewDefaultServer(Config{Protocol: "tcp", Address: "localhost:" + port}, e)
+		require.NoError(err)
+		go s.Start()
+		return s, fmt.Sprintf("root:@tcp(localhost:%s)/test", port)
+	}
+
+	// Two servers share the engine, and closing one of them leaves the SHUTDOWN statement of the other one in place
+	closed, _ := newServer()
+	s, dsn := newServer()
+	other, otherDsn := newServer()
+	defer other.Close()
+	require.Eventually(func() bool {
+		return closed.Status().Ready
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(closed.Close())
+
+	db, conn := openTestConn(t, dsn)
+	defer db.Close()
+	otherDB, otherConn := openTestConn(t, otherDsn)
+	defer otherDB.Close()
+
+	_, err := conn.ExecContext(context.Background(), "SHUTDOWN")
+	require.NoError(err)
+	require.Eventually(func() bool {
+		s.h.mu.Lock()
+		defer s.h.mu.Unlock()
+		return s.h.shuttingDown && len(s.h.conns) == 0
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// The statement only shut down the server it was received by
+	require.True(other.Status().Ready)
+	require.NoError(otherConn.PingContext(context.Background()))
+	_, ok := other.h.sm.adminCommands.Get("shutdown")
+	require.True(ok)
+	_, ok = e.Analyzer.Catalog.AdminCommands.Get("shutdown")
+	require.False(ok)
+}
+
+func TestHandlerRejectsQueriesWhileShuttingDown(t *testing.T) {
+	require := require.New(t)
+	s, dsn := startTestServer(t)
+
+	db, conn := openTestConn(t, dsn)
+	defer db.Close()
+
+	require.NoError(s.h.drain(context.Background()))
+	_, err := conn.ExecContext(context.Background(), "SELECT 1")
+	require.Error(err)
+	require.Contains(err.Error(), "1053")
+
+	require.NoError(s.Shutdown(context.Background()))
+}
+
+func TestServerUnixSocketAndMultipleListeners(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("unix sockets are not supported on windows")
+	}
+
+	require := require.New(t)
+	e := setupMemDB(require)
+	e.Analyzer.Catalog.GrantTables.AddRootAccount()
+
+	port, err := getFreePort()
+	require.NoError(err)
+	otherPort, err := getFreePort()
+	require.NoError(err)
+
+	dir, err := ioutil.TempDir("", "gms-socket")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "mysql.sock")
+
+	s, err := NewDefaultServer(Config{
+		Protocol:            "tcp",
+		Address:             "localhost:" + port,
+		Socket:              socket,
+		SocketPermissions:   0770,
+		AdditionalListeners: []ListenerAddress{{Protocol: "tcp", Address: "localhost:" + otherPort}},
+	}, e)
+	require.NoError(err)
+	go s.Start()
+
+	fi, err := os.Stat(socket)
+	require.NoError(err)
+	require.Equal(os.FileMode(0770), fi.Mode().Perm())
+
+	// The listeners share the connection IDs of the server
+	connectionIDs := make(map[uint32]bool)
+	for _, dsn := range []string{
+		fmt.Sprintf("root:@tcp(localhost:%s)/test", port),
+		fmt.Sprintf("root:@tcp(localhost:%s)/test", otherPort),
+		fmt.Sprintf("root:@unix(%s)/test", socket),
+	} {
+		db, conn := openTestConn(t, dsn)
+		defer db.Close()
+		var count int
+		require.NoError(conn.QueryRowContext(context.Background(), "SELECT COUNT(*) FROM test").Scan(&count), dsn)
+		require.Equal(1010, count)
+		var id uint32
+		require.NoError(conn.QueryRowContext(context.Background(), "SELECT CONNECTION_ID()").Scan(&id), dsn)
+		require.False(connectionIDs[id], dsn)
+		connectionIDs[id] = true
+		require.NoError(conn.Close())
+	}
+
+	// A second server can't take over a socket that is still being served.
+	_, err = NewUnixSocketListener(socket, DefaultSocketPermissions)
+	require.True(ErrSocketInUse.Is(err))
+
+	require.NoError(s.Shutdown(context.Background()))
+	_, err = os.Stat(socket)
+	require.True(os.IsNotExist(err), "socket file should be removed on shutdown")
+}
+
+// flakyListener is a net.Listener whose first calls to Accept fail.
+type flakyListener struct {
+	net.Listener
+	failures int
+}
+
+func (l *flakyListener) Accept() (net.Conn, error) {
+	if l.failures > 0 {
+		l.failures--
+		return nil, fmt.Errorf("accept: too many open files")
+	}
+	return l.Listener.Accept()
+}
+
+func TestListenerRetriesAcceptErrors(t *testing.T) {
+	require := require.New(t)
+
+	flaky, err := net.Listen("tcp", "localhost:0")
+	require.NoError(err)
+	l := newListener(&flakyListener{Listener: flaky, failures: 3}, nil)
+
+	// The errors aren't returned, and the listener keeps accepting connections
+	client, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(err)
+	conn, err := l.Accept()
+	require.NoError(err)
+	require.Equal(client.LocalAddr().String(), conn.RemoteAddr().String())
+	require.NoError(conn.Close())
+	require.NoError(client.Close())
+
+	require.NoError(l.Close())
+	_, err = l.Accept()
+	require.True(errListenerClosed.Is(err))
+}
+
+// newTestTLSConfig returns a TLS configuration with a self-signed certificate for localhost.
+func newTestTLSConfig(t *testing.T) *tls.Config {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	return &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+}
+
+func mustAtoi(t *testing.T, s string) int {
+	i, err := strconv.Atoi(s)
+	require.NoError(t, err)
+	return i
+}
+
+func TestServerListenerTLS(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	port, err := getFreePort()
+	require.NoError(err)
+	tlsPort, err := getFreePort()
+	require.NoError(err)
+
+	// Plaintext on the first address, and only TLS on the other one
+	s, err := NewDefaultServer(Config{
+		Protocol: "tcp",
+		Address:  "localhost:" + port,
+		AdditionalListeners: []ListenerAddress{{
+			Protocol:               "tcp",
+			Address:                "localhost:" + tlsPort,
+			TLSConfig:              newTestTLSConfig(t),
+			RequireSecureTransport: true,
+		}},
+	}, e)
+	require.NoError(err)
+	go s.Start()
+	defer s.Close()
+
+	ping := func(port string, tls string) error {
+		db, err := gosql.Open("mysql", fmt.Sprintf("root:@tcp(localhost:%s)/test?tls=%s", port, tls))
+		require.NoError(err)
+		defer db.Close()
+		return db.Ping()
+	}
+
+	// TLS isn't advertised on the plaintext listener, so clients that prefer it connect without it
+	require.NoError(ping(port, "false"))
+	require.NoError(ping(port, "preferred"))
+	require.Error(ping(port, "skip-verify"))
+
+	require.NoError(ping(tlsPort, "skip-verify"))
+	err = ping(tlsPort, "false")
+	require.Error(err)
+	require.Contains(err.Error(), "3159")
+}
+
+func TestServerProtocolCommands(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	port, err := getFreePort()
+	require.NoError(err)
+	tlsPort, err := getFreePort()
+	require.NoError(err)
+	tlsConfig := newTestTLSConfig(t)
+	s, err := NewDefaultServer(Config{
+		Protocol:            "tcp",
+		Address:             "localhost:" + port,
+		AdditionalListeners: []ListenerAddress{{Protocol: "tcp", Address: "localhost:" + tlsPort, TLSConfig: tlsConfig}},
+	}, e)
+	require.NoError(err)
+	go s.Start()
+	defer s.Close()
+
+	ca := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsConfig.Certificates[0].Certificate[0]})
+	require.NoError(ioutil.WriteFile(ca, certPEM, 0600))
+
+	plainParams := &mysql.ConnParams{Host: "localhost", Port: mustAtoi(t, port), Uname: "root", DbName: "test"}
+	tlsParams := &mysql.ConnParams{Host: "localhost", Port: mustAtoi(t, tlsPort), Uname: "root", DbName: "test", SslCa: ca}
+	tlsParams.EnableSSL()
+
+	statsRegex := regexp.MustCompile(`^Uptime: \d+  Threads: \d+  Questions: \d+  Slow queries: (\d+)  Opens: (\d+)  Flush tables: (\d+)  Open tables: (\d+)  Queries per second avg: \d+\.\d{3}$`)
+
+	for _, params := range []*mysql.ConnParams{plainParams, tlsParams} {
+		c, err := mysql.Connect(context.Background(), params)
+		require.NoError(err)
+		require.Equal(params.SslEnabled(), c.Capabilities&mysql.CapabilityClientSSL != 0)
+		require.NoError(c.Ping())
+
+		// writeCommand sends a command packet with no arguments and returns the payload of the response.
+		writeCommand := func(cmd byte) []byte {
+			_, err := c.Conn.Write([]byte{1, 0, 0, 0, cmd})
+			require.NoError(err)
+			header := make([]byte, 4)
+			_, err = io.ReadFull(c.Conn, header)
+			require.NoError(err)
+			require.Equal(byte(1), header[3])
+			payload := make([]byte, int(header[0])|int(header[1])<<8|int(header[2])<<16)
+			_, err = io.ReadFull(c.Conn, payload)
+			require.NoError(err)
+			return payload
+		}
+		// statistics returns the slow queries, opens, flush tables and open tables of COM_STATISTICS.
+		statistics := func() []int {
+			stats := string(writeCommand(mysql.ComStatistics))
+			m := statsRegex.FindStringSubmatch(stats)
+			require.NotNil(m, stats)
+			counters := make([]int, 4)
+			for i := range counters {
+				counters[i] = mustAtoi(t, m[i+1])
+			}
+			return counters
+		}
+
+		// The counters come from the status variables
+		_, err = c.ExecuteFetch("FLUSH TABLES", 1, false)
+		require.NoError(err)
+		before := statistics()
+		require.Zero(before[3])
+		_, err = c.ExecuteFetch("SET long_query_time = 0", 1, false)
+		require.NoError(err)
+		res, err := c.ExecuteFetch("SELECT COUNT(*) FROM test", 1, false)
+		require.NoError(err)
+		require.Equal("1010", res.Rows[0][0].ToString())
+		after := statistics()
+		require.Greater(after[0], before[0])
+		require.Greater(after[1], before[1])
+		require.Equal(1, after[3])
+		_, err = c.ExecuteFetch("FLUSH TABLES", 1, false)
+		require.NoError(err)
+		flushed := statistics()
+		require.Equal(after[2]+1, flushed[2])
+		require.Zero(flushed[3])
+
+		debug := writeCommand(mysql.ComDebug)
+		require.Equal(byte(mysql.EOFPacket), debug[0])
+
+		// The connection is still usable afterwards
+		res, err = c.ExecuteFetch("SELECT COUNT(*) FROM test", 1, false)
+		require.NoError(err)
+		require.Equal("1010", res.Rows[0][0].ToString())
+		require.NoError(c.Ping())
+		c.Close()
+	}
+
+	// Ping fails once the server begins to shut down
+	c, err := mysql.Connect(context.Background(), plainParams)
+	require.NoError(err)
+	defer c.Close()
+	s.Listener.Shutdown()
+	require.Error(c.Ping())
+}
+
+func TestServerCompletesStatementChanges(t *testing.T) {
+	require := require.New(t)
+	s, dsn := startTestServer(t)
+	defer s.Close()
+
+	db, conn := openTestConn(t, dsn)
+	defer db.Close()
+	ctx := context.Background()
+
+	// The changes of a statement are completed once it's done, even though the query's context is canceled by then
+	_, err := conn.ExecContext(ctx, "CREATE TABLE completed (id BIGINT PRIMARY KEY)")
+	require.NoError(err)
+	res, err := conn.ExecContext(ctx, "INSERT INTO completed VALUES (1), (2)")
+	require.NoError(err)
+	affected, err := res.RowsAffected()
+	require.NoError(err)
+	require.Equal(int64(2), affected)
+
+	var count int
+	require.NoError(conn.QueryRowContext(ctx, "SELECT COUNT(*) FROM completed").Scan(&count))
+	require.Equal(2, count)
+}
+
+func TestServerPreparedStatements(t *testing.T) {
+	require := require.New(t)
+	s, dsn := startTestServer(t)
+	defer s.Close()
+
+	// Without interpolateParams, the driver prepares statements with arguments on the server, and executes them with
+	// the binary protocol.
+	db, conn := openTestConn(t, dsn)
+	defer db.Close()
+	ctx := context.Background()
+
+	_, err := conn.ExecContext(ctx, "CREATE TABLE prepared (id BIGINT PRIMARY KEY, name VARCHAR(20), created DATETIME, score DOUBLE, data BLOB)")
+	require.NoError(err)
+
+	insert, err := conn.PrepareContext(ctx, "INSERT INTO prepared VALUES (?, ?, ?, ?, ?)")
+	require.NoError(err)
+	created := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+	for i := 1; i <= 3; i++ {
+		res, err := insert.ExecContext(ctx, i, fmt.Sprintf("name%d", i), created, float64(i)/2, []byte{byte(i), 0})
+		require.NoError(err)
+		affected, err := res.RowsAffected()
+		require.NoError(err)
+		require.Equal(int64(1), affected)
+	}
+	require.NoError(insert.Close())
+
+	sel, err := conn.PrepareContext(ctx, "SELECT id, name, created, score, data FROM prepared WHERE id >= ? ORDER BY id")
+	require.NoError(err)
+	defer sel.Close()
+	for _, from := range []int{2, 3} {
+		rows, err := sel.QueryContext(ctx, from)
+		require.NoError(err)
+		var ids []int64
+		for rows.Next() {
+			var id int64
+			var name, created string
+			var score float64
+			var data []byte
+			require.NoError(rows.Scan(&id, &name, &created, &score, &data))
+			require.Equal(fmt.Sprintf("name%d", id), name)
+			require.Equal("2022-01-02 03:04:05", created)
+			require.Equal(float64(id)/2, score)
+			require.Equal([]byte{byte(id), 0}, data)
+			ids = append(ids, id)
+		}
+		require.NoError(rows.Err())
+		require.NoError(rows.Close())
+		require.Len(ids, 4-from)
+		require.Equal(int64(from), ids[0])
+	}
+
+	// NULL parameters, and errors of executions
+	var name gosql.NullString
+	require.NoError(conn.QueryRowContext(ctx, "SELECT ? FROM prepared WHERE id = ?", nil, 1).Scan(&name))
+	require.False(name.Valid)
+	_, err = conn.ExecContext(ctx, "INSERT INTO prepared (id) VALUES (?)", 1)
+	require.Error(err)
+}
+
+// patternReader reads |size| bytes of lowercase letters, recording the largest buffer it's asked to fill.
+type patternReader struct {
+	pos, size int64
+	maxRead   *int
+}
+
+func (r *patternReader) Read(p []byte) (int, error) {
+	if len(p) > *r.maxRead {
+		*r.maxRead = len(p)
+	}
+	if r.pos == r.size {
+		return 0, io.EOF
+	}
+	if int64(len(p)) > r.size-r.pos {
+		p = p[:r.size-r.pos]
+	}
+	for i := range p {
+		p[i] = byte('a' + (r.pos+int64(i))%26)
+	}
+	r.pos += int64(len(p))
+	return len(p), nil
+}
+
+func patternLob(size int64, maxRead *int) sql.LobValue {
+	return sql.NewLobValue(size, func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(&patternReader{size: size, maxRead: maxRead}), nil
+	})
+}
+
+func TestServerStreamsLobValues(t *testing.T) {
+	require := require.New(t)
+
+	// Larger than a packet, so that its row is split in several
+	const size = 20 << 20
+	var maxRead int
+	lobs := memory.NewTable("lobs", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "pk", Type: sql.Int64, Source: "lobs", PrimaryKey: true},
+		{Name: "b", Type: sql.LongBlob, Source: "lobs", Nullable: true},
+		{Name: "t", Type: sql.LongText, Source: "lobs", Nullable: true},
+	}))
+	require.NoError(lobs.Insert(sql.NewEmptyContext(), sql.NewRow(int64(1), patternLob(size, &maxRead), patternLob(3, &maxRead))))
+	require.NoError(lobs.Insert(sql.NewEmptyContext(), sql.NewRow(int64(2), patternLob(5, &maxRead), nil)))
+	db := memory.NewDatabase("test")
+	db.AddTable("lobs", lobs)
+	e := sqle.NewDefault(memory.NewMemoryDBProvider(db))
+	expected := make([]byte, size)
+	_, err := io.ReadFull(&patternReader{size: size, maxRead: new(int)}, expected)
+	require.NoError(err)
+
+	port, err := getFreePort()
+	require.NoError(err)
+	tlsPort, err := getFreePort()
+	require.NoError(err)
+	s, err := NewDefaultServer(Config{
+		Protocol:            "tcp",
+		Address:             "localhost:" + port,
+		AdditionalListeners: []ListenerAddress{{Protocol: "tcp", Address: "localhost:" + tlsPort, TLSConfig: newTestTLSConfig(t)}},
+	}, e)
+	require.NoError(err)
+	go s.Start()
+	defer s.Close()
+
+	for _, dsn := range []string{
+		fmt.Sprintf("root:@tcp(localhost:%s)/test", port),
+		fmt.Sprintf("root:@tcp(localhost:%s)/test?tls=skip-verify", tlsPort),
+	} {
+		db, conn := openTestConn(t, dsn)
+		// Both the text protocol, and the binary protocol of prepared statements
+		for _, args := range [][]interface{}{nil, {0}} {
+			maxRead = 0
+			query := "SELECT pk, b, t FROM lobs ORDER BY pk"
+			if args != nil {
+				query = "SELECT pk, b, t FROM lobs WHERE pk > ? ORDER BY pk"
+			}
+			rows, err := conn.QueryContext(context.Background(), query, args...)
+			require.NoError(err, dsn)
+
+			var pk int64
+			var b []byte
+			var text gosql.NullString
+			require.True(rows.Next())
+			require.NoError(rows.Scan(&pk, &b, &text))
+			require.Equal(int64(1), pk)
+			require.True(bytes.Equal(expected, b), dsn)
+			require.Equal(gosql.NullString{String: "abc", Valid: true}, text)
+			require.True(rows.Next())
+			require.NoError(rows.Scan(&pk, &b, &text))
+			require.Equal(int64(2), pk)
+			require.Equal([]byte("abcde"), b)
+			require.False(text.Valid)
+			require.False(rows.Next())
+			require.NoError(rows.Err())
+			require.NoError(rows.Close())
+
+			// The values are streamed rather than read whole
+			require.NotZero(maxRead)
+			require.Less(maxRead, size)
+
+			// The packets that follow are numbered in sequence
+			var count int
+			require.NoError(conn.QueryRowContext(context.Background(), "SELECT COUNT(*) FROM lobs").Scan(&count))
+			require.Equal(2, count)
+			require.NoError(conn.PingContext(context.Background()))
+		}
+		require.NoError(conn.Close())
+		require.NoError(db.Close())
+	}
+}
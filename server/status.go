@@ -0,0 +1,123 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Status is a snapshot of the state of a running server, as reported by the /status endpoint of the admin listener.
+type Status struct {
+	// Version is the server version advertised to clients.
+	Version string `json:"version"`
+	// Uptime is the number of seconds since the server was created.
+	Uptime int64 `json:"uptime_seconds"`
+	// Ready is whether the server is accepting connections and queries.
+	Ready bool `json:"ready"`
+	// Connections is the number of client connections currently open.
+	Connections int `json:"connections"`
+	// TotalConnections is the number of client connections opened since the engine of the server was created, which is
+	// the Connections status variable.
+	TotalConnections uint64 `json:"total_connections"`
+	// QueriesInFlight is the number of queries currently being executed.
+	QueriesInFlight int `json:"queries_in_flight"`
+}
+
+// adminServer serves the health check and status HTTP endpoints of a Server.
+type adminServer struct {
+	listener net.Listener
+	http     *http.Server
+}
+
+// newAdminServer creates the admin HTTP server for |s|, listening on |address|.
+func newAdminServer(s *Server, address string) (*adminServer, error) {
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	// The process is alive as long as it can answer HTTP requests.
+	mux.HandleFunc("/livez", func(w http.ResponseWriter, r *http.Request) {
+		writeHealth(w, true)
+	})
+	// The server is ready while it accepts connections, and stops being ready as soon as it begins to shut down so
+	// that load balancers stop routing new clients to it.
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		writeHealth(w, s.Status().Ready)
+	})
+	mux.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(s.Status()); err != nil {
+			sql.GetLogger().WithError(err).Warn("error writing server status")
+		}
+	})
+
+	return &adminServer{
+		listener: l,
+		http:     &http.Server{Handler: mux},
+	}, nil
+}
+
+func writeHealth(w http.ResponseWriter, ok bool) {
+	w.Header().Set("Content-Type", "text/plain")
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("unavailable\n"))
+		return
+	}
+	w.Write([]byte("ok\n"))
+}
+
+func (a *adminServer) serve() {
+	if err := a.http.Serve(a.listener); err != nil && err != http.ErrServerClosed {
+		sql.GetLogger().WithError(err).Error("admin server stopped")
+	}
+}
+
+// close stops the admin server. The listener is closed explicitly, since http.Server only closes the listeners it's
+// serving, and the server isn't serving any before Start.
+func (a *adminServer) close() error {
+	err := a.http.Close()
+	_ = a.listener.Close()
+	return err
+}
+
+// Status returns a snapshot of the current state of the server.
+func (s *Server) Status() Status {
+	s.h.mu.Lock()
+	defer s.h.mu.Unlock()
+	return Status{
+		Version:          s.Listener.ServerVersion,
+		Uptime:           int64(time.Since(s.startTime) / time.Second),
+		Ready:            s.started && !s.h.shuttingDown,
+		Connections:      len(s.h.conns),
+		TotalConnections: uint64(s.h.e.StatusVariables.GlobalValue("Connections")),
+		QueriesInFlight:  s.h.inFlight,
+	}
+}
+
+// AdminAddr returns the address of the admin HTTP listener, or nil if the server has none.
+func (s *Server) AdminAddr() net.Addr {
+	if s.admin == nil {
+		return nil
+	}
+	return s.admin.listener.Addr()
+}
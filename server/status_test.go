@@ -0,0 +1,138 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	gosql "database/sql"
+	"encoding/json"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminEndpoints(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	port, err := getFreePort()
+	require.NoError(err)
+
+	s, err := NewDefaultServer(Config{
+		Protocol:     "tcp",
+		Address:      "localhost:" + port,
+		Version:      "8.0.0-test",
+		AdminAddress: "localhost:0",
+	}, e)
+	require.NoError(err)
+	require.NotNil(s.AdminAddr())
+	baseURL := "http://" + s.AdminAddr().String()
+
+	get := func(path string) *http.Response {
+		resp, err := http.Get(baseURL + path)
+		require.NoError(err)
+		return resp
+	}
+
+	go s.Start()
+	require.Eventually(func() bool {
+		resp, err := http.Get(baseURL + "/readyz")
+		if err != nil {
+			return false
+		}
+		resp.Body.Close()
+		return resp.StatusCode == http.StatusOK
+	}, 5*time.Second, 10*time.Millisecond)
+
+	resp := get("/livez")
+	require.Equal(http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	db, err := gosql.Open("mysql", "root:@tcp(localhost:"+port+")/test")
+	require.NoError(err)
+	defer db.Close()
+	require.NoError(db.Ping())
+
+	resp = get("/status")
+	require.Equal(http.StatusOK, resp.StatusCode)
+	var status Status
+	require.NoError(json.NewDecoder(resp.Body).Decode(&status))
+	resp.Body.Close()
+	require.Equal("8.0.0-test", status.Version)
+	require.True(status.Ready)
+	require.Equal(1, status.Connections)
+	require.Equal(uint64(1), status.TotalConnections)
+	require.Equal(0, status.QueriesInFlight)
+
+	// Once shutdown begins the server is no longer ready, but it is still alive until it finishes.
+	require.NoError(s.h.drain(context.Background()))
+	resp = get("/readyz")
+	require.Equal(http.StatusServiceUnavailable, resp.StatusCode)
+	resp.Body.Close()
+	resp = get("/livez")
+	require.Equal(http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	require.NoError(s.Shutdown(context.Background()))
+	_, err = http.Get(baseURL + "/livez")
+	require.Error(err)
+}
+
+func TestAdminListenerClosedWithoutStart(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	port, err := getFreePort()
+	require.NoError(err)
+
+	s, err := NewDefaultServer(Config{
+		Protocol:     "tcp",
+		Address:      "localhost:" + port,
+		AdminAddress: "localhost:0",
+	}, e)
+	require.NoError(err)
+	addr := s.AdminAddr().String()
+
+	// The admin listener is closed even though it was never served
+	require.NoError(s.Close())
+	l, err := net.Listen("tcp", addr)
+	require.NoError(err)
+	require.NoError(l.Close())
+	require.False(s.Status().Ready)
+}
+
+func TestNotReadyAfterClose(t *testing.T) {
+	require := require.New(t)
+	e := setupMemDB(require)
+
+	port, err := getFreePort()
+	require.NoError(err)
+
+	s, err := NewDefaultServer(Config{
+		Protocol: "tcp",
+		Address:  "localhost:" + port,
+	}, e)
+	require.NoError(err)
+	go s.Start()
+	require.Eventually(func() bool {
+		return s.Status().Ready
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(s.Close())
+	require.False(s.Status().Ready)
+}
@@ -0,0 +1,60 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// slowQueryIter wraps the rows of a statement, and counts the statement in the Slow_queries status variable once
+// they're closed if it took longer than the long_query_time of the session, in seconds, like MySQL does.
+type slowQueryIter struct {
+	childIter     sql.RowIter
+	started       time.Time
+	longQueryTime time.Duration
+}
+
+var _ sql.RowIter = (*slowQueryIter)(nil)
+
+// newSlowQueryIter returns a slowQueryIter for the rows given of a statement that started running at the time given.
+func newSlowQueryIter(ctx *sql.Context, started time.Time, iter sql.RowIter) (*slowQueryIter, error) {
+	val, err := ctx.GetSessionVariable(ctx, "long_query_time")
+	if err != nil {
+		return nil, err
+	}
+	val, err = sql.Float64.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return &slowQueryIter{
+		childIter:     iter,
+		started:       started,
+		longQueryTime: time.Duration(val.(float64) * float64(time.Second)),
+	}, nil
+}
+
+func (s *slowQueryIter) Next(ctx *sql.Context) (sql.Row, error) {
+	return s.childIter.Next(ctx)
+}
+
+func (s *slowQueryIter) Close(ctx *sql.Context) error {
+	err := s.childIter.Close(ctx)
+	if time.Since(s.started) > s.longQueryTime {
+		ctx.StatusVariables().Increment(ctx, "Slow_queries", 1)
+	}
+	return err
+}
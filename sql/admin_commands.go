@@ -0,0 +1,99 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// AdminCommand_Shutdown is the name of the command run by the SHUTDOWN statement.
+	AdminCommand_Shutdown = "SHUTDOWN"
+	// AdminCommand_ResetMaster is the name of the command run by the RESET MASTER statement.
+	AdminCommand_ResetMaster = "RESET MASTER"
+	// AdminCommand_ResetReplica is the name of the command run by the RESET REPLICA and RESET SLAVE statements.
+	AdminCommand_ResetReplica = "RESET REPLICA"
+)
+
+// AdminCommand is a server administration command, such as the ones run by the SHUTDOWN and RESET statements. The
+// engine doesn't implement any of them itself, since they act on the server or on subsystems such as replication that
+// are provided by the integrator or the server package, which register them with AdminCommands. The commands that act
+// on a single server, such as SHUTDOWN, are registered with the AdminCommands of the Services of its queries instead.
+type AdminCommand struct {
+	// Name is the name of the command in upper case, such as AdminCommand_Shutdown.
+	Name string
+	// Privilege is the column of the mysql.user table of the privilege required to run the command, such as
+	// "Shutdown_priv". Accounts with the SUPER privilege can run every command, and only they can run the commands
+	// without a privilege.
+	Privilege string
+	// Run runs the command with the arguments of its statement, which are the words following its name.
+	Run func(ctx *Context, args []string) error
+}
+
+// AdminCommandRunner runs admin commands for the statements that invoke them.
+type AdminCommandRunner interface {
+	// RunAdminCommand runs the admin command with the name given, after checking that the account of the context has
+	// the privilege it requires.
+	RunAdminCommand(ctx *Context, name string, args []string) error
+}
+
+// AdminCommands holds the admin commands that can be run. Commands can be registered and unregistered at any time, so
+// that a server that is shut down and started again registers its commands anew rather than leaving the old ones
+// behind.
+type AdminCommands struct {
+	mu       sync.RWMutex
+	commands map[string]AdminCommand
+}
+
+// NewAdminCommands returns a new, empty set of admin commands.
+func NewAdminCommands() *AdminCommands {
+	return &AdminCommands{commands: make(map[string]AdminCommand)}
+}
+
+// Register adds the command given, replacing any command with the same name.
+func (c *AdminCommands) Register(cmd AdminCommand) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.commands[strings.ToUpper(cmd.Name)] = cmd
+}
+
+// Unregister removes the command with the name given, if any.
+func (c *AdminCommands) Unregister(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.commands, strings.ToUpper(name))
+}
+
+// Get returns the command with the name given, case-insensitive.
+func (c *AdminCommands) Get(name string) (AdminCommand, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	cmd, ok := c.commands[strings.ToUpper(name)]
+	return cmd, ok
+}
+
+// Names returns the names of every registered command, sorted.
+func (c *AdminCommands) Names() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	names := make([]string, 0, len(c.commands))
+	for name := range c.commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
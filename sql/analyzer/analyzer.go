@@ -19,6 +19,7 @@ import (
 	"os"
 	"reflect"
 	"strings"
+	"time"
 
 	opentracing "github.com/opentracing/opentracing-go"
 	"github.com/pmezard/go-difflib/difflib"
@@ -33,7 +34,11 @@ const debugAnalyzerKey = "DEBUG_ANALYZER"
 const maxAnalysisIterations = 8
 
 // ErrMaxAnalysisIters is thrown when the analysis iterations are exceeded
-var ErrMaxAnalysisIters = errors.NewKind("exceeded max analysis iterations (%d)")
+var ErrMaxAnalysisIters = errors.NewKind("exceeded max analysis iterations (%d) of batch %s; rules still changing the plan: %s")
+
+// ErrAnalysisCycle is thrown when the rules of a batch change the plan back to one of an earlier iteration, so that
+// the batch can never converge
+var ErrAnalysisCycle = errors.NewKind("analysis of batch %s cycles between %d plans without converging; rules changing the plan: %s")
 
 // ErrInAnalysis is thrown for generic analyzer errors
 var ErrInAnalysis = errors.NewKind("error in analysis: %s")
@@ -55,6 +60,8 @@ type Builder struct {
 	provider            sql.DatabaseProvider
 	debug               bool
 	parallelism         int
+	deterministicOrder  bool
+	slowAnalysis        time.Duration
 }
 
 // NewBuilder creates a new Builder from a specific catalog.
@@ -83,6 +90,29 @@ func (ab *Builder) WithParallelism(parallelism int) *Builder {
 	return ab
 }
 
+// WithDeterministicOrder makes the analyzer plan parallel queries so that they return their rows in the same order
+// serial queries do, given tables that return their partitions in a stable order. This is meant for tests, which can
+// then compare the results of queries without an ORDER BY row for row.
+func (ab *Builder) WithDeterministicOrder() *Builder {
+	ab.deterministicOrder = true
+	return ab
+}
+
+// WithSlowAnalysisThreshold sets the time after which the analysis of a query is logged as slow on the analyzer.
+func (ab *Builder) WithSlowAnalysisThreshold(threshold time.Duration) *Builder {
+	ab.slowAnalysis = threshold
+	return ab
+}
+
+// WithEngineConfig sets the settings of the analyzer in the engine config given.
+func (ab *Builder) WithEngineConfig(cfg *sql.EngineConfig) *Builder {
+	ab.debug = ab.debug || cfg.DebugAnalyzer
+	ab.parallelism = cfg.Parallelism
+	ab.deterministicOrder = cfg.DeterministicOrder
+	ab.slowAnalysis = cfg.SlowAnalysisThreshold
+	return ab
+}
+
 // AddPreAnalyzeRule adds a new rule to the analyze before the standard analyzer rules.
 func (ab *Builder) AddPreAnalyzeRule(name string, fn RuleFunc) *Builder {
 	ab.preAnalyzeRules = append(ab.preAnalyzeRules, Rule{name, fn})
@@ -248,6 +278,9 @@ func (ab *Builder) Build() *Analyzer {
 		Catalog:        NewCatalog(ab.provider),
 		Parallelism:    ab.parallelism,
 		ProcedureCache: NewProcedureCache(),
+
+		DeterministicOrder:    ab.deterministicOrder,
+		SlowAnalysisThreshold: ab.slowAnalysis,
 	}
 }
 
@@ -261,12 +294,18 @@ type Analyzer struct {
 	// A stack of debugger context. See PushDebugContext, PopDebugContext
 	contextStack []string
 	Parallelism  int
+	// DeterministicOrder is whether parallel queries return their rows in the same order serial queries do, rather
+	// than in the order the partitions of their tables are read in.
+	DeterministicOrder bool
 	// Batches of Rules to apply.
 	Batches []*Batch
 	// Catalog of databases and registered functions.
 	Catalog *Catalog
 	// ProcedureCache is a cache of stored procedures.
 	ProcedureCache *ProcedureCache
+	// SlowAnalysisThreshold, if not zero, is the time after which the analysis of a query is logged as a warning,
+	// along with its slowest rules.
+	SlowAnalysisThreshold time.Duration
 }
 
 // NewDefault creates a default Analyzer instance with all default Rules and configuration.
@@ -365,10 +404,29 @@ func (a *Analyzer) analyzeThroughBatch(ctx *sql.Context, n sql.Node, scope *Scop
 	})
 }
 
+// AnalyzeWithTimings analyzes the node given as Analyze does, and returns the time spent by each rule of the analysis
+// along with its result.
+func (a *Analyzer) AnalyzeWithTimings(ctx *sql.Context, n sql.Node, scope *Scope) (sql.Node, *AnalysisTimings, error) {
+	timings := newAnalysisTimings()
+	n, err := a.analyzeWithTimings(ctx, timings, n, scope, analyzeAll)
+	return n, timings, err
+}
+
 func (a *Analyzer) analyzeWithSelector(ctx *sql.Context, n sql.Node, scope *Scope, selector func(d string) bool) (sql.Node, error) {
+	// The analyses of subqueries and other nodes analyzed by rules are timed with the query they're part of
+	if timingsFromContext(ctx) == nil {
+		return a.analyzeWithTimings(ctx, newAnalysisTimings(), n, scope, selector)
+	}
+
 	span, ctx := ctx.Span("analyze", opentracing.Tags{
 		//"plan": , n.String(),
 	})
+	defer func() {
+		if n != nil {
+			span.SetTag("IsResolved", n.Resolved())
+		}
+		span.Finish()
+	}()
 
 	var err error
 	a.Log("starting analysis of node of type: %T", n)
@@ -385,13 +443,26 @@ func (a *Analyzer) analyzeWithSelector(ctx *sql.Context, n sql.Node, scope *Scop
 		}
 	}
 
-	defer func() {
-		if n != nil {
-			span.SetTag("IsResolved", n.Resolved())
-		}
-		span.Finish()
-	}()
+	return n, err
+}
 
+// analyzeWithTimings analyzes the node given, recording the time spent by each rule in the timings given. Analyses that
+// take longer than the SlowAnalysisThreshold are logged, unless they're part of the analysis of another node. The
+// tables of the catalog are pinned for the whole analysis of a query by a catalogSnapshot.
+func (a *Analyzer) analyzeWithTimings(ctx *sql.Context, timings *AnalysisTimings, n sql.Node, scope *Scope, selector func(d string) bool) (sql.Node, error) {
+	nested := timingsFromContext(ctx) != nil
+	if catalogSnapshotFromContext(ctx) == nil {
+		ctx = withCatalogSnapshot(ctx, newCatalogSnapshot())
+	}
+	start := time.Now()
+	n, err := a.analyzeWithSelector(withTimings(ctx, timings), n, scope, selector)
+	total := timings.finish(time.Since(start))
+
+	if !nested && a.SlowAnalysisThreshold > 0 && total > a.SlowAnalysisThreshold {
+		ctx.GetLogger().WithField("query", sql.RedactQueryForLog(ctx.Query())).Warnf(
+			"analysis took %s, longer than the threshold of %s; slowest rules: %s",
+			total, a.SlowAnalysisThreshold, timings.slowestRules())
+	}
 	return n, err
 }
 
@@ -17,7 +17,9 @@ package analyzer
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -62,6 +64,7 @@ func TestMaxIterations(t *testing.T) {
 	analyzed, err := a.Analyze(ctx, notAnalyzed, nil)
 	require.Error(err)
 	require.True(ErrMaxAnalysisIters.Is(err))
+	require.Contains(err.Error(), "rules still changing the plan: loop")
 	require.Equal(
 		plan.NewResolvedTable(memory.NewTable("mytable-8", sql.NewPrimaryKeySchema(sql.Schema{
 			{Name: "i", Type: sql.Int32, Source: "mytable-8"},
@@ -72,6 +75,91 @@ func TestMaxIterations(t *testing.T) {
 	require.Equal(maxAnalysisIterations, count)
 }
 
+func TestAnalysisCycle(t *testing.T) {
+	require := require.New(t)
+	schema := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: sql.Int32, Source: "t1"},
+	})
+	t1 := memory.NewTable("t1", schema)
+	t2 := memory.NewTable("t2", schema)
+	db := memory.NewDatabase("mydb")
+	db.AddTable("t1", t1)
+
+	count := 0
+	a := withoutProcessTracking(NewBuilder(sql.NewDatabaseProvider(db)).AddPostAnalyzeRule("swap",
+		func(c *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+			rt, ok := n.(*plan.ResolvedTable)
+			if !ok {
+				return n, nil
+			}
+			count++
+			if rt.Name() == "t1" {
+				return plan.NewResolvedTable(t2, nil, nil), nil
+			}
+			return plan.NewResolvedTable(t1, nil, nil), nil
+		}).Build())
+
+	ctx := sql.NewContext(context.Background()).WithCurrentDB("mydb")
+	_, err := a.Analyze(ctx, plan.NewUnresolvedTable("t1", ""), nil)
+	require.Error(err)
+	require.True(ErrAnalysisCycle.Is(err), "unexpected error %v", err)
+	require.Contains(err.Error(), "cycles between 2 plans")
+	require.Contains(err.Error(), "rules changing the plan: swap")
+	// The cycle is detected before the max number of iterations
+	require.Equal(3, count)
+}
+
+func TestAnalyzeWithTimings(t *testing.T) {
+	require := require.New(t)
+	tName := "mytable"
+	table := memory.NewTable(tName, sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: sql.Int32, Source: tName},
+	}))
+	db := memory.NewDatabase("mydb")
+	db.AddTable(tName, table)
+
+	a := withoutProcessTracking(NewBuilder(sql.NewDatabaseProvider(db)).AddPostAnalyzeRule("slow",
+		func(c *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+			time.Sleep(5 * time.Millisecond)
+			return n, nil
+		}).Build())
+
+	ctx := sql.NewContext(context.Background()).WithCurrentDB("mydb")
+	analyzed, timings, err := a.AnalyzeWithTimings(ctx, plan.NewUnresolvedTable(tName, ""), nil)
+	require.NoError(err)
+	require.True(analyzed.Resolved())
+
+	rules := timings.Rules()
+	require.Equal("post-analyzer", rules[0].Batch)
+	require.Equal("slow", rules[0].Rule)
+	require.Equal(1, rules[0].Runs)
+	require.True(rules[0].Duration >= 5*time.Millisecond)
+	require.True(timings.Total() >= rules[0].Duration)
+
+	var resolveTables *RuleTiming
+	for i, rule := range rules {
+		if i > 0 {
+			require.True(rules[i-1].Duration >= rule.Duration)
+		}
+		if rule.Rule == "resolve_tables" {
+			resolveTables = &rules[i]
+		}
+	}
+	require.NotNil(resolveTables)
+	require.Equal("once-before", resolveTables.Batch)
+	require.Equal(1, resolveTables.Runs)
+
+	// EXPLAIN ANALYZE reports the timings of the analysis of its query
+	describe := plan.NewDescribeQuery("tree", plan.NewUnresolvedTable(tName, ""))
+	describe.Analyze = true
+	analyzed, err = a.Analyze(ctx, describe, nil)
+	require.NoError(err)
+	analysis := analyzed.(*plan.DescribeQuery).Analysis
+	require.True(strings.HasPrefix(analysis, "Analysis(total="), analysis)
+	require.Contains(analysis, "post-analyzer/slow: ")
+	require.Contains(analysis, "once-before/resolve_tables: ")
+}
+
 func TestAddRule(t *testing.T) {
 	require := require.New(t)
 
@@ -217,8 +305,10 @@ func TestMixInnerAndNaturalJoins(t *testing.T) {
 			expression.NewGetFieldWithTable(5, sql.Text, "mytable2", "t2", false),
 			expression.NewGetFieldWithTable(8, sql.Text, "mytable3", "t3", false),
 		},
-		plan.NewInnerJoin(
-			plan.NewInnerJoin(
+		plan.NewHashJoin(
+			plan.JoinTypeInner,
+			plan.NewHashJoin(
+				plan.JoinTypeInner,
 				plan.NewDecoratedNode("Projected table access on [i f t]", plan.NewResolvedTable(table.WithProjection([]string{"i", "f", "t"}), db, nil)),
 				plan.NewDecoratedNode("Projected table access on [f2 i2 t2]", plan.NewResolvedTable(table2.WithProjection([]string{"f2", "i2", "t2"}), db, nil)),
 				expression.NewEquals(
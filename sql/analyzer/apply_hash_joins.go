@@ -0,0 +1,69 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// applyHashJoins replaces the joins whose condition has an equality comparison between the columns of both sides with
+// hash joins. Joins that can use an index were already replaced with indexed joins by this point, and joins whose
+// secondary side is a hash lookup already look up their rows by hash. Nothing is replaced when the hash_join feature
+// flag is off.
+func applyHashJoins(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	if !ctx.FeatureEnabled(sql.FeatureHashJoin) {
+		return n, nil
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		var typ plan.JoinType
+		switch n.(type) {
+		case *plan.InnerJoin:
+			typ = plan.JoinTypeInner
+		case *plan.LeftJoin:
+			typ = plan.JoinTypeLeft
+		case *plan.RightJoin:
+			typ = plan.JoinTypeRight
+		default:
+			return n, nil
+		}
+
+		j := n.(plan.JoinNode)
+		if !j.Resolved() {
+			return n, nil
+		}
+
+		secondary := j.Right()
+		if typ == plan.JoinTypeRight {
+			secondary = j.Left()
+		}
+		if _, ok := secondary.(*plan.HashLookup); ok {
+			return n, nil
+		}
+
+		scopeLen := len(scope.Schema())
+		if keys, _ := plan.HashJoinKeys(typ, j.JoinCond(), scopeLen, len(j.Left().Schema())); len(keys) == 0 {
+			return n, nil
+		}
+
+		a.Log("replacing %s with a hash join", typ)
+		var hj sql.Node = plan.NewHashJoin(typ, j.Left(), j.Right(), j.JoinCond()).WithScopeLen(scopeLen)
+		if j.Comment() != "" {
+			hj = hj.(sql.CommentedNode).WithComment(j.Comment())
+		}
+		return hj, nil
+	})
+}
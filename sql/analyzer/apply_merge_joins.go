@@ -0,0 +1,232 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// applyMergeJoins replaces the joins of two tables whose condition has an equality comparison between a column of each
+// of them with merge joins, when both tables have an ordered index whose first column is the compared one. Both tables
+// are then read once, in the order of those indexes. This includes the indexed joins of two tables, which would
+// otherwise look up the rows of their secondary table for every row of their primary table. Nothing is replaced when
+// the merge_join feature flag is off.
+func applyMergeJoins(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	if !ctx.FeatureEnabled(sql.FeatureMergeJoin) {
+		return n, nil
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		var typ plan.JoinType
+		var left, right sql.Node
+		var cond sql.Expression
+		var comment string
+		switch n := n.(type) {
+		case *plan.IndexedJoin:
+			// The left side of indexed joins is always the primary side, which is the side kept by their outer joins
+			typ = n.JoinType()
+			if typ == plan.JoinTypeRight {
+				typ = plan.JoinTypeLeft
+			}
+			left, right, cond = n.Left(), n.Right(), n.Cond
+			// Looking up the rows of the secondary side is cheaper than reading all of them when the primary side
+			// only reads the few rows of an index lookup
+			if hasStaticLookup(left) && !hasStaticLookup(right) {
+				return n, nil
+			}
+			// The primary side of an indexed join nested in the secondary side of another one looks up its rows for
+			// every row of the outer join, which is cheaper than reading all of them every time
+			if hasRowLookup(left) {
+				return n, nil
+			}
+		case *plan.InnerJoin, *plan.LeftJoin, *plan.RightJoin:
+			j := n.(plan.JoinNode)
+			typ = j.JoinType()
+			left, right, cond, comment = j.Left(), j.Right(), j.JoinCond(), j.Comment()
+		default:
+			return n, nil
+		}
+
+		if !n.Resolved() {
+			return n, nil
+		}
+
+		scopeLen := len(scope.Schema())
+		conds := splitConjunction(cond)
+		for i, e := range conds {
+			primaryKeys, secondaryKeys := plan.HashJoinKeys(typ, e, scopeLen, len(left.Schema()))
+			if len(primaryKeys) == 0 {
+				continue
+			}
+
+			leftKey, rightKey := primaryKeys[0], secondaryKeys[0]
+			if typ == plan.JoinTypeRight {
+				leftKey, rightKey = rightKey, leftKey
+			}
+
+			orderedLeft, ok, err := orderByIndex(ctx, left, leftKey.(*expression.GetField), "")
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+			orderedRight, ok, err := orderByIndex(ctx, right, rightKey.(*expression.GetField), "")
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			// The merge join orders its sides by the first comparison of its condition
+			mergeCond := append([]sql.Expression{e}, conds[:i]...)
+			mergeCond = append(mergeCond, conds[i+1:]...)
+
+			a.Log("replacing %s with a merge join", typ)
+			var mj sql.Node = plan.NewMergeJoin(typ, orderedLeft, orderedRight, expression.JoinAnd(mergeCond...)).WithScopeLen(scopeLen)
+			if comment != "" {
+				mj = mj.(sql.CommentedNode).WithComment(comment)
+			}
+			return mj, nil
+		}
+
+		return n, nil
+	})
+}
+
+// orderByIndex returns the side of a join given reading its table in the order of an ordered index whose first
+// column is the one given, if the side reads a single table with such an index, through nodes that keep the order of
+// its rows. The name is the alias of the table, if any.
+func orderByIndex(ctx *sql.Context, n sql.Node, column *expression.GetField, name string) (sql.Node, bool, error) {
+	var child sql.Node
+	switch n := n.(type) {
+	case *plan.TableAlias:
+		if name == "" {
+			name = n.Name()
+		}
+		child = n.Child
+	case *plan.DecoratedNode:
+		child = n.Child
+	case *plan.Filter:
+		child = n.Child
+	case *plan.ResolvedTable:
+		return orderedTableAccess(ctx, n, nil, column, name)
+	case *plan.IndexedTableAccess:
+		return orderedTableAccess(ctx, n.ResolvedTable, n, column, name)
+	default:
+		return n, false, nil
+	}
+
+	ordered, ok, err := orderByIndex(ctx, child, column, name)
+	if !ok || err != nil {
+		return n, false, err
+	}
+	n, err = n.WithChildren(ordered)
+	if err != nil {
+		return nil, false, err
+	}
+	return n, true, nil
+}
+
+// orderedTableAccess returns an access of the table given in the order of an ordered index whose first column is the
+// one given, if it has one. If the table is already accessed with a lookup computed during the analysis, the new
+// access uses the same ranges, and the same index must be ordered. Lookups computed for each row, such as those of
+// the secondary side of indexed joins, are replaced with a lookup of all the rows of the table.
+func orderedTableAccess(ctx *sql.Context, rt *plan.ResolvedTable, access *plan.IndexedTableAccess, column *expression.GetField, name string) (sql.Node, bool, error) {
+	if name == "" {
+		name = rt.Name()
+	}
+	if !strings.EqualFold(column.Table(), name) {
+		return rt, false, nil
+	}
+
+	it, ok := rt.Table.(sql.IndexedTable)
+	if !ok {
+		return rt, false, nil
+	}
+	indexes, err := it.GetIndexes(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var lookup sql.IndexLookup
+	if access != nil {
+		lookup = plan.GetIndexLookup(access)
+	}
+
+	expr := rt.Name() + "." + column.Name()
+	for _, idx := range indexes {
+		ordered, ok := idx.(sql.OrderedIndex)
+		if !ok || !strings.EqualFold(idx.Expressions()[0], expr) {
+			continue
+		}
+
+		var ranges []sql.Range
+		if lookup != nil {
+			if lookup.Index().ID() != idx.ID() {
+				continue
+			}
+			ranges = lookup.Ranges()
+		} else {
+			types := idx.ColumnExpressionTypes(ctx)
+			rang := make(sql.Range, len(types))
+			for i, typ := range types {
+				rang[i] = sql.AllRangeColumnExpr(typ.Type)
+			}
+			ranges = []sql.Range{rang}
+		}
+
+		orderedLookup, err := ordered.NewOrderedLookup(ctx, ranges...)
+		if err != nil {
+			return nil, false, err
+		}
+		if orderedLookup == nil {
+			continue
+		}
+		return plan.NewStaticIndexedTableAccess(rt, orderedLookup, idx, []sql.Expression{column}), true, nil
+	}
+
+	return rt, false, nil
+}
+
+// hasStaticLookup returns whether the node given reads a table with an index lookup computed during the analysis.
+func hasStaticLookup(n sql.Node) bool {
+	found := false
+	plan.Inspect(n, func(n sql.Node) bool {
+		if ita, ok := n.(*plan.IndexedTableAccess); ok && plan.GetIndexLookup(ita) != nil {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// hasRowLookup returns whether the node given reads a table with an index lookup computed for each row, such as the
+// secondary side of an indexed join.
+func hasRowLookup(n sql.Node) bool {
+	found := false
+	plan.Inspect(n, func(n sql.Node) bool {
+		if ita, ok := n.(*plan.IndexedTableAccess); ok && plan.GetIndexLookup(ita) == nil {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
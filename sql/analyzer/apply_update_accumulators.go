@@ -22,7 +22,8 @@ import (
 )
 
 // applyUpdateAccumulators wraps any Insert, Update, or Delete nodes with RowUpdateAccumulators to tally the results
-// for report to the client.
+// for report to the client. The rows changed in the table of statements that change a single table are counted towards
+// refreshing its statistics, and in the statement statistics of the catalog.
 func applyUpdateAccumulators(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
 	// Scope will be non-null in the case of trigger execution analysis. We don't want to apply update accumulators in
 	// that case.
@@ -37,12 +38,33 @@ func applyUpdateAccumulators(ctx *sql.Context, a *Analyzer, n sql.Node, scope *S
 		if err != nil {
 			return nil, err
 		}
-		return plan.NewRowUpdateAccumulator(n, accumulatorType), nil
+		acc := plan.NewRowUpdateAccumulator(n, accumulatorType)
+		if accumulatorType == plan.UpdateTypeJoinUpdate {
+			return acc, nil
+		}
+		if target := getUpdateTarget(n); target != nil {
+			return acc.WithStatistics(a.Catalog.TableStatistics, a.Catalog.StatementStatistics, target), nil
+		}
+		return acc, nil
 	default:
 		return n, nil
 	}
 }
 
+// getUpdateTarget returns the table changed by the Insert, Update or Delete node given, if it's resolved.
+func getUpdateTarget(n sql.Node) *plan.ResolvedTable {
+	switch n := n.(type) {
+	case *plan.TriggerExecutor:
+		return getUpdateTarget(n.Left())
+	case *plan.InsertInto:
+		return getResolvedTable(n.Destination)
+	case *plan.DeleteFrom, *plan.Update:
+		return getResolvedTable(n)
+	default:
+		return nil
+	}
+}
+
 // getUpdateAccumulatorType returns the type of accumulator needed for the node given, or an error if there's no match.
 func getUpdateAccumulatorType(n sql.Node) (plan.RowUpdateType, error) {
 	switch n := n.(type) {
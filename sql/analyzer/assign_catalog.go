@@ -80,6 +80,30 @@ func assignCatalog(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql
 			nc := *node
 			nc.Catalog = a.Catalog
 			return &nc, nil
+		case *plan.MultiRenameTable:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.Flush:
+			nc := *node
+			nc.Handler = a.Catalog
+			return &nc, nil
+		case *plan.ChecksumTable:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.TableMaintenance:
+			nc := *node
+			nc.Catalog = a.Catalog
+			return &nc, nil
+		case *plan.AnalyzeTable:
+			nc := *node
+			nc.Statistics = a.Catalog.TableStatistics
+			return &nc, nil
+		case *plan.AdminCommand:
+			nc := *node
+			nc.Runner = a.Catalog
+			return &nc, nil
 		case *plan.ResolvedTable:
 			nc := *node
 			ct, ok := nc.Table.(CatalogTable)
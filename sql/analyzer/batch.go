@@ -17,6 +17,10 @@ package analyzer
 import (
 	"reflect"
 	"strconv"
+	"strings"
+	"time"
+
+	opentracing "github.com/opentracing/opentracing-go"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -43,15 +47,20 @@ type Batch struct {
 
 // Eval executes the rules of the batch. On any error, the partially transformed node is returned along with the error.
 // If the batch's max number of iterations is reached without achieving stabilization (batch evaluation no longer
-// changes the node), then this method returns ErrMaxAnalysisIters.
+// changes the node), then this method returns ErrMaxAnalysisIters. If an iteration changes the node back to the result
+// of an earlier one, the batch can't stabilize, and ErrAnalysisCycle is returned right away. Both errors name the rules
+// that keep changing the node.
 func (b *Batch) Eval(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
 	if b.Iterations == 0 {
 		return n, nil
 	}
 
+	span, ctx := ctx.Span("analyze_batch", opentracing.Tags{"batch": b.Desc})
+	defer span.Finish()
+
 	prev := n
 	a.PushDebugContext("0")
-	cur, err := b.evalOnce(ctx, a, n, scope)
+	cur, _, err := b.evalOnce(ctx, a, n, scope, false)
 	a.PopDebugContext()
 	if err != nil {
 		return cur, err
@@ -61,16 +70,30 @@ func (b *Batch) Eval(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (s
 		return cur, nil
 	}
 
+	// The results of the iterations so far, to detect cycles. The rules changing the node are tracked from the third
+	// iteration on, which batches that converge normally don't need.
+	results := []sql.Node{n}
+	var changing []string
 	for i := 1; !nodesEqual(prev, cur); {
 		a.Log("Nodes not equal, re-running batch")
 		a.LogDiff(prev, cur)
+		if changing != nil {
+			for j, result := range results[:len(results)-1] {
+				if nodesEqual(result, cur) {
+					a.Log("Batch cycles between %d plans, rules changing the plan: %s", len(results)-j, changing)
+					return cur, ErrAnalysisCycle.New(b.Desc, len(results)-j, strings.Join(changing, ", "))
+				}
+			}
+		}
 		if i >= b.Iterations {
-			return cur, ErrMaxAnalysisIters.New(b.Iterations)
+			a.Log("Batch didn't converge, rules changing the plan: %s", changing)
+			return cur, ErrMaxAnalysisIters.New(b.Iterations, b.Desc, strings.Join(changing, ", "))
 		}
 
+		results = append(results, cur)
 		prev = cur
 		a.PushDebugContext(strconv.Itoa(i))
-		cur, err = b.evalOnce(ctx, a, cur, scope)
+		cur, changing, err = b.evalOnce(ctx, a, cur, scope, i >= 2)
 		a.PopDebugContext()
 		if err != nil {
 			return cur, err
@@ -84,16 +107,31 @@ func (b *Batch) Eval(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (s
 
 // evalOnce returns the result of evaluating a batch of rules on the node given. In the result of an error, the result
 // of the last successful transformation is returned along with the error. If no transformation was successful, the
-// input node is returned as-is.
-func (b *Batch) evalOnce(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+// input node is returned as-is. The time spent applying each rule is recorded in the timings of the analysis, if any.
+// If |track| is true, the names of the rules that changed the node are returned as well, in the order they were applied.
+func (b *Batch) evalOnce(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope, track bool) (sql.Node, []string, error) {
+	timings := timingsFromContext(ctx)
+	var changing []string
+	if track {
+		changing = []string{}
+	}
 	prev := n
 	for _, rule := range b.Rules {
 		var err error
 		a.Log("Evaluating rule %s", rule.Name)
 		a.PushDebugContext(rule.Name)
-		next, err := rule.Apply(ctx, a, prev, scope)
+		span, ruleCtx := ctx.Span("analyze_rule", opentracing.Tags{"batch": b.Desc, "rule": rule.Name})
+		start := time.Now()
+		next, err := rule.Apply(ruleCtx, a, prev, scope)
+		if timings != nil {
+			timings.add(b.Desc, rule.Name, time.Since(start))
+		}
+		span.Finish()
 		if next != nil {
 			a.LogDiff(prev, next)
+			if track && !nodesEqual(prev, next) {
+				changing = append(changing, rule.Name)
+			}
 			prev = next
 			a.LogNode(prev)
 		}
@@ -102,11 +140,11 @@ func (b *Batch) evalOnce(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope
 			// Returning the last node before the error is important. This is non-idiomatic, but in the case of partial
 			// resolution before an error we want the last successful transformation result. Very important for resolving
 			// subqueries.
-			return prev, err
+			return prev, changing, err
 		}
 	}
 
-	return prev, nil
+	return prev, changing, nil
 }
 
 func nodesEqual(a, b sql.Node) bool {
@@ -27,15 +27,45 @@ import (
 
 type Catalog struct {
 	GrantTables *grant_tables.GrantTables
+	// RowSecurity holds the row security policies applied to the tables of this catalog.
+	RowSecurity *sql.RowSecurityPolicies
+	// ColumnMasks holds the masks applied to the columns of the tables of this catalog.
+	ColumnMasks *sql.ColumnMasks
+	// AdminCommands holds the commands run by admin statements such as RESET MASTER, which every server of the engine
+	// shares.
+	AdminCommands *sql.AdminCommands
+	// TableHandlers holds the table handlers opened by HANDLER statements of each session.
+	TableHandlers *sql.TableHandlers
+	// PreparedQueries holds the statements prepared by PREPARE statements of each session.
+	PreparedQueries *sql.PreparedQueries
+	// LockSubsystem holds the named locks acquired with GET_LOCK.
+	LockSubsystem *sql.LockSubsystem
+	// TableStatistics tracks the tables analyzed with ANALYZE TABLE and the rows changed in them since.
+	TableStatistics *sql.TableStatistics
+	// StatementStatistics aggregates the statements run by digest and the rows changed in each table.
+	StatementStatistics *sql.StatementStatistics
+	// StatusVariables holds the status variables reported by SHOW STATUS.
+	StatusVariables *sql.StatusVariables
+	// FlushHandler, if set, is notified of the options of FLUSH statements after the catalog flushes its own state.
+	FlushHandler sql.FlushHandler
 
 	provider         sql.DatabaseProvider
 	builtInFunctions function.Registry
 	mu               sync.RWMutex
 	locks            sessionLocks
+
+	versionsMu        sync.Mutex
+	schemaVersions    map[schemaVersionKey]uint64
+	lastSchemaVersion uint64
+	openTables        map[schemaVersionKey]struct{}
 }
 
 var _ sql.Catalog = (*Catalog)(nil)
 var _ sql.FunctionProvider = (*Catalog)(nil)
+var _ sql.FlushHandler = (*Catalog)(nil)
+var _ sql.AdminCommandRunner = (*Catalog)(nil)
+var _ sql.NamedLockCatalog = (*Catalog)(nil)
+var _ sql.StatementStatisticsCatalog = (*Catalog)(nil)
 
 type tableLocks map[string]struct{}
 
@@ -46,10 +76,19 @@ type sessionLocks map[uint32]dbLocks
 // NewCatalog returns a new empty Catalog with the given provider
 func NewCatalog(provider sql.DatabaseProvider) *Catalog {
 	return &Catalog{
-		GrantTables:      grant_tables.CreateEmptyGrantTables(),
-		provider:         provider,
-		builtInFunctions: function.NewRegistry(),
-		locks:            make(sessionLocks),
+		GrantTables:         grant_tables.CreateEmptyGrantTables(),
+		RowSecurity:         sql.NewRowSecurityPolicies(),
+		ColumnMasks:         sql.NewColumnMasks(),
+		AdminCommands:       sql.NewAdminCommands(),
+		TableHandlers:       sql.NewTableHandlers(),
+		PreparedQueries:     sql.NewPreparedQueries(),
+		LockSubsystem:       sql.NewLockSubsystem(),
+		TableStatistics:     sql.NewTableStatistics(),
+		StatementStatistics: sql.NewStatementStatistics(),
+		StatusVariables:     sql.NewStatusVariables(),
+		provider:            provider,
+		builtInFunctions:    function.NewRegistry(),
+		locks:               make(sessionLocks),
 	}
 }
 
@@ -61,6 +100,77 @@ func (c *Catalog) AllDatabases() []sql.Database {
 	return c.provider.AllDatabases()
 }
 
+// NamedLocks implements the sql.NamedLockCatalog interface.
+func (c *Catalog) NamedLocks() *sql.LockSubsystem {
+	return c.LockSubsystem
+}
+
+// Statements implements the sql.StatementStatisticsCatalog interface.
+func (c *Catalog) Statements() *sql.StatementStatistics {
+	return c.StatementStatistics
+}
+
+// Flush implements the sql.FlushHandler interface. FLUSH TABLES changes the schema versions of the tables flushed, so
+// that anything derived from their schemas is computed again, and closes them, and FLUSH STATUS resets the status variables of the
+// session. The grant tables are read by every privilege check, so there is nothing to reload for FLUSH PRIVILEGES, and
+// the engine keeps no logs of its own. The option is then passed to the FlushHandler of the catalog, if any.
+func (c *Catalog) Flush(ctx *sql.Context, option sql.FlushOption) error {
+	switch option.Kind {
+	case sql.FlushKind_Tables:
+		c.StatusVariables.Increment(ctx, "Flush_commands", 1)
+		if len(option.Tables) == 0 {
+			for _, db := range c.AllDatabases() {
+				c.BumpSchemaVersion(db.Name(), "")
+			}
+		}
+		for _, table := range option.Tables {
+			db := table.Database
+			if db == "" {
+				db = ctx.GetCurrentDatabase()
+			}
+			c.BumpSchemaVersion(db, table.Name)
+		}
+	case sql.FlushKind_Status:
+		c.StatusVariables.FlushSession(ctx.ID())
+	}
+
+	if c.FlushHandler != nil {
+		return c.FlushHandler.Flush(ctx, option)
+	}
+	return nil
+}
+
+// RunAdminCommand implements the sql.AdminCommandRunner interface. The commands of the server the query was received
+// by are looked up before the ones of the catalog. When the grant tables are disabled every account may run every
+// command, as every account has every privilege.
+func (c *Catalog) RunAdminCommand(ctx *sql.Context, name string, args []string) error {
+	var cmd sql.AdminCommand
+	var ok bool
+	if commands := ctx.AdminCommands(); commands != nil {
+		cmd, ok = commands.Get(name)
+	}
+	if !ok {
+		cmd, ok = c.AdminCommands.Get(name)
+	}
+	if !ok {
+		return sql.ErrAdminCommandNotSupported.New(strings.ToUpper(name))
+	}
+
+	if c.GrantTables.Enabled {
+		sc := ctx.SecurityContext()
+		if !c.GrantTables.HasSuperPrivilege(sc.User, sc.Host) &&
+			(cmd.Privilege == "" || !c.GrantTables.HasPrivilege(sc.User, sc.Host, cmd.Privilege)) {
+			privilege := "SUPER"
+			if cmd.Privilege != "" {
+				privilege = strings.ToUpper(strings.TrimSuffix(cmd.Privilege, "_priv"))
+			}
+			return sql.ErrAdminCommandDenied.New(privilege)
+		}
+	}
+
+	return cmd.Run(ctx, args)
+}
+
 // CreateDatabase creates a new Database and adds it to the catalog.
 func (c *Catalog) CreateDatabase(ctx *sql.Context, dbName string) error {
 	c.mu.Lock()
@@ -87,16 +197,34 @@ func (c *Catalog) RemoveDatabase(ctx *sql.Context, dbName string) error {
 	}
 }
 
+// HasDB returns whether the database with the given name exists. The mysql database always exists: it's the grant
+// tables, unless the provider has a mysql database of its own.
 func (c *Catalog) HasDB(db string) bool {
-	return c.provider.HasDatabase(db)
+	return c.provider.HasDatabase(db) || strings.ToLower(db) == "mysql"
 }
 
-// Database returns the database with the given name.
+// Database returns the database with the given name. If names are compared case-sensitively, as lower_case_table_names
+// decides, only a database with exactly that name is returned, unless it's the information_schema or mysql database.
+// The mysql database of the provider is returned if it has one, and the grant tables otherwise.
 func (c *Catalog) Database(db string) (sql.Database, error) {
-	if strings.ToLower(db) == "mysql" {
+	if strings.ToLower(db) == "mysql" && !c.provider.HasDatabase(db) {
 		return c.GrantTables, nil
 	}
-	return c.provider.Database(db)
+	database, err := c.provider.Database(db)
+	if err != nil {
+		return nil, err
+	}
+	if !sql.TableNamesCaseInsensitive() && !isSystemDatabase(database) && database.Name() != db {
+		return nil, sql.ErrDatabaseNotFound.New(db)
+	}
+	return database, nil
+}
+
+// isSystemDatabase returns whether the database given is the information_schema or mysql database, whose names, and the
+// names of whose tables, are always compared case-insensitively.
+func isSystemDatabase(db sql.Database) bool {
+	name := strings.ToLower(db.Name())
+	return name == "information_schema" || name == "mysql"
 }
 
 // LockTable adds a lock for the given table and session client. It is assumed
@@ -156,6 +284,12 @@ func (c *Catalog) UnlockTables(ctx *sql.Context, id uint32) error {
 
 // Table returns the table in the given database with the given name.
 func (c *Catalog) Table(ctx *sql.Context, dbName, tableName string) (sql.Table, sql.Database, error) {
+	// Tables are looked up once for the whole analysis of a query
+	snapshot := catalogSnapshotFromContext(ctx)
+	if tbl, db, ok := snapshot.get(dbName, tableName); ok {
+		return tbl, db, nil
+	}
+
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
@@ -167,10 +301,12 @@ func (c *Catalog) Table(ctx *sql.Context, dbName, tableName string) (sql.Table,
 	tbl, ok, err := db.GetTableInsensitive(ctx, tableName)
 	if err != nil {
 		return nil, nil, err
-	} else if !ok {
+	} else if !ok || !tableNameMatches(db, tbl, tableName) {
 		return nil, nil, suggestSimilarTables(db, ctx, tableName)
 	}
 
+	snapshot.pin(dbName, tableName, tbl, db)
+	c.openTable(ctx, db.Name(), tbl.Name())
 	return tbl, db, nil
 }
 
@@ -194,13 +330,20 @@ func (c *Catalog) TableAsOf(ctx *sql.Context, dbName, tableName string, asOf int
 
 	if err != nil {
 		return nil, nil, err
-	} else if !ok {
+	} else if !ok || !tableNameMatches(db, tbl, tableName) {
 		return nil, nil, suggestSimilarTablesAsOf(versionedDb, ctx, tableName, asOf)
 	}
 
 	return tbl, versionedDb, nil
 }
 
+// tableNameMatches returns whether the table given, found in the database given, is matched by the name it was looked up
+// with. Databases look tables up case-insensitively, preferring the table with exactly the name given, so a table found
+// with a name that isn't exactly the one given doesn't match when names are compared case-sensitively.
+func tableNameMatches(db sql.Database, tbl sql.Table, name string) bool {
+	return sql.TableNamesCaseInsensitive() || isSystemDatabase(db) || tbl.Name() == name
+}
+
 // RegisterFunction registers the functions given, adding them to the built-in functions.
 // Integrators with custom functions should typically use the FunctionProvider interface instead.
 func (c *Catalog) RegisterFunction(fns ...sql.Function) {
@@ -0,0 +1,88 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// catalogSnapshot pins the tables returned by the catalog while a query is analyzed, so that every reference to a table
+// in the query, including in its subqueries, views, triggers and stored procedures, resolves to the same table even if
+// another session changes or drops it in the meantime. The analyzed plan keeps the tables it resolved to for its
+// execution. Only tables that are found are pinned, so that a table created after it wasn't found is found.
+type catalogSnapshot struct {
+	mu     sync.Mutex
+	tables map[schemaVersionKey]snapshotTable
+}
+
+// snapshotTable is a table pinned by a catalogSnapshot, along with its database.
+type snapshotTable struct {
+	table sql.Table
+	db    sql.Database
+}
+
+func newCatalogSnapshot() *catalogSnapshot {
+	return &catalogSnapshot{tables: make(map[schemaVersionKey]snapshotTable)}
+}
+
+// get returns the table named pinned by the snapshot, if any. It's safe to call on a nil snapshot.
+func (s *catalogSnapshot) get(db, table string) (sql.Table, sql.Database, bool) {
+	if s == nil {
+		return nil, nil, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.tables[snapshotKey(db, table)]
+	return t.table, t.db, ok
+}
+
+// pin pins the table named to the table given. It's safe to call on a nil snapshot.
+func (s *catalogSnapshot) pin(dbName, name string, table sql.Table, db sql.Database) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tables[snapshotKey(dbName, name)] = snapshotTable{table, db}
+}
+
+// snapshotKey returns the key of the table named in a catalogSnapshot, which only ignores case if names are compared
+// case-insensitively, so that tables whose names only differ in case are pinned separately.
+func snapshotKey(db, table string) schemaVersionKey {
+	if sql.TableNamesCaseInsensitive() {
+		return newSchemaVersionKey(db, table)
+	}
+	return schemaVersionKey{db, table}
+}
+
+type catalogSnapshotKey struct{}
+
+// catalogSnapshotFromContext returns the catalog snapshot of the analysis run by the context given, or nil if there's
+// none.
+func catalogSnapshotFromContext(ctx *sql.Context) *catalogSnapshot {
+	if ctx == nil || ctx.Context == nil {
+		return nil
+	}
+	snapshot, _ := ctx.Value(catalogSnapshotKey{}).(*catalogSnapshot)
+	return snapshot
+}
+
+// withCatalogSnapshot returns a copy of the context given whose catalog lookups are pinned by the snapshot given.
+func withCatalogSnapshot(ctx *sql.Context, snapshot *catalogSnapshot) *sql.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context, catalogSnapshotKey{}, snapshot))
+}
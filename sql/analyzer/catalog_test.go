@@ -52,6 +52,23 @@ func TestCatalogDatabase(t *testing.T) {
 	require.Equal(mydb, db)
 }
 
+func TestCatalogMysqlDatabase(t *testing.T) {
+	require := require.New(t)
+
+	c := NewCatalog(sql.NewDatabaseProvider(memory.NewDatabase("foo")))
+	require.True(c.HasDB("mysql"))
+	db, err := c.Database("mysql")
+	require.NoError(err)
+	require.Equal(c.GrantTables, db)
+
+	mysql := memory.NewDatabase("mysql")
+	c = NewCatalog(sql.NewDatabaseProvider(mysql))
+	require.True(c.HasDB("mysql"))
+	db, err = c.Database("MySQL")
+	require.NoError(err)
+	require.Equal(mysql, db)
+}
+
 func TestCatalogTable(t *testing.T) {
 	require := require.New(t)
 
@@ -120,3 +137,54 @@ func (l *lockableTable) Unlock(ctx *sql.Context, id uint32) error {
 	l.unlocks++
 	return nil
 }
+
+func TestCatalogSchemaVersions(t *testing.T) {
+	require := require.New(t)
+
+	c := NewCatalog(sql.NewDatabaseProvider())
+	require.Equal(uint64(0), c.SchemaVersion("db", "t"))
+
+	c.BumpSchemaVersion("db", "t")
+	v := c.SchemaVersion("db", "t")
+	require.NotEqual(uint64(0), v)
+	require.Equal(v, c.SchemaVersion("DB", "T"))
+	require.Equal(uint64(0), c.SchemaVersion("db", "u"))
+
+	c.BumpSchemaVersion("db", "")
+	require.NotEqual(v, c.SchemaVersion("db", "t"))
+	require.NotEqual(uint64(0), c.SchemaVersion("db", "u"))
+	require.Equal(uint64(0), c.SchemaVersion("other", "t"))
+}
+
+func TestCatalogTableSnapshot(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("foo")
+	mytable := memory.NewTable("bar", sql.PrimaryKeySchema{})
+	db.AddTable("bar", mytable)
+	c := NewCatalog(sql.NewDatabaseProvider(db))
+
+	ctx := sql.NewEmptyContext()
+	snapshotCtx := withCatalogSnapshot(ctx, newCatalogSnapshot())
+
+	table, _, err := c.Table(snapshotCtx, "foo", "bar")
+	require.NoError(err)
+	require.Equal(mytable, table)
+
+	_, _, err = c.Table(snapshotCtx, "foo", "baz")
+	require.True(sql.ErrTableNotFound.Is(err))
+
+	require.NoError(db.DropTable(ctx, "bar"))
+	db.AddTable("baz", memory.NewTable("baz", sql.PrimaryKeySchema{}))
+
+	// Tables found are pinned by the snapshot, while those that weren't are looked up again
+	table, _, err = c.Table(snapshotCtx, "foo", "BAR")
+	require.NoError(err)
+	require.Equal(mytable, table)
+
+	_, _, err = c.Table(snapshotCtx, "foo", "baz")
+	require.NoError(err)
+
+	_, _, err = c.Table(ctx, "foo", "bar")
+	require.True(sql.ErrTableNotFound.Is(err))
+}
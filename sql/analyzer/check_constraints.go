@@ -185,8 +185,7 @@ func loadChecks(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.No
 			for i, check := range checks {
 				newExpr, err := expression.TransformUp(check.Expr, func(e sql.Expression) (sql.Expression, error) {
 					if t, ok := e.(*expression.UnresolvedColumn); ok {
-						name := t.Name()
-						strings.Replace(name, "`", "", -1) // remove any preexisting backticks
+						name := strings.Replace(t.Name(), "`", "``", -1) // escape any backticks in the name
 
 						return expression.NewUnresolvedColumn(fmt.Sprintf("`%s`", name)), nil
 					}
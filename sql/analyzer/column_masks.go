@@ -0,0 +1,59 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// applyColumnMasks wraps the tables read by the node given that have masked columns in a plan.MaskedTable, so that
+// every expression of the query, including its filters, sees only masked values. UPDATE and DELETE statements and the
+// destinations of INSERT statements are not masked, since table editors need the stored rows, but their subqueries
+// and the sources of inserts are analyzed on their own and are. Sessions of users with the SUPER privilege bypass
+// column masks.
+func applyColumnMasks(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	if a.Catalog == nil || a.Catalog.ColumnMasks == nil || a.Catalog.ColumnMasks.IsEmpty() {
+		return n, nil
+	}
+	if hasSuperPrivilege(ctx, a) {
+		return n, nil
+	}
+
+	switch n.(type) {
+	case *plan.InsertInto, *plan.Update, *plan.DeleteFrom,
+		*plan.LockTables, *plan.AlterAutoIncrement, *plan.AlterDefaultSet, *plan.AlterDefaultDrop:
+		return n, nil
+	default:
+		if plan.IsNoRowNode(n) {
+			return n, nil
+		}
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		rt, ok := n.(*plan.ResolvedTable)
+		if !ok || rt.Database == nil {
+			return n, nil
+		}
+		if _, ok := rt.Table.(*plan.MaskedTable); ok {
+			return n, nil
+		}
+		masks := a.Catalog.ColumnMasks.Get(rt.Database.Name(), rt.Name())
+		if len(masks) == 0 {
+			return n, nil
+		}
+		return rt.WithTable(plan.NewMaskedTable(rt.Table, masks))
+	})
+}
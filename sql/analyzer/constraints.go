@@ -34,6 +34,9 @@ func resolveDropConstraint(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Sco
 		return nil, ErrInAnalysis.New("Expected a ResolvedTable for ALTER TABLE DROP CONSTRAINT statement")
 	}
 
+	// The constraint may be a foreign key, a check or a unique index. MySQL requires a constraint specific DROP clause
+	// when more than one of these has the name given.
+	var matches []sql.Node
 	table := rt.Table
 	fkt, ok := table.(sql.ForeignKeyTable)
 	if ok {
@@ -44,7 +47,8 @@ func resolveDropConstraint(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Sco
 
 		for _, fk := range fks {
 			if strings.ToLower(fk.Name) == strings.ToLower(dropConstraint.Name) {
-				return plan.NewAlterDropForeignKey(rt, dropConstraint.Name), nil
+				matches = append(matches, plan.NewAlterDropForeignKey(rt, dropConstraint.Name))
+				break
 			}
 		}
 	}
@@ -58,12 +62,35 @@ func resolveDropConstraint(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Sco
 
 		for _, check := range checks {
 			if strings.ToLower(check.Name) == strings.ToLower(dropConstraint.Name) {
-				return plan.NewAlterDropCheck(rt, check.Name), nil
+				matches = append(matches, plan.NewAlterDropCheck(rt, check.Name))
+				break
 			}
 		}
 	}
 
-	return nil, sql.ErrUnknownConstraint.New(dropConstraint.Name)
+	it, ok := table.(sql.IndexedTable)
+	if ok {
+		indexes, err := it.GetIndexes(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, index := range indexes {
+			if index.IsUnique() && index.ID() != "PRIMARY" && strings.ToLower(index.ID()) == strings.ToLower(dropConstraint.Name) {
+				matches = append(matches, plan.NewAlterDropIndex(rt, index.ID()))
+				break
+			}
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, sql.ErrUnknownConstraint.New(dropConstraint.Name)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, sql.ErrMultipleConstraintsWithSameName.New(dropConstraint.Name)
+	}
 }
 
 // validateDropConstraint returns an error if the constraint named to be dropped doesn't exist
@@ -0,0 +1,451 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// decorrelateSubqueries rewrites the correlated subqueries of WHERE clauses, which are executed again for every row of
+// the outer query, into joins with a derived table that is computed once. The subqueries rewritten are conjuncts of
+// the filter of one of these forms, where the subquery is correlated with the outer query only by equalities between
+// a column of the outer query and a column of the subquery, ANDed in its WHERE clause:
+//
+//	outer_expr = (SELECT MAX(x) FROM inner WHERE inner.k = outer.k)  -- also with MIN, SUM or AVG and <, <=, > or >=
+//	outer_col IN (SELECT inner.x FROM inner WHERE inner.k = outer.k)
+//	EXISTS (SELECT ... FROM inner WHERE inner.k = outer.k)
+//
+// The derived table groups the rows of the subquery by the columns of the correlation, and is joined with an inner
+// join on them. Since every row of the outer query then matches at most one row of the derived table, and the
+// conjunct rejects the rows whose subquery returns no rows or NULL, the join returns the rows of the filter. IN and
+// EXISTS are semi-joins this way, without a join type of their own. A row matching several rows of the derived table
+// would be returned several times, so the rewrite only applies when the equalities compare integer columns or text
+// columns, whose values are equal exactly when they are grouped together. The subquery is otherwise left as is. The
+// scalar subqueries are left as is when the subquery_to_derived feature flag is off, and the IN and EXISTS ones when
+// the semijoin feature flag is off.
+func decorrelateSubqueries(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	if !ctx.FeatureEnabled(sql.FeatureSubqueryToDerived) && !ctx.FeatureEnabled(sql.FeatureSemijoin) {
+		return n, nil
+	}
+
+	span, _ := ctx.Span("decorrelate_subqueries")
+	defer span.Finish()
+
+	// The rows of the filters of statements that modify tables must come from their tables
+	modifies := false
+	plan.Inspect(n, func(n sql.Node) bool {
+		switch n.(type) {
+		case *plan.Update, *plan.DeleteFrom, *plan.InsertInto:
+			modifies = true
+		}
+		return !modifies
+	})
+	if modifies {
+		return n, nil
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		filter, ok := n.(*plan.Filter)
+		if !ok {
+			return n, nil
+		}
+
+		outer, ok := newCorrelationTables(filter.Child)
+		if !ok {
+			return n, nil
+		}
+
+		var rest []sql.Expression
+		var joins []*decorrelatedSubquery
+		for _, e := range splitConjunction(filter.Expression) {
+			dq, err := decorrelateConjunct(ctx, a, e, outer, fmt.Sprintf("decorrelated_subquery_%d", len(joins)+1), scope)
+			if err != nil {
+				return nil, err
+			}
+			if dq == nil || outer.has(dq.alias.Name()) {
+				rest = append(rest, e)
+				continue
+			}
+			joins = append(joins, dq)
+		}
+		if len(joins) == 0 {
+			return n, nil
+		}
+
+		a.Log("decorrelating %d subqueries into joins", len(joins))
+		child := filter.Child
+		if len(rest) > 0 {
+			child = plan.NewFilter(expression.JoinAnd(rest...), child)
+		}
+		for _, dq := range joins {
+			child = plan.NewInnerJoin(child, dq.alias, dq.cond)
+		}
+
+		// The columns of the derived tables aren't part of the rows of the filter
+		stars := make([]sql.Expression, len(outer.names))
+		for i, name := range outer.names {
+			stars[i] = expression.NewQualifiedStar(name)
+		}
+		return plan.NewProject(stars, child), nil
+	})
+}
+
+// decorrelatedSubquery is a derived table that replaces a correlated subquery, and the condition of its join with the
+// outer query.
+type decorrelatedSubquery struct {
+	alias *plan.SubqueryAlias
+	cond  sql.Expression
+}
+
+// correlationTables are the tables of the FROM clause of a query, in the order of their columns in its rows, with
+// their resolved schemas.
+type correlationTables struct {
+	names   []string
+	schemas map[string]sql.Schema
+}
+
+// newCorrelationTables returns the tables of the node given, which must be made of resolved tables, their aliases and
+// joins.
+func newCorrelationTables(n sql.Node) (*correlationTables, bool) {
+	tables := &correlationTables{schemas: make(map[string]sql.Schema)}
+	if !tables.add(n) {
+		return nil, false
+	}
+	return tables, true
+}
+
+func (t *correlationTables) add(n sql.Node) bool {
+	switch n := n.(type) {
+	case *plan.CrossJoin:
+		return t.add(n.Left()) && t.add(n.Right())
+	case *plan.InnerJoin, *plan.LeftJoin, *plan.RightJoin:
+		join := n.(plan.JoinNode)
+		return t.add(join.Left()) && t.add(join.Right())
+	case *plan.TableAlias, *plan.ResolvedTable:
+		name := strings.ToLower(n.(sql.Nameable).Name())
+		if !n.Resolved() || t.has(name) {
+			return false
+		}
+		t.names = append(t.names, name)
+		t.schemas[name] = n.Schema()
+		return true
+	default:
+		return false
+	}
+}
+
+func (t *correlationTables) has(name string) bool {
+	_, ok := t.schemas[strings.ToLower(name)]
+	return ok
+}
+
+// column returns the table and the schema column of the column given, or false if none of the tables has it, or
+// several of them do for an unqualified column.
+func (t *correlationTables) column(c *expression.UnresolvedColumn) (string, *sql.Column, bool) {
+	var table string
+	var col *sql.Column
+	for _, name := range t.names {
+		if c.Table() != "" && !strings.EqualFold(c.Table(), name) {
+			continue
+		}
+		for _, sc := range t.schemas[name] {
+			if !strings.EqualFold(sc.Name, c.Name()) {
+				continue
+			}
+			if col != nil {
+				return "", nil, false
+			}
+			table, col = name, sc
+		}
+	}
+	return table, col, col != nil
+}
+
+// decorrelateConjunct returns the derived table, with the name given, that replaces the correlated subquery of the
+// conjunct given, or nil if the conjunct can't be rewritten into a join.
+func decorrelateConjunct(ctx *sql.Context, a *Analyzer, e sql.Expression, outer *correlationTables, name string, scope *Scope) (*decorrelatedSubquery, error) {
+	var subquery *plan.Subquery
+	var left sql.Expression
+	var comparison expression.Comparer
+	switch e := e.(type) {
+	case *expression.Equals, *expression.LessThan, *expression.LessThanOrEqual, *expression.GreaterThan, *expression.GreaterThanOrEqual:
+		if !ctx.FeatureEnabled(sql.FeatureSubqueryToDerived) {
+			return nil, nil
+		}
+		comparison = e.(expression.Comparer)
+		if sq, ok := comparison.Right().(*plan.Subquery); ok {
+			subquery, left = sq, comparison.Left()
+		} else if sq, ok := comparison.Left().(*plan.Subquery); ok {
+			subquery, left = sq, comparison.Right()
+		} else {
+			return nil, nil
+		}
+	case *plan.InSubquery:
+		if !ctx.FeatureEnabled(sql.FeatureSemijoin) {
+			return nil, nil
+		}
+		sq, ok := e.Right.(*plan.Subquery)
+		if !ok {
+			return nil, nil
+		}
+		subquery, left = sq, e.Left
+	case *plan.ExistsSubquery:
+		if !ctx.FeatureEnabled(sql.FeatureSemijoin) {
+			return nil, nil
+		}
+		sq, ok := e.Children()[0].(*plan.Subquery)
+		if !ok {
+			return nil, nil
+		}
+		subquery = sq
+	default:
+		return nil, nil
+	}
+	if subquery.Query.Resolved() {
+		return nil, nil
+	}
+
+	// The select list of the subquery, which is ignored by EXISTS, and its FROM and WHERE clauses
+	var selected sql.Expression
+	grouped := false
+	n := subquery.Query
+	switch q := n.(type) {
+	case *plan.GroupBy:
+		if len(q.SelectedExprs) != 1 || len(q.GroupByExprs) != 0 {
+			return nil, nil
+		}
+		selected, grouped, n = q.SelectedExprs[0], true, q.Child
+	case *plan.Distinct:
+		project, ok := q.Child.(*plan.Project)
+		if !ok || len(project.Projections) != 1 {
+			return nil, nil
+		}
+		selected, n = project.Projections[0], project.Child
+	case *plan.Project:
+		if len(q.Projections) != 1 {
+			return nil, nil
+		}
+		selected, n = q.Projections[0], q.Child
+	default:
+		return nil, nil
+	}
+	if alias, ok := selected.(*expression.Alias); ok {
+		selected = alias.Child
+	}
+	if comparison != nil && (!grouped || !nullOnEmptyAggregation(selected)) {
+		return nil, nil
+	}
+	if comparison == nil && grouped {
+		return nil, nil
+	}
+
+	f, ok := n.(*plan.Filter)
+	if !ok {
+		return nil, nil
+	}
+	from, err := resolveTables(ctx, a, f.Child, scope)
+	if err != nil {
+		// The analysis of the subquery returns the error
+		return nil, nil
+	}
+	inner, ok := newCorrelationTables(from)
+	if !ok || inner.has(name) {
+		return nil, nil
+	}
+	fromOnly := true
+	plan.InspectExpressions(from, func(e sql.Expression) bool {
+		fromOnly = fromOnly && innerOnly(inner, e)
+		return fromOnly
+	})
+	if !fromOnly {
+		return nil, nil
+	}
+	if _, ok := e.(*plan.ExistsSubquery); !ok && !innerOnly(inner, selected) {
+		return nil, nil
+	}
+
+	// The equalities of the correlation, and the other conjuncts of the WHERE clause of the subquery
+	var keys, grouping, conds, where []sql.Expression
+	for _, c := range splitConjunction(f.Expression) {
+		if innerOnly(inner, c) {
+			where = append(where, c)
+			continue
+		}
+		eq, ok := c.(*expression.Equals)
+		if !ok {
+			return nil, nil
+		}
+		outerKey, innerKey, ok := correlationKeys(inner, outer, eq)
+		if !ok {
+			return nil, nil
+		}
+		key := fmt.Sprintf("k%d", len(keys))
+		keys = append(keys, expression.NewAlias(key, innerKey))
+		grouping = append(grouping, innerKey)
+		conds = append(conds, expression.NewEquals(outerKey, expression.NewUnresolvedQualifiedColumn(name, key)))
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	var derived sql.Node = from
+	if len(where) > 0 {
+		derived = plan.NewFilter(expression.JoinAnd(where...), derived)
+	}
+	value := expression.NewUnresolvedQualifiedColumn(name, "v")
+	switch {
+	case left == nil:
+		derived = plan.NewDistinct(plan.NewProject(keys, derived))
+	case comparison == nil:
+		column, ok := selected.(*expression.UnresolvedColumn)
+		if !ok {
+			return nil, nil
+		}
+		_, valueCol, _ := inner.column(column)
+		leftKey, ok := qualifiedOuterColumn(outer, left, valueCol)
+		if !ok {
+			return nil, nil
+		}
+		derived = plan.NewDistinct(plan.NewProject(append(keys, expression.NewAlias("v", selected)), derived))
+		conds = append(conds, expression.NewEquals(leftKey, value))
+	default:
+		left, ok = qualifyOuterColumns(outer, left)
+		if !ok {
+			return nil, nil
+		}
+		derived = plan.NewGroupBy(append(keys, expression.NewAlias("v", selected)), grouping, derived)
+		operands := []sql.Expression{left, value}
+		if comparison.Left() == subquery {
+			operands = []sql.Expression{value, left}
+		}
+		cond, err := comparison.(sql.Expression).WithChildren(operands...)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, cond)
+	}
+
+	return &decorrelatedSubquery{
+		alias: plan.NewSubqueryAlias(name, "", derived),
+		cond:  expression.JoinAnd(conds...),
+	}, nil
+}
+
+// nullOnEmptyAggregation returns whether the expression given is an aggregation that returns NULL for no rows.
+func nullOnEmptyAggregation(e sql.Expression) bool {
+	f, ok := e.(*expression.UnresolvedFunction)
+	if !ok || f.Window != nil {
+		return false
+	}
+	switch strings.ToLower(f.Name()) {
+	case "min", "max", "sum", "avg":
+		return true
+	default:
+		return false
+	}
+}
+
+// innerOnly returns whether the expression given only uses columns of the inner tables given, and doesn't contain
+// subqueries or window functions.
+func innerOnly(inner *correlationTables, e sql.Expression) bool {
+	ok := true
+	sql.Inspect(e, func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.UnresolvedColumn:
+			_, _, ok = inner.column(e)
+		case *expression.UnresolvedFunction:
+			ok = e.Window == nil
+		case *plan.Subquery, *expression.Star:
+			ok = false
+		}
+		return ok
+	})
+	return ok
+}
+
+// correlationKeys returns the outer column, qualified with its table, and the inner column of an equality between
+// a column of the outer query and a column of the subquery.
+func correlationKeys(inner, outer *correlationTables, eq *expression.Equals) (sql.Expression, sql.Expression, bool) {
+	for _, pair := range [][2]sql.Expression{{eq.Left(), eq.Right()}, {eq.Right(), eq.Left()}} {
+		outerCol, ok := pair[0].(*expression.UnresolvedColumn)
+		if !ok {
+			continue
+		}
+		innerCol, ok := pair[1].(*expression.UnresolvedColumn)
+		if !ok {
+			continue
+		}
+		// Columns of the subquery hide the columns of the outer query with the same name
+		if _, _, found := inner.column(outerCol); found {
+			continue
+		}
+		_, col, found := inner.column(innerCol)
+		if !found {
+			continue
+		}
+		if key, found := qualifiedOuterColumn(outer, outerCol, col); found {
+			return key, innerCol, true
+		}
+	}
+	return nil, nil, false
+}
+
+// qualifiedOuterColumn returns the outer column given, qualified with its table, if its values are equal to the
+// values of the inner column given exactly when they are grouped together: when both are integers, or both are text,
+// which is compared byte by byte.
+func qualifiedOuterColumn(outer *correlationTables, e sql.Expression, innerCol *sql.Column) (sql.Expression, bool) {
+	c, ok := e.(*expression.UnresolvedColumn)
+	if !ok || innerCol == nil {
+		return nil, false
+	}
+	table, col, ok := outer.column(c)
+	if !ok {
+		return nil, false
+	}
+	if !(sql.IsInteger(col.Type) && sql.IsInteger(innerCol.Type)) && !(sql.IsText(col.Type) && sql.IsText(innerCol.Type)) {
+		return nil, false
+	}
+	return expression.NewUnresolvedQualifiedColumn(table, col.Name), true
+}
+
+// qualifyOuterColumns qualifies the columns of the expression given with their outer tables, so that they can't be
+// mistaken for the columns of the derived tables. It returns false if the expression has a column the outer tables
+// don't have, or a subquery.
+func qualifyOuterColumns(outer *correlationTables, e sql.Expression) (sql.Expression, bool) {
+	ok := true
+	qualified, err := expression.TransformUp(e, func(e sql.Expression) (sql.Expression, error) {
+		switch e := e.(type) {
+		case *expression.UnresolvedColumn:
+			table, col, found := outer.column(e)
+			if !found {
+				ok = false
+				return e, nil
+			}
+			return expression.NewUnresolvedQualifiedColumn(table, col.Name), nil
+		case *plan.Subquery:
+			ok = false
+		}
+		return e, nil
+	})
+	if err != nil || !ok {
+		return nil, false
+	}
+	return qualified, true
+}
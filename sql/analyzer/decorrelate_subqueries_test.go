@@ -0,0 +1,176 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestDecorrelateSubqueries(t *testing.T) {
+	foo := memory.NewTable("foo", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: sql.Int64, Source: "foo"},
+		{Name: "f", Type: sql.Float64, Source: "foo"},
+	}))
+	bar := memory.NewTable("bar", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "b", Type: sql.Int64, Source: "bar"},
+		{Name: "k", Type: sql.Int32, Source: "bar"},
+	}))
+	db := memory.NewDatabase("mydb")
+	db.AddTable("foo", foo)
+	db.AddTable("bar", bar)
+
+	a := NewDefault(sql.NewDatabaseProvider(db))
+	fooTable := plan.NewResolvedTable(foo, db, nil)
+	barTable := plan.NewResolvedTable(bar, db, nil)
+	maxB := expression.NewUnresolvedFunction("max", true, nil, uc("b"))
+	countB := expression.NewUnresolvedFunction("count", true, nil, uc("b"))
+
+	testCases := []analyzerFnTestCase{
+		{
+			name: "exists",
+			node: plan.NewFilter(
+				plan.NewExistsSubquery(plan.NewSubquery(
+					plan.NewProject(
+						[]sql.Expression{expression.NewStar()},
+						plan.NewFilter(
+							expression.NewEquals(uqc("bar", "k"), uqc("foo", "a")),
+							plan.NewUnresolvedTable("bar", ""),
+						),
+					), "",
+				)),
+				fooTable,
+			),
+			expected: plan.NewProject(
+				[]sql.Expression{expression.NewQualifiedStar("foo")},
+				plan.NewInnerJoin(
+					fooTable,
+					plan.NewSubqueryAlias("decorrelated_subquery_1", "",
+						plan.NewDistinct(plan.NewProject(
+							[]sql.Expression{expression.NewAlias("k0", uqc("bar", "k"))},
+							barTable,
+						)),
+					),
+					expression.NewEquals(uqc("foo", "a"), uqc("decorrelated_subquery_1", "k0")),
+				),
+			),
+		},
+		{
+			name: "scalar aggregation",
+			node: plan.NewFilter(
+				expression.JoinAnd(
+					expression.NewGreaterThan(uc("f"), uc("a")),
+					expression.NewLessThan(
+						uc("a"),
+						plan.NewSubquery(
+							plan.NewGroupBy(
+								[]sql.Expression{maxB},
+								nil,
+								plan.NewFilter(
+									expression.JoinAnd(
+										expression.NewEquals(uc("k"), uqc("foo", "a")),
+										expression.NewGreaterThan(uc("b"), expression.NewLiteral(1, sql.Int64)),
+									),
+									plan.NewUnresolvedTable("bar", ""),
+								),
+							), "",
+						),
+					),
+				),
+				fooTable,
+			),
+			expected: plan.NewProject(
+				[]sql.Expression{expression.NewQualifiedStar("foo")},
+				plan.NewInnerJoin(
+					plan.NewFilter(expression.NewGreaterThan(uc("f"), uc("a")), fooTable),
+					plan.NewSubqueryAlias("decorrelated_subquery_1", "",
+						plan.NewGroupBy(
+							[]sql.Expression{expression.NewAlias("k0", uc("k")), expression.NewAlias("v", maxB)},
+							[]sql.Expression{uc("k")},
+							plan.NewFilter(
+								expression.NewGreaterThan(uc("b"), expression.NewLiteral(1, sql.Int64)),
+								barTable,
+							),
+						),
+					),
+					expression.JoinAnd(
+						expression.NewEquals(uqc("foo", "a"), uqc("decorrelated_subquery_1", "k0")),
+						expression.NewLessThan(uqc("foo", "a"), uqc("decorrelated_subquery_1", "v")),
+					),
+				),
+			),
+		},
+		{
+			name: "count of no rows isn't null",
+			node: plan.NewFilter(
+				expression.NewEquals(
+					uc("a"),
+					plan.NewSubquery(
+						plan.NewGroupBy(
+							[]sql.Expression{countB},
+							nil,
+							plan.NewFilter(
+								expression.NewEquals(uc("k"), uqc("foo", "a")),
+								plan.NewUnresolvedTable("bar", ""),
+							),
+						), "",
+					),
+				),
+				fooTable,
+			),
+		},
+		{
+			name: "correlation on a float column",
+			node: plan.NewFilter(
+				plan.NewExistsSubquery(plan.NewSubquery(
+					plan.NewProject(
+						[]sql.Expression{expression.NewStar()},
+						plan.NewFilter(
+							expression.NewEquals(uqc("bar", "k"), uqc("foo", "f")),
+							plan.NewUnresolvedTable("bar", ""),
+						),
+					), "",
+				)),
+				fooTable,
+			),
+		},
+		{
+			name: "correlation by an inequality",
+			node: plan.NewFilter(
+				plan.NewInSubquery(
+					uc("a"),
+					plan.NewSubquery(
+						plan.NewProject(
+							[]sql.Expression{uc("b")},
+							plan.NewFilter(
+								expression.NewLessThan(uqc("bar", "k"), uqc("foo", "a")),
+								plan.NewUnresolvedTable("bar", ""),
+							),
+						), "",
+					),
+				),
+				fooTable,
+			),
+		},
+	}
+
+	ctx := sql.NewContext(context.Background()).WithCurrentDB("mydb")
+	runTestCases(t, ctx, testCases, a, getRule("decorrelate_subqueries"))
+}
@@ -19,13 +19,22 @@ import (
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
-// resolveDescribeQuery resolves any DescribeQuery nodes by analyzing their child and assigning it back.
+// resolveDescribeQuery resolves any DescribeQuery nodes by analyzing their child and assigning it back. The timings of
+// the analysis are assigned as well for EXPLAIN ANALYZE.
 func resolveDescribeQuery(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
 	d, ok := n.(*plan.DescribeQuery)
 	if !ok {
 		return n, nil
 	}
 
+	if d.Analyze {
+		q, timings, err := a.AnalyzeWithTimings(ctx, d.Query(), scope)
+		if err != nil {
+			return nil, err
+		}
+		return d.WithAnalysis(timings.String()).WithQuery(StripQueryProcess(q)), nil
+	}
+
 	q, err := a.Analyze(ctx, d.Query(), scope)
 	if err != nil {
 		return nil, err
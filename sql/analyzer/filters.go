@@ -82,14 +82,59 @@ func exprToTableFilters(expr sql.Expression) filtersByTable {
 			return true
 		})
 
-		if len(seenTables) == 1 && !hasSubquery {
+		if hasSubquery {
+			continue
+		}
+
+		switch len(seenTables) {
+		case 0:
+		case 1:
 			filters[lastTable] = append(filters[lastTable], expr)
+		default:
+			if _, ok := expr.(*expression.Or); ok {
+				filters.merge(impliedTableFilters(expr))
+			}
 		}
 	}
 
 	return filters
 }
 
+// impliedTableFilters returns the filters on single tables implied by the disjunction given, which refers to more than
+// one table. A table gets a filter when every disjunct has predicates on that table alone, e.g.
+// (a.x = 1 AND b.y = 2) OR (a.x = 3 AND b.y = 4) implies (a.x = 1 OR a.x = 3) on a and (b.y = 2 OR b.y = 4) on b.
+// Implied filters narrow down the rows read from each table, but the disjunction itself must still be evaluated.
+func impliedTableFilters(expr sql.Expression) filtersByTable {
+	disjuncts := splitDisjunction(expr)
+	filtersByDisjunct := make([]filtersByTable, len(disjuncts))
+	for i, disjunct := range disjuncts {
+		filtersByDisjunct[i] = exprToTableFilters(disjunct)
+	}
+
+	implied := newFiltersByTable()
+	for table := range filtersByDisjunct[0] {
+		var terms []sql.Expression
+		seen := make(map[string]bool)
+		for _, filters := range filtersByDisjunct {
+			tableFilters, ok := filters[table]
+			if !ok {
+				terms = nil
+				break
+			}
+			term := expression.JoinAnd(tableFilters...)
+			if !seen[term.String()] {
+				seen[term.String()] = true
+				terms = append(terms, term)
+			}
+		}
+		if len(terms) > 0 {
+			implied[table] = []sql.Expression{expression.JoinOr(terms...)}
+		}
+	}
+
+	return implied
+}
+
 type filterSet struct {
 	filterPredicates    []sql.Expression
 	filtersByTable      filtersByTable
@@ -159,6 +204,19 @@ func splitConjunction(expr sql.Expression) []sql.Expression {
 	)
 }
 
+// splitDisjunction breaks OR expressions into their left and right parts, recursively
+func splitDisjunction(expr sql.Expression) []sql.Expression {
+	or, ok := expr.(*expression.Or)
+	if !ok {
+		return []sql.Expression{expr}
+	}
+
+	return append(
+		splitDisjunction(or.Left),
+		splitDisjunction(or.Right)...,
+	)
+}
+
 // subtractExprSet returns all expressions in the first parameter that aren't present in the second.
 func subtractExprSet(all, toSubtract []sql.Expression) []sql.Expression {
 	var remainder []sql.Expression
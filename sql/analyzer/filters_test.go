@@ -248,4 +248,34 @@ func TestExprToTableFilters(t *testing.T) {
 		},
 	}
 	assert.Equal(t, expected, filters)
+
+	// Disjunctions over more than one table imply filters on the tables constrained by every disjunct
+	filters = exprToTableFilters(expression.NewOr(
+		expression.NewOr(
+			expression.NewAnd(
+				eq(expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "a", false), lit(1)),
+				eq(expression.NewGetFieldWithTable(0, sql.Int64, "mytable2", "i", false), lit(2)),
+			),
+			expression.NewAnd(
+				eq(expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "a", false), lit(3)),
+				eq(expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "f", false), lit(4)),
+			),
+		),
+		expression.NewAnd(
+			eq(expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "a", false), lit(1)),
+			eq(expression.NewGetFieldWithTable(0, sql.Int64, "mytable2", "i", false), lit(5)),
+		),
+	))
+	expected = filtersByTable{
+		"mytable": []sql.Expression{
+			expression.NewOr(
+				eq(expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "a", false), lit(1)),
+				expression.NewAnd(
+					eq(expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "a", false), lit(3)),
+					eq(expression.NewGetFieldWithTable(0, sql.Int64, "mytable", "f", false), lit(4)),
+				),
+			),
+		},
+	}
+	assert.Equal(t, expected, filters)
 }
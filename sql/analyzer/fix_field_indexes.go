@@ -163,6 +163,26 @@ func FixFieldIndexesForExpressions(ctx *sql.Context, a *Analyzer, node sql.Node,
 			return nil, err
 		}
 
+		n, err = j.WithExpressions(cond)
+		if err != nil {
+			return nil, err
+		}
+	case *plan.HashJoin:
+		cond, err := FixFieldIndexes(ctx, scope, a, j.Schema(), j.Cond)
+		if err != nil {
+			return nil, err
+		}
+
+		n, err = j.WithExpressions(cond)
+		if err != nil {
+			return nil, err
+		}
+	case *plan.MergeJoin:
+		cond, err := FixFieldIndexes(ctx, scope, a, j.Schema(), j.Cond)
+		if err != nil {
+			return nil, err
+		}
+
 		n, err = j.WithExpressions(cond)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,93 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// foldConstantFunctions replaces the calls of deterministic functions whose arguments are all literals with the
+// literal result of the call, so that they're evaluated once during analysis rather than for each row. Calls are folded
+// from the bottom up, so those whose arguments are calls that can be folded are folded as well. Only the expressions of
+// query nodes are folded: the expressions of DDL statements, such as column defaults, are kept as written.
+func foldConstantFunctions(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	if !n.Resolved() {
+		return n, nil
+	}
+
+	fold := func(_ sql.Node, e sql.Expression) (sql.Expression, sql.TreeIdentity, error) {
+		return foldConstantFunction(ctx, e)
+	}
+
+	n, _, err := plan.TransformUpWithIdentity(n, nil, func(c plan.TransformContext) (sql.Node, sql.TreeIdentity, error) {
+		switch n := c.Node.(type) {
+		case *plan.Project:
+			return foldProjections(n, fold)
+		case *plan.Filter, *plan.Having, *plan.Sort, *plan.TopN, plan.JoinNode, *plan.Values, *plan.UpdateSource:
+			return plan.TransformExpressionsWithIdentity(n, fold)
+		default:
+			return n, sql.SameTree, nil
+		}
+	})
+	return n, err
+}
+
+// foldConstantFunction returns the literal result of the expression given if it's the call of a deterministic function
+// whose arguments are all literals. Calls that fail are kept, so that their error is returned when the query is run.
+func foldConstantFunction(ctx *sql.Context, e sql.Expression) (sql.Expression, sql.TreeIdentity, error) {
+	f, ok := e.(sql.FunctionExpression)
+	if !ok || !f.Resolved() || sql.GetFunctionVolatility(f) != sql.Deterministic {
+		return e, sql.SameTree, nil
+	}
+	for _, child := range f.Children() {
+		if _, ok := child.(*expression.Literal); !ok {
+			return e, sql.SameTree, nil
+		}
+	}
+
+	val, err := f.Eval(ctx, nil)
+	if err != nil {
+		return e, sql.SameTree, nil
+	}
+	return expression.NewLiteral(val, f.Type()), sql.NewTree, nil
+}
+
+// foldProjections folds the projections of the node given, keeping the name of those that are folded.
+func foldProjections(p *plan.Project, fold expression.ExprTransformer) (sql.Node, sql.TreeIdentity, error) {
+	var projections []sql.Expression
+	for i, e := range p.Projections {
+		folded, same, err := expression.TransformUpWithIdentity(p, e, fold)
+		if err != nil {
+			return nil, sql.SameTree, err
+		}
+		if same == sql.SameTree {
+			continue
+		}
+		if _, ok := folded.(*expression.Literal); ok {
+			folded = expression.NewAlias(e.String(), folded)
+		}
+		if projections == nil {
+			projections = append([]sql.Expression(nil), p.Projections...)
+		}
+		projections[i] = folded
+	}
+
+	if projections == nil {
+		return p, sql.SameTree, nil
+	}
+	return plan.NewProject(projections, p.Child), sql.NewTree, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+func TestFoldConstantFunctions(t *testing.T) {
+	require := require.New(t)
+	f := getRule("fold_constant_functions")
+
+	table := memory.NewTable("mytable", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "s", Source: "mytable", Type: sql.LongText},
+	}))
+	s := expression.NewGetFieldWithTable(0, sql.LongText, "mytable", "s", false)
+
+	lower := function.NewLower(expression.NewLiteral("ABC", sql.LongText))
+	concat, err := function.NewConcat(
+		function.NewLower(expression.NewLiteral("A", sql.LongText)),
+		expression.NewLiteral("b", sql.LongText),
+	)
+	require.NoError(err)
+	concatColumn, err := function.NewConcat(s, expression.NewLiteral("b", sql.LongText))
+	require.NoError(err)
+	rand, err := function.NewRand(expression.NewLiteral(1, sql.Int64))
+	require.NoError(err)
+
+	node := plan.NewProject(
+		[]sql.Expression{
+			lower,
+			expression.NewAlias("c", concat),
+			concatColumn,
+			rand,
+		},
+		plan.NewFilter(
+			expression.NewEquals(s, function.NewUpper(expression.NewLiteral("x", sql.LongText))),
+			plan.NewResolvedTable(table, nil, nil),
+		),
+	)
+
+	expected := plan.NewProject(
+		[]sql.Expression{
+			expression.NewAlias(lower.String(), expression.NewLiteral("abc", sql.LongText)),
+			expression.NewAlias("c", expression.NewLiteral("ab", sql.LongText)),
+			concatColumn,
+			rand,
+		},
+		plan.NewFilter(
+			expression.NewEquals(s, expression.NewLiteral("X", sql.LongText)),
+			plan.NewResolvedTable(table, nil, nil),
+		),
+	)
+
+	result, err := f.Apply(sql.NewEmptyContext(), NewDefault(nil), node, nil)
+	require.NoError(err)
+	require.Equal(expected, result)
+
+	// Nodes without calls to fold are kept as is
+	result, err = f.Apply(sql.NewEmptyContext(), NewDefault(nil), expected, nil)
+	require.NoError(err)
+	require.True(result == sql.Node(expected))
+}
@@ -79,7 +79,13 @@ func replaceJoinPlans(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (
 				return n, nil
 			}
 
-			return replanJoin(ctx, n, a, joinIndexes, scope)
+			// The filter above the join, if any, tells how many rows of each table are joined
+			var filter sql.Expression
+			if f, ok := c.Parent.(*plan.Filter); ok {
+				filter = f.Expression
+			}
+
+			return replanJoin(ctx, n, a, joinIndexes, filter, scope)
 		default:
 			return n, nil
 		}
@@ -302,7 +308,7 @@ func replaceIndexedAccessInUnaryNode(
 	return newNode, replaced, nil
 }
 
-func replanJoin(ctx *sql.Context, node plan.JoinNode, a *Analyzer, joinIndexes joinIndexesByTable, scope *Scope) (sql.Node, error) {
+func replanJoin(ctx *sql.Context, node plan.JoinNode, a *Analyzer, joinIndexes joinIndexesByTable, filter sql.Expression, scope *Scope) (sql.Node, error) {
 	// Inspect the node for eligibility. The join planner rewrites the tree beneath this node, and for this to be correct
 	// only certain nodes can be below it.
 	eligible := true
@@ -342,7 +348,7 @@ func replanJoin(ctx *sql.Context, node plan.JoinNode, a *Analyzer, joinIndexes j
 	}
 
 	if !ordered {
-		err := tableJoinOrder.estimateCost(ctx, joinIndexes)
+		err := tableJoinOrder.estimateCost(ctx, newJoinCostModel(tableJoinOrder, joinIndexes, filter))
 		if err != nil {
 			return nil, err
 		}
@@ -560,9 +566,11 @@ func findJoinIndexesByTable(
 	var err error
 	var conds []joinCond
 
-	// collect all the conds for the entire tree together
+	// collect all the conds for the entire tree together. The joins of subqueries were already planned on their own.
 	plan.Inspect(node, func(node sql.Node) bool {
 		switch node := node.(type) {
+		case *plan.SubqueryAlias:
+			return false
 		case plan.JoinNode:
 			conds = append(conds, joinCond{
 				cond:           node.JoinCond(),
@@ -19,6 +19,7 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -102,10 +103,15 @@ func getIndexes(
 	var result = make(indexLookupsByTable)
 	switch e := e.(type) {
 	case *expression.Or:
-		// If more than one table is involved in a disjunction, we can't use indexed lookups. This is because we will
-		// inappropriately restrict the iterated values of the indexed table to matching index values, when during a cross
-		// join we must consider every row from each table.
+		// If more than one table is involved in a disjunction, we can't use its indexed lookups directly. This is because
+		// we will inappropriately restrict the iterated values of the indexed table to matching index values, when during
+		// a cross join we must consider every row from each table. The filters it implies on single tables are safe to
+		// use, since no row failing them can satisfy the disjunction.
 		if len(findTables(e)) > 1 {
+			return getImpliedIndexes(ctx, a, ia, e, tableAliases)
+		}
+		// Merging the lookups of the conditions of a disjunction is the index_merge feature.
+		if !ctx.FeatureEnabled(sql.FeatureIndexMerge) {
 			return nil, nil
 		}
 
@@ -229,6 +235,25 @@ func getIndexes(
 		result[getField.Table()] = lookup
 	case *expression.IsNull:
 		return getIndexes(ctx, a, ia, expression.NewEquals(e.Child, expression.NewLiteral(nil, sql.Null)), tableAliases)
+	case *function.STContains,
+		*function.STWithin,
+		*function.STIntersects,
+		*function.STTouches,
+		*function.MBRContains,
+		*function.MBRWithin,
+		*function.MBRIntersects,
+		*function.MBRTouches:
+		lookup, err := getSpatialIndexLookup(ctx, ia, e, tableAliases)
+		if err != nil || lookup == nil {
+			return result, err
+		}
+
+		getField := expression.ExtractGetField(e)
+		if getField == nil {
+			return result, nil
+		}
+
+		result[getField.Table()] = lookup
 	case *expression.Not:
 		r, err := getNegatedIndexes(ctx, a, ia, e, tableAliases)
 		if err != nil {
@@ -288,7 +313,13 @@ func getIndexes(
 
 		result := multiColumnIndexes
 		// Next try to match the remaining expressions individually
+		var disjunctions []sql.Expression
 		for _, e := range unusedExprs {
+			if or, ok := e.(*expression.Or); ok && len(findTables(or)) > 1 {
+				disjunctions = append(disjunctions, or)
+				continue
+			}
+
 			indexes, err := getIndexes(ctx, a, ia, e, tableAliases)
 			if err != nil {
 				return nil, err
@@ -307,12 +338,104 @@ func getIndexes(
 			}
 		}
 
+		// Lookups implied by disjunctions over several tables are only used for tables without a lookup of their own.
+		// They are a superset of the rows matching the whole expression, so it's always safe to leave them out.
+		for _, e := range disjunctions {
+			indexes, err := getIndexes(ctx, a, ia, e, tableAliases)
+			if err != nil {
+				return nil, err
+			}
+			for table, idx := range indexes {
+				if _, ok := result[table]; !ok {
+					result[table] = idx
+				}
+			}
+		}
+
 		return result, nil
 	}
 
 	return result, nil
 }
 
+// getImpliedIndexes returns the index lookups for the filters on single tables implied by the disjunction given, which
+// refers to more than one table.
+func getImpliedIndexes(
+	ctx *sql.Context,
+	a *Analyzer,
+	ia *indexAnalyzer,
+	or *expression.Or,
+	tableAliases TableAliases,
+) (indexLookupsByTable, error) {
+	result := make(indexLookupsByTable)
+	for _, filters := range impliedTableFilters(or) {
+		for _, filter := range filters {
+			indexes, err := getIndexes(ctx, a, ia, filter, tableAliases)
+			if err != nil {
+				return nil, err
+			}
+			for table, idx := range indexes {
+				result[table] = idx
+			}
+		}
+	}
+	return result, nil
+}
+
+// getSpatialIndexLookup returns the spatial index and index lookup for the given spatial predicate between a column
+// and a constant geometry, if the column has a spatial index. Each of these predicates can only hold for rows whose
+// geometries' minimum bounding rectangles intersect the constant geometry's, which is what the lookup finds.
+func getSpatialIndexLookup(
+	ctx *sql.Context,
+	ia *indexAnalyzer,
+	e sql.Expression,
+	tableAliases TableAliases,
+) (*indexLookup, error) {
+	children := e.Children()
+	column, geometry := children[0], children[1]
+	if !isEvaluable(geometry) {
+		column, geometry = geometry, column
+	}
+	if isEvaluable(column) || !isEvaluable(geometry) {
+		return nil, nil
+	}
+
+	gf, ok := column.(*expression.GetField)
+	if !ok {
+		return nil, nil
+	}
+
+	normalizedExpressions := normalizeExpressions(ctx, tableAliases, gf)
+	for _, idx := range ia.MatchingIndexes(ctx, ctx.GetCurrentDatabase(), gf.Table(), normalizedExpressions...) {
+		spatialIdx, ok := idx.(sql.SpatialIndex)
+		if !ok {
+			continue
+		}
+
+		value, err := geometry.Eval(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		bounds, ok := sql.GeometryBoundingBox(value)
+		if !ok {
+			return nil, nil
+		}
+
+		lookup, err := spatialIdx.NewSpatialLookup(ctx, bounds)
+		if err != nil || lookup == nil {
+			return nil, err
+		}
+
+		return &indexLookup{
+			exprs:   []sql.Expression{gf},
+			lookup:  lookup,
+			indexes: []sql.Index{idx},
+		}, nil
+	}
+
+	return nil, nil
+}
+
 // getComparisonIndexLookup returns the index and index lookup for the given
 // comparison if any index can be found.
 // It works for the following comparisons: eq, lt, gt, gte and lte.
@@ -1027,6 +1150,13 @@ func canMergeIndexes(a, b sql.IndexLookup) bool {
 	if ai.Database() != bi.Database() || ai.Table() != bi.Table() {
 		return false
 	}
+	// Lookups of spatial indexes are for rectangles rather than ranges, so they can't be merged
+	if _, ok := ai.(sql.SpatialIndex); ok {
+		return false
+	}
+	if _, ok := bi.(sql.SpatialIndex); ok {
+		return false
+	}
 	aiExprs := ai.Expressions()
 	biExprs := bi.Expressions()
 	if len(aiExprs) != len(biExprs) {
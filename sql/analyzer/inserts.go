@@ -58,18 +58,6 @@ func resolveInsertRows(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 			}
 		}
 
-		source := insert.Source
-		// TriggerExecutor has already been analyzed
-		if _, ok := insert.Source.(*plan.TriggerExecutor); !ok {
-			// Analyze the source of the insert independently
-			source, err = a.Analyze(ctx, insert.Source, scope)
-			if err != nil {
-				return nil, err
-			}
-
-			source = StripQueryProcess(source)
-		}
-
 		dstSchema := insertable.Schema()
 
 		// normalize the column name
@@ -79,7 +67,7 @@ func resolveInsertRows(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 		}
 
 		// If no columns are given and value tuples are not all empty, use the full schema
-		if len(columnNames) == 0 && existsNonZeroValueCount(source) {
+		if len(columnNames) == 0 && existsNonZeroValueCount(insert.Source) {
 			columnNames = make([]string, len(dstSchema))
 			for i, f := range dstSchema {
 				columnNames[i] = f.Name
@@ -91,6 +79,25 @@ func resolveInsertRows(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 			}
 		}
 
+		source := insert.Source
+		if values, ok := source.(*plan.Values); ok {
+			source, columnNames, err = resolveValuesDefaults(ctx, values, columnNames, insert.Destination.Schema())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// TriggerExecutor has already been analyzed
+		if _, ok := source.(*plan.TriggerExecutor); !ok {
+			// Analyze the source of the insert independently
+			source, err = a.Analyze(ctx, source, scope)
+			if err != nil {
+				return nil, err
+			}
+
+			source = StripQueryProcess(source)
+		}
+
 		err = validateValueCount(columnNames, source)
 		if err != nil {
 			return nil, err
@@ -121,6 +128,77 @@ func existsNonZeroValueCount(values sql.Node) bool {
 	return false
 }
 
+// resolveValuesDefaults replaces the DEFAULT keyword and the DEFAULT(col) function in the rows of an INSERT ... VALUES
+// statement with the default values of their columns, and returns the new rows along with the columns they're inserted
+// into. Columns given DEFAULT in every row are removed from the insert instead, so that their default values are
+// evaluated as those of omitted columns, which may reference the other columns of the row.
+func resolveValuesDefaults(ctx *sql.Context, values *plan.Values, columnNames []string, schema sql.Schema) (sql.Node, []string, error) {
+	isDefaultKeyword := func(e sql.Expression) bool {
+		defaultColumn, ok := e.(*expression.DefaultColumn)
+		return ok && defaultColumn.Name() == ""
+	}
+
+	omitted := make([]bool, len(columnNames))
+	var newColumnNames []string
+	for j, name := range columnNames {
+		omitted[j] = len(values.ExpressionTuples) > 0
+		for _, tuple := range values.ExpressionTuples {
+			if j >= len(tuple) || !isDefaultKeyword(tuple[j]) {
+				omitted[j] = false
+				break
+			}
+		}
+		if !omitted[j] {
+			newColumnNames = append(newColumnNames, name)
+		}
+	}
+
+	tuples := make([][]sql.Expression, len(values.ExpressionTuples))
+	for i, tuple := range values.ExpressionTuples {
+		newTuple := make([]sql.Expression, 0, len(tuple))
+		for j, e := range tuple {
+			if j < len(omitted) && omitted[j] {
+				continue
+			}
+
+			if isDefaultKeyword(e) && j < len(columnNames) {
+				col := findColumn(schema, "", columnNames[j])
+				if col == nil {
+					return nil, nil, plan.ErrInsertIntoNonexistentColumn.New(columnNames[j])
+				}
+				if col.Default != nil && referencesColumns(col.Default) {
+					return nil, nil, sql.ErrUnsupportedFeature.New("DEFAULT in only some rows for a column whose default value references other columns")
+				}
+				def, err := columnDefaultExpression(ctx, schema, col, false)
+				if err != nil {
+					return nil, nil, err
+				}
+				newTuple = append(newTuple, def)
+				continue
+			}
+
+			expr, err := expression.TransformUp(e, func(e sql.Expression) (sql.Expression, error) {
+				defaultColumn, ok := e.(*expression.DefaultColumn)
+				if !ok || defaultColumn.Name() == "" {
+					return e, nil
+				}
+				col := findColumn(schema, "", defaultColumn.Name())
+				if col == nil {
+					return nil, sql.ErrColumnNotFound.New(defaultColumn.Name())
+				}
+				return columnDefaultExpression(ctx, schema, col, true)
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			newTuple = append(newTuple, expr)
+		}
+		tuples[i] = newTuple
+	}
+
+	return plan.NewValues(tuples), newColumnNames, nil
+}
+
 // wrapRowSource wraps the original row source in a projection so that its schema matches the full schema of the
 // underlying table, in the same order.
 func wrapRowSource(ctx *sql.Context, insertSource sql.Node, destTbl sql.Table, schema sql.Schema, columnNames []string) (sql.Node, error) {
@@ -0,0 +1,257 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// defaultTableRows is the number of rows assumed for the tables without statistics, and for subqueries, which are
+// opaque to the join planner.
+const defaultTableRows = float64(1000)
+
+// joinCostModel estimates the number of rows returned by the joins of a set of tables, and the cost of computing them
+// in a given order, from the number of rows of the tables and the cardinalities of their columns reported by
+// sql.StatisticsTable and sql.ColumnStatisticsTable. The number of rows matching an equality comparison of two columns
+// is estimated as the product of the rows of their tables divided by the larger of their cardinalities, and that of an
+// equality comparison of a column with a constant as the rows of its table divided by its cardinality. Columns without
+// a cardinality are assumed to have a distinct value for every row.
+//
+// The cost of joining a table to the rows of the tables before it is the number of lookups of its rows when it has an
+// index usable for the join, or that of reading all its rows for every row before it otherwise, plus the number of rows
+// the join returns. Orders that keep the intermediate results small are the cheapest.
+type joinCostModel struct {
+	joinIndexes joinIndexesByTable
+	// filters are the comparisons of columns of the joined tables with constants, such as those of a filter above the
+	// join, by lower case table name.
+	filters map[string][]sql.Expression
+	// tables are the leaf joinOrderNodes of the joined tables, by lower case name.
+	tables map[string]*joinOrderNode
+}
+
+// newJoinCostModel returns a joinCostModel for the tables of the joinOrderNode given, with the join indexes given and
+// the filter on the rows of the join, if any.
+func newJoinCostModel(jo *joinOrderNode, joinIndexes joinIndexesByTable, filter sql.Expression) *joinCostModel {
+	m := &joinCostModel{
+		joinIndexes: joinIndexes,
+		filters:     make(map[string][]sql.Expression),
+		tables:      make(map[string]*joinOrderNode),
+	}
+	jo.visitLeaves(func(leaf *joinOrderNode) {
+		m.tables[strings.ToLower(leaf.node.Name())] = leaf
+	})
+
+	if filter != nil {
+		for _, e := range splitConjunction(filter) {
+			if field := constantComparisonField(e); field != nil {
+				table := strings.ToLower(field.Table())
+				m.filters[table] = append(m.filters[table], e)
+			}
+		}
+	}
+	return m
+}
+
+// constantComparisonField returns the column an equality comparison of a column with a constant compares, or nil if
+// the expression given is not one.
+func constantComparisonField(e sql.Expression) *expression.GetField {
+	eq, ok := e.(*expression.Equals)
+	if !ok {
+		return nil
+	}
+	left, right := eq.Left(), eq.Right()
+	if isEvaluable(left) {
+		left, right = right, left
+	}
+	field, ok := left.(*expression.GetField)
+	if !ok || !isEvaluable(right) {
+		return nil
+	}
+	return field
+}
+
+// estimateTable sets the number of rows of the leaf joinOrderNode given, before and after applying the filters on its
+// table.
+func (m *joinCostModel) estimateTable(ctx *sql.Context, jo *joinOrderNode) error {
+	jo.tableRows = defaultTableRows
+	switch node := jo.node.(type) {
+	case *plan.SubqueryAlias:
+	case *plan.ValueDerivedTable:
+		jo.tableRows = float64(len(node.ExpressionTuples))
+	default:
+		rt := getResolvedTable(jo.node)
+		if st, ok := rt.Table.(sql.StatisticsTable); ok {
+			numRows, err := st.NumRows(ctx)
+			if err != nil {
+				return err
+			}
+			jo.tableRows = float64(numRows)
+		}
+	}
+
+	// Reading the table reads all its rows, but only those matching the filters are joined
+	// TODO: also consider indexes which could be pushed down to this table, if it's the first one
+	jo.cost = jo.tableRows
+	jo.rows = jo.tableRows
+	for _, f := range m.filters[strings.ToLower(jo.node.Name())] {
+		cardinality, err := m.cardinality(ctx, constantComparisonField(f))
+		if err != nil {
+			return err
+		}
+		jo.rows /= cardinality
+	}
+	return nil
+}
+
+// cardinality returns the estimated number of distinct values of the column given, which is at least one, and no more
+// than the rows of its table. Columns of tables outside the join have a cardinality of one.
+func (m *joinCostModel) cardinality(ctx *sql.Context, field *expression.GetField) (float64, error) {
+	jo, ok := m.tables[strings.ToLower(field.Table())]
+	if !ok {
+		return 1, nil
+	}
+
+	column := strings.ToLower(field.Name())
+	if cardinality, ok := jo.cardinalities[column]; ok {
+		return cardinality, nil
+	}
+
+	cardinality := jo.tableRows
+	switch jo.node.(type) {
+	case *plan.SubqueryAlias, *plan.ValueDerivedTable:
+	default:
+		if cst, ok := getResolvedTable(jo.node).Table.(sql.ColumnStatisticsTable); ok {
+			distinct, ok, err := cst.ColumnCardinality(ctx, field.Name())
+			if err != nil {
+				return 0, err
+			}
+			if ok {
+				cardinality = float64(distinct)
+			}
+		}
+	}
+	if cardinality > jo.tableRows {
+		cardinality = jo.tableRows
+	}
+	if cardinality < 1 {
+		cardinality = 1
+	}
+
+	if jo.cardinalities == nil {
+		jo.cardinalities = make(map[string]float64)
+	}
+	jo.cardinalities[column] = cardinality
+	return cardinality, nil
+}
+
+// selectivity returns the estimated fraction of the combinations of the rows before a joinOrderNode, whose schema is
+// given, and the rows of the node, that match the join conditions between them.
+func (m *joinCostModel) selectivity(ctx *sql.Context, jo *joinOrderNode, schema sql.Schema) (float64, error) {
+	selectivity := float64(1)
+	for _, table := range jo.tableNames() {
+		for _, ji := range m.joinIndexes[table] {
+			if !schemaContainsFields(schema, ji.comparandCols) {
+				continue
+			}
+			s, err := m.conditionSelectivity(ctx, ji)
+			if err != nil {
+				return 0, err
+			}
+			selectivity *= s
+		}
+	}
+	return selectivity, nil
+}
+
+// conditionSelectivity returns the estimated fraction of the combinations of rows of the tables compared by the join
+// condition of the joinIndex given that match it.
+func (m *joinCostModel) conditionSelectivity(ctx *sql.Context, ji *joinIndex) (float64, error) {
+	if ji.disjunction[0] != nil {
+		left, err := m.conditionSelectivity(ctx, ji.disjunction[0])
+		if err != nil {
+			return 0, err
+		}
+		right, err := m.conditionSelectivity(ctx, ji.disjunction[1])
+		if err != nil {
+			return 0, err
+		}
+		if left+right > 1 {
+			return 1, nil
+		}
+		return left + right, nil
+	}
+
+	selectivity := float64(1)
+	for i, col := range ji.cols {
+		cardinality, err := m.cardinality(ctx, col)
+		if err != nil {
+			return 0, err
+		}
+		comparandCardinality, err := m.cardinality(ctx, ji.comparandCols[i])
+		if err != nil {
+			return 0, err
+		}
+		if comparandCardinality > cardinality {
+			cardinality = comparandCardinality
+		}
+		selectivity /= cardinality
+	}
+	return selectivity, nil
+}
+
+// joinCost returns the estimated cost of joining the joinOrderNode given to the rows before it, whose number and
+// schema are given, along with the number of rows the join returns.
+func (m *joinCostModel) joinCost(ctx *sql.Context, jo *joinOrderNode, rows float64, schema sql.Schema) (cost float64, joinedRows float64, err error) {
+	selectivity, err := m.selectivity(ctx, jo, schema)
+	if err != nil {
+		return 0, 0, err
+	}
+	joinedRows = rows * jo.rows * selectivity
+
+	if m.hasUsableIndex(jo, schema) {
+		return rows + joinedRows, joinedRows, nil
+	}
+	return rows*jo.cost + joinedRows, joinedRows, nil
+}
+
+// hasUsableIndex returns whether the joinOrderNode given is a table with an index usable to look up its rows matching
+// those before it, whose schema is given.
+func (m *joinCostModel) hasUsableIndex(jo *joinOrderNode, schema sql.Schema) bool {
+	if jo.node == nil {
+		return false
+	}
+	switch jo.node.(type) {
+	case *plan.SubqueryAlias, *plan.ValueDerivedTable:
+		return false
+	}
+	available := make(sql.Schema, 0, len(schema)+len(jo.node.Schema()))
+	available = append(available, schema...)
+	available = append(available, jo.node.Schema()...)
+	return m.joinIndexes[strings.ToLower(jo.node.Name())].getUsableIndex(available) != nil
+}
+
+// schemaContainsFields returns whether the schema given has all the fields given.
+func schemaContainsFields(schema sql.Schema, fields []*expression.GetField) bool {
+	for _, field := range fields {
+		if !schemaContainsField(schema, field) {
+			return false
+		}
+	}
+	return true
+}
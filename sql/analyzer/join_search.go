@@ -129,7 +129,15 @@ type joinOrderNode struct {
 	left     *joinOrderNode
 	right    *joinOrderNode
 	order    []int
-	cost     uint64
+	// cost is the estimated cost of accessing the tables of this node
+	// in `order`, and rows the estimated number of rows they return.
+	cost float64
+	rows float64
+	// tableRows is the number of rows of the table of a leaf node,
+	// and cardinalities the estimated number of distinct values of
+	// its columns, by lower case name.
+	tableRows     float64
+	cardinalities map[string]float64
 }
 
 func (jo *joinOrderNode) String() string {
@@ -266,46 +274,35 @@ func (jo *joinOrderNode) tables() []NameableNode {
 	}
 }
 
-// estimateCost sets `jo.cost` and `jo.order` for this
+// estimateCost sets `jo.cost`, `jo.rows` and `jo.order` for this
 // `joinOrderNode`, taking into account the cost of its children and
 // attempting to find the lowest cost assignment by varying
 // `jo.order` for commutable nodes.
-func (jo *joinOrderNode) estimateCost(ctx *sql.Context, joinIndexes joinIndexesByTable) error {
+func (jo *joinOrderNode) estimateCost(ctx *sql.Context, m *joinCostModel) error {
 	if jo.node != nil {
-		// Subqueries are considered opaque in this analysis, so give them the opaque table cost.
-		switch node := jo.node.(type) {
-		case *plan.SubqueryAlias:
-			jo.cost = uint64(1000)
-			return nil
-		case *plan.ValueDerivedTable:
-			jo.cost = uint64(len(node.ExpressionTuples))
-			return nil
-		}
-
-		rt := getResolvedTable(jo.node)
-		// TODO: also consider indexes which could be pushed down to this table, if it's the first one
-		if st, ok := rt.Table.(sql.StatisticsTable); ok {
-			numRows, err := st.NumRows(ctx)
-			if err != nil {
-				return err
-			}
-			jo.cost = numRows
-		} else {
-			jo.cost = uint64(1000)
-		}
+		return m.estimateTable(ctx, jo)
 	} else if jo.left != nil {
-		err := jo.left.estimateCost(ctx, joinIndexes)
+		err := jo.left.estimateCost(ctx, m)
+		if err != nil {
+			return err
+		}
+		err = jo.right.estimateCost(ctx, m)
 		if err != nil {
 			return err
 		}
-		err = jo.right.estimateCost(ctx, joinIndexes)
+		cost, rows, err := m.joinCost(ctx, jo.right, jo.left.rows, jo.left.schema())
 		if err != nil {
 			return err
 		}
-		jo.cost = jo.left.cost * jo.right.cost
+		// Left joins return every row of their left side at least once
+		if rows < jo.left.rows {
+			rows = jo.left.rows
+		}
+		jo.cost = jo.left.cost + cost
+		jo.rows = rows
 	} else {
 		for i := range jo.commutes {
-			err := jo.commutes[i].estimateCost(ctx, joinIndexes)
+			err := jo.commutes[i].estimateCost(ctx, m)
 			if err != nil {
 				return err
 			}
@@ -314,17 +311,18 @@ func (jo *joinOrderNode) estimateCost(ctx *sql.Context, joinIndexes joinIndexesB
 		for i := range jo.commutes {
 			indexes[i] = i
 		}
-		lowestCost := uint64(math.MaxUint64)
+		lowestCost := math.Inf(1)
 		accessOrders := permutations(indexes)
 		lowestCostIdx := 0
 		for i, accessOrder := range accessOrders {
-			cost, err := jo.estimateAccessOrderCost(ctx, accessOrder, joinIndexes, lowestCost)
+			cost, rows, err := jo.estimateAccessOrderCost(ctx, m, accessOrder, lowestCost)
 			if err != nil {
 				return err
 			}
 			if cost < lowestCost {
 				lowestCost = cost
 				lowestCostIdx = i
+				jo.rows = rows
 			}
 		}
 		jo.order = accessOrders[lowestCostIdx]
@@ -334,28 +332,26 @@ func (jo *joinOrderNode) estimateCost(ctx *sql.Context, joinIndexes joinIndexesB
 	return nil
 }
 
-func (jo *joinOrderNode) estimateAccessOrderCost(ctx *sql.Context, accessOrder []int, joinIndexes joinIndexesByTable, lowestCost uint64) (uint64, error) {
-	cost := uint64(1)
-	var availableSchemaForKeys sql.Schema
-	for i, idx := range accessOrder {
+// estimateAccessOrderCost returns the cost of joining the commutable nodes of this `joinOrderNode` in the order given,
+// along with the number of rows they return. Once the cost reaches the lowest cost given, the estimation stops and the
+// cost so far is returned.
+func (jo *joinOrderNode) estimateAccessOrderCost(ctx *sql.Context, m *joinCostModel, accessOrder []int, lowestCost float64) (float64, float64, error) {
+	first := &jo.commutes[accessOrder[0]]
+	cost, rows := first.cost, first.rows
+	availableSchemaForKeys := first.schema()
+	for _, idx := range accessOrder[1:] {
 		if cost >= lowestCost {
-			return cost, nil
+			return cost, rows, nil
 		}
-		availableSchemaForKeys = append(availableSchemaForKeys, jo.commutes[idx].schema()...)
-		if jo.commutes[idx].node != nil {
-			indexes := joinIndexes[strings.ToLower(jo.commutes[idx].node.Name())]
-			_, isSubquery := jo.commutes[idx].node.(*plan.SubqueryAlias)
-			_, isValuesTable := jo.commutes[idx].node.(*plan.ValueDerivedTable)
-			if i == 0 || isSubquery || isValuesTable || indexes.getUsableIndex(availableSchemaForKeys) == nil {
-				cost *= jo.commutes[idx].cost
-			} else {
-				cost += 1
-			}
-		} else {
-			cost *= jo.commutes[idx].cost
+		joinCost, joinedRows, err := m.joinCost(ctx, &jo.commutes[idx], rows, availableSchemaForKeys)
+		if err != nil {
+			return 0, 0, err
 		}
+		cost += joinCost
+		rows = joinedRows
+		availableSchemaForKeys = append(availableSchemaForKeys, jo.commutes[idx].schema()...)
 	}
-	return cost, nil
+	return cost, rows, nil
 }
 
 func (jo *joinOrderNode) schema() sql.Schema {
@@ -372,6 +368,21 @@ func (jo *joinOrderNode) schema() sql.Schema {
 	}
 }
 
+// visitLeaves calls the function given with every leaf node of this
+// `joinOrderNode`.
+func (jo *joinOrderNode) visitLeaves(cb func(leaf *joinOrderNode)) {
+	if jo.node != nil {
+		cb(jo)
+	} else if jo.left != nil {
+		jo.left.visitLeaves(cb)
+		jo.right.visitLeaves(cb)
+	} else {
+		for i := range jo.commutes {
+			jo.commutes[i].visitLeaves(cb)
+		}
+	}
+}
+
 func (jo *joinOrderNode) visitJoinSearchNodes(cb func(n *joinSearchNode) bool) {
 	if jo.node != nil {
 		cb(&joinSearchNode{table: jo.node.Name()})
@@ -0,0 +1,49 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import "github.com/dolthub/go-mysql-server/sql"
+
+// openTable records that the table named was opened by a statement, which is reported by the Open_tables and
+// Opened_tables status variables. Like in MySQL's table cache, a table stays open until FLUSH TABLES closes it or its
+// schema changes, and is only counted as opened again after that.
+func (c *Catalog) openTable(ctx *sql.Context, db, table string) {
+	c.versionsMu.Lock()
+	defer c.versionsMu.Unlock()
+
+	key := newSchemaVersionKey(db, table)
+	if _, ok := c.openTables[key]; ok {
+		return
+	}
+	if c.openTables == nil {
+		c.openTables = make(map[schemaVersionKey]struct{})
+	}
+	c.openTables[key] = struct{}{}
+
+	c.StatusVariables.Increment(ctx, "Opened_tables", 1)
+	c.StatusVariables.SetGlobal("Open_tables", int64(len(c.openTables)))
+}
+
+// closeTables closes the open table named, or every open table in the database named if the table name is empty. The
+// caller must hold versionsMu.
+func (c *Catalog) closeTables(db, table string) {
+	key := newSchemaVersionKey(db, table)
+	for open := range c.openTables {
+		if open.db == key.db && (key.table == "" || open.table == key.table) {
+			delete(c.openTables, open)
+		}
+	}
+	c.StatusVariables.SetGlobal("Open_tables", int64(len(c.openTables)))
+}
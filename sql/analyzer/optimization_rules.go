@@ -286,7 +286,8 @@ func evalFilter(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope) (sql
 			case *expression.Literal, expression.Tuple, *expression.Interval:
 				return e, nil
 			default:
-				if !isEvaluable(e) {
+				// Volatile expressions, like RAND(), must be evaluated for each row
+				if !isEvaluable(e) || sql.IsVolatile(e) {
 					return e, nil
 				}
 
@@ -15,11 +15,14 @@
 package analyzer
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/go-kit/kit/metrics/discard"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
@@ -62,7 +65,249 @@ func parallelize(ctx *sql.Context, a *Analyzer, node sql.Node, scope *Scope) (sq
 		return nil, err
 	}
 
-	return plan.TransformUp(node, removeRedundantExchanges)
+	node, err = plan.TransformUp(node, removeRedundantExchanges)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err = plan.TransformUp(node, preserveExchangeOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.DeterministicOrder {
+		node, err = plan.TransformUp(node, preservePartitionOrder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	node, err = plan.TransformUp(node, splitAggregations)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan.TransformUp(node, distributeExchanges)
+}
+
+// splitAggregations splits the GroupBy nodes right above an exchange into
+// a partial aggregation of the rows of each partition, below the exchange,
+// and a final aggregation of the partial results of all partitions. The
+// workers of the exchange then aggregate the rows of their partitions
+// instead of sending all of them to the GroupBy. Only COUNT, SUM, AVG, MIN,
+// MAX, FIRST and LAST, whose partial results can be combined, are split.
+// Exchanges that merge sorted partitions or keep their order are kept as
+// they are, since the sums of the partial aggregations could differ from
+// those of the rows in their order.
+func splitAggregations(node sql.Node) (sql.Node, error) {
+	gb, ok := node.(*plan.GroupBy)
+	if !ok {
+		return node, nil
+	}
+	// Decorations between the GroupBy and the exchange go below the partial
+	// aggregations, with the child of the exchange
+	var decorations []*plan.DecoratedNode
+	child := gb.Child
+	for {
+		decorated, ok := child.(*plan.DecoratedNode)
+		if !ok {
+			break
+		}
+		decorations = append(decorations, decorated)
+		child = decorated.Child
+	}
+	exchange, ok := child.(*plan.Exchange)
+	if !ok || len(exchange.SortFields) > 0 || exchange.PartitionOrder {
+		return node, nil
+	}
+	for _, e := range gb.Expressions() {
+		if containsSubquery(e) {
+			return node, nil
+		}
+	}
+
+	// partialField returns the field of the rows of the partial aggregation
+	// with the value of the expression given, adding it to them. The field
+	// has the name of the column of the partial aggregation, like the fields
+	// the analyzer resolves.
+	var partial []sql.Expression
+	partialField := func(e sql.Expression) *expression.GetField {
+		partial = append(partial, e)
+		name := e.String()
+		if n, ok := e.(sql.Nameable); ok {
+			name = n.Name()
+		}
+		var table string
+		if t, ok := e.(sql.Tableable); ok {
+			table = t.Table()
+		}
+		return expression.NewGetFieldWithTable(len(partial)-1, e.Type(), table, name, e.IsNullable())
+	}
+
+	grouping := make([]sql.Expression, len(gb.GroupByExprs))
+	groupingFields := make(map[string]*expression.GetField)
+	for i, e := range gb.GroupByExprs {
+		field := partialField(e)
+		grouping[i] = field
+		groupingFields[e.String()] = field
+	}
+
+	selected := make([]sql.Expression, len(gb.SelectedExprs))
+	for i, e := range gb.SelectedExprs {
+		if alias, ok := e.(*expression.Alias); ok {
+			e = alias.Child
+		}
+
+		var final sql.Expression
+		switch agg := e.(type) {
+		case *aggregation.Count:
+			final = aggregation.NewCountMerge(partialField(agg))
+		case *aggregation.Sum:
+			final = aggregation.NewSum(partialField(agg))
+		case *aggregation.Avg:
+			sum := partialField(aggregation.NewSum(agg.Child))
+			count := partialField(aggregation.NewCount(agg.Child))
+			final = aggregation.NewAvgMerge(sum, count)
+		case *aggregation.Min:
+			final = aggregation.NewMin(partialField(agg))
+		case *aggregation.Max:
+			final = aggregation.NewMax(partialField(agg))
+		case *aggregation.First:
+			final = aggregation.NewFirst(partialField(agg))
+		case *aggregation.Last:
+			final = aggregation.NewLast(partialField(agg))
+		default:
+			if containsAggregation(e) {
+				return node, nil
+			}
+			// Other expressions take the last value of their group
+			if field, ok := groupingFields[e.String()]; ok {
+				final = field
+			} else {
+				final = partialField(e)
+			}
+		}
+
+		selected[i] = final
+	}
+
+	// The final aggregation returns the same columns as the GroupBy
+	final := plan.NewGroupBy(selected, grouping, nil)
+	finalSchema := final.Schema()
+	columns := make([]sql.Expression, len(finalSchema))
+	for i, col := range gb.Schema() {
+		finalCol := finalSchema[i]
+		columns[i] = expression.NewGetFieldWithTable(i, finalCol.Type, finalCol.Source, finalCol.Name, finalCol.Nullable)
+		if finalCol.Name != col.Name || finalCol.Source != col.Source {
+			columns[i] = expression.NewAlias(col.Name, columns[i])
+		}
+	}
+
+	child = exchange.Child
+	for i := len(decorations) - 1; i >= 0; i-- {
+		child = plan.NewDecoratedNode(decorations[i].Decoration(), child)
+	}
+	partialExchange, err := exchange.WithChildren(plan.NewGroupBy(partial, gb.GroupByExprs, child))
+	if err != nil {
+		return nil, err
+	}
+	final.Child = partialExchange
+	return plan.NewProject(columns, final), nil
+}
+
+// distributeExchanges replaces the exchanges of sharded tables with
+// distributed exchanges, which read the partitions of each node that
+// stores them concurrently and retry them when they fail. Exchanges that
+// merge sorted partitions or keep their order are kept as they are.
+func distributeExchanges(node sql.Node) (sql.Node, error) {
+	exchange, ok := node.(*plan.Exchange)
+	if !ok || len(exchange.SortFields) > 0 || exchange.PartitionOrder {
+		return node, nil
+	}
+
+	var sharded bool
+	plan.Inspect(exchange.Child, func(node sql.Node) bool {
+		if rt, ok := node.(*plan.ResolvedTable); ok {
+			sharded = isShardedTable(rt.Table)
+			return false
+		}
+		return true
+	})
+	if !sharded {
+		return node, nil
+	}
+
+	return plan.NewDistributedExchange(exchange.Parallelism, exchange.Child), nil
+}
+
+// isShardedTable returns whether the table given, or the table it wraps,
+// is a sharded table.
+func isShardedTable(t sql.Table) bool {
+	for {
+		if _, ok := t.(sql.ShardedTable); ok {
+			return true
+		}
+		w, ok := t.(sql.TableWrapper)
+		if !ok {
+			return false
+		}
+		t = w.Underlying()
+	}
+}
+
+// preserveExchangeOrder moves exchanges whose child sorts its rows down to
+// right above the Sort, and makes them merge the sorted rows of their
+// partitions. Otherwise, the rows of different partitions would be
+// interleaved in the order the partitions are iterated. The nodes between
+// the exchange and the Sort, which may only be projections, filters and
+// table aliases, don't change the order of the rows.
+func preserveExchangeOrder(node sql.Node) (sql.Node, error) {
+	exchange, ok := node.(*plan.Exchange)
+	if !ok || len(exchange.SortFields) > 0 {
+		return node, nil
+	}
+
+	var hasSort bool
+	plan.Inspect(exchange.Child, func(node sql.Node) bool {
+		if _, ok := node.(*plan.Sort); ok {
+			hasSort = true
+		}
+		return !hasSort
+	})
+	if !hasSort {
+		return node, nil
+	}
+
+	return pushdownExchange(exchange, exchange.Child)
+}
+
+// preservePartitionOrder makes the exchanges that don't merge sorted
+// partitions return the rows of their partitions in the order of the
+// partitions, which is the order the rows would be returned in without
+// the exchange.
+func preservePartitionOrder(node sql.Node) (sql.Node, error) {
+	exchange, ok := node.(*plan.Exchange)
+	if !ok || len(exchange.SortFields) > 0 {
+		return node, nil
+	}
+	return exchange.WithPartitionOrder(), nil
+}
+
+// pushdownExchange returns the node given, with the exchange given placed
+// right above its topmost Sort, merging its sorted partitions.
+func pushdownExchange(exchange *plan.Exchange, node sql.Node) (sql.Node, error) {
+	switch node := node.(type) {
+	case *plan.Sort:
+		return plan.NewExchange(exchange.Parallelism, node).WithSortFields(node.SortFields), nil
+	case *plan.Project, *plan.Filter, *plan.TableAlias:
+		child, err := pushdownExchange(exchange, node.Children()[0])
+		if err != nil {
+			return nil, err
+		}
+		return node.WithChildren(child)
+	default:
+		return nil, fmt.Errorf("unexpected node %T between an exchange and its sort", node)
+	}
 }
 
 // removeRedundantExchanges removes all the exchanges except for the topmost
@@ -107,7 +352,7 @@ func isParallelizable(node sql.Node) bool {
 		// unary nodes will not.
 		case *plan.TableAlias, *plan.Exchange:
 		// Some nodes may have subquery expressions that make them unparallelizable
-		case *plan.Project, *plan.Filter:
+		case *plan.Project, *plan.Filter, *plan.Sort:
 			for _, e := range node.(sql.Expressioner).Expressions() {
 				sql.Inspect(e, func(e sql.Expression) bool {
 					if q, ok := e.(*plan.Subquery); ok {
@@ -22,6 +22,7 @@ import (
 	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
 	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation/window"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
@@ -71,6 +72,176 @@ func TestParallelize(t *testing.T) {
 	require.Equal(expected, result)
 }
 
+func TestParallelizeSort(t *testing.T) {
+	require := require.New(t)
+	table := memory.NewTable("t", sql.PrimaryKeySchema{})
+	rule := getRuleFrom(OnceAfterAll, "parallelize")
+	fields := []sql.SortField{{Column: gf(0, "t", "a"), Order: sql.Ascending}}
+	node := plan.NewProject(
+		[]sql.Expression{gf(0, "t", "a")},
+		plan.NewSort(
+			fields,
+			plan.NewFilter(
+				expression.NewLiteral(1, sql.Int64),
+				plan.NewResolvedTable(table, nil, nil),
+			),
+		),
+	)
+
+	// The exchange merges the sorted rows of each partition right above the sort
+	expected := plan.NewProject(
+		[]sql.Expression{gf(0, "t", "a")},
+		plan.NewExchange(
+			2,
+			plan.NewSort(
+				fields,
+				plan.NewFilter(
+					expression.NewLiteral(1, sql.Int64),
+					plan.NewResolvedTable(table, nil, nil),
+				),
+			),
+		).WithSortFields(fields),
+	)
+
+	result, err := rule.Apply(sql.NewEmptyContext(), &Analyzer{Parallelism: 2}, node, nil)
+	require.NoError(err)
+	require.Equal(expected, result)
+}
+
+func TestParallelizeDeterministicOrder(t *testing.T) {
+	require := require.New(t)
+	table := memory.NewTable("t", sql.PrimaryKeySchema{})
+	rule := getRuleFrom(OnceAfterAll, "parallelize")
+	fields := []sql.SortField{{Column: gf(0, "t", "a"), Order: sql.Ascending}}
+	node := plan.NewInnerJoin(
+		plan.NewSort(fields, plan.NewResolvedTable(table, nil, nil)),
+		plan.NewResolvedTable(table, nil, nil),
+		expression.NewLiteral(1, sql.Int64),
+	)
+
+	// Exchanges that merge sorted partitions already return rows in a deterministic order
+	expected := plan.NewInnerJoin(
+		plan.NewExchange(2, plan.NewSort(fields, plan.NewResolvedTable(table, nil, nil))).WithSortFields(fields),
+		plan.NewExchange(2, plan.NewResolvedTable(table, nil, nil)).WithPartitionOrder(),
+		expression.NewLiteral(1, sql.Int64),
+	)
+
+	result, err := rule.Apply(sql.NewEmptyContext(), &Analyzer{Parallelism: 2, DeterministicOrder: true}, node, nil)
+	require.NoError(err)
+	require.Equal(expected, result)
+}
+
+func TestParallelizeGroupBy(t *testing.T) {
+	require := require.New(t)
+	table := memory.NewTable("t", sql.PrimaryKeySchema{})
+	rule := getRuleFrom(OnceAfterAll, "parallelize")
+	node := plan.NewGroupBy(
+		[]sql.Expression{
+			gf(0, "t", "a"),
+			expression.NewAlias("c", aggregation.NewCount(expression.NewLiteral(1, sql.Int64))),
+			aggregation.NewAvg(gf(1, "t", "b")),
+		},
+		[]sql.Expression{gf(0, "t", "a")},
+		plan.NewResolvedTable(table, nil, nil),
+	)
+
+	// The partitions are aggregated below the exchange and their partial
+	// aggregations are merged above it
+	expected := plan.NewProject(
+		[]sql.Expression{
+			gf(0, "t", "a"),
+			expression.NewAlias("c", expression.NewGetFieldWithTable(1, sql.Int64, "", "COUNTMERGE(COUNT(1))", false)),
+			expression.NewAlias("AVG(t.b)", expression.NewGetFieldWithTable(2, sql.Float64, "", "AVGMERGE(SUM(t.b), COUNT(t.b))", true)),
+		},
+		plan.NewGroupBy(
+			[]sql.Expression{
+				gf(0, "t", "a"),
+				aggregation.NewCountMerge(expression.NewGetFieldWithTable(1, sql.Int64, "", "COUNT(1)", false)),
+				aggregation.NewAvgMerge(
+					expression.NewGetFieldWithTable(2, sql.Float64, "", "SUM(t.b)", false),
+					expression.NewGetFieldWithTable(3, sql.Int64, "", "COUNT(t.b)", false),
+				),
+			},
+			[]sql.Expression{gf(0, "t", "a")},
+			plan.NewExchange(
+				2,
+				plan.NewGroupBy(
+					[]sql.Expression{
+						gf(0, "t", "a"),
+						aggregation.NewCount(expression.NewLiteral(1, sql.Int64)),
+						aggregation.NewSum(gf(1, "t", "b")),
+						aggregation.NewCount(gf(1, "t", "b")),
+					},
+					[]sql.Expression{gf(0, "t", "a")},
+					plan.NewResolvedTable(table, nil, nil),
+				),
+			),
+		),
+	)
+
+	result, err := rule.Apply(sql.NewEmptyContext(), &Analyzer{Parallelism: 2}, node, nil)
+	require.NoError(err)
+	require.Equal(expected, result)
+
+	// Group bys with aggregations that can't be merged are not split
+	node = plan.NewGroupBy(
+		[]sql.Expression{aggregation.NewCountDistinct(gf(0, "t", "a"))},
+		nil,
+		plan.NewResolvedTable(table, nil, nil),
+	)
+
+	result, err = rule.Apply(sql.NewEmptyContext(), &Analyzer{Parallelism: 2}, node, nil)
+	require.NoError(err)
+	require.Equal(plan.NewGroupBy(
+		[]sql.Expression{aggregation.NewCountDistinct(gf(0, "t", "a"))},
+		nil,
+		plan.NewExchange(2, plan.NewResolvedTable(table, nil, nil)),
+	), result)
+}
+
+func TestParallelizeShardedTable(t *testing.T) {
+	require := require.New(t)
+	table := &shardedTable{memory.NewTable("t", sql.PrimaryKeySchema{})}
+	rule := getRuleFrom(OnceAfterAll, "parallelize")
+	node := plan.NewProject(
+		nil,
+		plan.NewFilter(
+			expression.NewLiteral(1, sql.Int64),
+			plan.NewResolvedTable(table, nil, nil),
+		),
+	)
+
+	expected := plan.NewDistributedExchange(
+		2,
+		plan.NewProject(
+			nil,
+			plan.NewFilter(
+				expression.NewLiteral(1, sql.Int64),
+				plan.NewResolvedTable(table, nil, nil),
+			),
+		),
+	)
+
+	result, err := rule.Apply(sql.NewEmptyContext(), &Analyzer{Parallelism: 2}, node, nil)
+	require.NoError(err)
+	require.Equal(expected, result)
+
+	// Sorted partitions are merged by a regular exchange
+	fields := []sql.SortField{{Column: gf(0, "t", "a"), Order: sql.Ascending}}
+	sort := plan.NewSort(fields, plan.NewResolvedTable(table, nil, nil))
+	result, err = rule.Apply(sql.NewEmptyContext(), &Analyzer{Parallelism: 2}, sort, nil)
+	require.NoError(err)
+	require.Equal(plan.NewExchange(2, sort).WithSortFields(fields), result)
+}
+
+type shardedTable struct {
+	*memory.Table
+}
+
+func (*shardedTable) IsRetryable(sql.Partition, error) bool {
+	return true
+}
+
 func TestParallelizeCreateIndex(t *testing.T) {
 	require := require.New(t)
 	table := memory.NewTable("t", sql.PrimaryKeySchema{})
@@ -217,7 +388,7 @@ func TestIsParallelizable(t *testing.T) {
 				nil,
 				plan.NewResolvedTable(nil, nil, nil),
 			),
-			false,
+			true,
 		},
 		{
 			"distinct",
@@ -0,0 +1,78 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// resolvePreparedQueries assigns the prepared statements of the catalog to PREPARE and DEALLOCATE PREPARE statements,
+// and parses the text of the statements prepared. They're analyzed too, without values for their parameters, so that
+// statements that aren't valid fail to prepare, as they do in MySQL.
+func resolvePreparedQueries(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	switch n := n.(type) {
+	case *plan.PrepareQuery:
+		if n.Query != nil {
+			return n, nil
+		}
+		return resolvePrepareQuery(ctx, a, n, scope)
+	case *plan.DeallocateQuery:
+		nc := *n
+		nc.Queries = a.Catalog.PreparedQueries
+		return &nc, nil
+	default:
+		return n, nil
+	}
+}
+
+func resolvePrepareQuery(ctx *sql.Context, a *Analyzer, n *plan.PrepareQuery, scope *Scope) (sql.Node, error) {
+	val, err := n.Source.Eval(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, sql.ErrSyntaxError.New("syntax error near 'NULL'")
+	}
+	text, err := sql.LongText.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	query := text.(string)
+
+	parsed, err := parse.Parse(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	switch parsed.(type) {
+	case *plan.PrepareQuery, *plan.ExecuteQuery, *plan.DeallocateQuery:
+		return nil, sql.ErrUnsupportedFeature.New("prepared PREPARE, EXECUTE and DEALLOCATE PREPARE statements")
+	}
+	if _, err := a.Analyze(ctx, parsed, scope); err != nil {
+		return nil, err
+	}
+
+	params, err := plan.BindVarNames(parsed)
+	if err != nil {
+		return nil, err
+	}
+	a.Log("prepared statement %s with %d parameters", n.Name, len(params))
+
+	nc := *n
+	nc.Query = &sql.PreparedQuery{Name: n.Name, Query: query, Statement: parsed, ParamCount: len(params)}
+	nc.Queries = a.Catalog.PreparedQueries
+	return &nc, nil
+}
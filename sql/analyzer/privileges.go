@@ -15,14 +15,19 @@
 package analyzer
 
 import (
+	"net"
+	"sort"
+	"strings"
+
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/grant_tables"
 	"github.com/dolthub/go-mysql-server/sql/plan"
 )
 
 // checkPrivileges verifies the given statement (node n) by checking that the calling user has the necessary privileges
 // to execute it.
 func checkPrivileges(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
-	//TODO: implement this
 	switch n.(type) {
 	case *plan.CreateUser, *plan.DropUser, *plan.RenameUser, *plan.CreateRole, *plan.DropRole,
 		*plan.Grant, *plan.GrantRole, *plan.GrantProxy, *plan.Revoke, *plan.RevokeRole, *plan.RevokeAll, *plan.RevokeProxy:
@@ -30,3 +35,354 @@ func checkPrivileges(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (s
 	}
 	return n, nil
 }
+
+// checkTablePrivileges verifies that the account that the statement (node n) executes as has the privileges needed on
+// the databases, tables and columns that it touches. Nothing is checked while the grant tables are disabled, as every
+// account has every privilege then.
+func checkTablePrivileges(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	if !a.Catalog.GrantTables.Enabled {
+		return n, nil
+	}
+
+	sc := ctx.SecurityContext()
+	host := sc.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	checker := &privilegeChecker{
+		ctx:         ctx,
+		grantTables: a.Catalog.GrantTables,
+		user:        sc.User,
+		host:        host,
+		root:        n,
+	}
+	if err := checker.checkNode(n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// privilegeChecker checks the privileges needed by the nodes of a statement.
+type privilegeChecker struct {
+	ctx         *sql.Context
+	grantTables *grant_tables.GrantTables
+	user        string
+	host        string
+	// root is the statement, whose expressions name the columns read from each of its tables.
+	root sql.Node
+	// updated are the columns set by an UPDATE statement, by the lowercase name or alias of their table.
+	updated map[string][]string
+}
+
+// checkNode checks the privileges needed by the node given and its children.
+func (c *privilegeChecker) checkNode(n sql.Node) error {
+	switch n := n.(type) {
+	case *plan.TableAlias:
+		if rt, ok := n.Child.(*plan.ResolvedTable); ok {
+			return c.checkRead(rt, n.Name())
+		}
+	case *plan.ResolvedTable:
+		return c.checkRead(n, n.Name())
+	case *plan.IndexedTableAccess:
+		return c.checkRead(n.ResolvedTable, n.Name())
+	case *plan.InsertInto:
+		privileges := []grant_tables.PrivilegeType{grant_tables.PrivilegeType_Insert}
+		if n.IsReplace {
+			privileges = append(privileges, grant_tables.PrivilegeType_Delete)
+		}
+		if len(n.OnDupExprs) > 0 {
+			privileges = append(privileges, grant_tables.PrivilegeType_Update)
+		}
+		if rt := getResolvedTable(n.Destination); rt != nil {
+			for _, privilege := range privileges {
+				if err := c.checkColumns(rt, privilege, n.ColumnNames); err != nil {
+					return err
+				}
+			}
+		}
+		return c.checkNode(n.Source)
+	case *plan.Update:
+		c.updated = make(map[string][]string)
+		plan.InspectExpressions(n, func(e sql.Expression) bool {
+			if sf, ok := e.(*expression.SetField); ok {
+				if gf, ok := sf.Left.(*expression.GetField); ok {
+					table := strings.ToLower(gf.Table())
+					c.updated[table] = append(c.updated[table], gf.Name())
+				}
+			}
+			return true
+		})
+	case *plan.DeleteFrom:
+		return c.checkTargets(n.Child, grant_tables.PrivilegeType_Delete)
+	case *plan.Truncate:
+		return c.checkTargets(n.Child, grant_tables.PrivilegeType_Drop)
+	case *plan.AddColumn, *plan.DropColumn, *plan.RenameColumn, *plan.ModifyColumn, *plan.AlterAutoIncrement,
+		*plan.AlterDefaultSet, *plan.AlterDefaultDrop, *plan.CreateCheck, *plan.DropCheck, *plan.DropConstraint,
+		*plan.DropForeignKey:
+		return c.checkTargets(n.Children()[0], grant_tables.PrivilegeType_Alter)
+	case *plan.AlterPK:
+		return c.checkTargets(n.Table, grant_tables.PrivilegeType_Alter)
+	case *plan.AlterIndex:
+		return c.checkTargets(n.Table, grant_tables.PrivilegeType_Index)
+	case *plan.CreateIndex:
+		return c.checkTargets(n.Table, grant_tables.PrivilegeType_Index)
+	case *plan.CreateForeignKey:
+		db := databaseName(n.Database())
+		if err := c.checkTable(db, n.Table, grant_tables.PrivilegeType_Alter); err != nil {
+			return err
+		}
+		return c.checkTable(db, n.ReferencedTable, grant_tables.PrivilegeType_References)
+	case *plan.CreateTable:
+		if err := c.checkTable(databaseName(n.Database()), n.Name(), grant_tables.PrivilegeType_Create); err != nil {
+			return err
+		}
+	case *plan.DropTable:
+		for _, table := range n.TableNames() {
+			if err := c.checkTable(databaseName(n.Database()), table, grant_tables.PrivilegeType_Drop); err != nil {
+				return err
+			}
+		}
+	case *plan.RenameTable:
+		db := databaseName(n.Database())
+		for _, table := range n.OldNames() {
+			if err := c.checkTable(db, table, grant_tables.PrivilegeType_Alter, grant_tables.PrivilegeType_Drop); err != nil {
+				return err
+			}
+		}
+		for _, table := range n.NewNames() {
+			if err := c.checkTable(db, table, grant_tables.PrivilegeType_Create, grant_tables.PrivilegeType_Insert); err != nil {
+				return err
+			}
+		}
+	case *plan.CreateDB:
+		return c.checkDatabase(n.DbName(), grant_tables.PrivilegeType_Create)
+	case *plan.DropDB:
+		return c.checkDatabase(n.DbName(), grant_tables.PrivilegeType_Drop)
+	case *plan.CreateView:
+		if err := c.checkTable(databaseName(n.Database()), n.Name, grant_tables.PrivilegeType_CreateView); err != nil {
+			return err
+		}
+	case *plan.SingleDropView:
+		return c.checkTable(databaseName(n.Database()), n.ViewName(), grant_tables.PrivilegeType_Drop)
+	case *plan.CreateTrigger:
+		// The body of a trigger is checked when it's applied to a statement
+		return c.checkTargets(n.Table, grant_tables.PrivilegeType_Trigger)
+	case *plan.DropTrigger:
+		return c.checkDatabase(databaseName(n.Database()), grant_tables.PrivilegeType_Trigger)
+	case *plan.CreateProcedure:
+		// The body of a procedure is checked when it's called, with the privileges of the account it executes as
+		return c.checkDatabase(databaseName(n.Database()), grant_tables.PrivilegeType_CreateRoutine)
+	case *plan.DropProcedure:
+		return c.checkDatabase(databaseName(n.Database()), grant_tables.PrivilegeType_AlterRoutine)
+	case *plan.Call:
+		return c.checkDatabase(c.ctx.GetCurrentDatabase(), grant_tables.PrivilegeType_Execute)
+	case *plan.LockTables:
+		for _, lock := range n.Locks {
+			err := c.checkTargets(lock.Table, grant_tables.PrivilegeType_LockTables, grant_tables.PrivilegeType_Select)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case *plan.CreateUser, *plan.DropUser, *plan.RenameUser, *plan.GrantRole, *plan.RevokeRole, *plan.GrantProxy,
+		*plan.RevokeProxy, *plan.RevokeAll:
+		return c.checkGlobal(grant_tables.PrivilegeType_CreateUser)
+	case *plan.CreateRole:
+		if c.grantTables.HasGlobalPrivilege(c.user, c.host, grant_tables.PrivilegeType_CreateRole) {
+			return nil
+		}
+		return c.checkGlobal(grant_tables.PrivilegeType_CreateUser)
+	case *plan.DropRole:
+		if c.grantTables.HasGlobalPrivilege(c.user, c.host, grant_tables.PrivilegeType_DropRole) {
+			return nil
+		}
+		return c.checkGlobal(grant_tables.PrivilegeType_CreateUser)
+	case *plan.Grant:
+		return c.checkGrant(n.PrivilegeLevel, n.Privileges)
+	case *plan.Revoke:
+		return c.checkGrant(n.PrivilegeLevel, n.Privileges)
+	case *plan.ShowGrants:
+		// Any account may show its own grants, but showing the grants of others needs access to the grant tables
+		if n.For != nil {
+			if accountHost, ok := c.grantTables.MatchAccount(c.user, c.host); !ok || n.For.Name != c.user || n.For.Host != accountHost {
+				return c.checkDatabase("mysql", grant_tables.PrivilegeType_Select)
+			}
+		}
+		return nil
+	}
+
+	for _, child := range n.Children() {
+		if err := c.checkNode(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkRead checks the privileges needed on a table read by the statement, with the name or alias given. The table
+// must be readable, or all of its columns that the statement refers to. The tables of an UPDATE statement whose columns
+// are set must be updatable instead, and readable only if the statement reads any of their columns, such as in its
+// WHERE clause or in the values it sets.
+func (c *privilegeChecker) checkRead(rt *plan.ResolvedTable, alias string) error {
+	if updated, ok := c.updated[strings.ToLower(alias)]; ok {
+		if err := c.checkColumns(rt, grant_tables.PrivilegeType_Update, updated); err != nil {
+			return err
+		}
+		if columns := c.readColumns(alias); len(columns) > 0 {
+			return c.checkColumns(rt, grant_tables.PrivilegeType_Select, columns)
+		}
+		return nil
+	}
+	if skipPrivilegeCheck(rt.Database) {
+		return nil
+	}
+	if c.grantTables.HasTablePrivilege(c.user, c.host, rt.Database.Name(), rt.Name(), grant_tables.PrivilegeType_Select) {
+		return nil
+	}
+	return c.checkColumns(rt, grant_tables.PrivilegeType_Select, c.readColumns(alias))
+}
+
+// readColumns returns the columns of the table with the name or alias given that the statement reads, sorted. The
+// columns an UPDATE statement sets aren't read, unless the statement also refers to them elsewhere.
+func (c *privilegeChecker) readColumns(alias string) []string {
+	seen := make(map[string]bool)
+	var columns []string
+	var inspect func(e sql.Expression) bool
+	inspect = func(e sql.Expression) bool {
+		switch e := e.(type) {
+		case *expression.SetField:
+			sql.Inspect(e.Right, inspect)
+			return false
+		case *expression.GetField:
+			if strings.EqualFold(e.Table(), alias) && !seen[strings.ToLower(e.Name())] {
+				seen[strings.ToLower(e.Name())] = true
+				columns = append(columns, e.Name())
+			}
+		}
+		return true
+	}
+	plan.InspectExpressions(c.root, inspect)
+	sort.Strings(columns)
+	return columns
+}
+
+// checkColumns checks that the table given has the privilege given, or all of the columns given if any.
+func (c *privilegeChecker) checkColumns(rt *plan.ResolvedTable, privilege grant_tables.PrivilegeType, columns []string) error {
+	if skipPrivilegeCheck(rt.Database) {
+		return nil
+	}
+	db := rt.Database.Name()
+	if c.grantTables.HasTablePrivilege(c.user, c.host, db, rt.Name(), privilege) {
+		return nil
+	}
+	// Without the privilege on any of the table's columns, the table itself is inaccessible
+	hasColumn := false
+	for _, col := range rt.Schema() {
+		if c.grantTables.HasColumnPrivilege(c.user, c.host, db, rt.Name(), col.Name, privilege) {
+			hasColumn = true
+			break
+		}
+	}
+	if len(columns) == 0 || !hasColumn {
+		return sql.ErrTableAccessDenied.New(privilege.String(), c.user, c.host, rt.Name())
+	}
+	for _, column := range columns {
+		if !c.grantTables.HasColumnPrivilege(c.user, c.host, db, rt.Name(), column, privilege) {
+			return sql.ErrColumnAccessDenied.New(privilege.String(), c.user, c.host, column, rt.Name())
+		}
+	}
+	return nil
+}
+
+// checkTargets checks that every table in the node given has the privileges given.
+func (c *privilegeChecker) checkTargets(n sql.Node, privileges ...grant_tables.PrivilegeType) error {
+	var err error
+	plan.Inspect(n, func(n sql.Node) bool {
+		if err != nil {
+			return false
+		}
+		var rt *plan.ResolvedTable
+		switch n := n.(type) {
+		case *plan.ResolvedTable:
+			rt = n
+		case *plan.IndexedTableAccess:
+			rt = n.ResolvedTable
+		default:
+			return true
+		}
+		if !skipPrivilegeCheck(rt.Database) {
+			err = c.checkTable(rt.Database.Name(), rt.Name(), privileges...)
+		}
+		return false
+	})
+	return err
+}
+
+// checkTable checks that the table given has the privileges given.
+func (c *privilegeChecker) checkTable(db string, table string, privileges ...grant_tables.PrivilegeType) error {
+	if db == "" || strings.EqualFold(db, "information_schema") {
+		return nil
+	}
+	for _, privilege := range privileges {
+		if !c.grantTables.HasTablePrivilege(c.user, c.host, db, table, privilege) {
+			return sql.ErrTableAccessDenied.New(privilege.String(), c.user, c.host, table)
+		}
+	}
+	return nil
+}
+
+// checkDatabase checks that the database given has the privileges given.
+func (c *privilegeChecker) checkDatabase(db string, privileges ...grant_tables.PrivilegeType) error {
+	for _, privilege := range privileges {
+		if !c.grantTables.HasDatabasePrivilege(c.user, c.host, db, privilege) {
+			return sql.ErrDatabaseAccessDenied.New(c.user, c.host, db)
+		}
+	}
+	return nil
+}
+
+// checkGlobal checks that the account has the global privileges given.
+func (c *privilegeChecker) checkGlobal(privileges ...grant_tables.PrivilegeType) error {
+	for _, privilege := range privileges {
+		if !c.grantTables.HasGlobalPrivilege(c.user, c.host, privilege) {
+			return sql.ErrPrivilegeDenied.New(privilege.String())
+		}
+	}
+	return nil
+}
+
+// checkGrant checks that the account may grant or revoke the privileges given on the level given, which needs the
+// GRANT OPTION and the privileges themselves.
+func (c *privilegeChecker) checkGrant(planLevel plan.PrivilegeLevel, planPrivileges []plan.Privilege) error {
+	level, err := planLevel.GrantTablesLevel(c.ctx)
+	if err != nil {
+		return err
+	}
+	privileges := []grant_tables.PrivilegeType{grant_tables.PrivilegeType_Grant}
+	for _, privilege := range planPrivileges {
+		privileges = append(privileges, privilege.GrantTablesPrivileges(level)...)
+	}
+
+	switch {
+	case level.IsGlobal():
+		return c.checkGlobal(privileges...)
+	case level.IsDatabase():
+		return c.checkDatabase(level.Database, privileges...)
+	default:
+		return c.checkTable(level.Database, level.Table, privileges...)
+	}
+}
+
+// skipPrivilegeCheck returns whether the tables of the database given need no privileges, as with the tables of the
+// information schema, which only show what the account has access to, and the dual table, which has no database.
+func skipPrivilegeCheck(db sql.Database) bool {
+	return db == nil || strings.EqualFold(db.Name(), "information_schema")
+}
+
+// databaseName returns the name of the database given, which may be nil.
+func databaseName(db sql.Database) string {
+	if db == nil {
+		return ""
+	}
+	return db.Name()
+}
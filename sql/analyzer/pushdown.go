@@ -57,8 +57,13 @@ func pushdownFiltersAtNode(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Sco
 }
 
 // pushdownSubqueryAliasFilters attempts to push conditions in filters down to
-// individual subquery aliases.
+// individual subquery aliases, unless the derived_condition_pushdown feature
+// flag is off.
 func pushdownSubqueryAliasFilters(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	if !ctx.FeatureEnabled(sql.FeatureDerivedConditionPushdown) {
+		return n, nil
+	}
+
 	span, ctx := ctx.Span("pushdown_subquery_alias_filters")
 	defer span.Finish()
 
@@ -91,58 +96,73 @@ func pushdownProjections(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope
 
 func canProject(n sql.Node, a *Analyzer) bool {
 	switch n.(type) {
-	case *plan.Update, *plan.RowUpdateAccumulator, *plan.DeleteFrom, *plan.Block, *plan.BeginEndBlock, *plan.TriggerBeginEndBlock:
+	case *plan.Block, *plan.BeginEndBlock, *plan.TriggerBeginEndBlock:
 		return false
 	}
+	return true
+}
 
-	// Pushdown of projections interferes with subqueries on the same table: the table gets two different sets of
-	// projected columns pushed down, once for its alias in the subquery and once for its alias outside. For that reason,
-	// skip pushdown for any query with a subquery in it.
-	// TODO: fix this
-	containsSubquery := false
-	plan.InspectExpressions(n, func(e sql.Expression) bool {
-		if _, ok := e.(*plan.Subquery); ok {
-			containsSubquery = true
-			return false
-		}
-		return true
-	})
+// isProjectedTableAccess returns whether the node given is the decorator added when a projection is pushed down to a
+// table. Because analysis runs more than once on subqueries, it's possible for projection pushdown logic to be applied
+// multiple times to the same table. It's totally undefined what happens when you push a projection down to a table
+// that already has one, and shouldn't happen. We don't have the necessary interface to interrogate a projected table
+// about its projection, so we look for the decorator instead.
+// TODO: this is a hack, we shouldn't use decorator nodes for logic like this.
+func isProjectedTableAccess(n sql.Node) bool {
+	d, ok := n.(*plan.DecoratedNode)
+	return ok && strings.Contains(d.String(), "Projected table access on")
+}
 
-	if containsSubquery {
-		a.Log("skipping pushdown of projection for query with subquery")
-		return false
+// getDMLTargetTables returns the lowercase names of the tables that the UPDATE and DELETE statements in the node given
+// change. Their rows are passed to table editors, which expect every column, so projections can't be pushed down to
+// them. An UPDATE with a join validates and splits the entire joined row, so every table in the join is a target.
+func getDMLTargetTables(n sql.Node) map[string]bool {
+	targets := make(map[string]bool)
+	addTables := func(n sql.Node) {
+		plan.Inspect(n, func(n sql.Node) bool {
+			switch n := n.(type) {
+			case *plan.TableAlias, *plan.ResolvedTable, *plan.IndexedTableAccess:
+				targets[strings.ToLower(n.(NameableNode).Name())] = true
+				return false
+			}
+			return true
+		})
 	}
 
-	containsIndexedJoin := false
-	plan.Inspect(n, func(node sql.Node) bool {
-		if _, ok := node.(*plan.IndexedJoin); ok {
-			containsIndexedJoin = true
-			return false
+	plan.Inspect(n, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.DeleteFrom:
+			addTables(n.Child)
+		case *plan.UpdateSource:
+			if hasJoin(n.Child) {
+				addTables(n.Child)
+				break
+			}
+			for _, e := range n.UpdateExprs {
+				if sf, ok := e.(*expression.SetField); ok {
+					if gf, ok := sf.Left.(*expression.GetField); ok {
+						targets[strings.ToLower(gf.Table())] = true
+					}
+				}
+			}
 		}
 		return true
-
 	})
+	return targets
+}
 
-	if containsIndexedJoin {
-		a.Log("skipping pushdown of projection for query with an indexed join")
-		return false
-	}
-
-	// Because analysis runs more than once on subquery, it's possible for projection pushdown logic to be applied
-	// multiple times. It's totally undefined what happens when you push a projection down to a table that already has
-	// one, and shouldn't happen. We don't have the necessary interface to interrogate a projected table about its
-	// projection, so we do this for now.
-	// TODO: this is a hack, we shouldn't use decorator nodes for logic like this.
-	alreadyPushedDown := false
+// hasJoin returns whether the node given contains a join
+func hasJoin(n sql.Node) bool {
+	found := false
 	plan.Inspect(n, func(n sql.Node) bool {
-		if n, ok := n.(*plan.DecoratedNode); ok && strings.Contains(n.String(), "Projected table access on") {
-			alreadyPushedDown = true
+		switch n.(type) {
+		case plan.JoinNode, *plan.CrossJoin, *plan.IndexedJoin:
+			found = true
 			return false
 		}
 		return true
 	})
-
-	return !alreadyPushedDown
+	return found
 }
 
 // canDoPushdown returns whether the node given can safely be analyzed for pushdown
@@ -186,6 +206,22 @@ func filterPushdownChildSelector(c plan.TransformContext) bool {
 		return c.ChildNum == 0
 	case *plan.RightJoin:
 		return c.ChildNum == 1
+	case *plan.HashJoin:
+		switch n.JoinType() {
+		case plan.JoinTypeLeft:
+			return c.ChildNum == 0
+		case plan.JoinTypeRight:
+			return c.ChildNum == 1
+		}
+		return true
+	case *plan.MergeJoin:
+		switch n.JoinType() {
+		case plan.JoinTypeLeft:
+			return c.ChildNum == 0
+		case plan.JoinTypeRight:
+			return c.ChildNum == 1
+		}
+		return true
 	}
 	return true
 }
@@ -345,6 +381,16 @@ func convertFiltersToIndexedAccess(
 			return c.ChildNum == 0
 		case *plan.RightJoin:
 			return c.ChildNum == 1
+		case *plan.HashJoin:
+			switch c.Parent.(*plan.HashJoin).JoinType() {
+			case plan.JoinTypeLeft:
+				return c.ChildNum == 0
+			case plan.JoinTypeRight:
+				return c.ChildNum == 1
+			}
+		case *plan.MergeJoin:
+			// The sides of merge joins are already read in the order of an index, which another lookup could change
+			return false
 		case *plan.TableAlias:
 			// For a TableAlias, we apply this pushdown to the
 			// TableAlias, but not to the resolved table directly
@@ -577,7 +623,6 @@ func pushdownProjectionsToTable(
 	a *Analyzer,
 	tableNode NameableNode,
 	fieldsByTable fieldsByTable,
-	usedProjections fieldsByTable,
 ) (sql.Node, error) {
 
 	table := getTable(tableNode)
@@ -589,11 +634,9 @@ func pushdownProjectionsToTable(
 
 	replacedTable := false
 	if pt, ok := table.(sql.ProjectedTable); ok && len(fieldsByTable[tableNode.Name()]) > 0 {
-		if usedProjections[tableNode.Name()] == nil {
-			projectedFields := fieldsByTable[tableNode.Name()]
-			table = pt.WithProjection(projectedFields)
-			usedProjections[tableNode.Name()] = projectedFields
-		}
+		// A table can be accessed more than once under the same name, e.g. on both sides of a concatenated index
+		// lookup, so every access gets the same projection.
+		table = pt.WithProjection(fieldsByTable[tableNode.Name()])
 
 		newTableNode = plan.NewDecoratedNode(
 			fmt.Sprintf("Projected table access on %v",
@@ -621,8 +664,8 @@ func pushdownProjectionsToTable(
 }
 
 func transformPushdownProjections(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
-	usedFieldsByTable := make(fieldsByTable)
 	fieldsByTable := getFieldsByTable(ctx, n)
+	targetTables := getDMLTargetTables(n)
 
 	selector := func(c plan.TransformContext) bool {
 		switch c.Parent.(type) {
@@ -630,21 +673,31 @@ func transformPushdownProjections(ctx *sql.Context, a *Analyzer, n sql.Node, sco
 			// When we hit a table alias, we don't want to descend farther into the tree for expression matches, which
 			// would give us the original (unaliased) names of columns
 			return false
-		default:
-			return true
 		}
+		// Tables that already have a projection are left alone
+		return !isProjectedTableAccess(c.Node)
 	}
 
 	node, err := plan.TransformUpCtx(n, selector, func(c plan.TransformContext) (sql.Node, error) {
 		var nameable NameableNode
 
-		switch c.Node.(type) {
-		case *plan.TableAlias, *plan.ResolvedTable, *plan.IndexedTableAccess:
-			nameable = c.Node.(NameableNode)
+		switch n := c.Node.(type) {
+		case *plan.TableAlias:
+			// An alias can wrap more than one table access, e.g. a concatenation of index lookups, which can't be
+			// projected as a single table
+			switch n.Child.(type) {
+			case *plan.ResolvedTable, *plan.IndexedTableAccess:
+				nameable = n
+			}
+		case *plan.ResolvedTable, *plan.IndexedTableAccess:
+			nameable = n.(NameableNode)
+		}
+		if nameable != nil && targetTables[strings.ToLower(nameable.Name())] {
+			nameable = nil
 		}
 
 		if nameable != nil {
-			table, err := pushdownProjectionsToTable(a, nameable, fieldsByTable, usedFieldsByTable)
+			table, err := pushdownProjectionsToTable(a, nameable, fieldsByTable)
 			if err != nil {
 				return nil, err
 			}
@@ -77,9 +77,19 @@ func resolveCreateLike(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 		pkOrdinals = pkTable.PrimaryKeySchema().PkOrdinals
 	}
 
+	var options sql.TableOptions
+	if optionsTable, ok := likeTable.(sql.TableOptionsTable); ok {
+		var err error
+		options, err = optionsTable.GetTableOptions(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	tableSpec := &plan.TableSpec{
 		Schema:  sql.NewPrimaryKeySchema(newSch, pkOrdinals...),
 		IdxDefs: idxDefs,
+		Options: options,
 	}
 
 	return plan.NewCreateTable(ct.Database(), ct.Name(), ct.IfNotExists(), ct.Temporary(), tableSpec), nil
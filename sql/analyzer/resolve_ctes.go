@@ -109,6 +109,16 @@ func stripWith(ctx *sql.Context, a *Analyzer, n sql.Node, ctes map[string]sql.No
 	for _, cte := range with.CTEs {
 		cteName := cte.Subquery.Name()
 		subquery := cte.Subquery
+		if with.Recursive {
+			if !ctx.FeatureEnabled(sql.FeatureRecursiveCte) {
+				return nil, sql.ErrFeatureDisabled.New("WITH RECURSIVE", sql.FeatureRecursiveCte)
+			}
+			var err error
+			subquery, err = recursiveCteDefinition(cte)
+			if err != nil {
+				return nil, err
+			}
+		}
 
 		if len(cte.Columns) > 0 {
 			schemaLen := schemaLength(subquery)
@@ -119,12 +129,144 @@ func stripWith(ctx *sql.Context, a *Analyzer, n sql.Node, ctes map[string]sql.No
 			subquery = subquery.WithColumns(cte.Columns)
 		}
 
+		if cte.Materialization != plan.CteMaterializationDefault {
+			if !ctx.FeatureEnabled(sql.FeatureCteMaterialization) {
+				return nil, sql.ErrFeatureDisabled.New("MATERIALIZED and NOT MATERIALIZED", sql.FeatureCteMaterialization)
+			}
+			subquery = subquery.WithMaterialization(cte.Materialization)
+		}
+
 		ctes[strings.ToLower(cteName)] = subquery
 	}
 
 	return with.Child, nil
 }
 
+// recursiveCteDefinition returns the definition of the common table expression given, of a WITH RECURSIVE clause, as a
+// RecursiveCte when it refers to itself. The definition must be a union of non-recursive query blocks followed by
+// recursive ones, which refer to the expression once, and not in a subquery, and which can't aggregate rows.
+func recursiveCteDefinition(cte *plan.CommonTableExpression) (*plan.SubqueryAlias, error) {
+	name := cte.Subquery.Name()
+	if countCteReferences(cte.Subquery.Child, name, true) == 0 {
+		return cte.Subquery, nil
+	}
+
+	var blocks []sql.Node
+	distinct := false
+	var flatten func(n sql.Node)
+	flatten = func(n sql.Node) {
+		switch n := n.(type) {
+		case *plan.Distinct:
+			if union, ok := n.Child.(*plan.Union); ok {
+				distinct = true
+				flatten(union)
+				return
+			}
+		case *plan.Union:
+			flatten(n.Left())
+			flatten(n.Right())
+			return
+		}
+		blocks = append(blocks, n)
+	}
+	flatten(cte.Subquery.Child)
+	if len(blocks) < 2 {
+		return nil, sql.ErrCteRecursionRequiresUnion.New(name)
+	}
+
+	var initial, recursive sql.Node
+	union := func(left, right sql.Node) sql.Node {
+		if left == nil {
+			return right
+		}
+		return plan.NewUnion(left, right)
+	}
+	for _, block := range blocks {
+		references := countCteReferences(block, name, true)
+		if references == 0 {
+			if recursive != nil {
+				return nil, sql.ErrCteRecursionRequiresNonRecursiveFirst.New(name)
+			}
+			initial = union(initial, block)
+			continue
+		}
+		if initial == nil {
+			return nil, sql.ErrCteRecursionRequiresNonRecursiveFirst.New(name)
+		}
+		if references != 1 || countCteReferences(block, name, false) != 1 {
+			return nil, sql.ErrCteRecursionRequiresSingleReference.New(name)
+		}
+
+		aggregates := false
+		inspectUpToOpaque(block, func(n sql.Node) {
+			switch n.(type) {
+			case *plan.GroupBy, *plan.Window:
+				aggregates = true
+			}
+		})
+		if aggregates {
+			return nil, sql.ErrCteRecursionForbidsAggregation.New(name)
+		}
+
+		block, err := plan.TransformUp(block, func(n sql.Node) (sql.Node, error) {
+			if t, ok := n.(*plan.UnresolvedTable); ok && t.Database == "" && strings.EqualFold(t.Name(), name) {
+				return plan.NewResolvedTable(plan.NewRecursiveTable(name, nil), nil, nil), nil
+			}
+			return n, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		recursive = union(recursive, block)
+	}
+
+	subquery, err := cte.Subquery.WithChildren(plan.NewRecursiveCte(initial, recursive, name, cte.Columns, distinct))
+	if err != nil {
+		return nil, err
+	}
+	return subquery.(*plan.SubqueryAlias), nil
+}
+
+// countCteReferences returns the number of tables of the node given that refer to the common table expression with
+// the name given. Unless subqueries is true, the tables of subqueries and derived tables aren't counted.
+func countCteReferences(n sql.Node, name string, subqueries bool) int {
+	count := 0
+	inspect := func(n sql.Node) bool {
+		if t, ok := n.(*plan.UnresolvedTable); ok && t.Database == "" && strings.EqualFold(t.Name(), name) {
+			count++
+		}
+		return true
+	}
+	if !subqueries {
+		inspectUpToOpaque(n, func(n sql.Node) {
+			inspect(n)
+		})
+		return count
+	}
+
+	plan.Inspect(n, inspect)
+	plan.InspectExpressions(n, func(e sql.Expression) bool {
+		if sq, ok := e.(*plan.Subquery); ok {
+			count += countCteReferences(sq.Query, name, true)
+		}
+		return true
+	})
+	return count
+}
+
+// inspectUpToOpaque calls the function given with each node of the tree given, without descending into the children
+// of opaque nodes other than its root.
+func inspectUpToOpaque(node sql.Node, f func(sql.Node)) {
+	plan.Inspect(node, func(n sql.Node) bool {
+		if n == nil {
+			return false
+		}
+		f(n)
+		o, ok := n.(sql.OpaqueNode)
+		return n == node || !ok || !o.Opaque()
+	})
+}
+
 // transformUpWithOpaque applies a transformation function to the given tree from the bottom up, including through
 // opaque nodes. This method is generally not safe to use for a transformation. Opaque nodes need to be considered in
 // isolation except for very specific exceptions.
@@ -195,7 +337,7 @@ func liftCommonTableExpressions(ctx *sql.Context, a *Analyzer, n sql.Node, scope
 	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
 		if union, isUnion := n.(*plan.Union); isUnion {
 			if cte, isCTE := union.Left().(*plan.With); isCTE {
-				return plan.NewWith(plan.NewUnion(cte.Child, union.Right()), cte.CTEs), nil
+				return cte.WithChildren(plan.NewUnion(cte.Child, union.Right()))
 			}
 			l, err := liftCommonTableExpressions(ctx, a, union.Left(), scope)
 			if err != nil {
@@ -212,7 +354,7 @@ func liftCommonTableExpressions(ctx *sql.Context, a *Analyzer, n sql.Node, scope
 		}
 		if distinct, isDistinct := n.(*plan.Distinct); isDistinct {
 			if cte, isCTE := distinct.Child.(*plan.With); isCTE {
-				return plan.NewWith(plan.NewDistinct(cte.Child), cte.CTEs), nil
+				return cte.WithChildren(plan.NewDistinct(cte.Child))
 			}
 		}
 		return n, nil
@@ -0,0 +1,169 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// resolveDefaultColumns replaces the DEFAULT keyword on the right side of the SET clauses of UPDATE and INSERT ... ON
+// DUPLICATE KEY UPDATE statements, and the DEFAULT(col) function, with the default value of their column. The DEFAULT
+// keyword and function in the rows of INSERT ... VALUES statements are handled with the other insert rows, in
+// resolveInsertRows.
+func resolveDefaultColumns(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	span, _ := ctx.Span("resolve_default_columns")
+	defer span.Finish()
+
+	return plan.TransformExpressionsUpWithNode(n, func(n sql.Node, e sql.Expression) (sql.Expression, error) {
+		switch e := e.(type) {
+		case *expression.SetField:
+			defaultColumn, ok := e.Right.(*expression.DefaultColumn)
+			if !ok || defaultColumn.Name() != "" {
+				return e, nil
+			}
+			// The left side is a variable, or isn't resolved yet
+			getField, ok := e.Left.(*expression.GetField)
+			if !ok {
+				return e, nil
+			}
+
+			sch := childrenSchema(n)
+			col := findColumn(sch, getField.Table(), getField.Name())
+			if col == nil {
+				return e, nil
+			}
+			def, err := columnDefaultExpression(ctx, sch, col, false)
+			if err != nil {
+				return nil, err
+			}
+			return e.WithChildren(e.Left, def)
+		case *expression.DefaultColumn:
+			if e.Name() == "" {
+				return e, nil
+			}
+
+			sch := childrenSchema(n)
+			col := findColumn(sch, "", e.Name())
+			if col == nil {
+				if len(n.Children()) > 0 && childrenResolved(n) {
+					return nil, sql.ErrColumnNotFound.New(e.Name())
+				}
+				return e, nil
+			}
+			return columnDefaultExpression(ctx, sch, col, true)
+		default:
+			return e, nil
+		}
+	})
+}
+
+// childrenSchema returns the schemas of the children of the node given, one after the other.
+func childrenSchema(n sql.Node) sql.Schema {
+	var sch sql.Schema
+	for _, child := range n.Children() {
+		if !child.Resolved() {
+			continue
+		}
+		sch = append(sch, child.Schema()...)
+	}
+	return sch
+}
+
+func childrenResolved(n sql.Node) bool {
+	for _, child := range n.Children() {
+		if !child.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// findColumn returns the column of the schema given with the name given, from the table given if it isn't empty, or
+// nil if there's none.
+func findColumn(sch sql.Schema, table, name string) *sql.Column {
+	for _, col := range sch {
+		if strings.EqualFold(col.Name, name) && (table == "" || strings.EqualFold(col.Source, table)) {
+			return col
+		}
+	}
+	return nil
+}
+
+// columnDefaultExpression returns an expression for the default value of the column given, which can reference the
+// other columns of its table in the schema given. Columns without a default value default to NULL, unless they're
+// non-nullable, in which case an error is returned as in MySQL. As the DEFAULT(col) function may only be used on
+// columns whose default value is a literal, an error is returned for expression defaults if |isFunction| is true.
+func columnDefaultExpression(ctx *sql.Context, sch sql.Schema, col *sql.Column, isFunction bool) (sql.Expression, error) {
+	def := col.Default
+	if def == nil {
+		if !col.Nullable && !col.AutoIncrement {
+			return nil, sql.ErrInsertIntoNonNullableDefaultNullColumn.New(col.Name)
+		}
+		return expression.NewLiteral(nil, sql.Null), nil
+	}
+
+	if ucd, ok := def.Expression.(sql.UnresolvedColumnDefault); ok {
+		var err error
+		def, err = parse.StringToColumnDefaultValue(ctx, ucd.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if isFunction && !def.IsLiteral() {
+		return nil, sql.ErrDefaultFunctionExpressionDefault.New(col.Name)
+	}
+
+	// Default values index the columns of their table, which may be anywhere in the schema given
+	expr, err := expression.TransformUp(def.Expression, func(e sql.Expression) (sql.Expression, error) {
+		var name string
+		switch e := e.(type) {
+		case *expression.GetField:
+			name = e.Name()
+		case *expression.UnresolvedColumn:
+			name = e.Name()
+		default:
+			return e, nil
+		}
+
+		idx := sch.IndexOf(name, col.Source)
+		if idx < 0 {
+			return nil, sql.ErrTableColumnNotFound.New(col.Source, name)
+		}
+		refCol := sch[idx]
+		return expression.NewGetFieldWithTable(idx, refCol.Type, refCol.Source, refCol.Name, refCol.Nullable), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return sql.NewColumnDefaultValue(expr, col.Type, def.IsLiteral(), col.Nullable)
+}
+
+// referencesColumns returns whether the default value given references any column.
+func referencesColumns(def *sql.ColumnDefaultValue) bool {
+	found := false
+	sql.Inspect(def, func(e sql.Expression) bool {
+		if _, ok := e.(*expression.GetField); ok {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
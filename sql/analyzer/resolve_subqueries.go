@@ -30,7 +30,7 @@ func resolveSubqueries(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 			// subqueries do not have access to outer scope
 			child, err := a.analyzeThroughBatch(ctx, n.Child, nil, "default-rules")
 			if err != nil {
-				return nil, err
+				return nil, viewInvalidError(n, err)
 			}
 
 			if len(n.Columns) > 0 {
@@ -57,7 +57,7 @@ func finalizeSubqueries(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope)
 			// subqueries do not have access to outer scope
 			child, err := a.analyzeStartingAtBatch(ctx, n.Child, nil, "default-rules")
 			if err != nil {
-				return nil, err
+				return nil, viewInvalidError(n, err)
 			}
 
 			if len(n.Columns) > 0 {
@@ -144,6 +144,11 @@ func StripQueryProcess(n sql.Node) sql.Node {
 }
 
 func exprIsCacheable(expr sql.Expression, lowestAlloewdIdx int) bool {
+	// Stable functions, like NOW(), return the same result for the whole statement, so only volatile ones prevent
+	// the results of a subquery from being cached
+	if sql.IsVolatile(expr) {
+		return false
+	}
 	cacheable := true
 	sql.Inspect(expr, func(e sql.Expression) bool {
 		if gf, ok := e.(*expression.GetField); ok {
@@ -152,10 +157,6 @@ func exprIsCacheable(expr sql.Expression, lowestAlloewdIdx int) bool {
 				return false
 			}
 		}
-		if nd, ok := e.(sql.NonDeterministicExpression); ok && nd.IsNonDeterministic() {
-			cacheable = false
-			return false
-		}
 		return true
 	})
 	return cacheable
@@ -224,13 +225,29 @@ func cacheSubqueryResults(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scop
 
 // cacheSubqueryAlisesInJoins will look for joins against subquery aliases that
 // will repeatedly execute the subquery, and will insert a *plan.CachedResults
-// node on top of those nodes.
+// node on top of those nodes. References to common table expressions defined
+// AS NOT MATERIALIZED are never cached, and those defined AS MATERIALIZED
+// are cached wherever they are.
 func cacheSubqueryAlisesInJoins(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
 	n, err := plan.TransformUpCtx(n, nil, func(c plan.TransformContext) (sql.Node, error) {
+		sq, isSubqueryAlias := c.Node.(*plan.SubqueryAlias)
+		if isSubqueryAlias && sq.Materialization == plan.CteNotMaterialized {
+			return c.Node, nil
+		}
+
 		_, isJoin := c.Parent.(plan.JoinNode)
 		_, isIndexedJoin := c.Parent.(*plan.IndexedJoin)
+		if isSubqueryAlias && !isJoin && !isIndexedJoin && sq.Materialization == plan.CteMaterialized {
+			// The cache goes below the subquery alias, which is opaque, so that
+			// it outlives the copies of the plan made to evaluate subquery
+			// expressions for each row of their outer scope.
+			if _, isCached := sq.Child.(*plan.CachedResults); !isCached {
+				return sq.WithChildren(plan.NewCachedResults(sq.Child))
+			}
+			return c.Node, nil
+		}
+
 		if isJoin || isIndexedJoin {
-			_, isSubqueryAlias := c.Node.(*plan.SubqueryAlias)
 			if isSubqueryAlias {
 				// SubqueryAliases are always cacheable. They
 				// cannot reference their outside scope and
@@ -427,7 +427,9 @@ func TestResolveSubqueryExpressions(t *testing.T) {
 										),
 										""),
 								),
-								plan.NewResolvedTable(table2, db, nil),
+								plan.NewDecoratedNode("Projected table access on [y i]",
+									plan.NewResolvedTable(table2.WithProjection([]string{"y", "i"}), db, nil),
+								),
 							),
 						),
 						""),
@@ -16,6 +16,7 @@ package analyzer
 
 import (
 	"reflect"
+	"strings"
 
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -51,12 +52,78 @@ func resolveUnions(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql
 			}
 
 			return n.WithChildren(StripQueryProcess(left), StripQueryProcess(right))
+		case *plan.RecursiveCte:
+			return resolveRecursiveCte(ctx, a, n, scope)
 		default:
 			return n, nil
 		}
 	})
 }
 
+// resolveRecursiveCte resolves the non-recursive query blocks of a recursive common table expression, whose schema
+// gives the schema of the table of the expression, and then resolves its recursive query blocks, which read that table.
+// The integer and floating point columns of the table are widened to 64 bits, so that recursive query blocks that
+// count don't overflow the types of the literals of the non-recursive ones.
+func resolveRecursiveCte(ctx *sql.Context, a *Analyzer, n *plan.RecursiveCte, scope *Scope) (sql.Node, error) {
+	subqueryCtx, cancelFunc := ctx.NewSubContext()
+	defer cancelFunc()
+
+	left, err := a.analyzeThroughBatch(subqueryCtx, n.Left(), scope, "default-rules")
+	if err != nil {
+		return nil, err
+	}
+	left = StripQueryProcess(left)
+
+	leftSchema := left.Schema()
+	if len(n.Columns) > 0 && len(n.Columns) != len(leftSchema) {
+		return nil, sql.ErrColumnCountMismatch.New()
+	}
+	schema := make(sql.Schema, len(leftSchema))
+	for i, col := range leftSchema {
+		c := *col
+		c.Source = n.Name()
+		if len(n.Columns) > 0 {
+			c.Name = n.Columns[i]
+		}
+		switch {
+		case sql.IsInteger(c.Type) && sql.IsUnsigned(c.Type):
+			c.Type = sql.Uint64
+		case sql.IsInteger(c.Type):
+			c.Type = sql.Int64
+		case sql.IsFloat(c.Type):
+			c.Type = sql.Float64
+		}
+		schema[i] = &c
+	}
+	table := plan.NewRecursiveTable(n.Name(), schema)
+
+	right, err := plan.TransformUp(n.Right(), func(n sql.Node) (sql.Node, error) {
+		if rt, ok := n.(*plan.ResolvedTable); ok {
+			if _, ok := rt.Table.(*plan.RecursiveTable); ok && strings.EqualFold(rt.Name(), table.Name()) {
+				return plan.NewResolvedTable(table, nil, nil), nil
+			}
+		}
+		return n, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	right, err = a.analyzeThroughBatch(subqueryCtx, right, scope, "default-rules")
+	if err != nil {
+		return nil, err
+	}
+	right = StripQueryProcess(right)
+	if len(right.Schema()) != len(schema) {
+		return nil, ErrUnionSchemasDifferentLength.New(len(schema), len(right.Schema()))
+	}
+
+	nn, err := n.WithChildren(left, right)
+	if err != nil {
+		return nil, err
+	}
+	return nn.(*plan.RecursiveCte).WithTable(table), nil
+}
+
 func finalizeUnions(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
 	// Procedures explicitly handle unions
 	if _, ok := n.(*plan.CreateProcedure); ok {
@@ -79,6 +146,21 @@ func finalizeUnions(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sq
 				return nil, err
 			}
 
+			return n.WithChildren(StripQueryProcess(left), StripQueryProcess(right))
+		case *plan.RecursiveCte:
+			subqueryCtx, cancelFunc := ctx.NewSubContext()
+			defer cancelFunc()
+
+			left, err := a.analyzeStartingAtBatch(subqueryCtx, n.Left(), scope, "default-rules")
+			if err != nil {
+				return nil, err
+			}
+
+			right, err := a.analyzeStartingAtBatch(subqueryCtx, n.Right(), scope, "default-rules")
+			if err != nil {
+				return nil, err
+			}
+
 			return n.WithChildren(StripQueryProcess(left), StripQueryProcess(right))
 		default:
 			return n, nil
@@ -166,10 +166,10 @@ func TestResolveColumnsSession(t *testing.T) {
 
 	expected := plan.NewProject(
 		[]sql.Expression{
-			expression.NewUserVar("foo_bar"),
-			expression.NewUserVar("bar_baz"),
+			expression.NewUserVarWithType("foo_bar", sql.Int64),
+			expression.NewUserVarWithType("bar_baz", sql.Null),
 			expression.NewSystemVar("autocommit", sql.SystemVariableScope_Session),
-			expression.NewUserVar("myvar"),
+			expression.NewUserVarWithType("myvar", sql.Null),
 		},
 		plan.NewResolvedTable(dualTable, nil, nil),
 	)
@@ -94,10 +94,31 @@ func resolveViews(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.
 			}
 		}
 
-		return view.Definition().WithChildren(query)
+		definition, err := view.Definition().WithChildren(query)
+		if err != nil {
+			return nil, err
+		}
+		if sa, ok := definition.(*plan.SubqueryAlias); ok {
+			definition = sa.WithViewDatabase(dbName)
+		}
+		return definition, nil
 	})
 }
 
+// viewInvalidError returns the error given as an ErrViewInvalid if the view definition given failed to analyze because
+// a table, column or function it refers to no longer exists, and the error unchanged otherwise.
+func viewInvalidError(sa *plan.SubqueryAlias, err error) error {
+	if sa.ViewDatabase == "" {
+		return err
+	}
+	switch {
+	case sql.ErrTableNotFound.Is(err), sql.ErrDatabaseNotFound.Is(err), sql.ErrColumnNotFound.Is(err),
+		sql.ErrTableColumnNotFound.Is(err), sql.ErrFunctionNotFound.Is(err):
+		return sql.ErrViewInvalid.New(sa.ViewDatabase, sa.Name())
+	}
+	return err
+}
+
 func applyAsOfToView(n sql.Node, a *Analyzer, asOf sql.Expression) (sql.Node, error) {
 	a.Log("applying AS OF clause to view definition")
 
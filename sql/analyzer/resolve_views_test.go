@@ -54,7 +54,7 @@ func TestResolveViews(t *testing.T) {
 	var notAnalyzed sql.Node = plan.NewUnresolvedTable("myview", "")
 	analyzed, err := f.Apply(ctx, a, notAnalyzed, nil)
 	require.NoError(err)
-	require.Equal(viewDefinition, analyzed)
+	require.Equal(viewDefinition.WithViewDatabase("mydb"), analyzed)
 
 	viewDefinitionWithAsOf := plan.NewSubqueryAlias(
 		"myview", "select i from mytable",
@@ -67,7 +67,7 @@ func TestResolveViews(t *testing.T) {
 
 	analyzed, err = f.Apply(ctx, a, notAnalyzedAsOf, nil)
 	require.NoError(err)
-	require.Equal(viewDefinitionWithAsOf, analyzed)
+	require.Equal(viewDefinitionWithAsOf.WithViewDatabase("mydb"), analyzed)
 
 	// Views that are defined with AS OF clauses cannot have an AS OF pushed down to them
 	viewWithAsOf := sql.NewView("viewWithAsOf", viewDefinitionWithAsOf, "select i from mytable as of '2019-01-01'")
@@ -0,0 +1,187 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// applyRowSecurity applies the row security policies of the catalog to the tables of the node given. Tables read by
+// the node are filtered by the predicates of their policies, and the rows written by INSERT and UPDATE statements are
+// checked against the predicates of the table they're written to. Sessions of users with the SUPER privilege bypass
+// row security.
+func applyRowSecurity(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	if a.Catalog == nil || a.Catalog.RowSecurity == nil || a.Catalog.RowSecurity.IsEmpty() {
+		return n, nil
+	}
+	if hasSuperPrivilege(ctx, a) {
+		return n, nil
+	}
+
+	switch n := n.(type) {
+	case *plan.InsertInto:
+		// The source of an insert is analyzed on its own, which filters the tables it reads
+		rt := getResolvedTable(n.Destination)
+		if rt == nil {
+			return n, nil
+		}
+		check, err := rowSecurityCheck(ctx, a, rt)
+		if err != nil || check == nil {
+			return n, err
+		}
+		nn := *n
+		nn.Checks = append(append(sql.CheckConstraints(nil), n.Checks...), check)
+		return &nn, nil
+	case *plan.Update:
+		node, err := filterRowSecurityTables(ctx, a, n)
+		if err != nil {
+			return nil, err
+		}
+		// Rows written by multi-table updates are not checked, only the rows they read are filtered
+		tables := getTablesByName(n)
+		if len(tables) != 1 {
+			return node, nil
+		}
+		check, err := rowSecurityCheck(ctx, a, getResolvedTable(n))
+		if err != nil || check == nil {
+			return node, err
+		}
+		nn := *(node.(*plan.Update))
+		nn.Checks = append(append(sql.CheckConstraints(nil), nn.Checks...), check)
+		return &nn, nil
+	case *plan.LockTables, *plan.AlterAutoIncrement, *plan.AlterDefaultSet, *plan.AlterDefaultDrop:
+		return n, nil
+	default:
+		if plan.IsNoRowNode(n) {
+			return n, nil
+		}
+		return filterRowSecurityTables(ctx, a, n)
+	}
+}
+
+// hasSuperPrivilege returns whether the account of the security context has the SUPER privilege, which bypasses row
+// security and column masks.
+func hasSuperPrivilege(ctx *sql.Context, a *Analyzer) bool {
+	sc := ctx.SecurityContext()
+	return a.Catalog.GrantTables.HasSuperPrivilege(sc.User, sc.Host)
+}
+
+// filterRowSecurityTables wraps each table of the node given that has row security policies in a filter of their
+// predicates. Aliased tables are filtered above their alias. Subqueries and unions are analyzed on their own and are
+// not descended into.
+func filterRowSecurityTables(ctx *sql.Context, a *Analyzer, n sql.Node) (sql.Node, error) {
+	return plan.TransformUpCtx(n, nil, func(c plan.TransformContext) (sql.Node, error) {
+		var rt *plan.ResolvedTable
+		switch node := c.Node.(type) {
+		case *plan.TableAlias:
+			var ok bool
+			if rt, ok = node.Child.(*plan.ResolvedTable); !ok {
+				return node, nil
+			}
+		case *plan.ResolvedTable:
+			if _, ok := c.Parent.(*plan.TableAlias); ok {
+				return node, nil
+			}
+			rt = node
+		default:
+			return node, nil
+		}
+
+		predicate, err := rowSecurityPredicate(ctx, a, rt)
+		if err != nil || predicate == nil {
+			return c.Node, err
+		}
+		return plan.NewFilter(predicate, c.Node), nil
+	})
+}
+
+// rowSecurityCheck returns a check constraint that rejects rows written to the table given that its row security
+// policies don't allow, or nil if the table has no policies.
+func rowSecurityCheck(ctx *sql.Context, a *Analyzer, rt *plan.ResolvedTable) (*sql.CheckConstraint, error) {
+	predicate, err := rowSecurityPredicate(ctx, a, rt)
+	if err != nil || predicate == nil {
+		return nil, err
+	}
+	return &sql.CheckConstraint{
+		Name:     fmt.Sprintf("%s_row_security", rt.Name()),
+		Expr:     expression.NewRowSecurityCheck(rt.Name(), predicate),
+		Enforced: true,
+	}, nil
+}
+
+// rowSecurityPredicate returns the disjunction of the predicates of the row security policies of the table given, or
+// nil if the table has no policies.
+func rowSecurityPredicate(ctx *sql.Context, a *Analyzer, rt *plan.ResolvedTable) (sql.Expression, error) {
+	if rt.Database == nil {
+		return nil, nil
+	}
+	policies := a.Catalog.RowSecurity.Get(rt.Database.Name(), rt.Name())
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	predicates := make([]sql.Expression, len(policies))
+	for i, policy := range policies {
+		predicate, err := parseRowSecurityPredicate(ctx, a, policy)
+		if err != nil {
+			return nil, err
+		}
+		predicates[i] = predicate
+	}
+	return expression.JoinOr(predicates...), nil
+}
+
+// parseRowSecurityPredicate parses the predicate of the policy given. The variables it references are resolved here,
+// since the predicate is added to the node after its variables are resolved.
+func parseRowSecurityPredicate(ctx *sql.Context, a *Analyzer, policy sql.RowSecurityPolicy) (sql.Expression, error) {
+	parsed, err := sqlparser.Parse(fmt.Sprintf("select %s", policy.Predicate))
+	if err != nil {
+		return nil, sql.ErrInvalidRowSecurityPredicate.New(policy.Name, policy.Predicate)
+	}
+
+	selectStmt, ok := parsed.(*sqlparser.Select)
+	if !ok || len(selectStmt.SelectExprs) != 1 {
+		return nil, sql.ErrInvalidRowSecurityPredicate.New(policy.Name, policy.Predicate)
+	}
+
+	ae, ok := selectStmt.SelectExprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil, sql.ErrInvalidRowSecurityPredicate.New(policy.Name, policy.Predicate)
+	}
+
+	predicate, err := parse.ExprToExpression(ctx, ae.Expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return expression.TransformUp(predicate, func(e sql.Expression) (sql.Expression, error) {
+		uc, ok := e.(column)
+		if !ok || e.Resolved() {
+			return e, nil
+		}
+		expr, ok, err := resolveSystemOrUserVariable(ctx, a, uc)
+		if err != nil || !ok {
+			return e, err
+		}
+		return expr, nil
+	})
+}
@@ -24,6 +24,7 @@ var OnceBeforeDefault = []Rule{
 	{"check_privileges", checkPrivileges},
 	{"validate_offset_and_limit", validateLimitAndOffset},
 	{"validate_create_table", validateCreateTable},
+	{"validate_identifiers", validateIdentifiers},
 	{"load_stored_procedures", loadStoredProcedures},
 	{"resolve_variables", resolveVariables},
 	{"resolve_set_variables", resolveSetVariables},
@@ -33,11 +34,16 @@ var OnceBeforeDefault = []Rule{
 	{"resolve_databases", resolveDatabases},
 	{"resolve_tables", resolveTables},
 	{"set_target_schemas", setTargetSchemas},
+	{"decorrelate_subqueries", decorrelateSubqueries},
 	{"resolve_create_like", resolveCreateLike},
 	{"parse_column_defaults", parseColumnDefaults},
 	{"resolve_drop_constraint", resolveDropConstraint},
 	{"validate_drop_constraint", validateDropConstraint},
 	{"load_check_constraints", loadChecks},
+	{"apply_column_masks", applyColumnMasks},
+	{"apply_row_security", applyRowSecurity},
+	{"resolve_table_handlers", resolveTableHandlers},
+	{"resolve_prepared_queries", resolvePreparedQueries},
 	{"resolve_create_select", resolveCreateSelect},
 	{"resolve_subqueries", resolveSubqueries},
 	{"resolve_unions", resolveUnions},
@@ -63,6 +69,7 @@ var DefaultRules = []Rule{
 	{"pushdown_subquery_alias_filters", pushdownSubqueryAliasFilters},
 	{"qualify_columns", qualifyColumns},
 	{"resolve_columns", resolveColumns},
+	{"resolve_default_columns", resolveDefaultColumns},
 	{"validate_check_constraint", validateCreateCheck},
 	{"resolve_bareword_set_variables", resolveBarewordSetVariables},
 	{"expand_stars", expandStars},
@@ -80,6 +87,7 @@ var DefaultRules = []Rule{
 // OnceAfterDefault contains the rules to be applied just once after the
 // DefaultRules.
 var OnceAfterDefault = []Rule{
+	{"check_table_privileges", checkTablePrivileges},
 	{"finalize_subqueries", finalizeSubqueries},
 	{"finalize_unions", finalizeUnions},
 	{"load_triggers", loadTriggers},
@@ -88,6 +96,7 @@ var OnceAfterDefault = []Rule{
 	{"validate_alter_column", validateAlterColumn},
 	{"resolve_generators", resolveGenerators},
 	{"remove_unnecessary_converts", removeUnnecessaryConverts},
+	{"fold_constant_functions", foldConstantFunctions},
 	{"assign_catalog", assignCatalog},
 	{"prune_columns", pruneColumns},
 	{"optimize_joins", constructJoinPlan},
@@ -104,6 +113,9 @@ var OnceAfterDefault = []Rule{
 	{"cache_subquery_results", cacheSubqueryResults},
 	{"cache_subquery_aliases_in_joins", cacheSubqueryAlisesInJoins},
 	{"apply_hash_lookups", applyHashLookups},
+	{"apply_merge_joins", applyMergeJoins},
+	{"apply_sorted_group_by", applySortedGroupBy},
+	{"apply_hash_joins", applyHashJoins},
 	{"apply_hash_in", applyHashIn},
 	{"resolve_insert_rows", resolveInsertRows},
 	{"apply_triggers", applyTriggers},
@@ -0,0 +1,119 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// SchemaVersions are the schema versions of a set of tables, as returned by Catalog.SchemaVersions.
+type SchemaVersions map[schemaVersionKey]uint64
+
+// schemaVersionKey identifies a table, or a database when the table name is empty.
+type schemaVersionKey struct {
+	db, table string
+}
+
+func newSchemaVersionKey(db, table string) schemaVersionKey {
+	return schemaVersionKey{strings.ToLower(db), strings.ToLower(table)}
+}
+
+// SchemaVersion returns the schema version of the table named. The version starts at zero and changes every time the
+// schema of the table, or the database it's in, is changed by a DDL statement, including when the table is created,
+// dropped or renamed. Anything that caches information derived from the schema of a table, such as an analyzed plan,
+// can keep the version and compare it to the current one before using the information again.
+func (c *Catalog) SchemaVersion(db, table string) uint64 {
+	c.versionsMu.Lock()
+	defer c.versionsMu.Unlock()
+
+	version := c.schemaVersions[newSchemaVersionKey(db, "")]
+	if v := c.schemaVersions[newSchemaVersionKey(db, table)]; v > version {
+		version = v
+	}
+	return version
+}
+
+// BumpSchemaVersion changes the schema version of the table named, or of every table in the database named if the
+// table name is empty. The tables affected are closed, see openTable.
+func (c *Catalog) BumpSchemaVersion(db, table string) {
+	c.versionsMu.Lock()
+	defer c.versionsMu.Unlock()
+
+	if c.schemaVersions == nil {
+		c.schemaVersions = make(map[schemaVersionKey]uint64)
+	}
+	c.lastSchemaVersion++
+	c.schemaVersions[newSchemaVersionKey(db, table)] = c.lastSchemaVersion
+	c.closeTables(db, table)
+}
+
+// SchemaVersions returns the current schema version of every table the node given reads or writes, including in
+// subqueries. The node may be parsed or analyzed.
+func (c *Catalog) SchemaVersions(ctx *sql.Context, node sql.Node) SchemaVersions {
+	versions := make(SchemaVersions)
+	addTable := func(db sql.Database, name string) {
+		dbName := ctx.GetCurrentDatabase()
+		if db != nil {
+			dbName = db.Name()
+		}
+		versions[newSchemaVersionKey(dbName, name)] = c.SchemaVersion(dbName, name)
+	}
+
+	var inspect func(sql.Node) bool
+	inspectSubqueries := func(e sql.Expression) bool {
+		if sq, ok := e.(*plan.Subquery); ok {
+			plan.Inspect(sq.Query, inspect)
+		}
+		return true
+	}
+	inspect = func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.UnresolvedTable:
+			dbName := n.Database
+			if dbName == "" {
+				dbName = ctx.GetCurrentDatabase()
+			}
+			versions[newSchemaVersionKey(dbName, n.Name())] = c.SchemaVersion(dbName, n.Name())
+		case *plan.ResolvedTable:
+			addTable(n.Database, n.Name())
+		case *plan.IndexedTableAccess:
+			addTable(n.ResolvedTable.Database, n.ResolvedTable.Name())
+		case *plan.IndexedInSubqueryFilter:
+			inspectSubqueries(n.Subquery())
+		}
+		if ex, ok := n.(sql.Expressioner); ok {
+			for _, e := range ex.Expressions() {
+				sql.Inspect(e, inspectSubqueries)
+			}
+		}
+		return true
+	}
+	plan.Inspect(node, inspect)
+	return versions
+}
+
+// IsSchemaCurrent returns whether the schema of none of the tables of the versions given has changed since they were
+// returned by SchemaVersions.
+func (c *Catalog) IsSchemaCurrent(versions SchemaVersions) bool {
+	for key, version := range versions {
+		if c.SchemaVersion(key.db, key.table) != version {
+			return false
+		}
+	}
+	return true
+}
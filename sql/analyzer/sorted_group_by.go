@@ -0,0 +1,92 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// applySortedGroupBy makes the GroupBy nodes whose child returns its rows sorted by their grouping expressions
+// aggregate one group at a time, as the rows are read, instead of holding all the groups until the last row. The rows
+// are sorted when the child sorts them, through nodes that keep their order, or when the only grouping expression is
+// a column of the single table the child reads, and the table has an ordered index whose first column is that one.
+// The child then reads the table in the order of the index. Nothing changes when the sorted_group_by feature flag is
+// off.
+func applySortedGroupBy(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	if !ctx.FeatureEnabled(sql.FeatureSortedGroupBy) {
+		return n, nil
+	}
+
+	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
+		gb, ok := n.(*plan.GroupBy)
+		if !ok || gb.Sorted || len(gb.GroupByExprs) == 0 || !gb.Resolved() {
+			return n, nil
+		}
+
+		if sortedBy(gb.Child, gb.GroupByExprs) {
+			a.Log("aggregating the groups of a sorted child one at a time")
+			return gb.WithSorted(), nil
+		}
+
+		column, ok := gb.GroupByExprs[0].(*expression.GetField)
+		if len(gb.GroupByExprs) != 1 || !ok {
+			return n, nil
+		}
+		child, ok, err := orderByIndex(ctx, gb.Child, column, "")
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return n, nil
+		}
+
+		a.Log("aggregating the groups of a table read in the order of an index one at a time")
+		sorted, err := gb.WithSorted().WithChildren(child)
+		if err != nil {
+			return nil, err
+		}
+		return sorted, nil
+	})
+}
+
+// sortedBy returns whether the node given returns its rows sorted by the expressions given, in any order and
+// direction, because it sorts them by these expressions before any others, through nodes that keep the order of its
+// rows.
+func sortedBy(n sql.Node, exprs []sql.Expression) bool {
+	switch n := n.(type) {
+	case *plan.Sort:
+		if len(n.SortFields) < len(exprs) {
+			return false
+		}
+		fields := make(map[string]bool)
+		for _, f := range n.SortFields[:len(exprs)] {
+			fields[strings.ToLower(f.Column.String())] = true
+		}
+		for _, e := range exprs {
+			if !fields[strings.ToLower(e.String())] {
+				return false
+			}
+		}
+		return true
+	case *plan.Project, *plan.Filter, *plan.TableAlias, *plan.DecoratedNode:
+		return sortedBy(n.Children()[0], exprs)
+	default:
+		return false
+	}
+}
@@ -80,7 +80,8 @@ func loadStoredProcedures(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scop
 				if err != nil {
 					return nil, err
 				}
-				analyzedNode, err = analyzeProcedureBodies(ctx, a, analyzedNode, false, scope)
+				// The body is analyzed with the privileges it executes with, which may be its definer's
+				analyzedNode, err = analyzeProcedureBodies(cp.Procedure.ExecutionContext(ctx), a, analyzedNode, false, scope)
 				if err != nil {
 					return nil, err
 				}
@@ -0,0 +1,129 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// resolveTableHandlers assigns the table handlers of the catalog to HANDLER statements, and resolves HANDLER ... READ
+// statements against the table of the handler they read from. Reads see the same rows as queries of the session do:
+// the columns masks and row security policies of the table are applied to them.
+func resolveTableHandlers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	switch n := n.(type) {
+	case *plan.HandlerOpen:
+		nc := *n
+		nc.Catalog = a.Catalog
+		nc.Handlers = a.Catalog.TableHandlers
+		return &nc, nil
+	case *plan.HandlerClose:
+		nc := *n
+		nc.Handlers = a.Catalog.TableHandlers
+		return &nc, nil
+	case *plan.HandlerRead:
+		if n.Resolved() {
+			return n, nil
+		}
+		return resolveHandlerRead(ctx, a, n)
+	default:
+		return n, nil
+	}
+}
+
+func resolveHandlerRead(ctx *sql.Context, a *Analyzer, n *plan.HandlerRead) (sql.Node, error) {
+	handler, err := a.Catalog.TableHandlers.Get(ctx.ID(), n.Name)
+	if err != nil {
+		return nil, err
+	}
+	table, db, err := a.Catalog.Table(ctx, handler.Database, handler.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	var index sql.Index
+	if n.IndexName != "" {
+		if it, ok := table.(sql.IndexedTable); ok {
+			indexes, err := it.GetIndexes(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, idx := range indexes {
+				if strings.EqualFold(idx.ID(), n.IndexName) {
+					index = idx
+					break
+				}
+			}
+		}
+		if index == nil {
+			return nil, sql.ErrIndexNotFound.New(n.IndexName)
+		}
+	}
+
+	where := n.Where
+	if !hasSuperPrivilege(ctx, a) {
+		predicate, err := rowSecurityPredicate(ctx, a, plan.NewResolvedTable(table, db, nil))
+		if err != nil {
+			return nil, err
+		}
+		if predicate != nil && where != nil {
+			where = expression.JoinAnd(where, predicate)
+		} else if predicate != nil {
+			where = predicate
+		}
+		if masks := a.Catalog.ColumnMasks.Get(db.Name(), table.Name()); len(masks) > 0 {
+			table = plan.NewMaskedTable(table, masks)
+		}
+	}
+
+	sch := table.Schema()
+	if where != nil {
+		if where, err = resolveHandlerExpression(ctx, a, sch, where); err != nil {
+			return nil, err
+		}
+	}
+	key := make([]sql.Expression, len(n.Key))
+	for i, e := range n.Key {
+		if key[i], err = resolveHandlerExpression(ctx, a, sch, e); err != nil {
+			return nil, err
+		}
+	}
+
+	return n.WithTable(handler, table, index, key, where), nil
+}
+
+// resolveHandlerExpression resolves the columns of the expression given against the schema of the table of a handler,
+// and its functions against the catalog.
+func resolveHandlerExpression(ctx *sql.Context, a *Analyzer, sch sql.Schema, e sql.Expression) (sql.Expression, error) {
+	e, err := expression.TransformUp(e, func(e sql.Expression) (sql.Expression, error) {
+		uc, ok := e.(*expression.UnresolvedColumn)
+		if !ok {
+			return e, nil
+		}
+		idx := sch.IndexOf(uc.Name(), sch[0].Source)
+		if idx < 0 {
+			return nil, sql.ErrColumnNotFound.New(uc.Name())
+		}
+		col := sch[idx]
+		return expression.NewGetFieldWithTable(idx, col.Type, col.Source, col.Name, col.Nullable), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return expression.TransformUp(e, resolveFunctionsInExpr(ctx, a))
+}
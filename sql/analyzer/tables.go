@@ -256,5 +256,35 @@ func getFieldsByTable(ctx *sql.Context, n sql.Node) fieldsByTable {
 		}
 		return true
 	})
+
+	// Indexed joins and index lookups evaluate expressions that aren't exposed as node expressions: the join condition,
+	// and the indexed columns of the table being accessed.
+	plan.Inspect(n, func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.IndexedJoin:
+			sql.Inspect(n.Cond, func(e sql.Expression) bool {
+				if gf, ok := e.(*expression.GetField); ok {
+					fieldsByTable.add(gf.Table(), gf.Name())
+				}
+				return true
+			})
+		case *plan.TableAlias:
+			if ita, ok := n.Child.(*plan.IndexedTableAccess); ok {
+				fieldsByTable.addIndexColumns(n.Name(), ita.Index())
+				return false
+			}
+		case *plan.IndexedTableAccess:
+			fieldsByTable.addIndexColumns(n.Name(), n.Index())
+		}
+		return true
+	})
+
 	return fieldsByTable
 }
+
+// addIndexColumns adds the columns of the index given to the table given
+func (f fieldsByTable) addIndexColumns(table string, idx sql.Index) {
+	for _, e := range idx.Expressions() {
+		f.add(table, e[strings.LastIndex(e, ".")+1:])
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// slowAnalysisRules is the number of rules listed in the warnings logged for slow analyses.
+const slowAnalysisRules = 5
+
+// RuleTiming is the time spent applying a rule of a batch while analyzing a query.
+type RuleTiming struct {
+	// Batch is the description of the batch of the rule.
+	Batch string
+	// Rule is the name of the rule.
+	Rule string
+	// Runs is the number of times the rule was applied, across the iterations of its batch and the analyses of
+	// subqueries.
+	Runs int
+	// Duration is the total time spent applying the rule. This includes the analysis of any subquery or other node the
+	// rule analyzes, whose rules are timed as well.
+	Duration time.Duration
+}
+
+// AnalysisTimings is the time spent by each rule of the analyzer while analyzing a query.
+type AnalysisTimings struct {
+	mu    sync.Mutex
+	total time.Duration
+	rules map[[2]string]*RuleTiming
+	order []*RuleTiming
+}
+
+func newAnalysisTimings() *AnalysisTimings {
+	return &AnalysisTimings{rules: make(map[[2]string]*RuleTiming)}
+}
+
+// Total returns the time spent analyzing the query.
+func (t *AnalysisTimings) Total() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.total
+}
+
+// Rules returns the timings of the rules applied, slowest first. Rules that took as long are in the order they were
+// first applied.
+func (t *AnalysisTimings) Rules() []RuleTiming {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	rules := make([]RuleTiming, len(t.order))
+	for i, rule := range t.order {
+		rules[i] = *rule
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Duration > rules[j].Duration
+	})
+	return rules
+}
+
+// String returns a report of the timings, with a line for each rule.
+func (t *AnalysisTimings) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("Analysis(total=%s)", t.Total())
+	var children []string
+	for _, rule := range t.Rules() {
+		children = append(children, fmt.Sprintf("%s/%s: %s (runs=%d)", rule.Batch, rule.Rule, rule.Duration, rule.Runs))
+	}
+	_ = pr.WriteChildren(children...)
+	return pr.String()
+}
+
+// add records a run of the rule of the batch given that took the time given.
+func (t *AnalysisTimings) add(batch, rule string, d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := [2]string{batch, rule}
+	timing, ok := t.rules[key]
+	if !ok {
+		timing = &RuleTiming{Batch: batch, Rule: rule}
+		t.rules[key] = timing
+		t.order = append(t.order, timing)
+	}
+	timing.Runs++
+	timing.Duration += d
+}
+
+// finish records the total time of the analysis, and returns it.
+func (t *AnalysisTimings) finish(total time.Duration) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.total = total
+	return total
+}
+
+// slowestRules returns a summary of the slowest rules, for logging.
+func (t *AnalysisTimings) slowestRules() string {
+	rules := t.Rules()
+	if len(rules) > slowAnalysisRules {
+		rules = rules[:slowAnalysisRules]
+	}
+	summaries := make([]string, len(rules))
+	for i, rule := range rules {
+		summaries[i] = fmt.Sprintf("%s/%s=%s", rule.Batch, rule.Rule, rule.Duration)
+	}
+	return strings.Join(summaries, ", ")
+}
+
+type analysisTimingsKey struct{}
+
+// timingsFromContext returns the timings of the analysis run by the context given, or nil if there's none.
+func timingsFromContext(ctx *sql.Context) *AnalysisTimings {
+	if ctx == nil || ctx.Context == nil {
+		return nil
+	}
+	timings, _ := ctx.Value(analysisTimingsKey{}).(*AnalysisTimings)
+	return timings
+}
+
+// withTimings returns a copy of the context given whose analysis records its timings in those given.
+func withTimings(ctx *sql.Context, timings *AnalysisTimings) *sql.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context, analysisTimingsKey{}, timings))
+}
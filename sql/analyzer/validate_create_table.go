@@ -217,6 +217,11 @@ func validateIndexes(tableSpec *plan.TableSpec) error {
 				return sql.ErrUnknownIndexColumn.New(col.Name, idx.IndexName)
 			}
 		}
+		if idx.Constraint == sql.IndexConstraint_Spatial {
+			if err := plan.ValidateSpatialIndex(tableSpec.Schema.Schema, idx.Columns); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -0,0 +1,101 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// validateIdentifiers returns an error if a statement creates or renames a database, table, view, column, index,
+// constraint, trigger or stored procedure with a name that's longer than the maximum identifier length.
+func validateIdentifiers(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
+	var err error
+	plan.Inspect(n, func(n sql.Node) bool {
+		if err != nil {
+			return false
+		}
+		err = validateIdentifierNames(createdNames(n)...)
+		return err == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// createdNames returns the names of the objects created or renamed by the node given.
+func createdNames(n sql.Node) []string {
+	switch n := n.(type) {
+	case *plan.CreateDB:
+		return []string{n.DbName()}
+	case *plan.CreateTable:
+		names := []string{n.Name()}
+		spec := n.TableSpec()
+		for _, col := range spec.Schema.Schema {
+			names = append(names, col.Name)
+		}
+		for _, idx := range spec.IdxDefs {
+			names = append(names, idx.IndexName)
+		}
+		for _, fk := range spec.FkDefs {
+			names = append(names, fk.Name)
+		}
+		for _, check := range spec.ChDefs {
+			names = append(names, check.Name)
+		}
+		return names
+	case *plan.CreateView:
+		return append([]string{n.Name}, n.Columns...)
+	case *plan.RenameTable:
+		return n.NewNames()
+	case *plan.MultiRenameTable:
+		names := make([]string, len(n.Renames))
+		for i, rename := range n.Renames {
+			names[i] = rename.NewName
+		}
+		return names
+	case *plan.AddColumn:
+		return []string{n.Column().Name}
+	case *plan.ModifyColumn:
+		return []string{n.NewColumn().Name}
+	case *plan.RenameColumn:
+		return []string{n.NewColumnName}
+	case *plan.CreateIndex:
+		return []string{n.Name}
+	case *plan.AlterIndex:
+		return []string{n.IndexName}
+	case *plan.CreateForeignKey:
+		return []string{n.FkDef.Name}
+	case *plan.CreateCheck:
+		return []string{n.Check.Name}
+	case *plan.CreateTrigger:
+		return []string{n.TriggerName}
+	case *plan.CreateProcedure:
+		return []string{n.Name}
+	default:
+		return nil
+	}
+}
+
+// validateIdentifierNames returns an error if any of the names given is longer than the maximum identifier length.
+func validateIdentifierNames(names ...string) error {
+	for _, name := range names {
+		if err := sql.ValidateIdentifier(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
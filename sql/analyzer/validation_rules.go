@@ -114,64 +114,64 @@ var DefaultValidationRules = []Rule{
 	{validateAggregationsRule, validateAggregations},
 }
 
-// validateLimitAndOffset ensures that only integer literals are used for limit and offset values
+// validateLimitAndOffset ensures that only non-negative integers are used for limit and offset values. Literals are
+// validated here, while other expressions, like the parameters of prepared statements or the expressions they're bound
+// to, must be constant: they're evaluated and validated when the node is executed.
 func validateLimitAndOffset(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
 	return plan.TransformUp(n, func(n sql.Node) (sql.Node, error) {
 		switch n := n.(type) {
 		case *plan.Limit:
-			switch e := n.Limit.(type) {
-			case *expression.Literal:
-				if !sql.IsInteger(e.Type()) {
-					return nil, sql.ErrInvalidType.New(e.Type().String())
-				}
-				i, err := e.Eval(ctx, nil)
-				if err != nil {
-					return nil, err
-				}
-
-				i64, err := sql.Int64.Convert(i)
-				if err != nil {
-					return nil, err
-				}
-				if i64.(int64) < 0 {
-					return nil, sql.ErrInvalidSyntax.New("negative limit")
-				}
-			case *expression.BindVar:
-				return n, nil
-			default:
-				return nil, sql.ErrInvalidType.New(e.Type().String())
-			}
-			return n, nil
+			return n, validateLimitExpression(ctx, n.Limit, "limit")
 		case *plan.Offset:
-			switch e := n.Offset.(type) {
-			case *expression.Literal:
-				if !sql.IsInteger(e.Type()) {
-					return nil, sql.ErrInvalidType.New(e.Type().String())
-				}
-				i, err := e.Eval(ctx, nil)
-				if err != nil {
-					return nil, err
-				}
-
-				i64, err := sql.Int64.Convert(i)
-				if err != nil {
-					return nil, err
-				}
-				if i64.(int64) < 0 {
-					return nil, sql.ErrInvalidSyntax.New("negative offset")
-				}
-			case *expression.BindVar:
-				return n, nil
-			default:
-				return nil, sql.ErrInvalidType.New(e.Type().String())
-			}
-			return n, nil
+			return n, validateLimitExpression(ctx, n.Offset, "offset")
 		default:
 			return n, nil
 		}
 	})
 }
 
+// validateLimitExpression validates the value of a limit or offset clause, as named by the clause given.
+func validateLimitExpression(ctx *sql.Context, e sql.Expression, clause string) error {
+	switch e := e.(type) {
+	case *expression.Literal:
+		if !sql.IsInteger(e.Type()) {
+			return sql.ErrInvalidType.New(e.Type().String())
+		}
+		i, err := e.Eval(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		// Unsigned values too large for an int64 are valid, and mean that there's no limit
+		if sql.IsUnsigned(e.Type()) {
+			return nil
+		}
+		i64, err := sql.Int64.Convert(i)
+		if err != nil {
+			return err
+		}
+		if i64.(int64) < 0 {
+			return sql.ErrInvalidSyntax.New("negative " + clause)
+		}
+		return nil
+	case *expression.BindVar:
+		return nil
+	default:
+		constant := true
+		sql.Inspect(e, func(e sql.Expression) bool {
+			switch e.(type) {
+			case *expression.UnresolvedColumn, *expression.GetField, *expression.Star, *plan.Subquery:
+				constant = false
+			}
+			return constant
+		})
+		if !constant {
+			return sql.ErrInvalidSyntax.New(fmt.Sprintf("%s must be constant: %s", clause, e))
+		}
+		return nil
+	}
+}
+
 func validateIsResolved(ctx *sql.Context, a *Analyzer, n sql.Node, scope *Scope) (sql.Node, error) {
 	span, _ := ctx.Span("validate_is_resolved")
 	defer span.Finish()
@@ -0,0 +1,108 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/parse"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// ViewDependency is a table or view that a view selects from.
+type ViewDependency struct {
+	ViewDatabase  string
+	ViewName      string
+	TableDatabase string
+	TableName     string
+}
+
+// ViewDependencies returns the tables and views that every view in the catalog given selects from, whether they
+// still exist or not, in the order they're named in each view's definition.
+func ViewDependencies(ctx *sql.Context, catalog sql.Catalog) ([]ViewDependency, error) {
+	var dependencies []ViewDependency
+	for _, db := range catalog.AllDatabases() {
+		if vdb, ok := db.(sql.ViewDatabase); ok {
+			views, err := vdb.AllViews(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, view := range views {
+				definition, err := parse.Parse(ctx, view.TextDefinition)
+				if err != nil {
+					return nil, err
+				}
+				dependencies = append(dependencies, viewDependencies(db.Name(), view.Name, definition)...)
+			}
+		}
+
+		for _, view := range ctx.GetViewRegistry().ViewsInDatabase(strings.ToLower(db.Name())) {
+			dependencies = append(dependencies, viewDependencies(db.Name(), view.Name(), view.Definition())...)
+		}
+	}
+	return dependencies, nil
+}
+
+// viewDependencies returns the tables and views named by the parsed definition given of the view named in the
+// database named, excluding common table expressions.
+func viewDependencies(db, view string, definition sql.Node) []ViewDependency {
+	var dependencies []ViewDependency
+	seen := make(map[string]bool)
+	ctes := make(map[string]bool)
+
+	var inspect func(sql.Node) bool
+	inspectSubqueries := func(e sql.Expression) bool {
+		if sq, ok := e.(*plan.Subquery); ok {
+			plan.Inspect(sq.Query, inspect)
+		}
+		return true
+	}
+	inspect = func(n sql.Node) bool {
+		switch n := n.(type) {
+		case *plan.With:
+			for _, cte := range n.CTEs {
+				ctes[strings.ToLower(cte.Subquery.Name())] = true
+				plan.Inspect(cte.Subquery, inspect)
+			}
+		case *plan.UnresolvedTable:
+			tableDb := n.Database
+			if tableDb == "" {
+				if ctes[strings.ToLower(n.Name())] {
+					return true
+				}
+				tableDb = db
+			}
+			key := strings.ToLower(tableDb) + "." + strings.ToLower(n.Name())
+			if !seen[key] {
+				seen[key] = true
+				dependencies = append(dependencies, ViewDependency{
+					ViewDatabase:  db,
+					ViewName:      view,
+					TableDatabase: tableDb,
+					TableName:     n.Name(),
+				})
+			}
+		}
+		if ex, ok := n.(sql.Expressioner); ok {
+			for _, e := range ex.Expressions() {
+				sql.Inspect(e, inspectSubqueries)
+			}
+		}
+		return true
+	}
+	plan.Inspect(definition, inspect)
+	return dependencies
+}
@@ -0,0 +1,43 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestViewDependencies(t *testing.T) {
+	require := require.New(t)
+
+	db := memory.NewDatabase("mydb")
+	ctx := sql.NewEmptyContext()
+	require.NoError(db.CreateView(ctx, "v1", "select a from t1 join other.t2 on a = b where a > (select max(c) from t3)"))
+	require.NoError(db.CreateView(ctx, "v2", "with cte as (select * from T1) select * from cte join v1 join t1"))
+
+	dependencies, err := ViewDependencies(ctx, NewCatalog(sql.NewDatabaseProvider(db)))
+	require.NoError(err)
+	require.ElementsMatch([]ViewDependency{
+		{ViewDatabase: "mydb", ViewName: "v1", TableDatabase: "mydb", TableName: "t1"},
+		{ViewDatabase: "mydb", ViewName: "v1", TableDatabase: "other", TableName: "t2"},
+		{ViewDatabase: "mydb", ViewName: "v1", TableDatabase: "mydb", TableName: "t3"},
+		{ViewDatabase: "mydb", ViewName: "v2", TableDatabase: "mydb", TableName: "T1"},
+		{ViewDatabase: "mydb", ViewName: "v2", TableDatabase: "mydb", TableName: "v1"},
+	}, dependencies)
+}
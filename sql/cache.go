@@ -15,8 +15,13 @@
 package sql
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
+	"io"
 	"runtime"
+	"strings"
 
 	"github.com/cespare/xxhash"
 
@@ -26,14 +31,110 @@ import (
 
 // HashOf returns a hash of the given value to be used as key in a cache.
 func HashOf(v Row) (uint64, error) {
+	hash, _, err := hashRow(v, false)
+	return hash, err
+}
+
+// largeValueLength is the length above which strings and byte slices are held as a digest of their content in the keys
+// returned by HashKeyOf.
+const largeValueLength = 64
+
+// HashKeyOf returns a hash of the given row, the same as HashOf, along with a key that tells apart the rows that have
+// the same hash. Two rows have the same key exactly when they have the same hash because they have the same values.
+// The key holds the values of the row, except for strings and byte slices longer than largeValueLength, which it
+// only holds a SHA-256 digest of, so that keeping the keys of large TEXT and BLOB values doesn't keep a copy of them.
+func HashKeyOf(v Row) (uint64, string, error) {
+	return hashRow(v, true)
+}
+
+func hashRow(v Row, withKey bool) (uint64, string, error) {
 	hash := xxhash.New()
+	var key strings.Builder
 	for _, x := range v {
-		// TODO: probably much faster to do this with a type switch
-		if _, err := hash.Write([]byte(fmt.Sprintf("%#v,", x))); err != nil {
-			return 0, err
+		// Strings and byte slices are hashed as they are rather than formatted, and every value is prefixed with its
+		// length so that the values of the row can't run into each other
+		var tag byte
+		var content []byte
+		switch x := x.(type) {
+		case string:
+			tag, content = 's', []byte(x)
+		case []byte:
+			tag, content = 'b', x
+		case LobValue:
+			if err := hashLobValue(x, hash, &key, withKey); err != nil {
+				return 0, "", err
+			}
+			continue
+		default:
+			tag, content = 'v', []byte(fmt.Sprintf("%#v,", x))
+		}
+		var header [binary.MaxVarintLen64 + 1]byte
+		header[0] = tag
+		n := binary.PutUvarint(header[1:], uint64(len(content)))
+		if _, err := hash.Write(header[:n+1]); err != nil {
+			return 0, "", err
+		}
+		if _, err := hash.Write(content); err != nil {
+			return 0, "", err
+		}
+
+		if !withKey {
+			continue
+		}
+		key.Write(header[:n+1])
+		if tag != 'v' && len(content) > largeValueLength {
+			digest := sha256.Sum256(content)
+			key.Write(digest[:])
+		} else {
+			key.Write(content)
 		}
 	}
-	return hash.Sum64(), nil
+	return hash.Sum64(), key.String(), nil
+}
+
+// hashLobValue hashes the contents of the LobValue given the same as a string with those contents, which is what it's
+// converted to, reading them from its reader rather than into memory.
+func hashLobValue(v LobValue, hash io.Writer, key *strings.Builder, withKey bool) error {
+	var header [binary.MaxVarintLen64 + 1]byte
+	header[0] = 's'
+	n := binary.PutUvarint(header[1:], uint64(v.Size()))
+	if _, err := hash.Write(header[:n+1]); err != nil {
+		return err
+	}
+
+	r, err := v.NewReader()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	w := hash
+	var content bytes.Buffer
+	digest := sha256.New()
+	if withKey {
+		key.Write(header[:n+1])
+		if v.Size() > largeValueLength {
+			w = io.MultiWriter(hash, digest)
+		} else {
+			w = io.MultiWriter(hash, &content)
+		}
+	}
+	read, err := io.Copy(w, io.LimitReader(r, v.Size()))
+	if err != nil {
+		return err
+	}
+	if read != v.Size() {
+		return ErrLobValueSize.New(v.Size(), read)
+	}
+
+	if withKey {
+		if v.Size() > largeValueLength {
+			key.Write(digest.Sum(nil))
+		} else {
+			key.Write(content.Bytes())
+		}
+	}
+	return nil
 }
 
 // ErrKeyNotFound is returned when the key could not be found in the cache.
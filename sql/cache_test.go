@@ -15,6 +15,7 @@
 package sql
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -176,3 +177,39 @@ func TestRowsCache(t *testing.T) {
 		require.True(freed)
 	})
 }
+
+func TestHashKeyOf(t *testing.T) {
+	require := require.New(t)
+
+	hashKey := func(row Row) (uint64, string) {
+		hash, key, err := HashKeyOf(row)
+		require.NoError(err)
+		return hash, key
+	}
+
+	// The hash is the same as the one of HashOf
+	hash, key := hashKey(NewRow("foo", int64(1), nil))
+	expected, err := HashOf(NewRow("foo", int64(1), nil))
+	require.NoError(err)
+	require.Equal(expected, hash)
+	_, other := hashKey(NewRow("foo", int64(1), nil))
+	require.Equal(key, other)
+
+	// Values can't run into each other
+	h1, k1 := hashKey(NewRow("ab", "c"))
+	h2, k2 := hashKey(NewRow("a", "bc"))
+	require.NotEqual(h1, h2)
+	require.NotEqual(k1, k2)
+	_, k1 = hashKey(NewRow("a"))
+	_, k2 = hashKey(NewRow([]byte("a")))
+	require.NotEqual(k1, k2)
+
+	// Large values are only held as a digest of their content
+	large := strings.Repeat("0123456789", 1000)
+	_, key = hashKey(NewRow(large, large+"x"))
+	require.Less(len(key), 100)
+	_, other = hashKey(NewRow(large, large+"y"))
+	require.NotEqual(key, other)
+	_, other = hashKey(NewRow(large, large+"x"))
+	require.Equal(key, other)
+}
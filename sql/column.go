@@ -31,6 +31,9 @@ type Column struct {
 	Type Type
 	// Default contains the default value of the column or nil if it was not explicitly defined. A nil instance is valid, thus calls do not error.
 	Default *ColumnDefaultValue
+	// OnUpdate contains the value the column is set to when any other column of its row is updated, as declared with
+	// ON UPDATE CURRENT_TIMESTAMP, or nil if it has none.
+	OnUpdate *ColumnDefaultValue
 	// AutoIncrement is true if the column auto-increments.
 	AutoIncrement bool
 	// Nullable is true if the column can contain NULL values, or false
@@ -62,6 +65,7 @@ func (c *Column) Equals(c2 *Column) bool {
 		c.Source == c2.Source &&
 		c.Nullable == c2.Nullable &&
 		reflect.DeepEqual(c.Default, c2.Default) &&
+		reflect.DeepEqual(c.OnUpdate, c2.OnUpdate) &&
 		reflect.DeepEqual(c.Type, c2.Type)
 }
 
@@ -0,0 +1,143 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+)
+
+// ColumnMasker replaces the values of a masked column with a redacted value.
+type ColumnMasker interface {
+	// Mask returns the masked form of the value given, which was read from a column of the type given.
+	Mask(ctx *Context, typ Type, value interface{}) (interface{}, error)
+}
+
+// ColumnMaskerFunc is a function that implements ColumnMasker.
+type ColumnMaskerFunc func(ctx *Context, typ Type, value interface{}) (interface{}, error)
+
+// Mask implements the ColumnMasker interface.
+func (f ColumnMaskerFunc) Mask(ctx *Context, typ Type, value interface{}) (interface{}, error) {
+	return f(ctx, typ, value)
+}
+
+// NullMask masks every value as NULL.
+var NullMask = ColumnMaskerFunc(func(*Context, Type, interface{}) (interface{}, error) {
+	return nil, nil
+})
+
+// RedactMask masks the values of text columns by replacing every character but the last KeepSuffix characters with
+// Char. Values of other types are masked as the zero value of their type. NULL values are left as they are.
+type RedactMask struct {
+	// Char replaces the redacted characters. If it's zero, 'X' is used.
+	Char rune
+	// KeepSuffix is the number of trailing characters left unredacted.
+	KeepSuffix int
+}
+
+var _ ColumnMasker = RedactMask{}
+
+// Mask implements the ColumnMasker interface.
+func (m RedactMask) Mask(ctx *Context, typ Type, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if !IsText(typ) {
+		return typ.Zero(), nil
+	}
+
+	s, err := LongText.Convert(value)
+	if err != nil {
+		return nil, err
+	}
+	char := m.Char
+	if char == 0 {
+		char = 'X'
+	}
+	runes := []rune(s.(string))
+	for i := 0; i < len(runes)-m.KeepSuffix; i++ {
+		runes[i] = char
+	}
+	return string(runes), nil
+}
+
+// ColumnMask masks the values of a column read by sessions of users without the SUPER privilege. Masked values are
+// substituted as rows are read from the table, so filters, joins and row security predicates all see the masked
+// values, and the stored values never reach the result of a query.
+type ColumnMask struct {
+	Database string
+	Table    string
+	Column   string
+	Masker   ColumnMasker
+}
+
+// ColumnMasks holds the masks of every masked column. Columns have at most one mask.
+type ColumnMasks struct {
+	mu    sync.RWMutex
+	masks map[string][]ColumnMask
+}
+
+// NewColumnMasks returns a new, empty set of column masks.
+func NewColumnMasks() *ColumnMasks {
+	return &ColumnMasks{masks: make(map[string][]ColumnMask)}
+}
+
+// Set sets the mask of its column, replacing any mask the column already has.
+func (m *ColumnMasks) Set(mask ColumnMask) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := rowSecurityKey(mask.Database, mask.Table)
+	for i, existing := range m.masks[key] {
+		if strings.EqualFold(existing.Column, mask.Column) {
+			m.masks[key][i] = mask
+			return
+		}
+	}
+	m.masks[key] = append(m.masks[key], mask)
+}
+
+// Drop removes the mask of the column given, returning an error if the column isn't masked.
+func (m *ColumnMasks) Drop(database, table, column string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := rowSecurityKey(database, table)
+	masks := m.masks[key]
+	for i, mask := range masks {
+		if strings.EqualFold(mask.Column, column) {
+			m.masks[key] = append(masks[:i:i], masks[i+1:]...)
+			if len(m.masks[key]) == 0 {
+				delete(m.masks, key)
+			}
+			return nil
+		}
+	}
+	return ErrColumnMaskNotFound.New(table, column)
+}
+
+// Get returns the masks of the columns of the table given.
+func (m *ColumnMasks) Get(database, table string) []ColumnMask {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]ColumnMask(nil), m.masks[rowSecurityKey(database, table)]...)
+}
+
+// IsEmpty returns whether no column is masked.
+func (m *ColumnMasks) IsEmpty() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.masks) == 0
+}
@@ -42,6 +42,28 @@ const (
 	ForeignKeyReferenceOption_SetDefault    ForeignKeyReferenceOption = "SET DEFAULT"
 )
 
+// GetForeignKeys returns the foreign keys declared by the table given, or by the table it wraps. A table that can't
+// declare foreign keys has none.
+func GetForeignKeys(ctx *Context, table Table) ([]ForeignKeyConstraint, error) {
+	switch t := table.(type) {
+	case ForeignKeyTable:
+		return t.GetForeignKeys(ctx)
+	case TableWrapper:
+		return GetForeignKeys(ctx, t.Underlying())
+	default:
+		return nil, nil
+	}
+}
+
+// Rule returns the reference option as a referential action of the SQL standard, which is NO ACTION when no explicit
+// action was specified.
+func (o ForeignKeyReferenceOption) Rule() string {
+	if o == "" || o == ForeignKeyReferenceOption_DefaultAction {
+		return string(ForeignKeyReferenceOption_NoAction)
+	}
+	return string(o)
+}
+
 func (f *ForeignKeyConstraint) DebugString() string {
 	return fmt.Sprintf(
 		"FOREIGN KEY %s (%s) REFERENCES %s (%s)",
@@ -48,6 +48,21 @@ type TransformNodeFunc func(Node) (Node, error)
 // expression as is or transformed along with an error, if any.
 type TransformExprFunc func(Expression) (Expression, error)
 
+// TreeIdentity tells whether a transformation function returned the node or
+// expression it was given as is (SameTree) or a transformed one (NewTree), so
+// that the trees containing nodes and expressions that weren't transformed
+// don't need to be rebuilt.
+type TreeIdentity bool
+
+const (
+	// SameTree is returned by transformation functions that didn't transform
+	// the node or expression given.
+	SameTree TreeIdentity = true
+	// NewTree is returned by transformation functions that transformed the
+	// node or expression given.
+	NewTree TreeIdentity = false
+)
+
 // Expression is a combination of one or more SQL expressions.
 type Expression interface {
 	Resolvable
@@ -78,7 +93,8 @@ type UnsupportedFunctionStub interface {
 	IsUnsupported() bool
 }
 
-// FunctionExpression is an Expression that represents a function.
+// FunctionExpression is an Expression that represents a function. Functions may declare their volatility by
+// implementing FunctionWithVolatility.
 type FunctionExpression interface {
 	Expression
 	FunctionName() string
@@ -86,8 +102,62 @@ type FunctionExpression interface {
 	// TODO: add Example() function
 }
 
+// FunctionVolatility tells whether a function may return different results when evaluated again with the same
+// arguments.
+type FunctionVolatility int
+
+const (
+	// Volatile functions may return a different result on each evaluation, or have side effects, like RAND() or
+	// SLEEP(). Functions are volatile unless they declare otherwise.
+	Volatile FunctionVolatility = iota
+	// Stable functions return the same result for the same arguments within a statement, but may not across
+	// statements, like NOW() or DATABASE(). They're non-deterministic as a NonDeterministicExpression, but may be
+	// evaluated once for a whole statement.
+	Stable
+	// Deterministic functions always return the same result for the same arguments, and have no side effects, like
+	// DATE() or CONCAT(). Calls of deterministic functions whose arguments are all constant are evaluated once during
+	// analysis rather than for each row.
+	Deterministic
+)
+
+// FunctionWithVolatility is a FunctionExpression that declares its volatility.
+type FunctionWithVolatility interface {
+	FunctionExpression
+	// Volatility returns the volatility of the function.
+	Volatility() FunctionVolatility
+}
+
+// GetFunctionVolatility returns the volatility of the function given. Functions that don't implement
+// FunctionWithVolatility are Volatile.
+func GetFunctionVolatility(f FunctionExpression) FunctionVolatility {
+	if fv, ok := f.(FunctionWithVolatility); ok {
+		return fv.Volatility()
+	}
+	return Volatile
+}
+
+// IsVolatile returns whether the expression given may return a different result each time it's evaluated in a
+// statement, even for the same row: whether it calls a function declared Volatile, or contains another expression that
+// declares itself non-deterministic. Such expressions must be evaluated for each row, rather than once for the whole
+// statement. Unlike GetFunctionVolatility, functions that don't declare their volatility aren't considered volatile.
+func IsVolatile(e Expression) bool {
+	volatile := false
+	Inspect(e, func(e Expression) bool {
+		if f, ok := e.(FunctionWithVolatility); ok {
+			if f.Volatility() == Volatile {
+				volatile = true
+			}
+		} else if nd, ok := e.(NonDeterministicExpression); ok && nd.IsNonDeterministic() {
+			volatile = true
+		}
+		return !volatile
+	})
+	return volatile
+}
+
 // NonDeterministicExpression allows a way for expressions to declare that they are non-deterministic, which will
-// signal the engine to not cache their results when this would otherwise appear to be safe.
+// signal the engine to not cache their results when this would otherwise appear to be safe. Functions that are only
+// non-deterministic across statements should declare themselves Stable with FunctionWithVolatility as well.
 type NonDeterministicExpression interface {
 	Expression
 	// IsNonDeterministic returns whether this expression returns a non-deterministic result. An expression is
@@ -272,6 +342,11 @@ type SchemaTarget interface {
 }
 
 // Table represents the backend of a SQL table.
+//
+// The contexts given to the methods of a table, and to the iterators they return, are the contexts of the query that
+// reads the table. The context is done once the query is killed, its client goes away or it runs past its
+// max_execution_time, and tables that may block while reading their partitions or rows, such as over the network, must
+// stop then and return the context's error, or the query can't be interrupted.
 type Table interface {
 	Nameable
 	String() string
@@ -328,6 +403,16 @@ type StatisticsTable interface {
 	DataLength(ctx *Context) (uint64, error)
 }
 
+// ColumnStatisticsTable is a StatisticsTable that can also estimate the number of distinct values of its columns. The
+// analyzer uses these cardinalities, along with the number of rows of tables, to estimate how many rows joins and
+// filters return when choosing the order to join tables in.
+type ColumnStatisticsTable interface {
+	StatisticsTable
+	// ColumnCardinality returns the estimated number of distinct non-NULL values of the column named, and whether
+	// there is an estimate for it at all.
+	ColumnCardinality(ctx *Context, column string) (uint64, bool, error)
+}
+
 // IndexUsing is the desired storage type.
 type IndexUsing byte
 
@@ -348,6 +433,53 @@ const (
 	IndexConstraint_Primary
 )
 
+// IndexAlgorithm is the ALGORITHM clause of a statement that adds or drops an index, which states whether the index
+// is built in place, while the table stays available, or by copying the table.
+type IndexAlgorithm byte
+
+const (
+	IndexAlgorithm_Default IndexAlgorithm = iota
+	IndexAlgorithm_Inplace
+	IndexAlgorithm_Copy
+)
+
+// String returns the keyword of the algorithm.
+func (a IndexAlgorithm) String() string {
+	switch a {
+	case IndexAlgorithm_Inplace:
+		return "INPLACE"
+	case IndexAlgorithm_Copy:
+		return "COPY"
+	default:
+		return "DEFAULT"
+	}
+}
+
+// IndexLock is the LOCK clause of a statement that adds or drops an index, which states the concurrent access to the
+// table allowed while the index is built.
+type IndexLock byte
+
+const (
+	IndexLock_Default IndexLock = iota
+	IndexLock_None
+	IndexLock_Shared
+	IndexLock_Exclusive
+)
+
+// String returns the keyword of the lock.
+func (l IndexLock) String() string {
+	switch l {
+	case IndexLock_None:
+		return "NONE"
+	case IndexLock_Shared:
+		return "SHARED"
+	case IndexLock_Exclusive:
+		return "EXCLUSIVE"
+	default:
+		return "DEFAULT"
+	}
+}
+
 // IndexColumn is the column by which to add to an index.
 type IndexColumn struct {
 	Name string
@@ -373,6 +505,16 @@ type IndexAddressableTable interface {
 	WithIndexLookup(IndexLookup) Table
 }
 
+// BatchedIndexAddressableTable is an IndexAddressableTable that can return the rows for many index lookups at once.
+// Lookup joins use this to retrieve the matching rows for a batch of outer rows together, rather than performing a
+// separate lookup, and possibly a separate round trip to a remote backend, for each outer row.
+type BatchedIndexAddressableTable interface {
+	IndexAddressableTable
+	// IndexLookupRowIters returns a RowIter for each of the lookups given, in the same order. Each RowIter must return
+	// the same rows as iterating over every partition of WithIndexLookup for that lookup.
+	IndexLookupRowIters(ctx *Context, lookups []IndexLookup) ([]RowIter, error)
+}
+
 // IndexAlterableTable represents a table that supports index modification operations.
 type IndexAlterableTable interface {
 	Table
@@ -386,6 +528,28 @@ type IndexAlterableTable interface {
 	RenameIndex(ctx *Context, fromIndexName string, toIndexName string) error
 }
 
+// AsyncIndexAlterableTable is an IndexAlterableTable that can build the indexes added to it in the background, so that
+// the table stays available while they're built. The statement adding an index waits for its build to finish,
+// reporting the progress of the build in the process list.
+type AsyncIndexAlterableTable interface {
+	IndexAlterableTable
+	// StartIndexBuild starts building an index for this table, using the provided parameters, and returns without
+	// waiting for the build to finish. The lock states the access to the table that must be blocked while the index is
+	// built. The build must stop, and fail, once the context given is canceled.
+	StartIndexBuild(ctx *Context, indexName string, using IndexUsing, constraint IndexConstraint, columns []IndexColumn, comment string, lock IndexLock) (IndexBuild, error)
+}
+
+// IndexBuild is an index being built in the background by an AsyncIndexAlterableTable.
+type IndexBuild interface {
+	// Progress returns the number of rows indexed so far, and the number of rows to index, or -1 if it isn't known.
+	Progress() (done, total int64)
+	// Done returns a channel that's closed once the build has finished.
+	Done() <-chan struct{}
+	// Err returns the error the build failed with, or nil if the index was built. It's only called once the build has
+	// finished.
+	Err() error
+}
+
 // ForeignKeyTable is a table that can declare its foreign key constraints.
 type ForeignKeyTable interface {
 	Table
@@ -421,6 +585,35 @@ type CheckAlterableTable interface {
 	DropCheck(ctx *Context, chName string) error
 }
 
+// TableOptions are the options of a table that aren't part of its schema, set by the table options of a CREATE TABLE
+// statement.
+type TableOptions struct {
+	// Collation is the default collation of the table.
+	Collation Collation
+	// Comment is the comment of the table.
+	Comment string
+	// RowFormat is the row format of the table, such as DYNAMIC or COMPRESSED, and empty for the default row format.
+	RowFormat string
+	// EngineAttribute is the JSON document of attributes for the storage engine of the table.
+	EngineAttribute string
+	// SecondaryEngineAttribute is the JSON document of attributes for the secondary storage engine of the table.
+	SecondaryEngineAttribute string
+}
+
+// TableOptionsTable is a table that can declare its table options.
+type TableOptionsTable interface {
+	Table
+	// GetTableOptions returns the table options of this table.
+	GetTableOptions(ctx *Context) (TableOptions, error)
+}
+
+// TableOptionsAlterableTable represents a table that supports changing its table options.
+type TableOptionsAlterableTable interface {
+	TableOptionsTable
+	// SetTableOptions replaces the table options of this table with the ones given.
+	SetTableOptions(ctx *Context, options TableOptions) error
+}
+
 // PrimaryKeyAlterableTable represents a table that supports primary key changes.
 type PrimaryKeyAlterableTable interface {
 	Table
@@ -443,10 +636,12 @@ type TableEditor interface {
 	// in some way that it may be returned to in the case of an error.
 	StatementBegin(ctx *Context)
 	// DiscardChanges is called if a statement encounters an error, and all current changes since the statement beginning
-	// should be discarded.
+	// should be discarded. As statements are atomic, this includes errors encountered after the editor was closed by
+	// later parts of the same statement, such as its triggers, in which case DiscardChanges is called after Close.
 	DiscardChanges(ctx *Context, errorEncountered error) error
 	// StatementComplete is called after the last operation of the statement, indicating that it has successfully completed.
-	// The mark set in StatementBegin may be removed, and a new one should be created on the next StatementBegin.
+	// The mark set in StatementBegin may be removed, and a new one should be created on the next StatementBegin. This
+	// may be called after Close, once the rest of the statement has completed as well.
 	StatementComplete(ctx *Context) error
 }
 
@@ -572,6 +767,16 @@ type RowUpdater interface {
 	Closer
 }
 
+// PartialRowUpdater is a RowUpdater that is told which columns of a row changed, for tables that store columns
+// separately and can write only the changed ones. When the RowUpdater of a table implements it, the engine calls
+// UpdateColumns instead of Update.
+type PartialRowUpdater interface {
+	RowUpdater
+	// UpdateColumns updates the given row, like Update, given the set of the columns of the table schema whose
+	// values differ between the old and new rows. The set is never empty.
+	UpdateColumns(ctx *Context, old Row, new Row, changed ColumnSet) error
+}
+
 // DatabaseProvider is a collection of Database.
 type DatabaseProvider interface {
 	// Database gets a Database from the provider.
@@ -770,7 +975,8 @@ func DBTableIter(ctx *Context, db Database, cb func(Table) (cont bool, err error
 		if err != nil {
 			return err
 		} else if !ok {
-			return ErrTableNotFound.New(name)
+			// The table was dropped by another session since its name was listed
+			continue
 		}
 
 		cont, err := cb(tbl)
@@ -838,6 +1044,32 @@ type TableRenamer interface {
 	RenameTable(ctx *Context, oldName, newName string) error
 }
 
+// TableRename is a single rename of a statement that renames several tables at once.
+type TableRename struct {
+	OldName string
+	NewName string
+}
+
+// MultiTableRenamer should be implemented by databases that can rename several tables atomically. When a RENAME TABLE
+// statement renames more than one table of such a database, such as to swap the names of two tables, the renames are
+// made with a single call to RenameTables rather than one call to RenameTable for each.
+type MultiTableRenamer interface {
+	TableRenamer
+	// RenameTables renames the tables given all at once: every old name refers to a table as it was before the call,
+	// so that renames such as a to b and b to a swap the two tables. The engine guarantees that every old name exists
+	// and that no new name is in use once all the renames are applied. Either all of the renames must be made, or none.
+	RenameTables(ctx *Context, renames []TableRename) error
+}
+
+// TableMover should be implemented by databases that can move tables to another database, as RENAME TABLE does when
+// the new name of a table is qualified with a different database than the old one.
+type TableMover interface {
+	// MoveTable moves the table named oldName to the database given, where it's named newName. If a table with newName
+	// already exists in that database, must return sql.ErrTableAlreadyExists. If tables can't be moved to that
+	// database, must return sql.ErrMoveTableNotSupported.
+	MoveTable(ctx *Context, oldName string, to Database, newName string) error
+}
+
 // ColumnOrder is used in ALTER TABLE statements to change the order of inserted / modified columns.
 type ColumnOrder struct {
 	First       bool   // True if this column should come first
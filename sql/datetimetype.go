@@ -385,10 +385,14 @@ func (t datetimeType) MinimumTime() time.Time {
 }
 
 // ValidateTime receives a time and returns either that time or nil if it's
-// not a valid time.
+// not a valid time, which is the case of times before year 0 or after year 9999,
+// as in MySQL's date arithmetic.
 func ValidateTime(t time.Time) interface{} {
 	if t.After(time.Date(9999, time.December, 31, 23, 59, 59, 999999999, time.UTC)) {
 		return nil
 	}
+	if t.Before(time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+		return nil
+	}
 	return t
 }
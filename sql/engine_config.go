@@ -0,0 +1,152 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"time"
+)
+
+// EngineConfig gathers the settings of an engine and its analyzer in one place, so that integrators can configure
+// them with functional options instead of through the analyzer builder, the engine config and environment variables.
+// Create one with NewEngineConfig, and an engine with it with sqle.NewWithConfig. Every setting left unset keeps the
+// behavior of an engine created with sqle.NewDefault.
+type EngineConfig struct {
+	// Parallelism is the number of goroutines that read the partitions of tables. Zero or one, the default, reads them
+	// serially.
+	Parallelism int
+	// MaxMemory is the number of bytes of memory the process may use before the engine frees its caches and spills
+	// rows to disk. Zero, the default, uses the MAX_MEMORY environment variable, in MiB, or no limit if it's not set.
+	MaxMemory uint64
+	// SpillThreshold is the number of bytes of rows an operator that can spill them to disk, such as a sort, holds in
+	// memory before it does. Zero, the default, only spills rows when there is no memory available.
+	SpillThreshold uint64
+	// SlowAnalysisThreshold is the time after which the analysis of a query is logged as slow. Zero, the default,
+	// logs no analysis as slow.
+	SlowAnalysisThreshold time.Duration
+	// ReadOnly disallows the queries that modify data or schemas.
+	ReadOnly bool
+	// DeterministicOrder makes parallel queries return their rows in the same order serial queries do.
+	DeterministicOrder bool
+	// DebugAnalyzer logs the steps of the analysis of every query.
+	DebugAnalyzer bool
+	// DisablePanicRecovery lets the panics of queries crash the process, rather than returning them as errors.
+	DisablePanicRecovery bool
+	// VersionPostfix is displayed after the version of the server by the `VERSION()` function.
+	VersionPostfix string
+	// FeatureFlags turns feature flags on or off for the queries of the engine, unless their session sets them in
+	// optimizer_switch. The flags left out keep their defaults.
+	FeatureFlags FeatureSwitches
+}
+
+// EngineOption sets a setting of an EngineConfig.
+type EngineOption func(*EngineConfig)
+
+// NewEngineConfig returns an EngineConfig with the default settings, changed by the options given in order.
+func NewEngineConfig(opts ...EngineOption) *EngineConfig {
+	cfg := &EngineConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WithParallelism sets the number of goroutines that read the partitions of tables.
+func WithParallelism(parallelism int) EngineOption {
+	return func(cfg *EngineConfig) {
+		cfg.Parallelism = parallelism
+	}
+}
+
+// WithMaxMemory sets the number of bytes of memory the process may use before the engine frees its caches and spills
+// rows to disk.
+func WithMaxMemory(bytes uint64) EngineOption {
+	return func(cfg *EngineConfig) {
+		cfg.MaxMemory = bytes
+	}
+}
+
+// WithSpillThreshold sets the number of bytes of rows an operator holds in memory before it spills them to disk.
+func WithSpillThreshold(bytes uint64) EngineOption {
+	return func(cfg *EngineConfig) {
+		cfg.SpillThreshold = bytes
+	}
+}
+
+// WithSlowAnalysisThreshold sets the time after which the analysis of a query is logged as slow.
+func WithSlowAnalysisThreshold(threshold time.Duration) EngineOption {
+	return func(cfg *EngineConfig) {
+		cfg.SlowAnalysisThreshold = threshold
+	}
+}
+
+// WithReadOnly disallows the queries that modify data or schemas.
+func WithReadOnly() EngineOption {
+	return func(cfg *EngineConfig) {
+		cfg.ReadOnly = true
+	}
+}
+
+// WithDeterministicOrder makes parallel queries return their rows in the same order serial queries do, given tables
+// that return their partitions in a stable order.
+func WithDeterministicOrder() EngineOption {
+	return func(cfg *EngineConfig) {
+		cfg.DeterministicOrder = true
+	}
+}
+
+// WithDebugAnalyzer logs the steps of the analysis of every query.
+func WithDebugAnalyzer() EngineOption {
+	return func(cfg *EngineConfig) {
+		cfg.DebugAnalyzer = true
+	}
+}
+
+// WithoutPanicRecovery lets the panics of queries crash the process, rather than returning them as errors.
+func WithoutPanicRecovery() EngineOption {
+	return func(cfg *EngineConfig) {
+		cfg.DisablePanicRecovery = true
+	}
+}
+
+// WithVersionPostfix sets the text displayed after the version of the server by the `VERSION()` function.
+func WithVersionPostfix(postfix string) EngineOption {
+	return func(cfg *EngineConfig) {
+		cfg.VersionPostfix = postfix
+	}
+}
+
+// WithFeatureFlag turns the feature flag with the name given on or off for the queries of the engine, unless their
+// session sets it in optimizer_switch.
+func WithFeatureFlag(name string, enabled bool) EngineOption {
+	return func(cfg *EngineConfig) {
+		if cfg.FeatureFlags == nil {
+			cfg.FeatureFlags = make(FeatureSwitches)
+		}
+		cfg.FeatureFlags[strings.ToLower(name)] = enabled
+	}
+}
+
+// NewMemoryManager returns a memory manager for the process memory, with the maximum memory and spill threshold of
+// the config.
+func (cfg *EngineConfig) NewMemoryManager() *MemoryManager {
+	var reporter Reporter = ProcessMemory
+	if cfg.MaxMemory > 0 {
+		reporter = limitedProcessReporter{cfg.MaxMemory}
+	}
+	m := NewMemoryManager(reporter)
+	m.SetSpillThreshold(cfg.SpillThreshold)
+	return m
+}
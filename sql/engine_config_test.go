@@ -0,0 +1,68 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEngineConfig(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal(&EngineConfig{}, NewEngineConfig())
+
+	cfg := NewEngineConfig(
+		WithParallelism(4),
+		WithMaxMemory(1<<30),
+		WithSpillThreshold(1<<20),
+		WithSlowAnalysisThreshold(time.Second),
+		WithReadOnly(),
+		WithDeterministicOrder(),
+		WithDebugAnalyzer(),
+		WithoutPanicRecovery(),
+		WithVersionPostfix("test"),
+		WithFeatureFlag("HASH_JOIN", false),
+		WithFeatureFlag(FeatureRecursiveCte, true),
+		WithParallelism(8),
+	)
+	require.Equal(&EngineConfig{
+		Parallelism:           8,
+		MaxMemory:             1 << 30,
+		SpillThreshold:        1 << 20,
+		SlowAnalysisThreshold: time.Second,
+		ReadOnly:              true,
+		DeterministicOrder:    true,
+		DebugAnalyzer:         true,
+		DisablePanicRecovery:  true,
+		VersionPostfix:        "test",
+		FeatureFlags:          FeatureSwitches{FeatureHashJoin: false, FeatureRecursiveCte: true},
+	}, cfg)
+}
+
+func TestEngineConfigMemoryManager(t *testing.T) {
+	require := require.New(t)
+
+	m := NewEngineConfig().NewMemoryManager()
+	require.Equal(ProcessMemory, m.reporter)
+	require.Equal(uint64(0), m.spillThreshold)
+
+	m = NewEngineConfig(WithMaxMemory(1), WithSpillThreshold(50)).NewMemoryManager()
+	require.Equal(uint64(1), m.reporter.MaxMemory())
+	require.False(m.HasAvailable())
+	require.True(m.ShouldSpill(51))
+}
@@ -236,7 +236,7 @@ func (t enumType) SQL(v interface{}) (sqltypes.Value, error) {
 
 // String implements Type interface.
 func (t enumType) String() string {
-	s := fmt.Sprintf("ENUM('%v')", strings.Join(t.indexToVal, `','`))
+	s := fmt.Sprintf("ENUM(%v)", strings.Join(quoteStringValues(t.indexToVal), ","))
 	if t.CharacterSet() != Collation_Default.CharacterSet() {
 		s += " CHARACTER SET " + t.CharacterSet().String()
 	}
@@ -318,3 +318,12 @@ func (t enumType) Values() []string {
 	copy(vals, t.indexToVal)
 	return vals
 }
+
+// quoteStringValues returns the values given as single-quoted string literals, escaping any single quotes they contain.
+func quoteStringValues(vals []string) []string {
+	quoted := make([]string, len(vals))
+	for i, val := range vals {
+		quoted[i] = "'" + strings.ReplaceAll(val, "'", "''") + "'"
+	}
+	return quoted
+}
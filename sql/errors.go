@@ -113,12 +113,22 @@ var (
 	// ErrColumnDefaultDatetimeOnlyFunc is returned when a non datetime/timestamp column attempts to declare now/current_timestamp as a default value literal.
 	ErrColumnDefaultDatetimeOnlyFunc = errors.NewKind("only datetime/timestamp may declare default values of now()/current_timestamp() without surrounding parentheses")
 
+	// ErrInvalidOnUpdate is returned when a column declares an ON UPDATE clause other than CURRENT_TIMESTAMP, or when
+	// it isn't a datetime/timestamp column.
+	ErrInvalidOnUpdate = errors.NewKind("Invalid ON UPDATE clause for '%s' column")
+
 	// ErrColumnDefaultSubquery is returned when a default value contains a subquery.
 	ErrColumnDefaultSubquery = errors.NewKind("default value on column `%s` may not contain subqueries")
 
 	// ErrInvalidDefaultValueOrder is returned when a default value references a column that comes after it and contains a default expression.
 	ErrInvalidDefaultValueOrder = errors.NewKind(`default value of column "%s" cannot refer to a column defined after it if those columns have an expression default value`)
 
+	// ErrInvalidDefaultValueAutoIncrement is returned when a default value references an AUTO_INCREMENT column.
+	ErrInvalidDefaultValueAutoIncrement = errors.NewKind(`default value of column "%s" cannot refer to an AUTO_INCREMENT column`)
+
+	// ErrDefaultFunctionExpressionDefault is returned when DEFAULT(col) is used on a column whose default value is an expression.
+	ErrDefaultFunctionExpressionDefault = errors.NewKind(`DEFAULT function cannot be used on column "%s" as its default value is an expression`)
+
 	// ErrColumnDefaultReturnedNull is returned when a default expression evaluates to nil but the column is non-nullable.
 	ErrColumnDefaultReturnedNull = errors.NewKind(`default value attempted to return null but column is non-nullable`)
 
@@ -131,6 +141,9 @@ var (
 	// ErrTriggerCreateStatementInvalid is returned when a TriggerDatabase returns a CREATE TRIGGER statement that is invalid
 	ErrTriggerCreateStatementInvalid = errors.NewKind(`Invalid CREATE TRIGGER statement: %s`)
 
+	// ErrTriggerAlreadyExists is returned when a trigger is created with the name of an existing trigger.
+	ErrTriggerAlreadyExists = errors.NewKind(`trigger "%s" already exists`)
+
 	// ErrTriggerDoesNotExist is returned when a trigger does not exist.
 	ErrTriggerDoesNotExist = errors.NewKind(`trigger "%s" does not exist`)
 
@@ -164,6 +177,19 @@ var (
 	// ErrCallIncorrectParameterCount is returned when a CALL statement has the incorrect number of parameters.
 	ErrCallIncorrectParameterCount = errors.NewKind("`%s` expected `%d` parameters but got `%d`")
 
+	// ErrUnknownFeatureFlag is returned when optimizer_switch is set to a feature flag that isn't registered.
+	ErrUnknownFeatureFlag = errors.NewKind("unknown feature flag: %s")
+
+	// ErrFeatureFlagExists is returned when registering a feature flag whose name is already registered.
+	ErrFeatureFlagExists = errors.NewKind("feature flag %s is already registered")
+
+	// ErrInvalidFeatureFlagName is returned when registering a feature flag whose name can't be set in
+	// optimizer_switch.
+	ErrInvalidFeatureFlagName = errors.NewKind("invalid feature flag name: '%s'")
+
+	// ErrFeatureDisabled is returned when a query uses a feature whose feature flag is off.
+	ErrFeatureDisabled = errors.NewKind("The '%s' feature is disabled; enable it with SET optimizer_switch = '%s=on'")
+
 	// ErrUnknownSystemVariable is returned when a query references a system variable that doesn't exist
 	ErrUnknownSystemVariable = errors.NewKind(`Unknown system variable '%s'`)
 
@@ -215,6 +241,25 @@ var (
 	// list with a different number of columns than the schema of the table.
 	ErrColumnCountMismatch = errors.NewKind("In definition of view, derived table or common table expression, SELECT list and column names list have different column counts")
 
+	// ErrCteRecursionRequiresUnion is returned when a recursive common table expression isn't a UNION.
+	ErrCteRecursionRequiresUnion = errors.NewKind("Recursive Common Table Expression '%s' should contain a UNION")
+
+	// ErrCteRecursionRequiresNonRecursiveFirst is returned when the query blocks of a recursive common table expression
+	// don't start with a non-recursive one, or when a non-recursive one follows a recursive one.
+	ErrCteRecursionRequiresNonRecursiveFirst = errors.NewKind("Recursive Common Table Expression '%s' should have one or more non-recursive query blocks followed by one or more recursive ones")
+
+	// ErrCteRecursionForbidsAggregation is returned when a recursive query block of a common table expression has
+	// aggregation or window functions.
+	ErrCteRecursionForbidsAggregation = errors.NewKind("Recursive Common Table Expression '%s' can contain neither aggregation nor window functions in recursive query block")
+
+	// ErrCteRecursionRequiresSingleReference is returned when a recursive query block of a common table expression
+	// refers to the expression more than once, or in a subquery.
+	ErrCteRecursionRequiresSingleReference = errors.NewKind("In recursive query block of Recursive Common Table Expression '%s', the recursive table must be referenced only once, and not in any subquery")
+
+	// ErrCteMaxRecursionDepth is returned when a recursive common table expression runs more iterations than the
+	// cte_max_recursion_depth system variable allows.
+	ErrCteMaxRecursionDepth = errors.NewKind("Recursive query aborted after %d iterations. Try increasing @@cte_max_recursion_depth to a larger value.")
+
 	// ErrUuidUnableToParse is returned when a UUID is unable to be parsed.
 	ErrUuidUnableToParse = errors.NewKind("unable to parse '%s' to UUID: %s")
 
@@ -246,6 +291,21 @@ var (
 	// ErrUnknownConstraint is returned when a DROP CONSTRAINT statement refers to a constraint that doesn't exist
 	ErrUnknownConstraint = errors.NewKind("Constraint %q does not exist")
 
+	// ErrMultipleConstraintsWithSameName is returned when a DROP CONSTRAINT statement names more than one kind of
+	// constraint on the table.
+	ErrMultipleConstraintsWithSameName = errors.NewKind("Table has multiple constraints with the name '%s'. Please use constraint specific 'DROP' clause.")
+
+	// ErrForeignKeyDuplicateName is returned when a foreign key is created with the name of an existing constraint.
+	ErrForeignKeyDuplicateName = errors.NewKind("Duplicate foreign key constraint name '%s'")
+
+	// ErrCheckConstraintDuplicateName is returned when a check constraint is created with the name of an existing
+	// constraint.
+	ErrCheckConstraintDuplicateName = errors.NewKind("Duplicate check constraint name '%s'.")
+
+	// ErrDuplicateKeyName is returned when an index, including a UNIQUE constraint, is created with the name of an
+	// existing index.
+	ErrDuplicateKeyName = errors.NewKind("Duplicate key name '%s'")
+
 	// ErrInsertIntoNonNullableDefaultNullColumn is returned when an INSERT excludes a field which is non-nullable and has no default/autoincrement.
 	ErrInsertIntoNonNullableDefaultNullColumn = errors.NewKind("Field '%s' doesn't have a default value")
 
@@ -331,6 +391,9 @@ var (
 	// ErrNoDatabaseSelected is thrown when a database is not selected and the query requires one
 	ErrNoDatabaseSelected = errors.NewKind("no database selected")
 
+	// ErrMoveTableNotSupported is returned when a table can't be moved to another database by RENAME TABLE
+	ErrMoveTableNotSupported = errors.NewKind("tables cannot be moved from database %s to database %s")
+
 	// ErrAsOfNotSupported is thrown when an AS OF query is run on a database that can't support it
 	ErrAsOfNotSupported = errors.NewKind("AS OF not supported for database %s")
 
@@ -350,12 +413,20 @@ var (
 	// ErrReadOnlyTransaction is returned when a write query is executed in a READ ONLY transaction.
 	ErrReadOnlyTransaction = errors.NewKind("cannot execute statement in a READ ONLY transaction")
 
+	// ErrReadOnlyServer is returned when a statement that modifies rows or schemas is executed while the read_only or
+	// super_read_only system variable is set, or in a read-only session. The argument is the name of the option.
+	ErrReadOnlyServer = errors.NewKind("The MySQL server is running with the %s option so it cannot execute this statement")
+
 	// ErrExistingView is returned when a CREATE VIEW statement uses a name that already exists
 	ErrExistingView = errors.NewKind("the view %s.%s already exists")
 
 	// ErrViewDoesNotExist is returned when a DROP VIEW statement drops a view that does not exist
 	ErrViewDoesNotExist = errors.NewKind("the view %s.%s does not exist")
 
+	// ErrViewInvalid is returned when a view is used after a table, column or function it refers to has been dropped
+	// or changed
+	ErrViewInvalid = errors.NewKind("View '%s.%s' references invalid table(s) or column(s) or function(s) or definer/invoker of view lack rights to use them")
+
 	// ErrSessionDoesNotSupportPersistence is thrown when a feature is not already supported
 	ErrSessionDoesNotSupportPersistence = errors.NewKind("session does not support persistence")
 
@@ -368,6 +439,10 @@ var (
 	// ErrUnsupportedSyntax is returned when syntax that parses correctly is not supported
 	ErrUnsupportedSyntax = errors.NewKind("unsupported syntax: %s")
 
+	// ErrTooLongIdentifier is returned when the name of a database, table, column or other object is longer than the
+	// maximum identifier length.
+	ErrTooLongIdentifier = errors.NewKind("Identifier name '%s' is too long")
+
 	// ErrInvalidSQLValType is returned when a SQL value is of the incorrect type during parsing
 	ErrInvalidSQLValType = errors.NewKind("invalid SQLVal of type: %d")
 
@@ -377,6 +452,18 @@ var (
 	// ErrUnknownIndexColumn is returned when a column in an index is not in the table
 	ErrUnknownIndexColumn = errors.NewKind("unknown column: '%s' in index '%s'")
 
+	// ErrSpatialIndexKeyParts is returned when a SPATIAL index is defined on more than one column.
+	ErrSpatialIndexKeyParts = errors.NewKind("Too many key parts specified; max 1 parts allowed")
+
+	// ErrSpatialIndexColumnType is returned when a SPATIAL index is defined on a column that isn't a spatial column.
+	ErrSpatialIndexColumnType = errors.NewKind("A SPATIAL index may only contain a geometrical type column")
+
+	// ErrSpatialIndexNullable is returned when a SPATIAL index is defined on a nullable column.
+	ErrSpatialIndexNullable = errors.NewKind("All parts of a SPATIAL index must be NOT NULL")
+
+	// ErrMaxExecutionTimeExceeded is returned when a SELECT statement runs past the max_execution_time of its session.
+	ErrMaxExecutionTimeExceeded = errors.NewKind("Query execution was interrupted, maximum statement execution time exceeded")
+
 	// ErrInvalidAutoIncCols is returned when an auto_increment column cannot be applied
 	ErrInvalidAutoIncCols = errors.NewKind("there can be only one auto_increment column and it must be defined as a key")
 
@@ -388,6 +475,101 @@ var (
 
 	// ErrUserCreationFailure is returned when attempting to create a user and it fails for any reason.
 	ErrUserCreationFailure = errors.NewKind("Operation CREATE USER failed for %s")
+
+	// ErrUserDeletionFailure is returned when attempting to drop a user that doesn't exist.
+	ErrUserDeletionFailure = errors.NewKind("Operation DROP USER failed for %s")
+
+	// ErrGrantUserNotFound is returned when granting privileges to an account that doesn't exist.
+	ErrGrantUserNotFound = errors.NewKind("You are not allowed to create a user with GRANT")
+
+	// ErrIllegalGrant is returned when granting or revoking a privilege on a level it can't be granted on.
+	ErrIllegalGrant = errors.NewKind("Illegal GRANT/REVOKE command; please consult the manual to see which privileges can be used")
+
+	// ErrNoSuchGrant is returned when showing the grants of an account that doesn't exist.
+	ErrNoSuchGrant = errors.NewKind("There is no such grant defined for user '%s' on host '%s'")
+
+	// ErrPrivilegeDenied is returned when running a statement without the global privilege it requires.
+	ErrPrivilegeDenied = errors.NewKind("Access denied; you need (at least one of) the %s privilege(s) for this operation")
+
+	// ErrDatabaseAccessDenied is returned when running a statement without the privilege it requires on a database.
+	ErrDatabaseAccessDenied = errors.NewKind("Access denied for user '%s'@'%s' to database '%s'")
+
+	// ErrTableAccessDenied is returned when running a statement without the privilege it requires on a table.
+	ErrTableAccessDenied = errors.NewKind("%s command denied to user '%s'@'%s' for table '%s'")
+
+	// ErrColumnAccessDenied is returned when running a statement without the privilege it requires on a column.
+	ErrColumnAccessDenied = errors.NewKind("%s command denied to user '%s'@'%s' for column '%s' in table '%s'")
+
+	// ErrRowSecurityPolicyExists is returned when adding a row security policy with the name of an existing policy on
+	// the same table.
+	ErrRowSecurityPolicyExists = errors.NewKind("row security policy %s already exists on table %s")
+
+	// ErrRowSecurityPolicyNotFound is returned when dropping a row security policy that doesn't exist.
+	ErrRowSecurityPolicyNotFound = errors.NewKind("row security policy %s does not exist on table %s")
+
+	// ErrRowSecurityViolation is returned when a statement writes a row that the session's row security policies
+	// wouldn't allow it to read.
+	ErrRowSecurityViolation = errors.NewKind("new row violates row security policy for table %s")
+
+	// ErrInvalidRowSecurityPredicate is returned when the predicate of a row security policy isn't a valid expression.
+	ErrInvalidRowSecurityPredicate = errors.NewKind("invalid predicate for row security policy %s: %s")
+
+	// ErrColumnMaskNotFound is returned when dropping the mask of a column that isn't masked.
+	ErrColumnMaskNotFound = errors.NewKind("column %s.%s is not masked")
+
+	// ErrAdminCommandNotSupported is returned when running an admin command that no one has registered.
+	ErrAdminCommandNotSupported = errors.NewKind("%s is not supported by this server")
+
+	// ErrAdminCommandDenied is returned when running an admin command without the privilege it requires.
+	ErrAdminCommandDenied = errors.NewKind("Access denied; you need (at least one of) the %s privilege(s) for this operation")
+
+	// ErrUnknownTableHandler is returned by HANDLER statements naming a handler that isn't open.
+	ErrUnknownTableHandler = errors.NewKind("Unknown table '%s' in HANDLER")
+
+	// ErrUnknownPreparedStatement is returned by EXECUTE and DEALLOCATE PREPARE statements naming a statement that
+	// isn't prepared.
+	ErrUnknownPreparedStatement = errors.NewKind("Unknown prepared statement handler (%s) given to %s")
+
+	// ErrTransactionConflict is returned by integrators when a transaction can't be committed because it conflicts with
+	// another transaction that committed first. The engine may retry statements that fail with it, and clients should
+	// retry their transaction otherwise.
+	ErrTransactionConflict = errors.NewKind("transaction conflict, try restarting transaction: %s")
+
+	// ErrPacketTooLarge is returned when a query sent by a client, or a row sent back to it, is larger than
+	// max_allowed_packet.
+	ErrPacketTooLarge = errors.NewKind("Got a packet bigger than 'max_allowed_packet' bytes")
+
+	// ErrUnknownAlterAlgorithm is returned when the ALGORITHM clause of a statement isn't a known algorithm.
+	ErrUnknownAlterAlgorithm = errors.NewKind("Unknown ALGORITHM '%s'")
+
+	// ErrUnknownAlterLock is returned when the LOCK clause of a statement isn't a known lock type.
+	ErrUnknownAlterLock = errors.NewKind("Unknown LOCK type '%s'")
+
+	// ErrAlterOperationNotSupported is returned when the ALGORITHM or LOCK clause of a statement can't be honored.
+	ErrAlterOperationNotSupported = errors.NewKind("%s is not supported for this operation. Try %s.")
+
+	// ErrAlterOperationNotSupportedReason is returned when the ALGORITHM or LOCK clause of a statement can't be honored,
+	// for the reason given.
+	ErrAlterOperationNotSupportedReason = errors.NewKind("%s is not supported. Reason: %s. Try %s.")
+
+	// ErrInternal is returned when a query panics, because of a bug in the engine or in an integrator.
+	ErrInternal = errors.NewKind("Internal error: %v")
+
+	// ErrInvalidWindowFrameOffset is returned when the offset of a bound of a window frame is negative, NULL or not an
+	// integer for a ROWS frame.
+	ErrInvalidWindowFrameOffset = errors.NewKind("Window '%s': frame start or end is negative, NULL or of non-integral type")
+
+	// ErrWindowRangeFrameOrderBy is returned when a RANGE frame with PRECEDING or FOLLOWING offsets doesn't have exactly
+	// one ORDER BY expression of a numeric or temporal type.
+	ErrWindowRangeFrameOrderBy = errors.NewKind("Window '%s' with RANGE N PRECEDING/FOLLOWING frame requires exactly one ORDER BY expression, of numeric or temporal type")
+
+	// ErrWindowRangeFrameTemporalType is returned when the offset of a RANGE frame ordered by a temporal expression
+	// isn't an interval.
+	ErrWindowRangeFrameTemporalType = errors.NewKind("Window '%s' with RANGE frame has ORDER BY expression of datetime type. Only INTERVAL bound value allowed.")
+
+	// ErrWindowRangeFrameNumericType is returned when the offset of a RANGE frame ordered by a numeric expression is an
+	// interval.
+	ErrWindowRangeFrameNumericType = errors.NewKind("Window '%s' with RANGE frame has ORDER BY expression of numeric type, INTERVAL bound value not allowed.")
 )
 
 func CastSQLError(err error) (*mysql.SQLError, error, bool) {
@@ -438,12 +620,91 @@ func CastSQLError(err error) (*mysql.SQLError, error, bool) {
 		code = mysql.ERKeyColumnDoesNotExist
 	case ErrCantDropFieldOrKey.Is(err):
 		code = mysql.ERCantDropFieldOrKey
+	case ErrDuplicateKeyName.Is(err):
+		code = mysql.ERDupKeyName
+	case ErrTooLongIdentifier.Is(err):
+		code = mysql.ERTooLongIdent
+	case ErrSpatialIndexKeyParts.Is(err):
+		code = mysql.ERTooManyKeyParts
+	case ErrSpatialIndexColumnType.Is(err):
+		code = 1687 // TODO: Needs to be added to vitess
+	case ErrSpatialIndexNullable.Is(err):
+		code = 1252 // TODO: Needs to be added to vitess
+	case ErrForeignKeyDuplicateName.Is(err):
+		code = 1826 // TODO: Needs to be added to vitess
+	case ErrCheckConstraintDuplicateName.Is(err):
+		code = 3822 // TODO: Needs to be added to vitess
+	case ErrMultipleConstraintsWithSameName.Is(err):
+		code = 3939 // TODO: Needs to be added to vitess
+	case ErrStoredProcedureAlreadyExists.Is(err):
+		code = 1304 // TODO: Needs to be added to vitess
+	case ErrTriggerAlreadyExists.Is(err):
+		code = 1359 // TODO: Needs to be added to vitess
+	case ErrViewInvalid.Is(err):
+		code = 1356 // TODO: Needs to be added to vitess
+	case ErrUserCreationFailure.Is(err), ErrUserDeletionFailure.Is(err):
+		code = 1396 // TODO: Needs to be added to vitess
+	case ErrGrantUserNotFound.Is(err):
+		code = 1410 // TODO: Needs to be added to vitess
+	case ErrIllegalGrant.Is(err):
+		code = mysql.ERIllegalGrantForTable
+	case ErrNoSuchGrant.Is(err):
+		code = mysql.ERNonExistingGrant
+	case ErrPrivilegeDenied.Is(err):
+		code = mysql.ERSpecifiedAccessDenied
+	case ErrDatabaseAccessDenied.Is(err):
+		code = mysql.ERDBAccessDenied
+	case ErrTableAccessDenied.Is(err):
+		code = 1142 // TODO: Needs to be added to vitess
+	case ErrColumnAccessDenied.Is(err):
+		code = 1143 // TODO: Needs to be added to vitess
 	case ErrReadOnlyTransaction.Is(err):
 		code = 1792 // TODO: Needs to be added to vitess
+	case ErrReadOnlyServer.Is(err):
+		code = mysql.EROptionPreventsStatement
 	case ErrCantDropIndex.Is(err):
 		code = 1553 // TODO: Needs to be added to vitess
+	case ErrLengthBeyondLimit.Is(err):
+		code = mysql.ERDataTooLong
+	case ErrIncorrectStringValue.Is(err):
+		code = mysql.ERTruncatedWrongValueForField
+	case ErrPacketTooLarge.Is(err):
+		code = mysql.ERNetPacketTooLarge
+	case ErrTransactionConflict.Is(err):
+		code = mysql.ERLockDeadlock
+		sqlState = mysql.SSLockDeadlock
 	case ErrInvalidValue.Is(err):
 		code = mysql.ERTruncatedWrongValueForField
+	case ErrUnknownAlterAlgorithm.Is(err):
+		code = 1800 // TODO: Needs to be added to vitess
+	case ErrUnknownAlterLock.Is(err):
+		code = 1801 // TODO: Needs to be added to vitess
+	case ErrAlterOperationNotSupported.Is(err):
+		code = 1845 // TODO: Needs to be added to vitess
+	case ErrAlterOperationNotSupportedReason.Is(err):
+		code = 1846 // TODO: Needs to be added to vitess
+	case ErrInternal.Is(err):
+		code = 1815 // TODO: Needs to be added to vitess
+	case ErrMaxExecutionTimeExceeded.Is(err):
+		code = 3024 // TODO: Needs to be added to vitess
+	case ErrInvalidGeometryData.Is(err):
+		code = 1416 // TODO: Needs to be added to vitess
+	case ErrCteRecursionRequiresUnion.Is(err):
+		code = 3573 // TODO: Needs to be added to vitess
+	case ErrCteRecursionRequiresNonRecursiveFirst.Is(err):
+		code = 3574 // TODO: Needs to be added to vitess
+	case ErrCteRecursionForbidsAggregation.Is(err):
+		code = 3575 // TODO: Needs to be added to vitess
+	case ErrCteRecursionRequiresSingleReference.Is(err):
+		code = 3577 // TODO: Needs to be added to vitess
+	case ErrCteMaxRecursionDepth.Is(err):
+		code = 3636 // TODO: Needs to be added to vitess
+	case ErrFeatureDisabled.Is(err):
+		code = mysql.ERFeatureDisabled
+	case ErrUnknownPreparedStatement.Is(err):
+		code = 1243 // TODO: Needs to be added to vitess
+	case ErrInvalidArgument.Is(err):
+		code = mysql.ERWrongArguments
 	default:
 		code = mysql.ERUnknownError
 	}
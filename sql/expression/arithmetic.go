@@ -180,6 +180,13 @@ func (a *Arithmetic) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
+	if delta, ok := lval.(*TimeDelta); ok {
+		return AddInterval(ctx, rval, delta, false), nil
+	}
+	if delta, ok := rval.(*TimeDelta); ok {
+		return AddInterval(ctx, lval, delta, strings.ToLower(a.Op) == sqlparser.MinusStr), nil
+	}
+
 	lval, rval, err = a.convertLeftRight(lval, rval)
 	if err != nil {
 		return nil, err
@@ -218,10 +225,13 @@ func (a *Arithmetic) evalLeftRight(ctx *sql.Context, row sql.Row) (interface{},
 	var err error
 
 	if i, ok := a.Left.(*Interval); ok {
-		lval, err = i.EvalDelta(ctx, row)
+		delta, err := i.EvalDelta(ctx, row)
 		if err != nil {
 			return nil, nil, err
 		}
+		if delta != nil {
+			lval = delta
+		}
 	} else {
 		lval, err = a.Left.Eval(ctx, row)
 		if err != nil {
@@ -230,10 +240,13 @@ func (a *Arithmetic) evalLeftRight(ctx *sql.Context, row sql.Row) (interface{},
 	}
 
 	if i, ok := a.Right.(*Interval); ok {
-		rval, err = i.EvalDelta(ctx, row)
+		delta, err := i.EvalDelta(ctx, row)
 		if err != nil {
 			return nil, nil, err
 		}
+		if delta != nil {
+			rval = delta
+		}
 	} else {
 		rval, err = a.Right.Eval(ctx, row)
 		if err != nil {
@@ -248,22 +261,14 @@ func (a *Arithmetic) convertLeftRight(left interface{}, right interface{}) (inte
 	var err error
 	typ := a.Type()
 
-	if i, ok := left.(*TimeDelta); ok {
-		left = i
-	} else {
-		left, err = typ.Convert(left)
-		if err != nil {
-			return nil, nil, err
-		}
+	left, err = typ.Convert(left)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	if i, ok := right.(*TimeDelta); ok {
-		right = i
-	} else {
-		right, err = typ.Convert(right)
-		if err != nil {
-			return nil, nil, err
-		}
+	right, err = typ.Convert(right)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	return left, right, nil
@@ -290,16 +295,9 @@ func plus(lval, rval interface{}) (interface{}, error) {
 		}
 	case time.Time:
 		switch r := rval.(type) {
-		case *TimeDelta:
-			return sql.ValidateTime(r.Add(l)), nil
 		case time.Time:
 			return l.Unix() + r.Unix(), nil
 		}
-	case *TimeDelta:
-		switch r := rval.(type) {
-		case time.Time:
-			return sql.ValidateTime(l.Add(r)), nil
-		}
 	}
 
 	return nil, errUnableToCast.New(lval, rval)
@@ -326,8 +324,6 @@ func minus(lval, rval interface{}) (interface{}, error) {
 		}
 	case time.Time:
 		switch r := rval.(type) {
-		case *TimeDelta:
-			return sql.ValidateTime(r.Sub(l)), nil
 		case time.Time:
 			return l.Unix() - r.Unix(), nil
 		}
@@ -123,6 +123,48 @@ func TestMinusInterval(t *testing.T) {
 	require.Equal(expected, result)
 }
 
+func TestIntervalNullAndInvalid(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	result, err := NewPlus(
+		NewLiteral("2018-05-01", sql.LongText),
+		NewInterval(NewLiteral(nil, sql.Null), "DAY"),
+	).Eval(ctx, nil)
+	require.NoError(err)
+	require.Nil(result)
+
+	result, err = NewPlus(
+		NewLiteral("2018-05-01", sql.LongText),
+		NewInterval(NewLiteral("1:2:3", sql.LongText), "HOUR_MINUTE"),
+	).Eval(ctx, nil)
+	require.NoError(err)
+	require.Nil(result)
+
+	result, err = NewPlus(
+		NewLiteral("not a date", sql.LongText),
+		NewInterval(NewLiteral(int64(1), sql.Int64), "DAY"),
+	).Eval(ctx, nil)
+	require.NoError(err)
+	require.Nil(result)
+	require.Equal(1292, ctx.Warnings()[0].Code)
+
+	result, err = NewMinus(
+		NewLiteral("0001-01-01", sql.LongText),
+		NewInterval(NewLiteral(int64(1), sql.Int64), "YEAR"),
+	).Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal(time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC), result)
+
+	result, err = NewMinus(
+		NewLiteral("0001-01-01", sql.LongText),
+		NewInterval(NewLiteral(int64(2), sql.Int64), "YEAR"),
+	).Eval(ctx, nil)
+	require.NoError(err)
+	require.Nil(result)
+	require.Equal(1441, ctx.Warnings()[0].Code)
+}
+
 func TestMult(t *testing.T) {
 	var testCases = []struct {
 		name        string
@@ -45,6 +45,11 @@ func (t *AbsVal) Description() string {
 	return "returns the absolute value of an expression."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (t *AbsVal) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements the Expression interface.
 func (t *AbsVal) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	val, err := t.Child.Eval(ctx, row)
@@ -16,7 +16,6 @@ package aggregation
 
 import (
 	"fmt"
-	"sort"
 	"strings"
 
 	"github.com/dolthub/vitess/go/vt/proto/query"
@@ -254,10 +253,8 @@ func (g *groupConcatBuffer) Eval(ctx *sql.Context) (interface{}, error) {
 			Rows:       rows,
 			Ctx:        ctx,
 		}
-
-		sort.Stable(sorter)
-		if sorter.LastError != nil {
-			return nil, sorter.LastError
+		if err := sorter.Sort(); err != nil {
+			return nil, err
 		}
 	}
 
@@ -112,7 +112,7 @@ func (j *JSONObjectAgg) NewBuffer() (sql.AggregationBuffer, error) {
 
 // NewWindowFunctionAggregation implements sql.WindowAdaptableExpression
 func (j *JSONObjectAgg) NewWindowFunction() (sql.WindowFunction, error) {
-	return NewWindowedJSONObjectAgg(j), nil
+	return NewWindowedJSONObjectAgg(j).WithWindow(j.Window()), nil
 }
 
 // Eval implements the Expression interface.
@@ -0,0 +1,259 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"fmt"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// ErrMergeAggregationWindow is returned when an aggregation that merges partial aggregations is used as a window
+// function.
+var ErrMergeAggregationWindow = errors.NewKind("%s can't be used as a window function")
+
+// CountMerge adds up the counts of the rows of a group that Count computed for parts of them, such as the rows of each
+// partition of a table, when a group by is split into partial aggregations and a final one.
+type CountMerge struct {
+	unaryAggBase
+}
+
+var _ sql.FunctionExpression = (*CountMerge)(nil)
+var _ sql.Aggregation = (*CountMerge)(nil)
+
+// NewCountMerge returns a CountMerge of the partial counts given.
+func NewCountMerge(e sql.Expression) *CountMerge {
+	return &CountMerge{
+		unaryAggBase{
+			UnaryExpression: expression.UnaryExpression{Child: e},
+			functionName:    "CountMerge",
+			description:     "returns the sum of the partial counts of the rows of a group.",
+		},
+	}
+}
+
+// Type implements the Expression interface.
+func (a *CountMerge) Type() sql.Type {
+	return sql.Int64
+}
+
+// IsNullable implements the Expression interface.
+func (a *CountMerge) IsNullable() bool {
+	return false
+}
+
+func (a *CountMerge) String() string {
+	return fmt.Sprintf("COUNTMERGE(%s)", a.Child)
+}
+
+// WithWindow implements sql.Aggregation
+func (a *CountMerge) WithWindow(window *sql.Window) (sql.Aggregation, error) {
+	res, err := a.unaryAggBase.WithWindow(window)
+	return &CountMerge{unaryAggBase: *res.(*unaryAggBase)}, err
+}
+
+// WithChildren implements the Expression interface.
+func (a *CountMerge) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	res, err := a.unaryAggBase.WithChildren(children...)
+	if err != nil {
+		return nil, err
+	}
+	return &CountMerge{unaryAggBase: *res.(*unaryAggBase)}, nil
+}
+
+// NewBuffer implements the Aggregation interface.
+func (a *CountMerge) NewBuffer() (sql.AggregationBuffer, error) {
+	child, err := expression.Clone(a.Child)
+	if err != nil {
+		return nil, err
+	}
+	return &countMergeBuffer{expr: child}, nil
+}
+
+// NewWindowFunction implements sql.WindowAdaptableExpression
+func (a *CountMerge) NewWindowFunction() (sql.WindowFunction, error) {
+	return nil, ErrMergeAggregationWindow.New(a.FunctionName())
+}
+
+type countMergeBuffer struct {
+	cnt  int64
+	expr sql.Expression
+}
+
+// Update implements the AggregationBuffer interface.
+func (c *countMergeBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	v, err := c.expr.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+	if v == nil {
+		return nil
+	}
+
+	cnt, err := sql.Int64.Convert(v)
+	if err != nil {
+		return err
+	}
+	c.cnt += cnt.(int64)
+	return nil
+}
+
+// Eval implements the AggregationBuffer interface.
+func (c *countMergeBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	return c.cnt, nil
+}
+
+// Dispose implements the Disposable interface.
+func (c *countMergeBuffer) Dispose() {
+	expression.Dispose(c.expr)
+}
+
+// AvgMerge computes the average of the values of a group from the sums and counts of the values that Sum and Count
+// computed for parts of them, such as the rows of each partition of a table, when a group by is split into partial
+// aggregations and a final one.
+type AvgMerge struct {
+	expression.BinaryExpression
+	window *sql.Window
+}
+
+var _ sql.FunctionExpression = (*AvgMerge)(nil)
+var _ sql.Aggregation = (*AvgMerge)(nil)
+
+// NewAvgMerge returns an AvgMerge of the partial sums and counts given.
+func NewAvgMerge(sum, count sql.Expression) *AvgMerge {
+	return &AvgMerge{BinaryExpression: expression.BinaryExpression{Left: sum, Right: count}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (a *AvgMerge) FunctionName() string {
+	return "AvgMerge"
+}
+
+// Description implements sql.FunctionExpression
+func (a *AvgMerge) Description() string {
+	return "returns the average value of a group from the partial sums and counts of its values."
+}
+
+// Type implements the Expression interface.
+func (a *AvgMerge) Type() sql.Type {
+	return sql.Float64
+}
+
+// IsNullable implements the Expression interface.
+func (a *AvgMerge) IsNullable() bool {
+	return true
+}
+
+func (a *AvgMerge) String() string {
+	return fmt.Sprintf("AVGMERGE(%s, %s)", a.Left, a.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (a *AvgMerge) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 2)
+	}
+	na := *a
+	na.BinaryExpression = expression.BinaryExpression{Left: children[0], Right: children[1]}
+	return &na, nil
+}
+
+// WithWindow implements sql.Aggregation
+func (a *AvgMerge) WithWindow(window *sql.Window) (sql.Aggregation, error) {
+	na := *a
+	na.window = window
+	return &na, nil
+}
+
+// Window implements sql.Aggregation
+func (a *AvgMerge) Window() *sql.Window {
+	return a.window
+}
+
+// NewBuffer implements the Aggregation interface.
+func (a *AvgMerge) NewBuffer() (sql.AggregationBuffer, error) {
+	sum, err := expression.Clone(a.Left)
+	if err != nil {
+		return nil, err
+	}
+	count, err := expression.Clone(a.Right)
+	if err != nil {
+		return nil, err
+	}
+	return &avgMergeBuffer{sumExpr: sum, countExpr: count}, nil
+}
+
+// NewWindowFunction implements sql.WindowAdaptableExpression
+func (a *AvgMerge) NewWindowFunction() (sql.WindowFunction, error) {
+	return nil, ErrMergeAggregationWindow.New(a.FunctionName())
+}
+
+// Eval implements the Expression interface.
+func (a *AvgMerge) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return nil, ErrEvalUnsupportedOnAggregation.New(a.FunctionName())
+}
+
+type avgMergeBuffer struct {
+	sum       float64
+	rows      int64
+	sumExpr   sql.Expression
+	countExpr sql.Expression
+}
+
+// Update implements the AggregationBuffer interface.
+func (a *avgMergeBuffer) Update(ctx *sql.Context, row sql.Row) error {
+	sum, err := a.sumExpr.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+	count, err := a.countExpr.Eval(ctx, row)
+	if err != nil {
+		return err
+	}
+
+	// The sums of parts without values are NULL
+	if sum != nil {
+		sum, err = sql.Float64.Convert(sum)
+		if err != nil {
+			return err
+		}
+		a.sum += sum.(float64)
+	}
+	if count != nil {
+		count, err = sql.Int64.Convert(count)
+		if err != nil {
+			return err
+		}
+		a.rows += count.(int64)
+	}
+	return nil
+}
+
+// Eval implements the AggregationBuffer interface.
+func (a *avgMergeBuffer) Eval(ctx *sql.Context) (interface{}, error) {
+	if a.rows == 0 {
+		return nil, nil
+	}
+	return a.sum / float64(a.rows), nil
+}
+
+// Dispose implements the Disposable interface.
+func (a *avgMergeBuffer) Dispose() {
+	expression.Dispose(a.sumExpr)
+	expression.Dispose(a.countExpr)
+}
@@ -0,0 +1,67 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestCountMerge(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	c := NewCountMerge(expression.NewGetField(0, sql.Int64, "COUNT(*)", false))
+	require.Equal("COUNTMERGE(COUNT(*))", c.String())
+
+	b, _ := c.NewBuffer()
+	require.Equal(int64(0), evalBuffer(t, b))
+
+	require.NoError(b.Update(ctx, sql.NewRow(int64(3))))
+	require.NoError(b.Update(ctx, sql.NewRow(nil)))
+	require.NoError(b.Update(ctx, sql.NewRow(int64(4))))
+	require.Equal(int64(7), evalBuffer(t, b))
+
+	_, err := c.NewWindowFunction()
+	require.True(ErrMergeAggregationWindow.Is(err))
+}
+
+func TestAvgMerge(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	a := NewAvgMerge(
+		expression.NewGetField(0, sql.Float64, "SUM(x)", true),
+		expression.NewGetField(1, sql.Int64, "COUNT(x)", false),
+	)
+	require.Equal("AVGMERGE(SUM(x), COUNT(x))", a.String())
+
+	b, _ := a.NewBuffer()
+	require.Nil(evalBuffer(t, b))
+
+	require.NoError(b.Update(ctx, sql.NewRow(nil, int64(0))))
+	require.Nil(evalBuffer(t, b))
+
+	require.NoError(b.Update(ctx, sql.NewRow(float64(6), int64(2))))
+	require.NoError(b.Update(ctx, sql.NewRow(float64(9), int64(3))))
+	require.Equal(float64(3), evalBuffer(t, b))
+
+	_, err := a.NewWindowFunction()
+	require.True(ErrMergeAggregationWindow.Is(err))
+}
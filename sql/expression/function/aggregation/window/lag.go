@@ -41,31 +41,10 @@ var _ sql.WindowAdaptableExpression = (*Lag)(nil)
 
 // getLagOffset extracts a non-negative integer from an expression.Literal, or errors
 func getLagOffset(e sql.Expression) (int, error) {
-	lit, ok := e.(*expression.Literal)
-	if !ok {
+	offset, ok := intLiteral(e)
+	if !ok || offset < 0 {
 		return 0, ErrInvalidLagOffset.New(e)
 	}
-	val := lit.Value()
-	var offset int
-	switch e := val.(type) {
-	case int:
-		offset = e
-	case int8:
-		offset = int(e)
-	case int16:
-		offset = int(e)
-	case int32:
-		offset = int(e)
-	case int64:
-		offset = int(e)
-	default:
-		return 0, ErrInvalidLagOffset.New(e)
-	}
-
-	if offset < 0 {
-		return 0, ErrInvalidLagOffset.New(e)
-	}
-
 	return offset, nil
 }
 
@@ -0,0 +1,134 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+)
+
+type LastValue struct {
+	window *sql.Window
+	expression.UnaryExpression
+	pos int
+}
+
+var _ sql.FunctionExpression = (*LastValue)(nil)
+var _ sql.WindowAggregation = (*LastValue)(nil)
+var _ sql.WindowAdaptableExpression = (*LastValue)(nil)
+
+func NewLastValue(e sql.Expression) sql.Expression {
+	return &LastValue{nil, expression.UnaryExpression{Child: e}, 0}
+}
+
+// Description implements sql.FunctionExpression
+func (f *LastValue) Description() string {
+	return "returns value of argument from last row of window frame."
+}
+
+// Window implements sql.WindowExpression
+func (f *LastValue) Window() *sql.Window {
+	return f.window
+}
+
+// Resolved implements sql.Expression
+func (f *LastValue) Resolved() bool {
+	return windowResolved(f.window)
+}
+
+func (f *LastValue) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("last_value(%s)", f.Child.String()))
+	if f.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(f.window.String())
+	}
+	return sb.String()
+}
+
+func (f *LastValue) DebugString() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("last_value(%s)", f.Child.String()))
+	if f.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(sql.DebugString(f.window))
+	}
+	return sb.String()
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *LastValue) FunctionName() string {
+	return "LAST_VALUE"
+}
+
+// Type implements sql.Expression
+func (f *LastValue) Type() sql.Type {
+	return f.Child.Type()
+}
+
+// IsNullable implements sql.Expression
+func (f *LastValue) IsNullable() bool {
+	return true
+}
+
+// Eval implements sql.Expression
+func (f *LastValue) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	panic("eval called on window function")
+}
+
+// Children implements sql.Expression
+func (f *LastValue) Children() []sql.Expression {
+	if f == nil {
+		return nil
+	}
+	return append(f.window.ToExpressions(), f.Child)
+}
+
+// WithChildren implements sql.Expression
+func (f *LastValue) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) < 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 2)
+	}
+
+	nf := *f
+	window, err := f.window.FromExpressions(children[:len(children)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	nf.Child = children[len(children)-1]
+	nf.window = window
+
+	return &nf, nil
+}
+
+// WithWindow implements sql.WindowAggregation
+func (f *LastValue) WithWindow(window *sql.Window) (sql.WindowAggregation, error) {
+	nr := *f
+	nr.window = window
+	return &nr, nil
+}
+
+func (f *LastValue) NewWindowFunction() (sql.WindowFunction, error) {
+	c, err := expression.Clone(f.Child)
+	if err != nil {
+		return nil, err
+	}
+	return aggregation.NewLastValueAgg(c).WithWindow(f.window), nil
+}
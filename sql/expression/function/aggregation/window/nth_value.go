@@ -0,0 +1,147 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package window
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/expression/function/aggregation"
+)
+
+var ErrInvalidNthValueRow = errors.NewKind("'NTH_VALUE' row number must be a positive integer; found: %v")
+
+type NthValue struct {
+	window *sql.Window
+	expression.UnaryExpression
+	n int
+}
+
+var _ sql.FunctionExpression = (*NthValue)(nil)
+var _ sql.WindowAggregation = (*NthValue)(nil)
+var _ sql.WindowAdaptableExpression = (*NthValue)(nil)
+
+// NewNthValue creates a new NthValue node from the expression and the row number of the frame to evaluate it at. The
+// row number is constrained to a positive integer expression.Literal.
+func NewNthValue(e ...sql.Expression) (sql.Expression, error) {
+	if len(e) != 2 {
+		return nil, sql.ErrInvalidArgumentNumber.New("NTH_VALUE", 2, len(e))
+	}
+	n, ok := intLiteral(e[1])
+	if !ok || n < 1 {
+		return nil, ErrInvalidNthValueRow.New(e[1])
+	}
+	return &NthValue{UnaryExpression: expression.UnaryExpression{Child: e[0]}, n: n}, nil
+}
+
+// Description implements sql.FunctionExpression
+func (f *NthValue) Description() string {
+	return "returns value of argument from N-th row of window frame."
+}
+
+// Window implements sql.WindowExpression
+func (f *NthValue) Window() *sql.Window {
+	return f.window
+}
+
+// Resolved implements sql.Expression
+func (f *NthValue) Resolved() bool {
+	return f.Child.Resolved() && windowResolved(f.window)
+}
+
+func (f *NthValue) String() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("nth_value(%s, %d)", f.Child.String(), f.n))
+	if f.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(f.window.String())
+	}
+	return sb.String()
+}
+
+func (f *NthValue) DebugString() string {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("nth_value(%s, %d)", sql.DebugString(f.Child), f.n))
+	if f.window != nil {
+		sb.WriteString(" ")
+		sb.WriteString(sql.DebugString(f.window))
+	}
+	return sb.String()
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f *NthValue) FunctionName() string {
+	return "NTH_VALUE"
+}
+
+// Type implements sql.Expression
+func (f *NthValue) Type() sql.Type {
+	return f.Child.Type()
+}
+
+// IsNullable implements sql.Expression
+func (f *NthValue) IsNullable() bool {
+	return true
+}
+
+// Eval implements sql.Expression
+func (f *NthValue) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	panic("eval called on window function")
+}
+
+// Children implements sql.Expression
+func (f *NthValue) Children() []sql.Expression {
+	if f == nil {
+		return nil
+	}
+	return append(f.window.ToExpressions(), f.Child)
+}
+
+// WithChildren implements sql.Expression
+func (f *NthValue) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) < 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 2)
+	}
+
+	nf := *f
+	window, err := f.window.FromExpressions(children[:len(children)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	nf.Child = children[len(children)-1]
+	nf.window = window
+
+	return &nf, nil
+}
+
+// WithWindow implements sql.WindowAggregation
+func (f *NthValue) WithWindow(window *sql.Window) (sql.WindowAggregation, error) {
+	nf := *f
+	nf.window = window
+	return &nf, nil
+}
+
+func (f *NthValue) NewWindowFunction() (sql.WindowFunction, error) {
+	c, err := expression.Clone(f.Child)
+	if err != nil {
+		return nil, err
+	}
+	return aggregation.NewNthValueAgg(c, f.n).WithWindow(f.window), nil
+}
@@ -25,3 +25,25 @@ func windowResolved(window *sql.Window) bool {
 	}
 	return expression.ExpressionsResolved(append(window.OrderBy.ToExpressions(), window.PartitionBy...)...)
 }
+
+// intLiteral returns the value of an integer expression.Literal, and false if the expression isn't one.
+func intLiteral(e sql.Expression) (int, bool) {
+	lit, ok := e.(*expression.Literal)
+	if !ok {
+		return 0, false
+	}
+	switch v := lit.Value().(type) {
+	case int:
+		return v, true
+	case int8:
+		return int(v), true
+	case int16:
+		return int(v), true
+	case int32:
+		return int(v), true
+	case int64:
+		return int(v), true
+	default:
+		return 0, false
+	}
+}
@@ -17,8 +17,10 @@ package aggregation
 import (
 	"errors"
 	"io"
+	"sort"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 var ErrPartitionNotSet = errors.New("attempted to general a window frame interval before framer partition was set")
@@ -255,3 +257,471 @@ func (f *GroupByFramer) Interval() (sql.WindowInterval, error) {
 func (f *GroupByFramer) SlidingInterval(ctx sql.Context) (sql.WindowInterval, sql.WindowInterval, sql.WindowInterval) {
 	panic("implement me")
 }
+
+// unnamedWindow is the name of windows in errors, since windows can't be named.
+const unnamedWindow = "<unnamed window>"
+
+// bufferedFramer is a sql.WindowFramer whose frames depend on the values of the rows of a partition, rather than only
+// on their positions, and which needs the partition's rows to start framing it.
+type bufferedFramer interface {
+	sql.WindowFramer
+	// NewBufferedFramer returns a framer of the partition given of the buffer given.
+	NewBufferedFramer(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) (sql.WindowFramer, error)
+}
+
+// NewWindowFramer returns the sql.WindowFramer of the window function given in the window given: a framer of the frame
+// clause of the window if it has one, or the default framer of the function otherwise. As in MySQL, ranking
+// functions, LAG and LEAD use their default framers whatever the frame of their window.
+func NewWindowFramer(fn sql.WindowFunction, w *sql.Window) (sql.WindowFramer, error) {
+	if w == nil || w.Frame == nil {
+		return fn.DefaultFramer(), nil
+	}
+	switch fn.(type) {
+	case *RowNumber, *PercentRank, *Ntile, *Lag, *Lead:
+		return fn.DefaultFramer(), nil
+	}
+	if w.Frame.Unit == sql.FrameRange {
+		return NewRangeFramer(w.Frame, w.OrderBy)
+	}
+	return NewRowsFramer(w.Frame)
+}
+
+var _ sql.WindowFramer = (*RowsFramer)(nil)
+var _ bufferedFramer = (*RangeFramer)(nil)
+
+// rowsFrameBound is a bound of a ROWS frame, whose offset is a number of rows.
+type rowsFrameBound struct {
+	typ    sql.WindowFrameBoundType
+	offset int
+}
+
+// NewRowsFramer returns a framer of the ROWS frame given, whose bounds are offsets in rows from the current row.
+// Frames whose end precedes their start are empty.
+//
+// Ex: ROWS BETWEEN 1 PRECEDING AND 2 FOLLOWING; partition = [0, 1, 2, 3, 4, 5]
+// =>
+// frames: {0,3},   {0,4},     {1,5},     {2,6},     {3,6},   {4,6}
+// rows:   [0,1,2], [0,1,2,3], [1,2,3,4], [2,3,4,5], [3,4,5], [4,5]
+func NewRowsFramer(frame *sql.WindowFrame) (*RowsFramer, error) {
+	start, err := newRowsFrameBound(frame.Start)
+	if err != nil {
+		return nil, err
+	}
+	end, err := newRowsFrameBound(frame.End)
+	if err != nil {
+		return nil, err
+	}
+	return &RowsFramer{
+		start:          start,
+		end:            end,
+		frameStart:     -1,
+		frameEnd:       -1,
+		partitionStart: -1,
+		partitionEnd:   -1,
+	}, nil
+}
+
+func newRowsFrameBound(bound sql.WindowFrameBound) (rowsFrameBound, error) {
+	b := rowsFrameBound{typ: bound.Type}
+	if bound.Type != sql.FramePreceding && bound.Type != sql.FrameFollowing {
+		return b, nil
+	}
+
+	if _, ok := bound.Offset.(*expression.Interval); ok {
+		return b, sql.ErrInvalidWindowFrameOffset.New(unnamedWindow)
+	}
+	v, err := bound.Offset.Eval(sql.NewEmptyContext(), nil)
+	if err != nil {
+		return b, err
+	}
+	if v == nil || !sql.IsInteger(bound.Offset.Type()) {
+		return b, sql.ErrInvalidWindowFrameOffset.New(unnamedWindow)
+	}
+	offset, err := sql.Int64.Convert(v)
+	if err != nil || offset.(int64) < 0 {
+		return b, sql.ErrInvalidWindowFrameOffset.New(unnamedWindow)
+	}
+	b.offset = int(offset.(int64))
+	return b, nil
+}
+
+// RowsFramer generates the sql.WindowInterval of a ROWS frame for each row of a partition.
+type RowsFramer struct {
+	idx                          int
+	partitionStart, partitionEnd int
+	frameStart, frameEnd         int
+	partitionSet                 bool
+
+	start, end rowsFrameBound
+}
+
+func (f *RowsFramer) NewFramer(interval sql.WindowInterval) sql.WindowFramer {
+	return &RowsFramer{
+		idx:            interval.Start,
+		partitionStart: interval.Start,
+		partitionEnd:   interval.End,
+		frameStart:     -1,
+		frameEnd:       -1,
+		partitionSet:   true,
+		// pass through parent state
+		start: f.start,
+		end:   f.end,
+	}
+}
+
+func (f *RowsFramer) Next() (sql.WindowInterval, error) {
+	if f.idx != 0 && f.idx >= f.partitionEnd || !f.partitionSet {
+		return sql.WindowInterval{}, io.EOF
+	}
+
+	// The start bound is the first row of the frame, and the end bound its last row
+	f.frameStart = f.clamp(f.position(f.start))
+	f.frameEnd = f.clamp(f.position(f.end) + 1)
+	if f.frameEnd < f.frameStart {
+		f.frameEnd = f.frameStart
+	}
+
+	f.idx++
+	return f.Interval()
+}
+
+// position returns the index of the row at the bound given of the frame of the current row.
+func (f *RowsFramer) position(bound rowsFrameBound) int {
+	switch bound.typ {
+	case sql.FrameUnboundedPreceding:
+		return f.partitionStart
+	case sql.FramePreceding:
+		return f.idx - bound.offset
+	case sql.FrameFollowing:
+		return f.idx + bound.offset
+	case sql.FrameUnboundedFollowing:
+		return f.partitionEnd - 1
+	default:
+		return f.idx
+	}
+}
+
+func (f *RowsFramer) clamp(idx int) int {
+	if idx < f.partitionStart {
+		return f.partitionStart
+	}
+	if idx > f.partitionEnd {
+		return f.partitionEnd
+	}
+	return idx
+}
+
+func (f *RowsFramer) FirstIdx() int {
+	return f.frameStart
+}
+
+func (f *RowsFramer) LastIdx() int {
+	return f.frameEnd
+}
+
+func (f *RowsFramer) Interval() (sql.WindowInterval, error) {
+	if !f.partitionSet {
+		return sql.WindowInterval{}, ErrPartitionNotSet
+	}
+	return sql.WindowInterval{Start: f.frameStart, End: f.frameEnd}, nil
+}
+
+func (f *RowsFramer) SlidingInterval(ctx sql.Context) (sql.WindowInterval, sql.WindowInterval, sql.WindowInterval) {
+	panic("implement me")
+}
+
+// NewRangeFramer returns a framer of the RANGE frame given for a window ordered by the sort fields given. CURRENT ROW
+// bounds include the peers of the current row, the rows with the same ORDER BY values, and all the rows of a
+// partition are peers if the window isn't ordered. PRECEDING and FOLLOWING bounds are offsets from the value of the
+// only ORDER BY expression of the current row in the direction of the order: numbers for numeric expressions, and
+// intervals for temporal ones. The offset bounds of a row whose ORDER BY value is NULL are its peers, the other rows
+// with a NULL value.
+//
+// Ex: RANGE BETWEEN 2 PRECEDING AND CURRENT ROW; ORDER BY x; x = [1, 2, 2, 4, 7]
+// =>
+// frames: {0,1}, {0,3},   {0,3},   {1,4},     {4,5}
+// rows:   [1],   [1,2,2], [1,2,2], [2,2,4],   [7]
+func NewRangeFramer(frame *sql.WindowFrame, orderBy sql.SortFields) (*RangeFramer, error) {
+	for _, bound := range []sql.WindowFrameBound{frame.Start, frame.End} {
+		if bound.Type != sql.FramePreceding && bound.Type != sql.FrameFollowing {
+			continue
+		}
+		if len(orderBy) != 1 {
+			return nil, sql.ErrWindowRangeFrameOrderBy.New(unnamedWindow)
+		}
+		typ := orderBy[0].Column.Type()
+		_, isInterval := bound.Offset.(*expression.Interval)
+		switch {
+		case sql.IsTime(typ):
+			if !isInterval {
+				return nil, sql.ErrWindowRangeFrameTemporalType.New(unnamedWindow)
+			}
+		case sql.IsNumber(typ):
+			if isInterval {
+				return nil, sql.ErrWindowRangeFrameNumericType.New(unnamedWindow)
+			}
+			v, err := bound.Offset.Eval(sql.NewEmptyContext(), nil)
+			if err != nil {
+				return nil, err
+			}
+			if v == nil {
+				return nil, sql.ErrInvalidWindowFrameOffset.New(unnamedWindow)
+			}
+			if cmp, err := sql.Float64.Compare(v, float64(0)); err != nil || cmp < 0 {
+				return nil, sql.ErrInvalidWindowFrameOffset.New(unnamedWindow)
+			}
+		default:
+			return nil, sql.ErrWindowRangeFrameOrderBy.New(unnamedWindow)
+		}
+	}
+	return &RangeFramer{frame: frame, orderBy: orderBy}, nil
+}
+
+// RangeFramer generates the sql.WindowInterval of a RANGE frame for each row of a partition. The frames of a partition
+// are computed when the framer of the partition is created with NewBufferedFramer.
+type RangeFramer struct {
+	frame   *sql.WindowFrame
+	orderBy sql.SortFields
+
+	idx                          int
+	partitionStart, partitionEnd int
+	frames                       []sql.WindowInterval
+	partitionSet                 bool
+}
+
+// NewDefaultRangeFramer returns a framer of the default frame of windows, RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT
+// ROW, whose frames end with the last peer of their row, and span the whole partition if the window has no ORDER BY.
+func NewDefaultRangeFramer(orderBy sql.SortFields) *RangeFramer {
+	return &RangeFramer{
+		frame: &sql.WindowFrame{
+			Unit:  sql.FrameRange,
+			Start: sql.WindowFrameBound{Type: sql.FrameUnboundedPreceding},
+			End:   sql.WindowFrameBound{Type: sql.FrameCurrentRow},
+		},
+		orderBy: orderBy,
+	}
+}
+
+// NewFramer implements sql.WindowFramer. Range frames depend on the values of the rows of the partition, which are
+// given to NewBufferedFramer, so the framer returned has no partition set.
+func (f *RangeFramer) NewFramer(interval sql.WindowInterval) sql.WindowFramer {
+	return &RangeFramer{frame: f.frame, orderBy: f.orderBy}
+}
+
+// NewBufferedFramer implements bufferedFramer.
+func (f *RangeFramer) NewBufferedFramer(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) (sql.WindowFramer, error) {
+	nf := &RangeFramer{
+		frame:          f.frame,
+		orderBy:        f.orderBy,
+		idx:            interval.Start,
+		partitionStart: interval.Start,
+		partitionEnd:   interval.End,
+		partitionSet:   true,
+	}
+	var err error
+	nf.frames, err = nf.computeFrames(ctx, buf)
+	if err != nil {
+		return nil, err
+	}
+	return nf, nil
+}
+
+// computeFrames returns the frames of the rows of the partition of the framer.
+func (f *RangeFramer) computeFrames(ctx *sql.Context, buf sql.WindowBuffer) ([]sql.WindowInterval, error) {
+	n := f.partitionEnd - f.partitionStart
+	values := make([][]interface{}, n)
+	for i := range values {
+		var err error
+		values[i], _, err = evalExprs(ctx, f.orderBy.ToExpressions(), buf[f.partitionStart+i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// peers[i] is the interval of the peers of the row i of the partition
+	peers := make([]sql.WindowInterval, n)
+	start := 0
+	for i := 1; i <= n; i++ {
+		if i < n {
+			same, err := f.samePeerGroup(values[i-1], values[i])
+			if err != nil {
+				return nil, err
+			}
+			if same {
+				continue
+			}
+		}
+		for j := start; j < i; j++ {
+			peers[j] = sql.WindowInterval{Start: f.partitionStart + start, End: f.partitionStart + i}
+		}
+		start = i
+	}
+
+	// The rows with a NULL ORDER BY value are together at one end of the partition, and never within an offset of the
+	// other rows
+	nonNullStart, nonNullEnd := 0, n
+	if len(f.orderBy) == 1 {
+		for nonNullStart < n && values[nonNullStart][0] == nil {
+			nonNullStart++
+		}
+		for nonNullEnd > nonNullStart && values[nonNullEnd-1][0] == nil {
+			nonNullEnd--
+		}
+	}
+
+	frames := make([]sql.WindowInterval, n)
+	for i := range frames {
+		frameStart, err := f.position(ctx, f.frame.Start, false, i, values, peers, nonNullStart, nonNullEnd)
+		if err != nil {
+			return nil, err
+		}
+		frameEnd, err := f.position(ctx, f.frame.End, true, i, values, peers, nonNullStart, nonNullEnd)
+		if err != nil {
+			return nil, err
+		}
+		if frameEnd < frameStart {
+			frameEnd = frameStart
+		}
+		frames[i] = sql.WindowInterval{Start: frameStart, End: frameEnd}
+	}
+	return frames, nil
+}
+
+// samePeerGroup returns whether rows with the ORDER BY values given are peers.
+func (f *RangeFramer) samePeerGroup(a, b []interface{}) (bool, error) {
+	for i, sf := range f.orderBy {
+		if a[i] == nil || b[i] == nil {
+			if a[i] != nil || b[i] != nil {
+				return false, nil
+			}
+			continue
+		}
+		cmp, err := sf.Column.Type().Compare(a[i], b[i])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// position returns the buffer index of the bound given of the frame of the row i of the partition: the index of the
+// first row of the frame for its start bound, and the index following its last row for its end bound.
+func (f *RangeFramer) position(ctx *sql.Context, bound sql.WindowFrameBound, end bool, i int, values [][]interface{}, peers []sql.WindowInterval, nonNullStart, nonNullEnd int) (int, error) {
+	switch bound.Type {
+	case sql.FrameUnboundedPreceding:
+		return f.partitionStart, nil
+	case sql.FrameUnboundedFollowing:
+		return f.partitionEnd, nil
+	case sql.FrameCurrentRow:
+		if end {
+			return peers[i].End, nil
+		}
+		return peers[i].Start, nil
+	}
+
+	v := values[i][0]
+	if v == nil {
+		if end {
+			return peers[i].End, nil
+		}
+		return peers[i].Start, nil
+	}
+
+	sf := f.orderBy[0]
+	descending := sf.Order == sql.Descending
+	preceding := bound.Type == sql.FramePreceding
+	// Preceding values are smaller in ascending order, and greater in descending order
+	subtract := preceding != descending
+
+	var target interface{}
+	var typ sql.Type
+	if interval, ok := bound.Offset.(*expression.Interval); ok {
+		delta, err := interval.EvalDelta(ctx, nil)
+		if err != nil {
+			return 0, err
+		}
+		if delta == nil {
+			return 0, sql.ErrInvalidWindowFrameOffset.New(unnamedWindow)
+		}
+		target, typ = expression.AddInterval(ctx, v, delta, subtract), sql.Datetime
+	} else {
+		op := expression.NewPlus
+		if subtract {
+			op = expression.NewMinus
+		}
+		arithmetic := op(expression.NewLiteral(v, sf.Column.Type()), bound.Offset)
+		var err error
+		target, err = arithmetic.Eval(ctx, nil)
+		if err != nil {
+			return 0, err
+		}
+		typ = arithmetic.Type()
+	}
+
+	// A target out of the range of the type is before or after every value
+	if target == nil {
+		if preceding {
+			return f.partitionStart + nonNullStart, nil
+		}
+		return f.partitionStart + nonNullEnd, nil
+	}
+
+	// The start bound is the first row that doesn't sort before the target, and the end bound the first row that sorts
+	// after it
+	var err error
+	j := sort.Search(nonNullEnd-nonNullStart, func(k int) bool {
+		if err != nil {
+			return true
+		}
+		var cmp int
+		cmp, err = typ.Compare(values[nonNullStart+k][0], target)
+		if descending {
+			cmp = -cmp
+		}
+		if end {
+			return cmp > 0
+		}
+		return cmp >= 0
+	})
+	if err != nil {
+		return 0, err
+	}
+	return f.partitionStart + nonNullStart + j, nil
+}
+
+func (f *RangeFramer) Next() (sql.WindowInterval, error) {
+	if f.idx != 0 && f.idx >= f.partitionEnd || !f.partitionSet {
+		return sql.WindowInterval{}, io.EOF
+	}
+	f.idx++
+	return f.Interval()
+}
+
+func (f *RangeFramer) FirstIdx() int {
+	frame, _ := f.Interval()
+	return frame.Start
+}
+
+func (f *RangeFramer) LastIdx() int {
+	frame, _ := f.Interval()
+	return frame.End
+}
+
+func (f *RangeFramer) Interval() (sql.WindowInterval, error) {
+	if !f.partitionSet {
+		return sql.WindowInterval{}, ErrPartitionNotSet
+	}
+	// The frame of the last row returned by Next, which is empty for empty partitions
+	pos := f.idx - 1 - f.partitionStart
+	if pos < 0 || pos >= len(f.frames) {
+		return sql.WindowInterval{Start: f.partitionStart, End: f.partitionStart}, nil
+	}
+	return f.frames[pos], nil
+}
+
+func (f *RangeFramer) SlidingInterval(ctx sql.Context) (sql.WindowInterval, sql.WindowInterval, sql.WindowInterval) {
+	panic("implement me")
+}
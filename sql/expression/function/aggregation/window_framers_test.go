@@ -22,8 +22,17 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
+func newTestRowsFramer(start, end sql.WindowFrameBound) *RowsFramer {
+	framer, err := NewRowsFramer(&sql.WindowFrame{Unit: sql.FrameRows, Start: start, End: end})
+	if err != nil {
+		panic(err)
+	}
+	return framer
+}
+
 func TestWindowFramers(t *testing.T) {
 	tests := []struct {
 		Name     string
@@ -72,6 +81,44 @@ func TestWindowFramers(t *testing.T) {
 				{Start: 6, End: 9},
 			},
 		},
+		{
+			Name: "rows 1 preceding to 1 following framer",
+			Framer: newTestRowsFramer(
+				sql.WindowFrameBound{Type: sql.FramePreceding, Offset: expression.NewLiteral(int8(1), sql.Int8)},
+				sql.WindowFrameBound{Type: sql.FrameFollowing, Offset: expression.NewLiteral(int8(1), sql.Int8)},
+			),
+			Expected: []sql.WindowInterval{
+				{},
+				{Start: 0, End: 2},
+				{Start: 0, End: 2},
+				{Start: 2, End: 4},
+				{Start: 2, End: 5},
+				{Start: 3, End: 6},
+				{Start: 4, End: 6},
+				{Start: 6, End: 8},
+				{Start: 6, End: 9},
+				{Start: 7, End: 9},
+			},
+		},
+		{
+			Name: "rows 2 following to unbounded following framer",
+			Framer: newTestRowsFramer(
+				sql.WindowFrameBound{Type: sql.FrameFollowing, Offset: expression.NewLiteral(int8(2), sql.Int8)},
+				sql.WindowFrameBound{Type: sql.FrameUnboundedFollowing},
+			),
+			Expected: []sql.WindowInterval{
+				{},
+				{Start: 2, End: 2},
+				{Start: 2, End: 2},
+				{Start: 4, End: 6},
+				{Start: 5, End: 6},
+				{Start: 6, End: 6},
+				{Start: 6, End: 6},
+				{Start: 8, End: 9},
+				{Start: 9, End: 9},
+				{Start: 9, End: 9},
+			},
+		},
 	}
 
 	partitions := []sql.WindowInterval{
@@ -103,3 +150,82 @@ func TestWindowFramers(t *testing.T) {
 		})
 	}
 }
+
+func TestRangeFramer(t *testing.T) {
+	x := expression.NewGetField(0, sql.Int64, "x", true)
+	buf := sql.WindowBuffer{{nil}, {int64(1)}, {int64(2)}, {int64(4)}, {int64(5)}, {int64(5)}}
+	partition := sql.WindowInterval{Start: 0, End: 6}
+
+	tests := []struct {
+		Name     string
+		Frame    *sql.WindowFrame
+		Expected []sql.WindowInterval
+	}{
+		{
+			Name: "1 preceding to 1 following",
+			Frame: &sql.WindowFrame{
+				Unit:  sql.FrameRange,
+				Start: sql.WindowFrameBound{Type: sql.FramePreceding, Offset: expression.NewLiteral(int8(1), sql.Int8)},
+				End:   sql.WindowFrameBound{Type: sql.FrameFollowing, Offset: expression.NewLiteral(int8(1), sql.Int8)},
+			},
+			Expected: []sql.WindowInterval{
+				{Start: 0, End: 1},
+				{Start: 1, End: 3},
+				{Start: 1, End: 3},
+				{Start: 3, End: 6},
+				{Start: 3, End: 6},
+				{Start: 3, End: 6},
+			},
+		},
+		{
+			Name: "current row to unbounded following",
+			Frame: &sql.WindowFrame{
+				Unit:  sql.FrameRange,
+				Start: sql.WindowFrameBound{Type: sql.FrameCurrentRow},
+				End:   sql.WindowFrameBound{Type: sql.FrameUnboundedFollowing},
+			},
+			Expected: []sql.WindowInterval{
+				{Start: 0, End: 6},
+				{Start: 1, End: 6},
+				{Start: 2, End: 6},
+				{Start: 3, End: 6},
+				{Start: 4, End: 6},
+				{Start: 4, End: 6},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			require := require.New(t)
+			framer, err := NewRangeFramer(tt.Frame, sql.SortFields{{Column: x, Order: sql.Ascending}})
+			require.NoError(err)
+
+			_, err = framer.NewFramer(partition).Next()
+			require.Equal(io.EOF, err)
+
+			partitionFramer, err := framer.NewBufferedFramer(sql.NewEmptyContext(), partition, buf)
+			require.NoError(err)
+			var res []sql.WindowInterval
+			for {
+				frame, err := partitionFramer.Next()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				require.NoError(err)
+				res = append(res, frame)
+			}
+			require.Equal(tt.Expected, res)
+		})
+	}
+
+	_, err := NewRangeFramer(
+		&sql.WindowFrame{
+			Unit:  sql.FrameRange,
+			Start: sql.WindowFrameBound{Type: sql.FramePreceding, Offset: expression.NewLiteral(int8(1), sql.Int8)},
+			End:   sql.WindowFrameBound{Type: sql.FrameCurrentRow},
+		},
+		sql.SortFields{{Column: x}, {Column: x}},
+	)
+	require.True(t, sql.ErrWindowRangeFrameOrderBy.Is(err))
+}
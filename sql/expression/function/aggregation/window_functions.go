@@ -15,7 +15,6 @@
 package aggregation
 
 import (
-	"sort"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -37,6 +36,9 @@ var _ sql.WindowFunction = (*PercentRank)(nil)
 var _ sql.WindowFunction = (*RowNumber)(nil)
 var _ sql.WindowFunction = (*Lag)(nil)
 var _ sql.WindowFunction = (*Lead)(nil)
+var _ sql.WindowFunction = (*Ntile)(nil)
+var _ sql.WindowFunction = (*LastValueAgg)(nil)
+var _ sql.WindowFunction = (*NthValueAgg)(nil)
 
 type SumAgg struct {
 	partitionStart, partitionEnd int
@@ -44,6 +46,8 @@ type SumAgg struct {
 
 	// use prefix sums to quickly calculate arbitrary frame sum within partition
 	prefixSum []float64
+	// frames of only nulls sum to null
+	nullCnt []int
 }
 
 func NewSumAgg(e sql.Expression) *SumAgg {
@@ -71,7 +75,7 @@ func (a *SumAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, b
 	a.partitionStart, a.partitionEnd = interval.Start, interval.End
 	a.Dispose()
 	var err error
-	a.prefixSum, _, err = floatPrefixSum(ctx, interval, buf, a.expr)
+	a.prefixSum, a.nullCnt, err = floatPrefixSum(ctx, interval, buf, a.expr)
 	return err
 }
 
@@ -80,7 +84,7 @@ func (a *SumAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
 }
 
 func (a *SumAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
-	if interval.End-interval.Start < 1 {
+	if nonNullCount(interval, a.partitionStart, a.nullCnt) == 0 {
 		return nil
 	}
 	return computePrefixSum(interval, a.partitionStart, a.prefixSum)
@@ -109,6 +113,24 @@ func floatPrefixSum(ctx *sql.Context, interval sql.WindowInterval, buf sql.Windo
 	return sums, nulls, nil
 }
 
+// nonNullCount returns the number of rows of the interval given whose values aren't null, given the prefix counts of
+// null values of the partition.
+func nonNullCount(interval sql.WindowInterval, partitionStart int, nullCnt []int) int {
+	startIdx := interval.Start - partitionStart - 1
+	endIdx := interval.End - partitionStart - 1
+
+	var nonNullCnt int
+	if endIdx >= 0 {
+		nonNullCnt += endIdx + 1
+		nonNullCnt -= nullCnt[endIdx]
+	}
+	if startIdx >= 0 {
+		nonNullCnt -= startIdx + 1
+		nonNullCnt += nullCnt[startIdx]
+	}
+	return nonNullCnt
+}
+
 func computePrefixSum(interval sql.WindowInterval, partitionStart int, prefixSum []float64) float64 {
 	startIdx := interval.Start - partitionStart - 1
 	endIdx := interval.End - partitionStart - 1
@@ -166,17 +188,9 @@ func (a *AvgAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
 }
 
 func (a *AvgAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
-	startIdx := interval.Start - a.partitionStart - 1
-	endIdx := interval.End - a.partitionStart - 1
-
-	var nonNullCnt int
-	if endIdx >= 0 {
-		nonNullCnt += endIdx + 1
-		nonNullCnt -= a.nullCnt[endIdx]
-	}
-	if startIdx >= 0 {
-		nonNullCnt -= startIdx + 1
-		nonNullCnt += a.nullCnt[startIdx]
+	nonNullCnt := nonNullCount(interval, a.partitionStart, a.nullCnt)
+	if nonNullCnt == 0 {
+		return nil
 	}
 	return computePrefixSum(interval, a.partitionStart, a.prefixSum) / float64(nonNullCnt)
 }
@@ -391,6 +405,107 @@ func (a *FirstAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buffer
 	return v
 }
 
+// LastValueAgg returns the value of the last row of the frame. Unlike LastAgg, its default frame ends with the last
+// peer of the current row.
+type LastValueAgg struct {
+	expr    sql.Expression
+	orderBy sql.SortFields
+}
+
+func NewLastValueAgg(e sql.Expression) *LastValueAgg {
+	return &LastValueAgg{
+		expr: e,
+	}
+}
+
+func (a *LastValueAgg) WithWindow(w *sql.Window) sql.WindowFunction {
+	na := *a
+	if w != nil {
+		na.orderBy = w.OrderBy
+	}
+	return &na
+}
+
+func (a *LastValueAgg) Dispose() {
+	expression.Dispose(a.expr)
+}
+
+// DefaultFramer returns a NewDefaultRangeFramer
+func (a *LastValueAgg) DefaultFramer() sql.WindowFramer {
+	return NewDefaultRangeFramer(a.orderBy)
+}
+
+func (a *LastValueAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) error {
+	a.Dispose()
+	return nil
+}
+
+func (a *LastValueAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
+	panic("sliding window interface not implemented yet")
+}
+
+func (a *LastValueAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) interface{} {
+	if interval.End-interval.Start < 1 {
+		return nil
+	}
+	v, err := a.expr.Eval(ctx, buffer[interval.End-1])
+	if err != nil {
+		return err
+	}
+	return v
+}
+
+// NthValueAgg returns the value of the N-th row of the frame, or nil if the frame has fewer rows.
+type NthValueAgg struct {
+	expr    sql.Expression
+	n       int
+	orderBy sql.SortFields
+}
+
+func NewNthValueAgg(e sql.Expression, n int) *NthValueAgg {
+	return &NthValueAgg{
+		expr: e,
+		n:    n,
+	}
+}
+
+func (a *NthValueAgg) WithWindow(w *sql.Window) sql.WindowFunction {
+	na := *a
+	if w != nil {
+		na.orderBy = w.OrderBy
+	}
+	return &na
+}
+
+func (a *NthValueAgg) Dispose() {
+	expression.Dispose(a.expr)
+}
+
+// DefaultFramer returns a NewDefaultRangeFramer
+func (a *NthValueAgg) DefaultFramer() sql.WindowFramer {
+	return NewDefaultRangeFramer(a.orderBy)
+}
+
+func (a *NthValueAgg) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) error {
+	a.Dispose()
+	return nil
+}
+
+func (a *NthValueAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
+	panic("sliding window interface not implemented yet")
+}
+
+func (a *NthValueAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) interface{} {
+	if interval.End-interval.Start < a.n {
+		return nil
+	}
+	v, err := a.expr.Eval(ctx, buffer[interval.Start+a.n-1])
+	if err != nil {
+		return err
+	}
+	return v
+}
+
 type CountAgg struct {
 	partitionStart, partitionEnd int
 	expr                         sql.Expression
@@ -403,6 +518,8 @@ type CountAgg struct {
 	pos int
 	// peerGroup tracks value increments
 	peerGroup sql.WindowInterval
+	// framed is whether the window has a frame clause, whose frames are counted rather than peer groups
+	framed bool
 }
 
 func NewCountAgg(e sql.Expression) *CountAgg {
@@ -425,6 +542,7 @@ func NewCountDistinctAgg(e sql.Expression) *CountAgg {
 func (a *CountAgg) WithWindow(w *sql.Window) sql.WindowFunction {
 	na := *a
 	na.orderBy = w.OrderBy.ToExpressions()
+	na.framed = w.Frame != nil
 	return &na
 }
 
@@ -455,6 +573,9 @@ func (a *CountAgg) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
 }
 
 func (a *CountAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
+	if a.framed {
+		return int64(computePrefixSum(interval, a.partitionStart, a.prefixSum))
+	}
 	// if a.pos >= a.peerGroup.End, find next peerGroup
 	if a.pos >= a.peerGroup.End {
 		var err error
@@ -548,9 +669,7 @@ func (a *GroupConcatAgg) Compute(ctx *sql.Context, interval sql.WindowInterval,
 			Rows:       rows,
 			Ctx:        ctx,
 		}
-
-		sort.Stable(sorter)
-		if sorter.LastError != nil {
+		if err := sorter.Sort(); err != nil {
 			return nil
 		}
 	}
@@ -666,6 +785,9 @@ func (a *WindowedJSONArrayAgg) NewSlidingFrameInterval(added, dropped sql.Window
 }
 
 func (a *WindowedJSONArrayAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
+	if interval.End-interval.Start < 1 {
+		return nil
+	}
 	res, err := a.aggregateVals(ctx, interval, buf)
 	if err != nil {
 		return nil
@@ -700,6 +822,8 @@ type WindowedJSONObjectAgg struct {
 	j *JSONObjectAgg
 	// we need to eval the partition before Compute to return nil key errors
 	vals map[string]interface{}
+	// framed is whether the window has a frame clause, whose frames are aggregated rather than whole partitions
+	framed bool
 }
 
 func NewWindowedJSONObjectAgg(j *JSONObjectAgg) *WindowedJSONObjectAgg {
@@ -709,7 +833,9 @@ func NewWindowedJSONObjectAgg(j *JSONObjectAgg) *WindowedJSONObjectAgg {
 }
 
 func (a *WindowedJSONObjectAgg) WithWindow(w *sql.Window) sql.WindowFunction {
-	return a
+	na := *a
+	na.framed = w != nil && w.Frame != nil
+	return &na
 }
 
 func (a *WindowedJSONObjectAgg) Dispose() {
@@ -733,10 +859,18 @@ func (a *WindowedJSONObjectAgg) NewSlidingFrameInterval(added, dropped sql.Windo
 }
 
 func (a *WindowedJSONObjectAgg) Compute(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) interface{} {
-	if len(a.vals) == 0 {
+	vals := a.vals
+	if a.framed {
+		var err error
+		vals, err = a.aggregateVals(ctx, interval, buf)
+		if err != nil {
+			return err
+		}
+	}
+	if len(vals) == 0 {
 		return nil
 	}
-	return sql.JSONDocument{Val: a.vals}
+	return sql.JSONDocument{Val: vals}
 }
 
 func (a *WindowedJSONObjectAgg) aggregateVals(ctx *sql.Context, interval sql.WindowInterval, buf sql.WindowBuffer) (map[string]interface{}, error) {
@@ -819,6 +953,59 @@ func (a *RowNumber) Compute(ctx *sql.Context, interval sql.WindowInterval, buffe
 	return a.pos
 }
 
+type Ntile struct {
+	buckets int
+	pos     int
+}
+
+func NewNtile(buckets int) *Ntile {
+	return &Ntile{
+		buckets: buckets,
+		pos:     -1,
+	}
+}
+
+func (a *Ntile) WithWindow(w *sql.Window) sql.WindowFunction {
+	return a
+}
+
+func (a *Ntile) Dispose() {
+	return
+}
+
+// DefaultFramer returns a NewPartitionFramer
+func (a *Ntile) DefaultFramer() sql.WindowFramer {
+	return NewPartitionFramer()
+}
+
+func (a *Ntile) StartPartition(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) error {
+	a.Dispose()
+	a.pos = interval.Start
+	return nil
+}
+
+func (a *Ntile) NewSlidingFrameInterval(added, dropped sql.WindowInterval) {
+	panic("implement me")
+}
+
+// Compute returns the bucket of the current row, the rows of the partition being divided into buckets whose sizes
+// differ by at most one, the larger buckets first.
+// ex: 3 buckets, [a, b, c, d, e, f, g] => 1, 1, 1, 2, 2, 3, 3
+func (a *Ntile) Compute(ctx *sql.Context, interval sql.WindowInterval, buffer sql.WindowBuffer) interface{} {
+	rows := interval.End - interval.Start
+	if rows < 1 {
+		return nil
+	}
+	defer func() { a.pos++ }()
+	i := a.pos - interval.Start
+	size, larger := rows/a.buckets, rows%a.buckets
+	// the first buckets have an extra row each
+	if i < larger*(size+1) {
+		return uint64(i/(size+1) + 1)
+	}
+	return uint64(larger + (i-larger*(size+1))/size + 1)
+}
+
 type PercentRank struct {
 	partitionStart, partitionEnd int
 
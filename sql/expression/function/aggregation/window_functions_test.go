@@ -317,6 +317,25 @@ func TestWindowedAggFuncs(t *testing.T) {
 			),
 			Expected: sql.Row{3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 5, 6, 5, 6},
 		},
+		{
+			Name:     "ntile",
+			Agg:      NewNtile(3),
+			Expected: sql.Row{uint64(1), uint64(1), uint64(2), uint64(3), uint64(1), uint64(1), uint64(2), uint64(3), uint64(1), uint64(1), uint64(2), uint64(2), uint64(3), uint64(3)},
+		},
+		{
+			Name: "last value peer groups",
+			Agg: NewLastValueAgg(expression.NewGetField(1, sql.LongText, "x", true)).WithWindow(
+				sql.NewWindow(nil, sql.SortFields{{Column: expression.NewGetField(5, sql.LongText, "x", true)}}),
+			),
+			Expected: sql.Row{2, 2, 3, 4, 1, 3, 3, 4, 1, 3, 3, 5, 5, 6},
+		},
+		{
+			Name: "nth value peer groups",
+			Agg: NewNthValueAgg(expression.NewGetField(1, sql.LongText, "x", true), 2).WithWindow(
+				sql.NewWindow(nil, sql.SortFields{{Column: expression.NewGetField(5, sql.LongText, "x", true)}}),
+			),
+			Expected: sql.Row{2, 2, 2, 2, nil, 2, 2, 2, nil, 2, 2, 2, 2, 2},
+		},
 		{
 			Name:     "row number",
 			Agg:      NewRowNumber(),
@@ -375,6 +394,10 @@ func TestWindowedAggFuncs(t *testing.T) {
 				require.NoError(t, err)
 				var framer sql.WindowFramer = NewUnboundedPrecedingToCurrentRowFramer()
 				framer = tt.Agg.DefaultFramer().NewFramer(p)
+				if bf, ok := tt.Agg.DefaultFramer().(bufferedFramer); ok {
+					framer, err = bf.NewBufferedFramer(ctx, p, buf)
+					require.NoError(t, err)
+				}
 				for {
 					interval, err := framer.Next()
 					if errors.Is(err, io.EOF) {
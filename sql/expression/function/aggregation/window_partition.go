@@ -45,6 +45,10 @@ func (a *Aggregation) startPartition(ctx *sql.Context, interval sql.WindowInterv
 	if err != nil {
 		return err
 	}
+	if framer, ok := a.framer.(bufferedFramer); ok {
+		a.framer, err = framer.NewBufferedFramer(ctx, interval, buf)
+		return err
+	}
 	a.framer = a.framer.NewFramer(interval)
 	return nil
 }
@@ -181,7 +185,9 @@ func (i *WindowPartitionIter) materializeInput(ctx *sql.Context) (sql.WindowBuff
 		Rows:       input,
 		Ctx:        ctx,
 	}
-	sort.Stable(sorter)
+	if err := sorter.Sort(); err != nil {
+		return nil, nil, err
+	}
 
 	// maintain output sort ordering
 	// TODO: push sort above aggregation, makes this code unnecessarily complex
@@ -325,7 +331,9 @@ func (i *WindowPartitionIter) nextPartition(ctx *sql.Context) error {
 	i.outputOrderingPos = i.currentPartition.Start
 
 	for _, a := range i.w.Aggs {
-		a.startPartition(ctx, i.currentPartition, i.input)
+		if err := a.startPartition(ctx, i.currentPartition, i.input); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -0,0 +1,101 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// Benchmark is a function that evaluates an expression the specified number of times and returns 0. It's used to time
+// expressions, and to make queries take longer.
+type Benchmark struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*Benchmark)(nil)
+
+// NewBenchmark creates a new Benchmark expression.
+func NewBenchmark(count, e sql.Expression) sql.Expression {
+	return &Benchmark{expression.BinaryExpression{Left: count, Right: e}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (b *Benchmark) FunctionName() string {
+	return "benchmark"
+}
+
+// Description implements sql.FunctionExpression
+func (b *Benchmark) Description() string {
+	return "evaluates the expression the specified number of times."
+}
+
+// Eval implements the Expression interface. A NULL or negative count returns NULL, as in MySQL.
+func (b *Benchmark) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	count, err := b.Left.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+
+	if count == nil {
+		return nil, nil
+	}
+
+	count, err = sql.Int64.Convert(count)
+	if err != nil {
+		return nil, err
+	}
+
+	if count.(int64) < 0 {
+		return nil, nil
+	}
+
+	for i := int64(0); i < count.(int64); i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if _, err := b.Right.Eval(ctx, row); err != nil {
+			return nil, err
+		}
+	}
+
+	return int32(0), nil
+}
+
+// String implements the fmt.Stringer interface.
+func (b *Benchmark) String() string {
+	return fmt.Sprintf("BENCHMARK(%s, %s)", b.Left, b.Right)
+}
+
+// IsNullable implements the Expression interface.
+func (b *Benchmark) IsNullable() bool {
+	return true
+}
+
+// WithChildren implements the Expression interface.
+func (b *Benchmark) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(b, len(children), 2)
+	}
+	return NewBenchmark(children[0], children[1]), nil
+}
+
+// Type implements the Expression interface.
+func (b *Benchmark) Type() sql.Type {
+	return sql.Int32
+}
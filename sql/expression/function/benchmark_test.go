@@ -0,0 +1,77 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+type countingExpression struct {
+	*expression.Literal
+	evals int
+}
+
+func (e *countingExpression) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	e.evals++
+	return e.Literal.Eval(ctx, row)
+}
+
+func TestBenchmark(t *testing.T) {
+	testCases := []struct {
+		name     string
+		count    interface{}
+		expected interface{}
+		evals    int
+		err      bool
+	}{
+		{"null count", nil, nil, 0, false},
+		{"negative count", -1, nil, 0, false},
+		{"zero count", 0, int32(0), 0, false},
+		{"positive count", 5, int32(0), 5, false},
+		{"string count", "foo", nil, 0, true},
+	}
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			e := &countingExpression{Literal: expression.NewLiteral("abc", sql.LongText)}
+			f := NewBenchmark(expression.NewGetField(0, sql.LongText, "n", true), e)
+
+			v, err := f.Eval(sql.NewEmptyContext(), sql.NewRow(tt.count))
+			if tt.err {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.expected, v)
+			require.Equal(tt.evals, e.evals)
+		})
+	}
+}
+
+func TestBenchmarkCanceled(t *testing.T) {
+	require := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	f := NewBenchmark(expression.NewLiteral(int64(1000000000), sql.Int64), expression.NewLiteral(1, sql.Int64))
+	_, err := f.Eval(sql.NewContext(ctx), nil)
+	require.Equal(context.Canceled, err)
+}
@@ -19,6 +19,8 @@ import (
 	"math"
 	"reflect"
 
+	"github.com/shopspring/decimal"
+
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 )
@@ -45,6 +47,11 @@ func (c *Ceil) Description() string {
 	return "returns the smallest integer value that is greater than or equal to number."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (c *Ceil) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Type implements the Expression interface.
 func (c *Ceil) Type() sql.Type {
 	childType := c.Child.Type()
@@ -123,6 +130,11 @@ func (f *Floor) Description() string {
 	return "returns the largest integer value that is less than or equal to number."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (f *Floor) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Type implements the Expression interface.
 func (f *Floor) Type() sql.Type {
 	childType := f.Child.Type()
@@ -214,6 +226,11 @@ func (r *Round) Description() string {
 	return "rounds the number to decimals decimal places."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (r *Round) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Children implements the Expression interface.
 func (r *Round) Children() []sql.Expression {
 	if r.Right == nil {
@@ -345,3 +362,140 @@ func (r *Round) Type() sql.Type {
 func (r *Round) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	return NewRound(children...)
 }
+
+// Truncate returns the number (x) truncated to (d) decimal places. If d is negative, the (abs(d)) least significant
+// digits of the integer part of the number are set to 0. Integers and decimals are truncated exactly, while floats are
+// multiplied by a power of 10 and truncated, as MySQL does, so TRUNCATE(10.28*100, 0) is 1027. It returns NULL if x or
+// d is NULL.
+type Truncate struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*Truncate)(nil)
+
+// NewTruncate returns a new Truncate expression.
+func NewTruncate(x, d sql.Expression) sql.Expression {
+	return &Truncate{expression.BinaryExpression{Left: x, Right: d}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (t *Truncate) FunctionName() string {
+	return "truncate"
+}
+
+// Description implements sql.FunctionExpression
+func (t *Truncate) Description() string {
+	return "truncates the number to decimals decimal places."
+}
+
+// Eval implements the Expression interface.
+func (t *Truncate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	xVal, err := t.Left.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if xVal == nil {
+		return nil, nil
+	}
+
+	dVal, err := t.Right.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if dVal == nil {
+		return nil, nil
+	}
+	dVal, err = sql.Int64.Convert(dVal)
+	if err != nil {
+		return nil, err
+	}
+	d := dVal.(int64)
+
+	if !sql.IsNumber(t.Left.Type()) {
+		xVal, err = sql.Float64.Convert(xVal)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch x := xVal.(type) {
+	case float64:
+		return truncateFloat(x, d), nil
+	case float32:
+		return float32(truncateFloat(float64(x), d)), nil
+	case decimal.Decimal:
+		if d >= 0 {
+			return x.Truncate(int32(d)), nil
+		}
+		return x.Shift(int32(d)).Truncate(0).Shift(int32(-d)), nil
+	case uint64:
+		if d >= 0 {
+			return x, nil
+		}
+		if d < -19 {
+			return uint64(0), nil
+		}
+		pow := uint64(math.Pow10(int(-d)))
+		return x / pow * pow, nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32:
+		if d >= 0 {
+			return x, nil
+		}
+		i, err := sql.Int64.Convert(x)
+		if err != nil {
+			return nil, err
+		}
+		truncated := int64(0)
+		if d >= -18 {
+			pow := int64(math.Pow10(int(-d)))
+			truncated = i.(int64) / pow * pow
+		}
+		return t.Left.Type().Convert(truncated)
+	default:
+		return nil, sql.ErrInvalidType.New(t.Left.Type().String())
+	}
+}
+
+// truncateFloat truncates a float to d decimal places in the same steps as MySQL, so that results match even when
+// floats can't represent the number exactly.
+func truncateFloat(x float64, d int64) float64 {
+	pow := math.Pow(10, math.Abs(float64(d)))
+	if d < 0 {
+		if math.IsInf(pow, 0) {
+			return 0
+		}
+		return math.Trunc(x/pow) * pow
+	}
+
+	mul := x * pow
+	if math.IsInf(mul, 0) || math.IsNaN(mul) {
+		return x
+	}
+	return math.Trunc(mul) / pow
+}
+
+// IsNullable implements the Expression interface.
+func (t *Truncate) IsNullable() bool {
+	return t.Left.IsNullable() || t.Right.IsNullable()
+}
+
+func (t *Truncate) String() string {
+	return fmt.Sprintf("TRUNCATE(%s, %s)", t.Left, t.Right)
+}
+
+// Type implements the Expression interface.
+func (t *Truncate) Type() sql.Type {
+	leftChildType := t.Left.Type()
+	if sql.IsNumber(leftChildType) {
+		return leftChildType
+	}
+	return sql.Float64
+}
+
+// WithChildren implements the Expression interface.
+func (t *Truncate) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 2)
+	}
+	return NewTruncate(children[0], children[1]), nil
+}
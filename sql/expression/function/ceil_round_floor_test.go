@@ -17,6 +17,7 @@ package function
 import (
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -281,3 +282,48 @@ func TestRound(t *testing.T) {
 	req.NoError(err)
 	req.Equal(int32(0), result)
 }
+
+func TestTruncate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		xType    sql.Type
+		x        interface{}
+		d        interface{}
+		expected interface{}
+	}{
+		{"x is nil", sql.Float64, nil, 1, nil},
+		{"d is nil", sql.Float64, 1.223, nil, nil},
+		{"float64 with d", sql.Float64, 1.223, 1, 1.2},
+		{"float64 doesn't round", sql.Float64, 1.999, 1, 1.9},
+		{"float64 with zero d", sql.Float64, 1.999, 0, float64(1)},
+		{"negative float64", sql.Float64, -1.999, 1, -1.9},
+		{"float64 with negative d", sql.Float64, 122.5, -2, float64(100)},
+		{"float64 with large negative d", sql.Float64, 122.5, -400, float64(0)},
+		{"float32 with d", sql.Float32, float32(1.25), 1, float32(1.2)},
+		{"int64 with d", sql.Int64, int64(122), 2, int64(122)},
+		{"int64 with negative d", sql.Int64, int64(122), -2, int64(100)},
+		{"negative int64 with negative d", sql.Int64, int64(-122), -1, int64(-120)},
+		{"int32 with negative d", sql.Int32, int32(122), -1, int32(120)},
+		{"int64 with large negative d", sql.Int64, int64(122), -30, int64(0)},
+		{"uint64 with negative d", sql.Uint64, uint64(18446744073709551615), -3, uint64(18446744073709551000)},
+		{"text", sql.Text, "1.999", 2, 1.99},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewTruncate(expression.NewLiteral(tt.x, tt.xType), expression.NewLiteral(tt.d, sql.Int32))
+			require.Equal(t, tt.expected, eval(t, f, nil))
+		})
+	}
+
+	t.Run("decimal", func(t *testing.T) {
+		require := require.New(t)
+		x := expression.NewLiteral(decimal.RequireFromString("1234.5678"), sql.MustCreateDecimalType(8, 4))
+
+		result := eval(t, NewTruncate(x, expression.NewLiteral(2, sql.Int32)), nil)
+		require.Equal("1234.56", result.(decimal.Decimal).String())
+
+		result = eval(t, NewTruncate(x, expression.NewLiteral(-2, sql.Int32)), nil)
+		require.Equal("1200", result.(decimal.Decimal).String())
+	})
+}
@@ -0,0 +1,220 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// compressedLengthSize is the size of the length of the uncompressed string that starts compressed strings.
+const compressedLengthSize = 4
+
+// Compress function compresses a string with zlib, in the format of MySQL: the length of the string as four bytes, low
+// byte first, followed by the compressed string, and by a '.' if the result would otherwise end with a space. The empty
+// string is returned as it is. It returns NULL if the string is NULL.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_compress
+type Compress struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Compress)(nil)
+
+// NewCompress returns a new COMPRESS function expression
+func NewCompress(arg sql.Expression) sql.Expression {
+	return &Compress{NewUnaryFunc(arg, "COMPRESS", sql.LongBlob)}
+}
+
+// Description implements sql.FunctionExpression
+func (f *Compress) Description() string {
+	return "compresses a string and returns the result as a binary string."
+}
+
+// Eval implements sql.Expression
+func (f *Compress) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	arg, err := f.EvalChild(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if arg == nil {
+		return nil, nil
+	}
+
+	val, err := sql.LongText.Convert(arg)
+	if err != nil {
+		return nil, err
+	}
+	str := val.(string)
+	if str == "" {
+		return "", nil
+	}
+
+	var buf bytes.Buffer
+	var length [compressedLengthSize]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(len(str)))
+	buf.Write(length[:])
+
+	w := zlib.NewWriter(&buf)
+	if _, err := io.WriteString(w, str); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	if buf.Bytes()[buf.Len()-1] == ' ' {
+		buf.WriteByte('.')
+	}
+	return buf.String(), nil
+}
+
+// WithChildren implements sql.Expression
+func (f *Compress) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewCompress(children[0]), nil
+}
+
+// Uncompress function uncompresses a string compressed by COMPRESS. The empty string is returned as it is. It returns
+// NULL, with a warning, if the string isn't a compressed string, or if it would be longer than max_allowed_packet once
+// uncompressed. It returns NULL if the string is NULL.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_uncompress
+type Uncompress struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*Uncompress)(nil)
+
+// NewUncompress returns a new UNCOMPRESS function expression
+func NewUncompress(arg sql.Expression) sql.Expression {
+	return &Uncompress{NewUnaryFunc(arg, "UNCOMPRESS", sql.LongBlob)}
+}
+
+// Description implements sql.FunctionExpression
+func (f *Uncompress) Description() string {
+	return "uncompresses a string compressed by COMPRESS."
+}
+
+// Eval implements sql.Expression
+func (f *Uncompress) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	arg, err := f.EvalChild(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if arg == nil {
+		return nil, nil
+	}
+
+	val, err := sql.LongText.Convert(arg)
+	if err != nil {
+		return nil, err
+	}
+	str := val.(string)
+	if str == "" {
+		return "", nil
+	}
+	if len(str) <= compressedLengthSize {
+		ctx.Warn(1259, "ZLIB: Input data corrupted")
+		return nil, nil
+	}
+
+	maxPacket, err := ctx.Session.GetSessionVariable(ctx, "max_allowed_packet")
+	if err != nil {
+		return nil, err
+	}
+	length := int64(binary.LittleEndian.Uint32([]byte(str[:compressedLengthSize])))
+	if length > maxPacket.(int64) {
+		ctx.Warn(1256, fmt.Sprintf("Uncompressed data size too large; the maximum size is %d (probably, length of uncompressed data was corrupted)", maxPacket))
+		return nil, nil
+	}
+
+	r, err := zlib.NewReader(bytes.NewReader([]byte(str[compressedLengthSize:])))
+	if err != nil {
+		ctx.Warn(1259, "ZLIB: Input data corrupted")
+		return nil, nil
+	}
+	defer r.Close()
+
+	out, err := ioutil.ReadAll(io.LimitReader(r, length+1))
+	if err != nil || int64(len(out)) != length {
+		ctx.Warn(1259, "ZLIB: Input data corrupted")
+		return nil, nil
+	}
+	return string(out), nil
+}
+
+// WithChildren implements sql.Expression
+func (f *Uncompress) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewUncompress(children[0]), nil
+}
+
+// UncompressedLength function returns the length of a string compressed by COMPRESS once uncompressed, as stored at
+// the start of the compressed string, without uncompressing it. The empty string has a length of zero. It returns NULL
+// if the string is NULL.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_uncompressed-length
+type UncompressedLength struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*UncompressedLength)(nil)
+
+// NewUncompressedLength returns a new UNCOMPRESSED_LENGTH function expression
+func NewUncompressedLength(arg sql.Expression) sql.Expression {
+	return &UncompressedLength{NewUnaryFunc(arg, "UNCOMPRESSED_LENGTH", sql.Uint32)}
+}
+
+// Description implements sql.FunctionExpression
+func (f *UncompressedLength) Description() string {
+	return "returns the length of a string before compression."
+}
+
+// Eval implements sql.Expression
+func (f *UncompressedLength) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	arg, err := f.EvalChild(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if arg == nil {
+		return nil, nil
+	}
+
+	val, err := sql.LongText.Convert(arg)
+	if err != nil {
+		return nil, err
+	}
+	str := val.(string)
+	if len(str) < compressedLengthSize {
+		return uint32(0), nil
+	}
+	return binary.LittleEndian.Uint32([]byte(str[:compressedLengthSize])), nil
+}
+
+// WithChildren implements sql.Expression
+func (f *UncompressedLength) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewUncompressedLength(children[0]), nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestCompress(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input interface{}
+	}{
+		{"string", "hello world"},
+		{"repeated string", strings.Repeat("a", 1000)},
+		{"trailing space", "text that ends with a space "},
+		{"binary", "\x00\x01\x02\xff"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+
+			compressed := eval(t, NewCompress(expression.NewLiteral(tt.input, sql.LongText)), nil)
+			require.NotEqual(byte(' '), compressed.(string)[len(compressed.(string))-1])
+
+			lit := expression.NewLiteral(compressed, sql.LongBlob)
+			require.Equal(uint32(len(tt.input.(string))), eval(t, NewUncompressedLength(lit), nil))
+			require.Equal(tt.input, eval(t, NewUncompress(lit), nil))
+		})
+	}
+}
+
+func TestCompressEmptyAndNull(t *testing.T) {
+	require := require.New(t)
+
+	empty := expression.NewLiteral("", sql.LongText)
+	null := expression.NewLiteral(nil, sql.Null)
+
+	require.Equal("", eval(t, NewCompress(empty), nil))
+	require.Equal("", eval(t, NewUncompress(empty), nil))
+	require.Equal(uint32(0), eval(t, NewUncompressedLength(empty), nil))
+	require.Nil(eval(t, NewCompress(null), nil))
+	require.Nil(eval(t, NewUncompress(null), nil))
+	require.Nil(eval(t, NewUncompressedLength(null), nil))
+}
+
+func TestUncompressInvalid(t *testing.T) {
+	require := require.New(t)
+
+	for _, input := range []string{"abc", "not a compressed string", "\xff\xff\xff\x7fabcdef"} {
+		ctx := sql.NewEmptyContext()
+		val, err := NewUncompress(expression.NewLiteral(input, sql.LongBlob)).Eval(ctx, nil)
+		require.NoError(err)
+		require.Nil(val)
+		require.Equal(uint16(1), ctx.WarningCount())
+	}
+}
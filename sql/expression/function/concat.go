@@ -65,6 +65,11 @@ func (c *Concat) Description() string {
 	return "concatenates any group of fields into a single string."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (c *Concat) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Type implements the Expression interface.
 func (f *Concat) Type() sql.Type { return sql.LongText }
 
@@ -0,0 +1,211 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Conv function converts a number from one base to another, and returns it as a string. Bases go from 2 to 36. If
+// from_base is negative, the number is read as a signed number, and otherwise as an unsigned one. If to_base is
+// negative, the number is written as a signed number, and otherwise as an unsigned one, so that CONV(-1, 10, 16) is
+// FFFFFFFFFFFFFFFF. The number is read up to the first character that isn't a digit of from_base, and numbers too large
+// for 64 bits are read as the largest number that fits. It returns NULL if any argument is NULL, or if a base is out of
+// range.
+// https://dev.mysql.com/doc/refman/8.0/en/mathematical-functions.html#function_conv
+type Conv struct {
+	N        sql.Expression
+	FromBase sql.Expression
+	ToBase   sql.Expression
+}
+
+var _ sql.FunctionExpression = (*Conv)(nil)
+
+// NewConv returns a new Conv expression.
+func NewConv(n, fromBase, toBase sql.Expression) sql.Expression {
+	return &Conv{N: n, FromBase: fromBase, ToBase: toBase}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (c *Conv) FunctionName() string {
+	return "conv"
+}
+
+// Description implements sql.FunctionExpression
+func (c *Conv) Description() string {
+	return "converts numbers between different number bases."
+}
+
+// Type implements the Expression interface.
+func (c *Conv) Type() sql.Type { return sql.LongText }
+
+// IsNullable implements the Expression interface.
+func (c *Conv) IsNullable() bool {
+	return true
+}
+
+func (c *Conv) String() string {
+	return fmt.Sprintf("CONV(%s, %s, %s)", c.N, c.FromBase, c.ToBase)
+}
+
+// Eval implements the Expression interface.
+func (c *Conv) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	n, err := c.N.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if n == nil {
+		return nil, nil
+	}
+
+	fromBase, err := evalConvBase(ctx, c.FromBase, row)
+	if err != nil || fromBase == 0 {
+		return nil, err
+	}
+	toBase, err := evalConvBase(ctx, c.ToBase, row)
+	if err != nil || toBase == 0 {
+		return nil, err
+	}
+
+	n, err = sql.LongText.Convert(n)
+	if err != nil {
+		return nil, err
+	}
+
+	val := parseConvNumber(n.(string), fromBase)
+	var result string
+	if toBase < 0 && int64(val) < 0 {
+		result = "-" + strconv.FormatUint(uint64(-int64(val)), -toBase)
+	} else {
+		result = strconv.FormatUint(val, absInt(toBase))
+	}
+	return strings.ToUpper(result), nil
+}
+
+// evalConvBase evaluates a base of CONV, and returns 0 if it's NULL or out of range.
+func evalConvBase(ctx *sql.Context, e sql.Expression, row sql.Row) (int, error) {
+	val, err := e.Eval(ctx, row)
+	if err != nil || val == nil {
+		return 0, err
+	}
+	val, err = sql.Int64.Convert(val)
+	if err != nil {
+		return 0, err
+	}
+
+	base := val.(int64)
+	if base < 0 {
+		base = -base
+	}
+	if base < 2 || base > 36 {
+		return 0, nil
+	}
+	return int(val.(int64)), nil
+}
+
+// parseConvNumber reads a number written in the base given, which is negative for signed numbers. Leading spaces are
+// skipped, and the number ends at the first character that isn't a digit of the base. Numbers that don't fit in 64
+// bits are clamped to the largest or smallest number that does.
+func parseConvNumber(s string, base int) uint64 {
+	signed := base < 0
+	base = absInt(base)
+
+	s = strings.TrimLeft(s, " \t\n")
+	negative := false
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	var val uint64
+	overflow := false
+	for _, r := range s {
+		digit := convDigit(r)
+		if digit < 0 || digit >= base {
+			break
+		}
+		if val > (math.MaxUint64-uint64(digit))/uint64(base) {
+			overflow = true
+			break
+		}
+		val = val*uint64(base) + uint64(digit)
+	}
+
+	if signed {
+		if negative {
+			if overflow || val > uint64(math.MaxInt64)+1 {
+				return uint64(1) << 63
+			}
+			return uint64(-int64(val))
+		}
+		if overflow || val > math.MaxInt64 {
+			return math.MaxInt64
+		}
+		return val
+	}
+
+	if overflow {
+		return math.MaxUint64
+	}
+	if negative {
+		return -val
+	}
+	return val
+}
+
+// convDigit returns the value of a digit of a base up to 36, or -1 if the rune isn't one.
+func convDigit(r rune) int {
+	switch {
+	case r >= '0' && r <= '9':
+		return int(r - '0')
+	case r >= 'a' && r <= 'z':
+		return int(r-'a') + 10
+	case r >= 'A' && r <= 'Z':
+		return int(r-'A') + 10
+	default:
+		return -1
+	}
+}
+
+// absInt returns the absolute value of an int.
+func absInt(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
+
+// Resolved implements the Expression interface.
+func (c *Conv) Resolved() bool {
+	return c.N.Resolved() && c.FromBase.Resolved() && c.ToBase.Resolved()
+}
+
+// Children implements the Expression interface.
+func (c *Conv) Children() []sql.Expression {
+	return []sql.Expression{c.N, c.FromBase, c.ToBase}
+}
+
+// WithChildren implements the Expression interface.
+func (c *Conv) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 3 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 3)
+	}
+	return NewConv(children[0], children[1], children[2]), nil
+}
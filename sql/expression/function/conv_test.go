@@ -0,0 +1,62 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestConv(t *testing.T) {
+	testCases := []struct {
+		name     string
+		n        interface{}
+		fromBase interface{}
+		toBase   interface{}
+		expected interface{}
+	}{
+		{"hex to binary", "a", 16, 2, "1010"},
+		{"base 18 to octal", "6E", 18, 8, "172"},
+		{"number", 255, 10, 16, "FF"},
+		{"base 36", "zz", 36, 10, "1295"},
+		{"signed output", -17, 10, -18, "-H"},
+		{"unsigned output of negative number", -1, 10, 16, "FFFFFFFFFFFFFFFF"},
+		{"signed input", "-10", -10, 10, "18446744073709551606"},
+		{"stops at invalid digit", "12z4", 10, 10, "12"},
+		{"no digits", "z", 10, 10, "0"},
+		{"overflow", "99999999999999999999", 10, 10, "18446744073709551615"},
+		{"signed overflow", "99999999999999999999", -10, -10, "9223372036854775807"},
+		{"n is nil", nil, 10, 2, nil},
+		{"from_base is nil", "10", nil, 2, nil},
+		{"to_base is nil", "10", 10, nil, nil},
+		{"from_base out of range", "10", 1, 2, nil},
+		{"to_base out of range", "10", 10, 37, nil},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewConv(
+				expression.NewLiteral(tt.n, sql.LongText),
+				expression.NewLiteral(tt.fromBase, sql.Int32),
+				expression.NewLiteral(tt.toBase, sql.Int32),
+			)
+			require.Equal(t, tt.expected, eval(t, f, nil))
+		})
+	}
+}
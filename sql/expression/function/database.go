@@ -42,6 +42,11 @@ func (db *Database) Description() string {
 	return "returns the default (current) database name."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (db *Database) Volatility() sql.FunctionVolatility {
+	return sql.Stable
+}
+
 // Type implements the sql.Expression (sql.LongText)
 func (db *Database) Type() sql.Type { return sql.LongText }
 
@@ -69,8 +69,14 @@ func (d *DateAdd) IsNullable() bool {
 	return true
 }
 
-// Type implements the sql.Expression interface.
-func (d *DateAdd) Type() sql.Type { return sql.Date }
+// Type implements the sql.Expression interface. The result is a datetime if
+// the date is one, or if the interval has a time part, and a date otherwise.
+func (d *DateAdd) Type() sql.Type {
+	if t := d.Date.Type(); d.Interval.HasTimePart() || (sql.IsTime(t) && t != sql.Date) {
+		return sql.Datetime
+	}
+	return sql.Date
+}
 
 // WithChildren implements the Expression interface.
 func (d *DateAdd) WithChildren(children ...sql.Expression) (sql.Expression, error) {
@@ -88,11 +94,6 @@ func (d *DateAdd) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	date, err = sql.Datetime.Convert(date)
-	if err != nil {
-		return nil, err
-	}
-
 	delta, err := d.Interval.EvalDelta(ctx, row)
 	if err != nil {
 		return nil, err
@@ -102,7 +103,7 @@ func (d *DateAdd) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	return sql.ValidateTime(delta.Add(date.(time.Time))), nil
+	return expression.AddInterval(ctx, date, delta, false), nil
 }
 
 func (d *DateAdd) String() string {
@@ -156,8 +157,14 @@ func (d *DateSub) IsNullable() bool {
 	return true
 }
 
-// Type implements the sql.Expression interface.
-func (d *DateSub) Type() sql.Type { return sql.Date }
+// Type implements the sql.Expression interface. The result is a datetime if
+// the date is one, or if the interval has a time part, and a date otherwise.
+func (d *DateSub) Type() sql.Type {
+	if t := d.Date.Type(); d.Interval.HasTimePart() || (sql.IsTime(t) && t != sql.Date) {
+		return sql.Datetime
+	}
+	return sql.Date
+}
 
 // WithChildren implements the Expression interface.
 func (d *DateSub) WithChildren(children ...sql.Expression) (sql.Expression, error) {
@@ -175,11 +182,6 @@ func (d *DateSub) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	date, err = sql.Datetime.Convert(date)
-	if err != nil {
-		return nil, err
-	}
-
 	delta, err := d.Interval.EvalDelta(ctx, row)
 	if err != nil {
 		return nil, err
@@ -189,7 +191,7 @@ func (d *DateSub) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return nil, nil
 	}
 
-	return sql.ValidateTime(delta.Sub(date.(time.Time))), nil
+	return expression.AddInterval(ctx, date, delta, true), nil
 }
 
 func (d *DateSub) String() string {
@@ -345,6 +347,11 @@ func (ut *UnixTimestamp) Description() string {
 	return "returns the datetime argument to the number of seconds since the Unix epoch. With no argument, returns the number of seconds since the Unix epoch for the current time."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (ut *UnixTimestamp) Volatility() sql.FunctionVolatility {
+	return sql.Stable
+}
+
 func (ut *UnixTimestamp) Children() []sql.Expression {
 	if ut.Date != nil {
 		return []sql.Expression{ut.Date}
@@ -461,6 +468,11 @@ func (c CurrDate) Description() string {
 	return "returns the current date."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (c CurrDate) Volatility() sql.FunctionVolatility {
+	return sql.Stable
+}
+
 func NewCurrDate() sql.Expression {
 	return CurrDate{
 		NoArgFunc: NoArgFunc{"curdate", sql.LongText},
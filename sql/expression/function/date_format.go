@@ -265,6 +265,11 @@ func (f *DateFormat) Description() string {
 	return "format date as specified."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (f *DateFormat) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // NewDateFormat returns a new DateFormat UDF
 func NewDateFormat(ex, value sql.Expression) sql.Expression {
 	return &DateFormat{
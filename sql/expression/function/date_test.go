@@ -57,8 +57,29 @@ func TestDateAdd(t *testing.T) {
 	require.NoError(err)
 	require.Nil(result)
 
-	_, err = f.Eval(ctx, sql.Row{"asdasdasd"})
-	require.Error(err)
+	result, err = f.Eval(ctx, sql.Row{"asdasdasd"})
+	require.NoError(err)
+	require.Nil(result)
+	require.Equal(1292, ctx.Warnings()[0].Code)
+
+	result, err = f.Eval(ctx, sql.Row{"9999-12-31"})
+	require.NoError(err)
+	require.Nil(result)
+	require.Equal(1441, ctx.Warnings()[0].Code)
+	require.Equal(sql.Date, f.Type())
+
+	f, err = NewDateAdd(expression.NewGetField(0, sql.Text, "foo", false),
+		expression.NewInterval(
+			expression.NewLiteral("1:30", sql.LongText),
+			"HOUR_MINUTE",
+		),
+	)
+	require.NoError(err)
+	require.Equal(sql.Datetime, f.Type())
+
+	result, err = f.Eval(ctx, sql.Row{"2018-05-02 23:00:00"})
+	require.NoError(err)
+	require.Equal(time.Date(2018, time.May, 3, 0, 30, 0, 0, time.UTC), result)
 }
 
 func TestDateSub(t *testing.T) {
@@ -94,8 +115,27 @@ func TestDateSub(t *testing.T) {
 	require.NoError(err)
 	require.Nil(result)
 
-	_, err = f.Eval(ctx, sql.Row{"asdasdasd"})
-	require.Error(err)
+	result, err = f.Eval(ctx, sql.Row{"asdasdasd"})
+	require.NoError(err)
+	require.Nil(result)
+	require.Equal(1292, ctx.Warnings()[0].Code)
+
+	result, err = f.Eval(ctx, sql.Row{"0000-01-01"})
+	require.NoError(err)
+	require.Nil(result)
+	require.Equal(1441, ctx.Warnings()[0].Code)
+
+	f, err = NewDateSub(expression.NewGetField(0, sql.Text, "foo", false),
+		expression.NewInterval(
+			expression.NewLiteral("-1 1", sql.LongText),
+			"DAY_HOUR",
+		),
+	)
+	require.NoError(err)
+
+	result, err = f.Eval(ctx, sql.Row{"2018-05-02"})
+	require.NoError(err)
+	require.Equal(time.Date(2018, time.May, 3, 1, 0, 0, 0, time.UTC), result)
 }
 
 func TestUnixTimestamp(t *testing.T) {
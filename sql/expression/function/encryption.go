@@ -0,0 +1,437 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"strings"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ErrInvalidBlockEncryptionMode is returned when the block_encryption_mode system variable isn't a valid mode.
+var ErrInvalidBlockEncryptionMode = errors.NewKind("Variable 'block_encryption_mode' can't be set to the value of '%s'")
+
+// ErrMissingInitVector is returned when a block mode that needs an initialization vector is used without one.
+var ErrMissingInitVector = errors.NewKind("Incorrect parameter count in the call to native function '%s'")
+
+// ErrInitVectorTooShort is returned when the initialization vector given is shorter than the AES block size.
+var ErrInitVectorTooShort = errors.NewKind("The initialization vector supplied to %s is too short. Must be at least %d bytes long")
+
+// ErrRandomBytesLength is returned when RANDOM_BYTES is called with a length outside of 1 to 1024.
+var ErrRandomBytesLength = errors.NewKind("length value is out of range in 'random_bytes'")
+
+// maxRandomBytes is the largest length accepted by RANDOM_BYTES.
+const maxRandomBytes = 1024
+
+// blockEncryptionMode is a key size and block mode of AES, as set by the block_encryption_mode system variable.
+type blockEncryptionMode struct {
+	keyBits int
+	mode    string
+}
+
+// parseBlockEncryptionMode parses a block_encryption_mode value of the form aes-keylen-mode, such as aes-256-cbc.
+func parseBlockEncryptionMode(val string) (blockEncryptionMode, error) {
+	parts := strings.Split(strings.ToLower(val), "-")
+	if len(parts) != 3 || parts[0] != "aes" {
+		return blockEncryptionMode{}, ErrInvalidBlockEncryptionMode.New(val)
+	}
+
+	var m blockEncryptionMode
+	switch parts[1] {
+	case "128":
+		m.keyBits = 128
+	case "192":
+		m.keyBits = 192
+	case "256":
+		m.keyBits = 256
+	default:
+		return blockEncryptionMode{}, ErrInvalidBlockEncryptionMode.New(val)
+	}
+
+	switch parts[2] {
+	case "ecb", "cbc", "cfb1", "cfb8", "cfb128", "ofb":
+		m.mode = parts[2]
+	default:
+		return blockEncryptionMode{}, ErrInvalidBlockEncryptionMode.New(val)
+	}
+	return m, nil
+}
+
+// needsInitVector returns whether the mode needs an initialization vector, which every mode but ECB does.
+func (m blockEncryptionMode) needsInitVector() bool {
+	return m.mode != "ecb"
+}
+
+// padded returns whether the mode pads data to a multiple of the block size, which ECB and CBC do.
+func (m blockEncryptionMode) padded() bool {
+	return m.mode == "ecb" || m.mode == "cbc"
+}
+
+// aesKey folds the key given into a key of the size of the mode, as MySQL does: the bytes of the key are XORed into a
+// zeroed buffer, going back to its start every time its end is reached.
+func (m blockEncryptionMode) aesKey(key []byte) []byte {
+	folded := make([]byte, m.keyBits/8)
+	for i, b := range key {
+		folded[i%len(folded)] ^= b
+	}
+	return folded
+}
+
+// aesFunc is the common implementation of AES_ENCRYPT and AES_DECRYPT, which take the same arguments.
+type aesFunc struct {
+	name    string
+	str     sql.Expression
+	key     sql.Expression
+	initVec sql.Expression
+}
+
+func newAESFunc(name string, args ...sql.Expression) (aesFunc, error) {
+	switch len(args) {
+	case 2:
+		return aesFunc{name: name, str: args[0], key: args[1]}, nil
+	case 3:
+		return aesFunc{name: name, str: args[0], key: args[1], initVec: args[2]}, nil
+	default:
+		return aesFunc{}, sql.ErrInvalidArgumentNumber.New(strings.ToUpper(name), "2 or 3", len(args))
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (f aesFunc) FunctionName() string {
+	return f.name
+}
+
+// Type implements sql.Expression
+func (f aesFunc) Type() sql.Type {
+	return sql.LongBlob
+}
+
+// IsNullable implements sql.Expression
+func (f aesFunc) IsNullable() bool {
+	return true
+}
+
+// Resolved implements sql.Expression
+func (f aesFunc) Resolved() bool {
+	for _, e := range f.Children() {
+		if !e.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// Children implements sql.Expression
+func (f aesFunc) Children() []sql.Expression {
+	if f.initVec == nil {
+		return []sql.Expression{f.str, f.key}
+	}
+	return []sql.Expression{f.str, f.key, f.initVec}
+}
+
+func (f aesFunc) String() string {
+	if f.initVec == nil {
+		return fmt.Sprintf("%s(%s, %s)", strings.ToUpper(f.name), f.str, f.key)
+	}
+	return fmt.Sprintf("%s(%s, %s, %s)", strings.ToUpper(f.name), f.str, f.key, f.initVec)
+}
+
+// evalArgs evaluates the string, the key and the initialization vector, and returns the block mode and the cipher to
+// use. It returns a nil block, without an error, if any of the arguments is NULL.
+func (f aesFunc) evalArgs(ctx *sql.Context, row sql.Row) (str []byte, block cipher.Block, iv []byte, mode blockEncryptionMode, err error) {
+	modeVal, err := ctx.Session.GetSessionVariable(ctx, "block_encryption_mode")
+	if err != nil {
+		return nil, nil, nil, mode, err
+	}
+	mode, err = parseBlockEncryptionMode(fmt.Sprint(modeVal))
+	if err != nil {
+		return nil, nil, nil, mode, err
+	}
+
+	if mode.needsInitVector() && f.initVec == nil {
+		return nil, nil, nil, mode, ErrMissingInitVector.New(f.name)
+	}
+
+	var args [][]byte
+	for _, e := range f.Children() {
+		val, err := e.Eval(ctx, row)
+		if err != nil {
+			return nil, nil, nil, mode, err
+		}
+		if val == nil {
+			return nil, nil, nil, mode, nil
+		}
+		val, err = sql.LongText.Convert(val)
+		if err != nil {
+			return nil, nil, nil, mode, err
+		}
+		args = append(args, []byte(val.(string)))
+	}
+
+	if mode.needsInitVector() {
+		iv = args[2]
+		if len(iv) < aes.BlockSize {
+			return nil, nil, nil, mode, ErrInitVectorTooShort.New(f.name, aes.BlockSize)
+		}
+		iv = iv[:aes.BlockSize]
+	} else if f.initVec != nil {
+		ctx.Warn(1618, "<IV> option ignored")
+	}
+
+	block, err = aes.NewCipher(mode.aesKey(args[1]))
+	if err != nil {
+		return nil, nil, nil, mode, err
+	}
+	return args[0], block, iv, mode, nil
+}
+
+// AESEncrypt function encrypts a string with AES, using the key size and block mode of the block_encryption_mode
+// system variable. Every mode but ECB needs an initialization vector of at least 16 bytes as its third argument. It
+// returns NULL if any argument is NULL.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_aes-encrypt
+type AESEncrypt struct {
+	aesFunc
+}
+
+var _ sql.FunctionExpression = (*AESEncrypt)(nil)
+
+// NewAESEncrypt returns a new AES_ENCRYPT function expression
+func NewAESEncrypt(args ...sql.Expression) (sql.Expression, error) {
+	f, err := newAESFunc("aes_encrypt", args...)
+	if err != nil {
+		return nil, err
+	}
+	return &AESEncrypt{f}, nil
+}
+
+// Description implements sql.FunctionExpression
+func (f *AESEncrypt) Description() string {
+	return "encrypts a string using AES."
+}
+
+// Eval implements sql.Expression
+func (f *AESEncrypt) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	str, block, iv, mode, err := f.evalArgs(ctx, row)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	if mode.padded() {
+		padding := aes.BlockSize - len(str)%aes.BlockSize
+		str = append(str, bytes.Repeat([]byte{byte(padding)}, padding)...)
+	}
+
+	out := make([]byte, len(str))
+	switch mode.mode {
+	case "ecb":
+		for i := 0; i < len(str); i += aes.BlockSize {
+			block.Encrypt(out[i:], str[i:])
+		}
+	case "cbc":
+		cipher.NewCBCEncrypter(block, iv).CryptBlocks(out, str)
+	case "cfb1":
+		out = cfbSegments(block, iv, str, 1, false)
+	case "cfb8":
+		out = cfbSegments(block, iv, str, 8, false)
+	case "cfb128":
+		cipher.NewCFBEncrypter(block, iv).XORKeyStream(out, str)
+	case "ofb":
+		cipher.NewOFB(block, iv).XORKeyStream(out, str)
+	}
+	return string(out), nil
+}
+
+// WithChildren implements sql.Expression
+func (f *AESEncrypt) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewAESEncrypt(children...)
+}
+
+// AESDecrypt function decrypts a string encrypted by AES_ENCRYPT, with the same key, initialization vector and
+// block_encryption_mode. It returns NULL if any argument is NULL, or if the string can't have been encrypted with the
+// key given, which is only detected by the modes that pad their data, ECB and CBC.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_aes-decrypt
+type AESDecrypt struct {
+	aesFunc
+}
+
+var _ sql.FunctionExpression = (*AESDecrypt)(nil)
+
+// NewAESDecrypt returns a new AES_DECRYPT function expression
+func NewAESDecrypt(args ...sql.Expression) (sql.Expression, error) {
+	f, err := newAESFunc("aes_decrypt", args...)
+	if err != nil {
+		return nil, err
+	}
+	return &AESDecrypt{f}, nil
+}
+
+// Description implements sql.FunctionExpression
+func (f *AESDecrypt) Description() string {
+	return "decrypts a string encrypted using AES."
+}
+
+// Eval implements sql.Expression
+func (f *AESDecrypt) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	str, block, iv, mode, err := f.evalArgs(ctx, row)
+	if err != nil || block == nil {
+		return nil, err
+	}
+
+	if mode.padded() && (len(str) == 0 || len(str)%aes.BlockSize != 0) {
+		return nil, nil
+	}
+
+	out := make([]byte, len(str))
+	switch mode.mode {
+	case "ecb":
+		for i := 0; i < len(str); i += aes.BlockSize {
+			block.Decrypt(out[i:], str[i:])
+		}
+	case "cbc":
+		cipher.NewCBCDecrypter(block, iv).CryptBlocks(out, str)
+	case "cfb1":
+		out = cfbSegments(block, iv, str, 1, true)
+	case "cfb8":
+		out = cfbSegments(block, iv, str, 8, true)
+	case "cfb128":
+		cipher.NewCFBDecrypter(block, iv).XORKeyStream(out, str)
+	case "ofb":
+		cipher.NewOFB(block, iv).XORKeyStream(out, str)
+	}
+
+	if mode.padded() {
+		padding := int(out[len(out)-1])
+		if padding == 0 || padding > aes.BlockSize || !bytes.Equal(out[len(out)-padding:], bytes.Repeat([]byte{byte(padding)}, padding)) {
+			return nil, nil
+		}
+		out = out[:len(out)-padding]
+	}
+	return string(out), nil
+}
+
+// WithChildren implements sql.Expression
+func (f *AESDecrypt) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	return NewAESDecrypt(children...)
+}
+
+// cfbSegments encrypts or decrypts data in CFB mode with segments of 1 or 8 bits, which the cipher package doesn't
+// implement: for each segment, the shift register is encrypted, the segment is XORed with the leftmost bits of the
+// result, and the ciphertext segment is shifted into the register.
+func cfbSegments(block cipher.Block, iv, data []byte, segmentBits int, decrypt bool) []byte {
+	register := make([]byte, len(iv))
+	copy(register, iv)
+	keyStream := make([]byte, block.BlockSize())
+	out := make([]byte, len(data))
+
+	for i, in := range data {
+		if segmentBits == 8 {
+			block.Encrypt(keyStream, register)
+			out[i] = in ^ keyStream[0]
+			feedback := out[i]
+			if decrypt {
+				feedback = in
+			}
+			copy(register, register[1:])
+			register[len(register)-1] = feedback
+			continue
+		}
+
+		for bit := 7; bit >= 0; bit-- {
+			block.Encrypt(keyStream, register)
+			inBit := (in >> bit) & 1
+			outBit := inBit ^ (keyStream[0] >> 7)
+			out[i] |= outBit << bit
+			feedback := outBit
+			if decrypt {
+				feedback = inBit
+			}
+			for j := 0; j < len(register)-1; j++ {
+				register[j] = register[j]<<1 | register[j+1]>>7
+			}
+			register[len(register)-1] = register[len(register)-1]<<1 | feedback
+		}
+	}
+	return out
+}
+
+// RandomBytes function returns a binary string of the length given, made of random bytes generated by the
+// cryptographic random number generator. The length must be between 1 and 1024. It returns NULL if the length is NULL.
+// https://dev.mysql.com/doc/refman/8.0/en/encryption-functions.html#function_random-bytes
+type RandomBytes struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*RandomBytes)(nil)
+var _ sql.NonDeterministicExpression = (*RandomBytes)(nil)
+
+// NewRandomBytes returns a new RANDOM_BYTES function expression
+func NewRandomBytes(arg sql.Expression) sql.Expression {
+	return &RandomBytes{NewUnaryFunc(arg, "RANDOM_BYTES", sql.LongBlob)}
+}
+
+// Description implements sql.FunctionExpression
+func (f *RandomBytes) Description() string {
+	return "returns a random byte vector."
+}
+
+// Volatility implements sql.FunctionWithVolatility
+func (f *RandomBytes) Volatility() sql.FunctionVolatility {
+	return sql.Volatile
+}
+
+// IsNonDeterministic implements sql.NonDeterministicExpression
+func (f *RandomBytes) IsNonDeterministic() bool {
+	return true
+}
+
+// Eval implements sql.Expression
+func (f *RandomBytes) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	arg, err := f.EvalChild(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if arg == nil {
+		return nil, nil
+	}
+
+	length, err := sql.Int64.Convert(arg)
+	if err != nil {
+		return nil, err
+	}
+	if length.(int64) < 1 || length.(int64) > maxRandomBytes {
+		return nil, ErrRandomBytesLength.New()
+	}
+
+	buf := make([]byte, length.(int64))
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+	return string(buf), nil
+}
+
+// WithChildren implements sql.Expression
+func (f *RandomBytes) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewRandomBytes(children[0]), nil
+}
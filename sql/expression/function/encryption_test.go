@@ -0,0 +1,162 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func mustDecodeHex(t *testing.T, s string) string {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestAESEncrypt(t *testing.T) {
+	// Test vectors of NIST SP 800-38A. The key is 16 bytes long, so folding leaves it as it is.
+	key := mustDecodeHex(t, "2b7e151628aed2a6abf7158809cf4f3c")
+	iv := mustDecodeHex(t, "000102030405060708090a0b0c0d0e0f")
+	testCases := []struct {
+		mode      string
+		plaintext string
+		expected  string
+	}{
+		{"aes-128-ecb", "6bc1bee22e409f96e93d7e117393172a", "3ad77bb40d7a3660a89ecaf32466ef97"},
+		{"aes-128-cbc", "6bc1bee22e409f96e93d7e117393172a", "7649abac8119b246cee98e9b12e9197d"},
+		{"aes-128-cfb1", "6bc1", "68b3"},
+		{"aes-128-cfb8", "6bc1bee22e409f96e93d7e117393172aae2d", "3b79424c9c0dd436bace9e0ed4586a4f32b9"},
+		{"aes-128-cfb128", "6bc1bee22e409f96e93d7e117393172a", "3b3fd92eb72dad20333449f8e83cfb4a"},
+		{"aes-128-ofb", "6bc1bee22e409f96e93d7e117393172a", "3b3fd92eb72dad20333449f8e83cfb4a"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.mode, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+			require.NoError(ctx.SetSessionVariable(ctx, "block_encryption_mode", tt.mode))
+
+			plaintext := mustDecodeHex(t, tt.plaintext)
+			args := []sql.Expression{
+				expression.NewLiteral(plaintext, sql.LongBlob),
+				expression.NewLiteral(key, sql.LongBlob),
+				expression.NewLiteral(iv, sql.LongBlob),
+			}
+			enc, err := NewAESEncrypt(args...)
+			require.NoError(err)
+			encrypted, err := enc.Eval(ctx, nil)
+			require.NoError(err)
+
+			// The modes that pad their data add a block of padding to data that's a multiple of the block size
+			if tt.mode == "aes-128-ecb" || tt.mode == "aes-128-cbc" {
+				require.Len(encrypted, 32)
+				encrypted = encrypted.(string)[:16]
+			}
+			require.Equal(tt.expected, hex.EncodeToString([]byte(encrypted.(string))))
+
+			encrypted, err = enc.Eval(ctx, nil)
+			require.NoError(err)
+			args[0] = expression.NewLiteral(encrypted, sql.LongBlob)
+			dec, err := NewAESDecrypt(args...)
+			require.NoError(err)
+			decrypted, err := dec.Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal(plaintext, decrypted)
+		})
+	}
+}
+
+func TestAESEncryptKeySizes(t *testing.T) {
+	for _, mode := range []string{"aes-128-ecb", "aes-192-cbc", "aes-256-ofb"} {
+		t.Run(mode, func(t *testing.T) {
+			require := require.New(t)
+			ctx := sql.NewEmptyContext()
+			require.NoError(ctx.SetSessionVariable(ctx, "block_encryption_mode", mode))
+
+			args := []sql.Expression{
+				expression.NewLiteral("some text", sql.LongText),
+				expression.NewLiteral("a key that is longer than every key size of AES", sql.LongText),
+				expression.NewLiteral("an initialization vector", sql.LongText),
+			}
+			enc, err := NewAESEncrypt(args...)
+			require.NoError(err)
+			encrypted, err := enc.Eval(ctx, nil)
+			require.NoError(err)
+			require.NotEqual("some text", encrypted)
+
+			args[0] = expression.NewLiteral(encrypted, sql.LongBlob)
+			dec, err := NewAESDecrypt(args...)
+			require.NoError(err)
+			decrypted, err := dec.Eval(ctx, nil)
+			require.NoError(err)
+			require.Equal("some text", decrypted)
+		})
+	}
+}
+
+func TestAESEncryptErrors(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	text := expression.NewLiteral("text", sql.LongText)
+	key := expression.NewLiteral("key", sql.LongText)
+
+	_, err := NewAESEncrypt(text)
+	require.Error(err)
+
+	// NULL arguments return NULL
+	f, err := NewAESEncrypt(expression.NewLiteral(nil, sql.Null), key)
+	require.NoError(err)
+	require.Nil(eval(t, f, nil))
+
+	// Data that wasn't encrypted with the key returns NULL
+	f, err = NewAESDecrypt(text, key)
+	require.NoError(err)
+	require.Nil(eval(t, f, nil))
+
+	require.NoError(ctx.SetSessionVariable(ctx, "block_encryption_mode", "aes-256-cbc"))
+	f, err = NewAESEncrypt(text, key)
+	require.NoError(err)
+	_, err = f.Eval(ctx, nil)
+	require.True(ErrMissingInitVector.Is(err))
+
+	f, err = NewAESEncrypt(text, key, expression.NewLiteral("short", sql.LongText))
+	require.NoError(err)
+	_, err = f.Eval(ctx, nil)
+	require.True(ErrInitVectorTooShort.Is(err))
+
+	require.NoError(ctx.SetSessionVariable(ctx, "block_encryption_mode", "des-128-cbc"))
+	_, err = f.Eval(ctx, nil)
+	require.True(ErrInvalidBlockEncryptionMode.Is(err))
+}
+
+func TestRandomBytes(t *testing.T) {
+	require := require.New(t)
+
+	f := NewRandomBytes(expression.NewGetField(0, sql.Int64, "n", true))
+	require.Len(eval(t, f, sql.Row{int64(16)}), 16)
+	require.Len(eval(t, f, sql.Row{int64(1024)}), 1024)
+	require.NotEqual(eval(t, f, sql.Row{int64(16)}), eval(t, f, sql.Row{int64(16)}))
+	require.Nil(eval(t, f, sql.Row{nil}))
+
+	for _, n := range []int64{0, -1, 1025} {
+		_, err := f.Eval(sql.NewEmptyContext(), sql.Row{n})
+		require.True(ErrRandomBytesLength.Is(err))
+	}
+}
@@ -103,6 +103,7 @@ func (f *Format) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		if loc != nil {
 			locale, err = language.Parse(loc.(string))
 			if err != nil {
+				ctx.Warn(1649, fmt.Sprintf("Unknown locale: '%s'", loc))
 				locale = language.English
 			}
 		}
@@ -188,3 +189,132 @@ func (f *Format) WithChildren(children ...sql.Expression) (sql.Expression, error
 	}
 	return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 2)
 }
+
+// formatUnit is a unit of the quantities formatted by FORMAT_BYTES and FORMAT_PICO_TIME.
+type formatUnit struct {
+	name string
+	size float64
+}
+
+var byteUnits = []formatUnit{
+	{"EiB", 1 << 60},
+	{"PiB", 1 << 50},
+	{"TiB", 1 << 40},
+	{"GiB", 1 << 30},
+	{"MiB", 1 << 20},
+	{"KiB", 1 << 10},
+}
+
+var picoTimeUnits = []formatUnit{
+	{"d", 24 * 60 * 60 * 1e12},
+	{"h", 60 * 60 * 1e12},
+	{"min", 60 * 1e12},
+	{"s", 1e12},
+	{"ms", 1e9},
+	{"us", 1e6},
+	{"ns", 1e3},
+}
+
+// formatQuantity formats a quantity with the largest of the units given that it reaches, with two decimal places, as
+// the performance schema functions of MySQL do. Quantities smaller than every unit are formatted as integers with the
+// base unit given, padded to the width given.
+func formatQuantity(val float64, units []formatUnit, baseUnit string, baseWidth int) string {
+	for _, unit := range units {
+		if math.Abs(val) >= unit.size {
+			scaled := val / unit.size
+			if math.Abs(scaled) >= 100000 {
+				return fmt.Sprintf("%4.2e %s", scaled, unit.name)
+			}
+			return fmt.Sprintf("%4.2f %s", scaled, unit.name)
+		}
+	}
+	return fmt.Sprintf("%*d %s", baseWidth, int64(val), baseUnit)
+}
+
+// FormatBytes function formats a number of bytes as a value with size units, such as '2.00 KiB'. It returns NULL if
+// the number is NULL.
+// https://dev.mysql.com/doc/refman/8.0/en/performance-schema-functions.html#function_format-bytes
+type FormatBytes struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*FormatBytes)(nil)
+
+// NewFormatBytes returns a new FormatBytes expression.
+func NewFormatBytes(arg sql.Expression) sql.Expression {
+	return &FormatBytes{NewUnaryFunc(arg, "FORMAT_BYTES", sql.LongText)}
+}
+
+// Description implements sql.FunctionExpression
+func (f *FormatBytes) Description() string {
+	return "converts a byte count to a value with units."
+}
+
+// Eval implements the Expression interface.
+func (f *FormatBytes) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := f.EvalChild(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, nil
+	}
+
+	val, err = sql.Float64.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return formatQuantity(val.(float64), byteUnits, "bytes", 4), nil
+}
+
+// WithChildren implements the Expression interface.
+func (f *FormatBytes) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewFormatBytes(children[0]), nil
+}
+
+// FormatPicoTime function formats a time in picoseconds, the unit of the timers of the performance schema, as a value
+// with time units, such as '3.15 min'. It returns NULL if the time is NULL.
+// https://dev.mysql.com/doc/refman/8.0/en/performance-schema-functions.html#function_format-pico-time
+type FormatPicoTime struct {
+	*UnaryFunc
+}
+
+var _ sql.FunctionExpression = (*FormatPicoTime)(nil)
+
+// NewFormatPicoTime returns a new FormatPicoTime expression.
+func NewFormatPicoTime(arg sql.Expression) sql.Expression {
+	return &FormatPicoTime{NewUnaryFunc(arg, "FORMAT_PICO_TIME", sql.LongText)}
+}
+
+// Description implements sql.FunctionExpression
+func (f *FormatPicoTime) Description() string {
+	return "converts a time in picoseconds to a value with units."
+}
+
+// Eval implements the Expression interface.
+func (f *FormatPicoTime) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	val, err := f.EvalChild(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, nil
+	}
+
+	val, err = sql.Float64.Convert(val)
+	if err != nil {
+		return nil, err
+	}
+	return formatQuantity(val.(float64), picoTimeUnits, "ps", 3), nil
+}
+
+// WithChildren implements the Expression interface.
+func (f *FormatPicoTime) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 1)
+	}
+	return NewFormatPicoTime(children[0]), nil
+}
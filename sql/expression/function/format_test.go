@@ -15,6 +15,7 @@
 package function
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -135,6 +136,71 @@ func TestFormat(t *testing.T) {
 	}
 }
 
+func TestFormatUnknownLocale(t *testing.T) {
+	require := require.New(t)
+
+	f, err := NewFormat(
+		expression.NewLiteral(1234.5, sql.Float64),
+		expression.NewLiteral(1, sql.Int32),
+		expression.NewLiteral("not a locale", sql.LongText),
+	)
+	require.NoError(err)
+
+	ctx := sql.NewEmptyContext()
+	result, err := f.Eval(ctx, nil)
+	require.NoError(err)
+	require.Equal("1,234.5", result)
+	require.Equal(uint16(1), ctx.WarningCount())
+	require.Equal(1649, ctx.Warnings()[0].Code)
+}
+
+func TestFormatBytes(t *testing.T) {
+	testCases := []struct {
+		val      interface{}
+		expected interface{}
+	}{
+		{nil, nil},
+		{0, "   0 bytes"},
+		{512, " 512 bytes"},
+		{-512, "-512 bytes"},
+		{1023, "1023 bytes"},
+		{1024, "1.00 KiB"},
+		{18410715276690, "16.74 TiB"},
+		{-18410715276690, "-16.74 TiB"},
+		{uint64(18446644073709551615), "16.00 EiB"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprint(tt.val), func(t *testing.T) {
+			f := NewFormatBytes(expression.NewLiteral(tt.val, sql.Int64))
+			require.Equal(t, tt.expected, eval(t, f, nil))
+		})
+	}
+}
+
+func TestFormatPicoTime(t *testing.T) {
+	testCases := []struct {
+		val      interface{}
+		expected interface{}
+	}{
+		{nil, nil},
+		{10, " 10 ps"},
+		{3501, "3.50 ns"},
+		{3501000, "3.50 us"},
+		{188732396662000, "3.15 min"},
+		{3600e12, "1.00 h"},
+		{8.64e17, "10.00 d"},
+		{8.64e22, "1.00e+06 d"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(fmt.Sprint(tt.val), func(t *testing.T) {
+			f := NewFormatPicoTime(expression.NewLiteral(tt.val, sql.Float64))
+			require.Equal(t, tt.expected, eval(t, f, nil))
+		})
+	}
+}
+
 // TestSkippedFormat contains all the skipped tests those are incompatible to mysql format function results.
 // These include handling text type scientific notation numbers and some locales do not match exactly to mysql format
 // results. Some issues include usage of non-ascii characters from language library used and incorrect general format.
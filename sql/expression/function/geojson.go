@@ -5,20 +5,21 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
-// AsGeoJSON is a function that returns a point type from a WKT string
+// AsGeoJSON is a function that returns a GeoJSON object from a geometry
 type AsGeoJSON struct {
 	expression.NaryExpression
 }
 
 var _ sql.FunctionExpression = (*AsGeoJSON)(nil)
 
-// NewAsGeoJSON creates a new point expression.
+// NewAsGeoJSON creates a new ST_ASGEOJSON expression.
 func NewAsGeoJSON(args ...sql.Expression) (sql.Expression, error) {
 	if len(args) < 1 || len(args) > 3 {
 		return nil, sql.ErrInvalidArgumentNumber.New("ST_ASGEOJSON", "1, 2, or 3", len(args))
@@ -54,7 +55,12 @@ func (g *AsGeoJSON) WithChildren(children ...sql.Expression) (sql.Expression, er
 	return NewAsGeoJSON(children...)
 }
 
+// PointToSlice returns the GeoJSON coordinates of the point given. GeoJSON coordinates are always longitude first,
+// while geographic points have their latitude as X, so the coordinates of geographic points are swapped.
 func PointToSlice(p sql.Point) [2]float64 {
+	if sql.IsGeographicSRID(p.SRID) {
+		return [2]float64{p.Y, p.X}
+	}
 	return [2]float64{p.X, p.Y}
 }
 
@@ -74,29 +80,16 @@ func PolyToSlice(p sql.Polygon) [][][2]float64 {
 	return arr
 }
 
+// FindBBox returns the GeoJSON bounding box of the geometry given, as its minimum coordinates followed by its maximum
+// coordinates.
 func FindBBox(v interface{}) [4]float64 {
-	var res [4]float64
-	switch v := v.(type) {
-	case sql.Point:
-		res = [4]float64{v.X, v.Y, v.X, v.Y}
-	case sql.Linestring:
-		res = [4]float64{math.MaxFloat64, math.MaxFloat64, math.SmallestNonzeroFloat64, math.SmallestNonzeroFloat64}
-		for _, p := range v.Points {
-			tmp := FindBBox(p)
-			res[0] = math.Min(res[0], tmp[0])
-			res[1] = math.Min(res[1], tmp[1])
-			res[2] = math.Max(res[2], tmp[2])
-			res[3] = math.Max(res[3], tmp[3])
-		}
-	case sql.Polygon:
-		res = [4]float64{math.MaxFloat64, math.MaxFloat64, math.SmallestNonzeroFloat64, math.SmallestNonzeroFloat64}
-		for _, l := range v.Lines {
-			tmp := FindBBox(l)
-			res[0] = math.Min(res[0], tmp[0])
-			res[1] = math.Min(res[1], tmp[1])
-			res[2] = math.Max(res[2], tmp[2])
-			res[3] = math.Max(res[3], tmp[3])
-		}
+	res := [4]float64{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}
+	for _, p := range geometryPoints(v) {
+		c := PointToSlice(p)
+		res[0] = math.Min(res[0], c[0])
+		res[1] = math.Min(res[1], c[1])
+		res[2] = math.Max(res[2], c[0])
+		res[3] = math.Max(res[3], c[1])
 	}
 	return res
 }
@@ -105,6 +98,8 @@ func RoundFloatSlices(v interface{}, p float64) interface{} {
 	switch v := v.(type) {
 	case [2]float64:
 		return [2]float64{math.Round(v[0]*p) / p, math.Round(v[1]*p) / p}
+	case [4]float64:
+		return [4]float64{math.Round(v[0]*p) / p, math.Round(v[1]*p) / p, math.Round(v[2]*p) / p, math.Round(v[3]*p) / p}
 	case [][2]float64:
 		res := make([][2]float64, len(v))
 		for i, c := range v {
@@ -121,6 +116,30 @@ func RoundFloatSlices(v interface{}, p float64) interface{} {
 	return nil
 }
 
+// The bits of the options argument of ST_ASGEOJSON.
+const (
+	geoJSONOptionBBox     = 1
+	geoJSONOptionShortCRS = 2
+	geoJSONOptionLongCRS  = 4
+)
+
+// evalGeoJSONIntArg evaluates the integer argument of a GeoJSON function at the index given, returning false when it's
+// NULL.
+func evalGeoJSONIntArg(ctx *sql.Context, row sql.Row, args []sql.Expression, i int) (int64, bool, error) {
+	v, err := args[i].Eval(ctx, row)
+	if err != nil {
+		return 0, false, err
+	}
+	if v == nil {
+		return 0, false, nil
+	}
+	v, err = sql.Int64.Convert(v)
+	if err != nil {
+		return 0, false, err
+	}
+	return v.(int64), true, nil
+}
+
 // Eval implements the sql.Expression interface.
 func (g *AsGeoJSON) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	// Evaluate child
@@ -135,7 +154,7 @@ func (g *AsGeoJSON) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	// Create map object to hold values
-	obj := make(map[string]interface{}, 3) // TODO: needs to be 3 when including bounding box
+	obj := make(map[string]interface{}, 4)
 	switch v := val.(type) {
 	case sql.Point:
 		obj["type"] = "Point"
@@ -156,99 +175,74 @@ func (g *AsGeoJSON) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	// Evaluate precision
-	p, err := g.ChildExpressions[1].Eval(ctx, row)
+	p, ok, err := evalGeoJSONIntArg(ctx, row, g.ChildExpressions, 1)
 	if err != nil {
 		return nil, err
 	}
-	if p == nil {
+	if !ok {
 		return nil, nil
 	}
-	// Must be an int type
-	_p := 0
-	switch p := p.(type) {
-	case int8:
-		_p = int(p)
-	case int16:
-		_p = int(p)
-	case int32:
-		_p = int(p)
-	case int64:
-		_p = int(p)
-	case int:
-		_p = p
-	default:
-		return nil, errors.New("incorrect precision value")
-	}
 	// Must be >= 0
-	if _p < 0 {
-		return nil, errors.New("incorrect precision value")
+	if p < 0 {
+		return nil, ErrInvalidArgument.New(g.FunctionName(), "max_dec_digits must not be negative")
 	}
 
 	// TODO: lose accuracy with high precisions, 17 is about the most MySQL prints anyway
-	if _p > 17 {
-		_p = 17
+	if p > 17 {
+		p = 17
 	}
 
 	// Round floats
-	prec := math.Pow10(_p)
+	prec := math.Pow10(int(p))
 	obj["coordinates"] = RoundFloatSlices(obj["coordinates"], prec)
 
-	// No flag argument, just return object
+	// No options argument, just return object
 	if len(g.ChildExpressions) == 2 {
 		return sql.JSONDocument{Val: obj}, nil
 	}
 
-	// Evaluate flag argument
-	flag, err := g.ChildExpressions[2].Eval(ctx, row)
+	// Evaluate options argument
+	options, ok, err := evalGeoJSONIntArg(ctx, row, g.ChildExpressions, 2)
 	if err != nil {
 		return nil, err
 	}
-	if flag == nil {
+	if !ok {
 		return nil, nil
 	}
-	// Must be an int type
-	_flag := 0
-	switch flag := flag.(type) {
-	case int8:
-		_flag = int(flag)
-	case int16:
-		_flag = int(flag)
-	case int32:
-		_flag = int(flag)
-	case int64:
-		_flag = int(flag)
-	case int:
-		_flag = flag
-	default:
-		return nil, errors.New("incorrect flag value")
-	}
-	// Only flags 0-7 are valid
-	if _flag < 0 || _flag > 7 {
-		return nil, ErrInvalidArgument.New(g.FunctionName(), _flag)
-	}
-	// TODO: figure out exactly what flags are; only 1,3,5 have bbox
-	if _flag%2 == 1 {
-		// Calculate bounding box
-		res := FindBBox(val)
-		for i, r := range res {
-			res[i] = math.Round(r*prec) / prec
+	// Only options 0-7 are valid
+	if options < 0 || options > 7 {
+		return nil, ErrInvalidArgument.New(g.FunctionName(), fmt.Sprintf("options must be between 0 and 7, not %d", options))
+	}
+	if options&geoJSONOptionBBox != 0 {
+		obj["bbox"] = RoundFloatSlices(FindBBox(val), prec)
+	}
+	// The CRS is only included for geometries that aren't in the Cartesian plane, and the long format wins when both
+	// formats are asked for.
+	srid, _ := sql.GeometrySRID(val)
+	if srid != CartesianSRID && options&(geoJSONOptionShortCRS|geoJSONOptionLongCRS) != 0 {
+		name := fmt.Sprintf("EPSG:%d", srid)
+		if options&geoJSONOptionLongCRS != 0 {
+			name = fmt.Sprintf("urn:ogc:def:crs:EPSG::%d", srid)
+		}
+		obj["crs"] = map[string]interface{}{
+			"type":       "name",
+			"properties": map[string]interface{}{"name": name},
 		}
-		obj["bbox"] = res
 	}
 	return sql.JSONDocument{Val: obj}, nil
 }
 
-// GeomFromGeoJSON is a function returns a geometry based on a string
+// GeomFromGeoJSON is a function that returns a geometry from a GeoJSON object
 type GeomFromGeoJSON struct {
 	expression.NaryExpression
 }
 
 var _ sql.FunctionExpression = (*GeomFromGeoJSON)(nil)
 
-// NewGeomFromGeoJSON creates a new point expression.
+// NewGeomFromGeoJSON creates a new ST_GEOMFROMGEOJSON expression.
 func NewGeomFromGeoJSON(args ...sql.Expression) (sql.Expression, error) {
 	if len(args) < 1 || len(args) > 3 {
-		return nil, sql.ErrInvalidArgumentNumber.New("ST_ASGEOJSON", "1, 2, or 3", len(args))
+		return nil, sql.ErrInvalidArgumentNumber.New("ST_GEOMFROMGEOJSON", "1, 2, or 3", len(args))
 	}
 	return &GeomFromGeoJSON{expression.NaryExpression{ChildExpressions: args}}, nil
 }
@@ -260,7 +254,7 @@ func (g *GeomFromGeoJSON) FunctionName() string {
 
 // Description implements sql.FunctionExpression
 func (g *GeomFromGeoJSON) Description() string {
-	return "returns a GeoJSON object from the geometry."
+	return "returns a geometry from the GeoJSON object."
 }
 
 // Type implements the sql.Expression interface.
@@ -273,7 +267,7 @@ func (g *GeomFromGeoJSON) String() string {
 	for i, arg := range g.ChildExpressions {
 		args[i] = arg.String()
 	}
-	return fmt.Sprintf("ST_GEOMFROMWKT(%s)", strings.Join(args, ","))
+	return fmt.Sprintf("ST_GEOMFROMGEOJSON(%s)", strings.Join(args, ","))
 }
 
 // WithChildren implements the Expression interface.
@@ -281,6 +275,19 @@ func (g *GeomFromGeoJSON) WithChildren(children ...sql.Expression) (sql.Expressi
 	return NewGeomFromGeoJSON(children...)
 }
 
+// The values of the options argument of ST_GEOMFROMGEOJSON, which decide what's done with coordinates of more than two
+// dimensions. Coordinates of more than two dimensions are rejected unless the options allow them, in which case their
+// extra dimensions are stripped.
+const (
+	geoJSONRejectHigherDimensions = 1
+	geoJSONMaxOptions             = 4
+)
+
+// The names of the coordinate reference systems a GeoJSON object's crs member may have that aren't EPSG names.
+var geoJSONCRS84Names = []string{"urn:ogc:def:crs:OGC:1.3:CRS84", "urn:ogc:def:crs:OGC::CRS84", "CRS84"}
+
+// SliceToPoint returns the geographic point of the GeoJSON coordinates given. GeoJSON coordinates are longitude first,
+// while geographic points have their latitude as X.
 func SliceToPoint(coords interface{}) (interface{}, error) {
 	// coords must be a slice of 2 float64
 	c, ok := coords.([]interface{})
@@ -298,7 +305,7 @@ func SliceToPoint(coords interface{}) (interface{}, error) {
 	if !ok {
 		return nil, errors.New("coordinate must be of type number")
 	}
-	return sql.Point{SRID: 4326, X: x, Y: y}, nil
+	return sql.Point{SRID: GeoSpatialSRID, X: x, Y: y}, nil
 }
 
 func SliceToLine(coords interface{}) (interface{}, error) {
@@ -318,7 +325,7 @@ func SliceToLine(coords interface{}) (interface{}, error) {
 		}
 		points[i] = p.(sql.Point)
 	}
-	return sql.Linestring{SRID: 4326, Points: points}, nil
+	return sql.Linestring{SRID: GeoSpatialSRID, Points: points}, nil
 }
 
 func SliceToPoly(coords interface{}) (interface{}, error) {
@@ -341,174 +348,224 @@ func SliceToPoly(coords interface{}) (interface{}, error) {
 		}
 		lines[i] = l.(sql.Linestring)
 	}
-	return sql.Polygon{SRID: 4326, Lines: lines}, nil
+	return sql.Polygon{SRID: GeoSpatialSRID, Lines: lines}, nil
 }
 
-// Eval implements the sql.Expression interface.
-func (g *GeomFromGeoJSON) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
-	// Evaluate child
-	val, err := g.ChildExpressions[0].Eval(ctx, row)
-	if err != nil {
-		return nil, err
+// maxCoordinateDimensions returns the largest number of dimensions of the positions in the GeoJSON coordinates given,
+// which are nested to the depth given: 0 for a single position.
+func maxCoordinateDimensions(coords interface{}, depth int) int {
+	cs, ok := coords.([]interface{})
+	if !ok {
+		return 0
 	}
-	// Return nil when geometry is nil
-	if val == nil {
-		return nil, nil
+	if depth == 0 {
+		return len(cs)
 	}
-	// Convert to string
-	val, err = sql.LongBlob.Convert(val)
-	if err != nil {
-		return nil, err
-	}
-	// Parse string as JSON
-	var obj map[string]interface{}
-	err = json.Unmarshal([]byte(val.(string)), &obj)
-	if err != nil {
-		return nil, err
+	max := 0
+	for _, c := range cs {
+		if d := maxCoordinateDimensions(c, depth-1); d > max {
+			max = d
+		}
 	}
-	// Check for type and coordinates
+	return max
+}
+
+// geoJSONGeometry returns the geometry of the GeoJSON object given, and false when it's a feature without a geometry.
+func geoJSONGeometry(obj map[string]interface{}, options int64) (interface{}, bool, error) {
+	// Check for type
 	geomType, ok := obj["type"]
 	if !ok {
-		return nil, errors.New("missing required member 'type'")
+		return nil, false, errors.New("missing required member 'type'")
 	}
+
+	// A feature wraps its geometry, which may be null
+	if geomType == "Feature" {
+		geom, ok := obj["geometry"]
+		if !ok {
+			return nil, false, errors.New("missing required member 'geometry'")
+		}
+		if geom == nil {
+			return nil, false, nil
+		}
+		geomObj, ok := geom.(map[string]interface{})
+		if !ok {
+			return nil, false, errors.New("member 'geometry' must be of type 'object'")
+		}
+		return geoJSONGeometry(geomObj, options)
+	}
+
+	// Check for coordinates
 	coords, ok := obj["coordinates"]
 	if !ok {
-		return nil, errors.New("missing required member 'coordinates'")
+		return nil, false, errors.New("missing required member 'coordinates'")
 	}
+
 	// Create type accordingly
-	var res interface{}
+	var depth int
+	var parse func(interface{}) (interface{}, error)
 	switch geomType {
 	case "Point":
-		res, err = SliceToPoint(coords)
+		depth, parse = 0, SliceToPoint
 	case "LineString":
-		res, err = SliceToLine(coords)
+		depth, parse = 1, SliceToLine
 	case "Polygon":
-		res, err = SliceToPoly(coords)
+		depth, parse = 2, SliceToPoly
+	case "MultiPoint", "MultiLineString", "MultiPolygon", "GeometryCollection", "FeatureCollection":
+		return nil, false, fmt.Errorf("unsupported GeoJSON type '%s'", geomType)
 	default:
-		return nil, errors.New("member 'type' is wrong")
+		return nil, false, errors.New("member 'type' is wrong")
 	}
-	// Handle error
+	if options == geoJSONRejectHigherDimensions && maxCoordinateDimensions(coords, depth) > 2 {
+		return nil, false, errors.New("unsupported number of coordinate dimensions")
+	}
+	res, err := parse(coords)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
-	// if only 1 argument, return
-	if len(g.ChildExpressions) == 1 {
-		return res, nil
+	return res, true, nil
+}
+
+// geoJSONSRID returns the SRID named by the crs member of the GeoJSON object given, and false when it has none.
+func geoJSONSRID(obj map[string]interface{}) (uint32, bool, error) {
+	crs, ok := obj["crs"]
+	if !ok || crs == nil {
+		return 0, false, nil
 	}
-	// Evaluate flag argument
-	flag, err := g.ChildExpressions[1].Eval(ctx, row)
-	if err != nil {
-		return nil, err
+	crsObj, ok := crs.(map[string]interface{})
+	if !ok {
+		return 0, false, errors.New("member 'crs' must be of type 'object'")
 	}
-	if flag == nil {
-		return nil, nil
+	if crsObj["type"] != "name" {
+		return 0, false, errors.New("unsupported CRS type, must be 'name'")
 	}
-	// Must be an int type
-	_flag := 0
-	switch flag := flag.(type) {
-	case int8:
-		_flag = int(flag)
-	case int16:
-		_flag = int(flag)
-	case int32:
-		_flag = int(flag)
-	case int64:
-		_flag = int(flag)
-	case int:
-		_flag = flag
-	default:
-		return nil, errors.New("incorrect flag value")
-	}
-	// Only flags 1-4 are valid
-	if _flag < 1 || _flag > 4 {
-		return nil, ErrInvalidArgument.New(g.FunctionName(), _flag)
-	}
-	// If flag is 1 and dimension of coordinates is greater than 2, throw error
-	if _flag == 1 {
-		// Swap coordinates with SRID 0
-		switch geomType {
-		case "Point":
-			if len(obj["coordinates"].([]interface{})) > 2 {
-				return nil, errors.New("unsupported number of coordinate dimensions")
-			}
-		case "LineString":
-			for _, a := range obj["coordinates"].([]interface{}) {
-				if len(a.([]interface{})) > 2 {
-					return nil, errors.New("unsupported number of coordinate dimensions")
-				}
-			}
-		case "Polygon":
-			for _, a := range obj["coordinates"].([]interface{}) {
-				for _, b := range a.([]interface{}) {
-					if len(b.([]interface{})) > 2 {
-						return nil, errors.New("unsupported number of coordinate dimensions")
-					}
-				}
-			}
+	props, ok := crsObj["properties"].(map[string]interface{})
+	if !ok {
+		return 0, false, errors.New("member 'properties' must be of type 'object'")
+	}
+	name, ok := props["name"].(string)
+	if !ok {
+		return 0, false, errors.New("member 'name' must be of type 'string'")
+	}
+
+	for _, crs84 := range geoJSONCRS84Names {
+		if name == crs84 {
+			return GeoSpatialSRID, true, nil
 		}
 	}
-	// If no SRID provided, return answer
-	if len(g.ChildExpressions) == 2 {
-		return res, nil
+	for _, prefix := range []string{"urn:ogc:def:crs:EPSG::", "EPSG:"} {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		srid, err := strconv.ParseUint(name[len(prefix):], 10, 32)
+		if err != nil {
+			break
+		}
+		return uint32(srid), true, nil
+	}
+	return 0, false, fmt.Errorf("invalid CRS name '%s'", name)
+}
+
+// withGeoJSONSRID returns the geographic geometry given with the SRID given. Geometries that aren't geographic have the
+// coordinates of the GeoJSON object in the order they're given, so their X and Y are swapped.
+func withGeoJSONSRID(g interface{}, srid uint32) interface{} {
+	if srid == GeoSpatialSRID {
+		return g
+	}
+	switch g := g.(type) {
+	case sql.Point:
+		g.SRID = srid
+		if !sql.IsGeographicSRID(srid) {
+			g.X, g.Y = g.Y, g.X
+		}
+		return g
+	case sql.Linestring:
+		points := make([]sql.Point, len(g.Points))
+		for i, p := range g.Points {
+			points[i] = withGeoJSONSRID(p, srid).(sql.Point)
+		}
+		return sql.Linestring{SRID: srid, Points: points}
+	case sql.Polygon:
+		lines := make([]sql.Linestring, len(g.Lines))
+		for i, l := range g.Lines {
+			lines[i] = withGeoJSONSRID(l, srid).(sql.Linestring)
+		}
+		return sql.Polygon{SRID: srid, Lines: lines}
 	}
-	// Evaluate SRID
-	srid, err := g.ChildExpressions[2].Eval(ctx, row)
+	return g
+}
+
+// Eval implements the sql.Expression interface.
+func (g *GeomFromGeoJSON) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	// Evaluate child
+	val, err := g.ChildExpressions[0].Eval(ctx, row)
 	if err != nil {
 		return nil, err
 	}
-	if srid == nil {
+	// Return nil when geometry is nil
+	if val == nil {
 		return nil, nil
 	}
-	// Must be an uint32 type
-	_srid := uint32(0)
-	switch srid := srid.(type) {
-	case int8:
-		_srid = uint32(srid)
-	case int16:
-		_srid = uint32(srid)
-	case int32:
-		_srid = uint32(srid)
-	case int64:
-		_srid = uint32(srid)
-	case int:
-		_srid = uint32(srid)
-	default:
-		return nil, errors.New("incorrect srid value")
+	// Convert to string
+	val, err = sql.LongBlob.Convert(val)
+	if err != nil {
+		return nil, err
 	}
-	// Check for invalid SRID
-	if _srid != 0 && _srid != 4326 {
-		return nil, ErrInvalidSRID.New(g.FunctionName(), _srid)
+	// Parse string as JSON
+	var obj map[string]interface{}
+	err = json.Unmarshal([]byte(val.(string)), &obj)
+	if err != nil {
+		return nil, err
 	}
-	// If SRID 4326, do nothing
-	if _srid == 4326 {
-		return res, nil
+
+	// Evaluate options argument, which rejects higher dimensions by default
+	options := int64(geoJSONRejectHigherDimensions)
+	if len(g.ChildExpressions) > 1 {
+		var ok bool
+		options, ok, err = evalGeoJSONIntArg(ctx, row, g.ChildExpressions, 1)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, nil
+		}
+		// Only options 1-4 are valid
+		if options < geoJSONRejectHigherDimensions || options > geoJSONMaxOptions {
+			return nil, ErrInvalidArgument.New(g.FunctionName(), fmt.Sprintf("options must be between 1 and 4, not %d", options))
+		}
 	}
-	// Swap coordinates with SRID 0
-	switch geomType {
-	case "Point":
-		_res := res.(sql.Point)
-		_res.SRID = _srid
-		_res.X, _res.Y = _res.Y, _res.X
-		return _res, nil
-	case "LineString":
-		_res := res.(sql.Linestring)
-		_res.SRID = _srid
-		for i, p := range _res.Points {
-			_res.Points[i].SRID = _srid
-			_res.Points[i].X, _res.Points[i].Y = p.Y, p.X
+
+	// Evaluate SRID, which is taken from the crs member of the object when there's no SRID argument
+	srid, hasCRS, err := geoJSONSRID(obj)
+	if err != nil {
+		return nil, err
+	}
+	if !hasCRS {
+		srid = GeoSpatialSRID
+	}
+	if len(g.ChildExpressions) > 2 {
+		_srid, ok, err := evalGeoJSONIntArg(ctx, row, g.ChildExpressions, 2)
+		if err != nil {
+			return nil, err
 		}
-		return _res, nil
-	case "Polygon":
-		_res := res.(sql.Polygon)
-		_res.SRID = _srid
-		for i, l := range _res.Lines {
-			_res.Lines[i].SRID = _srid
-			for j, p := range l.Points {
-				_res.Lines[i].Points[j].SRID = _srid
-				_res.Lines[i].Points[j].X, _res.Lines[i].Points[j].Y = p.Y, p.X
-			}
+		if !ok {
+			return nil, nil
+		}
+		if _srid < 0 || _srid > math.MaxUint32 {
+			return nil, ErrInvalidArgument.New(g.FunctionName(), fmt.Sprintf("srid %d is out of range", _srid))
 		}
-		return _res, nil
+		srid = uint32(_srid)
+	}
+	// Check for invalid SRID
+	if err := sql.ValidateSRID(srid); err != nil {
+		return nil, err
+	}
+
+	res, ok, err := geoJSONGeometry(obj, options)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
 	}
-	return nil, nil
+	return withGeoJSONSRID(res, srid), nil
 }
@@ -162,6 +162,88 @@ func TestAsGeoJSON(t *testing.T) {
 		require.NoError(err)
 		require.Equal(nil, v)
 	})
+	t.Run("convert geographic point to geojson", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewAsGeoJSON(expression.NewLiteral(sql.Point{SRID: 4326, X: 1, Y: 2}, sql.PointType{}))
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(sql.JSONDocument{Val: map[string]interface{}{"coordinates": [2]float64{2, 1}, "type": "Point"}}, v)
+	})
+	t.Run("convert linestring with negative coordinates with bounding box", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewAsGeoJSON(
+			expression.NewLiteral(sql.Linestring{Points: []sql.Point{{X: -1, Y: -2}, {X: -3, Y: -4}}}, sql.LinestringType{}),
+			expression.NewLiteral(2, sql.Int64),
+			expression.NewLiteral(1, sql.Int64),
+		)
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(sql.JSONDocument{Val: map[string]interface{}{"coordinates": [][2]float64{{-1, -2}, {-3, -4}}, "type": "LineString", "bbox": [4]float64{-3, -4, -1, -2}}}, v)
+	})
+	t.Run("convert geographic point with short crs", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewAsGeoJSON(
+			expression.NewLiteral(sql.Point{SRID: 4326, X: 1, Y: 2}, sql.PointType{}),
+			expression.NewLiteral(2, sql.Int64),
+			expression.NewLiteral(2, sql.Int64),
+		)
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(sql.JSONDocument{Val: map[string]interface{}{"coordinates": [2]float64{2, 1}, "type": "Point",
+			"crs": map[string]interface{}{"type": "name", "properties": map[string]interface{}{"name": "EPSG:4326"}}}}, v)
+	})
+	t.Run("convert geographic point with long crs and bounding box", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewAsGeoJSON(
+			expression.NewLiteral(sql.Point{SRID: 4326, X: 1, Y: 2}, sql.PointType{}),
+			expression.NewLiteral(2, sql.Int64),
+			expression.NewLiteral(7, sql.Int64),
+		)
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(sql.JSONDocument{Val: map[string]interface{}{"coordinates": [2]float64{2, 1}, "type": "Point", "bbox": [4]float64{2, 1, 2, 1},
+			"crs": map[string]interface{}{"type": "name", "properties": map[string]interface{}{"name": "urn:ogc:def:crs:EPSG::4326"}}}}, v)
+	})
+	t.Run("convert cartesian point with crs has no crs", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewAsGeoJSON(
+			expression.NewLiteral(sql.Point{X: 1, Y: 2}, sql.PointType{}),
+			expression.NewLiteral(2, sql.Int64),
+			expression.NewLiteral(4, sql.Int64),
+		)
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(sql.JSONDocument{Val: map[string]interface{}{"coordinates": [2]float64{1, 2}, "type": "Point"}}, v)
+	})
+	t.Run("reject invalid options and precision", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewAsGeoJSON(
+			expression.NewLiteral(sql.Point{X: 1, Y: 2}, sql.PointType{}),
+			expression.NewLiteral(2, sql.Int64),
+			expression.NewLiteral(8, sql.Int64),
+		)
+		require.NoError(err)
+		_, err = f.Eval(sql.NewEmptyContext(), nil)
+		require.Error(err)
+
+		f, err = NewAsGeoJSON(
+			expression.NewLiteral(sql.Point{X: 1, Y: 2}, sql.PointType{}),
+			expression.NewLiteral(-1, sql.Int64),
+		)
+		require.NoError(err)
+		_, err = f.Eval(sql.NewEmptyContext(), nil)
+		require.Error(err)
+	})
 }
 
 func TestGeomFromGeoJSON(t *testing.T) {
@@ -250,4 +332,66 @@ func TestGeomFromGeoJSON(t *testing.T) {
 		v, err := f.Eval(sql.NewEmptyContext(), nil)
 		require.Equal(sql.Polygon{SRID: 0, Lines: []sql.Linestring{{0, []sql.Point{{0, 0, 0}, {0, 1, 1}, {0, 0, 1}, {0, 0, 0}}}}}, v)
 	})
+	t.Run("reject dimensions greater than 2 by default", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewGeomFromGeoJSON(expression.NewLiteral(`{"type":"Point", "coordinates":[1,2,3]}`, sql.Blob))
+		require.NoError(err)
+
+		_, err = f.Eval(sql.NewEmptyContext(), nil)
+		require.Error(err)
+	})
+	t.Run("convert feature to geometry", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewGeomFromGeoJSON(expression.NewLiteral(`{"type":"Feature", "geometry":{"type":"Point", "coordinates":[1,2]}, "properties":{}}`, sql.Blob))
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(sql.Point{SRID: 4326, X: 2, Y: 1}, v)
+	})
+	t.Run("feature without geometry is null", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewGeomFromGeoJSON(expression.NewLiteral(`{"type":"Feature", "geometry":null, "properties":{}}`, sql.Blob))
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Nil(v)
+	})
+	t.Run("srid from crs member", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewGeomFromGeoJSON(expression.NewLiteral(`{"type":"Point", "coordinates":[1,2], "crs":{"type":"name", "properties":{"name":"EPSG:0"}}}`, sql.Blob))
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(sql.Point{SRID: 0, X: 1, Y: 2}, v)
+
+		f, err = NewGeomFromGeoJSON(expression.NewLiteral(`{"type":"Point", "coordinates":[1,2], "crs":{"type":"name", "properties":{"name":"urn:ogc:def:crs:OGC:1.3:CRS84"}}}`, sql.Blob))
+		require.NoError(err)
+
+		v, err = f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(sql.Point{SRID: 4326, X: 2, Y: 1}, v)
+	})
+	t.Run("unknown srid in crs member", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewGeomFromGeoJSON(expression.NewLiteral(`{"type":"Point", "coordinates":[1,2], "crs":{"type":"name", "properties":{"name":"EPSG:1234"}}}`, sql.Blob))
+		require.NoError(err)
+
+		_, err = f.Eval(sql.NewEmptyContext(), nil)
+		require.True(sql.ErrInvalidSRID.Is(err))
+	})
+	t.Run("round trip geographic polygon", func(t *testing.T) {
+		require := require.New(t)
+		poly := sql.Polygon{SRID: 4326, Lines: []sql.Linestring{{SRID: 4326, Points: []sql.Point{{SRID: 4326, X: 0, Y: 0}, {SRID: 4326, X: 1, Y: 2}, {SRID: 4326, X: 3, Y: 1}, {SRID: 4326, X: 0, Y: 0}}}}}
+		a, err := NewAsGeoJSON(expression.NewLiteral(poly, sql.PolygonType{}))
+		require.NoError(err)
+		f, err := NewGeomFromGeoJSON(a)
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(poly, v)
+	})
 }
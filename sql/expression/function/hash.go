@@ -46,6 +46,11 @@ func (f *MD5) Description() string {
 	return "calculates MD5 checksum."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (f *MD5) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements sql.Expression
 func (f *MD5) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	arg, err := f.EvalChild(ctx, row)
@@ -95,6 +100,11 @@ func (f *SHA1) Description() string {
 	return "calculates an SHA-1 160-bit checksum."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (f *SHA1) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements sql.Expression
 func (f *SHA1) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	arg, err := f.EvalChild(ctx, row)
@@ -144,6 +154,11 @@ func (f *SHA2) Description() string {
 	return "calculates an SHA-2 checksum."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (f *SHA2) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements sql.Expression
 func (f *SHA2) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	arg, err := f.Left.Eval(ctx, row)
@@ -73,6 +73,11 @@ func (l *Length) Description() string {
 	}
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (l *Length) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // WithChildren implements the Expression interface.
 func (l *Length) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 1 {
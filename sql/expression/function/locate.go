@@ -50,6 +50,11 @@ func (l *Locate) Description() string {
 	return "returns the position of the first occurrence of a substring in a string."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (l *Locate) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // WithChildren implements the Expression interface.
 func (l *Locate) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) < 2 || len(children) > 3 {
@@ -133,22 +138,26 @@ func (l *Locate) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		}
 	}
 
-	// Edge cases that cannot be handled by strings.Index.
+	// Positions are in characters, not bytes
+	text := []rune(strings.ToLower(str))
+	subtext := []rune(strings.ToLower(substr))
+
+	// Edge cases that cannot be handled by findSubsequence.
 	switch {
 	// Position 0 doesn't exist.
-	case position == 0 || (len(str) > 0 && position > len(str)):
+	case position <= 0 || (len(text) > 0 && position > len(text)):
 		return int32(0), nil
 	// Locate("", "") returns 1 if start is 1.
-	case len(substr) == 0 && len(str) == 0:
+	case len(subtext) == 0 && len(text) == 0:
 		if position == 1 {
 			return int32(1), nil
 		}
 		return int32(0), nil
 	}
 
-	res := strings.Index(strings.ToLower(str[position-1:]), strings.ToLower(substr))
+	res := findSubsequence(text[position-1:], subtext)
 	if res == -1 {
 		return int32(0), nil
 	}
-	return int32(res + position), nil
+	return int32(res) + int32(position), nil
 }
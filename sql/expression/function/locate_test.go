@@ -106,6 +106,32 @@ func TestLocate(t *testing.T) {
 			Start:    intPtr(2),
 			Expected: 0,
 		},
+		{
+			Name:     "locate multibyte characters",
+			Substr:   "ü",
+			Str:      "äöü",
+			Expected: 3,
+		},
+		{
+			Name:     "locate multibyte characters with start",
+			Substr:   "語",
+			Str:      "日本語の日本語",
+			Start:    intPtr(4),
+			Expected: 7,
+		},
+		{
+			Name:     "locate with start after multibyte string",
+			Substr:   "本",
+			Str:      "日本語",
+			Start:    intPtr(4),
+			Expected: 0,
+		},
+		{
+			Name:     "locate is case insensitive with multibyte characters",
+			Substr:   "Ö",
+			Str:      "äöü",
+			Expected: 2,
+		},
 	}
 
 	for _, tt := range testCases {
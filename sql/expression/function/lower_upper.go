@@ -44,6 +44,11 @@ func (l *Lower) Description() string {
 	return "returns the string str with all characters in lower case."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (l *Lower) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements the Expression interface.
 func (l *Lower) Eval(
 	ctx *sql.Context,
@@ -105,6 +110,11 @@ func (u *Upper) Description() string {
 	return "converts string to uppercase."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (u *Upper) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements the Expression interface.
 func (u *Upper) Eval(
 	ctx *sql.Context,
@@ -22,22 +22,30 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/shopspring/decimal"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
-// Rand returns a random float 0 <= x < 1. If it has an argument, that argument will be used to seed the random number
-// generator, effectively turning it into a hash on that value.
+// Rand returns a random float 0 <= x < 1. If it has a constant argument, that argument is used to seed the random
+// number generator of the statement, which returns the same sequence of numbers for the rows of statements with the
+// same seed. If its argument isn't constant, it's used to seed the generator for each row, effectively turning it into
+// a hash on that value.
 type Rand struct {
 	Child sql.Expression
+
+	mu  sync.Mutex
+	rng *rand.Rand
+	pid uint64
 }
 
-var _ sql.Expression = (*Rand)(nil)
-var _ sql.NonDeterministicExpression = (*Rand)(nil)
 var _ sql.FunctionExpression = (*Rand)(nil)
+var _ sql.NonDeterministicExpression = (*Rand)(nil)
+var _ sql.FunctionWithVolatility = (*Rand)(nil)
 
 // NewRand creates a new Rand expression.
 func NewRand(exprs ...sql.Expression) (sql.Expression, error) {
@@ -60,6 +68,15 @@ func (r *Rand) Description() string {
 	return "returns a random number in the range 0 <= x < 1. If an argument is given, it is used to seed the random number generator."
 }
 
+// Volatility implements sql.FunctionWithVolatility. Rand is volatile unless its seed varies with each row, in which
+// case it returns the same result for the same seed.
+func (r *Rand) Volatility() sql.FunctionVolatility {
+	if r.Child == nil || r.hasConstantSeed() {
+		return sql.Volatile
+	}
+	return sql.Deterministic
+}
+
 // Type implements sql.Expression.
 func (r *Rand) Type() sql.Type {
 	return sql.Float64
@@ -67,7 +84,7 @@ func (r *Rand) Type() sql.Type {
 
 // IsNonDeterministic implements sql.NonDeterministicExpression
 func (r *Rand) IsNonDeterministic() bool {
-	return r.Child == nil
+	return r.Volatility() != sql.Deterministic
 }
 
 // IsNullable implements sql.Expression
@@ -113,13 +130,43 @@ func (r *Rand) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 		return rand.Float64(), nil
 	}
 
-	// For child expressions, the mysql semantics are to seed the PRNG with an int64 value of the expression given. For
-	// non-numeric types, the seed will always be 0, which means that rand() will always return the same result for all
-	// non-numeric seed arguments.
-	e, err := r.Child.Eval(ctx, row)
+	if r.hasConstantSeed() {
+		// The generator is seeded on the first evaluation of each statement, and returns the next number of its
+		// sequence for each following one. Prepared statements reuse their expressions, so the statement is told apart
+		// by the process id of its context.
+		var pid uint64
+		if ctx != nil {
+			pid = ctx.Pid()
+		}
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.rng == nil || r.pid != pid {
+			seed, err := r.seed(ctx, row)
+			if err != nil {
+				return nil, err
+			}
+			r.rng = rand.New(rand.NewSource(seed))
+			r.pid = pid
+		}
+		return r.rng.Float64(), nil
+	}
+
+	seed, err := r.seed(ctx, row)
 	if err != nil {
 		return nil, err
 	}
+	return rand.New(rand.NewSource(seed)).Float64(), nil
+}
+
+// seed returns the seed of the generator for the row given. For child expressions, the mysql semantics are to seed the
+// PRNG with an int64 value of the expression given. For non-numeric types, the seed will always be 0, which means that
+// rand() will always return the same result for all non-numeric seed arguments.
+func (r *Rand) seed(ctx *sql.Context, row sql.Row) (int64, error) {
+	e, err := r.Child.Eval(ctx, row)
+	if err != nil {
+		return 0, err
+	}
 
 	var seed int64
 	if sql.IsNumber(r.Child.Type()) {
@@ -128,8 +175,20 @@ func (r *Rand) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 			seed = e.(int64)
 		}
 	}
+	return seed, nil
+}
 
-	return rand.New(rand.NewSource(seed)).Float64(), nil
+// hasConstantSeed returns whether the seed of this Rand is the same for every row, as it doesn't reference any column.
+func (r *Rand) hasConstantSeed() bool {
+	constant := true
+	sql.Inspect(r.Child, func(e sql.Expression) bool {
+		switch e.(type) {
+		case *expression.GetField, *expression.UnresolvedColumn:
+			constant = false
+		}
+		return constant
+	})
+	return constant
 }
 
 // Sin is the SIN function
@@ -149,6 +208,11 @@ func (s *Sin) Description() string {
 	return "returns the sine of the expression given."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (s *Sin) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements sql.Expression
 func (s *Sin) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	val, err := s.EvalChild(ctx, row)
@@ -192,6 +256,11 @@ func (s *Cos) Description() string {
 	return "returns the cosine of an expression."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (s *Cos) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements sql.Expression
 func (s *Cos) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	val, err := s.EvalChild(ctx, row)
@@ -455,6 +524,11 @@ func (d *Degrees) Description() string {
 	return "returns the number of degrees in the radian expression given."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (d *Degrees) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements sql.Expression
 func (d *Degrees) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	val, err := d.EvalChild(ctx, row)
@@ -498,6 +572,11 @@ func (r *Radians) Description() string {
 	return "returns the radian value of the degrees argument given."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (r *Radians) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements sql.Expression
 func (r *Radians) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	val, err := r.EvalChild(ctx, row)
@@ -541,6 +620,11 @@ func (c *Crc32) Description() string {
 	return "returns the cyclic redundancy check value of a given string as a 32-bit unsigned value."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (c *Crc32) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements sql.Expression
 func (c *Crc32) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	arg, err := c.EvalChild(ctx, row)
@@ -629,6 +713,11 @@ func (s *Sign) Description() string {
 	return "returns the sign of the argument."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (s *Sign) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements sql.Expression
 func (s *Sign) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	arg, err := s.EvalChild(ctx, row)
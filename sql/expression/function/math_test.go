@@ -15,6 +15,7 @@
 package function
 
 import (
+	"context"
 	"math"
 	"testing"
 	"time"
@@ -54,35 +55,47 @@ func TestRandWithSeed(t *testing.T) {
 
 	assert.Equal(t, sql.Float64, r.Type())
 	assert.Equal(t, "RAND(10)", r.String())
+	assert.Equal(t, sql.Volatile, sql.GetFunctionVolatility(r.(sql.FunctionExpression)))
 
+	// A constant seed returns the same sequence for the rows of each statement
 	f, err := r.Eval(nil, nil)
 	require.NoError(t, err)
-	f64 := f.(float64)
+	assert.Equal(t, 0.5660920659323543, f)
 
-	assert.GreaterOrEqual(t, f64, float64(0))
-	assert.Less(t, f64, float64(1))
+	f, err = r.Eval(nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0.41765200380165207, f)
 
+	r, _ = NewRand(expression.NewLiteral(10, sql.Int8))
 	f, err = r.Eval(nil, nil)
 	require.NoError(t, err)
-	f642 := f.(float64)
+	assert.Equal(t, 0.5660920659323543, f)
 
-	assert.Equal(t, f64, f642)
+	f, err = r.Eval(sql.NewContext(context.Background(), sql.WithPid(1)), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0.5660920659323543, f)
 
 	r, _ = NewRand(expression.NewLiteral("not a number", sql.LongText))
 	assert.Equal(t, `RAND("not a number")`, r.String())
 
 	f, err = r.Eval(nil, nil)
 	require.NoError(t, err)
-	f64 = f.(float64)
+	f64 := f.(float64)
 
 	assert.GreaterOrEqual(t, f64, float64(0))
 	assert.Less(t, f64, float64(1))
 
-	f, err = r.Eval(nil, nil)
+	// A column seed returns the same result for the same seed
+	r, _ = NewRand(expression.NewGetField(0, sql.Int64, "i", false))
+	assert.Equal(t, sql.Deterministic, sql.GetFunctionVolatility(r.(sql.FunctionExpression)))
+
+	f, err = r.Eval(nil, sql.Row{int64(10)})
 	require.NoError(t, err)
-	f642 = f.(float64)
+	assert.Equal(t, 0.5660920659323543, f)
 
-	assert.Equal(t, f64, f642)
+	f, err = r.Eval(nil, sql.Row{int64(10)})
+	require.NoError(t, err)
+	assert.Equal(t, 0.5660920659323543, f)
 }
 
 func TestRadians(t *testing.T) {
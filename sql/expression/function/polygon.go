@@ -82,9 +82,9 @@ func pointOrientation(p1, p2, p3 sql.Point) int {
 	}
 }
 
-// Check if point c is in line segment ab
+// Check if point c, which is collinear with points a and b, is in line segment ab, including its end points
 func onSegment(a, b, c sql.Point) bool {
-	return c.X > math.Min(a.X, b.X) && c.X < math.Max(a.X, b.X) && c.Y > math.Min(a.Y, b.Y) && c.Y < math.Max(a.Y, b.Y)
+	return c.X >= math.Min(a.X, b.X) && c.X <= math.Max(a.X, b.X) && c.Y >= math.Min(a.Y, b.Y) && c.Y <= math.Max(a.Y, b.Y)
 }
 
 // TODO: https://www.geeksforgeeks.org/check-if-two-given-line-segments-intersect/
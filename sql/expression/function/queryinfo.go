@@ -20,6 +20,11 @@ func (r RowCount) Description() string {
 	return "returns the number of rows updated."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (r RowCount) Volatility() sql.FunctionVolatility {
+	return sql.Stable
+}
+
 // Resolved implements sql.Expression
 func (r RowCount) Resolved() bool {
 	return true
@@ -78,6 +83,11 @@ func (r LastInsertId) Description() string {
 	return "returns value of the AUTOINCREMENT column for the last INSERT."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (r LastInsertId) Volatility() sql.FunctionVolatility {
+	return sql.Stable
+}
+
 // Resolved implements sql.Expression
 func (r LastInsertId) Resolved() bool {
 	return true
@@ -141,6 +151,11 @@ func (r FoundRows) Description() string {
 	return "for a SELECT with a LIMIT clause, returns the number of rows that would be returned were there no LIMIT clause."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (r FoundRows) Volatility() sql.FunctionVolatility {
+	return sql.Stable
+}
+
 // Resolved implements sql.Expression
 func (r FoundRows) Resolved() bool {
 	return true
@@ -33,11 +33,14 @@ var BuiltIns = []sql.Function{
 	// elt, find_in_set, insert, load_file, locate
 	sql.Function1{Name: "abs", Fn: NewAbsVal},
 	sql.Function1{Name: "acos", Fn: NewAcos},
+	sql.FunctionN{Name: "aes_decrypt", Fn: NewAESDecrypt},
+	sql.FunctionN{Name: "aes_encrypt", Fn: NewAESEncrypt},
 	sql.Function1{Name: "array_length", Fn: NewArrayLength},
 	sql.Function1{Name: "ascii", Fn: NewAscii},
 	sql.Function1{Name: "asin", Fn: NewAsin},
 	sql.Function1{Name: "atan", Fn: NewAtan},
 	sql.Function1{Name: "avg", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewAvg(e) }},
+	sql.Function2{Name: "benchmark", Fn: NewBenchmark},
 	sql.Function1{Name: "bin", Fn: NewBin},
 	sql.FunctionN{Name: "bin_to_uuid", Fn: NewBinToUUID},
 	sql.Function1{Name: "bit_length", Fn: NewBitlength},
@@ -45,10 +48,12 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "ceiling", Fn: NewCeil},
 	sql.Function1{Name: "char_length", Fn: NewCharLength},
 	sql.Function1{Name: "character_length", Fn: NewCharLength},
+	sql.Function1{Name: "compress", Fn: NewCompress},
 	sql.FunctionN{Name: "coalesce", Fn: NewCoalesce},
 	sql.FunctionN{Name: "concat", Fn: NewConcat},
 	sql.FunctionN{Name: "concat_ws", Fn: NewConcatWithSeparator},
 	sql.NewFunction0("connection_id", NewConnectionID),
+	sql.Function3{Name: "conv", Fn: NewConv},
 	sql.Function1{Name: "cos", Fn: NewCos},
 	sql.Function1{Name: "cot", Fn: NewCot},
 	sql.Function3{Name: "convert_tz", Fn: NewConvertTz},
@@ -78,6 +83,8 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "floor", Fn: NewFloor},
 	sql.Function0{Name: "found_rows", Fn: NewFoundRows},
 	sql.FunctionN{Name: "format", Fn: NewFormat},
+	sql.Function1{Name: "format_bytes", Fn: NewFormatBytes},
+	sql.Function1{Name: "format_pico_time", Fn: NewFormatPicoTime},
 	sql.Function1{Name: "from_base64", Fn: NewFromBase64},
 	sql.Function1{Name: "from_unixtime", Fn: NewFromUnixtime},
 	sql.FunctionN{Name: "greatest", Fn: NewGreatest},
@@ -131,8 +138,10 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "json_value", Fn: NewJSONValue},
 	sql.FunctionN{Name: "lag", Fn: func(e ...sql.Expression) (sql.Expression, error) { return window.NewLag(e...) }},
 	sql.Function1{Name: "last", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewLast(e) }},
+	sql.Function1{Name: "last_value", Fn: window.NewLastValue},
 	sql.Function0{Name: "last_insert_id", Fn: NewLastInsertId},
 	sql.Function1{Name: "lcase", Fn: NewLower},
+	sql.FunctionN{Name: "lead", Fn: func(e ...sql.Expression) (sql.Expression, error) { return window.NewLead(e...) }},
 	sql.FunctionN{Name: "least", Fn: NewLeast},
 	sql.Function2{Name: "left", Fn: NewLeft},
 	sql.Function1{Name: "length", Fn: NewLength},
@@ -147,6 +156,10 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "lpad", Fn: NewLeftPad},
 	sql.Function1{Name: "ltrim", Fn: NewLeftTrim},
 	sql.Function1{Name: "max", Fn: func(e sql.Expression) sql.Expression { return aggregation.NewMax(e) }},
+	sql.Function2{Name: "mbrcontains", Fn: NewMBRContains},
+	sql.Function2{Name: "mbrintersects", Fn: NewMBRIntersects},
+	sql.Function2{Name: "mbrtouches", Fn: NewMBRTouches},
+	sql.Function2{Name: "mbrwithin", Fn: NewMBRWithin},
 	sql.Function1{Name: "md5", Fn: NewMD5},
 	sql.Function1{Name: "microsecond", Fn: NewMicrosecond},
 	sql.FunctionN{Name: "mid", Fn: NewSubstring},
@@ -155,6 +168,8 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "month", Fn: NewMonth},
 	sql.Function1{Name: "monthname", Fn: NewMonthName},
 	sql.FunctionN{Name: "now", Fn: NewNow},
+	sql.FunctionN{Name: "nth_value", Fn: window.NewNthValue},
+	sql.FunctionN{Name: "ntile", Fn: window.NewNtile},
 	sql.Function2{Name: "nullif", Fn: NewNullIf},
 	sql.Function2{Name: "point", Fn: NewPoint},
 	sql.FunctionN{Name: "polygon", Fn: NewPolygon},
@@ -162,6 +177,7 @@ var BuiltIns = []sql.Function{
 	sql.Function2{Name: "power", Fn: NewPower},
 	sql.Function1{Name: "radians", Fn: NewRadians},
 	sql.FunctionN{Name: "rand", Fn: NewRand},
+	sql.Function1{Name: "random_bytes", Fn: NewRandomBytes},
 	sql.FunctionN{Name: "regexp_like", Fn: NewRegexpLike},
 	sql.FunctionN{Name: "regexp_replace", Fn: NewRegexpReplace},
 	sql.Function2{Name: "repeat", Fn: NewRepeat},
@@ -194,15 +210,33 @@ var BuiltIns = []sql.Function{
 	sql.Function1{Name: "st_astext", Fn: NewAsWKT},
 	sql.FunctionN{Name: "st_geomfromgeojson", Fn: NewGeomFromGeoJSON},
 	sql.FunctionN{Name: "st_geomfromtext", Fn: NewGeomFromWKT},
+	sql.FunctionN{Name: "st_geometryfromtext", Fn: NewGeomFromWKT},
 	sql.FunctionN{Name: "st_geomfromwkb", Fn: NewGeomFromWKB},
+	sql.FunctionN{Name: "st_geometryfromwkb", Fn: NewGeomFromWKB},
 	sql.FunctionN{Name: "st_linefromwkb", Fn: NewLineFromWKB},
+	sql.FunctionN{Name: "st_linestringfromwkb", Fn: NewLineFromWKB},
 	sql.FunctionN{Name: "st_pointfromwkb", Fn: NewPointFromWKB},
 	sql.FunctionN{Name: "st_polyfromwkb", Fn: NewPolyFromWKB},
+	sql.FunctionN{Name: "st_polygonfromwkb", Fn: NewPolyFromWKB},
 	sql.FunctionN{Name: "st_geomfromwkt", Fn: NewGeomFromWKT},
+	sql.FunctionN{Name: "st_linefromtext", Fn: NewLineFromWKT},
+	sql.FunctionN{Name: "st_linestringfromtext", Fn: NewLineFromWKT},
 	sql.FunctionN{Name: "st_linefromwkt", Fn: NewLineFromWKT},
+	sql.FunctionN{Name: "st_pointfromtext", Fn: NewPointFromWKT},
 	sql.FunctionN{Name: "st_pointfromwkt", Fn: NewPointFromWKT},
+	sql.FunctionN{Name: "st_polyfromtext", Fn: NewPolyFromWKT},
+	sql.FunctionN{Name: "st_polygonfromtext", Fn: NewPolyFromWKT},
 	sql.FunctionN{Name: "st_polyfromwkt", Fn: NewPolyFromWKT},
+	sql.Function1{Name: "st_area", Fn: NewSTArea},
+	sql.Function1{Name: "st_centroid", Fn: NewSTCentroid},
+	sql.Function2{Name: "st_contains", Fn: NewSTContains},
+	sql.Function2{Name: "st_distance", Fn: NewSTDistance},
+	sql.Function2{Name: "st_intersects", Fn: NewSTIntersects},
+	sql.Function1{Name: "st_length", Fn: NewSTLength},
 	sql.FunctionN{Name: "st_srid", Fn: NewSRID},
+	sql.Function2{Name: "st_touches", Fn: NewSTTouches},
+	sql.Function2{Name: "st_transform", Fn: NewTransform},
+	sql.Function2{Name: "st_within", Fn: NewSTWithin},
 	sql.FunctionN{Name: "st_x", Fn: NewSTX},
 	sql.FunctionN{Name: "st_y", Fn: NewSTY},
 	sql.FunctionN{Name: "substr", Fn: NewSubstring},
@@ -216,13 +250,17 @@ var BuiltIns = []sql.Function{
 	sql.FunctionN{Name: "timestamp", Fn: NewTimestamp},
 	sql.Function3{Name: "timestampdiff", Fn: NewTimestampDiff},
 	sql.Function1{Name: "to_base64", Fn: NewToBase64},
+	sql.Function2{Name: "truncate", Fn: NewTruncate},
 	sql.Function1{Name: "ucase", Fn: NewUpper},
+	sql.Function1{Name: "uncompress", Fn: NewUncompress},
+	sql.Function1{Name: "uncompressed_length", Fn: NewUncompressedLength},
 	sql.Function1{Name: "unhex", Fn: NewUnhex},
 	sql.FunctionN{Name: "unix_timestamp", Fn: NewUnixTimestamp},
 	sql.Function1{Name: "upper", Fn: NewUpper},
 	sql.NewFunction0("user", NewUser),
 	sql.FunctionN{Name: "utc_timestamp", Fn: NewUTCTimestamp},
 	sql.Function0{Name: "uuid", Fn: NewUUIDFunc},
+	sql.Function0{Name: "uuid_short", Fn: NewUUIDShortFunc},
 	sql.FunctionN{Name: "uuid_to_bin", Fn: NewUUIDToBin},
 	sql.FunctionN{Name: "week", Fn: NewWeek},
 	sql.Function1{Name: "values", Fn: NewValues},
@@ -46,6 +46,11 @@ func (r *Reverse) Description() string {
 	return "returns the string str with the order of the characters reversed."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (r *Reverse) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements the Expression interface.
 func (r *Reverse) Eval(
 	ctx *sql.Context,
@@ -113,6 +118,11 @@ func (r *Repeat) Description() string {
 	return "returns a string consisting of the string str repeated count times."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (r *Repeat) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 func (r *Repeat) String() string {
 	return fmt.Sprintf("repeat(%s, %s)", r.Left, r.Right)
 }
@@ -185,6 +195,11 @@ func (r *Replace) Description() string {
 	return "returns the string str with all occurrences of the string from_str replaced by the string to_str."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (r *Replace) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Children implements the Expression interface.
 func (r *Replace) Children() []sql.Expression {
 	return []sql.Expression{r.str, r.fromStr, r.toStr}
@@ -83,6 +83,11 @@ func (p *Pad) Description() string {
 	}
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (p *Pad) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Children implements the Expression interface.
 func (p *Pad) Children() []sql.Expression {
 	return []sql.Expression{p.str, p.length, p.padStr}
@@ -163,29 +168,32 @@ func (p *Pad) Eval(
 	return padString(str.(string), length.(int64), padStr.(string), p.padType)
 }
 
+// padString pads the string given up to |length| characters, truncating it if it's longer. Lengths are in
+// characters, not bytes, so multibyte strings are padded and truncated on character boundaries.
 func padString(str string, length int64, padStr string, padType padType) (string, error) {
 	if length <= 0 {
 		return "", nil
 	}
-	if int64(len(str)) >= length {
-		return str[:length], nil
+	text := []rune(str)
+	if int64(len(text)) >= length {
+		return string(text[:length]), nil
 	}
-	if len(padStr) == 0 {
+	padText := []rune(padStr)
+	if len(padText) == 0 {
 		return "", nil
 	}
 
-	padLen := int(length - int64(len(str)))
-	quo, rem, err := divmod(int64(padLen), int64(len(padStr)))
+	padLen := int(length - int64(len(text)))
+	quo, rem, err := divmod(int64(padLen), int64(len(padText)))
 	if err != nil {
 		return "", err
 	}
 
+	padding := strings.Repeat(padStr, int(quo)) + string(padText[:rem])
 	if padType == lPadType {
-		result := strings.Repeat(padStr, int(quo)) + padStr[:rem] + str
-		return result[:length], nil
+		return padding + str, nil
 	}
-	result := str + strings.Repeat(padStr, int(quo)) + padStr[:rem]
-	return result[(int64(len(result)) - length):], nil
+	return str + padding, nil
 }
 
 func divmod(a, b int64) (quotient, remainder int64, err error) {
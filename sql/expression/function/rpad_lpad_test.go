@@ -55,6 +55,10 @@ func TestLPad(t *testing.T) {
 		{"padStr repeats exactly once", sql.NewRow("foo", 6, "abc"), "abcfoo", false},
 		{"padStr does not repeat once", sql.NewRow("foo", 5, "abc"), "abfoo", false},
 		{"padStr repeats many times", sql.NewRow("foo", 10, "abc"), "abcabcafoo", false},
+
+		{"multibyte string and len < len(str)", sql.NewRow("日本語", 2, "x"), "日本", false},
+		{"multibyte padStr", sql.NewRow("foo", 5, "äöü"), "äöfoo", false},
+		{"multibyte string and padStr", sql.NewRow("日本", 5, "語"), "語語語日本", false},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -105,6 +109,10 @@ func TestRPad(t *testing.T) {
 		{"padStr repeats exactly once", sql.NewRow("foo", 6, "abc"), "fooabc", false},
 		{"padStr does not repeat once", sql.NewRow("foo", 5, "abc"), "fooab", false},
 		{"padStr repeats many times", sql.NewRow("foo", 10, "abc"), "fooabcabca", false},
+
+		{"multibyte string and len < len(str)", sql.NewRow("日本語", 2, "x"), "日本", false},
+		{"multibyte padStr", sql.NewRow("foo", 5, "äöü"), "fooäö", false},
+		{"multibyte string and padStr", sql.NewRow("日本", 5, "語"), "日本語語語", false},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -47,6 +47,11 @@ func (s *Sleep) Description() string {
 	return "waits for the specified number of seconds (can be fractional)."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (s *Sleep) Volatility() sql.FunctionVolatility {
+	return sql.Volatile
+}
+
 // Eval implements the Expression interface.
 func (s *Sleep) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	child, err := s.Child.Eval(ctx, row)
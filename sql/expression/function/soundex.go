@@ -48,6 +48,11 @@ func (s *Soundex) Description() string {
 	return "returns the soundex of a string."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (s *Soundex) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements the Expression interface.
 func (s *Soundex) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	v, err := s.Child.Eval(ctx, row)
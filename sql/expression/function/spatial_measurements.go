@@ -0,0 +1,408 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"math"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// ErrNotImplementedForGeographicSRS is returned by the measurement functions for geometries of a geographic spatial
+// reference system, whose coordinates are on the ellipsoid of the Earth rather than on a plane.
+var ErrNotImplementedForGeographicSRS = errors.NewKind("%s(%s) has not been implemented for geographic spatial reference systems.")
+
+// geometryTypeName returns the name of the type of the geometry given.
+func geometryTypeName(g interface{}) string {
+	switch g.(type) {
+	case sql.Point:
+		return sql.PointType{}.String()
+	case sql.Linestring:
+		return sql.LinestringType{}.String()
+	case sql.Polygon:
+		return sql.PolygonType{}.String()
+	default:
+		return fmt.Sprintf("%T", g)
+	}
+}
+
+// evalCartesianGeometry evaluates the geometry argument of the measurement function given, and returns an error if
+// it isn't a geometry of the Cartesian plane.
+func evalCartesianGeometry(ctx *sql.Context, row sql.Row, f sql.FunctionExpression, e sql.Expression) (interface{}, error) {
+	g, err := e.Eval(ctx, row)
+	if err != nil || g == nil {
+		return nil, err
+	}
+
+	srid, ok := sql.GeometrySRID(g)
+	if !ok {
+		return nil, sql.ErrIllegalGISValue.New(g)
+	}
+	if srid != CartesianSRID {
+		return nil, ErrNotImplementedForGeographicSRS.New(f.FunctionName(), geometryTypeName(g))
+	}
+	return g, nil
+}
+
+// pointDistance returns the distance between the points given.
+func pointDistance(p, q sql.Point) float64 {
+	return math.Hypot(q.X-p.X, q.Y-p.Y)
+}
+
+// segmentDistance returns the distance between the point and the line segment given.
+func segmentDistance(p sql.Point, s segment) float64 {
+	dx, dy := s.b.X-s.a.X, s.b.Y-s.a.Y
+	lengthSquared := dx*dx + dy*dy
+	if lengthSquared == 0 {
+		return pointDistance(p, s.a)
+	}
+	t := ((p.X-s.a.X)*dx + (p.Y-s.a.Y)*dy) / lengthSquared
+	t = math.Max(0, math.Min(1, t))
+	return pointDistance(p, sql.Point{X: s.a.X + t*dx, Y: s.a.Y + t*dy})
+}
+
+// geometryDistance returns the shortest distance between the geometries given, which is 0 when they intersect. Otherwise
+// the closest points of the geometries are a point of one of the geometries and a point on a segment of the other.
+func geometryDistance(a, b interface{}) float64 {
+	if geometriesIntersect(a, b) {
+		return 0
+	}
+
+	distance := math.Inf(1)
+	for _, pair := range [][2]interface{}{{a, b}, {b, a}} {
+		segments := geometrySegments(pair[1])
+		for _, p := range geometryPoints(pair[0]) {
+			for _, q := range geometryPoints(pair[1]) {
+				distance = math.Min(distance, pointDistance(p, q))
+			}
+			for _, s := range segments {
+				distance = math.Min(distance, segmentDistance(p, s))
+			}
+		}
+	}
+	return distance
+}
+
+// lineLength returns the length of the linestring given.
+func lineLength(l sql.Linestring) float64 {
+	var length float64
+	for _, s := range geometrySegments(l) {
+		length += pointDistance(s.a, s.b)
+	}
+	return length
+}
+
+// ringArea returns the signed area of the linear ring given, which is positive when its points are counter-clockwise.
+func ringArea(ring sql.Linestring) float64 {
+	var area float64
+	for _, s := range geometrySegments(ring) {
+		area += s.a.X*s.b.Y - s.b.X*s.a.Y
+	}
+	return area / 2
+}
+
+// polygonArea returns the area of the polygon given: the area of its exterior ring, minus the areas of its holes.
+func polygonArea(p sql.Polygon) float64 {
+	if len(p.Lines) == 0 {
+		return 0
+	}
+	area := math.Abs(ringArea(p.Lines[0]))
+	for _, hole := range p.Lines[1:] {
+		area -= math.Abs(ringArea(hole))
+	}
+	return area
+}
+
+// geometryCentroid returns the centroid of the geometry given, and false for an empty geometry. The centroid of a
+// polygon is the centroid of its area, and the centroid of a linestring, or of a polygon without area, is the centroid
+// of its segments weighted by their lengths.
+func geometryCentroid(g interface{}) (sql.Point, bool) {
+	srid, _ := sql.GeometrySRID(g)
+	if p, ok := g.(sql.Point); ok {
+		return p, true
+	}
+
+	if p, ok := g.(sql.Polygon); ok && polygonArea(p) != 0 {
+		var x, y, area float64
+		for i, ring := range p.Lines {
+			ringSign := 1.0
+			if i > 0 {
+				ringSign = -1
+			}
+			// The rings are taken counter-clockwise, and the holes are subtracted from the exterior ring
+			if ringArea(ring) < 0 {
+				ringSign = -ringSign
+			}
+			for _, s := range geometrySegments(ring) {
+				cross := (s.a.X*s.b.Y - s.b.X*s.a.Y) * ringSign
+				x += (s.a.X + s.b.X) * cross
+				y += (s.a.Y + s.b.Y) * cross
+				area += cross / 2
+			}
+		}
+		return sql.Point{SRID: srid, X: x / (6 * area), Y: y / (6 * area)}, true
+	}
+
+	var x, y, length float64
+	for _, s := range geometrySegments(g) {
+		l := pointDistance(s.a, s.b)
+		x += (s.a.X + s.b.X) / 2 * l
+		y += (s.a.Y + s.b.Y) / 2 * l
+		length += l
+	}
+	if length == 0 {
+		// All the points of the geometry are the same point
+		points := geometryPoints(g)
+		if len(points) == 0 {
+			return sql.Point{}, false
+		}
+		return sql.Point{SRID: srid, X: points[0].X, Y: points[0].Y}, true
+	}
+	return sql.Point{SRID: srid, X: x / length, Y: y / length}, true
+}
+
+// STDistance is a function that returns the shortest distance between two geometries.
+type STDistance struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*STDistance)(nil)
+
+// NewSTDistance creates a new ST_DISTANCE expression.
+func NewSTDistance(g1, g2 sql.Expression) sql.Expression {
+	return &STDistance{expression.BinaryExpression{Left: g1, Right: g2}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (d *STDistance) FunctionName() string {
+	return "st_distance"
+}
+
+// Description implements sql.FunctionExpression
+func (d *STDistance) Description() string {
+	return "returns the shortest distance between the two geometries."
+}
+
+// Type implements the sql.Expression interface.
+func (d *STDistance) Type() sql.Type {
+	return sql.Float64
+}
+
+func (d *STDistance) String() string {
+	return fmt.Sprintf("ST_DISTANCE(%s,%s)", d.Left, d.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (d *STDistance) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), 2)
+	}
+	return NewSTDistance(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (d *STDistance) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	a, err := d.Left.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	b, err := d.Right.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil || b == nil {
+		return nil, nil
+	}
+
+	aSRID, ok := sql.GeometrySRID(a)
+	if !ok {
+		return nil, sql.ErrIllegalGISValue.New(a)
+	}
+	bSRID, ok := sql.GeometrySRID(b)
+	if !ok {
+		return nil, sql.ErrIllegalGISValue.New(b)
+	}
+	if aSRID != bSRID {
+		return nil, ErrDiffSRIDs.New(d.FunctionName(), aSRID, bSRID)
+	}
+	if aSRID != CartesianSRID {
+		return nil, ErrNotImplementedForGeographicSRS.New(d.FunctionName(), geometryTypeName(a)+", "+geometryTypeName(b))
+	}
+
+	return geometryDistance(a, b), nil
+}
+
+// STLength is a function that returns the length of a linestring.
+type STLength struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*STLength)(nil)
+
+// NewSTLength creates a new ST_LENGTH expression.
+func NewSTLength(ls sql.Expression) sql.Expression {
+	return &STLength{expression.UnaryExpression{Child: ls}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (l *STLength) FunctionName() string {
+	return "st_length"
+}
+
+// Description implements sql.FunctionExpression
+func (l *STLength) Description() string {
+	return "returns the length of the given linestring."
+}
+
+// Type implements the sql.Expression interface.
+func (l *STLength) Type() sql.Type {
+	return sql.Float64
+}
+
+func (l *STLength) String() string {
+	return fmt.Sprintf("ST_LENGTH(%s)", l.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (l *STLength) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(l, len(children), 1)
+	}
+	return NewSTLength(children[0]), nil
+}
+
+// Eval implements the sql.Expression interface. Geometries other than linestrings have no length.
+func (l *STLength) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	g, err := evalCartesianGeometry(ctx, row, l, l.Child)
+	if err != nil || g == nil {
+		return nil, err
+	}
+
+	line, ok := g.(sql.Linestring)
+	if !ok {
+		return nil, nil
+	}
+	return lineLength(line), nil
+}
+
+// STArea is a function that returns the area of a polygon.
+type STArea struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*STArea)(nil)
+
+// NewSTArea creates a new ST_AREA expression.
+func NewSTArea(poly sql.Expression) sql.Expression {
+	return &STArea{expression.UnaryExpression{Child: poly}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (a *STArea) FunctionName() string {
+	return "st_area"
+}
+
+// Description implements sql.FunctionExpression
+func (a *STArea) Description() string {
+	return "returns the area of the given polygon."
+}
+
+// Type implements the sql.Expression interface.
+func (a *STArea) Type() sql.Type {
+	return sql.Float64
+}
+
+func (a *STArea) String() string {
+	return fmt.Sprintf("ST_AREA(%s)", a.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (a *STArea) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 1)
+	}
+	return NewSTArea(children[0]), nil
+}
+
+// Eval implements the sql.Expression interface. Points and linestrings have no area.
+func (a *STArea) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	g, err := evalCartesianGeometry(ctx, row, a, a.Child)
+	if err != nil || g == nil {
+		return nil, err
+	}
+
+	poly, ok := g.(sql.Polygon)
+	if !ok {
+		return 0.0, nil
+	}
+	return polygonArea(poly), nil
+}
+
+// STCentroid is a function that returns the centroid of a geometry.
+type STCentroid struct {
+	expression.UnaryExpression
+}
+
+var _ sql.FunctionExpression = (*STCentroid)(nil)
+
+// NewSTCentroid creates a new ST_CENTROID expression.
+func NewSTCentroid(g sql.Expression) sql.Expression {
+	return &STCentroid{expression.UnaryExpression{Child: g}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (c *STCentroid) FunctionName() string {
+	return "st_centroid"
+}
+
+// Description implements sql.FunctionExpression
+func (c *STCentroid) Description() string {
+	return "returns the centroid of the given geometry as a point."
+}
+
+// Type implements the sql.Expression interface.
+func (c *STCentroid) Type() sql.Type {
+	return sql.PointType{}
+}
+
+func (c *STCentroid) String() string {
+	return fmt.Sprintf("ST_CENTROID(%s)", c.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (c *STCentroid) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	return NewSTCentroid(children[0]), nil
+}
+
+// Eval implements the sql.Expression interface. The centroid of an empty geometry is NULL.
+func (c *STCentroid) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	g, err := evalCartesianGeometry(ctx, row, c, c.Child)
+	if err != nil || g == nil {
+		return nil, err
+	}
+
+	centroid, ok := geometryCentroid(g)
+	if !ok {
+		return nil, nil
+	}
+	return centroid, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestSpatialMeasurements(t *testing.T) {
+	square := poly(line(pt(0, 0), pt(4, 0), pt(4, 4), pt(0, 4), pt(0, 0)))
+	clockwise := poly(line(pt(0, 0), pt(0, 4), pt(4, 4), pt(4, 0), pt(0, 0)))
+	squareWithHole := poly(
+		line(pt(0, 0), pt(4, 0), pt(4, 4), pt(0, 4), pt(0, 0)),
+		line(pt(0, 0), pt(2, 0), pt(2, 4), pt(0, 4), pt(0, 0)),
+	)
+	lShape := line(pt(0, 0), pt(3, 0), pt(3, 1))
+
+	tests := []struct {
+		name     string
+		expr     sql.Expression
+		expected interface{}
+	}{
+		{"area of square", NewSTArea(geometryLiteral(square)), 16.0},
+		{"area of clockwise square", NewSTArea(geometryLiteral(clockwise)), 16.0},
+		{"area of polygon with hole", NewSTArea(geometryLiteral(squareWithHole)), 8.0},
+		{"area of linestring", NewSTArea(geometryLiteral(lShape)), 0.0},
+		{"area of point", NewSTArea(geometryLiteral(pt(1, 1))), 0.0},
+		{"length of linestring", NewSTLength(geometryLiteral(lShape)), 4.0},
+		{"length of point", NewSTLength(geometryLiteral(pt(1, 1))), nil},
+		{"length of polygon", NewSTLength(geometryLiteral(square)), nil},
+		{"distance between points", NewSTDistance(geometryLiteral(pt(0, 0)), geometryLiteral(pt(3, 4))), 5.0},
+		{"distance to segment", NewSTDistance(geometryLiteral(pt(1, 2)), geometryLiteral(lShape)), 2.0},
+		{"distance to segment end", NewSTDistance(geometryLiteral(pt(6, 5)), geometryLiteral(lShape)), 5.0},
+		{"distance between polygons", NewSTDistance(geometryLiteral(square), geometryLiteral(poly(line(pt(6, 1), pt(7, 1), pt(7, 2), pt(6, 1))))), 2.0},
+		{"distance inside polygon", NewSTDistance(geometryLiteral(pt(2, 2)), geometryLiteral(square)), 0.0},
+		{"distance in hole", NewSTDistance(geometryLiteral(pt(1, 2)), geometryLiteral(squareWithHole)), 1.0},
+		{"centroid of point", NewSTCentroid(geometryLiteral(pt(1, 2))), pt(1, 2)},
+		{"centroid of linestring", NewSTCentroid(geometryLiteral(lShape)), pt(1.875, 0.125)},
+		{"centroid of square", NewSTCentroid(geometryLiteral(clockwise)), pt(2, 2)},
+		{"centroid of polygon with hole", NewSTCentroid(geometryLiteral(squareWithHole)), pt(3, 2)},
+		{"centroid of empty linestring", NewSTCentroid(geometryLiteral(line())), nil},
+		{"null geometry", NewSTArea(expression.NewLiteral(nil, sql.Null)), nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			v, err := test.expr.Eval(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Equal(test.expected, v, test.expr.String())
+		})
+	}
+}
+
+func TestSpatialMeasurementArguments(t *testing.T) {
+	t.Run("geographic SRID", func(t *testing.T) {
+		require := require.New(t)
+		f := NewSTLength(geometryLiteral(sql.Linestring{SRID: 4326, Points: []sql.Point{{SRID: 4326, X: 1, Y: 2}, {SRID: 4326, X: 3, Y: 4}}}))
+
+		_, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.True(ErrNotImplementedForGeographicSRS.Is(err))
+	})
+
+	t.Run("different SRIDs", func(t *testing.T) {
+		require := require.New(t)
+		f := NewSTDistance(geometryLiteral(pt(1, 2)), geometryLiteral(sql.Point{SRID: 4326, X: 1, Y: 2}))
+
+		_, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.True(ErrDiffSRIDs.Is(err))
+	})
+
+	t.Run("not a geometry", func(t *testing.T) {
+		require := require.New(t)
+		f := NewSTCentroid(geometryLiteral("POINT(1 2)"))
+
+		_, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.True(sql.ErrIllegalGISValue.Is(err))
+	})
+}
@@ -0,0 +1,420 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// ErrDiffSRIDs is returned when a function of two geometries is given geometries of different spatial reference systems.
+var ErrDiffSRIDs = errors.NewKind("Binary geometry function %s given two geometries of different srids: %d and %d, which should have been identical.")
+
+var (
+	mbrContains   = mbrRelation(geometryContains)
+	mbrWithin     = mbrRelation(geometryWithin)
+	mbrIntersects = mbrRelation(geometriesIntersect)
+	mbrTouches    = mbrRelation(geometriesTouch)
+)
+
+// evalSpatialRelation returns whether the spatial relation given holds between the geometries of the function given,
+// or nil if either geometry is NULL.
+func evalSpatialRelation(ctx *sql.Context, row sql.Row, f sql.FunctionExpression, e expression.BinaryExpression, relation func(a, b interface{}) bool) (interface{}, error) {
+	a, err := e.Left.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	b, err := e.Right.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if a == nil || b == nil {
+		return nil, nil
+	}
+
+	aSRID, ok := sql.GeometrySRID(a)
+	if !ok {
+		return nil, sql.ErrIllegalGISValue.New(a)
+	}
+	bSRID, ok := sql.GeometrySRID(b)
+	if !ok {
+		return nil, sql.ErrIllegalGISValue.New(b)
+	}
+	if aSRID != bSRID {
+		return nil, ErrDiffSRIDs.New(f.FunctionName(), aSRID, bSRID)
+	}
+
+	return relation(a, b), nil
+}
+
+// STContains is a function that returns whether a geometry contains another geometry: no point of the other
+// geometry is in the exterior of the geometry, and their interiors have a point in common.
+type STContains struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*STContains)(nil)
+
+// NewSTContains creates a new ST_CONTAINS expression.
+func NewSTContains(g1, g2 sql.Expression) sql.Expression {
+	return &STContains{expression.BinaryExpression{Left: g1, Right: g2}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *STContains) FunctionName() string {
+	return "st_contains"
+}
+
+// Description implements sql.FunctionExpression
+func (s *STContains) Description() string {
+	return "returns whether the first geometry contains the second geometry."
+}
+
+// Type implements the sql.Expression interface.
+func (s *STContains) Type() sql.Type {
+	return sql.Boolean
+}
+
+func (s *STContains) String() string {
+	return fmt.Sprintf("ST_CONTAINS(%s,%s)", s.Left, s.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (s *STContains) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 2)
+	}
+	return NewSTContains(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (s *STContains) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return evalSpatialRelation(ctx, row, s, s.BinaryExpression, geometryContains)
+}
+
+// STWithin is a function that returns whether a geometry is within another geometry, which is when the other
+// geometry contains it.
+type STWithin struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*STWithin)(nil)
+
+// NewSTWithin creates a new ST_WITHIN expression.
+func NewSTWithin(g1, g2 sql.Expression) sql.Expression {
+	return &STWithin{expression.BinaryExpression{Left: g1, Right: g2}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *STWithin) FunctionName() string {
+	return "st_within"
+}
+
+// Description implements sql.FunctionExpression
+func (s *STWithin) Description() string {
+	return "returns whether the first geometry is within the second geometry."
+}
+
+// Type implements the sql.Expression interface.
+func (s *STWithin) Type() sql.Type {
+	return sql.Boolean
+}
+
+func (s *STWithin) String() string {
+	return fmt.Sprintf("ST_WITHIN(%s,%s)", s.Left, s.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (s *STWithin) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 2)
+	}
+	return NewSTWithin(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (s *STWithin) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return evalSpatialRelation(ctx, row, s, s.BinaryExpression, geometryWithin)
+}
+
+// STIntersects is a function that returns whether two geometries have any point in common.
+type STIntersects struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*STIntersects)(nil)
+
+// NewSTIntersects creates a new ST_INTERSECTS expression.
+func NewSTIntersects(g1, g2 sql.Expression) sql.Expression {
+	return &STIntersects{expression.BinaryExpression{Left: g1, Right: g2}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *STIntersects) FunctionName() string {
+	return "st_intersects"
+}
+
+// Description implements sql.FunctionExpression
+func (s *STIntersects) Description() string {
+	return "returns whether the two geometries intersect."
+}
+
+// Type implements the sql.Expression interface.
+func (s *STIntersects) Type() sql.Type {
+	return sql.Boolean
+}
+
+func (s *STIntersects) String() string {
+	return fmt.Sprintf("ST_INTERSECTS(%s,%s)", s.Left, s.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (s *STIntersects) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 2)
+	}
+	return NewSTIntersects(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (s *STIntersects) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return evalSpatialRelation(ctx, row, s, s.BinaryExpression, geometriesIntersect)
+}
+
+// STTouches is a function that returns whether two geometries have points in common, but only on their boundaries.
+type STTouches struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*STTouches)(nil)
+
+// NewSTTouches creates a new ST_TOUCHES expression.
+func NewSTTouches(g1, g2 sql.Expression) sql.Expression {
+	return &STTouches{expression.BinaryExpression{Left: g1, Right: g2}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (s *STTouches) FunctionName() string {
+	return "st_touches"
+}
+
+// Description implements sql.FunctionExpression
+func (s *STTouches) Description() string {
+	return "returns whether the two geometries touch, which is when their boundaries intersect but their interiors don't."
+}
+
+// Type implements the sql.Expression interface.
+func (s *STTouches) Type() sql.Type {
+	return sql.Boolean
+}
+
+func (s *STTouches) String() string {
+	return fmt.Sprintf("ST_TOUCHES(%s,%s)", s.Left, s.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (s *STTouches) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(s, len(children), 2)
+	}
+	return NewSTTouches(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (s *STTouches) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return evalSpatialRelation(ctx, row, s, s.BinaryExpression, geometriesTouch)
+}
+
+// MBRContains is a function that returns whether the minimum bounding rectangle of a geometry contains the minimum
+// bounding rectangle of another geometry.
+type MBRContains struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*MBRContains)(nil)
+
+// NewMBRContains creates a new MBRCONTAINS expression.
+func NewMBRContains(g1, g2 sql.Expression) sql.Expression {
+	return &MBRContains{expression.BinaryExpression{Left: g1, Right: g2}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (m *MBRContains) FunctionName() string {
+	return "mbrcontains"
+}
+
+// Description implements sql.FunctionExpression
+func (m *MBRContains) Description() string {
+	return "returns whether the minimum bounding rectangle of the first geometry contains the minimum bounding rectangle of the second geometry."
+}
+
+// Type implements the sql.Expression interface.
+func (m *MBRContains) Type() sql.Type {
+	return sql.Boolean
+}
+
+func (m *MBRContains) String() string {
+	return fmt.Sprintf("MBRCONTAINS(%s,%s)", m.Left, m.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (m *MBRContains) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(children), 2)
+	}
+	return NewMBRContains(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (m *MBRContains) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return evalSpatialRelation(ctx, row, m, m.BinaryExpression, mbrContains)
+}
+
+// MBRWithin is a function that returns whether the minimum bounding rectangle of a geometry is within the minimum
+// bounding rectangle of another geometry.
+type MBRWithin struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*MBRWithin)(nil)
+
+// NewMBRWithin creates a new MBRWITHIN expression.
+func NewMBRWithin(g1, g2 sql.Expression) sql.Expression {
+	return &MBRWithin{expression.BinaryExpression{Left: g1, Right: g2}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (m *MBRWithin) FunctionName() string {
+	return "mbrwithin"
+}
+
+// Description implements sql.FunctionExpression
+func (m *MBRWithin) Description() string {
+	return "returns whether the minimum bounding rectangle of the first geometry is within the minimum bounding rectangle of the second geometry."
+}
+
+// Type implements the sql.Expression interface.
+func (m *MBRWithin) Type() sql.Type {
+	return sql.Boolean
+}
+
+func (m *MBRWithin) String() string {
+	return fmt.Sprintf("MBRWITHIN(%s,%s)", m.Left, m.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (m *MBRWithin) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(children), 2)
+	}
+	return NewMBRWithin(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (m *MBRWithin) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return evalSpatialRelation(ctx, row, m, m.BinaryExpression, mbrWithin)
+}
+
+// MBRIntersects is a function that returns whether the minimum bounding rectangles of two geometries intersect.
+type MBRIntersects struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*MBRIntersects)(nil)
+
+// NewMBRIntersects creates a new MBRINTERSECTS expression.
+func NewMBRIntersects(g1, g2 sql.Expression) sql.Expression {
+	return &MBRIntersects{expression.BinaryExpression{Left: g1, Right: g2}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (m *MBRIntersects) FunctionName() string {
+	return "mbrintersects"
+}
+
+// Description implements sql.FunctionExpression
+func (m *MBRIntersects) Description() string {
+	return "returns whether the minimum bounding rectangles of the two geometries intersect."
+}
+
+// Type implements the sql.Expression interface.
+func (m *MBRIntersects) Type() sql.Type {
+	return sql.Boolean
+}
+
+func (m *MBRIntersects) String() string {
+	return fmt.Sprintf("MBRINTERSECTS(%s,%s)", m.Left, m.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (m *MBRIntersects) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(children), 2)
+	}
+	return NewMBRIntersects(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (m *MBRIntersects) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return evalSpatialRelation(ctx, row, m, m.BinaryExpression, mbrIntersects)
+}
+
+// MBRTouches is a function that returns whether the minimum bounding rectangles of two geometries touch.
+type MBRTouches struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*MBRTouches)(nil)
+
+// NewMBRTouches creates a new MBRTOUCHES expression.
+func NewMBRTouches(g1, g2 sql.Expression) sql.Expression {
+	return &MBRTouches{expression.BinaryExpression{Left: g1, Right: g2}}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (m *MBRTouches) FunctionName() string {
+	return "mbrtouches"
+}
+
+// Description implements sql.FunctionExpression
+func (m *MBRTouches) Description() string {
+	return "returns whether the minimum bounding rectangles of the two geometries touch."
+}
+
+// Type implements the sql.Expression interface.
+func (m *MBRTouches) Type() sql.Type {
+	return sql.Boolean
+}
+
+func (m *MBRTouches) String() string {
+	return fmt.Sprintf("MBRTOUCHES(%s,%s)", m.Left, m.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (m *MBRTouches) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(children), 2)
+	}
+	return NewMBRTouches(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (m *MBRTouches) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return evalSpatialRelation(ctx, row, m, m.BinaryExpression, mbrTouches)
+}
@@ -0,0 +1,168 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func pt(x, y float64) sql.Point {
+	return sql.Point{X: x, Y: y}
+}
+
+func line(points ...sql.Point) sql.Linestring {
+	return sql.Linestring{Points: points}
+}
+
+func poly(rings ...sql.Linestring) sql.Polygon {
+	return sql.Polygon{Lines: rings}
+}
+
+func geometryLiteral(g interface{}) sql.Expression {
+	switch g.(type) {
+	case sql.Point:
+		return expression.NewLiteral(g, sql.PointType{})
+	case sql.Linestring:
+		return expression.NewLiteral(g, sql.LinestringType{})
+	case sql.Polygon:
+		return expression.NewLiteral(g, sql.PolygonType{})
+	default:
+		return expression.NewLiteral(g, sql.LongText)
+	}
+}
+
+func TestSpatialPredicates(t *testing.T) {
+	square := poly(line(pt(0, 0), pt(4, 0), pt(4, 4), pt(0, 4), pt(0, 0)))
+	squareWithHole := poly(
+		line(pt(0, 0), pt(4, 0), pt(4, 4), pt(0, 4), pt(0, 0)),
+		line(pt(1, 1), pt(3, 1), pt(3, 3), pt(1, 3), pt(1, 1)),
+	)
+	inner := poly(line(pt(1, 1), pt(2, 1), pt(2, 2), pt(1, 2), pt(1, 1)))
+	inHole := poly(line(pt(1.5, 1.5), pt(2.5, 1.5), pt(2.5, 2.5), pt(1.5, 2.5), pt(1.5, 1.5)))
+	adjacent := poly(line(pt(4, 0), pt(6, 0), pt(6, 4), pt(4, 4), pt(4, 0)))
+	diagonal := line(pt(1, 1), pt(3, 3))
+	crossing := line(pt(-1, 2), pt(5, 2))
+	onBoundary := line(pt(0, 0), pt(4, 0))
+	fromEdge := line(pt(4, 2), pt(6, 2))
+	up := line(pt(0, 0), pt(1, 1))
+	down := line(pt(1, 1), pt(2, 0))
+
+	tests := []struct {
+		a, b                                  interface{}
+		contains, within, intersects, touches bool
+	}{
+		{square, pt(2, 2), true, false, true, false},
+		{square, pt(0, 2), false, false, true, true},
+		{square, pt(5, 5), false, false, false, false},
+		{square, inner, true, false, true, false},
+		{inner, square, false, true, true, false},
+		{square, square, true, true, true, false},
+		{square, adjacent, false, false, true, true},
+		{square, diagonal, true, false, true, false},
+		{square, crossing, false, false, true, false},
+		{square, onBoundary, false, false, true, true},
+		{square, fromEdge, false, false, true, true},
+		{squareWithHole, pt(2, 2), false, false, false, false},
+		{squareWithHole, inHole, false, false, false, false},
+		{squareWithHole, square, false, true, true, false},
+		{crossing, diagonal, false, false, true, false},
+		{up, down, false, false, true, true},
+		{diagonal, pt(2, 2), true, false, true, false},
+		{diagonal, pt(1, 1), false, false, true, true},
+		{pt(1, 1), pt(1, 1), true, true, true, false},
+		{pt(1, 1), pt(1, 2), false, false, false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v and %v", test.a, test.b), func(t *testing.T) {
+			require := require.New(t)
+			for _, f := range []struct {
+				expr     sql.Expression
+				expected bool
+			}{
+				{NewSTContains(geometryLiteral(test.a), geometryLiteral(test.b)), test.contains},
+				{NewSTWithin(geometryLiteral(test.a), geometryLiteral(test.b)), test.within},
+				{NewSTIntersects(geometryLiteral(test.a), geometryLiteral(test.b)), test.intersects},
+				{NewSTTouches(geometryLiteral(test.a), geometryLiteral(test.b)), test.touches},
+			} {
+				v, err := f.expr.Eval(sql.NewEmptyContext(), nil)
+				require.NoError(err)
+				require.Equal(f.expected, v, f.expr.String())
+			}
+		})
+	}
+}
+
+func TestMBRPredicates(t *testing.T) {
+	require := require.New(t)
+	square := poly(line(pt(0, 0), pt(4, 0), pt(4, 4), pt(0, 4), pt(0, 0)))
+	up := line(pt(0, 0), pt(1, 1))
+	crossing := line(pt(-1, 2), pt(5, 2))
+
+	tests := []struct {
+		expr     sql.Expression
+		expected bool
+	}{
+		{NewMBRContains(geometryLiteral(square), geometryLiteral(line(pt(1, 1), pt(3, 3)))), true},
+		{NewMBRContains(geometryLiteral(square), geometryLiteral(crossing)), false},
+		{NewMBRContains(geometryLiteral(crossing), geometryLiteral(pt(2, 2))), true},
+		{NewMBRWithin(geometryLiteral(pt(2, 2)), geometryLiteral(square)), true},
+		{NewMBRWithin(geometryLiteral(square), geometryLiteral(pt(2, 2))), false},
+		{NewMBRIntersects(geometryLiteral(up), geometryLiteral(pt(1, 0))), true},
+		{NewSTIntersects(geometryLiteral(up), geometryLiteral(pt(1, 0))), false},
+		{NewMBRIntersects(geometryLiteral(up), geometryLiteral(pt(2, 0))), false},
+		{NewMBRTouches(geometryLiteral(up), geometryLiteral(pt(1, 0))), true},
+		{NewMBRTouches(geometryLiteral(square), geometryLiteral(up)), false},
+	}
+
+	for _, test := range tests {
+		v, err := test.expr.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(test.expected, v, test.expr.String())
+	}
+}
+
+func TestSpatialPredicateArguments(t *testing.T) {
+	t.Run("null geometry", func(t *testing.T) {
+		require := require.New(t)
+		f := NewSTContains(expression.NewLiteral(nil, sql.Null), geometryLiteral(pt(1, 2)))
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Nil(v)
+	})
+
+	t.Run("different SRIDs", func(t *testing.T) {
+		require := require.New(t)
+		f := NewSTIntersects(geometryLiteral(pt(1, 2)), geometryLiteral(sql.Point{SRID: 4326, X: 1, Y: 2}))
+
+		_, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.True(ErrDiffSRIDs.Is(err))
+	})
+
+	t.Run("not a geometry", func(t *testing.T) {
+		require := require.New(t)
+		f := NewMBRWithin(geometryLiteral("POINT(1 2)"), geometryLiteral(pt(1, 2)))
+
+		_, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.True(sql.ErrIllegalGISValue.Is(err))
+	})
+}
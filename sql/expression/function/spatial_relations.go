@@ -0,0 +1,384 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"math"
+	"sort"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// The spatial relations between geometries are decided from where the points of one geometry are relative to the
+// other geometry: in its interior, on its boundary or in its exterior. The interior of a point is the point itself, the
+// boundary of a linestring is its two end points unless it's closed, and the boundary of a polygon is its rings. The
+// coordinates of geometries are treated as coordinates on a plane, whatever their spatial reference system.
+
+// location is where a point is relative to a geometry.
+type location byte
+
+const (
+	exterior location = iota
+	boundary
+	interior
+)
+
+// segment is the line segment between two points of a geometry.
+type segment struct {
+	a, b sql.Point
+}
+
+// sample is a point of a geometry, and where it is relative to that geometry.
+type sample struct {
+	p   sql.Point
+	loc location
+}
+
+// offsetRatio is the distance from the middle of a piece of a polygon ring to the points next to it on both sides of
+// the ring, relative to the length of the piece.
+const offsetRatio = 1e-7
+
+// geometryPoints returns the points that the geometry given is made of.
+func geometryPoints(g interface{}) []sql.Point {
+	switch g := g.(type) {
+	case sql.Point:
+		return []sql.Point{g}
+	case sql.Linestring:
+		return g.Points
+	case sql.Polygon:
+		var points []sql.Point
+		for _, line := range g.Lines {
+			points = append(points, line.Points...)
+		}
+		return points
+	default:
+		return nil
+	}
+}
+
+// geometrySegments returns the line segments of the lines of the geometry given.
+func geometrySegments(g interface{}) []segment {
+	var lines []sql.Linestring
+	switch g := g.(type) {
+	case sql.Linestring:
+		lines = []sql.Linestring{g}
+	case sql.Polygon:
+		lines = g.Lines
+	}
+
+	var segments []segment
+	for _, line := range lines {
+		for i := 0; i+1 < len(line.Points); i++ {
+			segments = append(segments, segment{line.Points[i], line.Points[i+1]})
+		}
+	}
+	return segments
+}
+
+// geometryDimension returns the dimension of the geometry given: 0 for points, 1 for linestrings and 2 for polygons.
+func geometryDimension(g interface{}) int {
+	switch g.(type) {
+	case sql.Linestring:
+		return 1
+	case sql.Polygon:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// samePoint returns whether the points given have the same coordinates.
+func samePoint(p, q sql.Point) bool {
+	return p.X == q.X && p.Y == q.Y
+}
+
+// pointOnSegment returns whether the point given is on the line segment given, including its end points.
+func pointOnSegment(p sql.Point, s segment) bool {
+	return pointOrientation(s.a, s.b, p) == 0 &&
+		p.X >= math.Min(s.a.X, s.b.X) && p.X <= math.Max(s.a.X, s.b.X) &&
+		p.Y >= math.Min(s.a.Y, s.b.Y) && p.Y <= math.Max(s.a.Y, s.b.Y)
+}
+
+// pointInRing returns whether the point given is inside the linear ring given. The point must not be on the ring.
+func pointInRing(p sql.Point, ring sql.Linestring) bool {
+	inside := false
+	points := ring.Points
+	for i, j := 0, len(points)-1; i < len(points); j, i = i, i+1 {
+		a, b := points[i], points[j]
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < (b.X-a.X)*(p.Y-a.Y)/(b.Y-a.Y)+a.X {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// locate returns where the point given is relative to the geometry given.
+func locate(p sql.Point, g interface{}) location {
+	switch g := g.(type) {
+	case sql.Point:
+		if samePoint(p, g) {
+			return interior
+		}
+		return exterior
+	case sql.Linestring:
+		n := len(g.Points)
+		if n == 0 {
+			return exterior
+		}
+		closed := samePoint(g.Points[0], g.Points[n-1])
+		if !closed && (samePoint(p, g.Points[0]) || samePoint(p, g.Points[n-1])) {
+			return boundary
+		}
+		for _, s := range geometrySegments(g) {
+			if pointOnSegment(p, s) {
+				return interior
+			}
+		}
+		if n == 1 && samePoint(p, g.Points[0]) {
+			return interior
+		}
+		return exterior
+	case sql.Polygon:
+		if len(g.Lines) == 0 {
+			return exterior
+		}
+		for _, s := range geometrySegments(g) {
+			if pointOnSegment(p, s) {
+				return boundary
+			}
+		}
+		if !pointInRing(p, g.Lines[0]) {
+			return exterior
+		}
+		for _, hole := range g.Lines[1:] {
+			if pointInRing(p, hole) {
+				return exterior
+			}
+		}
+		return interior
+	default:
+		return exterior
+	}
+}
+
+// segmentParam returns the position of a point on the line segment given, from 0 at its first point to 1 at its second.
+func segmentParam(s segment, p sql.Point) float64 {
+	dx, dy := s.b.X-s.a.X, s.b.Y-s.a.Y
+	if math.Abs(dx) >= math.Abs(dy) {
+		if dx == 0 {
+			return 0
+		}
+		return (p.X - s.a.X) / dx
+	}
+	return (p.Y - s.a.Y) / dy
+}
+
+// segmentCrossing returns the position on the first line segment given of the point where the line segments given
+// cross, and false if they don't cross or are parallel.
+func segmentCrossing(s, o segment) (float64, bool) {
+	sx, sy := s.b.X-s.a.X, s.b.Y-s.a.Y
+	ox, oy := o.b.X-o.a.X, o.b.Y-o.a.Y
+	d := sx*oy - sy*ox
+	if d == 0 {
+		return 0, false
+	}
+	ax, ay := o.a.X-s.a.X, o.a.Y-s.a.Y
+	t := (ax*oy - ay*ox) / d
+	u := (ax*sy - ay*sx) / d
+	if t < 0 || t > 1 || u < 0 || u > 1 {
+		return 0, false
+	}
+	return t, true
+}
+
+// splitSegment returns the points that split the line segment given where it meets the other geometry given, from the
+// first point of the segment to its last.
+func splitSegment(s segment, other interface{}) []sql.Point {
+	params := []float64{0, 1}
+	for _, p := range geometryPoints(other) {
+		if pointOnSegment(p, s) {
+			params = append(params, segmentParam(s, p))
+		}
+	}
+	for _, o := range geometrySegments(other) {
+		if t, ok := segmentCrossing(s, o); ok {
+			params = append(params, t)
+		}
+	}
+	sort.Float64s(params)
+
+	points := make([]sql.Point, 0, len(params))
+	for _, t := range params {
+		p := sql.Point{SRID: s.a.SRID, X: s.a.X + t*(s.b.X-s.a.X), Y: s.a.Y + t*(s.b.Y-s.a.Y)}
+		if len(points) > 0 && samePoint(points[len(points)-1], p) {
+			continue
+		}
+		points = append(points, p)
+	}
+	return points
+}
+
+// samples returns points of the geometry given that are enough to tell where the geometry is relative to the other
+// geometry given: its points, the points where its lines meet the other geometry, and a point in each piece of its
+// lines between them. For the pieces of the rings of a polygon, the points next to the piece on both sides of the ring
+// are included, which tell where the parts of the polygon's interior and exterior next to the piece are.
+func samples(g, other interface{}) []sample {
+	var samples []sample
+	for _, p := range geometryPoints(g) {
+		samples = append(samples, sample{p, locate(p, g)})
+	}
+
+	isPolygon := geometryDimension(g) == 2
+	for _, s := range geometrySegments(g) {
+		points := splitSegment(s, other)
+		for i, p := range points {
+			if i > 0 && i < len(points)-1 {
+				samples = append(samples, sample{p, locate(p, g)})
+			}
+			if i == len(points)-1 {
+				break
+			}
+
+			q := points[i+1]
+			mid := sql.Point{SRID: p.SRID, X: (p.X + q.X) / 2, Y: (p.Y + q.Y) / 2}
+			samples = append(samples, sample{mid, locate(mid, g)})
+			if isPolygon {
+				nx, ny := (p.Y-q.Y)*offsetRatio, (q.X-p.X)*offsetRatio
+				for _, side := range []sql.Point{
+					{SRID: p.SRID, X: mid.X + nx, Y: mid.Y + ny},
+					{SRID: p.SRID, X: mid.X - nx, Y: mid.Y - ny},
+				} {
+					samples = append(samples, sample{side, locate(side, g)})
+				}
+			}
+		}
+	}
+	return samples
+}
+
+// geometriesIntersect returns whether the geometries given have any point in common.
+func geometriesIntersect(a, b interface{}) bool {
+	for _, p := range geometryPoints(a) {
+		if locate(p, b) != exterior {
+			return true
+		}
+	}
+	for _, p := range geometryPoints(b) {
+		if locate(p, a) != exterior {
+			return true
+		}
+	}
+	for _, sa := range geometrySegments(a) {
+		for _, sb := range geometrySegments(b) {
+			if lineSegmentsIntersect(sa.a, sa.b, sb.a, sb.b) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// interiorsIntersect returns whether the interiors of the geometries given have any point in common.
+func interiorsIntersect(a, b interface{}) bool {
+	for _, s := range samples(a, b) {
+		if s.loc == interior && locate(s.p, b) == interior {
+			return true
+		}
+	}
+	for _, s := range samples(b, a) {
+		if s.loc == interior && locate(s.p, a) == interior {
+			return true
+		}
+	}
+	return false
+}
+
+// geometryCovers returns whether no point of the second geometry given is in the exterior of the first.
+func geometryCovers(a, b interface{}) bool {
+	if geometryDimension(b) > geometryDimension(a) {
+		return false
+	}
+	for _, s := range samples(b, a) {
+		if s.loc != exterior && locate(s.p, a) == exterior {
+			return false
+		}
+	}
+	// The exterior of a polygon may be inside another polygon without any of the other polygon's points being in it,
+	// when a hole of the polygon is inside the other polygon.
+	if geometryDimension(b) == 2 {
+		for _, s := range samples(a, b) {
+			if s.loc == exterior && locate(s.p, b) == interior {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// geometryContains returns whether the first geometry given contains the second: no point of the second geometry is in
+// the exterior of the first, and their interiors have a point in common.
+func geometryContains(a, b interface{}) bool {
+	return geometryCovers(a, b) && interiorsIntersect(a, b)
+}
+
+// geometryWithin returns whether the first geometry given is within the second.
+func geometryWithin(a, b interface{}) bool {
+	return geometryContains(b, a)
+}
+
+// geometriesTouch returns whether the geometries given have points in common, but only on their boundaries.
+func geometriesTouch(a, b interface{}) bool {
+	return geometriesIntersect(a, b) && !interiorsIntersect(a, b)
+}
+
+// envelope returns the minimum bounding rectangle of the geometry given. The rectangle is a point or a line when the
+// geometry has no extent in both or either directions, like MySQL does for the MBR functions.
+func envelope(g interface{}) interface{} {
+	points := geometryPoints(g)
+	srid, _ := sql.GeometrySRID(g)
+	if len(points) == 0 {
+		return sql.Polygon{SRID: srid}
+	}
+
+	minX, minY, maxX, maxY := points[0].X, points[0].Y, points[0].X, points[0].Y
+	for _, p := range points[1:] {
+		minX, minY = math.Min(minX, p.X), math.Min(minY, p.Y)
+		maxX, maxY = math.Max(maxX, p.X), math.Max(maxY, p.Y)
+	}
+
+	min := sql.Point{SRID: srid, X: minX, Y: minY}
+	max := sql.Point{SRID: srid, X: maxX, Y: maxY}
+	switch {
+	case minX == maxX && minY == maxY:
+		return min
+	case minX == maxX || minY == maxY:
+		return sql.Linestring{SRID: srid, Points: []sql.Point{min, max}}
+	default:
+		return sql.Polygon{SRID: srid, Lines: []sql.Linestring{{SRID: srid, Points: []sql.Point{
+			min,
+			{SRID: srid, X: maxX, Y: minY},
+			max,
+			{SRID: srid, X: minX, Y: maxY},
+			min,
+		}}}}
+	}
+}
+
+// mbrRelation returns the spatial relation given between the minimum bounding rectangles of two geometries.
+func mbrRelation(relation func(a, b interface{}) bool) func(a, b interface{}) bool {
+	return func(a, b interface{}) bool {
+		return relation(envelope(a), envelope(b))
+	}
+}
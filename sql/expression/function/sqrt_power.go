@@ -44,6 +44,11 @@ func (s *Sqrt) Description() string {
 	return "returns the square root of a nonnegative number X."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (s *Sqrt) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 func (s *Sqrt) String() string {
 	return fmt.Sprintf("sqrt(%s)", s.Child.String())
 }
@@ -113,6 +118,11 @@ func (p *Power) Description() string {
 	return "returns the value of X raised to the power of Y."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (p *Power) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Type implements the Expression interface.
 func (p *Power) Type() sql.Type { return sql.Float64 }
 
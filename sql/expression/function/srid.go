@@ -18,8 +18,6 @@ import (
 	"fmt"
 	"strings"
 
-	"gopkg.in/src-d/go-errors.v1"
-
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 )
@@ -31,11 +29,12 @@ type SRID struct {
 
 var _ sql.FunctionExpression = (*SRID)(nil)
 
-var ErrInvalidSRID = errors.NewKind("There's no spatial reference with SRID %d")
+// ErrInvalidSRID is returned for SRIDs that aren't spatial reference systems known to the engine.
+var ErrInvalidSRID = sql.ErrInvalidSRID
 
 const (
-	CartesianSRID  = 0
-	GeoSpatialSRID = 4326
+	CartesianSRID  = sql.CartesianSRID
+	GeoSpatialSRID = sql.GeoSpatialSRID
 )
 
 // NewSRID creates a new STX expression.
@@ -150,8 +149,8 @@ func (s *SRID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	_srid := srid.(uint32)
 
 	// Must be either 0 or 4326
-	if _srid != CartesianSRID && _srid != GeoSpatialSRID {
-		return nil, ErrInvalidSRID.New(_srid)
+	if err := sql.ValidateSRID(_srid); err != nil {
+		return nil, err
 	}
 
 	// Create new geometry object with matching SRID
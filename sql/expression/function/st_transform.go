@@ -0,0 +1,163 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"fmt"
+
+	"gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+var (
+	// ErrTransformSourceNotSupported is returned when transforming a geometry from a spatial reference system that
+	// can't be transformed.
+	ErrTransformSourceNotSupported = errors.NewKind("Transformation from SRID %d is not supported.")
+
+	// ErrTransformTargetNotSupported is returned when transforming a geometry to a spatial reference system that
+	// can't be transformed to.
+	ErrTransformTargetNotSupported = errors.NewKind("Transformation to SRID %d is not supported.")
+)
+
+// Transform is a function that returns a geometry transformed to another spatial reference system. Every point is
+// transformed to WGS 84, shifting its datum or unprojecting it as needed, and then from WGS 84 to the target system.
+// The coordinates of a geometry can't be transformed between the Cartesian plane and the ellipsoid of the Earth, so
+// transforming a geometry from or to SRID 0 is an error, rather than a reinterpretation of its coordinates.
+type Transform struct {
+	expression.BinaryExpression
+}
+
+var _ sql.FunctionExpression = (*Transform)(nil)
+
+// NewTransform creates a new ST_TRANSFORM expression.
+func NewTransform(g, srid sql.Expression) sql.Expression {
+	return &Transform{
+		expression.BinaryExpression{
+			Left:  g,
+			Right: srid,
+		},
+	}
+}
+
+// FunctionName implements sql.FunctionExpression
+func (t *Transform) FunctionName() string {
+	return "st_transform"
+}
+
+// Description implements sql.FunctionExpression
+func (t *Transform) Description() string {
+	return "returns the given geometry transformed to the spatial reference system with the given SRID."
+}
+
+// Type implements the sql.Expression interface.
+func (t *Transform) Type() sql.Type {
+	return t.Left.Type()
+}
+
+func (t *Transform) String() string {
+	return fmt.Sprintf("ST_TRANSFORM(%s,%s)", t.Left, t.Right)
+}
+
+// WithChildren implements the Expression interface.
+func (t *Transform) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(t, len(children), 2)
+	}
+	return NewTransform(children[0], children[1]), nil
+}
+
+// Eval implements the sql.Expression interface.
+func (t *Transform) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	g, err := t.Left.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, nil
+	}
+
+	srid, err := t.Right.Eval(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	if srid == nil {
+		return nil, nil
+	}
+	srid, err = sql.Uint32.Convert(srid)
+	if err != nil {
+		return nil, err
+	}
+	target := srid.(uint32)
+	if err := sql.ValidateSRID(target); err != nil {
+		return nil, err
+	}
+
+	source, ok := sql.GeometrySRID(g)
+	if !ok {
+		return nil, sql.ErrIllegalGISValue.New(g)
+	}
+	switch {
+	case source == target:
+		return g, nil
+	case source == CartesianSRID:
+		return nil, ErrTransformSourceNotSupported.New(source)
+	case target == CartesianSRID:
+		return nil, ErrTransformTargetNotSupported.New(target)
+	}
+
+	from, _ := sql.SpatialReferenceSystemByID(source)
+	to, _ := sql.SpatialReferenceSystemByID(target)
+	transformPoint := func(p sql.Point) (sql.Point, error) {
+		lat, lon, ok := from.ToWGS84(p.X, p.Y)
+		if !ok {
+			return sql.Point{}, ErrTransformSourceNotSupported.New(source)
+		}
+		x, y, ok := to.FromWGS84(lat, lon)
+		if !ok {
+			return sql.Point{}, ErrTransformTargetNotSupported.New(target)
+		}
+		return sql.Point{SRID: target, X: x, Y: y}, nil
+	}
+	transformLine := func(l sql.Linestring) (sql.Linestring, error) {
+		points := make([]sql.Point, len(l.Points))
+		for i, p := range l.Points {
+			var err error
+			if points[i], err = transformPoint(p); err != nil {
+				return sql.Linestring{}, err
+			}
+		}
+		return sql.Linestring{SRID: target, Points: points}, nil
+	}
+
+	switch g := g.(type) {
+	case sql.Point:
+		return transformPoint(g)
+	case sql.Linestring:
+		return transformLine(g)
+	case sql.Polygon:
+		lines := make([]sql.Linestring, len(g.Lines))
+		for i, l := range g.Lines {
+			var err error
+			if lines[i], err = transformLine(l); err != nil {
+				return nil, err
+			}
+		}
+		return sql.Polygon{SRID: target, Lines: lines}, nil
+	default:
+		return nil, sql.ErrIllegalGISValue.New(g)
+	}
+}
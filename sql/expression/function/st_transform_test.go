@@ -0,0 +1,113 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestTransform(t *testing.T) {
+	t.Run("transform to the same SRID", func(t *testing.T) {
+		require := require.New(t)
+		line := sql.Linestring{SRID: 4326, Points: []sql.Point{{SRID: 4326, X: 1, Y: 2}, {SRID: 4326, X: 3, Y: 4}}}
+		f := NewTransform(expression.NewLiteral(line, sql.LinestringType{}), expression.NewLiteral(4326, sql.Int32))
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(line, v)
+	})
+
+	t.Run("transform from cartesian", func(t *testing.T) {
+		require := require.New(t)
+		f := NewTransform(expression.NewLiteral(sql.Point{X: 1, Y: 2}, sql.PointType{}), expression.NewLiteral(4326, sql.Int32))
+
+		_, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.True(ErrTransformSourceNotSupported.Is(err))
+	})
+
+	t.Run("transform to cartesian", func(t *testing.T) {
+		require := require.New(t)
+		f := NewTransform(expression.NewLiteral(sql.Point{SRID: 4326, X: 1, Y: 2}, sql.PointType{}), expression.NewLiteral(0, sql.Int32))
+
+		_, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.True(ErrTransformTargetNotSupported.Is(err))
+	})
+
+	t.Run("transform to unknown SRID", func(t *testing.T) {
+		require := require.New(t)
+		f := NewTransform(expression.NewLiteral(sql.Point{X: 1, Y: 2}, sql.PointType{}), expression.NewLiteral(1234, sql.Int32))
+
+		_, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.True(sql.ErrInvalidSRID.Is(err))
+	})
+
+	t.Run("transform to a projected SRID", func(t *testing.T) {
+		require := require.New(t)
+		f := NewTransform(expression.NewLiteral(sql.Point{SRID: 4326, X: 0, Y: 1}, sql.PointType{}), expression.NewLiteral(3857, sql.Int32))
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		p := v.(sql.Point)
+		require.Equal(uint32(3857), p.SRID)
+		require.InDelta(111319.49, p.X, 0.01)
+		require.InDelta(0, p.Y, 0.01)
+
+		f = NewTransform(expression.NewLiteral(p, sql.PointType{}), expression.NewLiteral(4326, sql.Int32))
+		v, err = f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		p = v.(sql.Point)
+		require.Equal(uint32(4326), p.SRID)
+		require.InDelta(0, p.X, 1e-9)
+		require.InDelta(1, p.Y, 1e-9)
+	})
+
+	t.Run("transform between datums", func(t *testing.T) {
+		require := require.New(t)
+		poly := sql.Polygon{SRID: 4230, Lines: []sql.Linestring{{SRID: 4230, Points: []sql.Point{
+			{SRID: 4230, X: 50, Y: 10}, {SRID: 4230, X: 51, Y: 10}, {SRID: 4230, X: 51, Y: 11}, {SRID: 4230, X: 50, Y: 10},
+		}}}}
+		f := NewTransform(expression.NewLiteral(poly, sql.PolygonType{}), expression.NewLiteral(4326, sql.Int32))
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		p := v.(sql.Polygon).Lines[0].Points[0]
+		require.Equal(uint32(4326), p.SRID)
+		require.InDelta(49.999199, p.X, 1e-6)
+		require.InDelta(9.998865, p.Y, 1e-6)
+
+		f = NewTransform(expression.NewLiteral(v, sql.PolygonType{}), expression.NewLiteral(4230, sql.Int32))
+		v, err = f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(uint32(4230), v.(sql.Polygon).SRID)
+		for i, p := range v.(sql.Polygon).Lines[0].Points {
+			require.InDelta(poly.Lines[0].Points[i].X, p.X, 1e-7)
+			require.InDelta(poly.Lines[0].Points[i].Y, p.Y, 1e-7)
+		}
+	})
+
+	t.Run("transform null", func(t *testing.T) {
+		require := require.New(t)
+		f := NewTransform(expression.NewLiteral(nil, sql.Null), expression.NewLiteral(0, sql.Int32))
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Nil(v)
+	})
+}
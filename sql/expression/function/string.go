@@ -43,6 +43,11 @@ func (a *Ascii) Description() string {
 	return "returns the numeric value of the leftmost character."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (a *Ascii) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements the sql.Expression interface
 func (a *Ascii) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	val, err := a.EvalChild(ctx, row)
@@ -100,6 +105,11 @@ func (h *Hex) Description() string {
 	return "returns the hexadecimal representation of the string or numeric value."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (h *Hex) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements the sql.Expression interface
 func (h *Hex) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	arg, err := h.EvalChild(ctx, row)
@@ -241,6 +251,11 @@ func (h *Unhex) Description() string {
 	return "returns a string containing hex representation of a number."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (h *Unhex) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements the sql.Expression interface
 func (h *Unhex) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	arg, err := h.EvalChild(ctx, row)
@@ -324,6 +339,11 @@ func (b *Bin) Description() string {
 	return "returns the binary representation of a number."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (b *Bin) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements the sql.Expression interface
 func (h *Bin) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	arg, err := h.EvalChild(ctx, row)
@@ -64,6 +64,11 @@ func (s *Substring) Description() string {
 	return "returns a substring from the provided string starting at pos with a length of len characters. If no len is provided, all characters from pos until the end will be taken."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (s *Substring) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Children implements the Expression interface.
 func (s *Substring) Children() []sql.Expression {
 	if s.len == nil {
@@ -319,6 +324,11 @@ func (l Left) Description() string {
 	return "returns the first N characters in the string given."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (l Left) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Children implements the Expression interface.
 func (l Left) Children() []sql.Expression {
 	return []sql.Expression{l.str, l.len}
@@ -419,6 +429,11 @@ func (r Right) Description() string {
 	return "returns the specified rightmost number of characters."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (r Right) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Children implements the Expression interface.
 func (r Right) Children() []sql.Expression {
 	return []sql.Expression{r.str, r.len}
@@ -518,6 +533,11 @@ func (i Instr) Description() string {
 	return "returns the 1-based index of the first occurence of str2 in str1, or 0 if it does not occur."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (i Instr) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Children implements the Expression interface.
 func (i Instr) Children() []sql.Expression {
 	return []sql.Expression{i.str, i.substr}
@@ -552,7 +572,7 @@ func (i Instr) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	case string:
 		subtext = []rune(substr)
 	case []byte:
-		subtext = []rune(string(subtext))
+		subtext = []rune(string(substr))
 	case nil:
 		return nil, nil
 	default:
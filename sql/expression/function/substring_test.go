@@ -49,6 +49,8 @@ func TestSubstring(t *testing.T) {
 		{"length overflows by one", sql.NewRow("foo", 2, 2), "oo", false},
 		{"substring contained", sql.NewRow("foo", 1, 2), "fo", false},
 		{"negative start until str beginning", sql.NewRow("foo", -3, 2), "fo", false},
+		{"multibyte substring", sql.NewRow("日本語です", 2, 2), "本語", false},
+		{"multibyte negative start", sql.NewRow("äöü", -2, 5), "öü", false},
 	}
 
 	for _, tt := range testCases {
@@ -129,6 +131,8 @@ func TestInstr(t *testing.T) {
 		{"non match", sql.NewRow("foo", "bar"), 0, false},
 		{"substr bigger than string", sql.NewRow("foo", "foobar"), 0, false},
 		{"multiple matches", sql.NewRow("bobobo", "bo"), 1, false},
+		{"multibyte match", sql.NewRow("日本語", "語"), 3, false},
+		{"multibyte non match", sql.NewRow("äöü", "a"), 0, false},
 		{"bad string", sql.NewRow(1, "hello"), 0, true},
 		{"bad substr", sql.NewRow("foo", 1), 0, true},
 	}
@@ -173,6 +177,7 @@ func TestLeft(t *testing.T) {
 		{"len == 0", sql.NewRow("foo", 0), "", false},
 		{"len < 0", sql.NewRow("foo", -1), "", false},
 		{"len < string.len", sql.NewRow("foo", 2), "fo", false},
+		{"multibyte string", sql.NewRow("日本語", 2), "日本", false},
 		{"bad string type", sql.NewRow(1, 1), "", true},
 		{"bad len type", sql.NewRow("hello", "hello"), "", true},
 	}
@@ -213,6 +218,7 @@ func TestRight(t *testing.T) {
 		{"len == 0", sql.NewRow("foo", 0), "", false},
 		{"len < 0", sql.NewRow("foo", -1), "", false},
 		{"len < string.len", sql.NewRow("foo", 2), "oo", false},
+		{"multibyte string", sql.NewRow("日本語", 2), "本語", false},
 		{"bad string type", sql.NewRow(1, 1), "", true},
 		{"bad len type", sql.NewRow("hello", "hello"), "", true},
 	}
@@ -46,6 +46,11 @@ func (c ConnectionID) Description() string {
 	return "returns the current connection ID."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (c ConnectionID) Volatility() sql.FunctionVolatility {
+	return sql.Stable
+}
+
 // Eval implements sql.Expression
 func (c ConnectionID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	return connIDFuncLogic(ctx, row)
@@ -72,6 +77,17 @@ func userFuncLogic(ctx *sql.Context, _ sql.Row) (interface{}, error) {
 	return ctx.Client().User + "@" + ctx.Client().Address, nil
 }
 
+// currentUserFuncLogic returns the account whose privileges the statement executes with. This differs from the user
+// of the session within stored procedures that execute with the privileges of their definer.
+func currentUserFuncLogic(ctx *sql.Context, _ sql.Row) (interface{}, error) {
+	sc := ctx.SecurityContext()
+	if sc.User == "" && sc.Host == "" {
+		return "", nil
+	}
+
+	return sc.User + "@" + sc.Host, nil
+}
+
 var _ sql.FunctionExpression = User{}
 
 // Description implements sql.FunctionExpression
@@ -79,6 +95,11 @@ func (c User) Description() string {
 	return "returns the authenticated user name and host name."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (c User) Volatility() sql.FunctionVolatility {
+	return sql.Stable
+}
+
 func NewUser() sql.Expression {
 	return User{
 		NoArgFunc: NoArgFunc{"user", sql.LongText},
@@ -93,6 +114,9 @@ func NewCurrentUser() sql.Expression {
 
 // Eval implements sql.Expression
 func (c User) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	if c.Name == "current_user" {
+		return currentUserFuncLogic(ctx, row)
+	}
 	return userFuncLogic(ctx, row)
 }
 
@@ -94,6 +94,11 @@ func (y *Year) Description() string {
 	return "returns the year of the given date."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (y *Year) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 func (y *Year) String() string { return fmt.Sprintf("YEAR(%s)", y.Child) }
 
 // Type implements the Expression interface.
@@ -134,6 +139,11 @@ func (m *Month) Description() string {
 	return "returns the month of the given date."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (m *Month) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 func (m *Month) String() string { return fmt.Sprintf("MONTH(%s)", m.Child) }
 
 // Type implements the Expression interface.
@@ -174,6 +184,11 @@ func (d *Day) Description() string {
 	return "returns the day of the month (0-31)."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (d *Day) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 func (d *Day) String() string { return fmt.Sprintf("DAY(%s)", d.Child) }
 
 // Type implements the Expression interface.
@@ -255,6 +270,11 @@ func (h *Hour) Description() string {
 	return "returns the hours of the given date."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (h *Hour) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 func (h *Hour) String() string { return fmt.Sprintf("HOUR(%s)", h.Child) }
 
 // Type implements the Expression interface.
@@ -295,6 +315,11 @@ func (m *Minute) Description() string {
 	return "returns the minutes of the given date."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (m *Minute) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 func (m *Minute) String() string { return fmt.Sprintf("MINUTE(%d)", m.Child) }
 
 // Type implements the Expression interface.
@@ -335,6 +360,11 @@ func (s *Second) Description() string {
 	return "returns the seconds of the given date."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (s *Second) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 func (s *Second) String() string { return fmt.Sprintf("SECOND(%s)", s.Child) }
 
 // Type implements the Expression interface.
@@ -376,6 +406,11 @@ func (d *DayOfWeek) Description() string {
 	return "returns the day of the week of the given date."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (d *DayOfWeek) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 func (d *DayOfWeek) String() string { return fmt.Sprintf("DAYOFWEEK(%s)", d.Child) }
 
 // Type implements the Expression interface.
@@ -416,6 +451,11 @@ func (d *DayOfYear) Description() string {
 	return "returns the day of the year of the given date."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (d *DayOfYear) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 func (d *DayOfYear) String() string { return fmt.Sprintf("DAYOFYEAR(%s)", d.Child) }
 
 // Type implements the Expression interface.
@@ -834,6 +874,11 @@ func (n *Now) Description() string {
 	return "returns the current timestamp."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (n *Now) Volatility() sql.FunctionVolatility {
+	return sql.Stable
+}
+
 // Type implements the sql.Expression interface.
 func (n *Now) Type() sql.Type {
 	return sql.Datetime
@@ -927,6 +972,11 @@ func (ut *UTCTimestamp) Description() string {
 	return "returns the current UTC timestamp."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (ut *UTCTimestamp) Volatility() sql.FunctionVolatility {
+	return sql.Stable
+}
+
 // Type implements the sql.Expression interface.
 func (ut *UTCTimestamp) Type() sql.Type {
 	return sql.Datetime
@@ -978,6 +1028,11 @@ func (d *Date) Description() string {
 	return "returns the date part of the given date."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (d *Date) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // NewDate returns a new Date node.
 func NewDate(date sql.Expression) sql.Expression {
 	return &Date{expression.UnaryExpression{Child: date}}
@@ -1070,6 +1125,11 @@ func (d *DayName) Description() string {
 	return "returns the name of the weekday."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (d *DayName) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 func (d *DayName) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	val, err := d.EvalChild(ctx, row)
 	if err != nil {
@@ -1136,6 +1196,11 @@ func (d *MonthName) Description() string {
 	return "returns the name of the month."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (d *MonthName) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 func (d *MonthName) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	val, err := d.EvalChild(ctx, row)
 	if err != nil {
@@ -1235,6 +1300,11 @@ func (c CurrTime) Description() string {
 	return "returns the current time."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (c CurrTime) Volatility() sql.FunctionVolatility {
+	return sql.Stable
+}
+
 func NewCurrTime() sql.Expression {
 	return CurrTime{
 		NoArgFunc: NoArgFunc{"curtime", sql.LongText},
@@ -1284,6 +1354,11 @@ func (c *CurrTimestamp) Description() string {
 	return "returns the current date and time."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (c *CurrTimestamp) Volatility() sql.FunctionVolatility {
+	return sql.Stable
+}
+
 func NewCurrTimestamp(args ...sql.Expression) (sql.Expression, error) {
 	return &CurrTimestamp{args}, nil
 }
@@ -47,6 +47,11 @@ func (t *ToBase64) Description() string {
 	return "encodes the string str in base64 format."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (t *ToBase64) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements the Expression interface.
 func (t *ToBase64) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	str, err := t.Child.Eval(ctx, row)
@@ -134,6 +139,11 @@ func (t *FromBase64) Description() string {
 	return "decodes the base64-encoded string str."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (t *FromBase64) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Eval implements the Expression interface.
 func (t *FromBase64) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	str, err := t.Child.Eval(ctx, row)
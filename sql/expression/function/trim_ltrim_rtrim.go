@@ -47,6 +47,11 @@ func (t *Trim) Description() string {
 	return "remove leading and trailing spaces."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (t *Trim) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 // Children implements the Expression interface.
 func (t *Trim) Children() []sql.Expression {
 	return []sql.Expression{t.str, t.pat}
@@ -160,6 +165,11 @@ func (t *LeftTrim) Description() string {
 	return "returns the string str with leading space characters removed."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (t *LeftTrim) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 func (t *LeftTrim) Type() sql.Type { return sql.LongText }
 
 func (t *LeftTrim) String() string {
@@ -217,6 +227,11 @@ func (t *RightTrim) Description() string {
 	return "returns the string str with trailing space characters removed."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (t *RightTrim) Volatility() sql.FunctionVolatility {
+	return sql.Deterministic
+}
+
 func (t *RightTrim) Type() sql.Type { return sql.LongText }
 
 func (t *RightTrim) String() string {
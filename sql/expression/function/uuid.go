@@ -16,6 +16,8 @@ package function
 
 import (
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
@@ -68,6 +70,11 @@ func (u UUIDFunc) Description() string {
 	return "returns a Universal Unique Identifier (UUID)."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (u UUIDFunc) Volatility() sql.FunctionVolatility {
+	return sql.Volatile
+}
+
 func (u UUIDFunc) String() string {
 	return "UUID()"
 }
@@ -111,6 +118,91 @@ func (u UUIDFunc) IsNullable() bool {
 	return false
 }
 
+// UUID_SHORT()
+//
+// Returns a “short” universal identifier as a 64-bit unsigned integer. Values returned by UUID_SHORT() differ from the
+// string-format 128-bit identifiers returned by the UUID() function and have different uniqueness properties. The value
+// of UUID_SHORT() is guaranteed to be unique if the following conditions hold:
+//
+// The server_id value of the current server is between 0 and 255 and is unique among your set of source and replica
+// servers
+//
+// You do not set back the system time for your server host between mysqld restarts
+//
+// You invoke UUID_SHORT() on average fewer than 16 million times per second between mysqld restarts
+//
+// The UUID_SHORT() return value is constructed this way:
+//
+//   (server_id & 255) << 56
+// + (server_startup_time_in_seconds << 24)
+// + incremented_variable_by_one;
+//
+// The engine has no server_id, so the top byte is always 0, and the startup time is the time the engine was loaded.
+// https://dev.mysql.com/doc/refman/8.0/en/miscellaneous-functions.html#function_uuid-short
+
+// uuidShortCounter is the last value returned by UUID_SHORT().
+var uuidShortCounter = uint64(time.Now().Unix()) << 24
+
+type UUIDShortFunc struct{}
+
+func (u UUIDShortFunc) IsNonDeterministic() bool {
+	return true
+}
+
+var _ sql.FunctionExpression = &UUIDShortFunc{}
+
+func NewUUIDShortFunc() sql.Expression {
+	return UUIDShortFunc{}
+}
+
+// Description implements sql.FunctionExpression
+func (u UUIDShortFunc) Description() string {
+	return "returns a short universal identifier as a 64-bit unsigned integer."
+}
+
+// Volatility implements sql.FunctionWithVolatility
+func (u UUIDShortFunc) Volatility() sql.FunctionVolatility {
+	return sql.Volatile
+}
+
+func (u UUIDShortFunc) String() string {
+	return "UUID_SHORT()"
+}
+
+func (u UUIDShortFunc) Type() sql.Type {
+	return sql.Uint64
+}
+
+func (u UUIDShortFunc) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	return atomic.AddUint64(&uuidShortCounter, 1), nil
+}
+
+func (u UUIDShortFunc) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(u, len(children), 0)
+	}
+
+	return UUIDShortFunc{}, nil
+}
+
+func (u UUIDShortFunc) FunctionName() string {
+	return "uuid_short"
+}
+
+func (u UUIDShortFunc) Resolved() bool {
+	return true
+}
+
+// Children returns the children expressions of this expression.
+func (u UUIDShortFunc) Children() []sql.Expression {
+	return nil
+}
+
+// IsNullable returns whether the expression can be null.
+func (u UUIDShortFunc) IsNullable() bool {
+	return false
+}
+
 // IS_UUID(string_uuid)
 //
 // Returns 1 if the argument is a valid string-format UUID, 0 if the argument is not a valid UUID, and NULL if the
@@ -442,7 +534,6 @@ func (bu BinToUUID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	parsed, err := uuid.FromBytes(asBytes)
 	if err != nil {
 		return nil, sql.ErrUuidUnableToParse.New(uuidAsByteString, err.Error())
-		return nil, err
 	}
 
 	// If no swap flag is passed we can return uuid's string format as is.
@@ -461,7 +552,7 @@ func (bu BinToUUID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	// If the swap flag is 0 we can return uuid's string format as is.
-	if sf.(int8) == 0 {
+	if sf == nil || sf.(int8) == 0 {
 		return parsed.String(), nil
 	} else if sf.(int8) == 1 {
 		encoding := unswapUUIDBytes(parsed)
@@ -473,7 +564,7 @@ func (bu BinToUUID) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 
 		return parsed.String(), nil
 	} else {
-		return nil, fmt.Errorf("UUID_TO_BIN received invalid swap flag")
+		return nil, fmt.Errorf("BIN_TO_UUID received invalid swap flag")
 	}
 }
 
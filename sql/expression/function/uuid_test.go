@@ -47,6 +47,18 @@ func TestUUID(t *testing.T) {
 	require.True(t, re2.MatchString(myUUID))
 }
 
+func TestUUIDShort(t *testing.T) {
+	f := NewUUIDShortFunc()
+
+	first := eval(t, f, sql.Row{nil}).(uint64)
+	second := eval(t, f, sql.Row{nil}).(uint64)
+	require.Equal(t, first+1, second)
+
+	// The server id in the top byte is always 0
+	require.Equal(t, uint64(0), first>>56)
+	require.False(t, f.IsNullable())
+}
+
 func TestIsUUID(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -166,6 +178,7 @@ func TestBinToUUID(t *testing.T) {
 	}{
 		{"valid uuid; swap=0", sql.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("lxºº & d[e`$Û"), true, sql.Int8, int8(0), "6c78c2ba-c2ba-2026-2064-5b656024c39b"},
 		{"valid uuid; swap=1", sql.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("&ººlÍxd[e`$Û"), true, sql.Int8, int8(1), "ba6cc38d-bac2-26c2-7864-5b656024c39b"},
+		{"valid uuid; swap=nil", sql.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("lxºº & d[e`$Û"), true, sql.Null, nil, "6c78c2ba-c2ba-2026-2064-5b656024c39b"},
 		{"valid uuid; no swap", sql.MustCreateBinary(query.Type_VARBINARY, int64(16)), []byte("lxºº & d[e`$Û"), false, nil, nil, "6c78c2ba-c2ba-2026-2064-5b656024c39b"},
 		{"null input", sql.Null, nil, false, nil, nil, nil},
 	}
@@ -49,6 +49,11 @@ func (f Version) Description() string {
 	return "returns a string that indicates the SQL server version."
 }
 
+// Volatility implements sql.FunctionWithVolatility
+func (f Version) Volatility() sql.FunctionVolatility {
+	return sql.Stable
+}
+
 // Type implements the Expression interface.
 func (f Version) Type() sql.Type { return sql.LongText }
 
@@ -398,8 +398,8 @@ func (g *GeomFromWKB) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	// Must be valid SRID
-	if srid != 0 && srid != 4230 {
-		return nil, ErrInvalidSRID.New(srid)
+	if err := sql.ValidateSRID(srid); err != nil {
+		return nil, err
 	}
 
 	// Convert this block to helper function
@@ -523,8 +523,8 @@ func (p *PointFromWKB) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 	}
 
 	// Must be valid SRID
-	if srid != 0 && srid != 4230 {
-		return nil, ErrInvalidSRID.New(srid)
+	if err := sql.ValidateSRID(srid); err != nil {
+		return nil, err
 	}
 
 	// Determine xy order
@@ -638,8 +638,8 @@ func (l *LineFromWKB) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	// Must be valid SRID
-	if srid != 0 && srid != 4230 {
-		return nil, ErrInvalidSRID.New(srid)
+	if err := sql.ValidateSRID(srid); err != nil {
+		return nil, err
 	}
 
 	// Determine xy order
@@ -753,8 +753,8 @@ func (p *PolyFromWKB) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	// Must be valid SRID
-	if srid != 0 && srid != 4230 {
-		return nil, ErrInvalidSRID.New(srid)
+	if err := sql.ValidateSRID(srid); err != nil {
+		return nil, err
 	}
 
 	// Determine xy order
@@ -367,8 +367,8 @@ func (g *GeomFromText) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 	}
 
 	// Must be valid SRID
-	if srid != 0 && srid != 4230 {
-		return nil, ErrInvalidSRID.New(srid)
+	if err := sql.ValidateSRID(srid); err != nil {
+		return nil, err
 	}
 
 	// Determine xy order
@@ -469,7 +469,7 @@ func (p *PointFromWKT) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 	}
 
 	// Not a point, throw error
-	if geomType == "point" {
+	if geomType != "point" {
 		return nil, sql.ErrInvalidGISData.New("ST_PointFromText")
 	}
 
@@ -491,8 +491,8 @@ func (p *PointFromWKT) Eval(ctx *sql.Context, row sql.Row) (interface{}, error)
 	}
 
 	// Must be valid SRID
-	if srid != 0 && srid != 4230 {
-		return nil, ErrInvalidSRID.New(srid)
+	if err := sql.ValidateSRID(srid); err != nil {
+		return nil, err
 	}
 
 	// Determine xy order
@@ -604,8 +604,8 @@ func (l *LineFromWKT) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	// Must be valid SRID
-	if srid != 0 && srid != 4230 {
-		return nil, ErrInvalidSRID.New(srid)
+	if err := sql.ValidateSRID(srid); err != nil {
+		return nil, err
 	}
 
 	// Determine xt order
@@ -717,8 +717,8 @@ func (p *PolyFromWKT) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	}
 
 	// Must be valid SRID
-	if srid != 0 && srid != 4230 {
-		return nil, ErrInvalidSRID.New(srid)
+	if err := sql.ValidateSRID(srid); err != nil {
+		return nil, err
 	}
 
 	// Determine xy order
@@ -321,3 +321,24 @@ func TestGeomFromText(t *testing.T) {
 		require.Equal(sql.Polygon{SRID: 4230, Lines: []sql.Linestring{{SRID: 4230, Points: []sql.Point{{SRID: 4230, X: 0, Y: 0}, {SRID: 4230, X: 1, Y: 0}, {SRID: 4230, X: 0, Y: 1}, {SRID: 4230, X: 0, Y: 0}}}}}, v)
 	})
 }
+
+func TestPointFromText(t *testing.T) {
+	t.Run("create valid point", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewPointFromWKT(expression.NewLiteral("POINT(1 2)", sql.Blob))
+		require.NoError(err)
+
+		v, err := f.Eval(sql.NewEmptyContext(), nil)
+		require.NoError(err)
+		require.Equal(sql.Point{X: 1, Y: 2}, v)
+	})
+
+	t.Run("create point from linestring is invalid", func(t *testing.T) {
+		require := require.New(t)
+		f, err := NewPointFromWKT(expression.NewLiteral("LINESTRING(1 2, 3 4)", sql.Blob))
+		require.NoError(err)
+
+		_, err = f.Eval(sql.NewEmptyContext(), nil)
+		require.Error(err)
+	})
+}
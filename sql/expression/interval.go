@@ -16,11 +16,12 @@ package expression
 
 import (
 	"fmt"
-	"regexp"
+	"math"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/shopspring/decimal"
 	errors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -48,14 +49,13 @@ func (i *Interval) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
 	panic("Interval.Eval is just a placeholder method and should not be called directly")
 }
 
-var (
-	errInvalidIntervalUnit   = errors.NewKind("invalid interval unit: %s")
-	errInvalidIntervalFormat = errors.NewKind("invalid interval format for %q: %s")
-)
+var errInvalidIntervalUnit = errors.NewKind("invalid interval unit: %s")
 
 // EvalDelta evaluates the expression returning a TimeDelta. This method should
 // be used instead of Eval, as this expression returns a TimeDelta, which is not
-// a valid value that can be returned in Eval.
+// a valid value that can be returned in Eval. It returns nil if the value of the
+// interval is NULL, or if it isn't a valid value for the unit of the interval,
+// which makes the result of the expression using the interval NULL, as in MySQL.
 func (i *Interval) EvalDelta(ctx *sql.Context, row sql.Row) (*TimeDelta, error) {
 	val, err := i.Child.Eval(ctx, row)
 	if err != nil {
@@ -68,19 +68,17 @@ func (i *Interval) EvalDelta(ctx *sql.Context, row sql.Row) (*TimeDelta, error)
 
 	var td TimeDelta
 
-	if r, ok := unitTextFormats[i.Unit]; ok {
+	if count, ok := unitTextParts[i.Unit]; ok {
 		val, err = sql.LongText.Convert(val)
 		if err != nil {
 			return nil, err
 		}
 
-		text := val.(string)
-		if !r.MatchString(text) {
-			return nil, errInvalidIntervalFormat.New(i.Unit, text)
+		parts, negative, ok := parseIntervalText(val.(string), count, strings.HasSuffix(i.Unit, "_MICROSECOND"))
+		if !ok {
+			return nil, nil
 		}
 
-		parts := textFormatParts(text, r)
-
 		switch i.Unit {
 		case "DAY_HOUR":
 			td.Days = parts[0]
@@ -128,13 +126,29 @@ func (i *Interval) EvalDelta(ctx *sql.Context, row sql.Row) (*TimeDelta, error)
 		default:
 			return nil, errInvalidIntervalUnit.New(i.Unit)
 		}
-	} else {
-		val, err = sql.Int64.Convert(val)
+
+		if negative {
+			td = td.negate()
+		}
+	} else if i.Unit == "SECOND" {
+		// Seconds are the only unit that keeps the fractional part of the
+		// value, as microseconds.
+		val, err = sql.Float64.Convert(val)
 		if err != nil {
 			return nil, err
 		}
 
-		num := val.(int64)
+		secs := val.(float64)
+		if math.Abs(secs) > math.MaxInt64/1e6 {
+			return nil, nil
+		}
+		td.Seconds = int64(secs)
+		td.Microseconds = int64(math.Round((secs - math.Trunc(secs)) * 1e6))
+	} else {
+		num, err := intervalNumber(val)
+		if err != nil {
+			return nil, err
+		}
 
 		switch i.Unit {
 		case "DAY":
@@ -143,8 +157,6 @@ func (i *Interval) EvalDelta(ctx *sql.Context, row sql.Row) (*TimeDelta, error)
 			td.Hours = num
 		case "MINUTE":
 			td.Minutes = num
-		case "SECOND":
-			td.Seconds = num
 		case "MICROSECOND":
 			td.Microseconds = num
 		case "QUARTER":
@@ -163,6 +175,40 @@ func (i *Interval) EvalDelta(ctx *sql.Context, row sql.Row) (*TimeDelta, error)
 	return &td, nil
 }
 
+// AddInterval returns the date given plus the time delta given, or minus the
+// time delta if |subtract| is set, as DATE_ADD, DATE_SUB and the + and -
+// operators do. As in MySQL, it returns nil, with a warning, if the date isn't
+// a valid date, or if the result is out of the range of dates.
+func AddInterval(ctx *sql.Context, date interface{}, delta *TimeDelta, subtract bool) interface{} {
+	t, err := sql.Datetime.ConvertWithoutRangeCheck(date)
+	if err != nil {
+		ctx.Warn(1292, fmt.Sprintf("Incorrect datetime value: '%v'", date))
+		return nil
+	}
+
+	var result interface{}
+	if subtract {
+		result = sql.ValidateTime(delta.Sub(t))
+	} else {
+		result = sql.ValidateTime(delta.Add(t))
+	}
+	if result == nil {
+		ctx.Warn(1441, "Datetime function: datetime field overflow")
+	}
+	return result
+}
+
+// HasTimePart returns whether the unit of the interval has a part smaller than
+// a day, so that adding the interval to a date gives a datetime.
+func (i *Interval) HasTimePart() bool {
+	switch i.Unit {
+	case "YEAR", "QUARTER", "MONTH", "WEEK", "DAY", "YEAR_MONTH":
+		return false
+	default:
+		return true
+	}
+}
+
 // WithChildren implements the Expression interface.
 func (i *Interval) WithChildren(children ...sql.Expression) (sql.Expression, error) {
 	if len(children) != 1 {
@@ -175,31 +221,94 @@ func (i *Interval) String() string {
 	return fmt.Sprintf("INTERVAL %s %s", i.Child, i.Unit)
 }
 
-var unitTextFormats = map[string]*regexp.Regexp{
-	"DAY_HOUR":           regexp.MustCompile(`^(\d+)\s+(\d+)$`),
-	"DAY_MICROSECOND":    regexp.MustCompile(`^(\d+)\s+(\d+):(\d+):(\d+).(\d+)$`),
-	"DAY_MINUTE":         regexp.MustCompile(`^(\d+)\s+(\d+):(\d+)$`),
-	"DAY_SECOND":         regexp.MustCompile(`^(\d+)\s+(\d+):(\d+):(\d+)$`),
-	"HOUR_MICROSECOND":   regexp.MustCompile(`^(\d+):(\d+):(\d+).(\d+)$`),
-	"HOUR_SECOND":        regexp.MustCompile(`^(\d+):(\d+):(\d+)$`),
-	"HOUR_MINUTE":        regexp.MustCompile(`^(\d+):(\d+)$`),
-	"MINUTE_MICROSECOND": regexp.MustCompile(`^(\d+):(\d+).(\d+)$`),
-	"MINUTE_SECOND":      regexp.MustCompile(`^(\d+):(\d+)$`),
-	"SECOND_MICROSECOND": regexp.MustCompile(`^(\d+).(\d+)$`),
-	"YEAR_MONTH":         regexp.MustCompile(`^(\d+)-(\d+)$`),
+// intervalNumber converts the value of an interval with a single unit to an
+// integer. Numbers with a fractional part are rounded, as in MySQL.
+func intervalNumber(val interface{}) (int64, error) {
+	switch v := val.(type) {
+	case float32:
+		val = math.Round(float64(v))
+	case float64:
+		val = math.Round(v)
+	case decimal.Decimal:
+		val = v.Round(0)
+	}
+
+	val, err := sql.Int64.Convert(val)
+	if err != nil {
+		return 0, err
+	}
+	return val.(int64), nil
 }
 
-func textFormatParts(text string, r *regexp.Regexp) []int64 {
-	parts := r.FindStringSubmatch(text)
-	var result []int64
-	for _, p := range parts[1:] {
-		// It is safe to igore the error here, because at this point we know
-		// the string matches the regexp, and that means it can't be an
-		// invalid number.
-		n, _ := strconv.ParseInt(p, 10, 64)
-		result = append(result, n)
+// unitTextParts are the number of parts of the values of the units that are
+// written as text, such as '1:30' for HOUR_MINUTE.
+var unitTextParts = map[string]int{
+	"DAY_HOUR":           2,
+	"DAY_MICROSECOND":    5,
+	"DAY_MINUTE":         3,
+	"DAY_SECOND":         4,
+	"HOUR_MICROSECOND":   4,
+	"HOUR_SECOND":        3,
+	"HOUR_MINUTE":        2,
+	"MINUTE_MICROSECOND": 3,
+	"MINUTE_SECOND":      2,
+	"SECOND_MICROSECOND": 2,
+	"YEAR_MONTH":         2,
+}
+
+// parseIntervalText reads the parts of the value of an interval written as
+// text the same way MySQL does. Parts are runs of digits separated by any other
+// characters, and the whole value is negative if it starts with '-'. If there
+// are fewer parts than |count|, they are the smallest parts of the unit, so
+// that '30' is 30 minutes for HOUR_MINUTE. If |microseconds| is set, the last
+// part is read as the digits of a fraction of a second, so that '1.5' is 1
+// second and 500000 microseconds. It returns false if there are more parts than
+// |count|, or if a part doesn't fit in 64 bits.
+func parseIntervalText(text string, count int, microseconds bool) ([]int64, bool, bool) {
+	text = strings.TrimLeft(text, " \t\n")
+	negative := strings.HasPrefix(text, "-")
+	if negative {
+		text = text[1:]
 	}
-	return result
+
+	parts := make([]int64, count)
+	n := 0
+	digits := 0
+	pos := 0
+	for n < count && pos < len(text) {
+		start := pos
+		for pos < len(text) && text[pos] >= '0' && text[pos] <= '9' {
+			pos++
+		}
+		if pos-start > 18 {
+			return nil, false, false
+		}
+		parts[n], _ = strconv.ParseInt("0"+text[start:pos], 10, 64)
+		digits = pos - start
+		n++
+
+		for pos < len(text) && (text[pos] < '0' || text[pos] > '9') {
+			pos++
+		}
+	}
+	if pos < len(text) {
+		return nil, false, false
+	}
+
+	if microseconds && n > 0 && digits < 6 {
+		for ; digits < 6; digits++ {
+			parts[n-1] *= 10
+		}
+	}
+
+	if n < count {
+		copy(parts[count-n:], parts[:n])
+		for i := 0; i < count-n; i++ {
+			parts[i] = 0
+		}
+	}
+
+	return parts, negative, true
 }
 
 // TimeDelta is the difference between a time and another time.
@@ -228,65 +337,75 @@ const (
 	week = 7 * day
 )
 
-func (td TimeDelta) apply(t time.Time, sign int64) time.Time {
-	y := int64(t.Year())
-	mo := int64(t.Month())
-	d := t.Day()
-	h := t.Hour()
-	min := t.Minute()
-	s := t.Second()
-	ns := t.Nanosecond()
+// maxIntervalDays is a number of days larger than the range of valid dates. Adding it to any valid date gives an
+// invalid date, which lets deltas be clamped to it without changing their results.
+const maxIntervalDays = 10000 * 366
 
-	if td.Years != 0 {
-		y += td.Years * sign
+func (td TimeDelta) apply(t time.Time, sign int64) time.Time {
+	if sign < 0 {
+		td = td.negate()
 	}
 
-	if td.Months != 0 {
-		m := mo + td.Months*sign
-		if m < 1 {
-			mo = 12 + (m % 12)
-			y += m/12 - 1
-		} else if m > 12 {
-			mo = m % 12
-			y += m / 12
-		} else {
-			mo = m
-		}
-
-		// Due to the operations done before, month may be zero, which means it's
-		// december.
-		if mo == 0 {
-			mo = 12
-		}
+	months := clampInterval(td.Years, 12*10000)*12 + clampInterval(td.Months, 12*10000)
+	months += int64(t.Year())*12 + int64(t.Month()) - 1
+	y := months / 12
+	if months < 0 && months%12 != 0 {
+		y--
 	}
+	mo := time.Month(months-y*12) + 1
 
-	if days := daysInMonth(time.Month(mo), int(y)); days < d {
+	d := t.Day()
+	if days := daysInMonth(mo, int(y)); days < d {
 		d = days
 	}
 
-	date := time.Date(int(y), time.Month(mo), d, h, min, s, ns, t.Location())
-
-	if td.Days != 0 {
-		date = date.Add(time.Duration(td.Days) * day * time.Duration(sign))
+	date := time.Date(int(y), mo, d, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+
+	// The time parts are split in whole days and the rest, so that large
+	// deltas don't overflow a time.Duration.
+	const usPerDay = int64(day / time.Microsecond)
+	days := clampInterval(td.Days, maxIntervalDays) +
+		clampInterval(td.Hours/24, maxIntervalDays) +
+		clampInterval(td.Minutes/(24*60), maxIntervalDays) +
+		clampInterval(td.Seconds/(24*60*60), maxIntervalDays) +
+		td.Microseconds/usPerDay
+	us := (td.Hours%24)*int64(time.Hour/time.Microsecond) +
+		(td.Minutes%(24*60))*int64(time.Minute/time.Microsecond) +
+		(td.Seconds%(24*60*60))*int64(time.Second/time.Microsecond) +
+		td.Microseconds%usPerDay
+
+	if days != 0 {
+		date = date.AddDate(0, 0, int(clampInterval(days, maxIntervalDays)))
 	}
-
-	if td.Hours != 0 {
-		date = date.Add(time.Duration(td.Hours) * time.Hour * time.Duration(sign))
+	if us != 0 {
+		date = date.Add(time.Duration(us) * time.Microsecond)
 	}
 
-	if td.Minutes != 0 {
-		date = date.Add(time.Duration(td.Minutes) * time.Minute * time.Duration(sign))
-	}
+	return date
+}
 
-	if td.Seconds != 0 {
-		date = date.Add(time.Duration(td.Seconds) * time.Second * time.Duration(sign))
+// negate returns the time delta with the sign of every part reversed.
+func (td TimeDelta) negate() TimeDelta {
+	return TimeDelta{
+		Years:        -td.Years,
+		Months:       -td.Months,
+		Days:         -td.Days,
+		Hours:        -td.Hours,
+		Minutes:      -td.Minutes,
+		Seconds:      -td.Seconds,
+		Microseconds: -td.Microseconds,
 	}
+}
 
-	if td.Microseconds != 0 {
-		date = date.Add(time.Duration(td.Microseconds) * time.Microsecond * time.Duration(sign))
+// clampInterval limits |n| to the range from -|max| to |max|.
+func clampInterval(n, max int64) int64 {
+	if n > max {
+		return max
 	}
-
-	return date
+	if n < -max {
+		return -max
+	}
+	return n
 }
 
 func daysInMonth(month time.Month, year int) int {
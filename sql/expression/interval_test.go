@@ -15,6 +15,7 @@
 package expression
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -53,6 +54,12 @@ func TestTimeDelta(t *testing.T) {
 			"plus overflowing until december",
 			TimeDelta{Months: 22},
 			leapYear,
+			date(2005, time.December, 29, 0, 0, 0, 0),
+		},
+		{
+			"plus months to december",
+			TimeDelta{Months: 34},
+			leapYear,
 			date(2006, time.December, 29, 0, 0, 0, 0),
 		},
 		{
@@ -226,7 +233,7 @@ func TestIntervalEvalDelta(t *testing.T) {
 			NewLiteral("2 3:04:05.06", sql.LongText),
 			"DAY_MICROSECOND",
 			nil,
-			TimeDelta{Days: 2, Hours: 3, Minutes: 4, Seconds: 5, Microseconds: 6},
+			TimeDelta{Days: 2, Hours: 3, Minutes: 4, Seconds: 5, Microseconds: 60000},
 		},
 		{
 			NewLiteral("2 3:04:05", sql.LongText),
@@ -244,7 +251,7 @@ func TestIntervalEvalDelta(t *testing.T) {
 			NewLiteral("3:04:05.06", sql.LongText),
 			"HOUR_MICROSECOND",
 			nil,
-			TimeDelta{Hours: 3, Minutes: 4, Seconds: 5, Microseconds: 6},
+			TimeDelta{Hours: 3, Minutes: 4, Seconds: 5, Microseconds: 60000},
 		},
 		{
 			NewLiteral("3:04:05", sql.LongText),
@@ -262,7 +269,7 @@ func TestIntervalEvalDelta(t *testing.T) {
 			NewLiteral("04:05.06", sql.LongText),
 			"MINUTE_MICROSECOND",
 			nil,
-			TimeDelta{Minutes: 4, Seconds: 5, Microseconds: 6},
+			TimeDelta{Minutes: 4, Seconds: 5, Microseconds: 60000},
 		},
 		{
 			NewLiteral("04:05", sql.LongText),
@@ -274,7 +281,7 @@ func TestIntervalEvalDelta(t *testing.T) {
 			NewLiteral("04.05", sql.LongText),
 			"SECOND_MICROSECOND",
 			nil,
-			TimeDelta{Seconds: 4, Microseconds: 5},
+			TimeDelta{Seconds: 4, Microseconds: 50000},
 		},
 		{
 			NewLiteral("1-5", sql.LongText),
@@ -282,6 +289,54 @@ func TestIntervalEvalDelta(t *testing.T) {
 			nil,
 			TimeDelta{Years: 1, Months: 5},
 		},
+		{
+			NewLiteral("30", sql.LongText),
+			"HOUR_MINUTE",
+			nil,
+			TimeDelta{Minutes: 30},
+		},
+		{
+			NewLiteral("-1:30", sql.LongText),
+			"HOUR_MINUTE",
+			nil,
+			TimeDelta{Hours: -1, Minutes: -30},
+		},
+		{
+			NewLiteral(" 1 / 30", sql.LongText),
+			"HOUR_MINUTE",
+			nil,
+			TimeDelta{Hours: 1, Minutes: 30},
+		},
+		{
+			NewLiteral("5", sql.LongText),
+			"SECOND_MICROSECOND",
+			nil,
+			TimeDelta{Microseconds: 500000},
+		},
+		{
+			NewLiteral("1.000002", sql.LongText),
+			"SECOND_MICROSECOND",
+			nil,
+			TimeDelta{Seconds: 1, Microseconds: 2},
+		},
+		{
+			NewLiteral(1.5, sql.Float64),
+			"SECOND",
+			nil,
+			TimeDelta{Seconds: 1, Microseconds: 500000},
+		},
+		{
+			NewLiteral(-1.5, sql.Float64),
+			"SECOND",
+			nil,
+			TimeDelta{Seconds: -1, Microseconds: -500000},
+		},
+		{
+			NewLiteral(1.5, sql.Float64),
+			"DAY",
+			nil,
+			TimeDelta{Days: 2},
+		},
 	}
 
 	for _, tt := range testCases {
@@ -295,6 +350,41 @@ func TestIntervalEvalDelta(t *testing.T) {
 	}
 }
 
+func TestIntervalEvalDeltaInvalid(t *testing.T) {
+	testCases := []struct {
+		value string
+		unit  string
+	}{
+		{"1:2:3", "HOUR_MINUTE"},
+		{"1 2 3", "SECOND_MICROSECOND"},
+		{"1-2-3", "YEAR_MONTH"},
+		{"9999999999999999999:1", "HOUR_MINUTE"},
+	}
+
+	for _, tt := range testCases {
+		interval := NewInterval(NewLiteral(tt.value, sql.LongText), tt.unit)
+		t.Run(interval.String(), func(t *testing.T) {
+			require := require.New(t)
+			result, err := interval.EvalDelta(sql.NewEmptyContext(), nil)
+			require.NoError(err)
+			require.Nil(result)
+		})
+	}
+}
+
+func TestTimeDeltaOverflow(t *testing.T) {
+	require := require.New(t)
+	d := date(2004, time.February, 29, 0, 0, 0, 0)
+
+	require.Nil(sql.ValidateTime(TimeDelta{Days: math.MaxInt64}.Add(d)))
+	require.Nil(sql.ValidateTime(TimeDelta{Hours: math.MaxInt64}.Add(d)))
+	require.Nil(sql.ValidateTime(TimeDelta{Microseconds: math.MinInt64}.Add(d)))
+	require.Nil(sql.ValidateTime(TimeDelta{Years: math.MaxInt64}.Sub(d)))
+	require.Nil(sql.ValidateTime(TimeDelta{Months: math.MaxInt64}.Add(d)))
+	require.Equal(date(9999, time.December, 31, 0, 0, 0, 0), TimeDelta{Years: 7995, Months: 10, Days: 2}.Add(d))
+	require.Equal(date(1, time.January, 1, 0, 0, 0, 0), TimeDelta{Days: 731639}.Sub(d))
+}
+
 func date(year int, month time.Month, day, hour, min, sec, micro int) time.Time {
 	return time.Date(year, month, day, hour, min, sec, micro*int(time.Microsecond), time.Local)
 }
@@ -46,6 +46,22 @@ func JoinAnd(exprs ...sql.Expression) sql.Expression {
 	}
 }
 
+// JoinOr joins several expressions with Or.
+func JoinOr(exprs ...sql.Expression) sql.Expression {
+	switch len(exprs) {
+	case 0:
+		return nil
+	case 1:
+		return exprs[0]
+	default:
+		result := NewOr(exprs[0], exprs[1])
+		for _, e := range exprs[2:] {
+			result = NewOr(result, e)
+		}
+		return result
+	}
+}
+
 func (a *And) String() string {
 	return fmt.Sprintf("(%s AND %s)", a.Left, a.Right)
 }
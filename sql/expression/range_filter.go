@@ -0,0 +1,105 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// RangeFilter is an expression that evaluates whether the values of an index's column expressions, for a given row,
+// are contained within a RangeCollection. Integrators whose indexes cannot iterate over ranges directly may use this
+// to filter the rows of a table by the ranges given to sql.Index.NewLookup.
+type RangeFilter struct {
+	exprs  []sql.Expression
+	ranges sql.RangeCollection
+}
+
+var _ sql.Expression = (*RangeFilter)(nil)
+
+// NewRangeFilter returns a new RangeFilter over the given expressions, which must be in the same order as the
+// columns of the ranges.
+func NewRangeFilter(exprs []sql.Expression, ranges sql.RangeCollection) *RangeFilter {
+	return &RangeFilter{exprs: exprs, ranges: ranges}
+}
+
+// Ranges returns the ranges that this filter evaluates against.
+func (f *RangeFilter) Ranges() sql.RangeCollection {
+	return f.ranges
+}
+
+// Resolved implements the Expression interface.
+func (f *RangeFilter) Resolved() bool {
+	for _, expr := range f.exprs {
+		if !expr.Resolved() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNullable implements the Expression interface.
+func (f *RangeFilter) IsNullable() bool {
+	return false
+}
+
+// Type implements the Expression interface.
+func (f *RangeFilter) Type() sql.Type {
+	return sql.Boolean
+}
+
+// Children implements the Expression interface.
+func (f *RangeFilter) Children() []sql.Expression {
+	return f.exprs
+}
+
+// WithChildren implements the Expression interface.
+func (f *RangeFilter) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != len(f.exprs) {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), len(f.exprs))
+	}
+	return NewRangeFilter(children, f.ranges), nil
+}
+
+// Eval implements the Expression interface.
+func (f *RangeFilter) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	values := make([]interface{}, len(f.exprs))
+	for i, expr := range f.exprs {
+		val, err := expr.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = val
+	}
+	return f.ranges.Contains(values)
+}
+
+func (f *RangeFilter) String() string {
+	exprs := make([]string, len(f.exprs))
+	for i, expr := range f.exprs {
+		exprs[i] = expr.String()
+	}
+	return fmt.Sprintf("(%s) IN %s", strings.Join(exprs, ", "), f.ranges.String())
+}
+
+func (f *RangeFilter) DebugString() string {
+	exprs := make([]string, len(f.exprs))
+	for i, expr := range f.exprs {
+		exprs[i] = sql.DebugString(expr)
+	}
+	return fmt.Sprintf("(%s) IN %s", strings.Join(exprs, ", "), f.ranges.DebugString())
+}
@@ -0,0 +1,51 @@
+// Copyright 2021 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestRangeFilter(t *testing.T) {
+	require := require.New(t)
+
+	x := NewGetField(0, sql.Int64, "x", true)
+	y := NewGetField(1, sql.Int64, "y", true)
+	e := NewRangeFilter([]sql.Expression{x, y}, sql.RangeCollection{
+		{sql.ClosedRangeColumnExpr(int64(1), int64(1), sql.Int64), sql.GreaterThanRangeColumnExpr(int64(5), sql.Int64)},
+		{sql.ClosedRangeColumnExpr(nil, nil, sql.Int64), sql.AllRangeColumnExpr(sql.Int64)},
+	})
+	require.Equal(sql.Boolean, e.Type())
+	require.False(e.IsNullable())
+
+	testCases := []struct {
+		row      sql.Row
+		expected bool
+	}{
+		{sql.NewRow(int64(1), int64(6)), true},
+		{sql.NewRow(int64(1), int64(5)), false},
+		{sql.NewRow(int64(2), int64(6)), false},
+		{sql.NewRow(nil, int64(3)), true},
+		{sql.NewRow(nil, nil), true},
+		{sql.NewRow(int64(1), nil), true},
+	}
+	for _, tt := range testCases {
+		require.Equal(tt.expected, eval(t, e, tt.row), "%v", tt.row)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// RowSecurityCheck is an expression that evaluates the row security predicate of a table for a row being written,
+// returning an error if the predicate isn't true. It's used as a check constraint on the tables written by INSERT and
+// UPDATE statements, so that sessions can't write rows they wouldn't be allowed to read.
+type RowSecurityCheck struct {
+	UnaryExpression
+	table string
+}
+
+var _ sql.Expression = (*RowSecurityCheck)(nil)
+
+// NewRowSecurityCheck returns a new RowSecurityCheck of the predicate given for the table with the name given.
+func NewRowSecurityCheck(table string, predicate sql.Expression) *RowSecurityCheck {
+	return &RowSecurityCheck{UnaryExpression: UnaryExpression{predicate}, table: table}
+}
+
+// Type implements the Expression interface.
+func (*RowSecurityCheck) Type() sql.Type {
+	return sql.Boolean
+}
+
+// IsNullable implements the Expression interface.
+func (*RowSecurityCheck) IsNullable() bool {
+	return false
+}
+
+// Eval implements the Expression interface.
+func (c *RowSecurityCheck) Eval(ctx *sql.Context, row sql.Row) (interface{}, error) {
+	res, err := sql.EvaluateCondition(ctx, c.Child, row)
+	if err != nil {
+		return nil, err
+	}
+	if !sql.IsTrue(res) {
+		return nil, sql.ErrRowSecurityViolation.New(c.table)
+	}
+	return true, nil
+}
+
+func (c *RowSecurityCheck) String() string {
+	return fmt.Sprintf("ROW SECURITY(%s)", c.Child)
+}
+
+// WithChildren implements the Expression interface.
+func (c *RowSecurityCheck) WithChildren(children ...sql.Expression) (sql.Expression, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 1)
+	}
+	return NewRowSecurityCheck(c.table, children[0]), nil
+}
@@ -15,7 +15,9 @@
 package expression
 
 import (
+	"bytes"
 	"container/heap"
+	"sort"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
@@ -23,8 +25,104 @@ import (
 type Sorter struct {
 	SortFields []sql.SortField
 	Rows       []sql.Row
-	LastError  error
-	Ctx        *sql.Context
+	// Keys are the sort keys of Rows, if they've been computed with ComputeKeys. Rows are compared by their keys when
+	// there's one for each row, and by evaluating their sort fields on each comparison otherwise.
+	Keys      []SortKey
+	LastError error
+	Ctx       *sql.Context
+	// weightStrings is whether the values of each sort field are kept as weight strings in sort keys.
+	weightStrings []bool
+}
+
+// SortKey is the values of the sort fields of a row, evaluated once so that rows can be compared without evaluating
+// their sort fields on each comparison. Values of a sql.WeightStringType are kept as their weight strings.
+type SortKey []interface{}
+
+// NewSortKey returns the sort key of the row given for the sort fields given.
+func NewSortKey(ctx *sql.Context, fields []sql.SortField, row sql.Row) (SortKey, error) {
+	key := make(SortKey, len(fields))
+	for i, sf := range fields {
+		v, err := sf.Column.Eval(ctx, row)
+		if err != nil {
+			return nil, sql.ErrUnableSort.Wrap(err)
+		}
+		if v != nil {
+			if wt, ok := sf.Column.Type().(sql.WeightStringType); ok {
+				v, err = wt.WeightString(v)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		key[i] = v
+	}
+	return key, nil
+}
+
+// ComputeKeys computes the sort keys of the sorter's rows, so that they're compared by their keys.
+func (s *Sorter) ComputeKeys() error {
+	keys := make([]SortKey, len(s.Rows))
+	for i, row := range s.Rows {
+		key, err := NewSortKey(s.Ctx, s.SortFields, row)
+		if err != nil {
+			return err
+		}
+		keys[i] = key
+	}
+	s.Keys = keys
+	return nil
+}
+
+// Sort stably sorts the sorter's rows by their sort keys, which it computes first. The positions of the rows are sorted
+// rather than the rows themselves, which keeps the many swaps of a stable sort cheap, and the rows are then moved to
+// their sorted positions once.
+func (s *Sorter) Sort() error {
+	if err := s.ComputeKeys(); err != nil {
+		return err
+	}
+
+	order := make([]int, len(s.Rows))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Stable(&sortedPositions{s, order})
+	if s.LastError != nil {
+		return s.LastError
+	}
+
+	rows := make([]sql.Row, len(order))
+	keys := make([]SortKey, len(order))
+	for i, j := range order {
+		rows[i], keys[i] = s.Rows[j], s.Keys[j]
+	}
+	copy(s.Rows, rows)
+	copy(s.Keys, keys)
+	return nil
+}
+
+// sortedPositions implements sort.Interface for the positions of the rows of a Sorter with sort keys.
+type sortedPositions struct {
+	s     *Sorter
+	order []int
+}
+
+func (p *sortedPositions) Len() int {
+	return len(p.order)
+}
+
+func (p *sortedPositions) Swap(i, j int) {
+	p.order[i], p.order[j] = p.order[j], p.order[i]
+}
+
+func (p *sortedPositions) Less(i, j int) bool {
+	if p.s.LastError != nil {
+		return false
+	}
+	return p.s.lessKeys(p.s.Keys[p.order[i]], p.s.Keys[p.order[j]])
+}
+
+func (s *Sorter) keyed() bool {
+	return s.Keys != nil && len(s.Keys) == len(s.Rows)
 }
 
 func (s *Sorter) Len() int {
@@ -33,6 +131,9 @@ func (s *Sorter) Len() int {
 
 func (s *Sorter) Swap(i, j int) {
 	s.Rows[i], s.Rows[j] = s.Rows[j], s.Rows[i]
+	if s.keyed() {
+		s.Keys[i], s.Keys[j] = s.Keys[j], s.Keys[i]
+	}
 }
 
 func (s *Sorter) Less(i, j int) bool {
@@ -40,10 +141,13 @@ func (s *Sorter) Less(i, j int) bool {
 		return false
 	}
 
+	if s.keyed() {
+		return s.lessKeys(s.Keys[i], s.Keys[j])
+	}
+
 	a := s.Rows[i]
 	b := s.Rows[j]
 	for _, sf := range s.SortFields {
-		typ := sf.Column.Type()
 		av, err := sf.Column.Eval(s.Ctx, a)
 		if err != nil {
 			s.LastError = sql.ErrUnableSort.Wrap(err)
@@ -56,22 +160,47 @@ func (s *Sorter) Less(i, j int) bool {
 			return false
 		}
 
-		if sf.Order == sql.Descending {
-			av, bv = bv, av
+		cmp, err := compareSortValues(sf, sf.Column.Type(), av, bv)
+		if err != nil {
+			s.LastError = err
+			return false
 		}
 
-		if av == nil && bv == nil {
-			continue
-		} else if av == nil {
-			return sf.NullOrdering == sql.NullsFirst
-		} else if bv == nil {
-			return sf.NullOrdering != sql.NullsFirst
+		switch cmp {
+		case -1:
+			return true
+		case 1:
+			return false
 		}
+	}
 
-		cmp, err := typ.Compare(av, bv)
-		if err != nil {
-			s.LastError = err
-			return false
+	return false
+}
+
+func (s *Sorter) lessKeys(a, b SortKey) bool {
+	if s.weightStrings == nil {
+		s.weightStrings = make([]bool, len(s.SortFields))
+		for k, sf := range s.SortFields {
+			_, s.weightStrings[k] = sf.Column.Type().(sql.WeightStringType)
+		}
+	}
+
+	for k, sf := range s.SortFields {
+		av, bv := a[k], b[k]
+
+		var cmp int
+		if s.weightStrings[k] && av != nil && bv != nil {
+			if sf.Order == sql.Descending {
+				av, bv = bv, av
+			}
+			cmp = bytes.Compare(av.([]byte), bv.([]byte))
+		} else {
+			var err error
+			cmp, err = compareSortValues(sf, sf.Column.Type(), av, bv)
+			if err != nil {
+				s.LastError = err
+				return false
+			}
 		}
 
 		switch cmp {
@@ -85,6 +214,30 @@ func (s *Sorter) Less(i, j int) bool {
 	return false
 }
 
+// compareSortValues compares the values of the sort field given of two rows, returning -1 if the first sorts before the
+// second, 1 if it sorts after it and 0 if they sort the same.
+func compareSortValues(sf sql.SortField, typ sql.Type, av, bv interface{}) (int, error) {
+	if sf.Order == sql.Descending {
+		av, bv = bv, av
+	}
+
+	if av == nil && bv == nil {
+		return 0, nil
+	} else if av == nil {
+		if sf.NullOrdering == sql.NullsFirst {
+			return -1, nil
+		}
+		return 1, nil
+	} else if bv == nil {
+		if sf.NullOrdering != sql.NullsFirst {
+			return -1, nil
+		}
+		return 1, nil
+	}
+
+	return typ.Compare(av, bv)
+}
+
 // TopRowsHeap implements heap.Interface based on Sorter. It inverts the Less()
 // function so that it can be used to implement TopN. heap.Push() rows into it,
 // and if Len() > MAX; heap.Pop() the current min row. Then, at the end of
@@ -99,8 +252,18 @@ func (h *TopRowsHeap) Less(i, j int) bool {
 	return !h.Sorter.Less(i, j)
 }
 
+// Push implements heap.Interface. The sort key of the row is computed as it's pushed, unless the heap was given rows
+// without their keys.
 func (h *TopRowsHeap) Push(x interface{}) {
-	h.Sorter.Rows = append(h.Sorter.Rows, x.(sql.Row))
+	row := x.(sql.Row)
+	if len(h.Sorter.Keys) == len(h.Sorter.Rows) {
+		key, err := NewSortKey(h.Sorter.Ctx, h.Sorter.SortFields, row)
+		if err != nil {
+			h.Sorter.LastError = err
+		}
+		h.Sorter.Keys = append(h.Sorter.Keys, key)
+	}
+	h.Sorter.Rows = append(h.Sorter.Rows, row)
 }
 
 func (h *TopRowsHeap) Pop() interface{} {
@@ -108,6 +271,9 @@ func (h *TopRowsHeap) Pop() interface{} {
 	n := len(old)
 	res := old[n-1]
 	h.Sorter.Rows = old[0 : n-1]
+	if len(h.Sorter.Keys) == n {
+		h.Sorter.Keys = h.Sorter.Keys[0 : n-1]
+	}
 	return res
 }
 
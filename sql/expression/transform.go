@@ -108,6 +108,49 @@ func TransformUpWithNode(n sql.Node, e sql.Expression, f TransformExprWithNodeFu
 	return f(n, e)
 }
 
+// ExprTransformer is a function that given an expression and the node that contains it, will return that expression
+// as is along with sql.SameTree, or transformed along with sql.NewTree, and an error, if any. As with
+// plan.NodeTransformer, transformers that only apply to some types of expressions type switch on the expression given,
+// because this module supports Go 1.15, which has no type parameters.
+type ExprTransformer func(sql.Node, sql.Expression) (sql.Expression, sql.TreeIdentity, error)
+
+// TransformUpWithIdentity applies a transformation function to the given expression of the node given from the bottom
+// up. Unlike TransformUpWithNode, expressions whose children weren't transformed aren't rebuilt, and the expression
+// given is returned as is, along with sql.SameTree, if none of it was transformed.
+func TransformUpWithIdentity(n sql.Node, e sql.Expression, f ExprTransformer) (sql.Expression, sql.TreeIdentity, error) {
+	children := e.Children()
+	same := sql.SameTree
+	var newChildren []sql.Expression
+	for i, c := range children {
+		c, identity, err := TransformUpWithIdentity(n, c, f)
+		if err != nil {
+			return nil, sql.SameTree, err
+		}
+		if identity == sql.NewTree {
+			if newChildren == nil {
+				newChildren = make([]sql.Expression, len(children))
+				copy(newChildren, children)
+			}
+			newChildren[i] = c
+		}
+	}
+
+	if newChildren != nil {
+		var err error
+		e, err = e.WithChildren(newChildren...)
+		if err != nil {
+			return nil, sql.SameTree, err
+		}
+		same = sql.NewTree
+	}
+
+	e, identity, err := f(n, e)
+	if err != nil {
+		return nil, sql.SameTree, err
+	}
+	return e, same && identity, nil
+}
+
 // ExpressionToColumn converts the expression to the form that should be used in a Schema. Expressions that have Name()
 // and Table() methods will use these; otherwise, String() and "" are used, respectively. The type and nullability are
 // taken from the expression directly.
@@ -92,12 +92,19 @@ func (v *SystemVar) WithChildren(children ...sql.Expression) (sql.Expression, er
 // UserVar is an expression that returns the value of a user variable. It's also used as the expression on the left hand
 // side of a SET statement for a user var.
 type UserVar struct {
-	Name string
+	Name     string
+	exprType sql.Type
 }
 
 // NewUserVar creates a new UserVar expression.
 func NewUserVar(name string) *UserVar {
-	return &UserVar{name}
+	return &UserVar{Name: name}
+}
+
+// NewUserVarWithType creates a new UserVar expression whose value has the type given, which is usually the type of the
+// value of the variable when the expression is analyzed.
+func NewUserVarWithType(name string, t sql.Type) *UserVar {
+	return &UserVar{Name: name, exprType: t}
 }
 
 // Children implements the sql.Expression interface.
@@ -113,8 +120,12 @@ func (v *UserVar) Eval(ctx *sql.Context, _ sql.Row) (interface{}, error) {
 }
 
 // Type implements the sql.Expression interface.
-// TODO: type checking based on type of user var
-func (v *UserVar) Type() sql.Type { return sql.Boolean }
+func (v *UserVar) Type() sql.Type {
+	if v.exprType == nil {
+		return sql.Boolean
+	}
+	return v.exprType
+}
 
 // IsNullable implements the sql.Expression interface.
 func (v *UserVar) IsNullable() bool { return true }
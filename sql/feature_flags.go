@@ -0,0 +1,281 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// FeatureFlagsSysVar is the system variable that turns feature flags on or off for a session, or for the new sessions
+// when it's set globally. Its value is a comma separated list of flag=on, flag=off or flag=default entries, like
+// MySQL's optimizer_switch, where later entries take precedence over earlier ones. The flags it doesn't mention keep
+// the default of the engine, or the default of the flag when the engine has none.
+const FeatureFlagsSysVar = "optimizer_switch"
+
+// The feature flags of the engine.
+const (
+	// FeatureHashJoin lets the analyzer execute joins on an equality of columns as hash joins.
+	FeatureHashJoin = "hash_join"
+	// FeatureMergeJoin lets the analyzer execute joins of tables with ordered indexes on the joined columns as merge
+	// joins.
+	FeatureMergeJoin = "merge_join"
+	// FeatureSortedGroupBy lets the analyzer aggregate the groups of rows sorted by their grouping one at a time.
+	FeatureSortedGroupBy = "sorted_group_by"
+	// FeatureSubqueryToDerived lets the analyzer rewrite correlated scalar subqueries into joins with derived tables.
+	FeatureSubqueryToDerived = "subquery_to_derived"
+	// FeatureSemijoin lets the analyzer rewrite correlated IN and EXISTS subqueries into joins with derived tables.
+	FeatureSemijoin = "semijoin"
+	// FeatureIndexMerge lets the analyzer look up the rows matching a disjunction of conditions on indexed columns of a
+	// table with the union of the ranges of their index lookups.
+	FeatureIndexMerge = "index_merge"
+	// FeatureDerivedConditionPushdown lets the analyzer push the conditions of filters on derived tables down into their
+	// queries.
+	FeatureDerivedConditionPushdown = "derived_condition_pushdown"
+	// FeatureRecursiveCte allows WITH RECURSIVE common table expressions.
+	FeatureRecursiveCte = "recursive_cte"
+	// FeatureCteMaterialization allows the MATERIALIZED and NOT MATERIALIZED hints of common table expressions, which
+	// MySQL doesn't have.
+	FeatureCteMaterialization = "cte_materialization"
+	// FeatureSpillToDisk lets the operators that hold many rows, such as sorts, spill them to disk when they don't fit
+	// in memory.
+	FeatureSpillToDisk = "spill_to_disk"
+)
+
+// FeatureFlag is a flag that turns a feature of the engine on or off. The analyzer and the nodes of plans check the
+// flags of their features with Context.FeatureEnabled.
+type FeatureFlag struct {
+	// Name is the name of the flag in optimizer_switch, in lower case.
+	Name string
+	// Description says what the flag turns on or off.
+	Description string
+	// Default is whether the feature is enabled when neither the session nor the engine sets the flag.
+	Default bool
+}
+
+type featureFlagRegistry struct {
+	mu    *sync.RWMutex
+	flags map[string]FeatureFlag
+}
+
+var featureFlags = &featureFlagRegistry{&sync.RWMutex{}, make(map[string]FeatureFlag)}
+
+func init() {
+	for _, flag := range []FeatureFlag{
+		{Name: FeatureHashJoin, Description: "Execute joins on an equality of columns as hash joins", Default: true},
+		{Name: FeatureMergeJoin, Description: "Execute joins of tables with ordered indexes on the joined columns as merge joins", Default: true},
+		{Name: FeatureSortedGroupBy, Description: "Aggregate the groups of sorted rows one at a time", Default: true},
+		{Name: FeatureSubqueryToDerived, Description: "Rewrite correlated scalar subqueries into joins with derived tables", Default: true},
+		{Name: FeatureSemijoin, Description: "Rewrite correlated IN and EXISTS subqueries into joins with derived tables", Default: true},
+		{Name: FeatureIndexMerge, Description: "Look up the rows matching disjunctions of indexed conditions with the union of their ranges", Default: true},
+		{Name: FeatureDerivedConditionPushdown, Description: "Push the conditions on derived tables down into their queries", Default: true},
+		{Name: FeatureRecursiveCte, Description: "Allow WITH RECURSIVE common table expressions", Default: true},
+		{Name: FeatureCteMaterialization, Description: "Allow the MATERIALIZED and NOT MATERIALIZED hints of common table expressions", Default: true},
+		{Name: FeatureSpillToDisk, Description: "Spill the rows that don't fit in memory to disk", Default: true},
+	} {
+		featureFlags.flags[flag.Name] = flag
+	}
+}
+
+// ignoredOptimizerSwitches are the flags of MySQL's optimizer_switch for optimizations the engine doesn't have, or
+// always applies, such as derived_merge. They can be set, for the clients and scripts that set them, but have no
+// effect and aren't part of the value of optimizer_switch.
+var ignoredOptimizerSwitches = map[string]bool{
+	"batched_key_access":                  true,
+	"block_nested_loop":                   true,
+	"condition_fanout_filter":             true,
+	"derived_merge":                       true,
+	"duplicateweedout":                    true,
+	"engine_condition_pushdown":           true,
+	"firstmatch":                          true,
+	"hypergraph_optimizer":                true,
+	"index_condition_pushdown":            true,
+	"index_merge_intersection":            true,
+	"index_merge_sort_union":              true,
+	"index_merge_union":                   true,
+	"loosescan":                           true,
+	"materialization":                     true,
+	"mrr":                                 true,
+	"mrr_cost_based":                      true,
+	"prefer_ordering_index":               true,
+	"skip_scan":                           true,
+	"subquery_materialization_cost_based": true,
+	"use_index_extensions":                true,
+	"use_invisible_indexes":               true,
+}
+
+// RegisterFeatureFlag registers a feature flag, so that it can be set in optimizer_switch and checked with
+// Context.FeatureEnabled. Integrators use it for the features of their own nodes and analyzer rules.
+func RegisterFeatureFlag(flag FeatureFlag) error {
+	flag.Name = strings.ToLower(strings.TrimSpace(flag.Name))
+	if flag.Name == "" || strings.ContainsAny(flag.Name, "=, ") {
+		return ErrInvalidFeatureFlagName.New(flag.Name)
+	}
+
+	featureFlags.mu.Lock()
+	defer featureFlags.mu.Unlock()
+	if _, ok := featureFlags.flags[flag.Name]; ok {
+		return ErrFeatureFlagExists.New(flag.Name)
+	}
+	featureFlags.flags[flag.Name] = flag
+	return nil
+}
+
+// LookupFeatureFlag returns the registered feature flag with the name given, if any.
+func LookupFeatureFlag(name string) (FeatureFlag, bool) {
+	featureFlags.mu.RLock()
+	defer featureFlags.mu.RUnlock()
+	flag, ok := featureFlags.flags[strings.ToLower(name)]
+	return flag, ok
+}
+
+// FeatureFlags returns the registered feature flags, sorted by name.
+func FeatureFlags() []FeatureFlag {
+	featureFlags.mu.RLock()
+	defer featureFlags.mu.RUnlock()
+	flags := make([]FeatureFlag, 0, len(featureFlags.flags))
+	for _, flag := range featureFlags.flags {
+		flags = append(flags, flag)
+	}
+	sort.Slice(flags, func(i, j int) bool {
+		return flags[i].Name < flags[j].Name
+	})
+	return flags
+}
+
+// FeatureSwitches turns feature flags on or off, by name, overriding their defaults.
+type FeatureSwitches map[string]bool
+
+// ParseFeatureSwitches parses a value of optimizer_switch. Every entry names a registered flag, or one of the MySQL
+// flags the engine ignores, and the flags set to default are left out of the result.
+func ParseFeatureSwitches(s string) (FeatureSwitches, error) {
+	switches := make(FeatureSwitches)
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if strings.ToLower(entry) == "default" {
+			switches = make(FeatureSwitches)
+			continue
+		}
+
+		parts := strings.SplitN(entry, "=", 2)
+		if len(parts) != 2 {
+			return nil, ErrInvalidSystemVariableValue.New(FeatureFlagsSysVar, entry)
+		}
+		name := strings.ToLower(strings.TrimSpace(parts[0]))
+		_, registered := LookupFeatureFlag(name)
+		if !registered && !ignoredOptimizerSwitches[name] {
+			return nil, ErrUnknownFeatureFlag.New(name)
+		}
+
+		var on bool
+		switch strings.ToLower(strings.TrimSpace(parts[1])) {
+		case "on":
+			on = true
+		case "off":
+			on = false
+		case "default":
+			delete(switches, name)
+			continue
+		default:
+			return nil, ErrInvalidSystemVariableValue.New(FeatureFlagsSysVar, entry)
+		}
+		if registered {
+			switches[name] = on
+		}
+	}
+	return switches, nil
+}
+
+// MergeFeatureSwitches returns the value optimizer_switch takes when it's set to the changes given while its value is
+// current. As in MySQL, the flags the changes don't mention keep their values, unless the changes are empty or
+// contain a default entry, which resets all of them.
+func MergeFeatureSwitches(current, changes string) string {
+	if strings.TrimSpace(current) == "" || strings.TrimSpace(changes) == "" {
+		return changes
+	}
+	return current + "," + changes
+}
+
+// String returns the switches as a value of optimizer_switch, sorted by the name of their flags.
+func (fs FeatureSwitches) String() string {
+	entries := make([]string, 0, len(fs))
+	for name, on := range fs {
+		if on {
+			entries = append(entries, name+"=on")
+		} else {
+			entries = append(entries, name+"=off")
+		}
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+// FeatureEnabled returns whether the feature flag with the name given is on for the query of the context. The
+// optimizer_switch of the session takes precedence over the defaults of the engine, set with WithFeatureDefaults,
+// which take precedence over the default of the flag. Flags that aren't registered are always off.
+func (c *Context) FeatureEnabled(name string) bool {
+	name = strings.ToLower(name)
+	if c.Session != nil {
+		if val, err := c.GetSessionVariable(c, FeatureFlagsSysVar); err == nil {
+			if s, ok := val.(string); ok && s != "" {
+				if switches, err := ParseFeatureSwitches(s); err == nil {
+					if on, ok := switches[name]; ok {
+						return on
+					}
+				}
+			}
+		}
+	}
+	if on, ok := c.featureDefaults[name]; ok {
+		return on
+	}
+
+	flag, ok := LookupFeatureFlag(name)
+	return ok && flag.Default
+}
+
+// systemFeatureSwitchesType is the type of optimizer_switch, a string that must parse with ParseFeatureSwitches. Its
+// values are normalized to the switches they set.
+type systemFeatureSwitchesType struct {
+	systemStringType
+}
+
+var _ SystemVariableType = systemFeatureSwitchesType{}
+
+// Convert implements Type interface.
+func (t systemFeatureSwitchesType) Convert(v interface{}) (interface{}, error) {
+	val, err := t.systemStringType.Convert(v)
+	if err != nil {
+		return nil, err
+	}
+	switches, err := ParseFeatureSwitches(val.(string))
+	if err != nil {
+		return nil, err
+	}
+	return switches.String(), nil
+}
+
+// MustConvert implements the Type interface.
+func (t systemFeatureSwitchesType) MustConvert(v interface{}) interface{} {
+	value, err := t.Convert(v)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
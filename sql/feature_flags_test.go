@@ -0,0 +1,125 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterFeatureFlag(t *testing.T) {
+	require := require.New(t)
+
+	require.NoError(RegisterFeatureFlag(FeatureFlag{Name: "Test_Feature", Description: "A feature of the tests"}))
+	defer func() {
+		featureFlags.mu.Lock()
+		delete(featureFlags.flags, "test_feature")
+		featureFlags.mu.Unlock()
+	}()
+
+	flag, ok := LookupFeatureFlag("TEST_FEATURE")
+	require.True(ok)
+	require.Equal(FeatureFlag{Name: "test_feature", Description: "A feature of the tests"}, flag)
+	require.Contains(FeatureFlags(), flag)
+
+	err := RegisterFeatureFlag(FeatureFlag{Name: "test_feature"})
+	require.True(ErrFeatureFlagExists.Is(err))
+	err = RegisterFeatureFlag(FeatureFlag{Name: "test=feature"})
+	require.True(ErrInvalidFeatureFlagName.Is(err))
+
+	flags := FeatureFlags()
+	for i := 1; i < len(flags); i++ {
+		require.True(flags[i-1].Name < flags[i].Name)
+	}
+}
+
+func TestParseFeatureSwitches(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected FeatureSwitches
+		err      bool
+	}{
+		{"", FeatureSwitches{}, false},
+		{"hash_join=off", FeatureSwitches{FeatureHashJoin: false}, false},
+		{" Hash_Join = OFF , merge_join=on", FeatureSwitches{FeatureHashJoin: false, FeatureMergeJoin: true}, false},
+		{"hash_join=off,hash_join=on", FeatureSwitches{FeatureHashJoin: true}, false},
+		{"hash_join=off,merge_join=off,hash_join=default", FeatureSwitches{FeatureMergeJoin: false}, false},
+		{"hash_join=off,default,merge_join=on", FeatureSwitches{FeatureMergeJoin: true}, false},
+		{"derived_merge=off,mrr=on,semijoin=off", FeatureSwitches{FeatureSemijoin: false}, false},
+		{"derived_merge=maybe", nil, true},
+		{"hash_join", nil, true},
+		{"hash_join=maybe", nil, true},
+		{"nonexistent=on", nil, true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.value, func(t *testing.T) {
+			switches, err := ParseFeatureSwitches(tt.value)
+			if tt.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, switches)
+			}
+		})
+	}
+
+	require.Equal(t, "hash_join=off,merge_join=on", FeatureSwitches{FeatureMergeJoin: true, FeatureHashJoin: false}.String())
+}
+
+func TestMergeFeatureSwitches(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("semijoin=off", MergeFeatureSwitches("", "semijoin=off"))
+	require.Equal("", MergeFeatureSwitches("hash_join=off", ""))
+
+	merge := func(current, changes string) FeatureSwitches {
+		switches, err := ParseFeatureSwitches(MergeFeatureSwitches(current, changes))
+		require.NoError(err)
+		return switches
+	}
+	require.Equal(FeatureSwitches{FeatureHashJoin: false, FeatureSemijoin: false}, merge("hash_join=off", "semijoin=off"))
+	require.Equal(FeatureSwitches{FeatureHashJoin: true}, merge("hash_join=off", "hash_join=on"))
+	require.Equal(FeatureSwitches{FeatureSemijoin: false}, merge("hash_join=off", "default,semijoin=off"))
+}
+
+func TestFeatureEnabled(t *testing.T) {
+	require := require.New(t)
+
+	ctx := NewContext(context.Background())
+	require.True(ctx.FeatureEnabled(FeatureHashJoin))
+	require.True(ctx.FeatureEnabled(FeatureMergeJoin))
+	require.False(ctx.FeatureEnabled("nonexistent"))
+
+	// The defaults of the engine override the defaults of the flags
+	ctx.ApplyOpts(WithFeatureDefaults(FeatureSwitches{FeatureHashJoin: false, FeatureMergeJoin: false}))
+	require.False(ctx.FeatureEnabled(FeatureHashJoin))
+	require.False(ctx.FeatureEnabled(FeatureMergeJoin))
+	require.True(ctx.FeatureEnabled(FeatureSortedGroupBy))
+
+	// The session overrides the defaults of the engine
+	require.NoError(ctx.SetSessionVariable(ctx, FeatureFlagsSysVar, "HASH_JOIN=ON"))
+	val, err := ctx.GetSessionVariable(ctx, FeatureFlagsSysVar)
+	require.NoError(err)
+	require.Equal("hash_join=on", val)
+	require.True(ctx.FeatureEnabled(FeatureHashJoin))
+	require.False(ctx.FeatureEnabled(FeatureMergeJoin))
+
+	err = ctx.SetSessionVariable(ctx, FeatureFlagsSysVar, "hash_join=maybe")
+	require.True(ErrInvalidSystemVariableValue.Is(err))
+	require.True(ctx.FeatureEnabled(FeatureHashJoin))
+}
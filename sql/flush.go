@@ -0,0 +1,71 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// FlushKind is the kind of an option of a FLUSH statement.
+type FlushKind byte
+
+const (
+	// FlushKind_Other is the kind of the options the engine has nothing to flush for, such as HOSTS or
+	// OPTIMIZER_COSTS. They're only passed along to the FlushHandler.
+	FlushKind_Other FlushKind = iota
+	// FlushKind_Privileges is the kind of FLUSH PRIVILEGES.
+	FlushKind_Privileges
+	// FlushKind_Tables is the kind of FLUSH TABLES, with or without a list of tables.
+	FlushKind_Tables
+	// FlushKind_Status is the kind of FLUSH STATUS.
+	FlushKind_Status
+	// FlushKind_Logs is the kind of FLUSH LOGS, and of the options that flush a single kind of log, such as FLUSH
+	// BINARY LOGS.
+	FlushKind_Logs
+)
+
+func (k FlushKind) String() string {
+	switch k {
+	case FlushKind_Privileges:
+		return "PRIVILEGES"
+	case FlushKind_Tables:
+		return "TABLES"
+	case FlushKind_Status:
+		return "STATUS"
+	case FlushKind_Logs:
+		return "LOGS"
+	default:
+		return "OTHER"
+	}
+}
+
+// FlushTable is a table named by a FLUSH TABLES statement. Database is empty for tables of the current database.
+type FlushTable struct {
+	Database string
+	Name     string
+}
+
+// FlushOption is one of the options of a FLUSH statement.
+type FlushOption struct {
+	Kind FlushKind
+	// Name is the text of the option in upper case, without its list of tables, such as "BINARY LOGS" or
+	// "TABLES WITH READ LOCK".
+	Name string
+	// Tables are the tables of a FLUSH TABLES option. It's empty when every table is flushed.
+	Tables []FlushTable
+}
+
+// FlushHandler is notified of the options of every FLUSH statement, after the engine has flushed its own state for
+// them, so that integrators can flush theirs: reload the privileges they store, close and reopen the files of tables,
+// rotate their logs, and so on. Returning an error fails the statement.
+type FlushHandler interface {
+	Flush(ctx *Context, option FlushOption) error
+}
@@ -0,0 +1,214 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/binary"
+	"math"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// Geometries are sent over the wire, and stored, in MySQL's internal format: the SRID of the geometry as a 4 byte
+// little endian integer, followed by the WKB of the geometry. Like MySQL, the coordinates of geographic geometries are
+// in longitude-latitude order in the WKB, while geographic points have their latitude as X.
+
+// ErrInvalidGeometryData is returned when bytes that aren't a geometry in the internal format are converted to one.
+var ErrInvalidGeometryData = errors.NewKind("Cannot get geometry object from data you send to the GEOMETRY field")
+
+const (
+	// SRIDLength is the length of the SRID that prefixes the WKB of a geometry in the internal format.
+	SRIDLength = 4
+	// wkbHeaderLength is the length of the byte order and geometry type that start the WKB of a geometry.
+	wkbHeaderLength = 5
+	// wkbPointLength is the length of the coordinates of a point in WKB.
+	wkbPointLength = 16
+)
+
+// The WKB geometry types of the geometries known to the engine.
+const (
+	wkbPointID uint32 = iota + 1
+	wkbLinestringID
+	wkbPolygonID
+)
+
+// SerializeGeometry returns the geometry given in the internal format, and false if it isn't a geometry.
+func SerializeGeometry(v interface{}) ([]byte, bool) {
+	srid, ok := GeometrySRID(v)
+	if !ok {
+		return nil, false
+	}
+	buf := make([]byte, SRIDLength, SRIDLength+wkbHeaderLength+wkbPointLength)
+	binary.LittleEndian.PutUint32(buf, srid)
+	return appendWKB(buf, v), true
+}
+
+// appendWKB appends the little endian WKB of the geometry given to the buffer given.
+func appendWKB(buf []byte, v interface{}) []byte {
+	appendUint32 := func(n uint32) {
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], n)
+		buf = append(buf, b[:]...)
+	}
+	appendPoint := func(p Point) {
+		x, y := p.X, p.Y
+		if IsGeographicSRID(p.SRID) {
+			x, y = y, x
+		}
+		var b [wkbPointLength]byte
+		binary.LittleEndian.PutUint64(b[:8], math.Float64bits(x))
+		binary.LittleEndian.PutUint64(b[8:], math.Float64bits(y))
+		buf = append(buf, b[:]...)
+	}
+	appendLine := func(l Linestring) {
+		appendUint32(uint32(len(l.Points)))
+		for _, p := range l.Points {
+			appendPoint(p)
+		}
+	}
+
+	buf = append(buf, 1)
+	switch v := v.(type) {
+	case Point:
+		appendUint32(wkbPointID)
+		appendPoint(v)
+	case Linestring:
+		appendUint32(wkbLinestringID)
+		appendLine(v)
+	case Polygon:
+		appendUint32(wkbPolygonID)
+		appendUint32(uint32(len(v.Lines)))
+		for _, l := range v.Lines {
+			appendLine(l)
+		}
+	}
+	return buf
+}
+
+// DeserializeGeometry returns the geometry in the internal format given.
+func DeserializeGeometry(buf []byte) (interface{}, error) {
+	if len(buf) < SRIDLength+wkbHeaderLength {
+		return nil, ErrInvalidGeometryData.New()
+	}
+	srid := binary.LittleEndian.Uint32(buf)
+	if err := ValidateSRID(srid); err != nil {
+		return nil, err
+	}
+
+	r := wkbReader{buf: buf[SRIDLength:], srid: srid}
+	g, ok := r.readGeometry()
+	if !ok || len(r.buf) != 0 {
+		return nil, ErrInvalidGeometryData.New()
+	}
+	return g, nil
+}
+
+// wkbReader reads a geometry from WKB, consuming its buffer as it goes.
+type wkbReader struct {
+	buf   []byte
+	order binary.ByteOrder
+	srid  uint32
+}
+
+func (r *wkbReader) readUint32() (uint32, bool) {
+	if len(r.buf) < 4 {
+		return 0, false
+	}
+	n := r.order.Uint32(r.buf)
+	r.buf = r.buf[4:]
+	return n, true
+}
+
+func (r *wkbReader) readPoint() (Point, bool) {
+	if len(r.buf) < wkbPointLength {
+		return Point{}, false
+	}
+	x := math.Float64frombits(r.order.Uint64(r.buf[:8]))
+	y := math.Float64frombits(r.order.Uint64(r.buf[8:]))
+	r.buf = r.buf[wkbPointLength:]
+	if IsGeographicSRID(r.srid) {
+		x, y = y, x
+	}
+	return Point{SRID: r.srid, X: x, Y: y}, true
+}
+
+func (r *wkbReader) readLine() (Linestring, bool) {
+	n, ok := r.readUint32()
+	if !ok || uint64(len(r.buf)) < uint64(n)*wkbPointLength {
+		return Linestring{}, false
+	}
+	points := make([]Point, n)
+	for i := range points {
+		points[i], _ = r.readPoint()
+	}
+	return Linestring{SRID: r.srid, Points: points}, true
+}
+
+func (r *wkbReader) readGeometry() (interface{}, bool) {
+	switch r.buf[0] {
+	case 0:
+		r.order = binary.BigEndian
+	case 1:
+		r.order = binary.LittleEndian
+	default:
+		return nil, false
+	}
+	r.buf = r.buf[1:]
+
+	geomType, ok := r.readUint32()
+	if !ok {
+		return nil, false
+	}
+	switch geomType {
+	case wkbPointID:
+		return r.readPoint()
+	case wkbLinestringID:
+		return r.readLine()
+	case wkbPolygonID:
+		n, ok := r.readUint32()
+		// Every line takes at least 4 bytes, which bounds the number of lines before they're read
+		if !ok || uint64(len(r.buf)) < uint64(n)*4 {
+			return nil, false
+		}
+		lines := make([]Linestring, n)
+		for i := range lines {
+			if lines[i], ok = r.readLine(); !ok {
+				return nil, false
+			}
+		}
+		return Polygon{SRID: r.srid, Lines: lines}, true
+	default:
+		return nil, false
+	}
+}
+
+// geometryFromBytes returns the geometry of the value given when it's a geometry in the internal format, as sent by
+// clients, and false when it isn't bytes or a string.
+func geometryFromBytes(v interface{}) (interface{}, bool, error) {
+	var buf []byte
+	switch v := v.(type) {
+	case []byte:
+		buf = v
+	case string:
+		buf = []byte(v)
+	default:
+		return nil, false, nil
+	}
+	g, err := DeserializeGeometry(buf)
+	if err != nil {
+		return nil, false, err
+	}
+	return g, true, nil
+}
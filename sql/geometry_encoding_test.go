@@ -0,0 +1,114 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeometrySQL(t *testing.T) {
+	require := require.New(t)
+
+	v, err := PointType{}.SQL(Point{X: 1, Y: 2})
+	require.NoError(err)
+	require.Equal(sqltypes.Geometry, v.Type())
+	require.Equal("00000000"+"0101000000"+"000000000000f03f"+"0000000000000040", hex.EncodeToString(v.Raw()))
+
+	// Geographic coordinates are sent longitude first
+	v, err = PointType{}.SQL(Point{SRID: GeoSpatialSRID, X: 1, Y: 2})
+	require.NoError(err)
+	require.Equal("e6100000"+"0101000000"+"0000000000000040"+"000000000000f03f", hex.EncodeToString(v.Raw()))
+
+	v, err = LinestringType{}.SQL(Linestring{Points: []Point{{X: 1, Y: 2}, {X: 3, Y: 4}}})
+	require.NoError(err)
+	require.Equal("00000000"+"0102000000"+"02000000"+"000000000000f03f"+"0000000000000040"+"0000000000000840"+"0000000000001040", hex.EncodeToString(v.Raw()))
+
+	v, err = PointType{}.SQL(nil)
+	require.NoError(err)
+	require.True(v.IsNull())
+
+	_, err = PointType{}.SQL(Linestring{})
+	require.Error(err)
+}
+
+func TestGeometryConvertBytes(t *testing.T) {
+	square := Polygon{SRID: GeoSpatialSRID, Lines: []Linestring{{SRID: GeoSpatialSRID, Points: []Point{
+		{SRID: GeoSpatialSRID, X: 0, Y: 0},
+		{SRID: GeoSpatialSRID, X: 1, Y: 0},
+		{SRID: GeoSpatialSRID, X: 1, Y: 1},
+		{SRID: GeoSpatialSRID, X: 0, Y: 0},
+	}}}}
+	tests := []struct {
+		typ Type
+		val interface{}
+	}{
+		{PointType{}, Point{X: 1, Y: 2}},
+		{PointType{}, Point{SRID: GeoSpatialSRID, X: 1, Y: 2}},
+		{LinestringType{}, Linestring{Points: []Point{{X: 1, Y: 2}, {X: 3, Y: 4}}}},
+		{LinestringType{}, Linestring{Points: []Point{}}},
+		{PolygonType{}, square},
+		{PolygonType{}.SetSRID(GeoSpatialSRID), square},
+	}
+	for _, test := range tests {
+		t.Run(test.typ.String(), func(t *testing.T) {
+			require := require.New(t)
+			v, err := test.typ.SQL(test.val)
+			require.NoError(err)
+
+			converted, err := test.typ.Convert(v.Raw())
+			require.NoError(err)
+			require.Equal(test.val, converted)
+
+			converted, err = test.typ.Convert(string(v.Raw()))
+			require.NoError(err)
+			require.Equal(test.val, converted)
+		})
+	}
+}
+
+func TestGeometryConvertInvalidBytes(t *testing.T) {
+	require := require.New(t)
+	point, _ := SerializeGeometry(Point{X: 1, Y: 2})
+
+	// A point isn't a linestring
+	_, err := LinestringType{}.Convert(point)
+	require.True(ErrNotLinestring.Is(err))
+
+	// The SRID of the column must match
+	_, err = PointType{}.SetSRID(GeoSpatialSRID).Convert(point)
+	require.True(ErrNotMatchingSRID.Is(err))
+
+	for _, buf := range [][]byte{
+		nil,
+		point[:len(point)-1],
+		append(append([]byte{}, point...), 0),
+		append([]byte{0xff, 0xff, 0, 0}, point[SRIDLength:]...),
+		append([]byte{0, 0, 0, 0, 2}, point[SRIDLength+1:]...),
+		{0, 0, 0, 0, 1, 2, 0, 0, 0, 0xff, 0xff, 0xff, 0xff},
+	} {
+		_, err = PointType{}.Convert(buf)
+		require.Error(err)
+	}
+
+	// Big endian WKB is accepted as well
+	bigEndian := []byte{0, 0, 0, 0, 0, 0, 0, 0, 1, 0x3f, 0xf0, 0, 0, 0, 0, 0, 0, 0x40, 0, 0, 0, 0, 0, 0, 0}
+	v, err := PointType{}.Convert(bigEndian)
+	require.NoError(err)
+	require.Equal(Point{X: 1, Y: 2}, v)
+}
@@ -0,0 +1,98 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grant_tables
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/in_mem_table"
+)
+
+const columnsPrivTblName = "columns_priv"
+
+var (
+	columnsPrivPkCols        = []uint16{0, 1, 2, 3, 4}
+	columnsPrivUserCols      = []uint16{2}
+	errColumnsPrivPkAssign   = fmt.Errorf("the primary key for the `columns_priv` table expects a host, database, user, table and column string")
+	errColumnsPrivUserAssign = fmt.Errorf("the secondary key for the `columns_priv` table expects a user string")
+
+	// columnsPrivTblSchema is created along with the schema of the "tables_priv" table.
+	columnsPrivTblSchema sql.Schema
+)
+
+// ColumnsPrivPrimaryKey is a key that represents the primary key for the "columns_priv" Grant Table.
+type ColumnsPrivPrimaryKey struct {
+	Host   string
+	Db     string
+	User   string
+	Table  string
+	Column string
+}
+
+// ColumnsPrivSecondaryKey is a key that represents the secondary key for the "columns_priv" Grant Table, which
+// contains only usernames.
+type ColumnsPrivSecondaryKey struct {
+	User string
+}
+
+var _ in_mem_table.InMemTableDataKey = ColumnsPrivPrimaryKey{}
+var _ in_mem_table.InMemTableDataKey = ColumnsPrivSecondaryKey{}
+
+// AssignValues implements the interface in_mem_table.InMemTableDataKey.
+func (c ColumnsPrivPrimaryKey) AssignValues(vals ...interface{}) (in_mem_table.InMemTableDataKey, error) {
+	if len(vals) != 5 {
+		return c, errColumnsPrivPkAssign
+	}
+	strs := make([]string, len(vals))
+	for i, val := range vals {
+		s, ok := val.(string)
+		if !ok {
+			return c, errColumnsPrivPkAssign
+		}
+		strs[i] = s
+	}
+	return ColumnsPrivPrimaryKey{
+		Host:   strs[0],
+		Db:     strs[1],
+		User:   strs[2],
+		Table:  strs[3],
+		Column: strs[4],
+	}, nil
+}
+
+// RepresentedColumns implements the interface in_mem_table.InMemTableDataKey.
+func (c ColumnsPrivPrimaryKey) RepresentedColumns() []uint16 {
+	return columnsPrivPkCols
+}
+
+// AssignValues implements the interface in_mem_table.InMemTableDataKey.
+func (c ColumnsPrivSecondaryKey) AssignValues(vals ...interface{}) (in_mem_table.InMemTableDataKey, error) {
+	if len(vals) != 1 {
+		return c, errColumnsPrivUserAssign
+	}
+	user, ok := vals[0].(string)
+	if !ok {
+		return c, errColumnsPrivUserAssign
+	}
+	return ColumnsPrivSecondaryKey{
+		User: user,
+	}, nil
+}
+
+// RepresentedColumns implements the interface in_mem_table.InMemTableDataKey.
+func (c ColumnsPrivSecondaryKey) RepresentedColumns() []uint16 {
+	return columnsPrivUserCols
+}
@@ -0,0 +1,141 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grant_tables
+
+import (
+	"fmt"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/in_mem_table"
+)
+
+const dbTblName = "db"
+
+var (
+	dbPkCols        = []uint16{0, 1, 2}
+	dbUserCols      = []uint16{2}
+	errDbPkAssign   = fmt.Errorf("the primary key for the `db` table expects a host, database and user string")
+	errDbUserAssign = fmt.Errorf("the secondary key for the `db` table expects a user string")
+
+	dbTblSchema sql.Schema
+)
+
+// DbPrimaryKey is a key that represents the primary key for the "db" Grant Table.
+type DbPrimaryKey struct {
+	Host string
+	Db   string
+	User string
+}
+
+// DbSecondaryKey is a key that represents the secondary key for the "db" Grant Table, which contains only usernames.
+type DbSecondaryKey struct {
+	User string
+}
+
+var _ in_mem_table.InMemTableDataKey = DbPrimaryKey{}
+var _ in_mem_table.InMemTableDataKey = DbSecondaryKey{}
+
+// AssignValues implements the interface in_mem_table.InMemTableDataKey.
+func (d DbPrimaryKey) AssignValues(vals ...interface{}) (in_mem_table.InMemTableDataKey, error) {
+	if len(vals) != 3 {
+		return d, errDbPkAssign
+	}
+	host, ok := vals[0].(string)
+	if !ok {
+		return d, errDbPkAssign
+	}
+	db, ok := vals[1].(string)
+	if !ok {
+		return d, errDbPkAssign
+	}
+	user, ok := vals[2].(string)
+	if !ok {
+		return d, errDbPkAssign
+	}
+	return DbPrimaryKey{
+		Host: host,
+		Db:   db,
+		User: user,
+	}, nil
+}
+
+// RepresentedColumns implements the interface in_mem_table.InMemTableDataKey.
+func (d DbPrimaryKey) RepresentedColumns() []uint16 {
+	return dbPkCols
+}
+
+// AssignValues implements the interface in_mem_table.InMemTableDataKey.
+func (d DbSecondaryKey) AssignValues(vals ...interface{}) (in_mem_table.InMemTableDataKey, error) {
+	if len(vals) != 1 {
+		return d, errDbUserAssign
+	}
+	user, ok := vals[0].(string)
+	if !ok {
+		return d, errDbUserAssign
+	}
+	return DbSecondaryKey{
+		User: user,
+	}, nil
+}
+
+// RepresentedColumns implements the interface in_mem_table.InMemTableDataKey.
+func (d DbSecondaryKey) RepresentedColumns() []uint16 {
+	return dbUserCols
+}
+
+// init creates the schema for the "db" Grant Table.
+func init() {
+	// Types
+	char32_utf8_bin := sql.MustCreateString(sqltypes.Char, 32, sql.Collation_utf8_bin)
+	char64_utf8_bin := sql.MustCreateString(sqltypes.Char, 64, sql.Collation_utf8_bin)
+	char255_ascii_general_ci := sql.MustCreateString(sqltypes.Char, 255, sql.Collation_ascii_general_ci)
+	enum_N_Y_utf8_general_ci := sql.MustCreateEnumType([]string{"N", "Y"}, sql.Collation_utf8_general_ci)
+
+	// Column Templates
+	char32_utf8_bin_not_null_default_empty := &sql.Column{
+		Type:     char32_utf8_bin,
+		Default:  mustDefault(expression.NewLiteral("", char32_utf8_bin), char32_utf8_bin, true, false),
+		Nullable: false,
+	}
+	char64_utf8_bin_not_null_default_empty := &sql.Column{
+		Type:     char64_utf8_bin,
+		Default:  mustDefault(expression.NewLiteral("", char64_utf8_bin), char64_utf8_bin, true, false),
+		Nullable: false,
+	}
+	char255_ascii_general_ci_not_null_default_empty := &sql.Column{
+		Type:     char255_ascii_general_ci,
+		Default:  mustDefault(expression.NewLiteral("", char255_ascii_general_ci), char255_ascii_general_ci, true, false),
+		Nullable: false,
+	}
+	enum_N_Y_utf8_general_ci_not_null_default_N := &sql.Column{
+		Type:     enum_N_Y_utf8_general_ci,
+		Default:  mustDefault(expression.NewLiteral("N", enum_N_Y_utf8_general_ci), enum_N_Y_utf8_general_ci, true, false),
+		Nullable: false,
+	}
+
+	dbTblSchema = sql.Schema{
+		columnTemplate("Host", dbTblName, true, char255_ascii_general_ci_not_null_default_empty),
+		columnTemplate("Db", dbTblName, true, char64_utf8_bin_not_null_default_empty),
+		columnTemplate("User", dbTblName, true, char32_utf8_bin_not_null_default_empty),
+	}
+	for _, info := range privilegeTypes {
+		if info.database {
+			dbTblSchema = append(dbTblSchema, columnTemplate(info.column, dbTblName, false, enum_N_Y_utf8_general_ci_not_null_default_N))
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net"
+	"sort"
 	"strings"
 
 	"github.com/dolthub/vitess/go/mysql"
@@ -32,12 +33,16 @@ import (
 type GrantTables struct {
 	Enabled bool
 
-	user *grantTable
+	user        *grantTable
+	db          *grantTable
+	tablesPriv  *grantTable
+	columnsPriv *grantTable
+	// system holds the read-only tables of the mysql database that aren't grant tables, keyed by lowercase name.
+	system map[string]*systemTable
+	// persister is given the contents of the grant tables whenever a statement changes them.
+	persister Persister
 	//TODO: add the rest of these tables
-	//db               *grantTable
 	//global_grants    *grantTable
-	//tables_priv      *grantTable
-	//columns_priv     *grantTable
 	//procs_priv       *grantTable
 	//proxies_priv     *grantTable
 	//default_roles    *grantTable
@@ -51,7 +56,11 @@ var _ mysql.AuthServer = (*GrantTables)(nil)
 // CreateEmptyGrantTables returns a collection of Grant Tables that do not contain any data.
 func CreateEmptyGrantTables() *GrantTables {
 	grantTables := &GrantTables{
-		user: newGrantTable(userTblName, userTblSchema, UserPrimaryKey{}, UserSecondaryKey{}),
+		user:        newGrantTable(userTblName, userTblSchema, UserPrimaryKey{}, UserSecondaryKey{}),
+		db:          newGrantTable(dbTblName, dbTblSchema, DbPrimaryKey{}, DbSecondaryKey{}),
+		tablesPriv:  newGrantTable(tablesPrivTblName, tablesPrivTblSchema, TablesPrivPrimaryKey{}, TablesPrivSecondaryKey{}),
+		columnsPriv: newGrantTable(columnsPrivTblName, columnsPrivTblSchema, ColumnsPrivPrimaryKey{}, ColumnsPrivSecondaryKey{}),
+		system:      systemTables(),
 	}
 	return grantTables
 }
@@ -87,16 +96,30 @@ func (g *GrantTables) Name() string {
 // GetTableInsensitive implements the interface sql.Database.
 func (g *GrantTables) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Table, bool, error) {
 	switch strings.ToLower(tblName) {
-	case "user":
+	case userTblName:
 		return g.user, true, nil
+	case dbTblName:
+		return g.db, true, nil
+	case tablesPrivTblName:
+		return g.tablesPriv, true, nil
+	case columnsPrivTblName:
+		return g.columnsPriv, true, nil
 	default:
+		if t, ok := g.system[strings.ToLower(tblName)]; ok {
+			return t, true, nil
+		}
 		return nil, false, nil
 	}
 }
 
 // GetTableNames implements the interface sql.Database.
 func (g *GrantTables) GetTableNames(ctx *sql.Context) ([]string, error) {
-	return []string{"user"}, nil
+	names := []string{userTblName, dbTblName, tablesPrivTblName, columnsPrivTblName}
+	for _, t := range g.system {
+		names = append(names, t.name)
+	}
+	sort.Strings(names)
+	return names, nil
 }
 
 // AuthMethod implements the interface mysql.AuthServer.
@@ -116,10 +139,105 @@ func (g *GrantTables) ValidateHash(salt []byte, user string, authResponse []byte
 		return mysqlGetter(user), nil
 	}
 
-	host, _, err := net.SplitHostPort(addr.String())
+	host, err := clientHost(addr)
+	if err != nil {
+		return nil, err
+	}
+	userRow, userRows := g.getUserRow(user, host)
+	if len(userRow) == 0 {
+		return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
+	}
+	// accounts that use another plugin can't be authenticated with a native password hash
+	if plugin, ok := userRow[39].(string); ok && len(plugin) > 0 && plugin != "mysql_native_password" {
+		return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
+	}
+
+	if password, ok := userRows[0][40].(string); ok && len(password) > 0 { // index 40 is the authentication string, see the mysql.user schema
+		if !validateMysqlNativePassword(authResponse, salt, password) {
+			return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
+		}
+	} else if len(authResponse) > 0 { // password is nil or empty, therefore no password is set
+		// a password was given and the account has no password set, therefore access is denied
+		return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
+	}
+
+	return mysqlGetter(user), nil
+}
+
+// AccountPlugin returns the authentication plugin of the accounts of the user given, or "mysql_native_password" if the
+// user has no accounts. The host of the client isn't known when the authentication method is chosen, so this is the
+// plugin of the first of the accounts if they don't all use the same one.
+func (g *GrantTables) AccountPlugin(user string) string {
+	for _, userRow := range g.user.data.Get(UserSecondaryKey{User: user}) {
+		if plugin, ok := userRow[39].(string); ok && len(plugin) > 0 { // index 39 is the plugin, see the mysql.user schema
+			return plugin
+		}
+	}
+	return "mysql_native_password"
+}
+
+// ValidateWith validates the credentials of the client at the address given for the account of the user given, using
+// the function given, which is given the host of the client and the plugin and authentication string of its account.
+// This is used by servers to authenticate the accounts that don't use "mysql_native_password".
+func (g *GrantTables) ValidateWith(user string, addr net.Addr, validate func(host string, plugin string, authString string) (bool, error)) (mysql.Getter, error) {
+	if !g.Enabled {
+		return mysqlGetter(user), nil
+	}
+
+	host, err := clientHost(addr)
+	if err != nil {
+		return nil, err
+	}
+	userRow, _ := g.getUserRow(user, host)
+	if len(userRow) == 0 {
+		return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
+	}
+
+	plugin, _ := userRow[39].(string)
+	authString, _ := userRow[40].(string)
+	ok, err := validate(host, plugin, authString)
 	if err != nil {
 		return nil, err
 	}
+	if !ok {
+		return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
+	}
+	return mysqlGetter(user), nil
+}
+
+// clientHost returns the host of the client at the address given, as it's matched against the hosts of the accounts.
+func clientHost(addr net.Addr) (string, error) {
+	// Connections over a unix domain socket are always local, and are matched against localhost accounts as in MySQL
+	if addr.Network() == "unix" {
+		return "localhost", nil
+	}
+	host, _, err := net.SplitHostPort(addr.String())
+	return host, err
+}
+
+// HasSuperPrivilege returns whether the account matching the user and host given has the SUPER privilege. The host may
+// include a port, which is ignored.
+func (g *GrantTables) HasSuperPrivilege(user string, host string) bool {
+	return g.HasPrivilege(user, host, "Super_priv")
+}
+
+// HasPrivilege returns whether the account matching the user and host given has the global privilege of the column of
+// the mysql.user table given, such as "Shutdown_priv". The host may include a port, which is ignored.
+func (g *GrantTables) HasPrivilege(user string, host string, privilege string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	idx := userTblSchema.IndexOf(privilege, userTblName)
+	if idx < 0 {
+		return false
+	}
+	userRow, _ := g.getUserRow(user, host)
+	return len(userRow) > idx && userRow[idx] == "Y"
+}
+
+// getUserRow returns the row of the user table for the account matching the user and host given, or nil if there is
+// none. The rows read while searching for the account are also returned.
+func (g *GrantTables) getUserRow(user string, host string) (sql.Row, []sql.Row) {
 	//TODO: determine what the localhost is on the machine, then handle the conversion between ip and localhost
 	// For now, this just does another check for localhost if the host is 127.0.0.1
 	var userRow sql.Row
@@ -141,20 +259,7 @@ func (g *GrantTables) ValidateHash(salt []byte, user string, authResponse []byte
 			}
 		}
 	}
-	if len(userRow) == 0 {
-		return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
-	}
-
-	if password, ok := userRows[0][40].(string); ok && len(password) > 0 { // index 40 is the authentication string, see the mysql.user schema
-		if !validateMysqlNativePassword(authResponse, salt, password) {
-			return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
-		}
-	} else if len(authResponse) > 0 { // password is nil or empty, therefore no password is set
-		// a password was given and the account has no password set, therefore access is denied
-		return nil, mysql.NewSQLError(mysql.ERAccessDeniedError, mysql.SSAccessDeniedError, "Access denied for user '%v'", user)
-	}
-
-	return mysqlGetter(user), nil
+	return userRow, userRows
 }
 
 // Negotiate implements the interface mysql.AuthServer. This is called when the method used is not "mysql_native_password".
@@ -165,12 +270,61 @@ func (g *GrantTables) Negotiate(c *mysql.Conn, user string, remoteAddr net.Addr)
 	return nil, fmt.Errorf(`the only user login interface currently supported is "mysql_native_password"`)
 }
 
+// SetPersister sets the persister that is given the contents of the grant tables whenever a statement changes them.
+func (g *GrantTables) SetPersister(persister Persister) {
+	g.persister = persister
+}
+
 // Persist passes along all changes to the integrator.
 func (g *GrantTables) Persist(ctx *sql.Context) error {
-	//TODO: add the UserPersist interface, using this as a stand-in so I won't forget to put it where it needs to go
+	if g.persister == nil {
+		return nil
+	}
+	data, err := g.Data(ctx)
+	if err != nil {
+		return err
+	}
+	return g.persister.Persist(ctx, data)
+}
+
+// Data returns the rows of the grant tables.
+func (g *GrantTables) Data(ctx *sql.Context) (GrantTablesData, error) {
+	data := make(GrantTablesData)
+	for _, table := range g.grantTables() {
+		rows, err := sql.RowIterToRows(ctx, table.data.ToRowIter())
+		if err != nil {
+			return nil, err
+		}
+		data[table.name] = rows
+	}
+	return data, nil
+}
+
+// LoadData replaces the rows of the grant tables with the ones given, such as the ones given to a persister before a
+// restart, and enables the grant tables if there are any accounts. The tables that aren't in the data are emptied.
+func (g *GrantTables) LoadData(data GrantTablesData) error {
+	for _, table := range g.grantTables() {
+		table.data.Clear()
+		for _, row := range data[table.name] {
+			if len(row) != len(table.sch) {
+				return fmt.Errorf("expected rows of %d columns for the `%s` table but got %d", len(table.sch), table.name, len(row))
+			}
+			if err := table.data.Put(row); err != nil {
+				return err
+			}
+		}
+	}
+	if g.user.data.Count() > 0 {
+		g.Enabled = true
+	}
 	return nil
 }
 
+// grantTables returns the tables that hold the accounts and their privileges.
+func (g *GrantTables) grantTables() []*grantTable {
+	return []*grantTable{g.user, g.db, g.tablesPriv, g.columnsPriv}
+}
+
 // UserTable returns the user table.
 func (g *GrantTables) UserTable() *grantTable {
 	return g.user
@@ -0,0 +1,421 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grant_tables
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// The positions of the privilege sets in the rows of the tables_priv and columns_priv tables.
+const (
+	tablesPrivTablePrivIdx   = 6
+	tablesPrivColumnPrivIdx  = 7
+	columnsPrivColumnPrivIdx = 6
+)
+
+// MatchAccount returns the host of the account that the user connecting from the host given is authenticated as,
+// which may be a pattern such as "%". The host may include a port, which is ignored.
+func (g *GrantTables) MatchAccount(user string, host string) (string, bool) {
+	accountHost, _, ok := g.account(user, host)
+	return accountHost, ok
+}
+
+// account returns the host of the account matching the user and host given, along with its row of the user table.
+func (g *GrantTables) account(user string, host string) (string, sql.Row, bool) {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	userRow, _ := g.getUserRow(user, host)
+	if len(userRow) == 0 {
+		return "", nil, false
+	}
+	accountHost, ok := userRow[0].(string)
+	return accountHost, userRow, ok
+}
+
+// HasGlobalPrivilege returns whether the account matching the user and host given has the privilege given on every
+// database.
+func (g *GrantTables) HasGlobalPrivilege(user string, host string, privilege PrivilegeType) bool {
+	_, userRow, ok := g.account(user, host)
+	return ok && hasPrivilegeColumn(userTblSchema, userTblName, userRow, privilege)
+}
+
+// HasDatabasePrivilege returns whether the account matching the user and host given has the privilege given on all
+// of the tables of the database given, from a global grant or a grant on the database.
+func (g *GrantTables) HasDatabasePrivilege(user string, host string, db string, privilege PrivilegeType) bool {
+	accountHost, userRow, ok := g.account(user, host)
+	return ok && g.hasDatabasePrivilege(accountHost, user, userRow, strings.ToLower(db), privilege)
+}
+
+// HasTablePrivilege returns whether the account matching the user and host given has the privilege given on the table
+// given, from a global grant, a grant on the table's database or a grant on the table.
+func (g *GrantTables) HasTablePrivilege(user string, host string, db string, table string, privilege PrivilegeType) bool {
+	accountHost, userRow, ok := g.account(user, host)
+	return ok && g.hasTablePrivilege(accountHost, user, userRow, strings.ToLower(db), strings.ToLower(table), privilege)
+}
+
+// HasColumnPrivilege returns whether the account matching the user and host given has the privilege given on the
+// column given, from a grant on the column or on any of the levels that contain it.
+func (g *GrantTables) HasColumnPrivilege(user string, host string, db string, table string, column string, privilege PrivilegeType) bool {
+	accountHost, userRow, ok := g.account(user, host)
+	if !ok {
+		return false
+	}
+	db, table = strings.ToLower(db), strings.ToLower(table)
+	if g.hasTablePrivilege(accountHost, user, userRow, db, table, privilege) {
+		return true
+	}
+	for _, row := range g.columnsPriv.data.Get(ColumnsPrivPrimaryKey{
+		Host:   accountHost,
+		Db:     db,
+		User:   user,
+		Table:  table,
+		Column: strings.ToLower(column),
+	}) {
+		if parsePrivilegeSet(row[columnsPrivColumnPrivIdx])[privilege] {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *GrantTables) hasDatabasePrivilege(accountHost string, user string, userRow sql.Row, db string, privilege PrivilegeType) bool {
+	if hasPrivilegeColumn(userTblSchema, userTblName, userRow, privilege) {
+		return true
+	}
+	if !privilegeTypes[privilege].database {
+		return false
+	}
+	for _, row := range g.db.data.Get(DbPrimaryKey{Host: accountHost, Db: db, User: user}) {
+		if hasPrivilegeColumn(dbTblSchema, dbTblName, row, privilege) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *GrantTables) hasTablePrivilege(accountHost string, user string, userRow sql.Row, db string, table string, privilege PrivilegeType) bool {
+	if g.hasDatabasePrivilege(accountHost, user, userRow, db, privilege) {
+		return true
+	}
+	for _, row := range g.tablesPriv.data.Get(TablesPrivPrimaryKey{Host: accountHost, Db: db, User: user, Table: table}) {
+		if parsePrivilegeSet(row[tablesPrivTablePrivIdx])[privilege] {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPrivilegeColumn returns whether the column of the privilege given is set in the row given of the user or db table.
+func hasPrivilegeColumn(sch sql.Schema, tblName string, row sql.Row, privilege PrivilegeType) bool {
+	idx := sch.IndexOf(privilegeTypes[privilege].column, tblName)
+	return idx >= 0 && len(row) > idx && row[idx] == "Y"
+}
+
+// Grant grants the privileges given on the level given to the account with the user and host given, which must exist.
+// When columns are given, the privileges are granted on those columns of the table of the level. Granting
+// PrivilegeType_Grant grants the GRANT OPTION on the level.
+func (g *GrantTables) Grant(user string, host string, level PrivilegeLevel, privileges []PrivilegeType, columns []string) error {
+	if len(g.user.data.Get(UserPrimaryKey{Host: host, User: user})) == 0 {
+		return sql.ErrGrantUserNotFound.New()
+	}
+	return g.setPrivileges(user, host, level.normalize(), privileges, columns, true)
+}
+
+// Revoke revokes the privileges given on the level given from the account with the user and host given, which must
+// exist. Privileges that the account doesn't have on the level are ignored.
+func (g *GrantTables) Revoke(user string, host string, level PrivilegeLevel, privileges []PrivilegeType, columns []string) error {
+	if len(g.user.data.Get(UserPrimaryKey{Host: host, User: user})) == 0 {
+		return sql.ErrNoSuchGrant.New(user, host)
+	}
+	return g.setPrivileges(user, host, level.normalize(), privileges, columns, false)
+}
+
+// RevokeAll revokes every privilege of the account with the user and host given, including the GRANT OPTION, on every
+// level.
+func (g *GrantTables) RevokeAll(user string, host string) error {
+	userRows := g.user.data.Get(UserPrimaryKey{Host: host, User: user})
+	if len(userRows) == 0 {
+		return sql.ErrNoSuchGrant.New(user, host)
+	}
+	all := make([]PrivilegeType, len(privilegeTypes))
+	for i := range privilegeTypes {
+		all[i] = PrivilegeType(i)
+	}
+	userRow := userRows[0]
+	if err := replaceRow(g.user, userRow, setPrivilegeColumns(userTblSchema, userTblName, userRow, all, false)); err != nil {
+		return err
+	}
+
+	for _, table := range []*grantTable{g.db, g.tablesPriv, g.columnsPriv} {
+		// The rows of every one of these tables start with the host, which is followed by the user two columns later
+		var rows []sql.Row
+		switch table {
+		case g.db:
+			rows = table.data.Get(DbSecondaryKey{User: user})
+		case g.tablesPriv:
+			rows = table.data.Get(TablesPrivSecondaryKey{User: user})
+		default:
+			rows = table.data.Get(ColumnsPrivSecondaryKey{User: user})
+		}
+		for _, row := range append([]sql.Row(nil), rows...) {
+			if row[0] == host {
+				if err := table.data.Remove(nil, row); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// setPrivileges grants or revokes the privileges given on the level given.
+func (g *GrantTables) setPrivileges(user string, host string, level PrivilegeLevel, privileges []PrivilegeType, columns []string, grant bool) error {
+	for _, privilege := range privileges {
+		if !privilege.canGrant(level, columns) {
+			return sql.ErrIllegalGrant.New()
+		}
+	}
+
+	switch {
+	case len(columns) > 0:
+		return g.setColumnPrivileges(user, host, level, privileges, columns, grant)
+	case level.IsGlobal():
+		userRow := g.user.data.Get(UserPrimaryKey{Host: host, User: user})[0]
+		return replaceRow(g.user, userRow, setPrivilegeColumns(userTblSchema, userTblName, userRow, privileges, grant))
+	case level.IsDatabase():
+		var oldRow sql.Row
+		newRow := make(sql.Row, len(dbTblSchema))
+		if rows := g.db.data.Get(DbPrimaryKey{Host: host, Db: level.Database, User: user}); len(rows) > 0 {
+			oldRow = rows[0]
+			copy(newRow, oldRow)
+		} else {
+			newRow[0], newRow[1], newRow[2] = host, level.Database, user
+			for i := 3; i < len(newRow); i++ {
+				newRow[i] = "N"
+			}
+		}
+		newRow = setPrivilegeColumns(dbTblSchema, dbTblName, newRow, privileges, grant)
+		for i := 3; i < len(newRow); i++ {
+			if newRow[i] == "Y" {
+				return replaceRow(g.db, oldRow, newRow)
+			}
+		}
+		return replaceRow(g.db, oldRow, nil)
+	default:
+		oldRow, newRow := g.tablesPrivRow(user, host, level)
+		tablePrivs := parsePrivilegeSet(newRow[tablesPrivTablePrivIdx])
+		for _, privilege := range privileges {
+			tablePrivs[privilege] = grant
+		}
+		newRow[tablesPrivTablePrivIdx] = privilegeSet(tablePrivs)
+		return g.replaceTablesPrivRow(oldRow, newRow)
+	}
+}
+
+// setColumnPrivileges grants or revokes the privileges given on the columns given.
+func (g *GrantTables) setColumnPrivileges(user string, host string, level PrivilegeLevel, privileges []PrivilegeType, columns []string, grant bool) error {
+	for _, column := range columns {
+		key := ColumnsPrivPrimaryKey{Host: host, Db: level.Database, User: user, Table: level.Table, Column: strings.ToLower(column)}
+		var oldRow sql.Row
+		newRow := sql.Row{key.Host, key.Db, key.User, key.Table, key.Column, time.Now().UTC(), ""}
+		if rows := g.columnsPriv.data.Get(key); len(rows) > 0 {
+			oldRow = rows[0]
+			copy(newRow, oldRow)
+		}
+		columnPrivs := parsePrivilegeSet(newRow[columnsPrivColumnPrivIdx])
+		for _, privilege := range privileges {
+			columnPrivs[privilege] = grant
+		}
+		newRow[columnsPrivColumnPrivIdx] = privilegeSet(columnPrivs)
+		if newRow[columnsPrivColumnPrivIdx] == "" {
+			newRow = nil
+		}
+		if err := replaceRow(g.columnsPriv, oldRow, newRow); err != nil {
+			return err
+		}
+	}
+
+	// The Column_priv column of the table's row in tables_priv has every privilege granted on any of its columns
+	columnPrivs := make(map[PrivilegeType]bool)
+	for _, row := range g.columnsPriv.data.Get(ColumnsPrivSecondaryKey{User: user}) {
+		if row[0] == host && row[1] == level.Database && row[3] == level.Table {
+			for privilege := range parsePrivilegeSet(row[columnsPrivColumnPrivIdx]) {
+				columnPrivs[privilege] = true
+			}
+		}
+	}
+	oldRow, newRow := g.tablesPrivRow(user, host, level)
+	newRow[tablesPrivColumnPrivIdx] = privilegeSet(columnPrivs)
+	return g.replaceTablesPrivRow(oldRow, newRow)
+}
+
+// tablesPrivRow returns the row of the tables_priv table for the account and table given, if there's any, and a copy
+// of it to modify, which is a new row when there's none.
+func (g *GrantTables) tablesPrivRow(user string, host string, level PrivilegeLevel) (sql.Row, sql.Row) {
+	newRow := sql.Row{host, level.Database, user, level.Table, "", time.Now().UTC(), "", ""}
+	rows := g.tablesPriv.data.Get(TablesPrivPrimaryKey{Host: host, Db: level.Database, User: user, Table: level.Table})
+	if len(rows) == 0 {
+		return nil, newRow
+	}
+	copy(newRow, rows[0])
+	return rows[0], newRow
+}
+
+// replaceTablesPrivRow replaces a row of the tables_priv table, removing it when it has no privileges left.
+func (g *GrantTables) replaceTablesPrivRow(oldRow sql.Row, newRow sql.Row) error {
+	if newRow[tablesPrivTablePrivIdx] == "" && newRow[tablesPrivColumnPrivIdx] == "" {
+		newRow = nil
+	}
+	return replaceRow(g.tablesPriv, oldRow, newRow)
+}
+
+// setPrivilegeColumns returns a copy of the row given of the user or db table with the columns of the privileges given
+// set, or unset when grant is false.
+func setPrivilegeColumns(sch sql.Schema, tblName string, row sql.Row, privileges []PrivilegeType, grant bool) sql.Row {
+	newRow := row.Copy()
+	val := "N"
+	if grant {
+		val = "Y"
+	}
+	for _, privilege := range privileges {
+		if idx := sch.IndexOf(privilegeTypes[privilege].column, tblName); idx >= 0 {
+			newRow[idx] = val
+		}
+	}
+	return newRow
+}
+
+// replaceRow replaces the old row given with the new one, either of which may be nil.
+func replaceRow(table *grantTable, oldRow sql.Row, newRow sql.Row) error {
+	if oldRow != nil {
+		if err := table.data.Remove(nil, oldRow); err != nil {
+			return err
+		}
+	}
+	if newRow != nil {
+		return table.data.Put(newRow)
+	}
+	return nil
+}
+
+// ShowGrants returns the GRANT statements that grant the privileges of the account with the user and host given, as
+// SHOW GRANTS returns them: its global privileges first, followed by its privileges on each database and on each table.
+func (g *GrantTables) ShowGrants(user string, host string) ([]string, error) {
+	userRows := g.user.data.Get(UserPrimaryKey{Host: host, User: user})
+	if len(userRows) == 0 {
+		return nil, sql.ErrNoSuchGrant.New(user, host)
+	}
+	account := fmt.Sprintf("`%s`@`%s`", user, host)
+
+	var globalPrivs []PrivilegeType
+	for i := range privilegeTypes {
+		if PrivilegeType(i) != PrivilegeType_Grant && hasPrivilegeColumn(userTblSchema, userTblName, userRows[0], PrivilegeType(i)) {
+			globalPrivs = append(globalPrivs, PrivilegeType(i))
+		}
+	}
+	grants := []string{grantStatement(globalPrivs, nil, GlobalPrivilegeLevel, account,
+		hasPrivilegeColumn(userTblSchema, userTblName, userRows[0], PrivilegeType_Grant))}
+
+	dbRows := g.db.data.Get(DbSecondaryKey{User: user})
+	sort.Slice(dbRows, func(i, j int) bool {
+		return dbRows[i][1].(string) < dbRows[j][1].(string)
+	})
+	for _, row := range dbRows {
+		if row[0] != host {
+			continue
+		}
+		var dbPrivs []PrivilegeType
+		for i, info := range privilegeTypes {
+			if info.database && PrivilegeType(i) != PrivilegeType_Grant && hasPrivilegeColumn(dbTblSchema, dbTblName, row, PrivilegeType(i)) {
+				dbPrivs = append(dbPrivs, PrivilegeType(i))
+			}
+		}
+		level := PrivilegeLevel{Database: row[1].(string), Table: "*"}
+		grants = append(grants, grantStatement(dbPrivs, nil, level, account, hasPrivilegeColumn(dbTblSchema, dbTblName, row, PrivilegeType_Grant)))
+	}
+
+	tableRows := g.tablesPriv.data.Get(TablesPrivSecondaryKey{User: user})
+	sort.Slice(tableRows, func(i, j int) bool {
+		if tableRows[i][1].(string) != tableRows[j][1].(string) {
+			return tableRows[i][1].(string) < tableRows[j][1].(string)
+		}
+		return tableRows[i][3].(string) < tableRows[j][3].(string)
+	})
+	for _, row := range tableRows {
+		if row[0] != host {
+			continue
+		}
+		level := PrivilegeLevel{Database: row[1].(string), Table: row[3].(string)}
+		privs := parsePrivilegeSet(row[tablesPrivTablePrivIdx])
+		var tablePrivs []PrivilegeType
+		for i := range privilegeTypes {
+			if PrivilegeType(i) != PrivilegeType_Grant && privs[PrivilegeType(i)] {
+				tablePrivs = append(tablePrivs, PrivilegeType(i))
+			}
+		}
+
+		columnPrivs := make(map[PrivilegeType][]string)
+		for _, columnRow := range g.columnsPriv.data.Get(ColumnsPrivSecondaryKey{User: user}) {
+			if columnRow[0] == host && columnRow[1] == level.Database && columnRow[3] == level.Table {
+				for privilege := range parsePrivilegeSet(columnRow[columnsPrivColumnPrivIdx]) {
+					if !privs[privilege] {
+						columnPrivs[privilege] = append(columnPrivs[privilege], columnRow[4].(string))
+					}
+				}
+			}
+		}
+		grants = append(grants, grantStatement(tablePrivs, columnPrivs, level, account, privs[PrivilegeType_Grant]))
+	}
+	return grants, nil
+}
+
+// grantStatement returns the GRANT statement that grants the privileges given on the level given.
+func grantStatement(privileges []PrivilegeType, columnPrivileges map[PrivilegeType][]string, level PrivilegeLevel, account string, withGrant bool) string {
+	var names []string
+	if len(privileges) > 0 && len(privileges) == len(AllPrivileges(level)) {
+		names = append(names, "ALL PRIVILEGES")
+	} else {
+		for _, privilege := range privileges {
+			names = append(names, privilege.String())
+		}
+	}
+	for i := range privilegeTypes {
+		columns := columnPrivileges[PrivilegeType(i)]
+		if len(columns) == 0 {
+			continue
+		}
+		sort.Strings(columns)
+		for j, column := range columns {
+			columns[j] = "`" + column + "`"
+		}
+		names = append(names, fmt.Sprintf("%s (%s)", PrivilegeType(i).String(), strings.Join(columns, ", ")))
+	}
+	if len(names) == 0 {
+		names = append(names, "USAGE")
+	}
+
+	grant := fmt.Sprintf("GRANT %s ON %s TO %s", strings.Join(names, ", "), level.String(), account)
+	if withGrant {
+		grant += " WITH GRANT OPTION"
+	}
+	return grant
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grant_tables
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+func TestGrantsAndRevokes(t *testing.T) {
+	require := require.New(t)
+	g := CreateEmptyGrantTables()
+	addSuperUser(g.user, "tester", "")
+	require.NoError(g.RevokeAll("tester", "%"))
+
+	db := PrivilegeLevel{Database: "MyDb", Table: "*"}
+	table := PrivilegeLevel{Database: "mydb", Table: "T"}
+	require.False(g.HasTablePrivilege("tester", "127.0.0.1:3306", "mydb", "t", PrivilegeType_Select))
+
+	// Privileges are granted on each level, and apply to the levels below it
+	require.NoError(g.Grant("tester", "%", db, []PrivilegeType{PrivilegeType_Insert}, nil))
+	require.NoError(g.Grant("tester", "%", table, []PrivilegeType{PrivilegeType_Select, PrivilegeType_Grant}, nil))
+	require.NoError(g.Grant("tester", "%", table, []PrivilegeType{PrivilegeType_Update}, []string{"V"}))
+	require.True(g.HasDatabasePrivilege("tester", "127.0.0.1:3306", "mydb", PrivilegeType_Insert))
+	require.True(g.HasTablePrivilege("tester", "127.0.0.1", "MYDB", "t", PrivilegeType_Insert))
+	require.True(g.HasTablePrivilege("tester", "127.0.0.1", "mydb", "t", PrivilegeType_Select))
+	require.False(g.HasTablePrivilege("tester", "127.0.0.1", "mydb", "other", PrivilegeType_Select))
+	require.False(g.HasTablePrivilege("tester", "127.0.0.1", "mydb", "t", PrivilegeType_Update))
+	require.True(g.HasColumnPrivilege("tester", "127.0.0.1", "mydb", "t", "v", PrivilegeType_Update))
+	require.False(g.HasColumnPrivilege("tester", "127.0.0.1", "mydb", "t", "pk", PrivilegeType_Update))
+	require.False(g.HasGlobalPrivilege("tester", "127.0.0.1", PrivilegeType_Insert))
+
+	grants, err := g.ShowGrants("tester", "%")
+	require.NoError(err)
+	require.Equal([]string{
+		"GRANT USAGE ON *.* TO `tester`@`%`",
+		"GRANT INSERT ON `mydb`.* TO `tester`@`%`",
+		"GRANT SELECT, UPDATE (`v`) ON `mydb`.`t` TO `tester`@`%` WITH GRANT OPTION",
+	}, grants)
+
+	// Privileges that can't be granted on a level are rejected
+	require.True(sql.ErrIllegalGrant.Is(g.Grant("tester", "%", db, []PrivilegeType{PrivilegeType_Super}, nil)))
+	require.True(sql.ErrIllegalGrant.Is(g.Grant("tester", "%", table, []PrivilegeType{PrivilegeType_Delete}, []string{"v"})))
+	require.True(sql.ErrGrantUserNotFound.Is(g.Grant("nobody", "%", db, []PrivilegeType{PrivilegeType_Select}, nil)))
+
+	// The rows of the tables are removed once they have no privileges left
+	require.NoError(g.Revoke("tester", "%", table, []PrivilegeType{PrivilegeType_Select, PrivilegeType_Grant}, nil))
+	require.NoError(g.Revoke("tester", "%", table, []PrivilegeType{PrivilegeType_Update}, []string{"v"}))
+	require.NoError(g.Revoke("tester", "%", db, []PrivilegeType{PrivilegeType_Insert}, nil))
+	require.Zero(g.db.data.Count())
+	require.Zero(g.tablesPriv.data.Count())
+	require.Zero(g.columnsPriv.data.Count())
+
+	grants, err = g.ShowGrants("tester", "%")
+	require.NoError(err)
+	require.Equal([]string{"GRANT USAGE ON *.* TO `tester`@`%`"}, grants)
+	_, err = g.ShowGrants("tester", "localhost")
+	require.True(sql.ErrNoSuchGrant.Is(err))
+}
+
+func TestPersistAndLoadData(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	g := CreateEmptyGrantTables()
+	persister := NewMemoryPersister()
+	g.SetPersister(persister)
+
+	addSuperUser(g.user, "tester", "")
+	require.NoError(g.RevokeAll("tester", "%"))
+	require.NoError(g.Grant("tester", "%", PrivilegeLevel{Database: "mydb", Table: "t"}, []PrivilegeType{PrivilegeType_Select}, nil))
+	require.NoError(g.Persist(ctx))
+	require.Len(persister.Data()[userTblName], 1)
+	require.Len(persister.Data()[tablesPrivTblName], 1)
+
+	loaded := CreateEmptyGrantTables()
+	require.NoError(loaded.LoadData(persister.Data()))
+	require.True(loaded.Enabled)
+	require.True(loaded.HasTablePrivilege("tester", "localhost", "mydb", "t", PrivilegeType_Select))
+	require.False(loaded.HasTablePrivilege("tester", "localhost", "mydb", "t", PrivilegeType_Insert))
+}
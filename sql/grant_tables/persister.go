@@ -0,0 +1,60 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grant_tables
+
+import (
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// GrantTablesData is the contents of the grant tables, keyed by the name of each table.
+type GrantTablesData map[string][]sql.Row
+
+// Persister persists the grant tables, for integrators that keep accounts and privileges across restarts. The grant
+// tables hold their rows in memory, and give all of them to the persister after every statement that changes them.
+// The rows persisted are loaded back with GrantTables.LoadData.
+type Persister interface {
+	// Persist stores the rows of the grant tables.
+	Persist(ctx *sql.Context, data GrantTablesData) error
+}
+
+// MemoryPersister is a Persister that keeps the last rows persisted in memory.
+type MemoryPersister struct {
+	mu   *sync.Mutex
+	data GrantTablesData
+}
+
+var _ Persister = (*MemoryPersister)(nil)
+
+// NewMemoryPersister returns a new MemoryPersister with nothing persisted.
+func NewMemoryPersister() *MemoryPersister {
+	return &MemoryPersister{mu: &sync.Mutex{}}
+}
+
+// Persist implements the interface Persister.
+func (m *MemoryPersister) Persist(ctx *sql.Context, data GrantTablesData) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data = data
+	return nil
+}
+
+// Data returns the rows last persisted.
+func (m *MemoryPersister) Data() GrantTablesData {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.data
+}
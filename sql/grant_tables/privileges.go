@@ -0,0 +1,212 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grant_tables
+
+import (
+	"strings"
+)
+
+// PrivilegeType is a privilege that may be granted to an account.
+// https://dev.mysql.com/doc/refman/8.0/en/privileges-provided.html
+type PrivilegeType byte
+
+const (
+	PrivilegeType_Select PrivilegeType = iota
+	PrivilegeType_Insert
+	PrivilegeType_Update
+	PrivilegeType_Delete
+	PrivilegeType_Create
+	PrivilegeType_Drop
+	PrivilegeType_Reload
+	PrivilegeType_Shutdown
+	PrivilegeType_Process
+	PrivilegeType_File
+	PrivilegeType_Grant
+	PrivilegeType_References
+	PrivilegeType_Index
+	PrivilegeType_Alter
+	PrivilegeType_ShowDB
+	PrivilegeType_Super
+	PrivilegeType_CreateTempTable
+	PrivilegeType_LockTables
+	PrivilegeType_Execute
+	PrivilegeType_ReplicationSlave
+	PrivilegeType_ReplicationClient
+	PrivilegeType_CreateView
+	PrivilegeType_ShowView
+	PrivilegeType_CreateRoutine
+	PrivilegeType_AlterRoutine
+	PrivilegeType_CreateUser
+	PrivilegeType_Event
+	PrivilegeType_Trigger
+	PrivilegeType_CreateTablespace
+	PrivilegeType_CreateRole
+	PrivilegeType_DropRole
+)
+
+// privilegeTypeInfo describes where a privilege is stored in the grant tables.
+type privilegeTypeInfo struct {
+	// name is the name of the privilege in GRANT statements.
+	name string
+	// column is the name of the privilege's column in the user table, and in the db table if it may be granted on
+	// databases.
+	column string
+	// database is whether the privilege may be granted on databases.
+	database bool
+	// table is the name of the privilege in the Table_priv column of the tables_priv table, if it may be granted on
+	// tables.
+	table string
+	// columnLevel is whether the privilege may be granted on columns, in which case its name in the Column_priv
+	// columns is the same as in the Table_priv column.
+	columnLevel bool
+}
+
+// privilegeTypes are the privilege types in the order of their columns in the user table.
+var privilegeTypes = []privilegeTypeInfo{
+	PrivilegeType_Select:            {"SELECT", "Select_priv", true, "Select", true},
+	PrivilegeType_Insert:            {"INSERT", "Insert_priv", true, "Insert", true},
+	PrivilegeType_Update:            {"UPDATE", "Update_priv", true, "Update", true},
+	PrivilegeType_Delete:            {"DELETE", "Delete_priv", true, "Delete", false},
+	PrivilegeType_Create:            {"CREATE", "Create_priv", true, "Create", false},
+	PrivilegeType_Drop:              {"DROP", "Drop_priv", true, "Drop", false},
+	PrivilegeType_Reload:            {"RELOAD", "Reload_priv", false, "", false},
+	PrivilegeType_Shutdown:          {"SHUTDOWN", "Shutdown_priv", false, "", false},
+	PrivilegeType_Process:           {"PROCESS", "Process_priv", false, "", false},
+	PrivilegeType_File:              {"FILE", "File_priv", false, "", false},
+	PrivilegeType_Grant:             {"GRANT OPTION", "Grant_priv", true, "Grant", false},
+	PrivilegeType_References:        {"REFERENCES", "References_priv", true, "References", true},
+	PrivilegeType_Index:             {"INDEX", "Index_priv", true, "Index", false},
+	PrivilegeType_Alter:             {"ALTER", "Alter_priv", true, "Alter", false},
+	PrivilegeType_ShowDB:            {"SHOW DATABASES", "Show_db_priv", false, "", false},
+	PrivilegeType_Super:             {"SUPER", "Super_priv", false, "", false},
+	PrivilegeType_CreateTempTable:   {"CREATE TEMPORARY TABLES", "Create_tmp_table_priv", true, "", false},
+	PrivilegeType_LockTables:        {"LOCK TABLES", "Lock_tables_priv", true, "", false},
+	PrivilegeType_Execute:           {"EXECUTE", "Execute_priv", true, "", false},
+	PrivilegeType_ReplicationSlave:  {"REPLICATION SLAVE", "Repl_slave_priv", false, "", false},
+	PrivilegeType_ReplicationClient: {"REPLICATION CLIENT", "Repl_client_priv", false, "", false},
+	PrivilegeType_CreateView:        {"CREATE VIEW", "Create_view_priv", true, "Create View", false},
+	PrivilegeType_ShowView:          {"SHOW VIEW", "Show_view_priv", true, "Show view", false},
+	PrivilegeType_CreateRoutine:     {"CREATE ROUTINE", "Create_routine_priv", true, "", false},
+	PrivilegeType_AlterRoutine:      {"ALTER ROUTINE", "Alter_routine_priv", true, "", false},
+	PrivilegeType_CreateUser:        {"CREATE USER", "Create_user_priv", false, "", false},
+	PrivilegeType_Event:             {"EVENT", "Event_priv", true, "", false},
+	PrivilegeType_Trigger:           {"TRIGGER", "Trigger_priv", true, "Trigger", false},
+	PrivilegeType_CreateTablespace:  {"CREATE TABLESPACE", "Create_tablespace_priv", false, "", false},
+	PrivilegeType_CreateRole:        {"CREATE ROLE", "Create_role_priv", false, "", false},
+	PrivilegeType_DropRole:          {"DROP ROLE", "Drop_role_priv", false, "", false},
+}
+
+// String returns the name of the privilege in GRANT statements.
+func (p PrivilegeType) String() string {
+	if int(p) < len(privilegeTypes) {
+		return privilegeTypes[p].name
+	}
+	return "UNKNOWN"
+}
+
+// PrivilegeLevel is the level that a privilege is granted on. A privilege is granted globally when the database is
+// "*", on all of the tables of a database when the table is "*", and on a single table otherwise. Privileges on
+// columns are granted on a table, for the columns they name.
+type PrivilegeLevel struct {
+	Database string
+	Table    string
+}
+
+// GlobalPrivilegeLevel is the level of the privileges granted on every database.
+var GlobalPrivilegeLevel = PrivilegeLevel{Database: "*", Table: "*"}
+
+// IsGlobal returns whether the level is global.
+func (l PrivilegeLevel) IsGlobal() bool {
+	return l.Database == "*"
+}
+
+// IsDatabase returns whether the level is the level of all of the tables of a database.
+func (l PrivilegeLevel) IsDatabase() bool {
+	return l.Database != "*" && l.Table == "*"
+}
+
+// String returns the level as it is written in GRANT statements.
+func (l PrivilegeLevel) String() string {
+	if l.IsGlobal() {
+		return "*.*"
+	} else if l.IsDatabase() {
+		return "`" + l.Database + "`.*"
+	}
+	return "`" + l.Database + "`.`" + l.Table + "`"
+}
+
+// normalize returns the level with its names in lowercase, as they are stored in the grant tables.
+func (l PrivilegeLevel) normalize() PrivilegeLevel {
+	return PrivilegeLevel{Database: strings.ToLower(l.Database), Table: strings.ToLower(l.Table)}
+}
+
+// AllPrivileges returns the privileges that GRANT ALL grants on the level given, which are all of the privileges that
+// may be granted on it except for GRANT OPTION.
+func AllPrivileges(level PrivilegeLevel) []PrivilegeType {
+	var privileges []PrivilegeType
+	for i, info := range privilegeTypes {
+		privilege := PrivilegeType(i)
+		if privilege == PrivilegeType_Grant {
+			continue
+		}
+		if level.IsGlobal() || (level.IsDatabase() && info.database) || (!level.IsDatabase() && info.table != "") {
+			privileges = append(privileges, privilege)
+		}
+	}
+	return privileges
+}
+
+// canGrant returns whether the privilege may be granted on the level given, for the columns given if any.
+func (p PrivilegeType) canGrant(level PrivilegeLevel, columns []string) bool {
+	info := privilegeTypes[p]
+	switch {
+	case len(columns) > 0:
+		return !level.IsGlobal() && !level.IsDatabase() && info.columnLevel
+	case level.IsGlobal():
+		return true
+	case level.IsDatabase():
+		return info.database
+	default:
+		return info.table != ""
+	}
+}
+
+// privilegeSet returns the value of a Table_priv or Column_priv column with the privileges given.
+func privilegeSet(privileges map[PrivilegeType]bool) string {
+	var names []string
+	for i, info := range privilegeTypes {
+		if privileges[PrivilegeType(i)] && info.table != "" {
+			names = append(names, info.table)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// parsePrivilegeSet returns the privileges of a Table_priv or Column_priv column.
+func parsePrivilegeSet(val interface{}) map[PrivilegeType]bool {
+	privileges := make(map[PrivilegeType]bool)
+	s, ok := val.(string)
+	if !ok {
+		return privileges
+	}
+	for _, name := range strings.Split(s, ",") {
+		for i, info := range privilegeTypes {
+			if info.table != "" && strings.EqualFold(info.table, strings.TrimSpace(name)) {
+				privileges[PrivilegeType(i)] = true
+			}
+		}
+	}
+	return privileges
+}
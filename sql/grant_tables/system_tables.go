@@ -0,0 +1,375 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grant_tables
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// systemTable is a read-only table in the mysql database. These tables exist so that clients and tools that query the
+// mysql database directly, such as mysqldump, DBeaver and the mysql client's HELP command, work against the engine.
+// Most of them are always empty, as the features they describe are not implemented.
+type systemTable struct {
+	name    string
+	sch     sql.Schema
+	catalog sql.Catalog
+	rows    func(ctx *sql.Context, cat sql.Catalog) ([]sql.Row, error)
+}
+
+var _ sql.Table = (*systemTable)(nil)
+
+// Name implements the interface sql.Table.
+func (t *systemTable) Name() string {
+	return t.name
+}
+
+// String implements the interface sql.Table.
+func (t *systemTable) String() string {
+	return t.name
+}
+
+// Schema implements the interface sql.Table.
+func (t *systemTable) Schema() sql.Schema {
+	return t.sch
+}
+
+// Partitions implements the interface sql.Table.
+func (t *systemTable) Partitions(ctx *sql.Context) (sql.PartitionIter, error) {
+	return sql.PartitionsToPartitionIter(dummyPartition{}), nil
+}
+
+// PartitionRows implements the interface sql.Table.
+func (t *systemTable) PartitionRows(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	if t.rows == nil || t.catalog == nil {
+		return sql.RowsToRowIter(), nil
+	}
+	rows, err := t.rows(ctx, t.catalog)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// AssignCatalog assigns a catalog to the table, which is used by the tables that are populated from other databases.
+func (t *systemTable) AssignCatalog(cat sql.Catalog) sql.Table {
+	nt := *t
+	nt.catalog = cat
+	return &nt
+}
+
+const procTblName = "proc"
+
+var (
+	char64_utf8_bin = sql.MustCreateString(sqltypes.Char, 64, sql.Collation_utf8_bin)
+	char32_utf8_bin = sql.MustCreateString(sqltypes.Char, 32, sql.Collation_utf8_bin)
+	varchar255      = sql.MustCreateString(sqltypes.VarChar, 255, sql.Collation_Default)
+	text_utf8       = sql.CreateText(sql.Collation_Default)
+)
+
+// systemTables returns the read-only tables of the mysql database, keyed by lowercase name.
+func systemTables() map[string]*systemTable {
+	tables := []*systemTable{
+		{
+			name: procTblName,
+			sch: systemSchema(procTblName,
+				pkCol("db", char64_utf8_bin),
+				pkCol("name", char64_utf8_bin),
+				pkCol("type", sql.MustCreateEnumType([]string{"FUNCTION", "PROCEDURE"}, sql.Collation_Default)),
+				col("specific_name", char64_utf8_bin),
+				col("language", sql.MustCreateEnumType([]string{"SQL"}, sql.Collation_Default)),
+				col("sql_data_access", sql.MustCreateEnumType([]string{"CONTAINS_SQL", "NO_SQL", "READS_SQL_DATA", "MODIFIES_SQL_DATA"}, sql.Collation_Default)),
+				col("is_deterministic", sql.MustCreateEnumType([]string{"YES", "NO"}, sql.Collation_Default)),
+				col("security_type", sql.MustCreateEnumType([]string{"INVOKER", "DEFINER"}, sql.Collation_Default)),
+				col("param_list", sql.Blob),
+				col("returns", sql.LongBlob),
+				col("body", sql.LongBlob),
+				col("definer", varchar255),
+				col("created", sql.Timestamp),
+				col("modified", sql.Timestamp),
+				col("sql_mode", text_utf8),
+				col("comment", text_utf8),
+				col("character_set_client", char32_utf8_bin),
+				col("collation_connection", char32_utf8_bin),
+				col("db_collation", char32_utf8_bin),
+				col("body_utf8", sql.LongBlob),
+			),
+			rows: procRows,
+		},
+		{
+			name: "procs_priv",
+			sch: systemSchema("procs_priv",
+				pkCol("Host", char64_utf8_bin),
+				pkCol("Db", char64_utf8_bin),
+				pkCol("User", char32_utf8_bin),
+				pkCol("Routine_name", char64_utf8_bin),
+				pkCol("Routine_type", sql.MustCreateEnumType([]string{"FUNCTION", "PROCEDURE"}, sql.Collation_Default)),
+				col("Grantor", varchar255),
+				col("Proc_priv", text_utf8),
+				col("Timestamp", sql.Timestamp),
+			),
+		},
+		{
+			name: "help_topic",
+			sch: systemSchema("help_topic",
+				pkCol("help_topic_id", sql.Uint32),
+				col("name", char64_utf8_bin),
+				col("help_category_id", sql.Uint16),
+				col("description", text_utf8),
+				col("example", text_utf8),
+				col("url", text_utf8),
+			),
+		},
+		{
+			name: "help_category",
+			sch: systemSchema("help_category",
+				pkCol("help_category_id", sql.Uint16),
+				col("name", char64_utf8_bin),
+				col("parent_category_id", sql.Uint16),
+				col("url", text_utf8),
+			),
+		},
+		{
+			name: "help_keyword",
+			sch: systemSchema("help_keyword",
+				pkCol("help_keyword_id", sql.Uint32),
+				col("name", char64_utf8_bin),
+			),
+		},
+		{
+			name: "help_relation",
+			sch: systemSchema("help_relation",
+				pkCol("help_topic_id", sql.Uint32),
+				pkCol("help_keyword_id", sql.Uint32),
+			),
+		},
+		{
+			name: "func",
+			sch: systemSchema("func",
+				pkCol("name", char64_utf8_bin),
+				col("ret", sql.Int8),
+				col("dl", char64_utf8_bin),
+				col("type", sql.MustCreateEnumType([]string{"function", "aggregate"}, sql.Collation_Default)),
+			),
+		},
+		{
+			name: "plugin",
+			sch: systemSchema("plugin",
+				pkCol("name", varchar255),
+				col("dl", varchar255),
+			),
+		},
+		{
+			name: "servers",
+			sch: systemSchema("servers",
+				pkCol("Server_name", char64_utf8_bin),
+				col("Host", char64_utf8_bin),
+				col("Db", char64_utf8_bin),
+				col("Username", char64_utf8_bin),
+				col("Password", char64_utf8_bin),
+				col("Port", sql.Int32),
+				col("Socket", char64_utf8_bin),
+				col("Wrapper", char64_utf8_bin),
+				col("Owner", char64_utf8_bin),
+			),
+		},
+		{
+			name: "time_zone",
+			sch: systemSchema("time_zone",
+				pkCol("Time_zone_id", sql.Uint32),
+				col("Use_leap_seconds", sql.MustCreateEnumType([]string{"Y", "N"}, sql.Collation_Default)),
+			),
+		},
+		{
+			name: "time_zone_name",
+			sch: systemSchema("time_zone_name",
+				pkCol("Name", char64_utf8_bin),
+				col("Time_zone_id", sql.Uint32),
+			),
+		},
+		{
+			name: "time_zone_transition",
+			sch: systemSchema("time_zone_transition",
+				pkCol("Time_zone_id", sql.Uint32),
+				pkCol("Transition_time", sql.Int64),
+				col("Transition_type_id", sql.Uint32),
+			),
+		},
+		{
+			name: "time_zone_transition_type",
+			sch: systemSchema("time_zone_transition_type",
+				pkCol("Time_zone_id", sql.Uint32),
+				pkCol("Transition_type_id", sql.Uint32),
+				col("Offset", sql.Int32),
+				col("Is_DST", sql.Uint8),
+				col("Abbreviation", char64_utf8_bin),
+			),
+		},
+		{
+			name: "time_zone_leap_second",
+			sch: systemSchema("time_zone_leap_second",
+				pkCol("Transition_time", sql.Int64),
+				col("Correction", sql.Int32),
+			),
+		},
+		{
+			name: "general_log",
+			sch: systemSchema("general_log",
+				col("event_time", sql.Timestamp),
+				col("user_host", sql.MediumText),
+				col("thread_id", sql.Uint64),
+				col("server_id", sql.Uint32),
+				col("command_type", varchar255),
+				col("argument", sql.MediumBlob),
+			),
+		},
+		{
+			name: "slow_log",
+			sch: systemSchema("slow_log",
+				col("start_time", sql.Timestamp),
+				col("user_host", sql.MediumText),
+				col("query_time", sql.Time),
+				col("lock_time", sql.Time),
+				col("rows_sent", sql.Int32),
+				col("rows_examined", sql.Int32),
+				col("db", varchar255),
+				col("last_insert_id", sql.Int32),
+				col("insert_id", sql.Int32),
+				col("server_id", sql.Uint32),
+				col("sql_text", sql.MediumBlob),
+				col("thread_id", sql.Uint64),
+			),
+		},
+	}
+
+	res := make(map[string]*systemTable, len(tables))
+	for _, t := range tables {
+		res[strings.ToLower(t.name)] = t
+	}
+	return res
+}
+
+func systemSchema(table string, cols ...*sql.Column) sql.Schema {
+	for _, c := range cols {
+		c.Source = table
+	}
+	return cols
+}
+
+func pkCol(name string, typ sql.Type) *sql.Column {
+	return &sql.Column{Name: name, Type: typ, PrimaryKey: true}
+}
+
+func col(name string, typ sql.Type) *sql.Column {
+	return &sql.Column{Name: name, Type: typ, Nullable: true}
+}
+
+// procRows returns a row in mysql.proc for every stored procedure in every database of the catalog.
+func procRows(ctx *sql.Context, cat sql.Catalog) ([]sql.Row, error) {
+	var rows []sql.Row
+	for _, db := range cat.AllDatabases() {
+		spDb, ok := db.(sql.StoredProcedureDatabase)
+		if !ok {
+			continue
+		}
+		procs, err := spDb.GetStoredProcedures(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, proc := range procs {
+			rows = append(rows, procRow(db.Name(), proc))
+		}
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i][0].(string) != rows[j][0].(string) {
+			return rows[i][0].(string) < rows[j][0].(string)
+		}
+		return rows[i][1].(string) < rows[j][1].(string)
+	})
+	return rows, nil
+}
+
+// procRow returns the mysql.proc row for the given stored procedure. The details that aren't stored directly are
+// taken from the procedure's CREATE statement, and left at their defaults if it can't be parsed.
+func procRow(dbName string, proc sql.StoredProcedureDetails) sql.Row {
+	var paramList []string
+	body := proc.CreateStatement
+	definer := ""
+	dataAccess := "CONTAINS_SQL"
+	deterministic := "NO"
+	security := "DEFINER"
+	comment := ""
+
+	stmt, err := sqlparser.Parse(proc.CreateStatement)
+	if ddl, ok := stmt.(*sqlparser.DDL); err == nil && ok && ddl.ProcedureSpec != nil {
+		spec := ddl.ProcedureSpec
+		definer = spec.Definer
+		for _, param := range spec.Params {
+			paramList = append(paramList, fmt.Sprintf("%s %s %s", strings.ToUpper(string(param.Direction)), param.Name, sqlparser.String(&param.Type)))
+		}
+		for _, c := range spec.Characteristics {
+			switch c.Type {
+			case sqlparser.CharacteristicValue_Comment:
+				comment = c.Comment
+			case sqlparser.CharacteristicValue_Deterministic:
+				deterministic = "YES"
+			case sqlparser.CharacteristicValue_NotDeterministic:
+				deterministic = "NO"
+			case sqlparser.CharacteristicValue_ContainsSql,
+				sqlparser.CharacteristicValue_NoSql,
+				sqlparser.CharacteristicValue_ReadsSqlData,
+				sqlparser.CharacteristicValue_ModifiesSqlData:
+				dataAccess = strings.ReplaceAll(strings.ToUpper(string(c.Type)), " ", "_")
+			case sqlparser.CharacteristicValue_SqlSecurityInvoker:
+				security = "INVOKER"
+			case sqlparser.CharacteristicValue_SqlSecurityDefiner:
+				security = "DEFINER"
+			}
+		}
+		if spec.Body != nil {
+			body = sqlparser.String(spec.Body)
+		}
+	}
+
+	return sql.Row{
+		dbName,                        // db
+		proc.Name,                     // name
+		"PROCEDURE",                   // type
+		proc.Name,                     // specific_name
+		"SQL",                         // language
+		dataAccess,                    // sql_data_access
+		deterministic,                 // is_deterministic
+		security,                      // security_type
+		strings.Join(paramList, ", "), // param_list
+		"",                            // returns
+		body,                          // body
+		definer,                       // definer
+		proc.CreatedAt,                // created
+		proc.ModifiedAt,               // modified
+		"",                            // sql_mode
+		comment,                       // comment
+		sql.Collation_Default.CharacterSet().String(), // character_set_client
+		sql.Collation_Default.String(),                // collation_connection
+		sql.Collation_Default.String(),                // db_collation
+		body,                                          // body_utf8
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grant_tables
+
+import (
+	"fmt"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+	"github.com/dolthub/go-mysql-server/sql/in_mem_table"
+)
+
+const tablesPrivTblName = "tables_priv"
+
+var (
+	tablesPrivPkCols        = []uint16{0, 1, 2, 3}
+	tablesPrivUserCols      = []uint16{2}
+	errTablesPrivPkAssign   = fmt.Errorf("the primary key for the `tables_priv` table expects a host, database, user and table string")
+	errTablesPrivUserAssign = fmt.Errorf("the secondary key for the `tables_priv` table expects a user string")
+
+	tablesPrivTblSchema sql.Schema
+)
+
+// TablesPrivPrimaryKey is a key that represents the primary key for the "tables_priv" Grant Table.
+type TablesPrivPrimaryKey struct {
+	Host  string
+	Db    string
+	User  string
+	Table string
+}
+
+// TablesPrivSecondaryKey is a key that represents the secondary key for the "tables_priv" Grant Table, which contains
+// only usernames.
+type TablesPrivSecondaryKey struct {
+	User string
+}
+
+var _ in_mem_table.InMemTableDataKey = TablesPrivPrimaryKey{}
+var _ in_mem_table.InMemTableDataKey = TablesPrivSecondaryKey{}
+
+// AssignValues implements the interface in_mem_table.InMemTableDataKey.
+func (t TablesPrivPrimaryKey) AssignValues(vals ...interface{}) (in_mem_table.InMemTableDataKey, error) {
+	if len(vals) != 4 {
+		return t, errTablesPrivPkAssign
+	}
+	strs := make([]string, len(vals))
+	for i, val := range vals {
+		s, ok := val.(string)
+		if !ok {
+			return t, errTablesPrivPkAssign
+		}
+		strs[i] = s
+	}
+	return TablesPrivPrimaryKey{
+		Host:  strs[0],
+		Db:    strs[1],
+		User:  strs[2],
+		Table: strs[3],
+	}, nil
+}
+
+// RepresentedColumns implements the interface in_mem_table.InMemTableDataKey.
+func (t TablesPrivPrimaryKey) RepresentedColumns() []uint16 {
+	return tablesPrivPkCols
+}
+
+// AssignValues implements the interface in_mem_table.InMemTableDataKey.
+func (t TablesPrivSecondaryKey) AssignValues(vals ...interface{}) (in_mem_table.InMemTableDataKey, error) {
+	if len(vals) != 1 {
+		return t, errTablesPrivUserAssign
+	}
+	user, ok := vals[0].(string)
+	if !ok {
+		return t, errTablesPrivUserAssign
+	}
+	return TablesPrivSecondaryKey{
+		User: user,
+	}, nil
+}
+
+// RepresentedColumns implements the interface in_mem_table.InMemTableDataKey.
+func (t TablesPrivSecondaryKey) RepresentedColumns() []uint16 {
+	return tablesPrivUserCols
+}
+
+// init creates the schemas for the "tables_priv" and "columns_priv" Grant Tables, which share the types of their
+// privilege columns.
+func init() {
+	// Types
+	char32_utf8_bin := sql.MustCreateString(sqltypes.Char, 32, sql.Collation_utf8_bin)
+	char64_utf8_bin := sql.MustCreateString(sqltypes.Char, 64, sql.Collation_utf8_bin)
+	char255_ascii_general_ci := sql.MustCreateString(sqltypes.Char, 255, sql.Collation_ascii_general_ci)
+	varchar288_utf8_bin := sql.MustCreateString(sqltypes.VarChar, 288, sql.Collation_utf8_bin)
+	var tablePrivs, columnPrivs []string
+	for _, info := range privilegeTypes {
+		if info.table != "" {
+			tablePrivs = append(tablePrivs, info.table)
+		}
+		if info.columnLevel {
+			columnPrivs = append(columnPrivs, info.table)
+		}
+	}
+	set_table_privs_utf8_general_ci := sql.MustCreateSetType(tablePrivs, sql.Collation_utf8_general_ci)
+	set_column_privs_utf8_general_ci := sql.MustCreateSetType(columnPrivs, sql.Collation_utf8_general_ci)
+
+	// Column Templates
+	char32_utf8_bin_not_null_default_empty := &sql.Column{
+		Type:     char32_utf8_bin,
+		Default:  mustDefault(expression.NewLiteral("", char32_utf8_bin), char32_utf8_bin, true, false),
+		Nullable: false,
+	}
+	char64_utf8_bin_not_null_default_empty := &sql.Column{
+		Type:     char64_utf8_bin,
+		Default:  mustDefault(expression.NewLiteral("", char64_utf8_bin), char64_utf8_bin, true, false),
+		Nullable: false,
+	}
+	char255_ascii_general_ci_not_null_default_empty := &sql.Column{
+		Type:     char255_ascii_general_ci,
+		Default:  mustDefault(expression.NewLiteral("", char255_ascii_general_ci), char255_ascii_general_ci, true, false),
+		Nullable: false,
+	}
+	varchar288_utf8_bin_not_null_default_empty := &sql.Column{
+		Type:     varchar288_utf8_bin,
+		Default:  mustDefault(expression.NewLiteral("", varchar288_utf8_bin), varchar288_utf8_bin, true, false),
+		Nullable: false,
+	}
+	timestamp_nullable_default_nil := &sql.Column{
+		Type:     sql.Timestamp,
+		Default:  nil,
+		Nullable: true,
+	}
+	set_table_privs_utf8_general_ci_not_null_default_empty := &sql.Column{
+		Type:     set_table_privs_utf8_general_ci,
+		Default:  mustDefault(expression.NewLiteral("", set_table_privs_utf8_general_ci), set_table_privs_utf8_general_ci, true, false),
+		Nullable: false,
+	}
+	set_column_privs_utf8_general_ci_not_null_default_empty := &sql.Column{
+		Type:     set_column_privs_utf8_general_ci,
+		Default:  mustDefault(expression.NewLiteral("", set_column_privs_utf8_general_ci), set_column_privs_utf8_general_ci, true, false),
+		Nullable: false,
+	}
+
+	tablesPrivTblSchema = sql.Schema{
+		columnTemplate("Host", tablesPrivTblName, true, char255_ascii_general_ci_not_null_default_empty),
+		columnTemplate("Db", tablesPrivTblName, true, char64_utf8_bin_not_null_default_empty),
+		columnTemplate("User", tablesPrivTblName, true, char32_utf8_bin_not_null_default_empty),
+		columnTemplate("Table_name", tablesPrivTblName, true, char64_utf8_bin_not_null_default_empty),
+		columnTemplate("Grantor", tablesPrivTblName, false, varchar288_utf8_bin_not_null_default_empty),
+		columnTemplate("Timestamp", tablesPrivTblName, false, timestamp_nullable_default_nil),
+		columnTemplate("Table_priv", tablesPrivTblName, false, set_table_privs_utf8_general_ci_not_null_default_empty),
+		columnTemplate("Column_priv", tablesPrivTblName, false, set_column_privs_utf8_general_ci_not_null_default_empty),
+	}
+	columnsPrivTblSchema = sql.Schema{
+		columnTemplate("Host", columnsPrivTblName, true, char255_ascii_general_ci_not_null_default_empty),
+		columnTemplate("Db", columnsPrivTblName, true, char64_utf8_bin_not_null_default_empty),
+		columnTemplate("User", columnsPrivTblName, true, char32_utf8_bin_not_null_default_empty),
+		columnTemplate("Table_name", columnsPrivTblName, true, char64_utf8_bin_not_null_default_empty),
+		columnTemplate("Column_name", columnsPrivTblName, true, char64_utf8_bin_not_null_default_empty),
+		columnTemplate("Timestamp", columnsPrivTblName, false, timestamp_nullable_default_nil),
+		columnTemplate("Column_priv", columnsPrivTblName, false, set_column_privs_utf8_general_ci_not_null_default_empty),
+	}
+}
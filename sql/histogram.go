@@ -0,0 +1,255 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/shopspring/decimal"
+)
+
+// DefaultHistogramBuckets is the number of buckets of the histograms collected by ANALYZE TABLE, the same as MySQL's
+// default.
+const DefaultHistogramBuckets = 100
+
+// HistogramTable is an AnalyzableTable that collects histograms of the values of its columns when it's analyzed. They
+// are shown in the INFORMATION_SCHEMA.COLUMN_STATISTICS table.
+type HistogramTable interface {
+	AnalyzableTable
+	// ColumnHistogram returns the histogram of the column named collected when the table was last analyzed, and whether
+	// there is one at all.
+	ColumnHistogram(ctx *Context, column string) (*Histogram, bool, error)
+}
+
+// HistogramType is the kind of a histogram, which tells what its buckets hold.
+type HistogramType string
+
+const (
+	// HistogramSingleton histograms have a bucket for every distinct value of their column.
+	HistogramSingleton HistogramType = "singleton"
+	// HistogramEquiHeight histograms have buckets for ranges of values of their column, each with about the same
+	// number of rows.
+	HistogramEquiHeight HistogramType = "equi-height"
+)
+
+// HistogramBucket is a bucket of a Histogram. The bounds of the buckets of singleton histograms are the same value.
+type HistogramBucket struct {
+	// LowerBound is the lowest value of the bucket.
+	LowerBound interface{}
+	// UpperBound is the highest value of the bucket.
+	UpperBound interface{}
+	// CumulativeFrequency is the fraction of the rows of the table whose value is in this bucket or in one before it.
+	CumulativeFrequency float64
+	// Distinct is the number of distinct values in the bucket.
+	Distinct uint64
+}
+
+// Histogram describes the distribution of the values of a column, like the histograms of MySQL.
+type Histogram struct {
+	// Type is the kind of the histogram.
+	Type HistogramType
+	// DataType is the type of the column.
+	DataType Type
+	// Buckets are the buckets of the histogram, in the order of their values.
+	Buckets []HistogramBucket
+	// NullFraction is the fraction of the rows of the table whose value is NULL.
+	NullFraction float64
+	// BucketsSpecified is the maximum number of buckets the histogram was built with.
+	BucketsSpecified int
+	// LastUpdated is when the histogram was built.
+	LastUpdated time.Time
+}
+
+// NewHistogram returns the histogram of the values given, one for each row of a table, of the type given, with at most
+// the number of buckets given. It's a singleton histogram when there are no more distinct values than buckets, and an
+// equi-height one otherwise.
+func NewHistogram(ctx *Context, typ Type, values []interface{}, buckets int) (*Histogram, error) {
+	if buckets < 1 {
+		buckets = 1
+	}
+
+	h := &Histogram{
+		DataType:         typ,
+		BucketsSpecified: buckets,
+		LastUpdated:      ctx.QueryTime(),
+	}
+	if len(values) == 0 {
+		h.Type = HistogramSingleton
+		return h, nil
+	}
+
+	sorted := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		if v != nil {
+			sorted = append(sorted, v)
+		}
+	}
+	total := float64(len(values))
+	h.NullFraction = float64(len(values)-len(sorted)) / total
+
+	var err error
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if err != nil {
+			return false
+		}
+		var cmp int
+		cmp, err = typ.Compare(sorted[i], sorted[j])
+		return cmp < 0
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// groups are the indexes of the first of every run of equal values
+	var groups []int
+	for i := range sorted {
+		if i == 0 {
+			groups = append(groups, i)
+			continue
+		}
+		cmp, err := typ.Compare(sorted[i-1], sorted[i])
+		if err != nil {
+			return nil, err
+		}
+		if cmp != 0 {
+			groups = append(groups, i)
+		}
+	}
+
+	if len(groups) <= buckets {
+		h.Type = HistogramSingleton
+		for i, start := range groups {
+			end := len(sorted)
+			if i+1 < len(groups) {
+				end = groups[i+1]
+			}
+			h.Buckets = append(h.Buckets, HistogramBucket{
+				LowerBound:          sorted[start],
+				UpperBound:          sorted[start],
+				CumulativeFrequency: float64(end) / total,
+				Distinct:            1,
+			})
+		}
+		return h, nil
+	}
+
+	// Every bucket takes whole runs of equal values until it has its share of the rows left
+	h.Type = HistogramEquiHeight
+	start, distinct := 0, uint64(0)
+	for i, groupStart := range groups {
+		end := len(sorted)
+		if i+1 < len(groups) {
+			end = groups[i+1]
+		}
+		distinct++
+
+		remainingBuckets := buckets - len(h.Buckets)
+		if end < len(sorted) && float64(end-start) < float64(len(sorted)-start)/float64(remainingBuckets) {
+			continue
+		}
+		h.Buckets = append(h.Buckets, HistogramBucket{
+			LowerBound:          sorted[start],
+			UpperBound:          sorted[groupStart],
+			CumulativeFrequency: float64(end) / total,
+			Distinct:            distinct,
+		})
+		start, distinct = end, 0
+	}
+	return h, nil
+}
+
+// JSON returns the histogram in the JSON format of the histograms of MySQL, as shown in the HISTOGRAM column of the
+// INFORMATION_SCHEMA.COLUMN_STATISTICS table.
+func (h *Histogram) JSON() JSONDocument {
+	buckets := make([]interface{}, len(h.Buckets))
+	for i, b := range h.Buckets {
+		if h.Type == HistogramSingleton {
+			buckets[i] = []interface{}{histogramValue(b.UpperBound), b.CumulativeFrequency}
+		} else {
+			buckets[i] = []interface{}{histogramValue(b.LowerBound), histogramValue(b.UpperBound), b.CumulativeFrequency, float64(b.Distinct)}
+		}
+	}
+
+	doc := map[string]interface{}{
+		"buckets":                     buckets,
+		"data-type":                   histogramDataType(h.DataType),
+		"null-values":                 h.NullFraction,
+		"last-updated":                h.LastUpdated.Format("2006-01-02 15:04:05.000000"),
+		"sampling-rate":               float64(1),
+		"histogram-type":              string(h.Type),
+		"number-of-buckets-specified": float64(h.BucketsSpecified),
+	}
+	if st, ok := h.DataType.(StringType); ok {
+		doc["collation-id"] = float64(st.Collation().ID())
+	}
+	return JSONDocument{Val: doc}
+}
+
+// histogramValue returns the value of a column given as a value of a JSON document.
+func histogramValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case time.Time:
+		return v.Format("2006-01-02 15:04:05.000000")
+	case decimal.Decimal:
+		f, _ := v.Float64()
+		return f
+	case []byte:
+		return string(v)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return v
+	}
+}
+
+// histogramDataType returns the name of the type given in the histograms of MySQL.
+func histogramDataType(typ Type) string {
+	switch typ.Type() {
+	case sqltypes.Int8, sqltypes.Int16, sqltypes.Int24, sqltypes.Int32, sqltypes.Int64, sqltypes.Year:
+		return "int"
+	case sqltypes.Uint8, sqltypes.Uint16, sqltypes.Uint24, sqltypes.Uint32, sqltypes.Uint64, sqltypes.Bit:
+		return "uint"
+	case sqltypes.Float32, sqltypes.Float64:
+		return "double"
+	case sqltypes.Decimal:
+		return "decimal"
+	case sqltypes.Date:
+		return "date"
+	case sqltypes.Datetime, sqltypes.Timestamp:
+		return "datetime"
+	case sqltypes.Time:
+		return "time"
+	case sqltypes.Enum:
+		return "enum"
+	case sqltypes.Set:
+		return "set"
+	default:
+		return "string"
+	}
+}
+
+// HistogramSupported returns whether histograms can be collected for the columns of the type given. Like MySQL, there
+// are no histograms of JSON and spatial columns.
+func HistogramSupported(typ Type) bool {
+	switch typ.Type() {
+	case sqltypes.TypeJSON, sqltypes.Geometry:
+		return false
+	default:
+		return true
+	}
+}
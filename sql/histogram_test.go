@@ -0,0 +1,87 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHistogram(t *testing.T) {
+	ctx := NewEmptyContext()
+	values := []interface{}{int64(5), int64(1), nil, int64(3), int64(3), int64(3), int64(2), int64(4), int64(6), nil}
+
+	tests := []struct {
+		name     string
+		buckets  int
+		typ      HistogramType
+		expected []HistogramBucket
+	}{
+		{
+			name:    "singleton",
+			buckets: 6,
+			typ:     HistogramSingleton,
+			expected: []HistogramBucket{
+				{LowerBound: int64(1), UpperBound: int64(1), CumulativeFrequency: 0.1, Distinct: 1},
+				{LowerBound: int64(2), UpperBound: int64(2), CumulativeFrequency: 0.2, Distinct: 1},
+				{LowerBound: int64(3), UpperBound: int64(3), CumulativeFrequency: 0.5, Distinct: 1},
+				{LowerBound: int64(4), UpperBound: int64(4), CumulativeFrequency: 0.6, Distinct: 1},
+				{LowerBound: int64(5), UpperBound: int64(5), CumulativeFrequency: 0.7, Distinct: 1},
+				{LowerBound: int64(6), UpperBound: int64(6), CumulativeFrequency: 0.8, Distinct: 1},
+			},
+		},
+		{
+			// Every bucket takes whole runs of equal values, until it has its share of the rows
+			name:    "equi-height",
+			buckets: 3,
+			typ:     HistogramEquiHeight,
+			expected: []HistogramBucket{
+				{LowerBound: int64(1), UpperBound: int64(3), CumulativeFrequency: 0.5, Distinct: 3},
+				{LowerBound: int64(4), UpperBound: int64(5), CumulativeFrequency: 0.7, Distinct: 2},
+				{LowerBound: int64(6), UpperBound: int64(6), CumulativeFrequency: 0.8, Distinct: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			h, err := NewHistogram(ctx, Int64, values, tt.buckets)
+			require.NoError(err)
+			require.Equal(tt.typ, h.Type)
+			require.Equal(0.2, h.NullFraction)
+			require.Equal(tt.buckets, h.BucketsSpecified)
+			require.Equal(tt.expected, h.Buckets)
+		})
+	}
+}
+
+func TestHistogramJSON(t *testing.T) {
+	require := require.New(t)
+	ctx := NewEmptyContext()
+
+	h, err := NewHistogram(ctx, Text, []interface{}{"b", "a", "b", nil}, 1)
+	require.NoError(err)
+	doc := h.JSON().Val.(map[string]interface{})
+	require.Equal([]interface{}{
+		[]interface{}{"a", "b", 0.75, float64(2)},
+	}, doc["buckets"])
+	require.Equal("string", doc["data-type"])
+	require.Equal("equi-height", doc["histogram-type"])
+	require.Equal(0.25, doc["null-values"])
+	require.Equal(float64(1), doc["number-of-buckets-specified"])
+	require.Equal(float64(Collation_Default.ID()), doc["collation-id"])
+}
@@ -0,0 +1,70 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+)
+
+// The modes of the lower_case_table_names system variable, which decides how the names of databases, tables and views
+// are stored and compared. The variable is read only, so it's set by integrators with SystemVariables.AssignValues
+// before any databases or tables are created. It defaults to LowerCaseTableNames_Insensitive, which is how names have
+// always been resolved.
+const (
+	// LowerCaseTableNames_AsGiven stores names as they're given, and compares them case-sensitively, so a name only
+	// refers to the table with exactly that name, and tables whose names only differ in case can exist together. The
+	// names of the information_schema and mysql databases, and of their tables, are always compared case-insensitively.
+	LowerCaseTableNames_AsGiven int64 = 0
+	// LowerCaseTableNames_Lowered stores names in lower case, and compares them case-insensitively.
+	LowerCaseTableNames_Lowered int64 = 1
+	// LowerCaseTableNames_Insensitive stores names as they're given, and compares them case-insensitively.
+	LowerCaseTableNames_Insensitive int64 = 2
+)
+
+// LowerCaseTableNames returns the mode of the lower_case_table_names system variable.
+func LowerCaseTableNames() int64 {
+	_, val, ok := SystemVariables.GetGlobal("lower_case_table_names")
+	if !ok {
+		return LowerCaseTableNames_Insensitive
+	}
+	mode, ok := val.(int64)
+	if !ok {
+		return LowerCaseTableNames_Insensitive
+	}
+	return mode
+}
+
+// StoredTableName returns the name that a database, table or view created with the name given is stored with.
+func StoredTableName(name string) string {
+	if LowerCaseTableNames() == LowerCaseTableNames_Lowered {
+		return strings.ToLower(name)
+	}
+	return name
+}
+
+// TableNamesCaseInsensitive returns whether names of databases, tables and views that only differ in case are the same
+// name, so that creating a table whose name only differs in case from an existing table's name is an error.
+func TableNamesCaseInsensitive() bool {
+	return LowerCaseTableNames() != LowerCaseTableNames_AsGiven
+}
+
+// TableNameMatches returns whether the name of an existing database, table or view is matched by the name given, which
+// is only the case for a name that differs in case if names are compared case-insensitively.
+func TableNameMatches(name, given string) bool {
+	if TableNamesCaseInsensitive() {
+		return strings.EqualFold(name, given)
+	}
+	return name == given
+}
@@ -0,0 +1,46 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoredTableName(t *testing.T) {
+	require := require.New(t)
+	defer func() {
+		require.NoError(SystemVariables.AssignValues(map[string]interface{}{"lower_case_table_names": LowerCaseTableNames_Insensitive}))
+	}()
+
+	tests := []struct {
+		mode        int64
+		stored      string
+		insensitive bool
+	}{
+		{LowerCaseTableNames_AsGiven, "MyTable", false},
+		{LowerCaseTableNames_Lowered, "mytable", true},
+		{LowerCaseTableNames_Insensitive, "MyTable", true},
+	}
+	for _, test := range tests {
+		require.NoError(SystemVariables.AssignValues(map[string]interface{}{"lower_case_table_names": test.mode}))
+		require.Equal(test.mode, LowerCaseTableNames())
+		require.Equal(test.stored, StoredTableName("MyTable"))
+		require.Equal(test.insensitive, TableNamesCaseInsensitive())
+		require.Equal(test.insensitive, TableNameMatches("MyTable", "mytable"))
+		require.True(TableNameMatches("MyTable", "MyTable"))
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxIdentifierLength is the maximum length, in characters, of the names of databases, tables, views, columns, indexes,
+// constraints, triggers and stored procedures.
+const MaxIdentifierLength = 64
+
+// ValidateIdentifier returns an error if the identifier given is longer than the maximum identifier length.
+func ValidateIdentifier(name string) error {
+	if utf8.RuneCountInString(name) > MaxIdentifierLength {
+		return ErrTooLongIdentifier.New(name)
+	}
+	return nil
+}
+
+// FormatIdentifier returns the identifier given as it's written in a query. Identifiers that are only made of letters,
+// digits, underscores and dollar signs, and aren't only digits, are written as they are, and other identifiers are
+// quoted with backticks.
+func FormatIdentifier(name string) string {
+	if name == "" {
+		return name
+	}
+	onlyDigits := true
+	for _, c := range name {
+		switch {
+		case c >= '0' && c <= '9':
+		case c == '_' || c == '$' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80:
+			onlyDigits = false
+		default:
+			return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+		}
+	}
+	if onlyDigits {
+		return "`" + name + "`"
+	}
+	return name
+}
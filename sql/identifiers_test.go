@@ -0,0 +1,40 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatIdentifier(t *testing.T) {
+	require := require.New(t)
+	require.Equal("abc", FormatIdentifier("abc"))
+	require.Equal("a_$1", FormatIdentifier("a_$1"))
+	require.Equal("1a", FormatIdentifier("1a"))
+	require.Equal("`12`", FormatIdentifier("12"))
+	require.Equal("`a b`", FormatIdentifier("a b"))
+	require.Equal("`a``b`", FormatIdentifier("a`b"))
+	require.Equal("`a.b`", FormatIdentifier("a.b"))
+}
+
+func TestValidateIdentifier(t *testing.T) {
+	require := require.New(t)
+	require.NoError(ValidateIdentifier(strings.Repeat("a", MaxIdentifierLength)))
+	require.NoError(ValidateIdentifier(strings.Repeat("é", MaxIdentifierLength)))
+	require.True(ErrTooLongIdentifier.Is(ValidateIdentifier(strings.Repeat("a", MaxIdentifierLength+1))))
+}
@@ -154,6 +154,7 @@ func (imtd *InMemTableData) Put(row sql.Row) error {
 			indexedData = make(map[InMemTableDataKey][]sql.Row)
 			imtd.data[keyType] = indexedData
 			indexedData[key] = []sql.Row{row}
+			isDuplicateRow = false
 		} else {
 			existingRows := indexedData[key]
 			found := false
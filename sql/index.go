@@ -44,13 +44,25 @@ type Index interface {
 	// searchable area for each column expression. Each Range given will not overlap with any other ranges. Additionally,
 	// all ranges will have the same length, and may represent a partial index (matching a prefix rather than the entire
 	// index). If an integrator is unable to process the given ranges, then a nil may be returned. An error should be
-	// returned only in the event that an error occurred.
+	// returned only in the event that an error occurred. Integrators that cannot iterate over ranges directly may filter
+	// rows using RangeCollection.Contains, or the expression.RangeFilter expression.
 	NewLookup(ctx *Context, ranges ...Range) (IndexLookup, error)
 	// ColumnExpressionTypes returns each expression and its associated Type. Each expression string should exactly
 	// match the string returned from Index.Expressions().
 	ColumnExpressionTypes(ctx *Context) []ColumnExpressionType
 }
 
+// OrderedIndex is an Index whose lookups can return the rows of its table ordered by the values of its expressions,
+// as needed by merge joins.
+type OrderedIndex interface {
+	Index
+	// NewOrderedLookup returns a lookup of the ranges given, as NewLookup does, whose rows are returned in ascending
+	// order of the indexed expressions, with NULL values first. When a table is iterated with the lookup, the rows of
+	// all its partitions must be in order when the partitions are iterated one after the other, in the order the
+	// table returns them.
+	NewOrderedLookup(ctx *Context, ranges ...Range) (IndexLookup, error)
+}
+
 // IndexLookup is the implementation-specific definition of an index lookup. The IndexLookup must contain all necessary
 // information to retrieve exactly the rows in the table as specified by the ranges given to their parent index.
 // Implementors are responsible for all semantics of correctly returning rows that match an index lookup.
@@ -62,8 +62,11 @@ type DriverIndex interface {
 type DriverIndexLookup interface {
 	IndexLookup
 
-	// Values returns the values in the subset of the index. These are used to populate the index via the driver.
-	Values(Partition) (IndexValueIter, error)
+	// Values returns the values in the subset of the index. These are used to populate the index via the driver. The
+	// context is the one of the query the values are read for: lookups that read the values from storage that may block,
+	// such as over the network, must stop and return its error once it's done, which happens when the query is killed
+	// or runs past its max_execution_time.
+	Values(*Context, Partition) (IndexValueIter, error)
 
 	// Indexes returns the IDs of all indexes involved in this lookup.
 	Indexes() []string
@@ -117,7 +117,8 @@ func (r *IndexRegistry) LoadIndexes(ctx *Context, dbs []Database) error {
 					if err != nil {
 						return err
 					} else if !ok {
-						panic("Failed to find table in list of table names")
+						// The table was dropped since its name was listed
+						return nil
 					}
 
 					indexes, err := driver.LoadAll(ctx, db.Name(), t.Name())
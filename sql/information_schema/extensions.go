@@ -0,0 +1,63 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package information_schema
+
+import (
+	"strings"
+
+	. "github.com/dolthub/go-mysql-server/sql"
+)
+
+// RowIterFunc returns the rows of a system table, which are computed from the catalog given whenever the table is read.
+type RowIterFunc func(*Context, Catalog) (RowIter, error)
+
+// NewTable returns a read-only system table with the name and schema given, whose rows are returned by the function
+// given. Integrators use it to define the tables they add to INFORMATION_SCHEMA with NewInformationSchemaDatabase, or
+// to their own system databases with NewSystemDatabase. The source of the columns of the schema is set to the name of
+// the table.
+func NewTable(name string, schema Schema, rowIter RowIterFunc) Table {
+	schema = schema.Copy()
+	for _, col := range schema {
+		col.Source = name
+	}
+	return &informationSchemaTable{
+		name:    name,
+		schema:  schema,
+		rowIter: rowIter,
+	}
+}
+
+// NewSystemDatabase returns a read-only database with the name and tables given, such as those returned by NewTable,
+// for integrators that expose their metadata in a schema of their own, like INFORMATION_SCHEMA and PERFORMANCE_SCHEMA.
+func NewSystemDatabase(name string, tables ...Table) Database {
+	db := &informationSchemaDatabase{
+		name:   name,
+		tables: make(map[string]Table, len(tables)),
+	}
+	db.addTables(tables)
+	return db
+}
+
+// addTables adds the tables given to the database, replacing the tables of the database with the same names.
+func (db *informationSchemaDatabase) addTables(tables []Table) {
+	for _, t := range tables {
+		for name := range db.tables {
+			if strings.EqualFold(name, t.Name()) {
+				delete(db.tables, name)
+			}
+		}
+		db.tables[t.Name()] = t
+	}
+}
@@ -61,6 +61,8 @@ const (
 	RoutinesTableName = "routines"
 	// ViewsTableName is the name of the views table.
 	ViewsTableName = "views"
+	// ViewTableUsageTableName is the name of the view_table_usage table.
+	ViewTableUsageTableName = "view_table_usage"
 	// UserPrivilegesTableName is the name of the user_privileges table
 	UserPrivilegesTableName = "user_privileges"
 	// CharacterSetsTableName is the name of the character_sets table
@@ -73,6 +75,10 @@ const (
 	PartitionsTableName = "partitions"
 	// InnoDBTempTableName is the name of the INNODB_TEMP_TABLE_INFO table
 	InnoDBTempTableName = "innodb_temp_table_info"
+	// TablesExtensionsTableName is the name of the tables_extensions table
+	TablesExtensionsTableName = "tables_extensions"
+	// StSpatialReferenceSystemsTableName is the name of the st_spatial_reference_systems table
+	StSpatialReferenceSystemsTableName = "st_spatial_reference_systems"
 )
 
 var _ Database = (*informationSchemaDatabase)(nil)
@@ -86,7 +92,7 @@ type informationSchemaTable struct {
 	name    string
 	schema  Schema
 	catalog Catalog
-	rowIter func(*Context, Catalog) (RowIter, error)
+	rowIter RowIterFunc
 }
 
 type informationSchemaPartition struct {
@@ -199,6 +205,7 @@ var columnsSchema = Schema{
 	{Name: "privileges", Type: LongText, Default: parse.MustStringToColumnDefaultValue(NewEmptyContext(), `""`, LongText, false), Nullable: false, Source: ColumnsTableName},
 	{Name: "column_comment", Type: LongText, Default: parse.MustStringToColumnDefaultValue(NewEmptyContext(), `""`, LongText, false), Nullable: false, Source: ColumnsTableName},
 	{Name: "generation_expression", Type: LongText, Default: parse.MustStringToColumnDefaultValue(NewEmptyContext(), `""`, LongText, false), Nullable: false, Source: ColumnsTableName},
+	{Name: "srs_id", Type: Uint32, Default: nil, Nullable: true, Source: ColumnsTableName},
 }
 
 var schemataSchema = Schema{
@@ -378,6 +385,15 @@ var viewsSchema = Schema{
 	{Name: "collation_connection", Type: LongText, Default: nil, Nullable: false, Source: ViewsTableName},
 }
 
+var viewTableUsageSchema = Schema{
+	{Name: "view_catalog", Type: LongText, Default: nil, Nullable: false, Source: ViewTableUsageTableName},
+	{Name: "view_schema", Type: LongText, Default: nil, Nullable: false, Source: ViewTableUsageTableName},
+	{Name: "view_name", Type: LongText, Default: nil, Nullable: false, Source: ViewTableUsageTableName},
+	{Name: "table_catalog", Type: LongText, Default: nil, Nullable: false, Source: ViewTableUsageTableName},
+	{Name: "table_schema", Type: LongText, Default: nil, Nullable: false, Source: ViewTableUsageTableName},
+	{Name: "table_name", Type: LongText, Default: nil, Nullable: false, Source: ViewTableUsageTableName},
+}
+
 var userPrivilegesSchema = Schema{
 	{Name: "grantee", Type: LongText, Default: nil, Nullable: false, Source: UserPrivilegesTableName},
 	{Name: "table_catalog", Type: LongText, Default: nil, Nullable: false, Source: UserPrivilegesTableName},
@@ -436,6 +452,23 @@ var partitionSchema = Schema{
 	{Name: "tablespace_name", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 258), Default: nil, Nullable: true, Source: PartitionsTableName},
 }
 
+var tablesExtensionsSchema = Schema{
+	{Name: "table_catalog", Type: LongText, Default: nil, Nullable: false, Source: TablesExtensionsTableName},
+	{Name: "table_schema", Type: LongText, Default: nil, Nullable: false, Source: TablesExtensionsTableName},
+	{Name: "table_name", Type: LongText, Default: nil, Nullable: false, Source: TablesExtensionsTableName},
+	{Name: "engine_attribute", Type: LongText, Default: nil, Nullable: true, Source: TablesExtensionsTableName},
+	{Name: "secondary_engine_attribute", Type: LongText, Default: nil, Nullable: true, Source: TablesExtensionsTableName},
+}
+
+var stSpatialReferenceSystemsSchema = Schema{
+	{Name: "srs_name", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 80), Default: nil, Nullable: false, Source: StSpatialReferenceSystemsTableName},
+	{Name: "srs_id", Type: Uint32, Default: nil, Nullable: false, Source: StSpatialReferenceSystemsTableName},
+	{Name: "organization", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 256), Default: nil, Nullable: true, Source: StSpatialReferenceSystemsTableName},
+	{Name: "organization_coordsys_id", Type: Uint32, Default: nil, Nullable: true, Source: StSpatialReferenceSystemsTableName},
+	{Name: "definition", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 4096), Default: nil, Nullable: false, Source: StSpatialReferenceSystemsTableName},
+	{Name: "description", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 2048), Default: nil, Nullable: true, Source: StSpatialReferenceSystemsTableName},
+}
+
 var innoDBTempTableSchema = Schema{
 	{Name: "table_id", Type: Int64, Default: nil, Nullable: false, Source: InnoDBTempTableName},
 	{Name: "name", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 64), Default: nil, Nullable: true, Source: InnoDBTempTableName},
@@ -458,6 +491,16 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 		y2k, _ := Timestamp.Convert("2000-01-01 00:00:00")
 		err := DBTableIter(ctx, db, func(t Table) (cont bool, err error) {
 			autoVal := getAutoIncrementValue(ctx, t)
+			options, err := getTableOptions(ctx, t)
+			if err != nil {
+				return false, err
+			}
+			tableRowFormat := rowFormat
+			var createOptions interface{}
+			if options.RowFormat != "" {
+				tableRowFormat = strings.Title(strings.ToLower(options.RowFormat))
+				createOptions = "row_format=" + options.RowFormat
+			}
 			rows = append(rows, Row{
 				"def",                      // table_catalog
 				db.Name(),                  // table_schema
@@ -465,7 +508,7 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 				tableType,                  // table_type
 				engine,                     // engine
 				10,                         // version (protocol, always 10)
-				rowFormat,                  // row_format
+				tableRowFormat,             // row_format
 				nil,                        // table_rows
 				nil,                        // avg_row_length
 				nil,                        // data_length
@@ -476,10 +519,10 @@ func tablesRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 				y2k,                        // create_time
 				y2k,                        // update_time
 				nil,                        // check_time
-				Collation_Default.String(), // table_collation
+				options.Collation.String(), // table_collation
 				nil,                        // checksum
-				nil,                        // create_options
-				"",                         // table_comment
+				createOptions,              // create_options
+				options.Comment,            // table_comment
 			})
 
 			return true, nil
@@ -533,6 +576,7 @@ func columnsRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 					nullable string
 					charName interface{}
 					collName interface{}
+					srsID    interface{}
 				)
 				if c.Nullable {
 					nullable = "YES"
@@ -543,6 +587,11 @@ func columnsRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 					charName = Collation_Default.CharacterSet().String()
 					collName = Collation_Default.String()
 				}
+				if st, ok := c.Type.(SpatialColumnType); ok {
+					if srid, defined := st.GetSpatialTypeSRID(); defined {
+						srsID = srid
+					}
+				}
 				rows = append(rows, Row{
 					"def",                            // table_catalog
 					db.Name(),                        // table_schema
@@ -565,6 +614,7 @@ func columnsRowIter(ctx *Context, cat Catalog) (RowIter, error) {
 					"select",                         // privileges
 					c.Comment,                        // column_comment
 					"",                               // generation_expression
+					srsID,                            // srs_id
 				})
 			}
 			return true, nil
@@ -749,7 +799,10 @@ func checkConstraintsRowIter(ctx *Context, c Catalog) (RowIter, error) {
 
 		for _, tableName := range tableNames {
 			tbl, _, err := c.Table(ctx, db.Name(), tableName)
-			if err != nil {
+			if ErrTableNotFound.Is(err) {
+				// The table was dropped by another session since its name was listed
+				continue
+			} else if err != nil {
 				return nil, err
 			}
 
@@ -780,7 +833,10 @@ func tableConstraintRowIter(ctx *Context, c Catalog) (RowIter, error) {
 
 		for _, tableName := range tableNames {
 			tbl, _, err := c.Table(ctx, db.Name(), tableName)
-			if err != nil {
+			if ErrTableNotFound.Is(err) {
+				// The table was dropped by another session since its name was listed
+				continue
+			} else if err != nil {
 				return nil, err
 			}
 
@@ -844,6 +900,100 @@ func tableConstraintRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+// referentialConstraintsRowIter returns a row for each foreign key, with the unique index of the referenced table that
+// the referenced columns are the columns of, if there's one.
+func referentialConstraintsRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	var rows []Row
+	for _, db := range c.AllDatabases() {
+		tableNames, err := db.GetTableNames(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tableName := range tableNames {
+			tbl, _, err := c.Table(ctx, db.Name(), tableName)
+			if ErrTableNotFound.Is(err) {
+				// The table was dropped by another session since its name was listed
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+
+			fks, err := GetForeignKeys(ctx, tbl)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, fk := range fks {
+				uniqueConstraintName, err := referencedUniqueIndexName(ctx, c, db.Name(), fk)
+				if err != nil {
+					return nil, err
+				}
+				rows = append(rows, Row{"def", db.Name(), fk.Name, "def", db.Name(), uniqueConstraintName, "NONE", fk.OnUpdate.Rule(), fk.OnDelete.Rule(), tbl.Name(), fk.ReferencedTable})
+			}
+		}
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
+// referencedUniqueIndexName returns the name of the primary key or unique index of the table referenced by the foreign
+// key given that has the referenced columns as its columns, or nil if there's no such index.
+func referencedUniqueIndexName(ctx *Context, c Catalog, dbName string, fk ForeignKeyConstraint) (interface{}, error) {
+	tbl, _, err := c.Table(ctx, dbName, fk.ReferencedTable)
+	if ErrTableNotFound.Is(err) {
+		// The foreign key was created while foreign key checks were disabled
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var pkCols []string
+	for _, col := range tbl.Schema() {
+		if col.PrimaryKey {
+			pkCols = append(pkCols, col.Name)
+		}
+	}
+	if len(pkCols) == len(fk.ReferencedColumns) {
+		matches := true
+		for i, colName := range pkCols {
+			if !strings.EqualFold(colName, fk.ReferencedColumns[i]) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return "PRIMARY", nil
+		}
+	}
+
+	indexTable, ok := tbl.(IndexedTable)
+	if !ok {
+		return nil, nil
+	}
+	indexes, err := indexTable.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, index := range indexes {
+		if !index.IsUnique() || len(index.Expressions()) != len(fk.ReferencedColumns) {
+			continue
+		}
+		matches := true
+		for i, colName := range getColumnNamesFromIndex(index, tbl) {
+			if !strings.EqualFold(strings.Trim(colName, "`"), fk.ReferencedColumns[i]) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return index.ID(), nil
+		}
+	}
+	return nil, nil
+}
+
 func getColumnNamesFromIndex(idx Index, table Table) []string {
 	var indexCols []string
 	for _, expr := range idx.Expressions() {
@@ -866,7 +1016,10 @@ func keyColumnConstraintRowIter(ctx *Context, c Catalog) (RowIter, error) {
 
 		for _, tableName := range tableNames {
 			tbl, _, err := c.Table(ctx, db.Name(), tableName)
-			if err != nil {
+			if ErrTableNotFound.Is(err) {
+				// The table was dropped by another session since its name was listed
+				continue
+			} else if err != nil {
 				return nil, err
 			}
 
@@ -946,13 +1099,99 @@ func innoDBTempTableIter(ctx *Context, c Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+// columnStatisticsRowIter returns a row for every column with a histogram collected by ANALYZE TABLE.
+func columnStatisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	var rows []Row
+	for _, db := range c.AllDatabases() {
+		err := DBTableIter(ctx, db, func(t Table) (cont bool, err error) {
+			ht, ok := t.(HistogramTable)
+			if !ok {
+				return true, nil
+			}
+			for _, col := range t.Schema() {
+				histogram, ok, err := ht.ColumnHistogram(ctx, col.Name)
+				if err != nil {
+					return false, err
+				}
+				if !ok {
+					continue
+				}
+				rows = append(rows, Row{
+					db.Name(),        // schema_name
+					t.Name(),         // table_name
+					col.Name,         // column_name
+					histogram.JSON(), // histogram
+				})
+			}
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return RowsToRowIter(rows...), nil
+}
+
+// tablesExtensionsRowIter returns a row for every table, with the attributes for the storage engines of the table.
+func tablesExtensionsRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	var rows []Row
+	for _, db := range c.AllDatabases() {
+		err := DBTableIter(ctx, db, func(t Table) (cont bool, err error) {
+			options, err := getTableOptions(ctx, t)
+			if err != nil {
+				return false, err
+			}
+			var engineAttribute, secondaryEngineAttribute interface{}
+			if options.EngineAttribute != "" {
+				engineAttribute = options.EngineAttribute
+			}
+			if options.SecondaryEngineAttribute != "" {
+				secondaryEngineAttribute = options.SecondaryEngineAttribute
+			}
+			rows = append(rows, Row{
+				"def",                    // table_catalog
+				db.Name(),                // table_schema
+				t.Name(),                 // table_name
+				engineAttribute,          // engine_attribute
+				secondaryEngineAttribute, // secondary_engine_attribute
+			})
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return RowsToRowIter(rows...), nil
+}
+
+// stSpatialReferenceSystemsRowIter returns a row for every spatial reference system known to the engine.
+func stSpatialReferenceSystemsRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	var rows []Row
+	for _, srs := range SpatialReferenceSystems() {
+		var description interface{}
+		if srs.Description != "" {
+			description = srs.Description
+		}
+		rows = append(rows, Row{
+			srs.Name,                   // srs_name
+			srs.ID,                     // srs_id
+			srs.Organization,           // organization
+			srs.OrganizationCoordsysID, // organization_coordsys_id
+			srs.Definition,             // definition
+			description,                // description
+		})
+	}
+	return RowsToRowIter(rows...), nil
+}
+
 func emptyRowIter(ctx *Context, c Catalog) (RowIter, error) {
 	return RowsToRowIter(), nil
 }
 
-// NewInformationSchemaDatabase creates a new INFORMATION_SCHEMA Database.
-func NewInformationSchemaDatabase() Database {
-	return &informationSchemaDatabase{
+// NewInformationSchemaDatabase creates a new INFORMATION_SCHEMA Database. The extension tables given, such as those
+// returned by NewTable, are added to the tables of MySQL, and replace those with the same names.
+func NewInformationSchemaDatabase(extensions ...Table) Database {
+	db := &informationSchemaDatabase{
 		name: InformationSchemaDatabaseName,
 		tables: map[string]Table{
 			FilesTableName: &informationSchemaTable{
@@ -960,8 +1199,9 @@ func NewInformationSchemaDatabase() Database {
 				schema: filesSchema,
 			},
 			ColumnStatisticsTableName: &informationSchemaTable{
-				name:   ColumnStatisticsTableName,
-				schema: columnStatisticsSchema,
+				name:    ColumnStatisticsTableName,
+				schema:  columnStatisticsSchema,
+				rowIter: columnStatisticsRowIter,
 			},
 			TablesTableName: &informationSchemaTable{
 				name:    TablesTableName,
@@ -1001,7 +1241,7 @@ func NewInformationSchemaDatabase() Database {
 			ReferentialConstraintsTableName: &informationSchemaTable{
 				name:    ReferentialConstraintsTableName,
 				schema:  referentialConstraintsSchema,
-				rowIter: emptyRowIter,
+				rowIter: referentialConstraintsRowIter,
 			},
 			KeyColumnUsageTableName: &informationSchemaTable{
 				name:    KeyColumnUsageTableName,
@@ -1028,6 +1268,11 @@ func NewInformationSchemaDatabase() Database {
 				schema:  viewsSchema,
 				rowIter: viewRowIter,
 			},
+			ViewTableUsageTableName: &informationSchemaTable{
+				name:    ViewTableUsageTableName,
+				schema:  viewTableUsageSchema,
+				rowIter: viewTableUsageRowIter,
+			},
 			UserPrivilegesTableName: &informationSchemaTable{
 				name:    UserPrivilegesTableName,
 				schema:  userPrivilegesSchema,
@@ -1053,8 +1298,20 @@ func NewInformationSchemaDatabase() Database {
 				schema:  innoDBTempTableSchema,
 				rowIter: innoDBTempTableIter,
 			},
+			TablesExtensionsTableName: &informationSchemaTable{
+				name:    TablesExtensionsTableName,
+				schema:  tablesExtensionsSchema,
+				rowIter: tablesExtensionsRowIter,
+			},
+			StSpatialReferenceSystemsTableName: &informationSchemaTable{
+				name:    StSpatialReferenceSystemsTableName,
+				schema:  stSpatialReferenceSystemsSchema,
+				rowIter: stSpatialReferenceSystemsRowIter,
+			},
 		},
 	}
+	db.addTables(extensions)
+	return db
 }
 
 func viewRowIter(context *Context, catalog Catalog) (RowIter, error) {
@@ -1086,6 +1343,20 @@ func viewRowIter(context *Context, catalog Catalog) (RowIter, error) {
 	return RowsToRowIter(rows...), nil
 }
 
+func viewTableUsageRowIter(context *Context, catalog Catalog) (RowIter, error) {
+	dependencies, err := analyzer.ViewDependencies(context, catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []Row
+	for _, dep := range dependencies {
+		rows = append(rows, Row{"def", dep.ViewDatabase, dep.ViewName, "def", dep.TableDatabase, dep.TableName})
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
 // viewsInDatabase returns all views defined on the database given, consulting both the database itself as well as any
 // views defined in session memory. Typically there will not be both types of views on a single database, but the
 // interfaces do make it possible.
@@ -1166,7 +1437,69 @@ func (t *informationSchemaTable) PartitionRows(ctx *Context, partition Partition
 		return nil, fmt.Errorf("nil catalog for info schema table %s", t.name)
 	}
 
-	return t.rowIter(ctx, t.catalog)
+	iter, err := t.rowIter(ctx, t.catalog)
+	if err != nil {
+		return nil, err
+	}
+	return newStoredNamesRowIter(t.schema, iter), nil
+}
+
+// tableNameColumns are the columns of the information_schema tables that hold names of databases, tables and views.
+var tableNameColumns = map[string]bool{
+	"table_schema":             true,
+	"table_name":               true,
+	"schema_name":              true,
+	"constraint_schema":        true,
+	"unique_constraint_schema": true,
+	"referenced_table_schema":  true,
+	"referenced_table_name":    true,
+	"trigger_schema":           true,
+	"event_object_schema":      true,
+	"event_object_table":       true,
+	"event_schema":             true,
+	"routine_schema":           true,
+	"index_schema":             true,
+	"view_schema":              true,
+	"view_name":                true,
+}
+
+// storedNamesRowIter returns the names of databases, tables and views in the rows of an information_schema table as
+// they're stored, according to lower_case_table_names, for the tables that integrators created with other names.
+type storedNamesRowIter struct {
+	RowIter
+	columns []int
+}
+
+// newStoredNamesRowIter returns the iterator given, converting the names in the columns of the schema given that hold
+// names of databases, tables and views if they're stored in lower case.
+func newStoredNamesRowIter(schema Schema, iter RowIter) RowIter {
+	if LowerCaseTableNames() != LowerCaseTableNames_Lowered {
+		return iter
+	}
+	var columns []int
+	for i, col := range schema {
+		if tableNameColumns[strings.ToLower(col.Name)] {
+			columns = append(columns, i)
+		}
+	}
+	if len(columns) == 0 {
+		return iter
+	}
+	return &storedNamesRowIter{RowIter: iter, columns: columns}
+}
+
+// Next implements the sql.RowIter interface.
+func (i *storedNamesRowIter) Next(ctx *Context) (Row, error) {
+	row, err := i.RowIter.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, col := range i.columns {
+		if name, ok := row[col].(string); ok {
+			row[col] = StoredTableName(name)
+		}
+	}
+	return row, nil
 }
 
 // PartitionCount implements the sql.PartitionCounter interface.
@@ -1212,6 +1545,15 @@ func partitionKey(tableName string) []byte {
 	return []byte(InformationSchemaDatabaseName + "." + tableName)
 }
 
+// getTableOptions returns the table options of the table given, which are the defaults for tables that don't have
+// table options.
+func getTableOptions(ctx *Context, t Table) (TableOptions, error) {
+	if ot, ok := t.(TableOptionsTable); ok {
+		return ot.GetTableOptions(ctx)
+	}
+	return TableOptions{Collation: Collation_Default}, nil
+}
+
 func getAutoIncrementValue(ctx *Context, t Table) (val interface{}) {
 	for _, c := range t.Schema() {
 		if c.AutoIncrement {
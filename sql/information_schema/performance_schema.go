@@ -0,0 +1,251 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package information_schema
+
+import (
+	"hash/fnv"
+	"sort"
+	"time"
+
+	. "github.com/dolthub/go-mysql-server/sql"
+)
+
+const (
+	// PerformanceSchemaDatabaseName is the name of the performance schema database.
+	PerformanceSchemaDatabaseName = "performance_schema"
+	// EventsStagesCurrentTableName is the name of the events_stages_current table in the performance schema.
+	EventsStagesCurrentTableName = "events_stages_current"
+	// MetadataLocksTableName is the name of the metadata_locks table in the performance schema.
+	MetadataLocksTableName = "metadata_locks"
+	// EventsStatementsSummaryByDigestTableName is the name of the events_statements_summary_by_digest table in the
+	// performance schema.
+	EventsStatementsSummaryByDigestTableName = "events_statements_summary_by_digest"
+)
+
+var nestingEventType = MustCreateEnumType([]string{"TRANSACTION", "STATEMENT", "STAGE", "WAIT"}, Collation_Default)
+
+var eventsStagesCurrentSchema = Schema{
+	{Name: "THREAD_ID", Type: Uint64, Default: nil, Nullable: false, Source: EventsStagesCurrentTableName},
+	{Name: "EVENT_ID", Type: Uint64, Default: nil, Nullable: false, Source: EventsStagesCurrentTableName},
+	{Name: "END_EVENT_ID", Type: Uint64, Default: nil, Nullable: true, Source: EventsStagesCurrentTableName},
+	{Name: "EVENT_NAME", Type: LongText, Default: nil, Nullable: false, Source: EventsStagesCurrentTableName},
+	{Name: "SOURCE", Type: LongText, Default: nil, Nullable: true, Source: EventsStagesCurrentTableName},
+	{Name: "TIMER_START", Type: Uint64, Default: nil, Nullable: true, Source: EventsStagesCurrentTableName},
+	{Name: "TIMER_END", Type: Uint64, Default: nil, Nullable: true, Source: EventsStagesCurrentTableName},
+	{Name: "TIMER_WAIT", Type: Uint64, Default: nil, Nullable: true, Source: EventsStagesCurrentTableName},
+	{Name: "WORK_COMPLETED", Type: Uint64, Default: nil, Nullable: true, Source: EventsStagesCurrentTableName},
+	{Name: "WORK_ESTIMATED", Type: Uint64, Default: nil, Nullable: true, Source: EventsStagesCurrentTableName},
+	{Name: "NESTING_EVENT_ID", Type: Uint64, Default: nil, Nullable: true, Source: EventsStagesCurrentTableName},
+	{Name: "NESTING_EVENT_TYPE", Type: nestingEventType, Default: nil, Nullable: true, Source: EventsStagesCurrentTableName},
+}
+
+var metadataLocksSchema = Schema{
+	{Name: "OBJECT_TYPE", Type: LongText, Default: nil, Nullable: false, Source: MetadataLocksTableName},
+	{Name: "OBJECT_SCHEMA", Type: LongText, Default: nil, Nullable: true, Source: MetadataLocksTableName},
+	{Name: "OBJECT_NAME", Type: LongText, Default: nil, Nullable: true, Source: MetadataLocksTableName},
+	{Name: "COLUMN_NAME", Type: LongText, Default: nil, Nullable: true, Source: MetadataLocksTableName},
+	{Name: "OBJECT_INSTANCE_BEGIN", Type: Uint64, Default: nil, Nullable: false, Source: MetadataLocksTableName},
+	{Name: "LOCK_TYPE", Type: LongText, Default: nil, Nullable: false, Source: MetadataLocksTableName},
+	{Name: "LOCK_DURATION", Type: LongText, Default: nil, Nullable: false, Source: MetadataLocksTableName},
+	{Name: "LOCK_STATUS", Type: LongText, Default: nil, Nullable: false, Source: MetadataLocksTableName},
+	{Name: "SOURCE", Type: LongText, Default: nil, Nullable: true, Source: MetadataLocksTableName},
+	{Name: "OWNER_THREAD_ID", Type: Uint64, Default: nil, Nullable: true, Source: MetadataLocksTableName},
+	{Name: "OWNER_EVENT_ID", Type: Uint64, Default: nil, Nullable: true, Source: MetadataLocksTableName},
+}
+
+var eventsStatementsSummaryByDigestSchema = Schema{
+	{Name: "SCHEMA_NAME", Type: LongText, Default: nil, Nullable: true, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "DIGEST", Type: LongText, Default: nil, Nullable: true, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "DIGEST_TEXT", Type: LongText, Default: nil, Nullable: true, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "COUNT_STAR", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "SUM_TIMER_WAIT", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "MIN_TIMER_WAIT", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "AVG_TIMER_WAIT", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "MAX_TIMER_WAIT", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "SUM_LOCK_TIME", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "SUM_ERRORS", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "SUM_WARNINGS", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "SUM_ROWS_AFFECTED", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "SUM_ROWS_SENT", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "SUM_ROWS_EXAMINED", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "SUM_CREATED_TMP_DISK_TABLES", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "SUM_CREATED_TMP_TABLES", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "SUM_SORT_MERGE_PASSES", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "SUM_SORT_ROWS", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "SUM_NO_INDEX_USED", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "SUM_NO_GOOD_INDEX_USED", Type: Uint64, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "FIRST_SEEN", Type: Datetime, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+	{Name: "LAST_SEEN", Type: Datetime, Default: nil, Nullable: false, Source: EventsStatementsSummaryByDigestTableName},
+}
+
+// NewPerformanceSchemaDatabase creates a new PERFORMANCE_SCHEMA Database. The events_stages_current table is populated
+// from the stages reported with Context.ReportStageProgress by the processes of the process list, and the
+// metadata_locks table from the named locks of the catalog, if it's a NamedLockCatalog. The
+// events_statements_summary_by_digest table summarizes the statements recorded in the statement statistics of the
+// catalog, if it's a StatementStatisticsCatalog.
+func NewPerformanceSchemaDatabase() Database {
+	return &informationSchemaDatabase{
+		name: PerformanceSchemaDatabaseName,
+		tables: map[string]Table{
+			EventsStagesCurrentTableName: &informationSchemaTable{
+				name:    EventsStagesCurrentTableName,
+				schema:  eventsStagesCurrentSchema,
+				rowIter: eventsStagesCurrentRowIter,
+			},
+			MetadataLocksTableName: &informationSchemaTable{
+				name:    MetadataLocksTableName,
+				schema:  metadataLocksSchema,
+				rowIter: metadataLocksRowIter,
+			},
+			EventsStatementsSummaryByDigestTableName: &informationSchemaTable{
+				name:    EventsStatementsSummaryByDigestTableName,
+				schema:  eventsStatementsSummaryByDigestSchema,
+				rowIter: eventsStatementsSummaryByDigestRowIter,
+			},
+		},
+	}
+}
+
+// eventsStagesCurrentRowIter returns a row for every process that is in a stage. Timers are in picoseconds, and only
+// the time spent in the stage is known, so TIMER_START and TIMER_END are NULL.
+func eventsStagesCurrentRowIter(ctx *Context, _ Catalog) (RowIter, error) {
+	processes := ctx.ProcessList.Processes()
+	sort.Slice(processes, func(i, j int) bool {
+		return processes[i].Pid < processes[j].Pid
+	})
+
+	var rows []Row
+	for _, proc := range processes {
+		stage := proc.Stage
+		if stage.Name == "" {
+			continue
+		}
+
+		var estimated interface{}
+		if stage.Total >= 0 {
+			estimated = uint64(stage.Total)
+		}
+		wait := uint64(time.Since(stage.StartedAt).Nanoseconds()) * 1000
+		rows = append(rows, Row{
+			uint64(proc.Connection), // thread_id
+			proc.Pid,                // event_id
+			nil,                     // end_event_id
+			stage.Name,              // event_name
+			nil,                     // source
+			nil,                     // timer_start
+			nil,                     // timer_end
+			wait,                    // timer_wait
+			uint64(stage.Done),      // work_completed
+			estimated,               // work_estimated
+			proc.Pid,                // nesting_event_id
+			"STATEMENT",             // nesting_event_type
+		})
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
+// metadataLocksRowIter returns a row for every named lock that is owned. The engine doesn't lock the metadata of
+// tables, so user level locks are the only ones reported. OBJECT_INSTANCE_BEGIN stands in for the address of the lock
+// in MySQL, and is a hash of its name.
+func metadataLocksRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	nlc, ok := c.(NamedLockCatalog)
+	if !ok || nlc.NamedLocks() == nil {
+		return RowsToRowIter(), nil
+	}
+
+	var rows []Row
+	for _, lock := range nlc.NamedLocks().Locks() {
+		h := fnv.New64a()
+		h.Write([]byte(lock.Name))
+		rows = append(rows, Row{
+			"USER LEVEL LOCK",  // object_type
+			nil,                // object_schema
+			lock.Name,          // object_name
+			nil,                // column_name
+			h.Sum64(),          // object_instance_begin
+			"EXCLUSIVE",        // lock_type
+			"EXPLICIT",         // lock_duration
+			"GRANTED",          // lock_status
+			nil,                // source
+			uint64(lock.Owner), // owner_thread_id
+			nil,                // owner_event_id
+		})
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
+// statementDigests returns the statement digests recorded in the statement statistics of the catalog given, ordered by
+// database and digest.
+func statementDigests(c Catalog) []StatementDigest {
+	sc, ok := c.(StatementStatisticsCatalog)
+	if !ok || sc.Statements() == nil {
+		return nil
+	}
+
+	digests := sc.Statements().Digests()
+	sort.Slice(digests, func(i, j int) bool {
+		if digests[i].Database != digests[j].Database {
+			return digests[i].Database < digests[j].Database
+		}
+		return digests[i].Digest < digests[j].Digest
+	})
+	return digests
+}
+
+// picoseconds returns the duration given in picoseconds, the unit of the timers of the performance schema.
+func picoseconds(d time.Duration) uint64 {
+	return uint64(d.Nanoseconds()) * 1000
+}
+
+// eventsStatementsSummaryByDigestRowIter returns a row for every statement digest recorded. The engine doesn't count
+// the rows statements examine or sort, the temporary tables they create or the time they wait for locks, so those are
+// 0.
+func eventsStatementsSummaryByDigestRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	var rows []Row
+	for _, d := range statementDigests(c) {
+		var schemaName interface{}
+		if d.Database != "" {
+			schemaName = d.Database
+		}
+		rows = append(rows, Row{
+			schemaName,                            // schema_name
+			d.Digest,                              // digest
+			d.DigestText,                          // digest_text
+			d.Count,                               // count_star
+			picoseconds(d.TotalLatency),           // sum_timer_wait
+			picoseconds(d.MinLatency),             // min_timer_wait
+			picoseconds(d.TotalLatency) / d.Count, // avg_timer_wait
+			picoseconds(d.MaxLatency),             // max_timer_wait
+			uint64(0),                             // sum_lock_time
+			d.Errors,                              // sum_errors
+			d.Warnings,                            // sum_warnings
+			d.RowsAffected,                        // sum_rows_affected
+			d.RowsSent,                            // sum_rows_sent
+			uint64(0),                             // sum_rows_examined
+			uint64(0),                             // sum_created_tmp_disk_tables
+			uint64(0),                             // sum_created_tmp_tables
+			uint64(0),                             // sum_sort_merge_passes
+			uint64(0),                             // sum_sort_rows
+			d.NoIndexUsed,                         // sum_no_index_used
+			uint64(0),                             // sum_no_good_index_used
+			d.FirstSeen,                           // first_seen
+			d.LastSeen,                            // last_seen
+		})
+	}
+
+	return RowsToRowIter(rows...), nil
+}
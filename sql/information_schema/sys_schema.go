@@ -0,0 +1,274 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package information_schema
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	. "github.com/dolthub/go-mysql-server/sql"
+)
+
+const (
+	// SysSchemaDatabaseName is the name of the sys schema database.
+	SysSchemaDatabaseName = "sys"
+	// SchemaTableStatisticsTableName is the name of the schema_table_statistics view in the sys schema.
+	SchemaTableStatisticsTableName = "schema_table_statistics"
+	// StatementAnalysisTableName is the name of the statement_analysis view in the sys schema.
+	StatementAnalysisTableName = "statement_analysis"
+)
+
+// statementTruncateLength is the length statements are truncated to in the views of the sys schema, which is the
+// default of its statement_truncate_len option.
+const statementTruncateLength = 64
+
+var schemaTableStatisticsSchema = Schema{
+	{Name: "table_schema", Type: LongText, Default: nil, Nullable: true, Source: SchemaTableStatisticsTableName},
+	{Name: "table_name", Type: LongText, Default: nil, Nullable: true, Source: SchemaTableStatisticsTableName},
+	{Name: "total_latency", Type: LongText, Default: nil, Nullable: true, Source: SchemaTableStatisticsTableName},
+	{Name: "rows_fetched", Type: Uint64, Default: nil, Nullable: false, Source: SchemaTableStatisticsTableName},
+	{Name: "fetch_latency", Type: LongText, Default: nil, Nullable: true, Source: SchemaTableStatisticsTableName},
+	{Name: "rows_inserted", Type: Uint64, Default: nil, Nullable: false, Source: SchemaTableStatisticsTableName},
+	{Name: "insert_latency", Type: LongText, Default: nil, Nullable: true, Source: SchemaTableStatisticsTableName},
+	{Name: "rows_updated", Type: Uint64, Default: nil, Nullable: false, Source: SchemaTableStatisticsTableName},
+	{Name: "update_latency", Type: LongText, Default: nil, Nullable: true, Source: SchemaTableStatisticsTableName},
+	{Name: "rows_deleted", Type: Uint64, Default: nil, Nullable: false, Source: SchemaTableStatisticsTableName},
+	{Name: "delete_latency", Type: LongText, Default: nil, Nullable: true, Source: SchemaTableStatisticsTableName},
+	{Name: "io_read_requests", Type: Uint64, Default: nil, Nullable: true, Source: SchemaTableStatisticsTableName},
+	{Name: "io_read", Type: LongText, Default: nil, Nullable: true, Source: SchemaTableStatisticsTableName},
+	{Name: "io_read_latency", Type: LongText, Default: nil, Nullable: true, Source: SchemaTableStatisticsTableName},
+	{Name: "io_write_requests", Type: Uint64, Default: nil, Nullable: true, Source: SchemaTableStatisticsTableName},
+	{Name: "io_write", Type: LongText, Default: nil, Nullable: true, Source: SchemaTableStatisticsTableName},
+	{Name: "io_write_latency", Type: LongText, Default: nil, Nullable: true, Source: SchemaTableStatisticsTableName},
+	{Name: "io_misc_requests", Type: Uint64, Default: nil, Nullable: true, Source: SchemaTableStatisticsTableName},
+	{Name: "io_misc_latency", Type: LongText, Default: nil, Nullable: true, Source: SchemaTableStatisticsTableName},
+}
+
+var statementAnalysisSchema = Schema{
+	{Name: "query", Type: LongText, Default: nil, Nullable: true, Source: StatementAnalysisTableName},
+	{Name: "db", Type: LongText, Default: nil, Nullable: true, Source: StatementAnalysisTableName},
+	{Name: "full_scan", Type: LongText, Default: nil, Nullable: false, Source: StatementAnalysisTableName},
+	{Name: "exec_count", Type: Uint64, Default: nil, Nullable: false, Source: StatementAnalysisTableName},
+	{Name: "err_count", Type: Uint64, Default: nil, Nullable: false, Source: StatementAnalysisTableName},
+	{Name: "warn_count", Type: Uint64, Default: nil, Nullable: false, Source: StatementAnalysisTableName},
+	{Name: "total_latency", Type: LongText, Default: nil, Nullable: true, Source: StatementAnalysisTableName},
+	{Name: "max_latency", Type: LongText, Default: nil, Nullable: true, Source: StatementAnalysisTableName},
+	{Name: "avg_latency", Type: LongText, Default: nil, Nullable: true, Source: StatementAnalysisTableName},
+	{Name: "lock_latency", Type: LongText, Default: nil, Nullable: true, Source: StatementAnalysisTableName},
+	{Name: "rows_sent", Type: Uint64, Default: nil, Nullable: false, Source: StatementAnalysisTableName},
+	{Name: "rows_sent_avg", Type: Uint64, Default: nil, Nullable: false, Source: StatementAnalysisTableName},
+	{Name: "rows_examined", Type: Uint64, Default: nil, Nullable: false, Source: StatementAnalysisTableName},
+	{Name: "rows_examined_avg", Type: Uint64, Default: nil, Nullable: false, Source: StatementAnalysisTableName},
+	{Name: "rows_affected", Type: Uint64, Default: nil, Nullable: false, Source: StatementAnalysisTableName},
+	{Name: "rows_affected_avg", Type: Uint64, Default: nil, Nullable: false, Source: StatementAnalysisTableName},
+	{Name: "tmp_tables", Type: Uint64, Default: nil, Nullable: false, Source: StatementAnalysisTableName},
+	{Name: "tmp_disk_tables", Type: Uint64, Default: nil, Nullable: false, Source: StatementAnalysisTableName},
+	{Name: "rows_sorted", Type: Uint64, Default: nil, Nullable: false, Source: StatementAnalysisTableName},
+	{Name: "sort_merge_passes", Type: Uint64, Default: nil, Nullable: false, Source: StatementAnalysisTableName},
+	{Name: "digest", Type: LongText, Default: nil, Nullable: true, Source: StatementAnalysisTableName},
+	{Name: "first_seen", Type: Datetime, Default: nil, Nullable: false, Source: StatementAnalysisTableName},
+	{Name: "last_seen", Type: Datetime, Default: nil, Nullable: false, Source: StatementAnalysisTableName},
+}
+
+// NewSysSchemaDatabase creates a new SYS Database with the views of the sys schema that summarize the statistics of the
+// performance schema in a readable format. The schema_table_statistics view lists the tables of every database of the
+// catalog with the rows inserted, updated and deleted in them, and the statement_analysis view the statements
+// recorded, both from the statement statistics of the catalog, if it's a StatementStatisticsCatalog.
+func NewSysSchemaDatabase() Database {
+	return &informationSchemaDatabase{
+		name: SysSchemaDatabaseName,
+		tables: map[string]Table{
+			SchemaTableStatisticsTableName: &informationSchemaTable{
+				name:    SchemaTableStatisticsTableName,
+				schema:  schemaTableStatisticsSchema,
+				rowIter: schemaTableStatisticsRowIter,
+			},
+			StatementAnalysisTableName: &informationSchemaTable{
+				name:    StatementAnalysisTableName,
+				schema:  statementAnalysisSchema,
+				rowIter: statementAnalysisRowIter,
+			},
+		},
+	}
+}
+
+// isSystemSchema returns whether the database with the name given is one of the schemas describing the server rather
+// than holding data, whose tables aren't listed by the sys schema.
+func isSystemSchema(name string) bool {
+	switch strings.ToLower(name) {
+	case InformationSchemaDatabaseName, PerformanceSchemaDatabaseName, SysSchemaDatabaseName, "mysql":
+		return true
+	default:
+		return false
+	}
+}
+
+// schemaTableStatisticsRowIter returns a row for every table of the databases of the catalog, ordered by database and
+// table name. The engine doesn't time the reads and writes of tables, or count the rows read from them, so those are
+// 0.
+func schemaTableStatisticsRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	var statements *StatementStatistics
+	if sc, ok := c.(StatementStatisticsCatalog); ok {
+		statements = sc.Statements()
+	}
+
+	dbs := c.AllDatabases()
+	sort.Slice(dbs, func(i, j int) bool {
+		return dbs[i].Name() < dbs[j].Name()
+	})
+
+	var rows []Row
+	for _, db := range dbs {
+		if isSystemSchema(db.Name()) {
+			continue
+		}
+
+		names, err := db.GetTableNames(ctx)
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			ops := TableRowOperations{Database: db.Name(), Table: name}
+			if statements != nil {
+				ops = statements.TableRows(db.Name(), name)
+			}
+			rows = append(rows, Row{
+				db.Name(),         // table_schema
+				name,              // table_name
+				formatPicoTime(0), // total_latency
+				uint64(0),         // rows_fetched
+				formatPicoTime(0), // fetch_latency
+				ops.Inserted,      // rows_inserted
+				formatPicoTime(0), // insert_latency
+				ops.Updated,       // rows_updated
+				formatPicoTime(0), // update_latency
+				ops.Deleted,       // rows_deleted
+				formatPicoTime(0), // delete_latency
+				uint64(0),         // io_read_requests
+				formatBytes(0),    // io_read
+				formatPicoTime(0), // io_read_latency
+				uint64(0),         // io_write_requests
+				formatBytes(0),    // io_write
+				formatPicoTime(0), // io_write_latency
+				uint64(0),         // io_misc_requests
+				formatPicoTime(0), // io_misc_latency
+			})
+		}
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
+// statementAnalysisRowIter returns a row for every statement digest recorded, ordered by their total latency,
+// highest first.
+func statementAnalysisRowIter(ctx *Context, c Catalog) (RowIter, error) {
+	digests := statementDigests(c)
+	sort.SliceStable(digests, func(i, j int) bool {
+		return digests[i].TotalLatency > digests[j].TotalLatency
+	})
+
+	var rows []Row
+	for _, d := range digests {
+		var db interface{}
+		if d.Database != "" {
+			db = d.Database
+		}
+		fullScan := ""
+		if d.NoIndexUsed > 0 {
+			fullScan = "*"
+		}
+		rows = append(rows, Row{
+			formatStatement(d.DigestText), // query
+			db,                            // db
+			fullScan,                      // full_scan
+			d.Count,                       // exec_count
+			d.Errors,                      // err_count
+			d.Warnings,                    // warn_count
+			formatPicoTime(picoseconds(d.TotalLatency)),           // total_latency
+			formatPicoTime(picoseconds(d.MaxLatency)),             // max_latency
+			formatPicoTime(picoseconds(d.TotalLatency) / d.Count), // avg_latency
+			formatPicoTime(0),                       // lock_latency
+			d.RowsSent,                              // rows_sent
+			roundedAverage(d.RowsSent, d.Count),     // rows_sent_avg
+			uint64(0),                               // rows_examined
+			uint64(0),                               // rows_examined_avg
+			d.RowsAffected,                          // rows_affected
+			roundedAverage(d.RowsAffected, d.Count), // rows_affected_avg
+			uint64(0),                               // tmp_tables
+			uint64(0),                               // tmp_disk_tables
+			uint64(0),                               // rows_sorted
+			uint64(0),                               // sort_merge_passes
+			d.Digest,                                // digest
+			d.FirstSeen,                             // first_seen
+			d.LastSeen,                              // last_seen
+		})
+	}
+
+	return RowsToRowIter(rows...), nil
+}
+
+func roundedAverage(sum, count uint64) uint64 {
+	return uint64(math.Round(float64(sum) / float64(count)))
+}
+
+// formatStatement returns the statement given as the format_statement function of the sys schema does, truncating
+// statements longer than statementTruncateLength by replacing their middle with an ellipsis.
+func formatStatement(s string) string {
+	if len(s) <= statementTruncateLength {
+		return s
+	}
+	keep := statementTruncateLength/2 - 2
+	return s[:keep] + " ... " + s[len(s)-keep:]
+}
+
+// formatPicoTime returns the number of picoseconds given in the readable format of the FORMAT_PICO_TIME function.
+func formatPicoTime(ps uint64) string {
+	units := []struct {
+		size float64
+		name string
+	}{
+		{86400e12, "d"},
+		{3600e12, "h"},
+		{60e12, "min"},
+		{1e12, "s"},
+		{1e9, "ms"},
+		{1e6, "us"},
+		{1e3, "ns"},
+	}
+	for _, unit := range units {
+		if float64(ps) >= unit.size {
+			return fmt.Sprintf("%.2f %s", float64(ps)/unit.size, unit.name)
+		}
+	}
+	return fmt.Sprintf("%d ps", ps)
+}
+
+// formatBytes returns the number of bytes given in the readable format of the FORMAT_BYTES function.
+func formatBytes(b uint64) string {
+	units := []string{"KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+	if b < 1024 {
+		return fmt.Sprintf("%d bytes", b)
+	}
+	size := float64(b) / 1024
+	i := 0
+	for size >= 1024 && i < len(units)-1 {
+		size /= 1024
+		i++
+	}
+	return fmt.Sprintf("%.2f %s", size, units[i])
+}
@@ -28,9 +28,15 @@ type Linestring struct {
 	Points []Point
 }
 
-type LinestringType struct{}
+// LinestringType is the type of a column of linestrings. The column may be restricted to the linestrings of a single spatial reference
+// system, with the SRID attribute.
+type LinestringType struct {
+	SRID        uint32
+	DefinedSRID bool
+}
 
 var _ Type = LinestringType{}
+var _ SpatialColumnType = LinestringType{}
 
 var ErrNotLinestring = errors.NewKind("value of type %T is not a linestring")
 
@@ -86,8 +92,22 @@ func (t LinestringType) Compare(a interface{}, b interface{}) (int, error) {
 
 // Convert implements Type interface.
 func (t LinestringType) Convert(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	// Bytes are a geometry in the internal format, like clients send
+	if g, ok, err := geometryFromBytes(v); err != nil {
+		return nil, err
+	} else if ok {
+		v = g
+	}
+
 	// Must be a Linestring, fail otherwise
 	if v, ok := v.(Linestring); ok {
+		if err := matchSRID(v, t.SRID, t.DefinedSRID); err != nil {
+			return nil, err
+		}
 		return v, nil
 	}
 
@@ -107,10 +127,11 @@ func (t LinestringType) SQL(v interface{}) (sqltypes.Value, error) {
 
 	pv, err := t.Convert(v)
 	if err != nil {
-		return sqltypes.Value{}, nil
+		return sqltypes.Value{}, err
 	}
 
-	return sqltypes.MakeTrusted(sqltypes.Geometry, []byte(pv.(string))), nil
+	buf, _ := SerializeGeometry(pv)
+	return sqltypes.MakeTrusted(sqltypes.Geometry, buf), nil
 }
 
 // String implements Type interface.
@@ -118,6 +139,18 @@ func (t LinestringType) String() string {
 	return "LINESTRING"
 }
 
+// GetSpatialTypeSRID implements SpatialColumnType interface.
+func (t LinestringType) GetSpatialTypeSRID() (uint32, bool) {
+	return t.SRID, t.DefinedSRID
+}
+
+// SetSRID implements SpatialColumnType interface.
+func (t LinestringType) SetSRID(srid uint32) Type {
+	t.SRID = srid
+	t.DefinedSRID = true
+	return t
+}
+
 // Type implements Type interface.
 func (t LinestringType) Type() query.Type {
 	return sqltypes.Geometry
@@ -125,5 +158,5 @@ func (t LinestringType) Type() query.Type {
 
 // Zero implements Type interface.
 func (t LinestringType) Zero() interface{} {
-	return Linestring{Points: []Point{{}, {}}}
+	return Linestring{SRID: t.SRID, Points: []Point{{SRID: t.SRID}, {SRID: t.SRID}}}
 }
@@ -0,0 +1,81 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"io"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrLobValueSize is returned when a LobValue doesn't have as many bytes as its size says.
+var ErrLobValueSize = errors.NewKind("large object value has a size of %d bytes but %d bytes could be read")
+
+// LobValue is a large TEXT or BLOB value whose contents are read on demand, rather than being held in memory with the
+// rest of its row. Tables may return LobValues in the rows of their TEXT and BLOB columns, so that large values are only
+// read when they're needed: the server streams them to the client in chunks, without holding them in memory whole,
+// and expressions that need their contents read them into a string when they're converted to their column's type.
+type LobValue interface {
+	// Size returns the size of the value in bytes.
+	Size() int64
+	// NewReader returns a reader over the contents of the value, from its start. The reader is closed once read.
+	NewReader() (io.ReadCloser, error)
+}
+
+type lobValue struct {
+	size int64
+	open func() (io.ReadCloser, error)
+}
+
+var _ LobValue = lobValue{}
+
+// NewLobValue returns a LobValue of the size given, whose readers are returned by |open|.
+func NewLobValue(size int64, open func() (io.ReadCloser, error)) LobValue {
+	return lobValue{size: size, open: open}
+}
+
+// Size implements the LobValue interface.
+func (l lobValue) Size() int64 {
+	return l.size
+}
+
+// NewReader implements the LobValue interface.
+func (l lobValue) NewReader() (io.ReadCloser, error) {
+	return l.open()
+}
+
+// String implements the fmt.Stringer interface. The contents of the value aren't read.
+func (l lobValue) String() string {
+	return fmt.Sprintf("LOB(%d bytes)", l.size)
+}
+
+// ReadLobValue reads the whole contents of the LobValue given into a buffer of its size.
+func ReadLobValue(v LobValue) ([]byte, error) {
+	r, err := v.NewReader()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	buf := make([]byte, v.Size())
+	n, err := io.ReadFull(r, buf)
+	if err == io.ErrUnexpectedEOF || err == io.EOF {
+		return nil, ErrLobValueSize.New(v.Size(), n)
+	} else if err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
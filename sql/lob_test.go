@@ -0,0 +1,116 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testLobValue returns a LobValue of the contents given, with a size of |size| bytes, counting the readers opened.
+func testLobValue(contents string, size int64, opened *int) LobValue {
+	return NewLobValue(size, func() (io.ReadCloser, error) {
+		*opened++
+		return ioutil.NopCloser(strings.NewReader(contents)), nil
+	})
+}
+
+func TestReadLobValue(t *testing.T) {
+	var opened int
+	b, err := ReadLobValue(testLobValue("abcdef", 6, &opened))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("abcdef"), b)
+	assert.Equal(t, 1, opened)
+
+	b, err = ReadLobValue(testLobValue("", 0, &opened))
+	require.NoError(t, err)
+	assert.Equal(t, []byte{}, b)
+
+	_, err = ReadLobValue(testLobValue("abc", 6, &opened))
+	assert.True(t, ErrLobValueSize.Is(err))
+}
+
+func TestLobValueConvert(t *testing.T) {
+	var opened int
+	val, err := LongText.Convert(testLobValue("abcdef", 6, &opened))
+	require.NoError(t, err)
+	assert.Equal(t, "abcdef", val)
+
+	val, err = MustCreateStringWithDefaults(sqltypes.VarChar, 3).Convert(testLobValue("日本語", 9, &opened))
+	require.NoError(t, err)
+	assert.Equal(t, "日本語", val)
+	assert.Equal(t, 2, opened)
+
+	// values too large for their type aren't read
+	_, err = TinyBlob.Convert(testLobValue("", tinyTextBlobMax+1, &opened))
+	assert.True(t, ErrLengthBeyondLimit.Is(err))
+	assert.Equal(t, 2, opened)
+}
+
+func TestLobValueSQL(t *testing.T) {
+	var opened int
+	val, err := LongBlob.SQL(testLobValue("abcdef", 6, &opened))
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTrusted(sqltypes.Blob, []byte("abcdef")), val)
+
+	val, err = LongText.SQL(testLobValue("äöü", 6, &opened))
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTrusted(sqltypes.Text, []byte("äöü")), val)
+	assert.Equal(t, 2, opened)
+
+	_, err = TinyText.SQL(testLobValue("", tinyTextBlobMax+1, &opened))
+	assert.True(t, ErrLengthBeyondLimit.Is(err))
+	assert.Equal(t, 2, opened)
+}
+
+func TestHashLobValue(t *testing.T) {
+	var opened int
+	large := strings.Repeat("0123456789", 100)
+
+	// LobValues are hashed by their contents, the same as the strings they're converted to
+	for _, contents := range []string{"abc", large} {
+		lob := testLobValue(contents, int64(len(contents)), &opened)
+		hash, key, err := HashKeyOf(NewRow(int64(1), lob))
+		require.NoError(t, err)
+		expectedHash, expectedKey, err := HashKeyOf(NewRow(int64(1), contents))
+		require.NoError(t, err)
+		assert.Equal(t, expectedHash, hash)
+		assert.Equal(t, expectedKey, key)
+
+		hash, err = HashOf(NewRow(lob))
+		require.NoError(t, err)
+		expectedHash, err = HashOf(NewRow(contents))
+		require.NoError(t, err)
+		assert.Equal(t, expectedHash, hash)
+	}
+	assert.Equal(t, 4, opened)
+
+	hash, key, err := HashKeyOf(NewRow(testLobValue(large+"x", int64(len(large)+1), &opened)))
+	require.NoError(t, err)
+	otherHash, otherKey, err := HashKeyOf(NewRow(testLobValue(large+"y", int64(len(large)+1), &opened)))
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, otherHash)
+	assert.NotEqual(t, key, otherKey)
+
+	_, _, err = HashKeyOf(NewRow(testLobValue("abc", 6, &opened)))
+	assert.True(t, ErrLobValueSize.Is(err))
+}
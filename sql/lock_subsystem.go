@@ -15,6 +15,7 @@
 package sql
 
 import (
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -71,7 +72,7 @@ func (ls *LockSubsystem) createLock(name string) **ownedLock {
 }
 
 // Lock attempts to acquire a lock with a given name for the Id associated with the given ctx.Session within the given
-// timeout
+// timeout. Waiting for the lock stops with the error of the context if it's canceled, such as when the query is killed.
 func (ls *LockSubsystem) Lock(ctx *Context, name string, timeout time.Duration) error {
 	nl := ls.getNamedLock(name)
 
@@ -97,6 +98,12 @@ func (ls *LockSubsystem) Lock(ctx *Context, name string, timeout time.Duration)
 			}
 		}
 
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
 		time.Sleep(100 * time.Microsecond)
 	}
 
@@ -197,3 +204,38 @@ func (ls *LockSubsystem) GetLockState(name string) (state LockState, owner uint3
 		return LockInUse, uint32(currLock.Owner)
 	}
 }
+
+// NamedLock is a lock that is currently owned, as returned by LockSubsystem.Locks.
+type NamedLock struct {
+	Name string
+	// Owner is the ID of the session that owns the lock.
+	Owner uint32
+	// Count is the number of times the owner acquired the lock without releasing it.
+	Count int64
+}
+
+// Locks returns the locks that are currently owned, sorted by name.
+func (ls *LockSubsystem) Locks() []NamedLock {
+	ls.lockLock.RLock()
+	defer ls.lockLock.RUnlock()
+
+	var locks []NamedLock
+	for name, nl := range ls.locks {
+		dest := (*unsafe.Pointer)(unsafe.Pointer(nl))
+		currLock := *(*ownedLock)(atomic.LoadPointer(dest))
+		if currLock.Owner != 0 {
+			locks = append(locks, NamedLock{Name: name, Owner: uint32(currLock.Owner), Count: currLock.Count})
+		}
+	}
+
+	sort.Slice(locks, func(i, j int) bool {
+		return locks[i].Name < locks[j].Name
+	})
+	return locks
+}
+
+// NamedLockCatalog is implemented by catalogs that keep the LockSubsystem of the named locks of the engine, which are
+// listed by the performance_schema.metadata_locks table.
+type NamedLockCatalog interface {
+	NamedLocks() *LockSubsystem
+}
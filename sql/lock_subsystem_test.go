@@ -15,6 +15,7 @@
 package sql
 
 import (
+	"context"
 	"math/rand"
 	"sync"
 	"testing"
@@ -151,6 +152,24 @@ func TestTimeout(t *testing.T) {
 	assert.Nil(t, getLockDiffs(user2))
 }
 
+func TestLockCanceled(t *testing.T) {
+	ls := NewLockSubsystem()
+	user1 := NewEmptyContext()
+	err := ls.Lock(user1, testLockName, 0)
+	assert.NoError(t, err)
+
+	cancelCtx, cancel := context.WithCancel(context.Background())
+	user2 := NewContext(cancelCtx)
+	go func() {
+		time.Sleep(time.Millisecond)
+		cancel()
+	}()
+
+	err = ls.Lock(user2, testLockName, -1)
+	assert.Equal(t, context.Canceled, err)
+	assert.Nil(t, getLockDiffs(user2))
+}
+
 func TestErrLockNotOwned(t *testing.T) {
 	user1 := NewEmptyContext()
 	user2 := NewEmptyContext()
@@ -187,3 +206,25 @@ func TestGetLockState(t *testing.T) {
 	assert.Equal(t, LockFree, state)
 	assert.Equal(t, uint32(0), owner)
 }
+
+func TestLocks(t *testing.T) {
+	user1 := NewEmptyContext()
+	user2 := NewEmptyContext()
+	ls := NewLockSubsystem()
+
+	assert.NoError(t, ls.Lock(user1, "b", 0))
+	assert.NoError(t, ls.Lock(user1, "b", 0))
+	assert.NoError(t, ls.Lock(user2, "a", 0))
+	assert.NoError(t, ls.Lock(user2, "c", 0))
+	assert.NoError(t, ls.Unlock(user2, "c"))
+
+	assert.Equal(t, []NamedLock{
+		{Name: "a", Owner: user2.Session.ID(), Count: 1},
+		{Name: "b", Owner: user1.Session.ID(), Count: 2},
+	}, ls.Locks())
+
+	released, err := ls.ReleaseAll(user1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, released)
+	assert.Equal(t, []NamedLock{{Name: "a", Owner: user2.Session.ID(), Count: 1}}, ls.Locks())
+}
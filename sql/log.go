@@ -0,0 +1,190 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"io/ioutil"
+	"strings"
+	"sync/atomic"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	"github.com/sirupsen/logrus"
+)
+
+// LogLevel is the severity of a log entry.
+type LogLevel uint8
+
+const (
+	// LogLevelError is the level of errors.
+	LogLevelError LogLevel = iota
+	// LogLevelWarn is the level of unexpected events that aren't errors.
+	LogLevelWarn
+	// LogLevelInfo is the level of general information about the server, such as its connections.
+	LogLevelInfo
+	// LogLevelDebug is the level of information about each query.
+	LogLevelDebug
+	// LogLevelTrace is the level of detailed information about the execution of each query.
+	LogLevelTrace
+)
+
+// logLevelSystemVariable is the system variable that sets the level of the engine's logger at runtime.
+const logLevelSystemVariable = "log_level"
+
+// logLevelNames are the names of the log levels, which are the values of the log_level system variable.
+var logLevelNames = []string{"error", "warn", "info", "debug", "trace"}
+
+// String returns the name of the log level.
+func (l LogLevel) String() string {
+	if int(l) < len(logLevelNames) {
+		return logLevelNames[l]
+	}
+	return "unknown"
+}
+
+// Logger is a structured logger that the engine and server can log to, to integrate them with logging libraries other
+// than logrus, which they use internally. Install one with UseLogger.
+type Logger interface {
+	// Log logs the message given at the level given. The fields of the entry, such as the connection and query it's
+	// about, must not be modified or retained.
+	Log(level LogLevel, msg string, fields map[string]interface{})
+}
+
+// LoggerFunc is a function that implements the Logger interface.
+type LoggerFunc func(level LogLevel, msg string, fields map[string]interface{})
+
+var _ Logger = LoggerFunc(nil)
+
+// Log implements the Logger interface.
+func (f LoggerFunc) Log(level LogLevel, msg string, fields map[string]interface{}) {
+	f(level, msg, fields)
+}
+
+// UseLogger sets the logger of the engine to forward its entries to the Logger given, at and above the level set by
+// the log_level system variable.
+func UseLogger(l Logger) {
+	SetLogger(NewLogrusLogger(l))
+}
+
+// NewLogrusLogger returns a logrus logger that forwards its entries to the Logger given, at and above the level set by
+// the log_level system variable, rather than writing them.
+func NewLogrusLogger(l Logger) *logrus.Logger {
+	ll := logrus.New()
+	ll.Out = ioutil.Discard
+	ll.Formatter = discardFormatter{}
+	ll.Hooks.Add(loggerHook{l})
+	if _, val, ok := SystemVariables.GetGlobal(logLevelSystemVariable); ok {
+		if level, ok := val.(string); ok {
+			setLogrusLevel(ll, level)
+		}
+	}
+	return ll
+}
+
+// loggerHook is a logrus hook that forwards the entries logged to a Logger.
+type loggerHook struct {
+	logger Logger
+}
+
+var _ logrus.Hook = loggerHook{}
+
+// Levels implements the logrus.Hook interface.
+func (h loggerHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire implements the logrus.Hook interface.
+func (h loggerHook) Fire(entry *logrus.Entry) error {
+	h.logger.Log(logLevelFromLogrus(entry.Level), entry.Message, entry.Data)
+	return nil
+}
+
+// discardFormatter is a logrus formatter for loggers that don't write their entries, which skips formatting them.
+type discardFormatter struct{}
+
+// Format implements the logrus.Formatter interface.
+func (discardFormatter) Format(*logrus.Entry) ([]byte, error) {
+	return nil, nil
+}
+
+func logLevelFromLogrus(level logrus.Level) LogLevel {
+	switch level {
+	case logrus.PanicLevel, logrus.FatalLevel, logrus.ErrorLevel:
+		return LogLevelError
+	case logrus.WarnLevel:
+		return LogLevelWarn
+	case logrus.InfoLevel:
+		return LogLevelInfo
+	case logrus.DebugLevel:
+		return LogLevelDebug
+	default:
+		return LogLevelTrace
+	}
+}
+
+// setLogrusLevel sets the level of the logrus logger given to the log level named.
+func setLogrusLevel(l *logrus.Logger, name string) {
+	switch strings.ToLower(name) {
+	case "error":
+		l.SetLevel(logrus.ErrorLevel)
+	case "warn":
+		l.SetLevel(logrus.WarnLevel)
+	case "info":
+		l.SetLevel(logrus.InfoLevel)
+	case "debug":
+		l.SetLevel(logrus.DebugLevel)
+	case "trace":
+		l.SetLevel(logrus.TraceLevel)
+	}
+}
+
+// QueryLogRedaction is how the text of queries is redacted in the entries logged about them.
+type QueryLogRedaction int32
+
+const (
+	// QueryLogRedactNone logs queries as they're written.
+	QueryLogRedactNone QueryLogRedaction = iota
+	// QueryLogRedactLiterals replaces the literals of queries, which may hold sensitive values, with placeholders.
+	// Queries that can't be parsed are omitted.
+	QueryLogRedactLiterals
+	// QueryLogRedactAll omits the text of queries.
+	QueryLogRedactAll
+)
+
+// redactedQuery is logged in place of the queries that are omitted.
+const redactedQuery = "<redacted>"
+
+var queryLogRedaction int32
+
+// SetQueryLogRedaction sets how the text of queries is redacted in the entries logged about them.
+func SetQueryLogRedaction(r QueryLogRedaction) {
+	atomic.StoreInt32(&queryLogRedaction, int32(r))
+}
+
+// RedactQueryForLog returns the text of the query given as it should be logged, according to the redaction set with
+// SetQueryLogRedaction.
+func RedactQueryForLog(query string) string {
+	switch QueryLogRedaction(atomic.LoadInt32(&queryLogRedaction)) {
+	case QueryLogRedactLiterals:
+		redacted, err := sqlparser.RedactSQLQuery(query)
+		if err != nil {
+			return redactedQuery
+		}
+		return redacted
+	case QueryLogRedactAll:
+		return redactedQuery
+	default:
+		return query
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type logEntry struct {
+	level  LogLevel
+	msg    string
+	fields map[string]interface{}
+}
+
+func TestUseLogger(t *testing.T) {
+	require := require.New(t)
+
+	prev := GetLogger()
+	defer SetLogger(prev)
+	defer func() {
+		require.NoError(SystemVariables.SetGlobal("log_level", "info"))
+	}()
+
+	var entries []logEntry
+	UseLogger(LoggerFunc(func(level LogLevel, msg string, fields map[string]interface{}) {
+		copied := make(map[string]interface{}, len(fields))
+		for k, v := range fields {
+			copied[k] = v
+		}
+		entries = append(entries, logEntry{level, msg, copied})
+	}))
+
+	GetLogger().WithField("connectionID", 1).Info("connected")
+	GetLogger().Debug("not logged at the default level")
+	GetLogger().WithField("queryID", 2).Warn("slow query")
+	require.Equal([]logEntry{
+		{LogLevelInfo, "connected", map[string]interface{}{"connectionID": 1}},
+		{LogLevelWarn, "slow query", map[string]interface{}{"queryID": 2}},
+	}, entries)
+
+	entries = nil
+	require.NoError(SystemVariables.SetGlobal("log_level", "debug"))
+	GetLogger().Debug("logged")
+	GetLogger().Trace("not logged")
+	require.NoError(SystemVariables.SetGlobal("log_level", "error"))
+	GetLogger().Warn("not logged")
+	GetLogger().Error("failed")
+	require.Equal([]logEntry{
+		{LogLevelDebug, "logged", map[string]interface{}{}},
+		{LogLevelError, "failed", map[string]interface{}{}},
+	}, entries)
+
+	require.Error(SystemVariables.SetGlobal("log_level", "verbose"))
+}
+
+func TestRedactQueryForLog(t *testing.T) {
+	require := require.New(t)
+	defer SetQueryLogRedaction(QueryLogRedactNone)
+
+	query := "SELECT * FROM users WHERE secret = 'hunter2'"
+	require.Equal(query, RedactQueryForLog(query))
+
+	SetQueryLogRedaction(QueryLogRedactLiterals)
+	require.NotContains(RedactQueryForLog(query), "hunter2")
+	require.Contains(RedactQueryForLog(query), "users")
+	require.Equal(redactedQuery, RedactQueryForLog("SELECT 'hunter2' FROM"))
+
+	SetQueryLogRedaction(QueryLogRedactAll)
+	require.Equal(redactedQuery, RedactQueryForLog(query))
+}
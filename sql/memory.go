@@ -112,6 +112,16 @@ func (processReporter) UsedMemory() uint64 {
 
 func (processReporter) MaxMemory() uint64 { return maxMemory }
 
+// limitedProcessReporter reports the memory used by the process, with a maximum amount of memory of its own rather
+// than the one of the MAX_MEMORY environment variable.
+type limitedProcessReporter struct {
+	maxMemory uint64
+}
+
+func (limitedProcessReporter) UsedMemory() uint64 { return processReporter{}.UsedMemory() }
+
+func (r limitedProcessReporter) MaxMemory() uint64 { return r.maxMemory }
+
 // HasAvailableMemory reports whether more memory is available to the program if
 // it hasn't reached the max memory limit.
 func HasAvailableMemory(r Reporter) bool {
@@ -131,6 +141,8 @@ type MemoryManager struct {
 	reporter Reporter
 	caches   map[uint64]Disposable
 	token    uint64
+	// spillThreshold is the number of bytes of rows past which an operator spills them to disk, if not zero.
+	spillThreshold uint64
 }
 
 // NewMemoryManager creates a new manager with the given memory reporter. If nil is given,
@@ -151,6 +163,41 @@ func (m *MemoryManager) HasAvailable() bool {
 	return HasAvailableMemory(m.reporter)
 }
 
+// SetSpillThreshold sets the number of bytes of rows an operator that can spill them to disk, such as a sort, holds in
+// memory before it does. Zero, the default, only spills rows when there is no memory available.
+func (m *MemoryManager) SetSpillThreshold(bytes uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.spillThreshold = bytes
+}
+
+// ShouldSpill reports whether an operator holding rows of the number of bytes given in memory should spill them to
+// disk, because they're more than the spill threshold or because there is no memory available even after collecting
+// garbage.
+func (m *MemoryManager) ShouldSpill(bytes uint64) bool {
+	m.mu.RLock()
+	threshold := m.spillThreshold
+	m.mu.RUnlock()
+
+	if threshold > 0 && bytes > threshold {
+		return true
+	}
+	return bytes > 0 && !releaseMemoryIfNeeded(m.reporter, func() {})
+}
+
+// NewRowSpill returns a new temporary file to spill rows to, and a function to remove it when it's no longer needed.
+func (m *MemoryManager) NewRowSpill() (*RowSpill, DisposeFunc, error) {
+	s, err := newRowSpill()
+	if err != nil {
+		return nil, nil, err
+	}
+	pos := m.addCache(s)
+	return s, func() {
+		s.Dispose()
+		m.removeCache(pos)
+	}, nil
+}
+
 // DisposeFunc is a function to completely erase a cache and remove it from the manager.
 type DisposeFunc func()
 
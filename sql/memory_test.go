@@ -15,8 +15,14 @@
 package sql
 
 import (
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 )
 
@@ -52,6 +58,93 @@ func TestManager(t *testing.T) {
 	require.True(f.freed)
 }
 
+func TestShouldSpill(t *testing.T) {
+	require := require.New(t)
+
+	m := NewMemoryManager(fixedReporter(2, 5))
+	require.False(m.ShouldSpill(100))
+	m.SetSpillThreshold(50)
+	require.False(m.ShouldSpill(50))
+	require.True(m.ShouldSpill(51))
+
+	m = NewMemoryManager(fixedReporter(6, 5))
+	require.False(m.ShouldSpill(0))
+	require.True(m.ShouldSpill(1))
+}
+
+func TestRowSpill(t *testing.T) {
+	require := require.New(t)
+	m := NewMemoryManager(nil)
+
+	spill, dispose, err := m.NewRowSpill()
+	require.NoError(err)
+	require.Equal(1, m.NumCaches())
+
+	runs := [][]Row{
+		{
+			NewRow(int64(1), "a", nil),
+			NewRow(int8(2), []byte("b"), time.Date(2022, time.March, 4, 5, 6, 7, 0, time.UTC)),
+		},
+		{
+			NewRow(decimal.New(314, -2), JSONDocument{Val: map[string]interface{}{"a": []interface{}{1.0, "b"}}}, Point{X: 1, Y: 2}),
+		},
+	}
+	for _, run := range runs {
+		require.NoError(spill.WriteRun(NewEmptyContext(), run))
+	}
+	require.Equal(2, spill.NumRuns())
+
+	// Runs are read back on their own, in any order
+	for _, n := range []int{1, 0} {
+		iter := spill.ReadRun(n)
+		var rows []Row
+		for {
+			row, err := iter.Next()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(err)
+			rows = append(rows, row)
+		}
+		require.Len(rows, len(runs[n]))
+		for i, row := range rows {
+			require.Equal(len(runs[n][i]), len(row))
+			for j := range row {
+				if d, ok := row[j].(decimal.Decimal); ok {
+					require.True(d.Equal(runs[n][i][j].(decimal.Decimal)))
+				} else {
+					require.Equal(runs[n][i][j], row[j])
+				}
+			}
+		}
+	}
+
+	// Values that can't be encoded aren't written, nor is the rest of their run
+	err = spill.WriteRun(NewEmptyContext(), []Row{
+		NewRow(int64(1)),
+		NewRow(JSONDocument{Val: struct{ A int }{1}}),
+	})
+	require.True(ErrUnspillableValue.Is(err))
+	require.Equal(2, spill.NumRuns())
+
+	// LobValues are kept rather than read
+	lob := NewLobValue(3, func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(strings.NewReader("abc")), nil
+	})
+	require.NoError(spill.WriteRun(NewEmptyContext(), []Row{NewRow(int64(1), lob)}))
+	row, err := spill.ReadRun(2).Next()
+	require.NoError(err)
+	b, err := ReadLobValue(row[1].(LobValue))
+	require.NoError(err)
+	require.Equal("abc", string(b))
+
+	name := spill.file.Name()
+	dispose()
+	require.Equal(0, m.NumCaches())
+	_, err = os.Stat(name)
+	require.True(os.IsNotExist(err))
+}
+
 type disposableCache struct{}
 
 func (d disposableCache) Dispose() {}
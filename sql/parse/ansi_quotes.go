@@ -0,0 +1,100 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ansiQuotes returns whether the ANSI_QUOTES SQL mode is set for the session of the context given, in which case text
+// in double quotes is an identifier rather than a string literal.
+func ansiQuotes(ctx *sql.Context) bool {
+	if ctx == nil || ctx.Session == nil {
+		return false
+	}
+	val, err := ctx.GetSessionVariable(ctx, "sql_mode")
+	if err != nil {
+		return false
+	}
+	mode, ok := val.(string)
+	if !ok {
+		return false
+	}
+	for _, m := range strings.Split(mode, ",") {
+		if strings.EqualFold(strings.TrimSpace(m), "ANSI_QUOTES") {
+			return true
+		}
+	}
+	return false
+}
+
+// rewriteANSIQuotes rewrites the identifiers quoted with double quotes in the query given as identifiers quoted with
+// backticks, which is how the parser reads them. Double quotes are escaped in such identifiers by doubling them, and
+// backslashes have no special meaning. String literals, backtick quoted identifiers and comments are left as they are.
+func rewriteANSIQuotes(query string) string {
+	if !strings.Contains(query, `"`) {
+		return query
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(query) + 2)
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '`':
+			end, _ := skipQuoted(query, i)
+			sb.WriteString(query[i:end])
+			i = end
+		case c == '#' || (c == '-' && strings.HasPrefix(query[i:], "-- ")):
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				end = len(query)
+			} else {
+				end += i
+			}
+			sb.WriteString(query[i:end])
+			i = end
+		case strings.HasPrefix(query[i:], "/*"):
+			_, end := commentBody(query, i)
+			sb.WriteString(query[i:end])
+			i = end
+		case c == '"':
+			var name strings.Builder
+			i++
+			for i < len(query) {
+				if query[i] == '"' {
+					if i+1 < len(query) && query[i+1] == '"' {
+						name.WriteByte('"')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				name.WriteByte(query[i])
+				i++
+			}
+			sb.WriteByte('`')
+			sb.WriteString(strings.ReplaceAll(name.String(), "`", "``"))
+			sb.WriteByte('`')
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	return sb.String()
+}
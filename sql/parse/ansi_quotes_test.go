@@ -0,0 +1,44 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteANSIQuotes(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected string
+	}{
+		{"SELECT 1", "SELECT 1"},
+		{`SELECT "a" FROM "t"`, "SELECT `a` FROM `t`"},
+		{`SELECT "a""b", "c` + "`" + `d"`, "SELECT `a\"b`, `c``d`"},
+		{`SELECT "a\b"`, "SELECT `a\\b`"},
+		{`SELECT 'a"b', "c"`, "SELECT 'a\"b', `c`"},
+		{`SELECT 'it\'s "x"', "c"`, "SELECT 'it\\'s \"x\"', `c`"},
+		{"SELECT `a\"b`, \"c\"", "SELECT `a\"b`, `c`"},
+		{"SELECT \"a\" -- \"b\"\n", "SELECT `a` -- \"b\"\n"},
+		{`SELECT "a" /* "b" */`, "SELECT `a` /* \"b\" */"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			require.Equal(t, tt.expected, rewriteANSIQuotes(tt.query))
+		})
+	}
+}
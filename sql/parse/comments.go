@@ -0,0 +1,150 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ConditionalCommentVersion is the server version that versioned comments are compared against, in the format used
+// by those comments: 80011 is 8.0.11, the version reported by VERSION().
+var ConditionalCommentVersion = 80011
+
+// hintKeywords are the keywords that optimizer hints may directly follow.
+var hintKeywords = map[string]struct{}{
+	"select":  {},
+	"insert":  {},
+	"replace": {},
+	"update":  {},
+	"delete":  {},
+}
+
+// preprocessComments rewrites the MySQL-specific comments in the query given before it's parsed. The text of a
+// conditional comment, /*! ... */, becomes part of the statement, unless the comment is versioned, /*!NNNNN ... */, and
+// the version is newer than ConditionalCommentVersion, in which case the comment is dropped. An optimizer hint comment,
+// /*+ ... */, is kept if it directly follows the keyword that starts a SELECT, INSERT, REPLACE, UPDATE or DELETE, and
+// dropped elsewhere, as MySQL ignores misplaced hints. String literals, quoted identifiers and every other kind of
+// comment are left as they are.
+func preprocessComments(query string) string {
+	if !strings.Contains(query, "/*!") && !strings.Contains(query, "/*+") {
+		return query
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(query))
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			end, _ := skipQuoted(query, i)
+			sb.WriteString(query[i:end])
+			i = end
+		case c == '#' || (c == '-' && strings.HasPrefix(query[i:], "-- ")):
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				end = len(query)
+			} else {
+				end += i
+			}
+			sb.WriteString(query[i:end])
+			i = end
+		case strings.HasPrefix(query[i:], "/*!"):
+			body, end := commentBody(query, i)
+			version, text := splitCommentVersion(body[1:])
+			sb.WriteByte(' ')
+			if version <= ConditionalCommentVersion {
+				sb.WriteString(text)
+				sb.WriteByte(' ')
+			}
+			i = end
+		case strings.HasPrefix(query[i:], "/*+"):
+			_, end := commentBody(query, i)
+			if followsHintKeyword(sb.String()) {
+				sb.WriteString(query[i:end])
+			} else {
+				sb.WriteByte(' ')
+			}
+			i = end
+		case strings.HasPrefix(query[i:], "/*"):
+			_, end := commentBody(query, i)
+			sb.WriteString(query[i:end])
+			i = end
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	return sb.String()
+}
+
+// skipQuoted returns the index just past the string literal or quoted identifier that starts at |start|, and whether
+// it's terminated. Quotes may be escaped by doubling them and, in string literals, with a backslash.
+func skipQuoted(query string, start int) (int, bool) {
+	quote := query[start]
+	for i := start + 1; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			if quote != '`' {
+				i++
+			}
+		case quote:
+			if i+1 < len(query) && query[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1, true
+		}
+	}
+	return len(query), false
+}
+
+// commentBody returns the text between the delimiters of the comment that starts at |start|, along with the index
+// just past its end. An unterminated comment extends to the end of the query.
+func commentBody(query string, start int) (string, int) {
+	end := strings.Index(query[start+2:], "*/")
+	if end < 0 {
+		return query[start+2:], len(query)
+	}
+	end += start + 2
+	return query[start+2 : end], end + 2
+}
+
+// splitCommentVersion splits the text of a conditional comment into its version, which is 0 if it has none, and the
+// text that follows it.
+func splitCommentVersion(text string) (int, string) {
+	n := 0
+	for n < len(text) && n < 6 && text[n] >= '0' && text[n] <= '9' {
+		n++
+	}
+	if n < 5 {
+		return 0, text
+	}
+	version, err := strconv.Atoi(text[:n])
+	if err != nil {
+		return 0, text
+	}
+	return version, text[n:]
+}
+
+// followsHintKeyword returns whether the last word of the text given is a keyword that optimizer hints may follow.
+func followsHintKeyword(text string) bool {
+	text = strings.TrimRight(text, " \t\r\n")
+	start := strings.LastIndexFunc(text, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z')
+	})
+	_, ok := hintKeywords[strings.ToLower(text[start+1:])]
+	return ok
+}
@@ -0,0 +1,54 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreprocessComments(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected string
+	}{
+		{"SELECT 1", "SELECT 1"},
+		{"/*!40101 SET NAMES utf8mb4 */", "  SET NAMES utf8mb4  "},
+		{"/*! SET NAMES utf8mb4 */", "  SET NAMES utf8mb4  "},
+		{"SELECT 1 /*!80011 + 1 */", "SELECT 1   + 1  "},
+		{"SELECT 1 /*!80012 + 1 */", "SELECT 1  "},
+		{"SELECT 1 /*!90000 + 1 */, 2", "SELECT 1  , 2"},
+		{"SELECT 1 /*!100000 + 1 */", "SELECT 1  "},
+		{"SELECT 1 /*!080000+ 1 */", "SELECT 1  + 1  "},
+		{"SELECT 1 /* plain */", "SELECT 1 /* plain */"},
+		{"SELECT '/*!90000 x */', \"/*+ y */\", `/*!z*/`", "SELECT '/*!90000 x */', \"/*+ y */\", `/*!z*/`"},
+		{"SELECT 'it''s /*!90000 x */'", "SELECT 'it''s /*!90000 x */'"},
+		{"SELECT 'it\\'s /*!90000 x */'", "SELECT 'it\\'s /*!90000 x */'"},
+		{"SELECT 1 -- /*!90000 x */\n", "SELECT 1 -- /*!90000 x */\n"},
+		{"SELECT 1 # /*!90000 x */", "SELECT 1 # /*!90000 x */"},
+		{"SELECT /*+ JOIN_ORDER(a, b) */ 1", "SELECT /*+ JOIN_ORDER(a, b) */ 1"},
+		{"insert /*+ SET_VAR(foreign_key_checks=0) */ into t values (1)", "insert /*+ SET_VAR(foreign_key_checks=0) */ into t values (1)"},
+		{"SELECT 1 FROM t /*+ JOIN_ORDER(a, b) */", "SELECT 1 FROM t  "},
+		{"/*+ JOIN_ORDER(a, b) */ SELECT 1", "  SELECT 1"},
+		{"SELECT 1 /*!40101 unterminated", "SELECT 1   unterminated "},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.query, func(t *testing.T) {
+			require.Equal(t, tt.expected, preprocessComments(tt.query))
+		})
+	}
+}
@@ -15,8 +15,11 @@
 package parse
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	goerrors "errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -43,6 +46,58 @@ var (
 
 var describeSupportedFormats = []string{"tree"}
 
+var (
+	// commentMarkerRegex matches the delimiters of comments, including the version prefix of MySQL-specific comments.
+	commentMarkerRegex = regexp.MustCompile(`/\*!\d*|/\*|\*/`)
+
+	// consistentSnapshotRegex matches START TRANSACTION WITH CONSISTENT SNAPSHOT, which the parser doesn't support.
+	consistentSnapshotRegex = regexp.MustCompile(`(?is)^(START\s+TRANSACTION)\s+WITH\s+CONSISTENT\s+SNAPSHOT\s*,?`)
+
+	// flushRegex matches a FLUSH statement, capturing whether it's local and its options.
+	flushRegex = regexp.MustCompile(`(?is)^FLUSH(?:\s+(LOCAL|NO_WRITE_TO_BINLOG))?\s+(.+?)\s*;?$`)
+
+	// flushTablesRegex matches the TABLES option of a FLUSH statement, capturing its list of tables and modifier.
+	flushTablesRegex = regexp.MustCompile(`(?is)^TABLES?(?:\s+(.*?))??(?:\s+(WITH\s+READ\s+LOCK|FOR\s+EXPORT))?$`)
+
+	// alterKeysRegex matches ALTER TABLE ... DISABLE KEYS and ALTER TABLE ... ENABLE KEYS.
+	alterKeysRegex = regexp.MustCompile(`(?i)^ALTER\s+TABLE\s+.+\s+(DISABLE|ENABLE)\s+KEYS$`)
+
+	// analyzeTableRegex matches ANALYZE TABLE, which the parser parses as an ALTER TABLE without any alteration.
+	analyzeTableRegex = regexp.MustCompile(`(?i)^ANALYZE\s+TABLE\b`)
+
+	// orReplaceRegex matches CREATE OR REPLACE PROCEDURE and CREATE OR REPLACE TRIGGER, which the parser doesn't
+	// support.
+	orReplaceRegex = regexp.MustCompile(`(?is)^(CREATE)\s+OR\s+REPLACE\s+((?:DEFINER\s*=\s*\S+\s+)?(?:PROCEDURE|TRIGGER)\b)`)
+
+	// createIfNotExistsRegex matches CREATE PROCEDURE, CREATE TRIGGER and CREATE VIEW with IF NOT EXISTS, which the
+	// parser doesn't support.
+	createIfNotExistsRegex = regexp.MustCompile(`(?is)^(CREATE\s+(?:DEFINER\s*=\s*\S+\s+)?(?:PROCEDURE|TRIGGER|VIEW))\s+IF\s+NOT\s+EXISTS\b`)
+
+	// indexStatementRegex matches the statements that can add or drop indexes.
+	indexStatementRegex = regexp.MustCompile(`(?is)^(?:CREATE\s+(?:(?:UNIQUE|FULLTEXT|SPATIAL)\s+)?INDEX|DROP\s+INDEX|ALTER\s+TABLE)\b`)
+
+	// indexExistenceRegex matches the IF NOT EXISTS and IF EXISTS clauses of the indexes added or dropped by CREATE
+	// INDEX, DROP INDEX and ALTER TABLE, which the parser doesn't support, capturing the name of the index.
+	indexExistenceRegex = regexp.MustCompile("(?is)(^CREATE\\s+(?:(?:UNIQUE|FULLTEXT|SPATIAL)\\s+)?INDEX|^DROP\\s+INDEX|\\b(?:ADD|DROP)\\s+(?:(?:UNIQUE|FULLTEXT|SPATIAL)(?:\\s+(?:INDEX|KEY))?|INDEX|KEY))\\s+IF\\s+(NOT\\s+)?EXISTS\\s+(`(?:[^`]|``)+`|[^\\s(`,;]+)")
+
+	// indexBuildClausesRegex matches the trailing ALGORITHM and LOCK clauses of CREATE INDEX and DROP INDEX, which the
+	// parser doesn't support, capturing the statement without them and the clauses.
+	indexBuildClausesRegex = regexp.MustCompile(`(?is)^((?:CREATE\s+(?:(?:UNIQUE|FULLTEXT|SPATIAL)\s+)?INDEX|DROP\s+INDEX)\b.*?)((?:\s+(?:ALGORITHM|LOCK)(?:\s*=\s*|\s+)\w+)+)$`)
+
+	// indexBuildClauseRegex matches one of the clauses captured by indexBuildClausesRegex, capturing its name and value.
+	indexBuildClauseRegex = regexp.MustCompile(`(?i)(ALGORITHM|LOCK)(?:\s*=\s*|\s+)(\w+)`)
+
+	// tableDefinitionRegex matches the statements that can define the columns of a table.
+	tableDefinitionRegex = regexp.MustCompile(`(?is)^(?:CREATE\s+(?:TEMPORARY\s+)?TABLE|ALTER\s+TABLE)\b`)
+
+	// columnSRIDRegex matches the SRID attribute of the spatial columns of a table definition, which the parser doesn't
+	// support, capturing the name of the column, its type and the attributes before the SRID, and the SRID.
+	columnSRIDRegex = regexp.MustCompile("(?is)(`(?:[^`]|``)+`|[^\\s`(),]+)(\\s+(?:POINT|LINESTRING|POLYGON|GEOMETRY)\\b[^,()]*?)\\s+SRID\\s+(\\d+)")
+
+	// showStatusFilterRegex matches the LIKE or WHERE clause of SHOW STATUS statements, which the parser skips.
+	showStatusFilterRegex = regexp.MustCompile(`(?is)^SHOW\s+(?:(?:GLOBAL|SESSION|LOCAL)\s+)?STATUS\s+(LIKE|WHERE)\s+(.+)$`)
+)
+
 // These constants aren't exported from vitess for some reason. This could be removed if we changed this.
 const (
 	colKeyNone sqlparser.ColumnKeyOption = iota
@@ -93,16 +148,33 @@ func parse(ctx *sql.Context, query string, multi bool) (sql.Node, string, string
 	span, ctx := ctx.Span("parse", opentracing.Tag{Key: "query", Value: query})
 	defer span.Finish()
 
-	s := strings.TrimSpace(query)
+	s := strings.TrimSpace(preprocessComments(query))
+	if ansiQuotes(ctx) {
+		s = rewriteANSIQuotes(s)
+	}
 	if strings.HasSuffix(s, ";") {
 		s = s[:len(s)-1]
 	}
 
 	var stmt sqlparser.Statement
-	var err error
 	var parsed string
 	var remainder string
 
+	// Every transaction already reads from a consistent snapshot, so the clause can be dropped
+	if m := consistentSnapshotRegex.FindStringSubmatchIndex(s); m != nil {
+		s = s[m[2]:m[3]] + " " + s[m[1]:]
+	}
+
+	s, clauses := stripExistenceClauses(s)
+	s, buildClauses, err := stripIndexBuildClauses(s)
+	if err != nil {
+		return nil, s, "", err
+	}
+	s, srids, err := stripColumnSRIDs(s)
+	if err != nil {
+		return nil, s, "", err
+	}
+
 	parsed = s
 	if !multi {
 		stmt, err = sqlparser.Parse(s)
@@ -128,6 +200,11 @@ func parse(ctx *sql.Context, query string, multi bool) (sql.Node, string, string
 	}
 
 	node, err := convert(ctx, stmt, s)
+	if err == nil {
+		clauses.apply(node)
+		buildClauses.apply(node)
+		srids.apply(node)
+	}
 
 	return node, parsed, remainder, err
 }
@@ -215,6 +292,20 @@ func convert(ctx *sql.Context, stmt sqlparser.Statement, query string) (sql.Node
 		return convertDeclare(ctx, n)
 	case *sqlparser.Kill:
 		return convertKill(ctx, n)
+	case *sqlparser.Prepare:
+		return convertPrepare(ctx, query, n)
+	case *sqlparser.Execute:
+		return convertExecute(ctx, query, n)
+	case *sqlparser.Deallocate:
+		return plan.NewDeallocateQuery(n.Name.String()), nil
+	case *sqlparser.AdminCommand:
+		return convertAdminCommand(n), nil
+	case *sqlparser.Handler:
+		return convertHandler(ctx, query, n)
+	case *sqlparser.ChecksumTable:
+		return convertChecksumTable(n), nil
+	case *sqlparser.TableMaintenance:
+		return convertTableMaintenance(n), nil
 	case *sqlparser.Signal:
 		return convertSignal(ctx, n)
 	case *sqlparser.LockTables:
@@ -361,7 +452,9 @@ func convertExplain(ctx *sql.Context, n *sqlparser.Explain) (sql.Node, error) {
 		)
 	}
 
-	return plan.NewDescribeQuery(explainFmt, child), nil
+	describe := plan.NewDescribeQuery(explainFmt, child)
+	describe.Analyze = n.Analyze
+	return describe, nil
 }
 
 func convertUse(n *sqlparser.Use) (sql.Node, error) {
@@ -693,17 +786,53 @@ func convertShow(ctx *sql.Context, s *sqlparser.Show, query string) (sql.Node, e
 
 		return infoSchemaSelect, nil
 	case sqlparser.KeywordString(sqlparser.STATUS):
-		if s.Scope == sqlparser.GlobalStr {
-			return plan.NewShowStatus(plan.ShowStatusModifier_Global), nil
+		filter, err := convertShowStatusFilter(ctx, query)
+		if err != nil {
+			return nil, err
 		}
 
-		return plan.NewShowStatus(plan.ShowStatusModifier_Session), nil
+		var node sql.Node
+		if s.Scope == sqlparser.GlobalStr {
+			node = plan.NewShowStatus(plan.ShowStatusModifier_Global)
+		} else {
+			node = plan.NewShowStatus(plan.ShowStatusModifier_Session)
+		}
+		if filter != nil {
+			node = plan.NewFilter(filter, node)
+		}
+		return node, nil
 	default:
+		if ext, ok := showExtension(s.Type); ok {
+			return ext(ctx, query)
+		}
 		unsupportedShow := fmt.Sprintf("SHOW %s", s.Type)
 		return nil, sql.ErrUnsupportedFeature.New(unsupportedShow)
 	}
 }
 
+// convertShowStatusFilter returns the filter of the LIKE or WHERE clause of the SHOW STATUS statement given, if any.
+// The parser skips these clauses, so they're parsed as the WHERE clause of a SELECT statement.
+func convertShowStatusFilter(ctx *sql.Context, query string) (sql.Expression, error) {
+	m := showStatusFilterRegex.FindStringSubmatch(query)
+	if m == nil {
+		return nil, nil
+	}
+
+	where := m[2]
+	if strings.EqualFold(m[1], "LIKE") {
+		where = "Variable_name LIKE " + where
+	}
+	stmt, err := sqlparser.Parse("SELECT * FROM dual WHERE " + where)
+	if err != nil {
+		return nil, sql.ErrSyntaxError.New(err.Error())
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Where == nil {
+		return nil, sql.ErrSyntaxError.New(query)
+	}
+	return ExprToExpression(ctx, sel.Where.Expr)
+}
+
 func convertUnion(ctx *sql.Context, u *sqlparser.Union) (sql.Node, error) {
 	left, err := convertSelectStatement(ctx, u.Left)
 	if err != nil {
@@ -789,8 +918,8 @@ func convertSelect(ctx *sql.Context, s *sqlparser.Select) (sql.Node, error) {
 	}
 
 	// Finally, if common table expressions were provided, wrap the top-level node in a With node to capture them
-	if len(s.CommonTableExprs) > 0 {
-		node, err = ctesToWith(ctx, s.CommonTableExprs, node)
+	if s.With != nil {
+		node, err = ctesToWith(ctx, s.With, node)
 		if err != nil {
 			return nil, err
 		}
@@ -799,9 +928,9 @@ func convertSelect(ctx *sql.Context, s *sqlparser.Select) (sql.Node, error) {
 	return node, nil
 }
 
-func ctesToWith(ctx *sql.Context, cteExprs sqlparser.TableExprs, node sql.Node) (sql.Node, error) {
-	ctes := make([]*plan.CommonTableExpression, len(cteExprs))
-	for i, cteExpr := range cteExprs {
+func ctesToWith(ctx *sql.Context, with *sqlparser.With, node sql.Node) (sql.Node, error) {
+	ctes := make([]*plan.CommonTableExpression, len(with.Ctes))
+	for i, cteExpr := range with.Ctes {
 		var err error
 		ctes[i], err = cteExprToCte(ctx, cteExpr)
 		if err != nil {
@@ -809,7 +938,9 @@ func ctesToWith(ctx *sql.Context, cteExprs sqlparser.TableExprs, node sql.Node)
 		}
 	}
 
-	return plan.NewWith(node, ctes), nil
+	w := plan.NewWith(node, ctes)
+	w.Recursive = with.Recursive
+	return w, nil
 }
 
 func cteExprToCte(ctx *sql.Context, expr sqlparser.TableExpr) (*plan.CommonTableExpression, error) {
@@ -831,7 +962,14 @@ func cteExprToCte(ctx *sql.Context, expr sqlparser.TableExpr) (*plan.CommonTable
 
 	columns := columnsToStrings(cte.Columns)
 
-	return plan.NewCommonTableExpression(subquery.(*plan.SubqueryAlias), columns), nil
+	commonTableExpr := plan.NewCommonTableExpression(subquery.(*plan.SubqueryAlias), columns)
+	switch cte.Materialization {
+	case sqlparser.MaterializedStr:
+		commonTableExpr.Materialization = plan.CteMaterialized
+	case sqlparser.NotMaterializedStr:
+		commonTableExpr.Materialization = plan.CteNotMaterialized
+	}
+	return commonTableExpr, nil
 }
 
 func convertDDL(ctx *sql.Context, query string, c *sqlparser.DDL) (sql.Node, error) {
@@ -859,16 +997,289 @@ func convertDDL(ctx *sql.Context, query string, c *sqlparser.DDL) (sql.Node, err
 		}
 		return convertDropTable(ctx, c)
 	case sqlparser.AlterStr:
+		if analyzeTableRegex.MatchString(stripCommentMarkers(query)) {
+			return plan.NewAnalyzeTable(tableNameToUnresolvedTable(c.Table)), nil
+		}
+		if m := alterKeysRegex.FindStringSubmatch(stripCommentMarkers(query)); m != nil {
+			if strings.ToUpper(m[1]) == "DISABLE" {
+				return plan.NewAlterDisableKeys(tableNameToUnresolvedTable(c.Table)), nil
+			}
+			return plan.NewAlterEnableKeys(tableNameToUnresolvedTable(c.Table)), nil
+		}
 		return convertAlterTable(ctx, c)
 	case sqlparser.RenameStr:
 		return convertRenameTable(ctx, c)
 	case sqlparser.TruncateStr:
 		return convertTruncateTable(ctx, c)
+	case sqlparser.FlushStr:
+		return convertFlush(query)
 	default:
 		return nil, sql.ErrUnsupportedSyntax.New(sqlparser.String(c))
 	}
 }
 
+// convertFlush returns a Flush node for the query given. The parser skips everything after the FLUSH keyword, so the
+// options are parsed from the text of the query.
+func convertFlush(query string) (*plan.Flush, error) {
+	m := flushRegex.FindStringSubmatch(stripCommentMarkers(query))
+	if m == nil {
+		return nil, sql.ErrUnsupportedSyntax.New(query)
+	}
+	local := m[1] != ""
+
+	if tm := flushTablesRegex.FindStringSubmatch(m[2]); tm != nil {
+		option := sql.FlushOption{Kind: sql.FlushKind_Tables, Name: "TABLES"}
+		if tm[2] != "" {
+			option.Name += " " + strings.ToUpper(strings.Join(strings.Fields(tm[2]), " "))
+		}
+		if strings.TrimSpace(tm[1]) != "" {
+			for _, name := range strings.Split(tm[1], ",") {
+				table, err := parseTableName(name)
+				if err != nil {
+					return nil, err
+				}
+				option.Tables = append(option.Tables, table)
+			}
+		}
+		return plan.NewFlush(local, option), nil
+	}
+
+	var options []sql.FlushOption
+	for _, text := range strings.Split(m[2], ",") {
+		name := strings.ToUpper(strings.Join(strings.Fields(text), " "))
+		option := sql.FlushOption{Name: name}
+		switch {
+		case name == "PRIVILEGES":
+			option.Kind = sql.FlushKind_Privileges
+		case name == "STATUS":
+			option.Kind = sql.FlushKind_Status
+		case name == "TABLES", name == "TABLE":
+			option.Kind, option.Name = sql.FlushKind_Tables, "TABLES"
+		case name == "LOGS", name == "BINARY LOGS", name == "ENGINE LOGS", name == "ERROR LOGS",
+			name == "GENERAL LOGS", name == "SLOW LOGS", name == "RELAY LOGS", strings.HasPrefix(name, "RELAY LOGS FOR CHANNEL "):
+			option.Kind = sql.FlushKind_Logs
+		case name == "HOSTS", name == "OPTIMIZER_COSTS", name == "USER_RESOURCES":
+			option.Kind = sql.FlushKind_Other
+		default:
+			return nil, sql.ErrUnsupportedSyntax.New(query)
+		}
+		options = append(options, option)
+	}
+	return plan.NewFlush(local, options...), nil
+}
+
+// convertAdminCommand returns an AdminCommand node for the SHUTDOWN, RESET MASTER or RESET REPLICA statement given.
+// The parser returns RESET SLAVE, the deprecated name of RESET REPLICA, as RESET REPLICA.
+func convertAdminCommand(c *sqlparser.AdminCommand) *plan.AdminCommand {
+	var name string
+	switch c.Command {
+	case sqlparser.ShutdownStr:
+		name = sql.AdminCommand_Shutdown
+	case sqlparser.ResetMasterStr:
+		name = sql.AdminCommand_ResetMaster
+	case sqlparser.ResetReplicaStr:
+		name = sql.AdminCommand_ResetReplica
+	}
+	return plan.NewAdminCommand(name, c.Args...)
+}
+
+// convertChecksumTable returns a ChecksumTable node for the CHECKSUM TABLE statement given.
+func convertChecksumTable(c *sqlparser.ChecksumTable) *plan.ChecksumTable {
+	names := make([]plan.ChecksumTableName, len(c.Tables))
+	for i, t := range c.Tables {
+		names[i] = plan.ChecksumTableName{Database: t.Qualifier.String(), Name: t.Name.String()}
+	}
+
+	switch c.Mode {
+	case sqlparser.QuickStr:
+		return plan.NewChecksumTable(plan.ChecksumMode_Quick, names...)
+	case sqlparser.ExtendedStr:
+		return plan.NewChecksumTable(plan.ChecksumMode_Extended, names...)
+	default:
+		return plan.NewChecksumTable(plan.ChecksumMode_Default, names...)
+	}
+}
+
+// convertTableMaintenance returns a TableMaintenance node for the OPTIMIZE TABLE or REPAIR TABLE statement given.
+func convertTableMaintenance(m *sqlparser.TableMaintenance) *plan.TableMaintenance {
+	names := make([]plan.MaintenanceTableName, len(m.Tables))
+	for i, t := range m.Tables {
+		names[i] = plan.MaintenanceTableName{Database: t.Qualifier.String(), Name: t.Name.String()}
+	}
+
+	if m.Action == sqlparser.OptimizeStr {
+		return plan.NewOptimizeTable(names...)
+	}
+
+	var repairOptions sql.RepairOptions
+	for _, option := range m.Options {
+		switch option {
+		case sqlparser.QuickStr:
+			repairOptions.Quick = true
+		case sqlparser.ExtendedStr:
+			repairOptions.Extended = true
+		case sqlparser.UseFrmStr:
+			repairOptions.UseFrm = true
+		}
+	}
+	return plan.NewRepairTable(repairOptions, names...)
+}
+
+// convertHandler returns the node of a HANDLER statement.
+func convertHandler(ctx *sql.Context, query string, h *sqlparser.Handler) (sql.Node, error) {
+	database, name := h.Table.Qualifier.String(), h.Table.Name.String()
+	switch h.Action {
+	case sqlparser.HandlerOpenStr:
+		return plan.NewHandlerOpen(database, name, h.Alias.String()), nil
+	case sqlparser.HandlerCloseStr:
+		if database != "" {
+			return nil, sql.ErrUnsupportedSyntax.New(query)
+		}
+		return plan.NewHandlerClose(name), nil
+	}
+
+	index := h.Index.String()
+	var mode plan.HandlerReadMode
+	var op string
+	switch h.Mode {
+	case sqlparser.HandlerFirstStr:
+		mode = plan.HandlerRead_First
+	case sqlparser.HandlerNextStr:
+		mode = plan.HandlerRead_Next
+	case sqlparser.HandlerPrevStr:
+		mode = plan.HandlerRead_Prev
+	case sqlparser.HandlerLastStr:
+		mode = plan.HandlerRead_Last
+	default:
+		mode, op = plan.HandlerRead_Key, h.Mode
+	}
+	if database != "" {
+		return nil, sql.ErrUnsupportedSyntax.New(query)
+	}
+
+	var key []sql.Expression
+	for _, k := range h.Key {
+		e, err := ExprToExpression(ctx, k)
+		if err != nil {
+			return nil, err
+		}
+		key = append(key, e)
+	}
+
+	var where sql.Expression
+	var err error
+	if h.Where != nil {
+		if where, err = ExprToExpression(ctx, h.Where.Expr); err != nil {
+			return nil, err
+		}
+	}
+
+	limit, offset := int64(1), int64(0)
+	if h.Limit != nil {
+		if limit, err = getInt64Value(ctx, h.Limit.Rowcount, "LIMIT with non-integer literal"); err != nil {
+			return nil, err
+		}
+		if h.Limit.Offset != nil {
+			if offset, err = getInt64Value(ctx, h.Limit.Offset, "OFFSET with non-integer literal"); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return plan.NewHandlerRead(name, index, mode, op, key, where, limit, offset), nil
+}
+
+// convertPrepare returns the node of a PREPARE statement, whose text is a string literal or a user variable.
+func convertPrepare(ctx *sql.Context, query string, p *sqlparser.Prepare) (sql.Node, error) {
+	source, err := ExprToExpression(ctx, p.Expr)
+	if err != nil {
+		return nil, err
+	}
+	if uv, ok := userVariable(source); ok {
+		return plan.NewPrepareQuery(p.Name.String(), uv), nil
+	}
+	if l, ok := source.(*expression.Literal); ok {
+		if _, ok := l.Value().(string); ok {
+			return plan.NewPrepareQuery(p.Name.String(), l), nil
+		}
+	}
+	return nil, sql.ErrUnsupportedSyntax.New(query)
+}
+
+// convertExecute returns the node of an EXECUTE statement, whose USING clause, if any, lists user variables.
+func convertExecute(ctx *sql.Context, query string, e *sqlparser.Execute) (sql.Node, error) {
+	if len(e.VarList) == 0 {
+		return plan.NewExecuteQuery(e.Name.String(), nil), nil
+	}
+	bindings := make([]sql.Expression, len(e.VarList))
+	for i, v := range e.VarList {
+		b, err := ExprToExpression(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		uv, ok := userVariable(b)
+		if !ok {
+			return nil, sql.ErrUnsupportedSyntax.New(query)
+		}
+		bindings[i] = uv
+	}
+	return plan.NewExecuteQuery(e.Name.String(), bindings), nil
+}
+
+// userVariable returns the user variable an expression refers to, if it's a column named after one, as the parser
+// returns them.
+func userVariable(e sql.Expression) (*expression.UserVar, bool) {
+	uc, ok := e.(*expression.UnresolvedColumn)
+	if !ok || uc.Table() != "" {
+		return nil, false
+	}
+	name, scope, err := sqlparser.VarScope(uc.Name())
+	if err != nil || scope != sqlparser.SetScope_User {
+		return nil, false
+	}
+	return expression.NewUserVar(name), true
+}
+
+// parseTableName parses a table name of a statement the parser doesn't support, which may be qualified with its
+// database and quoted with backticks.
+func parseTableName(name string) (sql.FlushTable, error) {
+	var parts []string
+	var part strings.Builder
+	quoted := false
+	name = strings.TrimSpace(name)
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c == '`' && quoted && i+1 < len(name) && name[i+1] == '`':
+			part.WriteByte('`')
+			i++
+		case c == '`':
+			quoted = !quoted
+		case c == '.' && !quoted:
+			parts = append(parts, part.String())
+			part.Reset()
+		default:
+			part.WriteByte(c)
+		}
+	}
+	parts = append(parts, part.String())
+
+	switch {
+	case quoted || len(parts) > 2:
+		return sql.FlushTable{}, sql.ErrUnsupportedSyntax.New(name)
+	case len(parts) == 2:
+		return sql.FlushTable{Database: parts[0], Name: parts[1]}, nil
+	default:
+		return sql.FlushTable{Name: parts[0]}, nil
+	}
+}
+
+// stripCommentMarkers returns the query given without any comment delimiters, leaving the text inside the comments
+// in place and trimming any surrounding space.
+func stripCommentMarkers(query string) string {
+	return strings.TrimSpace(commentMarkerRegex.ReplaceAllString(query, " "))
+}
+
 func convertMultiAlterDDL(ctx *sql.Context, query string, c *sqlparser.MultiAlterDDL) (sql.Node, error) {
 	statementsLen := len(c.Statements)
 	if statementsLen == 1 {
@@ -885,6 +1296,208 @@ func convertMultiAlterDDL(ctx *sql.Context, query string, c *sqlparser.MultiAlte
 	return plan.NewBlock(statements), nil
 }
 
+// existenceClauses are the OR REPLACE and IF [NOT] EXISTS clauses of a DDL statement that the parser doesn't
+// support, and that are stripped from the query before it's parsed.
+type existenceClauses struct {
+	orReplace   bool
+	ifNotExists bool
+	// createIndexes and dropIndexes are the lowercase names of the indexes added with IF NOT EXISTS and dropped
+	// with IF EXISTS.
+	createIndexes map[string]bool
+	dropIndexes   map[string]bool
+}
+
+// stripExistenceClauses removes the OR REPLACE and IF [NOT] EXISTS clauses the parser doesn't support from the query
+// given, returning the rest of the query and the clauses removed. Only the first statement of the query is considered.
+func stripExistenceClauses(query string) (string, existenceClauses) {
+	var clauses existenceClauses
+	if m := orReplaceRegex.FindStringSubmatchIndex(query); m != nil {
+		clauses.orReplace = true
+		query = query[m[2]:m[3]] + " " + query[m[4]:]
+	}
+	if m := createIfNotExistsRegex.FindStringSubmatchIndex(query); m != nil {
+		clauses.ifNotExists = true
+		query = query[m[2]:m[3]] + query[m[1]:]
+	}
+
+	if !indexStatementRegex.MatchString(query) {
+		return query, clauses
+	}
+	statement, rest := query, ""
+	if i := strings.Index(query, ";"); i >= 0 {
+		statement, rest = query[:i], query[i:]
+	}
+	statement = indexExistenceRegex.ReplaceAllStringFunc(statement, func(clause string) string {
+		m := indexExistenceRegex.FindStringSubmatch(clause)
+		name := m[3]
+		if strings.HasPrefix(name, "`") {
+			name = strings.ReplaceAll(name[1:len(name)-1], "``", "`")
+		}
+		if m[2] != "" {
+			if clauses.createIndexes == nil {
+				clauses.createIndexes = make(map[string]bool)
+			}
+			clauses.createIndexes[strings.ToLower(name)] = true
+		} else {
+			if clauses.dropIndexes == nil {
+				clauses.dropIndexes = make(map[string]bool)
+			}
+			clauses.dropIndexes[strings.ToLower(name)] = true
+		}
+		return m[1] + " " + m[3]
+	})
+	return statement + rest, clauses
+}
+
+// apply sets the clauses on the node given, which was parsed from the query they were stripped from.
+func (c existenceClauses) apply(node sql.Node) {
+	switch n := node.(type) {
+	case *plan.CreateProcedure:
+		n.OrReplace, n.IfNotExists = c.orReplace, c.ifNotExists
+	case *plan.CreateTrigger:
+		n.OrReplace, n.IfNotExists = c.orReplace, c.ifNotExists
+	case *plan.CreateView:
+		n.IfNotExists = n.IfNotExists || c.ifNotExists
+	case *plan.AlterIndex:
+		switch n.Action {
+		case plan.IndexAction_Create:
+			n.IfNotExists = c.createIndexes[strings.ToLower(n.IndexName)]
+		case plan.IndexAction_Drop:
+			n.IfExists = c.dropIndexes[strings.ToLower(n.IndexName)]
+		}
+	case *plan.Block:
+		for _, child := range n.Children() {
+			c.apply(child)
+		}
+	}
+}
+
+// indexBuildClauses are the ALGORITHM and LOCK clauses of a CREATE INDEX or DROP INDEX statement, which the parser
+// doesn't support, and that are stripped from the query before it's parsed.
+type indexBuildClauses struct {
+	algorithm sql.IndexAlgorithm
+	lock      sql.IndexLock
+}
+
+// stripIndexBuildClauses removes the ALGORITHM and LOCK clauses from the end of the CREATE INDEX or DROP INDEX
+// statement given, returning the rest of the query and the clauses removed. Only the first statement of the query is
+// considered. As in MySQL, a clause given more than once takes the last value given.
+func stripIndexBuildClauses(query string) (string, indexBuildClauses, error) {
+	var clauses indexBuildClauses
+	statement, rest := query, ""
+	if i := strings.Index(query, ";"); i >= 0 {
+		statement, rest = query[:i], query[i:]
+	}
+	m := indexBuildClausesRegex.FindStringSubmatch(statement)
+	if m == nil {
+		return query, clauses, nil
+	}
+	for _, clause := range indexBuildClauseRegex.FindAllStringSubmatch(m[2], -1) {
+		value := strings.ToLower(clause[2])
+		if strings.EqualFold(clause[1], "ALGORITHM") {
+			switch value {
+			case "default":
+				clauses.algorithm = sql.IndexAlgorithm_Default
+			case "inplace":
+				clauses.algorithm = sql.IndexAlgorithm_Inplace
+			case "copy":
+				clauses.algorithm = sql.IndexAlgorithm_Copy
+			default:
+				return query, clauses, sql.ErrUnknownAlterAlgorithm.New(clause[2])
+			}
+		} else {
+			switch value {
+			case "default":
+				clauses.lock = sql.IndexLock_Default
+			case "none":
+				clauses.lock = sql.IndexLock_None
+			case "shared":
+				clauses.lock = sql.IndexLock_Shared
+			case "exclusive":
+				clauses.lock = sql.IndexLock_Exclusive
+			default:
+				return query, clauses, sql.ErrUnknownAlterLock.New(clause[2])
+			}
+		}
+	}
+	return m[1] + rest, clauses, nil
+}
+
+// apply sets the clauses on the node given, which was parsed from the query they were stripped from.
+func (c indexBuildClauses) apply(node sql.Node) {
+	if n, ok := node.(*plan.AlterIndex); ok {
+		n.Algorithm, n.Lock = c.algorithm, c.lock
+	}
+}
+
+// columnSRIDs are the SRID attributes of the spatial columns of a table definition, which the parser doesn't support,
+// and that are stripped from the query before it's parsed, by the lowercase name of their column.
+type columnSRIDs map[string]uint32
+
+// stripColumnSRIDs removes the SRID attributes of the spatial columns defined by the CREATE TABLE or ALTER TABLE
+// statement given, returning the rest of the query and the attributes removed. Only the first statement of the query is
+// considered.
+func stripColumnSRIDs(query string) (string, columnSRIDs, error) {
+	if !tableDefinitionRegex.MatchString(query) {
+		return query, nil, nil
+	}
+	statement, rest := query, ""
+	if i := strings.Index(query, ";"); i >= 0 {
+		statement, rest = query[:i], query[i:]
+	}
+	var srids columnSRIDs
+	var err error
+	statement = columnSRIDRegex.ReplaceAllStringFunc(statement, func(attribute string) string {
+		m := columnSRIDRegex.FindStringSubmatch(attribute)
+		srid, _ := strconv.ParseUint(m[3], 10, 32)
+		if sridErr := sql.ValidateSRID(uint32(srid)); sridErr != nil && err == nil {
+			err = sridErr
+		}
+		name := m[1]
+		if strings.HasPrefix(name, "`") {
+			name = strings.ReplaceAll(name[1:len(name)-1], "``", "`")
+		}
+		if srids == nil {
+			srids = make(columnSRIDs)
+		}
+		srids[strings.ToLower(name)] = uint32(srid)
+		return m[1] + m[2]
+	})
+	return statement + rest, srids, err
+}
+
+// apply restricts the types of the spatial columns of the node given, which was parsed from the query the SRID
+// attributes were stripped from, to their SRIDs.
+func (c columnSRIDs) apply(node sql.Node) {
+	if len(c) == 0 {
+		return
+	}
+	switch n := node.(type) {
+	case *plan.CreateTable:
+		for _, col := range n.CreateSchema.Schema {
+			c.applyToColumn(col)
+		}
+	case *plan.AddColumn:
+		c.applyToColumn(n.Column())
+	case *plan.ModifyColumn:
+		c.applyToColumn(n.NewColumn())
+	case *plan.Block:
+		for _, child := range n.Children() {
+			c.apply(child)
+		}
+	}
+}
+
+func (c columnSRIDs) applyToColumn(col *sql.Column) {
+	srid, ok := c[strings.ToLower(col.Name)]
+	if !ok {
+		return
+	}
+	if st, ok := col.Type.(sql.SpatialColumnType); ok {
+		col.Type = st.SetSRID(srid)
+	}
+}
+
 func convertDBDDL(c *sqlparser.DBDDL) (sql.Node, error) {
 	switch strings.ToLower(c.Action) {
 	case sqlparser.CreateStr:
@@ -1145,15 +1758,21 @@ func convertRenameTable(ctx *sql.Context, ddl *sqlparser.DDL) (sql.Node, error)
 		panic("Expected from tables and to tables of equal length")
 	}
 
-	var fromTables, toTables []string
-	for _, table := range ddl.FromTables {
-		fromTables = append(fromTables, table.Name.String())
-	}
-	for _, table := range ddl.ToTables {
-		toTables = append(toTables, table.Name.String())
+	if len(ddl.FromTables) > 1 || !ddl.FromTables[0].Qualifier.IsEmpty() || !ddl.ToTables[0].Qualifier.IsEmpty() {
+		renames := make([]plan.QualifiedRename, len(ddl.FromTables))
+		for i, from := range ddl.FromTables {
+			to := ddl.ToTables[i]
+			renames[i] = plan.QualifiedRename{
+				OldDatabase: from.Qualifier.String(),
+				OldName:     from.Name.String(),
+				NewDatabase: to.Qualifier.String(),
+				NewName:     to.Name.String(),
+			}
+		}
+		return plan.NewMultiRenameTable(renames), nil
 	}
 
-	return plan.NewRenameTable(sql.UnresolvedDatabase(""), fromTables, toTables), nil
+	return plan.NewRenameTable(sql.UnresolvedDatabase(""), []string{ddl.FromTables[0].Name.String()}, []string{ddl.ToTables[0].Name.String()}), nil
 }
 
 func convertAlterTable(ctx *sql.Context, ddl *sqlparser.DDL) (sql.Node, error) {
@@ -1282,7 +1901,13 @@ func convertAlterIndex(ctx *sql.Context, ddl *sqlparser.DDL) (sql.Node, error) {
 			return plan.NewAlterCreatePk(table, columns), nil
 		}
 
-		return plan.NewAlterCreateIndex(table, ddl.IndexSpec.ToName.String(), using, constraint, columns, comment), nil
+		// As in MySQL, a UNIQUE index is named after its constraint, unless it's given a name of its own
+		name := ddl.IndexSpec.ToName.String()
+		if name == "" && constraint == sql.IndexConstraint_Unique {
+			name = ddl.IndexSpec.ConstraintName
+		}
+
+		return plan.NewAlterCreateIndex(table, name, using, constraint, columns, comment), nil
 	case sqlparser.DropStr:
 		if ddl.IndexSpec.Type == sqlparser.PrimaryStr {
 			return plan.NewAlterDropPk(table), nil
@@ -1489,16 +2114,33 @@ func convertCreateTable(ctx *sql.Context, c *sqlparser.DDL) (sql.Node, error) {
 
 	qualifier := c.Table.Qualifier.String()
 
+	if ctx != nil {
+		explicitDefaults, err := ctx.GetSessionVariable(ctx, "explicit_defaults_for_timestamp")
+		if err != nil {
+			return nil, err
+		}
+		if explicitDefaults.(int8) == 0 {
+			addImplicitTimestampDefaults(c.TableSpec.Columns)
+		}
+	}
+
 	schema, err := TableSpecToSchema(nil, c.TableSpec)
 	if err != nil {
 		return nil, err
 	}
 
+	options, autoIncrement, err := convertTableOptions(c.TableSpec.Options)
+	if err != nil {
+		return nil, err
+	}
+
 	tableSpec := &plan.TableSpec{
-		Schema:  schema,
-		IdxDefs: idxDefs,
-		FkDefs:  fkDefs,
-		ChDefs:  chDefs,
+		Schema:        schema,
+		IdxDefs:       idxDefs,
+		FkDefs:        fkDefs,
+		ChDefs:        chDefs,
+		Options:       options,
+		AutoIncrement: autoIncrement,
 	}
 
 	if c.OptSelect != nil {
@@ -1514,6 +2156,138 @@ func convertCreateTable(ctx *sql.Context, c *sqlparser.DDL) (sql.Node, error) {
 		sql.UnresolvedDatabase(qualifier), c.Table.Name.String(), plan.IfNotExistsOption(c.IfNotExists), plan.TempTableOption(c.Temporary), tableSpec), nil
 }
 
+// convertTableOptions returns the table options, and the first AUTO_INCREMENT value, of the table options clause of a
+// CREATE TABLE statement. The parser gives the clause as text, with the options it doesn't know about. The table
+// options are empty if the clause has none of the options that tables can have.
+func convertTableOptions(clause string) (sql.TableOptions, uint64, error) {
+	var options sql.TableOptions
+	var autoIncrement uint64
+	var charset, collation *string
+	given := false
+
+	tokens := tableOptionTokens(clause)
+	for i := 0; i < len(tokens); i++ {
+		name := strings.ToUpper(tokens[i])
+		if name == "CHARACTER" && i+1 < len(tokens) && strings.EqualFold(tokens[i+1], "SET") {
+			name = "CHARSET"
+			i++
+		}
+		switch name {
+		case "AUTO_INCREMENT", "CHARSET", "COLLATE", "COMMENT", "ROW_FORMAT", "ENGINE_ATTRIBUTE", "SECONDARY_ENGINE_ATTRIBUTE":
+		default:
+			continue
+		}
+		if i+1 < len(tokens) && tokens[i+1] == "=" {
+			i++
+		}
+		if i+1 >= len(tokens) {
+			break
+		}
+		i++
+		value := tokens[i]
+		if len(value) >= 2 && value[0] == '\'' && value[len(value)-1] == '\'' {
+			value = value[1 : len(value)-1]
+		}
+
+		switch name {
+		case "AUTO_INCREMENT":
+			val, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return sql.TableOptions{}, 0, sql.ErrInvalidSQLValType.New(value)
+			}
+			autoIncrement = val
+		case "CHARSET":
+			charset = &value
+			given = true
+		case "COLLATE":
+			collation = &value
+			given = true
+		case "COMMENT":
+			options.Comment = value
+			given = true
+		case "ROW_FORMAT":
+			value = strings.ToUpper(value)
+			if !rowFormats[value] {
+				return sql.TableOptions{}, 0, sql.ErrInvalidValue.New(tokens[i], "ROW_FORMAT")
+			}
+			if value != "DEFAULT" {
+				options.RowFormat = value
+			}
+			given = true
+		case "ENGINE_ATTRIBUTE", "SECONDARY_ENGINE_ATTRIBUTE":
+			if !json.Valid([]byte(value)) {
+				return sql.TableOptions{}, 0, sql.ErrInvalidJSONText.New(value)
+			}
+			if name == "ENGINE_ATTRIBUTE" {
+				options.EngineAttribute = value
+			} else {
+				options.SecondaryEngineAttribute = value
+			}
+			given = true
+		}
+	}
+
+	if !given {
+		return sql.TableOptions{}, autoIncrement, nil
+	}
+	// The character set of the default collation without a collation is the default collation, so that the options
+	// shown by SHOW CREATE TABLE for a table with the default collation create a table with the default collation.
+	if charset != nil && collation == nil && strings.EqualFold(*charset, sql.Collation_Default.CharacterSet().String()) {
+		options.Collation = sql.Collation_Default
+		return options, autoIncrement, nil
+	}
+
+	var err error
+	options.Collation, err = sql.ParseCollation(charset, collation, false)
+	if err != nil {
+		return sql.TableOptions{}, 0, err
+	}
+	return options, autoIncrement, nil
+}
+
+// rowFormats are the row formats that the ROW_FORMAT table option accepts.
+var rowFormats = map[string]bool{
+	"DEFAULT":    true,
+	"DYNAMIC":    true,
+	"FIXED":      true,
+	"COMPRESSED": true,
+	"REDUNDANT":  true,
+	"COMPACT":    true,
+}
+
+// tableOptionTokens splits a table options clause into its names, values and equals signs. The parser removes the
+// escaping of the quotes in string values, so a string value ends at the first quote that's followed by a separator.
+func tableOptionTokens(clause string) []string {
+	var tokens []string
+	for i := 0; i < len(clause); {
+		switch c := clause[i]; {
+		case c == ' ' || c == ',':
+			i++
+		case c == '=':
+			tokens = append(tokens, "=")
+			i++
+		case c == '\'':
+			end := i + 1
+			for end < len(clause) && !(clause[end] == '\'' && (end+1 == len(clause) || clause[end+1] == ' ' || clause[end+1] == ',')) {
+				end++
+			}
+			if end == len(clause) {
+				end--
+			}
+			tokens = append(tokens, clause[i:end+1])
+			i = end + 1
+		default:
+			end := i
+			for end < len(clause) && clause[end] != ' ' && clause[end] != ',' && clause[end] != '=' {
+				end++
+			}
+			tokens = append(tokens, clause[i:end])
+			i = end
+		}
+	}
+	return tokens
+}
+
 type namedConstraint struct {
 	name string
 }
@@ -1619,38 +2393,8 @@ func convertInsert(ctx *sql.Context, i *sqlparser.Insert) (sql.Node, error) {
 		ignore = true
 	}
 
-	columnWithDefaultValues := make(map[int]bool)
-
-	if e, ok := src.(*plan.Values); ok {
-		for i, tuple := range e.ExpressionTuples {
-			var needCols []sql.Expression
-			for j, s := range tuple {
-				if _, ok := s.(*expression.DefaultColumn); ok {
-					columnWithDefaultValues[j] = true
-				} else {
-					needCols = append(needCols, s)
-				}
-			}
-
-			// Only re-assign if found column with default values
-			if len(columnWithDefaultValues) == 0 {
-				break
-			}
-
-			e.ExpressionTuples[i] = needCols
-		}
-	}
-
-	var columns []string
-	if len(columnWithDefaultValues) > 0 {
-		for i, c := range columnsToStrings(i.Columns) {
-			if _, found := columnWithDefaultValues[i]; !found {
-				columns = append(columns, c)
-			}
-		}
-	} else {
-		columns = columnsToStrings(i.Columns)
-	}
+	// DEFAULT values in the rows are replaced with the defaults of their columns during analysis
+	columns := columnsToStrings(i.Columns)
 
 	return plan.NewInsertInto(sql.UnresolvedDatabase(i.Table.Qualifier.String()), tableNameToUnresolvedTable(i.Table), src, isReplace, columns, onDupExprs, ignore), nil
 }
@@ -1806,6 +2550,32 @@ func TableSpecToSchema(ctx *sql.Context, tableSpec *sqlparser.TableSpec) (sql.Pr
 	return sql.NewPrimaryKeySchema(schema, getPkOrdinals(tableSpec)...), nil
 }
 
+// addImplicitTimestampDefaults gives TIMESTAMP columns the implicit properties they have in MySQL when
+// explicit_defaults_for_timestamp is disabled. TIMESTAMP columns that aren't declared NULL are NOT NULL. The first of
+// them, if it declares no DEFAULT nor ON UPDATE clause, defaults to CURRENT_TIMESTAMP and is updated to it with any
+// other column of its row. The others default to the zero timestamp.
+func addImplicitTimestampDefaults(columns []*sqlparser.ColumnDefinition) {
+	first := true
+	for _, cd := range columns {
+		if strings.ToLower(cd.Type.Type) != "timestamp" {
+			continue
+		}
+		if bool(cd.Type.Null) {
+			first = false
+			continue
+		}
+
+		cd.Type.NotNull = true
+		if first && cd.Type.Default == nil && cd.Type.OnUpdate == nil {
+			cd.Type.Default = &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("CURRENT_TIMESTAMP")}
+			cd.Type.OnUpdate = &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("CURRENT_TIMESTAMP")}
+		} else if cd.Type.Default == nil {
+			cd.Type.Default = sqlparser.NewStrVal([]byte("0000-00-00 00:00:00"))
+		}
+		first = false
+	}
+}
+
 // columnDefinitionToColumn returns the sql.Column for the column definition given, as part of a create table statement.
 func columnDefinitionToColumn(ctx *sql.Context, cd *sqlparser.ColumnDefinition, indexes []*sqlparser.IndexDefinition) (*sql.Column, error) {
 	internalTyp, err := sql.ColumnTypeToType(&cd.Type)
@@ -1841,9 +2611,19 @@ func columnDefinitionToColumn(ctx *sql.Context, cd *sqlparser.ColumnDefinition,
 		return nil, err
 	}
 
+	onUpdateVal, err := convertOnUpdateExpression(ctx, cd, internalTyp)
+	if err != nil {
+		return nil, err
+	}
+
 	extra := ""
 	if cd.Type.Autoincrement {
 		extra = "auto_increment"
+	} else if onUpdateVal != nil {
+		extra = "on update CURRENT_TIMESTAMP"
+		if curTime, ok := cd.Type.OnUpdate.(*sqlparser.CurTimeFuncExpr); ok && curTime.Fsp != nil {
+			extra = fmt.Sprintf("%s(%s)", extra, sqlparser.String(curTime.Fsp))
+		}
 	}
 
 	return &sql.Column{
@@ -1852,6 +2632,7 @@ func columnDefinitionToColumn(ctx *sql.Context, cd *sqlparser.ColumnDefinition,
 		Name:          cd.Name.String(),
 		PrimaryKey:    isPkey,
 		Default:       defaultVal,
+		OnUpdate:      onUpdateVal,
 		AutoIncrement: bool(cd.Type.Autoincrement),
 		Comment:       comment,
 		Extra:         extra,
@@ -1873,12 +2654,60 @@ func convertDefaultExpression(ctx *sql.Context, defaultExpr sqlparser.Expr) (*sq
 	return ExpressionToColumnDefaultValue(ctx, parsedExpr, !isExpr)
 }
 
+// convertOnUpdateExpression returns the value of the ON UPDATE clause of the column definition given, or nil if it has
+// none. As in MySQL, the clause can only set DATETIME and TIMESTAMP columns to CURRENT_TIMESTAMP or one of its
+// synonyms, with an optional fractional seconds precision.
+func convertOnUpdateExpression(ctx *sql.Context, cd *sqlparser.ColumnDefinition, typ sql.Type) (*sql.ColumnDefaultValue, error) {
+	if cd.Type.OnUpdate == nil {
+		return nil, nil
+	}
+	if !sql.IsTime(typ) || typ == sql.Date {
+		return nil, sql.ErrInvalidOnUpdate.New(cd.Name.String())
+	}
+
+	var name string
+	var args []sql.Expression
+	switch e := cd.Type.OnUpdate.(type) {
+	case *sqlparser.FuncExpr:
+		if len(e.Exprs) != 0 {
+			return nil, sql.ErrInvalidOnUpdate.New(cd.Name.String())
+		}
+		name = e.Name.Lowered()
+	case *sqlparser.CurTimeFuncExpr:
+		name = e.Name.Lowered()
+		if e.Fsp != nil {
+			fsp, err := ExprToExpression(ctx, e.Fsp)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, fsp)
+		}
+	}
+
+	switch name {
+	case "current_timestamp", "localtime", "localtimestamp", "now":
+	default:
+		return nil, sql.ErrInvalidOnUpdate.New(cd.Name.String())
+	}
+
+	onUpdate, err := function.NewCurrTimestamp(args...)
+	if err != nil {
+		return nil, err
+	}
+	return sql.NewColumnDefaultValue(onUpdate, typ, true, false)
+}
+
 func convertAccountName(names ...sqlparser.AccountName) []plan.UserName {
 	userNames := make([]plan.UserName, len(names))
 	for i, name := range names {
+		// An account without a host matches any host, as if its host were "%"
+		host := name.Host
+		if name.AnyHost {
+			host = "%"
+		}
 		userNames[i] = plan.UserName{
 			Name:    name.Name,
-			Host:    name.Host,
+			Host:    host,
 			AnyHost: name.AnyHost,
 		}
 	}
@@ -1943,8 +2772,13 @@ func convertCreateUser(ctx *sql.Context, n *sqlparser.CreateUser) (*plan.CreateU
 		if user.Auth1 != nil {
 			if user.Auth1.Plugin == "mysql_native_password" && len(user.Auth1.Password) > 0 {
 				authUser.Auth1 = plan.AuthenticationMysqlNativePassword(user.Auth1.Password)
+			} else if user.Auth1.Plugin == "caching_sha2_password" && len(user.Auth1.Password) > 0 {
+				authUser.Auth1 = plan.AuthenticationCachingSha2Password(user.Auth1.Password)
 			} else if user.Auth1.Plugin == "" && len(user.Auth1.Password) > 0 {
 				authUser.Auth1 = plan.NewDefaultAuthentication(user.Auth1.Password)
+			} else if user.Auth1.Plugin != "" && len(user.Auth1.Password) == 0 && !user.Auth1.RandomPassword {
+				// Other plugins are provided by the integrator, and are given their authentication string as is
+				authUser.Auth1 = plan.AuthenticationPlugin{Name: user.Auth1.Plugin, AuthString: user.Auth1.Identity}
 			} else {
 				return nil, fmt.Errorf(`the given authentication format is not yet supported`)
 			}
@@ -2670,8 +3504,13 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 			exprs[0] = expression.NewDistinctExpression(exprs[0])
 		}
 
+		over, err := overToWindow(ctx, v.Over)
+		if err != nil {
+			return nil, err
+		}
+
 		return expression.NewUnresolvedFunction(v.Name.Lowered(),
-			isAggregateFunc(v), overToWindow(ctx, v.Over), exprs...), nil
+			isAggregateFunc(v), over, exprs...), nil
 	case *sqlparser.GroupConcatExpr:
 		exprs, err := selectExprsToExpressions(ctx, v.Exprs)
 		if err != nil {
@@ -2818,14 +3657,14 @@ func ExprToExpression(ctx *sql.Context, e sqlparser.Expr) (sql.Expression, error
 	}
 }
 
-func overToWindow(ctx *sql.Context, over *sqlparser.Over) *sql.Window {
+func overToWindow(ctx *sql.Context, over *sqlparser.Over) (*sql.Window, error) {
 	if over == nil {
-		return nil
+		return nil, nil
 	}
 
 	sortFields, err := orderByToSortFields(ctx, over.OrderBy)
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
 	partitions := make([]sql.Expression, len(over.PartitionBy))
@@ -2833,11 +3672,69 @@ func overToWindow(ctx *sql.Context, over *sqlparser.Over) *sql.Window {
 		var err error
 		partitions[i], err = ExprToExpression(ctx, expr)
 		if err != nil {
-			return nil
+			return nil, err
+		}
+	}
+
+	window := sql.NewWindow(partitions, sortFields)
+	if over.Frame != nil {
+		window.Frame, err = frameToWindowFrame(ctx, over.Frame)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return window, nil
+}
+
+// frameToWindowFrame converts the frame clause of a window. A frame with only a start bound ends at the current row.
+func frameToWindowFrame(ctx *sql.Context, frame *sqlparser.Frame) (*sql.WindowFrame, error) {
+	wf := &sql.WindowFrame{
+		Unit: sql.FrameRows,
+		End:  sql.WindowFrameBound{Type: sql.FrameCurrentRow},
+	}
+	if frame.Unit == sqlparser.RangeUnit {
+		wf.Unit = sql.FrameRange
+	}
+
+	var err error
+	wf.Start, err = frameBoundToWindowFrameBound(ctx, frame.Extent.Start)
+	if err != nil {
+		return nil, err
+	}
+	if frame.Extent.End != nil {
+		wf.End, err = frameBoundToWindowFrameBound(ctx, frame.Extent.End)
+		if err != nil {
+			return nil, err
 		}
 	}
+	return wf, nil
+}
+
+func frameBoundToWindowFrameBound(ctx *sql.Context, bound *sqlparser.FrameBound) (sql.WindowFrameBound, error) {
+	var b sql.WindowFrameBound
+	switch bound.Type {
+	case sqlparser.UnboundedPreceding:
+		b.Type = sql.FrameUnboundedPreceding
+	case sqlparser.ExprPreceding:
+		b.Type = sql.FramePreceding
+	case sqlparser.CurrentRow:
+		b.Type = sql.FrameCurrentRow
+	case sqlparser.ExprFollowing:
+		b.Type = sql.FrameFollowing
+	case sqlparser.UnboundedFollowing:
+		b.Type = sql.FrameUnboundedFollowing
+	default:
+		return b, sql.ErrUnsupportedSyntax.New(sqlparser.String(bound))
+	}
 
-	return sql.NewWindow(partitions, sortFields)
+	if bound.Expr != nil {
+		var err error
+		b.Offset, err = ExprToExpression(ctx, bound.Expr)
+		if err != nil {
+			return b, err
+		}
+	}
+	return b, nil
 }
 
 func isAggregateFunc(v *sqlparser.FuncExpr) bool {
@@ -2905,6 +3802,17 @@ func convertVal(v *sqlparser.SQLVal) (sql.Expression, error) {
 			v = strings.Trim(v[1:], "'")
 		}
 
+		// Hex numbers too long for an integer are the bytes they encode, such as the WKB of a geometry
+		if len(strings.TrimLeft(v, "0")) > 16 {
+			if len(v)%2 != 0 {
+				v = "0" + v
+			}
+			val, err := hex.DecodeString(v)
+			if err != nil {
+				return nil, err
+			}
+			return expression.NewLiteral(val, sql.LongBlob), nil
+		}
 		return convertInt(v, 16)
 	case sqlparser.HexVal:
 		val, err := v.HexDecode()
@@ -127,6 +127,64 @@ var fixtures = map[string]sql.Node{
 			}}),
 		},
 	),
+	"CREATE TABLE t1(a POINT SRID 4326, `b c` LINESTRING NOT NULL SRID 0, d POLYGON)": plan.NewCreateTable(
+		sql.UnresolvedDatabase(""),
+		"t1",
+		plan.IfNotExistsAbsent,
+		plan.IsTempTableAbsent,
+		&plan.TableSpec{
+			Schema: sql.NewPrimaryKeySchema(sql.Schema{{
+				Name:     "a",
+				Type:     sql.PointType{SRID: 4326, DefinedSRID: true},
+				Nullable: true,
+			}, {
+				Name:     "b c",
+				Type:     sql.LinestringType{SRID: 0, DefinedSRID: true},
+				Nullable: false,
+			}, {
+				Name:     "d",
+				Type:     sql.PolygonType{},
+				Nullable: true,
+			}}),
+		},
+	),
+	"CREATE TABLE t1(a INTEGER) ENGINE=InnoDB AUTO_INCREMENT=5 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin COMMENT='it''s, t1'": plan.NewCreateTable(
+		sql.UnresolvedDatabase(""),
+		"t1",
+		plan.IfNotExistsAbsent,
+		plan.IsTempTableAbsent,
+		&plan.TableSpec{
+			Schema: sql.NewPrimaryKeySchema(sql.Schema{{
+				Name:     "a",
+				Type:     sql.Int32,
+				Nullable: true,
+			}}),
+			Options: sql.TableOptions{
+				Collation: sql.Collation_utf8mb4_bin,
+				Comment:   "it's, t1",
+			},
+			AutoIncrement: 5,
+		},
+	),
+	`CREATE TABLE t1(a INTEGER) ROW_FORMAT=compressed ENGINE_ATTRIBUTE='{"a": 1}' SECONDARY_ENGINE_ATTRIBUTE='{}'`: plan.NewCreateTable(
+		sql.UnresolvedDatabase(""),
+		"t1",
+		plan.IfNotExistsAbsent,
+		plan.IsTempTableAbsent,
+		&plan.TableSpec{
+			Schema: sql.NewPrimaryKeySchema(sql.Schema{{
+				Name:     "a",
+				Type:     sql.Int32,
+				Nullable: true,
+			}}),
+			Options: sql.TableOptions{
+				Collation:                sql.Collation_Default,
+				RowFormat:                "COMPRESSED",
+				EngineAttribute:          `{"a": 1}`,
+				SecondaryEngineAttribute: "{}",
+			},
+		},
+	),
 	`CREATE TABLE t1(a INTEGER, b TEXT, PRIMARY KEY (a))`: plan.NewCreateTable(
 		sql.UnresolvedDatabase(""),
 		"t1",
@@ -956,9 +1014,16 @@ CREATE TABLE t2
 	`RENAME TABLE foo TO bar`: plan.NewRenameTable(
 		sql.UnresolvedDatabase(""), []string{"foo"}, []string{"bar"},
 	),
-	`RENAME TABLE foo TO bar, baz TO qux`: plan.NewRenameTable(
-		sql.UnresolvedDatabase(""), []string{"foo", "baz"}, []string{"bar", "qux"},
-	),
+	`RENAME TABLE foo TO bar, baz TO qux`: plan.NewMultiRenameTable([]plan.QualifiedRename{
+		{OldName: "foo", NewName: "bar"},
+		{OldName: "baz", NewName: "qux"},
+	}),
+	`RENAME TABLE db1.foo TO db2.bar`: plan.NewMultiRenameTable([]plan.QualifiedRename{
+		{OldDatabase: "db1", OldName: "foo", NewDatabase: "db2", NewName: "bar"},
+	}),
+	`ALTER TABLE foo RENAME TO db2.bar`: plan.NewMultiRenameTable([]plan.QualifiedRename{
+		{OldName: "foo", NewDatabase: "db2", NewName: "bar"},
+	}),
 	`ALTER TABLE foo RENAME bar`: plan.NewRenameTable(
 		sql.UnresolvedDatabase(""), []string{"foo"}, []string{"bar"},
 	),
@@ -1042,6 +1107,30 @@ CREATE TABLE t2
 		[]sql.IndexColumn{{"v1", 0}},
 		"",
 	),
+	`ALTER TABLE foo ADD CONSTRAINT uq1 UNIQUE (v1)`: plan.NewAlterCreateIndex(
+		plan.NewUnresolvedTable("foo", ""),
+		"uq1",
+		sql.IndexUsing_BTree,
+		sql.IndexConstraint_Unique,
+		[]sql.IndexColumn{{"v1", 0}},
+		"",
+	),
+	"ALTER TABLE foo ADD CONSTRAINT `uq 1` UNIQUE KEY (v1)": plan.NewAlterCreateIndex(
+		plan.NewUnresolvedTable("foo", ""),
+		"uq 1",
+		sql.IndexUsing_BTree,
+		sql.IndexConstraint_Unique,
+		[]sql.IndexColumn{{"v1", 0}},
+		"",
+	),
+	`ALTER TABLE foo ADD CONSTRAINT uq1 UNIQUE idx (v1)`: plan.NewAlterCreateIndex(
+		plan.NewUnresolvedTable("foo", ""),
+		"idx",
+		sql.IndexUsing_BTree,
+		sql.IndexConstraint_Unique,
+		[]sql.IndexColumn{{"v1", 0}},
+		"",
+	),
 	`ALTER TABLE foo DROP COLUMN bar`: plan.NewDropColumn(
 		sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("foo", ""), "bar",
 	),
@@ -1231,6 +1320,15 @@ CREATE TABLE t2
 			[]sql.Expression{expression.NewStar()},
 			plan.NewUnresolvedTable("foo", "")),
 	),
+	"EXPLAIN ANALYZE SELECT * FROM foo": func() sql.Node {
+		describe := plan.NewDescribeQuery(
+			"tree", plan.NewProject(
+				[]sql.Expression{expression.NewStar()},
+				plan.NewUnresolvedTable("foo", "")),
+		)
+		describe.Analyze = true
+		return describe
+	}(),
 	`SELECT foo, bar FROM foo;`: plan.NewProject(
 		[]sql.Expression{
 			expression.NewUnresolvedColumn("foo"),
@@ -1606,7 +1704,12 @@ CREATE TABLE t2
 	}}), false, []string{}, []sql.Expression{}, false),
 	`INSERT INTO t1 (col1, col2) VALUES ('a', DEFAULT)`: plan.NewInsertInto(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("t1", ""), plan.NewValues([][]sql.Expression{{
 		expression.NewLiteral("a", sql.LongText),
-	}}), false, []string{"col1"}, []sql.Expression{}, false),
+		expression.NewDefaultColumn(""),
+	}}), false, []string{"col1", "col2"}, []sql.Expression{}, false),
+	`INSERT INTO t1 (col1, col2) VALUES (DEFAULT(col2), 'b')`: plan.NewInsertInto(sql.UnresolvedDatabase(""), plan.NewUnresolvedTable("t1", ""), plan.NewValues([][]sql.Expression{{
+		expression.NewDefaultColumn("col2"),
+		expression.NewLiteral("b", sql.LongText),
+	}}), false, []string{"col1", "col2"}, []sql.Expression{}, false),
 	`UPDATE t1 SET col1 = ?, col2 = ? WHERE id = ?`: plan.NewUpdate(
 		plan.NewFilter(
 			expression.NewEquals(expression.NewUnresolvedColumn("id"), expression.NewBindVar("v3")),
@@ -1845,6 +1948,14 @@ CREATE TABLE t2
 		},
 		plan.NewUnresolvedTable("dual", ""),
 	),
+	`SELECT 0x0101000000000000000000F03F`: plan.NewProject(
+		[]sql.Expression{
+			expression.NewAlias("0x0101000000000000000000F03F",
+				expression.NewLiteral([]byte{1, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0xf0, 0x3f}, sql.LongBlob),
+			),
+		},
+		plan.NewUnresolvedTable("dual", ""),
+	),
 	`SELECT X'41'`: plan.NewProject(
 		[]sql.Expression{
 			expression.NewAlias("X'41'",
@@ -2207,6 +2318,44 @@ CREATE TABLE t2
 		plan.NewUnresolvedTable("bar", ""),
 		"foo",
 	),
+	"DROP INDEX IF EXISTS `foo` ON bar": func() sql.Node {
+		n := plan.NewAlterDropIndex(plan.NewUnresolvedTable("bar", ""), "foo")
+		n.IfExists = true
+		return n
+	}(),
+	`CREATE UNIQUE INDEX IF NOT EXISTS idx ON foo (bar)`: func() sql.Node {
+		n := plan.NewAlterCreateIndex(
+			plan.NewUnresolvedTable("foo", ""),
+			"idx",
+			sql.IndexUsing_BTree,
+			sql.IndexConstraint_Unique,
+			[]sql.IndexColumn{
+				{"bar", 0},
+			},
+			"",
+		)
+		n.IfNotExists = true
+		return n
+	}(),
+	`CREATE INDEX idx ON foo (bar) ALGORITHM = INPLACE LOCK NONE`: func() sql.Node {
+		n := plan.NewAlterCreateIndex(
+			plan.NewUnresolvedTable("foo", ""),
+			"idx",
+			sql.IndexUsing_BTree,
+			sql.IndexConstraint_None,
+			[]sql.IndexColumn{
+				{Name: "bar"},
+			},
+			"",
+		)
+		n.Algorithm, n.Lock = sql.IndexAlgorithm_Inplace, sql.IndexLock_None
+		return n
+	}(),
+	`DROP INDEX foo ON bar lock=shared algorithm=copy`: func() sql.Node {
+		n := plan.NewAlterDropIndex(plan.NewUnresolvedTable("bar", ""), "foo")
+		n.Algorithm, n.Lock = sql.IndexAlgorithm_Copy, sql.IndexLock_Shared
+		return n
+	}(),
 	`DESCRIBE FORMAT=TREE SELECT * FROM foo`: plan.NewDescribeQuery(
 		"tree",
 		plan.NewProject(
@@ -2387,6 +2536,22 @@ CREATE TABLE t2
 		),
 		plan.NewShowTableStatus(sql.UnresolvedDatabase("")),
 	),
+	`SHOW STATUS`: plan.NewShowStatus(plan.ShowStatusModifier_Session),
+	`SHOW GLOBAL STATUS LIKE 'Com_%'`: plan.NewFilter(
+		expression.NewLike(
+			expression.NewUnresolvedColumn("Variable_name"),
+			expression.NewLiteral("Com_%", sql.LongText),
+			nil,
+		),
+		plan.NewShowStatus(plan.ShowStatusModifier_Global),
+	),
+	`SHOW SESSION STATUS WHERE Value > 0`: plan.NewFilter(
+		expression.NewGreaterThan(
+			expression.NewUnresolvedColumn("Value"),
+			expression.NewLiteral(int8(0), sql.Int8),
+		),
+		plan.NewShowStatus(plan.ShowStatusModifier_Session),
+	),
 	`USE foo`: plan.NewUse(sql.UnresolvedDatabase("foo")),
 	`DESCRIBE foo.bar`: plan.NewShowColumns(false,
 		plan.NewUnresolvedTable("bar", "foo"),
@@ -2533,8 +2698,45 @@ CREATE TABLE t2
 		),
 		showCollationProjection,
 	),
-	"BEGIN":                                  plan.NewStartTransaction("", sql.ReadWrite),
-	"START TRANSACTION":                      plan.NewStartTransaction("", sql.ReadWrite),
+	"BEGIN":             plan.NewStartTransaction("", sql.ReadWrite),
+	"START TRANSACTION": plan.NewStartTransaction("", sql.ReadWrite),
+	"START TRANSACTION WITH CONSISTENT SNAPSHOT":             plan.NewStartTransaction("", sql.ReadWrite),
+	"START TRANSACTION /*!40100 WITH CONSISTENT SNAPSHOT */": plan.NewStartTransaction("", sql.ReadWrite),
+	"START TRANSACTION WITH CONSISTENT SNAPSHOT, READ ONLY":  plan.NewStartTransaction("", sql.ReadOnly),
+	"FLUSH TABLES":                            plan.NewFlush(false, sql.FlushOption{Kind: sql.FlushKind_Tables, Name: "TABLES"}),
+	"FLUSH /*!40101 LOCAL */ TABLES":          plan.NewFlush(true, sql.FlushOption{Kind: sql.FlushKind_Tables, Name: "TABLES"}),
+	"FLUSH TABLES WITH READ LOCK":             plan.NewFlush(false, sql.FlushOption{Kind: sql.FlushKind_Tables, Name: "TABLES WITH READ LOCK"}),
+	"FLUSH TABLES t1, `my``db`.t2 FOR EXPORT": plan.NewFlush(false, sql.FlushOption{Kind: sql.FlushKind_Tables, Name: "TABLES FOR EXPORT", Tables: []sql.FlushTable{{Name: "t1"}, {Database: "my`db", Name: "t2"}}}),
+	"FLUSH NO_WRITE_TO_BINLOG PRIVILEGES, binary  logs, STATUS": plan.NewFlush(true,
+		sql.FlushOption{Kind: sql.FlushKind_Privileges, Name: "PRIVILEGES"},
+		sql.FlushOption{Kind: sql.FlushKind_Logs, Name: "BINARY LOGS"},
+		sql.FlushOption{Kind: sql.FlushKind_Status, Name: "STATUS"},
+	),
+	"SHUTDOWN":                              plan.NewAdminCommand(sql.AdminCommand_Shutdown),
+	"reset master to 1234":                  plan.NewAdminCommand(sql.AdminCommand_ResetMaster, "to", "1234"),
+	"RESET SLAVE ALL":                       plan.NewAdminCommand(sql.AdminCommand_ResetReplica, "ALL"),
+	"HANDLER mydb.t1 OPEN AS h":             plan.NewHandlerOpen("mydb", "t1", "h"),
+	"HANDLER h CLOSE":                       plan.NewHandlerClose("h"),
+	"HANDLER h READ FIRST":                  plan.NewHandlerRead("h", "", plan.HandlerRead_First, "", nil, nil, 1, 0),
+	"HANDLER h READ `PRIMARY` PREV LIMIT 2": plan.NewHandlerRead("h", "PRIMARY", plan.HandlerRead_Prev, "", nil, nil, 2, 0),
+	"HANDLER h READ idx >= (1, 'a') WHERE b > 2": plan.NewHandlerRead("h", "idx", plan.HandlerRead_Key, ">=",
+		[]sql.Expression{expression.NewLiteral(int8(1), sql.Int8), expression.NewLiteral("a", sql.LongText)},
+		expression.NewGreaterThan(expression.NewUnresolvedColumn("b"), expression.NewLiteral(int8(2), sql.Int8)),
+		1, 0,
+	),
+	"PREPARE stmt FROM 'SELECT * FROM t WHERE a = ?'":  plan.NewPrepareQuery("stmt", expression.NewLiteral("SELECT * FROM t WHERE a = ?", sql.LongText)),
+	"prepare `my stmt` from @query":                    plan.NewPrepareQuery("my stmt", expression.NewUserVar("query")),
+	"EXECUTE stmt":                                     plan.NewExecuteQuery("stmt", nil),
+	"EXECUTE stmt USING @a, @b":                        plan.NewExecuteQuery("stmt", []sql.Expression{expression.NewUserVar("a"), expression.NewUserVar("b")}),
+	"DEALLOCATE PREPARE stmt":                          plan.NewDeallocateQuery("stmt"),
+	"DROP PREPARE `stmt`;":                             plan.NewDeallocateQuery("stmt"),
+	"ANALYZE TABLE mydb.mytable":                       plan.NewAnalyzeTable(plan.NewUnresolvedTable("mytable", "mydb")),
+	"CHECKSUM TABLE t1, `my``db`.t2 EXTENDED":          plan.NewChecksumTable(plan.ChecksumMode_Extended, plan.ChecksumTableName{Name: "t1"}, plan.ChecksumTableName{Database: "my`db", Name: "t2"}),
+	"OPTIMIZE NO_WRITE_TO_BINLOG TABLE t1, mydb.t2":    plan.NewOptimizeTable(plan.MaintenanceTableName{Name: "t1"}, plan.MaintenanceTableName{Database: "mydb", Name: "t2"}),
+	"REPAIR TABLE t1 QUICK USE_FRM":                    plan.NewRepairTable(sql.RepairOptions{Quick: true, UseFrm: true}, plan.MaintenanceTableName{Name: "t1"}),
+	"checksum table t1 quick":                          plan.NewChecksumTable(plan.ChecksumMode_Quick, plan.ChecksumTableName{Name: "t1"}),
+	"ALTER TABLE `my``table` DISABLE KEYS":             plan.NewAlterDisableKeys(plan.NewUnresolvedTable("my`table", "")),
+	"/*!40000 ALTER TABLE mydb.mytable ENABLE KEYS */": plan.NewAlterEnableKeys(plan.NewUnresolvedTable("mytable", "mydb")),
 	"COMMIT":                                 plan.NewCommit(""),
 	`ROLLBACK`:                               plan.NewRollback(""),
 	"SAVEPOINT abc":                          plan.NewCreateSavepoint("", "abc"),
@@ -3007,6 +3209,66 @@ CREATE TABLE t2
 			),
 		},
 	),
+	`with recursive cte1 as (select a from b) select * from cte1`: func() sql.Node {
+		with := plan.NewWith(
+			plan.NewProject(
+				[]sql.Expression{
+					expression.NewStar(),
+				},
+				plan.NewUnresolvedTable("cte1", "")),
+			[]*plan.CommonTableExpression{
+				plan.NewCommonTableExpression(
+					plan.NewSubqueryAlias("cte1", "select a from b",
+						plan.NewProject(
+							[]sql.Expression{
+								expression.NewUnresolvedColumn("a"),
+							},
+							plan.NewUnresolvedTable("b", ""),
+						),
+					),
+					[]string{},
+				),
+			},
+		)
+		with.Recursive = true
+		return with
+	}(),
+	`with cte1 as materialized (select a from b), cte2 (x) as not materialized (select c from d) select * from cte1`: func() sql.Node {
+		with := plan.NewWith(
+			plan.NewProject(
+				[]sql.Expression{
+					expression.NewStar(),
+				},
+				plan.NewUnresolvedTable("cte1", "")),
+			[]*plan.CommonTableExpression{
+				plan.NewCommonTableExpression(
+					plan.NewSubqueryAlias("cte1", "select a from b",
+						plan.NewProject(
+							[]sql.Expression{
+								expression.NewUnresolvedColumn("a"),
+							},
+							plan.NewUnresolvedTable("b", ""),
+						),
+					),
+					[]string{},
+				),
+				plan.NewCommonTableExpression(
+					plan.NewSubqueryAlias("cte2", "select c from d",
+						plan.NewProject(
+							[]sql.Expression{
+								expression.NewUnresolvedColumn("c"),
+							},
+							plan.NewUnresolvedTable("d", ""),
+						),
+					),
+					[]string{"x"},
+				),
+			},
+		)
+		with.CTEs[0].Materialization = plan.CteMaterialized
+		with.CTEs[1].Materialization = plan.CteNotMaterialized
+		return with
+	}(),
 	`with cte1 as (select a from b), cte2 as (select c from d) select * from cte1`: plan.NewWith(
 		plan.NewProject(
 			[]sql.Expression{
@@ -3431,14 +3693,15 @@ func assertNodesEqualWithDiff(t *testing.T, expected, actual sql.Node) bool {
 }
 
 var fixturesErrors = map[string]*errors.Kind{
-	`SELECT INTERVAL 1 DAY - '2018-05-01'`:                      sql.ErrUnsupportedSyntax,
-	`SELECT INTERVAL 1 DAY * '2018-05-01'`:                      sql.ErrUnsupportedSyntax,
-	`SELECT '2018-05-01' * INTERVAL 1 DAY`:                      sql.ErrUnsupportedSyntax,
-	`SELECT '2018-05-01' / INTERVAL 1 DAY`:                      sql.ErrUnsupportedSyntax,
-	`SELECT INTERVAL 1 DAY + INTERVAL 1 DAY`:                    sql.ErrUnsupportedSyntax,
-	`SELECT '2018-05-01' + (INTERVAL 1 DAY + INTERVAL 1 DAY)`:   sql.ErrUnsupportedSyntax,
-	"DESCRIBE FORMAT=pretty SELECT * FROM foo":                  errInvalidDescribeFormat,
-	`CREATE TABLE test (pk int null primary key)`:               ErrPrimaryKeyOnNullField,
+	`SELECT INTERVAL 1 DAY - '2018-05-01'`:                    sql.ErrUnsupportedSyntax,
+	`SELECT INTERVAL 1 DAY * '2018-05-01'`:                    sql.ErrUnsupportedSyntax,
+	`SELECT '2018-05-01' * INTERVAL 1 DAY`:                    sql.ErrUnsupportedSyntax,
+	`SELECT '2018-05-01' / INTERVAL 1 DAY`:                    sql.ErrUnsupportedSyntax,
+	`SELECT INTERVAL 1 DAY + INTERVAL 1 DAY`:                  sql.ErrUnsupportedSyntax,
+	`SELECT '2018-05-01' + (INTERVAL 1 DAY + INTERVAL 1 DAY)`: sql.ErrUnsupportedSyntax,
+	"DESCRIBE FORMAT=pretty SELECT * FROM foo":                errInvalidDescribeFormat,
+	`CREATE TABLE test (pk int null primary key)`:             ErrPrimaryKeyOnNullField,
+	"FLUSH CACHES": sql.ErrUnsupportedSyntax,
 	`CREATE TABLE test (pk int not null null primary key)`:      ErrPrimaryKeyOnNullField,
 	`CREATE TABLE test (pk int null, primary key(pk))`:          ErrPrimaryKeyOnNullField,
 	`CREATE TABLE test (pk int not null null, primary key(pk))`: ErrPrimaryKeyOnNullField,
@@ -3448,6 +3711,16 @@ var fixturesErrors = map[string]*errors.Kind{
 	`SHOW VARIABLES WHERE Variable_name = 'autocommit'`:         sql.ErrUnsupportedFeature,
 	`SHOW SESSION VARIABLES WHERE Variable_name IS NOT NULL`:    sql.ErrUnsupportedFeature,
 	`KILL CONNECTION 4294967296`:                                sql.ErrUnsupportedFeature,
+	`CREATE INDEX idx ON foo (bar) ALGORITHM=FAST`:              sql.ErrUnknownAlterAlgorithm,
+	`DROP INDEX idx ON foo LOCK=ROW`:                            sql.ErrUnknownAlterLock,
+	`CREATE TABLE foo (p POINT SRID 1234)`:                      sql.ErrInvalidSRID,
+	`CREATE TABLE foo (i int) COLLATE=not_a_collation`:          sql.ErrCollationNotSupported,
+	`CREATE TABLE foo (i int) ROW_FORMAT=WIDE`:                  sql.ErrInvalidValue,
+	`CREATE TABLE foo (i int) ENGINE_ATTRIBUTE='not json'`:      sql.ErrInvalidJSONText,
+	`PREPARE stmt FROM 1`:                                       sql.ErrUnsupportedSyntax,
+	`PREPARE stmt FROM @@sql_mode`:                              sql.ErrUnsupportedSyntax,
+	`EXECUTE stmt USING 1, @b`:                                  sql.ErrUnsupportedSyntax,
+	`DEALLOCATE PREPARE mydb.stmt`:                              sql.ErrSyntaxError,
 }
 
 func TestParseOne(t *testing.T) {
@@ -3489,6 +3762,26 @@ func TestParseOne(t *testing.T) {
 			"SELECT 1; SELECT 2; -- empty statement with comment\n",
 			[]string{"SELECT 1", "SELECT 2", "-- empty statement with comment"},
 		},
+		{
+			"PREPARE s FROM 'SELECT 1; SELECT 2'; EXECUTE s; DEALLOCATE PREPARE s",
+			[]string{"PREPARE s FROM 'SELECT 1; SELECT 2'", "EXECUTE s", "DEALLOCATE PREPARE s"},
+		},
+		{
+			"RESET SLAVE ALL; SHUTDOWN",
+			[]string{"RESET SLAVE ALL", "SHUTDOWN"},
+		},
+		{
+			"HANDLER t OPEN; HANDLER t READ FIRST WHERE s = 'a; b'; HANDLER t CLOSE",
+			[]string{"HANDLER t OPEN", "HANDLER t READ FIRST WHERE s = 'a; b'", "HANDLER t CLOSE"},
+		},
+		{
+			"CHECKSUM TABLE t1, `t;2` QUICK; SELECT 1",
+			[]string{"CHECKSUM TABLE t1, `t;2` QUICK", "SELECT 1"},
+		},
+		{
+			"OPTIMIZE TABLE t1; REPAIR TABLE t1 QUICK; SELECT 1",
+			[]string{"OPTIMIZE TABLE t1", "REPAIR TABLE t1 QUICK", "SELECT 1"},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.input, func(t *testing.T) {
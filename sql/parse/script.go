@@ -0,0 +1,149 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+	"unicode"
+
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrUnterminatedScript is returned by SplitScript when a script ends inside a quoted string or a comment.
+var ErrUnterminatedScript = errors.NewKind("line %d: unterminated %s")
+
+// ErrInvalidDelimiter is returned by SplitScript for a DELIMITER command without a delimiter.
+var ErrInvalidDelimiter = errors.NewKind("line %d: DELIMITER must be followed by a delimiter")
+
+// defaultDelimiter is the statement delimiter in effect at the start of a script.
+const defaultDelimiter = ";"
+
+// ScriptStatement is a single statement of a SQL script.
+type ScriptStatement struct {
+	// Query is the text of the statement, without its delimiter.
+	Query string
+	// Line is the line of the script the statement starts on, counting from 1.
+	Line int
+}
+
+// SplitScript splits a SQL script, such as the contents of a .sql file, into its statements the way the mysql client
+// does. Statements end at the current delimiter, which is a semicolon unless changed with the client-side DELIMITER
+// command, so that stored programs with semicolons in their bodies can be written as one statement:
+//
+//	DELIMITER $$
+//	CREATE PROCEDURE p() BEGIN SELECT 1; SELECT 2; END$$
+//	DELIMITER ;
+//
+// Delimiters inside quoted strings, quoted identifiers and comments are ignored. The last statement doesn't need a
+// delimiter, and statements with nothing but whitespace are skipped.
+func SplitScript(script string) ([]ScriptStatement, error) {
+	var statements []ScriptStatement
+	delimiter := defaultDelimiter
+	line := 1
+	// start is the offset of the current statement, and startLine the line it starts on
+	start, startLine := 0, 1
+	atLineStart := true
+
+	emit := func(end int) {
+		query := script[start:end]
+		trimmed := strings.TrimLeftFunc(query, unicode.IsSpace)
+		startLine += strings.Count(query[:len(query)-len(trimmed)], "\n")
+		trimmed = strings.TrimRightFunc(trimmed, unicode.IsSpace)
+		if trimmed != "" {
+			statements = append(statements, ScriptStatement{Query: trimmed, Line: startLine})
+		}
+	}
+
+	for i := 0; i < len(script); {
+		if atLineStart {
+			atLineStart = false
+			if newDelimiter, end, ok := delimiterCommand(script, i); ok && strings.TrimSpace(script[start:i]) == "" {
+				if newDelimiter == "" {
+					return nil, ErrInvalidDelimiter.New(line)
+				}
+				delimiter = newDelimiter
+				i = end
+				start, startLine = i, line
+				continue
+			}
+		}
+
+		c := script[i]
+		switch {
+		case c == '\n':
+			line++
+			atLineStart = true
+			i++
+		case c == '\'' || c == '"' || c == '`':
+			end, ok := skipQuoted(script, i)
+			if !ok {
+				return nil, ErrUnterminatedScript.New(line, "quoted string")
+			}
+			line += strings.Count(script[i:end], "\n")
+			i = end
+		case c == '#' || (c == '-' && strings.HasPrefix(script[i:], "-- ")):
+			end := strings.IndexByte(script[i:], '\n')
+			if end < 0 {
+				end = len(script)
+			} else {
+				end += i
+			}
+			i = end
+		case strings.HasPrefix(script[i:], "/*"):
+			if !strings.Contains(script[i+2:], "*/") {
+				return nil, ErrUnterminatedScript.New(line, "comment")
+			}
+			_, end := commentBody(script, i)
+			line += strings.Count(script[i:end], "\n")
+			i = end
+		case strings.HasPrefix(script[i:], delimiter):
+			emit(i)
+			i += len(delimiter)
+			start, startLine = i, line
+		default:
+			i++
+		}
+	}
+	emit(len(script))
+
+	return statements, nil
+}
+
+// delimiterCommand returns the delimiter set by the DELIMITER command at offset |i| of the script, if there is one,
+// along with the offset of the end of its line.
+func delimiterCommand(script string, i int) (string, int, bool) {
+	for i < len(script) && (script[i] == ' ' || script[i] == '\t') {
+		i++
+	}
+	const keyword = "delimiter"
+	if len(script)-i < len(keyword) || !strings.EqualFold(script[i:i+len(keyword)], keyword) {
+		return "", 0, false
+	}
+	rest := script[i+len(keyword):]
+	if rest != "" && rest[0] != ' ' && rest[0] != '\t' && rest[0] != '\r' && rest[0] != '\n' {
+		return "", 0, false
+	}
+
+	end := strings.IndexByte(rest, '\n')
+	if end < 0 {
+		end = len(rest)
+	}
+	fields := strings.Fields(rest[:end])
+	delimiter := ""
+	if len(fields) > 0 {
+		delimiter = fields[0]
+	}
+	return delimiter, i + len(keyword) + end, true
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+func TestSplitScript(t *testing.T) {
+	testCases := []struct {
+		name     string
+		script   string
+		expected []ScriptStatement
+	}{
+		{
+			name:     "single statement without delimiter",
+			script:   "SELECT 1",
+			expected: []ScriptStatement{{"SELECT 1", 1}},
+		},
+		{
+			name:   "multiple statements",
+			script: "SELECT 1;\nSELECT 2; SELECT 3;\n\n\n  SELECT\n4;\n",
+			expected: []ScriptStatement{
+				{"SELECT 1", 1},
+				{"SELECT 2", 2},
+				{"SELECT 3", 2},
+				{"SELECT\n4", 5},
+			},
+		},
+		{
+			name:   "delimiters in strings, identifiers and comments",
+			script: "SELECT ';', \"a;b\", 'it''s;', 'x\\';';\nSELECT `a;b` FROM t; -- c;d\n# e;f\nSELECT /* g;\nh */ 1;",
+			expected: []ScriptStatement{
+				{"SELECT ';', \"a;b\", 'it''s;', 'x\\';'", 1},
+				{"SELECT `a;b` FROM t", 2},
+				{"-- c;d\n# e;f\nSELECT /* g;\nh */ 1", 2},
+			},
+		},
+		{
+			name: "delimiter command",
+			script: "CREATE TABLE t (a int);\n" +
+				"DELIMITER $$\n" +
+				"CREATE PROCEDURE p()\n" +
+				"BEGIN\n" +
+				"  INSERT INTO t VALUES (1);\n" +
+				"  SELECT * FROM t;\n" +
+				"END$$\n" +
+				"delimiter //\n" +
+				"CALL p() //\n" +
+				"DELIMITER ;\n" +
+				"SELECT 'DELIMITER $$';\n",
+			expected: []ScriptStatement{
+				{"CREATE TABLE t (a int)", 1},
+				{"CREATE PROCEDURE p()\nBEGIN\n  INSERT INTO t VALUES (1);\n  SELECT * FROM t;\nEND", 3},
+				{"CALL p()", 9},
+				{"SELECT 'DELIMITER $$'", 11},
+			},
+		},
+		{
+			name:     "empty statements",
+			script:   ";;\n  ;\n",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			statements, err := SplitScript(tt.script)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, statements)
+		})
+	}
+}
+
+func TestSplitScriptErrors(t *testing.T) {
+	testCases := []struct {
+		script  string
+		err     *errors.Kind
+		message string
+	}{
+		{"SELECT 1;\nSELECT 'abc;", ErrUnterminatedScript, "line 2: unterminated quoted string"},
+		{"SELECT 1;\n\nSELECT /* abc;", ErrUnterminatedScript, "line 3: unterminated comment"},
+		{"SELECT 1;\nDELIMITER\nSELECT 2;", ErrInvalidDelimiter, "line 2: DELIMITER must be followed by a delimiter"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.script, func(t *testing.T) {
+			_, err := SplitScript(tt.script)
+			require.True(t, tt.err.Is(err))
+			require.Equal(t, tt.message, err.Error())
+		})
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parse
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ShowExtension converts a SHOW statement added by an integrator, given as the full text of the query, to a node.
+type ShowExtension func(ctx *sql.Context, query string) (sql.Node, error)
+
+var showExtensions = struct {
+	mu         sync.RWMutex
+	extensions map[string]ShowExtension
+}{extensions: make(map[string]ShowExtension)}
+
+// RegisterShowExtension registers the conversion of the SHOW statement whose name is the identifier given, such as
+// BRANCHES for SHOW BRANCHES. The name must not be a keyword of the parser, and the statements built in take
+// precedence over extensions. The parser skips everything after the name, so extensions that take arguments parse them
+// from the query they're given. If an extension is already registered with the name, it's replaced.
+func RegisterShowExtension(name string, ext ShowExtension) {
+	showExtensions.mu.Lock()
+	defer showExtensions.mu.Unlock()
+	showExtensions.extensions[strings.ToLower(name)] = ext
+}
+
+// NewShowQueryExtension returns a ShowExtension whose statement returns the result of the SELECT statement given, like
+// SHOW ENGINES returns the rows of INFORMATION_SCHEMA.ENGINES.
+func NewShowQueryExtension(selectQuery string) ShowExtension {
+	return func(ctx *sql.Context, _ string) (sql.Node, error) {
+		return Parse(ctx, selectQuery)
+	}
+}
+
+// showExtension returns the extension registered for the SHOW statement with the name given, if any.
+func showExtension(name string) (ShowExtension, bool) {
+	showExtensions.mu.RLock()
+	defer showExtensions.mu.RUnlock()
+	ext, ok := showExtensions.extensions[strings.ToLower(name)]
+	return ext, ok
+}
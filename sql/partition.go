@@ -48,3 +48,29 @@ func (i *slicePartitionIter) Close(*Context) error {
 	i.partitions = nil
 	return nil
 }
+
+// PartitionLocality describes where the rows of a partition are stored.
+type PartitionLocality struct {
+	// Node is the address of the node that stores the rows of the partition. Partitions whose Node is the same are
+	// read by the same workers of a distributed exchange.
+	Node string
+}
+
+// RemotePartition is a partition whose rows are stored on another node than the server that reads them, such as a
+// shard of a federated table.
+type RemotePartition interface {
+	Partition
+	// Locality returns where the rows of the partition are stored.
+	Locality() PartitionLocality
+}
+
+// ShardedTable is a table whose partitions may be RemotePartitions stored across several nodes. The partitions of a
+// sharded table are read concurrently by a distributed exchange, which retries reading a partition when it fails with
+// an error the table reports as retryable. The rows a partition returned before it failed are skipped when it's read
+// again, so a partition must return its rows in the same order every time it's read.
+type ShardedTable interface {
+	Table
+	// IsRetryable returns whether reading the partition given may succeed if it's attempted again after the error
+	// given, such as when the node that stores it couldn't be reached.
+	IsRetryable(partition Partition, err error) bool
+}
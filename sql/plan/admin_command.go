@@ -0,0 +1,80 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// AdminCommand represents a statement that runs an admin command, such as SHUTDOWN or RESET MASTER. The command is
+// looked up by name and run by its Runner when the statement is executed.
+type AdminCommand struct {
+	// Name is the name of the command, such as sql.AdminCommand_Shutdown.
+	Name string
+	// Args are the words of the statement following the name of the command.
+	Args []string
+	// Runner runs the command. It's assigned by the analyzer.
+	Runner sql.AdminCommandRunner
+}
+
+var _ sql.Node = (*AdminCommand)(nil)
+
+// NewAdminCommand returns a new AdminCommand node for the command with the name and arguments given.
+func NewAdminCommand(name string, args ...string) *AdminCommand {
+	return &AdminCommand{Name: name, Args: args}
+}
+
+// Resolved implements the sql.Node interface.
+func (a *AdminCommand) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (a *AdminCommand) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (a *AdminCommand) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 0)
+	}
+	return a, nil
+}
+
+// Schema implements the sql.Node interface.
+func (a *AdminCommand) Schema() sql.Schema {
+	return sql.OkResultSchema
+}
+
+// RowIter implements the sql.Node interface.
+func (a *AdminCommand) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if a.Runner == nil {
+		return nil, sql.ErrAdminCommandNotSupported.New(a.Name)
+	}
+	if err := a.Runner.RunAdminCommand(ctx, a.Name, a.Args); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+func (a *AdminCommand) String() string {
+	if len(a.Args) == 0 {
+		return a.Name
+	}
+	return a.Name + " " + strings.Join(a.Args, " ")
+}
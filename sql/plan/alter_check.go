@@ -208,11 +208,12 @@ func (p DropCheck) String() string {
 func NewCheckDefinition(ctx *sql.Context, check *sql.CheckConstraint) (*sql.CheckDefinition, error) {
 	// When transforming an analyzed CheckConstraint into a CheckDefinition (for storage), we strip off any table
 	// qualifiers that got resolved during analysis. This is to naively match the MySQL behavior, which doesn't print
-	// any table qualifiers in check expressions.
+	// any table qualifiers in check expressions. Column names that must be quoted are quoted, so that the stored
+	// expression can be parsed again.
 	unqualifiedCols, err := expression.TransformUp(check.Expr, func(e sql.Expression) (sql.Expression, error) {
 		gf, ok := e.(*expression.GetField)
 		if ok {
-			return expression.NewGetField(gf.Index(), gf.Type(), gf.Name(), gf.IsNullable()), nil
+			return expression.NewGetField(gf.Index(), gf.Type(), sql.FormatIdentifier(gf.Name()), gf.IsNullable()), nil
 		}
 		return e, nil
 	})
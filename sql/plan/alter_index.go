@@ -17,7 +17,9 @@ package plan
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -59,8 +61,20 @@ type AlterIndex struct {
 	Columns []sql.IndexColumn
 	// Comment is the comment that was left at index creation, if any
 	Comment string
+	// IfNotExists is whether creating an index does nothing when the table has an index with the same name
+	IfNotExists bool
+	// IfExists is whether dropping an index does nothing when the table has no index with that name
+	IfExists bool
+	// Algorithm states whether an index is built in place or by copying the table, or neither was specified
+	Algorithm sql.IndexAlgorithm
+	// Lock states the concurrent access to the table allowed while an index is built, if specified
+	Lock sql.IndexLock
 }
 
+// indexBuildProgressInterval is how often the progress of an index built in the background is reported to the process
+// list.
+const indexBuildProgressInterval = 100 * time.Millisecond
+
 func NewAlterCreateIndex(table sql.Node, indexName string, using sql.IndexUsing, constraint sql.IndexConstraint, columns []sql.IndexColumn, comment string) *AlterIndex {
 	return &AlterIndex{
 		Action:     IndexAction_Create,
@@ -128,6 +142,10 @@ func (p *AlterIndex) Execute(ctx *sql.Context) error {
 
 	switch p.Action {
 	case IndexAction_Create:
+		if p.IfNotExists && p.IndexName != "" && hasIndex(ctx, indexable, p.IndexName) {
+			addNote(ctx, mysql.ERDupKeyName, "Duplicate key name '%s'", p.IndexName)
+			return nil
+		}
 		if len(p.Columns) == 0 {
 			return ErrCreateIndexMissingColumns.New()
 		}
@@ -149,8 +167,21 @@ func (p *AlterIndex) Execute(ctx *sql.Context) error {
 			}
 		}
 
-		return indexable.CreateIndex(ctx, p.IndexName, p.Using, p.Constraint, p.Columns, p.Comment)
+		if p.Constraint == sql.IndexConstraint_Spatial {
+			if err := ValidateSpatialIndex(indexable.Schema(), p.Columns); err != nil {
+				return err
+			}
+		}
+
+		return p.createIndex(ctx, indexable)
 	case IndexAction_Drop:
+		if p.Algorithm == sql.IndexAlgorithm_Copy && p.Lock == sql.IndexLock_None {
+			return sql.ErrAlterOperationNotSupportedReason.New("LOCK=NONE", "COPY algorithm requires a lock", "LOCK=SHARED")
+		}
+		if p.IfExists && !hasIndex(ctx, indexable, p.IndexName) {
+			addNote(ctx, mysql.ERCantDropFieldOrKey, "Can't DROP '%s'; check that column/key exists", p.IndexName)
+			return nil
+		}
 		return indexable.DropIndex(ctx, p.IndexName)
 	case IndexAction_Rename:
 		return indexable.RenameIndex(ctx, p.PreviousIndexName, p.IndexName)
@@ -159,7 +190,88 @@ func (p *AlterIndex) Execute(ctx *sql.Context) error {
 	}
 }
 
+// createIndex adds the index to the table given. Indexes are built in the background when the table supports it,
+// unless the COPY algorithm was asked for, and otherwise the table is blocked while they're built, which the INPLACE
+// algorithm and a LOCK=NONE clause don't allow.
+func (p *AlterIndex) createIndex(ctx *sql.Context, indexable sql.IndexAlterableTable) error {
+	if p.Algorithm == sql.IndexAlgorithm_Copy && p.Lock == sql.IndexLock_None {
+		return sql.ErrAlterOperationNotSupportedReason.New("LOCK=NONE", "COPY algorithm requires a lock", "LOCK=SHARED")
+	}
+	if async, ok := indexable.(sql.AsyncIndexAlterableTable); ok && p.Algorithm != sql.IndexAlgorithm_Copy {
+		build, err := async.StartIndexBuild(ctx, p.IndexName, p.Using, p.Constraint, p.Columns, p.Comment, p.Lock)
+		if err != nil {
+			return err
+		}
+		return waitForIndexBuild(ctx, build)
+	}
+	if p.Algorithm == sql.IndexAlgorithm_Inplace {
+		return sql.ErrAlterOperationNotSupported.New("ALGORITHM=INPLACE", "ALGORITHM=COPY")
+	}
+	if p.Lock == sql.IndexLock_None {
+		return sql.ErrAlterOperationNotSupportedReason.New("LOCK=NONE", "the table can't build indexes in place", "LOCK=SHARED")
+	}
+	return indexable.CreateIndex(ctx, p.IndexName, p.Using, p.Constraint, p.Columns, p.Comment)
+}
+
+// waitForIndexBuild waits for the index build given to finish, reporting its progress as the stage of the process of
+// the context given.
+func waitForIndexBuild(ctx *sql.Context, build sql.IndexBuild) error {
+	ticker := time.NewTicker(indexBuildProgressInterval)
+	defer ticker.Stop()
+	for {
+		done, total := build.Progress()
+		ctx.ReportStageProgress(sql.StageBuildingIndex, done, total)
+		select {
+		case <-build.Done():
+			done, total = build.Progress()
+			ctx.ReportStageProgress(sql.StageBuildingIndex, done, total)
+			return build.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// hasIndex returns whether the table given has an index with the name given. Tables that don't report their indexes
+// are assumed to have it.
+func hasIndex(ctx *sql.Context, table sql.Table, name string) bool {
+	indexed, ok := table.(sql.IndexedTable)
+	if !ok {
+		return true
+	}
+	indexes, err := indexed.GetIndexes(ctx)
+	if err != nil {
+		return true
+	}
+	for _, index := range indexes {
+		if strings.EqualFold(index.ID(), name) {
+			return true
+		}
+	}
+	return false
+}
+
 // RowIter implements the Node interface.
+// ValidateSpatialIndex returns an error if a SPATIAL index can't be created on the columns of the schema given. A
+// spatial index has a single column, which must be a spatial column that's NOT NULL. Columns that aren't in the schema
+// are left for the caller to report.
+func ValidateSpatialIndex(sch sql.Schema, columns []sql.IndexColumn) error {
+	if len(columns) != 1 {
+		return sql.ErrSpatialIndexKeyParts.New()
+	}
+	for _, col := range sch {
+		if !strings.EqualFold(col.Name, columns[0].Name) {
+			continue
+		}
+		if _, ok := col.Type.(sql.SpatialColumnType); !ok {
+			return sql.ErrSpatialIndexColumnType.New()
+		}
+		if col.Nullable {
+			return sql.ErrSpatialIndexNullable.New()
+		}
+	}
+	return nil
+}
+
 func (p *AlterIndex) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	err := p.Execute(ctx)
 	if err != nil {
@@ -175,12 +287,10 @@ func (p *AlterIndex) WithChildren(children ...sql.Node) (sql.Node, error) {
 		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 1)
 	}
 	switch p.Action {
-	case IndexAction_Create:
-		return NewAlterCreateIndex(children[0], p.IndexName, p.Using, p.Constraint, p.Columns, p.Comment), nil
-	case IndexAction_Drop:
-		return NewAlterDropIndex(children[0], p.IndexName), nil
-	case IndexAction_Rename:
-		return NewAlterRenameIndex(children[0], p.PreviousIndexName, p.IndexName), nil
+	case IndexAction_Create, IndexAction_Drop, IndexAction_Rename:
+		np := *p
+		np.Table = children[0]
+		return &np, nil
 	default:
 		return nil, ErrIndexActionNotImplemented.New(p.Action)
 	}
@@ -216,10 +326,13 @@ func (p AlterIndex) String() string {
 		}
 		children = append(children, fmt.Sprintf("Columns(%s)", strings.Join(cols, ", ")))
 		children = append(children, fmt.Sprintf("Comment(%s)", p.Comment))
+		children = append(children, p.buildOptionStrings()...)
 		_ = pr.WriteChildren(children...)
 	case IndexAction_Drop:
 		_ = pr.WriteNode("DropIndex(%s)", p.IndexName)
-		_ = pr.WriteChildren(fmt.Sprintf("Table(%s)", p.Table.String()))
+		children := []string{fmt.Sprintf("Table(%s)", p.Table.String())}
+		children = append(children, p.buildOptionStrings()...)
+		_ = pr.WriteChildren(children...)
 	case IndexAction_Rename:
 		_ = pr.WriteNode("RenameIndex")
 		_ = pr.WriteChildren(
@@ -233,6 +346,18 @@ func (p AlterIndex) String() string {
 	return pr.String()
 }
 
+// buildOptionStrings returns the ALGORITHM and LOCK clauses that were specified, for printing the node.
+func (p AlterIndex) buildOptionStrings() []string {
+	var options []string
+	if p.Algorithm != sql.IndexAlgorithm_Default {
+		options = append(options, fmt.Sprintf("Algorithm(%s)", p.Algorithm))
+	}
+	if p.Lock != sql.IndexLock_Default {
+		options = append(options, fmt.Sprintf("Lock(%s)", p.Lock))
+	}
+	return options
+}
+
 func (p *AlterIndex) Resolved() bool {
 	return p.Table.Resolved()
 }
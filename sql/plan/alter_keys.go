@@ -0,0 +1,69 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// AlterKeys represents ALTER TABLE ... DISABLE KEYS and ALTER TABLE ... ENABLE KEYS. MySQL only honors these for
+// MyISAM tables, where they defer the maintenance of nonunique indexes during bulk loads. Indexes are always kept up to
+// date here, so the statement only checks that the table exists.
+type AlterKeys struct {
+	UnaryNode
+	Disable bool
+}
+
+var _ sql.Node = (*AlterKeys)(nil)
+
+// NewAlterDisableKeys returns a new AlterKeys node for ALTER TABLE ... DISABLE KEYS.
+func NewAlterDisableKeys(table sql.Node) *AlterKeys {
+	return &AlterKeys{UnaryNode: UnaryNode{Child: table}, Disable: true}
+}
+
+// NewAlterEnableKeys returns a new AlterKeys node for ALTER TABLE ... ENABLE KEYS.
+func NewAlterEnableKeys(table sql.Node) *AlterKeys {
+	return &AlterKeys{UnaryNode: UnaryNode{Child: table}, Disable: false}
+}
+
+// WithChildren implements the sql.Node interface.
+func (a *AlterKeys) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(a, len(children), 1)
+	}
+	na := *a
+	na.Child = children[0]
+	return &na, nil
+}
+
+// Schema implements the sql.Node interface.
+func (a *AlterKeys) Schema() sql.Schema {
+	return sql.OkResultSchema
+}
+
+// RowIter implements the sql.Node interface.
+func (a *AlterKeys) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+func (a *AlterKeys) String() string {
+	action := "ENABLE"
+	if a.Disable {
+		action = "DISABLE"
+	}
+	return fmt.Sprintf("ALTER TABLE %s %s KEYS", a.Child.String(), action)
+}
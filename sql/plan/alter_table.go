@@ -34,10 +34,14 @@ var _ sql.Databaser = (*RenameTable)(nil)
 
 // NewRenameTable creates a new RenameTable node
 func NewRenameTable(db sql.Database, oldNames, newNames []string) *RenameTable {
+	storedNames := make([]string, len(newNames))
+	for i, name := range newNames {
+		storedNames[i] = sql.StoredTableName(name)
+	}
 	return &RenameTable{
 		ddlNode:  ddlNode{db},
 		oldNames: oldNames,
-		newNames: newNames,
+		newNames: storedNames,
 	}
 }
 
@@ -47,6 +51,16 @@ func (r *RenameTable) WithDatabase(db sql.Database) (sql.Node, error) {
 	return &nr, nil
 }
 
+// OldNames returns the names of the tables that are renamed.
+func (r *RenameTable) OldNames() []string {
+	return r.oldNames
+}
+
+// NewNames returns the names the tables are renamed to.
+func (r *RenameTable) NewNames() []string {
+	return r.newNames
+}
+
 func (r *RenameTable) String() string {
 	return fmt.Sprintf("Rename table %s to %s", r.oldNames, r.newNames)
 }
@@ -176,6 +190,9 @@ func (a *AddColumn) updateRowsWithDefaults(ctx *sql.Context, row sql.Row) error
 
 	updater := updatable.Updater(ctx)
 
+	total := estimatedRowCount(ctx, updatable)
+	var done int64
+	ctx.ReportStageProgress(sql.StageAlteringTable, done, total)
 	for {
 		r, err := tableIter.Next(ctx)
 		if err == io.EOF {
@@ -192,11 +209,28 @@ func (a *AddColumn) updateRowsWithDefaults(ctx *sql.Context, row sql.Row) error
 			return err
 		}
 
-		err = updater.Update(ctx, r, updatedRow)
+		err = updateRow(ctx, updater, schema, r, updatedRow)
 		if err != nil {
 			return err
 		}
+
+		done++
+		ctx.ReportStageProgress(sql.StageAlteringTable, done, total)
+	}
+}
+
+// estimatedRowCount returns the number of rows of the table given for reporting the progress of a statement that
+// rewrites it, or -1 if the table doesn't know it.
+func estimatedRowCount(ctx *sql.Context, table sql.Table) int64 {
+	st, ok := table.(sql.StatisticsTable)
+	if !ok {
+		return -1
+	}
+	n, err := st.NumRows(ctx)
+	if err != nil {
+		return -1
 	}
+	return int64(n)
 }
 
 // applyDefaults applies the default value of the given column index to the given row, and returns a new row with the updated values.
@@ -294,7 +328,7 @@ func (a *AddColumn) validateDefaultPosition(tblSch sql.Schema) error {
 		return err
 	}
 
-	return nil
+	return inspectDefaultForAutoIncrementColumns(a.column, tblSch)
 }
 
 func (a AddColumn) WithChildren(children ...sql.Node) (sql.Node, error) {
@@ -726,6 +760,10 @@ func (m *ModifyColumn) validateDefaultPosition(tblSch sql.Schema) error {
 	if err != nil {
 		return err
 	}
+	err = inspectDefaultForAutoIncrementColumns(m.column, tblSch)
+	if err != nil {
+		return err
+	}
 	thisCol := map[string]*sql.Column{m.column.Name: m.column}
 	for _, colBefore := range colsBeforeThis {
 		err = inspectDefaultForInvalidColumns(colBefore, thisCol)
@@ -771,6 +809,29 @@ func updateDefaultsOnColumnRename(ctx *sql.Context, tbl sql.AlterableTable, sche
 	return nil
 }
 
+// inspectDefaultForAutoIncrementColumns returns an error if the default value of the column given references an
+// AUTO_INCREMENT column of the schema given, as their values aren't known yet when default values are evaluated.
+func inspectDefaultForAutoIncrementColumns(col *sql.Column, sch sql.Schema) error {
+	if col.Default == nil {
+		return nil
+	}
+	var err error
+	sql.Inspect(col.Default, func(expr sql.Expression) bool {
+		getField, ok := expr.(*expression.GetField)
+		if !ok {
+			return true
+		}
+		for _, schCol := range sch {
+			if schCol.AutoIncrement && strings.EqualFold(schCol.Name, getField.Name()) {
+				err = sql.ErrInvalidDefaultValueAutoIncrement.New(col.Name)
+				return false
+			}
+		}
+		return true
+	})
+	return err
+}
+
 func inspectDefaultForInvalidColumns(col *sql.Column, columnsAfterThis map[string]*sql.Column) error {
 	if col.Default == nil {
 		return nil
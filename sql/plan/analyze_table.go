@@ -0,0 +1,112 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// AnalyzeTable represents an ANALYZE TABLE statement, which collects the statistics of a table that's an
+// sql.AnalyzableTable. Like MySQL, it reports the outcome as a row rather than failing for tables that can't be
+// analyzed.
+type AnalyzeTable struct {
+	UnaryNode
+	// Statistics tracks the tables analyzed, to refresh their statistics once they're stale. It's assigned by the
+	// analyzer, and the table's statistics are collected without being tracked without one.
+	Statistics *sql.TableStatistics
+}
+
+var _ sql.Node = (*AnalyzeTable)(nil)
+
+// NewAnalyzeTable returns a new AnalyzeTable node for the table given.
+func NewAnalyzeTable(table sql.Node) *AnalyzeTable {
+	return &AnalyzeTable{UnaryNode: UnaryNode{Child: table}}
+}
+
+var analyzeTableSchema = sql.Schema{
+	{Name: "Table", Type: sql.LongText},
+	{Name: "Op", Type: sql.LongText},
+	{Name: "Msg_type", Type: sql.LongText},
+	{Name: "Msg_text", Type: sql.LongText},
+}
+
+// Schema implements the sql.Node interface.
+func (n *AnalyzeTable) Schema() sql.Schema {
+	return analyzeTableSchema
+}
+
+// WithChildren implements the sql.Node interface.
+func (n *AnalyzeTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(n, len(children), 1)
+	}
+	nn := *n
+	nn.Child = children[0]
+	return &nn, nil
+}
+
+// RowIter implements the sql.Node interface.
+func (n *AnalyzeTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	var rt *ResolvedTable
+	Inspect(n.Child, func(node sql.Node) bool {
+		if t, ok := node.(*ResolvedTable); ok && rt == nil {
+			rt = t
+		}
+		return rt == nil
+	})
+	if rt == nil {
+		return nil, sql.ErrTableNotFound.New(n.Child.String())
+	}
+
+	var db string
+	if rt.Database != nil {
+		db = rt.Database.Name()
+	}
+	name := fmt.Sprintf("%s.%s", db, rt.Name())
+
+	table, ok := GetAnalyzableTable(rt.Table)
+	if !ok {
+		return sql.RowsToRowIter(sql.NewRow(name, "analyze", "note", "The storage engine for the table doesn't support analyze")), nil
+	}
+
+	var err error
+	if n.Statistics != nil {
+		err = n.Statistics.Analyze(ctx, db, table)
+	} else {
+		err = table.AnalyzeTable(ctx)
+	}
+	if err != nil {
+		return sql.RowsToRowIter(sql.NewRow(name, "analyze", "error", err.Error())), nil
+	}
+	return sql.RowsToRowIter(sql.NewRow(name, "analyze", "status", "OK")), nil
+}
+
+func (n *AnalyzeTable) String() string {
+	return fmt.Sprintf("ANALYZE TABLE %s", n.Child.String())
+}
+
+// GetAnalyzableTable returns the sql.AnalyzableTable the table given is or wraps, if any.
+func GetAnalyzableTable(table sql.Table) (sql.AnalyzableTable, bool) {
+	switch t := table.(type) {
+	case sql.AnalyzableTable:
+		return t, true
+	case sql.TableWrapper:
+		return GetAnalyzableTable(t.Underlying())
+	default:
+		return nil, false
+	}
+}
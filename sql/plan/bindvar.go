@@ -25,19 +25,43 @@ import (
 // returned and the |BindVar| expression is left in place. There is no check on
 // whether all entries in |bindings| are used at least once throughout the |n|.
 //
-// This applies binding substitutions across *SubqueryAlias nodes, but will
-// fail to apply bindings across other |sql.Opaque| nodes.
+// This applies binding substitutions across *SubqueryAlias nodes and *Subquery
+// expressions, but will fail to apply bindings across other |sql.Opaque| nodes.
 func ApplyBindings(ctx *sql.Context, n sql.Node, bindings map[string]sql.Expression) (sql.Node, error) {
+	return transformBindVars(n, func(bv *expression.BindVar) sql.Expression {
+		if val, found := bindings[bv.Name]; found {
+			return val
+		}
+		return bv
+	})
+}
+
+// BindVarNames returns the distinct names of the `BindVar` expressions in the given sql.Node, in the order they're
+// found, looking across the same nodes and expressions as ApplyBindings.
+func BindVarNames(n sql.Node) ([]string, error) {
+	var names []string
+	seen := make(map[string]bool)
+	_, err := transformBindVars(n, func(bv *expression.BindVar) sql.Expression {
+		if !seen[bv.Name] {
+			seen[bv.Name] = true
+			names = append(names, bv.Name)
+		}
+		return bv
+	})
+	return names, err
+}
+
+func transformBindVars(n sql.Node, fn func(*expression.BindVar) sql.Expression) (sql.Node, error) {
 	withSubqueries, err := TransformUp(n, func(n sql.Node) (sql.Node, error) {
 		switch n := n.(type) {
 		case *SubqueryAlias:
-			child, err := ApplyBindings(ctx, n.Child, bindings)
+			child, err := transformBindVars(n.Child, fn)
 			if err != nil {
 				return nil, err
 			}
 			return n.WithChildren(child)
 		case *InsertInto:
-			source, err := ApplyBindings(ctx, n.Source, bindings)
+			source, err := transformBindVars(n.Source, fn)
 			if err != nil {
 				return nil, err
 			}
@@ -50,11 +74,15 @@ func ApplyBindings(ctx *sql.Context, n sql.Node, bindings map[string]sql.Express
 		return nil, err
 	}
 	return TransformExpressionsUp(withSubqueries, func(e sql.Expression) (sql.Expression, error) {
-		if bv, ok := e.(*expression.BindVar); ok {
-			val, found := bindings[bv.Name]
-			if found {
-				return val, nil
+		switch e := e.(type) {
+		case *expression.BindVar:
+			return fn(e), nil
+		case *Subquery:
+			query, err := transformBindVars(e.Query, fn)
+			if err != nil {
+				return nil, err
 			}
+			return e.WithQuery(query), nil
 		}
 		return e, nil
 	})
@@ -192,6 +192,48 @@ func TestApplyBindings(t *testing.T) {
 				),
 			),
 		},
+		tc{
+			"SubqueryExpression",
+			NewFilter(
+				NewInSubquery(
+					expression.NewUnresolvedColumn("foo"),
+					NewSubquery(
+						NewLimit(
+							expression.NewBindVar("v1"),
+							NewProject(
+								[]sql.Expression{
+									expression.NewUnresolvedColumn("bar"),
+								},
+								NewUnresolvedTable("t2", ""),
+							),
+						),
+						"select bar from t2 limit ?",
+					),
+				),
+				NewUnresolvedTable("t1", ""),
+			),
+			map[string]sql.Expression{
+				"v1": expression.NewLiteral(int8(1), sql.Int8),
+			},
+			NewFilter(
+				NewInSubquery(
+					expression.NewUnresolvedColumn("foo"),
+					NewSubquery(
+						NewLimit(
+							expression.NewLiteral(int8(1), sql.Int8),
+							NewProject(
+								[]sql.Expression{
+									expression.NewUnresolvedColumn("bar"),
+								},
+								NewUnresolvedTable("t2", ""),
+							),
+						),
+						"select bar from t2 limit ?",
+					),
+				),
+				NewUnresolvedTable("t1", ""),
+			),
+		},
 	}
 
 	for _, c := range cases {
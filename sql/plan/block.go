@@ -91,10 +91,22 @@ func (b *Block) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 
 	selectSeen := false
 	for _, s := range b.statements {
-		err := func() error {
+		err := func() (err error) {
 			rowCache, disposeFunc := ctx.Memory.NewRowsCache()
 			defer disposeFunc()
 
+			// Outside of triggers, e.g. in stored procedures, each statement of the block is a statement of its own
+			ctx := ctx
+			if statementFromContext(ctx) == nil {
+				statement := &statementEditors{}
+				ctx = withStatement(ctx, statement)
+				defer func() {
+					if endErr := statement.end(ctx, err); err == nil {
+						err = endErr
+					}
+				}()
+			}
+
 			var isSelect bool
 			subIter, err := s.RowIter(ctx, row)
 			if err != nil {
@@ -127,6 +139,8 @@ func (b *Block) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 					}
 					break
 				} else if err != nil {
+					// Closing the iterator discards the changes of the failed statement
+					_ = subIter.Close(ctx)
 					return err
 				} else if isSelect || !selectSeen {
 					err = rowCache.Add(newRow)
@@ -128,13 +128,16 @@ func (c *Call) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 			return nil, err
 		}
 	}
-	innerIter, err := c.proc.RowIter(ctx, row)
+	// The statements of a procedure aren't part of the CALL statement, so they complete their changes on their own
+	procCtx := withStatement(c.proc.ExecutionContext(ctx), nil)
+	innerIter, err := c.proc.RowIter(procCtx, row)
 	if err != nil {
 		return nil, err
 	}
 	return &callIter{
 		call:      c,
 		innerIter: innerIter,
+		procCtx:   procCtx,
 	}, nil
 }
 
@@ -142,16 +145,19 @@ func (c *Call) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 type callIter struct {
 	call      *Call
 	innerIter sql.RowIter
+	// procCtx is the context that the procedure's body executes with, which has the security context of its definer
+	// for procedures with SQL SECURITY DEFINER.
+	procCtx *sql.Context
 }
 
 // Next implements the sql.RowIter interface.
 func (iter *callIter) Next(ctx *sql.Context) (sql.Row, error) {
-	return iter.innerIter.Next(ctx)
+	return iter.innerIter.Next(iter.procCtx.WithContext(ctx.Context))
 }
 
 // Close implements the sql.RowIter interface.
 func (iter *callIter) Close(ctx *sql.Context) error {
-	err := iter.innerIter.Close(ctx)
+	err := iter.innerIter.Close(iter.procCtx.WithContext(ctx.Context))
 	if err != nil {
 		return err
 	}
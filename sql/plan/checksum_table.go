@@ -0,0 +1,165 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// ChecksumMode is the mode of a CHECKSUM TABLE statement.
+type ChecksumMode byte
+
+const (
+	// ChecksumMode_Default computes the checksums of the tables by reading all of their rows, since no table keeps a
+	// live checksum.
+	ChecksumMode_Default ChecksumMode = iota
+	// ChecksumMode_Quick reports the live checksums of the tables, which are NULL since no table keeps one.
+	ChecksumMode_Quick
+	// ChecksumMode_Extended computes the checksums of the tables by reading all of their rows.
+	ChecksumMode_Extended
+)
+
+// ChecksumTableName is a table named by a CHECKSUM TABLE statement. Database is empty for tables of the current
+// database.
+type ChecksumTableName struct {
+	Database string
+	Name     string
+}
+
+// ChecksumTable represents a CHECKSUM TABLE statement, which reports a checksum of the rows of each of its tables that
+// is the same as the one MySQL reports for a table with the same schema and rows, as computed by sql.RowChecksummer.
+// Like MySQL, tables that don't exist have a NULL checksum and a warning rather than failing the statement.
+type ChecksumTable struct {
+	Tables []ChecksumTableName
+	Mode   ChecksumMode
+	// Catalog is the catalog the tables are looked up in. It's assigned by the analyzer.
+	Catalog sql.Catalog
+}
+
+var _ sql.Node = (*ChecksumTable)(nil)
+
+// NewChecksumTable returns a new ChecksumTable node for the tables given.
+func NewChecksumTable(mode ChecksumMode, tables ...ChecksumTableName) *ChecksumTable {
+	return &ChecksumTable{Tables: tables, Mode: mode}
+}
+
+var checksumTableSchema = sql.Schema{
+	{Name: "Table", Type: sql.LongText},
+	{Name: "Checksum", Type: sql.Int64, Nullable: true},
+}
+
+// Resolved implements the sql.Node interface.
+func (c *ChecksumTable) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (c *ChecksumTable) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (c *ChecksumTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(c, len(children), 0)
+	}
+	return c, nil
+}
+
+// Schema implements the sql.Node interface.
+func (c *ChecksumTable) Schema() sql.Schema {
+	return checksumTableSchema
+}
+
+// RowIter implements the sql.Node interface.
+func (c *ChecksumTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	rows := make([]sql.Row, len(c.Tables))
+	for i, name := range c.Tables {
+		db := name.Database
+		if db == "" {
+			db = ctx.GetCurrentDatabase()
+		}
+		qualified := fmt.Sprintf("%s.%s", db, name.Name)
+
+		table, _, err := c.Catalog.Table(ctx, db, name.Name)
+		if sql.ErrTableNotFound.Is(err) || sql.ErrDatabaseNotFound.Is(err) {
+			ctx.Warn(1146, "Table '%s' doesn't exist", qualified)
+			rows[i] = sql.NewRow(qualified, nil)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		if c.Mode == ChecksumMode_Quick {
+			rows[i] = sql.NewRow(qualified, nil)
+			continue
+		}
+		checksum, err := checksumTable(ctx, table)
+		if err != nil {
+			return nil, err
+		}
+		rows[i] = sql.NewRow(qualified, int64(checksum))
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// checksumTable returns the checksum of the rows of the table given.
+func checksumTable(ctx *sql.Context, table sql.Table) (uint32, error) {
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return 0, err
+	}
+	iter := sql.NewTableRowIter(ctx, table, partitions)
+	defer iter.Close(ctx)
+
+	checksummer := sql.NewRowChecksummer(table.Schema())
+	var sum uint32
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			return sum, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		crc, err := checksummer.Checksum(row)
+		if err != nil {
+			return 0, err
+		}
+		sum += crc
+	}
+}
+
+func (c *ChecksumTable) String() string {
+	names := make([]string, len(c.Tables))
+	for i, table := range c.Tables {
+		names[i] = table.Name
+		if table.Database != "" {
+			names[i] = table.Database + "." + table.Name
+		}
+	}
+	switch c.Mode {
+	case ChecksumMode_Quick:
+		return fmt.Sprintf("CHECKSUM TABLE %s QUICK", strings.Join(names, ", "))
+	case ChecksumMode_Extended:
+		return fmt.Sprintf("CHECKSUM TABLE %s EXTENDED", strings.Join(names, ", "))
+	default:
+		return fmt.Sprintf("CHECKSUM TABLE %s", strings.Join(names, ", "))
+	}
+}
@@ -103,6 +103,7 @@ func (n *CreateUser) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 		existingRows := userTableData.Get(userPk)
 		if len(existingRows) > 0 {
 			if n.IfNotExists {
+				addNote(ctx, errUserAlreadyExists, "Authorization ID %s already exists.", user.UserName.StringWithQuote("'", ""))
 				continue
 			}
 			return nil, sql.ErrUserCreationFailure.New(user.UserName.StringWithQuote("'", ""))
@@ -16,6 +16,7 @@ package plan
 
 import (
 	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"strings"
@@ -118,6 +119,47 @@ func (a AuthenticationMysqlNativePassword) Password() string {
 	return "*" + strings.ToUpper(hex.EncodeToString(s2))
 }
 
+// AuthenticationCachingSha2Password is an authentication type that represents "caching_sha2_password".
+type AuthenticationCachingSha2Password string
+
+var _ Authentication = AuthenticationCachingSha2Password("")
+
+// Plugin implements the interface Authentication.
+func (a AuthenticationCachingSha2Password) Plugin() string {
+	return "caching_sha2_password"
+}
+
+// Password implements the interface Authentication. Unlike MySQL, which stores a salted SHA-256 crypt of the password,
+// this is the double SHA-256 of the password, which is what the scrambles sent by clients are checked against.
+func (a AuthenticationCachingSha2Password) Password() string {
+	if len(a) == 0 {
+		return ""
+	}
+	// caching_sha2 = sha256(sha256(password))
+	s1 := sha256.Sum256([]byte(a))
+	s2 := sha256.Sum256(s1[:])
+	return strings.ToUpper(hex.EncodeToString(s2[:]))
+}
+
+// AuthenticationPlugin is an authentication type that represents a plugin that the integrator provides, whose
+// authentication string is given as is, as with IDENTIFIED WITH plugin AS 'auth_string'.
+type AuthenticationPlugin struct {
+	Name       string
+	AuthString string
+}
+
+var _ Authentication = AuthenticationPlugin{}
+
+// Plugin implements the interface Authentication.
+func (a AuthenticationPlugin) Plugin() string {
+	return a.Name
+}
+
+// Password implements the interface Authentication.
+func (a AuthenticationPlugin) Password() string {
+	return a.AuthString
+}
+
 // NewDefaultAuthentication returns the given password with the default authentication method.
 func NewDefaultAuthentication(password string) Authentication {
 	return AuthenticationMysqlNativePassword(password)
@@ -18,6 +18,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/dolthub/vitess/go/mysql"
+
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
@@ -31,6 +33,8 @@ type CreateView struct {
 	Columns    []string
 	IsReplace  bool
 	Definition *SubqueryAlias
+	// IfNotExists is whether the statement does nothing when a view with the same name exists.
+	IfNotExists bool
 }
 
 // NewCreateView creates a CreateView node with the specified parameters,
@@ -45,7 +49,7 @@ func NewCreateView(
 	return &CreateView{
 		UnaryNode:  UnaryNode{Child: definition},
 		database:   database,
-		Name:       name,
+		Name:       sql.StoredTableName(name),
 		Columns:    columns,
 		IsReplace:  isReplace,
 		Definition: definition,
@@ -78,6 +82,17 @@ func (cv *CreateView) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 	view := cv.View()
 	registry := ctx.GetViewRegistry()
 
+	if cv.IfNotExists {
+		exists, err := cv.viewExists(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			addNote(ctx, mysql.ERTableExists, "Table '%s' already exists", cv.Name)
+			return sql.RowsToRowIter(), nil
+		}
+	}
+
 	if cv.IsReplace {
 		if dropper, ok := cv.database.(sql.ViewDatabase); ok {
 			err := dropper.DropView(ctx, cv.Name)
@@ -100,6 +115,19 @@ func (cv *CreateView) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 	}
 }
 
+// viewExists returns whether a view with the name of the one being created exists in its database.
+func (cv *CreateView) viewExists(ctx *sql.Context) (bool, error) {
+	if vdb, ok := cv.database.(sql.ViewDatabase); ok {
+		_, exists, err := vdb.GetView(ctx, cv.Name)
+		return exists, err
+	}
+	_, err := ctx.GetViewRegistry().View(cv.database.Name(), cv.Name)
+	if sql.ErrViewDoesNotExist.Is(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
 // Schema implements the Node interface. It always returns nil.
 func (cv *CreateView) Schema() sql.Schema { return nil }
 
@@ -42,6 +42,11 @@ func (c CreateDB) String() string {
 	return fmt.Sprintf("%s database%s %v", sqlparser.CreateStr, ifNotExists, c.dbName)
 }
 
+// DbName returns the name of the database to create.
+func (c CreateDB) DbName() string {
+	return c.dbName
+}
+
 func (c CreateDB) Schema() sql.Schema {
 	return sql.OkResultSchema
 }
@@ -82,7 +87,7 @@ func (c CreateDB) WithChildren(children ...sql.Node) (sql.Node, error) {
 
 func NewCreateDatabase(dbName string, ifNotExists bool) *CreateDB {
 	return &CreateDB{
-		dbName:      dbName,
+		dbName:      sql.StoredTableName(dbName),
 		IfNotExists: ifNotExists,
 	}
 }
@@ -94,6 +99,11 @@ type DropDB struct {
 	IfExists bool
 }
 
+// DbName returns the name of the database to drop.
+func (d DropDB) DbName() string {
+	return d.dbName
+}
+
 func (d DropDB) Resolved() bool {
 	return true
 }
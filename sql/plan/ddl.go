@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -42,6 +43,27 @@ var ErrNullDefault = errors.NewKind("column declared not null must have a non-nu
 // ErrTableCreatedNotFound is thrown when a table is created from CREATE TABLE but cannot be found immediately afterward
 var ErrTableCreatedNotFound = errors.NewKind("table was created but could not be found")
 
+// MySQL codes of the notes added by DDL statements that aren't in vitess.
+// TODO: Needs to be added to vitess
+const (
+	errSpAlreadyExists   = 1304
+	errSpDoesNotExist    = 1305
+	errTrgAlreadyExists  = 1359
+	errTrgDoesNotExist   = 1360
+	errUserDoesNotExist  = 3162
+	errUserAlreadyExists = 3163
+)
+
+// addNote adds a note to the warnings of the session, as MySQL does when a DDL statement has nothing to do because of
+// its IF EXISTS or IF NOT EXISTS clause.
+func addNote(ctx *sql.Context, code int, msg string, args ...interface{}) {
+	ctx.Session.Warn(&sql.Warning{
+		Level:   "Note",
+		Code:    code,
+		Message: fmt.Sprintf(msg, args...),
+	})
+}
+
 type IfNotExistsOption bool
 
 const (
@@ -96,6 +118,10 @@ type TableSpec struct {
 	FkDefs  []*sql.ForeignKeyConstraint
 	ChDefs  []*sql.CheckConstraint
 	IdxDefs []*IndexDefinition
+	// Options are the table options of the table. Tables that don't support table options ignore them.
+	Options sql.TableOptions
+	// AutoIncrement is the first value of the AUTO_INCREMENT sequence of the table, or 0 when it isn't given.
+	AutoIncrement uint64
 }
 
 func (c *TableSpec) WithSchema(schema sql.PrimaryKeySchema) *TableSpec {
@@ -122,6 +148,13 @@ func (c *TableSpec) WithIndices(idxDefs []*IndexDefinition) *TableSpec {
 	return &nc
 }
 
+func (c *TableSpec) WithOptions(options sql.TableOptions, autoIncrement uint64) *TableSpec {
+	nc := *c
+	nc.Options = options
+	nc.AutoIncrement = autoIncrement
+	return &nc
+}
+
 // CreateTable is a node describing the creation of some table.
 type CreateTable struct {
 	ddlNode
@@ -131,6 +164,8 @@ type CreateTable struct {
 	fkDefs       []*sql.ForeignKeyConstraint
 	chDefs       sql.CheckConstraints
 	idxDefs      []*IndexDefinition
+	options      sql.TableOptions
+	autoIncr     uint64
 	like         sql.Node
 	temporary    TempTableOption
 	selectNode   sql.Node
@@ -142,6 +177,7 @@ var _ sql.Expressioner = (*CreateTable)(nil)
 
 // NewCreateTable creates a new CreateTable node
 func NewCreateTable(db sql.Database, name string, ifn IfNotExistsOption, temp TempTableOption, tableSpec *TableSpec) *CreateTable {
+	name = sql.StoredTableName(name)
 	for _, s := range tableSpec.Schema.Schema {
 		s.Source = name
 	}
@@ -153,6 +189,8 @@ func NewCreateTable(db sql.Database, name string, ifn IfNotExistsOption, temp Te
 		fkDefs:       tableSpec.FkDefs,
 		chDefs:       tableSpec.ChDefs,
 		idxDefs:      tableSpec.IdxDefs,
+		options:      tableSpec.Options,
+		autoIncr:     tableSpec.AutoIncrement,
 		ifNotExists:  ifn,
 		temporary:    temp,
 	}
@@ -162,7 +200,7 @@ func NewCreateTable(db sql.Database, name string, ifn IfNotExistsOption, temp Te
 func NewCreateTableLike(db sql.Database, name string, likeTable sql.Node, ifn IfNotExistsOption, temp TempTableOption) *CreateTable {
 	return &CreateTable{
 		ddlNode:     ddlNode{db},
-		name:        name,
+		name:        sql.StoredTableName(name),
 		ifNotExists: ifn,
 		like:        likeTable,
 		temporary:   temp,
@@ -171,6 +209,7 @@ func NewCreateTableLike(db sql.Database, name string, likeTable sql.Node, ifn If
 
 // NewCreateTableSelect create a new CreateTable node for CREATE TABLE [AS] SELECT
 func NewCreateTableSelect(db sql.Database, name string, selectNode sql.Node, tableSpec *TableSpec, ifn IfNotExistsOption, temp TempTableOption) *CreateTable {
+	name = sql.StoredTableName(name)
 	for _, s := range tableSpec.Schema.Schema {
 		s.Source = name
 	}
@@ -181,6 +220,8 @@ func NewCreateTableSelect(db sql.Database, name string, selectNode sql.Node, tab
 		fkDefs:       tableSpec.FkDefs,
 		chDefs:       tableSpec.ChDefs,
 		idxDefs:      tableSpec.IdxDefs,
+		options:      tableSpec.Options,
+		autoIncr:     tableSpec.AutoIncrement,
 		name:         name,
 		selectNode:   selectNode,
 		ifNotExists:  ifn,
@@ -233,8 +274,8 @@ func (c *CreateTable) Resolved() bool {
 
 // RowIter implements the Node interface.
 func (c *CreateTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	var err error
-	if c.temporary == IsTempTable {
+	err := c.checkNameIsFree(ctx)
+	if err == nil && c.temporary == IsTempTable {
 		creatable, ok := c.db.(sql.TemporaryTableCreator)
 		if !ok {
 			return sql.RowsToRowIter(), sql.ErrTemporaryTableNotSupported.New()
@@ -245,7 +286,7 @@ func (c *CreateTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 		}
 
 		err = creatable.CreateTemporaryTable(ctx, c.name, c.CreateSchema)
-	} else {
+	} else if err == nil {
 		creatable, ok := c.db.(sql.TableCreator)
 		if !ok {
 			return sql.RowsToRowIter(), ErrCreateTableNotSupported.New(c.db.Name())
@@ -258,7 +299,11 @@ func (c *CreateTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 		err = creatable.CreateTable(ctx, c.name, c.CreateSchema)
 	}
 
-	if err != nil && !(sql.ErrTableAlreadyExists.Is(err) && (c.ifNotExists == IfNotExists)) {
+	if err != nil {
+		if sql.ErrTableAlreadyExists.Is(err) && c.ifNotExists == IfNotExists {
+			addNote(ctx, mysql.ERTableExists, "Table '%s' already exists", c.name)
+			return sql.RowsToRowIter(), nil
+		}
 		return sql.RowsToRowIter(), err
 	}
 
@@ -300,9 +345,50 @@ func (c *CreateTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 		}
 	}
 
+	err = c.setTableOptions(ctx, tableNode)
+	if err != nil {
+		return sql.RowsToRowIter(), err
+	}
+
 	return sql.RowsToRowIter(), nil
 }
 
+// checkNameIsFree returns an error if names are compared case-insensitively, and a table whose name only differs in case
+// from the name of the table being created exists. Table creators only check for tables with exactly the same name.
+func (c *CreateTable) checkNameIsFree(ctx *sql.Context) error {
+	if !sql.TableNamesCaseInsensitive() {
+		return nil
+	}
+	existing, exists, err := c.db.GetTableInsensitive(ctx, c.name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return sql.ErrTableAlreadyExists.New(existing.Name())
+	}
+	return nil
+}
+
+// setTableOptions sets the table options and the first AUTO_INCREMENT value of the statement on the table created, if
+// they were given and the table supports them.
+func (c *CreateTable) setTableOptions(ctx *sql.Context, tableNode sql.Table) error {
+	if optionsAlterable, ok := tableNode.(sql.TableOptionsAlterableTable); ok && c.options.Collation.Name != "" {
+		if err := optionsAlterable.SetTableOptions(ctx, c.options); err != nil {
+			return err
+		}
+	}
+
+	autoTbl, ok := tableNode.(sql.AutoIncrementTable)
+	if !ok || c.autoIncr == 0 || !c.CreateSchema.Schema.HasAutoIncrement() {
+		return nil
+	}
+	setter := autoTbl.AutoIncrementSetter(ctx)
+	if err := setter.SetAutoIncrementValue(ctx, c.autoIncr); err != nil {
+		return err
+	}
+	return setter.Close(ctx)
+}
+
 func (c *CreateTable) createIndexes(ctx *sql.Context, tableNode sql.Table, idxes []*IndexDefinition) error {
 	idxAlterable, ok := tableNode.(sql.IndexAlterableTable)
 	if !ok {
@@ -519,6 +605,7 @@ func (c *CreateTable) TableSpec() *TableSpec {
 	ret = ret.WithForeignKeys(c.fkDefs)
 	ret = ret.WithIndices(c.idxDefs)
 	ret = ret.WithCheckConstraints(c.chDefs)
+	ret = ret.WithOptions(c.options, c.autoIncr)
 
 	return ret
 }
@@ -573,6 +660,9 @@ func (c *CreateTable) validateDefaultPosition() error {
 		if err := inspectDefaultForInvalidColumns(col, colsAfterThis); err != nil {
 			return err
 		}
+		if err := inspectDefaultForAutoIncrementColumns(col, c.CreateSchema.Schema); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -634,6 +724,7 @@ func (d *DropTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 
 		if !ok {
 			if d.ifExists {
+				addNote(ctx, mysql.ERBadTable, "Unknown table '%s.%s'", d.db.Name(), tableName)
 				continue
 			}
 
@@ -17,6 +17,7 @@ package plan
 import (
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 	"time"
 
@@ -27,6 +28,10 @@ type CreateProcedure struct {
 	*Procedure
 	BodyString string
 	Db         sql.Database
+	// OrReplace is whether the statement replaces a procedure with the same name, as in CREATE OR REPLACE PROCEDURE.
+	OrReplace bool
+	// IfNotExists is whether the statement does nothing when a procedure with the same name exists.
+	IfNotExists bool
 }
 
 var _ sql.Node = (*CreateProcedure)(nil)
@@ -162,15 +167,19 @@ func (c *CreateProcedure) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, e
 			CreatedAt:       c.CreatedAt,
 			ModifiedAt:      c.ModifiedAt,
 		},
-		db: c.Db,
+		db:          c.Db,
+		orReplace:   c.OrReplace,
+		ifNotExists: c.IfNotExists,
 	}, nil
 }
 
 // createProcedureIter is the row iterator for *CreateProcedure.
 type createProcedureIter struct {
-	once sync.Once
-	spd  sql.StoredProcedureDetails
-	db   sql.Database
+	once        sync.Once
+	spd         sql.StoredProcedureDetails
+	db          sql.Database
+	orReplace   bool
+	ifNotExists bool
 }
 
 // Next implements the sql.RowIter interface.
@@ -188,8 +197,35 @@ func (c *createProcedureIter) Next(ctx *sql.Context) (sql.Row, error) {
 		return nil, sql.ErrStoredProceduresNotSupported.New(c.db.Name())
 	}
 
+	var replaced *sql.StoredProcedureDetails
+	if c.orReplace || c.ifNotExists {
+		existing, err := pdb.GetStoredProcedures(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range existing {
+			if strings.EqualFold(existing[i].Name, c.spd.Name) {
+				replaced = &existing[i]
+				break
+			}
+		}
+	}
+
+	if replaced != nil {
+		if c.ifNotExists {
+			addNote(ctx, errSpAlreadyExists, "PROCEDURE %s already exists", c.spd.Name)
+			return sql.Row{sql.NewOkResult(0)}, nil
+		}
+		if err := pdb.DropStoredProcedure(ctx, replaced.Name); err != nil {
+			return nil, err
+		}
+	}
+
 	err := pdb.SaveStoredProcedure(ctx, c.spd)
 	if err != nil {
+		if replaced != nil {
+			_ = pdb.SaveStoredProcedure(ctx, *replaced)
+		}
 		return nil, err
 	}
 
@@ -17,6 +17,7 @@ package plan
 import (
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -37,6 +38,10 @@ type CreateTrigger struct {
 	CreateTriggerString string
 	BodyString          string
 	CreateDatabase      sql.Database
+	// OrReplace is whether the statement replaces a trigger with the same name, as in CREATE OR REPLACE TRIGGER.
+	OrReplace bool
+	// IfNotExists is whether the statement does nothing when a trigger with the same name exists.
+	IfNotExists bool
 }
 
 func NewCreateTrigger(triggerName, triggerTime, triggerEvent string, triggerOrder *TriggerOrder, table sql.Node, body sql.Node, createTriggerString, bodyString string) *CreateTrigger {
@@ -102,10 +107,12 @@ func (c *CreateTrigger) DebugString() string {
 }
 
 type createTriggerIter struct {
-	once       sync.Once
-	definition sql.TriggerDefinition
-	db         sql.Database
-	ctx        *sql.Context
+	once        sync.Once
+	definition  sql.TriggerDefinition
+	db          sql.Database
+	ctx         *sql.Context
+	orReplace   bool
+	ifNotExists bool
 }
 
 func (c *createTriggerIter) Next(ctx *sql.Context) (sql.Row, error) {
@@ -123,10 +130,39 @@ func (c *createTriggerIter) Next(ctx *sql.Context) (sql.Row, error) {
 		return nil, sql.ErrTriggersNotSupported.New(c.db.Name())
 	}
 
-	err := tdb.CreateTrigger(ctx, c.definition)
+	existing, err := tdb.GetTriggers(ctx)
 	if err != nil {
 		return nil, err
 	}
+	var replaced *sql.TriggerDefinition
+	for i := range existing {
+		if strings.EqualFold(existing[i].Name, c.definition.Name) {
+			replaced = &existing[i]
+			break
+		}
+	}
+
+	if replaced != nil {
+		switch {
+		case c.ifNotExists:
+			addNote(ctx, errTrgAlreadyExists, "Trigger already exists")
+			return sql.Row{sql.NewOkResult(0)}, nil
+		case c.orReplace:
+			if err := tdb.DropTrigger(ctx, replaced.Name); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, sql.ErrTriggerAlreadyExists.New(c.definition.Name)
+		}
+	}
+
+	err = tdb.CreateTrigger(ctx, c.definition)
+	if err != nil {
+		if replaced != nil {
+			_ = tdb.CreateTrigger(ctx, *replaced)
+		}
+		return nil, err
+	}
 
 	return sql.Row{sql.NewOkResult(0)}, nil
 }
@@ -141,6 +177,8 @@ func (c *CreateTrigger) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, err
 			Name:            c.TriggerName,
 			CreateStatement: c.CreateTriggerString,
 		},
-		db: c.CreateDatabase,
+		db:          c.CreateDatabase,
+		orReplace:   c.OrReplace,
+		ifNotExists: c.IfNotExists,
 	}, nil
 }
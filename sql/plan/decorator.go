@@ -47,6 +47,11 @@ func NewDecoratedNode(decoration string, node sql.Node) *DecoratedNode {
 	}
 }
 
+// Decoration returns the decoration of the node.
+func (n *DecoratedNode) Decoration() string {
+	return n.decoration
+}
+
 func (n *DecoratedNode) String() string {
 	pr := sql.NewTreePrinter()
 	_ = pr.WriteNode("%s", n.decoration)
@@ -113,7 +113,7 @@ func (p *DeleteFrom) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 
 	deleter := deletable.Deleter(ctx)
 
-	return newDeleteIter(iter, deleter, deletable.Schema()), nil
+	return newDeleteIter(ctx, iter, deleter, deletable.Schema()), nil
 }
 
 type deleteIter struct {
@@ -150,8 +150,8 @@ func (d *deleteIter) Close(ctx *sql.Context) error {
 	return nil
 }
 
-func newDeleteIter(childIter sql.RowIter, deleter sql.RowDeleter, schema sql.Schema) sql.RowIter {
-	return NewTableEditorIter(deleter, &deleteIter{
+func newDeleteIter(ctx *sql.Context, childIter sql.RowIter, deleter sql.RowDeleter, schema sql.Schema) sql.RowIter {
+	return NewTableEditorIter(ctx, deleter, &deleteIter{
 		deleter:   deleter,
 		childIter: childIter,
 		schema:    schema,
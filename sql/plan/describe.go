@@ -86,6 +86,10 @@ func (i *describeIter) Close(*sql.Context) error {
 type DescribeQuery struct {
 	child  sql.Node
 	Format string
+	// Analyze is whether this describes the analysis of the query as well, as EXPLAIN ANALYZE does.
+	Analyze bool
+	// Analysis is the report of the analysis of the query, once analyzed, if Analyze is true.
+	Analysis string
 }
 
 func (d *DescribeQuery) Resolved() bool {
@@ -110,7 +114,7 @@ var DescribeSchema = sql.Schema{
 
 // NewDescribeQuery creates a new DescribeQuery node.
 func NewDescribeQuery(format string, child sql.Node) *DescribeQuery {
-	return &DescribeQuery{child, format}
+	return &DescribeQuery{child: child, Format: format}
 }
 
 // Schema implements the Node interface.
@@ -133,6 +137,13 @@ func (d *DescribeQuery) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, err
 			rows = append(rows, sql.NewRow(l))
 		}
 	}
+	if d.Analyze {
+		for _, l := range strings.Split(d.Analysis, "\n") {
+			if strings.TrimSpace(l) != "" {
+				rows = append(rows, sql.NewRow(l))
+			}
+		}
+	}
 	return sql.RowsToRowIter(rows...), nil
 }
 
@@ -161,5 +172,14 @@ func (d *DescribeQuery) Query() sql.Node {
 
 // WithQuery returns a copy of this node with the query node given
 func (d *DescribeQuery) WithQuery(child sql.Node) sql.Node {
-	return NewDescribeQuery(d.Format, child)
+	nd := *d
+	nd.child = child
+	return &nd
+}
+
+// WithAnalysis returns a copy of this node with the report of the analysis of its query given
+func (d *DescribeQuery) WithAnalysis(analysis string) *DescribeQuery {
+	nd := *d
+	nd.Analysis = analysis
+	return &nd
 }
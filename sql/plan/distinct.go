@@ -73,11 +73,13 @@ func (d Distinct) DebugString() string {
 	return p.String()
 }
 
-// distinctIter keeps track of the hashes of all rows that have been emitted.
-// It does not emit any rows whose hashes have been seen already.
-// TODO: come up with a way to use less memory than keeping all hashes in memory.
-// Even though they are just 64-bit integers, this could be a problem in large
-// result sets.
+// distinctIter keeps track of the keys of all rows that have been emitted, as
+// returned by sql.HashKeyOf, by their hashes. It does not emit any rows whose
+// keys have been seen already. A key whose hash is taken by a different key is
+// stored under the next hash that isn't.
+// TODO: come up with a way to use less memory than keeping all keys in memory.
+// Even though the large values are only kept as digests, this could be a problem
+// in large result sets.
 type distinctIter struct {
 	childIter sql.RowIter
 	seen      sql.KeyValueCache
@@ -103,16 +105,21 @@ func (di *distinctIter) Next(ctx *sql.Context) (sql.Row, error) {
 			return nil, err
 		}
 
-		hash, err := sql.HashOf(row)
+		hash, key, err := sql.HashKeyOf(row)
 		if err != nil {
 			return nil, err
 		}
 
-		if _, err := di.seen.Get(hash); err == nil {
+		seen, err := di.seen.Get(hash)
+		for err == nil && seen.(string) != key {
+			hash++
+			seen, err = di.seen.Get(hash)
+		}
+		if err == nil {
 			continue
 		}
 
-		if err := di.seen.Put(hash, struct{}{}); err != nil {
+		if err := di.seen.Put(hash, key); err != nil {
 			return nil, err
 		}
 
@@ -16,6 +16,8 @@ package plan
 
 import (
 	"io"
+	"io/ioutil"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -72,6 +74,48 @@ func TestDistinct(t *testing.T) {
 	require.Equal([]string{"john", "jane", "martha"}, results)
 }
 
+func TestDistinctHashCollision(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	rows := []sql.Row{sql.NewRow("a"), sql.NewRow("b"), sql.NewRow("a")}
+	di := newDistinctIter(ctx, sql.RowsToRowIter(rows...))
+
+	// A row with another key takes the hash of the key of the rows with "a"
+	hash, _, err := sql.HashKeyOf(sql.NewRow("a"))
+	require.NoError(err)
+	require.NoError(di.seen.Put(hash, "other"))
+
+	results, err := sql.RowIterToRows(ctx, di)
+	require.NoError(err)
+	require.Equal([]sql.Row{{"a"}, {"b"}}, results)
+}
+
+func TestDistinctLobValues(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	lob := func(contents string) sql.LobValue {
+		return sql.NewLobValue(int64(len(contents)), func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(strings.NewReader(contents)), nil
+		})
+	}
+	large := strings.Repeat("0123456789", 100)
+	a, b, c := lob(large+"a"), lob(large+"b"), lob(large+"a")
+
+	// LobValues are told apart by their contents, not by their size
+	di := newDistinctIter(ctx, sql.RowsToRowIter(sql.NewRow(a), sql.NewRow(b), sql.NewRow(c)))
+	results, err := sql.RowIterToRows(ctx, di)
+	require.NoError(err)
+	var contents []string
+	for _, row := range results {
+		b, err := sql.ReadLobValue(row[0].(sql.LobValue))
+		require.NoError(err)
+		contents = append(contents, string(b))
+	}
+	require.Equal([]string{large + "a", large + "b"}, contents)
+}
+
 func TestOrderedDistinct(t *testing.T) {
 	require := require.New(t)
 	ctx := sql.NewEmptyContext()
@@ -0,0 +1,325 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+const (
+	// DefaultPartitionRetries is the number of times a distributed exchange retries reading a partition, unless
+	// it's given another number.
+	DefaultPartitionRetries = 3
+	// DefaultPartitionRetryDelay is the time a distributed exchange waits before it first retries reading a
+	// partition, unless it's given another delay. The delay doubles with each retry.
+	DefaultPartitionRetryDelay = 50 * time.Millisecond
+)
+
+// DistributedExchange is a node that parallelizes the underlying tree like an Exchange, for tables whose partitions
+// are stored across several nodes.
+//
+// The partitions of the table are grouped by the node that stores them, as given by the Locality of the
+// sql.RemotePartitions, and the partitions of each node are read concurrently by Parallelism workers of their own, so
+// that a slow node doesn't hold up the partitions of the others. Partitions that aren't remote are grouped together.
+//
+// When the table is a sql.ShardedTable, reading a partition that fails with an error the table reports as retryable
+// is retried up to MaxRetries times, waiting RetryDelay before the first retry and twice as long before each of the
+// next ones. The rows the partition returned before it failed are skipped when it's read again. Rows are returned in
+// the order the partitions' workers produce them.
+type DistributedExchange struct {
+	UnaryNode
+	// Parallelism is the number of partitions of each node that are read concurrently.
+	Parallelism int
+	// MaxRetries is the number of times reading a partition is retried after a retryable error.
+	MaxRetries int
+	// RetryDelay is the time waited before the first retry of a partition.
+	RetryDelay time.Duration
+}
+
+var _ sql.Node = (*DistributedExchange)(nil)
+
+// NewDistributedExchange creates a new DistributedExchange node, which retries reading partitions
+// DefaultPartitionRetries times.
+func NewDistributedExchange(parallelism int, child sql.Node) *DistributedExchange {
+	return &DistributedExchange{
+		UnaryNode:   UnaryNode{Child: child},
+		Parallelism: parallelism,
+		MaxRetries:  DefaultPartitionRetries,
+		RetryDelay:  DefaultPartitionRetryDelay,
+	}
+}
+
+// WithRetries returns a copy of this exchange that retries reading a partition up to the number of times given,
+// waiting the delay given before the first retry.
+func (e DistributedExchange) WithRetries(maxRetries int, delay time.Duration) *DistributedExchange {
+	e.MaxRetries = maxRetries
+	e.RetryDelay = delay
+	return &e
+}
+
+// RowIter implements the sql.Node interface.
+func (e *DistributedExchange) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	t, err := exchangeTable(e.Child)
+	if err != nil {
+		return nil, err
+	}
+
+	partitions, err := t.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	retryable := func(sql.Partition, error) bool { return false }
+	if st, ok := shardedTable(t); ok {
+		retryable = st.IsRetryable
+	}
+	getRowIter := partitionRowIterFunc(e.Child, row)
+
+	// The structure is that of an Exchange, except that the
+	// dependent errgroup of workers is grown by |dispatchPartitions|
+	// as it finds partitions of new nodes, and is waited on by it
+	// once all the partitions were dispatched, since an errgroup
+	// can't grow while it's being waited on.
+
+	rowsCh := make(chan sql.Row, e.Parallelism*16)
+	eg, egCtx := ctx.NewErrgroup()
+	eg.Go(func() error {
+		defer close(rowsCh)
+		seg, segCtx := egCtx.NewErrgroup()
+		err := dispatchPartitions(segCtx, partitions, func(partitions <-chan sql.Partition) {
+			for i := 0; i < e.Parallelism; i++ {
+				seg.Go(func() error {
+					return e.iterPartitionRows(segCtx, getRowIter, retryable, partitions, rowsCh)
+				})
+			}
+		})
+		if werr := seg.Wait(); err == nil {
+			err = werr
+		}
+		if err != nil {
+			return err
+		}
+		return io.EOF
+	})
+
+	waiter := func() error { return eg.Wait() }
+	shutdownHook := newShutdownHook(eg, egCtx)
+	return &exchangeRowIter{shutdownHook, waiter, rowsCh}, nil
+}
+
+// iterPartitionRows is the worker of a distributed exchange for the partitions of a node. It works like the
+// |iterPartitionRows| of an Exchange, except that it retries reading a partition that fails with an error that
+// |retryable| accepts.
+func (e *DistributedExchange) iterPartitionRows(
+	ctx *sql.Context,
+	getRowIter rowIterPartitionFunc,
+	retryable func(sql.Partition, error) bool,
+	partitions <-chan sql.Partition,
+	rows chan<- sql.Row,
+) (rerr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			rerr = fmt.Errorf("panic in DistributedExchangeIterPartitionRows: %v", r)
+		}
+	}()
+	for {
+		select {
+		case p, ok := <-partitions:
+			if !ok {
+				return nil
+			}
+			span, ctx := ctx.Span("exchange.IterPartition")
+			count, err := e.readPartition(ctx, getRowIter, retryable, p, rows)
+			span.LogKV("num_rows", count)
+			span.Finish()
+			if err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// readPartition sends the rows of the partition given to |rows|, retrying when reading them fails with a retryable
+// error, and returns the number of rows sent.
+func (e *DistributedExchange) readPartition(
+	ctx *sql.Context,
+	getRowIter rowIterPartitionFunc,
+	retryable func(sql.Partition, error) bool,
+	p sql.Partition,
+	rows chan<- sql.Row,
+) (int, error) {
+	var sent int
+	delay := e.RetryDelay
+	for attempt := 0; ; attempt++ {
+		iter, err := getRowIter(ctx, p)
+		if err == nil {
+			var count int
+			count, err = sendRowsAfter(ctx, iter, sent, rows)
+			if count > sent {
+				sent = count
+			}
+		}
+		if err == nil {
+			return sent, nil
+		}
+		if ctx.Err() != nil {
+			return sent, ctx.Err()
+		}
+		if attempt >= e.MaxRetries || !retryable(p, err) {
+			return sent, err
+		}
+
+		ctx.GetLogger().Warnf("retrying partition %s after error: %s", partitionDescription(p), err)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return sent, ctx.Err()
+		}
+		delay *= 2
+	}
+}
+
+// sendRowsAfter works like sendAllRows, except that it doesn't send the first |skip| rows of the iterator, which were
+// sent by a previous attempt. It returns the number of rows read, including the skipped ones.
+func sendRowsAfter(ctx *sql.Context, iter sql.RowIter, skip int, rows chan<- sql.Row) (rowCount int, rerr error) {
+	defer func() {
+		cerr := iter.Close(ctx)
+		if rerr == nil {
+			rerr = cerr
+		}
+	}()
+	for {
+		r, err := iter.Next(ctx)
+		if err == io.EOF {
+			return rowCount, nil
+		}
+		if err != nil {
+			return rowCount, err
+		}
+		rowCount++
+		if rowCount <= skip {
+			continue
+		}
+		select {
+		case rows <- r:
+		case <-ctx.Done():
+			return rowCount, ctx.Err()
+		}
+	}
+}
+
+// dispatchPartitions sends every partition of |iter| to the channel of the node that stores it, calling |startWorkers|
+// with the channel of each node the first time one of its partitions is found. The channels are closed once all the
+// partitions were dispatched.
+func dispatchPartitions(ctx *sql.Context, iter sql.PartitionIter, startWorkers func(<-chan sql.Partition)) (rerr error) {
+	nodes := make(map[string]chan sql.Partition)
+	defer func() {
+		for _, ch := range nodes {
+			close(ch)
+		}
+	}()
+	defer func() {
+		if r := recover(); r != nil {
+			rerr = fmt.Errorf("panic in dispatchPartitions: %v", r)
+		}
+	}()
+	defer func() {
+		cerr := iter.Close(ctx)
+		if rerr == nil {
+			rerr = cerr
+		}
+	}()
+	for {
+		p, err := iter.Next(ctx)
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+
+		var node string
+		if rp, ok := p.(sql.RemotePartition); ok {
+			node = rp.Locality().Node
+		}
+		ch, ok := nodes[node]
+		if !ok {
+			ch = make(chan sql.Partition)
+			nodes[node] = ch
+			startWorkers(ch)
+		}
+		select {
+		case ch <- p:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// partitionDescription returns the key of the partition given, along with the node that stores it if it's remote.
+func partitionDescription(p sql.Partition) string {
+	if rp, ok := p.(sql.RemotePartition); ok {
+		return fmt.Sprintf("%s on %s", string(p.Key()), rp.Locality().Node)
+	}
+	return string(p.Key())
+}
+
+// shardedTable returns the sharded table of the table given, which may be wrapped by a ResolvedTable or
+// sql.TableWrappers.
+func shardedTable(t sql.Table) (sql.ShardedTable, bool) {
+	for {
+		switch table := t.(type) {
+		case sql.ShardedTable:
+			return table, true
+		case *ResolvedTable:
+			t = table.Table
+		case sql.TableWrapper:
+			t = table.Underlying()
+		default:
+			return nil, false
+		}
+	}
+}
+
+func (e *DistributedExchange) String() string {
+	p := sql.NewTreePrinter()
+	_ = p.WriteNode("DistributedExchange(parallelism=%d, retries=%d)", e.Parallelism, e.MaxRetries)
+	_ = p.WriteChildren(e.Child.String())
+	return p.String()
+}
+
+func (e *DistributedExchange) DebugString() string {
+	p := sql.NewTreePrinter()
+	_ = p.WriteNode("DistributedExchange(parallelism=%d, retries=%d, delay=%s)", e.Parallelism, e.MaxRetries, e.RetryDelay)
+	_ = p.WriteChildren(sql.DebugString(e.Child))
+	return p.String()
+}
+
+// WithChildren implements the Node interface.
+func (e *DistributedExchange) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), 1)
+	}
+
+	ne := *e
+	ne.Child = children[0]
+	return &ne, nil
+}
@@ -0,0 +1,212 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestDistributedExchange(t *testing.T) {
+	var expected []sql.Row
+	for _, p := range []string{"a1", "a2", "b1", "b2", "local"} {
+		for i := 1; i <= 5; i++ {
+			expected = append(expected, sql.NewRow(p, int64(i)))
+		}
+	}
+
+	for parallelism := 1; parallelism <= 3; parallelism++ {
+		t.Run(fmt.Sprint(parallelism), func(t *testing.T) {
+			require := require.New(t)
+			table := newShardedTestTable(5)
+			// a2 fails twice after returning some of its rows, and b1 once before returning any
+			table.failures["a2"] = []int{3, 1}
+			table.failures["b1"] = []int{0}
+
+			exchange := NewDistributedExchange(parallelism, NewResolvedTable(table, nil, nil)).WithRetries(2, time.Millisecond)
+			rows, err := sql.NodeToRows(sql.NewEmptyContext(), exchange)
+			require.NoError(err)
+			require.ElementsMatch(expected, rows)
+			require.Equal(3, table.attempts["a2"])
+			require.Equal(2, table.attempts["b1"])
+			require.Equal(1, table.attempts["local"])
+		})
+	}
+}
+
+func TestDistributedExchangeRetriesExhausted(t *testing.T) {
+	require := require.New(t)
+	table := newShardedTestTable(5)
+	table.failures["b2"] = []int{2, 2, 2}
+
+	exchange := NewDistributedExchange(2, NewResolvedTable(table, nil, nil)).WithRetries(2, time.Millisecond)
+	_, err := sql.NodeToRows(sql.NewEmptyContext(), exchange)
+	require.Error(err)
+	require.Equal(errShardUnavailable, err)
+	require.Equal(3, table.attempts["b2"])
+}
+
+func TestDistributedExchangeNotRetryable(t *testing.T) {
+	require := require.New(t)
+	table := newShardedTestTable(5)
+	table.failures["a1"] = []int{1}
+	table.retryable = false
+
+	exchange := NewDistributedExchange(2, NewResolvedTable(table, nil, nil)).WithRetries(2, time.Millisecond)
+	_, err := sql.NodeToRows(sql.NewEmptyContext(), exchange)
+	require.Error(err)
+	require.Equal(1, table.attempts["a1"])
+}
+
+func TestDistributedExchangeFilter(t *testing.T) {
+	require := require.New(t)
+	table := newShardedTestTable(5)
+	table.failures["b2"] = []int{1}
+
+	node := NewFilter(
+		expression.NewLessThan(
+			expression.NewGetField(1, sql.Int64, "val", false),
+			expression.NewLiteral(int64(2), sql.Int64),
+		),
+		NewResolvedTable(table, nil, nil),
+	)
+	exchange := NewDistributedExchange(2, node).WithRetries(1, time.Millisecond)
+	rows, err := sql.NodeToRows(sql.NewEmptyContext(), exchange)
+	require.NoError(err)
+	require.ElementsMatch([]sql.Row{
+		{"a1", int64(1)},
+		{"a2", int64(1)},
+		{"b1", int64(1)},
+		{"b2", int64(1)},
+		{"local", int64(1)},
+	}, rows)
+}
+
+func TestDistributedExchangeCancelled(t *testing.T) {
+	require := require.New(t)
+	table := newShardedTestTable(2048)
+
+	c, cancel := context.WithCancel(context.Background())
+	ctx := sql.NewContext(c)
+	cancel()
+
+	iter, err := NewDistributedExchange(3, NewResolvedTable(table, nil, nil)).RowIter(ctx, nil)
+	require.NoError(err)
+
+	_, err = iter.Next(ctx)
+	require.Equal(context.Canceled, err)
+}
+
+var errShardUnavailable = fmt.Errorf("shard unavailable")
+
+// shardedTestTable is a sharded table with partitions a1 and a2 on node a, b1 and b2 on node b, and a local partition,
+// whose rows are the name of their partition and a number. Reading a partition fails after the number of rows given
+// by its failures, one attempt after the other.
+type shardedTestTable struct {
+	rowsPerPartition int
+	retryable        bool
+
+	mu       sync.Mutex
+	failures map[string][]int
+	attempts map[string]int
+}
+
+var _ sql.ShardedTable = (*shardedTestTable)(nil)
+
+func newShardedTestTable(rowsPerPartition int) *shardedTestTable {
+	return &shardedTestTable{
+		rowsPerPartition: rowsPerPartition,
+		retryable:        true,
+		failures:         make(map[string][]int),
+		attempts:         make(map[string]int),
+	}
+}
+
+func (t *shardedTestTable) Name() string { return "sharded" }
+
+func (t *shardedTestTable) String() string { return "sharded" }
+
+func (t *shardedTestTable) Schema() sql.Schema {
+	return sql.Schema{
+		{Name: "partition", Type: sql.Text, Source: "sharded"},
+		{Name: "val", Type: sql.Int64, Source: "sharded"},
+	}
+}
+
+func (t *shardedTestTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return sql.PartitionsToPartitionIter(
+		remoteTestPartition{"a1", "a"},
+		remoteTestPartition{"b1", "b"},
+		remoteTestPartition{"a2", "a"},
+		Partition("local"),
+		remoteTestPartition{"b2", "b"},
+	), nil
+}
+
+func (t *shardedTestTable) PartitionRows(_ *sql.Context, p sql.Partition) (sql.RowIter, error) {
+	key := string(p.Key())
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.attempts[key]++
+
+	var rows []sql.Row
+	for i := 1; i <= t.rowsPerPartition; i++ {
+		rows = append(rows, sql.NewRow(key, int64(i)))
+	}
+	if failures := t.failures[key]; len(failures) > 0 {
+		t.failures[key] = failures[1:]
+		return &failingRowIter{rows: rows[:failures[0]]}, nil
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+func (t *shardedTestTable) IsRetryable(_ sql.Partition, err error) bool {
+	return t.retryable && errShardUnavailable == err
+}
+
+type remoteTestPartition struct {
+	key  string
+	node string
+}
+
+func (p remoteTestPartition) Key() []byte { return []byte(p.key) }
+
+func (p remoteTestPartition) Locality() sql.PartitionLocality {
+	return sql.PartitionLocality{Node: p.node}
+}
+
+// failingRowIter returns its rows and then fails with errShardUnavailable.
+type failingRowIter struct {
+	rows []sql.Row
+}
+
+func (i *failingRowIter) Next(*sql.Context) (sql.Row, error) {
+	if len(i.rows) == 0 {
+		return nil, errShardUnavailable
+	}
+	row := i.rows[0]
+	i.rows = i.rows[1:]
+	return row, nil
+}
+
+func (i *failingRowIter) Close(*sql.Context) error { return nil }
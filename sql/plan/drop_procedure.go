@@ -69,6 +69,7 @@ func (d *DropProcedure) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, err
 	procDb, ok := d.db.(sql.StoredProcedureDatabase)
 	if !ok {
 		if d.IfExists {
+			addNote(ctx, errSpDoesNotExist, "PROCEDURE %s.%s does not exist", d.db.Name(), d.ProcedureName)
 			return sql.RowsToRowIter(), nil
 		} else {
 			return nil, sql.ErrStoredProceduresNotSupported.New(d.ProcedureName)
@@ -76,6 +77,7 @@ func (d *DropProcedure) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, err
 	}
 	err := procDb.DropStoredProcedure(ctx, d.ProcedureName)
 	if d.IfExists && sql.ErrStoredProcedureDoesNotExist.Is(err) {
+		addNote(ctx, errSpDoesNotExist, "PROCEDURE %s.%s does not exist", d.db.Name(), d.ProcedureName)
 		return sql.RowsToRowIter(), nil
 	} else if err != nil {
 		return nil, err
@@ -69,6 +69,7 @@ func (d *DropTrigger) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 	triggerDb, ok := d.db.(sql.TriggerDatabase)
 	if !ok {
 		if d.IfExists {
+			addNote(ctx, errTrgDoesNotExist, "Trigger does not exist")
 			return sql.RowsToRowIter(), nil
 		} else {
 			return nil, sql.ErrTriggerDoesNotExist.New(d.TriggerName)
@@ -76,6 +77,7 @@ func (d *DropTrigger) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error
 	}
 	err := triggerDb.DropTrigger(ctx, d.TriggerName)
 	if d.IfExists && sql.ErrTriggerDoesNotExist.Is(err) {
+		addNote(ctx, errTrgDoesNotExist, "Trigger does not exist")
 		return sql.RowsToRowIter(), nil
 	} else if err != nil {
 		return nil, err
@@ -19,23 +19,27 @@ import (
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/grant_tables"
 )
 
 // DropUser represents the statement DROP USER.
 type DropUser struct {
-	IfExists bool
-	Users    []UserName
+	IfExists    bool
+	Users       []UserName
+	GrantTables sql.Database
 }
 
 // NewDropUser returns a new DropUser node.
 func NewDropUser(ifExists bool, users []UserName) *DropUser {
 	return &DropUser{
-		IfExists: ifExists,
-		Users:    users,
+		IfExists:    ifExists,
+		Users:       users,
+		GrantTables: sql.UnresolvedDatabase("mysql"),
 	}
 }
 
 var _ sql.Node = (*DropUser)(nil)
+var _ sql.Databaser = (*DropUser)(nil)
 
 // Schema implements the interface sql.Node.
 func (n *DropUser) Schema() sql.Schema {
@@ -55,9 +59,22 @@ func (n *DropUser) String() string {
 	return fmt.Sprintf("DropUser(%s%s)", ifExists, strings.Join(users, ", "))
 }
 
+// Database implements the interface sql.Databaser.
+func (n *DropUser) Database() sql.Database {
+	return n.GrantTables
+}
+
+// WithDatabase implements the interface sql.Databaser.
+func (n *DropUser) WithDatabase(db sql.Database) (sql.Node, error) {
+	nn := *n
+	nn.GrantTables = db
+	return &nn, nil
+}
+
 // Resolved implements the interface sql.Node.
 func (n *DropUser) Resolved() bool {
-	return true
+	_, ok := n.GrantTables.(sql.UnresolvedDatabase)
+	return !ok
 }
 
 // Children implements the interface sql.Node.
@@ -75,5 +92,41 @@ func (n *DropUser) WithChildren(children ...sql.Node) (sql.Node, error) {
 
 // RowIter implements the interface sql.Node.
 func (n *DropUser) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	return nil, fmt.Errorf("not yet implemented")
+	grantTables, ok := n.GrantTables.(*grant_tables.GrantTables)
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New("mysql")
+	}
+	userTableData := grantTables.UserTable().Data()
+
+	// Every user is checked before any is dropped, so that the statement either drops all of them or none
+	var existing []grant_tables.UserPrimaryKey
+	for _, user := range n.Users {
+		userPk := grant_tables.UserPrimaryKey{
+			Host: user.Host,
+			User: user.Name,
+		}
+		if len(userTableData.Get(userPk)) == 0 {
+			if n.IfExists {
+				addNote(ctx, errUserDoesNotExist, "Authorization ID %s does not exist.", user.StringWithQuote("'", ""))
+				continue
+			}
+			return nil, sql.ErrUserDeletionFailure.New(user.StringWithQuote("'", ""))
+		}
+		existing = append(existing, userPk)
+	}
+
+	for _, userPk := range existing {
+		// The user's privileges are revoked first, which removes its rows from the other grant tables
+		if err := grantTables.RevokeAll(userPk.User, userPk.Host); err != nil {
+			return nil, err
+		}
+		if err := userTableData.Remove(userPk, nil); err != nil {
+			return nil, err
+		}
+	}
+	if err := grantTables.Persist(ctx); err != nil {
+		return nil, err
+	}
+
+	return sql.RowsToRowIter(sql.Row{sql.NewOkResult(0)}), nil
 }
@@ -15,6 +15,7 @@
 package plan
 
 import (
+	"github.com/dolthub/vitess/go/mysql"
 	errors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -74,6 +75,11 @@ func (dv *SingleDropView) WithChildren(children ...sql.Node) (sql.Node, error) {
 	return dv, nil
 }
 
+// ViewName returns the name of the view to drop.
+func (dv *SingleDropView) ViewName() string {
+	return dv.viewName
+}
+
 // Database implements the sql.Databaser interface. It returns the node's database.
 func (dv *SingleDropView) Database() sql.Database {
 	return dv.database
@@ -128,20 +134,19 @@ func (dvs *DropView) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 			return sql.RowsToRowIter(), errDropViewChild.New()
 		}
 
+		var err error
 		if dropper, ok := drop.database.(sql.ViewDatabase); ok {
-			err := dropper.DropView(ctx, drop.viewName)
-			if err != nil {
-				allowedError := dvs.ifExists && sql.ErrViewDoesNotExist.Is(err)
-				if !allowedError {
-					return sql.RowsToRowIter(), err
-				}
-			}
+			err = dropper.DropView(ctx, drop.viewName)
 		} else {
-			err := ctx.GetViewRegistry().Delete(drop.database.Name(), drop.viewName)
-			allowedError := dvs.ifExists && sql.ErrViewDoesNotExist.Is(err)
-			if !allowedError {
-				return sql.RowsToRowIter(), err
+			err = ctx.GetViewRegistry().Delete(drop.database.Name(), drop.viewName)
+		}
+
+		if err != nil {
+			if dvs.ifExists && sql.ErrViewDoesNotExist.Is(err) {
+				addNote(ctx, mysql.ERBadTable, "Unknown table '%s.%s'", drop.database.Name(), drop.viewName)
+				continue
 			}
+			return sql.RowsToRowIter(), err
 		}
 	}
 
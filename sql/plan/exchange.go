@@ -15,15 +15,18 @@
 package plan
 
 import (
+	"container/heap"
 	"context"
 	"fmt"
 	"io"
+	"strings"
 	"sync"
 
 	"golang.org/x/sync/errgroup"
 	errors "gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 // ErrNoPartitionable is returned when no Partitionable node is found
@@ -32,9 +35,23 @@ var ErrNoPartitionable = errors.NewKind("no partitionable node found in exchange
 
 // Exchange is a node that can parallelize the underlying tree iterating
 // partitions concurrently.
+//
+// Rows are returned in the order the partitions' workers produce them,
+// unless the exchange has SortFields: then its child returns the rows of
+// each partition sorted by these fields, and the exchange merges the
+// sorted rows of all partitions so that they're returned in that order.
+// An exchange that keeps the PartitionOrder returns all the rows of each
+// partition, in the order of the partitions of the table, the same order
+// a serial iteration of the table would return them in.
 type Exchange struct {
 	UnaryNode
 	Parallelism int
+	// SortFields are the fields the rows of each partition are sorted by,
+	// if the order of the rows must be preserved.
+	SortFields sql.SortFields
+	// PartitionOrder is whether the rows are returned in the order of
+	// the partitions they come from.
+	PartitionOrder bool
 }
 
 // NewExchange creates a new Exchange node.
@@ -48,18 +65,25 @@ func NewExchange(
 	}
 }
 
+// WithSortFields returns a copy of this exchange that merges the rows of its
+// partitions, sorted by the fields given, in that order.
+func (e Exchange) WithSortFields(fields sql.SortFields) *Exchange {
+	e.SortFields = fields
+	return &e
+}
+
+// WithPartitionOrder returns a copy of this exchange that returns the rows
+// of its partitions in the order of the partitions.
+func (e Exchange) WithPartitionOrder() *Exchange {
+	e.PartitionOrder = true
+	return &e
+}
+
 // RowIter implements the sql.Node interface.
 func (e *Exchange) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	var t sql.Table
-	Inspect(e.Child, func(n sql.Node) bool {
-		if table, ok := n.(sql.Table); ok {
-			t = table
-			return false
-		}
-		return true
-	})
-	if t == nil {
-		return nil, ErrNoPartitionable.New()
+	t, err := exchangeTable(e.Child)
+	if err != nil {
+		return nil, err
 	}
 
 	partitions, err := t.Partitions(ctx)
@@ -75,6 +99,10 @@ func (e *Exchange) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	// dependent errgroup and closes |rowsCh| once all its
 	// goroutines are completed.
 
+	if len(e.SortFields) > 0 || e.PartitionOrder {
+		return e.orderedRowIter(ctx, row, partitions)
+	}
+
 	partitionsCh := make(chan sql.Partition)
 	rowsCh := make(chan sql.Row, e.Parallelism*16)
 
@@ -112,32 +140,123 @@ func (e *Exchange) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	return &exchangeRowIter{shutdownHook, waiter, rowsCh}, nil
 }
 
+// orderedRowIter returns the rows of the partitions given, merging the sorted
+// rows of each partition, or one partition after the other, in their order.
+// The rows of each partition are read in full by its worker before they're
+// returned, as the child has to sort them anyway, and the rows of partitions
+// read before those before them are kept until they are returned.
+func (e *Exchange) orderedRowIter(ctx *sql.Context, row sql.Row, partitions sql.PartitionIter) (sql.RowIter, error) {
+	partitionsCh := make(chan sql.Partition)
+	runsCh := make(chan sortedRun, e.Parallelism)
+
+	eg, egCtx := ctx.NewErrgroup()
+	eg.Go(func() error {
+		defer close(partitionsCh)
+		return iterPartitions(egCtx, &numberedPartitionIter{PartitionIter: partitions}, partitionsCh)
+	})
+
+	getRowIter := e.getRowIterFunc(row)
+	seg, segCtx := egCtx.NewErrgroup()
+	for i := 0; i < e.Parallelism; i++ {
+		seg.Go(func() error {
+			return iterPartitionRuns(segCtx, getRowIter, partitionsCh, runsCh)
+		})
+	}
+
+	eg.Go(func() error {
+		defer close(runsCh)
+		err := seg.Wait()
+		if err != nil {
+			return err
+		}
+		return io.EOF
+	})
+
+	waiter := func() error { return eg.Wait() }
+	shutdownHook := newShutdownHook(eg, egCtx)
+	if len(e.SortFields) == 0 {
+		return &partitionOrderRowIter{
+			shutdownHook: shutdownHook,
+			waiter:       waiter,
+			runs:         runsCh,
+			pending:      make(map[int][]sql.Row),
+			next:         1,
+		}, nil
+	}
+	return &orderedExchangeRowIter{
+		shutdownHook: shutdownHook,
+		waiter:       waiter,
+		runs:         runsCh,
+		fields:       e.SortFields,
+	}, nil
+}
+
 func (e *Exchange) String() string {
 	p := sql.NewTreePrinter()
-	_ = p.WriteNode("Exchange(parallelism=%d)", e.Parallelism)
+	_ = p.WriteNode("Exchange(%s)", e.describe())
 	_ = p.WriteChildren(e.Child.String())
 	return p.String()
 }
 
 func (e *Exchange) DebugString() string {
 	p := sql.NewTreePrinter()
-	_ = p.WriteNode("Exchange(parallelism=%d)", e.Parallelism)
+	_ = p.WriteNode("Exchange(%s)", e.describe())
 	_ = p.WriteChildren(sql.DebugString(e.Child))
 	return p.String()
 }
 
+// describe returns the parameters of the exchange, for its String and
+// DebugString.
+func (e *Exchange) describe() string {
+	if len(e.SortFields) == 0 {
+		if e.PartitionOrder {
+			return fmt.Sprintf("parallelism=%d, partition_order", e.Parallelism)
+		}
+		return fmt.Sprintf("parallelism=%d", e.Parallelism)
+	}
+	fields := make([]string, len(e.SortFields))
+	for i, f := range e.SortFields {
+		fields[i] = fmt.Sprintf("%s %s", f.Column, f.Order)
+	}
+	return fmt.Sprintf("parallelism=%d, merge=[%s]", e.Parallelism, strings.Join(fields, ", "))
+}
+
 // WithChildren implements the Node interface.
 func (e *Exchange) WithChildren(children ...sql.Node) (sql.Node, error) {
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), 1)
 	}
 
-	return NewExchange(e.Parallelism, children[0]), nil
+	ne := *e
+	ne.UnaryNode = UnaryNode{Child: children[0]}
+	return &ne, nil
 }
 
 func (e *Exchange) getRowIterFunc(row sql.Row) func(*sql.Context, sql.Partition) (sql.RowIter, error) {
+	return partitionRowIterFunc(e.Child, row)
+}
+
+// exchangeTable returns the table whose partitions the exchange with the child given iterates.
+func exchangeTable(child sql.Node) (sql.Table, error) {
+	var t sql.Table
+	Inspect(child, func(n sql.Node) bool {
+		if table, ok := n.(sql.Table); ok {
+			t = table
+			return false
+		}
+		return true
+	})
+	if t == nil {
+		return nil, ErrNoPartitionable.New()
+	}
+	return t, nil
+}
+
+// partitionRowIterFunc returns a function that returns the rows of the child of an exchange for a single partition of
+// its table.
+func partitionRowIterFunc(child sql.Node, row sql.Row) rowIterPartitionFunc {
 	return func(ctx *sql.Context, partition sql.Partition) (sql.RowIter, error) {
-		node, err := TransformUp(e.Child, func(n sql.Node) (sql.Node, error) {
+		node, err := TransformUp(child, func(n sql.Node) (sql.Node, error) {
 			if t, ok := n.(sql.Table); ok {
 				return &exchangePartition{partition, t}, nil
 			}
@@ -179,6 +298,247 @@ func (i *exchangeRowIter) Close(ctx *sql.Context) error {
 	return err
 }
 
+// orderedExchangeRowIter implements sql.RowIter for an exchange node
+// with SortFields. The first call to |Next| waits for the sorted runs of
+// rows of all partitions, and then each call returns the first row of
+// the runs.
+type orderedExchangeRowIter struct {
+	shutdownHook func()
+	waiter       func() error
+	runs         <-chan sortedRun
+	fields       sql.SortFields
+	merge        *sortedRunsHeap
+}
+
+func (i *orderedExchangeRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if i.merge == nil {
+		var runs []sortedRun
+		for run := range i.runs {
+			if len(run.rows) > 0 {
+				runs = append(runs, run)
+			}
+		}
+		if err := i.waiter(); err != io.EOF {
+			return nil, err
+		}
+
+		i.merge = newSortedRunsHeap(ctx, i.fields, runs)
+		heap.Init(i.merge)
+		if i.merge.LastError != nil {
+			return nil, i.merge.LastError
+		}
+	}
+
+	if i.merge.Len() == 0 {
+		return nil, io.EOF
+	}
+	row := i.merge.next()
+	if i.merge.LastError != nil {
+		return nil, i.merge.LastError
+	}
+	return row, nil
+}
+
+func (i *orderedExchangeRowIter) Close(ctx *sql.Context) error {
+	i.merge = nil
+	i.shutdownHook()
+	err := i.waiter()
+	if err == shutdownHookErr || err == io.EOF {
+		return nil
+	}
+	return err
+}
+
+// partitionOrderRowIter implements sql.RowIter for an exchange node
+// with PartitionOrder. It returns the rows of each partition once those
+// of all the partitions before it were returned, keeping the rows of the
+// partitions read before their turn.
+type partitionOrderRowIter struct {
+	shutdownHook func()
+	waiter       func() error
+	runs         <-chan sortedRun
+	// pending are the rows of the partitions read but not yet returned,
+	// by position, and next the position of the partition to return.
+	pending map[int][]sql.Row
+	next    int
+	rows    []sql.Row
+}
+
+func (i *partitionOrderRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for len(i.rows) == 0 {
+		if rows, ok := i.pending[i.next]; ok {
+			delete(i.pending, i.next)
+			i.rows = rows
+			i.next++
+			continue
+		}
+
+		run, ok := <-i.runs
+		if !ok {
+			return nil, i.waiter()
+		}
+		i.pending[run.partition] = run.rows
+	}
+
+	row := i.rows[0]
+	i.rows = i.rows[1:]
+	return row, nil
+}
+
+func (i *partitionOrderRowIter) Close(ctx *sql.Context) error {
+	i.pending, i.rows = nil, nil
+	i.shutdownHook()
+	err := i.waiter()
+	if err == shutdownHookErr || err == io.EOF {
+		return nil
+	}
+	return err
+}
+
+// sortedRun is the sorted rows of a partition of an ordered exchange,
+// along with the position of the partition in the table, or a sorted
+// run of the rows of a sort, along with its position among the runs.
+type sortedRun struct {
+	partition int
+	rows      []sql.Row
+	// spilled reads the rows of a run spilled to disk by a sort, which
+	// are read into |rows| a batch at a time.
+	spilled *sql.SpilledRunIter
+}
+
+// spilledRunBatchSize is the number of rows of a spilled run read at
+// once.
+const spilledRunBatchSize = 1024
+
+// readSpilled reads the next batch of rows of a spilled run into its
+// rows.
+func (r *sortedRun) readSpilled() error {
+	r.rows = make([]sql.Row, 0, spilledRunBatchSize)
+	for len(r.rows) < spilledRunBatchSize {
+		row, err := r.spilled.Next()
+		if err == io.EOF {
+			r.spilled = nil
+			break
+		}
+		if err != nil {
+			return err
+		}
+		r.rows = append(r.rows, row)
+	}
+	return nil
+}
+
+// sortedRunsHeap implements heap.Interface for the k-way merge of the
+// sorted runs of an ordered exchange. Runs are ordered by their first
+// row, which are the Rows of its Sorter, and runs whose first rows sort
+// the same are ordered by their partition, so that rows that sort the
+// same are returned in the order a serial sort would return them. The
+// sort key of each first row is computed once, when it enters the heap.
+type sortedRunsHeap struct {
+	expression.Sorter
+	runs []sortedRun
+}
+
+func newSortedRunsHeap(ctx *sql.Context, fields sql.SortFields, runs []sortedRun) *sortedRunsHeap {
+	h := &sortedRunsHeap{
+		Sorter: expression.Sorter{
+			SortFields: fields,
+			Rows:       make([]sql.Row, len(runs)),
+			Ctx:        ctx,
+		},
+		runs: runs,
+	}
+	for i, run := range runs {
+		h.Rows[i] = run.rows[0]
+	}
+	if err := h.ComputeKeys(); err != nil {
+		h.LastError = err
+	}
+	return h
+}
+
+func (h *sortedRunsHeap) Less(i, j int) bool {
+	if h.Sorter.Less(i, j) {
+		return true
+	}
+	if h.Sorter.Less(j, i) {
+		return false
+	}
+	return h.runs[i].partition < h.runs[j].partition
+}
+
+func (h *sortedRunsHeap) Swap(i, j int) {
+	h.Sorter.Swap(i, j)
+	h.runs[i], h.runs[j] = h.runs[j], h.runs[i]
+}
+
+func (h *sortedRunsHeap) Push(x interface{}) {
+	run := x.(sortedRun)
+	h.Keys = append(h.Keys, h.key(run.rows[0]))
+	h.Rows = append(h.Rows, run.rows[0])
+	h.runs = append(h.runs, run)
+}
+
+func (h *sortedRunsHeap) Pop() interface{} {
+	n := len(h.runs)
+	run := h.runs[n-1]
+	h.Keys = h.Keys[:n-1]
+	h.Rows = h.Rows[:n-1]
+	h.runs = h.runs[:n-1]
+	return run
+}
+
+// key returns the sort key of the row given, recording any error in
+// LastError.
+func (h *sortedRunsHeap) key(row sql.Row) expression.SortKey {
+	key, err := expression.NewSortKey(h.Ctx, h.SortFields, row)
+	if err != nil {
+		h.LastError = err
+	}
+	return key
+}
+
+// next removes and returns the first row of the heap's first run.
+func (h *sortedRunsHeap) next() sql.Row {
+	row := h.Rows[0]
+	h.runs[0].rows = h.runs[0].rows[1:]
+	if len(h.runs[0].rows) == 0 && h.runs[0].spilled != nil {
+		if err := h.runs[0].readSpilled(); err != nil {
+			h.LastError = err
+		}
+	}
+	if len(h.runs[0].rows) == 0 {
+		heap.Pop(h)
+	} else {
+		h.Rows[0] = h.runs[0].rows[0]
+		h.Keys[0] = h.key(h.Rows[0])
+		heap.Fix(h, 0)
+	}
+	return row
+}
+
+// numberedPartition is a partition along with its position in the
+// table, for ordered exchanges.
+type numberedPartition struct {
+	sql.Partition
+	n int
+}
+
+// numberedPartitionIter numbers the partitions of the iterator it wraps.
+type numberedPartitionIter struct {
+	sql.PartitionIter
+	n int
+}
+
+func (i *numberedPartitionIter) Next(ctx *sql.Context) (sql.Partition, error) {
+	p, err := i.PartitionIter.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	i.n++
+	return &numberedPartition{p, i.n}, nil
+}
+
 type exchangePartition struct {
 	sql.Partition
 	table sql.Table
@@ -273,6 +633,46 @@ func iterPartitionRows(ctx *sql.Context, getRowIter rowIterPartitionFunc, partit
 	}
 }
 
+// iterPartitionRuns is the parallel worker for an Exchange node with
+// SortFields or PartitionOrder. It works like |iterPartitionRows|,
+// except that it reads all the rows of each partition and sends them to
+// |runs| at once. The partitions it reads must be numberedPartitions.
+func iterPartitionRuns(ctx *sql.Context, getRowIter rowIterPartitionFunc, partitions <-chan sql.Partition, runs chan<- sortedRun) (rerr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			rerr = fmt.Errorf("panic in ExchangeIterPartitionRuns: %v", r)
+		}
+	}()
+	for {
+		select {
+		case p, ok := <-partitions:
+			if !ok {
+				return nil
+			}
+			np := p.(*numberedPartition)
+			span, ctx := ctx.Span("exchange.IterPartition")
+			iter, err := getRowIter(ctx, np.Partition)
+			if err != nil {
+				span.Finish()
+				return err
+			}
+			rows, err := sql.RowIterToRows(ctx, iter)
+			span.LogKV("num_rows", len(rows))
+			span.Finish()
+			if err != nil {
+				return err
+			}
+			select {
+			case runs <- sortedRun{partition: np.n, rows: rows}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 // iterPartitions will call Next() on |iter| and send every result it
 // finds to |partitions|.  Meant to be run as a goroutine in an
 // errgroup, it returns a non-nil error if it gets an error and it
@@ -18,12 +18,15 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math/rand"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/dolthub/go-mysql-server/memory"
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/expression"
 )
@@ -75,6 +78,70 @@ func TestExchange(t *testing.T) {
 	}
 }
 
+func TestOrderedExchange(t *testing.T) {
+	seed := time.Now().UnixNano()
+	t.Logf("seed: %d", seed)
+	rnd := rand.New(rand.NewSource(seed))
+
+	ctx := sql.NewEmptyContext()
+	for _, partitions := range []int{1, 2, 7, 16} {
+		table := memory.NewPartitionedTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "id", Type: sql.Int64, Source: "t", PrimaryKey: true},
+			{Name: "a", Type: sql.Int64, Source: "t", Nullable: true},
+			{Name: "b", Type: sql.Text, Source: "t"},
+		}), partitions)
+		for i := 0; i < 500; i++ {
+			var a interface{}
+			if rnd.Intn(10) > 0 {
+				a = int64(rnd.Intn(20))
+			}
+			row := sql.NewRow(int64(i), a, fmt.Sprint(rnd.Intn(5)))
+			require.NoError(t, table.Insert(ctx, row))
+		}
+
+		// Sort by columns with many duplicates, so that the merge must be stable as well
+		fields := sql.SortFields{
+			{Column: expression.NewGetField(1, sql.Int64, "a", true), Order: sql.Descending, NullOrdering: sql.NullsFirst},
+			{Column: expression.NewGetField(2, sql.Text, "b", false), Order: sql.Ascending, NullOrdering: sql.NullsFirst},
+		}
+		expected, err := sql.NodeToRows(ctx, NewSort(fields, NewResolvedTable(table, nil, nil)))
+		require.NoError(t, err)
+
+		for parallelism := 1; parallelism <= 4; parallelism++ {
+			t.Run(fmt.Sprintf("partitions=%d,parallelism=%d", partitions, parallelism), func(t *testing.T) {
+				exchange := NewExchange(parallelism, NewSort(fields, NewResolvedTable(table, nil, nil))).WithSortFields(fields)
+				rows, err := sql.NodeToRows(ctx, exchange)
+				require.NoError(t, err)
+				require.Equal(t, expected, rows)
+			})
+		}
+	}
+}
+
+func TestExchangePartitionOrder(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	for _, partitions := range []int{1, 2, 7, 16} {
+		table := memory.NewPartitionedTable("t", sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: "id", Type: sql.Int64, Source: "t", PrimaryKey: true},
+		}), partitions)
+		for i := 0; i < 100; i++ {
+			require.NoError(t, table.Insert(ctx, sql.NewRow(int64(i))))
+		}
+
+		expected, err := sql.NodeToRows(ctx, NewResolvedTable(table, nil, nil))
+		require.NoError(t, err)
+
+		for parallelism := 1; parallelism <= 4; parallelism++ {
+			t.Run(fmt.Sprintf("partitions=%d,parallelism=%d", partitions, parallelism), func(t *testing.T) {
+				exchange := NewExchange(parallelism, NewResolvedTable(table, nil, nil)).WithPartitionOrder()
+				rows, err := sql.NodeToRows(ctx, exchange)
+				require.NoError(t, err)
+				require.Equal(t, expected, rows)
+			})
+		}
+	}
+}
+
 func TestExchangeCancelled(t *testing.T) {
 	children := NewProject(
 		[]sql.Expression{
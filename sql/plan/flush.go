@@ -0,0 +1,100 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// Flush represents a FLUSH statement. Each of its options is passed to its Handler, which flushes the engine's state for
+// it and notifies the integrator. FLUSH TABLES WITH READ LOCK, which tools like mysqldump issue before reading a
+// consistent snapshot, doesn't lock anything: the snapshot is already provided by the transaction they start
+// afterwards, and the lock is released by UNLOCK TABLES as usual.
+type Flush struct {
+	// Local is true for FLUSH LOCAL and FLUSH NO_WRITE_TO_BINLOG.
+	Local   bool
+	Options []sql.FlushOption
+	// Handler flushes the options of the statement. It's assigned by the analyzer, and the statement does nothing
+	// without one.
+	Handler sql.FlushHandler
+}
+
+var _ sql.Node = (*Flush)(nil)
+
+// NewFlush returns a new Flush node with the options given.
+func NewFlush(local bool, options ...sql.FlushOption) *Flush {
+	return &Flush{Local: local, Options: options}
+}
+
+// Resolved implements the sql.Node interface.
+func (f *Flush) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (f *Flush) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (f *Flush) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(f, len(children), 0)
+	}
+	return f, nil
+}
+
+// Schema implements the sql.Node interface.
+func (f *Flush) Schema() sql.Schema {
+	return sql.OkResultSchema
+}
+
+// RowIter implements the sql.Node interface.
+func (f *Flush) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if f.Handler != nil {
+		for _, option := range f.Options {
+			if err := f.Handler.Flush(ctx, option); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+func (f *Flush) String() string {
+	options := make([]string, len(f.Options))
+	for i, option := range f.Options {
+		if len(option.Tables) == 0 {
+			options[i] = option.Name
+			continue
+		}
+		tables := make([]string, len(option.Tables))
+		for j, table := range option.Tables {
+			tables[j] = table.Name
+			if table.Database != "" {
+				tables[j] = table.Database + "." + table.Name
+			}
+		}
+		// The modifier of FLUSH TABLES follows its list of tables
+		name := strings.TrimPrefix(option.Name, "TABLES")
+		options[i] = "TABLES " + strings.Join(tables, ", ") + name
+	}
+	if f.Local {
+		return "FLUSH LOCAL " + strings.Join(options, ", ")
+	}
+	return "FLUSH " + strings.Join(options, ", ")
+}
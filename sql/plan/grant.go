@@ -19,6 +19,7 @@ import (
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/grant_tables"
 )
 
 // Grant represents the statement GRANT [privilege...] ON [item] TO [user...].
@@ -29,6 +30,7 @@ type Grant struct {
 	Users           []UserName
 	WithGrantOption bool
 	As              *GrantUserAssumption
+	GrantTables     sql.Database
 }
 
 // NewGrant returns a new Grant node.
@@ -40,10 +42,12 @@ func NewGrant(privileges []Privilege, objType ObjectType, level PrivilegeLevel,
 		Users:           users,
 		WithGrantOption: withGrant,
 		As:              as,
+		GrantTables:     sql.UnresolvedDatabase("mysql"),
 	}
 }
 
 var _ sql.Node = (*Grant)(nil)
+var _ sql.Databaser = (*Grant)(nil)
 
 // Schema implements the interface sql.Node.
 func (n *Grant) Schema() sql.Schema {
@@ -64,9 +68,22 @@ func (n *Grant) String() string {
 		strings.Join(privileges, ", "), n.PrivilegeLevel.String(), strings.Join(users, ", "))
 }
 
+// Database implements the interface sql.Databaser.
+func (n *Grant) Database() sql.Database {
+	return n.GrantTables
+}
+
+// WithDatabase implements the interface sql.Databaser.
+func (n *Grant) WithDatabase(db sql.Database) (sql.Node, error) {
+	nn := *n
+	nn.GrantTables = db
+	return &nn, nil
+}
+
 // Resolved implements the interface sql.Node.
 func (n *Grant) Resolved() bool {
-	return true
+	_, ok := n.GrantTables.(sql.UnresolvedDatabase)
+	return !ok
 }
 
 // Children implements the interface sql.Node.
@@ -84,7 +101,47 @@ func (n *Grant) WithChildren(children ...sql.Node) (sql.Node, error) {
 
 // RowIter implements the interface sql.Node.
 func (n *Grant) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	return nil, fmt.Errorf("not yet implemented")
+	grantTables, ok := n.GrantTables.(*grant_tables.GrantTables)
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New("mysql")
+	}
+	if n.ObjectType == ObjectType_Function || n.ObjectType == ObjectType_Procedure {
+		return nil, sql.ErrUnsupportedFeature.New("GRANT on routines")
+	}
+	if n.As != nil {
+		return nil, sql.ErrUnsupportedFeature.New("GRANT ... AS")
+	}
+	level, err := n.PrivilegeLevel.GrantTablesLevel(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every user is checked before any is granted privileges, so that the statement either applies to all of them or none
+	userTableData := grantTables.UserTable().Data()
+	for _, user := range n.Users {
+		if len(userTableData.Get(grant_tables.UserPrimaryKey{Host: user.Host, User: user.Name})) == 0 {
+			return nil, sql.ErrGrantUserNotFound.New()
+		}
+	}
+
+	for _, user := range n.Users {
+		for _, privilege := range n.Privileges {
+			err = grantTables.Grant(user.Name, user.Host, level, privilege.GrantTablesPrivileges(level), privilege.Columns)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if n.WithGrantOption {
+			err = grantTables.Grant(user.Name, user.Host, level, []grant_tables.PrivilegeType{grant_tables.PrivilegeType_Grant}, nil)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err = grantTables.Persist(ctx); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{sql.NewOkResult(0)}), nil
 }
 
 // GrantRole represents the statement GRANT [role...] TO [user...].
@@ -17,6 +17,9 @@ package plan
 import (
 	"fmt"
 	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/grant_tables"
 )
 
 // Privilege specifies a privilege to be used in a GRANT or REVOKE statement.
@@ -114,3 +117,34 @@ func (p *PrivilegeLevel) String() string {
 		return fmt.Sprintf("%s.%s", p.Database, p.TableRoutine)
 	}
 }
+
+// GrantTablesLevel returns the level of the grant tables that this PrivilegeLevel refers to. A level without a database
+// refers to the current database.
+func (p *PrivilegeLevel) GrantTablesLevel(ctx *sql.Context) (grant_tables.PrivilegeLevel, error) {
+	db := p.Database
+	if db == "" {
+		db = ctx.GetCurrentDatabase()
+		if db == "" {
+			return grant_tables.PrivilegeLevel{}, sql.ErrNoDatabaseSelected.New()
+		}
+	}
+	return grant_tables.PrivilegeLevel{Database: db, Table: p.TableRoutine}, nil
+}
+
+// GrantTablesPrivileges returns the privileges of the grant tables that the Privilege refers to on the level given.
+func (p *Privilege) GrantTablesPrivileges(level grant_tables.PrivilegeLevel) []grant_tables.PrivilegeType {
+	switch p.Type {
+	case PrivilegeType_All:
+		return grant_tables.AllPrivileges(level)
+	case PrivilegeType_Insert:
+		return []grant_tables.PrivilegeType{grant_tables.PrivilegeType_Insert}
+	case PrivilegeType_References:
+		return []grant_tables.PrivilegeType{grant_tables.PrivilegeType_References}
+	case PrivilegeType_Select:
+		return []grant_tables.PrivilegeType{grant_tables.PrivilegeType_Select}
+	case PrivilegeType_Update:
+		return []grant_tables.PrivilegeType{grant_tables.PrivilegeType_Update}
+	default:
+		return nil
+	}
+}
@@ -19,7 +19,6 @@ import (
 	"io"
 	"strings"
 
-	"github.com/cespare/xxhash"
 	opentracing "github.com/opentracing/opentracing-go"
 	errors "gopkg.in/src-d/go-errors.v1"
 
@@ -36,6 +35,10 @@ type GroupBy struct {
 	UnaryNode
 	SelectedExprs []sql.Expression
 	GroupByExprs  []sql.Expression
+	// Sorted is whether the rows of the child are sorted by the grouping expressions, so that the rows of each group
+	// are next to each other. The groups are then aggregated one at a time, as the rows are read, instead of holding
+	// all of them until the last row.
+	Sorted bool
 }
 
 // NewGroupBy creates a new GroupBy node. Like Project, GroupBy is a top-level node, and contains all the fields that
@@ -50,6 +53,13 @@ func NewGroupBy(selectedExprs, groupByExprs []sql.Expression, child sql.Node) *G
 	}
 }
 
+// WithSorted returns a copy of this GroupBy that aggregates the groups of its child one at a time, because its rows
+// are sorted by the grouping expressions.
+func (g GroupBy) WithSorted() *GroupBy {
+	g.Sorted = true
+	return &g
+}
+
 // Resolved implements the Resolvable interface.
 func (g *GroupBy) Resolved() bool {
 	return g.UnaryNode.Child.Resolved() &&
@@ -100,6 +110,8 @@ func (g *GroupBy) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	var iter sql.RowIter
 	if len(g.GroupByExprs) == 0 {
 		iter = newGroupByIter(g.SelectedExprs, i)
+	} else if g.Sorted {
+		iter = newGroupBySortedIter(g.SelectedExprs, g.GroupByExprs, i)
 	} else {
 		iter = newGroupByGroupingIter(ctx, g.SelectedExprs, g.GroupByExprs, i)
 	}
@@ -113,7 +125,9 @@ func (g *GroupBy) WithChildren(children ...sql.Node) (sql.Node, error) {
 		return nil, sql.ErrInvalidChildrenNumber.New(g, len(children), 1)
 	}
 
-	return NewGroupBy(g.SelectedExprs, g.GroupByExprs, children[0]), nil
+	ng := *g
+	ng.UnaryNode = UnaryNode{Child: children[0]}
+	return &ng, nil
 }
 
 // WithExpressions implements the Node interface.
@@ -129,12 +143,15 @@ func (g *GroupBy) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
 	grouping := make([]sql.Expression, len(g.GroupByExprs))
 	copy(grouping, exprs[len(g.SelectedExprs):])
 
-	return NewGroupBy(agg, grouping, g.Child), nil
+	ng := *g
+	ng.SelectedExprs = agg
+	ng.GroupByExprs = grouping
+	return &ng, nil
 }
 
 func (g *GroupBy) String() string {
 	pr := sql.NewTreePrinter()
-	_ = pr.WriteNode("GroupBy")
+	_ = pr.WriteNode(g.nodeName())
 
 	var selectedExprs = make([]string, len(g.SelectedExprs))
 	for i, e := range g.SelectedExprs {
@@ -156,7 +173,7 @@ func (g *GroupBy) String() string {
 
 func (g *GroupBy) DebugString() string {
 	pr := sql.NewTreePrinter()
-	_ = pr.WriteNode("GroupBy")
+	_ = pr.WriteNode(g.nodeName())
 
 	var selectedExprs = make([]string, len(g.SelectedExprs))
 	for i, e := range g.SelectedExprs {
@@ -176,6 +193,14 @@ func (g *GroupBy) DebugString() string {
 	return pr.String()
 }
 
+// nodeName returns the name of the node, for its String and DebugString.
+func (g *GroupBy) nodeName() string {
+	if g.Sorted {
+		return "GroupBy(sorted)"
+	}
+	return "GroupBy"
+}
+
 // Expressions implements the Expressioner interface.
 func (g *GroupBy) Expressions() []sql.Expression {
 	var exprs []sql.Expression
@@ -247,11 +272,13 @@ func (i *groupByIter) Dispose() {
 type groupByGroupingIter struct {
 	selectedExprs []sql.Expression
 	groupByExprs  []sql.Expression
-	aggregations  sql.KeyValueCache
-	keys          []uint64
-	pos           int
-	child         sql.RowIter
-	dispose       sql.DisposeFunc
+	// aggregations are the groups by the hash of their grouping key. A group whose hash is taken by a group with a
+	// different key is stored under the next hash that isn't.
+	aggregations sql.KeyValueCache
+	keys         []uint64
+	pos          int
+	child        sql.RowIter
+	dispose      sql.DisposeFunc
 }
 
 func newGroupByGroupingIter(
@@ -278,12 +305,12 @@ func (i *groupByGroupingIter) Next(ctx *sql.Context) (sql.Row, error) {
 		return nil, io.EOF
 	}
 
-	buffers, err := i.get(i.keys[i.pos])
+	g, err := i.get(i.keys[i.pos])
 	if err != nil {
 		return nil, err
 	}
 	i.pos++
-	return evalBuffers(ctx, buffers)
+	return evalBuffers(ctx, g.buffers)
 }
 
 func (i *groupByGroupingIter) compute(ctx *sql.Context) error {
@@ -296,31 +323,35 @@ func (i *groupByGroupingIter) compute(ctx *sql.Context) error {
 			return err
 		}
 
-		key, err := groupingKey(ctx, i.groupByExprs, row)
+		hash, key, err := groupingKey(ctx, i.groupByExprs, row)
 		if err != nil {
 			return err
 		}
 
-		b, err := i.get(key)
+		g, err := i.get(hash)
+		for err == nil && g.key != key {
+			hash++
+			g, err = i.get(hash)
+		}
 		if sql.ErrKeyNotFound.Is(err) {
-			b = make([]sql.AggregationBuffer, len(i.selectedExprs))
+			g = &group{key: key, buffers: make([]sql.AggregationBuffer, len(i.selectedExprs))}
 			for j, a := range i.selectedExprs {
-				b[j], err = newAggregationBuffer(a)
+				g.buffers[j], err = newAggregationBuffer(a)
 				if err != nil {
 					return err
 				}
 			}
 
-			if err := i.aggregations.Put(key, b); err != nil {
+			if err := i.put(hash, g); err != nil {
 				return err
 			}
 
-			i.keys = append(i.keys, key)
+			i.keys = append(i.keys, hash)
 		} else if err != nil {
 			return err
 		}
 
-		err = updateBuffers(ctx, b, row)
+		err = updateBuffers(ctx, g.buffers, row)
 		if err != nil {
 			return err
 		}
@@ -329,18 +360,21 @@ func (i *groupByGroupingIter) compute(ctx *sql.Context) error {
 	return nil
 }
 
-func (i *groupByGroupingIter) get(key uint64) ([]sql.AggregationBuffer, error) {
+// group is the grouping key of a group, as returned by groupingKey, and its aggregation buffers.
+type group struct {
+	key     string
+	buffers []sql.AggregationBuffer
+}
+
+func (i *groupByGroupingIter) get(key uint64) (*group, error) {
 	v, err := i.aggregations.Get(key)
 	if err != nil {
 		return nil, err
 	}
-	if v == nil {
-		return nil, nil
-	}
-	return v.([]sql.AggregationBuffer), err
+	return v.(*group), err
 }
 
-func (i *groupByGroupingIter) put(key uint64, val []sql.AggregationBuffer) error {
+func (i *groupByGroupingIter) put(key uint64, val *group) error {
 	return i.aggregations.Put(key, val)
 }
 
@@ -357,33 +391,126 @@ func (i *groupByGroupingIter) Close(ctx *sql.Context) error {
 
 func (i *groupByGroupingIter) Dispose() {
 	for _, k := range i.keys {
-		bs, _ := i.get(k)
-		if bs != nil {
-			for _, b := range bs {
+		g, _ := i.get(k)
+		if g != nil {
+			for _, b := range g.buffers {
 				b.Dispose()
 			}
 		}
 	}
 }
 
+// groupBySortedIter aggregates the rows of a child sorted by the grouping expressions one group at a time, returning
+// each group when the first row of the next one is read.
+type groupBySortedIter struct {
+	selectedExprs []sql.Expression
+	groupByExprs  []sql.Expression
+	child         sql.RowIter
+	// buf are the buffers of the group being aggregated, if any
+	buf  []sql.AggregationBuffer
+	key  string
+	done bool
+}
+
+func newGroupBySortedIter(selectedExprs, groupByExprs []sql.Expression, child sql.RowIter) *groupBySortedIter {
+	return &groupBySortedIter{
+		selectedExprs: selectedExprs,
+		groupByExprs:  groupByExprs,
+		child:         child,
+	}
+}
+
+func (i *groupBySortedIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if i.done {
+		return nil, io.EOF
+	}
+
+	for {
+		row, err := i.child.Next(ctx)
+		if err == io.EOF {
+			i.done = true
+			if i.buf == nil {
+				return nil, io.EOF
+			}
+			return i.evalGroup(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		_, key, err := groupingKey(ctx, i.groupByExprs, row)
+		if err != nil {
+			return nil, err
+		}
+
+		var group sql.Row
+		if i.buf != nil && key != i.key {
+			group, err = i.evalGroup(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if i.buf == nil {
+			i.buf = make([]sql.AggregationBuffer, len(i.selectedExprs))
+			for j, a := range i.selectedExprs {
+				i.buf[j], err = newAggregationBuffer(a)
+				if err != nil {
+					return nil, err
+				}
+			}
+			i.key = key
+		}
+
+		if err := updateBuffers(ctx, i.buf, row); err != nil {
+			return nil, err
+		}
+
+		if group != nil {
+			return group, nil
+		}
+	}
+}
+
+// evalGroup returns the row of the group being aggregated, and disposes of its buffers.
+func (i *groupBySortedIter) evalGroup(ctx *sql.Context) (sql.Row, error) {
+	row, err := evalBuffers(ctx, i.buf)
+	i.Dispose()
+	i.buf = nil
+	return row, err
+}
+
+func (i *groupBySortedIter) Close(ctx *sql.Context) error {
+	i.Dispose()
+	i.buf = nil
+	return i.child.Close(ctx)
+}
+
+func (i *groupBySortedIter) Dispose() {
+	for _, b := range i.buf {
+		if b != nil {
+			b.Dispose()
+		}
+	}
+}
+
+// groupingKey returns the hash and the key, as returned by sql.HashKeyOf, of the values of the grouping expressions
+// given for the row given.
 func groupingKey(
 	ctx *sql.Context,
 	exprs []sql.Expression,
 	row sql.Row,
-) (uint64, error) {
-	hash := xxhash.New()
-	for _, expr := range exprs {
+) (uint64, string, error) {
+	vals := make(sql.Row, len(exprs))
+	for i, expr := range exprs {
 		v, err := expr.Eval(ctx, row)
 		if err != nil {
-			return 0, err
-		}
-		_, err = hash.Write(([]byte)(fmt.Sprintf("%#v,", v)))
-		if err != nil {
-			return 0, err
+			return 0, "", err
 		}
+		vals[i] = v
 	}
 
-	return hash.Sum64(), nil
+	return sql.HashKeyOf(vals)
 }
 
 func newAggregationBuffer(expr sql.Expression) (sql.AggregationBuffer, error) {
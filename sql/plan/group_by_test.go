@@ -157,6 +157,98 @@ func TestGroupByAggregationGrouping(t *testing.T) {
 	require.Equal(expected, rows)
 }
 
+func TestGroupByHashCollision(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	childSchema := sql.Schema{
+		{Name: "col1", Type: sql.LongText},
+	}
+	child := memory.NewTable("test", sql.NewPrimaryKeySchema(childSchema))
+	for _, r := range []sql.Row{sql.NewRow("a"), sql.NewRow("b"), sql.NewRow("a")} {
+		require.NoError(child.Insert(sql.NewEmptyContext(), r))
+	}
+
+	col := expression.NewGetField(0, sql.LongText, "col1", true)
+	iter, err := NewResolvedTable(child, nil, nil).RowIter(ctx, nil)
+	require.NoError(err)
+	i := newGroupByGroupingIter(ctx, []sql.Expression{aggregation.NewCount(col), col}, []sql.Expression{col}, iter)
+
+	// A group with another key takes the hash of the key of the rows with "a"
+	hash, _, err := sql.HashKeyOf(sql.NewRow("a"))
+	require.NoError(err)
+	i.aggregations, i.dispose = ctx.Memory.NewHistoryCache()
+	require.NoError(i.put(hash, &group{key: "other"}))
+	require.NoError(i.compute(ctx))
+
+	g, err := i.get(hash)
+	require.NoError(err)
+	require.Equal("other", g.key)
+	g, err = i.get(hash + 1)
+	require.NoError(err)
+	row, err := evalBuffers(ctx, g.buffers)
+	require.NoError(err)
+	require.Equal(sql.NewRow(int64(2), "a"), row)
+	require.Len(i.keys, 2)
+	require.NoError(i.Close(ctx))
+}
+
+func TestGroupBySortedRowIter(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	childSchema := sql.Schema{
+		{Name: "col1", Type: sql.LongText},
+		{Name: "col2", Type: sql.Int64, Nullable: true},
+	}
+	child := memory.NewTable("test", sql.NewPrimaryKeySchema(childSchema))
+
+	rows := []sql.Row{
+		sql.NewRow("col1_2", int64(4444)),
+		sql.NewRow("col1_1", int64(1111)),
+		sql.NewRow("col1_3", nil),
+		sql.NewRow("col1_1", int64(2222)),
+		sql.NewRow("col1_2", int64(5555)),
+	}
+
+	for _, r := range rows {
+		require.NoError(child.Insert(sql.NewEmptyContext(), r))
+	}
+
+	p := NewGroupBy(
+		[]sql.Expression{
+			expression.NewGetField(0, sql.LongText, "col1", true),
+			aggregation.NewCount(expression.NewGetField(1, sql.Int64, "col2", true)),
+			aggregation.NewMax(expression.NewGetField(1, sql.Int64, "col2", true)),
+		},
+		[]sql.Expression{
+			expression.NewGetField(0, sql.LongText, "col1", true),
+		},
+		NewSort(
+			[]sql.SortField{
+				{
+					Column: expression.NewGetField(0, sql.LongText, "col1", true),
+					Order:  sql.Descending,
+				},
+			},
+			NewResolvedTable(child, nil, nil),
+		),
+	).WithSorted()
+
+	require.Equal("GroupBy(sorted)", p.String()[:len("GroupBy(sorted)")])
+
+	rows, err := sql.NodeToRows(ctx, p)
+	require.NoError(err)
+
+	expected := []sql.Row{
+		{"col1_3", int64(0), nil},
+		{"col1_2", int64(2), int64(5555)},
+		{"col1_1", int64(2), int64(2222)},
+	}
+
+	require.Equal(expected, rows)
+}
+
 func BenchmarkGroupBy(b *testing.B) {
 	table := benchmarkTable(b)
 
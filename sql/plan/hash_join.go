@@ -0,0 +1,421 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"io"
+
+	opentracing "github.com/opentracing/opentracing-go"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+// HashJoin is a join whose condition compares columns of both sides for equality. Instead of iterating the secondary
+// side once per row of the primary side, it reads the rows of the secondary side once into a hash table keyed by the
+// compared columns, and looks up the rows matching each row of the primary side in it. The whole join condition is
+// still evaluated on every matching pair of rows. As for other joins, the secondary side is the right child, except
+// for right joins.
+//
+// If the rows of the secondary side don't fit in memory, the join is performed as a multipass join instead.
+type HashJoin struct {
+	joinStruct
+	joinType JoinType
+}
+
+var _ JoinNode = (*HashJoin)(nil)
+var _ sql.CommentedNode = (*HashJoin)(nil)
+
+// NewHashJoin creates a new hash join node of the type given from two tables. At least one of the equality
+// comparisons of the condition must be usable as a key of the hash table, as reported by HashJoinKeys.
+func NewHashJoin(joinType JoinType, left, right sql.Node, cond sql.Expression) *HashJoin {
+	return &HashJoin{
+		joinStruct: joinStruct{
+			BinaryNode: BinaryNode{
+				left:  left,
+				right: right,
+			},
+			Cond: cond,
+		},
+		joinType: joinType,
+	}
+}
+
+func (j *HashJoin) JoinType() JoinType {
+	return j.joinType
+}
+
+// Schema implements the Node interface.
+func (j *HashJoin) Schema() sql.Schema {
+	switch j.joinType {
+	case JoinTypeLeft:
+		return append(j.left.Schema(), makeNullable(j.right.Schema())...)
+	case JoinTypeRight:
+		return append(makeNullable(j.left.Schema()), j.right.Schema()...)
+	default:
+		return append(j.left.Schema(), j.right.Schema()...)
+	}
+}
+
+// Resolved implements the Resolvable interface.
+func (j *HashJoin) Resolved() bool {
+	return j.left.Resolved() && j.right.Resolved() && j.Cond.Resolved()
+}
+
+// RowIter implements the Node interface.
+func (j *HashJoin) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	primaryKeys, secondaryKeys := HashJoinKeys(j.joinType, j.Cond, j.ScopeLen, len(j.left.Schema()))
+	if len(primaryKeys) == 0 {
+		return joinRowIter(ctx, j.joinType, j.left, j.right, j.Cond, row, j.ScopeLen, j.JoinMode)
+	}
+
+	span, ctx := ctx.Span("plan.HashJoin", opentracing.Tags{
+		"type": j.joinType.String(),
+	})
+
+	primary, secondary := j.left, j.right
+	if j.joinType == JoinTypeRight {
+		primary, secondary = j.right, j.left
+	}
+
+	iter, err := primary.RowIter(ctx, row)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+
+	return sql.NewSpanIter(span, &hashJoinIter{
+		typ:               j.joinType,
+		primary:           iter,
+		secondaryProvider: secondary,
+		cond:              j.Cond,
+		primaryKeys:       primaryKeys,
+		secondaryKeys:     secondaryKeys,
+		rowSize:           len(row) + len(j.left.Schema()) + len(j.right.Schema()),
+		originalRow:       row,
+		scopeLen:          j.ScopeLen,
+	}), nil
+}
+
+// WithChildren implements the Node interface.
+func (j *HashJoin) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(children), 2)
+	}
+
+	nj := *j
+	nj.BinaryNode = BinaryNode{children[0], children[1]}
+	return &nj, nil
+}
+
+// WithExpressions implements the Expressioner interface.
+func (j *HashJoin) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(exprs), 1)
+	}
+
+	nj := *j
+	nj.Cond = exprs[0]
+	return &nj, nil
+}
+
+func (j *HashJoin) WithScopeLen(i int) JoinNode {
+	nj := *j
+	nj.ScopeLen = i
+	return &nj
+}
+
+func (j HashJoin) WithMultipassMode() JoinNode {
+	j.JoinMode = multipassMode
+	return &j
+}
+
+// WithComment implements sql.CommentedNode
+func (j *HashJoin) WithComment(comment string) sql.Node {
+	nj := *j
+	nj.CommentStr = comment
+	return &nj
+}
+
+func (j *HashJoin) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("%sHashJoin%s", j.typePrefix(), j.Cond)
+	_ = pr.WriteChildren(j.left.String(), j.right.String())
+	return pr.String()
+}
+
+func (j *HashJoin) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("%sHashJoin%s", j.typePrefix(), sql.DebugString(j.Cond))
+	_ = pr.WriteChildren(sql.DebugString(j.left), sql.DebugString(j.right))
+	return pr.String()
+}
+
+func (j *HashJoin) typePrefix() string {
+	switch j.joinType {
+	case JoinTypeLeft:
+		return "Left"
+	case JoinTypeRight:
+		return "Right"
+	default:
+		return ""
+	}
+}
+
+// HashJoinKeys returns the keys of the hash table of a hash join of the type given: for each equality comparison of
+// the condition given between a column of the primary side and a column of the secondary side, the column of the
+// primary side, to be evaluated on the rows of the join, and the column of the secondary side, to be evaluated on the
+// rows of the secondary side. The rows of the join are made of scopeLen columns of the outer scope followed by the
+// leftLen columns of the left side and the columns of the right side. Only the columns of the same integer or text
+// type are compared, as the values of other types may be equal without being identical.
+func HashJoinKeys(typ JoinType, cond sql.Expression, scopeLen, leftLen int) (primary, secondary []sql.Expression) {
+	// The columns of the secondary side are those between low and high, or those after low if high is -1
+	low, high := scopeLen+leftLen, -1
+	if typ == JoinTypeRight {
+		low, high = scopeLen, scopeLen+leftLen
+	}
+	isSecondary := func(gf *expression.GetField) bool {
+		return gf.Index() >= low && (high == -1 || gf.Index() < high)
+	}
+
+	for _, e := range splitConjunction(cond) {
+		eq, ok := e.(*expression.Equals)
+		if !ok {
+			continue
+		}
+		left, ok := eq.Left().(*expression.GetField)
+		if !ok {
+			continue
+		}
+		right, ok := eq.Right().(*expression.GetField)
+		if !ok {
+			continue
+		}
+		if !sql.TypesEqual(left.Type(), right.Type()) || !(sql.IsInteger(left.Type()) || sql.IsTextOnly(left.Type())) {
+			continue
+		}
+		if isSecondary(left) {
+			left, right = right, left
+		}
+		if isSecondary(left) || !isSecondary(right) {
+			continue
+		}
+		primary = append(primary, left)
+		secondary = append(secondary, right.WithIndex(right.Index()-low))
+	}
+	return primary, secondary
+}
+
+// splitConjunction breaks AND expressions into their left and right parts, recursively
+func splitConjunction(expr sql.Expression) []sql.Expression {
+	and, ok := expr.(*expression.And)
+	if !ok {
+		return []sql.Expression{expr}
+	}
+
+	return append(
+		splitConjunction(and.Left),
+		splitConjunction(and.Right)...,
+	)
+}
+
+// hashJoinIter is the iterator of a HashJoin. The hash table of the rows of the secondary side is built when the
+// first row is requested.
+type hashJoinIter struct {
+	typ               JoinType
+	primary           sql.RowIter
+	secondaryProvider rowIterProvider
+	cond              sql.Expression
+	primaryKeys       []sql.Expression
+	secondaryKeys     []sql.Expression
+
+	primaryRow sql.Row
+	matches    []sql.Row
+	foundMatch bool
+	rowSize    int
+
+	// scope variables from outer scope
+	originalRow sql.Row
+	scopeLen    int
+
+	lookup  map[interface{}][]sql.Row
+	dispose sql.DisposeFunc
+	// multipass is the iterator the join falls back to when the rows of the secondary side don't fit in memory
+	multipass sql.RowIter
+}
+
+func (i *hashJoinIter) Dispose() {
+	if i.dispose != nil {
+		i.dispose()
+		i.dispose = nil
+	}
+	i.lookup = nil
+}
+
+// build reads the rows of the secondary side into the hash table. It returns false if they don't fit in memory.
+func (i *hashJoinIter) build(ctx *sql.Context) (bool, error) {
+	cache, dispose := ctx.Memory.NewRowsCache()
+	i.dispose = dispose
+	i.lookup = make(map[interface{}][]sql.Row)
+
+	iter, err := i.secondaryProvider.RowIter(ctx, i.originalRow)
+	if err != nil {
+		return false, err
+	}
+
+	for {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			iter.Close(ctx)
+			return false, err
+		}
+
+		if err := cache.Add(row); err != nil {
+			iter.Close(ctx)
+			if sql.ErrNoMemoryAvailable.Is(err) {
+				return false, nil
+			}
+			return false, err
+		}
+
+		key, err := hashJoinKey(ctx, i.secondaryKeys, row)
+		if err != nil {
+			iter.Close(ctx)
+			return false, err
+		}
+		// Rows with a NULL key don't match any row
+		if key != nil {
+			i.lookup[key] = append(i.lookup[key], row)
+		}
+	}
+
+	return true, iter.Close(ctx)
+}
+
+func (i *hashJoinIter) Next(ctx *sql.Context) (sql.Row, error) {
+	if i.multipass != nil {
+		return i.multipass.Next(ctx)
+	}
+
+	if i.lookup == nil {
+		ok, err := i.build(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			i.Dispose()
+			cache, dispose := ctx.Memory.NewRowsCache()
+			i.multipass = &joinIter{
+				typ:               i.typ,
+				primary:           i.primary,
+				secondaryProvider: i.secondaryProvider,
+				cond:              i.cond,
+				mode:              multipassMode,
+				secondaryRows:     cache,
+				rowSize:           i.rowSize,
+				dispose:           dispose,
+				originalRow:       i.originalRow,
+				scopeLen:          i.scopeLen,
+			}
+			i.primary = nil
+			return i.multipass.Next(ctx)
+		}
+	}
+
+	for {
+		if i.primaryRow == nil {
+			r, err := i.primary.Next(ctx)
+			if err != nil {
+				if err == io.EOF {
+					i.Dispose()
+				}
+				return nil, err
+			}
+
+			i.primaryRow = i.originalRow.Append(r)
+			i.foundMatch = false
+
+			key, err := hashJoinKey(ctx, i.primaryKeys, i.buildRow(i.primaryRow, nil))
+			if err != nil {
+				return nil, err
+			}
+			i.matches = nil
+			if key != nil {
+				i.matches = i.lookup[key]
+			}
+		}
+
+		if len(i.matches) == 0 {
+			primary := i.primaryRow
+			i.primaryRow = nil
+			if !i.foundMatch && (i.typ == JoinTypeLeft || i.typ == JoinTypeRight) {
+				return i.buildRow(primary, nil), nil
+			}
+			continue
+		}
+
+		row := i.buildRow(i.primaryRow, i.matches[0])
+		i.matches = i.matches[1:]
+		matches, err := conditionIsTrue(ctx, row, i.cond)
+		if err != nil {
+			return nil, err
+		}
+
+		if !matches {
+			continue
+		}
+
+		i.foundMatch = true
+		return row, nil
+	}
+}
+
+// buildRow builds the resulting row using the rows from the primary and secondary sides depending on the join type.
+func (i *hashJoinIter) buildRow(primary, secondary sql.Row) sql.Row {
+	return buildJoinRow(i.typ, i.originalRow, i.scopeLen, i.rowSize, primary, secondary)
+}
+
+func (i *hashJoinIter) Close(ctx *sql.Context) error {
+	i.Dispose()
+	if i.multipass != nil {
+		return i.multipass.Close(ctx)
+	}
+	if i.primary != nil {
+		return i.primary.Close(ctx)
+	}
+	return nil
+}
+
+// hashJoinKey returns the key of the hash table for the row given, or nil if any of the values of the key is NULL.
+func hashJoinKey(ctx *sql.Context, keys []sql.Expression, row sql.Row) (interface{}, error) {
+	values := make([]interface{}, len(keys))
+	for i, k := range keys {
+		v, err := k.Eval(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			return nil, nil
+		}
+		// Values of the same type may have different representations, such as an int32 in an INT64 column
+		if values[i], err = k.Type().Convert(v); err != nil {
+			return nil, err
+		}
+	}
+	return hashKey(values)
+}
@@ -0,0 +1,135 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestHashJoin(t *testing.T) {
+	testHashJoin(t, sql.NewEmptyContext())
+}
+
+func TestMultiPassHashJoin(t *testing.T) {
+	// The rows of the secondary side don't fit in memory, so the join falls back to a multipass join
+	ctx := sql.NewContext(context.TODO(), sql.WithMemoryManager(
+		sql.NewMemoryManager(mockReporter{2, 1}),
+	))
+	testHashJoin(t, ctx)
+}
+
+func testHashJoin(t *testing.T, ctx *sql.Context) {
+	t.Helper()
+
+	ltable := memory.NewTable("left", lSchema)
+	rtable := memory.NewTable("right", sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "rcol1", Type: sql.Text, Nullable: true},
+		{Name: "rcol2", Type: sql.Text},
+		{Name: "rcol3", Type: sql.Int32},
+		{Name: "rcol4", Type: sql.Int64},
+	}))
+	insertData(t, ltable)
+	insertData(t, rtable)
+	require.NoError(t, rtable.Insert(sql.NewEmptyContext(), sql.NewRow(nil, "col2_3", int32(5), int64(6))))
+
+	cond := expression.NewAnd(
+		expression.NewEquals(
+			expression.NewGetField(0, sql.Text, "lcol1", false),
+			expression.NewGetField(4, sql.Text, "rcol1", true),
+		),
+		expression.NewEquals(
+			expression.NewGetField(2, sql.Int32, "lcol3", false),
+			expression.NewLiteral(int32(3), sql.Int32),
+		),
+	)
+
+	testCases := []struct {
+		typ      JoinType
+		expected []sql.Row
+	}{
+		{
+			JoinTypeInner,
+			[]sql.Row{
+				{"col1_2", "col2_2", int32(3), int64(4), "col1_2", "col2_2", int32(3), int64(4)},
+			},
+		},
+		{
+			JoinTypeLeft,
+			[]sql.Row{
+				{"col1_1", "col2_1", int32(1), int64(2), nil, nil, nil, nil},
+				{"col1_2", "col2_2", int32(3), int64(4), "col1_2", "col2_2", int32(3), int64(4)},
+			},
+		},
+		{
+			JoinTypeRight,
+			[]sql.Row{
+				{nil, nil, nil, nil, "col1_1", "col2_1", int32(1), int64(2)},
+				{"col1_2", "col2_2", int32(3), int64(4), "col1_2", "col2_2", int32(3), int64(4)},
+				{nil, nil, nil, nil, nil, "col2_3", int32(5), int64(6)},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.typ.String(), func(t *testing.T) {
+			require := require.New(t)
+			j := NewHashJoin(tt.typ, NewResolvedTable(ltable, nil, nil), NewResolvedTable(rtable, nil, nil), cond)
+
+			iter, err := j.RowIter(ctx, nil)
+			require.NoError(err)
+			rows, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(err)
+			require.Equal(tt.expected, rows)
+		})
+	}
+}
+
+func TestHashJoinKeys(t *testing.T) {
+	require := require.New(t)
+
+	scope := expression.NewGetField(0, sql.Int64, "scope", false)
+	l := expression.NewGetField(1, sql.Int64, "l", false)
+	lText := expression.NewGetField(2, sql.Text, "ltext", false)
+	r := expression.NewGetField(3, sql.Int64, "r", false)
+	rInt32 := expression.NewGetField(4, sql.Int32, "r32", false)
+	rText := expression.NewGetField(5, sql.Text, "rtext", false)
+
+	cond := expression.JoinAnd(
+		expression.NewEquals(r, l),
+		expression.NewEquals(lText, rText),
+		expression.NewEquals(l, rInt32),
+		expression.NewEquals(scope, r),
+		expression.NewGreaterThan(l, r),
+	)
+
+	primary, secondary := HashJoinKeys(JoinTypeInner, cond, 1, 2)
+	require.Equal([]sql.Expression{l, lText, scope}, primary)
+	require.Equal([]sql.Expression{r.WithIndex(0), rText.WithIndex(2), r.WithIndex(0)}, secondary)
+
+	// The left side is the secondary side of right joins
+	primary, secondary = HashJoinKeys(JoinTypeRight, cond, 1, 2)
+	require.Equal([]sql.Expression{r, rText}, primary)
+	require.Equal([]sql.Expression{l.WithIndex(0), lText.WithIndex(1)}, secondary)
+
+	primary, _ = HashJoinKeys(JoinTypeInner, expression.NewEquals(l, lText), 1, 2)
+	require.Empty(primary)
+}
@@ -104,32 +104,38 @@ func (n *HashLookup) RowIter(ctx *sql.Context, r sql.Row) (sql.RowIter, error) {
 	return n.UnaryNode.Child.RowIter(ctx, r)
 }
 
-// Convert a tuple expression returning []interface{} into something comparable.
-// Fast paths a few smaller slices into fixed size arrays, puts everything else
-// through string serialization and a hash for now. It is OK to hash lossy here
-// as the join condition is still evaluated after the matching rows are returned.
+// Convert a tuple expression returning []interface{} into something comparable,
+// as hashKey does. It is OK to hash lossy here as the join condition is still
+// evaluated after the matching rows are returned.
 func (n *HashLookup) getHashKey(ctx *sql.Context, e sql.Expression, row sql.Row) (interface{}, error) {
 	key, err := e.Eval(ctx, row)
 	if err != nil {
 		return nil, err
 	}
 	if s, ok := key.([]interface{}); ok {
-		switch len(s) {
-		case 0:
-			return [0]interface{}{}, nil
-		case 1:
-			return [1]interface{}{s[0]}, nil
-		case 2:
-			return [2]interface{}{s[0], s[1]}, nil
-		case 3:
-			return [3]interface{}{s[0], s[1], s[2]}, nil
-		case 4:
-			return [4]interface{}{s[0], s[1], s[2], s[3]}, nil
-		case 5:
-			return [5]interface{}{s[0], s[1], s[2], s[3], s[4]}, nil
-		default:
-			return sql.HashOf(s)
-		}
+		return hashKey(s)
 	}
 	return key, nil
 }
+
+// hashKey converts the values of a tuple into something comparable. It fast
+// paths a few smaller slices into fixed size arrays, puts everything else
+// through string serialization and a hash for now.
+func hashKey(s []interface{}) (interface{}, error) {
+	switch len(s) {
+	case 0:
+		return [0]interface{}{}, nil
+	case 1:
+		return [1]interface{}{s[0]}, nil
+	case 2:
+		return [2]interface{}{s[0], s[1]}, nil
+	case 3:
+		return [3]interface{}{s[0], s[1], s[2]}, nil
+	case 4:
+		return [4]interface{}{s[0], s[1], s[2], s[3]}, nil
+	case 5:
+		return [5]interface{}{s[0], s[1], s[2], s[3], s[4]}, nil
+	default:
+		return sql.HashOf(s)
+	}
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
+const lookupJoinBatchSizeSessionVar = "lookup_join_batch_size"
+
 // An IndexedJoin is a join that uses index lookups for the secondary table.
 type IndexedJoin struct {
 	// The primary and secondary table nodes. The normal meanings of Left and
@@ -126,10 +128,27 @@ func indexedJoinRowIter(
 		span.Finish()
 		return nil, err
 	}
+
+	var batchSize int
+	secondaryAccess := batchedSecondaryAccess(right)
+	if secondaryAccess != nil {
+		val, err := ctx.GetSessionVariable(ctx, lookupJoinBatchSizeSessionVar)
+		if err == nil {
+			if size, ok := val.(int64); ok {
+				batchSize = int(size)
+			}
+		}
+		if batchSize <= 1 {
+			secondaryAccess = nil
+		}
+	}
+
 	return sql.NewSpanIter(span, &indexedJoinIter{
 		parentRow:         parentRow,
 		primary:           l,
 		secondaryProvider: right,
+		secondaryAccess:   secondaryAccess,
+		batchSize:         batchSize,
 		cond:              cond,
 		joinType:          joinType,
 		rowSize:           len(parentRow) + len(left.Schema()) + len(right.Schema()),
@@ -137,8 +156,25 @@ func indexedJoinRowIter(
 	}), nil
 }
 
+// batchedSecondaryAccess returns the IndexedTableAccess for the secondary node of an indexed join if its lookups can be
+// batched, or nil otherwise.
+func batchedSecondaryAccess(node sql.Node) *IndexedTableAccess {
+	switch n := node.(type) {
+	case *TableAlias:
+		return batchedSecondaryAccess(n.Child)
+	case *DecoratedNode:
+		return batchedSecondaryAccess(n.Child)
+	case *IndexedTableAccess:
+		if n.canBatchLookups() {
+			return n
+		}
+	}
+	return nil
+}
+
 // indexedJoinIter is an iterator that iterates over every row in the primary table and performs an index lookup in
-// the secondary table for each value
+// the secondary table for each value. When the secondary table supports it, the lookups for up to batchSize primary
+// rows are performed together.
 type indexedJoinIter struct {
 	parentRow         sql.Row
 	primary           sql.RowIter
@@ -148,6 +184,12 @@ type indexedJoinIter struct {
 	cond              sql.Expression
 	joinType          JoinType
 
+	secondaryAccess *IndexedTableAccess
+	batchSize       int
+	batchedRows     []sql.Row
+	batchedIters    []sql.RowIter
+	primaryDone     bool
+
 	foundMatch bool
 	rowSize    int
 	scopeLen   int
@@ -155,6 +197,10 @@ type indexedJoinIter struct {
 
 func (i *indexedJoinIter) loadPrimary(ctx *sql.Context) error {
 	if i.primaryRow == nil {
+		if i.secondaryAccess != nil {
+			return i.loadPrimaryBatch(ctx)
+		}
+
 		r, err := i.primary.Next(ctx)
 		if err != nil {
 			return err
@@ -167,6 +213,43 @@ func (i *indexedJoinIter) loadPrimary(ctx *sql.Context) error {
 	return nil
 }
 
+// loadPrimaryBatch loads the next primary row and its secondary rows from the current batch, performing the lookups
+// for the next batch of primary rows if the current one is exhausted.
+func (i *indexedJoinIter) loadPrimaryBatch(ctx *sql.Context) error {
+	if len(i.batchedRows) == 0 {
+		if i.primaryDone {
+			return io.EOF
+		}
+
+		rows := make([]sql.Row, 0, i.batchSize)
+		for len(rows) < i.batchSize {
+			r, err := i.primary.Next(ctx)
+			if err == io.EOF {
+				i.primaryDone = true
+				break
+			}
+			if err != nil {
+				return err
+			}
+			rows = append(rows, i.parentRow.Append(r))
+		}
+		if len(rows) == 0 {
+			return io.EOF
+		}
+
+		iters, err := i.secondaryAccess.batchedRowIters(ctx, rows)
+		if err != nil {
+			return err
+		}
+		i.batchedRows, i.batchedIters = rows, iters
+	}
+
+	i.primaryRow, i.batchedRows = i.batchedRows[0], i.batchedRows[1:]
+	i.secondary, i.batchedIters = i.batchedIters[0], i.batchedIters[1:]
+	i.foundMatch = false
+	return nil
+}
+
 func (i *indexedJoinIter) loadSecondary(ctx *sql.Context) (sql.Row, error) {
 	if i.secondary == nil {
 		rowIter, err := i.secondaryProvider.RowIter(ctx, i.primaryRow)
@@ -255,6 +338,8 @@ func (i *indexedJoinIter) buildRow(primary, secondary sql.Row) sql.Row {
 }
 
 func (i *indexedJoinIter) Close(ctx *sql.Context) (err error) {
+	defer i.closeBatchedIters(ctx)
+
 	if i.primary != nil {
 		if err = i.primary.Close(ctx); err != nil {
 			if i.secondary != nil {
@@ -271,3 +356,12 @@ func (i *indexedJoinIter) Close(ctx *sql.Context) (err error) {
 
 	return err
 }
+
+// closeBatchedIters closes the secondary iterators for any primary rows remaining in the current batch.
+func (i *indexedJoinIter) closeBatchedIters(ctx *sql.Context) {
+	for _, iter := range i.batchedIters {
+		_ = iter.Close(ctx)
+	}
+	i.batchedIters = nil
+	i.batchedRows = nil
+}
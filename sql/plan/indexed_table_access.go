@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"strings"
 
+	opentracing "github.com/opentracing/opentracing-go"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -66,18 +67,58 @@ func (i *IndexedTableAccess) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter
 		return nil, ErrNoIndexableTable.New(i.ResolvedTable)
 	}
 
+	span, ctx := ctx.Span("plan.IndexedTableAccess", opentracing.Tag{Key: "table", Value: i.Name()})
+
 	lookup, err := i.getLookup(ctx, row)
 	if err != nil {
+		span.Finish()
 		return nil, err
 	}
 
 	indexedTable := resolvedTable.WithIndexLookup(lookup)
 	partIter, err := indexedTable.Partitions(ctx)
 	if err != nil {
+		span.Finish()
 		return nil, err
 	}
 
-	return sql.NewTableRowIter(ctx, indexedTable, partIter), nil
+	ctx.StatusVariables().Increment(ctx, "Handler_read_key", 1)
+	iter := newStatusCountingIter(sql.NewTableRowIter(ctx, indexedTable, partIter), "Handler_read_next")
+	return sql.NewSpanIter(span, iter), nil
+}
+
+// canBatchLookups returns whether the underlying table can return the rows for many index lookups at once.
+func (i *IndexedTableAccess) canBatchLookups() bool {
+	_, ok := i.ResolvedTable.Table.(sql.BatchedIndexAddressableTable)
+	return ok
+}
+
+// batchedRowIters returns a RowIter for each of the rows given, returning the same rows as RowIter would for each, with
+// a single call to the underlying sql.BatchedIndexAddressableTable.
+func (i *IndexedTableAccess) batchedRowIters(ctx *sql.Context, rows []sql.Row) ([]sql.RowIter, error) {
+	table, ok := i.ResolvedTable.Table.(sql.BatchedIndexAddressableTable)
+	if !ok {
+		return nil, ErrNoIndexableTable.New(i.ResolvedTable)
+	}
+
+	lookups := make([]sql.IndexLookup, len(rows))
+	for j, row := range rows {
+		var err error
+		lookups[j], err = i.getLookup(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	iters, err := table.IndexLookupRowIters(ctx, lookups)
+	if err != nil {
+		return nil, err
+	}
+	ctx.StatusVariables().Increment(ctx, "Handler_read_key", int64(len(lookups)))
+	for j := range iters {
+		iters[j] = newStatusCountingIter(iters[j], "Handler_read_next")
+	}
+	return iters, nil
 }
 
 func (i *IndexedTableAccess) CanBuildIndex(ctx *sql.Context) (bool, error) {
@@ -133,6 +174,11 @@ func (i *IndexedTableAccess) getLookup(ctx *sql.Context, row sql.Row) (sql.Index
 	return lookup, nil
 }
 
+// Index returns the index used by this node.
+func (i *IndexedTableAccess) Index() sql.Index {
+	return i.index
+}
+
 func (i *IndexedTableAccess) String() string {
 	return fmt.Sprintf("IndexedTableAccess(%s on %s)", i.Name(), formatIndexDecoratorString(i.index))
 }
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"gopkg.in/src-d/go-errors.v1"
 
@@ -291,9 +292,9 @@ func newInsertIter(
 	}
 
 	if replacer != nil {
-		return NewTableEditorIter(replacer, insertIter), nil
+		return NewTableEditorIter(ctx, replacer, insertIter), nil
 	} else {
-		return NewTableEditorIter(inserter, insertIter), nil
+		return NewTableEditorIter(ctx, inserter, insertIter), nil
 	}
 }
 
@@ -441,7 +442,12 @@ func (i *insertIter) handleOnDuplicateKeyUpdate(ctx *sql.Context, row, rowToUpda
 		newRow = val.(sql.Row)
 	}
 
-	err = i.updater.Update(ctx, rowToUpdate, newRow)
+	err = applyOnUpdateExpressions(ctx, i.schema, i.updateExprs, rowToUpdate, newRow, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	err = updateRow(ctx, i.updater, i.schema, rowToUpdate, newRow)
 	if err != nil {
 		return nil, err
 	}
@@ -560,8 +566,11 @@ func (i *insertIter) ignoreOrClose(ctx *sql.Context, row sql.Row, err error) (sq
 // cc. https://dev.mysql.com/doc/refman/8.0/en/sql-mode.html#sql-mode-strict
 func (i *insertIter) convertDataAndWarn(ctx *sql.Context, row sql.Row, columnIdx int, err error) (sql.Row, error) {
 	if sql.ErrLengthBeyondLimit.Is(err) {
-		maxLength := i.schema[columnIdx].Type.(sql.StringType).MaxCharacterLength()
-		row[columnIdx] = row[columnIdx].(string)[:maxLength] // truncate string
+		val, convErr := sql.LongText.Convert(row[columnIdx])
+		if convErr != nil {
+			return nil, convErr
+		}
+		row[columnIdx] = sql.TruncateString(i.schema[columnIdx].Type.(sql.StringType), val.(string))
 	} else {
 		row[columnIdx] = i.schema[columnIdx].Type.Zero()
 	}
@@ -686,7 +695,20 @@ func (ii InsertInto) DebugString() string {
 }
 
 func (i *insertIter) validateNullability(ctx *sql.Context, dstSchema sql.Schema, row sql.Row) error {
+	explicitDefaults, err := ctx.GetSessionVariable(ctx, "explicit_defaults_for_timestamp")
+	if err != nil {
+		return err
+	}
+
 	for count, col := range dstSchema {
+		// With explicit_defaults_for_timestamp disabled, inserting NULL into a TIMESTAMP column that isn't nullable
+		// sets it to the current timestamp
+		if !col.Nullable && row[count] == nil && col.Type == sql.Timestamp && explicitDefaults.(int8) == 0 {
+			row[count], err = sql.Timestamp.Convert(ctx.QueryTime().Truncate(time.Second))
+			if err != nil {
+				return err
+			}
+		}
 		if !col.Nullable && row[count] == nil {
 			// In the case of an IGNORE we set the nil value to a default and add a warning
 			if i.ignore {
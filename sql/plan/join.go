@@ -659,15 +659,22 @@ func (i *joinIter) Next(ctx *sql.Context) (sql.Row, error) {
 // buildRow builds the resulting row using the rows from the primary and
 // secondary branches depending on the join type.
 func (i *joinIter) buildRow(primary, secondary sql.Row) sql.Row {
-	toCut := len(i.originalRow) - i.scopeLen
-	row := make(sql.Row, i.rowSize-toCut)
+	return buildJoinRow(i.typ, i.originalRow, i.scopeLen, i.rowSize, primary, secondary)
+}
+
+// buildJoinRow builds a row of size rowSize of a join of the type given from the primary row, which is prefixed with
+// the original row given to the join, and the secondary row. The row is made of the first scopeLen columns of the
+// original row, followed by the left and right rows.
+func buildJoinRow(typ JoinType, originalRow sql.Row, scopeLen, rowSize int, primary, secondary sql.Row) sql.Row {
+	toCut := len(originalRow) - scopeLen
+	row := make(sql.Row, rowSize-toCut)
 
-	scope := primary[:i.scopeLen]
-	primary = primary[len(i.originalRow):]
+	scope := primary[:scopeLen]
+	primary = primary[len(originalRow):]
 
 	var first, second sql.Row
 	var secondOffset int
-	switch i.typ {
+	switch typ {
 	case JoinTypeRight:
 		first = secondary
 		second = primary
@@ -675,11 +682,11 @@ func (i *joinIter) buildRow(primary, secondary sql.Row) sql.Row {
 	default:
 		first = primary
 		second = secondary
-		secondOffset = i.scopeLen + len(first)
+		secondOffset = scopeLen + len(first)
 	}
 
 	copy(row, scope)
-	copy(row[i.scopeLen:], first)
+	copy(row[scopeLen:], first)
 	copy(row[secondOffset:], second)
 
 	return row
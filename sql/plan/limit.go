@@ -17,10 +17,13 @@ package plan
 import (
 	"fmt"
 	"io"
+	"math"
 
+	"github.com/dolthub/vitess/go/vt/sqlparser"
 	opentracing "github.com/opentracing/opentracing-go"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 // Limit is a node that only allows up to N rows to be retrieved.
@@ -67,8 +70,9 @@ func (l Limit) WithCalcFoundRows(v bool) *Limit {
 func (l *Limit) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	span, ctx := ctx.Span("plan.Limit", opentracing.Tag{Key: "limit", Value: l.Limit})
 
-	limit, err := getInt64Value(ctx, l.Limit)
+	limit, err := getInt64Value(ctx, l.Limit, "limit")
 	if err != nil {
+		span.Finish()
 		return nil, err
 	}
 
@@ -84,39 +88,77 @@ func (l *Limit) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	}), nil
 }
 
-// getInt64Value returns the int64 literal value in the expression given, or an error with the errStr given if it
-// cannot.
-func getInt64Value(ctx *sql.Context, expr sql.Expression) (int64, error) {
+// getInt64Value returns the value of the LIMIT or OFFSET expression given as an int64. The expression is evaluated
+// when the node is executed, as it may be a parameter bound after the statement was analyzed, so its value is
+// validated here as well: it must be a non-negative integer.
+func getInt64Value(ctx *sql.Context, expr sql.Expression, clause string) (int64, error) {
 	i, err := expr.Eval(ctx, nil)
 	if err != nil {
 		return 0, err
 	}
 
+	var i64 int64
 	switch i := i.(type) {
 	case int:
-		return int64(i), nil
+		i64 = int64(i)
 	case int8:
-		return int64(i), nil
+		i64 = int64(i)
 	case int16:
-		return int64(i), nil
+		i64 = int64(i)
 	case int32:
-		return int64(i), nil
+		i64 = int64(i)
 	case int64:
-		return i, nil
+		i64 = i
 	case uint:
-		return int64(i), nil
+		i64 = clampUint64(uint64(i))
 	case uint8:
-		return int64(i), nil
+		i64 = int64(i)
 	case uint16:
-		return int64(i), nil
+		i64 = int64(i)
 	case uint32:
-		return int64(i), nil
+		i64 = int64(i)
 	case uint64:
-		return int64(i), nil
+		i64 = clampUint64(i)
 	default:
-		// analyzer should catch this already
-		panic(fmt.Sprintf("Unsupported type for limit %T", i))
+		return 0, sql.ErrInvalidType.New(fmt.Sprintf("%s value %v", clause, i))
+	}
+
+	if i64 < 0 {
+		return 0, sql.ErrInvalidSyntax.New("negative " + clause)
+	}
+	return i64, nil
+}
+
+// getTopNLimit returns the value of the limit of a TopN node as an int64. The limit of a TopN below an OFFSET is the
+// sum of the LIMIT and OFFSET, which are evaluated on their own, so that their sum is clamped rather than overflowing
+// for a LIMIT like 18446744073709551615.
+func getTopNLimit(ctx *sql.Context, expr sql.Expression) (int64, error) {
+	plus, ok := expr.(*expression.Arithmetic)
+	if !ok || plus.Op != sqlparser.PlusStr {
+		return getInt64Value(ctx, expr, "limit")
+	}
+
+	limit, err := getInt64Value(ctx, plus.Left, "limit")
+	if err != nil {
+		return 0, err
+	}
+	offset, err := getInt64Value(ctx, plus.Right, "offset")
+	if err != nil {
+		return 0, err
+	}
+	if limit > math.MaxInt64-offset {
+		return math.MaxInt64, nil
+	}
+	return limit + offset, nil
+}
+
+// clampUint64 returns the uint64 given as an int64, or math.MaxInt64 if it's larger, as for LIMIT
+// 18446744073709551615.
+func clampUint64(i uint64) int64 {
+	if i > math.MaxInt64 {
+		return math.MaxInt64
 	}
+	return int64(i)
 }
 
 // WithChildren implements the Node interface.
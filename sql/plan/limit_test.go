@@ -17,6 +17,7 @@ package plan
 import (
 	"fmt"
 	"io"
+	"math"
 	"reflect"
 	"testing"
 
@@ -84,6 +85,59 @@ func TestLimitGreaterThanTotal(t *testing.T) {
 	testLimitOverflow(t, ctx, iterator, testingLimit, size)
 }
 
+func TestLimitExpressions(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	table, size := getTestingTable(t)
+
+	limit := NewLimit(expression.NewPlus(
+		expression.NewLiteral(int8(1), sql.Int8),
+		expression.NewLiteral(int8(1), sql.Int8),
+	), NewResolvedTable(table, nil, nil))
+	rows, err := sql.NodeToRows(ctx, limit)
+	require.NoError(err)
+	require.Len(rows, 2)
+
+	limit = NewLimit(expression.NewLiteral(uint64(math.MaxUint64), sql.Uint64), NewResolvedTable(table, nil, nil))
+	rows, err = sql.NodeToRows(ctx, limit)
+	require.NoError(err)
+	require.Len(rows, size)
+
+	limit = NewLimit(expression.NewMinus(
+		expression.NewLiteral(int8(0), sql.Int8),
+		expression.NewLiteral(int8(1), sql.Int8),
+	), NewResolvedTable(table, nil, nil))
+	_, err = limit.RowIter(ctx, nil)
+	require.True(sql.ErrInvalidSyntax.Is(err), "unexpected error %v", err)
+
+	limit = NewLimit(expression.NewLiteral("2", sql.LongText), NewResolvedTable(table, nil, nil))
+	_, err = limit.RowIter(ctx, nil)
+	require.True(sql.ErrInvalidType.Is(err), "unexpected error %v", err)
+
+	limit = NewLimit(expression.NewBindVar("v1"), NewResolvedTable(table, nil, nil))
+	_, err = limit.RowIter(ctx, nil)
+	require.True(sql.ErrUnboundPreparedStatementVariable.Is(err), "unexpected error %v", err)
+}
+
+func TestTopNLimitOverflow(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+	table, size := getTestingTable(t)
+
+	// The limit of a TopN below an OFFSET is the sum of the LIMIT and OFFSET
+	topn := NewTopN(
+		[]sql.SortField{{Column: expression.NewGetField(0, sql.Text, "col1", false), Order: sql.Ascending}},
+		expression.NewPlus(
+			expression.NewLiteral(uint64(math.MaxUint64), sql.Uint64),
+			expression.NewLiteral(int8(1), sql.Int8),
+		),
+		NewResolvedTable(table, nil, nil),
+	)
+	rows, err := sql.NodeToRows(ctx, NewOffset(expression.NewLiteral(int8(1), sql.Int8), topn))
+	require.NoError(err)
+	require.Len(rows, size-1)
+}
+
 func testLimitOverflow(t *testing.T, ctx *sql.Context, iter sql.RowIter, limit int, dataSize int) {
 	require := require.New(t)
 	for i := 0; i < limit+1; i++ {
@@ -0,0 +1,98 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// MaskedTable is a wrapper for sql.Tables that masks the values of some of their columns as rows are read. It
+// deliberately implements no optional table interfaces, so that no index lookup, filter or projection can be applied
+// to the unmasked values of the underlying table.
+type MaskedTable struct {
+	sql.Table
+	masks map[int]sql.ColumnMasker
+}
+
+var _ sql.TableWrapper = (*MaskedTable)(nil)
+
+// NewMaskedTable returns a new MaskedTable that masks the columns of the table given with the masks given. Masks of
+// columns the table doesn't have are ignored.
+func NewMaskedTable(t sql.Table, masks []sql.ColumnMask) *MaskedTable {
+	indexes := make(map[int]sql.ColumnMasker)
+	for _, mask := range masks {
+		if i := t.Schema().IndexOf(mask.Column, t.Name()); i >= 0 {
+			indexes[i] = mask.Masker
+		}
+	}
+	return &MaskedTable{Table: t, masks: indexes}
+}
+
+// Underlying implements sql.TableWrapper interface.
+func (t *MaskedTable) Underlying() sql.Table {
+	return t.Table
+}
+
+// WithUnderlying returns a copy of this table that masks the same columns of the table given, which is usually a newer
+// copy of the same table.
+func (t *MaskedTable) WithUnderlying(table sql.Table) *MaskedTable {
+	return &MaskedTable{Table: table, masks: t.masks}
+}
+
+func (t *MaskedTable) String() string {
+	return fmt.Sprintf("Masked(%s)", t.Table.String())
+}
+
+// PartitionRows implements the sql.Table interface.
+func (t *MaskedTable) PartitionRows(ctx *sql.Context, p sql.Partition) (sql.RowIter, error) {
+	iter, err := t.Table.PartitionRows(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	return &maskedRowIter{iter: iter, schema: t.Schema(), masks: t.masks}, nil
+}
+
+var _ sql.RowIter = (*maskedRowIter)(nil)
+
+type maskedRowIter struct {
+	iter   sql.RowIter
+	schema sql.Schema
+	masks  map[int]sql.ColumnMasker
+}
+
+func (i *maskedRowIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.iter.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	masked := row.Copy()
+	for idx, masker := range i.masks {
+		if idx >= len(masked) {
+			continue
+		}
+		masked[idx], err = masker.Mask(ctx, i.schema[idx].Type, masked[idx])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return masked, nil
+}
+
+func (i *maskedRowIter) Close(ctx *sql.Context) error {
+	return i.iter.Close(ctx)
+}
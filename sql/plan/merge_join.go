@@ -0,0 +1,346 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"io"
+
+	opentracing "github.com/opentracing/opentracing-go"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// MergeJoin is a join whose condition compares columns of both sides for equality, and whose sides both return their
+// rows in ascending order of the compared columns, with NULL values first, such as when they're read in the order of
+// an index on them. Instead of looking up the matching rows of the secondary side for every row of the primary side,
+// it reads both sides once, side by side, only keeping in memory the rows of the secondary side with the same value as
+// the current row of the primary side. The whole join condition is still evaluated on every matching pair of rows. As
+// for other joins, the secondary side is the right child, except for right joins.
+//
+// The columns both sides are ordered by are those of the first equality comparison of the condition returned by
+// HashJoinKeys.
+type MergeJoin struct {
+	joinStruct
+	joinType JoinType
+}
+
+var _ JoinNode = (*MergeJoin)(nil)
+var _ sql.CommentedNode = (*MergeJoin)(nil)
+
+// NewMergeJoin creates a new merge join node of the type given from two tables, which must return their rows in the
+// order of the columns of the first key reported by HashJoinKeys for the condition given.
+func NewMergeJoin(joinType JoinType, left, right sql.Node, cond sql.Expression) *MergeJoin {
+	return &MergeJoin{
+		joinStruct: joinStruct{
+			BinaryNode: BinaryNode{
+				left:  left,
+				right: right,
+			},
+			Cond: cond,
+		},
+		joinType: joinType,
+	}
+}
+
+func (j *MergeJoin) JoinType() JoinType {
+	return j.joinType
+}
+
+// Schema implements the Node interface.
+func (j *MergeJoin) Schema() sql.Schema {
+	switch j.joinType {
+	case JoinTypeLeft:
+		return append(j.left.Schema(), makeNullable(j.right.Schema())...)
+	case JoinTypeRight:
+		return append(makeNullable(j.left.Schema()), j.right.Schema()...)
+	default:
+		return append(j.left.Schema(), j.right.Schema()...)
+	}
+}
+
+// Resolved implements the Resolvable interface.
+func (j *MergeJoin) Resolved() bool {
+	return j.left.Resolved() && j.right.Resolved() && j.Cond.Resolved()
+}
+
+// RowIter implements the Node interface.
+func (j *MergeJoin) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	primaryKeys, secondaryKeys := HashJoinKeys(j.joinType, j.Cond, j.ScopeLen, len(j.left.Schema()))
+	if len(primaryKeys) == 0 {
+		return joinRowIter(ctx, j.joinType, j.left, j.right, j.Cond, row, j.ScopeLen, j.JoinMode)
+	}
+
+	span, ctx := ctx.Span("plan.MergeJoin", opentracing.Tags{
+		"type": j.joinType.String(),
+	})
+
+	primary, secondary := j.left, j.right
+	if j.joinType == JoinTypeRight {
+		primary, secondary = j.right, j.left
+	}
+
+	primaryIter, err := primary.RowIter(ctx, row)
+	if err != nil {
+		span.Finish()
+		return nil, err
+	}
+
+	secondaryIter, err := secondary.RowIter(ctx, row)
+	if err != nil {
+		primaryIter.Close(ctx)
+		span.Finish()
+		return nil, err
+	}
+
+	return sql.NewSpanIter(span, &mergeJoinIter{
+		typ:          j.joinType,
+		primary:      primaryIter,
+		secondary:    secondaryIter,
+		cond:         j.Cond,
+		primaryKey:   primaryKeys[0],
+		secondaryKey: secondaryKeys[0],
+		rowSize:      len(row) + len(j.left.Schema()) + len(j.right.Schema()),
+		originalRow:  row,
+		scopeLen:     j.ScopeLen,
+	}), nil
+}
+
+// WithChildren implements the Node interface.
+func (j *MergeJoin) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(children), 2)
+	}
+
+	nj := *j
+	nj.BinaryNode = BinaryNode{children[0], children[1]}
+	return &nj, nil
+}
+
+// WithExpressions implements the Expressioner interface.
+func (j *MergeJoin) WithExpressions(exprs ...sql.Expression) (sql.Node, error) {
+	if len(exprs) != 1 {
+		return nil, sql.ErrInvalidChildrenNumber.New(j, len(exprs), 1)
+	}
+
+	nj := *j
+	nj.Cond = exprs[0]
+	return &nj, nil
+}
+
+func (j *MergeJoin) WithScopeLen(i int) JoinNode {
+	nj := *j
+	nj.ScopeLen = i
+	return &nj
+}
+
+func (j MergeJoin) WithMultipassMode() JoinNode {
+	j.JoinMode = multipassMode
+	return &j
+}
+
+// WithComment implements sql.CommentedNode
+func (j *MergeJoin) WithComment(comment string) sql.Node {
+	nj := *j
+	nj.CommentStr = comment
+	return &nj
+}
+
+func (j *MergeJoin) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("%sMergeJoin%s", j.typePrefix(), j.Cond)
+	_ = pr.WriteChildren(j.left.String(), j.right.String())
+	return pr.String()
+}
+
+func (j *MergeJoin) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("%sMergeJoin%s", j.typePrefix(), sql.DebugString(j.Cond))
+	_ = pr.WriteChildren(sql.DebugString(j.left), sql.DebugString(j.right))
+	return pr.String()
+}
+
+func (j *MergeJoin) typePrefix() string {
+	switch j.joinType {
+	case JoinTypeLeft:
+		return "Left"
+	case JoinTypeRight:
+		return "Right"
+	default:
+		return ""
+	}
+}
+
+// mergeJoinIter is the iterator of a MergeJoin. It keeps the group of consecutive rows of the secondary side with the
+// same key, and the first row after them, so that primary rows with the same key are matched against the same group.
+type mergeJoinIter struct {
+	typ          JoinType
+	primary      sql.RowIter
+	secondary    sql.RowIter
+	cond         sql.Expression
+	primaryKey   sql.Expression
+	secondaryKey sql.Expression
+
+	primaryRow sql.Row
+	foundMatch bool
+	// group are the secondary rows whose key is groupKey, and matches those still to be matched with primaryRow
+	group    []sql.Row
+	groupKey interface{}
+	matches  []sql.Row
+	// next is the secondary row after the group, with its key, unless secondaryDone
+	next          sql.Row
+	nextKey       interface{}
+	secondaryDone bool
+	rowSize       int
+
+	// scope variables from outer scope
+	originalRow sql.Row
+	scopeLen    int
+}
+
+func (i *mergeJoinIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		if i.primaryRow == nil {
+			r, err := i.primary.Next(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			i.primaryRow = i.originalRow.Append(r)
+			i.foundMatch = false
+
+			key, err := mergeJoinKey(ctx, i.primaryKey, i.buildRow(i.primaryRow, nil))
+			if err != nil {
+				return nil, err
+			}
+			i.matches = nil
+			// Rows with a NULL key don't match any row
+			if key != nil {
+				if err := i.seek(ctx, key); err != nil {
+					return nil, err
+				}
+				i.matches = i.group
+			}
+		}
+
+		if len(i.matches) == 0 {
+			primary := i.primaryRow
+			i.primaryRow = nil
+			if !i.foundMatch && (i.typ == JoinTypeLeft || i.typ == JoinTypeRight) {
+				return i.buildRow(primary, nil), nil
+			}
+			continue
+		}
+
+		row := i.buildRow(i.primaryRow, i.matches[0])
+		i.matches = i.matches[1:]
+		matches, err := conditionIsTrue(ctx, row, i.cond)
+		if err != nil {
+			return nil, err
+		}
+
+		if !matches {
+			continue
+		}
+
+		i.foundMatch = true
+		return row, nil
+	}
+}
+
+// seek makes the group the secondary rows with the key given, skipping those with a lower key.
+func (i *mergeJoinIter) seek(ctx *sql.Context, key interface{}) error {
+	if i.group != nil {
+		cmp, err := i.primaryKey.Type().Compare(key, i.groupKey)
+		if err != nil {
+			return err
+		}
+		if cmp == 0 {
+			return nil
+		}
+		i.group, i.groupKey = nil, nil
+	}
+
+	for {
+		if i.next == nil {
+			if i.secondaryDone {
+				return nil
+			}
+			if err := i.advance(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+
+		cmp := -1
+		if i.nextKey != nil {
+			var err error
+			cmp, err = i.primaryKey.Type().Compare(i.nextKey, key)
+			if err != nil {
+				return err
+			}
+		}
+		if cmp > 0 {
+			return nil
+		}
+		if cmp == 0 {
+			i.group = append(i.group, i.next)
+			i.groupKey = key
+		}
+		i.next, i.nextKey = nil, nil
+	}
+}
+
+// advance reads the next row of the secondary side.
+func (i *mergeJoinIter) advance(ctx *sql.Context) error {
+	row, err := i.secondary.Next(ctx)
+	if err == io.EOF {
+		i.secondaryDone = true
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	key, err := mergeJoinKey(ctx, i.secondaryKey, row)
+	if err != nil {
+		return err
+	}
+	i.next, i.nextKey = row, key
+	return nil
+}
+
+// buildRow builds the resulting row using the rows from the primary and secondary sides depending on the join type.
+func (i *mergeJoinIter) buildRow(primary, secondary sql.Row) sql.Row {
+	return buildJoinRow(i.typ, i.originalRow, i.scopeLen, i.rowSize, primary, secondary)
+}
+
+func (i *mergeJoinIter) Close(ctx *sql.Context) error {
+	i.group, i.matches = nil, nil
+	err := i.primary.Close(ctx)
+	if serr := i.secondary.Close(ctx); err == nil {
+		err = serr
+	}
+	return err
+}
+
+// mergeJoinKey returns the value of the key given for the row given, converted to the type of the key.
+func mergeJoinKey(ctx *sql.Context, key sql.Expression, row sql.Row) (interface{}, error) {
+	v, err := key.Eval(ctx, row)
+	if err != nil || v == nil {
+		return nil, err
+	}
+	// Values of the same type may have different representations, such as an int32 in an INT64 column
+	return key.Type().Convert(v)
+}
@@ -0,0 +1,116 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestMergeJoin(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	schema := func(prefix string) sql.PrimaryKeySchema {
+		return sql.NewPrimaryKeySchema(sql.Schema{
+			{Name: prefix + "k", Type: sql.Int64, Nullable: true},
+			{Name: prefix + "v", Type: sql.Text},
+		})
+	}
+
+	// The rows of both sides are ordered by their first column
+	ltable := memory.NewTable("left", schema("l"))
+	rtable := memory.NewTable("right", schema("r"))
+	for _, row := range []sql.Row{
+		{nil, "l0"},
+		{int64(1), "l1"},
+		{int64(2), "l2a"},
+		{int64(2), "l2b"},
+		{int64(4), "l4"},
+	} {
+		require.NoError(t, ltable.Insert(ctx, row))
+	}
+	for _, row := range []sql.Row{
+		{nil, "r0"},
+		{int64(2), "r2a"},
+		{int64(2), "r2b"},
+		{int64(3), "r3"},
+		{int64(4), "r4"},
+	} {
+		require.NoError(t, rtable.Insert(ctx, row))
+	}
+
+	cond := expression.NewAnd(
+		expression.NewEquals(
+			expression.NewGetField(0, sql.Int64, "lk", true),
+			expression.NewGetField(2, sql.Int64, "rk", true),
+		),
+		expression.NewNot(expression.NewEquals(
+			expression.NewGetField(3, sql.Text, "rv", false),
+			expression.NewLiteral("r2b", sql.Text),
+		)),
+	)
+
+	testCases := []struct {
+		typ      JoinType
+		expected []sql.Row
+	}{
+		{
+			JoinTypeInner,
+			[]sql.Row{
+				{int64(2), "l2a", int64(2), "r2a"},
+				{int64(2), "l2b", int64(2), "r2a"},
+				{int64(4), "l4", int64(4), "r4"},
+			},
+		},
+		{
+			JoinTypeLeft,
+			[]sql.Row{
+				{nil, "l0", nil, nil},
+				{int64(1), "l1", nil, nil},
+				{int64(2), "l2a", int64(2), "r2a"},
+				{int64(2), "l2b", int64(2), "r2a"},
+				{int64(4), "l4", int64(4), "r4"},
+			},
+		},
+		{
+			JoinTypeRight,
+			[]sql.Row{
+				{nil, nil, nil, "r0"},
+				{int64(2), "l2a", int64(2), "r2a"},
+				{int64(2), "l2b", int64(2), "r2a"},
+				{nil, nil, int64(2), "r2b"},
+				{nil, nil, int64(3), "r3"},
+				{int64(4), "l4", int64(4), "r4"},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.typ.String(), func(t *testing.T) {
+			require := require.New(t)
+			j := NewMergeJoin(tt.typ, NewResolvedTable(ltable, nil, nil), NewResolvedTable(rtable, nil, nil), cond)
+
+			iter, err := j.RowIter(ctx, nil)
+			require.NoError(err)
+			rows, err := sql.RowIterToRows(ctx, iter)
+			require.NoError(err)
+			require.Equal(tt.expected, rows)
+		})
+	}
+}
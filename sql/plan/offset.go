@@ -56,8 +56,9 @@ func (o *Offset) Resolved() bool {
 func (o *Offset) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	span, ctx := ctx.Span("plan.Offset", opentracing.Tag{Key: "offset", Value: o.Offset})
 
-	offset, err := getInt64Value(ctx, o.Offset)
+	offset, err := getInt64Value(ctx, o.Offset, "offset")
 	if err != nil {
+		span.Finish()
 		return nil, err
 	}
 
@@ -0,0 +1,182 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// PrepareQuery represents a PREPARE statement, which prepares a statement for the session to execute with EXECUTE
+// statements.
+type PrepareQuery struct {
+	Name string
+	// Source is the text of the statement, a string literal or a user variable.
+	Source sql.Expression
+	// Query and Queries are assigned by the analyzer, which parses the text of the statement.
+	Query   *sql.PreparedQuery
+	Queries *sql.PreparedQueries
+}
+
+var _ sql.Node = (*PrepareQuery)(nil)
+
+// NewPrepareQuery returns a new PrepareQuery node for the statement with the name and text given.
+func NewPrepareQuery(name string, source sql.Expression) *PrepareQuery {
+	return &PrepareQuery{Name: name, Source: source}
+}
+
+// Resolved implements the sql.Node interface.
+func (p *PrepareQuery) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (p *PrepareQuery) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (p *PrepareQuery) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(p, len(children), 0)
+	}
+	return p, nil
+}
+
+// Schema implements the sql.Node interface.
+func (p *PrepareQuery) Schema() sql.Schema {
+	return sql.OkResultSchema
+}
+
+// RowIter implements the sql.Node interface.
+func (p *PrepareQuery) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if p.Query == nil || p.Queries == nil {
+		return nil, sql.ErrUnsupportedFeature.New("PREPARE")
+	}
+	p.Queries.Prepare(ctx.ID(), p.Query)
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+func (p *PrepareQuery) String() string {
+	return fmt.Sprintf("PREPARE %s FROM %s", p.Name, p.Source)
+}
+
+// ExecuteQuery represents an EXECUTE statement, which executes a statement prepared by the session with the values
+// of user variables bound to its parameters. The engine replaces it with the prepared statement before analyzing it.
+type ExecuteQuery struct {
+	Name string
+	// Bindings are the user variables whose values are bound to the parameters of the statement, in order.
+	Bindings []sql.Expression
+}
+
+var _ sql.Node = (*ExecuteQuery)(nil)
+
+// NewExecuteQuery returns a new ExecuteQuery node for the statement with the name given.
+func NewExecuteQuery(name string, bindings []sql.Expression) *ExecuteQuery {
+	return &ExecuteQuery{Name: name, Bindings: bindings}
+}
+
+// Resolved implements the sql.Node interface.
+func (e *ExecuteQuery) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (e *ExecuteQuery) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (e *ExecuteQuery) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(e, len(children), 0)
+	}
+	return e, nil
+}
+
+// Schema implements the sql.Node interface.
+func (e *ExecuteQuery) Schema() sql.Schema {
+	return nil
+}
+
+// RowIter implements the sql.Node interface.
+func (e *ExecuteQuery) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	return nil, sql.ErrUnsupportedFeature.New("EXECUTE outside of a top level statement")
+}
+
+func (e *ExecuteQuery) String() string {
+	if len(e.Bindings) == 0 {
+		return fmt.Sprintf("EXECUTE %s", e.Name)
+	}
+	bindings := make([]string, len(e.Bindings))
+	for i, b := range e.Bindings {
+		bindings[i] = b.String()
+	}
+	return fmt.Sprintf("EXECUTE %s USING %s", e.Name, strings.Join(bindings, ", "))
+}
+
+// DeallocateQuery represents a DEALLOCATE PREPARE statement, which deallocates a statement prepared by the session.
+type DeallocateQuery struct {
+	Name string
+	// Queries is assigned by the analyzer.
+	Queries *sql.PreparedQueries
+}
+
+var _ sql.Node = (*DeallocateQuery)(nil)
+
+// NewDeallocateQuery returns a new DeallocateQuery node for the statement with the name given.
+func NewDeallocateQuery(name string) *DeallocateQuery {
+	return &DeallocateQuery{Name: name}
+}
+
+// Resolved implements the sql.Node interface.
+func (d *DeallocateQuery) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (d *DeallocateQuery) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (d *DeallocateQuery) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(d, len(children), 0)
+	}
+	return d, nil
+}
+
+// Schema implements the sql.Node interface.
+func (d *DeallocateQuery) Schema() sql.Schema {
+	return sql.OkResultSchema
+}
+
+// RowIter implements the sql.Node interface.
+func (d *DeallocateQuery) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if d.Queries == nil {
+		return nil, sql.ErrUnsupportedFeature.New("DEALLOCATE PREPARE")
+	}
+	if err := d.Queries.Deallocate(ctx.ID(), d.Name); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+func (d *DeallocateQuery) String() string {
+	return fmt.Sprintf("DEALLOCATE PREPARE %s", d.Name)
+}
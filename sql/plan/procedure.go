@@ -160,6 +160,32 @@ func (p *Procedure) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 	return p.Body.RowIter(ctx, row)
 }
 
+// DefinerAccount returns the user and host of the account named by the procedure's DEFINER clause, and whether the
+// procedure has one. A definer without a host names the user's account for any host.
+func (p *Procedure) DefinerAccount() (string, string, bool) {
+	if p.Definer == "" {
+		return "", "", false
+	}
+	user, host := p.Definer, "%"
+	if i := strings.LastIndex(p.Definer, "@"); i >= 0 {
+		user, host = p.Definer[:i], p.Definer[i+1:]
+	}
+	return strings.Trim(user, "`'\""), strings.Trim(host, "`'\""), true
+}
+
+// ExecutionContext returns the context that the body of the procedure executes with when called with the context
+// given. Procedures with SQL SECURITY DEFINER execute with the privileges of their definer, and those with SQL SECURITY
+// INVOKER or without a definer execute with the privileges of their caller.
+func (p *Procedure) ExecutionContext(ctx *sql.Context) *sql.Context {
+	if p.SecurityContext != ProcedureSecurityContext_Definer {
+		return ctx
+	}
+	if user, host, ok := p.DefinerAccount(); ok {
+		return ctx.WithSecurityContext(user, host)
+	}
+	return ctx
+}
+
 // String returns the original SQL representation.
 func (pst ProcedureSecurityContext) String() string {
 	switch pst {
@@ -129,7 +129,7 @@ func (t *ProcedureResolvedTable) newestTable(ctx *sql.Context) (*ResolvedTable,
 		} else if !ok {
 			return nil, sql.ErrTableNotFound.New(t.ResolvedTable.Table.Name())
 		}
-		return t.ResolvedTable.WithTable(tbl)
+		return t.ResolvedTable.WithTable(t.keepMasks(tbl))
 	} else {
 		versionedDb, ok := t.ResolvedTable.Database.(sql.VersionedDatabase)
 		if !ok {
@@ -142,6 +142,22 @@ func (t *ProcedureResolvedTable) newestTable(ctx *sql.Context) (*ResolvedTable,
 		} else if !ok {
 			return nil, sql.ErrTableNotFound.New(t.ResolvedTable.Table.Name())
 		}
-		return t.ResolvedTable.WithTable(tbl)
+		return t.ResolvedTable.WithTable(t.keepMasks(tbl))
 	}
 }
+
+// keepMasks masks the table given like the contained table, if its columns are masked. The masked table may be wrapped
+// by other tables, such as a ProcessTable.
+func (t *ProcedureResolvedTable) keepMasks(tbl sql.Table) sql.Table {
+	for table := t.ResolvedTable.Table; table != nil; {
+		if mt, ok := table.(*MaskedTable); ok {
+			return mt.WithUnderlying(tbl)
+		}
+		wrapper, ok := table.(sql.TableWrapper)
+		if !ok {
+			break
+		}
+		table = wrapper.Underlying()
+	}
+	return tbl
+}
@@ -426,7 +426,7 @@ func IsDDLNode(node sql.Node) bool {
 	case *CreateTable, *DropTable, *Truncate,
 		*AddColumn, *ModifyColumn, *DropColumn,
 		*CreateDB, *DropDB,
-		*RenameTable, *RenameColumn,
+		*RenameTable, *MultiRenameTable, *RenameColumn,
 		*CreateView, *DropView,
 		*CreateIndex, *AlterIndex, *DropIndex,
 		*CreateProcedure, *DropProcedure,
@@ -89,6 +89,10 @@ func (p *ShowProcessList) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, e
 
 	for i, proc := range processes {
 		var status []string
+		if proc.Stage.Name != "" {
+			status = append(status, proc.Stage.String())
+		}
+
 		var names []string
 		for name := range proc.Progress {
 			names = append(names, name)
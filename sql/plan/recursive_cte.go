@@ -0,0 +1,315 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// RecursiveCte is the definition of a common table expression of a WITH RECURSIVE clause that refers to itself. Its
+// left child is the union of its non-recursive query blocks, which give the initial rows of the expression, and its
+// right child is the union of its recursive query blocks, which read the rows of the previous iteration from the
+// RecursiveTable of the expression. Iterations stop once one of them returns no rows. Like MySQL, a UNION DISTINCT
+// definition discards the rows that were already returned, so that cycles in the data end the recursion, while a
+// UNION ALL definition whose data has cycles fails once it reaches cte_max_recursion_depth iterations.
+type RecursiveCte struct {
+	BinaryNode
+	name string
+	// Columns are the names of the columns of the expression, if they were given.
+	Columns []string
+	// Distinct is whether the query blocks of the expression are combined with UNION DISTINCT.
+	Distinct bool
+	table    *RecursiveTable
+}
+
+var _ sql.Node = (*RecursiveCte)(nil)
+var _ sql.OpaqueNode = (*RecursiveCte)(nil)
+
+// NewRecursiveCte returns a new RecursiveCte with the name given, whose recursive query blocks refer to the table of
+// the expression with a ResolvedTable of a RecursiveTable of the same name.
+func NewRecursiveCte(initial, recursive sql.Node, name string, columns []string, distinct bool) *RecursiveCte {
+	return &RecursiveCte{
+		BinaryNode: BinaryNode{left: initial, right: recursive},
+		name:       name,
+		Columns:    columns,
+		Distinct:   distinct,
+	}
+}
+
+// Name returns the name of the common table expression.
+func (r *RecursiveCte) Name() string {
+	return r.name
+}
+
+// Table returns the table of the rows of the previous iteration, which is set once the non-recursive query blocks
+// have been analyzed, or nil.
+func (r *RecursiveCte) Table() *RecursiveTable {
+	return r.table
+}
+
+// WithTable returns a copy of the node with the table given, which its recursive query blocks read.
+func (r *RecursiveCte) WithTable(table *RecursiveTable) *RecursiveCte {
+	nr := *r
+	nr.table = table
+	return &nr
+}
+
+// Schema implements the sql.Node interface.
+func (r *RecursiveCte) Schema() sql.Schema {
+	if r.table != nil {
+		return r.table.Schema()
+	}
+	return r.left.Schema()
+}
+
+// Opaque implements the sql.OpaqueNode interface. Like a Union, the query blocks of the expression are analyzed in
+// isolation.
+func (r *RecursiveCte) Opaque() bool {
+	return true
+}
+
+// WithChildren implements the sql.Node interface.
+func (r *RecursiveCte) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 2 {
+		return nil, sql.ErrInvalidChildrenNumber.New(r, len(children), 2)
+	}
+	nr := *r
+	nr.left, nr.right = children[0], children[1]
+	return &nr, nil
+}
+
+// RowIter implements the sql.Node interface.
+func (r *RecursiveCte) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if r.table == nil {
+		return nil, fmt.Errorf("recursive common table expression %s has not been analyzed", r.name)
+	}
+	maxDepth, err := ctx.GetSessionVariable(ctx, "cte_max_recursion_depth")
+	if err != nil {
+		return nil, err
+	}
+	maxDepth, err = sql.Int64.Convert(maxDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every execution reads its own copy of the table, so that the expression may be executed concurrently
+	table := NewRecursiveTable(r.name, r.table.Schema())
+	recursive, err := TransformUp(r.right, func(n sql.Node) (sql.Node, error) {
+		if rt, ok := n.(*ResolvedTable); ok && r.readsTable(rt.Table) {
+			return NewResolvedTable(table, nil, nil), nil
+		}
+		return n, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := r.left.RowIter(ctx, row)
+	if err != nil {
+		return nil, err
+	}
+	rci := &recursiveCteIter{
+		iter:      iter,
+		recursive: recursive,
+		table:     table,
+		row:       row,
+		maxDepth:  maxDepth.(int64),
+	}
+	if r.Distinct {
+		rci.seen, rci.dispose = ctx.Memory.NewHistoryCache()
+	}
+	return rci, nil
+}
+
+// readsTable returns whether the table given is the table of the expression, or wraps it.
+func (r *RecursiveCte) readsTable(t sql.Table) bool {
+	for t != r.table {
+		w, ok := t.(sql.TableWrapper)
+		if !ok {
+			return false
+		}
+		t = w.Underlying()
+	}
+	return true
+}
+
+func (r *RecursiveCte) String() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("RecursiveCte(%s)", r.name)
+	_ = pr.WriteChildren(r.left.String(), r.right.String())
+	return pr.String()
+}
+
+func (r *RecursiveCte) DebugString() string {
+	pr := sql.NewTreePrinter()
+	_ = pr.WriteNode("RecursiveCte(%s, distinct=%t)", r.name, r.Distinct)
+	_ = pr.WriteChildren(sql.DebugString(r.left), sql.DebugString(r.right))
+	return pr.String()
+}
+
+// recursiveCteIter returns the rows of the non-recursive query blocks of a recursive common table expression, and then
+// those of each iteration of its recursive query blocks, which read the rows of the previous iteration.
+type recursiveCteIter struct {
+	iter      sql.RowIter
+	recursive sql.Node
+	table     *RecursiveTable
+	row       sql.Row
+	// cycle are the rows returned by the current iteration
+	cycle    []sql.Row
+	depth    int64
+	maxDepth int64
+	seen     sql.KeyValueCache
+	dispose  sql.DisposeFunc
+}
+
+var _ sql.RowIter = (*recursiveCteIter)(nil)
+
+func (r *recursiveCteIter) Next(ctx *sql.Context) (sql.Row, error) {
+	for {
+		if r.iter == nil {
+			return nil, io.EOF
+		}
+		row, err := r.iter.Next(ctx)
+		if err == io.EOF {
+			if err := r.nextIteration(ctx); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		row, err = r.table.convertRow(row)
+		if err != nil {
+			return nil, err
+		}
+		if r.seen != nil {
+			hash, err := sql.HashOf(row)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := r.seen.Get(hash); err == nil {
+				continue
+			}
+			if err := r.seen.Put(hash, struct{}{}); err != nil {
+				return nil, err
+			}
+		}
+		r.cycle = append(r.cycle, row)
+		return row, nil
+	}
+}
+
+// nextIteration closes the rows of the current iteration, and starts the next one unless the current one returned no
+// rows.
+func (r *recursiveCteIter) nextIteration(ctx *sql.Context) error {
+	err := r.iter.Close(ctx)
+	r.iter = nil
+	if err != nil || len(r.cycle) == 0 {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	r.depth++
+	if r.depth > r.maxDepth {
+		return sql.ErrCteMaxRecursionDepth.New(r.depth)
+	}
+	r.table.rows, r.cycle = r.cycle, nil
+	r.iter, err = r.recursive.RowIter(ctx, r.row)
+	return err
+}
+
+func (r *recursiveCteIter) Close(ctx *sql.Context) error {
+	if r.dispose != nil {
+		r.dispose()
+		r.dispose = nil
+	}
+	if r.iter == nil {
+		return nil
+	}
+	err := r.iter.Close(ctx)
+	r.iter = nil
+	return err
+}
+
+// RecursiveTable is the table that the recursive query blocks of a recursive common table expression read, whose rows
+// are those returned by the previous iteration of the expression.
+type RecursiveTable struct {
+	name   string
+	schema sql.Schema
+	rows   []sql.Row
+}
+
+var _ sql.Table = (*RecursiveTable)(nil)
+
+// NewRecursiveTable returns a new RecursiveTable with the name and schema given.
+func NewRecursiveTable(name string, schema sql.Schema) *RecursiveTable {
+	return &RecursiveTable{name: name, schema: schema}
+}
+
+// Name implements the sql.Nameable interface.
+func (t *RecursiveTable) Name() string {
+	return t.name
+}
+
+// String implements the sql.Table interface.
+func (t *RecursiveTable) String() string {
+	return t.name
+}
+
+// Schema implements the sql.Table interface.
+func (t *RecursiveTable) Schema() sql.Schema {
+	return t.schema
+}
+
+// Partitions implements the sql.Table interface.
+func (t *RecursiveTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return sql.PartitionsToPartitionIter(recursiveTablePartition{}), nil
+}
+
+// PartitionRows implements the sql.Table interface.
+func (t *RecursiveTable) PartitionRows(*sql.Context, sql.Partition) (sql.RowIter, error) {
+	return sql.RowsToRowIter(t.rows...), nil
+}
+
+// convertRow converts the values of the row given, returned by a query block of the expression, to the types of the
+// columns of the table.
+func (t *RecursiveTable) convertRow(row sql.Row) (sql.Row, error) {
+	converted := make(sql.Row, len(row))
+	for i, v := range row {
+		if i >= len(t.schema) {
+			return nil, fmt.Errorf("row of recursive common table expression %s has %d columns, expected %d", t.name, len(row), len(t.schema))
+		}
+		var err error
+		converted[i], err = t.schema[i].Type.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return converted, nil
+}
+
+// recursiveTablePartition is the only partition of a RecursiveTable.
+type recursiveTablePartition struct{}
+
+func (recursiveTablePartition) Key() []byte {
+	return nil
+}
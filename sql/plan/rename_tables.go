@@ -0,0 +1,316 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// QualifiedRename is a single rename of a MultiRenameTable. An empty database name refers to the current database.
+type QualifiedRename struct {
+	OldDatabase string
+	OldName     string
+	NewDatabase string
+	NewName     string
+}
+
+func (r QualifiedRename) String() string {
+	return fmt.Sprintf("%s to %s", qualifiedName(r.OldDatabase, r.OldName), qualifiedName(r.NewDatabase, r.NewName))
+}
+
+func qualifiedName(db, name string) string {
+	if db == "" {
+		return name
+	}
+	return db + "." + name
+}
+
+// MultiRenameTable is a RENAME TABLE statement that renames several tables, or moves tables to another database. The
+// renames are applied in order, except that an old name refers to the table with that name before the statement when
+// it hasn't been renamed yet, so both a TO b, b TO a and a TO tmp, b TO a, tmp TO b swap the two tables. The
+// statement is atomic: every rename is checked before any is made. When all the renames are within a database that
+// implements sql.MultiTableRenamer, they're made with a single call to it, otherwise one table at a time, undoing the
+// renames already made if one fails.
+type MultiRenameTable struct {
+	Renames []QualifiedRename
+	Catalog sql.Catalog
+}
+
+var _ sql.Node = (*MultiRenameTable)(nil)
+
+// NewMultiRenameTable returns a new MultiRenameTable node for the renames given.
+func NewMultiRenameTable(renames []QualifiedRename) *MultiRenameTable {
+	for i := range renames {
+		renames[i].NewName = sql.StoredTableName(renames[i].NewName)
+	}
+	return &MultiRenameTable{Renames: renames}
+}
+
+// Resolved implements the sql.Node interface.
+func (r *MultiRenameTable) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (r *MultiRenameTable) Children() []sql.Node {
+	return nil
+}
+
+// Schema implements the sql.Node interface.
+func (r *MultiRenameTable) Schema() sql.Schema {
+	return nil
+}
+
+func (r *MultiRenameTable) String() string {
+	renames := make([]string, len(r.Renames))
+	for i, rename := range r.Renames {
+		renames[i] = rename.String()
+	}
+	return fmt.Sprintf("Rename table %s", strings.Join(renames, ", "))
+}
+
+// WithChildren implements the sql.Node interface.
+func (r *MultiRenameTable) WithChildren(children ...sql.Node) (sql.Node, error) {
+	return NillaryWithChildren(r, children...)
+}
+
+// RowIter implements the sql.Node interface.
+func (r *MultiRenameTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	moves, err := r.tableMoves(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if db, ok := singleDatabase(moves); ok {
+		if renamer, ok := db.(sql.MultiTableRenamer); ok && len(moves) > 1 {
+			renames := make([]sql.TableRename, len(moves))
+			for i, m := range moves {
+				renames[i] = sql.TableRename{OldName: m.fromName, NewName: m.toName}
+			}
+			return sql.RowsToRowIter(), renamer.RenameTables(ctx, renames)
+		}
+	}
+
+	return sql.RowsToRowIter(), applyTableMoves(ctx, moves)
+}
+
+// tableMove is the rename of a single table, possibly to another database.
+type tableMove struct {
+	from     sql.Database
+	fromName string
+	to       sql.Database
+	toName   string
+}
+
+func (m *tableMove) apply(ctx *sql.Context) error {
+	if sameDatabase(m.from, m.to) {
+		renamer, ok := m.from.(sql.TableRenamer)
+		if !ok {
+			return ErrRenameTableNotSupported.New(m.from.Name())
+		}
+		return renamer.RenameTable(ctx, m.fromName, m.toName)
+	}
+
+	mover, ok := m.from.(sql.TableMover)
+	if !ok {
+		return sql.ErrMoveTableNotSupported.New(m.from.Name(), m.to.Name())
+	}
+	return mover.MoveTable(ctx, m.fromName, m.to, m.toName)
+}
+
+func (m *tableMove) reverse() *tableMove {
+	return &tableMove{from: m.to, fromName: m.toName, to: m.from, toName: m.fromName}
+}
+
+// tableMoves returns the renames of the statement as the moves of the tables they apply to, one for each table, or an
+// error if any of them can't be made.
+func (r *MultiRenameTable) tableMoves(ctx *sql.Context) ([]*tableMove, error) {
+	var moves []*tableMove
+	for _, rename := range r.Renames {
+		from, err := r.database(ctx, rename.OldDatabase)
+		if err != nil {
+			return nil, err
+		}
+		to, err := r.database(ctx, rename.NewDatabase)
+		if err != nil {
+			return nil, err
+		}
+
+		tbl, ok, err := from.GetTableInsensitive(ctx, rename.OldName)
+		if err != nil {
+			return nil, err
+		}
+
+		var move *tableMove
+		if ok && findMove(moves, from, tbl.Name(), false) == nil {
+			move = &tableMove{from: from, fromName: tbl.Name()}
+			moves = append(moves, move)
+		} else if move = findMove(moves, from, rename.OldName, true); move == nil {
+			return nil, sql.ErrTableNotFound.New(rename.OldName)
+		}
+		move.to, move.toName = to, rename.NewName
+	}
+
+	var result []*tableMove
+	for i, m := range moves {
+		for _, other := range moves[:i] {
+			if sameDatabase(m.to, other.to) && strings.EqualFold(m.toName, other.toName) {
+				return nil, sql.ErrTableAlreadyExists.New(m.toName)
+			}
+		}
+
+		existing, ok, err := m.to.GetTableInsensitive(ctx, m.toName)
+		if err != nil {
+			return nil, err
+		}
+		if ok && findMove(moves, m.to, existing.Name(), false) == nil {
+			return nil, sql.ErrTableAlreadyExists.New(m.toName)
+		}
+
+		if sameDatabase(m.from, m.to) {
+			if _, ok := m.from.(sql.TableRenamer); !ok {
+				return nil, ErrRenameTableNotSupported.New(m.from.Name())
+			}
+			if m.fromName == m.toName {
+				continue
+			}
+		} else if _, ok := m.from.(sql.TableMover); !ok {
+			return nil, sql.ErrMoveTableNotSupported.New(m.from.Name(), m.to.Name())
+		}
+		result = append(result, m)
+	}
+
+	return result, nil
+}
+
+func (r *MultiRenameTable) database(ctx *sql.Context, name string) (sql.Database, error) {
+	if name == "" {
+		name = ctx.GetCurrentDatabase()
+		if name == "" {
+			return nil, sql.ErrNoDatabaseSelected.New()
+		}
+	}
+	return r.Catalog.Database(name)
+}
+
+// applyTableMoves makes the moves given one at a time, in an order that frees the new name of each table before it's
+// taken. Tables whose moves form a cycle are first given a temporary name. If a move fails, the ones already made are
+// undone.
+func applyTableMoves(ctx *sql.Context, moves []*tableMove) (err error) {
+	var done []*tableMove
+	defer func() {
+		if err != nil {
+			for i := len(done) - 1; i >= 0; i-- {
+				_ = done[i].reverse().apply(ctx)
+			}
+		}
+	}()
+
+	pending := append([]*tableMove(nil), moves...)
+	for len(pending) > 0 {
+		next := -1
+		for i, m := range pending {
+			if !isMoveBlocked(pending, m) {
+				next = i
+				break
+			}
+		}
+
+		if next < 0 {
+			// Every remaining move waits for another, so the first table is moved out of the way
+			m := pending[0]
+			tmpName, err := temporaryTableName(ctx, m.from)
+			if err != nil {
+				return err
+			}
+			tmp := &tableMove{from: m.from, fromName: m.fromName, to: m.from, toName: tmpName}
+			if err := tmp.apply(ctx); err != nil {
+				return err
+			}
+			done = append(done, tmp)
+			pending[0] = &tableMove{from: m.from, fromName: tmpName, to: m.to, toName: m.toName}
+			continue
+		}
+
+		m := pending[next]
+		if err := m.apply(ctx); err != nil {
+			return err
+		}
+		done = append(done, m)
+		pending = append(pending[:next], pending[next+1:]...)
+	}
+
+	return nil
+}
+
+// isMoveBlocked returns whether the new name of the move given is still taken by a table that has yet to be moved.
+func isMoveBlocked(pending []*tableMove, m *tableMove) bool {
+	for _, other := range pending {
+		if other != m && sameDatabase(other.from, m.to) && strings.EqualFold(other.fromName, m.toName) {
+			return true
+		}
+	}
+	return false
+}
+
+// findMove returns the move of the table named in the database given, or nil if there isn't one. The name is matched
+// against the new name of the table if |moved| is true, and against its old name otherwise.
+func findMove(moves []*tableMove, db sql.Database, name string, moved bool) *tableMove {
+	for _, m := range moves {
+		mdb, mname := m.from, m.fromName
+		if moved {
+			mdb, mname = m.to, m.toName
+		}
+		if sameDatabase(mdb, db) && strings.EqualFold(mname, name) {
+			return m
+		}
+	}
+	return nil
+}
+
+// singleDatabase returns the database of the moves given, if they're all renames within the same database.
+func singleDatabase(moves []*tableMove) (sql.Database, bool) {
+	if len(moves) == 0 {
+		return nil, false
+	}
+	db := moves[0].from
+	for _, m := range moves {
+		if !sameDatabase(m.from, db) || !sameDatabase(m.to, db) {
+			return nil, false
+		}
+	}
+	return db, true
+}
+
+func sameDatabase(a, b sql.Database) bool {
+	return strings.EqualFold(a.Name(), b.Name())
+}
+
+// temporaryTableName returns a table name that isn't in use in the database given.
+func temporaryTableName(ctx *sql.Context, db sql.Database) (string, error) {
+	for i := 0; ; i++ {
+		name := fmt.Sprintf("__rename_tmp_%d", i)
+		_, ok, err := db.GetTableInsensitive(ctx, name)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			return name, nil
+		}
+	}
+}
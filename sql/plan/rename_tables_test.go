@@ -0,0 +1,119 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	. "github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/test"
+)
+
+// renameOnlyDatabase is a database that renames tables one at a time, failing to rename the table named failName.
+type renameOnlyDatabase struct {
+	sql.Database
+	db       *memory.BaseDatabase
+	failName string
+}
+
+func (d *renameOnlyDatabase) RenameTable(ctx *sql.Context, oldName, newName string) error {
+	if oldName == d.failName {
+		return fmt.Errorf("cannot rename %s", oldName)
+	}
+	return d.db.RenameTable(ctx, oldName, newName)
+}
+
+func TestMultiRenameTableOneAtATime(t *testing.T) {
+	newDatabase := func() *renameOnlyDatabase {
+		db := memory.NewViewlessDatabase("db")
+		for _, name := range []string{"a", "b", "c"} {
+			db.AddTable(name, memory.NewTable(name, sql.NewPrimaryKeySchema(sql.Schema{
+				{Name: name, Type: sql.Int64, Source: name},
+			})))
+		}
+		return &renameOnlyDatabase{Database: db, db: db}
+	}
+
+	// columns returns the name of the column of each table of the database, by table name
+	columns := func(db *renameOnlyDatabase) []string {
+		var result []string
+		for name, table := range db.db.Tables() {
+			result = append(result, name+":"+table.Schema()[0].Name)
+		}
+		sort.Strings(result)
+		return result
+	}
+
+	testCases := []struct {
+		name     string
+		renames  []QualifiedRename
+		failName string
+		expected []string
+	}{
+		{
+			name: "rotate",
+			renames: []QualifiedRename{
+				{OldName: "a", NewName: "b"},
+				{OldName: "b", NewName: "c"},
+				{OldName: "c", NewName: "a"},
+			},
+			expected: []string{"a:c", "b:a", "c:b"},
+		},
+		{
+			name: "chain",
+			renames: []QualifiedRename{
+				{OldName: "c", NewName: "d"},
+				{OldName: "b", NewName: "c"},
+				{OldName: "a", NewName: "b"},
+			},
+			expected: []string{"b:a", "c:b", "d:c"},
+		},
+		{
+			name: "undone on failure",
+			renames: []QualifiedRename{
+				{OldName: "a", NewName: "x"},
+				{OldName: "b", NewName: "a"},
+				{OldName: "c", NewName: "y"},
+			},
+			failName: "c",
+			expected: []string{"a:a", "b:b", "c:c"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newDatabase()
+			db.failName = tt.failName
+			ctx := sql.NewEmptyContext()
+			ctx.SetCurrentDatabase("db")
+
+			node := NewMultiRenameTable(tt.renames)
+			node.Catalog = test.NewCatalog(sql.NewDatabaseProvider(db))
+			_, err := node.RowIter(ctx, nil)
+			if tt.failName != "" {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.expected, columns(db))
+		})
+	}
+}
@@ -17,6 +17,8 @@ package plan
 import (
 	"fmt"
 
+	opentracing "github.com/opentracing/opentracing-go"
+
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
@@ -52,7 +54,7 @@ func (*ResolvedTable) Children() []sql.Node { return nil }
 
 // RowIter implements the RowIter interface.
 func (t *ResolvedTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	span, ctx := ctx.Span("plan.ResolvedTable")
+	span, ctx := ctx.Span("plan.ResolvedTable", opentracing.Tag{Key: "table", Value: t.Name()})
 
 	partitions, err := t.Table.Partitions(ctx)
 	if err != nil {
@@ -60,7 +62,8 @@ func (t *ResolvedTable) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, err
 		return nil, err
 	}
 
-	return sql.NewSpanIter(span, sql.NewTableRowIter(ctx, t.Table, partitions)), nil
+	iter := newStatusCountingIter(sql.NewTableRowIter(ctx, t.Table, partitions), "Handler_read_rnd_next")
+	return sql.NewSpanIter(span, iter), nil
 }
 
 // WithChildren implements the Node interface.
@@ -19,6 +19,7 @@ import (
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/grant_tables"
 )
 
 // Revoke represents the statement REVOKE [privilege...] ON [item] FROM [user...].
@@ -27,6 +28,7 @@ type Revoke struct {
 	ObjectType     ObjectType
 	PrivilegeLevel PrivilegeLevel
 	Users          []UserName
+	GrantTables    sql.Database
 }
 
 // NewRevoke returns a new Revoke node.
@@ -36,10 +38,12 @@ func NewRevoke(privileges []Privilege, objType ObjectType, level PrivilegeLevel,
 		ObjectType:     objType,
 		PrivilegeLevel: level,
 		Users:          users,
+		GrantTables:    sql.UnresolvedDatabase("mysql"),
 	}
 }
 
 var _ sql.Node = (*Revoke)(nil)
+var _ sql.Databaser = (*Revoke)(nil)
 
 // Schema implements the interface sql.Node.
 func (n *Revoke) Schema() sql.Schema {
@@ -60,9 +64,22 @@ func (n *Revoke) String() string {
 		strings.Join(privileges, ", "), n.PrivilegeLevel.String(), strings.Join(users, ", "))
 }
 
+// Database implements the interface sql.Databaser.
+func (n *Revoke) Database() sql.Database {
+	return n.GrantTables
+}
+
+// WithDatabase implements the interface sql.Databaser.
+func (n *Revoke) WithDatabase(db sql.Database) (sql.Node, error) {
+	nn := *n
+	nn.GrantTables = db
+	return &nn, nil
+}
+
 // Resolved implements the interface sql.Node.
 func (n *Revoke) Resolved() bool {
-	return true
+	_, ok := n.GrantTables.(sql.UnresolvedDatabase)
+	return !ok
 }
 
 // Children implements the interface sql.Node.
@@ -80,22 +97,55 @@ func (n *Revoke) WithChildren(children ...sql.Node) (sql.Node, error) {
 
 // RowIter implements the interface sql.Node.
 func (n *Revoke) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	return nil, fmt.Errorf("not yet implemented")
+	grantTables, ok := n.GrantTables.(*grant_tables.GrantTables)
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New("mysql")
+	}
+	if n.ObjectType == ObjectType_Function || n.ObjectType == ObjectType_Procedure {
+		return nil, sql.ErrUnsupportedFeature.New("REVOKE on routines")
+	}
+	level, err := n.PrivilegeLevel.GrantTablesLevel(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userTableData := grantTables.UserTable().Data()
+	for _, user := range n.Users {
+		if len(userTableData.Get(grant_tables.UserPrimaryKey{Host: user.Host, User: user.Name})) == 0 {
+			return nil, sql.ErrNoSuchGrant.New(user.Name, user.Host)
+		}
+	}
+
+	for _, user := range n.Users {
+		for _, privilege := range n.Privileges {
+			err = grantTables.Revoke(user.Name, user.Host, level, privilege.GrantTablesPrivileges(level), privilege.Columns)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err = grantTables.Persist(ctx); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{sql.NewOkResult(0)}), nil
 }
 
 // RevokeAll represents the statement REVOKE ALL PRIVILEGES.
 type RevokeAll struct {
-	Users []UserName
+	Users       []UserName
+	GrantTables sql.Database
 }
 
 // NewRevokeAll returns a new RevokeAll node.
 func NewRevokeAll(users []UserName) *RevokeAll {
 	return &RevokeAll{
-		Users: users,
+		Users:       users,
+		GrantTables: sql.UnresolvedDatabase("mysql"),
 	}
 }
 
 var _ sql.Node = (*RevokeAll)(nil)
+var _ sql.Databaser = (*RevokeAll)(nil)
 
 // Schema implements the interface sql.Node.
 func (n *RevokeAll) Schema() sql.Schema {
@@ -111,9 +161,22 @@ func (n *RevokeAll) String() string {
 	return fmt.Sprintf("RevokeAll(From: %s)", strings.Join(users, ", "))
 }
 
+// Database implements the interface sql.Databaser.
+func (n *RevokeAll) Database() sql.Database {
+	return n.GrantTables
+}
+
+// WithDatabase implements the interface sql.Databaser.
+func (n *RevokeAll) WithDatabase(db sql.Database) (sql.Node, error) {
+	nn := *n
+	nn.GrantTables = db
+	return &nn, nil
+}
+
 // Resolved implements the interface sql.Node.
 func (n *RevokeAll) Resolved() bool {
-	return true
+	_, ok := n.GrantTables.(sql.UnresolvedDatabase)
+	return !ok
 }
 
 // Children implements the interface sql.Node.
@@ -131,7 +194,26 @@ func (n *RevokeAll) WithChildren(children ...sql.Node) (sql.Node, error) {
 
 // RowIter implements the interface sql.Node.
 func (n *RevokeAll) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	return nil, fmt.Errorf("not yet implemented")
+	grantTables, ok := n.GrantTables.(*grant_tables.GrantTables)
+	if !ok {
+		return nil, sql.ErrDatabaseNotFound.New("mysql")
+	}
+	userTableData := grantTables.UserTable().Data()
+	for _, user := range n.Users {
+		if len(userTableData.Get(grant_tables.UserPrimaryKey{Host: user.Host, User: user.Name})) == 0 {
+			return nil, sql.ErrNoSuchGrant.New(user.Name, user.Host)
+		}
+	}
+
+	for _, user := range n.Users {
+		if err := grantTables.RevokeAll(user.Name, user.Host); err != nil {
+			return nil, err
+		}
+	}
+	if err := grantTables.Persist(ctx); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{sql.NewOkResult(0)}), nil
 }
 
 // RevokeRole represents the statement REVOKE [role...] FROM [user...].
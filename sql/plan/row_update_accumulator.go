@@ -40,6 +40,14 @@ const (
 type RowUpdateAccumulator struct {
 	UnaryNode
 	RowUpdateType
+	// Statistics, if set, is where the rows changed in Target are counted once the statement is done, so that its
+	// statistics are refreshed when they become stale.
+	Statistics *sql.TableStatistics
+	// Statements, if set, is where the rows inserted, updated and deleted in Target are counted once the statement is
+	// done.
+	Statements *sql.StatementStatistics
+	// Target is the table changed by the statement, if it changes a single table.
+	Target *ResolvedTable
 }
 
 // NewRowUpdateResult returns a new RowUpdateResult with the given node to wrap.
@@ -60,7 +68,18 @@ func (r RowUpdateAccumulator) WithChildren(children ...sql.Node) (sql.Node, erro
 	if len(children) != 1 {
 		return nil, sql.ErrInvalidChildrenNumber.New(r, 1, len(children))
 	}
-	return NewRowUpdateAccumulator(children[0], r.RowUpdateType), nil
+	nr := r
+	nr.Child = children[0]
+	return &nr, nil
+}
+
+// WithStatistics returns a copy of the accumulator that counts the rows changed in the table given with the table
+// statistics and statement statistics given.
+func (r RowUpdateAccumulator) WithStatistics(stats *sql.TableStatistics, statements *sql.StatementStatistics, target *ResolvedTable) *RowUpdateAccumulator {
+	r.Statistics = stats
+	r.Statements = statements
+	r.Target = target
+	return &r
 }
 
 func (r RowUpdateAccumulator) String() string {
@@ -79,6 +98,12 @@ type accumulatorRowHandler interface {
 	okResult() sql.OkResult
 }
 
+// rowOperationCounter is implemented by the accumulatorRowHandlers of statements that change a single table, to count
+// the rows inserted, updated and deleted in it.
+type rowOperationCounter interface {
+	rowOperations() (inserted, updated, deleted uint64)
+}
+
 type insertRowHandler struct {
 	rowsAffected int
 }
@@ -88,6 +113,10 @@ func (i *insertRowHandler) handleRowUpdate(_ sql.Row) error {
 	return nil
 }
 
+func (i *insertRowHandler) rowOperations() (uint64, uint64, uint64) {
+	return uint64(i.rowsAffected), 0, 0
+}
+
 func (i *insertRowHandler) okResult() sql.OkResult {
 	// TODO: the auto inserted id should be in this result. Needs to be passed up by the insert iter, which is a larger
 	//  change.
@@ -96,6 +125,7 @@ func (i *insertRowHandler) okResult() sql.OkResult {
 
 type replaceRowHandler struct {
 	rowsAffected int
+	rowsDeleted  int
 }
 
 func (r *replaceRowHandler) handleRowUpdate(row sql.Row) error {
@@ -106,6 +136,7 @@ func (r *replaceRowHandler) handleRowUpdate(row sql.Row) error {
 	for i := 0; i < len(row)/2; i++ {
 		if row[i] != nil {
 			r.rowsAffected++
+			r.rowsDeleted++
 			break
 		}
 	}
@@ -113,12 +144,18 @@ func (r *replaceRowHandler) handleRowUpdate(row sql.Row) error {
 	return nil
 }
 
+func (r *replaceRowHandler) rowOperations() (uint64, uint64, uint64) {
+	return uint64(r.rowsAffected - r.rowsDeleted), 0, uint64(r.rowsDeleted)
+}
+
 func (r *replaceRowHandler) okResult() sql.OkResult {
 	return sql.NewOkResult(r.rowsAffected)
 }
 
 type onDuplicateUpdateHandler struct {
 	rowsAffected              int
+	rowsInserted              int
+	rowsUpdated               int
 	schema                    sql.Schema
 	clientFoundRowsCapability bool
 }
@@ -128,6 +165,7 @@ func (o *onDuplicateUpdateHandler) handleRowUpdate(row sql.Row) error {
 	// If a row was inserted, increment by 1
 	if len(row) == len(o.schema) {
 		o.rowsAffected++
+		o.rowsInserted++
 		return nil
 	}
 
@@ -142,6 +180,7 @@ func (o *onDuplicateUpdateHandler) handleRowUpdate(row sql.Row) error {
 			}
 		} else {
 			o.rowsAffected += 2
+			o.rowsUpdated++
 		}
 	} else {
 		o.rowsAffected++
@@ -154,6 +193,10 @@ func (o *onDuplicateUpdateHandler) okResult() sql.OkResult {
 	return sql.NewOkResult(o.rowsAffected)
 }
 
+func (o *onDuplicateUpdateHandler) rowOperations() (uint64, uint64, uint64) {
+	return uint64(o.rowsInserted), uint64(o.rowsUpdated), 0
+}
+
 type updateRowHandler struct {
 	rowsMatched               int
 	rowsAffected              int
@@ -194,6 +237,10 @@ func (u *updateRowHandler) RowsMatched() int64 {
 	return int64(u.rowsMatched)
 }
 
+func (u *updateRowHandler) rowOperations() (uint64, uint64, uint64) {
+	return 0, uint64(u.rowsAffected), 0
+}
+
 // updateJoinRowHandler handles row update count for all UPDATEs that use a JOIN.
 type updateJoinRowHandler struct {
 	rowsMatched  int
@@ -269,10 +316,17 @@ func (u *deleteRowHandler) okResult() sql.OkResult {
 	return sql.NewOkResult(u.rowsAffected)
 }
 
+func (u *deleteRowHandler) rowOperations() (uint64, uint64, uint64) {
+	return 0, 0, uint64(u.rowsAffected)
+}
+
 type accumulatorIter struct {
 	iter             sql.RowIter
 	once             sync.Once
 	updateRowHandler accumulatorRowHandler
+	stats            *sql.TableStatistics
+	statements       *sql.StatementStatistics
+	target           *ResolvedTable
 }
 
 func (a *accumulatorIter) Next(ctx *sql.Context) (r sql.Row, err error) {
@@ -328,6 +382,8 @@ func (a *accumulatorIter) Next(ctx *sql.Context) (r sql.Row, err error) {
 				ctx.Session.SetLastQueryInfo(sql.LastInsertId, oldLastInsertId)
 			}
 
+			a.recordChanges(ctx, res.RowsAffected)
+
 			return sql.NewRow(res), nil
 		} else if isIg {
 			continue
@@ -346,6 +402,33 @@ func (a *accumulatorIter) Close(ctx *sql.Context) error {
 	return nil
 }
 
+// recordChanges counts the rows inserted, updated and deleted in the target table, and the rows changed towards its
+// statistics becoming stale, refreshing them if they are. Failing to refresh them doesn't fail the statement, which
+// already changed the table.
+func (a *accumulatorIter) recordChanges(ctx *sql.Context, rows uint64) {
+	if a.target == nil || a.target.Database == nil {
+		return
+	}
+
+	db := a.target.Database.Name()
+	if counter, ok := a.updateRowHandler.(rowOperationCounter); ok && a.statements != nil {
+		inserted, updated, deleted := counter.rowOperations()
+		a.statements.RecordTableRows(db, a.target.Name(), inserted, updated, deleted)
+	}
+
+	if a.stats == nil || rows == 0 {
+		return
+	}
+	table, ok := GetAnalyzableTable(a.target.Table)
+	if !ok {
+		return
+	}
+	a.stats.RecordChanges(db, table.Name(), rows)
+	if _, err := a.stats.RefreshIfStale(ctx, db, table); err != nil {
+		ctx.GetLogger().WithField("table", fmt.Sprintf("%s.%s", db, table.Name())).Warnf("unable to refresh stale table statistics: %s", err)
+	}
+}
+
 type matchingAccumulator interface {
 	RowsMatched() int64
 }
@@ -401,5 +484,8 @@ func (r RowUpdateAccumulator) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIte
 	return &accumulatorIter{
 		iter:             rowIter,
 		updateRowHandler: rowHandler,
+		stats:            r.Statistics,
+		statements:       r.Statements,
+		target:           r.Target,
 	}, nil
 }
@@ -0,0 +1,119 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// SchemaChanges returns the changes to tables and databases that the analyzed DDL statement given makes, naming the
+// tables changed but without their schemas. Statements that don't change tables or databases, including those on
+// temporary tables, return no changes.
+func SchemaChanges(ctx *sql.Context, node sql.Node) []sql.SchemaChange {
+	var changes []sql.SchemaChange
+	add := func(change sql.SchemaChange) {
+		// A statement with several ALTER TABLE clauses changes the table once
+		if change.Kind == sql.SchemaChangeKind_AlterTable {
+			for _, c := range changes {
+				if c.Kind == change.Kind && c.Database == change.Database && c.Table == change.Table {
+					return
+				}
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	var walk func(node sql.Node)
+	walk = func(node sql.Node) {
+		switch n := node.(type) {
+		case *QueryProcess:
+			walk(n.Child)
+		case *Block:
+			for _, s := range n.statements {
+				walk(s)
+			}
+		case *CreateDB:
+			add(sql.SchemaChange{Kind: sql.SchemaChangeKind_CreateDatabase, Database: n.dbName, NewDatabase: n.dbName})
+		case *DropDB:
+			add(sql.SchemaChange{Kind: sql.SchemaChangeKind_DropDatabase, Database: n.dbName})
+		case *CreateTable:
+			if n.temporary == IsTempTable {
+				return
+			}
+			db := n.db.Name()
+			add(sql.SchemaChange{Kind: sql.SchemaChangeKind_CreateTable, Database: db, Table: n.name, NewDatabase: db, NewTable: n.name})
+		case *DropTable:
+			for _, name := range n.names {
+				add(sql.SchemaChange{Kind: sql.SchemaChangeKind_DropTable, Database: n.db.Name(), Table: name})
+			}
+		case *RenameTable:
+			db := n.db.Name()
+			for i, name := range n.oldNames {
+				add(sql.SchemaChange{Kind: sql.SchemaChangeKind_RenameTable, Database: db, Table: name, NewDatabase: db, NewTable: n.newNames[i]})
+			}
+		case *MultiRenameTable:
+			for _, rename := range n.Renames {
+				add(sql.SchemaChange{
+					Kind:        sql.SchemaChangeKind_RenameTable,
+					Database:    databaseOrCurrent(ctx, rename.OldDatabase),
+					Table:       rename.OldName,
+					NewDatabase: databaseOrCurrent(ctx, rename.NewDatabase),
+					NewTable:    rename.NewName,
+				})
+			}
+		case *CreateForeignKey:
+			db := n.db.Name()
+			add(sql.SchemaChange{Kind: sql.SchemaChangeKind_AlterTable, Database: db, Table: n.Table, NewDatabase: db, NewTable: n.Table})
+		case *AddColumn, *DropColumn, *ModifyColumn, *RenameColumn, *AlterIndex, *AlterPK, *AlterAutoIncrement,
+			*AlterDefaultSet, *AlterDefaultDrop, *CreateCheck, *DropCheck, *DropForeignKey:
+			if change, ok := alterTableChange(ctx, n.Children()); ok {
+				add(change)
+			}
+		}
+	}
+	walk(node)
+
+	return changes
+}
+
+// alterTableChange returns the change to the table that is the first of the nodes given.
+func alterTableChange(ctx *sql.Context, children []sql.Node) (sql.SchemaChange, bool) {
+	if len(children) == 0 {
+		return sql.SchemaChange{}, false
+	}
+
+	var rt *ResolvedTable
+	switch n := children[0].(type) {
+	case *ResolvedTable:
+		rt = n
+	case *IndexedTableAccess:
+		rt = n.ResolvedTable
+	default:
+		return sql.SchemaChange{}, false
+	}
+
+	db := ctx.GetCurrentDatabase()
+	if rt.Database != nil {
+		db = rt.Database.Name()
+	}
+	return sql.SchemaChange{Kind: sql.SchemaChangeKind_AlterTable, Database: db, Table: rt.Name(), NewDatabase: db, NewTable: rt.Name()}, true
+}
+
+func databaseOrCurrent(ctx *sql.Context, db string) string {
+	if db == "" {
+		return ctx.GetCurrentDatabase()
+	}
+	return db
+}
@@ -132,11 +132,42 @@ func setUserVar(ctx *sql.Context, userVar *expression.UserVar, right sql.Express
 	return nil
 }
 
+// mergeFeatureSwitches returns the value given for optimizer_switch merged with its current value in the scope of the
+// variable, so that the flags the value doesn't mention keep their values.
+func mergeFeatureSwitches(ctx *sql.Context, sysVar *expression.SystemVar, val interface{}) (interface{}, error) {
+	changes, ok := val.(string)
+	if !ok {
+		return val, nil
+	}
+
+	var current interface{}
+	switch sysVar.Scope {
+	case sql.SystemVariableScope_Session:
+		var err error
+		current, err = ctx.GetSessionVariable(ctx, sysVar.Name)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		_, current, _ = sql.SystemVariables.GetGlobal(sysVar.Name)
+	}
+	if s, ok := current.(string); ok {
+		return sql.MergeFeatureSwitches(s, changes), nil
+	}
+	return changes, nil
+}
+
 func setSystemVar(ctx *sql.Context, sysVar *expression.SystemVar, right sql.Expression, row sql.Row) error {
 	val, err := right.Eval(ctx, row)
 	if err != nil {
 		return err
 	}
+	if strings.ToLower(sysVar.Name) == sql.FeatureFlagsSysVar {
+		val, err = mergeFeatureSwitches(ctx, sysVar, val)
+		if err != nil {
+			return err
+		}
+	}
 	switch sysVar.Scope {
 	case sql.SystemVariableScope_Global:
 		err = sql.SystemVariables.SetGlobal(sysVar.Name, val)
@@ -22,6 +22,7 @@ import (
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
 )
 
 var ErrNotView = errors.NewKind("'%' is not VIEW")
@@ -191,25 +192,38 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 	var primaryKeyCols []string
 
 	// Statement creation parts for each column
-	// TODO: rather than lower-casing here, we should do it in the String() method of types
 	for i, col := range schema {
-		stmt := fmt.Sprintf("  `%s` %s", col.Name, strings.ToLower(col.Type.String()))
+		stmt := fmt.Sprintf("  %s %s", quoteIdentifier(col.Name), columnTypeString(col.Type))
 
 		if !col.Nullable {
 			stmt = fmt.Sprintf("%s NOT NULL", stmt)
 		}
 
+		if st, ok := col.Type.(sql.SpatialColumnType); ok {
+			if srid, defined := st.GetSpatialTypeSRID(); defined {
+				stmt = fmt.Sprintf("%s /*!80003 SRID %d */", stmt, srid)
+			}
+		}
+
 		if col.AutoIncrement {
 			stmt = fmt.Sprintf("%s AUTO_INCREMENT", stmt)
 		}
 
 		// TODO: The columns that are rendered in defaults should be backticked
 		if col.Default != nil {
-			stmt = fmt.Sprintf("%s DEFAULT %s", stmt, col.Default.String())
+			def, err := formatColumnDefault(col.Default)
+			if err != nil {
+				return "", err
+			}
+			stmt = fmt.Sprintf("%s DEFAULT %s", stmt, def)
+		}
+
+		if col.OnUpdate != nil {
+			stmt = fmt.Sprintf("%s ON UPDATE %s", stmt, col.OnUpdate.String())
 		}
 
 		if col.Comment != "" {
-			stmt = fmt.Sprintf("%s COMMENT '%s'", stmt, col.Comment)
+			stmt = fmt.Sprintf("%s COMMENT %s", stmt, quoteString(col.Comment))
 		}
 
 		if col.PrimaryKey {
@@ -236,47 +250,46 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 		for _, expr := range index.Expressions() {
 			col := GetColumnFromIndexExpr(expr, table)
 			if col != nil {
-				indexCols = append(indexCols, fmt.Sprintf("`%s`", col.Name))
+				indexCols = append(indexCols, quoteIdentifier(col.Name))
 			}
 		}
 
 		unique := ""
 		if index.IsUnique() {
 			unique = "UNIQUE "
+		} else if _, ok := index.(sql.SpatialIndex); ok {
+			unique = "SPATIAL "
 		}
 
-		key := fmt.Sprintf("  %sKEY `%s` (%s)", unique, index.ID(), strings.Join(indexCols, ","))
+		key := fmt.Sprintf("  %sKEY %s (%s)", unique, quoteIdentifier(index.ID()), strings.Join(indexCols, ","))
 		if index.Comment() != "" {
-			key = fmt.Sprintf("%s COMMENT '%s'", key, index.Comment())
+			key = fmt.Sprintf("%s COMMENT %s", key, quoteString(index.Comment()))
 		}
 
 		colStmts = append(colStmts, key)
 	}
 
-	fkt := getForeignKeyTable(table)
-	if fkt != nil {
-		fks, err := fkt.GetForeignKeys(ctx)
-		if err != nil {
-			return "", err
+	fks, err := sql.GetForeignKeys(ctx, table)
+	if err != nil {
+		return "", err
+	}
+	for _, fk := range fks {
+		keyCols := strings.Join(quoteIdentifiers(fk.Columns), ",")
+		refCols := strings.Join(quoteIdentifiers(fk.ReferencedColumns), ",")
+		onDelete := ""
+		if len(fk.OnDelete) > 0 && fk.OnDelete != sql.ForeignKeyReferenceOption_DefaultAction {
+			onDelete = " ON DELETE " + string(fk.OnDelete)
 		}
-		for _, fk := range fks {
-			keyCols := strings.Join(quoteIdentifiers(fk.Columns), ",")
-			refCols := strings.Join(quoteIdentifiers(fk.ReferencedColumns), ",")
-			onDelete := ""
-			if len(fk.OnDelete) > 0 && fk.OnDelete != sql.ForeignKeyReferenceOption_DefaultAction {
-				onDelete = " ON DELETE " + string(fk.OnDelete)
-			}
-			onUpdate := ""
-			if len(fk.OnUpdate) > 0 && fk.OnUpdate != sql.ForeignKeyReferenceOption_DefaultAction {
-				onUpdate = " ON UPDATE " + string(fk.OnUpdate)
-			}
-			colStmts = append(colStmts, fmt.Sprintf("  CONSTRAINT `%s` FOREIGN KEY (%s) REFERENCES `%s` (%s)%s%s", fk.Name, keyCols, fk.ReferencedTable, refCols, onDelete, onUpdate))
+		onUpdate := ""
+		if len(fk.OnUpdate) > 0 && fk.OnUpdate != sql.ForeignKeyReferenceOption_DefaultAction {
+			onUpdate = " ON UPDATE " + string(fk.OnUpdate)
 		}
+		colStmts = append(colStmts, fmt.Sprintf("  CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)%s%s", quoteIdentifier(fk.Name), keyCols, quoteIdentifier(fk.ReferencedTable), refCols, onDelete, onUpdate))
 	}
 
 	if i.checks != nil {
 		for _, check := range i.checks {
-			fmted := fmt.Sprintf("  CONSTRAINT `%s` CHECK (%s)", check.Name, check.Expr.String())
+			fmted := fmt.Sprintf("  CONSTRAINT %s CHECK (%s)", quoteIdentifier(check.Name), check.Expr.String())
 
 			if !check.Enforced {
 				fmted += " /*!80016 NOT ENFORCED */"
@@ -286,33 +299,167 @@ func (i *showCreateTablesIter) produceCreateTableStatement(ctx *sql.Context, tab
 		}
 	}
 
+	tableOptions, err := produceTableOptions(ctx, table)
+	if err != nil {
+		return "", err
+	}
+
 	return fmt.Sprintf(
-		"CREATE TABLE `%s` (\n%s\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4",
-		table.Name(),
+		"CREATE TABLE %s (\n%s\n) %s",
+		quoteIdentifier(table.Name()),
 		strings.Join(colStmts, ",\n"),
+		tableOptions,
 	), nil
 }
 
-// getForeignKeyTable returns the underlying ForeignKeyTable for the table given, or nil if it isn't a ForeignKeyTable
-func getForeignKeyTable(t sql.Table) sql.ForeignKeyTable {
+// produceTableOptions returns the table options clause of the CREATE TABLE statement for the table given. Like MySQL,
+// the AUTO_INCREMENT option is only shown once the sequence is past its first value, and the collation only when it
+// isn't the default collation.
+func produceTableOptions(ctx *sql.Context, table sql.Table) (string, error) {
+	options := sql.TableOptions{Collation: sql.Collation_Default}
+	if ot := getTableOptionsTable(table); ot != nil {
+		var err error
+		options, err = ot.GetTableOptions(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	clause := "ENGINE=InnoDB"
+	if autoTbl := getAutoIncrementTable(table); autoTbl != nil && table.Schema().HasAutoIncrement() {
+		next, err := autoTbl.PeekNextAutoIncrementValue(ctx)
+		if err != nil {
+			return "", err
+		}
+		if next, err := sql.Uint64.Convert(next); err == nil && next.(uint64) > 1 {
+			clause = fmt.Sprintf("%s AUTO_INCREMENT=%d", clause, next)
+		}
+	}
+
+	clause = fmt.Sprintf("%s DEFAULT CHARSET=%s", clause, options.Collation.CharacterSet())
+	if options.Collation != sql.Collation_Default {
+		clause = fmt.Sprintf("%s COLLATE=%s", clause, options.Collation)
+	}
+
+	if options.RowFormat != "" {
+		clause = fmt.Sprintf("%s ROW_FORMAT=%s", clause, options.RowFormat)
+	}
+	if options.Comment != "" {
+		clause = fmt.Sprintf("%s COMMENT=%s", clause, quoteString(options.Comment))
+	}
+	if options.EngineAttribute != "" {
+		clause = fmt.Sprintf("%s ENGINE_ATTRIBUTE=%s", clause, quoteString(options.EngineAttribute))
+	}
+	if options.SecondaryEngineAttribute != "" {
+		clause = fmt.Sprintf("%s SECONDARY_ENGINE_ATTRIBUTE=%s", clause, quoteString(options.SecondaryEngineAttribute))
+	}
+
+	return clause, nil
+}
+
+// columnTypeString returns the type given as it's shown in a column definition. The type is lower case, except for its
+// quoted values, which keep their case, and its character set and collation clauses.
+func columnTypeString(typ sql.Type) string {
+	s := typ.String()
+	var sb strings.Builder
+	for len(s) > 0 {
+		quote := strings.IndexByte(s, '\'')
+		if quote == -1 {
+			quote = len(s)
+		}
+		unquoted := strings.ToLower(s[:quote])
+		unquoted = strings.ReplaceAll(unquoted, " character set ", " CHARACTER SET ")
+		unquoted = strings.ReplaceAll(unquoted, " collate ", " COLLATE ")
+		sb.WriteString(unquoted)
+		s = s[quote:]
+		if len(s) == 0 {
+			break
+		}
+
+		// Quotes in quoted values are escaped by doubling them
+		end := 1
+		for end < len(s) {
+			if s[end] == '\'' {
+				if end+1 < len(s) && s[end+1] == '\'' {
+					end += 2
+					continue
+				}
+				break
+			}
+			end++
+		}
+		if end < len(s) {
+			end++
+		}
+		sb.WriteString(s[:end])
+		s = s[end:]
+	}
+	return sb.String()
+}
+
+// getAutoIncrementTable returns the underlying AutoIncrementTable for the table given, or nil if it isn't an
+// AutoIncrementTable
+func getAutoIncrementTable(t sql.Table) sql.AutoIncrementTable {
+	switch t := t.(type) {
+	case sql.AutoIncrementTable:
+		return t
+	case sql.TableWrapper:
+		return getAutoIncrementTable(t.Underlying())
+	default:
+		return nil
+	}
+}
+
+// getTableOptionsTable returns the underlying TableOptionsTable for the table given, or nil if it isn't a
+// TableOptionsTable
+func getTableOptionsTable(t sql.Table) sql.TableOptionsTable {
 	switch t := t.(type) {
-	case sql.ForeignKeyTable:
+	case sql.TableOptionsTable:
 		return t
 	case sql.TableWrapper:
-		return getForeignKeyTable(t.Underlying())
+		return getTableOptionsTable(t.Underlying())
 	default:
 		return nil
 	}
 }
 
+// formatColumnDefault returns the default value given as it's written in a column definition, with the names of the
+// columns it refers to quoted where they must be.
+func formatColumnDefault(def *sql.ColumnDefaultValue) (string, error) {
+	quoted, err := expression.TransformUp(def, func(e sql.Expression) (sql.Expression, error) {
+		switch e := e.(type) {
+		case *expression.GetField:
+			return expression.NewGetFieldWithTable(e.Index(), e.Type(), e.Table(), sql.FormatIdentifier(e.Name()), e.IsNullable()), nil
+		case *expression.UnresolvedColumn:
+			return expression.NewUnresolvedQualifiedColumn(e.Table(), sql.FormatIdentifier(e.Name())), nil
+		default:
+			return e, nil
+		}
+	})
+	if err != nil {
+		return "", err
+	}
+	return quoted.String(), nil
+}
+
 func quoteIdentifiers(ids []string) []string {
 	quoted := make([]string, len(ids))
 	for i, id := range ids {
-		quoted[i] = fmt.Sprintf("`%s`", id)
+		quoted[i] = quoteIdentifier(id)
 	}
 	return quoted
 }
 
+// quoteIdentifier returns the identifier given quoted with backticks, escaping any backticks it contains.
+func quoteIdentifier(id string) string {
+	return "`" + strings.ReplaceAll(id, "`", "``") + "`"
+}
+
+// quoteString returns the string given as a single-quoted string literal, escaping any single quotes it contains.
+func quoteString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
 // isPrimaryKeyIndex returns whether the index given matches the table's primary key columns. Order is not considered.
 func isPrimaryKeyIndex(index sql.Index, table sql.Table) bool {
 	var pks []*sql.Column
@@ -349,8 +496,8 @@ func isPrimaryKeyIndex(index sql.Index, table sql.Table) bool {
 
 func produceCreateViewStatement(view *SubqueryAlias) string {
 	return fmt.Sprintf(
-		"CREATE VIEW `%s` AS %s",
-		view.Name(),
+		"CREATE VIEW %s AS %s",
+		quoteIdentifier(view.Name()),
 		view.TextDefinition,
 	)
 }
@@ -153,6 +153,46 @@ func TestShowCreateTableWithIndexAndForeignKeysAndChecks(t *testing.T) {
 	require.Equal(expected, row)
 }
 
+func TestShowCreateTableWithTableOptions(t *testing.T) {
+	var require = require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	schema := sql.Schema{
+		&sql.Column{Name: "pk", Type: sql.Int64, Nullable: false, PrimaryKey: true, AutoIncrement: true},
+		&sql.Column{Name: "e", Type: sql.MustCreateEnumType([]string{"A", "b'c"}, sql.Collation_Default), Nullable: true},
+		&sql.Column{Name: "s", Type: sql.MustCreateString(sqltypes.VarChar, 10, sql.Collation_utf8mb4_bin), Nullable: true},
+	}
+	table := memory.NewTable("test-table", sql.NewPrimaryKeySchema(schema))
+	require.NoError(table.SetTableOptions(ctx, sql.TableOptions{
+		Collation:       sql.Collation_utf8mb4_bin,
+		Comment:         "it's a table",
+		RowFormat:       "COMPRESSED",
+		EngineAttribute: `{"a": 1}`,
+	}))
+	setter := table.AutoIncrementSetter(ctx)
+	require.NoError(setter.SetAutoIncrementValue(ctx, int64(42)))
+	require.NoError(setter.Close(ctx))
+
+	showCreateTable, err := NewShowCreateTable(NewResolvedTable(table, nil, nil), false).WithTargetSchema(schema)
+	require.NoError(err)
+
+	rowIter, _ := showCreateTable.RowIter(ctx, nil)
+	row, err := rowIter.Next(ctx)
+	require.NoError(err)
+
+	expected := sql.NewRow(
+		table.Name(),
+		"CREATE TABLE `test-table` (\n"+
+			"  `pk` bigint NOT NULL AUTO_INCREMENT,\n"+
+			"  `e` enum('A','b''c'),\n"+
+			"  `s` varchar(10) COLLATE utf8mb4_bin,\n"+
+			"  PRIMARY KEY (`pk`)\n"+
+			") ENGINE=InnoDB AUTO_INCREMENT=42 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin ROW_FORMAT=COMPRESSED COMMENT='it''s a table' ENGINE_ATTRIBUTE='{\"a\": 1}'",
+	)
+
+	require.Equal(expected, row)
+}
+
 func TestShowCreateView(t *testing.T) {
 	var require = require.New(t)
 	ctx := sql.NewEmptyContext()
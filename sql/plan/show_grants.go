@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/grant_tables"
 )
 
 // ShowGrants represents the statement SHOW GRANTS.
@@ -25,6 +26,7 @@ type ShowGrants struct {
 	CurrentUser bool
 	For         *UserName
 	Using       []UserName
+	GrantTables sql.Database
 }
 
 // NewShowGrants returns a new ShowGrants node.
@@ -33,10 +35,12 @@ func NewShowGrants(currentUser bool, targetUser *UserName, using []UserName) *Sh
 		CurrentUser: currentUser,
 		For:         targetUser,
 		Using:       using,
+		GrantTables: sql.UnresolvedDatabase("mysql"),
 	}
 }
 
 var _ sql.Node = (*ShowGrants)(nil)
+var _ sql.Databaser = (*ShowGrants)(nil)
 
 // Schema implements the interface sql.Node.
 func (n *ShowGrants) Schema() sql.Schema {
@@ -67,9 +71,22 @@ func (n *ShowGrants) String() string {
 	return fmt.Sprintf("ShowGrants(%s)", user.StringWithQuote("", ""))
 }
 
+// Database implements the interface sql.Databaser.
+func (n *ShowGrants) Database() sql.Database {
+	return n.GrantTables
+}
+
+// WithDatabase implements the interface sql.Databaser.
+func (n *ShowGrants) WithDatabase(db sql.Database) (sql.Node, error) {
+	nn := *n
+	nn.GrantTables = db
+	return &nn, nil
+}
+
 // Resolved implements the interface sql.Node.
 func (n *ShowGrants) Resolved() bool {
-	return true
+	_, ok := n.GrantTables.(sql.UnresolvedDatabase)
+	return !ok
 }
 
 // Children implements the interface sql.Node.
@@ -87,7 +104,12 @@ func (n *ShowGrants) WithChildren(children ...sql.Node) (sql.Node, error) {
 
 // RowIter implements the interface sql.Node.
 func (n *ShowGrants) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	//TODO: actually show grants here
+	grantTables, ok := n.GrantTables.(*grant_tables.GrantTables)
+	if ok && grantTables.Enabled {
+		return n.grantTablesRowIter(ctx, grantTables)
+	}
+
+	// Without grant tables every user has every privilege
 	user := n.For
 	if user == nil {
 		user = &UserName{
@@ -99,3 +121,32 @@ func (n *ShowGrants) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error)
 	return sql.RowsToRowIter(sql.Row{
 		fmt.Sprintf("GRANT ALL PRIVILEGES ON *.* TO %s WITH GRANT OPTION", user.StringWithQuote("'", ""))}), nil
 }
+
+// grantTablesRowIter returns the grants of the user from the grant tables given. Without a user, the grants of the
+// account that the statement executes as are returned.
+func (n *ShowGrants) grantTablesRowIter(ctx *sql.Context, grantTables *grant_tables.GrantTables) (sql.RowIter, error) {
+	if len(n.Using) > 0 {
+		return nil, sql.ErrUnsupportedFeature.New("SHOW GRANTS ... USING")
+	}
+	var user, host string
+	if n.For != nil {
+		user, host = n.For.Name, n.For.Host
+	} else {
+		sc := ctx.SecurityContext()
+		accountHost, ok := grantTables.MatchAccount(sc.User, sc.Host)
+		if !ok {
+			return nil, sql.ErrNoSuchGrant.New(sc.User, sc.Host)
+		}
+		user, host = sc.User, accountHost
+	}
+
+	grants, err := grantTables.ShowGrants(user, host)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([]sql.Row, len(grants))
+	for i, grant := range grants {
+		rows[i] = sql.Row{grant}
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
@@ -15,14 +15,15 @@
 package plan
 
 import (
+	"strconv"
+
 	"github.com/dolthub/vitess/go/sqltypes"
 
 	"github.com/dolthub/go-mysql-server/sql"
 )
 
-// ShowStatus implements the SHOW STATUS MySQL command.
-// TODO: This is just a stub implementation that returns an empty set. The actual functionality needs to be implemented
-// in the future.
+// ShowStatus implements the SHOW STATUS MySQL command, which returns the values of the status variables of the
+// engine.
 type ShowStatus struct {
 	modifier ShowStatusModifier
 }
@@ -66,10 +67,119 @@ func (s *ShowStatus) Children() []sql.Node {
 
 // RowIter implements sql.Node interface.
 func (s *ShowStatus) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
-	return sql.RowsToRowIter(), nil
+	var values []sql.StatusValue
+	if s.modifier == ShowStatusModifier_Global {
+		values = ctx.StatusVariables().Global()
+	} else {
+		values = ctx.StatusVariables().Session(ctx.ID())
+	}
+
+	rows := make([]sql.Row, len(values))
+	for i, value := range values {
+		rows[i] = sql.Row{value.Name, strconv.FormatInt(value.Value, 10)}
+	}
+	return sql.RowsToRowIter(rows...), nil
 }
 
 // WithChildren implements sql.Node interface.
 func (s *ShowStatus) WithChildren(node ...sql.Node) (sql.Node, error) {
 	return NewShowStatus(s.modifier), nil
 }
+
+// StatementStatusVariable returns the name of the Com_xxx status variable that counts the statements of the kind of
+// the parsed node given, or an empty string if there is none.
+func StatementStatusVariable(n sql.Node) string {
+	switch n := n.(type) {
+	case *Filter:
+		// SHOW statements with a LIKE or WHERE clause are parsed as a filter of their rows
+		if v := StatementStatusVariable(n.Child); v != "" {
+			return v
+		}
+		return "Com_select"
+	case *Project, *Limit, *Offset, *Sort, *GroupBy, *Having, *Distinct, *Union, *With, *Window:
+		return "Com_select"
+	case *InsertInto:
+		if n.IsReplace {
+			return "Com_replace"
+		}
+		if _, ok := n.Source.(*Values); ok {
+			return "Com_insert"
+		}
+		return "Com_insert_select"
+	case *Update:
+		return "Com_update"
+	case *DeleteFrom:
+		return "Com_delete"
+	case *CreateTable:
+		return "Com_create_table"
+	case *DropTable:
+		return "Com_drop_table"
+	case *AddColumn, *DropColumn, *ModifyColumn, *RenameColumn, *AlterPK, *Block:
+		return "Com_alter_table"
+	case *CreateIndex:
+		return "Com_create_index"
+	case *DropIndex:
+		return "Com_drop_index"
+	case *CreateDB:
+		return "Com_create_db"
+	case *DropDB:
+		return "Com_drop_db"
+	case *CreateView:
+		return "Com_create_view"
+	case *DropView:
+		return "Com_drop_view"
+	case *Truncate:
+		return "Com_truncate"
+	case *Set:
+		return "Com_set_option"
+	case *Use:
+		return "Com_use"
+	case *ShowDatabases:
+		return "Com_show_databases"
+	case *ShowTables:
+		return "Com_show_tables"
+	case *ShowVariables:
+		return "Com_show_variables"
+	case *ShowStatus:
+		return "Com_show_status"
+	case *StartTransaction:
+		return "Com_begin"
+	case *Commit:
+		return "Com_commit"
+	case *Rollback:
+		return "Com_rollback"
+	case *Call:
+		return "Com_call_procedure"
+	default:
+		return ""
+	}
+}
+
+// statusCountingIter counts the rows returned by a RowIter, adding their number to a status variable when it's closed.
+type statusCountingIter struct {
+	iter  sql.RowIter
+	name  string
+	count int64
+}
+
+var _ sql.RowIter = (*statusCountingIter)(nil)
+
+func newStatusCountingIter(iter sql.RowIter, name string) *statusCountingIter {
+	return &statusCountingIter{iter: iter, name: name}
+}
+
+// Next implements the sql.RowIter interface.
+func (i *statusCountingIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := i.iter.Next(ctx)
+	if err == nil {
+		i.count++
+	}
+	return row, err
+}
+
+// Close implements the sql.RowIter interface.
+func (i *statusCountingIter) Close(ctx *sql.Context) error {
+	ctx.StatusVariables().Increment(ctx, i.name, i.count)
+	i.count = 0
+	return i.iter.Close(ctx)
+}
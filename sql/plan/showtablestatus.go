@@ -80,11 +80,14 @@ func (s *ShowTableStatus) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, e
 		return nil, err
 	}
 
-	var rows = make([]sql.Row, len(tables))
+	var rows = make([]sql.Row, 0, len(tables))
 
-	for i, tName := range tables {
+	for _, tName := range tables {
 		table, _, err := s.Catalog.Table(ctx, s.db.Name(), tName)
-		if err != nil {
+		if sql.ErrTableNotFound.Is(err) {
+			// The table was dropped by another session since its name was listed
+			continue
+		} else if err != nil {
 			return nil, err
 		}
 
@@ -107,7 +110,7 @@ func (s *ShowTableStatus) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, e
 			return nil, err
 		}
 
-		rows[i] = tableToStatusRow(tName, numRows, nextAIVal, dataLength)
+		rows = append(rows, tableToStatusRow(tName, numRows, nextAIVal, dataLength))
 	}
 
 	return sql.RowsToRowIter(rows...), nil
@@ -18,7 +18,6 @@ import (
 	"container/heap"
 	"fmt"
 	"io"
-	"sort"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -126,6 +125,10 @@ type sortIter struct {
 	childIter  sql.RowIter
 	sortedRows []sql.Row
 	idx        int
+	// merge is the merge of the sorted runs of rows spilled to disk, when they didn't fit in memory, and dispose
+	// removes the file they were spilled to.
+	merge   *sortedRunsHeap
+	dispose sql.DisposeFunc
 }
 
 func newSortIter(ctx *sql.Context, s *Sort, child sql.RowIter) *sortIter {
@@ -145,6 +148,17 @@ func (i *sortIter) Next(ctx *sql.Context) (sql.Row, error) {
 		i.idx = 0
 	}
 
+	if i.merge != nil {
+		if i.merge.Len() == 0 {
+			return nil, io.EOF
+		}
+		row := i.merge.next()
+		if i.merge.LastError != nil {
+			return nil, i.merge.LastError
+		}
+		return row, nil
+	}
+
 	if i.idx >= len(i.sortedRows) {
 		return nil, io.EOF
 	}
@@ -155,13 +169,23 @@ func (i *sortIter) Next(ctx *sql.Context) (sql.Row, error) {
 
 func (i *sortIter) Close(ctx *sql.Context) error {
 	i.sortedRows = nil
+	i.merge = nil
+	if i.dispose != nil {
+		i.dispose()
+		i.dispose = nil
+	}
 	return i.childIter.Close(ctx)
 }
 
+// computeSortedRows reads and sorts the rows of the child. Once the rows read take more memory than the memory manager
+// allows, they're sorted and spilled to disk as a run, and the runs are merged once all the rows are read. The rows are
+// all kept in memory when the spill_to_disk feature flag is off, and the remaining ones are once a row can't be
+// spilled.
 func (i *sortIter) computeSortedRows(ctx *sql.Context) error {
-	cache, dispose := ctx.Memory.NewRowsCache()
-	defer dispose()
-
+	var rows []sql.Row
+	var size uint64
+	var spill *sql.RowSpill
+	canSpill := ctx.FeatureEnabled(sql.FeatureSpillToDisk)
 	for {
 		row, err := i.childIter.Next(ctx)
 
@@ -172,24 +196,66 @@ func (i *sortIter) computeSortedRows(ctx *sql.Context) error {
 			return err
 		}
 
-		if err := cache.Add(row); err != nil {
+		rows = append(rows, row)
+		size += sql.EstimatedRowSize(row)
+		if !canSpill || !ctx.Memory.ShouldSpill(size) {
+			continue
+		}
+
+		if spill == nil {
+			spill, i.dispose, err = ctx.Memory.NewRowSpill()
+			if err != nil {
+				return err
+			}
+		}
+		if err := i.sortRows(ctx, rows); err != nil {
+			return err
+		}
+		if err := spill.WriteRun(ctx, rows); err != nil {
+			if !sql.ErrUnspillableValue.Is(err) {
+				return err
+			}
+			// The rows can't be written to disk, so the rest of them are sorted in memory
+			canSpill = false
+			continue
+		}
+		rows, size = nil, 0
+	}
+
+	if err := i.sortRows(ctx, rows); err != nil {
+		return err
+	}
+	if spill == nil || spill.NumRuns() == 0 {
+		i.sortedRows = rows
+		return nil
+	}
+
+	// The runs are numbered in the order of their rows, so that rows that sort the same are returned in that order
+	var runs []sortedRun
+	for n := 0; n < spill.NumRuns(); n++ {
+		run := sortedRun{partition: n, spilled: spill.ReadRun(n)}
+		if err := run.readSpilled(); err != nil {
 			return err
 		}
+		runs = append(runs, run)
+	}
+	if len(rows) > 0 {
+		runs = append(runs, sortedRun{partition: spill.NumRuns(), rows: rows})
 	}
 
-	rows := cache.Get()
+	i.merge = newSortedRunsHeap(ctx, i.s.SortFields, runs)
+	heap.Init(i.merge)
+	return i.merge.LastError
+}
+
+func (i *sortIter) sortRows(ctx *sql.Context, rows []sql.Row) error {
 	sorter := &expression.Sorter{
 		SortFields: i.s.SortFields,
 		Rows:       rows,
 		LastError:  nil,
 		Ctx:        ctx,
 	}
-	sort.Stable(sorter)
-	if sorter.LastError != nil {
-		return sorter.LastError
-	}
-	i.sortedRows = rows
-	return nil
+	return sorter.Sort()
 }
 
 // TopN was a sort node that has a limit. It doesn't need to buffer everything,
@@ -230,14 +296,15 @@ func (n TopN) WithCalcFoundRows(v bool) *TopN {
 // RowIter implements the Node interface.
 func (n *TopN) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	span, ctx := ctx.Span("plan.TopN")
-	i, err := n.UnaryNode.Child.RowIter(ctx, row)
+	limit, err := getTopNLimit(ctx, n.Limit)
 	if err != nil {
 		span.Finish()
 		return nil, err
 	}
 
-	limit, err := getInt64Value(ctx, n.Limit)
+	i, err := n.UnaryNode.Child.RowIter(ctx, row)
 	if err != nil {
+		span.Finish()
 		return nil, err
 	}
 	return sql.NewSpanIter(span, newTopRowsIter(n, limit, i)), nil
@@ -16,6 +16,10 @@ package plan
 
 import (
 	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/dolthub/go-mysql-server/memory"
@@ -211,10 +215,116 @@ func TestSort(t *testing.T) {
 			actual, err := sql.NodeToRows(ctx, sort)
 			require.NoError(err)
 			require.Equal(tt.expected, actual)
+
+			// Spilling every row to disk as its own run returns the same rows, and removes the file when done
+			ctx.Memory.SetSpillThreshold(1)
+			actual, err = sql.NodeToRows(ctx, sort)
+			require.NoError(err)
+			require.Equal(tt.expected, actual)
+			require.Equal(0, ctx.Memory.NumCaches())
 		})
 	}
 }
 
+func TestSortSpill(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	schema := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: sql.Int64},
+		{Name: "s", Type: sql.Text},
+	})
+	tbl := memory.NewTable("test", schema)
+	for i := 0; i < 5000; i++ {
+		require.NoError(tbl.Insert(ctx, sql.NewRow(int64(i*7919%1000), fmt.Sprintf("row %d", i))))
+	}
+
+	sort := NewSort([]sql.SortField{
+		{Column: expression.NewGetField(0, sql.Int64, "i", false), Order: sql.Descending},
+	}, NewResolvedTable(tbl, nil, nil))
+	expected, err := sql.NodeToRows(ctx, sort)
+	require.NoError(err)
+
+	// Runs of a few thousand rows are read back from disk in batches, and rows that sort the same keep their order
+	ctx.Memory.SetSpillThreshold(sql.EstimatedRowSize(expected[0]) * 1500)
+	actual, err := sql.NodeToRows(ctx, sort)
+	require.NoError(err)
+	require.Equal(expected, actual)
+}
+
+func TestSortSpillLobValues(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	schema := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: sql.Int64},
+		{Name: "b", Type: sql.LongBlob},
+	})
+	tbl := memory.NewTable("test", schema)
+	var opened int
+	for i := 0; i < 100; i++ {
+		contents := fmt.Sprintf("blob %d", i)
+		lob := sql.NewLobValue(int64(len(contents)), func() (io.ReadCloser, error) {
+			opened++
+			return ioutil.NopCloser(strings.NewReader(contents)), nil
+		})
+		require.NoError(tbl.Insert(ctx, sql.NewRow(int64(i*37%100), lob)))
+	}
+	opened = 0
+
+	sort := NewSort([]sql.SortField{
+		{Column: expression.NewGetField(0, sql.Int64, "i", false), Order: sql.Ascending},
+	}, NewResolvedTable(tbl, nil, nil))
+
+	// The LobValues are spilled along with their rows without being read
+	ctx.Memory.SetSpillThreshold(1)
+	rows, err := sql.NodeToRows(ctx, sort)
+	require.NoError(err)
+	require.Len(rows, 100)
+	require.Equal(0, opened)
+	for i, row := range rows {
+		require.Equal(int64(i), row[0])
+		b, err := sql.ReadLobValue(row[1].(sql.LobValue))
+		require.NoError(err)
+		require.Equal(fmt.Sprintf("blob %d", i*73%100), string(b))
+	}
+	require.Equal(0, ctx.Memory.NumCaches())
+}
+
+// unspillableValue is a value that can't be encoded to be spilled to disk, since its type isn't registered with gob.
+type unspillableValue struct {
+	V int64
+}
+
+func TestSortUnspillableValues(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	schema := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "i", Type: sql.Int64},
+		{Name: "j", Type: sql.JSON},
+	})
+	tbl := memory.NewTable("test", schema)
+	for i := 0; i < 100; i++ {
+		require.NoError(tbl.Insert(ctx, sql.NewRow(int64(i*37%100), sql.JSONDocument{Val: unspillableValue{V: int64(i)}})))
+	}
+
+	sort := NewSort([]sql.SortField{
+		{Column: expression.NewGetField(0, sql.Int64, "i", false), Order: sql.Descending},
+	}, NewResolvedTable(tbl, nil, nil))
+
+	// Rows that can't be spilled to disk are sorted in memory
+	ctx.Memory.SetSpillThreshold(1)
+	rows, err := sql.NodeToRows(ctx, sort)
+	require.NoError(err)
+	require.Len(rows, 100)
+	for i, row := range rows {
+		require.Equal(int64(99-i), row[0])
+		require.Equal(sql.JSONDocument{Val: unspillableValue{V: int64((99 - i) * 73 % 100)}}, row[1])
+	}
+	require.Equal(0, ctx.Memory.NumCaches())
+}
+
 func TestSortAscending(t *testing.T) {
 	require := require.New(t)
 	ctx := sql.NewEmptyContext()
@@ -294,3 +404,93 @@ func TestSortDescending(t *testing.T) {
 	require.NoError(err)
 	require.Equal(expected, actual)
 }
+
+func TestSortKeys(t *testing.T) {
+	require := require.New(t)
+	ctx := sql.NewEmptyContext()
+
+	var rows []sql.Row
+	for i := 0; i < 200; i++ {
+		var s interface{} = fmt.Sprintf("row%d", i*7919%37)
+		if i%11 == 0 {
+			s = nil
+		}
+		rows = append(rows, sql.NewRow(s, int32(i*31%7), i))
+	}
+
+	for _, fields := range [][]sql.SortField{
+		{
+			{Column: expression.NewGetField(0, sql.Text, "col1", true), Order: sql.Ascending, NullOrdering: sql.NullsFirst},
+		},
+		{
+			{Column: expression.NewGetField(0, sql.Text, "col1", true), Order: sql.Descending, NullOrdering: sql.NullsLast},
+			{Column: expression.NewGetField(1, sql.Int32, "col2", true), Order: sql.Ascending, NullOrdering: sql.NullsFirst},
+		},
+		{
+			{Column: expression.NewGetField(1, sql.Int32, "col2", true), Order: sql.Descending, NullOrdering: sql.NullsFirst},
+			{Column: expression.NewGetField(0, sql.Text, "col1", true), Order: sql.Ascending, NullOrdering: sql.NullsLast},
+		},
+	} {
+		naive := &expression.Sorter{SortFields: fields, Rows: append([]sql.Row(nil), rows...), Ctx: ctx}
+		sort.Stable(naive)
+		require.NoError(naive.LastError)
+
+		keyed := &expression.Sorter{SortFields: fields, Rows: append([]sql.Row(nil), rows...), Ctx: ctx}
+		require.NoError(keyed.Sort())
+
+		require.Equal(naive.Rows, keyed.Rows)
+		for i, row := range keyed.Rows {
+			key, err := expression.NewSortKey(ctx, fields, row)
+			require.NoError(err)
+			require.Equal(key, keyed.Keys[i])
+		}
+	}
+}
+
+func BenchmarkSortKeys(b *testing.B) {
+	ctx := sql.NewEmptyContext()
+	rows := make([]sql.Row, 10000)
+	for i := range rows {
+		rows[i] = sql.NewRow(fmt.Sprintf("%s%d", repeatStr("x", i%16), i*7919%997), int32(i%13), i*7919%10007)
+	}
+
+	benchmarks := []struct {
+		name   string
+		fields []sql.SortField
+	}{
+		{
+			name: "columns",
+			fields: []sql.SortField{
+				{Column: expression.NewGetField(0, sql.Text, "col1", true), Order: sql.Ascending, NullOrdering: sql.NullsFirst},
+				{Column: expression.NewGetField(1, sql.Int32, "col2", true), Order: sql.Descending, NullOrdering: sql.NullsFirst},
+			},
+		},
+		{
+			name: "expression",
+			fields: []sql.SortField{
+				{Column: expression.NewConvert(expression.NewGetField(2, sql.Int64, "col3", true), expression.ConvertToChar), Order: sql.Ascending, NullOrdering: sql.NullsFirst},
+			},
+		},
+	}
+
+	for _, bb := range benchmarks {
+		b.Run(bb.name+"/naive", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sorter := &expression.Sorter{SortFields: bb.fields, Rows: append([]sql.Row(nil), rows...), Ctx: ctx}
+				sort.Stable(sorter)
+				if sorter.LastError != nil {
+					b.Fatal(sorter.LastError)
+				}
+			}
+		})
+
+		b.Run(bb.name+"/keyed", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				sorter := &expression.Sorter{SortFields: bb.fields, Rows: append([]sql.Row(nil), rows...), Ctx: ctx}
+				if err := sorter.Sort(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
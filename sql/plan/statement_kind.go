@@ -0,0 +1,145 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import "github.com/dolthub/go-mysql-server/sql"
+
+// StatementKind is the kind of a statement, which tells whether it may run on a read-only server, such as a read
+// replica.
+type StatementKind byte
+
+const (
+	// StatementKind_Read is the kind of statements that only read data, such as SELECT, SHOW and DESCRIBE.
+	StatementKind_Read StatementKind = iota
+	// StatementKind_Write is the kind of statements that modify the rows of tables, such as INSERT, UPDATE, DELETE and
+	// LOAD DATA, as well as account management statements, which modify the grant tables.
+	StatementKind_Write
+	// StatementKind_DDL is the kind of statements that modify schemas, such as CREATE TABLE and ALTER TABLE.
+	StatementKind_DDL
+	// StatementKind_Other is the kind of statements that neither read nor modify data, such as SET, USE, KILL and the
+	// transaction and locking statements.
+	StatementKind_Other
+)
+
+// String returns the name of the statement kind.
+func (k StatementKind) String() string {
+	switch k {
+	case StatementKind_Read:
+		return "read"
+	case StatementKind_Write:
+		return "write"
+	case StatementKind_DDL:
+		return "DDL"
+	case StatementKind_Other:
+		return "other"
+	default:
+		return "unknown"
+	}
+}
+
+// ModifiesData returns whether statements of the kind modify rows or schemas, and are therefore rejected by read-only
+// servers.
+func (k StatementKind) ModifiesData() bool {
+	return k == StatementKind_Write || k == StatementKind_DDL
+}
+
+// ClassifyStatement returns the kind of the statement given, which may be either parsed or analyzed. A CALL statement
+// has the kind of the most modifying statement of its procedure, including the procedures it calls in turn, once it has
+// been analyzed: a CALL whose procedure hasn't been resolved yet is classified as a write.
+func ClassifyStatement(n sql.Node) StatementKind {
+	if qp, ok := n.(*QueryProcess); ok {
+		n = qp.Child
+	}
+	if IsDDLNode(n) {
+		return StatementKind_DDL
+	}
+	switch n.(type) {
+	case *Set, *Use, *Kill, *AdminCommand, *Flush, *AnalyzeTable, *Signal,
+		*StartTransaction, *Commit, *Rollback, *CreateSavepoint, *RollbackSavepoint, *ReleaseSavepoint,
+		*LockTables, *UnlockTables:
+		return StatementKind_Other
+	}
+
+	kind := StatementKind_Read
+	Inspect(n, func(node sql.Node) bool {
+		if nodeKind := statementNodeKind(node); nodeKind.ModifiesData() && nodeKind > kind {
+			kind = nodeKind
+		}
+		return kind != StatementKind_DDL
+	})
+	return kind
+}
+
+// statementNodeKind returns the kind of the node given as part of a statement: the kind of the statements it modifies
+// data for, or StatementKind_Read.
+func statementNodeKind(n sql.Node) StatementKind {
+	switch n := n.(type) {
+	case *InsertInto, *Update, *DeleteFrom, *TableMaintenance,
+		*CreateUser, *DropUser, *RenameUser, *CreateRole, *DropRole,
+		*Grant, *GrantRole, *GrantProxy, *Revoke, *RevokeAll, *RevokeRole, *RevokeProxy:
+		return StatementKind_Write
+	case *AlterAutoIncrement, *AlterDefaultSet, *AlterDefaultDrop, *AlterKeys, *DropConstraint:
+		return StatementKind_DDL
+	case *Block:
+		// Blocks of stored procedures hold statements of any kind, which are inspected on their own
+		return StatementKind_Read
+	case *Call:
+		if n.proc == nil {
+			return StatementKind_Write
+		}
+		return ClassifyStatement(n.proc.Body)
+	default:
+		if IsDDLNode(n) {
+			return StatementKind_DDL
+		}
+		return StatementKind_Read
+	}
+}
+
+// ModifiesOnlyTemporaryTables returns whether the statement given, which must have been analyzed, only modifies
+// temporary tables, which read-only servers allow. The tables an UPDATE or DELETE statement reads must be temporary as
+// well.
+func ModifiesOnlyTemporaryTables(n sql.Node) bool {
+	only := true
+	onlyTemporaryTables := func(n sql.Node) {
+		Inspect(n, func(node sql.Node) bool {
+			if rt, ok := node.(*ResolvedTable); ok {
+				if tt, ok := rt.Table.(sql.TemporaryTable); !ok || !tt.IsTemporary() {
+					only = false
+				}
+			}
+			return only
+		})
+	}
+
+	Inspect(n, func(node sql.Node) bool {
+		switch node := node.(type) {
+		case *CreateTable:
+			only = only && node.Temporary() == IsTempTable
+			return false
+		case *InsertInto:
+			onlyTemporaryTables(node.Destination)
+			return false
+		case *Update, *DeleteFrom:
+			onlyTemporaryTables(node)
+			return false
+		}
+		if statementNodeKind(node).ModifiesData() {
+			only = false
+		}
+		return only
+	})
+	return only
+}
@@ -0,0 +1,61 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+)
+
+func TestClassifyStatement(t *testing.T) {
+	table := NewResolvedTable(memory.NewTable("t", sql.NewPrimaryKeySchema(sql.Schema{{Name: "i", Type: sql.Int64, Source: "t"}})), nil, nil)
+	selectAll := NewProject([]sql.Expression{expression.NewStar()}, table)
+	insert := NewInsertInto(sql.UnresolvedDatabase(""), table, selectAll, false, nil, nil, false)
+	call := func(body sql.Node) sql.Node {
+		return NewCall("p", nil).WithProcedure(NewProcedure("p", "", nil, ProcedureSecurityContext_Definer, "", nil, "", body, time.Time{}, time.Time{}))
+	}
+
+	tests := []struct {
+		name string
+		node sql.Node
+		kind StatementKind
+	}{
+		{"select", selectAll, StatementKind_Read},
+		{"query process", NewQueryProcess(selectAll, nil), StatementKind_Read},
+		{"show", NewShowTables(sql.UnresolvedDatabase(""), false, nil), StatementKind_Read},
+		{"insert", insert, StatementKind_Write},
+		{"update", NewUpdate(table, nil), StatementKind_Write},
+		{"delete", NewQueryProcess(NewDeleteFrom(table), nil), StatementKind_Write},
+		{"truncate", NewTruncate("", table), StatementKind_DDL},
+		{"alter table", NewBlock([]sql.Node{NewDropColumn(nil, NewUnresolvedTable("t", ""), "i")}), StatementKind_DDL},
+		{"set", NewSet(nil), StatementKind_Other},
+		{"commit", NewCommit(""), StatementKind_Other},
+		{"unresolved call", NewCall("p", nil), StatementKind_Write},
+		{"call of a reading procedure", call(NewBeginEndBlock(NewBlock([]sql.Node{NewSet(nil), selectAll}))), StatementKind_Read},
+		{"call of a writing procedure", call(NewBeginEndBlock(NewBlock([]sql.Node{selectAll, insert}))), StatementKind_Write},
+		{"nested call", call(NewBeginEndBlock(NewBlock([]sql.Node{call(insert)}))), StatementKind_Write},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.kind, ClassifyStatement(test.node))
+		})
+	}
+}
@@ -24,6 +24,11 @@ type SubqueryAlias struct {
 	Columns        []string
 	name           string
 	TextDefinition string
+	// ViewDatabase is the database of the view this is the definition of, or empty if it isn't a view.
+	ViewDatabase string
+	// Materialization is whether the rows of the subquery are stored once they're computed, when it's the definition of
+	// a common table expression.
+	Materialization CteMaterialization
 }
 
 // NewSubqueryAlias creates a new SubqueryAlias node.
@@ -107,7 +112,20 @@ func (sq SubqueryAlias) DebugString() string {
 	return pr.String()
 }
 
+// WithViewDatabase returns a copy of this node that is the definition of a view in the database named.
+func (sq SubqueryAlias) WithViewDatabase(db string) *SubqueryAlias {
+	sq.ViewDatabase = db
+	return &sq
+}
+
 func (sq SubqueryAlias) WithColumns(columns []string) *SubqueryAlias {
 	sq.Columns = columns
 	return &sq
 }
+
+// WithMaterialization returns a copy of this node whose rows are stored, or not, as given by the definition of the
+// common table expression it's a reference to.
+func (sq SubqueryAlias) WithMaterialization(m CteMaterialization) *SubqueryAlias {
+	sq.Materialization = m
+	return &sq
+}
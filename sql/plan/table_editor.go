@@ -15,6 +15,7 @@
 package plan
 
 import (
+	"context"
 	"io"
 	"sync"
 
@@ -28,18 +29,22 @@ type tableEditorIter struct {
 	editor           sql.TableEditor
 	inner            sql.RowIter
 	errorEncountered error
+	// statement is the statement this iterator's changes are part of, if any.
+	statement *statementEditors
 }
 
 var _ sql.RowIter = (*tableEditorIter)(nil)
 
-// NewTableEditorIter returns a new *tableEditorIter by wrapping the given iterator. If the
-// "statement_boundaries" session variable is set to false, then the original iterator is returned.
-func NewTableEditorIter(table sql.TableEditor, wrappedIter sql.RowIter) sql.RowIter {
+// NewTableEditorIter returns a new *tableEditorIter by wrapping the given iterator. If the context given is running a
+// statement started with BeginStatement, the changes of the table are completed or discarded along with the rest of
+// the statement's, once it's done, rather than when the iterator is closed.
+func NewTableEditorIter(ctx *sql.Context, table sql.TableEditor, wrappedIter sql.RowIter) sql.RowIter {
 	return &tableEditorIter{
 		once:             &sync.Once{},
 		editor:           table,
 		inner:            wrappedIter,
 		errorEncountered: nil,
+		statement:        statementFromContext(ctx),
 	}
 }
 
@@ -61,6 +66,9 @@ func (s *tableEditorIter) Close(ctx *sql.Context) error {
 	var err error
 	if s.errorEncountered != nil {
 		err = s.editor.DiscardChanges(ctx, s.errorEncountered)
+	} else if s.statement != nil {
+		// Later parts of the statement, such as AFTER triggers, may still fail
+		s.statement.add(s.editor)
 	} else {
 		err = s.editor.StatementComplete(ctx)
 	}
@@ -71,3 +79,106 @@ func (s *tableEditorIter) Close(ctx *sql.Context) error {
 	}
 	return err
 }
+
+// statementEditors are the table editors of a statement, including those of the statements run by its triggers, whose
+// changes are completed together once the whole statement succeeds, or discarded together if any part of it fails.
+// This makes statements atomic, as MySQL guarantees, even for tables that don't support transactions.
+type statementEditors struct {
+	mu      sync.Mutex
+	editors []sql.TableEditor
+}
+
+type statementEditorsKey struct{}
+
+// statementFromContext returns the statement run by the context given, or nil if there's none.
+func statementFromContext(ctx *sql.Context) *statementEditors {
+	if ctx == nil || ctx.Context == nil {
+		return nil
+	}
+	statement, _ := ctx.Value(statementEditorsKey{}).(*statementEditors)
+	return statement
+}
+
+// withStatement returns a copy of the context given that runs the statement given, or no statement if it's nil.
+func withStatement(ctx *sql.Context, statement *statementEditors) *sql.Context {
+	return ctx.WithContext(context.WithValue(ctx.Context, statementEditorsKey{}, statement))
+}
+
+func (s *statementEditors) add(editor sql.TableEditor) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.editors = append(s.editors, editor)
+}
+
+// end completes the changes of the statement's table editors if |errorEncountered| is nil, and discards them otherwise.
+// Changes are discarded in the reverse order they were made, so that tables end up as they were before the statement.
+func (s *statementEditors) end(ctx *sql.Context, errorEncountered error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var err error
+	if errorEncountered != nil {
+		for i := len(s.editors) - 1; i >= 0; i-- {
+			if discardErr := s.editors[i].DiscardChanges(ctx, errorEncountered); err == nil {
+				err = discardErr
+			}
+		}
+	} else {
+		for _, editor := range s.editors {
+			if completeErr := editor.StatementComplete(ctx); err == nil {
+				err = completeErr
+			}
+		}
+	}
+	s.editors = nil
+	return err
+}
+
+// BeginStatement returns a copy of the context given for running a top-level statement, along with a function that
+// wraps the statement's row iterator. The table editors of the statement, and those of any statements run by its
+// triggers, complete their changes once the wrapped iterator is closed if the statement succeeded, and discard them
+// otherwise.
+func BeginStatement(ctx *sql.Context) (*sql.Context, func(sql.RowIter) sql.RowIter) {
+	statement := &statementEditors{}
+	return withStatement(ctx, statement), func(iter sql.RowIter) sql.RowIter {
+		return &statementIter{statement: statement, inner: iter}
+	}
+}
+
+// statementIter wraps the row iterator of a statement, and ends the statement once it's closed.
+type statementIter struct {
+	statement        *statementEditors
+	inner            sql.RowIter
+	errorEncountered error
+}
+
+var _ sql.RowIter = (*statementIter)(nil)
+
+// Next implements the interface sql.RowIter.
+func (s *statementIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := s.inner.Next(ctx)
+	_, isIg := err.(sql.ErrInsertIgnore)
+	if err != nil && err != io.EOF && !isIg {
+		s.errorEncountered = err
+	}
+	return row, err
+}
+
+// Close implements the interface sql.RowIter.
+func (s *statementIter) Close(ctx *sql.Context) error {
+	// Statements that were canceled before they were done, e.g. by KILL QUERY, are discarded as well. This is checked
+	// before closing the inner iterator, since closing the query's process ends the query and cancels its context.
+	canceled := ctx.Err()
+	err := s.inner.Close(ctx)
+	errorEncountered := s.errorEncountered
+	if errorEncountered == nil {
+		errorEncountered = err
+	}
+	if errorEncountered == nil {
+		errorEncountered = canceled
+	}
+	if endErr := s.statement.end(ctx, errorEncountered); err == nil {
+		err = endErr
+	}
+	return err
+}
@@ -0,0 +1,568 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// HandlerOpen represents a HANDLER ... OPEN statement, which opens a table handler for the session.
+type HandlerOpen struct {
+	Database string
+	Table    string
+	// Alias is the name of the handler, if it's not the name of the table.
+	Alias string
+	// Catalog and Handlers are assigned by the analyzer.
+	Catalog  sql.Catalog
+	Handlers *sql.TableHandlers
+}
+
+var _ sql.Node = (*HandlerOpen)(nil)
+
+// NewHandlerOpen returns a new HandlerOpen node for the table and alias given.
+func NewHandlerOpen(database, table, alias string) *HandlerOpen {
+	return &HandlerOpen{Database: database, Table: table, Alias: alias}
+}
+
+// Resolved implements the sql.Node interface.
+func (h *HandlerOpen) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (h *HandlerOpen) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (h *HandlerOpen) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(h, len(children), 0)
+	}
+	return h, nil
+}
+
+// Schema implements the sql.Node interface.
+func (h *HandlerOpen) Schema() sql.Schema {
+	return sql.OkResultSchema
+}
+
+// RowIter implements the sql.Node interface.
+func (h *HandlerOpen) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if h.Catalog == nil || h.Handlers == nil {
+		return nil, sql.ErrUnsupportedFeature.New("HANDLER")
+	}
+
+	db := h.Database
+	if db == "" {
+		db = ctx.GetCurrentDatabase()
+	}
+	if db == "" {
+		return nil, sql.ErrNoDatabaseSelected.New()
+	}
+	table, _, err := h.Catalog.Table(ctx, db, h.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	name := h.Alias
+	if name == "" {
+		name = table.Name()
+	}
+	err = h.Handlers.Open(ctx.ID(), &sql.TableHandler{Name: name, Database: db, Table: table.Name()})
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+func (h *HandlerOpen) String() string {
+	table := h.Table
+	if h.Database != "" {
+		table = h.Database + "." + h.Table
+	}
+	if h.Alias != "" {
+		return fmt.Sprintf("HANDLER %s OPEN AS %s", table, h.Alias)
+	}
+	return fmt.Sprintf("HANDLER %s OPEN", table)
+}
+
+// HandlerClose represents a HANDLER ... CLOSE statement, which closes a table handler of the session.
+type HandlerClose struct {
+	Name string
+	// Handlers is assigned by the analyzer.
+	Handlers *sql.TableHandlers
+}
+
+var _ sql.Node = (*HandlerClose)(nil)
+
+// NewHandlerClose returns a new HandlerClose node for the handler with the name given.
+func NewHandlerClose(name string) *HandlerClose {
+	return &HandlerClose{Name: name}
+}
+
+// Resolved implements the sql.Node interface.
+func (h *HandlerClose) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (h *HandlerClose) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (h *HandlerClose) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(h, len(children), 0)
+	}
+	return h, nil
+}
+
+// Schema implements the sql.Node interface.
+func (h *HandlerClose) Schema() sql.Schema {
+	return sql.OkResultSchema
+}
+
+// RowIter implements the sql.Node interface.
+func (h *HandlerClose) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	if h.Handlers == nil {
+		return nil, sql.ErrUnsupportedFeature.New("HANDLER")
+	}
+	if err := h.Handlers.Close(ctx.ID(), h.Name); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.NewRow(sql.NewOkResult(0))), nil
+}
+
+func (h *HandlerClose) String() string {
+	return fmt.Sprintf("HANDLER %s CLOSE", h.Name)
+}
+
+// HandlerReadMode is the position a HANDLER ... READ statement reads from.
+type HandlerReadMode byte
+
+const (
+	// HandlerRead_First reads from the start of the index, or of the table.
+	HandlerRead_First HandlerReadMode = iota
+	// HandlerRead_Next reads forward from the position of the cursor, or from the start if the cursor has no position.
+	HandlerRead_Next
+	// HandlerRead_Prev reads backward from the position of the cursor, or from the end if the cursor has no position.
+	HandlerRead_Prev
+	// HandlerRead_Last reads backward from the end of the index.
+	HandlerRead_Last
+	// HandlerRead_Key reads from the first key of the index that compares to the key of the statement with its
+	// operator. The = and >= operators read forward, and the <= and < operators read backward, as in MySQL.
+	HandlerRead_Key
+)
+
+func (m HandlerReadMode) String() string {
+	switch m {
+	case HandlerRead_First:
+		return "FIRST"
+	case HandlerRead_Next:
+		return "NEXT"
+	case HandlerRead_Prev:
+		return "PREV"
+	case HandlerRead_Last:
+		return "LAST"
+	default:
+		return "KEY"
+	}
+}
+
+// HandlerRead represents a HANDLER ... READ statement, which reads rows from an open table handler, in the order of
+// one of the indexes of its table or in the natural order of the table, and moves the cursor of the handler past the
+// rows it reads. Rows with equal keys are read in the order of their values, so the order of every index is total.
+type HandlerRead struct {
+	Name string
+	// IndexName is the name of the index to read in the order of, or empty to read the table in its natural order.
+	IndexName string
+	Mode      HandlerReadMode
+	// Op and Key are the operator and key of HandlerRead_Key reads. The key may be a prefix of the columns of the
+	// index.
+	Op  string
+	Key []sql.Expression
+	// Where filters the rows read. It's resolved against the schema of the table by the analyzer.
+	Where  sql.Expression
+	Limit  int64
+	Offset int64
+
+	// Handler, Table and Index are assigned by the analyzer.
+	Handler *sql.TableHandler
+	Table   sql.Table
+	Index   sql.Index
+}
+
+var _ sql.Node = (*HandlerRead)(nil)
+
+// NewHandlerRead returns a new HandlerRead node.
+func NewHandlerRead(name, index string, mode HandlerReadMode, op string, key []sql.Expression, where sql.Expression, limit, offset int64) *HandlerRead {
+	return &HandlerRead{
+		Name:      name,
+		IndexName: index,
+		Mode:      mode,
+		Op:        op,
+		Key:       key,
+		Where:     where,
+		Limit:     limit,
+		Offset:    offset,
+	}
+}
+
+// WithTable returns a copy of this node reading from the handler and table given, with its key and filter resolved.
+func (h *HandlerRead) WithTable(handler *sql.TableHandler, table sql.Table, index sql.Index, key []sql.Expression, where sql.Expression) *HandlerRead {
+	nh := *h
+	nh.Handler = handler
+	nh.Table = table
+	nh.Index = index
+	nh.Key = key
+	nh.Where = where
+	return &nh
+}
+
+// Resolved implements the sql.Node interface.
+func (h *HandlerRead) Resolved() bool {
+	return h.Table != nil
+}
+
+// Children implements the sql.Node interface.
+func (h *HandlerRead) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (h *HandlerRead) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(h, len(children), 0)
+	}
+	return h, nil
+}
+
+// Schema implements the sql.Node interface.
+func (h *HandlerRead) Schema() sql.Schema {
+	if h.Table == nil {
+		return nil
+	}
+	return h.Table.Schema()
+}
+
+// RowIter implements the sql.Node interface.
+func (h *HandlerRead) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	var rows []sql.Row
+	var err error
+	if h.Index == nil {
+		rows, err = h.readNatural(ctx)
+	} else {
+		rows, err = h.readIndex(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// readNatural reads the rows of the table in its natural order, starting after the rows already read by the cursor.
+func (h *HandlerRead) readNatural(ctx *sql.Context) ([]sql.Row, error) {
+	if h.Handler.Index != "" || h.Mode == HandlerRead_First {
+		h.Handler.ResetCursor("")
+	}
+
+	partitions, err := h.Table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	iter := sql.NewTableRowIter(ctx, h.Table, partitions)
+	defer iter.Close(ctx)
+
+	var candidates []sql.Row
+	for i := 0; ; i++ {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if i >= h.Handler.Offset {
+			candidates = append(candidates, row)
+		}
+	}
+
+	rows, examined, err := h.filter(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+	h.Handler.Offset += examined
+	return rows, nil
+}
+
+// readIndex reads the rows of the table in the order of the index, from the position given by the mode of the read.
+func (h *HandlerRead) readIndex(ctx *sql.Context) ([]sql.Row, error) {
+	keyIdxs, err := h.indexColumns()
+	if err != nil {
+		return nil, err
+	}
+	sch := h.Table.Schema()
+
+	key := make(sql.Row, len(h.Key))
+	for i, e := range h.Key {
+		if i >= len(keyIdxs) {
+			return nil, sql.ErrInvalidArgument.New("HANDLER ... READ")
+		}
+		if key[i], err = e.Eval(ctx, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	rows, err := h.indexRows(ctx, keyIdxs)
+	if err != nil {
+		return nil, err
+	}
+
+	// compare orders two rows by their keys in the index, and then by their values
+	compare := func(a, b sql.Row) (int, error) {
+		for _, idx := range keyIdxs {
+			if c, err := sch[idx].Type.Compare(a[idx], b[idx]); err != nil || c != 0 {
+				return c, err
+			}
+		}
+		for i, col := range sch {
+			if c, err := col.Type.Compare(a[i], b[i]); err != nil || c != 0 {
+				return c, err
+			}
+		}
+		return 0, nil
+	}
+	// compareKey compares the prefix of the key of a row with the key of the statement
+	compareKey := func(r sql.Row) (int, error) {
+		for i, value := range key {
+			idx := keyIdxs[i]
+			if c, err := sch[idx].Type.Compare(r[idx], value); err != nil || c != 0 {
+				return c, err
+			}
+		}
+		return 0, nil
+	}
+
+	var sortErr error
+	sort.SliceStable(rows, func(i, j int) bool {
+		c, err := compare(rows[i], rows[j])
+		if err != nil {
+			sortErr = err
+		}
+		return c < 0
+	})
+	if sortErr != nil {
+		return nil, sortErr
+	}
+
+	indexName := h.Index.ID()
+	mode := h.Mode
+	if h.Handler.Index != indexName || h.Handler.LastRow == nil {
+		if mode == HandlerRead_Next {
+			mode = HandlerRead_First
+		} else if mode == HandlerRead_Prev {
+			mode = HandlerRead_Last
+		}
+	}
+
+	// include returns whether a row comes after the starting position of the read, in the direction of the read
+	var include func(r sql.Row) (bool, error)
+	backward := false
+	switch mode {
+	case HandlerRead_First:
+		include = func(sql.Row) (bool, error) { return true, nil }
+	case HandlerRead_Last:
+		backward = true
+		include = func(sql.Row) (bool, error) { return true, nil }
+	case HandlerRead_Next, HandlerRead_Prev:
+		backward = mode == HandlerRead_Prev
+		lastRow := h.Handler.LastRow
+		include = func(r sql.Row) (bool, error) {
+			c, err := compare(r, lastRow)
+			if backward {
+				return c < 0, err
+			}
+			return c > 0, err
+		}
+	case HandlerRead_Key:
+		op := h.Op
+		backward = op == "<=" || op == "<"
+		include = func(r sql.Row) (bool, error) {
+			c, err := compareKey(r)
+			switch op {
+			case "=":
+				return c == 0, err
+			case ">=":
+				return c >= 0, err
+			case ">":
+				return c > 0, err
+			case "<=":
+				return c <= 0, err
+			default:
+				return c < 0, err
+			}
+		}
+	}
+
+	if backward {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+
+	var candidates []sql.Row
+	for _, r := range rows {
+		ok, err := include(r)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			// Rows matching the key of an equality read are contiguous in the index
+			if mode == HandlerRead_Key && h.Op == "=" && len(candidates) > 0 {
+				break
+			}
+			continue
+		}
+		candidates = append(candidates, r)
+	}
+
+	result, examined, err := h.filter(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+	h.Handler.Index = indexName
+	if examined > 0 {
+		h.Handler.LastRow = candidates[examined-1]
+	}
+	return result, nil
+}
+
+// indexColumns returns the positions in the schema of the table of the columns of the index.
+func (h *HandlerRead) indexColumns() ([]int, error) {
+	sch := h.Table.Schema()
+	exprs := h.Index.Expressions()
+	idxs := make([]int, len(exprs))
+	for i, expr := range exprs {
+		name := strings.Trim(expr[strings.LastIndex(expr, ".")+1:], "`")
+		idxs[i] = sch.IndexOf(name, sch[0].Source)
+		if idxs[i] < 0 {
+			return nil, sql.ErrTableColumnNotFound.New(h.Table.Name(), name)
+		}
+	}
+	return idxs, nil
+}
+
+// indexRows returns the rows of the table that may be read, which are the rows matching the first column of the key of
+// the read when the table supports index lookups, or every row otherwise.
+func (h *HandlerRead) indexRows(ctx *sql.Context, keyIdxs []int) ([]sql.Row, error) {
+	table := h.Table
+	if iat, ok := table.(sql.IndexAddressableTable); ok && h.Mode == HandlerRead_Key && len(h.Key) > 0 {
+		typ := table.Schema()[keyIdxs[0]].Type
+		value, err := h.Key[0].Eval(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		rng := make(sql.Range, len(keyIdxs))
+		for i, idx := range keyIdxs {
+			rng[i] = sql.AllRangeColumnExpr(table.Schema()[idx].Type)
+		}
+		switch h.Op {
+		case "=":
+			rng[0] = sql.ClosedRangeColumnExpr(value, value, typ)
+		case ">=", ">":
+			rng[0] = sql.GreaterOrEqualRangeColumnExpr(value, typ)
+		default:
+			rng[0] = sql.LessOrEqualRangeColumnExpr(value, typ)
+		}
+
+		lookup, err := h.Index.NewLookup(ctx, rng)
+		if err != nil {
+			return nil, err
+		}
+		if lookup != nil {
+			table = iat.WithIndexLookup(lookup)
+		}
+	}
+
+	partitions, err := table.Partitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowIterToRows(ctx, sql.NewTableRowIter(ctx, table, partitions))
+}
+
+// filter returns the rows that match the filter of the read, skipping the offset and up to its limit, and the number
+// of rows examined to find them, which is how far the cursor moves.
+func (h *HandlerRead) filter(ctx *sql.Context, candidates []sql.Row) ([]sql.Row, int, error) {
+	var rows []sql.Row
+	skipped := int64(0)
+	for i, r := range candidates {
+		if int64(len(rows)) >= h.Limit {
+			return rows, i, nil
+		}
+		if h.Where != nil {
+			ok, err := sql.EvaluateCondition(ctx, h.Where, r)
+			if err != nil {
+				return nil, 0, err
+			}
+			if !sql.IsTrue(ok) {
+				continue
+			}
+		}
+		if skipped < h.Offset {
+			skipped++
+			continue
+		}
+		rows = append(rows, r)
+	}
+	return rows, len(candidates), nil
+}
+
+func (h *HandlerRead) String() string {
+	var sb strings.Builder
+	sb.WriteString("HANDLER ")
+	sb.WriteString(h.Name)
+	sb.WriteString(" READ")
+	if h.IndexName != "" {
+		sb.WriteString(" ")
+		sb.WriteString(h.IndexName)
+	}
+	if h.Mode == HandlerRead_Key {
+		key := make([]string, len(h.Key))
+		for i, e := range h.Key {
+			key[i] = e.String()
+		}
+		fmt.Fprintf(&sb, " %s (%s)", h.Op, strings.Join(key, ", "))
+	} else {
+		sb.WriteString(" ")
+		sb.WriteString(h.Mode.String())
+	}
+	if h.Where != nil {
+		fmt.Fprintf(&sb, " WHERE %s", h.Where)
+	}
+	fmt.Fprintf(&sb, " LIMIT %d", h.Limit)
+	if h.Offset > 0 {
+		fmt.Fprintf(&sb, " OFFSET %d", h.Offset)
+	}
+	return sb.String()
+}
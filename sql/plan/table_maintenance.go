@@ -0,0 +1,188 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+// MaintenanceOp is the operation of a table maintenance statement, as reported in the Op column of its results.
+type MaintenanceOp string
+
+const (
+	MaintenanceOp_Optimize MaintenanceOp = "optimize"
+	MaintenanceOp_Repair   MaintenanceOp = "repair"
+)
+
+// MaintenanceTableName is a table named by a table maintenance statement. Database is empty for tables of the current
+// database.
+type MaintenanceTableName struct {
+	Database string
+	Name     string
+}
+
+// TableMaintenance represents an OPTIMIZE TABLE or REPAIR TABLE statement, which optimizes the tables that are
+// sql.OptimizableTable or repairs the tables that are sql.RepairableTable. Like MySQL, it reports the outcome for each
+// table as rows rather than failing for tables that don't exist or don't support the operation.
+type TableMaintenance struct {
+	Op      MaintenanceOp
+	Tables  []MaintenanceTableName
+	Options sql.RepairOptions
+	// Catalog is the catalog the tables are looked up in. It's assigned by the analyzer.
+	Catalog sql.Catalog
+}
+
+var _ sql.Node = (*TableMaintenance)(nil)
+
+// NewOptimizeTable returns a new TableMaintenance node that optimizes the tables given.
+func NewOptimizeTable(tables ...MaintenanceTableName) *TableMaintenance {
+	return &TableMaintenance{Op: MaintenanceOp_Optimize, Tables: tables}
+}
+
+// NewRepairTable returns a new TableMaintenance node that repairs the tables given with the options given.
+func NewRepairTable(options sql.RepairOptions, tables ...MaintenanceTableName) *TableMaintenance {
+	return &TableMaintenance{Op: MaintenanceOp_Repair, Tables: tables, Options: options}
+}
+
+var tableMaintenanceSchema = sql.Schema{
+	{Name: "Table", Type: sql.LongText},
+	{Name: "Op", Type: sql.LongText},
+	{Name: "Msg_type", Type: sql.LongText},
+	{Name: "Msg_text", Type: sql.LongText},
+}
+
+// Resolved implements the sql.Node interface.
+func (m *TableMaintenance) Resolved() bool {
+	return true
+}
+
+// Children implements the sql.Node interface.
+func (m *TableMaintenance) Children() []sql.Node {
+	return nil
+}
+
+// WithChildren implements the sql.Node interface.
+func (m *TableMaintenance) WithChildren(children ...sql.Node) (sql.Node, error) {
+	if len(children) != 0 {
+		return nil, sql.ErrInvalidChildrenNumber.New(m, len(children), 0)
+	}
+	return m, nil
+}
+
+// Schema implements the sql.Node interface.
+func (m *TableMaintenance) Schema() sql.Schema {
+	return tableMaintenanceSchema
+}
+
+// RowIter implements the sql.Node interface.
+func (m *TableMaintenance) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
+	op := string(m.Op)
+	var rows []sql.Row
+	for _, name := range m.Tables {
+		db := name.Database
+		if db == "" {
+			db = ctx.GetCurrentDatabase()
+		}
+		qualified := fmt.Sprintf("%s.%s", db, name.Name)
+
+		table, _, err := m.Catalog.Table(ctx, db, name.Name)
+		if sql.ErrTableNotFound.Is(err) || sql.ErrDatabaseNotFound.Is(err) {
+			rows = append(rows,
+				sql.NewRow(qualified, op, "Error", fmt.Sprintf("Table '%s' doesn't exist", qualified)),
+				sql.NewRow(qualified, op, "status", "Operation failed"))
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		supported, err := m.maintain(ctx, table)
+		if !supported {
+			rows = append(rows, sql.NewRow(qualified, op, "note", fmt.Sprintf("The storage engine for the table doesn't support %s", op)))
+		} else if err != nil {
+			rows = append(rows, sql.NewRow(qualified, op, "error", err.Error()))
+		} else {
+			rows = append(rows, sql.NewRow(qualified, op, "status", "OK"))
+		}
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// maintain runs the operation of the statement on the table given, returning whether the table supports it.
+func (m *TableMaintenance) maintain(ctx *sql.Context, table sql.Table) (bool, error) {
+	switch m.Op {
+	case MaintenanceOp_Optimize:
+		ot, ok := GetOptimizableTable(table)
+		if !ok {
+			return false, nil
+		}
+		return true, ot.OptimizeTable(ctx)
+	case MaintenanceOp_Repair:
+		rt, ok := GetRepairableTable(table)
+		if !ok {
+			return false, nil
+		}
+		return true, rt.RepairTable(ctx, m.Options)
+	default:
+		return false, nil
+	}
+}
+
+func (m *TableMaintenance) String() string {
+	names := make([]string, len(m.Tables))
+	for i, table := range m.Tables {
+		names[i] = table.Name
+		if table.Database != "" {
+			names[i] = table.Database + "." + table.Name
+		}
+	}
+	s := fmt.Sprintf("%s TABLE %s", strings.ToUpper(string(m.Op)), strings.Join(names, ", "))
+	if m.Options.Quick {
+		s += " QUICK"
+	}
+	if m.Options.Extended {
+		s += " EXTENDED"
+	}
+	if m.Options.UseFrm {
+		s += " USE_FRM"
+	}
+	return s
+}
+
+// GetOptimizableTable returns the sql.OptimizableTable the table given is or wraps, if any.
+func GetOptimizableTable(table sql.Table) (sql.OptimizableTable, bool) {
+	switch t := table.(type) {
+	case sql.OptimizableTable:
+		return t, true
+	case sql.TableWrapper:
+		return GetOptimizableTable(t.Underlying())
+	default:
+		return nil, false
+	}
+}
+
+// GetRepairableTable returns the sql.RepairableTable the table given is or wraps, if any.
+func GetRepairableTable(table sql.Table) (sql.RepairableTable, bool) {
+	switch t := table.(type) {
+	case sql.RepairableTable:
+		return t, true
+	case sql.TableWrapper:
+		return GetRepairableTable(t.Underlying())
+	default:
+		return nil, false
+	}
+}
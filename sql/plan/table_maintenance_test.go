@@ -0,0 +1,94 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/go-mysql-server/memory"
+	"github.com/dolthub/go-mysql-server/sql"
+	. "github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/test"
+)
+
+// maintainedTable is a table that records the times it's optimized and the options it's repaired with, failing to be
+// repaired when fail is set.
+type maintainedTable struct {
+	*memory.Table
+	optimized int
+	repairs   []sql.RepairOptions
+	fail      bool
+}
+
+func (t *maintainedTable) OptimizeTable(ctx *sql.Context) error {
+	t.optimized++
+	return nil
+}
+
+func (t *maintainedTable) RepairTable(ctx *sql.Context, options sql.RepairOptions) error {
+	if t.fail {
+		return fmt.Errorf("cannot repair %s", t.Name())
+	}
+	t.repairs = append(t.repairs, options)
+	return nil
+}
+
+func TestTableMaintenance(t *testing.T) {
+	require := require.New(t)
+
+	schema := sql.NewPrimaryKeySchema(sql.Schema{{Name: "i", Type: sql.Int64, Source: "a"}})
+	a := &maintainedTable{Table: memory.NewTable("a", schema)}
+	b := &maintainedTable{Table: memory.NewTable("b", schema), fail: true}
+	db := memory.NewDatabase("db")
+	db.AddTable("a", a)
+	db.AddTable("b", b)
+	db.AddTable("c", memory.NewTable("c", schema))
+	catalog := test.NewCatalog(sql.NewDatabaseProvider(db))
+
+	ctx := sql.NewEmptyContext()
+	ctx.SetCurrentDatabase("db")
+	run := func(node *TableMaintenance) []sql.Row {
+		node.Catalog = catalog
+		iter, err := node.RowIter(ctx, nil)
+		require.NoError(err)
+		rows, err := sql.RowIterToRows(ctx, iter)
+		require.NoError(err)
+		return rows
+	}
+
+	rows := run(NewOptimizeTable(
+		MaintenanceTableName{Name: "a"},
+		MaintenanceTableName{Database: "db", Name: "c"},
+		MaintenanceTableName{Name: "d"},
+	))
+	require.Equal([]sql.Row{
+		{"db.a", "optimize", "status", "OK"},
+		{"db.c", "optimize", "note", "The storage engine for the table doesn't support optimize"},
+		{"db.d", "optimize", "Error", "Table 'db.d' doesn't exist"},
+		{"db.d", "optimize", "status", "Operation failed"},
+	}, rows)
+	require.Equal(1, a.optimized)
+
+	options := sql.RepairOptions{Quick: true, UseFrm: true}
+	rows = run(NewRepairTable(options, MaintenanceTableName{Name: "a"}, MaintenanceTableName{Name: "b"}))
+	require.Equal([]sql.Row{
+		{"db.a", "repair", "status", "OK"},
+		{"db.b", "repair", "error", "cannot repair b"},
+	}, rows)
+	require.Equal([]sql.RepairOptions{options}, a.repairs)
+}
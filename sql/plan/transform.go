@@ -91,6 +91,88 @@ func transformUpCtx(c TransformContext, s TransformSelector, f Transformer) (sql
 	return f(TransformContext{node, c.Parent, c.ChildNum, c.SchemaPrefix})
 }
 
+// NodeTransformer is a function which will return new sql.Node values for a
+// given TransformContext, along with sql.SameTree if the node was returned as
+// is, or sql.NewTree if it was transformed.
+//
+// This module supports Go 1.15, which has no type parameters, so transformers
+// aren't typed by the nodes they apply to. Transformers that only apply to
+// some types of nodes type switch on the node given, and return the others as
+// is:
+//
+//	func(c TransformContext) (sql.Node, sql.TreeIdentity, error) {
+//		filter, ok := c.Node.(*Filter)
+//		if !ok {
+//			return c.Node, sql.SameTree, nil
+//		}
+//		...
+//	}
+type NodeTransformer func(TransformContext) (sql.Node, sql.TreeIdentity, error)
+
+// TransformUpWithIdentity transforms |n| from the bottom up, left to right, by
+// passing each node to |f| with its parent, as TransformUpCtx does. Unlike
+// TransformUpCtx, nodes whose children weren't transformed aren't rebuilt,
+// and |n| is returned as is, along with sql.SameTree, if none of its nodes
+// were transformed. If |s| is non-nil, does not descend into children where
+// |s| returns false.
+func TransformUpWithIdentity(n sql.Node, s TransformSelector, f NodeTransformer) (sql.Node, sql.TreeIdentity, error) {
+	return transformUpWithIdentity(TransformContext{n, nil, -1, sql.Schema{}}, s, f)
+}
+
+func transformUpWithIdentity(c TransformContext, s TransformSelector, f NodeTransformer) (sql.Node, sql.TreeIdentity, error) {
+	if o, ok := c.Node.(sql.OpaqueNode); ok && o.Opaque() {
+		return f(c)
+	}
+
+	children := c.Node.Children()
+	if len(children) == 0 {
+		return f(c)
+	}
+
+	childPrefix := append(sql.Schema{}, c.SchemaPrefix...)
+	var newChildren []sql.Node
+	for i, child := range children {
+		cc := TransformContext{child, c.Node, i, childPrefix}
+		if s == nil || s(cc) {
+			newChild, identity, err := transformUpWithIdentity(cc, s, f)
+			if err != nil {
+				return nil, sql.SameTree, err
+			}
+			if identity == sql.NewTree {
+				if newChildren == nil {
+					newChildren = make([]sql.Node, len(children))
+					copy(newChildren, children)
+				}
+				newChildren[i] = newChild
+				child = newChild
+			}
+		}
+		if child.Resolved() && childPrefix != nil {
+			cs := child.Schema()
+			childPrefix = append(childPrefix, cs...)
+		} else {
+			childPrefix = nil
+		}
+	}
+
+	node := c.Node
+	same := sql.SameTree
+	if newChildren != nil {
+		var err error
+		node, err = c.Node.WithChildren(newChildren...)
+		if err != nil {
+			return nil, sql.SameTree, err
+		}
+		same = sql.NewTree
+	}
+
+	node, identity, err := f(TransformContext{node, c.Parent, c.ChildNum, c.SchemaPrefix})
+	if err != nil {
+		return nil, sql.SameTree, err
+	}
+	return node, same && identity, nil
+}
+
 // TransformUp applies a transformation function to the given tree from the
 // bottom up.
 func TransformUp(node sql.Node, f sql.TransformNodeFunc) (sql.Node, error) {
@@ -147,3 +229,50 @@ func TransformExpressionsWithNode(n sql.Node, f expression.TransformExprWithNode
 
 	return e.WithExpressions(newExprs...)
 }
+
+// TransformExpressionsUpWithIdentity applies a transformation function to
+// all expressions on the given tree from the bottom up, passing each
+// expression with the node that contains it. Nodes and expressions that
+// weren't transformed aren't rebuilt, and the tree is returned as is, along
+// with sql.SameTree, if none of its expressions were transformed.
+func TransformExpressionsUpWithIdentity(node sql.Node, f expression.ExprTransformer) (sql.Node, sql.TreeIdentity, error) {
+	return TransformUpWithIdentity(node, nil, func(c TransformContext) (sql.Node, sql.TreeIdentity, error) {
+		return TransformExpressionsWithIdentity(c.Node, f)
+	})
+}
+
+// TransformExpressionsWithIdentity applies a transformation function to all
+// expressions on the given node, but not on its children. The node is
+// returned as is, along with sql.SameTree, if none of its expressions were
+// transformed.
+func TransformExpressionsWithIdentity(n sql.Node, f expression.ExprTransformer) (sql.Node, sql.TreeIdentity, error) {
+	e, ok := n.(sql.Expressioner)
+	if !ok {
+		return n, sql.SameTree, nil
+	}
+
+	exprs := e.Expressions()
+	var newExprs []sql.Expression
+	for i, e := range exprs {
+		e, identity, err := expression.TransformUpWithIdentity(n, e, f)
+		if err != nil {
+			return nil, sql.SameTree, err
+		}
+		if identity == sql.NewTree {
+			if newExprs == nil {
+				newExprs = make([]sql.Expression, len(exprs))
+				copy(newExprs, exprs)
+			}
+			newExprs[i] = e
+		}
+	}
+
+	if newExprs == nil {
+		return n, sql.SameTree, nil
+	}
+	n, err := e.WithExpressions(newExprs...)
+	if err != nil {
+		return nil, sql.SameTree, err
+	}
+	return n, sql.NewTree, nil
+}
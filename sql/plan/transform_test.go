@@ -56,3 +56,80 @@ func TestTransformUp(t *testing.T) {
 	)
 	require.Equal(ep, pt)
 }
+
+func TestTransformUpWithIdentity(t *testing.T) {
+	require := require.New(t)
+
+	schema := sql.NewPrimaryKeySchema(sql.Schema{
+		{Name: "a", Type: sql.Text},
+	})
+	table := memory.NewTable("resolved", schema)
+	left := NewFilter(expression.NewUnresolvedColumn("a"), NewUnresolvedTable("unresolved", ""))
+	right := NewResolvedTable(table, nil, nil)
+	join := NewCrossJoin(left, right)
+
+	// Trees with no transformed nodes are returned as is
+	n, same, err := TransformUpWithIdentity(join, nil, func(c TransformContext) (sql.Node, sql.TreeIdentity, error) {
+		return c.Node, sql.SameTree, nil
+	})
+	require.NoError(err)
+	require.Equal(sql.SameTree, same)
+	require.True(n == join)
+
+	var parents []sql.Node
+	n, same, err = TransformUpWithIdentity(join, nil, func(c TransformContext) (sql.Node, sql.TreeIdentity, error) {
+		switch c.Node.(type) {
+		case *UnresolvedTable:
+			parents = append(parents, c.Parent)
+			return NewResolvedTable(table, nil, nil), sql.NewTree, nil
+		default:
+			return c.Node, sql.SameTree, nil
+		}
+	})
+	require.NoError(err)
+	require.Equal(sql.NewTree, same)
+	require.Equal(NewCrossJoin(
+		NewFilter(expression.NewUnresolvedColumn("a"), NewResolvedTable(table, nil, nil)),
+		right,
+	), n)
+	require.Equal([]sql.Node{left}, parents)
+
+	// Untransformed subtrees are kept as is
+	require.True(n.Children()[1] == right)
+}
+
+func TestTransformExpressionsUpWithIdentity(t *testing.T) {
+	require := require.New(t)
+
+	aCol := expression.NewUnresolvedColumn("a")
+	bCol := expression.NewUnresolvedColumn("b")
+	filter := NewFilter(expression.NewEquals(aCol, bCol), NewUnresolvedTable("unresolved", ""))
+	p := NewProject([]sql.Expression{aCol}, filter)
+
+	var nodes []sql.Node
+	n, same, err := TransformExpressionsUpWithIdentity(p, func(n sql.Node, e sql.Expression) (sql.Expression, sql.TreeIdentity, error) {
+		if col, ok := e.(*expression.UnresolvedColumn); ok && col.Name() == "b" {
+			nodes = append(nodes, n)
+			return expression.NewLiteral("b", sql.LongText), sql.NewTree, nil
+		}
+		return e, sql.SameTree, nil
+	})
+	require.NoError(err)
+	require.Equal(sql.NewTree, same)
+	require.Equal(NewProject(
+		[]sql.Expression{aCol},
+		NewFilter(
+			expression.NewEquals(aCol, expression.NewLiteral("b", sql.LongText)),
+			NewUnresolvedTable("unresolved", ""),
+		),
+	), n)
+	require.Equal([]sql.Node{filter}, nodes)
+	require.True(n.(*Project).Projections[0] == aCol)
+
+	n, same, err = TransformExpressionsUpWithIdentity(p, func(n sql.Node, e sql.Expression) (sql.Expression, sql.TreeIdentity, error) {
+		return e, sql.SameTree, nil
+	})
+	require.NoError(err)
+	require.Equal(sql.SameTree, same)
+	require.True(n == p)
+}
@@ -175,7 +175,7 @@ func (u *updateIter) Next(ctx *sql.Context) (sql.Row, error) {
 				return nil, err
 			}
 
-			err = u.updater.Update(ctx, oldRow, newRow)
+			err = updateRow(ctx, u.updater, u.schema, oldRow, newRow)
 			if err != nil {
 				return nil, err
 			}
@@ -187,6 +187,24 @@ func (u *updateIter) Next(ctx *sql.Context) (sql.Row, error) {
 	return oldAndNewRow, nil
 }
 
+// updateRow updates the row given with the updater given. Updaters that implement sql.PartialRowUpdater are given the
+// columns of the schema that changed, and aren't called for rows that didn't change.
+func updateRow(ctx *sql.Context, updater sql.RowUpdater, schema sql.Schema, oldRow, newRow sql.Row) error {
+	partial, ok := updater.(sql.PartialRowUpdater)
+	if !ok {
+		return updater.Update(ctx, oldRow, newRow)
+	}
+
+	changed, err := oldRow.ChangedColumns(newRow, schema)
+	if err != nil {
+		return err
+	}
+	if changed.IsEmpty() {
+		return nil
+	}
+	return partial.UpdateColumns(ctx, oldRow, newRow, changed)
+}
+
 // Applies the update expressions given to the row given, returning the new resultant row.
 // TODO: a set of update expressions should probably be its own expression type with an Eval method that does this
 func applyUpdateExpressions(ctx *sql.Context, updateExprs []sql.Expression, row sql.Row) (sql.Row, error) {
@@ -205,6 +223,49 @@ func applyUpdateExpressions(ctx *sql.Context, updateExprs []sql.Expression, row
 	return prev, nil
 }
 
+// applyOnUpdateExpressions sets the columns of the new row that have an ON UPDATE clause to their ON UPDATE value when
+// any other column of the same table was changed by the update, unless the update explicitly set them. The update
+// expressions index the rows before the first |offset| values were trimmed from them.
+func applyOnUpdateExpressions(ctx *sql.Context, schema sql.Schema, updateExprs []sql.Expression, oldRow, newRow sql.Row, offset int) error {
+	explicit := make(map[int]bool)
+	for _, updateExpr := range updateExprs {
+		if idx, ok := getFieldIndexFromUpdateExpr(updateExpr); ok {
+			explicit[idx-offset] = true
+		}
+	}
+
+	for i, col := range schema {
+		if col.OnUpdate == nil || explicit[i] {
+			continue
+		}
+
+		changed := false
+		for j, other := range schema {
+			if j == i || other.Source != col.Source {
+				continue
+			}
+			cmp, err := other.Type.Compare(oldRow[j], newRow[j])
+			if err != nil {
+				return err
+			}
+			if cmp != 0 {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			continue
+		}
+
+		val, err := col.OnUpdate.Eval(ctx, newRow)
+		if err != nil {
+			return err
+		}
+		newRow[i] = val
+	}
+	return nil
+}
+
 func (u *updateIter) validateNullability(row sql.Row, schema sql.Schema) error {
 	for idx, col := range schema {
 		if !col.Nullable && row[idx] == nil {
@@ -227,12 +288,13 @@ func (u *updateIter) Close(ctx *sql.Context) error {
 }
 
 func newUpdateIter(
+	ctx *sql.Context,
 	childIter sql.RowIter,
 	schema sql.Schema,
 	updater sql.RowUpdater,
 	checks sql.CheckConstraints,
 ) sql.RowIter {
-	return NewTableEditorIter(updater, &updateIter{
+	return NewTableEditorIter(ctx, updater, &updateIter{
 		childIter: childIter,
 		updater:   updater,
 		schema:    schema,
@@ -253,7 +315,7 @@ func (u *Update) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 		return nil, err
 	}
 
-	return newUpdateIter(iter, updatable.Schema(), updater, u.Checks), nil
+	return newUpdateIter(ctx, iter, updatable.Schema(), updater, u.Checks), nil
 }
 
 // WithChildren implements the Node interface.
@@ -157,16 +157,10 @@ func (u *updateJoinIter) Next(ctx *sql.Context) (sql.Row, error) {
 // the left or right side of the join (given the direction). A row of all nils that does not pass condition 1 must not
 // be part of the update operation. This is follows the logic as established in the joinIter.
 func (u *updateJoinIter) shouldUpdateDirectionalJoin(ctx *sql.Context, joinRow, tableRow sql.Row) (bool, error) {
-	jn := u.joinNode.(JoinNode)
-	var cond sql.Expression
-	switch n := jn.(type) {
-	case *RightJoin:
-		cond = n.Cond
-	case *LeftJoin:
-		cond = n.Cond
-	default:
+	if !isRightOrLeftJoin(u.joinNode) {
 		return true, fmt.Errorf("error: should only consider left or right join.")
 	}
+	cond := u.joinNode.(JoinNode).JoinCond()
 
 	// If the overall row fits the join condition it is fine (i.e. middle of the venn diagram).
 	val, err := cond.Eval(ctx, joinRow)
@@ -308,7 +302,7 @@ func (u *updatableJoinUpdater) Update(ctx *sql.Context, old sql.Row, new sql.Row
 		}
 
 		if !eq {
-			err = updater.Update(ctx, oldRow, newRow)
+			err = updateRow(ctx, updater, schema, oldRow, newRow)
 		}
 
 		if err != nil {
@@ -109,11 +109,17 @@ func (u *updateSourceIter) Next(ctx *sql.Context) (sql.Row, error) {
 	// scope, which will be the first N values in the row.
 	// TODO: handle this in the analyzer instead?
 	expectedSchemaLen := len(u.tableSchema)
+	offset := 0
 	if expectedSchemaLen < len(oldRow) {
-		oldRow = oldRow[len(oldRow)-expectedSchemaLen:]
+		offset = len(oldRow) - expectedSchemaLen
+		oldRow = oldRow[offset:]
 		newRow = newRow[len(newRow)-expectedSchemaLen:]
 	}
 
+	if err := applyOnUpdateExpressions(ctx, u.tableSchema, u.updateExprs, oldRow, newRow, offset); err != nil {
+		return nil, err
+	}
+
 	return oldRow.Append(newRow), nil
 }
 
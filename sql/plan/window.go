@@ -143,7 +143,11 @@ func windowToIter(w *Window) ([]*aggregation.WindowPartitionIter, [][]int, error
 		if err != nil {
 			return nil, nil, err
 		}
-		agg = aggregation.NewAggregation(fn, fn.DefaultFramer())
+		framer, err := aggregation.NewWindowFramer(fn, window)
+		if err != nil {
+			return nil, nil, err
+		}
+		agg = aggregation.NewAggregation(fn, framer)
 
 		id, err := window.PartitionId()
 		if err != nil {
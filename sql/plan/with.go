@@ -26,6 +26,8 @@ import (
 type With struct {
 	UnaryNode
 	CTEs []*CommonTableExpression
+	// Recursive is whether the CTEs are defined with WITH RECURSIVE, which lets them refer to themselves.
+	Recursive bool
 }
 
 func NewWith(child sql.Node, ctes []*CommonTableExpression) *With {
@@ -42,7 +44,7 @@ func (w *With) String() string {
 	}
 
 	pr := sql.NewTreePrinter()
-	_ = pr.WriteNode("With(%s)", strings.Join(cteStrings, ", "))
+	_ = pr.WriteNode("%s(%s)", w.name(), strings.Join(cteStrings, ", "))
 	_ = pr.WriteChildren(w.Child.String())
 	return pr.String()
 }
@@ -54,11 +56,18 @@ func (w *With) DebugString() string {
 	}
 
 	pr := sql.NewTreePrinter()
-	_ = pr.WriteNode("With(%s)", strings.Join(cteStrings, ", "))
+	_ = pr.WriteNode("%s(%s)", w.name(), strings.Join(cteStrings, ", "))
 	_ = pr.WriteChildren(sql.DebugString(w.Child))
 	return pr.String()
 }
 
+func (w *With) name() string {
+	if w.Recursive {
+		return "With recursive"
+	}
+	return "With"
+}
+
 func (w *With) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter, error) {
 	panic("Cannot call RowIter on With node")
 }
@@ -68,12 +77,43 @@ func (w *With) WithChildren(children ...sql.Node) (sql.Node, error) {
 		return nil, sql.ErrInvalidChildrenNumber.New(w, len(children), 1)
 	}
 
-	return NewWith(children[0], w.CTEs), nil
+	nw := *w
+	nw.Child = children[0]
+	return &nw, nil
+}
+
+// CteMaterialization is whether the rows of a common table expression are stored once they're computed, as requested
+// with the MATERIALIZED and NOT MATERIALIZED keywords of its definition.
+type CteMaterialization byte
+
+const (
+	// CteMaterializationDefault leaves it to the analyzer to decide whether the rows are stored.
+	CteMaterializationDefault CteMaterialization = iota
+	// CteMaterialized stores the rows of each reference to the expression the first time they're computed, and reads
+	// them from the storage for the rest of the query.
+	CteMaterialized
+	// CteNotMaterialized computes the rows of the expression every time they're read.
+	CteNotMaterialized
+)
+
+// keyword returns the keyword that requests this materialization in the definition of a common table expression,
+// followed by a space, or an empty string for the default.
+func (m CteMaterialization) keyword() string {
+	switch m {
+	case CteMaterialized:
+		return "MATERIALIZED "
+	case CteNotMaterialized:
+		return "NOT MATERIALIZED "
+	default:
+		return ""
+	}
 }
 
 type CommonTableExpression struct {
 	Subquery *SubqueryAlias
 	Columns  []string
+	// Materialization is whether the rows of the expression are stored once they're computed.
+	Materialization CteMaterialization
 }
 
 func NewCommonTableExpression(subquery *SubqueryAlias, columns []string) *CommonTableExpression {
@@ -85,14 +125,14 @@ func NewCommonTableExpression(subquery *SubqueryAlias, columns []string) *Common
 
 func (e *CommonTableExpression) String() string {
 	if len(e.Columns) > 0 {
-		return fmt.Sprintf("%s (%s) AS %s", e.Subquery.name, strings.Join(e.Columns, ","), e.Subquery.Child)
+		return fmt.Sprintf("%s (%s) AS %s%s", e.Subquery.name, strings.Join(e.Columns, ","), e.Materialization.keyword(), e.Subquery.Child)
 	}
-	return fmt.Sprintf("%s AS %s", e.Subquery.name, e.Subquery.Child)
+	return fmt.Sprintf("%s AS %s%s", e.Subquery.name, e.Materialization.keyword(), e.Subquery.Child)
 }
 
 func (e *CommonTableExpression) DebugString() string {
 	if len(e.Columns) > 0 {
-		return fmt.Sprintf("%s (%s) AS %s", e.Subquery.name, strings.Join(e.Columns, ","), sql.DebugString(e.Subquery))
+		return fmt.Sprintf("%s (%s) AS %s%s", e.Subquery.name, strings.Join(e.Columns, ","), e.Materialization.keyword(), sql.DebugString(e.Subquery))
 	}
 	return fmt.Sprintf("%s AS %s", e.Subquery.name, e)
 }
@@ -28,9 +28,15 @@ type Point struct {
 	Y    float64
 }
 
-type PointType struct{}
+// PointType is the type of a column of points. The column may be restricted to the points of a single spatial reference
+// system, with the SRID attribute.
+type PointType struct {
+	SRID        uint32
+	DefinedSRID bool
+}
 
 var _ Type = PointType{}
+var _ SpatialColumnType = PointType{}
 
 var ErrNotPoint = errors.NewKind("value of type %T is not a point")
 
@@ -73,8 +79,22 @@ func (t PointType) Compare(a interface{}, b interface{}) (int, error) {
 
 // Convert implements Type interface.
 func (t PointType) Convert(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	// Bytes are a geometry in the internal format, like clients send
+	if g, ok, err := geometryFromBytes(v); err != nil {
+		return nil, err
+	} else if ok {
+		v = g
+	}
+
 	// Must be a Point, fail otherwise
 	if v, ok := v.(Point); ok {
+		if err := matchSRID(v, t.SRID, t.DefinedSRID); err != nil {
+			return nil, err
+		}
 		return v, nil
 	}
 
@@ -94,10 +114,11 @@ func (t PointType) SQL(v interface{}) (sqltypes.Value, error) {
 
 	pv, err := t.Convert(v)
 	if err != nil {
-		return sqltypes.Value{}, nil
+		return sqltypes.Value{}, err
 	}
 
-	return sqltypes.MakeTrusted(sqltypes.Geometry, []byte(pv.(string))), nil
+	buf, _ := SerializeGeometry(pv)
+	return sqltypes.MakeTrusted(sqltypes.Geometry, buf), nil
 }
 
 // String implements Type interface.
@@ -105,6 +126,18 @@ func (t PointType) String() string {
 	return "POINT"
 }
 
+// GetSpatialTypeSRID implements SpatialColumnType interface.
+func (t PointType) GetSpatialTypeSRID() (uint32, bool) {
+	return t.SRID, t.DefinedSRID
+}
+
+// SetSRID implements SpatialColumnType interface.
+func (t PointType) SetSRID(srid uint32) Type {
+	t.SRID = srid
+	t.DefinedSRID = true
+	return t
+}
+
 // Type implements Type interface.
 func (t PointType) Type() query.Type {
 	return sqltypes.Geometry
@@ -112,5 +145,5 @@ func (t PointType) Type() query.Type {
 
 // Zero implements Type interface.
 func (t PointType) Zero() interface{} {
-	return Point{X: 0.0, Y: 0.0}
+	return Point{SRID: t.SRID, X: 0.0, Y: 0.0}
 }
@@ -28,9 +28,15 @@ type Polygon struct {
 	Lines []Linestring
 }
 
-type PolygonType struct{}
+// PolygonType is the type of a column of polygons. The column may be restricted to the polygons of a single spatial reference
+// system, with the SRID attribute.
+type PolygonType struct {
+	SRID        uint32
+	DefinedSRID bool
+}
 
 var _ Type = PolygonType{}
+var _ SpatialColumnType = PolygonType{}
 
 var ErrNotPolygon = errors.NewKind("value of type %T is not a polygon")
 
@@ -86,8 +92,22 @@ func (t PolygonType) Compare(a interface{}, b interface{}) (int, error) {
 
 // Convert implements Type interface.
 func (t PolygonType) Convert(v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	// Bytes are a geometry in the internal format, like clients send
+	if g, ok, err := geometryFromBytes(v); err != nil {
+		return nil, err
+	} else if ok {
+		v = g
+	}
+
 	// Must be a Polygon, fail otherwise
 	if v, ok := v.(Polygon); ok {
+		if err := matchSRID(v, t.SRID, t.DefinedSRID); err != nil {
+			return nil, err
+		}
 		return v, nil
 	}
 
@@ -107,10 +127,11 @@ func (t PolygonType) SQL(v interface{}) (sqltypes.Value, error) {
 
 	lv, err := t.Convert(v)
 	if err != nil {
-		return sqltypes.Value{}, nil
+		return sqltypes.Value{}, err
 	}
 
-	return sqltypes.MakeTrusted(sqltypes.Geometry, []byte(lv.(string))), nil
+	buf, _ := SerializeGeometry(lv)
+	return sqltypes.MakeTrusted(sqltypes.Geometry, buf), nil
 }
 
 // String implements Type interface.
@@ -118,6 +139,18 @@ func (t PolygonType) String() string {
 	return "POLYGON"
 }
 
+// GetSpatialTypeSRID implements SpatialColumnType interface.
+func (t PolygonType) GetSpatialTypeSRID() (uint32, bool) {
+	return t.SRID, t.DefinedSRID
+}
+
+// SetSRID implements SpatialColumnType interface.
+func (t PolygonType) SetSRID(srid uint32) Type {
+	t.SRID = srid
+	t.DefinedSRID = true
+	return t
+}
+
 // Type implements Type interface.
 func (t PolygonType) Type() query.Type {
 	return sqltypes.Geometry
@@ -125,5 +158,6 @@ func (t PolygonType) Type() query.Type {
 
 // Zero implements Type interface.
 func (t PolygonType) Zero() interface{} {
-	return Polygon{Lines: []Linestring{{Points: []Point{{}, {}, {}, {}}}}}
+	p := Point{SRID: t.SRID}
+	return Polygon{SRID: t.SRID, Lines: []Linestring{{SRID: t.SRID, Points: []Point{p, p, p, p}}}}
 }
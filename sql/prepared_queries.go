@@ -0,0 +1,94 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+)
+
+// PreparedQuery is a statement prepared by a PREPARE statement, which EXECUTE statements execute with the values of
+// their user variables bound to its parameters, until it's deallocated.
+type PreparedQuery struct {
+	// Name is the name the statement is referred to by.
+	Name string
+	// Query is the text of the statement.
+	Query string
+	// Statement is the parsed statement, whose parameters are bind variables named v1, v2 and so on, in order.
+	Statement Node
+	// ParamCount is the number of parameters of the statement.
+	ParamCount int
+}
+
+// PreparedQueries holds the statements prepared by each session.
+type PreparedQueries struct {
+	mu      sync.Mutex
+	queries map[uint32]map[string]*PreparedQuery
+}
+
+// NewPreparedQueries returns a new, empty set of prepared statements.
+func NewPreparedQueries() *PreparedQueries {
+	return &PreparedQueries{queries: make(map[uint32]map[string]*PreparedQuery)}
+}
+
+// Prepare adds the statement given to the statements of the session with the id given, replacing the statement with
+// the same name, if any.
+func (p *PreparedQueries) Prepare(id uint32, query *PreparedQuery) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	session, ok := p.queries[id]
+	if !ok {
+		session = make(map[string]*PreparedQuery)
+		p.queries[id] = session
+	}
+	session[strings.ToLower(query.Name)] = query
+}
+
+// Get returns the statement with the name given of the session with the id given. The statement given is the one
+// that looks it up, for the error returned when there's no such statement.
+func (p *PreparedQueries) Get(id uint32, name, statement string) (*PreparedQuery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	query, ok := p.queries[id][strings.ToLower(name)]
+	if !ok {
+		return nil, ErrUnknownPreparedStatement.New(name, statement)
+	}
+	return query, nil
+}
+
+// Deallocate removes the statement with the name given from the statements of the session with the id given.
+func (p *PreparedQueries) Deallocate(id uint32, name string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := strings.ToLower(name)
+	if _, ok := p.queries[id][key]; !ok {
+		return ErrUnknownPreparedStatement.New(name, "DEALLOCATE PREPARE")
+	}
+	delete(p.queries[id], key)
+	if len(p.queries[id]) == 0 {
+		delete(p.queries, id)
+	}
+	return nil
+}
+
+// DeallocateAll removes every statement of the session with the id given, which is done once the session ends.
+func (p *PreparedQueries) DeallocateAll(id uint32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.queries, id)
+}
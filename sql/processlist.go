@@ -17,9 +17,18 @@ package sql
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 )
 
+// StageAlteringTable is the stage of an ALTER TABLE statement that changes the rows of the table, such as when a
+// column is added, modified or dropped.
+const StageAlteringTable = "stage/sql/altering table"
+
+// StageBuildingIndex is the stage of a statement that waits for an index to be built in the background by an
+// AsyncIndexAlterableTable.
+const StageBuildingIndex = "stage/innodb/alter table (read PK and internal sort)"
+
 type ProcessList interface {
 	// Processes returns the list of current running processes
 	Processes() []Process
@@ -56,6 +65,11 @@ type ProcessList interface {
 	// RemovePartitionProgress removes an existing partition tracking progress from the
 	// process with the given pid, if it exists.
 	RemovePartitionProgress(pid uint64, tableName, partitionName string)
+
+	// UpdateStageProgress sets the stage of the process with the given pid, and the
+	// work done and estimated for it. The time the stage started is kept as long as
+	// the name of the stage doesn't change.
+	UpdateStageProgress(pid uint64, stage string, done, total int64)
 }
 
 // Process represents a process in the SQL server.
@@ -65,6 +79,7 @@ type Process struct {
 	User       string
 	Query      string
 	Progress   map[string]TableProgress
+	Stage      StageProgress
 	StartedAt  time.Time
 	Kill       context.CancelFunc
 }
@@ -121,6 +136,19 @@ func (p PartitionProgress) String() string {
 	return fmt.Sprintf("%s (%d/%s rows)", p.Name, p.Done, p.totalString())
 }
 
+// StageProgress keeps track of the stage a process is in, such as the rewrite of the
+// rows of a table by an ALTER TABLE statement. A process isn't in any stage if the
+// name is empty.
+type StageProgress struct {
+	Progress
+	StartedAt time.Time
+}
+
+func (p StageProgress) String() string {
+	name := p.Name[strings.LastIndex(p.Name, "/")+1:]
+	return fmt.Sprintf("%s (%d/%s)", name, p.Done, p.totalString())
+}
+
 // EmptyProcessList is a no-op implementation of ProcessList suitable for use in tests or other installations that
 // don't require a process list
 type EmptyProcessList struct{}
@@ -145,3 +173,4 @@ func (e EmptyProcessList) AddPartitionProgress(pid uint64, tableName, partitionN
 }
 func (e EmptyProcessList) RemoveTableProgress(pid uint64, name string)                         {}
 func (e EmptyProcessList) RemovePartitionProgress(pid uint64, tableName, partitionName string) {}
+func (e EmptyProcessList) UpdateStageProgress(pid uint64, stage string, done, total int64)     {}
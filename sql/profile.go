@@ -0,0 +1,334 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime/pprof"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	opentracing "github.com/opentracing/opentracing-go"
+)
+
+// QueryProfile is the profile of the execution of a query: the rows returned by each operator of its plan, and the
+// time spent computing them. A query is profiled when its context is created with the WithQueryProfile option, and
+// the operators are the nodes whose row iterators are created with NewSpanIter.
+//
+// While an operator computes a row, the goroutine computing it is labeled with the "operator" and "operator_path"
+// pprof labels, so that CPU profiles taken with runtime/pprof during the query attribute their samples to operators.
+type QueryProfile struct {
+	mu sync.Mutex
+	// Operators are the root operators of the plan, usually just one.
+	Operators []*OperatorProfile
+}
+
+// OperatorProfile is the profile of an operator of a query plan. The row iterators of an operator with the same
+// name and attributes, created while computing the rows of the same parent, are profiled as one, such as those the
+// secondary side of a join creates for each row of its primary side.
+type OperatorProfile struct {
+	// Name is the name of the operator, such as plan.Project.
+	Name string `json:"name"`
+	// Attributes are the tags of the span of the operator, such as the table it reads from.
+	Attributes map[string]string `json:"attributes,omitempty"`
+	// Iterations is the number of row iterators of the operator created.
+	Iterations int `json:"iterations"`
+	// Rows is the number of rows returned by all the row iterators of the operator.
+	Rows int64 `json:"rows"`
+	// Time is the time spent computing the rows of the operator, including the time spent by its children.
+	Time time.Duration `json:"time_ns"`
+	// Children are the operators whose rows this one reads.
+	Children []*OperatorProfile `json:"children,omitempty"`
+
+	key string
+}
+
+// NewQueryProfile returns an empty profile to collect the profile of a query into.
+func NewQueryProfile() *QueryProfile {
+	return &QueryProfile{}
+}
+
+// WithQueryProfile profiles the execution of the query of the context into the profile given.
+func WithQueryProfile(p *QueryProfile) ContextOption {
+	return func(ctx *Context) {
+		ctx.profile = p
+	}
+}
+
+// SelfTime returns the time spent computing the rows of this operator, excluding the time spent by its children.
+func (p *OperatorProfile) SelfTime() time.Duration {
+	self := p.Time
+	for _, c := range p.Children {
+		self -= c.Time
+	}
+	if self < 0 {
+		// The children of some operators, such as exchanges, compute their rows concurrently
+		return 0
+	}
+	return self
+}
+
+// MarshalJSON implements the json.Marshaler interface, adding the self time of the operator.
+func (p *OperatorProfile) MarshalJSON() ([]byte, error) {
+	type operatorProfile OperatorProfile
+	return json.Marshal(struct {
+		*operatorProfile
+		SelfTime time.Duration `json:"self_time_ns"`
+	}{(*operatorProfile)(p), p.SelfTime()})
+}
+
+// WriteJSON writes the profile to the writer given as a JSON object, with the operators of the plan as a tree.
+func (p *QueryProfile) WriteJSON(w io.Writer) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	operators := p.Operators
+	if operators == nil {
+		operators = []*OperatorProfile{}
+	}
+	return json.NewEncoder(w).Encode(struct {
+		Operators []*OperatorProfile `json:"operators"`
+	}{operators})
+}
+
+// WriteFoldedStacks writes the profile to the writer given in the folded stacks format of flame graph tools: a line
+// for each operator, with the names of the operators from the root to it separated by semicolons, followed by the
+// self time of the operator in microseconds.
+func (p *QueryProfile) WriteFoldedStacks(w io.Writer) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var write func(prefix string, ops []*OperatorProfile) error
+	write = func(prefix string, ops []*OperatorProfile) error {
+		for _, op := range ops {
+			stack := prefix + op.Name
+			if table, ok := op.Attributes["table"]; ok {
+				stack += "(" + table + ")"
+			}
+			if _, err := fmt.Fprintf(w, "%s %d\n", stack, op.SelfTime().Microseconds()); err != nil {
+				return err
+			}
+			if err := write(stack+";", op.Children); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return write("", p.Operators)
+}
+
+// profiledOperator is a span started while profiling a query. It's only added to the profile once a row iterator of
+// it is used, so that spans which aren't operators, such as those of the analysis, are left out.
+type profiledOperator struct {
+	profile    *QueryProfile
+	parent     *profiledOperator
+	name       string
+	attributes map[string]string
+	path       string
+	// labels is the context with the pprof labels of this operator, and parentLabels the one with those of its parent.
+	labels       context.Context
+	parentLabels context.Context
+	// profiled is the operator profile this one is profiled as, once it's added to the profile.
+	profiled *OperatorProfile
+}
+
+// profiledSpan is the span of an operator of a query being profiled.
+type profiledSpan struct {
+	opentracing.Span
+	operator *profiledOperator
+}
+
+// startOperator returns the operator of the span named and started with the options given, with the parent given,
+// if any. Its labels are added to the context given.
+func (p *QueryProfile) startOperator(ctx context.Context, parent *profiledOperator, name string, opts []opentracing.StartSpanOption) *profiledOperator {
+	var spanOpts opentracing.StartSpanOptions
+	for _, opt := range opts {
+		opt.Apply(&spanOpts)
+	}
+	var attributes map[string]string
+	for k, v := range spanOpts.Tags {
+		if attributes == nil {
+			attributes = make(map[string]string)
+		}
+		attributes[k] = fmt.Sprint(v)
+	}
+
+	path := name
+	parentLabels := ctx
+	if parent != nil {
+		path = parent.path + "/" + name
+		parentLabels = parent.labels
+	}
+	return &profiledOperator{
+		profile:      p,
+		parent:       parent,
+		name:         name,
+		attributes:   attributes,
+		path:         path,
+		labels:       pprof.WithLabels(ctx, pprof.Labels("operator", name, "operator_path", path)),
+		parentLabels: parentLabels,
+	}
+}
+
+// add adds the operator given to the profile, as a child of its closest ancestor already in the profile, and returns
+// the operator profile it's profiled as.
+func (p *QueryProfile) add(op *profiledOperator) *OperatorProfile {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	siblings := &p.Operators
+	for parent := op.parent; parent != nil; parent = parent.parent {
+		if parent.profiled != nil {
+			siblings = &parent.profiled.Children
+			break
+		}
+	}
+
+	// Maps are formatted with their keys sorted
+	key := op.name + fmt.Sprint(op.attributes)
+	for _, s := range *siblings {
+		if s.key == key {
+			s.Iterations++
+			op.profiled = s
+			return s
+		}
+	}
+
+	profiled := &OperatorProfile{Name: op.name, Attributes: op.attributes, Iterations: 1, key: key}
+	*siblings = append(*siblings, profiled)
+	op.profiled = profiled
+	return profiled
+}
+
+// record adds the rows returned by a row iterator of an operator, and the time spent computing them, to its profile.
+func (p *QueryProfile) record(op *OperatorProfile, rows int64, elapsed time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	op.Rows += rows
+	op.Time += elapsed
+}
+
+// profiledIter is a row iterator of an operator of a query being profiled. The operator is added to the profile when
+// the iterator is first used rather than when it's created, since the iterators of the children of an operator are
+// usually created before that of the operator.
+type profiledIter struct {
+	iter     RowIter
+	operator *profiledOperator
+	profiled *OperatorProfile
+	rows     int64
+	elapsed  time.Duration
+	done     bool
+}
+
+func newProfiledIter(op *profiledOperator, iter RowIter) *profiledIter {
+	return &profiledIter{
+		iter:     iter,
+		operator: op,
+	}
+}
+
+func (i *profiledIter) Next(ctx *Context) (Row, error) {
+	if i.profiled == nil {
+		i.profiled = i.operator.profile.add(i.operator)
+	}
+
+	// The spans started while computing the row, such as those of the secondary side of a join or of subqueries, are
+	// children of this operator
+	nctx := *ctx
+	nctx.operator = i.operator
+
+	start := time.Now()
+	pprof.SetGoroutineLabels(i.operator.labels)
+	row, err := i.iter.Next(&nctx)
+	pprof.SetGoroutineLabels(i.operator.parentLabels)
+	i.elapsed += time.Since(start)
+
+	if err != nil {
+		i.finish()
+		return nil, err
+	}
+	i.rows++
+	return row, nil
+}
+
+func (i *profiledIter) finish() {
+	if i.profiled == nil {
+		i.profiled = i.operator.profile.add(i.operator)
+	}
+	if !i.done {
+		i.operator.profile.record(i.profiled, i.rows, i.elapsed)
+		i.done = true
+	}
+}
+
+func (i *profiledIter) Close(ctx *Context) error {
+	i.finish()
+	return i.iter.Close(ctx)
+}
+
+// QueryProfile returns the profile the execution of the query of this context is profiled into, if any.
+func (c *Context) QueryProfile() *QueryProfile {
+	return c.profile
+}
+
+// profileSpan returns the span given, started with the name and options given, as an operator of the query profile of
+// this context, along with the context to start the spans of its children with. The context given is the one with
+// the span.
+func (c *Context) profileSpan(ctx context.Context, span opentracing.Span, opName string, opts []opentracing.StartSpanOption) (opentracing.Span, *Context) {
+	op := c.profile.startOperator(ctx, c.operator, opName, opts)
+	nc := c.WithContext(op.labels)
+	nc.operator = op
+	return &profiledSpan{Span: span, operator: op}, nc
+}
+
+// sortedAttributes returns the attributes of the operator given, as key=value pairs sorted by key.
+func sortedAttributes(op *OperatorProfile) []string {
+	attrs := make([]string, 0, len(op.Attributes))
+	for k, v := range op.Attributes {
+		attrs = append(attrs, k+"="+v)
+	}
+	sort.Strings(attrs)
+	return attrs
+}
+
+// String returns the profile as a tree of operators, with their rows and times, as EXPLAIN prints plans.
+func (p *QueryProfile) String() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var print func(op *OperatorProfile) string
+	print = func(op *OperatorProfile) string {
+		pr := NewTreePrinter()
+		name := op.Name
+		if attrs := sortedAttributes(op); len(attrs) > 0 {
+			name += "(" + strings.Join(attrs, ", ") + ")"
+		}
+		_ = pr.WriteNode("%s iterations=%d rows=%d time=%s self=%s", name, op.Iterations, op.Rows, op.Time, op.SelfTime())
+		children := make([]string, len(op.Children))
+		for i, c := range op.Children {
+			children[i] = print(c)
+		}
+		_ = pr.WriteChildren(children...)
+		return pr.String()
+	}
+
+	var sb strings.Builder
+	for _, op := range p.Operators {
+		sb.WriteString(print(op))
+	}
+	return sb.String()
+}
@@ -0,0 +1,150 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"runtime/pprof"
+	"strings"
+	"testing"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/require"
+)
+
+// nestedLoopIter returns the rows of its child, and reads all the rows of a new inner iterator for each of them.
+type nestedLoopIter struct {
+	child RowIter
+	inner func(ctx *Context) RowIter
+}
+
+func (i *nestedLoopIter) Next(ctx *Context) (Row, error) {
+	row, err := i.child.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := RowIterToRows(ctx, i.inner(ctx)); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+func (i *nestedLoopIter) Close(ctx *Context) error {
+	return i.child.Close(ctx)
+}
+
+func TestQueryProfile(t *testing.T) {
+	require := require.New(t)
+	profile := NewQueryProfile()
+	ctx := NewContext(context.Background(), WithQueryProfile(profile))
+	require.Equal(profile, ctx.QueryProfile())
+
+	// Spans without row iterators, like those of the analysis, are left out of the profile
+	span, _ := ctx.Span("analyze")
+	span.Finish()
+
+	span, joinCtx := ctx.Span("plan.InnerJoin")
+	require.Equal("plan.InnerJoin", labelOf(joinCtx, "operator"))
+	leftSpan, leftCtx := joinCtx.Span("plan.ResolvedTable", opentracing.Tag{Key: "table", Value: "a"})
+	require.Equal("plan.InnerJoin/plan.ResolvedTable", labelOf(leftCtx, "operator_path"))
+	left := NewSpanIter(leftSpan, RowsToRowIter(NewRow(1), NewRow(2), NewRow(3)))
+
+	iter := NewSpanIter(span, &nestedLoopIter{
+		child: left,
+		inner: func(ctx *Context) RowIter {
+			// The inner operator is started while the join computes a row, under a span which isn't an operator, so
+			// it's a child of the join
+			_, exprCtx := ctx.Span("expression.Subquery")
+			require.Equal("plan.InnerJoin/expression.Subquery", labelOf(exprCtx, "operator_path"))
+			span, _ := exprCtx.Span("plan.ResolvedTable", opentracing.Tag{Key: "table", Value: "b"})
+			return NewSpanIter(span, RowsToRowIter(NewRow(1), NewRow(2)))
+		},
+	})
+	rows, err := RowIterToRows(ctx, iter)
+	require.NoError(err)
+	require.Len(rows, 3)
+
+	require.Len(profile.Operators, 1)
+	join := profile.Operators[0]
+	require.Equal("plan.InnerJoin", join.Name)
+	require.Equal(1, join.Iterations)
+	require.Equal(int64(3), join.Rows)
+	require.Len(join.Children, 2)
+
+	require.Equal("plan.ResolvedTable", join.Children[0].Name)
+	require.Equal(map[string]string{"table": "a"}, join.Children[0].Attributes)
+	require.Equal(1, join.Children[0].Iterations)
+	require.Equal(int64(3), join.Children[0].Rows)
+
+	require.Equal(map[string]string{"table": "b"}, join.Children[1].Attributes)
+	require.Equal(3, join.Children[1].Iterations)
+	require.Equal(int64(6), join.Children[1].Rows)
+	require.True(join.Time >= join.Children[0].Time+join.Children[1].Time)
+	require.Equal(join.Time-join.Children[0].Time-join.Children[1].Time, join.SelfTime())
+
+	var buf bytes.Buffer
+	require.NoError(profile.WriteJSON(&buf))
+	var decoded struct {
+		Operators []struct {
+			Name     string `json:"name"`
+			Rows     int64  `json:"rows"`
+			Children []struct {
+				Attributes map[string]string `json:"attributes"`
+				Iterations int               `json:"iterations"`
+			} `json:"children"`
+		} `json:"operators"`
+	}
+	require.NoError(json.Unmarshal(buf.Bytes(), &decoded))
+	require.Len(decoded.Operators, 1)
+	require.Equal("plan.InnerJoin", decoded.Operators[0].Name)
+	require.Equal(int64(3), decoded.Operators[0].Rows)
+	require.Equal(3, decoded.Operators[0].Children[1].Iterations)
+	require.Equal("b", decoded.Operators[0].Children[1].Attributes["table"])
+
+	buf.Reset()
+	require.NoError(profile.WriteFoldedStacks(&buf))
+	var stacks []string
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		stacks = append(stacks, line[:strings.LastIndex(line, " ")])
+	}
+	require.Equal([]string{
+		"plan.InnerJoin",
+		"plan.InnerJoin;plan.ResolvedTable(a)",
+		"plan.InnerJoin;plan.ResolvedTable(b)",
+	}, stacks)
+}
+
+func TestQueryProfileNotProfiling(t *testing.T) {
+	require := require.New(t)
+	ctx := NewEmptyContext()
+	require.Nil(ctx.QueryProfile())
+
+	span, _ := ctx.Span("plan.ResolvedTable")
+	iter := RowsToRowIter(NewRow(1))
+	require.Equal(iter, NewSpanIter(span, iter))
+
+	_, err := iter.Next(ctx)
+	require.NoError(err)
+	_, err = iter.Next(ctx)
+	require.Equal(io.EOF, err)
+}
+
+func labelOf(ctx context.Context, key string) string {
+	label, _ := pprof.Label(ctx, key)
+	return label
+}
@@ -0,0 +1,42 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// QueryRewriter rewrites the text of a query before the engine parses it, such as to remove syntax that the engine
+// doesn't support.
+type QueryRewriter interface {
+	// RewriteQuery returns the query to parse in place of the query given. Returning the query unchanged leaves it as
+	// is, and returning an error fails the query with that error.
+	RewriteQuery(ctx *Context, query string) (string, error)
+}
+
+// PlanRewriter rewrites the plan of a query after the engine has analyzed it and before it's executed, such as to add
+// row-level security filters.
+type PlanRewriter interface {
+	// RewritePlan returns the node to execute in place of the analyzed node given. The node returned is executed as is,
+	// without being analyzed again. Returning an error fails the query with that error.
+	RewritePlan(ctx *Context, query string, analyzed Node) (Node, error)
+}
+
+// QueryRouter decides where a query is executed once the engine has analyzed it and its PlanRewriters have rewritten
+// it, such as to forward the queries that read sharded tables to the servers holding their shards.
+type QueryRouter interface {
+	// RouteQuery returns the node to execute locally in place of the analyzed node given, which may be the node given
+	// itself. To forward the query to another backend instead, it returns the rows the backend returned along with a
+	// node whose schema describes them, which isn't executed. Forwarded queries aren't subject to the read-only checks
+	// of the engine, so that read replicas may forward writes to their primary. Returning an error fails the query with
+	// that error.
+	RouteQuery(ctx *Context, query string, analyzed Node) (Node, RowIter, error)
+}
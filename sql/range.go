@@ -67,6 +67,17 @@ func (ranges RangeCollection) Intersect(otherRanges RangeCollection) (RangeColle
 	return newRanges, nil
 }
 
+// Contains returns whether the given values, ordered by the index's column expressions, are contained within any of
+// the ranges in this RangeCollection.
+func (ranges RangeCollection) Contains(values []interface{}) (bool, error) {
+	for _, rang := range ranges {
+		if ok, err := rang.Contains(values); err != nil || ok {
+			return ok, err
+		}
+	}
+	return false, nil
+}
+
 // String returns this RangeCollection as a string for display purposes.
 func (ranges RangeCollection) String() string {
 	sb := strings.Builder{}
@@ -218,6 +229,21 @@ func (rang Range) TryMerge(otherRange Range) (Range, bool, error) {
 	return mergedRange, true, nil
 }
 
+// Contains returns whether the given values, ordered by the index's column expressions, are contained within this
+// Range. Only the leading values that have a matching RangeColumnExpr are considered, as a Range may cover a prefix of
+// an index's columns.
+func (rang Range) Contains(values []interface{}) (bool, error) {
+	if len(values) < len(rang) {
+		return false, nil
+	}
+	for i := range rang {
+		if ok, err := rang[i].Contains(values[i]); err != nil || !ok {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
 // IsSubsetOf evaluates whether the calling Range is fully encompassed by the given Range.
 func (rang Range) IsSubsetOf(otherRange Range) (bool, error) {
 	if len(rang) != len(otherRange) {
@@ -167,6 +167,20 @@ func (r RangeColumnExpr) IsEmpty() (bool, error) {
 	return cmp == 0, err
 }
 
+// Contains returns whether the given value is contained within this RangeColumnExpr. NULL values are ordered after all
+// other values, matching the ordering of Type.Compare.
+func (r RangeColumnExpr) Contains(value interface{}) (bool, error) {
+	cmp, err := r.LowerBound.Compare(Below{key: value}, r.typ)
+	if err != nil || cmp > 0 {
+		return false, err
+	}
+	cmp, err = r.UpperBound.Compare(Above{key: value}, r.typ)
+	if err != nil {
+		return false, err
+	}
+	return cmp >= 0, nil
+}
+
 // IsConnected evaluates whether the given RangeColumnExpr overlaps or is adjacent to the calling RangeColumnExpr.
 func (r RangeColumnExpr) IsConnected(other RangeColumnExpr) (bool, error) {
 	if r.typ.String() != other.typ.String() {
@@ -315,6 +315,67 @@ func TestRangeOverlapThreeColumns(t *testing.T) {
 	}
 }
 
+func TestRangeContains(t *testing.T) {
+	ctx := sql.NewEmptyContext()
+	x, y, _, values2, _ := setup()
+
+	tests := []struct {
+		reference sql.Expression
+		ranges    sql.RangeCollection
+	}{
+		{
+			and(gt(x, 2), lte(y, 5)),
+			sql.RangeCollection{
+				r(rgt(2), rlte(5)),
+			},
+		},
+		{
+			or(
+				and(eq(x, 4), gte(y, 3)),
+				and(lt(x, 4), lt(y, 7)),
+			),
+			sql.RangeCollection{
+				r(req(4), rgte(3)),
+				r(rlt(4), rlt(7)),
+			},
+		},
+		{
+			or(cc(x, 2, 3), co(x, 6, 8)),
+			sql.RangeCollection{
+				r(rcc(2, 3)),
+				r(rco(6, 8)),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("Expr:  %s\nRange: %s", test.reference.String(), test.ranges.DebugString()), func(t *testing.T) {
+			for _, row := range values2 {
+				referenceBool, err := test.reference.Eval(ctx, row)
+				require.NoError(t, err)
+				ok, err := test.ranges.Contains(row)
+				require.NoError(t, err)
+				assert.Equal(t, referenceBool, ok, fmt.Sprintf("%v: Ranges: %s", row, test.ranges.DebugString()))
+			}
+		})
+	}
+
+	t.Run("NULL values", func(t *testing.T) {
+		ok, err := r(req(4)).Contains([]interface{}{nil})
+		require.NoError(t, err)
+		assert.False(t, ok)
+		ok, err = r(sql.ClosedRangeColumnExpr(nil, nil, rangeType)).Contains([]interface{}{nil})
+		require.NoError(t, err)
+		assert.True(t, ok)
+		ok, err = r(sql.AllRangeColumnExpr(rangeType)).Contains([]interface{}{nil})
+		require.NoError(t, err)
+		assert.True(t, ok)
+		ok, err = r(req(4)).Contains(nil)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
+
 func setup() (x, y, z sql.Expression, values2, values3 [][]interface{}) {
 	values2 = make([][]interface{}, 0, 100)
 	values3 = make([][]interface{}, 0, 1000)
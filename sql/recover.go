@@ -0,0 +1,62 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"runtime/debug"
+
+	"github.com/sirupsen/logrus"
+)
+
+// PanicToError returns an ErrInternal error for the value |r| recovered from a panic, and logs the panic to |logger|
+// along with the stack of the goroutine that panicked. It must be called by the deferred function that recovered the
+// panic for the stack to be that of the panic.
+func PanicToError(logger *logrus.Entry, r interface{}) error {
+	logger.WithField("stack", string(debug.Stack())).Errorf("recovered from panic: %v", r)
+	return ErrInternal.New(r)
+}
+
+// panicRecoveringIter is a RowIter that converts the panics of the iterator it wraps into errors.
+type panicRecoveringIter struct {
+	iter RowIter
+}
+
+var _ RowIter = panicRecoveringIter{}
+
+// NewPanicRecoveringIter returns a RowIter that returns the rows of the iterator given, converting any panic of its
+// Next and Close methods into an error with PanicToError.
+func NewPanicRecoveringIter(iter RowIter) RowIter {
+	return panicRecoveringIter{iter: iter}
+}
+
+// Next implements the RowIter interface.
+func (i panicRecoveringIter) Next(ctx *Context) (row Row, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			row, err = nil, PanicToError(ctx.GetLogger(), r)
+		}
+	}()
+	return i.iter.Next(ctx)
+}
+
+// Close implements the RowIter interface.
+func (i panicRecoveringIter) Close(ctx *Context) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = PanicToError(ctx.GetLogger(), r)
+		}
+	}()
+	return i.iter.Close(ctx)
+}
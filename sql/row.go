@@ -67,6 +67,55 @@ func (r Row) Equals(row Row, schema Schema) (bool, error) {
 	return true, nil
 }
 
+// ChangedColumns returns the set of the columns of the schema given whose values differ between this row and the row
+// given, which must both have a value for every column of the schema.
+func (r Row) ChangedColumns(row Row, schema Schema) (ColumnSet, error) {
+	if len(row) != len(schema) || len(r) != len(schema) {
+		return nil, ErrUnexpectedRowLength.New(len(schema), len(row))
+	}
+
+	changed := NewColumnSet(len(schema))
+	for i, colLeft := range r {
+		cmp, err := schema[i].Type.Compare(colLeft, row[i])
+		if err != nil {
+			return nil, err
+		}
+		if cmp != 0 {
+			changed.Add(i)
+		}
+	}
+
+	return changed, nil
+}
+
+// ColumnSet is a set of the indexes of columns in a schema, stored as a bitmask.
+type ColumnSet []uint64
+
+// NewColumnSet returns an empty set for a schema with the number of columns given.
+func NewColumnSet(columns int) ColumnSet {
+	return make(ColumnSet, (columns+63)/64)
+}
+
+// Add adds the column with the index given to the set.
+func (m ColumnSet) Add(i int) {
+	m[i/64] |= 1 << uint(i%64)
+}
+
+// Contains returns whether the column with the index given is in the set.
+func (m ColumnSet) Contains(i int) bool {
+	return i/64 < len(m) && m[i/64]&(1<<uint(i%64)) != 0
+}
+
+// IsEmpty returns whether the set has no columns.
+func (m ColumnSet) IsEmpty() bool {
+	for _, word := range m {
+		if word != 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // FormatRow returns a formatted string representing this row's values
 func FormatRow(row Row) string {
 	var sb strings.Builder
@@ -0,0 +1,96 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+)
+
+// RowSecurityPolicy restricts the rows of a table that sessions may read and write to those for which its predicate is
+// true. When a table has more than one policy, a row is visible if any of them allows it.
+type RowSecurityPolicy struct {
+	// Name identifies the policy among the policies of its table.
+	Name     string
+	Database string
+	Table    string
+	// Predicate is a SQL expression over the columns of the table. It may reference attributes of the session, such as
+	// through CURRENT_USER() or user variables.
+	Predicate string
+}
+
+// RowSecurityPolicies holds the row security policies of every table. Policies apply to every session, except those
+// of users with the SUPER privilege.
+type RowSecurityPolicies struct {
+	mu       sync.RWMutex
+	policies map[string][]RowSecurityPolicy
+}
+
+// NewRowSecurityPolicies returns a new, empty set of row security policies.
+func NewRowSecurityPolicies() *RowSecurityPolicies {
+	return &RowSecurityPolicies{policies: make(map[string][]RowSecurityPolicy)}
+}
+
+func rowSecurityKey(database, table string) string {
+	return strings.ToLower(database) + "." + strings.ToLower(table)
+}
+
+// Add adds the policy given, returning an error if its table already has a policy with the same name.
+func (p *RowSecurityPolicies) Add(policy RowSecurityPolicy) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := rowSecurityKey(policy.Database, policy.Table)
+	for _, existing := range p.policies[key] {
+		if strings.EqualFold(existing.Name, policy.Name) {
+			return ErrRowSecurityPolicyExists.New(policy.Name, policy.Table)
+		}
+	}
+	p.policies[key] = append(p.policies[key], policy)
+	return nil
+}
+
+// Drop removes the policy with the name given from the table given.
+func (p *RowSecurityPolicies) Drop(database, table, name string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := rowSecurityKey(database, table)
+	policies := p.policies[key]
+	for i, policy := range policies {
+		if strings.EqualFold(policy.Name, name) {
+			p.policies[key] = append(policies[:i:i], policies[i+1:]...)
+			if len(p.policies[key]) == 0 {
+				delete(p.policies, key)
+			}
+			return nil
+		}
+	}
+	return ErrRowSecurityPolicyNotFound.New(name, table)
+}
+
+// Get returns the policies of the table given.
+func (p *RowSecurityPolicies) Get(database, table string) []RowSecurityPolicy {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return append([]RowSecurityPolicy(nil), p.policies[rowSecurityKey(database, table)]...)
+}
+
+// IsEmpty returns whether there are no policies on any table.
+func (p *RowSecurityPolicies) IsEmpty() bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.policies) == 0
+}
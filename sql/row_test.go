@@ -66,3 +66,35 @@ func TestRowsToRowIter(t *testing.T) {
 	err = iter.Close(ctx)
 	require.NoError(err)
 }
+
+func TestRowChangedColumns(t *testing.T) {
+	require := require.New(t)
+
+	schema := make(Schema, 70)
+	for i := range schema {
+		schema[i] = &Column{Name: "c", Type: Int64}
+	}
+	old := make(Row, len(schema))
+	for i := range old {
+		old[i] = int64(i)
+	}
+
+	changed, err := old.ChangedColumns(old.Copy(), schema)
+	require.NoError(err)
+	require.True(changed.IsEmpty())
+
+	row := old.Copy()
+	row[1] = int64(100)
+	row[65] = int32(65)
+	row[66] = nil
+	changed, err = old.ChangedColumns(row, schema)
+	require.NoError(err)
+	require.False(changed.IsEmpty())
+	for i := range schema {
+		require.Equal(i == 1 || i == 66, changed.Contains(i), "column %d", i)
+	}
+	require.False(changed.Contains(200))
+
+	_, err = old.ChangedColumns(row[1:], schema)
+	require.True(ErrUnexpectedRowLength.Is(err))
+}
@@ -0,0 +1,76 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// SchemaChangeKind is the kind of change a DDL statement makes to a table or database.
+type SchemaChangeKind byte
+
+const (
+	SchemaChangeKind_CreateDatabase SchemaChangeKind = iota
+	SchemaChangeKind_DropDatabase
+	SchemaChangeKind_CreateTable
+	SchemaChangeKind_DropTable
+	SchemaChangeKind_AlterTable
+	SchemaChangeKind_RenameTable
+)
+
+func (k SchemaChangeKind) String() string {
+	switch k {
+	case SchemaChangeKind_CreateDatabase:
+		return "CREATE DATABASE"
+	case SchemaChangeKind_DropDatabase:
+		return "DROP DATABASE"
+	case SchemaChangeKind_CreateTable:
+		return "CREATE TABLE"
+	case SchemaChangeKind_DropTable:
+		return "DROP TABLE"
+	case SchemaChangeKind_AlterTable:
+		return "ALTER TABLE"
+	case SchemaChangeKind_RenameTable:
+		return "RENAME TABLE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// SchemaChange is a change a DDL statement makes to a table or a database.
+type SchemaChange struct {
+	Kind SchemaChangeKind
+	// Database and Table are the names of the table before the change. Table is empty for changes to databases.
+	Database string
+	Table    string
+	// NewDatabase and NewTable are the names of the table after the change, which only differ from the names before
+	// it for renames.
+	NewDatabase string
+	NewTable    string
+	// OldSchema is the schema of the table before the change, or nil for tables being created.
+	OldSchema Schema
+	// NewSchema is the schema of the table after the change. It's only set once the change is made, and is nil for
+	// tables being dropped.
+	NewSchema Schema
+}
+
+// SchemaChangeListener is notified of the changes DDL statements make to tables and databases, so that integrators
+// can make schema changes part of their own transactions, such as to write them in the same commit as data changes,
+// or invalidate anything they cache about the schema. Every listener call covers all the changes of a single
+// statement, in the order they're made.
+type SchemaChangeListener interface {
+	// BeforeSchemaChange is called before the statement given is executed. Returning an error aborts the statement
+	// without making any of the changes.
+	BeforeSchemaChange(ctx *Context, query string, changes []SchemaChange) error
+	// AfterSchemaChange is called after the statement given is executed, with the error it failed with, if any. When
+	// the statement succeeded, the new schemas of the changes are set, and returning an error fails the statement.
+	AfterSchemaChange(ctx *Context, query string, changes []SchemaChange, err error) error
+}
@@ -113,6 +113,11 @@ type Session interface {
 	SetIgnoreAutoCommit(ignore bool)
 	// GetIgnoreAutoCommit returns whether this session should ignore the @@autocommit variable
 	GetIgnoreAutoCommit() bool
+	// SetReadOnly sets whether the session rejects the statements that modify rows or schemas, as the sessions of a
+	// read replica do, regardless of the read_only system variable and of the privileges of its user
+	SetReadOnly(readOnly bool)
+	// IsReadOnly returns whether the session rejects the statements that modify rows or schemas
+	IsReadOnly() bool
 	// GetLogger returns the logger for this session, useful if clients want to log messages with the same format / output
 	// as the running server. Clients should instantiate their own global logger with formatting options, and session
 	// implementations should return the logger to be used for the running server.
@@ -170,6 +175,7 @@ type BaseSession struct {
 	lastQueryInfo    map[string]int64
 	tx               Transaction
 	ignoreAutocommit bool
+	readOnly         bool
 }
 
 func (s *BaseSession) GetLogger() *logrus.Entry {
@@ -201,6 +207,18 @@ func (s *BaseSession) GetIgnoreAutoCommit() bool {
 	return s.ignoreAutocommit
 }
 
+func (s *BaseSession) SetReadOnly(readOnly bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.readOnly = readOnly
+}
+
+func (s *BaseSession) IsReadOnly() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.readOnly
+}
+
 var _ Session = (*BaseSession)(nil)
 
 // CommitTransaction commits the current transaction for the current database.
@@ -548,6 +566,22 @@ type Context struct {
 	queryTime   time.Time
 	tracer      opentracing.Tracer
 	rootSpan    opentracing.Span
+	// profile is the profile the execution of the query is profiled into, if any, and operator the operator of the
+	// innermost span started while profiling it.
+	profile  *QueryProfile
+	operator *profiledOperator
+	// securityContexts is the stack of accounts whose privileges nested stored routines execute with.
+	securityContexts []SecurityContext
+	// featureDefaults are the feature flags the engine turns on or off for its queries, unless their session sets them.
+	featureDefaults FeatureSwitches
+	// statusVariables are the status variables of the engine the query runs in.
+	statusVariables *StatusVariables
+}
+
+// SecurityContext is the account whose privileges a statement executes with.
+type SecurityContext struct {
+	User string
+	Host string
 }
 
 // ContextOption is a function to configure the context.
@@ -601,6 +635,21 @@ func WithProcessList(p ProcessList) ContextOption {
 	}
 }
 
+// WithFeatureDefaults sets the feature flags turned on or off for the Context, unless its session sets them in
+// optimizer_switch.
+func WithFeatureDefaults(defaults FeatureSwitches) ContextOption {
+	return func(ctx *Context) {
+		ctx.featureDefaults = defaults
+	}
+}
+
+// WithStatusVariables sets the status variables the query of the Context counts its statements and reads in.
+func WithStatusVariables(sv *StatusVariables) ContextOption {
+	return func(ctx *Context) {
+		ctx.statusVariables = sv
+	}
+}
+
 // WithServices sets the services for the Context
 func WithServices(services Services) ContextOption {
 	return func(ctx *Context) {
@@ -666,9 +715,22 @@ func (c *Context) ApplyOpts(opts ...ContextOption) {
 // NewEmptyContext returns a default context with default values.
 func NewEmptyContext() *Context { return NewContext(context.TODO()) }
 
+// StatusVariables returns the status variables of the engine the query of this context runs in, which are nil for
+// contexts created outside of an engine.
+func (c *Context) StatusVariables() *StatusVariables { return c.statusVariables }
+
 // Pid returns the process id associated with this context.
 func (c *Context) Pid() uint64 { return c.pid }
 
+// ReportStageProgress reports that the query of this context is in the stage given, such as StageAlteringTable, with
+// the work given done out of the total estimated, or -1 if the total isn't known. Integrators doing long-running work
+// for a query, such as rewriting the rows of a table for ALTER TABLE, call it as the work is done, and the progress is
+// shown by SHOW PROCESSLIST and the performance_schema.events_stages_current table. Integrators that want to be told
+// about the progress instead can pass their own ProcessList with WithProcessList.
+func (c *Context) ReportStageProgress(stage string, done, total int64) {
+	c.ProcessList.UpdateStageProgress(c.pid, stage, done, total)
+}
+
 // Query returns the query string associated with this context.
 func (c *Context) Query() string { return c.query }
 
@@ -695,10 +757,32 @@ func (c *Context) Span(
 	}
 	span := c.tracer.StartSpan(opName, opts...)
 	ctx := opentracing.ContextWithSpan(c.Context, span)
+	if c.profile != nil {
+		return c.profileSpan(ctx, span, opName, opts)
+	}
 
 	return span, c.WithContext(ctx)
 }
 
+// WithSecurityContext returns a copy of this context that executes with the privileges of the account given, as the
+// bodies of stored procedures with SQL SECURITY DEFINER do. The security context of this context is unchanged, so it's
+// restored once the returned context is no longer used.
+func (c *Context) WithSecurityContext(user, host string) *Context {
+	nc := *c
+	nc.securityContexts = append(append([]SecurityContext(nil), c.securityContexts...), SecurityContext{User: user, Host: host})
+	return &nc
+}
+
+// SecurityContext returns the account whose privileges statements of this context execute with. This is the definer
+// of the innermost stored routine executing with its definer's privileges, or the client of the session otherwise.
+func (c *Context) SecurityContext() SecurityContext {
+	if len(c.securityContexts) > 0 {
+		return c.securityContexts[len(c.securityContexts)-1]
+	}
+	client := c.Client()
+	return SecurityContext{User: client.User, Host: client.Address}
+}
+
 // NewSubContext creates a new sub-context with the current context as parent. Returns the resulting context.CancelFunc
 // as well as the new *sql.Context, which be used to cancel the new context before the parent is finished.
 func (c *Context) NewSubContext() (*Context, context.CancelFunc) {
@@ -760,6 +844,11 @@ func (c *Context) LoadInfile(filename string) (io.ReadCloser, error) {
 	return nil, ErrUnsupportedFeature.New("LOAD DATA LOCAL INFILE ...")
 }
 
+// AdminCommands returns the admin commands of the server the query was received by, or nil if there are none.
+func (c *Context) AdminCommands() *AdminCommands {
+	return c.services.AdminCommands
+}
+
 func (c *Context) NewErrgroup() (*errgroup.Group, *Context) {
 	eg, egCtx := errgroup.WithContext(c.Context)
 	return eg, c.WithContext(egCtx)
@@ -775,11 +864,19 @@ func (c *Context) NewErrgroup() (*errgroup.Group, *Context) {
 type Services struct {
 	KillConnection func(connID uint32) error
 	LoadInfile     func(filename string) (io.ReadCloser, error)
+	// AdminCommands are the admin commands of the server the query was received by, such as SHUTDOWN. They take
+	// precedence over the commands registered with the catalog, which are shared by every server of the engine.
+	AdminCommands *AdminCommands
 }
 
 // NewSpanIter creates a RowIter executed in the given span.
 // Currently inactive, returns the iter returned unaltered.
 func NewSpanIter(span opentracing.Span, iter RowIter) RowIter {
+	if ps, ok := span.(*profiledSpan); ok {
+		iter = newProfiledIter(ps.operator, iter)
+		span = ps.Span
+	}
+
 	// In the default, non traced case, we should not bother with
 	// collecting the timings below.
 	if (span.Tracer() == opentracing.NoopTracer{}) {
@@ -216,7 +216,7 @@ func (t setType) SQL(v interface{}) (sqltypes.Value, error) {
 
 // String implements Type interface.
 func (t setType) String() string {
-	s := fmt.Sprintf("SET('%v')", strings.Join(t.Values(), `','`))
+	s := fmt.Sprintf("SET(%v)", strings.Join(quoteStringValues(t.Values()), ","))
 	if t.CharacterSet() != Collation_Default.CharacterSet() {
 		s += " CHARACTER SET " + t.CharacterSet().String()
 	}
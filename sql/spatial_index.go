@@ -0,0 +1,85 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"math"
+)
+
+// SpatialIndex is an index of a spatial column, created with CREATE SPATIAL INDEX, that finds the rows whose geometries
+// are near a geometry. The analyzer uses it for the ST_CONTAINS, ST_WITHIN, ST_INTERSECTS and ST_TOUCHES predicates,
+// and their MBR counterparts, between the column and a constant geometry, as they can only hold for rows whose
+// geometries' minimum bounding rectangles intersect the constant geometry's.
+type SpatialIndex interface {
+	Index
+	// NewSpatialLookup returns a new IndexLookup for the rows whose geometries' minimum bounding rectangles intersect
+	// the rectangle given. The lookup may return other rows as well, as the predicate is still evaluated for each row
+	// it returns. The Ranges of the lookup are empty. If an integrator is unable to look up the rectangle, then a nil
+	// may be returned.
+	NewSpatialLookup(ctx *Context, bounds BoundingBox) (IndexLookup, error)
+}
+
+// BoundingBox is the minimum bounding rectangle of a geometry: the smallest rectangle with sides parallel to the axes
+// that contains every point of the geometry.
+type BoundingBox struct {
+	MinX, MinY, MaxX, MaxY float64
+}
+
+// GeometryBoundingBox returns the minimum bounding rectangle of the geometry given, and false if it isn't a geometry or
+// has no points.
+func GeometryBoundingBox(g interface{}) (BoundingBox, bool) {
+	var points []Point
+	switch g := g.(type) {
+	case Point:
+		points = []Point{g}
+	case Linestring:
+		points = g.Points
+	case Polygon:
+		for _, line := range g.Lines {
+			points = append(points, line.Points...)
+		}
+	}
+	if len(points) == 0 {
+		return BoundingBox{}, false
+	}
+
+	b := BoundingBox{MinX: points[0].X, MinY: points[0].Y, MaxX: points[0].X, MaxY: points[0].Y}
+	for _, p := range points[1:] {
+		b.MinX, b.MinY = math.Min(b.MinX, p.X), math.Min(b.MinY, p.Y)
+		b.MaxX, b.MaxY = math.Max(b.MaxX, p.X), math.Max(b.MaxY, p.Y)
+	}
+	return b, true
+}
+
+// Intersects returns whether this rectangle and the rectangle given have any point in common, including points on
+// their sides.
+func (b BoundingBox) Intersects(o BoundingBox) bool {
+	return b.MinX <= o.MaxX && o.MinX <= b.MaxX && b.MinY <= o.MaxY && o.MinY <= b.MaxY
+}
+
+// Union returns the smallest rectangle that contains both this rectangle and the rectangle given.
+func (b BoundingBox) Union(o BoundingBox) BoundingBox {
+	return BoundingBox{
+		MinX: math.Min(b.MinX, o.MinX),
+		MinY: math.Min(b.MinY, o.MinY),
+		MaxX: math.Max(b.MaxX, o.MaxX),
+		MaxY: math.Max(b.MaxY, o.MaxY),
+	}
+}
+
+// Area returns the area of this rectangle.
+func (b BoundingBox) Area() float64 {
+	return (b.MaxX - b.MinX) * (b.MaxY - b.MinY)
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeometryBoundingBox(t *testing.T) {
+	require := require.New(t)
+
+	box, ok := GeometryBoundingBox(Point{X: 1, Y: 2})
+	require.True(ok)
+	require.Equal(BoundingBox{MinX: 1, MinY: 2, MaxX: 1, MaxY: 2}, box)
+
+	box, ok = GeometryBoundingBox(Linestring{Points: []Point{{X: 3, Y: -1}, {X: 0, Y: 4}}})
+	require.True(ok)
+	require.Equal(BoundingBox{MinX: 0, MinY: -1, MaxX: 3, MaxY: 4}, box)
+
+	box, ok = GeometryBoundingBox(Polygon{Lines: []Linestring{{Points: []Point{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 2, Y: 2}, {X: 0, Y: 0}}}}})
+	require.True(ok)
+	require.Equal(BoundingBox{MinX: 0, MinY: 0, MaxX: 2, MaxY: 2}, box)
+
+	_, ok = GeometryBoundingBox(Linestring{})
+	require.False(ok)
+	_, ok = GeometryBoundingBox("POINT(1 2)")
+	require.False(ok)
+}
+
+func TestBoundingBoxIntersects(t *testing.T) {
+	require := require.New(t)
+	a := BoundingBox{MinX: 0, MinY: 0, MaxX: 2, MaxY: 2}
+	require.True(a.Intersects(BoundingBox{MinX: 1, MinY: 1, MaxX: 3, MaxY: 3}))
+	require.True(a.Intersects(BoundingBox{MinX: 2, MinY: 2, MaxX: 2, MaxY: 2}))
+	require.False(a.Intersects(BoundingBox{MinX: 2.5, MinY: 0, MaxX: 3, MaxY: 1}))
+	require.Equal(BoundingBox{MinX: 0, MinY: -1, MaxX: 3, MaxY: 2}, a.Union(BoundingBox{MinX: 1, MinY: -1, MaxX: 3, MaxY: 0}))
+	require.Equal(4.0, a.Area())
+}
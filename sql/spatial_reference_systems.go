@@ -0,0 +1,288 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SpatialReferenceSystem is a spatial reference system known to the engine, as listed in
+// INFORMATION_SCHEMA.ST_SPATIAL_REFERENCE_SYSTEMS. The Cartesian plane, SRID 0, isn't a spatial reference system of
+// the table.
+type SpatialReferenceSystem struct {
+	Name                   string
+	ID                     uint32
+	Organization           string
+	OrganizationCoordsysID uint32
+	// Definition is the WKT definition of the spatial reference system, in the dialect MySQL uses.
+	Definition  string
+	Description string
+
+	// The parameters of the definition, which are parsed when the system is registered.
+	geographic        bool
+	projection        string
+	semiMajorAxis     float64
+	inverseFlattening float64
+	toWGS84           [7]float64
+}
+
+// The definitions of the spatial reference systems reference the same coordinate systems and units, so they are
+// assembled from these parts.
+const (
+	srsDegree      = `PRIMEM["Greenwich",0,AUTHORITY["EPSG","8901"]],UNIT["degree",0.017453292519943278,AUTHORITY["EPSG","9122"]],AXIS["Lat",NORTH],AXIS["Lon",EAST]`
+	srsWGS84Datum  = `DATUM["World Geodetic System 1984",SPHEROID["WGS 84",6378137,298.257223563,AUTHORITY["EPSG","7030"]],AUTHORITY["EPSG","6326"]]`
+	srsWGS84GeogCS = `GEOGCS["WGS 84",` + srsWGS84Datum + `,` + srsDegree + `,AUTHORITY["EPSG","4326"]]`
+)
+
+var spatialReferenceSystems = map[uint32]*SpatialReferenceSystem{}
+
+func init() {
+	for _, srs := range []SpatialReferenceSystem{
+		{
+			Name:                   "WGS 84",
+			ID:                     GeoSpatialSRID,
+			Organization:           "EPSG",
+			OrganizationCoordsysID: 4326,
+			Definition:             srsWGS84GeogCS,
+		},
+		{
+			Name:                   "ED50",
+			ID:                     4230,
+			Organization:           "EPSG",
+			OrganizationCoordsysID: 4230,
+			Definition:             `GEOGCS["ED50",DATUM["European Datum 1950",SPHEROID["International 1924",6378388,297,AUTHORITY["EPSG","7022"]],TOWGS84[-87,-98,-121,0,0,0,0],AUTHORITY["EPSG","6230"]],` + srsDegree + `,AUTHORITY["EPSG","4230"]]`,
+		},
+		{
+			Name:                   "ETRS89",
+			ID:                     4258,
+			Organization:           "EPSG",
+			OrganizationCoordsysID: 4258,
+			Definition:             `GEOGCS["ETRS89",DATUM["European Terrestrial Reference System 1989",SPHEROID["GRS 1980",6378137,298.257222101,AUTHORITY["EPSG","7019"]],TOWGS84[0,0,0,0,0,0,0],AUTHORITY["EPSG","6258"]],` + srsDegree + `,AUTHORITY["EPSG","4258"]]`,
+		},
+		{
+			Name:                   "NAD27",
+			ID:                     4267,
+			Organization:           "EPSG",
+			OrganizationCoordsysID: 4267,
+			Definition:             `GEOGCS["NAD27",DATUM["North American Datum 1927",SPHEROID["Clarke 1866",6378206.4,294.9786982138982,AUTHORITY["EPSG","7008"]],TOWGS84[-8,160,176,0,0,0,0],AUTHORITY["EPSG","6267"]],` + srsDegree + `,AUTHORITY["EPSG","4267"]]`,
+		},
+		{
+			Name:                   "NAD83",
+			ID:                     4269,
+			Organization:           "EPSG",
+			OrganizationCoordsysID: 4269,
+			Definition:             `GEOGCS["NAD83",DATUM["North American Datum 1983",SPHEROID["GRS 1980",6378137,298.257222101,AUTHORITY["EPSG","7019"]],TOWGS84[0,0,0,0,0,0,0],AUTHORITY["EPSG","6269"]],` + srsDegree + `,AUTHORITY["EPSG","4269"]]`,
+		},
+		{
+			Name:                   "WGS 72",
+			ID:                     4322,
+			Organization:           "EPSG",
+			OrganizationCoordsysID: 4322,
+			Definition:             `GEOGCS["WGS 72",DATUM["World Geodetic System 1972",SPHEROID["WGS 72",6378135,298.26,AUTHORITY["EPSG","7043"]],TOWGS84[0,0,4.5,0,0,0.554,0.2263],AUTHORITY["EPSG","6322"]],` + srsDegree + `,AUTHORITY["EPSG","4322"]]`,
+		},
+		{
+			Name:                   "WGS 84 / Pseudo-Mercator",
+			ID:                     3857,
+			Organization:           "EPSG",
+			OrganizationCoordsysID: 3857,
+			Definition:             `PROJCS["WGS 84 / Pseudo-Mercator",` + srsWGS84GeogCS + `,PROJECTION["Popular Visualisation Pseudo Mercator",AUTHORITY["EPSG","1024"]],PARAMETER["Latitude of natural origin",0,AUTHORITY["EPSG","8801"]],PARAMETER["Longitude of natural origin",0,AUTHORITY["EPSG","8802"]],PARAMETER["False easting",0,AUTHORITY["EPSG","8806"]],PARAMETER["False northing",0,AUTHORITY["EPSG","8807"]],UNIT["metre",1,AUTHORITY["EPSG","9001"]],AXIS["X",EAST],AXIS["Y",NORTH],AUTHORITY["EPSG","3857"]]`,
+		},
+	} {
+		srs := srs
+		if err := srs.parseDefinition(); err != nil {
+			panic(err)
+		}
+		spatialReferenceSystems[srs.ID] = &srs
+	}
+}
+
+var (
+	srsSpheroidRegex   = regexp.MustCompile(`SPHEROID\["[^"]*",([^,\]]+),([^,\]]+)`)
+	srsToWGS84Regex    = regexp.MustCompile(`TOWGS84\[([^\]]*)\]`)
+	srsProjectionRegex = regexp.MustCompile(`PROJECTION\["([^"]*)"`)
+)
+
+// parseDefinition sets the parameters of the spatial reference system from its definition.
+func (s *SpatialReferenceSystem) parseDefinition() error {
+	switch {
+	case strings.HasPrefix(s.Definition, "GEOGCS["):
+		s.geographic = true
+	case strings.HasPrefix(s.Definition, "PROJCS["):
+		m := srsProjectionRegex.FindStringSubmatch(s.Definition)
+		if m == nil {
+			return fmt.Errorf("spatial reference system %d has no projection", s.ID)
+		}
+		s.projection = m[1]
+	default:
+		return fmt.Errorf("spatial reference system %d is neither geographic nor projected", s.ID)
+	}
+
+	m := srsSpheroidRegex.FindStringSubmatch(s.Definition)
+	if m == nil {
+		return fmt.Errorf("spatial reference system %d has no spheroid", s.ID)
+	}
+	var err error
+	if s.semiMajorAxis, err = strconv.ParseFloat(m[1], 64); err != nil {
+		return err
+	}
+	if s.inverseFlattening, err = strconv.ParseFloat(m[2], 64); err != nil {
+		return err
+	}
+
+	if m := srsToWGS84Regex.FindStringSubmatch(s.Definition); m != nil {
+		params := strings.Split(m[1], ",")
+		if len(params) != len(s.toWGS84) {
+			return fmt.Errorf("spatial reference system %d has %d TOWGS84 parameters", s.ID, len(params))
+		}
+		for i, p := range params {
+			if s.toWGS84[i], err = strconv.ParseFloat(strings.TrimSpace(p), 64); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// SpatialReferenceSystemByID returns the spatial reference system with the SRID given, and false if there's none.
+func SpatialReferenceSystemByID(srid uint32) (*SpatialReferenceSystem, bool) {
+	srs, ok := spatialReferenceSystems[srid]
+	return srs, ok
+}
+
+// SpatialReferenceSystems returns the spatial reference systems known to the engine, ordered by SRID.
+func SpatialReferenceSystems() []*SpatialReferenceSystem {
+	systems := make([]*SpatialReferenceSystem, 0, len(spatialReferenceSystems))
+	for _, srs := range spatialReferenceSystems {
+		systems = append(systems, srs)
+	}
+	sort.Slice(systems, func(i, j int) bool {
+		return systems[i].ID < systems[j].ID
+	})
+	return systems
+}
+
+// IsGeographicSRID returns whether the SRID given is a geographic spatial reference system, whose points have their
+// latitude as X and their longitude as Y.
+func IsGeographicSRID(srid uint32) bool {
+	srs, ok := spatialReferenceSystems[srid]
+	return ok && srs.geographic
+}
+
+// IsGeographic returns whether the spatial reference system has latitude and longitude coordinates.
+func (s *SpatialReferenceSystem) IsGeographic() bool {
+	return s.geographic
+}
+
+// ToWGS84 returns the latitude and longitude on WGS 84, in degrees, of the coordinates given in the spatial reference
+// system. It returns false if the coordinates can't be transformed.
+func (s *SpatialReferenceSystem) ToWGS84(x, y float64) (lat, lon float64, ok bool) {
+	if !s.geographic {
+		return s.unproject(x, y)
+	}
+	if s.toWGS84 == [7]float64{} {
+		return x, y, true
+	}
+	ex, ey, ez := geodeticToGeocentric(x, y, s.semiMajorAxis, s.inverseFlattening)
+	ex, ey, ez = helmert(ex, ey, ez, s.toWGS84, 1)
+	lat, lon = geocentricToGeodetic(ex, ey, ez, wgs84SemiMajorAxis, wgs84InverseFlattening)
+	return lat, lon, true
+}
+
+// FromWGS84 returns the coordinates in the spatial reference system of the latitude and longitude on WGS 84 given, in
+// degrees. It returns false if the coordinates can't be transformed.
+func (s *SpatialReferenceSystem) FromWGS84(lat, lon float64) (x, y float64, ok bool) {
+	if !s.geographic {
+		return s.project(lat, lon)
+	}
+	if s.toWGS84 == [7]float64{} {
+		return lat, lon, true
+	}
+	ex, ey, ez := geodeticToGeocentric(lat, lon, wgs84SemiMajorAxis, wgs84InverseFlattening)
+	ex, ey, ez = helmert(ex, ey, ez, s.toWGS84, -1)
+	x, y = geocentricToGeodetic(ex, ey, ez, s.semiMajorAxis, s.inverseFlattening)
+	return x, y, true
+}
+
+const (
+	wgs84SemiMajorAxis     = 6378137
+	wgs84InverseFlattening = 298.257223563
+
+	pseudoMercatorProjection = "Popular Visualisation Pseudo Mercator"
+)
+
+// project returns the easting and northing of the latitude and longitude given. Projected spatial reference systems
+// are all based on WGS 84.
+func (s *SpatialReferenceSystem) project(lat, lon float64) (x, y float64, ok bool) {
+	if s.projection != pseudoMercatorProjection {
+		return 0, 0, false
+	}
+	phi, lambda := lat*math.Pi/180, lon*math.Pi/180
+	return s.semiMajorAxis * lambda, s.semiMajorAxis * math.Log(math.Tan(math.Pi/4+phi/2)), true
+}
+
+// unproject returns the latitude and longitude of the easting and northing given.
+func (s *SpatialReferenceSystem) unproject(x, y float64) (lat, lon float64, ok bool) {
+	if s.projection != pseudoMercatorProjection {
+		return 0, 0, false
+	}
+	phi := 2*math.Atan(math.Exp(y/s.semiMajorAxis)) - math.Pi/2
+	lambda := x / s.semiMajorAxis
+	return phi * 180 / math.Pi, lambda * 180 / math.Pi, true
+}
+
+// geodeticToGeocentric returns the Earth-centered coordinates of the latitude and longitude given, in degrees, on the
+// surface of the ellipsoid given.
+func geodeticToGeocentric(lat, lon, a, invF float64) (x, y, z float64) {
+	phi, lambda := lat*math.Pi/180, lon*math.Pi/180
+	f := 1 / invF
+	e2 := f * (2 - f)
+	sinPhi := math.Sin(phi)
+	n := a / math.Sqrt(1-e2*sinPhi*sinPhi)
+	return n * math.Cos(phi) * math.Cos(lambda), n * math.Cos(phi) * math.Sin(lambda), n * (1 - e2) * sinPhi
+}
+
+// geocentricToGeodetic returns the latitude and longitude, in degrees, of the Earth-centered coordinates given on the
+// ellipsoid given. The latitude is found by iteration, which converges to well below a millimeter in a few steps.
+func geocentricToGeodetic(x, y, z, a, invF float64) (lat, lon float64) {
+	f := 1 / invF
+	e2 := f * (2 - f)
+	p := math.Hypot(x, y)
+	phi := math.Atan2(z, p*(1-e2))
+	for i := 0; i < 10; i++ {
+		sinPhi := math.Sin(phi)
+		n := a / math.Sqrt(1-e2*sinPhi*sinPhi)
+		h := p/math.Cos(phi) - n
+		phi = math.Atan2(z, p*(1-e2*n/(n+h)))
+	}
+	return phi * 180 / math.Pi, math.Atan2(y, x) * 180 / math.Pi
+}
+
+// helmert applies the position vector transformation with the TOWGS84 parameters given to the Earth-centered
+// coordinates given. The rotations are in arc-seconds and the scale is in parts per million. A sign of -1 applies the
+// reverse transformation, which is accurate for the small rotations of datum shifts.
+func helmert(x, y, z float64, params [7]float64, sign float64) (float64, float64, float64) {
+	const arcSecond = math.Pi / (180 * 3600)
+	tx, ty, tz := sign*params[0], sign*params[1], sign*params[2]
+	rx, ry, rz := sign*params[3]*arcSecond, sign*params[4]*arcSecond, sign*params[5]*arcSecond
+	m := 1 + sign*params[6]*1e-6
+	return tx + m*(x-rz*y+ry*z),
+		ty + m*(rz*x+y-rx*z),
+		tz + m*(-ry*x+rx*y+z)
+}
@@ -0,0 +1,215 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"bufio"
+	"encoding/gob"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// ErrUnspillableValue is returned when a row holds a value that can't be written to disk, so that the rows are kept in
+// memory instead.
+var ErrUnspillableValue = errors.NewKind("a value of type %T can't be spilled to disk: %v")
+
+func init() {
+	// Rows are encoded as slices of interface values, so every type of value they can hold must be registered
+	gob.Register(time.Time{})
+	gob.Register(decimal.Decimal{})
+	gob.Register(JSONDocument{})
+	gob.Register(map[string]interface{}{})
+	gob.Register([]interface{}{})
+	gob.Register(Point{})
+	gob.Register(Linestring{})
+	gob.Register(Polygon{})
+	gob.Register(spilledLob(0))
+}
+
+// spilledLob stands for a LobValue in a spilled row, by its position among the LobValues of the RowSpill. LobValues
+// are kept in memory rather than written out, since they only refer to contents that are already stored elsewhere.
+type spilledLob int
+
+// RowSpill is a temporary file that an operator holding too many rows in memory writes them to in runs, such as the
+// sorted runs of an external sort, to read them back later. Each run is read on its own, so runs can be read at the
+// same time.
+type RowSpill struct {
+	file *os.File
+	// ends are the offsets of the ends of the runs written
+	ends []int64
+	// lobs are the LobValues of the rows written
+	lobs []LobValue
+}
+
+func newRowSpill() (*RowSpill, error) {
+	file, err := ioutil.TempFile("", "gms-spill-")
+	if err != nil {
+		return nil, err
+	}
+	return &RowSpill{file: file}, nil
+}
+
+// WriteRun writes the rows given to the end of the file, as a new run. If any of the values of the rows can't be
+// written, ErrUnspillableValue is returned and the run isn't written.
+func (s *RowSpill) WriteRun(ctx *Context, rows []Row) error {
+	start := s.size()
+	if _, err := s.file.Seek(start, io.SeekStart); err != nil {
+		return err
+	}
+
+	lobs := len(s.lobs)
+	w := &spillWriter{w: bufio.NewWriter(s.file)}
+	enc := gob.NewEncoder(w)
+	for _, row := range rows {
+		spilled, err := s.spillRow(ctx, row)
+		if err == nil {
+			err = enc.Encode(spilled)
+		}
+		if err != nil {
+			s.lobs = s.lobs[:lobs]
+			if w.err == nil && !ErrUnspillableValue.Is(err) {
+				// the encoder failed rather than the file
+				err = ErrUnspillableValue.New(row, err)
+			}
+			return err
+		}
+	}
+	if err := w.w.Flush(); err != nil {
+		s.lobs = s.lobs[:lobs]
+		return err
+	}
+
+	end, err := s.file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	s.ends = append(s.ends, end)
+	return nil
+}
+
+// spillRow returns the row given with its values converted to the ones written to the file.
+func (s *RowSpill) spillRow(ctx *Context, row Row) (Row, error) {
+	spilled := make(Row, len(row))
+	for i, v := range row {
+		switch v := v.(type) {
+		case nil, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, string,
+			[]byte, time.Time, decimal.Decimal, JSONDocument, Point, Linestring, Polygon:
+			spilled[i] = v
+		case LobValue:
+			spilled[i] = spilledLob(len(s.lobs))
+			s.lobs = append(s.lobs, v)
+		case JSONValue:
+			doc, err := v.Unmarshall(ctx)
+			if err != nil {
+				return nil, err
+			}
+			spilled[i] = doc
+		default:
+			return nil, ErrUnspillableValue.New(v, "unknown type")
+		}
+	}
+	return spilled, nil
+}
+
+// spillWriter is a writer that records the error of the writer it wraps, to tell encoding errors from write errors.
+type spillWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (w *spillWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	if err != nil && w.err == nil {
+		w.err = err
+	}
+	return n, err
+}
+
+// NumRuns returns the number of runs written.
+func (s *RowSpill) NumRuns() int {
+	return len(s.ends)
+}
+
+// ReadRun returns an iterator of the rows of the run given, by its position among the runs written.
+func (s *RowSpill) ReadRun(run int) *SpilledRunIter {
+	var start int64
+	if run > 0 {
+		start = s.ends[run-1]
+	}
+	section := io.NewSectionReader(s.file, start, s.ends[run]-start)
+	return &SpilledRunIter{dec: gob.NewDecoder(bufio.NewReader(section)), lobs: s.lobs}
+}
+
+func (s *RowSpill) size() int64 {
+	if len(s.ends) == 0 {
+		return 0
+	}
+	return s.ends[len(s.ends)-1]
+}
+
+// Dispose implements the Disposable interface. It removes the file.
+func (s *RowSpill) Dispose() {
+	if s.file != nil {
+		s.file.Close()
+		os.Remove(s.file.Name())
+		s.file = nil
+	}
+	s.lobs = nil
+}
+
+// SpilledRunIter iterates over the rows of a run of a RowSpill.
+type SpilledRunIter struct {
+	dec  *gob.Decoder
+	lobs []LobValue
+}
+
+// Next returns the next row of the run, or io.EOF when there are no more.
+func (i *SpilledRunIter) Next() (Row, error) {
+	var row Row
+	if err := i.dec.Decode(&row); err != nil {
+		return nil, err
+	}
+	for j, v := range row {
+		if lob, ok := v.(spilledLob); ok {
+			row[j] = i.lobs[lob]
+		}
+	}
+	return row, nil
+}
+
+// EstimatedRowSize returns an estimate of the number of bytes of memory taken by the row given, to tell when the rows
+// held by an operator should be spilled to disk.
+func EstimatedRowSize(row Row) uint64 {
+	// The slice header, and an interface value for every column
+	size := uint64(24 + 16*len(row))
+	for _, v := range row {
+		switch v := v.(type) {
+		case string:
+			size += uint64(len(v))
+		case []byte:
+			size += uint64(len(v))
+		case JSONDocument, decimal.Decimal, time.Time:
+			size += 32
+		default:
+			size += 8
+		}
+	}
+	return size
+}
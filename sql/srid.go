@@ -0,0 +1,83 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"gopkg.in/src-d/go-errors.v1"
+)
+
+// The SRIDs of the default spatial reference systems. Geometries with the Cartesian SRID have X and Y coordinates on a
+// flat plane, while geometries with the geospatial SRID, WGS 84, have latitude and longitude coordinates on the
+// ellipsoid of the Earth. The other spatial reference systems are listed in SpatialReferenceSystems.
+const (
+	CartesianSRID  uint32 = 0
+	GeoSpatialSRID uint32 = 4326
+)
+
+var (
+	// ErrInvalidSRID is returned when a spatial reference system isn't known.
+	ErrInvalidSRID = errors.NewKind("There's no spatial reference with SRID %d")
+
+	// ErrNotMatchingSRID is returned when storing a geometry in a column that's restricted to another SRID.
+	ErrNotMatchingSRID = errors.NewKind("The SRID of the geometry is %d, but the SRID of the column is %d. Consider changing the SRID of the geometry or the SRID property of the column.")
+)
+
+// SpatialColumnType is the type of a column of geometries, which may be restricted to the geometries of a single
+// spatial reference system.
+type SpatialColumnType interface {
+	Type
+	// GetSpatialTypeSRID returns the SRID the values of the type are restricted to, and whether they're restricted.
+	GetSpatialTypeSRID() (uint32, bool)
+	// SetSRID returns this type, with its values restricted to the SRID given.
+	SetSRID(srid uint32) Type
+}
+
+// ValidateSRID returns an error if the SRID given is neither the Cartesian plane nor a spatial reference system known
+// to the engine.
+func ValidateSRID(srid uint32) error {
+	if srid == CartesianSRID {
+		return nil
+	}
+	if _, ok := SpatialReferenceSystemByID(srid); !ok {
+		return ErrInvalidSRID.New(srid)
+	}
+	return nil
+}
+
+// GeometrySRID returns the SRID of the geometry given, and false if it isn't a geometry.
+func GeometrySRID(v interface{}) (uint32, bool) {
+	switch v := v.(type) {
+	case Point:
+		return v.SRID, true
+	case Linestring:
+		return v.SRID, true
+	case Polygon:
+		return v.SRID, true
+	default:
+		return 0, false
+	}
+}
+
+// matchSRID returns an error if the geometry given doesn't have the SRID of a column type that's restricted to the
+// SRID given.
+func matchSRID(v interface{}, srid uint32, defined bool) error {
+	if !defined {
+		return nil
+	}
+	if geomSRID, ok := GeometrySRID(v); ok && geomSRID != srid {
+		return ErrNotMatchingSRID.New(geomSRID, srid)
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+)
+
+// StatementStatistics aggregates the statements run by the engine by digest, and the rows inserted, updated and
+// deleted in each table, the way the statement and table I/O instrumentation of MySQL's performance schema does.
+type StatementStatistics struct {
+	mu      sync.Mutex
+	digests map[statementDigestKey]*StatementDigest
+	tables  map[tableStatisticsKey]*TableRowOperations
+}
+
+type statementDigestKey struct {
+	db     string
+	digest string
+}
+
+// StatementDigest is the summary of the statements with the same digest run in the same current database.
+type StatementDigest struct {
+	// Database is the current database of the statements, and is empty if there was none.
+	Database string
+	// Digest is the SHA-256 hash of DigestText, in hex.
+	Digest string
+	// DigestText is the text of the statements with their literals replaced by ?.
+	DigestText string
+	// Count is the number of statements run.
+	Count uint64
+	// Errors is the number of statements that failed.
+	Errors uint64
+	// Warnings is the number of warnings of the statements.
+	Warnings uint64
+	// NoIndexUsed is the number of statements that read a table without an index.
+	NoIndexUsed uint64
+	// TotalLatency, MinLatency and MaxLatency are the sum, minimum and maximum of the time it took to run the
+	// statements, including reading all of their rows.
+	TotalLatency time.Duration
+	MinLatency   time.Duration
+	MaxLatency   time.Duration
+	// RowsSent is the number of rows returned by the statements.
+	RowsSent uint64
+	// RowsAffected is the number of rows affected by the statements.
+	RowsAffected uint64
+	// FirstSeen and LastSeen are the times the first and last statements were run.
+	FirstSeen time.Time
+	LastSeen  time.Time
+}
+
+// StatementRun is the outcome of a statement recorded with StatementStatistics.RecordStatement.
+type StatementRun struct {
+	Database     string
+	Query        string
+	Started      time.Time
+	Latency      time.Duration
+	RowsSent     uint64
+	RowsAffected uint64
+	Warnings     uint64
+	NoIndexUsed  bool
+	Err          error
+}
+
+// TableRowOperations is the number of rows inserted, updated and deleted in a table by the statements run by the
+// engine.
+type TableRowOperations struct {
+	Database string
+	Table    string
+	Inserted uint64
+	Updated  uint64
+	Deleted  uint64
+}
+
+// StatementStatisticsCatalog is implemented by catalogs that keep the StatementStatistics of the engine, which are
+// summarized by the performance_schema and sys schema tables.
+type StatementStatisticsCatalog interface {
+	Statements() *StatementStatistics
+}
+
+// NewStatementStatistics returns a new StatementStatistics without any statement recorded.
+func NewStatementStatistics() *StatementStatistics {
+	return &StatementStatistics{
+		digests: make(map[statementDigestKey]*StatementDigest),
+		tables:  make(map[tableStatisticsKey]*TableRowOperations),
+	}
+}
+
+// RecordStatement adds the statement run given to the summary of its digest.
+func (s *StatementStatistics) RecordStatement(run StatementRun) {
+	text := StatementDigestText(run.Query)
+	sum := sha256.Sum256([]byte(text))
+	digest := hex.EncodeToString(sum[:])
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := statementDigestKey{db: strings.ToLower(run.Database), digest: digest}
+	d, ok := s.digests[key]
+	if !ok {
+		d = &StatementDigest{
+			Database:   run.Database,
+			Digest:     digest,
+			DigestText: text,
+			MinLatency: run.Latency,
+			FirstSeen:  run.Started,
+		}
+		s.digests[key] = d
+	}
+
+	d.Count++
+	if run.Err != nil {
+		d.Errors++
+	}
+	d.Warnings += run.Warnings
+	if run.NoIndexUsed {
+		d.NoIndexUsed++
+	}
+	d.TotalLatency += run.Latency
+	if run.Latency < d.MinLatency {
+		d.MinLatency = run.Latency
+	}
+	if run.Latency > d.MaxLatency {
+		d.MaxLatency = run.Latency
+	}
+	d.RowsSent += run.RowsSent
+	d.RowsAffected += run.RowsAffected
+	d.LastSeen = run.Started
+}
+
+// Digests returns a copy of the summary of every digest recorded.
+func (s *StatementStatistics) Digests() []StatementDigest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	digests := make([]StatementDigest, 0, len(s.digests))
+	for _, d := range s.digests {
+		digests = append(digests, *d)
+	}
+	return digests
+}
+
+// RecordTableRows adds the numbers of rows given to the rows inserted, updated and deleted in the table of the database
+// given.
+func (s *StatementStatistics) RecordTableRows(db, table string, inserted, updated, deleted uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	key := newTableStatisticsKey(db, table)
+	ops, ok := s.tables[key]
+	if !ok {
+		ops = &TableRowOperations{Database: db, Table: table}
+		s.tables[key] = ops
+	}
+	ops.Inserted += inserted
+	ops.Updated += updated
+	ops.Deleted += deleted
+}
+
+// TableRows returns the rows inserted, updated and deleted in the table of the database given.
+func (s *StatementStatistics) TableRows(db, table string) TableRowOperations {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ops, ok := s.tables[newTableStatisticsKey(db, table)]; ok {
+		return *ops
+	}
+	return TableRowOperations{Database: db, Table: table}
+}
+
+var (
+	redactedListRegex    = regexp.MustCompile(`::redacted\d+`)
+	redactedLiteralRegex = regexp.MustCompile(`:redacted\d+`)
+)
+
+// StatementDigestText returns the text of the query given with its literals replaced by ?, and its lists of literals by
+// (...), so that the queries that only differ by their literals have the same digest. Queries that can't be parsed or
+// redacted, such as LOAD DATA, are their own digest text, with their whitespace collapsed.
+func StatementDigestText(query string) string {
+	redacted, err := redactQuery(query)
+	if err != nil {
+		return strings.Join(strings.Fields(query), " ")
+	}
+	redacted = redactedListRegex.ReplaceAllString(redacted, "(...)")
+	return redactedLiteralRegex.ReplaceAllString(redacted, "?")
+}
+
+// redactQuery returns the query given with its literals replaced by bind variables, turning the panics of the
+// redaction of statements it can't walk into errors.
+func redactQuery(query string) (redacted string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("cannot redact query: %v", r)
+		}
+	}()
+	return sqlparser.RedactSQLQuery(query)
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementDigestText(t *testing.T) {
+	require := require.New(t)
+	require.Equal("select * from t where a = ? and b in (...)", StatementDigestText("SELECT * FROM t WHERE a = 1 AND b IN ('x', 'y')"))
+	require.Equal("NOT A STATEMENT", StatementDigestText("  NOT   A\nSTATEMENT "))
+	require.Equal("LOAD DATA INFILE 'x.txt' INTO TABLE t", StatementDigestText("LOAD DATA INFILE 'x.txt'  INTO TABLE t"))
+}
+
+func TestStatementStatistics(t *testing.T) {
+	require := require.New(t)
+	s := NewStatementStatistics()
+	started := time.Date(2022, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	s.RecordStatement(StatementRun{Database: "mydb", Query: "SELECT * FROM t WHERE a = 1", Started: started, Latency: 2 * time.Millisecond, RowsSent: 1})
+	s.RecordStatement(StatementRun{Database: "MYDB", Query: "SELECT * FROM t WHERE a = 2", Started: started.Add(time.Second), Latency: time.Millisecond, RowsSent: 1, Err: errors.New("failed")})
+	s.RecordStatement(StatementRun{Database: "other", Query: "SELECT * FROM t WHERE a = 3", Started: started, Latency: time.Millisecond})
+
+	digests := s.Digests()
+	require.Len(digests, 2)
+	if digests[0].Database != "mydb" {
+		digests[0], digests[1] = digests[1], digests[0]
+	}
+	d := digests[0]
+	require.Equal("select * from t where a = ?", d.DigestText)
+	require.Len(d.Digest, 64)
+	require.Equal(uint64(2), d.Count)
+	require.Equal(uint64(1), d.Errors)
+	require.Equal(3*time.Millisecond, d.TotalLatency)
+	require.Equal(time.Millisecond, d.MinLatency)
+	require.Equal(2*time.Millisecond, d.MaxLatency)
+	require.Equal(uint64(2), d.RowsSent)
+	require.Equal(started, d.FirstSeen)
+	require.Equal(started.Add(time.Second), d.LastSeen)
+
+	s.RecordTableRows("mydb", "t", 3, 0, 0)
+	s.RecordTableRows("MyDB", "T", 1, 2, 1)
+	require.Equal(TableRowOperations{Database: "mydb", Table: "t", Inserted: 4, Updated: 2, Deleted: 1}, s.TableRows("mydb", "t"))
+	require.Equal(TableRowOperations{Database: "mydb", Table: "u"}, s.TableRows("mydb", "u"))
+}
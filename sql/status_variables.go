@@ -0,0 +1,279 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// StatusVariableScope is the scope of a status variable.
+type StatusVariableScope byte
+
+const (
+	// StatusVariableScope_Global is set on status variables that only have a global value, such as gauges of the
+	// server's state.
+	StatusVariableScope_Global StatusVariableScope = iota
+	// StatusVariableScope_Both is set on status variables that count events both globally and for each session.
+	StatusVariableScope_Both
+)
+
+// StatusVariable is a counter or gauge reported by SHOW STATUS.
+type StatusVariable struct {
+	Name  string
+	Scope StatusVariableScope
+}
+
+// StatusValue is the value of a status variable.
+type StatusValue struct {
+	Name  string
+	Value int64
+}
+
+// StatusVariables holds the definitions and values of the status variables reported by SHOW STATUS. Every engine has
+// its own, so engines in the same process count their own statements and connections. The values of variables with
+// StatusVariableScope_Both are kept both globally and for each session that changed them. Integrators may add their
+// own variables with AddStatusVariables, and update them with the same methods the engine uses. A nil StatusVariables,
+// which is what contexts created outside of an engine have, discards every update and has no variables.
+type StatusVariables struct {
+	mu        *sync.Mutex
+	defs      map[string]StatusVariable
+	global    map[string]int64
+	sessions  map[uint32]map[string]int64
+	startTime time.Time
+}
+
+// NewStatusVariables returns the status variables of the engine, with a value of zero.
+func NewStatusVariables() *StatusVariables {
+	sv := &StatusVariables{
+		mu:        &sync.Mutex{},
+		defs:      make(map[string]StatusVariable),
+		global:    make(map[string]int64),
+		sessions:  make(map[uint32]map[string]int64),
+		startTime: time.Now(),
+	}
+	sv.AddStatusVariables(statusVars)
+	return sv
+}
+
+// AddStatusVariables adds the given status variables, with a value of zero, to the collection of status variables.
+// Variables that already exist are left as they are.
+func (sv *StatusVariables) AddStatusVariables(vars []StatusVariable) {
+	if sv == nil {
+		return
+	}
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+	for _, v := range vars {
+		lowerName := strings.ToLower(v.Name)
+		if _, ok := sv.defs[lowerName]; ok {
+			continue
+		}
+		sv.defs[lowerName] = v
+		sv.global[lowerName] = 0
+	}
+}
+
+// Increment adds |delta| to the global value of the status variable with the name given, and also to its value for
+// the session of the context given if it's a session variable. Unknown variables are ignored.
+func (sv *StatusVariables) Increment(ctx *Context, name string, delta int64) {
+	if sv == nil {
+		return
+	}
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+
+	lowerName := strings.ToLower(name)
+	def, ok := sv.defs[lowerName]
+	if !ok {
+		return
+	}
+	sv.global[lowerName] += delta
+	if def.Scope != StatusVariableScope_Both || ctx == nil || ctx.Session == nil {
+		return
+	}
+	session, ok := sv.sessions[ctx.ID()]
+	if !ok {
+		session = make(map[string]int64)
+		sv.sessions[ctx.ID()] = session
+	}
+	session[lowerName] += delta
+}
+
+// SetGlobal sets the global value of the status variable with the name given. Unknown variables are ignored.
+func (sv *StatusVariables) SetGlobal(name string, value int64) {
+	if sv == nil {
+		return
+	}
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+
+	lowerName := strings.ToLower(name)
+	if _, ok := sv.defs[lowerName]; ok {
+		sv.global[lowerName] = value
+	}
+}
+
+// RaiseGlobal sets the global value of the status variable with the name given if it's less than the value given, which
+// keeps the variable at the highest value reached. Unknown variables are ignored.
+func (sv *StatusVariables) RaiseGlobal(name string, value int64) {
+	if sv == nil {
+		return
+	}
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+
+	lowerName := strings.ToLower(name)
+	if current, ok := sv.global[lowerName]; ok && current < value {
+		sv.global[lowerName] = value
+	}
+}
+
+// Global returns the global values of every status variable, sorted by name.
+func (sv *StatusVariables) Global() []StatusValue {
+	if sv == nil {
+		return nil
+	}
+	return sv.values(nil)
+}
+
+// GlobalValue returns the global value of the status variable with the name given, or zero if it's unknown.
+func (sv *StatusVariables) GlobalValue(name string) int64 {
+	if sv == nil {
+		return 0
+	}
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+
+	lowerName := strings.ToLower(name)
+	if lowerName == "uptime" {
+		return int64(time.Since(sv.startTime) / time.Second)
+	}
+	return sv.global[lowerName]
+}
+
+// Session returns the values of every status variable for the session with the id given, sorted by name. Variables
+// that only have a global value are returned with their global value, as in MySQL.
+func (sv *StatusVariables) Session(id uint32) []StatusValue {
+	if sv == nil {
+		return nil
+	}
+	sv.mu.Lock()
+	session := sv.sessions[id]
+	sv.mu.Unlock()
+	if session == nil {
+		session = make(map[string]int64)
+	}
+	return sv.values(session)
+}
+
+func (sv *StatusVariables) values(session map[string]int64) []StatusValue {
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+
+	values := make([]StatusValue, 0, len(sv.defs))
+	for lowerName, def := range sv.defs {
+		value := sv.global[lowerName]
+		if session != nil && def.Scope == StatusVariableScope_Both {
+			value = session[lowerName]
+		}
+		if lowerName == "uptime" {
+			value = int64(time.Since(sv.startTime) / time.Second)
+		}
+		values = append(values, StatusValue{Name: def.Name, Value: value})
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Name < values[j].Name
+	})
+	return values
+}
+
+// RemoveSession discards the values of the status variables of the session with the id given, which is done once the
+// session ends.
+func (sv *StatusVariables) RemoveSession(id uint32) {
+	if sv == nil {
+		return
+	}
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+	delete(sv.sessions, id)
+}
+
+// FlushSession resets the values of the status variables of the session with the id given, as FLUSH STATUS does. Their
+// global values already include them and are left as they are, except for Max_used_connections, which is reset to the
+// number of connections currently open.
+func (sv *StatusVariables) FlushSession(id uint32) {
+	if sv == nil {
+		return
+	}
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+	delete(sv.sessions, id)
+	sv.global["max_used_connections"] = sv.global["threads_connected"]
+}
+
+// statusVars are the status variables of the engine. Variables of storage engines that don't exist here, such as
+// InnoDB's, are reported with a value of zero for the benefit of monitoring agents that expect them.
+var statusVars = []StatusVariable{
+	{Name: "Aborted_clients", Scope: StatusVariableScope_Global},
+	{Name: "Aborted_connects", Scope: StatusVariableScope_Global},
+	{Name: "Com_alter_table", Scope: StatusVariableScope_Both},
+	{Name: "Com_begin", Scope: StatusVariableScope_Both},
+	{Name: "Com_call_procedure", Scope: StatusVariableScope_Both},
+	{Name: "Com_commit", Scope: StatusVariableScope_Both},
+	{Name: "Com_create_db", Scope: StatusVariableScope_Both},
+	{Name: "Com_create_index", Scope: StatusVariableScope_Both},
+	{Name: "Com_create_table", Scope: StatusVariableScope_Both},
+	{Name: "Com_create_view", Scope: StatusVariableScope_Both},
+	{Name: "Com_delete", Scope: StatusVariableScope_Both},
+	{Name: "Com_drop_db", Scope: StatusVariableScope_Both},
+	{Name: "Com_drop_index", Scope: StatusVariableScope_Both},
+	{Name: "Com_drop_table", Scope: StatusVariableScope_Both},
+	{Name: "Com_drop_view", Scope: StatusVariableScope_Both},
+	{Name: "Com_insert", Scope: StatusVariableScope_Both},
+	{Name: "Com_insert_select", Scope: StatusVariableScope_Both},
+	{Name: "Com_replace", Scope: StatusVariableScope_Both},
+	{Name: "Com_rollback", Scope: StatusVariableScope_Both},
+	{Name: "Com_select", Scope: StatusVariableScope_Both},
+	{Name: "Com_set_option", Scope: StatusVariableScope_Both},
+	{Name: "Com_show_databases", Scope: StatusVariableScope_Both},
+	{Name: "Com_show_status", Scope: StatusVariableScope_Both},
+	{Name: "Com_show_tables", Scope: StatusVariableScope_Both},
+	{Name: "Com_show_variables", Scope: StatusVariableScope_Both},
+	{Name: "Com_truncate", Scope: StatusVariableScope_Both},
+	{Name: "Com_update", Scope: StatusVariableScope_Both},
+	{Name: "Com_use", Scope: StatusVariableScope_Both},
+	{Name: "Connections", Scope: StatusVariableScope_Global},
+	{Name: "Flush_commands", Scope: StatusVariableScope_Global},
+	{Name: "Handler_read_key", Scope: StatusVariableScope_Both},
+	{Name: "Handler_read_next", Scope: StatusVariableScope_Both},
+	{Name: "Handler_read_rnd_next", Scope: StatusVariableScope_Both},
+	{Name: "Innodb_buffer_pool_pages_data", Scope: StatusVariableScope_Global},
+	{Name: "Innodb_buffer_pool_pages_free", Scope: StatusVariableScope_Global},
+	{Name: "Innodb_buffer_pool_pages_total", Scope: StatusVariableScope_Global},
+	{Name: "Innodb_row_lock_waits", Scope: StatusVariableScope_Global},
+	{Name: "Innodb_rows_read", Scope: StatusVariableScope_Global},
+	{Name: "Max_used_connections", Scope: StatusVariableScope_Global},
+	{Name: "Open_tables", Scope: StatusVariableScope_Global},
+	{Name: "Opened_tables", Scope: StatusVariableScope_Both},
+	{Name: "Queries", Scope: StatusVariableScope_Both},
+	{Name: "Questions", Scope: StatusVariableScope_Both},
+	{Name: "Slow_queries", Scope: StatusVariableScope_Both},
+	{Name: "Threads_connected", Scope: StatusVariableScope_Global},
+	{Name: "Threads_running", Scope: StatusVariableScope_Global},
+	{Name: "Uptime", Scope: StatusVariableScope_Global},
+}
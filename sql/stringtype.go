@@ -19,6 +19,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/dolthub/vitess/go/sqltypes"
 	"github.com/dolthub/vitess/go/vt/proto/query"
@@ -44,6 +45,8 @@ var (
 	ErrLengthTooLarge    = errors.NewKind("length is %v but max allowed is %v")
 	ErrLengthBeyondLimit = errors.NewKind("string is too large for column")
 	ErrBinaryCollation   = errors.NewKind("binary types must have the binary collation")
+	// ErrIncorrectStringValue is thrown when a string has characters that its character set can't store.
+	ErrIncorrectStringValue = errors.NewKind("incorrect string value '%v' for character set %v")
 
 	TinyText   = MustCreateStringWithDefaults(sqltypes.Text, tinyTextBlobMax/Collation_Default.CharacterSet().MaxLength())
 	Text       = MustCreateStringWithDefaults(sqltypes.Text, textBlobMax/Collation_Default.CharacterSet().MaxLength())
@@ -221,6 +224,20 @@ func (t stringType) Compare(a interface{}, b interface{}) (int, error) {
 	return strings.Compare(as, bs), nil
 }
 
+// WeightString implements WeightStringType interface. Weight strings are the bytes of the string, the same way Compare
+// compares strings; they'll account for the collation when Compare does.
+func (t stringType) WeightString(v interface{}) ([]byte, error) {
+	s, ok := v.(string)
+	if !ok {
+		sv, err := t.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		s = sv.(string)
+	}
+	return []byte(s), nil
+}
+
 // Convert implements Type interface.
 func (t stringType) Convert(v interface{}) (interface{}, error) {
 	if v == nil {
@@ -259,6 +276,16 @@ func (t stringType) Convert(v interface{}) (interface{}, error) {
 		val = s
 	case []byte:
 		val = string(s)
+	case LobValue:
+		// no need to read values that can't fit
+		if s.Size() > t.MaxByteLength() {
+			return nil, ErrLengthBeyondLimit.New()
+		}
+		b, err := ReadLobValue(s)
+		if err != nil {
+			return nil, err
+		}
+		val = string(b)
 	case time.Time:
 		val = s.Format(TimestampDatetimeLayout)
 	case decimal.Decimal:
@@ -294,13 +321,23 @@ func (t stringType) Convert(v interface{}) (interface{}, error) {
 				return nil, ErrLengthBeyondLimit.New()
 			}
 		} else {
-			//TODO: this should count the string's length properly according to the character set
-			if int64(len(val)) > t.charLength {
+			// strings are stored as UTF-8, so each character is a rune no matter how many bytes it takes
+			if int64(utf8.RuneCountInString(val)) > t.charLength {
 				return nil, ErrLengthBeyondLimit.New()
 			}
 		}
 	}
 
+	// utf8mb3 only stores the characters that take at most 3 bytes in UTF-8, which excludes emoji and the other
+	// characters outside of the Basic Multilingual Plane
+	if t.CharacterSet() == CharacterSet_utf8mb3 {
+		for _, r := range val {
+			if utf8.RuneLen(r) > 3 {
+				return nil, ErrIncorrectStringValue.New(string(r), t.CharacterSet())
+			}
+		}
+	}
+
 	if t.baseType == sqltypes.Binary {
 		val += strings.Repeat(string([]byte{0}), int(t.charLength)-len(val))
 	}
@@ -308,6 +345,39 @@ func (t stringType) Convert(v interface{}) (interface{}, error) {
 	return val, nil
 }
 
+// TruncateString returns the longest prefix of the string given that fits in the string type given. Lengths are
+// counted the same way as in Convert: in bytes for TEXT and BLOB types and single-byte character sets, and in characters
+// otherwise. Strings are never cut in the middle of a multibyte character.
+func TruncateString(t StringType, val string) string {
+	if t.Type() == sqltypes.Text {
+		maxBytes := t.MaxByteLength()
+		if int64(len(val)) <= maxBytes {
+			return val
+		}
+		for maxBytes > 0 && !utf8.RuneStart(val[maxBytes]) {
+			maxBytes--
+		}
+		return val[:maxBytes]
+	}
+
+	maxChars := t.MaxCharacterLength()
+	if t.CharacterSet().MaxLength() == 1 {
+		if int64(len(val)) <= maxChars {
+			return val
+		}
+		return val[:maxChars]
+	}
+
+	var chars int64
+	for i := range val {
+		if chars == maxChars {
+			return val[:i]
+		}
+		chars++
+	}
+	return val
+}
+
 // MustConvert implements the Type interface.
 func (t stringType) MustConvert(v interface{}) interface{} {
 	value, err := t.Convert(v)
@@ -335,6 +405,18 @@ func (t stringType) SQL(v interface{}) (sqltypes.Value, error) {
 		return sqltypes.NULL, nil
 	}
 
+	// Large values of TEXT and BLOB types are read straight into the value's buffer, without converting them to a string
+	if lob, ok := v.(LobValue); ok && (t.baseType == sqltypes.Text || t.baseType == sqltypes.Blob) {
+		if lob.Size() > t.MaxByteLength() {
+			return sqltypes.Value{}, ErrLengthBeyondLimit.New()
+		}
+		b, err := ReadLobValue(lob)
+		if err != nil {
+			return sqltypes.Value{}, err
+		}
+		return sqltypes.MakeTrusted(t.baseType, b), nil
+	}
+
 	v, err := t.Convert(v)
 	if err != nil {
 		return sqltypes.Value{}, err
@@ -309,6 +309,10 @@ func TestStringConvert(t *testing.T) {
 		{MustCreateStringWithDefaults(sqltypes.VarChar, 7), float64(11583.5), "11583.5", false},
 		{MustCreateStringWithDefaults(sqltypes.Char, 4), []byte("abcd"), "abcd", false},
 		{MustCreateStringWithDefaults(sqltypes.VarChar, 40), time.Date(2019, 12, 12, 12, 12, 12, 0, time.UTC), "2019-12-12 12:12:12", false},
+		{MustCreateStringWithDefaults(sqltypes.Char, 3), "äöü", "äöü", false},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 3), "日本語", "日本語", false},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 2), "😀🐬", "😀🐬", false},
+		{MustCreateString(sqltypes.VarChar, 3, Collation_utf8mb3_general_ci), "日本語", "日本語", false},
 
 		{MustCreateBinary(sqltypes.Binary, 3), "abcd", nil, true},
 		{MustCreateBinary(sqltypes.Blob, 3), strings.Repeat("0", tinyTextBlobMax+1), nil, true},
@@ -316,6 +320,10 @@ func TestStringConvert(t *testing.T) {
 		{MustCreateStringWithDefaults(sqltypes.Text, 3), strings.Repeat("𒁏", int(tinyTextBlobMax/Collation_Default.CharacterSet().MaxLength())+1), nil, true},
 		{MustCreateBinary(sqltypes.VarBinary, 3), []byte{01, 02, 03, 04}, nil, true},
 		{MustCreateStringWithDefaults(sqltypes.VarChar, 3), []byte("abcd"), nil, true},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 2), "日本語", nil, true},
+		{MustCreateBinary(sqltypes.VarBinary, 3), "äöü", nil, true},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 1), "😀🐬", nil, true},
+		{MustCreateString(sqltypes.VarChar, 3, Collation_utf8mb3_general_ci), "a😀", nil, true},
 		{MustCreateStringWithDefaults(sqltypes.Char, 20), JSONDocument{Val: nil}, "null", false},
 	}
 
@@ -332,6 +340,31 @@ func TestStringConvert(t *testing.T) {
 	}
 }
 
+func TestTruncateString(t *testing.T) {
+	tinyText := MustCreateStringWithDefaults(sqltypes.Text, 3)
+	maxBytes := int(tinyText.MaxByteLength())
+	tests := []struct {
+		typ      StringType
+		val      string
+		expected string
+	}{
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 3), "abcde", "abc"},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 3), "ab", "ab"},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 2), "日本語", "日本"},
+		{MustCreateStringWithDefaults(sqltypes.VarChar, 2), "😀🐬🎉", "😀🐬"},
+		{MustCreateStringWithDefaults(sqltypes.Char, 3), "äöüß", "äöü"},
+		{MustCreateBinary(sqltypes.VarBinary, 3), "äöü", "ä\xc3"},
+		{tinyText, strings.Repeat("語", maxBytes/3+1), strings.Repeat("語", maxBytes/3)},
+		{tinyText, strings.Repeat("a", maxBytes-1) + "ä", strings.Repeat("a", maxBytes-1)},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%v %v", test.typ, test.val), func(t *testing.T) {
+			assert.Equal(t, test.expected, TruncateString(test.typ, test.val))
+		})
+	}
+}
+
 func TestStringString(t *testing.T) {
 	tests := []struct {
 		typ         Type
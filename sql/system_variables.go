@@ -74,6 +74,9 @@ type SystemVariable struct {
 	Type Type
 	// Default defines the default value of the system variable.
 	Default interface{}
+	// NotifyChanged, if set, is called with the new global value of the system variable whenever it's assigned, so that
+	// variables that configure the server can take effect at runtime.
+	NotifyChanged func(value interface{})
 }
 
 // globalSystemVariables is the underlying type of SystemVariables.
@@ -116,6 +119,9 @@ func (sv *globalSystemVariables) AssignValues(vals map[string]interface{}) error
 			return err
 		}
 		sv.sysVarVals[varName] = convertedVal
+		if sysVar.NotifyChanged != nil {
+			sysVar.NotifyChanged(convertedVal)
+		}
 	}
 	return nil
 }
@@ -168,6 +174,9 @@ func (sv *globalSystemVariables) SetGlobal(name string, val interface{}) error {
 		return err
 	}
 	sv.sysVarVals[name] = convertedVal
+	if sysVar.NotifyChanged != nil {
+		sysVar.NotifyChanged(convertedVal)
+	}
 	return nil
 }
 
@@ -986,6 +995,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemBoolType("inmemory_joins"),
 		Default:           int8(0),
 	},
+	"innodb_stats_auto_recalc": {
+		Name:              "innodb_stats_auto_recalc",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemBoolType("innodb_stats_auto_recalc"),
+		Default:           int8(1),
+	},
 	"interactive_timeout": {
 		Name:              "interactive_timeout",
 		Scope:             SystemVariableScope_Both,
@@ -1170,6 +1187,17 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemIntType("log_error_verbosity", 1, 3, false),
 		Default:           int64(2),
 	},
+	"log_level": {
+		Name:              "log_level",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemEnumType("log_level", logLevelNames...),
+		Default:           "info",
+		NotifyChanged: func(value interface{}) {
+			setLogrusLevel(GetLogger(), value.(string))
+		},
+	},
 	"log_output": {
 		Name:              "log_output",
 		Scope:             SystemVariableScope_Global,
@@ -1266,6 +1294,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemDoubleType("long_query_time", 0, math.MaxFloat64),
 		Default:           float64(10),
 	},
+	"lookup_join_batch_size": {
+		Name:              "lookup_join_batch_size",
+		Scope:             SystemVariableScope_Session,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemIntType("lookup_join_batch_size", 1, 65536, false),
+		Default:           int64(64),
+	},
 	"low_priority_updates": {
 		Name:              "low_priority_updates",
 		Scope:             SystemVariableScope_Both,
@@ -1288,7 +1324,7 @@ var systemVars = map[string]SystemVariable{
 		Dynamic:           false,
 		SetVarHintApplies: false,
 		Type:              NewSystemIntType("lower_case_table_names", 0, 2, false),
-		Default:           int64(0),
+		Default:           LowerCaseTableNames_Insensitive,
 	},
 	"mandatory_roles": {
 		Name:              "mandatory_roles",
@@ -1667,14 +1703,14 @@ var systemVars = map[string]SystemVariable{
 		Default:           int64(62),
 	},
 	//TODO: add proper support for this
-	//"optimizer_switch": {
-	//	Name: "optimizer_switch",
-	//	Scope: SystemVariableScope_Both,
-	//	Dynamic: true,
-	//	SetVarHintApplies: true,
-	//	Type: NewSystemSetType("optimizer_switch"),
-	//	Default: "",
-	//},
+	FeatureFlagsSysVar: {
+		Name:              FeatureFlagsSysVar,
+		Scope:             SystemVariableScope_Both,
+		Dynamic:           true,
+		SetVarHintApplies: true,
+		Type:              systemFeatureSwitchesType{systemStringType{FeatureFlagsSysVar}},
+		Default:           "",
+	},
 	"optimizer_trace": {
 		Name:              "optimizer_trace",
 		Scope:             SystemVariableScope_Both,
@@ -2253,6 +2289,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemBoolType("sql_buffer_result"),
 		Default:           int8(0),
 	},
+	"sql_log_bin": {
+		Name:              "sql_log_bin",
+		Scope:             SystemVariableScope_Session,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemBoolType("sql_log_bin"),
+		Default:           int8(1),
+	},
 	"sql_log_off": {
 		Name:              "sql_log_off",
 		Scope:             SystemVariableScope_Both,
@@ -2381,6 +2425,14 @@ var systemVars = map[string]SystemVariable{
 		Type:              NewSystemStringType("ssl_key"),
 		Default:           "",
 	},
+	"stats_auto_recalc_threshold": {
+		Name:              "stats_auto_recalc_threshold",
+		Scope:             SystemVariableScope_Global,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              NewSystemIntType("stats_auto_recalc_threshold", 0, 100, false),
+		Default:           int64(10),
+	},
 	"stored_program_cache": {
 		Name:              "stored_program_cache",
 		Scope:             SystemVariableScope_Global,
@@ -0,0 +1,367 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/query"
+	"github.com/shopspring/decimal"
+)
+
+// RowChecksummer computes the checksums of the rows of a table the way MySQL does for CHECKSUM TABLE, so that the
+// checksum of a table is the same as the checksum MySQL computes for a table with the same schema and rows. The
+// checksum of a row is the CRC32 of its null bitmap followed by each of its non-null values in MySQL's record format,
+// and the checksum of a table is the sum of the checksums of its rows, truncated to 32 bits. DATETIME, TIMESTAMP and
+// TIME values have a precision of microseconds, so they're encoded like those of columns with a precision of 6.
+type RowChecksummer struct {
+	schema Schema
+	// packed is whether MySQL would store the rows in its packed record format, which it does for tables with
+	// variable length columns. The null bitmap of packed records doesn't start with an unused bit.
+	packed    bool
+	nullBytes int
+	nullMask  byte
+}
+
+// NewRowChecksummer returns a RowChecksummer for the rows of the schema given.
+func NewRowChecksummer(schema Schema) *RowChecksummer {
+	c := &RowChecksummer{schema: schema}
+	nullable := 0
+	for _, col := range schema {
+		if col.Nullable {
+			nullable++
+		}
+		if IsTextBlob(col.Type) || IsJSON(col.Type) || isVarLengthString(col.Type) {
+			c.packed = true
+		}
+	}
+
+	firstBit := 1
+	if c.packed {
+		firstBit = 0
+	}
+	c.nullBytes = (nullable + firstBit + 7) / 8
+	if lastBit := uint((nullable + firstBit) % 8); lastBit != 0 {
+		c.nullMask = byte(0xff << lastBit)
+	}
+	return c
+}
+
+func isVarLengthString(t Type) bool {
+	switch t.Type() {
+	case sqltypes.VarChar, sqltypes.VarBinary:
+		return true
+	default:
+		return false
+	}
+}
+
+// Checksum returns the checksum of the row given.
+func (c *RowChecksummer) Checksum(row Row) (uint32, error) {
+	var crc uint32
+	if c.nullBytes > 0 {
+		nulls := make([]byte, c.nullBytes)
+		bit := 1
+		if c.packed {
+			bit = 0
+		}
+		for i, col := range c.schema {
+			if !col.Nullable {
+				continue
+			}
+			if row[i] == nil {
+				nulls[bit/8] |= 1 << uint(bit%8)
+			}
+			bit++
+		}
+		nulls[c.nullBytes-1] |= c.nullMask
+		if !c.packed {
+			nulls[0] |= 1
+		}
+		crc = crc32.Update(crc, crc32.IEEETable, nulls)
+	}
+
+	for i, col := range c.schema {
+		if row[i] == nil {
+			continue
+		}
+		b, err := checksumValue(col.Type, row[i])
+		if err != nil {
+			return 0, err
+		}
+		crc = crc32.Update(crc, crc32.IEEETable, b)
+	}
+	return crc, nil
+}
+
+// checksumValue returns the non-null value given of the type given in MySQL's record format.
+func checksumValue(t Type, v interface{}) ([]byte, error) {
+	switch t.Type() {
+	case sqltypes.Int8, sqltypes.Uint8, sqltypes.Int16, sqltypes.Uint16, sqltypes.Int24, sqltypes.Uint24,
+		sqltypes.Int32, sqltypes.Uint32, sqltypes.Int64, sqltypes.Uint64:
+		return checksumInteger(t, v)
+	case sqltypes.Float32:
+		f, err := Float32.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		b := make([]byte, 4)
+		binary.LittleEndian.PutUint32(b, math.Float32bits(f.(float32)))
+		return b, nil
+	case sqltypes.Float64:
+		f, err := Float64.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, math.Float64bits(f.(float64)))
+		return b, nil
+	case sqltypes.Decimal:
+		dt := t.(DecimalType)
+		d, err := dt.ConvertToDecimal(v)
+		if err != nil {
+			return nil, err
+		}
+		return checksumDecimal(d.Decimal, int(dt.Precision()), int(dt.Scale())), nil
+	case sqltypes.Bit:
+		bits, err := t.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		n := (int(t.(BitType).NumberOfBits()) + 7) / 8
+		b := make([]byte, 8)
+		binary.BigEndian.PutUint64(b, bits.(uint64))
+		return b[8-n:], nil
+	case sqltypes.Year:
+		y, err := t.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		if year := y.(int16); year != 0 {
+			return []byte{byte(year - 1900)}, nil
+		}
+		return []byte{0}, nil
+	case sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp:
+		tv, err := t.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		return checksumTime(t.Type(), tv.(time.Time)), nil
+	case sqltypes.Time:
+		d, err := Time.ConvertToTimeDuration(v)
+		if err != nil {
+			return nil, err
+		}
+		return checksumTimespan(d.Microseconds()), nil
+	case sqltypes.Enum:
+		et := t.(EnumType)
+		ev, err := et.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		idx := et.IndexOf(ev.(string))
+		if idx < 0 {
+			idx = 0
+		}
+		if et.NumberOfElements() < 256 {
+			return []byte{byte(idx)}, nil
+		}
+		b := make([]byte, 2)
+		binary.LittleEndian.PutUint16(b, uint16(idx))
+		return b, nil
+	case sqltypes.Set:
+		st := t.(SetType)
+		bits, err := st.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		n := (int(st.NumberOfElements()) + 7) / 8
+		if n > 4 {
+			n = 8
+		}
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, bits)
+		return b[:n], nil
+	case sqltypes.Char, sqltypes.Binary:
+		s, err := t.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		b := []byte(s.(string))
+		pad := byte(' ')
+		if t.Type() == sqltypes.Binary {
+			pad = 0
+		}
+		if n := int(t.(StringType).MaxByteLength()); len(b) < n {
+			b = append(b, []byte(strings.Repeat(string(pad), n-len(b)))...)
+		}
+		return b, nil
+	default:
+		sv, err := t.SQL(v)
+		if err != nil {
+			return nil, err
+		}
+		return sv.Raw(), nil
+	}
+}
+
+// checksumInteger returns the integer given in the little endian format of MySQL's records, with as many bytes as its
+// type has.
+func checksumInteger(t Type, v interface{}) ([]byte, error) {
+	var n int
+	switch t.Type() {
+	case sqltypes.Int8, sqltypes.Uint8:
+		n = 1
+	case sqltypes.Int16, sqltypes.Uint16:
+		n = 2
+	case sqltypes.Int24, sqltypes.Uint24:
+		n = 3
+	case sqltypes.Int32, sqltypes.Uint32:
+		n = 4
+	default:
+		n = 8
+	}
+
+	var u uint64
+	if IsUnsigned(t) {
+		i, err := Uint64.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		u = i.(uint64)
+	} else {
+		i, err := Int64.Convert(v)
+		if err != nil {
+			return nil, err
+		}
+		u = uint64(i.(int64))
+	}
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, u)
+	return b[:n], nil
+}
+
+// checksumTime returns the time given in MySQL's binary format for DATE, DATETIME(6) and TIMESTAMP(6) columns.
+func checksumTime(typ query.Type, t time.Time) []byte {
+	zero := t.Equal(zeroTime)
+	switch typ {
+	case sqltypes.Date:
+		if zero {
+			return make([]byte, 3)
+		}
+		d := uint32(t.Day()) | uint32(t.Month())<<5 | uint32(t.Year())<<9
+		return []byte{byte(d), byte(d >> 8), byte(d >> 16)}
+	case sqltypes.Timestamp:
+		b := make([]byte, 7)
+		if !zero {
+			binary.BigEndian.PutUint32(b, uint32(t.Unix()))
+			putUint24BigEndian(b[4:], uint32(t.Nanosecond()/1000))
+		}
+		return b
+	default:
+		if zero {
+			return []byte{0x80, 0, 0, 0, 0, 0, 0, 0}
+		}
+		ymd := uint64(t.Year()*13+int(t.Month()))<<5 | uint64(t.Day())
+		hms := uint64(t.Hour())<<12 | uint64(t.Minute())<<6 | uint64(t.Second())
+		intPart := (ymd<<17 | hms) + 0x8000000000
+		b := make([]byte, 8)
+		binary.BigEndian.PutUint64(b, intPart)
+		b = append(b[3:], 0, 0, 0)
+		putUint24BigEndian(b[5:], uint32(t.Nanosecond()/1000))
+		return b
+	}
+}
+
+// checksumTimespan returns the number of microseconds given in MySQL's binary format for TIME(6) columns.
+func checksumTimespan(micros int64) []byte {
+	neg := micros < 0
+	if neg {
+		micros = -micros
+	}
+	secs := micros / 1000000
+	hms := (secs/3600)<<12 | (secs/60%60)<<6 | secs%60
+	packed := hms<<24 | micros%1000000
+	if neg {
+		packed = -packed
+	}
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(packed+0x800000000000))
+	return b[2:]
+}
+
+func putUint24BigEndian(b []byte, v uint32) {
+	b[0], b[1], b[2] = byte(v>>16), byte(v>>8), byte(v)
+}
+
+// decimalDigitBytes is the number of bytes MySQL stores a group of fewer than 9 decimal digits in.
+var decimalDigitBytes = [...]int{0, 1, 1, 2, 2, 3, 3, 4, 4, 4}
+
+// checksumDecimal returns the decimal given in MySQL's binary format for DECIMAL columns of the precision and scale
+// given. Digits are stored in groups of 9 per 4 bytes, big endian, with the integer digits that don't fill a group
+// first, and the sign is stored by inverting the bytes of negative values and the first bit of every value.
+func checksumDecimal(d decimal.Decimal, precision, scale int) []byte {
+	neg := d.Sign() < 0
+	digits := d.Abs().StringFixed(int32(scale))
+	intDigits, fracDigits := digits, ""
+	if i := strings.IndexByte(digits, '.'); i >= 0 {
+		intDigits, fracDigits = digits[:i], digits[i+1:]
+	}
+	intLen := precision - scale
+	if len(intDigits) < intLen {
+		intDigits = strings.Repeat("0", intLen-len(intDigits)) + intDigits
+	} else {
+		intDigits = intDigits[len(intDigits)-intLen:]
+	}
+
+	var b []byte
+	putDigits := func(digits string) {
+		var v uint32
+		for i := 0; i < len(digits); i++ {
+			v = v*10 + uint32(digits[i]-'0')
+		}
+		n := decimalDigitBytes[len(digits)]
+		for i := n - 1; i >= 0; i-- {
+			b = append(b, byte(v>>(uint(i)*8)))
+		}
+	}
+	lead := intLen % 9
+	putDigits(intDigits[:lead])
+	for i := lead; i < intLen; i += 9 {
+		putDigits(intDigits[i : i+9])
+	}
+	for i := 0; i < scale; i += 9 {
+		end := i + 9
+		if end > scale {
+			end = scale
+		}
+		putDigits(fracDigits[i:end])
+	}
+
+	if neg {
+		for i := range b {
+			b[i] = ^b[i]
+		}
+	}
+	if len(b) > 0 {
+		b[0] ^= 0x80
+	}
+	return b
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRowChecksummer(t *testing.T) {
+	require := require.New(t)
+
+	// Rows of tables without variable length columns have a null bitmap whose first bit is always set
+	fixed := NewRowChecksummer(Schema{
+		{Name: "a", Type: Int32, Nullable: true},
+		{Name: "b", Type: Int64},
+	})
+	crc, err := fixed.Checksum(NewRow(int32(5), int64(7)))
+	require.NoError(err)
+	require.Equal(uint32(3231711590), crc)
+	crc, err = fixed.Checksum(NewRow(nil, int64(7)))
+	require.NoError(err)
+	require.Equal(uint32(2776830460), crc)
+
+	// Rows of tables with variable length columns and no nullable columns have no null bitmap
+	packed := NewRowChecksummer(Schema{
+		{Name: "i", Type: Int64},
+		{Name: "s", Type: MustCreateStringWithDefaults(sqltypes.VarChar, 20)},
+	})
+	var sum uint32
+	for _, row := range []Row{
+		NewRow(int64(1), "first row"),
+		NewRow(int64(2), "second row"),
+		NewRow(int64(3), "third row"),
+	} {
+		crc, err := packed.Checksum(row)
+		require.NoError(err)
+		sum += crc
+	}
+	require.Equal(uint32(982938615), sum)
+}
+
+func TestChecksumValue(t *testing.T) {
+	tests := []struct {
+		typ      Type
+		val      interface{}
+		expected []byte
+	}{
+		{Int8, int8(-1), []byte{0xff}},
+		{Uint24, uint32(0x010203), []byte{0x03, 0x02, 0x01}},
+		{Int64, int64(1), []byte{1, 0, 0, 0, 0, 0, 0, 0}},
+		{MustCreateDecimalType(14, 4), "1234567890.1234", []byte{0x81, 0x0d, 0xfb, 0x38, 0xd2, 0x04, 0xd2}},
+		{MustCreateDecimalType(14, 4), "-1234567890.1234", []byte{0x7e, 0xf2, 0x04, 0xc7, 0x2d, 0xfb, 0x2d}},
+		{MustCreateBitType(12), uint64(0xabc), []byte{0x0a, 0xbc}},
+		{Year, int16(2022), []byte{122}},
+		{Date, time.Date(2022, 3, 4, 0, 0, 0, 0, time.UTC), []byte{0x64, 0xcc, 0x0f}},
+		{MustCreateEnumType([]string{"a", "b"}, Collation_Default), "b", []byte{2}},
+		{MustCreateSetType([]string{"a", "b", "c"}, Collation_Default), "a,c", []byte{5}},
+		{MustCreateString(sqltypes.Char, 3, Collation_ascii_bin), "a", []byte("a  ")},
+		{MustCreateBinary(sqltypes.Binary, 3), "a", []byte("a\x00\x00")},
+		{Text, "text", []byte("text")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ.String(), func(t *testing.T) {
+			b, err := checksumValue(tt.typ, tt.val)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, b)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+)
+
+// TableHandler is a table opened by a HANDLER ... OPEN statement. HANDLER ... READ statements read from it through a
+// cursor that keeps its position between statements, until the handler is closed.
+type TableHandler struct {
+	// Name is the name the handler is referred to by, which is its alias or the name of its table.
+	Name     string
+	Database string
+	Table    string
+
+	// Index is the name of the index the cursor last read in the order of, or empty if it last read the table in its
+	// natural order.
+	Index string
+	// LastRow is the last row read through Index, or nil if none was.
+	LastRow Row
+	// Offset is the number of rows read in the natural order of the table.
+	Offset int
+}
+
+// ResetCursor moves the cursor of the handler back to the start of the index given, or of the natural order of the
+// table if the index name is empty.
+func (h *TableHandler) ResetCursor(index string) {
+	h.Index = index
+	h.LastRow = nil
+	h.Offset = 0
+}
+
+// TableHandlers holds the table handlers opened by each session.
+type TableHandlers struct {
+	mu       sync.Mutex
+	handlers map[uint32]map[string]*TableHandler
+}
+
+// NewTableHandlers returns a new, empty set of table handlers.
+func NewTableHandlers() *TableHandlers {
+	return &TableHandlers{handlers: make(map[uint32]map[string]*TableHandler)}
+}
+
+// Open adds the handler given to the handlers of the session with the id given, returning an error if the session
+// already has a handler with the same name.
+func (t *TableHandlers) Open(id uint32, handler *TableHandler) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	session, ok := t.handlers[id]
+	if !ok {
+		session = make(map[string]*TableHandler)
+		t.handlers[id] = session
+	}
+	key := strings.ToLower(handler.Name)
+	if _, ok := session[key]; ok {
+		return ErrDuplicateAliasOrTable.New(handler.Name)
+	}
+	session[key] = handler
+	return nil
+}
+
+// Get returns the handler with the name given of the session with the id given.
+func (t *TableHandlers) Get(id uint32, name string) (*TableHandler, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	handler, ok := t.handlers[id][strings.ToLower(name)]
+	if !ok {
+		return nil, ErrUnknownTableHandler.New(name)
+	}
+	return handler, nil
+}
+
+// Close removes the handler with the name given from the handlers of the session with the id given.
+func (t *TableHandlers) Close(id uint32, name string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := strings.ToLower(name)
+	if _, ok := t.handlers[id][key]; !ok {
+		return ErrUnknownTableHandler.New(name)
+	}
+	delete(t.handlers[id], key)
+	if len(t.handlers[id]) == 0 {
+		delete(t.handlers, id)
+	}
+	return nil
+}
+
+// CloseAll removes every handler of the session with the id given, which is done once the session ends.
+func (t *TableHandlers) CloseAll(id uint32) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.handlers, id)
+}
@@ -0,0 +1,40 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+// OptimizableTable is a table that can be optimized with OPTIMIZE TABLE, such as by compacting its storage or
+// rebuilding its indexes.
+type OptimizableTable interface {
+	Table
+	// OptimizeTable optimizes the storage of the table.
+	OptimizeTable(ctx *Context) error
+}
+
+// RepairOptions are the options of a REPAIR TABLE statement.
+type RepairOptions struct {
+	// Quick is whether only the indexes of the table should be repaired, and not its rows.
+	Quick bool
+	// Extended is whether the indexes of the table should be rebuilt one row at a time.
+	Extended bool
+	// UseFrm is whether the table should be repaired using its definition rather than its index metadata.
+	UseFrm bool
+}
+
+// RepairableTable is a table that can be repaired with REPAIR TABLE, such as by rebuilding corrupted indexes.
+type RepairableTable interface {
+	Table
+	// RepairTable repairs the table with the options given.
+	RepairTable(ctx *Context, options RepairOptions) error
+}
@@ -0,0 +1,168 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sql
+
+import (
+	"strings"
+	"sync"
+)
+
+// AnalyzableTable is a table whose statistics are collected by ANALYZE TABLE.
+type AnalyzableTable interface {
+	Table
+	// AnalyzeTable collects the statistics of the table, replacing any collected before.
+	AnalyzeTable(ctx *Context) error
+}
+
+// ChangeCountingTable is an AnalyzableTable that counts the rows changed since its statistics were last collected
+// itself, such as a table whose rows are also changed outside of the engine. The engine only counts the rows changed by
+// the statements it runs for other tables.
+type ChangeCountingTable interface {
+	AnalyzableTable
+	// RowsChangedSinceAnalyze returns the number of rows inserted, updated or deleted since the statistics of the table
+	// were last collected.
+	RowsChangedSinceAnalyze(ctx *Context) (uint64, error)
+}
+
+// TableStatistics tracks the tables analyzed with ANALYZE TABLE and the number of rows changed in each since, to tell
+// when their statistics are stale. Stale statistics are collected again automatically when the innodb_stats_auto_recalc
+// system variable is enabled, the same way InnoDB does.
+type TableStatistics struct {
+	mu     sync.Mutex
+	tables map[tableStatisticsKey]*tableStatistics
+}
+
+type tableStatisticsKey struct {
+	db    string
+	table string
+}
+
+func newTableStatisticsKey(db, table string) tableStatisticsKey {
+	return tableStatisticsKey{strings.ToLower(db), strings.ToLower(table)}
+}
+
+// tableStatistics is what's tracked of the statistics of an analyzed table.
+type tableStatistics struct {
+	// rows is the number of rows of the table when it was analyzed, if it's a StatisticsTable.
+	rows uint64
+	// changed is the number of rows changed since the table was analyzed.
+	changed uint64
+}
+
+// NewTableStatistics returns a new TableStatistics that doesn't track any table.
+func NewTableStatistics() *TableStatistics {
+	return &TableStatistics{tables: make(map[tableStatisticsKey]*tableStatistics)}
+}
+
+// Analyze collects the statistics of the table of the database given, and starts counting the rows changed in it
+// since.
+func (s *TableStatistics) Analyze(ctx *Context, db string, table AnalyzableTable) error {
+	if err := table.AnalyzeTable(ctx); err != nil {
+		return err
+	}
+
+	var rows uint64
+	if st, ok := table.(StatisticsTable); ok {
+		var err error
+		rows, err = st.NumRows(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tables[newTableStatisticsKey(db, table.Name())] = &tableStatistics{rows: rows}
+	return nil
+}
+
+// RecordChanges adds the number of rows given to the rows changed in the table of the database given. Changes to
+// tables that weren't analyzed aren't counted, since they have no statistics to become stale.
+func (s *TableStatistics) RecordChanges(db, table string, rows uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if stats, ok := s.tables[newTableStatisticsKey(db, table)]; ok {
+		stats.changed += rows
+	}
+}
+
+// Forget stops tracking the table of the database given, such as when it's dropped.
+func (s *TableStatistics) Forget(db, table string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.tables, newTableStatisticsKey(db, table))
+}
+
+// RowsChanged returns the number of rows changed in the table of the database given since it was analyzed, and
+// whether it was analyzed at all. The count of a ChangeCountingTable is its own.
+func (s *TableStatistics) RowsChanged(ctx *Context, db string, table Table) (uint64, bool, error) {
+	s.mu.Lock()
+	stats, ok := s.tables[newTableStatisticsKey(db, table.Name())]
+	var changed uint64
+	if ok {
+		changed = stats.changed
+	}
+	s.mu.Unlock()
+
+	if !ok {
+		return 0, false, nil
+	}
+	if ct, ok := table.(ChangeCountingTable); ok {
+		var err error
+		changed, err = ct.RowsChangedSinceAnalyze(ctx)
+		if err != nil {
+			return 0, false, err
+		}
+	}
+	return changed, true, nil
+}
+
+// IsStale returns whether the statistics of the table of the database given are stale, which they are once more than
+// the percentage of its rows given by the stats_auto_recalc_threshold system variable changed since it was analyzed.
+// Tables that weren't analyzed have no statistics, so they're never stale.
+func (s *TableStatistics) IsStale(ctx *Context, db string, table Table) (bool, error) {
+	changed, ok, err := s.RowsChanged(ctx, db, table)
+	if err != nil || !ok || changed == 0 {
+		return false, err
+	}
+
+	s.mu.Lock()
+	rows := s.tables[newTableStatisticsKey(db, table.Name())].rows
+	s.mu.Unlock()
+
+	threshold := uint64(10)
+	if _, val, ok := SystemVariables.GetGlobal("stats_auto_recalc_threshold"); ok {
+		threshold = uint64(val.(int64))
+	}
+	return changed*100 > rows*threshold, nil
+}
+
+// RefreshIfStale analyzes the table of the database given again if the innodb_stats_auto_recalc system variable is
+// enabled and its statistics are stale, and returns whether it did.
+func (s *TableStatistics) RefreshIfStale(ctx *Context, db string, table Table) (bool, error) {
+	if _, val, ok := SystemVariables.GetGlobal("innodb_stats_auto_recalc"); !ok || val.(int8) == 0 {
+		return false, nil
+	}
+
+	at, ok := table.(AnalyzableTable)
+	if !ok {
+		return false, nil
+	}
+	stale, err := s.IsStale(ctx, db, table)
+	if err != nil || !stale {
+		return false, err
+	}
+	return true, s.Analyze(ctx, db, at)
+}
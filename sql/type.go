@@ -77,6 +77,15 @@ type Type2 interface {
 	Zero2() Value
 }
 
+// WeightStringType represents a SQL type whose values compare the same way as their weight strings, so that the values
+// of a column that are compared many times, such as when sorting, can be turned into weight strings once and then
+// compared as bytes.
+type WeightStringType interface {
+	Type
+	// WeightString returns the weight string of the given non-nil value.
+	WeightString(interface{}) ([]byte, error)
+}
+
 type LikeMatcher interface {
 	CreateMatcher(likeStr string) (regex.DisposableMatcher, error)
 }
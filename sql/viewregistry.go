@@ -105,14 +105,15 @@ func (r *ViewRegistry) Delete(databaseName, viewName string) error {
 }
 
 // View returns a pointer to the view specified by the pair {databaseName,
-// viewName}, returning an error if it does not exist.
+// viewName}, returning an error if it does not exist. The view name only
+// matches case-sensitively if lower_case_table_names says so.
 func (r *ViewRegistry) View(databaseName, viewName string) (*View, error) {
 	r.mutex.RLock()
 	defer r.mutex.RUnlock()
 
 	key := NewViewKey(databaseName, viewName)
 
-	if view, ok := r.views[key]; ok {
+	if view, ok := r.views[key]; ok && TableNameMatches(view.Name(), viewName) {
 		return view, nil
 	}
 
@@ -15,6 +15,7 @@
 package sql
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/cespare/xxhash"
@@ -24,14 +25,79 @@ import (
 type Window struct {
 	PartitionBy []Expression
 	OrderBy     SortFields
-	id          uint64
-	// TODO: window frame
+	// Frame is the frame clause of the window, or nil if the window has none and its functions use their default
+	// frames.
+	Frame *WindowFrame
+	id    uint64
 }
 
 func NewWindow(partitionBy []Expression, orderBy []SortField) *Window {
 	return &Window{PartitionBy: partitionBy, OrderBy: orderBy}
 }
 
+// WindowFrameUnit is the unit of the bounds of a window frame.
+type WindowFrameUnit byte
+
+const (
+	// FrameRows bounds are offsets in rows from the current row.
+	FrameRows WindowFrameUnit = iota
+	// FrameRange bounds are offsets from the value of the ORDER BY expression of the current row, and include the
+	// peers of the rows at the bounds.
+	FrameRange
+)
+
+func (u WindowFrameUnit) String() string {
+	if u == FrameRange {
+		return "range"
+	}
+	return "rows"
+}
+
+// WindowFrameBoundType is the type of a bound of a window frame.
+type WindowFrameBoundType byte
+
+const (
+	FrameUnboundedPreceding WindowFrameBoundType = iota
+	FramePreceding
+	FrameCurrentRow
+	FrameFollowing
+	FrameUnboundedFollowing
+)
+
+// WindowFrameBound is the start or end bound of a window frame.
+type WindowFrameBound struct {
+	Type WindowFrameBoundType
+	// Offset is the offset of FramePreceding and FrameFollowing bounds: a number of rows for FrameRows frames, and a
+	// number or an interval for FrameRange frames.
+	Offset Expression
+}
+
+func (b WindowFrameBound) String() string {
+	switch b.Type {
+	case FrameUnboundedPreceding:
+		return "unbounded preceding"
+	case FramePreceding:
+		return b.Offset.String() + " preceding"
+	case FrameFollowing:
+		return b.Offset.String() + " following"
+	case FrameUnboundedFollowing:
+		return "unbounded following"
+	default:
+		return "current row"
+	}
+}
+
+// WindowFrame is the frame clause of a window, which defines the rows of the partition of the current row that window
+// functions such as SUM and FIRST_VALUE aggregate.
+type WindowFrame struct {
+	Unit       WindowFrameUnit
+	Start, End WindowFrameBound
+}
+
+func (f *WindowFrame) String() string {
+	return fmt.Sprintf("%s between %s and %s", f.Unit, f.Start, f.End)
+}
+
 // ToExpressions converts the PartitionBy and OrderBy expressions to a single slice of expressions suitable for
 // manipulation by analyzer rules.
 func (w *Window) ToExpressions() []Expression {
@@ -82,6 +148,10 @@ func (w *Window) String() string {
 			sb.WriteString(ob.String())
 		}
 	}
+	if w.Frame != nil {
+		sb.WriteString(" ")
+		sb.WriteString(w.Frame.String())
+	}
 	sb.WriteString(")")
 	return sb.String()
 }
@@ -137,6 +207,10 @@ func (w *Window) DebugString() string {
 			sb.WriteString(DebugString(ob))
 		}
 	}
+	if w.Frame != nil {
+		sb.WriteString(" ")
+		sb.WriteString(w.Frame.String())
+	}
 	sb.WriteString(")")
 	return sb.String()
 }
@@ -0,0 +1,107 @@
+// Copyright 2022 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqle
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+)
+
+// statementStatisticsIter wraps the rows of a statement, and records the statement in the statement statistics of the
+// catalog once they're closed.
+type statementStatisticsIter struct {
+	childIter      sql.RowIter
+	stats          *sql.StatementStatistics
+	run            sql.StatementRun
+	okResult       bool
+	warningsBefore uint16
+}
+
+var _ sql.RowIter = (*statementStatisticsIter)(nil)
+
+// newStatementStatisticsIter returns a statementStatisticsIter for the rows given of the analyzed statement given,
+// which started running at the time given.
+func newStatementStatisticsIter(ctx *sql.Context, stats *sql.StatementStatistics, query string, analyzed sql.Node, started time.Time, iter sql.RowIter) *statementStatisticsIter {
+	return &statementStatisticsIter{
+		childIter: iter,
+		stats:     stats,
+		run: sql.StatementRun{
+			Database:    ctx.GetCurrentDatabase(),
+			Query:       query,
+			Started:     started,
+			NoIndexUsed: readsTableWithoutIndex(analyzed),
+		},
+		okResult:       sql.IsOkResultSchema(analyzed.Schema()),
+		warningsBefore: ctx.WarningCount(),
+	}
+}
+
+func (s *statementStatisticsIter) Next(ctx *sql.Context) (sql.Row, error) {
+	row, err := s.childIter.Next(ctx)
+	switch {
+	case err == nil && s.okResult && sql.IsOkResult(row):
+		s.run.RowsAffected += sql.GetOkResult(row).RowsAffected
+	case err == nil:
+		s.run.RowsSent++
+	case err != io.EOF && s.run.Err == nil:
+		s.run.Err = err
+	}
+	return row, err
+}
+
+func (s *statementStatisticsIter) Close(ctx *sql.Context) error {
+	err := s.childIter.Close(ctx)
+	if s.run.Err == nil {
+		s.run.Err = err
+	}
+	if warnings := ctx.WarningCount(); warnings > s.warningsBefore {
+		s.run.Warnings = uint64(warnings - s.warningsBefore)
+	}
+	s.run.Latency = time.Since(s.run.Started)
+	s.stats.RecordStatement(s.run)
+	return err
+}
+
+// recordFailedStatement records the statement given that failed before it could run in the statement statistics
+// given, if any.
+func recordFailedStatement(ctx *sql.Context, stats *sql.StatementStatistics, query string, started time.Time, err error) {
+	if stats == nil {
+		return
+	}
+	stats.RecordStatement(sql.StatementRun{
+		Database: ctx.GetCurrentDatabase(),
+		Query:    query,
+		Started:  started,
+		Latency:  time.Since(started),
+		Err:      err,
+	})
+}
+
+// readsTableWithoutIndex returns whether the analyzed statement given reads a table without an index. The dual table
+// of statements without a FROM clause isn't a table that's read.
+func readsTableWithoutIndex(analyzed sql.Node) bool {
+	found := false
+	plan.Inspect(analyzed, func(node sql.Node) bool {
+		if rt, ok := node.(*plan.ResolvedTable); ok && !strings.EqualFold(rt.Name(), "dual") {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2022 Dolthub, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"io"
+
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/dolthub/vitess/go/vt/proto/vtrpc"
+	"github.com/dolthub/vitess/go/vt/vterrors"
+)
+
+// This file contains the methods needed to write rows with streamed
+// values, see sqltypes.MakeStreamed. The packets of such a row are
+// written as its values are read, so that the row is never held in
+// memory whole.
+
+// packetWriter writes a payload of a known length as it's produced,
+// cutting it into packets like writePacket does.
+type packetWriter struct {
+	c *Conn
+	w io.Writer
+
+	// remaining is the length of the payload not written yet.
+	remaining int
+	// left is the length of the current packet not written yet.
+	left int
+	// full is set if the last packet started had MaxPacketSize size.
+	full bool
+	// started is set once the first packet is started.
+	started bool
+}
+
+// newPacketWriter returns a packetWriter for a payload of the given
+// length. Its Close method must be called once the payload is written.
+func (c *Conn) newPacketWriter(length int) *packetWriter {
+	return &packetWriter{c: c, w: c.getWriter(), remaining: length}
+}
+
+// Write implements io.Writer.
+func (w *packetWriter) Write(data []byte) (int, error) {
+	if len(data) > w.remaining {
+		return 0, vterrors.Errorf(vtrpc.Code_INTERNAL, "packet writer: got %v more bytes but expected %v", len(data), w.remaining)
+	}
+
+	n := len(data)
+	for len(data) > 0 {
+		if w.left == 0 {
+			if err := w.startPacket(); err != nil {
+				return 0, err
+			}
+		}
+		l := w.left
+		if l > len(data) {
+			l = len(data)
+		}
+		if _, err := w.w.Write(data[:l]); err != nil {
+			return 0, vterrors.Wrapf(err, "Write(packet) failed")
+		}
+		w.left -= l
+		w.remaining -= l
+		data = data[l:]
+	}
+	return n, nil
+}
+
+// startPacket writes the header of the next packet.
+func (w *packetWriter) startPacket() error {
+	packetLength := w.remaining
+	if packetLength > MaxPacketSize {
+		packetLength = MaxPacketSize
+	}
+
+	var header [4]byte
+	header[0] = byte(packetLength)
+	header[1] = byte(packetLength >> 8)
+	header[2] = byte(packetLength >> 16)
+	header[3] = w.c.sequence
+	if _, err := w.w.Write(header[:]); err != nil {
+		return vterrors.Wrapf(err, "Write(header) failed")
+	}
+
+	w.c.sequence++
+	w.left = packetLength
+	w.full = packetLength == MaxPacketSize
+	w.started = true
+	return nil
+}
+
+// Close ends the payload. Like writePacket, it sends a zero-size packet
+// if the last packet had exactly MaxPacketSize size.
+func (w *packetWriter) Close() error {
+	if w.remaining > 0 {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "packet writer: %v bytes of the payload were not written", w.remaining)
+	}
+	if !w.started || w.full {
+		return w.startPacket()
+	}
+	return nil
+}
+
+// streamedValueLen returns the length of a streamed value in a row,
+// as a length-encoded string.
+func streamedValueLen(stream sqltypes.ValueStream) int {
+	l := uint64(stream.Size())
+	return lenEncIntSize(l) + int(l)
+}
+
+// writeStreamedValue writes a streamed value to w, as a length-encoded
+// string.
+func writeStreamedValue(w io.Writer, stream sqltypes.ValueStream) error {
+	size := stream.Size()
+	var header [9]byte
+	pos := writeLenEncInt(header[:], 0, uint64(size))
+	if _, err := w.Write(header[:pos]); err != nil {
+		return err
+	}
+
+	r, err := stream.NewReader()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	n, err := io.CopyN(w, r, size)
+	if err == io.EOF {
+		return vterrors.Errorf(vtrpc.Code_INTERNAL, "streamed value: read %v bytes but expected %v", n, size)
+	}
+	return err
+}
+
+// writeStreamedRow writes a row with streamed values, whose payload has
+// the given length, in the text protocol. See writeRow.
+func (c *Conn) writeStreamedRow(row []sqltypes.Value, length int) error {
+	w := c.newPacketWriter(length)
+	var header [9]byte
+	for _, val := range row {
+		if val.IsNull() {
+			header[0] = NullValue
+			if _, err := w.Write(header[:1]); err != nil {
+				return err
+			}
+			continue
+		}
+		if stream := val.Stream(); stream != nil {
+			if err := writeStreamedValue(w, stream); err != nil {
+				return err
+			}
+			continue
+		}
+		pos := writeLenEncInt(header[:], 0, uint64(len(val.Raw())))
+		if _, err := w.Write(header[:pos]); err != nil {
+			return err
+		}
+		if _, err := w.Write(val.Raw()); err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
+
+// writeStreamedBinaryRow writes a row with streamed values, whose
+// payload has the given length, in the binary protocol. See
+// writeBinaryRow.
+func (c *Conn) writeStreamedBinaryRow(row []sqltypes.Value, length, nullBitMapLen int) error {
+	w := c.newPacketWriter(length)
+
+	header := make([]byte, nullBitMapLen+1)
+	for i, val := range row {
+		if val.IsNull() {
+			bytePos := (i+2)/8 + 1
+			bitPos := (i + 2) % 8
+			header[bytePos] |= 1 << uint(bitPos)
+		}
+	}
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+
+	for _, val := range row {
+		if val.IsNull() {
+			continue
+		}
+		if stream := val.Stream(); stream != nil {
+			if err := writeStreamedValue(w, stream); err != nil {
+				return err
+			}
+			continue
+		}
+		v, err := val2MySQL(val)
+		if err != nil {
+			return vterrors.Errorf(vtrpc.Code_INTERNAL, "internal value %v to MySQL value error: %v", val, err)
+		}
+		if _, err := w.Write(v); err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
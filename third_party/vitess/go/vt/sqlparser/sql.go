@@ -0,0 +1,13351 @@
+// Code generated by goyacc -o sql.go sql.y. DO NOT EDIT.
+
+//line sql.y:18
+package sqlparser
+
+import __yyfmt__ "fmt"
+
+//line sql.y:18
+
+import "fmt"
+
+func setParseTree(yylex interface{}, stmt Statement) {
+	yylex.(*Tokenizer).ParseTree = stmt
+}
+
+func setAllowComments(yylex interface{}, allow bool) {
+	yylex.(*Tokenizer).AllowComments = allow
+}
+
+func incNesting(yylex interface{}) bool {
+	yylex.(*Tokenizer).nesting++
+	if yylex.(*Tokenizer).nesting == 200 {
+		return true
+	}
+	return false
+}
+
+func decNesting(yylex interface{}) {
+	yylex.(*Tokenizer).nesting--
+}
+
+func statementSeen(yylex interface{}) {
+	if yylex.(*Tokenizer).stopAfterFirstStmt {
+		yylex.(*Tokenizer).stopped = true
+	}
+}
+
+func yyPosition(yylex interface{}) int {
+	return yylex.(*Tokenizer).Position
+}
+
+func yyOldPosition(yylex interface{}) int {
+	return yylex.(*Tokenizer).OldPosition
+}
+
+// skipToEnd forces the lexer to end prematurely. Not all SQL statements
+// are supported by the Parser, thus calling skipToEnd will make the lexer
+// return EOF early.
+func skipToEnd(yylex interface{}) {
+	yylex.(*Tokenizer).SkipToEnd = true
+}
+
+//line sql.y:65
+type yySymType struct {
+	yys                      int
+	empty                    struct{}
+	statement                Statement
+	selStmt                  SelectStatement
+	ddl                      *DDL
+	ddls                     []*DDL
+	ins                      *Insert
+	byt                      byte
+	bytes                    []byte
+	bytes2                   [][]byte
+	str                      string
+	int                      int
+	strs                     []string
+	selectExprs              SelectExprs
+	selectExpr               SelectExpr
+	columns                  Columns
+	statements               Statements
+	partitions               Partitions
+	colName                  *ColName
+	tableExprs               TableExprs
+	tableExpr                TableExpr
+	with                     *With
+	subquery                 *Subquery
+	simpleTableExpr          SimpleTableExpr
+	joinCondition            JoinCondition
+	tableName                TableName
+	tableNames               TableNames
+	indexHints               *IndexHints
+	asOf                     *AsOf
+	expr                     Expr
+	exprs                    Exprs
+	boolVal                  BoolVal
+	boolean                  bool
+	sqlVal                   *SQLVal
+	colTuple                 ColTuple
+	values                   Values
+	valTuple                 ValTuple
+	whens                    []*When
+	when                     *When
+	orderBy                  OrderBy
+	order                    *Order
+	limit                    *Limit
+	assignExprs              AssignmentExprs
+	assignExpr               *AssignmentExpr
+	setVarExprs              SetVarExprs
+	setVarExpr               *SetVarExpr
+	setScope                 SetScope
+	colIdent                 ColIdent
+	colIdents                []ColIdent
+	tableIdent               TableIdent
+	convertType              *ConvertType
+	aliasedTableName         *AliasedTableExpr
+	TableSpec                *TableSpec
+	columnType               ColumnType
+	columnOrder              *ColumnOrder
+	triggerOrder             *TriggerOrder
+	colKeyOpt                ColumnKeyOption
+	optVal                   Expr
+	LengthScaleOption        LengthScaleOption
+	columnDefinition         *ColumnDefinition
+	indexDefinition          *IndexDefinition
+	indexInfo                *IndexInfo
+	indexOption              *IndexOption
+	indexOptions             []*IndexOption
+	indexColumn              *IndexColumn
+	indexColumns             []*IndexColumn
+	constraintDefinition     *ConstraintDefinition
+	constraintInfo           ConstraintInfo
+	ReferenceAction          ReferenceAction
+	partDefs                 []*PartitionDefinition
+	partDef                  *PartitionDefinition
+	partSpec                 *PartitionSpec
+	showFilter               *ShowFilter
+	over                     *Over
+	frame                    *Frame
+	frameExtent              *FrameExtent
+	frameBound               *FrameBound
+	caseStatementCases       []CaseStatementCase
+	caseStatementCase        CaseStatementCase
+	ifStatementConditions    []IfStatementCondition
+	ifStatementCondition     IfStatementCondition
+	signalInfo               SignalInfo
+	signalInfos              []SignalInfo
+	signalConditionItemName  SignalConditionItemName
+	declareHandlerAction     DeclareHandlerAction
+	declareHandlerCondition  DeclareHandlerCondition
+	declareHandlerConditions []DeclareHandlerCondition
+	procedureParam           ProcedureParam
+	procedureParams          []ProcedureParam
+	characteristic           Characteristic
+	characteristics          []Characteristic
+	Fields                   *Fields
+	Lines                    *Lines
+	EnclosedBy               *EnclosedBy
+	tableAndLockType         *TableAndLockType
+	tableAndLockTypes        TableAndLockTypes
+	lockType                 LockType
+	accountName              AccountName
+	accountNames             []AccountName
+	accountRenames           []AccountRename
+	authentication           *Authentication
+	accountWithAuth          AccountWithAuth
+	accountsWithAuth         []AccountWithAuth
+	tlsOptionItem            TLSOptionItem
+	tlsOptionItems           []TLSOptionItem
+	accountLimitItem         AccountLimitItem
+	accountLimitItems        []AccountLimitItem
+	passLockItem             PassLockItem
+	passLockItems            []PassLockItem
+	grantPrivilege           Privilege
+	grantPrivileges          []Privilege
+	grantObjectType          GrantObjectType
+	privilegeLevel           PrivilegeLevel
+	grantAssumption          *GrantUserAssumption
+}
+
+const LEX_ERROR = 57346
+const UNION = 57347
+const SELECT = 57348
+const STREAM = 57349
+const INSERT = 57350
+const UPDATE = 57351
+const DELETE = 57352
+const FROM = 57353
+const WHERE = 57354
+const GROUP = 57355
+const HAVING = 57356
+const ORDER = 57357
+const BY = 57358
+const LIMIT = 57359
+const OFFSET = 57360
+const FOR = 57361
+const CALL = 57362
+const ALL = 57363
+const DISTINCT = 57364
+const AS = 57365
+const EXISTS = 57366
+const ASC = 57367
+const DESC = 57368
+const INTO = 57369
+const DUPLICATE = 57370
+const DEFAULT = 57371
+const SET = 57372
+const LOCK = 57373
+const UNLOCK = 57374
+const KEYS = 57375
+const OF = 57376
+const OUTFILE = 57377
+const DATA = 57378
+const LOAD = 57379
+const LINES = 57380
+const TERMINATED = 57381
+const ESCAPED = 57382
+const ENCLOSED = 57383
+const OPTIONALLY = 57384
+const STARTING = 57385
+const UNIQUE = 57386
+const KEY = 57387
+const SYSTEM_TIME = 57388
+const VALUES = 57389
+const LAST_INSERT_ID = 57390
+const SQL_CALC_FOUND_ROWS = 57391
+const NEXT = 57392
+const VALUE = 57393
+const SHARE = 57394
+const MODE = 57395
+const SQL_NO_CACHE = 57396
+const SQL_CACHE = 57397
+const JOIN = 57398
+const STRAIGHT_JOIN = 57399
+const LEFT = 57400
+const RIGHT = 57401
+const INNER = 57402
+const OUTER = 57403
+const CROSS = 57404
+const NATURAL = 57405
+const USE = 57406
+const FORCE = 57407
+const ON = 57408
+const USING = 57409
+const ID = 57410
+const HEX = 57411
+const STRING = 57412
+const INTEGRAL = 57413
+const FLOAT = 57414
+const HEXNUM = 57415
+const VALUE_ARG = 57416
+const LIST_ARG = 57417
+const COMMENT = 57418
+const COMMENT_KEYWORD = 57419
+const BIT_LITERAL = 57420
+const NULL = 57421
+const TRUE = 57422
+const FALSE = 57423
+const OFF = 57424
+const OR = 57425
+const AND = 57426
+const NOT = 57427
+const BETWEEN = 57428
+const CASE = 57429
+const WHEN = 57430
+const THEN = 57431
+const ELSE = 57432
+const ELSEIF = 57433
+const END = 57434
+const LE = 57435
+const GE = 57436
+const NE = 57437
+const NULL_SAFE_EQUAL = 57438
+const IS = 57439
+const LIKE = 57440
+const REGEXP = 57441
+const IN = 57442
+const SHIFT_LEFT = 57443
+const SHIFT_RIGHT = 57444
+const DIV = 57445
+const MOD = 57446
+const UNARY = 57447
+const COLLATE = 57448
+const BINARY = 57449
+const UNDERSCORE_BINARY = 57450
+const UNDERSCORE_UTF8MB4 = 57451
+const INTERVAL = 57452
+const JSON_EXTRACT_OP = 57453
+const JSON_UNQUOTE_EXTRACT_OP = 57454
+const CREATE = 57455
+const ALTER = 57456
+const DROP = 57457
+const RENAME = 57458
+const ANALYZE = 57459
+const ADD = 57460
+const FLUSH = 57461
+const MODIFY = 57462
+const CHANGE = 57463
+const SCHEMA = 57464
+const TABLE = 57465
+const INDEX = 57466
+const INDEXES = 57467
+const VIEW = 57468
+const TO = 57469
+const IGNORE = 57470
+const IF = 57471
+const PRIMARY = 57472
+const COLUMN = 57473
+const SPATIAL = 57474
+const FULLTEXT = 57475
+const KEY_BLOCK_SIZE = 57476
+const CHECK = 57477
+const ACTION = 57478
+const CASCADE = 57479
+const CONSTRAINT = 57480
+const FOREIGN = 57481
+const NO = 57482
+const REFERENCES = 57483
+const RESTRICT = 57484
+const FIRST = 57485
+const AFTER = 57486
+const SHOW = 57487
+const DESCRIBE = 57488
+const EXPLAIN = 57489
+const DATE = 57490
+const ESCAPE = 57491
+const REPAIR = 57492
+const OPTIMIZE = 57493
+const TRUNCATE = 57494
+const FORMAT = 57495
+const MAXVALUE = 57496
+const PARTITION = 57497
+const REORGANIZE = 57498
+const LESS = 57499
+const THAN = 57500
+const PROCEDURE = 57501
+const TRIGGER = 57502
+const TRIGGERS = 57503
+const FUNCTION = 57504
+const STATUS = 57505
+const VARIABLES = 57506
+const WARNINGS = 57507
+const ERRORS = 57508
+const KILL = 57509
+const CONNECTION = 57510
+const SEQUENCE = 57511
+const EACH = 57512
+const ROW = 57513
+const BEFORE = 57514
+const FOLLOWS = 57515
+const PRECEDES = 57516
+const DEFINER = 57517
+const INVOKER = 57518
+const INOUT = 57519
+const OUT = 57520
+const DETERMINISTIC = 57521
+const CONTAINS = 57522
+const READS = 57523
+const MODIFIES = 57524
+const SQL = 57525
+const SECURITY = 57526
+const TEMPORARY = 57527
+const CLASS_ORIGIN = 57528
+const SUBCLASS_ORIGIN = 57529
+const MESSAGE_TEXT = 57530
+const MYSQL_ERRNO = 57531
+const CONSTRAINT_CATALOG = 57532
+const CONSTRAINT_SCHEMA = 57533
+const CONSTRAINT_NAME = 57534
+const CATALOG_NAME = 57535
+const SCHEMA_NAME = 57536
+const TABLE_NAME = 57537
+const COLUMN_NAME = 57538
+const CURSOR_NAME = 57539
+const SIGNAL = 57540
+const RESIGNAL = 57541
+const SQLSTATE = 57542
+const DECLARE = 57543
+const CONDITION = 57544
+const CURSOR = 57545
+const CONTINUE = 57546
+const EXIT = 57547
+const UNDO = 57548
+const HANDLER = 57549
+const FOUND = 57550
+const SQLWARNING = 57551
+const SQLEXCEPTION = 57552
+const USER = 57553
+const IDENTIFIED = 57554
+const ROLE = 57555
+const REUSE = 57556
+const GRANT = 57557
+const GRANTS = 57558
+const REVOKE = 57559
+const NONE = 57560
+const ATTRIBUTE = 57561
+const RANDOM = 57562
+const PASSWORD = 57563
+const INITIAL = 57564
+const AUTHENTICATION = 57565
+const SSL = 57566
+const X509 = 57567
+const CIPHER = 57568
+const ISSUER = 57569
+const SUBJECT = 57570
+const ACCOUNT = 57571
+const EXPIRE = 57572
+const NEVER = 57573
+const DAY = 57574
+const OPTION = 57575
+const OPTIONAL = 57576
+const EXCEPT = 57577
+const ADMIN = 57578
+const PRIVILEGES = 57579
+const MAX_QUERIES_PER_HOUR = 57580
+const MAX_UPDATES_PER_HOUR = 57581
+const MAX_CONNECTIONS_PER_HOUR = 57582
+const MAX_USER_CONNECTIONS = 57583
+const FAILED_LOGIN_ATTEMPTS = 57584
+const PASSWORD_LOCK_TIME = 57585
+const UNBOUNDED = 57586
+const REQUIRE = 57587
+const PROXY = 57588
+const BEGIN = 57589
+const START = 57590
+const TRANSACTION = 57591
+const COMMIT = 57592
+const ROLLBACK = 57593
+const SAVEPOINT = 57594
+const WORK = 57595
+const RELEASE = 57596
+const BIT = 57597
+const TINYINT = 57598
+const SMALLINT = 57599
+const MEDIUMINT = 57600
+const INT = 57601
+const INTEGER = 57602
+const BIGINT = 57603
+const INTNUM = 57604
+const SERIAL = 57605
+const REAL = 57606
+const DOUBLE = 57607
+const FLOAT_TYPE = 57608
+const DECIMAL = 57609
+const NUMERIC = 57610
+const DEC = 57611
+const FIXED = 57612
+const PRECISION = 57613
+const TIME = 57614
+const TIMESTAMP = 57615
+const DATETIME = 57616
+const YEAR = 57617
+const CHAR = 57618
+const VARCHAR = 57619
+const BOOL = 57620
+const CHARACTER = 57621
+const VARBINARY = 57622
+const NCHAR = 57623
+const NVARCHAR = 57624
+const NATIONAL = 57625
+const VARYING = 57626
+const TEXT = 57627
+const TINYTEXT = 57628
+const MEDIUMTEXT = 57629
+const LONGTEXT = 57630
+const LONG = 57631
+const BLOB = 57632
+const TINYBLOB = 57633
+const MEDIUMBLOB = 57634
+const LONGBLOB = 57635
+const JSON = 57636
+const ENUM = 57637
+const GEOMETRY = 57638
+const POINT = 57639
+const LINESTRING = 57640
+const POLYGON = 57641
+const GEOMETRYCOLLECTION = 57642
+const MULTIPOINT = 57643
+const MULTILINESTRING = 57644
+const MULTIPOLYGON = 57645
+const LOCAL = 57646
+const LOW_PRIORITY = 57647
+const NULLX = 57648
+const AUTO_INCREMENT = 57649
+const APPROXNUM = 57650
+const SIGNED = 57651
+const UNSIGNED = 57652
+const ZEROFILL = 57653
+const COLLATION = 57654
+const DATABASES = 57655
+const SCHEMAS = 57656
+const TABLES = 57657
+const FULL = 57658
+const PROCESSLIST = 57659
+const COLUMNS = 57660
+const FIELDS = 57661
+const ENGINES = 57662
+const PLUGINS = 57663
+const NAMES = 57664
+const CHARSET = 57665
+const GLOBAL = 57666
+const SESSION = 57667
+const ISOLATION = 57668
+const LEVEL = 57669
+const READ = 57670
+const WRITE = 57671
+const ONLY = 57672
+const REPEATABLE = 57673
+const COMMITTED = 57674
+const UNCOMMITTED = 57675
+const SERIALIZABLE = 57676
+const CURRENT_TIMESTAMP = 57677
+const DATABASE = 57678
+const CURRENT_DATE = 57679
+const CURRENT_USER = 57680
+const CURRENT_TIME = 57681
+const LOCALTIME = 57682
+const LOCALTIMESTAMP = 57683
+const UTC_DATE = 57684
+const UTC_TIME = 57685
+const UTC_TIMESTAMP = 57686
+const REPLACE = 57687
+const CONVERT = 57688
+const CAST = 57689
+const SUBSTR = 57690
+const SUBSTRING = 57691
+const TRIM = 57692
+const LEADING = 57693
+const TRAILING = 57694
+const BOTH = 57695
+const GROUP_CONCAT = 57696
+const SEPARATOR = 57697
+const TIMESTAMPADD = 57698
+const TIMESTAMPDIFF = 57699
+const OVER = 57700
+const WINDOW = 57701
+const GROUPING = 57702
+const GROUPS = 57703
+const CURRENT = 57704
+const ROWS = 57705
+const RANGE = 57706
+const AVG = 57707
+const BIT_AND = 57708
+const BIT_OR = 57709
+const BIT_XOR = 57710
+const COUNT = 57711
+const JSON_ARRAYAGG = 57712
+const JSON_OBJECTAGG = 57713
+const MAX = 57714
+const MIN = 57715
+const STDDEV_POP = 57716
+const STDDEV = 57717
+const STD = 57718
+const STDDEV_SAMP = 57719
+const SUM = 57720
+const VAR_POP = 57721
+const VARIANCE = 57722
+const VAR_SAMP = 57723
+const CUME_DIST = 57724
+const DENSE_RANK = 57725
+const FIRST_VALUE = 57726
+const LAG = 57727
+const LAST_VALUE = 57728
+const LEAD = 57729
+const NTH_VALUE = 57730
+const NTILE = 57731
+const ROW_NUMBER = 57732
+const PERCENT_RANK = 57733
+const RANK = 57734
+const MATCH = 57735
+const AGAINST = 57736
+const BOOLEAN = 57737
+const LANGUAGE = 57738
+const WITH = 57739
+const QUERY = 57740
+const EXPANSION = 57741
+const UNUSED = 57742
+const ARRAY = 57743
+const DESCRIPTION = 57744
+const EMPTY = 57745
+const JSON_TABLE = 57746
+const LATERAL = 57747
+const MEMBER = 57748
+const RECURSIVE = 57749
+const ACTIVE = 57750
+const BUCKETS = 57751
+const CLONE = 57752
+const COMPONENT = 57753
+const DEFINITION = 57754
+const ENFORCED = 57755
+const EXCLUDE = 57756
+const FOLLOWING = 57757
+const GEOMCOLLECTION = 57758
+const GET_MASTER_PUBLIC_KEY = 57759
+const HISTOGRAM = 57760
+const HISTORY = 57761
+const INACTIVE = 57762
+const INVISIBLE = 57763
+const LOCKED = 57764
+const MASTER_COMPRESSION_ALGORITHMS = 57765
+const MASTER_PUBLIC_KEY_PATH = 57766
+const MASTER_TLS_CIPHERSUITES = 57767
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57768
+const NESTED = 57769
+const NETWORK_NAMESPACE = 57770
+const NOWAIT = 57771
+const NULLS = 57772
+const OJ = 57773
+const OLD = 57774
+const ORDINALITY = 57775
+const ORGANIZATION = 57776
+const OTHERS = 57777
+const PATH = 57778
+const PERSIST = 57779
+const PERSIST_ONLY = 57780
+const PRECEDING = 57781
+const PRIVILEGE_CHECKS_USER = 57782
+const PROCESS = 57783
+const REFERENCE = 57784
+const REQUIRE_ROW_FORMAT = 57785
+const RESOURCE = 57786
+const RESPECT = 57787
+const RESTART = 57788
+const RETAIN = 57789
+const SECONDARY = 57790
+const SECONDARY_ENGINE = 57791
+const SECONDARY_LOAD = 57792
+const SECONDARY_UNLOAD = 57793
+const SKIP = 57794
+const SRID = 57795
+const THREAD_PRIORITY = 57796
+const TIES = 57797
+const VCPU = 57798
+const VISIBLE = 57799
+const SYSTEM = 57800
+const INFILE = 57801
+const MATERIALIZED = 57802
+const PREPARE = 57803
+const EXECUTE = 57804
+const DEALLOCATE = 57805
+const SHUTDOWN = 57806
+const RESET = 57807
+const MASTER = 57808
+const REPLICA = 57809
+const SLAVE = 57810
+const OPEN = 57811
+const CLOSE = 57812
+const PREV = 57813
+const LAST = 57814
+const CHECKSUM = 57815
+const QUICK = 57816
+const EXTENDED = 57817
+const NO_WRITE_TO_BINLOG = 57818
+const USE_FRM = 57819
+
+var yyToknames = [...]string{
+	"$end",
+	"error",
+	"$unk",
+	"LEX_ERROR",
+	"UNION",
+	"SELECT",
+	"STREAM",
+	"INSERT",
+	"UPDATE",
+	"DELETE",
+	"FROM",
+	"WHERE",
+	"GROUP",
+	"HAVING",
+	"ORDER",
+	"BY",
+	"LIMIT",
+	"OFFSET",
+	"FOR",
+	"CALL",
+	"ALL",
+	"DISTINCT",
+	"AS",
+	"EXISTS",
+	"ASC",
+	"DESC",
+	"INTO",
+	"DUPLICATE",
+	"DEFAULT",
+	"SET",
+	"LOCK",
+	"UNLOCK",
+	"KEYS",
+	"OF",
+	"OUTFILE",
+	"DATA",
+	"LOAD",
+	"LINES",
+	"TERMINATED",
+	"ESCAPED",
+	"ENCLOSED",
+	"OPTIONALLY",
+	"STARTING",
+	"UNIQUE",
+	"KEY",
+	"SYSTEM_TIME",
+	"VALUES",
+	"LAST_INSERT_ID",
+	"SQL_CALC_FOUND_ROWS",
+	"NEXT",
+	"VALUE",
+	"SHARE",
+	"MODE",
+	"SQL_NO_CACHE",
+	"SQL_CACHE",
+	"JOIN",
+	"STRAIGHT_JOIN",
+	"LEFT",
+	"RIGHT",
+	"INNER",
+	"OUTER",
+	"CROSS",
+	"NATURAL",
+	"USE",
+	"FORCE",
+	"ON",
+	"USING",
+	"'('",
+	"','",
+	"')'",
+	"'@'",
+	"ID",
+	"HEX",
+	"STRING",
+	"INTEGRAL",
+	"FLOAT",
+	"HEXNUM",
+	"VALUE_ARG",
+	"LIST_ARG",
+	"COMMENT",
+	"COMMENT_KEYWORD",
+	"BIT_LITERAL",
+	"NULL",
+	"TRUE",
+	"FALSE",
+	"OFF",
+	"OR",
+	"AND",
+	"NOT",
+	"'!'",
+	"BETWEEN",
+	"CASE",
+	"WHEN",
+	"THEN",
+	"ELSE",
+	"ELSEIF",
+	"END",
+	"'='",
+	"'<'",
+	"'>'",
+	"LE",
+	"GE",
+	"NE",
+	"NULL_SAFE_EQUAL",
+	"IS",
+	"LIKE",
+	"REGEXP",
+	"IN",
+	"'|'",
+	"'&'",
+	"SHIFT_LEFT",
+	"SHIFT_RIGHT",
+	"'+'",
+	"'-'",
+	"'*'",
+	"'/'",
+	"DIV",
+	"'%'",
+	"MOD",
+	"'^'",
+	"'~'",
+	"UNARY",
+	"COLLATE",
+	"BINARY",
+	"UNDERSCORE_BINARY",
+	"UNDERSCORE_UTF8MB4",
+	"INTERVAL",
+	"'.'",
+	"JSON_EXTRACT_OP",
+	"JSON_UNQUOTE_EXTRACT_OP",
+	"CREATE",
+	"ALTER",
+	"DROP",
+	"RENAME",
+	"ANALYZE",
+	"ADD",
+	"FLUSH",
+	"MODIFY",
+	"CHANGE",
+	"SCHEMA",
+	"TABLE",
+	"INDEX",
+	"INDEXES",
+	"VIEW",
+	"TO",
+	"IGNORE",
+	"IF",
+	"PRIMARY",
+	"COLUMN",
+	"SPATIAL",
+	"FULLTEXT",
+	"KEY_BLOCK_SIZE",
+	"CHECK",
+	"ACTION",
+	"CASCADE",
+	"CONSTRAINT",
+	"FOREIGN",
+	"NO",
+	"REFERENCES",
+	"RESTRICT",
+	"FIRST",
+	"AFTER",
+	"SHOW",
+	"DESCRIBE",
+	"EXPLAIN",
+	"DATE",
+	"ESCAPE",
+	"REPAIR",
+	"OPTIMIZE",
+	"TRUNCATE",
+	"FORMAT",
+	"MAXVALUE",
+	"PARTITION",
+	"REORGANIZE",
+	"LESS",
+	"THAN",
+	"PROCEDURE",
+	"TRIGGER",
+	"TRIGGERS",
+	"FUNCTION",
+	"STATUS",
+	"VARIABLES",
+	"WARNINGS",
+	"ERRORS",
+	"KILL",
+	"CONNECTION",
+	"SEQUENCE",
+	"EACH",
+	"ROW",
+	"BEFORE",
+	"FOLLOWS",
+	"PRECEDES",
+	"DEFINER",
+	"INVOKER",
+	"INOUT",
+	"OUT",
+	"DETERMINISTIC",
+	"CONTAINS",
+	"READS",
+	"MODIFIES",
+	"SQL",
+	"SECURITY",
+	"TEMPORARY",
+	"CLASS_ORIGIN",
+	"SUBCLASS_ORIGIN",
+	"MESSAGE_TEXT",
+	"MYSQL_ERRNO",
+	"CONSTRAINT_CATALOG",
+	"CONSTRAINT_SCHEMA",
+	"CONSTRAINT_NAME",
+	"CATALOG_NAME",
+	"SCHEMA_NAME",
+	"TABLE_NAME",
+	"COLUMN_NAME",
+	"CURSOR_NAME",
+	"SIGNAL",
+	"RESIGNAL",
+	"SQLSTATE",
+	"DECLARE",
+	"CONDITION",
+	"CURSOR",
+	"CONTINUE",
+	"EXIT",
+	"UNDO",
+	"HANDLER",
+	"FOUND",
+	"SQLWARNING",
+	"SQLEXCEPTION",
+	"USER",
+	"IDENTIFIED",
+	"ROLE",
+	"REUSE",
+	"GRANT",
+	"GRANTS",
+	"REVOKE",
+	"NONE",
+	"ATTRIBUTE",
+	"RANDOM",
+	"PASSWORD",
+	"INITIAL",
+	"AUTHENTICATION",
+	"SSL",
+	"X509",
+	"CIPHER",
+	"ISSUER",
+	"SUBJECT",
+	"ACCOUNT",
+	"EXPIRE",
+	"NEVER",
+	"DAY",
+	"OPTION",
+	"OPTIONAL",
+	"EXCEPT",
+	"ADMIN",
+	"PRIVILEGES",
+	"MAX_QUERIES_PER_HOUR",
+	"MAX_UPDATES_PER_HOUR",
+	"MAX_CONNECTIONS_PER_HOUR",
+	"MAX_USER_CONNECTIONS",
+	"FAILED_LOGIN_ATTEMPTS",
+	"PASSWORD_LOCK_TIME",
+	"UNBOUNDED",
+	"REQUIRE",
+	"PROXY",
+	"BEGIN",
+	"START",
+	"TRANSACTION",
+	"COMMIT",
+	"ROLLBACK",
+	"SAVEPOINT",
+	"WORK",
+	"RELEASE",
+	"BIT",
+	"TINYINT",
+	"SMALLINT",
+	"MEDIUMINT",
+	"INT",
+	"INTEGER",
+	"BIGINT",
+	"INTNUM",
+	"SERIAL",
+	"REAL",
+	"DOUBLE",
+	"FLOAT_TYPE",
+	"DECIMAL",
+	"NUMERIC",
+	"DEC",
+	"FIXED",
+	"PRECISION",
+	"TIME",
+	"TIMESTAMP",
+	"DATETIME",
+	"YEAR",
+	"CHAR",
+	"VARCHAR",
+	"BOOL",
+	"CHARACTER",
+	"VARBINARY",
+	"NCHAR",
+	"NVARCHAR",
+	"NATIONAL",
+	"VARYING",
+	"TEXT",
+	"TINYTEXT",
+	"MEDIUMTEXT",
+	"LONGTEXT",
+	"LONG",
+	"BLOB",
+	"TINYBLOB",
+	"MEDIUMBLOB",
+	"LONGBLOB",
+	"JSON",
+	"ENUM",
+	"GEOMETRY",
+	"POINT",
+	"LINESTRING",
+	"POLYGON",
+	"GEOMETRYCOLLECTION",
+	"MULTIPOINT",
+	"MULTILINESTRING",
+	"MULTIPOLYGON",
+	"LOCAL",
+	"LOW_PRIORITY",
+	"NULLX",
+	"AUTO_INCREMENT",
+	"APPROXNUM",
+	"SIGNED",
+	"UNSIGNED",
+	"ZEROFILL",
+	"COLLATION",
+	"DATABASES",
+	"SCHEMAS",
+	"TABLES",
+	"FULL",
+	"PROCESSLIST",
+	"COLUMNS",
+	"FIELDS",
+	"ENGINES",
+	"PLUGINS",
+	"NAMES",
+	"CHARSET",
+	"GLOBAL",
+	"SESSION",
+	"ISOLATION",
+	"LEVEL",
+	"READ",
+	"WRITE",
+	"ONLY",
+	"REPEATABLE",
+	"COMMITTED",
+	"UNCOMMITTED",
+	"SERIALIZABLE",
+	"CURRENT_TIMESTAMP",
+	"DATABASE",
+	"CURRENT_DATE",
+	"CURRENT_USER",
+	"CURRENT_TIME",
+	"LOCALTIME",
+	"LOCALTIMESTAMP",
+	"UTC_DATE",
+	"UTC_TIME",
+	"UTC_TIMESTAMP",
+	"REPLACE",
+	"CONVERT",
+	"CAST",
+	"SUBSTR",
+	"SUBSTRING",
+	"TRIM",
+	"LEADING",
+	"TRAILING",
+	"BOTH",
+	"GROUP_CONCAT",
+	"SEPARATOR",
+	"TIMESTAMPADD",
+	"TIMESTAMPDIFF",
+	"OVER",
+	"WINDOW",
+	"GROUPING",
+	"GROUPS",
+	"CURRENT",
+	"ROWS",
+	"RANGE",
+	"AVG",
+	"BIT_AND",
+	"BIT_OR",
+	"BIT_XOR",
+	"COUNT",
+	"JSON_ARRAYAGG",
+	"JSON_OBJECTAGG",
+	"MAX",
+	"MIN",
+	"STDDEV_POP",
+	"STDDEV",
+	"STD",
+	"STDDEV_SAMP",
+	"SUM",
+	"VAR_POP",
+	"VARIANCE",
+	"VAR_SAMP",
+	"CUME_DIST",
+	"DENSE_RANK",
+	"FIRST_VALUE",
+	"LAG",
+	"LAST_VALUE",
+	"LEAD",
+	"NTH_VALUE",
+	"NTILE",
+	"ROW_NUMBER",
+	"PERCENT_RANK",
+	"RANK",
+	"MATCH",
+	"AGAINST",
+	"BOOLEAN",
+	"LANGUAGE",
+	"WITH",
+	"QUERY",
+	"EXPANSION",
+	"UNUSED",
+	"ARRAY",
+	"DESCRIPTION",
+	"EMPTY",
+	"JSON_TABLE",
+	"LATERAL",
+	"MEMBER",
+	"RECURSIVE",
+	"ACTIVE",
+	"BUCKETS",
+	"CLONE",
+	"COMPONENT",
+	"DEFINITION",
+	"ENFORCED",
+	"EXCLUDE",
+	"FOLLOWING",
+	"GEOMCOLLECTION",
+	"GET_MASTER_PUBLIC_KEY",
+	"HISTOGRAM",
+	"HISTORY",
+	"INACTIVE",
+	"INVISIBLE",
+	"LOCKED",
+	"MASTER_COMPRESSION_ALGORITHMS",
+	"MASTER_PUBLIC_KEY_PATH",
+	"MASTER_TLS_CIPHERSUITES",
+	"MASTER_ZSTD_COMPRESSION_LEVEL",
+	"NESTED",
+	"NETWORK_NAMESPACE",
+	"NOWAIT",
+	"NULLS",
+	"OJ",
+	"OLD",
+	"ORDINALITY",
+	"ORGANIZATION",
+	"OTHERS",
+	"PATH",
+	"PERSIST",
+	"PERSIST_ONLY",
+	"PRECEDING",
+	"PRIVILEGE_CHECKS_USER",
+	"PROCESS",
+	"REFERENCE",
+	"REQUIRE_ROW_FORMAT",
+	"RESOURCE",
+	"RESPECT",
+	"RESTART",
+	"RETAIN",
+	"SECONDARY",
+	"SECONDARY_ENGINE",
+	"SECONDARY_LOAD",
+	"SECONDARY_UNLOAD",
+	"SKIP",
+	"SRID",
+	"THREAD_PRIORITY",
+	"TIES",
+	"VCPU",
+	"VISIBLE",
+	"SYSTEM",
+	"INFILE",
+	"MATERIALIZED",
+	"PREPARE",
+	"EXECUTE",
+	"DEALLOCATE",
+	"SHUTDOWN",
+	"RESET",
+	"MASTER",
+	"REPLICA",
+	"SLAVE",
+	"OPEN",
+	"CLOSE",
+	"PREV",
+	"LAST",
+	"CHECKSUM",
+	"QUICK",
+	"EXTENDED",
+	"NO_WRITE_TO_BINLOG",
+	"USE_FRM",
+	"';'",
+}
+
+var yyStatenames = [...]string{}
+
+const yyEofCode = 1
+const yyErrCode = 2
+const yyInitialStackSize = 16
+
+//line yacctab:1
+var yyExca = [...]int{
+	-1, 1,
+	1, -1,
+	-2, 0,
+	-1, 42,
+	5, 61,
+	-2, 1025,
+	-1, 50,
+	141, 1087,
+	142, 1113,
+	-2, 215,
+	-1, 57,
+	181, 653,
+	182, 653,
+	-2, 643,
+	-1, 64,
+	1, 1621,
+	496, 1621,
+	-2, 681,
+	-1, 540,
+	128, 1123,
+	-2, 1117,
+	-1, 541,
+	128, 1124,
+	-2, 1118,
+	-1, 645,
+	98, 1415,
+	128, 1415,
+	-2, 1071,
+	-1, 646,
+	98, 1537,
+	128, 1537,
+	-2, 1072,
+	-1, 651,
+	98, 1439,
+	128, 1439,
+	-2, 1073,
+	-1, 652,
+	98, 1488,
+	128, 1488,
+	-2, 1074,
+	-1, 653,
+	98, 1489,
+	128, 1489,
+	-2, 1075,
+	-1, 654,
+	98, 1364,
+	128, 1364,
+	-2, 1079,
+	-1, 656,
+	98, 1464,
+	128, 1464,
+	-2, 1081,
+	-1, 970,
+	1, 1170,
+	496, 1170,
+	-2, 787,
+	-1, 1136,
+	1, 757,
+	5, 757,
+	12, 757,
+	13, 757,
+	14, 757,
+	15, 757,
+	17, 757,
+	19, 757,
+	30, 757,
+	31, 757,
+	56, 757,
+	57, 757,
+	58, 757,
+	59, 757,
+	60, 757,
+	62, 757,
+	63, 757,
+	66, 757,
+	67, 757,
+	69, 757,
+	70, 757,
+	496, 757,
+	-2, 787,
+	-1, 1140,
+	67, 78,
+	69, 78,
+	-2, 82,
+	-1, 1385,
+	128, 1126,
+	-2, 1122,
+	-1, 1550,
+	44, 487,
+	-2, 1377,
+	-1, 1554,
+	68, 487,
+	-2, 1326,
+	-1, 1557,
+	68, 483,
+	72, 483,
+	-2, 1253,
+	-1, 1558,
+	68, 484,
+	72, 484,
+	-2, 1263,
+	-1, 1694,
+	44, 530,
+	148, 532,
+	150, 530,
+	151, 530,
+	-2, 570,
+	-1, 1773,
+	5, 62,
+	-2, 853,
+	-1, 2083,
+	69, 1305,
+	70, 1305,
+	128, 1305,
+	-2, 700,
+	-1, 2106,
+	1, 808,
+	5, 808,
+	12, 808,
+	13, 808,
+	14, 808,
+	15, 808,
+	17, 808,
+	19, 808,
+	30, 808,
+	31, 808,
+	56, 808,
+	57, 808,
+	58, 808,
+	59, 808,
+	60, 808,
+	62, 808,
+	63, 808,
+	66, 808,
+	67, 808,
+	69, 808,
+	70, 808,
+	496, 808,
+	-2, 787,
+	-1, 2210,
+	148, 533,
+	-2, 531,
+	-1, 2273,
+	5, 62,
+	-2, 1045,
+	-1, 2475,
+	41, 1133,
+	-2, 1131,
+	-1, 2607,
+	5, 62,
+	-2, 1048,
+}
+
+const yyPrivate = 57344
+
+const yyLast = 34543
+
+var yyAct = [...]int{
+	603, 97, 2769, 2745, 2760, 2624, 2759, 453, 2610, 2688,
+	2747, 1602, 2796, 2528, 7, 2527, 6, 2597, 842, 2661,
+	2623, 2526, 5, 2529, 8, 2285, 543, 2496, 2686, 2592,
+	2475, 2100, 2422, 2118, 2009, 1812, 1171, 1600, 1999, 1261,
+	2525, 3, 1087, 532, 2076, 1677, 2382, 1614, 670, 2332,
+	2362, 2168, 2477, 602, 1509, 1559, 2338, 2316, 546, 525,
+	1363, 2611, 1503, 1507, 2119, 2077, 2203, 668, 2300, 1136,
+	100, 882, 2073, 1921, 520, 559, 1813, 110, 1353, 436,
+	439, 1551, 2008, 1692, 1861, 123, 498, 1591, 2085, 2092,
+	1726, 1356, 1410, 1263, 2192, 1541, 461, 461, 97, 2045,
+	1540, 1640, 1312, 1419, 1975, 859, 1547, 1283, 665, 1486,
+	1648, 430, 1904, 989, 1371, 1587, 1151, 1974, 1241, 1493,
+	1133, 1387, 1482, 647, 992, 1132, 1474, 996, 1339, 1000,
+	941, 1662, 664, 528, 870, 920, 1150, 872, 524, 639,
+	643, 1009, 644, 519, 1317, 456, 455, 517, 829, 86,
+	919, 102, 1142, 1649, 1650, 967, 1651, 433, 2818, 636,
+	2814, 1342, 1105, 2804, 2786, 2784, 2764, 650, 2740, 2669,
+	841, 99, 972, 505, 506, 507, 1355, 504, 1106, 435,
+	1713, 1310, 2726, 2723, 2153, 2310, 135, 131, 132, 951,
+	133, 2777, 448, 2655, 43, 1530, 104, 105, 106, 107,
+	108, 2301, 2758, 2454, 910, 2605, 2725, 2722, 2317, 1684,
+	1685, 1686, 1687, 1688, 1143, 2728, 2319, 1627, 1143, 2452,
+	1627, 2640, 2165, 137, 136, 43, 2654, 2066, 2651, 2652,
+	1575, 2571, 2692, 1748, 2455, 548, 2265, 2507, 1024, 1023,
+	1033, 1034, 1026, 1027, 1028, 1029, 1030, 1031, 1032, 1025,
+	666, 828, 1035, 1661, 2467, 1932, 98, 2114, 2115, 1849,
+	660, 43, 1848, 1527, 1528, 1850, 2407, 831, 1316, 834,
+	835, 2113, 1341, 1350, 1351, 138, 2019, 139, 982, 43,
+	43, 89, 46, 47, 2693, 2322, 1152, 98, 1153, 1526,
+	447, 1314, 1315, 2604, 911, 43, 1260, 89, 46, 47,
+	886, 887, 1505, 450, 1807, 446, 1561, 885, 1887, 70,
+	1660, 938, 1563, 970, 971, 95, 135, 131, 132, 48,
+	133, 1808, 1563, 98, 2320, 2321, 2323, 2324, 2325, 432,
+	618, 1576, 624, 626, 625, 622, 623, 621, 620, 619,
+	2692, 98, 98, 1567, 1569, 1581, 1568, 1576, 1588, 2252,
+	2250, 627, 628, 137, 136, 1313, 2687, 98, 424, 445,
+	89, 46, 47, 516, 1295, 2457, 900, 1927, 1959, 864,
+	2480, 957, 2333, 2753, 958, 2191, 1964, 1705, 2175, 1931,
+	2336, 2555, 48, 1672, 2767, 2770, 2766, 2339, 2340, 2341,
+	2342, 1704, 2693, 2334, 2335, 2630, 2671, 871, 871, 2575,
+	134, 2350, 2670, 2573, 538, 138, 2357, 139, 2453, 1615,
+	892, 1673, 2449, 871, 871, 871, 871, 871, 1330, 2689,
+	50, 91, 2554, 53, 56, 2677, 1929, 97, 97, 2348,
+	2773, 2391, 2666, 2447, 1709, 2628, 2556, 863, 867, 2442,
+	905, 869, 907, 1703, 2565, 2664, 2665, 2446, 906, 2445,
+	129, 2444, 57, 94, 93, 2561, 2562, 2443, 2441, 55,
+	2724, 883, 881, 884, 886, 887, 2612, 904, 908, 1609,
+	893, 894, 2166, 2287, 865, 868, 1833, 866, 2169, 2170,
+	2171, 2172, 2173, 2471, 425, 878, 912, 2169, 2170, 2171,
+	2172, 2173, 440, 877, 1701, 1695, 1696, 1608, 1694, 2523,
+	1697, 1698, 2755, 2178, 2521, 68, 69, 2810, 2557, 2658,
+	2659, 2720, 977, 1949, 1950, 879, 880, 2742, 833, 832,
+	2593, 986, 77, 101, 78, 140, 2152, 2689, 2002, 437,
+	134, 1930, 873, 449, 441, 1707, 1710, 2690, 130, 1487,
+	1044, 2318, 2567, 1046, 2508, 1170, 1894, 895, 896, 897,
+	898, 899, 2458, 1981, 2558, 92, 1566, 61, 62, 72,
+	959, 73, 1296, 2193, 901, 1354, 1667, 2572, 2450, 1590,
+	2800, 2209, 1057, 1058, 1059, 1060, 1061, 1062, 1063, 1064,
+	1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072, 1073, 1074,
+	1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082, 1083, 1084,
+	1682, 1683, 1085, 96, 1089, 1090, 1091, 1092, 1093, 1094,
+	1095, 1096, 1097, 1098, 1099, 1100, 1101, 2736, 1104, 1107,
+	1107, 1107, 1113, 1107, 1107, 1113, 1107, 1113, 1122, 1123,
+	1124, 1125, 1126, 1127, 96, 1137, 90, 1649, 1650, 913,
+	1651, 1344, 1345, 2603, 1086, 2690, 1477, 2470, 953, 954,
+	955, 862, 90, 2468, 1933, 1316, 1576, 1879, 2629, 1702,
+	438, 976, 974, 969, 2566, 2751, 984, 2177, 2746, 973,
+	96, 2819, 1884, 1883, 2046, 438, 427, 2816, 1314, 1315,
+	438, 2240, 2749, 1922, 541, 1318, 1234, 1700, 96, 96,
+	2363, 2364, 1170, 2577, 1880, 2798, 2124, 1131, 2799, 2805,
+	2797, 2787, 650, 459, 96, 457, 460, 650, 830, 1885,
+	844, 1872, 428, 2122, 2373, 90, 2048, 1873, 1170, 1923,
+	2423, 1320, 2124, 126, 1319, 1170, 1706, 126, 1169, 515,
+	514, 1164, 515, 949, 2425, 1230, 1143, 2374, 2377, 1958,
+	145, 1955, 1935, 145, 1934, 1668, 1512, 1514, 1249, 145,
+	922, 923, 924, 925, 926, 927, 928, 929, 930, 931,
+	932, 933, 464, 464, 502, 502, 116, 1155, 1247, 145,
+	966, 902, 1156, 1168, 1840, 1839, 1882, 1838, 1045, 1866,
+	1708, 145, 1108, 1110, 1112, 1114, 1116, 1118, 1119, 1121,
+	1146, 826, 145, 1953, 2237, 1141, 145, 502, 1109, 1111,
+	145, 1115, 1117, 980, 1120, 2424, 1924, 1925, 1866, 948,
+	1866, 438, 1866, 1165, 145, 464, 836, 502, 509, 118,
+	2748, 2750, 1624, 115, 1996, 502, 145, 1623, 1513, 128,
+	127, 391, 2229, 128, 127, 2050, 2372, 1957, 1956, 1869,
+	2054, 2378, 2049, 1243, 2047, 1853, 1866, 1783, 1845, 2052,
+	1743, 1780, 1047, 1048, 461, 1731, 458, 1716, 871, 1366,
+	1262, 1163, 2051, 1148, 1015, 871, 871, 871, 855, 2570,
+	1139, 461, 124, 1531, 1242, 1035, 1865, 2053, 2055, 2187,
+	871, 1522, 125, 1024, 1023, 1033, 1034, 1026, 1027, 1028,
+	1029, 1030, 1031, 1032, 1025, 2142, 1025, 1035, 1293, 1035,
+	1284, 1631, 843, 1359, 1265, 1865, 2000, 1865, 120, 1865,
+	121, 1991, 1981, 875, 1981, 861, 1988, 1006, 1170, 1987,
+	1990, 1984, 1982, 1026, 1027, 1028, 1029, 1030, 1031, 1032,
+	1025, 1291, 1008, 1035, 1008, 113, 1983, 513, 1983, 2090,
+	2089, 1641, 1778, 1865, 1777, 1305, 1995, 2143, 1161, 909,
+	1992, 113, 888, 1881, 1049, 1050, 1051, 1052, 1053, 1054,
+	1055, 1056, 1007, 1006, 1267, 1047, 1048, 837, 461, 1047,
+	1048, 2068, 1394, 1475, 2803, 1328, 1512, 1514, 97, 1308,
+	1008, 1236, 871, 1279, 1280, 1244, 1282, 1392, 1393, 1391,
+	1325, 1287, 1266, 1288, 2756, 1779, 112, 1285, 1632, 1272,
+	1273, 1274, 1334, 1251, 2790, 2770, 2789, 1336, 2737, 860,
+	876, 1270, 1271, 1475, 1281, 1796, 1364, 1365, 1347, 2349,
+	2343, 1024, 1023, 1033, 1034, 1026, 1027, 1028, 1029, 1030,
+	1031, 1032, 1025, 117, 111, 1035, 846, 847, 848, 849,
+	850, 851, 852, 633, 634, 2130, 890, 97, 1338, 1028,
+	1029, 1030, 1031, 1032, 1025, 2633, 98, 1035, 1513, 1007,
+	1006, 1003, 1007, 1006, 1385, 1300, 1301, 1390, 2662, 1303,
+	1298, 1910, 1388, 1299, 1414, 1415, 145, 1008, 1007, 1006,
+	1008, 502, 502, 993, 2625, 1306, 994, 1086, 1007, 1006,
+	1089, 1321, 2811, 2608, 1322, 1324, 1008, 502, 502, 502,
+	502, 502, 1329, 1007, 1006, 1332, 1008, 452, 1346, 1383,
+	2807, 1007, 1006, 2662, 2465, 2701, 1349, 2700, 2739, 1007,
+	1006, 1008, 1007, 1006, 1357, 2464, 2663, 1352, 2626, 1008,
+	1453, 145, 1007, 1006, 1728, 1729, 1730, 1008, 1506, 2070,
+	1008, 2463, 1368, 1137, 2568, 2462, 2812, 1137, 2456, 2309,
+	1008, 2308, 1381, 1384, 1909, 1460, 1463, 1907, 1377, 1379,
+	1380, 1888, 1337, 1476, 1378, 1411, 1369, 1412, 1428, 1370,
+	944, 987, 1432, 1851, 2627, 1852, 2699, 943, 1086, 917,
+	2713, 2712, 2674, 2672, 2639, 2564, 497, 1417, 2563, 502,
+	2569, 1425, 2520, 2472, 145, 2440, 2401, 1265, 2346, 145,
+	1434, 1435, 916, 145, 2345, 1517, 2344, 2306, 650, 1519,
+	2135, 1445, 1385, 1511, 1502, 1449, 1905, 1657, 1302, 1012,
+	1268, 2698, 2574, 2518, 1537, 564, 563, 566, 567, 568,
+	569, 1472, 2490, 1869, 565, 570, 871, 1230, 871, 2484,
+	2370, 2224, 2220, 1787, 2211, 1323, 2179, 2163, 2018, 2162,
+	1515, 2161, 1943, 1942, 1389, 1645, 1629, 1536, 1333, 1033,
+	1034, 1026, 1027, 1028, 1029, 1030, 1031, 1032, 1025, 1621,
+	1628, 1035, 1413, 1297, 1294, 1259, 1548, 1258, 1257, 1256,
+	1245, 1239, 1238, 1237, 1235, 939, 857, 1520, 1619, 1620,
+	1524, 1529, 1523, 1113, 444, 1290, 1597, 1598, 442, 1538,
+	1545, 1535, 988, 988, 1542, 666, 2414, 2729, 2296, 2719,
+	2646, 988, 1593, 1594, 1595, 1596, 2296, 2644, 145, 145,
+	145, 2296, 2642, 2296, 2522, 2414, 2514, 2414, 2430, 2414,
+	988, 2483, 1653, 1250, 502, 2414, 2413, 2434, 1589, 1451,
+	988, 1024, 1023, 1033, 1034, 1026, 1027, 1028, 1029, 1030,
+	1031, 1032, 1025, 956, 1712, 1035, 988, 2296, 2295, 2277,
+	988, 1715, 988, 2150, 2149, 2433, 2231, 1678, 1335, 1555,
+	1604, 2158, 1606, 1139, 2146, 2147, 2146, 2145, 1139, 1741,
+	988, 2136, 1139, 1490, 988, 1732, 1670, 1669, 1659, 1633,
+	1451, 1654, 1167, 1166, 1639, 1024, 1023, 1033, 1034, 1026,
+	1027, 1028, 1029, 1030, 1031, 1032, 1025, 1144, 101, 1035,
+	1144, 2074, 1613, 1678, 2088, 1385, 1361, 1421, 1577, 1578,
+	1579, 1580, 1386, 1711, 2232, 1395, 1396, 1397, 1398, 1399,
+	1400, 1401, 1402, 1403, 1404, 1405, 1406, 1407, 1408, 1409,
+	1652, 2482, 1658, 1678, 101, 1388, 1516, 961, 1143, 949,
+	1489, 960, 1612, 1665, 947, 1643, 946, 2088, 2648, 947,
+	1536, 1671, 1451, 1145, 1674, 1147, 1145, 1360, 1143, 1681,
+	1490, 1495, 1498, 1499, 1500, 1496, 1689, 1497, 1501, 2271,
+	1627, 2093, 2094, 2159, 661, 1718, 1719, 1809, 1811, 1490,
+	1720, 1137, 1137, 1137, 1137, 1137, 2148, 1737, 1741, 2232,
+	2088, 2102, 1972, 1466, 1384, 949, 1855, 1506, 1525, 1834,
+	1741, 502, 1478, 1733, 1801, 1800, 1451, 1137, 1304, 464,
+	464, 1143, 534, 1626, 1362, 983, 1331, 1810, 145, 1311,
+	1248, 145, 1246, 1149, 98, 985, 464, 464, 1815, 2656,
+	145, 2643, 502, 2101, 2489, 2487, 98, 1453, 1814, 502,
+	502, 502, 145, 145, 145, 2311, 1563, 2283, 1592, 145,
+	2129, 1841, 1588, 1843, 502, 1844, 1837, 1495, 1498, 1499,
+	1500, 1496, 1771, 1497, 1501, 1859, 464, 1829, 1610, 1842,
+	98, 2093, 2094, 650, 1583, 1836, 1582, 1231, 952, 1139,
+	936, 1601, 1642, 964, 2781, 145, 431, 2779, 145, 2761,
+	1795, 2157, 2096, 97, 2074, 544, 1911, 1253, 962, 950,
+	945, 1727, 1817, 1818, 2099, 1820, 1816, 1825, 1230, 1819,
+	1823, 1828, 1826, 2098, 1822, 1824, 871, 1389, 1821, 871,
+	871, 529, 530, 464, 464, 464, 1856, 502, 2695, 1868,
+	1870, 464, 1846, 1913, 2653, 2006, 464, 464, 1155, 1372,
+	1948, 1854, 1242, 1827, 1858, 1499, 1500, 502, 1717, 1001,
+	1002, 461, 2683, 434, 2274, 145, 502, 1938, 145, 1725,
+	1724, 502, 2404, 2369, 1871, 2299, 1941, 2219, 1542, 2218,
+	2134, 1970, 2133, 465, 465, 1863, 2125, 2579, 999, 145,
+	2582, 2638, 2637, 2476, 2673, 1012, 1906, 2474, 2560, 2559,
+	443, 1946, 522, 1747, 1749, 1898, 1908, 2459, 2460, 1426,
+	1427, 1162, 990, 97, 1759, 1760, 1761, 1762, 934, 918,
+	1437, 1438, 1439, 1440, 991, 1969, 1139, 1139, 1139, 1139,
+	1139, 1928, 915, 1450, 1452, 914, 465, 1967, 858, 2268,
+	1457, 502, 1139, 1945, 1944, 2708, 2494, 2493, 2269, 2026,
+	1364, 1365, 1139, 1086, 2379, 1864, 1867, 1656, 1951, 2351,
+	1936, 1605, 1954, 1939, 1940, 1952, 2352, 2707, 1252, 2631,
+	2478, 1897, 2003, 1899, 1900, 1901, 1902, 1914, 1638, 1024,
+	1023, 1033, 1034, 1026, 1027, 1028, 1029, 1030, 1031, 1032,
+	1025, 113, 1385, 1035, 1233, 1889, 1890, 1734, 1735, 1736,
+	1986, 1978, 1896, 1979, 1971, 1973, 968, 502, 502, 2079,
+	97, 2706, 1903, 1985, 145, 1997, 1998, 1001, 1002, 2001,
+	978, 979, 145, 145, 1562, 2705, 2437, 145, 145, 891,
+	1723, 145, 145, 145, 2104, 526, 2013, 2021, 1722, 2108,
+	2109, 2110, 2676, 2075, 2675, 2017, 2635, 2583, 2498, 2389,
+	2080, 502, 502, 2004, 2005, 2012, 1815, 527, 2087, 101,
+	2497, 2383, 2078, 1678, 2067, 2196, 1814, 2783, 2782, 2011,
+	1965, 2056, 1788, 1789, 1790, 1916, 1917, 1918, 2782, 2057,
+	1963, 1384, 1962, 1784, 1781, 1675, 1286, 2103, 2112, 1004,
+	963, 2107, 2783, 2511, 2132, 1358, 1976, 1989, 1994, 661,
+	510, 2082, 512, 2539, 60, 2541, 19, 2540, 18, 2097,
+	2542, 20, 2111, 2543, 21, 2538, 15, 2537, 14, 145,
+	502, 103, 502, 2105, 63, 2155, 2156, 1, 1597, 145,
+	1598, 145, 145, 464, 464, 464, 1666, 2174, 145, 1326,
+	1644, 2331, 2123, 2330, 2126, 997, 2337, 1357, 461, 2127,
+	2531, 10, 2128, 2117, 2164, 1016, 2116, 2552, 35, 2160,
+	2551, 34, 2550, 30, 2167, 1960, 2549, 29, 1926, 145,
+	145, 145, 1966, 2548, 28, 2181, 2546, 25, 2347, 2176,
+	2545, 24, 2547, 26, 2536, 13, 2533, 12, 2532, 11,
+	1240, 2011, 2448, 1542, 1690, 1542, 145, 840, 145, 2530,
+	9, 1611, 502, 1714, 940, 2636, 502, 2578, 2580, 2473,
+	2353, 2315, 2314, 2199, 502, 1920, 1919, 502, 935, 1309,
+	1977, 1088, 1980, 145, 1622, 1699, 2591, 2027, 1549, 2198,
+	2030, 2031, 2032, 1539, 1103, 2035, 663, 109, 1630, 874,
+	399, 1546, 1877, 2581, 937, 1876, 1947, 1886, 1560, 1875,
+	2182, 1874, 1230, 2233, 2230, 2576, 2201, 1878, 1175, 1173,
+	1739, 2207, 2200, 2208, 2223, 1742, 1174, 2263, 1172, 1177,
+	1744, 1745, 1176, 403, 1157, 1750, 1751, 1752, 1753, 1754,
+	1755, 2618, 2228, 1758, 1005, 2137, 2138, 2206, 1763, 1764,
+	1765, 1766, 2141, 1768, 1769, 1770, 119, 2239, 2212, 2144,
+	1773, 1774, 1775, 1776, 64, 2371, 1993, 1693, 114, 1782,
+	122, 405, 1785, 1786, 1043, 1721, 1847, 1791, 1792, 648,
+	649, 641, 2139, 1798, 522, 1799, 2650, 2657, 1802, 1803,
+	995, 1804, 1805, 2594, 2291, 2292, 2293, 1815, 2248, 1794,
+	1013, 1102, 1473, 2015, 547, 1832, 2596, 1814, 2270, 1376,
+	1830, 1831, 562, 561, 560, 2302, 557, 97, 2278, 558,
+	2691, 2289, 1637, 1367, 1806, 2279, 1017, 2151, 545, 536,
+	1135, 2294, 1128, 2267, 1655, 145, 145, 145, 145, 145,
+	1494, 1492, 1491, 2184, 2290, 2058, 2059, 145, 2060, 2061,
+	1254, 145, 2062, 637, 2095, 145, 2091, 2312, 2327, 2328,
+	2329, 145, 1504, 1134, 87, 2071, 2072, 889, 2303, 601,
+	426, 2264, 1856, 1024, 1023, 1033, 1034, 1026, 1027, 1028,
+	1029, 1030, 1031, 1032, 1025, 502, 2506, 1035, 45, 1712,
+	511, 531, 1137, 2326, 27, 17, 903, 1647, 1646, 22,
+	2106, 2365, 2366, 16, 1691, 2367, 838, 49, 52, 51,
+	1915, 1607, 2186, 2617, 1542, 2190, 2354, 2744, 921, 2194,
+	2195, 2768, 2197, 2660, 1343, 41, 1680, 2385, 2386, 2361,
+	1679, 40, 2079, 1229, 2375, 2079, 2409, 2368, 1597, 1269,
+	39, 2355, 38, 2360, 2131, 2356, 502, 37, 2104, 500,
+	503, 2376, 2123, 36, 2384, 33, 32, 464, 2380, 31,
+	2544, 2553, 2535, 2534, 2731, 23, 1289, 2730, 4, 2412,
+	502, 145, 464, 502, 502, 975, 2408, 88, 657, 2406,
+	42, 659, 669, 2, 2436, 2078, 2438, 464, 2078, 0,
+	0, 0, 0, 0, 0, 2304, 0, 2405, 2411, 0,
+	0, 2415, 845, 0, 0, 2435, 0, 0, 0, 0,
+	853, 2183, 2416, 464, 1088, 502, 0, 2421, 2427, 2428,
+	464, 2426, 0, 1511, 0, 2417, 0, 2381, 0, 0,
+	0, 0, 0, 2439, 0, 2011, 2305, 0, 2307, 0,
+	2495, 2451, 1088, 0, 0, 2429, 0, 2016, 0, 0,
+	0, 0, 0, 2024, 2461, 2466, 0, 2469, 0, 0,
+	502, 502, 0, 2033, 2034, 2481, 145, 0, 2079, 2479,
+	97, 0, 0, 0, 2040, 0, 502, 0, 2044, 2235,
+	0, 2485, 2486, 0, 1374, 1375, 0, 2492, 0, 2500,
+	2499, 0, 2501, 502, 0, 0, 0, 0, 0, 97,
+	465, 465, 0, 0, 2524, 0, 0, 0, 0, 0,
+	2513, 0, 2512, 2488, 2517, 0, 2081, 465, 465, 0,
+	0, 2078, 0, 2519, 1139, 0, 0, 1139, 2260, 2261,
+	2262, 0, 2516, 0, 0, 0, 0, 2390, 0, 0,
+	0, 0, 0, 1137, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2358, 502, 0, 502, 465, 0, 0,
+	0, 2431, 0, 2432, 0, 0, 0, 1088, 0, 0,
+	0, 0, 1458, 1459, 0, 0, 0, 0, 2584, 502,
+	0, 2587, 0, 2589, 0, 0, 2588, 0, 0, 0,
+	0, 0, 0, 0, 0, 2600, 2613, 0, 502, 0,
+	502, 2585, 502, 0, 502, 2586, 2599, 2606, 0, 0,
+	0, 0, 0, 1483, 465, 465, 465, 0, 0, 97,
+	1815, 0, 465, 0, 0, 0, 0, 465, 465, 0,
+	1814, 0, 2601, 0, 0, 0, 0, 0, 0, 1534,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 522,
+	0, 0, 0, 0, 0, 0, 145, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 669, 669, 2632, 2634,
+	0, 0, 0, 0, 464, 0, 1013, 0, 0, 145,
+	464, 0, 669, 669, 669, 669, 669, 2649, 0, 0,
+	0, 0, 2641, 0, 0, 0, 0, 0, 145, 0,
+	464, 0, 502, 464, 2517, 0, 0, 464, 464, 1599,
+	464, 2679, 0, 2681, 0, 2667, 0, 0, 0, 2234,
+	2392, 2393, 2394, 2395, 97, 0, 2684, 0, 2399, 2697,
+	97, 0, 2402, 2403, 502, 2711, 2682, 2680, 145, 502,
+	2702, 2694, 2696, 0, 2704, 2717, 502, 502, 2678, 0,
+	0, 0, 2244, 0, 0, 2622, 461, 2721, 0, 0,
+	0, 0, 97, 2253, 2254, 0, 2718, 97, 0, 2259,
+	0, 2735, 0, 2734, 965, 0, 2741, 0, 1139, 2733,
+	2727, 2732, 2738, 0, 0, 0, 2272, 2273, 2754, 2275,
+	0, 0, 2276, 0, 0, 0, 0, 97, 0, 0,
+	97, 97, 2762, 0, 522, 97, 2711, 2743, 0, 0,
+	2771, 0, 2288, 0, 0, 0, 2775, 2763, 0, 0,
+	2765, 2774, 0, 0, 2778, 97, 2776, 2780, 97, 145,
+	2711, 2791, 461, 0, 0, 1229, 2793, 0, 0, 0,
+	997, 0, 0, 0, 0, 97, 502, 97, 2788, 0,
+	2711, 97, 2711, 502, 502, 502, 2801, 0, 0, 0,
+	0, 502, 0, 0, 0, 97, 2709, 0, 97, 0,
+	2711, 2806, 0, 0, 502, 97, 0, 0, 0, 97,
+	2711, 0, 0, 0, 2711, 0, 0, 0, 2815, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1138, 0, 145, 0, 0, 0, 2359, 0, 0, 0,
+	0, 0, 0, 657, 465, 465, 465, 0, 657, 1158,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1772,
+	1023, 1033, 1034, 1026, 1027, 1028, 1029, 1030, 1031, 1032,
+	1025, 464, 0, 1035, 0, 0, 2388, 0, 0, 0,
+	0, 0, 0, 1797, 0, 0, 142, 0, 2590, 0,
+	0, 0, 0, 0, 0, 429, 0, 2396, 2397, 2398,
+	0, 2400, 0, 0, 502, 0, 145, 0, 0, 522,
+	0, 0, 502, 0, 0, 508, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 2418, 2419,
+	2420, 0, 0, 0, 0, 0, 0, 0, 638, 0,
+	0, 0, 662, 0, 0, 0, 827, 0, 0, 0,
+	0, 0, 502, 0, 0, 0, 0, 0, 502, 0,
+	839, 0, 0, 145, 0, 145, 0, 0, 0, 0,
+	0, 502, 854, 0, 0, 0, 0, 2258, 0, 0,
+	0, 0, 0, 2022, 2023, 0, 502, 0, 0, 0,
+	2028, 2029, 0, 1564, 1565, 0, 1570, 1571, 1572, 1573,
+	1574, 0, 2036, 2037, 2038, 2039, 0, 2041, 2042, 2043,
+	0, 0, 0, 0, 1584, 1585, 1586, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1232, 0, 0, 2502,
+	2503, 2504, 2505, 0, 0, 0, 0, 0, 0, 2509,
+	2510, 0, 0, 0, 0, 0, 0, 0, 0, 502,
+	0, 0, 0, 0, 0, 0, 0, 669, 0, 0,
+	0, 0, 0, 0, 669, 669, 669, 1024, 1023, 1033,
+	1034, 1026, 1027, 1028, 1029, 1030, 1031, 1032, 1025, 669,
+	0, 1035, 0, 0, 0, 0, 1968, 0, 0, 502,
+	0, 0, 0, 0, 0, 0, 0, 0, 522, 1019,
+	0, 1022, 0, 2752, 0, 0, 522, 0, 1036, 1037,
+	1038, 1039, 1040, 1041, 1042, 0, 1020, 1021, 1018, 1024,
+	1023, 1033, 1034, 1026, 1027, 1028, 1029, 1030, 1031, 1032,
+	1025, 0, 0, 1035, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2602, 0, 0, 1862, 0, 0, 2257,
+	2607, 0, 1327, 464, 0, 0, 0, 145, 0, 0,
+	0, 0, 502, 0, 502, 0, 0, 0, 0, 0,
+	2794, 0, 1340, 0, 0, 0, 0, 0, 0, 0,
+	0, 669, 0, 0, 0, 0, 669, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 145, 0, 2256, 0,
+	0, 0, 0, 0, 2069, 0, 0, 0, 465, 0,
+	0, 0, 0, 0, 0, 669, 2255, 0, 0, 0,
+	0, 0, 856, 465, 0, 0, 0, 0, 2645, 0,
+	0, 0, 502, 0, 0, 0, 0, 0, 465, 1024,
+	1023, 1033, 1034, 1026, 1027, 1028, 1029, 1030, 1031, 1032,
+	1025, 0, 0, 1035, 0, 2236, 1416, 0, 0, 0,
+	0, 0, 0, 2238, 465, 0, 0, 0, 502, 0,
+	0, 465, 2241, 2242, 0, 0, 0, 942, 0, 2243,
+	0, 0, 0, 0, 0, 0, 0, 2685, 1024, 1023,
+	1033, 1034, 1026, 1027, 1028, 1029, 1030, 1031, 1032, 1025,
+	0, 0, 1035, 0, 657, 0, 1024, 1023, 1033, 1034,
+	1026, 1027, 1028, 1029, 1030, 1031, 1032, 1025, 502, 0,
+	1035, 0, 1479, 1480, 0, 0, 0, 0, 0, 0,
+	0, 502, 0, 0, 0, 0, 0, 0, 0, 0,
+	638, 998, 0, 502, 0, 981, 0, 0, 0, 657,
+	0, 0, 464, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 669, 2014, 0, 669, 669, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1088, 0,
+	0, 1891, 1892, 1893, 1895, 1024, 1023, 1033, 1034, 1026,
+	1027, 1028, 1029, 1030, 1031, 1032, 1025, 143, 0, 1035,
+	423, 0, 502, 0, 0, 0, 143, 2225, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 534, 0, 0,
+	0, 0, 0, 0, 0, 0, 143, 0, 464, 0,
+	0, 2808, 2809, 0, 0, 669, 2065, 669, 521, 0,
+	0, 0, 0, 0, 0, 0, 535, 0, 0, 640,
+	658, 0, 0, 143, 0, 2121, 0, 143, 0, 0,
+	0, 0, 0, 0, 1130, 0, 1140, 0, 0, 0,
+	0, 143, 0, 0, 0, 0, 0, 0, 2266, 0,
+	0, 0, 0, 143, 0, 0, 0, 1088, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2280, 2281, 0,
+	0, 2282, 0, 0, 2284, 0, 0, 0, 0, 0,
+	0, 0, 1088, 0, 0, 0, 0, 1664, 0, 0,
+	0, 1664, 0, 0, 0, 465, 0, 0, 0, 1664,
+	0, 465, 1664, 0, 1024, 1023, 1033, 1034, 1026, 1027,
+	1028, 1029, 1030, 1031, 1032, 1025, 0, 2064, 1035, 0,
+	0, 465, 0, 0, 465, 0, 0, 0, 465, 465,
+	0, 465, 0, 0, 0, 0, 669, 0, 0, 0,
+	2205, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1862, 0, 0, 2214, 2216, 0, 0, 0, 0, 0,
+	0, 0, 0, 2205, 0, 0, 0, 0, 0, 0,
+	0, 0, 1418, 1423, 1424, 0, 0, 0, 0, 1429,
+	1430, 1431, 0, 1433, 0, 0, 1436, 0, 0, 0,
+	0, 1441, 1442, 1443, 1444, 0, 1446, 1447, 1448, 0,
+	0, 0, 0, 0, 1454, 1455, 1456, 0, 0, 0,
+	1462, 1465, 0, 1470, 1471, 1024, 1023, 1033, 1034, 1026,
+	1027, 1028, 1029, 1030, 1031, 1032, 1025, 2063, 0, 1035,
+	0, 0, 0, 0, 0, 0, 0, 0, 1481, 0,
+	1484, 1485, 0, 0, 638, 0, 0, 1255, 0, 0,
+	522, 0, 0, 0, 0, 0, 0, 0, 0, 657,
+	43, 0, 89, 46, 47, 0, 0, 0, 1275, 1276,
+	1277, 0, 0, 0, 70, 1278, 0, 0, 0, 2140,
+	95, 0, 0, 0, 48, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 657, 0, 0, 0, 0, 0,
+	0, 0, 0, 143, 0, 0, 0, 0, 0, 0,
+	669, 638, 0, 0, 638, 0, 0, 0, 0, 0,
+	0, 0, 98, 0, 0, 1024, 1023, 1033, 1034, 1026,
+	1027, 1028, 1029, 1030, 1031, 1032, 1025, 0, 0, 1035,
+	0, 0, 0, 0, 0, 0, 2555, 0, 0, 0,
+	0, 2817, 0, 0, 0, 0, 0, 0, 143, 0,
+	0, 0, 465, 534, 0, 0, 0, 1088, 2205, 0,
+	0, 1912, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1348, 0, 0, 0, 50, 91, 2554, 53, 56,
+	2205, 0, 0, 0, 0, 669, 0, 0, 669, 669,
+	0, 2556, 0, 0, 0, 1373, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 57, 94, 93,
+	1738, 143, 0, 0, 55, 0, 143, 0, 0, 0,
+	521, 2245, 2246, 0, 2247, 0, 0, 2249, 0, 2251,
+	1961, 1024, 1023, 1033, 1034, 1026, 1027, 1028, 1029, 1030,
+	1031, 1032, 1025, 0, 522, 1035, 522, 0, 0, 0,
+	0, 0, 2121, 0, 0, 0, 0, 0, 0, 0,
+	68, 69, 0, 2557, 0, 2595, 2598, 2121, 0, 0,
+	0, 0, 0, 0, 0, 669, 669, 77, 0, 78,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 669, 0, 0, 0, 2297, 2298, 0, 0, 0,
+	0, 0, 0, 669, 0, 0, 0, 0, 2025, 2558,
+	92, 0, 61, 62, 72, 0, 73, 0, 0, 0,
+	1488, 1740, 0, 2614, 2615, 0, 0, 0, 0, 1746,
+	0, 0, 0, 0, 1518, 143, 143, 143, 1756, 1757,
+	0, 0, 0, 0, 0, 658, 0, 0, 0, 1767,
+	658, 1024, 1023, 1033, 1034, 1026, 1027, 1028, 1029, 1030,
+	1031, 1032, 1025, 0, 0, 1035, 0, 657, 0, 669,
+	0, 2086, 0, 0, 0, 0, 1793, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2086, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 90, 0, 0,
+	0, 0, 0, 669, 0, 669, 2598, 669, 0, 2120,
+	0, 0, 0, 0, 0, 1603, 0, 0, 0, 0,
+	2703, 0, 0, 0, 465, 1616, 0, 1617, 1618, 0,
+	0, 0, 0, 2121, 1625, 2121, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 96,
+	0, 0, 0, 0, 0, 0, 0, 43, 44, 89,
+	46, 47, 0, 0, 0, 0, 0, 1636, 0, 0,
+	0, 70, 0, 0, 0, 0, 0, 95, 0, 0,
+	0, 48, 74, 75, 0, 0, 0, 0, 71, 0,
+	0, 0, 942, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 419, 0, 0, 0, 2189, 0, 0,
+	0, 0, 0, 0, 0, 58, 0, 0, 0, 98,
+	0, 0, 0, 0, 0, 0, 0, 0, 2792, 0,
+	0, 0, 0, 0, 0, 143, 0, 0, 143, 2217,
+	0, 0, 412, 0, 2222, 0, 0, 1264, 0, 0,
+	0, 2226, 2227, 0, 0, 0, 0, 0, 0, 143,
+	143, 143, 0, 0, 0, 0, 143, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 50, 91, 54, 53, 56, 0, 67, 2121,
+	0, 0, 0, 0, 392, 0, 0, 0, 0, 0,
+	0, 395, 143, 0, 0, 143, 0, 0, 0, 0,
+	0, 404, 417, 418, 57, 94, 93, 0, 0, 66,
+	65, 55, 0, 465, 0, 0, 0, 0, 0, 0,
+	1197, 0, 0, 0, 0, 0, 76, 0, 657, 0,
+	0, 0, 0, 0, 0, 0, 0, 401, 0, 0,
+	402, 2286, 0, 410, 411, 0, 0, 0, 2286, 2286,
+	2286, 0, 0, 0, 0, 0, 669, 68, 69, 2668,
+	0, 0, 143, 0, 0, 521, 84, 0, 0, 2286,
+	0, 0, 0, 0, 77, 0, 78, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 143, 0, 0, 465,
+	0, 0, 0, 0, 407, 0, 0, 1264, 0, 0,
+	0, 0, 0, 0, 0, 0, 59, 92, 0, 61,
+	62, 72, 0, 73, 1184, 408, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1422, 1422, 1422, 0, 0, 0, 1422, 1422, 1422, 1422,
+	1422, 1422, 0, 0, 1422, 0, 1198, 393, 0, 1422,
+	1422, 1422, 1422, 0, 1422, 1422, 1422, 0, 0, 669,
+	0, 0, 1422, 1422, 1422, 0, 0, 669, 1422, 1422,
+	0, 1422, 1422, 0, 0, 0, 658, 0, 0, 0,
+	406, 396, 397, 0, 422, 0, 0, 1937, 398, 400,
+	0, 394, 421, 420, 90, 0, 1422, 1422, 1422, 1422,
+	0, 143, 0, 0, 0, 0, 0, 2410, 0, 143,
+	521, 0, 0, 2286, 143, 143, 0, 0, 143, 1521,
+	1264, 658, 0, 0, 0, 0, 2120, 0, 0, 0,
+	0, 0, 0, 0, 0, 1264, 0, 409, 0, 0,
+	0, 2120, 0, 0, 0, 0, 96, 0, 0, 0,
+	0, 0, 0, 1211, 1214, 1215, 1216, 1217, 1218, 1219,
+	0, 1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 0,
+	1199, 1200, 1201, 1202, 1178, 1182, 1212, 1179, 1185, 1181,
+	1183, 1180, 2007, 1186, 1187, 1188, 1189, 1190, 1191, 1192,
+	1193, 1194, 1195, 1196, 1203, 1204, 1205, 1206, 1207, 1208,
+	1209, 1210, 0, 0, 2491, 0, 143, 0, 0, 0,
+	79, 80, 81, 82, 83, 0, 143, 0, 143, 143,
+	0, 0, 85, 0, 0, 143, 0, 0, 0, 0,
+	0, 0, 0, 0, 413, 415, 416, 414, 0, 0,
+	0, 0, 0, 0, 2515, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1634, 1635, 143, 0,
+	1197, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 143, 0, 521, 0, 0, 0, 0,
+	0, 0, 0, 1213, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1676, 0, 0, 0, 0, 0, 0, 2120, 0, 2120,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1264, 0,
+	0, 657, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1184, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2154, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 669, 0, 1422,
+	0, 0, 0, 0, 0, 2180, 1198, 1422, 0, 0,
+	0, 0, 0, 0, 0, 0, 1422, 1422, 0, 0,
+	0, 0, 0, 0, 2185, 0, 0, 1422, 0, 0,
+	0, 1422, 0, 2647, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1422, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2221, 0, 0, 0, 0, 0,
+	0, 658, 143, 143, 143, 143, 143, 0, 0, 0,
+	0, 0, 0, 2120, 521, 0, 0, 0, 143, 0,
+	0, 0, 521, 0, 0, 0, 2286, 0, 143, 0,
+	0, 0, 0, 0, 0, 0, 658, 0, 669, 0,
+	0, 0, 0, 1211, 1214, 1215, 1216, 1217, 1218, 1219,
+	0, 1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227, 0,
+	1199, 1200, 1201, 1202, 1178, 1182, 1212, 1179, 1185, 1181,
+	1183, 1180, 0, 1186, 1187, 1188, 1189, 1190, 1191, 1192,
+	1193, 1194, 1195, 1196, 1203, 1204, 1205, 1206, 1207, 1208,
+	1209, 1210, 0, 0, 0, 0, 0, 2757, 0, 43,
+	0, 89, 46, 47, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 70, 0, 0, 0, 0, 0, 95,
+	0, 0, 0, 48, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 143, 0,
+	0, 0, 0, 43, 0, 89, 46, 47, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 70, 2313, 0,
+	0, 98, 0, 95, 0, 0, 0, 48, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1213, 0, 2555, 0, 0, 0, 0,
+	2813, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 50, 91, 2554, 53, 56, 2555,
+	0, 0, 0, 143, 2802, 0, 0, 0, 0, 0,
+	2556, 0, 0, 0, 1422, 0, 0, 0, 0, 0,
+	0, 0, 0, 1422, 0, 1264, 57, 94, 93, 0,
+	0, 0, 0, 55, 0, 0, 0, 0, 50, 91,
+	2554, 53, 56, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2556, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	57, 94, 93, 0, 0, 0, 0, 55, 0, 68,
+	69, 0, 2557, 43, 0, 89, 46, 47, 0, 658,
+	0, 0, 0, 0, 0, 0, 77, 70, 78, 0,
+	0, 0, 0, 95, 0, 0, 0, 48, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 68, 69, 0, 2557, 0, 2558, 92,
+	0, 61, 62, 72, 0, 73, 0, 0, 0, 0,
+	77, 0, 78, 0, 0, 98, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2555,
+	0, 0, 2558, 92, 2785, 61, 62, 72, 0, 73,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 143, 0, 0, 0, 0, 0, 43,
+	0, 89, 46, 47, 0, 0, 0, 0, 50, 91,
+	2554, 53, 56, 70, 0, 0, 143, 0, 0, 95,
+	0, 0, 0, 48, 2556, 0, 90, 0, 0, 0,
+	0, 0, 0, 0, 0, 143, 0, 0, 0, 0,
+	57, 94, 93, 492, 0, 0, 0, 55, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2202, 98, 2772, 0, 2204, 0, 0, 0, 0, 0,
+	90, 0, 0, 0, 0, 143, 0, 0, 96, 0,
+	0, 0, 0, 0, 0, 2555, 0, 0, 0, 0,
+	0, 535, 2609, 68, 69, 0, 2557, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	77, 0, 78, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 96, 0, 50, 91, 2554, 53, 56, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2556, 0, 2558, 92, 0, 61, 62, 72, 0, 73,
+	0, 0, 0, 0, 0, 0, 57, 94, 93, 0,
+	0, 0, 0, 55, 0, 0, 521, 0, 0, 0,
+	658, 0, 0, 486, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 43, 0, 89, 46, 47, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 70, 0, 68,
+	69, 0, 2557, 95, 0, 0, 0, 48, 0, 0,
+	0, 0, 0, 0, 0, 0, 77, 0, 78, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
+	90, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 0, 0, 2558, 92,
+	0, 61, 62, 72, 0, 73, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 2555,
+	0, 0, 0, 0, 2710, 0, 0, 0, 0, 0,
+	0, 0, 96, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 143, 0, 0, 0, 0, 50, 91,
+	2554, 53, 56, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2556, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	57, 94, 93, 0, 0, 0, 90, 55, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	521, 0, 521, 0, 0, 466, 467, 468, 469, 470,
+	474, 475, 479, 480, 489, 488, 487, 490, 491, 494,
+	493, 495, 471, 472, 473, 476, 477, 478, 481, 482,
+	485, 483, 484, 68, 69, 0, 2557, 0, 96, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	77, 0, 78, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2558, 92, 0, 61, 62, 72, 0, 73,
+	0, 0, 0, 0, 0, 0, 0, 535, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 807, 787, 353,
+	737, 810, 704, 723, 822, 726, 729, 770, 687, 750,
+	276, 721, 688, 0, 708, 677, 715, 678, 705, 739,
+	196, 703, 789, 753, 809, 231, 766, 0, 185, 240,
+	238, 0, 0, 0, 284, 352, 808, 745, 0, 816,
+	235, 0, 762, 817, 340, 260, 0, 0, 741, 796,
+	748, 785, 736, 772, 696, 761, 811, 722, 768, 812,
+	90, 0, 0, 0, 2616, 0, 0, 0, 0, 0,
+	0, 0, 0, 691, 143, 765, 806, 718, 767, 769,
+	675, 764, 0, 682, 689, 821, 802, 711, 712, 713,
+	0, 0, 0, 0, 0, 0, 0, 740, 749, 780,
+	733, 0, 0, 658, 0, 0, 0, 0, 0, 709,
+	0, 759, 96, 143, 0, 690, 683, 0, 0, 738,
+	0, 0, 0, 695, 150, 710, 781, 0, 673, 210,
+	261, 163, 786, 801, 735, 222, 383, 805, 732, 731,
+	300, 0, 347, 212, 232, 167, 146, 161, 179, 211,
+	272, 779, 323, 719, 674, 790, 706, 716, 186, 714,
+	314, 281, 371, 0, 756, 288, 313, 236, 360, 776,
+	369, 370, 724, 825, 380, 385, 0, 746, 693, 337,
+	197, 0, 152, 0, 295, 192, 227, 734, 771, 707,
+	183, 777, 760, 795, 336, 358, 170, 354, 259, 265,
+	180, 182, 181, 162, 331, 357, 175, 184, 342, 319,
+	348, 191, 0, 0, 2619, 2620, 2621, 0, 0, 0,
+	0, 376, 730, 326, 325, 725, 218, 784, 763, 679,
+	307, 774, 223, 151, 350, 388, 169, 229, 355, 672,
+	205, 271, 188, 282, 283, 201, 148, 302, 253, 254,
+	252, 255, 717, 775, 372, 783, 304, 153, 351, 368,
+	176, 327, 329, 386, 312, 156, 366, 346, 257, 224,
+	225, 154, 0, 155, 310, 195, 209, 190, 275, 0,
+	208, 297, 363, 364, 187, 389, 164, 379, 158, 165,
+	378, 268, 0, 267, 381, 359, 367, 258, 244, 0,
+	157, 365, 256, 243, 230, 200, 214, 293, 239, 294,
+	215, 263, 262, 264, 241, 245, 0, 680, 0, 343,
+	374, 390, 173, 702, 330, 356, 0, 0, 174, 207,
+	199, 292, 266, 166, 217, 339, 228, 237, 309, 387,
+	279, 315, 177, 373, 338, 700, 701, 698, 0, 699,
+	751, 752, 813, 814, 815, 782, 692, 0, 797, 798,
+	0, 0, 0, 0, 0, 788, 803, 804, 773, 823,
+	727, 728, 697, 328, 308, 681, 684, 685, 686, 694,
+	742, 743, 755, 758, 793, 792, 791, 794, 799, 819,
+	818, 820, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 754, 149, 159, 233, 824, 305, 204,
+	375, 676, 194, 0, 744, 747, 757, 778, 147, 160,
+	171, 178, 193, 198, 202, 720, 213, 216, 219, 220,
+	221, 226, 242, 247, 248, 249, 250, 269, 270, 273,
+	274, 277, 278, 285, 286, 287, 289, 290, 291, 296,
+	301, 303, 311, 317, 320, 321, 322, 324, 332, 333,
+	334, 335, 344, 349, 361, 362, 382, 384, 800, 0,
+	251, 298, 203, 189, 341, 318, 246, 316, 345, 280,
+	172, 299, 234, 168, 306, 206, 0, 377, 807, 787,
+	353, 737, 810, 704, 723, 822, 726, 729, 770, 687,
+	750, 276, 721, 688, 0, 708, 677, 715, 678, 705,
+	739, 196, 703, 789, 753, 809, 231, 766, 0, 185,
+	240, 238, 0, 0, 0, 284, 352, 808, 745, 0,
+	816, 235, 0, 762, 817, 340, 260, 0, 0, 741,
+	796, 748, 785, 736, 772, 696, 761, 811, 722, 768,
+	812, 0, 0, 0, 0, 501, 0, 1543, 1544, 0,
+	0, 0, 0, 0, 691, 0, 765, 806, 718, 767,
+	769, 675, 764, 0, 682, 689, 821, 802, 711, 712,
+	713, 1857, 0, 0, 0, 0, 0, 0, 740, 749,
+	780, 733, 0, 0, 0, 0, 0, 0, 0, 0,
+	709, 0, 759, 0, 0, 0, 690, 683, 0, 0,
+	738, 0, 0, 0, 695, 150, 710, 781, 0, 673,
+	210, 261, 163, 786, 801, 735, 222, 383, 805, 732,
+	731, 300, 0, 347, 212, 232, 167, 146, 161, 179,
+	211, 272, 779, 323, 719, 674, 790, 706, 716, 186,
+	714, 314, 281, 371, 0, 756, 288, 313, 236, 360,
+	776, 369, 370, 724, 825, 380, 385, 0, 746, 693,
+	337, 197, 0, 152, 0, 295, 192, 227, 734, 771,
+	707, 183, 777, 760, 795, 336, 358, 170, 354, 259,
+	265, 180, 182, 181, 162, 331, 357, 175, 184, 342,
+	319, 348, 191, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 376, 730, 326, 325, 725, 218, 784, 763,
+	679, 307, 774, 223, 151, 350, 388, 169, 229, 355,
+	672, 205, 271, 188, 282, 283, 201, 148, 302, 253,
+	254, 252, 255, 717, 775, 372, 783, 304, 153, 351,
+	368, 176, 327, 329, 386, 312, 156, 366, 346, 257,
+	224, 225, 154, 0, 155, 310, 195, 209, 190, 275,
+	0, 208, 297, 363, 364, 187, 389, 164, 379, 158,
+	165, 378, 268, 0, 267, 381, 359, 367, 258, 244,
+	0, 157, 365, 256, 243, 230, 200, 214, 293, 239,
+	294, 215, 263, 262, 264, 241, 245, 0, 680, 0,
+	343, 374, 390, 173, 702, 330, 356, 0, 0, 174,
+	207, 199, 292, 266, 166, 217, 339, 228, 237, 309,
+	387, 279, 315, 177, 373, 338, 700, 701, 698, 0,
+	699, 751, 752, 813, 814, 815, 782, 692, 0, 797,
+	798, 0, 0, 0, 0, 0, 788, 803, 804, 773,
+	823, 727, 728, 697, 328, 308, 681, 684, 685, 686,
+	694, 742, 743, 755, 758, 793, 792, 791, 794, 799,
+	819, 818, 820, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 754, 149, 159, 233, 824, 305,
+	204, 375, 676, 194, 0, 744, 747, 757, 778, 147,
+	160, 171, 178, 193, 198, 202, 720, 213, 216, 219,
+	220, 221, 226, 242, 247, 248, 249, 250, 269, 270,
+	273, 274, 277, 278, 285, 286, 287, 289, 290, 291,
+	296, 301, 303, 311, 317, 320, 321, 322, 324, 332,
+	333, 334, 335, 344, 349, 361, 362, 382, 384, 800,
+	0, 251, 298, 203, 189, 341, 318, 246, 316, 345,
+	280, 172, 299, 234, 168, 306, 206, 0, 377, 807,
+	787, 353, 737, 810, 704, 723, 822, 726, 729, 770,
+	687, 750, 276, 721, 688, 0, 708, 677, 715, 678,
+	705, 739, 196, 703, 789, 753, 809, 231, 766, 0,
+	185, 240, 238, 0, 0, 0, 284, 352, 808, 745,
+	0, 816, 235, 0, 762, 817, 340, 260, 0, 0,
+	741, 796, 748, 785, 736, 772, 696, 761, 811, 722,
+	768, 812, 0, 0, 0, 0, 501, 0, 1543, 1544,
+	0, 0, 0, 0, 0, 691, 0, 765, 806, 718,
+	767, 769, 675, 764, 0, 682, 689, 821, 802, 711,
+	712, 713, 0, 0, 0, 0, 0, 0, 0, 740,
+	749, 780, 733, 0, 0, 0, 0, 0, 0, 0,
+	0, 709, 0, 759, 0, 0, 0, 690, 683, 0,
+	0, 738, 0, 0, 0, 695, 150, 710, 781, 0,
+	673, 210, 261, 163, 786, 801, 735, 222, 383, 805,
+	732, 731, 300, 0, 347, 212, 232, 167, 146, 161,
+	179, 211, 272, 779, 323, 719, 674, 790, 706, 716,
+	186, 714, 314, 281, 371, 0, 756, 288, 313, 236,
+	360, 776, 369, 370, 724, 825, 380, 385, 0, 746,
+	693, 337, 197, 0, 152, 0, 295, 192, 227, 734,
+	771, 707, 183, 777, 760, 795, 336, 358, 170, 354,
+	259, 265, 180, 182, 181, 162, 331, 357, 175, 184,
+	342, 319, 348, 191, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 376, 730, 326, 325, 725, 218, 784,
+	763, 679, 307, 774, 223, 151, 350, 388, 169, 229,
+	355, 672, 205, 271, 188, 282, 283, 201, 148, 302,
+	253, 254, 252, 255, 717, 775, 372, 783, 304, 153,
+	351, 368, 176, 327, 329, 386, 312, 156, 366, 346,
+	257, 224, 225, 154, 0, 155, 310, 195, 209, 190,
+	275, 0, 208, 297, 363, 364, 187, 389, 164, 379,
+	158, 165, 378, 268, 0, 267, 381, 359, 367, 258,
+	244, 0, 157, 365, 256, 243, 230, 200, 214, 293,
+	239, 294, 215, 263, 262, 264, 241, 245, 0, 680,
+	0, 343, 374, 390, 173, 702, 330, 356, 0, 0,
+	174, 207, 199, 292, 266, 166, 217, 339, 228, 237,
+	309, 387, 279, 315, 177, 373, 338, 700, 701, 698,
+	0, 699, 751, 752, 813, 814, 815, 782, 692, 0,
+	797, 798, 0, 0, 0, 0, 0, 788, 803, 804,
+	773, 823, 727, 728, 697, 328, 308, 681, 684, 685,
+	686, 694, 742, 743, 755, 758, 793, 792, 791, 794,
+	799, 819, 818, 820, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 754, 149, 159, 233, 824,
+	305, 204, 375, 676, 194, 0, 744, 747, 757, 778,
+	147, 160, 171, 178, 193, 198, 202, 720, 213, 216,
+	219, 220, 221, 226, 242, 247, 248, 249, 250, 269,
+	270, 273, 274, 277, 278, 285, 286, 287, 289, 290,
+	291, 296, 301, 303, 311, 317, 320, 321, 322, 324,
+	332, 333, 334, 335, 344, 349, 361, 362, 382, 384,
+	800, 0, 251, 298, 203, 189, 341, 318, 246, 316,
+	345, 280, 172, 299, 234, 168, 306, 206, 0, 377,
+	807, 2083, 353, 737, 810, 704, 723, 822, 726, 729,
+	770, 687, 750, 276, 721, 688, 0, 708, 677, 715,
+	678, 705, 739, 196, 703, 789, 753, 809, 231, 766,
+	0, 185, 240, 238, 0, 0, 0, 284, 352, 808,
+	745, 0, 816, 235, 0, 762, 817, 340, 260, 0,
+	0, 741, 796, 748, 785, 736, 772, 696, 761, 811,
+	722, 768, 812, 98, 0, 988, 0, 501, 0, 0,
+	0, 0, 0, 0, 0, 0, 691, 0, 765, 806,
+	718, 767, 769, 675, 764, 0, 682, 689, 821, 802,
+	711, 712, 713, 0, 0, 0, 0, 0, 0, 0,
+	740, 749, 780, 733, 0, 0, 0, 0, 0, 0,
+	0, 0, 709, 0, 759, 0, 0, 0, 690, 683,
+	0, 0, 738, 0, 0, 0, 695, 150, 710, 781,
+	0, 673, 210, 261, 163, 786, 801, 735, 222, 383,
+	805, 732, 731, 300, 0, 347, 212, 232, 167, 146,
+	161, 179, 211, 272, 779, 323, 719, 674, 790, 706,
+	716, 186, 714, 314, 281, 371, 0, 756, 288, 313,
+	236, 360, 776, 369, 370, 724, 825, 380, 385, 0,
+	746, 693, 337, 197, 0, 152, 0, 295, 192, 227,
+	734, 771, 707, 183, 777, 760, 795, 336, 358, 170,
+	354, 259, 265, 180, 182, 181, 162, 331, 357, 175,
+	184, 342, 319, 348, 191, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 376, 730, 326, 325, 725, 218,
+	784, 763, 679, 307, 774, 223, 151, 350, 388, 169,
+	229, 355, 672, 205, 271, 188, 282, 283, 201, 148,
+	302, 253, 254, 252, 255, 717, 775, 372, 783, 304,
+	153, 351, 368, 176, 327, 329, 386, 312, 156, 366,
+	346, 257, 224, 225, 154, 0, 155, 310, 195, 209,
+	190, 275, 0, 208, 297, 363, 364, 187, 389, 164,
+	379, 158, 165, 378, 268, 0, 267, 381, 359, 367,
+	258, 244, 0, 157, 365, 256, 243, 230, 200, 214,
+	293, 239, 294, 215, 263, 262, 264, 241, 245, 0,
+	680, 0, 343, 374, 390, 173, 702, 330, 356, 0,
+	0, 174, 207, 199, 292, 266, 166, 217, 339, 228,
+	237, 309, 387, 279, 315, 177, 373, 338, 700, 701,
+	698, 0, 699, 751, 752, 813, 814, 815, 782, 692,
+	0, 797, 798, 0, 0, 0, 0, 0, 788, 803,
+	804, 773, 823, 727, 728, 697, 328, 308, 681, 684,
+	685, 686, 694, 742, 743, 755, 758, 793, 792, 791,
+	794, 799, 819, 818, 820, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 754, 149, 159, 233,
+	2084, 305, 204, 375, 676, 194, 0, 744, 747, 757,
+	778, 147, 160, 171, 178, 193, 198, 202, 720, 213,
+	216, 219, 220, 221, 226, 242, 247, 248, 249, 250,
+	269, 270, 273, 274, 277, 278, 285, 286, 287, 289,
+	290, 291, 296, 301, 303, 311, 317, 320, 321, 322,
+	324, 332, 333, 334, 335, 344, 349, 361, 362, 382,
+	384, 800, 0, 251, 298, 203, 189, 341, 318, 246,
+	316, 345, 280, 172, 299, 234, 168, 306, 206, 0,
+	377, 807, 787, 353, 737, 810, 704, 723, 822, 726,
+	729, 770, 687, 750, 276, 721, 688, 0, 708, 677,
+	715, 678, 705, 739, 196, 703, 789, 753, 809, 231,
+	766, 0, 185, 240, 238, 0, 0, 0, 284, 352,
+	808, 745, 0, 816, 235, 0, 762, 817, 340, 260,
+	0, 0, 741, 796, 748, 785, 736, 772, 696, 761,
+	811, 722, 768, 812, 0, 0, 0, 0, 501, 0,
+	0, 0, 0, 0, 0, 0, 0, 691, 0, 765,
+	806, 718, 767, 769, 675, 764, 0, 682, 689, 821,
+	802, 711, 712, 713, 0, 0, 0, 0, 0, 0,
+	0, 740, 749, 780, 733, 0, 0, 0, 0, 0,
+	0, 2387, 0, 709, 0, 759, 0, 0, 0, 690,
+	683, 0, 0, 738, 0, 0, 0, 695, 150, 710,
+	781, 0, 673, 210, 261, 163, 786, 801, 735, 222,
+	383, 805, 732, 731, 300, 0, 347, 212, 232, 167,
+	146, 161, 179, 211, 272, 779, 323, 719, 674, 790,
+	706, 716, 186, 714, 314, 281, 371, 0, 756, 288,
+	313, 236, 360, 776, 369, 370, 724, 825, 380, 385,
+	0, 746, 693, 337, 197, 0, 152, 0, 295, 192,
+	227, 734, 771, 707, 183, 777, 760, 795, 336, 358,
+	170, 354, 259, 265, 180, 182, 181, 162, 331, 357,
+	175, 184, 342, 319, 348, 191, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 376, 730, 326, 325, 725,
+	218, 784, 763, 679, 307, 774, 223, 151, 350, 388,
+	169, 229, 355, 672, 205, 271, 188, 282, 283, 201,
+	148, 302, 253, 254, 252, 255, 717, 775, 372, 783,
+	304, 153, 351, 368, 176, 327, 329, 386, 312, 156,
+	366, 346, 257, 224, 225, 154, 0, 155, 310, 195,
+	209, 190, 275, 0, 208, 297, 363, 364, 187, 389,
+	164, 379, 158, 165, 378, 268, 0, 267, 381, 359,
+	367, 258, 244, 0, 157, 365, 256, 243, 230, 200,
+	214, 293, 239, 294, 215, 263, 262, 264, 241, 245,
+	0, 680, 0, 343, 374, 390, 173, 702, 330, 356,
+	0, 0, 174, 207, 199, 292, 266, 166, 217, 339,
+	228, 237, 309, 387, 279, 315, 177, 373, 338, 700,
+	701, 698, 0, 699, 751, 752, 813, 814, 815, 782,
+	692, 0, 797, 798, 0, 0, 0, 0, 0, 788,
+	803, 804, 773, 823, 727, 728, 697, 328, 308, 681,
+	684, 685, 686, 694, 742, 743, 755, 758, 793, 792,
+	791, 794, 799, 819, 818, 820, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 754, 149, 159,
+	233, 824, 305, 204, 375, 676, 194, 0, 744, 747,
+	757, 778, 147, 160, 171, 178, 193, 198, 202, 720,
+	213, 216, 219, 220, 221, 226, 242, 247, 248, 249,
+	250, 269, 270, 273, 274, 277, 278, 285, 286, 287,
+	289, 290, 291, 296, 301, 303, 311, 317, 320, 321,
+	322, 324, 332, 333, 334, 335, 344, 349, 361, 362,
+	382, 384, 800, 0, 251, 298, 203, 189, 341, 318,
+	246, 316, 345, 280, 172, 299, 234, 168, 306, 206,
+	0, 377, 807, 787, 353, 737, 810, 704, 723, 822,
+	726, 729, 770, 687, 750, 276, 721, 688, 0, 708,
+	677, 715, 678, 705, 739, 196, 703, 789, 753, 809,
+	231, 766, 0, 185, 240, 238, 0, 0, 0, 284,
+	352, 808, 745, 0, 816, 235, 0, 762, 817, 340,
+	260, 0, 0, 741, 796, 748, 785, 736, 772, 696,
+	761, 811, 722, 768, 812, 0, 0, 0, 0, 540,
+	0, 0, 0, 0, 0, 0, 0, 0, 691, 0,
+	765, 806, 718, 767, 769, 675, 764, 0, 682, 689,
+	821, 802, 711, 712, 713, 0, 0, 0, 0, 0,
+	0, 0, 740, 749, 780, 733, 0, 0, 0, 0,
+	0, 0, 2020, 0, 709, 0, 759, 0, 0, 0,
+	690, 683, 0, 0, 738, 0, 0, 0, 695, 150,
+	710, 781, 0, 673, 210, 261, 163, 786, 801, 735,
+	222, 383, 805, 732, 731, 300, 0, 347, 212, 232,
+	167, 146, 161, 179, 211, 272, 779, 323, 719, 674,
+	790, 706, 716, 186, 714, 314, 281, 371, 0, 756,
+	288, 313, 236, 360, 776, 369, 370, 724, 825, 380,
+	385, 0, 746, 693, 337, 197, 0, 152, 0, 295,
+	192, 227, 734, 771, 707, 183, 777, 760, 795, 336,
+	358, 170, 354, 259, 265, 180, 182, 181, 162, 331,
+	357, 175, 184, 342, 319, 348, 191, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 376, 730, 326, 325,
+	725, 218, 784, 763, 679, 307, 774, 223, 151, 350,
+	388, 169, 229, 355, 672, 205, 271, 188, 282, 283,
+	201, 148, 302, 253, 254, 252, 255, 717, 775, 372,
+	783, 304, 153, 351, 368, 176, 327, 329, 386, 312,
+	156, 366, 346, 257, 224, 225, 154, 0, 155, 310,
+	195, 209, 190, 275, 0, 208, 297, 363, 364, 187,
+	389, 164, 379, 158, 165, 378, 268, 0, 267, 381,
+	359, 367, 258, 244, 0, 157, 365, 256, 243, 230,
+	200, 214, 293, 239, 294, 215, 263, 262, 264, 241,
+	245, 0, 680, 0, 343, 374, 390, 173, 702, 330,
+	356, 0, 0, 174, 207, 199, 292, 266, 166, 217,
+	339, 228, 237, 309, 387, 279, 315, 177, 373, 338,
+	700, 701, 698, 0, 699, 751, 752, 813, 814, 815,
+	782, 692, 0, 797, 798, 0, 0, 0, 0, 0,
+	788, 803, 804, 773, 823, 727, 728, 697, 328, 308,
+	681, 684, 685, 686, 694, 742, 743, 755, 758, 793,
+	792, 791, 794, 799, 819, 818, 820, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 754, 149,
+	159, 233, 824, 305, 204, 375, 676, 194, 0, 744,
+	747, 757, 778, 147, 160, 171, 178, 193, 198, 202,
+	720, 213, 216, 219, 220, 221, 226, 242, 247, 248,
+	249, 250, 269, 270, 273, 274, 277, 278, 285, 286,
+	287, 289, 290, 291, 296, 301, 303, 311, 317, 320,
+	321, 322, 324, 332, 333, 334, 335, 344, 349, 361,
+	362, 382, 384, 800, 0, 251, 298, 203, 189, 341,
+	318, 246, 316, 345, 280, 172, 299, 234, 168, 306,
+	206, 0, 377, 807, 787, 353, 737, 810, 704, 723,
+	822, 726, 729, 770, 687, 750, 276, 721, 688, 0,
+	708, 677, 715, 678, 705, 739, 196, 703, 789, 753,
+	809, 231, 766, 0, 185, 240, 238, 0, 0, 0,
+	284, 352, 808, 745, 0, 816, 235, 0, 762, 817,
+	340, 260, 0, 0, 741, 796, 748, 785, 736, 772,
+	696, 761, 811, 722, 768, 812, 0, 0, 0, 0,
+	501, 0, 0, 0, 0, 0, 0, 0, 0, 691,
+	0, 765, 806, 718, 767, 769, 675, 764, 0, 682,
+	689, 821, 802, 711, 712, 713, 0, 0, 0, 0,
+	0, 0, 0, 740, 749, 780, 733, 0, 0, 0,
+	0, 0, 0, 2010, 0, 709, 0, 759, 0, 0,
+	0, 690, 683, 0, 0, 738, 0, 0, 0, 695,
+	150, 710, 781, 0, 673, 210, 261, 163, 786, 801,
+	735, 222, 383, 805, 732, 731, 300, 0, 347, 212,
+	232, 167, 146, 161, 179, 211, 272, 779, 323, 719,
+	674, 790, 706, 716, 186, 714, 314, 281, 371, 0,
+	756, 288, 313, 236, 360, 776, 369, 370, 724, 825,
+	380, 385, 0, 746, 693, 337, 197, 0, 152, 0,
+	295, 192, 227, 734, 771, 707, 183, 777, 760, 795,
+	336, 358, 170, 354, 259, 265, 180, 182, 181, 162,
+	331, 357, 175, 184, 342, 319, 348, 191, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 376, 730, 326,
+	325, 725, 218, 784, 763, 679, 307, 774, 223, 151,
+	350, 388, 169, 229, 355, 672, 205, 271, 188, 282,
+	283, 201, 148, 302, 253, 254, 252, 255, 717, 775,
+	372, 783, 304, 153, 351, 368, 176, 327, 329, 386,
+	312, 156, 366, 346, 257, 224, 225, 154, 0, 155,
+	310, 195, 209, 190, 275, 0, 208, 297, 363, 364,
+	187, 389, 164, 379, 158, 165, 378, 268, 0, 267,
+	381, 359, 367, 258, 244, 0, 157, 365, 256, 243,
+	230, 200, 214, 293, 239, 294, 215, 263, 262, 264,
+	241, 245, 0, 680, 0, 343, 374, 390, 173, 702,
+	330, 356, 0, 0, 174, 207, 199, 292, 266, 166,
+	217, 339, 228, 237, 309, 387, 279, 315, 177, 373,
+	338, 700, 701, 698, 0, 699, 751, 752, 813, 814,
+	815, 782, 692, 0, 797, 798, 0, 0, 0, 0,
+	0, 788, 803, 804, 773, 823, 727, 728, 697, 328,
+	308, 681, 684, 685, 686, 694, 742, 743, 755, 758,
+	793, 792, 791, 794, 799, 819, 818, 820, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 754,
+	149, 159, 233, 824, 305, 204, 375, 676, 194, 0,
+	744, 747, 757, 778, 147, 160, 171, 178, 193, 198,
+	202, 720, 213, 216, 219, 220, 221, 226, 242, 247,
+	248, 249, 250, 269, 270, 273, 274, 277, 278, 285,
+	286, 287, 289, 290, 291, 296, 301, 303, 311, 317,
+	320, 321, 322, 324, 332, 333, 334, 335, 344, 349,
+	361, 362, 382, 384, 800, 0, 251, 298, 203, 189,
+	341, 318, 246, 316, 345, 280, 172, 299, 234, 168,
+	306, 206, 0, 377, 807, 787, 353, 737, 810, 704,
+	723, 822, 726, 729, 770, 687, 750, 276, 721, 688,
+	0, 708, 677, 715, 678, 705, 739, 196, 703, 789,
+	753, 809, 231, 766, 0, 185, 240, 238, 0, 0,
+	0, 284, 352, 808, 745, 0, 816, 235, 0, 762,
+	817, 340, 260, 0, 0, 741, 796, 748, 785, 736,
+	772, 696, 761, 811, 722, 768, 812, 98, 0, 0,
+	0, 501, 0, 0, 0, 0, 0, 0, 0, 0,
+	691, 0, 765, 806, 718, 767, 769, 675, 764, 0,
+	682, 689, 821, 802, 711, 712, 713, 0, 0, 0,
+	0, 0, 0, 0, 740, 749, 780, 733, 0, 0,
+	0, 0, 0, 0, 0, 0, 709, 0, 759, 0,
+	0, 0, 690, 683, 0, 0, 738, 0, 0, 0,
+	695, 150, 710, 781, 0, 673, 210, 261, 163, 786,
+	801, 735, 222, 383, 805, 732, 731, 300, 0, 347,
+	212, 232, 167, 146, 161, 179, 211, 272, 779, 323,
+	719, 674, 790, 706, 716, 186, 714, 314, 281, 371,
+	0, 756, 288, 313, 236, 360, 776, 369, 370, 724,
+	825, 380, 385, 0, 746, 693, 337, 197, 0, 152,
+	0, 295, 192, 227, 734, 771, 707, 183, 777, 760,
+	795, 336, 358, 170, 354, 259, 265, 180, 182, 181,
+	162, 331, 357, 175, 184, 342, 319, 348, 191, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 376, 730,
+	326, 325, 725, 218, 784, 763, 679, 307, 774, 223,
+	151, 350, 388, 169, 229, 355, 672, 205, 271, 188,
+	282, 283, 201, 148, 302, 253, 254, 252, 255, 717,
+	775, 372, 783, 304, 153, 351, 368, 176, 327, 329,
+	386, 312, 156, 366, 346, 257, 224, 225, 154, 0,
+	155, 310, 195, 209, 190, 275, 0, 208, 297, 363,
+	364, 187, 389, 164, 379, 158, 165, 378, 268, 0,
+	267, 381, 359, 367, 258, 244, 0, 157, 365, 256,
+	243, 230, 200, 214, 293, 239, 294, 215, 263, 262,
+	264, 241, 245, 0, 680, 0, 343, 374, 390, 173,
+	702, 330, 356, 0, 0, 174, 207, 199, 292, 266,
+	166, 217, 339, 228, 237, 309, 387, 279, 315, 177,
+	373, 338, 700, 701, 698, 0, 699, 751, 752, 813,
+	814, 815, 782, 692, 0, 797, 798, 0, 0, 0,
+	0, 0, 788, 803, 804, 773, 823, 727, 728, 697,
+	328, 308, 681, 684, 685, 686, 694, 742, 743, 755,
+	758, 793, 792, 791, 794, 799, 819, 818, 820, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	754, 149, 159, 233, 824, 305, 204, 375, 676, 194,
+	0, 744, 747, 757, 778, 147, 160, 171, 178, 193,
+	198, 202, 720, 213, 216, 219, 220, 221, 226, 242,
+	247, 248, 249, 250, 269, 270, 273, 274, 277, 278,
+	285, 286, 287, 289, 290, 291, 296, 301, 303, 311,
+	317, 320, 321, 322, 324, 332, 333, 334, 335, 344,
+	349, 361, 362, 382, 384, 800, 0, 251, 298, 203,
+	189, 341, 318, 246, 316, 345, 280, 172, 299, 234,
+	168, 306, 206, 0, 377, 807, 787, 353, 737, 810,
+	704, 723, 822, 726, 729, 770, 687, 750, 276, 721,
+	688, 0, 708, 677, 715, 678, 705, 739, 196, 703,
+	789, 753, 809, 231, 766, 0, 185, 240, 238, 0,
+	0, 0, 284, 352, 808, 745, 0, 816, 235, 0,
+	762, 817, 340, 260, 0, 0, 741, 796, 748, 785,
+	736, 772, 696, 761, 811, 722, 768, 812, 0, 0,
+	0, 0, 144, 0, 0, 0, 0, 0, 0, 0,
+	0, 691, 0, 765, 806, 718, 767, 769, 675, 764,
+	0, 682, 689, 821, 802, 711, 712, 713, 0, 0,
+	0, 0, 0, 0, 0, 740, 749, 780, 733, 0,
+	0, 0, 0, 0, 0, 1522, 0, 709, 0, 759,
+	0, 0, 0, 690, 683, 0, 0, 738, 0, 0,
+	0, 695, 150, 710, 781, 0, 673, 210, 261, 163,
+	786, 801, 735, 222, 383, 805, 732, 731, 300, 0,
+	347, 212, 232, 167, 146, 161, 179, 211, 272, 779,
+	323, 719, 674, 790, 706, 716, 186, 714, 314, 281,
+	371, 0, 756, 288, 313, 236, 360, 776, 369, 370,
+	724, 825, 380, 385, 0, 746, 693, 337, 197, 0,
+	152, 0, 295, 192, 227, 734, 771, 707, 183, 777,
+	760, 795, 336, 358, 170, 354, 259, 265, 180, 182,
+	181, 162, 331, 357, 175, 184, 342, 319, 348, 191,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 376,
+	730, 326, 325, 725, 218, 784, 763, 679, 307, 774,
+	223, 151, 350, 388, 169, 229, 355, 672, 205, 271,
+	188, 282, 283, 201, 148, 302, 253, 254, 252, 255,
+	717, 775, 372, 783, 304, 153, 351, 368, 176, 327,
+	329, 386, 312, 156, 366, 346, 257, 224, 225, 154,
+	0, 155, 310, 195, 209, 190, 275, 0, 208, 297,
+	363, 364, 187, 389, 164, 379, 158, 165, 378, 268,
+	0, 267, 381, 359, 367, 258, 244, 0, 157, 365,
+	256, 243, 230, 200, 214, 293, 239, 294, 215, 263,
+	262, 264, 241, 245, 0, 680, 0, 343, 374, 390,
+	173, 702, 330, 356, 0, 0, 174, 207, 199, 292,
+	266, 166, 217, 339, 228, 237, 309, 387, 279, 315,
+	177, 373, 338, 700, 701, 698, 0, 699, 751, 752,
+	813, 814, 815, 782, 692, 0, 797, 798, 0, 0,
+	0, 0, 0, 788, 803, 804, 773, 823, 727, 728,
+	697, 328, 308, 681, 684, 685, 686, 694, 742, 743,
+	755, 758, 793, 792, 791, 794, 799, 819, 818, 820,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 754, 149, 159, 233, 824, 305, 204, 375, 676,
+	194, 0, 744, 747, 757, 778, 147, 160, 171, 178,
+	193, 198, 202, 720, 213, 216, 219, 220, 221, 226,
+	242, 247, 248, 249, 250, 269, 270, 273, 274, 277,
+	278, 285, 286, 287, 289, 290, 291, 296, 301, 303,
+	311, 317, 320, 321, 322, 324, 332, 333, 334, 335,
+	344, 349, 361, 362, 382, 384, 800, 0, 251, 298,
+	203, 189, 341, 318, 246, 316, 345, 280, 172, 299,
+	234, 168, 306, 206, 0, 377, 807, 787, 353, 737,
+	810, 704, 723, 822, 726, 729, 770, 687, 750, 276,
+	721, 688, 0, 708, 677, 715, 678, 705, 739, 196,
+	703, 789, 753, 809, 231, 766, 0, 185, 240, 238,
+	0, 0, 0, 284, 352, 808, 745, 0, 816, 235,
+	0, 762, 817, 340, 260, 0, 0, 741, 796, 748,
+	785, 736, 772, 696, 761, 811, 722, 768, 812, 0,
+	0, 0, 0, 540, 0, 0, 0, 0, 0, 0,
+	0, 0, 691, 0, 765, 806, 718, 767, 769, 675,
+	764, 0, 682, 689, 821, 802, 711, 712, 713, 0,
+	0, 0, 0, 0, 0, 0, 740, 749, 780, 733,
+	0, 0, 0, 0, 0, 0, 1382, 0, 709, 0,
+	759, 0, 0, 0, 690, 683, 0, 0, 738, 0,
+	0, 0, 695, 150, 710, 781, 0, 673, 210, 261,
+	163, 786, 801, 735, 222, 383, 805, 732, 731, 300,
+	0, 347, 212, 232, 167, 146, 161, 179, 211, 272,
+	779, 323, 719, 674, 790, 706, 716, 186, 714, 314,
+	281, 371, 0, 756, 288, 313, 236, 360, 776, 369,
+	370, 724, 825, 380, 385, 0, 746, 693, 337, 197,
+	0, 152, 0, 295, 192, 227, 734, 771, 707, 183,
+	777, 760, 795, 336, 358, 170, 354, 259, 265, 180,
+	182, 181, 162, 331, 357, 175, 184, 342, 319, 348,
+	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	376, 730, 326, 325, 725, 218, 784, 763, 679, 307,
+	774, 223, 151, 350, 388, 169, 229, 355, 672, 205,
+	271, 188, 282, 283, 201, 148, 302, 253, 254, 252,
+	255, 717, 775, 372, 783, 304, 153, 351, 368, 176,
+	327, 329, 386, 312, 156, 366, 346, 257, 224, 225,
+	154, 0, 155, 310, 195, 209, 190, 275, 0, 208,
+	297, 363, 364, 187, 389, 164, 379, 158, 165, 378,
+	268, 0, 267, 381, 359, 367, 258, 244, 0, 157,
+	365, 256, 243, 230, 200, 214, 293, 239, 294, 215,
+	263, 262, 264, 241, 245, 0, 680, 0, 343, 374,
+	390, 173, 702, 330, 356, 0, 0, 174, 207, 199,
+	292, 266, 166, 217, 339, 228, 237, 309, 387, 279,
+	315, 177, 373, 338, 700, 701, 698, 0, 699, 751,
+	752, 813, 814, 815, 782, 692, 0, 797, 798, 0,
+	0, 0, 0, 0, 788, 803, 804, 773, 823, 727,
+	728, 697, 328, 308, 681, 684, 685, 686, 694, 742,
+	743, 755, 758, 793, 792, 791, 794, 799, 819, 818,
+	820, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 754, 149, 159, 233, 824, 305, 204, 375,
+	676, 194, 0, 744, 747, 757, 778, 147, 160, 171,
+	178, 193, 198, 202, 720, 213, 216, 219, 220, 221,
+	226, 242, 247, 248, 249, 250, 269, 270, 273, 274,
+	277, 278, 285, 286, 287, 289, 290, 291, 296, 301,
+	303, 311, 317, 320, 321, 322, 324, 332, 333, 334,
+	335, 344, 349, 361, 362, 382, 384, 800, 0, 251,
+	298, 203, 189, 341, 318, 246, 316, 345, 280, 172,
+	299, 234, 168, 306, 206, 0, 377, 807, 787, 353,
+	737, 810, 704, 723, 822, 726, 729, 770, 687, 750,
+	276, 721, 688, 0, 708, 677, 715, 678, 705, 739,
+	196, 703, 789, 753, 809, 231, 766, 0, 185, 240,
+	238, 0, 0, 0, 284, 352, 808, 745, 0, 816,
+	235, 0, 762, 817, 340, 260, 0, 0, 741, 796,
+	748, 785, 736, 772, 696, 761, 811, 722, 768, 812,
+	0, 0, 0, 0, 501, 0, 0, 0, 0, 0,
+	0, 0, 0, 691, 0, 765, 806, 718, 767, 769,
+	675, 764, 0, 682, 689, 821, 802, 711, 712, 713,
+	0, 0, 0, 0, 0, 0, 0, 740, 749, 780,
+	733, 0, 0, 0, 0, 0, 0, 0, 0, 709,
+	0, 759, 0, 0, 0, 690, 683, 0, 0, 738,
+	0, 0, 0, 695, 150, 710, 781, 0, 673, 210,
+	261, 163, 786, 801, 735, 222, 383, 805, 732, 731,
+	300, 0, 347, 212, 232, 167, 146, 161, 179, 211,
+	272, 779, 323, 719, 674, 790, 706, 716, 186, 714,
+	314, 281, 371, 0, 756, 288, 313, 236, 360, 776,
+	369, 370, 724, 825, 380, 385, 0, 746, 693, 337,
+	197, 0, 152, 0, 295, 192, 227, 734, 771, 707,
+	183, 777, 760, 795, 336, 358, 170, 354, 259, 265,
+	180, 182, 181, 162, 331, 357, 175, 184, 342, 319,
+	348, 191, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 376, 730, 326, 325, 725, 218, 784, 763, 679,
+	307, 774, 223, 151, 350, 388, 169, 229, 355, 672,
+	205, 271, 188, 282, 283, 201, 148, 302, 253, 254,
+	252, 255, 717, 775, 372, 783, 304, 153, 351, 368,
+	176, 327, 329, 386, 312, 156, 366, 346, 257, 224,
+	225, 154, 0, 155, 310, 195, 209, 190, 275, 0,
+	208, 297, 363, 364, 187, 389, 164, 379, 158, 165,
+	378, 268, 0, 267, 381, 359, 367, 258, 244, 0,
+	157, 365, 256, 243, 230, 200, 214, 293, 239, 294,
+	215, 263, 262, 264, 241, 245, 0, 680, 0, 343,
+	374, 390, 173, 702, 330, 356, 0, 0, 174, 207,
+	199, 292, 266, 166, 217, 339, 228, 237, 309, 387,
+	279, 315, 177, 373, 338, 700, 701, 698, 0, 699,
+	751, 752, 813, 814, 815, 782, 692, 0, 797, 798,
+	0, 0, 0, 0, 0, 788, 803, 804, 773, 823,
+	727, 728, 697, 328, 308, 681, 684, 685, 686, 694,
+	742, 743, 755, 758, 793, 792, 791, 794, 799, 819,
+	818, 820, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 754, 149, 159, 233, 824, 305, 204,
+	375, 676, 194, 0, 744, 747, 757, 778, 147, 160,
+	171, 178, 193, 198, 202, 720, 213, 216, 219, 220,
+	221, 226, 242, 247, 248, 249, 250, 269, 270, 273,
+	274, 277, 278, 285, 286, 287, 289, 290, 291, 296,
+	301, 303, 311, 317, 320, 321, 322, 324, 332, 333,
+	334, 335, 344, 349, 361, 362, 382, 384, 800, 0,
+	251, 298, 203, 189, 341, 318, 246, 316, 345, 280,
+	172, 299, 234, 168, 306, 206, 0, 377, 807, 787,
+	353, 737, 810, 704, 723, 822, 726, 729, 770, 687,
+	750, 276, 721, 688, 0, 708, 677, 715, 678, 705,
+	739, 196, 703, 789, 753, 809, 231, 766, 0, 185,
+	240, 238, 0, 0, 0, 284, 352, 808, 745, 0,
+	816, 235, 0, 762, 817, 340, 260, 0, 0, 741,
+	796, 748, 785, 736, 772, 696, 761, 811, 722, 768,
+	812, 0, 0, 0, 0, 540, 0, 0, 0, 0,
+	0, 0, 0, 0, 691, 0, 765, 806, 718, 767,
+	769, 675, 764, 0, 682, 689, 821, 802, 711, 712,
+	713, 0, 0, 0, 0, 0, 0, 0, 740, 749,
+	780, 733, 0, 0, 0, 0, 0, 0, 0, 0,
+	709, 0, 759, 0, 0, 0, 690, 683, 0, 0,
+	738, 0, 0, 0, 695, 150, 710, 781, 0, 673,
+	210, 261, 163, 786, 801, 735, 222, 383, 805, 732,
+	731, 300, 0, 347, 212, 232, 167, 146, 161, 179,
+	211, 272, 779, 323, 719, 674, 790, 706, 716, 186,
+	714, 314, 281, 371, 0, 756, 288, 313, 236, 360,
+	776, 369, 370, 724, 825, 380, 385, 0, 746, 693,
+	337, 197, 0, 152, 0, 295, 192, 227, 734, 771,
+	707, 183, 777, 760, 795, 336, 358, 170, 354, 259,
+	265, 180, 182, 181, 162, 331, 357, 175, 184, 342,
+	319, 348, 191, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 376, 730, 326, 325, 725, 218, 784, 763,
+	679, 307, 774, 223, 151, 350, 388, 169, 229, 355,
+	672, 205, 271, 188, 282, 283, 201, 148, 302, 253,
+	254, 252, 255, 717, 775, 372, 783, 304, 153, 351,
+	368, 176, 327, 329, 386, 312, 156, 366, 346, 257,
+	224, 225, 154, 0, 155, 310, 195, 209, 190, 275,
+	0, 208, 297, 363, 364, 187, 389, 164, 379, 158,
+	165, 378, 268, 0, 267, 381, 359, 367, 258, 244,
+	0, 157, 365, 256, 243, 230, 200, 214, 293, 239,
+	294, 215, 263, 262, 264, 241, 245, 0, 680, 0,
+	343, 374, 390, 173, 702, 330, 356, 0, 0, 174,
+	207, 199, 292, 266, 166, 217, 339, 228, 237, 309,
+	387, 279, 315, 177, 373, 338, 700, 701, 698, 0,
+	699, 751, 752, 813, 814, 815, 782, 692, 0, 797,
+	798, 0, 0, 0, 0, 0, 788, 803, 804, 773,
+	823, 727, 728, 697, 328, 308, 681, 684, 685, 686,
+	694, 742, 743, 755, 758, 793, 792, 791, 794, 799,
+	819, 818, 820, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 754, 149, 159, 233, 824, 305,
+	204, 375, 676, 194, 0, 744, 747, 757, 778, 147,
+	160, 171, 178, 193, 198, 202, 720, 213, 216, 219,
+	220, 221, 226, 242, 247, 248, 249, 250, 269, 270,
+	273, 274, 277, 278, 285, 286, 287, 289, 290, 291,
+	296, 301, 303, 311, 317, 320, 321, 322, 324, 332,
+	333, 334, 335, 344, 349, 361, 362, 382, 384, 800,
+	0, 251, 298, 203, 189, 341, 318, 246, 316, 345,
+	280, 172, 299, 234, 168, 306, 206, 0, 377, 807,
+	787, 353, 737, 810, 704, 723, 822, 726, 729, 770,
+	687, 750, 276, 721, 688, 0, 708, 677, 715, 678,
+	705, 739, 196, 703, 789, 753, 809, 231, 766, 0,
+	185, 240, 238, 0, 0, 0, 284, 352, 1554, 1558,
+	0, 816, 235, 0, 762, 817, 340, 260, 0, 0,
+	741, 796, 748, 785, 736, 772, 696, 761, 811, 722,
+	768, 812, 0, 0, 0, 0, 501, 0, 0, 0,
+	0, 0, 0, 0, 0, 691, 0, 765, 806, 718,
+	767, 769, 675, 764, 0, 682, 689, 821, 802, 711,
+	712, 713, 0, 0, 0, 0, 0, 0, 0, 740,
+	749, 780, 733, 0, 0, 0, 0, 0, 0, 0,
+	0, 709, 0, 759, 0, 0, 0, 690, 683, 0,
+	0, 738, 0, 0, 0, 695, 150, 710, 781, 0,
+	673, 210, 261, 163, 786, 801, 1557, 222, 383, 805,
+	732, 731, 1552, 0, 1553, 212, 232, 671, 146, 161,
+	1550, 1556, 272, 779, 323, 719, 674, 790, 706, 716,
+	186, 714, 314, 281, 371, 0, 756, 288, 313, 236,
+	360, 776, 369, 370, 724, 825, 380, 385, 0, 746,
+	693, 337, 197, 0, 152, 0, 295, 192, 227, 734,
+	771, 707, 183, 777, 760, 795, 336, 358, 170, 354,
+	259, 265, 180, 182, 181, 162, 331, 357, 175, 184,
+	342, 319, 348, 191, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 376, 730, 326, 325, 725, 218, 784,
+	763, 679, 307, 774, 223, 151, 350, 388, 169, 229,
+	355, 672, 205, 271, 188, 282, 283, 201, 148, 302,
+	253, 254, 252, 255, 717, 775, 372, 783, 304, 153,
+	351, 368, 176, 327, 329, 386, 312, 156, 366, 346,
+	257, 224, 225, 154, 0, 155, 310, 195, 209, 190,
+	275, 0, 208, 297, 363, 364, 187, 389, 164, 379,
+	158, 165, 378, 268, 0, 267, 381, 359, 367, 258,
+	244, 0, 157, 365, 256, 243, 230, 200, 214, 293,
+	239, 294, 215, 263, 262, 264, 241, 245, 0, 680,
+	0, 343, 374, 390, 173, 702, 330, 356, 0, 0,
+	174, 207, 199, 292, 266, 166, 217, 339, 228, 237,
+	309, 387, 279, 315, 177, 373, 338, 700, 701, 698,
+	0, 699, 751, 752, 813, 814, 815, 782, 692, 0,
+	797, 798, 0, 0, 0, 0, 0, 788, 803, 804,
+	773, 823, 727, 728, 697, 328, 308, 681, 684, 685,
+	686, 694, 742, 743, 755, 758, 793, 792, 791, 794,
+	799, 819, 818, 820, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 754, 149, 159, 233, 824,
+	305, 204, 375, 676, 194, 0, 744, 747, 757, 778,
+	147, 160, 171, 178, 193, 198, 202, 720, 213, 216,
+	219, 220, 221, 226, 242, 247, 248, 249, 250, 269,
+	270, 273, 274, 277, 278, 285, 286, 287, 289, 290,
+	291, 296, 301, 303, 311, 317, 320, 321, 322, 324,
+	332, 333, 334, 335, 344, 349, 361, 362, 382, 384,
+	800, 0, 251, 298, 203, 189, 341, 318, 246, 316,
+	345, 280, 172, 299, 234, 168, 306, 206, 0, 377,
+	807, 787, 353, 737, 810, 704, 723, 822, 726, 729,
+	770, 687, 750, 276, 721, 688, 0, 708, 677, 715,
+	678, 705, 739, 196, 703, 789, 753, 809, 231, 766,
+	0, 185, 240, 238, 0, 0, 0, 284, 352, 808,
+	745, 0, 816, 235, 0, 762, 817, 340, 260, 0,
+	0, 741, 796, 748, 785, 736, 772, 696, 761, 811,
+	722, 768, 812, 0, 0, 0, 0, 144, 0, 0,
+	0, 0, 0, 0, 0, 0, 691, 0, 765, 806,
+	718, 767, 769, 675, 764, 0, 682, 689, 821, 802,
+	711, 712, 713, 0, 0, 0, 0, 0, 0, 0,
+	740, 749, 780, 733, 0, 0, 0, 0, 0, 0,
+	0, 0, 709, 0, 759, 0, 0, 0, 690, 683,
+	0, 0, 738, 0, 0, 0, 695, 150, 710, 781,
+	0, 673, 210, 261, 163, 786, 801, 735, 222, 383,
+	805, 732, 731, 300, 0, 347, 212, 232, 167, 146,
+	161, 179, 211, 272, 779, 323, 719, 674, 790, 706,
+	716, 186, 714, 314, 281, 371, 0, 756, 288, 313,
+	236, 360, 776, 369, 370, 724, 825, 380, 385, 0,
+	746, 693, 337, 197, 0, 152, 0, 295, 192, 227,
+	734, 771, 707, 183, 777, 760, 795, 336, 358, 170,
+	354, 259, 265, 180, 182, 181, 162, 331, 357, 175,
+	184, 342, 319, 348, 191, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 376, 730, 326, 325, 725, 218,
+	784, 763, 679, 307, 774, 223, 151, 350, 388, 169,
+	229, 355, 672, 205, 271, 188, 282, 283, 201, 148,
+	302, 253, 254, 252, 255, 717, 775, 372, 783, 304,
+	153, 351, 368, 176, 327, 329, 386, 312, 156, 366,
+	346, 257, 224, 225, 154, 0, 155, 310, 195, 209,
+	190, 275, 0, 208, 297, 363, 364, 187, 389, 164,
+	379, 158, 165, 378, 268, 0, 267, 381, 359, 367,
+	258, 244, 0, 157, 365, 256, 243, 230, 200, 214,
+	293, 239, 294, 215, 263, 262, 264, 241, 245, 0,
+	680, 0, 343, 374, 390, 173, 702, 330, 356, 0,
+	0, 174, 207, 199, 292, 266, 166, 217, 339, 228,
+	237, 309, 387, 279, 315, 177, 373, 338, 700, 701,
+	698, 0, 699, 751, 752, 813, 814, 815, 782, 692,
+	0, 797, 798, 0, 0, 0, 0, 0, 788, 803,
+	804, 773, 823, 727, 728, 697, 328, 308, 681, 684,
+	685, 686, 694, 742, 743, 755, 758, 793, 792, 791,
+	794, 799, 819, 818, 820, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 754, 149, 159, 233,
+	824, 305, 204, 375, 676, 194, 0, 744, 747, 757,
+	778, 147, 160, 171, 178, 193, 198, 202, 720, 213,
+	216, 219, 220, 221, 226, 242, 247, 248, 249, 250,
+	269, 270, 273, 274, 277, 278, 285, 286, 287, 289,
+	290, 291, 296, 301, 303, 311, 317, 320, 321, 322,
+	324, 332, 333, 334, 335, 344, 349, 361, 362, 382,
+	384, 800, 0, 251, 298, 203, 189, 341, 318, 246,
+	316, 345, 280, 172, 299, 234, 168, 306, 206, 0,
+	377, 807, 787, 353, 737, 810, 704, 723, 822, 726,
+	729, 770, 687, 750, 276, 721, 688, 0, 708, 677,
+	715, 678, 705, 739, 196, 703, 789, 753, 809, 231,
+	766, 0, 185, 240, 238, 0, 0, 0, 284, 352,
+	808, 745, 0, 816, 235, 0, 762, 817, 340, 260,
+	0, 0, 741, 796, 748, 785, 736, 772, 696, 761,
+	811, 722, 768, 812, 0, 0, 0, 0, 501, 0,
+	0, 0, 0, 0, 0, 0, 0, 691, 0, 765,
+	806, 718, 767, 769, 675, 764, 0, 682, 689, 821,
+	802, 711, 712, 713, 0, 0, 0, 0, 0, 0,
+	0, 740, 749, 780, 733, 0, 0, 0, 0, 0,
+	0, 0, 0, 709, 0, 759, 0, 0, 0, 690,
+	683, 0, 0, 738, 0, 0, 0, 695, 150, 710,
+	781, 0, 673, 210, 261, 163, 786, 801, 735, 222,
+	383, 805, 732, 731, 300, 0, 347, 212, 232, 671,
+	146, 161, 667, 211, 272, 779, 323, 719, 674, 790,
+	706, 716, 186, 714, 314, 281, 371, 0, 756, 288,
+	313, 236, 360, 776, 369, 370, 724, 825, 380, 385,
+	0, 746, 693, 337, 197, 0, 152, 0, 295, 192,
+	227, 734, 771, 707, 183, 777, 760, 795, 336, 358,
+	170, 354, 259, 265, 180, 182, 181, 162, 331, 357,
+	175, 184, 342, 319, 348, 191, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 376, 730, 326, 325, 725,
+	218, 784, 763, 679, 307, 774, 223, 151, 350, 388,
+	169, 229, 355, 672, 205, 271, 188, 282, 283, 201,
+	148, 302, 253, 254, 252, 255, 717, 775, 372, 783,
+	304, 153, 351, 368, 176, 327, 329, 386, 312, 156,
+	366, 346, 257, 224, 225, 154, 0, 155, 310, 195,
+	209, 190, 275, 0, 208, 297, 363, 364, 187, 389,
+	164, 379, 158, 165, 378, 268, 0, 267, 381, 359,
+	367, 258, 244, 0, 157, 365, 256, 243, 230, 200,
+	214, 293, 239, 294, 215, 263, 262, 264, 241, 245,
+	0, 680, 0, 343, 374, 390, 173, 702, 330, 356,
+	0, 0, 174, 207, 199, 292, 266, 166, 217, 339,
+	228, 237, 309, 387, 279, 315, 177, 373, 338, 700,
+	701, 698, 0, 699, 751, 752, 813, 814, 815, 782,
+	692, 0, 797, 798, 0, 0, 0, 0, 0, 788,
+	803, 804, 773, 823, 727, 728, 697, 328, 308, 681,
+	684, 685, 686, 694, 742, 743, 755, 758, 793, 792,
+	791, 794, 799, 819, 818, 820, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 754, 149, 159,
+	233, 824, 305, 204, 375, 676, 194, 0, 744, 747,
+	757, 778, 147, 160, 171, 178, 193, 198, 202, 720,
+	213, 216, 219, 220, 221, 226, 242, 247, 248, 249,
+	250, 269, 270, 273, 274, 277, 278, 285, 286, 287,
+	289, 290, 291, 296, 301, 303, 311, 317, 320, 321,
+	322, 324, 332, 333, 334, 335, 344, 349, 361, 362,
+	382, 384, 800, 0, 251, 298, 203, 189, 341, 318,
+	246, 316, 345, 280, 172, 299, 234, 168, 306, 206,
+	353, 377, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 276, 0, 0, 0, 0, 0, 542, 0, 0,
+	0, 196, 539, 0, 0, 0, 231, 0, 0, 185,
+	240, 238, 0, 0, 0, 284, 352, 0, 0, 0,
+	617, 235, 0, 0, 492, 340, 260, 0, 0, 0,
+	0, 604, 605, 0, 0, 0, 0, 0, 0, 1532,
+	0, 98, 0, 0, 0, 540, 564, 563, 566, 567,
+	568, 569, 0, 0, 0, 565, 570, 599, 600, 1533,
+	0, 0, 537, 555, 0, 616, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 552, 553, 0, 0,
+	0, 0, 631, 0, 554, 0, 0, 549, 550, 551,
+	556, 0, 0, 0, 0, 150, 0, 0, 0, 0,
+	210, 261, 163, 607, 0, 0, 222, 383, 0, 0,
+	629, 300, 0, 347, 212, 232, 167, 146, 161, 179,
+	211, 272, 0, 323, 614, 0, 0, 0, 0, 186,
+	0, 314, 281, 371, 606, 0, 288, 313, 236, 360,
+	0, 369, 370, 0, 486, 380, 385, 0, 0, 0,
+	337, 197, 0, 152, 0, 295, 192, 227, 0, 0,
+	0, 183, 0, 0, 0, 336, 358, 170, 354, 259,
+	265, 180, 182, 181, 162, 331, 357, 175, 184, 342,
+	319, 348, 191, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 376, 0, 326, 325, 0, 218, 0, 0,
+	0, 307, 0, 223, 151, 350, 388, 169, 229, 355,
+	0, 205, 271, 188, 282, 283, 201, 148, 302, 253,
+	254, 252, 255, 0, 0, 372, 0, 304, 153, 351,
+	368, 176, 327, 329, 386, 312, 156, 366, 346, 257,
+	224, 225, 154, 0, 155, 310, 195, 209, 190, 275,
+	0, 208, 297, 363, 364, 187, 389, 164, 379, 158,
+	165, 378, 268, 0, 267, 381, 359, 367, 258, 244,
+	0, 157, 365, 256, 243, 230, 200, 214, 293, 239,
+	294, 215, 263, 262, 264, 241, 245, 0, 0, 0,
+	343, 374, 390, 173, 0, 330, 356, 0, 0, 174,
+	207, 199, 292, 266, 166, 217, 339, 228, 237, 309,
+	387, 279, 315, 177, 373, 338, 618, 630, 624, 626,
+	625, 622, 623, 621, 620, 619, 632, 608, 609, 610,
+	611, 612, 0, 0, 0, 615, 0, 627, 628, 0,
+	0, 0, 0, 0, 328, 308, 571, 572, 573, 574,
+	575, 579, 580, 584, 585, 593, 592, 591, 594, 595,
+	597, 596, 598, 576, 577, 578, 581, 582, 583, 586,
+	587, 590, 588, 589, 613, 149, 159, 233, 0, 305,
+	204, 375, 0, 194, 0, 0, 0, 0, 0, 147,
+	160, 171, 178, 193, 198, 202, 0, 213, 216, 219,
+	220, 221, 226, 242, 247, 248, 249, 250, 269, 270,
+	273, 274, 277, 278, 285, 286, 287, 289, 290, 291,
+	296, 301, 303, 311, 317, 320, 321, 322, 324, 332,
+	333, 334, 335, 344, 349, 361, 362, 382, 384, 0,
+	0, 251, 298, 203, 189, 341, 318, 246, 316, 345,
+	280, 172, 299, 234, 168, 306, 206, 0, 377, 43,
+	353, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 276, 0, 0, 0, 0, 0, 542, 0, 0,
+	0, 196, 539, 0, 0, 0, 231, 0, 0, 185,
+	240, 238, 0, 0, 0, 284, 352, 0, 0, 0,
+	617, 235, 0, 0, 492, 340, 260, 0, 0, 0,
+	0, 604, 605, 0, 0, 0, 0, 0, 0, 0,
+	0, 98, 0, 0, 0, 540, 564, 563, 566, 567,
+	568, 569, 0, 0, 0, 565, 570, 599, 600, 0,
+	0, 0, 537, 555, 0, 616, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 552, 553, 0, 0,
+	0, 0, 631, 0, 554, 0, 0, 549, 550, 551,
+	556, 0, 0, 0, 0, 150, 0, 0, 0, 0,
+	210, 261, 163, 607, 0, 0, 222, 383, 0, 0,
+	629, 300, 0, 347, 212, 232, 167, 146, 161, 179,
+	211, 272, 0, 323, 614, 0, 0, 0, 0, 186,
+	0, 314, 281, 371, 606, 0, 288, 313, 236, 360,
+	0, 369, 370, 0, 486, 380, 385, 0, 0, 0,
+	337, 197, 0, 152, 0, 295, 192, 227, 0, 0,
+	0, 183, 0, 0, 0, 336, 358, 170, 354, 259,
+	265, 180, 182, 181, 162, 331, 357, 175, 184, 342,
+	319, 348, 191, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 376, 0, 326, 325, 0, 218, 0, 0,
+	0, 307, 0, 223, 151, 350, 388, 169, 229, 355,
+	0, 205, 271, 188, 282, 283, 201, 148, 302, 253,
+	254, 252, 255, 0, 0, 372, 0, 304, 153, 351,
+	368, 176, 327, 329, 386, 312, 156, 366, 346, 257,
+	224, 225, 154, 0, 155, 310, 195, 209, 190, 275,
+	0, 208, 297, 363, 364, 187, 389, 164, 379, 158,
+	165, 378, 268, 0, 267, 381, 359, 367, 258, 244,
+	0, 157, 365, 256, 243, 230, 200, 214, 293, 239,
+	294, 215, 263, 262, 264, 241, 245, 0, 0, 0,
+	343, 374, 390, 173, 0, 330, 356, 0, 0, 174,
+	207, 199, 292, 266, 166, 217, 339, 228, 237, 309,
+	387, 279, 315, 177, 373, 338, 618, 630, 624, 626,
+	625, 622, 623, 621, 620, 619, 632, 608, 609, 610,
+	611, 612, 0, 0, 0, 615, 0, 627, 628, 0,
+	0, 0, 0, 0, 328, 308, 571, 572, 573, 574,
+	575, 579, 580, 584, 585, 593, 592, 591, 594, 595,
+	597, 596, 598, 576, 577, 578, 581, 582, 583, 586,
+	587, 590, 588, 589, 613, 149, 159, 233, 96, 305,
+	204, 375, 0, 194, 0, 0, 0, 0, 0, 147,
+	160, 171, 178, 193, 198, 202, 0, 213, 216, 219,
+	220, 221, 226, 242, 247, 248, 249, 250, 269, 270,
+	273, 274, 277, 278, 285, 286, 287, 289, 290, 291,
+	296, 301, 303, 311, 317, 320, 321, 322, 324, 332,
+	333, 334, 335, 344, 349, 361, 362, 382, 384, 0,
+	0, 251, 298, 203, 189, 341, 318, 246, 316, 345,
+	280, 172, 299, 234, 168, 306, 206, 353, 377, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	0, 0, 0, 0, 542, 0, 0, 0, 196, 539,
+	0, 0, 0, 231, 0, 0, 185, 240, 238, 0,
+	0, 0, 284, 352, 0, 0, 0, 617, 235, 0,
+	0, 492, 340, 260, 0, 0, 0, 0, 604, 605,
+	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 540, 564, 563, 566, 567, 568, 569, 0,
+	0, 0, 565, 570, 599, 600, 0, 0, 0, 537,
+	555, 0, 616, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 552, 553, 533, 0, 0, 0, 631,
+	0, 554, 0, 0, 549, 550, 551, 556, 0, 0,
+	0, 0, 150, 0, 0, 0, 0, 210, 261, 163,
+	607, 0, 0, 222, 383, 0, 0, 629, 300, 0,
+	347, 212, 232, 167, 146, 161, 179, 211, 272, 0,
+	323, 614, 0, 0, 0, 0, 186, 0, 314, 281,
+	371, 606, 0, 288, 313, 236, 360, 0, 369, 370,
+	0, 486, 380, 385, 0, 0, 0, 337, 197, 0,
+	152, 0, 295, 192, 227, 0, 0, 0, 183, 0,
+	0, 0, 336, 358, 170, 354, 259, 265, 180, 182,
+	181, 162, 331, 357, 175, 184, 342, 319, 348, 191,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 376,
+	0, 326, 325, 0, 218, 0, 0, 0, 307, 0,
+	223, 151, 350, 388, 169, 229, 355, 0, 205, 271,
+	188, 282, 283, 201, 148, 302, 253, 254, 252, 255,
+	0, 0, 372, 0, 304, 153, 351, 368, 176, 327,
+	329, 386, 312, 156, 366, 346, 257, 224, 225, 154,
+	0, 155, 310, 195, 209, 190, 275, 0, 208, 297,
+	363, 364, 187, 389, 164, 379, 158, 165, 378, 268,
+	0, 267, 381, 359, 367, 258, 244, 0, 157, 365,
+	256, 243, 230, 200, 214, 293, 239, 294, 215, 263,
+	262, 264, 241, 245, 0, 0, 0, 343, 374, 390,
+	173, 0, 330, 356, 0, 0, 174, 207, 199, 292,
+	266, 166, 217, 339, 228, 237, 309, 387, 279, 315,
+	177, 373, 338, 618, 630, 624, 626, 625, 622, 623,
+	621, 620, 619, 632, 608, 609, 610, 611, 612, 0,
+	0, 0, 615, 0, 627, 628, 0, 0, 0, 0,
+	0, 328, 308, 571, 572, 573, 574, 575, 579, 580,
+	584, 585, 593, 592, 591, 594, 595, 597, 596, 598,
+	576, 577, 578, 581, 582, 583, 586, 587, 590, 588,
+	589, 613, 149, 159, 233, 0, 305, 204, 375, 0,
+	194, 0, 0, 0, 0, 0, 147, 160, 171, 178,
+	193, 198, 202, 0, 213, 216, 219, 220, 221, 226,
+	242, 247, 248, 249, 250, 269, 270, 273, 274, 277,
+	278, 285, 286, 287, 289, 290, 291, 296, 301, 303,
+	311, 317, 320, 321, 322, 324, 332, 333, 334, 335,
+	344, 349, 361, 362, 382, 384, 0, 0, 251, 298,
+	203, 189, 341, 318, 246, 316, 345, 280, 172, 299,
+	234, 168, 306, 206, 353, 377, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 276, 0, 0, 0, 0,
+	0, 542, 0, 0, 0, 196, 539, 0, 0, 0,
+	231, 0, 0, 185, 240, 238, 0, 0, 0, 284,
+	352, 0, 0, 0, 617, 235, 0, 0, 492, 340,
+	260, 0, 0, 0, 0, 604, 605, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 0, 988, 0, 540,
+	564, 563, 566, 567, 568, 569, 0, 0, 0, 565,
+	570, 599, 600, 0, 0, 0, 537, 555, 0, 616,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	552, 553, 0, 0, 0, 0, 631, 0, 554, 0,
+	0, 549, 550, 551, 556, 0, 0, 0, 0, 150,
+	0, 0, 0, 0, 210, 261, 163, 607, 0, 0,
+	222, 383, 0, 0, 629, 300, 0, 347, 212, 232,
+	167, 146, 161, 179, 211, 272, 0, 323, 614, 0,
+	0, 0, 0, 186, 0, 314, 281, 371, 606, 0,
+	288, 313, 236, 360, 0, 369, 370, 0, 486, 380,
+	385, 0, 0, 0, 337, 197, 0, 152, 0, 295,
+	192, 227, 0, 0, 0, 183, 0, 0, 0, 336,
+	358, 170, 354, 259, 265, 180, 182, 181, 162, 331,
+	357, 175, 184, 342, 319, 348, 191, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 376, 0, 326, 325,
+	0, 218, 0, 0, 0, 307, 0, 223, 151, 350,
+	388, 169, 229, 355, 0, 205, 271, 188, 282, 283,
+	201, 148, 302, 253, 254, 252, 255, 0, 0, 372,
+	0, 304, 153, 351, 368, 176, 327, 329, 386, 312,
+	156, 366, 346, 257, 224, 225, 154, 0, 155, 310,
+	195, 209, 190, 275, 0, 208, 297, 363, 364, 187,
+	389, 164, 379, 158, 165, 378, 268, 0, 267, 381,
+	359, 367, 258, 244, 0, 157, 365, 256, 243, 230,
+	200, 214, 293, 239, 294, 215, 263, 262, 264, 241,
+	245, 0, 0, 0, 343, 374, 390, 173, 0, 330,
+	356, 0, 0, 174, 207, 199, 292, 266, 166, 217,
+	339, 228, 237, 309, 387, 279, 315, 177, 373, 338,
+	618, 630, 624, 626, 625, 622, 623, 621, 620, 619,
+	632, 608, 609, 610, 611, 612, 0, 0, 0, 615,
+	0, 627, 628, 0, 0, 0, 0, 0, 328, 308,
+	571, 572, 573, 574, 575, 579, 580, 584, 585, 593,
+	592, 591, 594, 595, 597, 596, 598, 576, 577, 578,
+	581, 582, 583, 586, 587, 590, 588, 589, 613, 149,
+	159, 233, 0, 305, 204, 375, 0, 194, 0, 0,
+	0, 0, 0, 147, 160, 171, 178, 193, 198, 202,
+	0, 213, 216, 219, 220, 221, 226, 242, 247, 248,
+	249, 250, 269, 270, 273, 274, 277, 278, 285, 286,
+	287, 289, 290, 291, 296, 301, 303, 311, 317, 320,
+	321, 322, 324, 332, 333, 334, 335, 344, 349, 361,
+	362, 382, 384, 0, 0, 251, 298, 203, 189, 341,
+	318, 246, 316, 345, 280, 172, 299, 234, 168, 306,
+	206, 353, 377, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 0, 0, 0, 0, 542, 0,
+	0, 0, 196, 539, 0, 0, 0, 231, 0, 0,
+	185, 240, 238, 0, 0, 0, 284, 352, 0, 0,
+	0, 617, 235, 0, 0, 492, 340, 260, 0, 0,
+	0, 0, 604, 605, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 0, 0, 0, 540, 564, 563, 566,
+	567, 568, 569, 0, 0, 0, 565, 570, 599, 600,
+	0, 0, 0, 537, 555, 0, 616, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 552, 553, 1420,
+	0, 0, 0, 631, 0, 554, 0, 0, 549, 550,
+	551, 556, 0, 0, 0, 0, 150, 0, 0, 0,
+	0, 210, 261, 163, 607, 0, 0, 222, 383, 0,
+	0, 629, 300, 0, 347, 212, 232, 167, 146, 161,
+	179, 211, 272, 0, 323, 614, 0, 0, 0, 0,
+	186, 0, 314, 281, 371, 606, 0, 288, 313, 236,
+	360, 0, 369, 370, 0, 486, 380, 385, 0, 0,
+	0, 337, 197, 0, 152, 0, 295, 192, 227, 0,
+	0, 0, 183, 0, 0, 0, 336, 358, 170, 354,
+	259, 265, 180, 182, 181, 162, 331, 357, 175, 184,
+	342, 319, 348, 191, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 376, 0, 326, 325, 0, 218, 0,
+	0, 0, 307, 0, 223, 151, 350, 388, 169, 229,
+	355, 0, 205, 271, 188, 282, 283, 201, 148, 302,
+	253, 254, 252, 255, 0, 0, 372, 0, 304, 153,
+	351, 368, 176, 327, 329, 386, 312, 156, 366, 346,
+	257, 224, 225, 154, 0, 155, 310, 195, 209, 190,
+	275, 0, 208, 297, 363, 364, 187, 389, 164, 379,
+	158, 165, 378, 268, 0, 267, 381, 359, 367, 258,
+	244, 0, 157, 365, 256, 243, 230, 200, 214, 293,
+	239, 294, 215, 263, 262, 264, 241, 245, 0, 0,
+	0, 343, 374, 390, 173, 0, 330, 356, 0, 0,
+	174, 207, 199, 292, 266, 166, 217, 339, 228, 237,
+	309, 387, 279, 315, 177, 373, 338, 618, 630, 624,
+	626, 625, 622, 623, 621, 620, 619, 632, 608, 609,
+	610, 611, 612, 0, 0, 0, 615, 0, 627, 628,
+	0, 0, 0, 0, 0, 328, 308, 571, 572, 573,
+	574, 575, 579, 580, 584, 585, 593, 592, 591, 594,
+	595, 597, 596, 598, 576, 577, 578, 581, 582, 583,
+	586, 587, 590, 588, 589, 613, 149, 159, 233, 0,
+	305, 204, 375, 0, 194, 0, 0, 0, 0, 0,
+	147, 160, 171, 178, 193, 198, 202, 0, 213, 216,
+	219, 220, 221, 226, 242, 247, 248, 249, 250, 269,
+	270, 273, 274, 277, 278, 285, 286, 287, 289, 290,
+	291, 296, 301, 303, 311, 317, 320, 321, 322, 324,
+	332, 333, 334, 335, 344, 349, 361, 362, 382, 384,
+	0, 0, 251, 298, 203, 189, 341, 318, 246, 316,
+	345, 280, 172, 299, 234, 168, 306, 206, 353, 377,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 276,
+	0, 0, 0, 0, 0, 542, 0, 0, 0, 196,
+	539, 0, 0, 0, 231, 0, 0, 185, 240, 238,
+	0, 0, 0, 284, 352, 0, 0, 0, 617, 235,
+	0, 0, 492, 340, 260, 0, 0, 0, 0, 604,
+	605, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	0, 0, 0, 540, 564, 1464, 566, 567, 568, 569,
+	0, 0, 0, 565, 570, 599, 600, 0, 0, 0,
+	537, 555, 0, 616, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 552, 553, 1420, 0, 0, 0,
+	631, 0, 554, 0, 0, 549, 550, 551, 556, 0,
+	0, 0, 0, 150, 0, 0, 0, 0, 210, 261,
+	163, 607, 0, 0, 222, 383, 0, 0, 629, 300,
+	0, 347, 212, 232, 167, 146, 161, 179, 211, 272,
+	0, 323, 614, 0, 0, 0, 0, 186, 0, 314,
+	281, 371, 606, 0, 288, 313, 236, 360, 0, 369,
+	370, 0, 486, 380, 385, 0, 0, 0, 337, 197,
+	0, 152, 0, 295, 192, 227, 0, 0, 0, 183,
+	0, 0, 0, 336, 358, 170, 354, 259, 265, 180,
+	182, 181, 162, 331, 357, 175, 184, 342, 319, 348,
+	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	376, 0, 326, 325, 0, 218, 0, 0, 0, 307,
+	0, 223, 151, 350, 388, 169, 229, 355, 0, 205,
+	271, 188, 282, 283, 201, 148, 302, 253, 254, 252,
+	255, 0, 0, 372, 0, 304, 153, 351, 368, 176,
+	327, 329, 386, 312, 156, 366, 346, 257, 224, 225,
+	154, 0, 155, 310, 195, 209, 190, 275, 0, 208,
+	297, 363, 364, 187, 389, 164, 379, 158, 165, 378,
+	268, 0, 267, 381, 359, 367, 258, 244, 0, 157,
+	365, 256, 243, 230, 200, 214, 293, 239, 294, 215,
+	263, 262, 264, 241, 245, 0, 0, 0, 343, 374,
+	390, 173, 0, 330, 356, 0, 0, 174, 207, 199,
+	292, 266, 166, 217, 339, 228, 237, 309, 387, 279,
+	315, 177, 373, 338, 618, 630, 624, 626, 625, 622,
+	623, 621, 620, 619, 632, 608, 609, 610, 611, 612,
+	0, 0, 0, 615, 0, 627, 628, 0, 0, 0,
+	0, 0, 328, 308, 571, 572, 573, 574, 575, 579,
+	580, 584, 585, 593, 592, 591, 594, 595, 597, 596,
+	598, 576, 577, 578, 581, 582, 583, 586, 587, 590,
+	588, 589, 613, 149, 159, 233, 0, 305, 204, 375,
+	0, 194, 0, 0, 0, 0, 0, 147, 160, 171,
+	178, 193, 198, 202, 0, 213, 216, 219, 220, 221,
+	226, 242, 247, 248, 249, 250, 269, 270, 273, 274,
+	277, 278, 285, 286, 287, 289, 290, 291, 296, 301,
+	303, 311, 317, 320, 321, 322, 324, 332, 333, 334,
+	335, 344, 349, 361, 362, 382, 384, 0, 0, 251,
+	298, 203, 189, 341, 318, 246, 316, 345, 280, 172,
+	299, 234, 168, 306, 206, 353, 377, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 276, 0, 0, 0,
+	0, 0, 542, 0, 0, 0, 196, 539, 0, 0,
+	0, 231, 0, 0, 185, 240, 238, 0, 0, 0,
+	284, 352, 0, 0, 0, 617, 235, 0, 0, 492,
+	340, 260, 0, 0, 0, 0, 604, 605, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
+	540, 564, 1461, 566, 567, 568, 569, 0, 0, 0,
+	565, 570, 599, 600, 0, 0, 0, 537, 555, 0,
+	616, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 552, 553, 1420, 0, 0, 0, 631, 0, 554,
+	0, 0, 549, 550, 551, 556, 0, 0, 0, 0,
+	150, 0, 0, 0, 0, 210, 261, 163, 607, 0,
+	0, 222, 383, 0, 0, 629, 300, 0, 347, 212,
+	232, 167, 146, 161, 179, 211, 272, 0, 323, 614,
+	0, 0, 0, 0, 186, 0, 314, 281, 371, 606,
+	0, 288, 313, 236, 360, 0, 369, 370, 0, 486,
+	380, 385, 0, 0, 0, 337, 197, 0, 152, 0,
+	295, 192, 227, 0, 0, 0, 183, 0, 0, 0,
+	336, 358, 170, 354, 259, 265, 180, 182, 181, 162,
+	331, 357, 175, 184, 342, 319, 348, 191, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 376, 0, 326,
+	325, 0, 218, 0, 0, 0, 307, 0, 223, 151,
+	350, 388, 169, 229, 355, 0, 205, 271, 188, 282,
+	283, 201, 148, 302, 253, 254, 252, 255, 0, 0,
+	372, 0, 304, 153, 351, 368, 176, 327, 329, 386,
+	312, 156, 366, 346, 257, 224, 225, 154, 0, 155,
+	310, 195, 209, 190, 275, 0, 208, 297, 363, 364,
+	187, 389, 164, 379, 158, 165, 378, 268, 0, 267,
+	381, 359, 367, 258, 244, 0, 157, 365, 256, 243,
+	230, 200, 214, 293, 239, 294, 215, 263, 262, 264,
+	241, 245, 0, 0, 0, 343, 374, 390, 173, 0,
+	330, 356, 0, 0, 174, 207, 199, 292, 266, 166,
+	217, 339, 228, 237, 309, 387, 279, 315, 177, 373,
+	338, 618, 630, 624, 626, 625, 622, 623, 621, 620,
+	619, 632, 608, 609, 610, 611, 612, 0, 0, 0,
+	615, 0, 627, 628, 0, 0, 0, 0, 0, 328,
+	308, 571, 572, 573, 574, 575, 579, 580, 584, 585,
+	593, 592, 591, 594, 595, 597, 596, 598, 576, 577,
+	578, 581, 582, 583, 586, 587, 590, 588, 589, 613,
+	149, 159, 233, 0, 305, 204, 375, 0, 194, 0,
+	0, 0, 0, 0, 147, 160, 171, 178, 193, 198,
+	202, 0, 213, 216, 219, 220, 221, 226, 242, 247,
+	248, 249, 250, 269, 270, 273, 274, 277, 278, 285,
+	286, 287, 289, 290, 291, 296, 301, 303, 311, 317,
+	320, 321, 322, 324, 332, 333, 334, 335, 344, 349,
+	361, 362, 382, 384, 0, 0, 251, 298, 203, 189,
+	341, 318, 246, 316, 345, 280, 172, 299, 234, 168,
+	306, 206, 353, 377, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 276, 0, 0, 0, 0, 0, 542,
+	0, 0, 0, 196, 539, 0, 0, 0, 231, 0,
+	0, 185, 240, 238, 0, 0, 0, 284, 352, 0,
+	0, 0, 617, 235, 0, 0, 492, 340, 260, 0,
+	0, 0, 0, 604, 605, 0, 0, 0, 0, 0,
+	0, 0, 0, 98, 0, 1307, 0, 540, 564, 563,
+	566, 567, 568, 569, 0, 0, 0, 565, 570, 599,
+	600, 0, 0, 0, 537, 555, 0, 616, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 552, 553,
+	0, 0, 0, 0, 631, 0, 554, 0, 0, 549,
+	550, 551, 556, 0, 0, 0, 0, 150, 0, 0,
+	0, 0, 210, 261, 163, 607, 0, 0, 222, 383,
+	0, 0, 629, 300, 0, 347, 212, 232, 167, 146,
+	161, 179, 211, 272, 0, 323, 614, 0, 0, 0,
+	0, 186, 0, 314, 281, 371, 606, 0, 288, 313,
+	236, 360, 0, 369, 370, 0, 486, 380, 385, 0,
+	0, 0, 337, 197, 0, 152, 0, 295, 192, 227,
+	0, 0, 0, 183, 0, 0, 0, 336, 358, 170,
+	354, 259, 265, 180, 182, 181, 162, 331, 357, 175,
+	184, 342, 319, 348, 191, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 376, 0, 326, 325, 0, 218,
+	0, 0, 0, 307, 0, 223, 151, 350, 388, 169,
+	229, 355, 0, 205, 271, 188, 282, 283, 201, 148,
+	302, 253, 254, 252, 255, 0, 0, 372, 0, 304,
+	153, 351, 368, 176, 327, 329, 386, 312, 156, 366,
+	346, 257, 224, 225, 154, 0, 155, 310, 195, 209,
+	190, 275, 0, 208, 297, 363, 364, 187, 389, 164,
+	379, 158, 165, 378, 268, 0, 267, 381, 359, 367,
+	258, 244, 0, 157, 365, 256, 243, 230, 200, 214,
+	293, 239, 294, 215, 263, 262, 264, 241, 245, 0,
+	0, 0, 343, 374, 390, 173, 0, 330, 356, 0,
+	0, 174, 207, 199, 292, 266, 166, 217, 339, 228,
+	237, 309, 387, 279, 315, 177, 373, 338, 618, 630,
+	624, 626, 625, 622, 623, 621, 620, 619, 632, 608,
+	609, 610, 611, 612, 0, 0, 0, 615, 0, 627,
+	628, 0, 0, 0, 0, 0, 328, 308, 571, 572,
+	573, 574, 575, 579, 580, 584, 585, 593, 592, 591,
+	594, 595, 597, 596, 598, 576, 577, 578, 581, 582,
+	583, 586, 587, 590, 588, 589, 613, 149, 159, 233,
+	0, 305, 204, 375, 0, 194, 0, 0, 0, 0,
+	0, 147, 160, 171, 178, 193, 198, 202, 0, 213,
+	216, 219, 220, 221, 226, 242, 247, 248, 249, 250,
+	269, 270, 273, 274, 277, 278, 285, 286, 287, 289,
+	290, 291, 296, 301, 303, 311, 317, 320, 321, 322,
+	324, 332, 333, 334, 335, 344, 349, 361, 362, 382,
+	384, 0, 0, 251, 298, 203, 189, 341, 318, 246,
+	316, 345, 280, 172, 299, 234, 168, 306, 206, 353,
+	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	276, 0, 0, 0, 0, 0, 542, 0, 0, 0,
+	196, 539, 0, 0, 0, 231, 0, 0, 185, 240,
+	238, 0, 0, 0, 284, 352, 0, 0, 0, 617,
+	235, 0, 0, 492, 340, 260, 0, 0, 0, 0,
+	604, 605, 0, 0, 0, 0, 0, 0, 0, 0,
+	98, 0, 0, 0, 540, 564, 563, 566, 567, 568,
+	569, 0, 0, 0, 565, 570, 599, 600, 0, 0,
+	0, 537, 555, 0, 616, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 552, 553, 0, 0, 0,
+	0, 631, 0, 554, 0, 0, 549, 550, 551, 556,
+	0, 0, 0, 0, 150, 0, 0, 0, 0, 210,
+	261, 163, 607, 0, 0, 222, 383, 0, 0, 629,
+	300, 0, 347, 212, 232, 167, 146, 161, 179, 211,
+	272, 0, 323, 614, 0, 0, 0, 0, 186, 0,
+	314, 281, 371, 606, 0, 288, 313, 236, 360, 0,
+	369, 370, 0, 486, 380, 385, 0, 0, 0, 337,
+	197, 0, 152, 0, 295, 192, 227, 0, 0, 0,
+	183, 0, 0, 0, 336, 358, 170, 354, 259, 265,
+	180, 182, 181, 162, 331, 357, 175, 184, 342, 319,
+	348, 191, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 376, 0, 326, 325, 0, 218, 0, 0, 0,
+	307, 0, 223, 151, 350, 388, 169, 229, 355, 0,
+	205, 271, 188, 282, 283, 201, 148, 302, 253, 254,
+	252, 255, 0, 0, 372, 0, 304, 153, 351, 368,
+	176, 327, 329, 386, 312, 156, 366, 346, 257, 224,
+	225, 154, 0, 155, 310, 195, 209, 190, 275, 0,
+	208, 297, 363, 364, 187, 389, 164, 379, 158, 165,
+	378, 268, 0, 267, 381, 359, 367, 258, 244, 0,
+	157, 365, 256, 243, 230, 200, 214, 293, 239, 294,
+	215, 263, 262, 264, 241, 245, 0, 0, 0, 343,
+	374, 390, 173, 0, 330, 356, 0, 0, 174, 207,
+	199, 292, 266, 166, 217, 339, 228, 237, 309, 387,
+	279, 315, 177, 373, 338, 618, 630, 624, 626, 625,
+	622, 623, 621, 620, 619, 632, 608, 609, 610, 611,
+	612, 0, 0, 0, 615, 0, 627, 628, 0, 0,
+	0, 0, 0, 328, 308, 571, 572, 573, 574, 575,
+	579, 580, 584, 585, 593, 592, 591, 594, 595, 597,
+	596, 598, 576, 577, 578, 581, 582, 583, 586, 587,
+	590, 588, 589, 613, 149, 159, 233, 0, 305, 204,
+	375, 0, 194, 0, 0, 0, 0, 0, 147, 160,
+	171, 178, 193, 198, 202, 0, 213, 216, 219, 220,
+	221, 226, 242, 247, 248, 249, 250, 269, 270, 273,
+	274, 277, 278, 285, 286, 287, 289, 290, 291, 296,
+	301, 303, 311, 317, 320, 321, 322, 324, 332, 333,
+	334, 335, 344, 349, 361, 362, 382, 384, 0, 0,
+	251, 298, 203, 189, 341, 318, 246, 316, 345, 280,
+	172, 299, 234, 168, 306, 206, 353, 377, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 276, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 196, 0, 0,
+	0, 0, 231, 0, 0, 185, 240, 238, 0, 0,
+	0, 284, 352, 0, 0, 0, 617, 235, 0, 0,
+	492, 340, 260, 0, 0, 0, 0, 604, 605, 0,
+	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
+	0, 540, 564, 563, 566, 567, 568, 569, 0, 0,
+	0, 565, 570, 599, 600, 0, 0, 0, 0, 555,
+	0, 616, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 552, 553, 0, 0, 0, 0, 631, 0,
+	554, 0, 0, 549, 550, 551, 556, 0, 0, 0,
+	0, 150, 0, 0, 0, 0, 210, 261, 163, 607,
+	0, 0, 222, 383, 0, 0, 629, 300, 0, 347,
+	212, 232, 167, 146, 161, 179, 211, 272, 0, 323,
+	614, 0, 0, 0, 0, 186, 0, 314, 281, 371,
+	606, 0, 288, 313, 236, 360, 0, 369, 370, 0,
+	486, 380, 385, 0, 0, 0, 337, 197, 0, 152,
+	0, 295, 192, 227, 0, 0, 0, 183, 0, 0,
+	0, 336, 358, 170, 354, 259, 265, 180, 182, 181,
+	162, 331, 357, 175, 184, 342, 319, 348, 191, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 376, 0,
+	326, 325, 0, 218, 0, 0, 0, 307, 0, 223,
+	151, 350, 388, 169, 229, 355, 0, 205, 271, 188,
+	282, 283, 201, 148, 302, 253, 254, 252, 255, 0,
+	0, 372, 0, 304, 153, 351, 368, 176, 327, 329,
+	386, 312, 156, 366, 346, 257, 224, 225, 154, 0,
+	155, 310, 195, 209, 190, 275, 0, 208, 297, 363,
+	364, 187, 389, 164, 379, 158, 165, 378, 268, 0,
+	267, 381, 359, 367, 258, 244, 0, 157, 365, 256,
+	243, 230, 200, 214, 293, 239, 294, 215, 263, 262,
+	264, 241, 245, 0, 0, 0, 343, 374, 390, 173,
+	0, 330, 356, 0, 0, 174, 207, 199, 292, 266,
+	166, 217, 339, 228, 237, 309, 387, 279, 315, 177,
+	373, 338, 618, 630, 624, 626, 625, 622, 623, 621,
+	620, 619, 632, 608, 609, 610, 611, 612, 1467, 1468,
+	1469, 615, 0, 627, 628, 0, 0, 0, 0, 0,
+	328, 308, 571, 572, 573, 574, 575, 579, 580, 584,
+	585, 593, 592, 591, 594, 595, 597, 596, 598, 576,
+	577, 578, 581, 582, 583, 586, 587, 590, 588, 589,
+	613, 149, 159, 233, 0, 305, 204, 375, 0, 194,
+	0, 0, 0, 0, 0, 147, 160, 171, 178, 193,
+	198, 202, 0, 213, 216, 219, 220, 221, 226, 242,
+	247, 248, 249, 250, 269, 270, 273, 274, 277, 278,
+	285, 286, 287, 289, 290, 291, 296, 301, 303, 311,
+	317, 320, 321, 322, 324, 332, 333, 334, 335, 344,
+	349, 361, 362, 382, 384, 0, 0, 251, 298, 203,
+	189, 341, 318, 246, 316, 345, 280, 172, 299, 234,
+	168, 306, 206, 353, 377, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 276, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 196, 0, 0, 0, 0, 231,
+	0, 0, 185, 240, 238, 0, 0, 0, 284, 352,
+	0, 0, 0, 617, 235, 0, 0, 492, 340, 260,
+	0, 0, 0, 0, 604, 605, 0, 0, 0, 0,
+	0, 0, 0, 0, 98, 0, 0, 0, 540, 564,
+	563, 566, 567, 568, 569, 0, 0, 0, 565, 570,
+	599, 600, 0, 0, 0, 0, 555, 0, 616, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 552,
+	553, 0, 0, 0, 0, 631, 0, 554, 0, 0,
+	549, 550, 551, 556, 0, 0, 0, 0, 150, 0,
+	0, 0, 0, 210, 261, 163, 607, 0, 0, 222,
+	383, 0, 0, 629, 300, 0, 347, 212, 232, 167,
+	146, 161, 179, 211, 272, 0, 323, 614, 0, 0,
+	0, 0, 186, 0, 314, 281, 371, 606, 2795, 288,
+	313, 236, 360, 0, 369, 370, 0, 486, 380, 385,
+	0, 0, 0, 337, 197, 0, 152, 0, 295, 192,
+	227, 0, 0, 0, 183, 0, 0, 0, 336, 358,
+	170, 354, 259, 265, 180, 182, 181, 162, 331, 357,
+	175, 184, 342, 319, 348, 191, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 376, 0, 326, 325, 0,
+	218, 0, 0, 0, 307, 0, 223, 151, 350, 388,
+	169, 229, 355, 0, 205, 271, 188, 282, 283, 201,
+	148, 302, 253, 254, 252, 255, 0, 0, 372, 0,
+	304, 153, 351, 368, 176, 327, 329, 386, 312, 156,
+	366, 346, 257, 224, 225, 154, 0, 155, 310, 195,
+	209, 190, 275, 0, 208, 297, 363, 364, 187, 389,
+	164, 379, 158, 165, 378, 268, 0, 267, 381, 359,
+	367, 258, 244, 0, 157, 365, 256, 243, 230, 200,
+	214, 293, 239, 294, 215, 263, 262, 264, 241, 245,
+	0, 0, 0, 343, 374, 390, 173, 0, 330, 356,
+	0, 0, 174, 207, 199, 292, 266, 166, 217, 339,
+	228, 237, 309, 387, 279, 315, 177, 373, 338, 618,
+	630, 624, 626, 625, 622, 623, 621, 620, 619, 632,
+	608, 609, 610, 611, 612, 0, 0, 0, 615, 0,
+	627, 628, 0, 0, 0, 0, 0, 328, 308, 571,
+	572, 573, 574, 575, 579, 580, 584, 585, 593, 592,
+	591, 594, 595, 597, 596, 598, 576, 577, 578, 581,
+	582, 583, 586, 587, 590, 588, 589, 613, 149, 159,
+	233, 0, 305, 204, 375, 0, 194, 0, 0, 0,
+	0, 0, 147, 160, 171, 178, 193, 198, 202, 0,
+	213, 216, 219, 220, 221, 226, 242, 247, 248, 249,
+	250, 269, 270, 273, 274, 277, 278, 285, 286, 287,
+	289, 290, 291, 296, 301, 303, 311, 317, 320, 321,
+	322, 324, 332, 333, 334, 335, 344, 349, 361, 362,
+	382, 384, 0, 0, 251, 298, 203, 189, 341, 318,
+	246, 316, 345, 280, 172, 299, 234, 168, 306, 206,
+	353, 377, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 276, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 196, 0, 0, 0, 0, 231, 0, 0, 185,
+	240, 238, 0, 0, 0, 284, 352, 0, 0, 0,
+	617, 235, 0, 0, 492, 340, 260, 0, 0, 0,
+	0, 604, 605, 0, 0, 0, 0, 0, 0, 0,
+	0, 98, 0, 988, 0, 540, 564, 563, 566, 567,
+	568, 569, 0, 0, 0, 565, 570, 599, 600, 0,
+	0, 0, 0, 555, 0, 616, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 552, 553, 0, 0,
+	0, 0, 631, 0, 554, 0, 0, 549, 550, 551,
+	556, 0, 0, 0, 0, 150, 0, 0, 0, 0,
+	210, 261, 163, 607, 0, 0, 222, 383, 0, 0,
+	629, 300, 0, 347, 212, 232, 167, 146, 161, 179,
+	211, 272, 0, 323, 614, 0, 0, 0, 0, 186,
+	0, 314, 281, 371, 606, 0, 288, 313, 236, 360,
+	0, 369, 370, 0, 486, 380, 385, 0, 0, 0,
+	337, 197, 0, 152, 0, 295, 192, 227, 0, 0,
+	0, 183, 0, 0, 0, 336, 358, 170, 354, 259,
+	265, 180, 182, 181, 162, 331, 357, 175, 184, 342,
+	319, 348, 191, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 376, 0, 326, 325, 0, 218, 0, 0,
+	0, 307, 0, 223, 151, 350, 388, 169, 229, 355,
+	0, 205, 271, 188, 282, 283, 201, 148, 302, 253,
+	254, 252, 255, 0, 0, 372, 0, 304, 153, 351,
+	368, 176, 327, 329, 386, 312, 156, 366, 346, 257,
+	224, 225, 154, 0, 155, 310, 195, 209, 190, 275,
+	0, 208, 297, 363, 364, 187, 389, 164, 379, 158,
+	165, 378, 268, 0, 267, 381, 359, 367, 258, 244,
+	0, 157, 365, 256, 243, 230, 200, 214, 293, 239,
+	294, 215, 263, 262, 264, 241, 245, 0, 0, 0,
+	343, 374, 390, 173, 0, 330, 356, 0, 0, 174,
+	207, 199, 292, 266, 166, 217, 339, 228, 237, 309,
+	387, 279, 315, 177, 373, 338, 618, 630, 624, 626,
+	625, 622, 623, 621, 620, 619, 632, 608, 609, 610,
+	611, 612, 0, 0, 0, 615, 0, 627, 628, 0,
+	0, 0, 0, 0, 328, 308, 571, 572, 573, 574,
+	575, 579, 580, 584, 585, 593, 592, 591, 594, 595,
+	597, 596, 598, 576, 577, 578, 581, 582, 583, 586,
+	587, 590, 588, 589, 613, 149, 159, 233, 0, 305,
+	204, 375, 0, 194, 0, 0, 0, 0, 0, 147,
+	160, 171, 178, 193, 198, 202, 0, 213, 216, 219,
+	220, 221, 226, 242, 247, 248, 249, 250, 269, 270,
+	273, 274, 277, 278, 285, 286, 287, 289, 290, 291,
+	296, 301, 303, 311, 317, 320, 321, 322, 324, 332,
+	333, 334, 335, 344, 349, 361, 362, 382, 384, 0,
+	0, 251, 298, 203, 189, 341, 318, 246, 316, 345,
+	280, 172, 299, 234, 168, 306, 206, 353, 377, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 196, 0,
+	0, 0, 0, 231, 0, 0, 185, 240, 238, 0,
+	0, 0, 284, 352, 0, 0, 0, 617, 235, 0,
+	0, 492, 340, 260, 0, 0, 0, 0, 604, 605,
+	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 540, 564, 563, 566, 567, 568, 569, 0,
+	0, 0, 565, 570, 599, 600, 0, 0, 0, 0,
+	555, 0, 616, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 552, 553, 0, 0, 0, 0, 631,
+	0, 554, 0, 0, 549, 550, 551, 556, 0, 0,
+	0, 0, 150, 0, 0, 0, 0, 210, 261, 163,
+	607, 0, 0, 222, 383, 0, 0, 629, 300, 0,
+	347, 212, 232, 167, 146, 161, 179, 211, 272, 0,
+	323, 614, 0, 0, 0, 0, 186, 0, 314, 281,
+	371, 606, 0, 288, 313, 236, 360, 0, 369, 370,
+	0, 486, 380, 385, 0, 0, 0, 337, 197, 0,
+	152, 0, 295, 192, 227, 0, 0, 0, 183, 0,
+	0, 0, 336, 358, 170, 354, 259, 265, 180, 182,
+	181, 162, 331, 357, 175, 184, 342, 319, 348, 191,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 376,
+	0, 326, 325, 0, 218, 0, 0, 0, 307, 492,
+	223, 151, 350, 388, 169, 229, 355, 0, 205, 271,
+	188, 282, 283, 201, 148, 302, 253, 254, 252, 255,
+	2204, 0, 372, 0, 304, 153, 351, 368, 176, 327,
+	329, 386, 312, 156, 366, 346, 257, 224, 225, 154,
+	0, 155, 310, 195, 209, 190, 275, 0, 208, 297,
+	363, 364, 187, 389, 164, 379, 158, 165, 378, 268,
+	0, 267, 381, 359, 367, 258, 244, 0, 157, 365,
+	256, 243, 230, 200, 214, 293, 239, 294, 215, 263,
+	262, 264, 241, 245, 0, 0, 0, 343, 374, 390,
+	173, 0, 330, 356, 0, 0, 174, 207, 199, 292,
+	266, 166, 217, 339, 228, 237, 309, 387, 279, 315,
+	177, 373, 338, 618, 630, 624, 626, 625, 622, 623,
+	621, 620, 619, 632, 608, 609, 610, 611, 612, 486,
+	0, 0, 615, 0, 627, 628, 0, 0, 0, 0,
+	0, 328, 308, 571, 572, 573, 574, 575, 579, 580,
+	584, 585, 593, 592, 591, 594, 595, 597, 596, 598,
+	576, 577, 578, 581, 582, 583, 586, 587, 590, 588,
+	589, 613, 149, 159, 233, 0, 305, 204, 375, 0,
+	194, 0, 0, 0, 0, 0, 147, 160, 171, 178,
+	193, 198, 202, 0, 213, 216, 219, 220, 221, 226,
+	242, 247, 248, 249, 250, 269, 270, 273, 274, 277,
+	278, 285, 286, 287, 289, 290, 291, 296, 301, 303,
+	311, 317, 320, 321, 322, 324, 332, 333, 334, 335,
+	344, 349, 361, 362, 382, 384, 0, 0, 251, 298,
+	203, 189, 341, 318, 246, 316, 345, 280, 172, 299,
+	234, 168, 306, 206, 0, 377, 459, 353, 457, 460,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	0, 496, 0, 0, 0, 0, 0, 0, 196, 0,
+	0, 0, 0, 231, 0, 0, 185, 240, 238, 0,
+	0, 0, 284, 352, 0, 0, 0, 0, 235, 0,
+	0, 492, 340, 260, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 463, 0, 462, 0, 0, 0, 0, 0,
+	0, 466, 467, 468, 469, 470, 474, 475, 479, 480,
+	489, 488, 487, 490, 491, 494, 493, 495, 471, 472,
+	473, 476, 477, 478, 481, 482, 485, 483, 484, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 150, 0, 0, 0, 0, 210, 261, 163,
+	0, 0, 0, 222, 383, 0, 0, 0, 300, 0,
+	347, 212, 232, 167, 146, 161, 179, 211, 272, 458,
+	323, 0, 0, 0, 0, 0, 186, 0, 314, 281,
+	371, 0, 0, 288, 313, 236, 360, 0, 369, 370,
+	0, 486, 380, 385, 0, 0, 0, 337, 197, 0,
+	152, 0, 295, 192, 227, 0, 0, 0, 183, 0,
+	0, 0, 336, 358, 170, 354, 259, 265, 180, 182,
+	181, 162, 331, 357, 175, 184, 342, 319, 348, 191,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 376,
+	0, 326, 325, 0, 218, 0, 0, 0, 307, 492,
+	223, 151, 350, 388, 169, 229, 355, 0, 205, 271,
+	188, 282, 283, 201, 148, 302, 253, 254, 252, 255,
+	2215, 0, 372, 0, 499, 153, 351, 368, 176, 327,
+	329, 386, 312, 156, 366, 346, 257, 224, 225, 154,
+	0, 155, 310, 195, 209, 190, 275, 0, 208, 297,
+	363, 364, 187, 389, 164, 379, 158, 165, 378, 268,
+	0, 267, 381, 359, 367, 258, 244, 0, 157, 365,
+	256, 243, 230, 200, 214, 293, 239, 294, 215, 263,
+	262, 264, 241, 245, 0, 0, 0, 343, 374, 390,
+	173, 0, 330, 356, 0, 0, 174, 207, 199, 292,
+	266, 166, 217, 339, 228, 237, 309, 387, 279, 315,
+	177, 373, 338, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 486,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 328, 308, 466, 467, 468, 469, 470, 474, 475,
+	479, 480, 489, 488, 487, 490, 491, 494, 493, 495,
+	471, 472, 473, 476, 477, 478, 481, 482, 485, 483,
+	484, 0, 149, 159, 233, 0, 305, 204, 375, 0,
+	194, 0, 0, 0, 0, 0, 147, 160, 171, 178,
+	193, 198, 202, 0, 213, 216, 219, 220, 221, 226,
+	242, 247, 248, 249, 250, 269, 270, 273, 274, 277,
+	278, 285, 286, 287, 289, 290, 291, 296, 301, 303,
+	311, 317, 320, 321, 322, 324, 332, 333, 334, 335,
+	344, 349, 361, 362, 382, 384, 0, 0, 251, 298,
+	203, 189, 341, 318, 246, 316, 345, 280, 172, 299,
+	234, 168, 306, 206, 0, 377, 459, 353, 457, 460,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	0, 451, 0, 0, 0, 0, 0, 0, 196, 0,
+	0, 0, 0, 231, 0, 0, 185, 240, 238, 0,
+	0, 0, 284, 352, 0, 0, 0, 0, 235, 0,
+	0, 492, 340, 260, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 463, 0, 462, 0, 0, 0, 0, 0,
+	0, 466, 467, 468, 469, 470, 474, 475, 479, 480,
+	489, 488, 487, 490, 491, 494, 493, 495, 471, 472,
+	473, 476, 477, 478, 481, 482, 485, 483, 484, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 150, 0, 0, 0, 0, 210, 261, 163,
+	0, 0, 0, 222, 383, 0, 0, 0, 300, 0,
+	347, 212, 232, 167, 146, 161, 179, 211, 272, 458,
+	323, 0, 0, 0, 0, 0, 186, 0, 314, 281,
+	371, 0, 0, 288, 313, 236, 360, 0, 369, 370,
+	0, 486, 380, 385, 0, 0, 0, 337, 197, 0,
+	152, 0, 295, 192, 227, 0, 0, 0, 183, 0,
+	0, 0, 336, 358, 170, 354, 259, 265, 180, 182,
+	181, 162, 331, 357, 175, 184, 342, 319, 348, 191,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 376,
+	0, 326, 325, 0, 218, 0, 0, 0, 307, 492,
+	223, 151, 350, 388, 169, 229, 355, 0, 205, 271,
+	188, 282, 283, 201, 148, 302, 253, 254, 252, 255,
+	2213, 0, 372, 0, 454, 153, 351, 368, 176, 327,
+	329, 386, 312, 156, 366, 346, 257, 224, 225, 154,
+	0, 155, 310, 195, 209, 190, 275, 0, 208, 297,
+	363, 364, 187, 389, 164, 379, 158, 165, 378, 268,
+	0, 267, 381, 359, 367, 258, 244, 0, 157, 365,
+	256, 243, 230, 200, 214, 293, 239, 294, 215, 263,
+	262, 264, 241, 245, 0, 0, 0, 343, 374, 390,
+	173, 0, 330, 356, 0, 0, 174, 207, 199, 292,
+	266, 166, 217, 339, 228, 237, 309, 387, 279, 315,
+	177, 373, 338, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 486,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 328, 308, 466, 467, 468, 469, 470, 474, 475,
+	479, 480, 489, 488, 487, 490, 491, 494, 493, 495,
+	471, 472, 473, 476, 477, 478, 481, 482, 485, 483,
+	484, 0, 149, 159, 233, 0, 305, 204, 375, 0,
+	194, 0, 0, 0, 0, 0, 147, 160, 171, 178,
+	193, 198, 202, 0, 213, 216, 219, 220, 221, 226,
+	242, 247, 248, 249, 250, 269, 270, 273, 274, 277,
+	278, 285, 286, 287, 289, 290, 291, 296, 301, 303,
+	311, 317, 320, 321, 322, 324, 332, 333, 334, 335,
+	344, 349, 361, 362, 382, 384, 0, 0, 251, 298,
+	203, 189, 341, 318, 246, 316, 345, 280, 172, 299,
+	234, 168, 306, 206, 353, 377, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 276, 0, 0, 0, 0,
+	1510, 0, 0, 0, 0, 196, 0, 0, 0, 0,
+	231, 0, 0, 185, 240, 238, 0, 0, 0, 284,
+	352, 0, 0, 0, 0, 235, 0, 0, 492, 340,
+	260, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1512, 1514, 0, 0, 0, 0, 0, 0, 144,
+	0, 523, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 466, 467, 468, 469, 470, 474, 475, 479, 480,
+	489, 488, 487, 490, 491, 494, 493, 495, 471, 472,
+	473, 476, 477, 478, 481, 482, 485, 483, 484, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 150,
+	0, 0, 0, 0, 210, 261, 163, 0, 0, 0,
+	222, 383, 0, 1513, 0, 300, 0, 347, 212, 232,
+	167, 146, 161, 179, 211, 272, 0, 323, 0, 0,
+	0, 0, 0, 186, 0, 314, 281, 371, 0, 0,
+	288, 313, 236, 360, 0, 369, 370, 0, 486, 380,
+	385, 0, 0, 0, 337, 197, 0, 152, 0, 295,
+	192, 227, 0, 0, 0, 183, 0, 0, 0, 336,
+	358, 170, 354, 259, 265, 180, 182, 181, 162, 331,
+	357, 175, 184, 342, 319, 348, 191, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 376, 0, 326, 325,
+	0, 218, 0, 0, 0, 307, 0, 223, 151, 350,
+	388, 169, 229, 355, 0, 205, 271, 188, 282, 283,
+	201, 148, 302, 253, 254, 252, 255, 0, 0, 372,
+	0, 304, 153, 351, 368, 176, 327, 329, 386, 312,
+	156, 366, 346, 257, 224, 225, 154, 0, 155, 310,
+	195, 209, 190, 275, 0, 208, 297, 363, 364, 187,
+	389, 164, 379, 158, 165, 378, 268, 0, 267, 381,
+	359, 367, 258, 244, 0, 157, 365, 256, 243, 230,
+	200, 214, 293, 239, 294, 215, 263, 262, 264, 241,
+	245, 0, 0, 0, 343, 374, 390, 173, 0, 330,
+	356, 0, 0, 174, 207, 199, 292, 266, 166, 217,
+	339, 228, 237, 309, 387, 279, 315, 177, 373, 338,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 328, 308,
+	466, 467, 468, 469, 470, 474, 475, 479, 480, 489,
+	488, 487, 490, 491, 494, 493, 495, 471, 472, 473,
+	476, 477, 478, 481, 482, 485, 483, 484, 0, 149,
+	159, 233, 0, 305, 204, 375, 0, 194, 0, 0,
+	0, 0, 0, 147, 160, 171, 178, 193, 198, 202,
+	0, 213, 216, 219, 220, 221, 226, 242, 247, 248,
+	249, 250, 269, 270, 273, 274, 277, 278, 285, 286,
+	287, 289, 290, 291, 296, 301, 303, 311, 317, 320,
+	321, 322, 324, 332, 333, 334, 335, 344, 349, 361,
+	362, 382, 384, 0, 0, 251, 298, 203, 189, 341,
+	318, 246, 316, 345, 280, 172, 299, 234, 168, 306,
+	206, 353, 377, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 0, 0, 0, 1510, 0, 0,
+	0, 0, 196, 0, 0, 0, 0, 231, 0, 0,
+	185, 240, 238, 0, 0, 0, 284, 352, 0, 0,
+	0, 0, 235, 0, 0, 492, 340, 260, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1512, 1514,
+	0, 0, 0, 0, 0, 0, 144, 0, 523, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 150, 0, 0, 0,
+	0, 210, 261, 163, 0, 0, 0, 222, 383, 0,
+	1513, 0, 300, 0, 347, 212, 232, 167, 146, 161,
+	179, 211, 272, 0, 323, 0, 0, 0, 0, 0,
+	186, 0, 314, 281, 371, 0, 0, 1508, 313, 236,
+	360, 0, 369, 370, 0, 486, 380, 385, 0, 0,
+	0, 337, 197, 0, 152, 0, 295, 192, 227, 0,
+	0, 0, 183, 0, 0, 0, 336, 358, 170, 354,
+	259, 265, 180, 182, 181, 162, 331, 357, 175, 184,
+	342, 319, 348, 191, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 376, 0, 326, 325, 0, 218, 0,
+	0, 0, 307, 0, 223, 151, 350, 388, 169, 229,
+	355, 0, 205, 271, 188, 282, 283, 201, 148, 302,
+	253, 254, 252, 255, 0, 0, 372, 0, 304, 153,
+	351, 368, 176, 327, 329, 386, 312, 156, 366, 346,
+	257, 224, 225, 154, 0, 155, 310, 195, 209, 190,
+	275, 0, 208, 297, 363, 364, 187, 389, 164, 379,
+	158, 165, 378, 268, 0, 267, 381, 359, 367, 258,
+	244, 0, 157, 365, 256, 243, 230, 200, 214, 293,
+	239, 294, 215, 263, 262, 264, 241, 245, 0, 0,
+	0, 343, 374, 390, 173, 0, 330, 356, 0, 0,
+	174, 207, 199, 292, 266, 166, 217, 339, 228, 237,
+	309, 387, 279, 315, 177, 373, 338, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 328, 308, 466, 467, 468,
+	469, 470, 474, 475, 479, 480, 489, 488, 487, 490,
+	491, 494, 493, 495, 471, 472, 473, 476, 477, 478,
+	481, 482, 485, 483, 484, 0, 149, 159, 233, 0,
+	305, 204, 375, 0, 194, 0, 0, 0, 0, 0,
+	147, 160, 171, 178, 193, 198, 202, 0, 213, 216,
+	219, 220, 221, 226, 242, 247, 248, 249, 250, 269,
+	270, 273, 274, 277, 278, 285, 286, 287, 289, 290,
+	291, 296, 301, 303, 311, 317, 320, 321, 322, 324,
+	332, 333, 334, 335, 344, 349, 361, 362, 382, 384,
+	0, 0, 251, 298, 203, 189, 341, 318, 246, 316,
+	345, 280, 172, 299, 234, 168, 306, 206, 353, 377,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 276,
+	0, 0, 0, 0, 1010, 0, 0, 0, 0, 196,
+	0, 0, 0, 0, 231, 0, 0, 185, 240, 238,
+	0, 0, 0, 284, 352, 0, 0, 0, 0, 235,
+	0, 0, 492, 340, 260, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1011, 0, 1014, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1007, 1006,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1008, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 150, 0, 0, 0, 0, 210, 261,
+	163, 0, 0, 0, 222, 383, 0, 0, 0, 300,
+	0, 347, 212, 232, 167, 146, 161, 179, 211, 272,
+	0, 323, 0, 0, 0, 0, 0, 186, 0, 314,
+	281, 371, 0, 0, 288, 313, 236, 360, 0, 369,
+	370, 0, 486, 380, 385, 0, 0, 0, 337, 197,
+	0, 152, 0, 295, 192, 227, 0, 0, 0, 183,
+	0, 0, 0, 336, 358, 170, 354, 259, 265, 180,
+	182, 181, 162, 331, 357, 175, 184, 342, 319, 348,
+	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	376, 0, 326, 325, 0, 218, 0, 0, 0, 307,
+	0, 223, 151, 350, 388, 169, 229, 355, 0, 205,
+	271, 188, 282, 283, 201, 148, 302, 253, 254, 252,
+	255, 0, 0, 372, 0, 304, 153, 351, 368, 176,
+	327, 329, 386, 312, 156, 366, 346, 257, 224, 225,
+	154, 0, 155, 310, 195, 209, 190, 275, 0, 208,
+	297, 363, 364, 187, 389, 164, 379, 158, 165, 378,
+	268, 0, 267, 381, 359, 367, 258, 244, 0, 157,
+	365, 256, 243, 230, 200, 214, 293, 239, 294, 215,
+	263, 262, 264, 241, 245, 0, 0, 0, 343, 374,
+	390, 173, 0, 330, 356, 0, 0, 174, 207, 199,
+	292, 266, 166, 217, 339, 228, 237, 309, 387, 279,
+	315, 177, 373, 338, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 328, 308, 466, 467, 468, 469, 470, 474,
+	475, 479, 480, 489, 488, 487, 490, 491, 494, 493,
+	495, 471, 472, 473, 476, 477, 478, 481, 482, 485,
+	483, 484, 0, 149, 159, 233, 0, 305, 204, 375,
+	0, 194, 0, 0, 0, 0, 0, 147, 160, 171,
+	178, 193, 198, 202, 0, 213, 216, 219, 220, 221,
+	226, 242, 247, 248, 249, 250, 269, 270, 273, 274,
+	277, 278, 285, 286, 287, 289, 290, 291, 296, 301,
+	303, 311, 317, 320, 321, 322, 324, 332, 333, 334,
+	335, 344, 349, 361, 362, 382, 384, 0, 0, 251,
+	298, 203, 189, 341, 318, 246, 316, 345, 280, 172,
+	299, 234, 168, 306, 206, 353, 377, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 276, 0, 0, 2716,
+	0, 0, 0, 0, 0, 0, 196, 2714, 0, 0,
+	0, 231, 0, 0, 185, 240, 238, 0, 0, 0,
+	284, 352, 0, 0, 0, 0, 235, 0, 0, 492,
+	340, 260, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	463, 0, 462, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	150, 0, 0, 0, 0, 210, 261, 163, 0, 0,
+	0, 222, 383, 0, 0, 0, 300, 0, 347, 212,
+	232, 167, 146, 161, 179, 211, 272, 0, 323, 0,
+	0, 0, 0, 0, 186, 0, 314, 281, 371, 0,
+	0, 288, 313, 236, 360, 0, 369, 370, 0, 486,
+	380, 385, 0, 0, 0, 337, 197, 0, 152, 0,
+	295, 192, 227, 0, 0, 0, 183, 0, 0, 0,
+	336, 358, 170, 354, 259, 265, 180, 182, 181, 162,
+	331, 357, 175, 184, 342, 319, 348, 191, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 376, 0, 326,
+	325, 0, 218, 0, 2715, 0, 307, 0, 223, 151,
+	350, 388, 169, 229, 355, 0, 205, 271, 188, 282,
+	283, 201, 148, 302, 253, 254, 252, 255, 0, 0,
+	372, 0, 304, 153, 351, 368, 176, 327, 329, 386,
+	312, 156, 366, 346, 257, 224, 225, 154, 0, 155,
+	310, 195, 209, 190, 275, 0, 208, 297, 363, 364,
+	187, 389, 164, 379, 158, 165, 378, 268, 0, 267,
+	381, 359, 367, 258, 244, 0, 157, 365, 256, 243,
+	230, 200, 214, 293, 239, 294, 215, 263, 262, 264,
+	241, 245, 0, 0, 0, 343, 374, 390, 173, 0,
+	330, 356, 0, 0, 174, 207, 199, 292, 266, 166,
+	217, 339, 228, 237, 309, 387, 279, 315, 177, 373,
+	338, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	308, 466, 467, 468, 469, 470, 474, 475, 479, 480,
+	489, 488, 487, 490, 491, 494, 493, 495, 471, 472,
+	473, 476, 477, 478, 481, 482, 485, 483, 484, 0,
+	149, 159, 233, 0, 305, 204, 375, 0, 194, 0,
+	0, 0, 0, 0, 147, 160, 171, 178, 193, 198,
+	202, 0, 213, 216, 219, 220, 221, 226, 242, 247,
+	248, 249, 250, 269, 270, 273, 274, 277, 278, 285,
+	286, 287, 289, 290, 291, 296, 301, 303, 311, 317,
+	320, 321, 322, 324, 332, 333, 334, 335, 344, 349,
+	361, 362, 382, 384, 0, 0, 251, 298, 203, 189,
+	341, 318, 246, 316, 345, 280, 172, 299, 234, 168,
+	306, 206, 353, 377, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 276, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 196, 0, 0, 0, 0, 231, 0,
+	0, 185, 240, 238, 0, 0, 0, 284, 352, 0,
+	0, 0, 0, 235, 0, 0, 492, 340, 260, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 144, 0, 523,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 150, 0, 0,
+	0, 0, 210, 261, 163, 0, 0, 0, 222, 383,
+	0, 0, 0, 300, 0, 347, 212, 232, 167, 146,
+	161, 179, 211, 272, 0, 323, 0, 0, 0, 0,
+	0, 186, 0, 314, 281, 371, 0, 0, 288, 313,
+	236, 360, 0, 369, 370, 0, 486, 380, 385, 0,
+	0, 0, 337, 197, 0, 152, 0, 295, 192, 227,
+	0, 0, 0, 183, 0, 0, 0, 336, 358, 170,
+	354, 259, 265, 180, 182, 181, 162, 331, 357, 175,
+	184, 342, 319, 348, 191, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 376, 0, 326, 325, 0, 218,
+	0, 0, 0, 307, 0, 223, 151, 350, 388, 169,
+	229, 355, 0, 205, 271, 188, 282, 283, 201, 148,
+	302, 253, 254, 252, 255, 0, 0, 372, 0, 304,
+	153, 351, 368, 176, 327, 329, 386, 312, 156, 366,
+	346, 257, 224, 225, 154, 0, 155, 310, 195, 209,
+	190, 275, 0, 208, 297, 363, 364, 187, 389, 164,
+	379, 158, 165, 378, 268, 0, 267, 381, 359, 367,
+	258, 244, 0, 157, 365, 256, 243, 230, 200, 214,
+	293, 239, 294, 215, 263, 262, 264, 241, 245, 0,
+	0, 0, 343, 374, 390, 173, 0, 330, 356, 0,
+	0, 174, 207, 199, 292, 266, 166, 217, 339, 228,
+	237, 309, 387, 279, 315, 177, 373, 338, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 328, 308, 466, 467,
+	468, 469, 470, 474, 475, 479, 480, 489, 488, 487,
+	490, 491, 494, 493, 495, 471, 472, 473, 476, 477,
+	478, 481, 482, 485, 483, 484, 0, 149, 159, 233,
+	0, 305, 204, 375, 0, 194, 0, 0, 0, 0,
+	518, 147, 160, 171, 178, 193, 198, 202, 0, 213,
+	216, 219, 220, 221, 226, 242, 247, 248, 249, 250,
+	269, 270, 273, 274, 277, 278, 285, 286, 287, 289,
+	290, 291, 296, 301, 303, 311, 317, 320, 321, 322,
+	324, 332, 333, 334, 335, 344, 349, 361, 362, 382,
+	384, 0, 0, 251, 298, 203, 189, 341, 318, 246,
+	316, 345, 280, 172, 299, 234, 168, 306, 206, 353,
+	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	196, 0, 0, 0, 0, 231, 1835, 0, 185, 240,
+	238, 0, 0, 0, 284, 352, 0, 0, 0, 0,
+	235, 0, 0, 492, 340, 260, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 144, 0, 523, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 150, 0, 0, 0, 0, 210,
+	261, 163, 0, 0, 0, 222, 383, 0, 0, 0,
+	300, 0, 347, 212, 232, 167, 146, 161, 179, 211,
+	272, 0, 323, 0, 0, 0, 0, 0, 186, 0,
+	314, 281, 371, 0, 0, 288, 313, 236, 360, 0,
+	369, 370, 0, 486, 380, 385, 0, 0, 0, 337,
+	197, 0, 152, 0, 295, 192, 227, 0, 0, 0,
+	183, 0, 0, 0, 336, 358, 170, 354, 259, 265,
+	180, 182, 181, 162, 331, 357, 175, 184, 342, 319,
+	348, 191, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 376, 0, 326, 325, 0, 218, 0, 0, 0,
+	307, 0, 223, 151, 350, 388, 169, 229, 355, 0,
+	205, 271, 188, 282, 283, 201, 148, 302, 253, 254,
+	252, 255, 0, 0, 372, 0, 304, 153, 351, 368,
+	176, 327, 329, 386, 312, 156, 366, 346, 257, 224,
+	225, 154, 0, 155, 310, 195, 209, 190, 275, 0,
+	208, 297, 363, 364, 187, 389, 164, 379, 158, 165,
+	378, 268, 0, 267, 381, 359, 367, 258, 244, 0,
+	157, 365, 256, 243, 230, 200, 214, 293, 239, 294,
+	215, 263, 262, 264, 241, 245, 0, 0, 0, 343,
+	374, 390, 173, 0, 330, 356, 0, 0, 174, 207,
+	199, 292, 266, 166, 217, 339, 228, 237, 309, 387,
+	279, 315, 177, 373, 338, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 328, 308, 466, 467, 468, 469, 470,
+	474, 475, 479, 480, 489, 488, 487, 490, 491, 494,
+	493, 495, 471, 472, 473, 476, 477, 478, 481, 482,
+	485, 483, 484, 0, 149, 159, 233, 0, 305, 204,
+	375, 0, 194, 0, 0, 0, 0, 0, 147, 160,
+	171, 178, 193, 198, 202, 0, 213, 216, 219, 220,
+	221, 226, 242, 247, 248, 249, 250, 269, 270, 273,
+	274, 277, 278, 285, 286, 287, 289, 290, 291, 296,
+	301, 303, 311, 317, 320, 321, 322, 324, 332, 333,
+	334, 335, 344, 349, 361, 362, 382, 384, 0, 0,
+	251, 298, 203, 189, 341, 318, 246, 316, 345, 280,
+	172, 299, 234, 168, 306, 206, 353, 377, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 276, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 196, 0, 0,
+	0, 0, 231, 0, 0, 185, 240, 238, 0, 0,
+	0, 284, 352, 0, 0, 0, 0, 235, 0, 0,
+	492, 340, 260, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 463, 0, 462, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 150, 0, 0, 0, 0, 210, 261, 163, 0,
+	0, 0, 222, 383, 0, 0, 0, 300, 0, 347,
+	212, 232, 167, 146, 161, 179, 211, 272, 0, 323,
+	0, 0, 0, 0, 0, 186, 0, 314, 281, 371,
+	0, 0, 288, 313, 236, 360, 0, 369, 370, 0,
+	486, 380, 385, 0, 0, 0, 337, 197, 0, 152,
+	0, 295, 192, 227, 0, 0, 0, 183, 0, 0,
+	0, 336, 358, 170, 354, 259, 265, 180, 182, 181,
+	162, 331, 357, 175, 184, 342, 319, 348, 191, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 376, 0,
+	326, 325, 0, 218, 0, 0, 0, 307, 0, 223,
+	151, 350, 388, 169, 229, 355, 0, 205, 271, 188,
+	282, 283, 201, 148, 302, 253, 254, 252, 255, 0,
+	0, 372, 0, 304, 153, 351, 368, 176, 327, 329,
+	386, 312, 156, 366, 346, 257, 224, 225, 154, 0,
+	155, 310, 195, 209, 190, 275, 0, 208, 297, 363,
+	364, 187, 389, 164, 379, 158, 165, 378, 268, 0,
+	267, 381, 359, 367, 258, 244, 0, 157, 365, 256,
+	243, 230, 200, 214, 293, 239, 294, 215, 263, 262,
+	264, 241, 245, 0, 0, 0, 343, 374, 390, 173,
+	0, 330, 356, 0, 0, 174, 207, 199, 292, 266,
+	166, 217, 339, 228, 237, 309, 387, 279, 315, 177,
+	373, 338, 0, 0, 0, 1292, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	328, 308, 466, 467, 468, 469, 470, 474, 475, 479,
+	480, 489, 488, 487, 490, 491, 494, 493, 495, 471,
+	472, 473, 476, 477, 478, 481, 482, 485, 483, 484,
+	0, 149, 159, 233, 0, 305, 204, 375, 0, 194,
+	0, 0, 0, 0, 0, 147, 160, 171, 178, 193,
+	198, 202, 0, 213, 216, 219, 220, 221, 226, 242,
+	247, 248, 249, 250, 269, 270, 273, 274, 277, 278,
+	285, 286, 287, 289, 290, 291, 296, 301, 303, 311,
+	317, 320, 321, 322, 324, 332, 333, 334, 335, 344,
+	349, 361, 362, 382, 384, 0, 0, 251, 298, 203,
+	189, 341, 318, 246, 316, 345, 280, 172, 299, 234,
+	168, 306, 206, 353, 377, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 276, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 196, 0, 0, 0, 0, 231,
+	0, 0, 185, 240, 238, 0, 0, 0, 284, 352,
+	0, 0, 0, 0, 235, 0, 0, 492, 340, 260,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 463, 0,
+	462, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 150, 0,
+	0, 0, 0, 210, 261, 163, 0, 0, 0, 222,
+	383, 0, 0, 0, 300, 0, 347, 212, 232, 167,
+	146, 161, 179, 211, 272, 0, 323, 0, 0, 0,
+	0, 0, 186, 0, 314, 281, 371, 0, 0, 288,
+	313, 236, 360, 0, 369, 370, 0, 486, 380, 385,
+	0, 0, 0, 337, 197, 0, 152, 0, 295, 192,
+	227, 0, 0, 0, 183, 0, 0, 0, 336, 358,
+	170, 354, 259, 265, 180, 182, 181, 162, 331, 357,
+	175, 184, 342, 319, 348, 191, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 376, 0, 326, 325, 0,
+	218, 0, 0, 0, 307, 0, 223, 151, 350, 388,
+	169, 229, 355, 0, 205, 271, 188, 282, 283, 201,
+	148, 302, 253, 254, 252, 255, 0, 0, 372, 0,
+	304, 153, 351, 368, 176, 327, 329, 386, 312, 156,
+	366, 346, 257, 224, 225, 154, 0, 155, 310, 195,
+	209, 190, 275, 0, 208, 297, 363, 364, 187, 389,
+	164, 379, 158, 165, 378, 268, 0, 267, 381, 359,
+	367, 258, 244, 0, 157, 365, 256, 243, 230, 200,
+	214, 293, 239, 294, 215, 263, 262, 264, 241, 245,
+	0, 0, 0, 343, 374, 390, 173, 0, 330, 356,
+	0, 0, 174, 207, 199, 292, 266, 166, 217, 339,
+	228, 237, 309, 387, 279, 315, 177, 373, 338, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 328, 308, 466,
+	467, 468, 469, 470, 474, 475, 479, 480, 489, 488,
+	487, 490, 491, 494, 493, 495, 471, 472, 473, 476,
+	477, 478, 481, 482, 485, 483, 484, 0, 149, 159,
+	233, 0, 305, 204, 375, 0, 194, 0, 0, 0,
+	0, 0, 147, 160, 171, 178, 193, 198, 202, 0,
+	213, 216, 219, 220, 221, 226, 242, 247, 248, 249,
+	250, 269, 270, 273, 274, 277, 278, 285, 286, 287,
+	289, 290, 291, 296, 301, 303, 311, 317, 320, 321,
+	322, 324, 332, 333, 334, 335, 344, 349, 361, 362,
+	382, 384, 0, 0, 251, 298, 203, 189, 341, 318,
+	246, 316, 345, 280, 172, 299, 234, 168, 306, 206,
+	353, 377, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 276, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 196, 0, 0, 0, 0, 231, 0, 0, 185,
+	240, 238, 0, 0, 0, 284, 352, 0, 0, 0,
+	0, 235, 0, 0, 492, 340, 260, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 144, 0, 523, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 150, 0, 0, 0, 0,
+	210, 261, 163, 0, 0, 0, 222, 383, 0, 0,
+	0, 300, 0, 347, 212, 232, 167, 146, 161, 179,
+	211, 272, 0, 323, 0, 0, 0, 0, 0, 186,
+	0, 314, 281, 371, 0, 0, 288, 313, 236, 360,
+	0, 369, 370, 0, 486, 380, 385, 0, 0, 0,
+	337, 197, 0, 152, 0, 295, 192, 227, 0, 0,
+	0, 183, 0, 0, 0, 336, 358, 170, 354, 259,
+	265, 180, 182, 181, 162, 331, 357, 175, 184, 342,
+	319, 348, 191, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 376, 0, 326, 325, 0, 218, 0, 0,
+	0, 307, 0, 223, 151, 350, 388, 169, 229, 355,
+	0, 205, 271, 188, 282, 283, 201, 148, 302, 253,
+	254, 252, 255, 0, 0, 372, 0, 304, 153, 351,
+	368, 176, 327, 329, 386, 312, 156, 366, 346, 257,
+	224, 225, 154, 0, 155, 310, 195, 209, 190, 275,
+	0, 208, 297, 363, 364, 187, 389, 164, 379, 158,
+	165, 378, 268, 0, 267, 381, 359, 367, 258, 244,
+	0, 157, 365, 256, 243, 230, 200, 214, 293, 239,
+	294, 215, 263, 262, 264, 241, 245, 0, 0, 0,
+	343, 374, 390, 173, 0, 330, 356, 0, 0, 174,
+	207, 199, 292, 266, 166, 217, 339, 228, 237, 309,
+	387, 279, 315, 177, 373, 338, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 328, 308, 466, 467, 468, 469,
+	470, 474, 475, 479, 480, 489, 488, 487, 490, 491,
+	494, 493, 495, 471, 472, 473, 476, 477, 478, 481,
+	482, 485, 483, 484, 0, 149, 159, 233, 0, 305,
+	204, 375, 0, 194, 0, 0, 0, 0, 0, 147,
+	160, 171, 178, 193, 198, 202, 0, 213, 216, 219,
+	220, 221, 226, 242, 247, 248, 249, 250, 269, 270,
+	273, 274, 277, 278, 285, 286, 287, 289, 290, 291,
+	296, 301, 303, 311, 317, 320, 321, 322, 324, 332,
+	333, 334, 335, 344, 349, 361, 362, 382, 384, 0,
+	0, 251, 298, 203, 189, 341, 318, 246, 316, 345,
+	280, 172, 299, 234, 168, 306, 206, 353, 377, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 276, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 196, 0,
+	0, 0, 0, 231, 0, 0, 185, 240, 238, 0,
+	0, 0, 284, 352, 0, 0, 0, 0, 235, 0,
+	0, 492, 340, 260, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1011, 0, 1014, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 150, 0, 0, 0, 0, 210, 261, 163,
+	0, 0, 0, 222, 383, 0, 0, 0, 300, 0,
+	347, 212, 232, 167, 146, 161, 179, 211, 272, 0,
+	323, 0, 0, 0, 0, 0, 186, 0, 314, 281,
+	371, 0, 0, 288, 313, 236, 360, 0, 369, 370,
+	0, 486, 380, 385, 0, 0, 0, 337, 197, 0,
+	152, 0, 295, 192, 227, 0, 0, 0, 183, 0,
+	0, 0, 336, 358, 170, 354, 259, 265, 180, 182,
+	181, 162, 331, 357, 175, 184, 342, 319, 348, 191,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 376,
+	0, 326, 325, 0, 218, 0, 0, 0, 307, 0,
+	223, 151, 350, 388, 169, 229, 355, 0, 205, 271,
+	188, 282, 283, 201, 148, 302, 253, 254, 252, 255,
+	0, 0, 372, 0, 304, 153, 351, 368, 176, 327,
+	329, 386, 312, 156, 366, 346, 257, 224, 225, 154,
+	0, 155, 310, 195, 209, 190, 275, 0, 208, 297,
+	363, 364, 187, 389, 164, 379, 158, 165, 378, 268,
+	0, 267, 381, 359, 367, 258, 244, 0, 157, 365,
+	256, 243, 230, 200, 214, 293, 239, 294, 215, 263,
+	262, 264, 241, 245, 0, 0, 0, 343, 374, 390,
+	173, 0, 330, 356, 0, 0, 174, 207, 199, 292,
+	266, 166, 217, 339, 228, 237, 309, 387, 279, 315,
+	177, 373, 338, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 328, 308, 466, 467, 468, 469, 470, 474, 475,
+	479, 480, 489, 488, 487, 490, 491, 494, 493, 495,
+	471, 472, 473, 476, 477, 478, 481, 482, 485, 483,
+	484, 0, 149, 159, 233, 0, 305, 204, 375, 0,
+	194, 0, 0, 0, 0, 0, 147, 160, 171, 178,
+	193, 198, 202, 0, 213, 216, 219, 220, 221, 226,
+	242, 247, 248, 249, 250, 269, 270, 273, 274, 277,
+	278, 285, 286, 287, 289, 290, 291, 296, 301, 303,
+	311, 317, 320, 321, 322, 324, 332, 333, 334, 335,
+	344, 349, 361, 362, 382, 384, 0, 0, 251, 298,
+	203, 189, 341, 318, 246, 316, 345, 280, 172, 299,
+	234, 168, 306, 206, 353, 377, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 276, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 196, 0, 0, 0, 0,
+	231, 0, 0, 185, 240, 238, 0, 0, 0, 284,
+	352, 0, 0, 0, 0, 235, 0, 0, 492, 340,
+	260, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 501,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 150,
+	0, 0, 0, 0, 210, 261, 163, 0, 0, 0,
+	222, 383, 0, 0, 0, 300, 0, 347, 212, 232,
+	167, 146, 161, 179, 211, 272, 0, 323, 0, 0,
+	0, 0, 0, 186, 0, 314, 281, 371, 0, 0,
+	288, 313, 236, 360, 0, 369, 370, 0, 486, 380,
+	385, 0, 0, 0, 337, 197, 0, 152, 0, 295,
+	192, 227, 0, 0, 0, 183, 0, 0, 0, 336,
+	358, 170, 354, 259, 265, 180, 182, 181, 162, 331,
+	357, 175, 184, 342, 319, 348, 191, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 376, 0, 326, 325,
+	0, 218, 0, 0, 0, 307, 0, 223, 151, 350,
+	388, 169, 229, 355, 0, 205, 271, 188, 282, 283,
+	201, 148, 302, 253, 254, 252, 255, 0, 0, 372,
+	0, 304, 153, 351, 368, 176, 327, 329, 386, 312,
+	156, 366, 346, 257, 224, 225, 154, 0, 155, 310,
+	195, 209, 190, 275, 0, 208, 297, 363, 364, 187,
+	389, 164, 379, 158, 165, 378, 268, 0, 267, 381,
+	359, 367, 258, 244, 0, 157, 365, 256, 243, 230,
+	200, 214, 293, 239, 294, 215, 263, 262, 264, 241,
+	245, 0, 0, 0, 343, 374, 390, 173, 0, 330,
+	356, 0, 0, 174, 207, 199, 292, 266, 166, 217,
+	339, 228, 237, 309, 387, 279, 315, 177, 373, 338,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 328, 308,
+	466, 467, 468, 469, 470, 474, 475, 479, 480, 489,
+	488, 487, 490, 491, 494, 493, 495, 471, 472, 473,
+	476, 477, 478, 481, 482, 485, 483, 484, 0, 149,
+	159, 233, 0, 305, 204, 375, 0, 194, 0, 0,
+	0, 0, 0, 147, 160, 171, 178, 193, 198, 202,
+	0, 213, 216, 219, 220, 221, 226, 242, 247, 248,
+	249, 250, 269, 270, 273, 274, 277, 278, 285, 286,
+	287, 289, 290, 291, 296, 301, 303, 311, 317, 320,
+	321, 322, 324, 332, 333, 334, 335, 344, 349, 361,
+	362, 382, 384, 0, 0, 251, 298, 203, 189, 341,
+	318, 246, 316, 345, 280, 172, 299, 234, 168, 306,
+	206, 353, 377, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 196, 0, 0, 0, 0, 231, 0, 0,
+	185, 240, 238, 0, 0, 0, 284, 352, 0, 0,
+	0, 0, 235, 0, 0, 0, 340, 260, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 501, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1024, 1023, 1033, 1034, 1026, 1027, 1028,
+	1029, 1030, 1031, 1032, 1025, 0, 0, 1035, 0, 0,
+	0, 0, 0, 0, 0, 0, 150, 0, 0, 0,
+	0, 210, 261, 163, 0, 0, 0, 222, 383, 0,
+	0, 0, 300, 0, 347, 212, 232, 167, 146, 161,
+	179, 211, 272, 0, 323, 0, 0, 0, 0, 0,
+	186, 0, 314, 281, 371, 0, 0, 288, 313, 236,
+	360, 0, 369, 370, 0, 0, 380, 385, 0, 0,
+	0, 337, 197, 0, 152, 0, 295, 192, 227, 0,
+	0, 0, 183, 0, 0, 0, 336, 358, 170, 354,
+	259, 265, 180, 182, 181, 162, 331, 357, 175, 184,
+	342, 319, 348, 191, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 376, 0, 326, 325, 0, 218, 0,
+	0, 0, 307, 0, 223, 151, 350, 388, 169, 229,
+	355, 0, 205, 271, 188, 282, 283, 201, 148, 302,
+	253, 254, 252, 255, 0, 0, 372, 0, 304, 153,
+	351, 368, 176, 327, 329, 386, 312, 156, 366, 346,
+	257, 224, 225, 154, 0, 155, 310, 195, 209, 190,
+	275, 0, 208, 297, 363, 364, 187, 389, 164, 379,
+	158, 165, 378, 268, 0, 267, 381, 359, 367, 258,
+	244, 0, 157, 365, 256, 243, 230, 200, 214, 293,
+	239, 294, 215, 263, 262, 264, 241, 245, 0, 0,
+	0, 343, 374, 390, 173, 0, 330, 356, 0, 0,
+	174, 207, 199, 292, 266, 166, 217, 339, 228, 237,
+	309, 387, 279, 315, 177, 373, 338, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 328, 308, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 149, 159, 233, 0,
+	305, 204, 375, 0, 194, 0, 0, 0, 0, 0,
+	147, 160, 171, 178, 193, 198, 202, 0, 213, 216,
+	219, 220, 221, 226, 242, 247, 248, 249, 250, 269,
+	270, 273, 274, 277, 278, 285, 286, 287, 289, 290,
+	291, 296, 301, 303, 311, 317, 320, 321, 322, 324,
+	332, 333, 334, 335, 344, 349, 361, 362, 382, 384,
+	0, 0, 251, 298, 203, 189, 341, 318, 246, 316,
+	345, 280, 172, 299, 234, 168, 306, 206, 0, 377,
+	43, 353, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 196, 0, 0, 0, 0, 231, 0, 0,
+	185, 240, 238, 0, 0, 0, 284, 352, 0, 0,
+	0, 1505, 235, 0, 0, 0, 340, 260, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 0, 0, 0, 144, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 150, 0, 0, 0,
+	0, 210, 261, 163, 0, 0, 0, 222, 383, 0,
+	0, 0, 300, 0, 347, 212, 232, 167, 146, 161,
+	179, 211, 272, 0, 323, 0, 0, 0, 0, 0,
+	186, 0, 314, 281, 371, 0, 0, 288, 313, 236,
+	360, 0, 369, 370, 0, 0, 380, 385, 0, 0,
+	0, 337, 197, 0, 152, 0, 295, 192, 227, 0,
+	0, 0, 183, 0, 0, 0, 336, 358, 170, 354,
+	259, 265, 180, 182, 181, 162, 331, 357, 175, 184,
+	342, 319, 348, 191, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 376, 0, 326, 325, 0, 218, 0,
+	0, 0, 307, 0, 223, 151, 350, 388, 169, 229,
+	355, 0, 205, 271, 188, 282, 283, 201, 148, 302,
+	253, 254, 252, 255, 0, 0, 372, 0, 304, 153,
+	351, 368, 176, 327, 329, 386, 312, 156, 366, 346,
+	257, 224, 225, 154, 0, 155, 310, 195, 209, 190,
+	275, 0, 208, 297, 363, 364, 187, 389, 164, 379,
+	158, 165, 378, 268, 0, 267, 381, 359, 367, 258,
+	244, 0, 157, 365, 256, 243, 230, 200, 214, 293,
+	239, 294, 215, 263, 262, 264, 241, 245, 0, 0,
+	0, 343, 374, 390, 173, 0, 330, 356, 0, 0,
+	174, 207, 199, 292, 266, 166, 217, 339, 228, 237,
+	309, 387, 279, 315, 177, 373, 338, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 328, 308, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 149, 159, 233, 96,
+	305, 204, 375, 0, 194, 0, 0, 0, 0, 0,
+	147, 160, 171, 178, 193, 198, 202, 0, 213, 216,
+	219, 220, 221, 226, 242, 247, 248, 249, 250, 269,
+	270, 273, 274, 277, 278, 285, 286, 287, 289, 290,
+	291, 296, 301, 303, 311, 317, 320, 321, 322, 324,
+	332, 333, 334, 335, 344, 349, 361, 362, 382, 384,
+	0, 0, 251, 298, 203, 189, 341, 318, 246, 316,
+	345, 280, 172, 299, 234, 168, 306, 206, 353, 377,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 276,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 196,
+	0, 0, 0, 0, 231, 0, 0, 185, 240, 238,
+	0, 0, 0, 284, 352, 0, 0, 0, 0, 235,
+	0, 1342, 0, 340, 260, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 501, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 150, 0, 0, 0, 0, 210, 261,
+	163, 0, 0, 0, 222, 383, 0, 0, 0, 300,
+	0, 347, 212, 232, 167, 146, 161, 179, 211, 272,
+	0, 323, 1341, 0, 0, 0, 0, 186, 0, 314,
+	281, 371, 0, 0, 288, 313, 236, 360, 0, 369,
+	370, 0, 0, 380, 385, 0, 0, 0, 337, 197,
+	0, 152, 0, 295, 192, 227, 0, 0, 0, 183,
+	0, 0, 0, 336, 358, 170, 354, 259, 265, 180,
+	182, 181, 162, 331, 357, 175, 184, 342, 319, 348,
+	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	376, 0, 326, 325, 0, 218, 0, 0, 0, 307,
+	0, 223, 151, 350, 388, 169, 229, 355, 0, 205,
+	271, 188, 282, 283, 201, 148, 302, 253, 254, 252,
+	255, 0, 0, 372, 0, 304, 153, 351, 368, 176,
+	327, 329, 386, 312, 156, 366, 346, 257, 224, 225,
+	154, 0, 155, 310, 195, 209, 190, 275, 0, 208,
+	297, 363, 364, 187, 389, 164, 379, 158, 165, 378,
+	268, 0, 267, 381, 359, 367, 258, 244, 0, 157,
+	365, 256, 243, 230, 200, 214, 293, 239, 294, 215,
+	263, 262, 264, 241, 245, 0, 0, 0, 343, 374,
+	390, 173, 0, 330, 356, 0, 0, 174, 207, 199,
+	292, 266, 166, 217, 339, 228, 237, 309, 387, 279,
+	315, 177, 373, 338, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 328, 308, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 149, 159, 233, 0, 305, 204, 375,
+	0, 194, 0, 0, 0, 0, 0, 147, 160, 171,
+	178, 193, 198, 202, 0, 213, 216, 219, 220, 221,
+	226, 242, 247, 248, 249, 250, 269, 270, 273, 274,
+	277, 278, 285, 286, 287, 289, 290, 291, 296, 301,
+	303, 311, 317, 320, 321, 322, 324, 332, 333, 334,
+	335, 344, 349, 361, 362, 382, 384, 0, 0, 251,
+	298, 203, 189, 341, 318, 246, 316, 345, 280, 172,
+	299, 234, 168, 306, 206, 353, 377, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 276, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 196, 1160, 0, 0,
+	0, 231, 0, 0, 185, 240, 238, 0, 0, 0,
+	284, 352, 0, 0, 0, 0, 235, 0, 0, 0,
+	340, 260, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	501, 0, 1159, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	150, 0, 0, 0, 0, 210, 261, 163, 0, 0,
+	0, 222, 383, 0, 0, 0, 300, 0, 347, 212,
+	232, 167, 146, 161, 179, 211, 272, 0, 323, 0,
+	0, 0, 0, 0, 186, 0, 314, 281, 371, 0,
+	0, 288, 313, 236, 360, 0, 369, 370, 0, 0,
+	380, 385, 0, 0, 0, 337, 197, 0, 152, 0,
+	295, 192, 227, 0, 0, 0, 183, 0, 0, 0,
+	336, 358, 170, 354, 259, 265, 180, 182, 181, 162,
+	331, 357, 175, 184, 342, 319, 348, 191, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 376, 0, 326,
+	325, 0, 218, 0, 0, 0, 307, 0, 223, 151,
+	350, 388, 169, 229, 355, 0, 205, 271, 188, 282,
+	283, 201, 148, 302, 253, 254, 252, 255, 0, 0,
+	372, 0, 304, 153, 351, 368, 176, 327, 329, 386,
+	312, 156, 366, 346, 257, 224, 225, 154, 0, 155,
+	310, 195, 209, 190, 275, 0, 208, 297, 363, 364,
+	187, 389, 164, 379, 158, 165, 378, 268, 0, 267,
+	381, 359, 367, 258, 244, 0, 157, 365, 256, 243,
+	230, 200, 214, 293, 239, 294, 215, 263, 262, 264,
+	241, 245, 0, 0, 0, 343, 374, 390, 173, 0,
+	330, 356, 0, 0, 174, 207, 199, 292, 266, 166,
+	217, 339, 228, 237, 309, 387, 279, 315, 177, 373,
+	338, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	308, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	149, 159, 233, 0, 305, 204, 375, 0, 194, 0,
+	0, 0, 0, 0, 147, 160, 171, 178, 193, 198,
+	202, 0, 213, 216, 219, 220, 221, 226, 242, 247,
+	248, 249, 250, 269, 270, 273, 274, 277, 278, 285,
+	286, 287, 289, 290, 291, 296, 301, 303, 311, 317,
+	320, 321, 322, 324, 332, 333, 334, 335, 344, 349,
+	361, 362, 382, 384, 0, 0, 251, 298, 203, 189,
+	341, 318, 246, 316, 345, 280, 172, 299, 234, 168,
+	306, 206, 353, 377, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 276, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 196, 0, 0, 0, 0, 231, 0,
+	0, 185, 240, 238, 0, 0, 0, 284, 352, 0,
+	0, 0, 0, 235, 0, 0, 0, 340, 260, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 98, 0, 0, 0, 144, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 150, 0, 0,
+	0, 0, 210, 261, 163, 0, 0, 0, 222, 383,
+	0, 0, 0, 300, 0, 347, 212, 232, 167, 146,
+	161, 179, 211, 272, 0, 323, 0, 0, 0, 0,
+	0, 186, 0, 314, 281, 371, 0, 0, 288, 313,
+	236, 360, 0, 369, 370, 0, 0, 380, 385, 0,
+	0, 0, 337, 197, 0, 152, 0, 295, 192, 227,
+	0, 0, 0, 183, 0, 0, 0, 336, 358, 170,
+	354, 259, 265, 180, 182, 181, 162, 331, 357, 175,
+	184, 342, 319, 348, 191, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 376, 0, 326, 325, 0, 218,
+	0, 0, 0, 307, 0, 223, 151, 350, 388, 169,
+	229, 355, 0, 205, 271, 188, 282, 283, 201, 148,
+	302, 253, 254, 252, 255, 0, 0, 372, 0, 304,
+	153, 351, 368, 176, 327, 329, 386, 312, 156, 366,
+	346, 257, 224, 225, 154, 0, 155, 310, 195, 209,
+	190, 275, 0, 208, 297, 363, 364, 187, 389, 164,
+	379, 158, 165, 378, 268, 0, 267, 381, 359, 367,
+	258, 244, 0, 157, 365, 256, 243, 230, 200, 214,
+	293, 239, 294, 215, 263, 262, 264, 241, 245, 0,
+	0, 0, 343, 374, 390, 173, 0, 330, 356, 0,
+	0, 174, 207, 199, 292, 266, 166, 217, 339, 228,
+	237, 309, 387, 279, 315, 177, 373, 338, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 328, 308, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 149, 159, 233,
+	0, 305, 204, 375, 0, 194, 0, 0, 0, 0,
+	0, 147, 160, 171, 178, 193, 198, 202, 0, 213,
+	216, 219, 220, 221, 226, 242, 247, 248, 249, 250,
+	269, 270, 273, 274, 277, 278, 285, 286, 287, 289,
+	290, 291, 296, 301, 303, 311, 317, 320, 321, 322,
+	324, 332, 333, 334, 335, 344, 349, 361, 362, 382,
+	384, 0, 0, 251, 298, 203, 189, 341, 318, 246,
+	316, 345, 280, 172, 299, 234, 168, 306, 206, 353,
+	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	196, 0, 0, 0, 0, 231, 0, 0, 185, 240,
+	238, 0, 0, 0, 284, 352, 0, 0, 0, 0,
+	235, 0, 0, 0, 340, 260, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 501, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 2188, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 150, 0, 0, 0, 0, 210,
+	261, 163, 0, 0, 0, 222, 383, 0, 0, 0,
+	300, 0, 347, 212, 232, 167, 146, 161, 179, 211,
+	272, 0, 323, 0, 0, 0, 0, 0, 186, 0,
+	314, 281, 371, 0, 0, 288, 313, 236, 360, 0,
+	369, 370, 0, 0, 380, 385, 0, 0, 0, 337,
+	197, 0, 152, 0, 295, 192, 227, 0, 0, 0,
+	183, 0, 0, 0, 336, 358, 170, 354, 259, 265,
+	180, 182, 181, 162, 331, 357, 175, 184, 342, 319,
+	348, 191, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 376, 0, 326, 325, 0, 218, 0, 0, 0,
+	307, 0, 223, 151, 350, 388, 169, 229, 355, 0,
+	205, 271, 188, 282, 283, 201, 148, 302, 253, 254,
+	252, 255, 0, 0, 372, 0, 304, 153, 351, 368,
+	176, 327, 329, 386, 312, 156, 366, 346, 257, 224,
+	225, 154, 0, 155, 310, 195, 209, 190, 275, 0,
+	208, 297, 363, 364, 187, 389, 164, 379, 158, 165,
+	378, 268, 0, 267, 381, 359, 367, 258, 244, 0,
+	157, 365, 256, 243, 230, 200, 214, 293, 239, 294,
+	215, 263, 262, 264, 241, 245, 0, 0, 0, 343,
+	374, 390, 173, 0, 330, 356, 0, 0, 174, 207,
+	199, 292, 266, 166, 217, 339, 228, 237, 309, 387,
+	279, 315, 177, 373, 338, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 328, 308, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 149, 159, 233, 0, 305, 204,
+	375, 0, 194, 0, 0, 0, 0, 0, 147, 160,
+	171, 178, 193, 198, 202, 0, 213, 216, 219, 220,
+	221, 226, 242, 247, 248, 249, 250, 269, 270, 273,
+	274, 277, 278, 285, 286, 287, 289, 290, 291, 296,
+	301, 303, 311, 317, 320, 321, 322, 324, 332, 333,
+	334, 335, 344, 349, 361, 362, 382, 384, 0, 0,
+	251, 298, 203, 189, 341, 318, 246, 316, 345, 280,
+	172, 299, 234, 168, 306, 206, 353, 377, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 276, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 196, 0, 0,
+	0, 0, 231, 0, 0, 185, 240, 238, 0, 0,
+	0, 284, 352, 0, 0, 0, 0, 235, 0, 0,
+	0, 340, 260, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
+	0, 501, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 150, 0, 0, 0, 0, 210, 261, 163, 0,
+	0, 0, 222, 383, 0, 0, 0, 300, 0, 347,
+	212, 232, 167, 146, 161, 179, 211, 272, 0, 323,
+	0, 0, 0, 0, 0, 186, 0, 314, 281, 371,
+	0, 0, 288, 313, 236, 360, 0, 369, 370, 0,
+	0, 380, 385, 0, 0, 0, 337, 197, 0, 152,
+	0, 295, 192, 227, 0, 0, 0, 183, 0, 0,
+	0, 336, 358, 170, 354, 259, 265, 180, 182, 181,
+	162, 331, 357, 175, 184, 342, 319, 348, 191, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 376, 0,
+	326, 325, 0, 218, 0, 0, 0, 307, 0, 223,
+	151, 350, 388, 169, 229, 355, 0, 205, 271, 188,
+	282, 283, 201, 148, 302, 253, 254, 252, 255, 0,
+	0, 372, 0, 304, 153, 351, 368, 176, 327, 329,
+	386, 312, 156, 366, 346, 257, 224, 225, 154, 0,
+	155, 310, 195, 209, 190, 275, 0, 208, 297, 363,
+	364, 187, 389, 164, 379, 158, 165, 378, 268, 0,
+	267, 381, 359, 367, 258, 244, 0, 157, 365, 256,
+	243, 230, 200, 214, 293, 239, 294, 215, 263, 262,
+	264, 241, 245, 0, 0, 0, 343, 374, 390, 173,
+	0, 330, 356, 0, 0, 174, 207, 199, 292, 266,
+	166, 217, 339, 228, 237, 309, 387, 279, 315, 177,
+	373, 338, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	328, 308, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 149, 159, 233, 0, 305, 204, 375, 0, 194,
+	0, 0, 0, 0, 0, 147, 160, 171, 178, 193,
+	198, 202, 0, 213, 216, 219, 220, 221, 226, 242,
+	247, 248, 249, 250, 269, 270, 273, 274, 277, 278,
+	285, 286, 287, 289, 290, 291, 296, 301, 303, 311,
+	317, 320, 321, 322, 324, 332, 333, 334, 335, 344,
+	349, 361, 362, 382, 384, 0, 0, 251, 298, 203,
+	189, 341, 318, 246, 316, 345, 280, 172, 299, 234,
+	168, 306, 206, 353, 377, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 276, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 196, 0, 0, 0, 0, 231,
+	0, 0, 185, 240, 238, 0, 0, 0, 284, 352,
+	0, 0, 0, 0, 235, 0, 0, 0, 340, 260,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 501, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1663, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 150, 0,
+	0, 0, 0, 210, 261, 163, 0, 0, 0, 222,
+	383, 0, 0, 0, 300, 0, 347, 212, 232, 167,
+	146, 161, 179, 211, 272, 0, 323, 0, 0, 0,
+	0, 0, 186, 0, 314, 281, 371, 0, 0, 288,
+	313, 236, 360, 0, 369, 370, 0, 0, 380, 385,
+	0, 0, 0, 337, 197, 0, 152, 0, 295, 192,
+	227, 0, 0, 0, 183, 0, 0, 0, 336, 358,
+	170, 354, 259, 265, 180, 182, 181, 162, 331, 357,
+	175, 184, 342, 319, 348, 191, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 376, 0, 326, 325, 0,
+	218, 0, 0, 0, 307, 0, 223, 151, 350, 388,
+	169, 229, 355, 0, 205, 271, 188, 282, 283, 201,
+	148, 302, 253, 254, 252, 255, 0, 0, 372, 0,
+	304, 153, 351, 368, 176, 327, 329, 386, 312, 156,
+	366, 346, 257, 224, 225, 154, 0, 155, 310, 195,
+	209, 190, 275, 0, 208, 297, 363, 364, 187, 389,
+	164, 379, 158, 165, 378, 268, 0, 267, 381, 359,
+	367, 258, 244, 0, 157, 365, 256, 243, 230, 200,
+	214, 293, 239, 294, 215, 263, 262, 264, 241, 245,
+	0, 0, 0, 343, 374, 390, 173, 0, 330, 356,
+	0, 0, 174, 207, 199, 292, 266, 166, 217, 339,
+	228, 237, 309, 387, 279, 315, 177, 373, 338, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 328, 308, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 149, 159,
+	233, 0, 305, 204, 375, 0, 194, 0, 0, 0,
+	0, 0, 147, 160, 171, 178, 193, 198, 202, 0,
+	213, 216, 219, 220, 221, 226, 242, 247, 248, 249,
+	250, 269, 270, 273, 274, 277, 278, 285, 286, 287,
+	289, 290, 291, 296, 301, 303, 311, 317, 320, 321,
+	322, 324, 332, 333, 334, 335, 344, 349, 361, 362,
+	382, 384, 0, 0, 251, 298, 203, 189, 341, 318,
+	246, 316, 345, 280, 172, 299, 234, 168, 306, 206,
+	353, 377, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 276, 0, 0, 0, 0, 0, 0, 0, 0,
+	1129, 196, 0, 0, 0, 0, 231, 0, 0, 185,
+	240, 238, 0, 0, 0, 284, 352, 0, 0, 0,
+	0, 235, 0, 0, 0, 340, 260, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 144, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 150, 0, 0, 0, 0,
+	210, 261, 163, 0, 0, 0, 222, 383, 0, 0,
+	0, 300, 0, 347, 212, 232, 167, 146, 161, 179,
+	211, 272, 0, 323, 0, 0, 0, 0, 0, 186,
+	0, 314, 281, 371, 0, 0, 288, 313, 236, 360,
+	0, 369, 370, 0, 0, 380, 385, 0, 0, 0,
+	337, 197, 0, 152, 0, 295, 192, 227, 0, 0,
+	0, 183, 0, 0, 0, 336, 358, 170, 354, 259,
+	265, 180, 182, 181, 162, 331, 357, 175, 184, 342,
+	319, 348, 191, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 376, 0, 326, 325, 0, 218, 0, 0,
+	0, 307, 0, 223, 151, 350, 388, 169, 229, 355,
+	0, 205, 271, 188, 282, 283, 201, 148, 302, 253,
+	254, 252, 255, 0, 0, 372, 0, 304, 153, 351,
+	368, 176, 327, 329, 386, 312, 156, 366, 346, 257,
+	224, 225, 154, 0, 155, 310, 195, 209, 190, 275,
+	0, 208, 297, 363, 364, 187, 389, 164, 379, 158,
+	165, 378, 268, 0, 267, 381, 359, 367, 258, 244,
+	0, 157, 365, 256, 243, 230, 200, 214, 293, 239,
+	294, 215, 263, 262, 264, 241, 245, 0, 0, 0,
+	343, 374, 390, 173, 0, 330, 356, 0, 0, 174,
+	207, 199, 292, 266, 166, 217, 339, 228, 237, 309,
+	387, 279, 315, 177, 373, 338, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 328, 308, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 149, 159, 233, 0, 305,
+	204, 375, 0, 194, 0, 0, 0, 0, 0, 147,
+	160, 171, 178, 193, 198, 202, 0, 213, 216, 219,
+	220, 221, 226, 242, 247, 248, 249, 250, 269, 270,
+	273, 274, 277, 278, 285, 286, 287, 289, 290, 291,
+	296, 301, 303, 311, 317, 320, 321, 322, 324, 332,
+	333, 334, 335, 344, 349, 361, 362, 382, 384, 0,
+	0, 251, 298, 203, 189, 341, 318, 246, 316, 345,
+	280, 172, 299, 234, 168, 306, 206, 353, 377, 0,
+	0, 635, 0, 0, 0, 0, 0, 0, 276, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 196, 0,
+	0, 0, 0, 231, 0, 0, 185, 240, 238, 0,
+	0, 0, 284, 352, 0, 0, 0, 0, 235, 0,
+	0, 0, 340, 260, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 144, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 150, 0, 0, 0, 0, 210, 261, 163,
+	0, 0, 0, 222, 383, 0, 0, 0, 300, 0,
+	347, 212, 232, 167, 146, 161, 179, 211, 272, 0,
+	323, 0, 0, 0, 0, 0, 186, 0, 314, 281,
+	371, 0, 0, 288, 313, 236, 360, 0, 369, 370,
+	0, 0, 380, 385, 0, 0, 0, 337, 197, 0,
+	152, 0, 295, 192, 227, 0, 0, 0, 183, 0,
+	0, 0, 336, 358, 170, 354, 259, 265, 180, 182,
+	181, 162, 331, 357, 175, 184, 342, 319, 348, 191,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 376,
+	0, 326, 325, 0, 218, 0, 0, 0, 307, 0,
+	223, 151, 350, 388, 169, 229, 355, 0, 205, 271,
+	188, 282, 283, 201, 148, 302, 253, 254, 252, 255,
+	0, 0, 372, 0, 304, 153, 351, 368, 176, 327,
+	329, 386, 312, 156, 366, 346, 257, 224, 225, 154,
+	0, 155, 310, 195, 209, 190, 275, 0, 208, 297,
+	363, 364, 187, 389, 164, 379, 158, 165, 378, 268,
+	0, 267, 381, 359, 367, 258, 244, 0, 157, 365,
+	256, 243, 230, 200, 214, 293, 239, 294, 215, 263,
+	262, 264, 241, 245, 0, 0, 0, 343, 374, 390,
+	173, 0, 330, 356, 0, 0, 174, 207, 199, 292,
+	266, 166, 217, 339, 228, 237, 309, 387, 279, 315,
+	177, 373, 338, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 328, 308, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 149, 159, 233, 0, 305, 204, 375, 0,
+	194, 0, 0, 0, 0, 0, 147, 160, 171, 178,
+	193, 198, 202, 0, 213, 216, 219, 220, 221, 226,
+	242, 247, 248, 249, 250, 269, 270, 273, 274, 277,
+	278, 285, 286, 287, 289, 290, 291, 296, 301, 303,
+	311, 317, 320, 321, 322, 324, 332, 333, 334, 335,
+	344, 349, 361, 362, 382, 384, 0, 0, 251, 298,
+	203, 189, 341, 318, 246, 316, 345, 280, 172, 299,
+	234, 168, 306, 206, 353, 377, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 276, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 196, 0, 0, 0, 0,
+	231, 0, 0, 185, 240, 238, 0, 0, 0, 284,
+	352, 0, 0, 0, 0, 235, 0, 0, 0, 340,
+	260, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 144,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 150,
+	0, 0, 0, 0, 210, 261, 163, 0, 141, 0,
+	222, 383, 0, 0, 0, 300, 0, 347, 212, 232,
+	167, 146, 161, 179, 211, 272, 0, 323, 0, 0,
+	0, 0, 0, 186, 0, 314, 281, 371, 0, 0,
+	288, 313, 236, 360, 0, 369, 370, 0, 0, 380,
+	385, 0, 0, 0, 337, 197, 0, 152, 0, 295,
+	192, 227, 0, 0, 0, 183, 0, 0, 0, 336,
+	358, 170, 354, 259, 265, 180, 182, 181, 162, 331,
+	357, 175, 184, 342, 319, 348, 191, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 376, 0, 326, 325,
+	0, 218, 0, 0, 0, 307, 0, 223, 151, 350,
+	388, 169, 229, 355, 0, 205, 271, 188, 282, 283,
+	201, 148, 302, 253, 254, 252, 255, 0, 0, 372,
+	0, 304, 153, 351, 368, 176, 327, 329, 386, 312,
+	156, 366, 346, 257, 224, 225, 154, 0, 155, 310,
+	195, 209, 190, 275, 0, 208, 297, 363, 364, 187,
+	389, 164, 379, 158, 165, 378, 268, 0, 267, 381,
+	359, 367, 258, 244, 0, 157, 365, 256, 243, 230,
+	200, 214, 293, 239, 294, 215, 263, 262, 264, 241,
+	245, 0, 0, 0, 343, 374, 390, 173, 0, 330,
+	356, 0, 0, 174, 207, 199, 292, 266, 166, 217,
+	339, 228, 237, 309, 387, 279, 315, 177, 373, 338,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 328, 308,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 149,
+	159, 233, 0, 305, 204, 375, 0, 194, 0, 0,
+	0, 0, 0, 147, 160, 171, 178, 193, 198, 202,
+	0, 213, 216, 219, 220, 221, 226, 242, 247, 248,
+	249, 250, 269, 270, 273, 274, 277, 278, 285, 286,
+	287, 289, 290, 291, 296, 301, 303, 311, 317, 320,
+	321, 322, 324, 332, 333, 334, 335, 344, 349, 361,
+	362, 382, 384, 0, 0, 251, 298, 203, 189, 341,
+	318, 246, 316, 345, 280, 172, 299, 234, 168, 306,
+	206, 353, 377, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 276, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 196, 0, 0, 0, 0, 231, 0, 0,
+	185, 240, 238, 0, 0, 0, 284, 352, 0, 0,
+	0, 0, 235, 0, 0, 0, 340, 260, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 501, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 150, 0, 0, 0,
+	0, 210, 261, 163, 0, 0, 0, 222, 383, 0,
+	0, 0, 300, 0, 347, 212, 232, 167, 146, 161,
+	179, 211, 272, 0, 323, 0, 0, 0, 0, 0,
+	186, 0, 314, 281, 371, 0, 0, 288, 313, 236,
+	360, 0, 369, 370, 0, 0, 380, 385, 0, 0,
+	0, 337, 197, 0, 152, 0, 295, 192, 227, 0,
+	0, 0, 183, 0, 0, 0, 336, 358, 170, 354,
+	259, 265, 180, 182, 181, 162, 331, 357, 175, 184,
+	342, 319, 348, 191, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 376, 0, 326, 325, 0, 218, 0,
+	0, 0, 307, 0, 223, 151, 350, 388, 169, 229,
+	355, 0, 205, 271, 188, 282, 283, 201, 148, 302,
+	253, 254, 252, 255, 0, 0, 372, 0, 304, 153,
+	351, 368, 176, 327, 329, 386, 312, 156, 366, 346,
+	257, 224, 225, 154, 0, 155, 310, 195, 209, 190,
+	275, 0, 208, 297, 363, 364, 187, 389, 164, 379,
+	158, 165, 378, 268, 0, 267, 381, 359, 367, 258,
+	244, 0, 157, 365, 256, 243, 230, 200, 214, 293,
+	239, 294, 215, 263, 262, 264, 241, 245, 0, 0,
+	0, 343, 374, 390, 173, 0, 330, 356, 0, 0,
+	174, 207, 199, 292, 266, 166, 217, 339, 228, 237,
+	309, 387, 279, 315, 177, 373, 338, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 328, 308, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 149, 159, 233, 0,
+	305, 204, 375, 0, 194, 0, 0, 0, 0, 0,
+	147, 160, 171, 178, 193, 198, 202, 0, 213, 216,
+	219, 220, 221, 226, 242, 247, 248, 249, 250, 269,
+	270, 273, 274, 277, 278, 285, 286, 287, 289, 290,
+	291, 296, 301, 303, 311, 317, 320, 321, 322, 324,
+	332, 333, 334, 335, 344, 349, 361, 362, 382, 384,
+	0, 0, 251, 298, 203, 189, 341, 318, 246, 316,
+	345, 280, 172, 299, 234, 168, 306, 206, 353, 377,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 276,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 196,
+	0, 0, 0, 0, 231, 0, 0, 185, 240, 238,
+	0, 0, 0, 284, 352, 0, 0, 0, 0, 235,
+	0, 0, 0, 340, 260, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 144, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 150, 0, 0, 0, 0, 210, 261,
+	163, 0, 0, 0, 222, 383, 0, 0, 0, 300,
+	0, 347, 212, 232, 167, 146, 161, 179, 211, 272,
+	0, 323, 0, 0, 0, 0, 0, 186, 0, 314,
+	281, 371, 0, 0, 288, 313, 236, 360, 0, 369,
+	370, 0, 0, 380, 385, 0, 0, 0, 337, 197,
+	0, 152, 0, 295, 192, 227, 0, 0, 0, 183,
+	0, 0, 0, 336, 358, 170, 354, 259, 265, 180,
+	182, 181, 162, 331, 357, 175, 184, 342, 319, 348,
+	191, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	376, 0, 326, 325, 0, 218, 0, 0, 0, 307,
+	0, 223, 151, 350, 388, 169, 229, 355, 0, 205,
+	271, 188, 282, 283, 201, 148, 302, 253, 254, 252,
+	255, 0, 0, 372, 0, 304, 153, 351, 368, 176,
+	327, 329, 386, 312, 156, 366, 346, 257, 224, 225,
+	154, 0, 155, 310, 195, 209, 190, 275, 0, 208,
+	297, 363, 364, 187, 389, 164, 379, 158, 165, 378,
+	268, 0, 267, 381, 359, 367, 258, 244, 0, 157,
+	365, 256, 243, 230, 200, 214, 293, 239, 294, 215,
+	263, 262, 264, 241, 245, 0, 0, 0, 343, 374,
+	390, 173, 0, 330, 356, 0, 0, 174, 207, 199,
+	292, 266, 166, 217, 339, 228, 237, 309, 387, 279,
+	315, 177, 373, 338, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 328, 308, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 149, 159, 233, 0, 305, 204, 375,
+	0, 194, 0, 0, 0, 0, 0, 147, 160, 171,
+	178, 193, 198, 202, 0, 213, 216, 219, 220, 221,
+	226, 242, 247, 248, 249, 250, 269, 270, 273, 274,
+	277, 278, 285, 286, 287, 289, 290, 291, 296, 301,
+	303, 311, 317, 320, 321, 322, 324, 332, 333, 334,
+	335, 344, 349, 361, 362, 382, 384, 0, 0, 251,
+	298, 203, 189, 341, 318, 246, 316, 345, 280, 172,
+	299, 234, 168, 306, 206, 353, 377, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 276, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 196, 0, 0, 0,
+	0, 231, 0, 0, 185, 240, 238, 0, 0, 0,
+	284, 352, 0, 0, 0, 0, 235, 0, 0, 0,
+	340, 260, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	540, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	150, 0, 0, 0, 0, 210, 261, 163, 0, 0,
+	0, 222, 383, 0, 0, 0, 300, 0, 347, 212,
+	232, 167, 146, 161, 179, 211, 272, 0, 323, 0,
+	0, 0, 0, 0, 186, 0, 314, 281, 371, 0,
+	0, 288, 313, 236, 360, 0, 369, 370, 0, 0,
+	380, 385, 0, 0, 0, 337, 197, 0, 152, 0,
+	295, 192, 227, 0, 0, 0, 183, 0, 0, 0,
+	336, 358, 170, 354, 259, 265, 180, 182, 181, 162,
+	331, 357, 175, 184, 342, 319, 348, 191, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 376, 0, 326,
+	325, 0, 218, 0, 0, 0, 307, 0, 223, 151,
+	350, 388, 169, 229, 355, 0, 205, 271, 188, 282,
+	283, 201, 148, 302, 253, 254, 252, 255, 0, 0,
+	372, 0, 304, 153, 351, 368, 176, 327, 329, 386,
+	312, 156, 366, 346, 257, 224, 225, 154, 0, 155,
+	310, 195, 209, 190, 275, 0, 208, 297, 363, 364,
+	187, 389, 164, 379, 158, 165, 378, 268, 0, 267,
+	381, 359, 367, 258, 244, 0, 157, 365, 256, 243,
+	230, 200, 214, 293, 239, 294, 215, 263, 262, 264,
+	241, 245, 0, 0, 0, 343, 374, 390, 173, 0,
+	330, 356, 0, 0, 174, 207, 199, 292, 266, 166,
+	217, 339, 228, 237, 309, 387, 279, 315, 177, 373,
+	338, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	308, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	149, 159, 233, 0, 305, 204, 375, 0, 194, 0,
+	0, 0, 0, 0, 147, 160, 171, 178, 193, 198,
+	202, 0, 213, 216, 219, 220, 221, 226, 242, 247,
+	248, 249, 250, 269, 270, 273, 274, 277, 278, 285,
+	286, 287, 289, 290, 291, 296, 301, 303, 311, 317,
+	320, 321, 322, 324, 332, 333, 334, 335, 344, 349,
+	361, 362, 382, 384, 0, 0, 251, 298, 203, 189,
+	341, 318, 246, 316, 345, 280, 172, 299, 234, 168,
+	306, 206, 353, 377, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 276, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 196, 0, 0, 0, 0, 231, 0,
+	0, 185, 240, 238, 0, 0, 0, 284, 352, 0,
+	0, 0, 0, 235, 0, 0, 0, 340, 260, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 540, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 150, 0, 0,
+	0, 0, 210, 261, 163, 0, 0, 0, 222, 383,
+	0, 0, 0, 300, 0, 347, 212, 232, 167, 146,
+	161, 179, 211, 272, 0, 323, 0, 0, 0, 0,
+	0, 186, 0, 314, 281, 371, 0, 0, 288, 313,
+	236, 360, 0, 369, 370, 0, 0, 380, 385, 0,
+	0, 0, 337, 197, 0, 152, 0, 295, 192, 227,
+	0, 0, 0, 183, 0, 0, 0, 336, 358, 170,
+	354, 259, 265, 180, 182, 181, 162, 331, 357, 175,
+	184, 342, 319, 348, 191, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 376, 0, 326, 325, 0, 218,
+	0, 0, 0, 307, 0, 223, 151, 350, 388, 169,
+	229, 355, 0, 205, 271, 188, 282, 283, 201, 148,
+	302, 253, 254, 252, 255, 0, 0, 372, 0, 304,
+	153, 351, 368, 176, 327, 329, 386, 312, 156, 366,
+	346, 257, 224, 225, 154, 0, 155, 310, 195, 209,
+	190, 275, 0, 208, 297, 363, 364, 187, 389, 164,
+	379, 158, 655, 378, 268, 0, 267, 381, 359, 367,
+	258, 244, 0, 157, 365, 256, 243, 230, 200, 214,
+	293, 239, 294, 215, 263, 262, 264, 651, 245, 0,
+	0, 0, 343, 374, 390, 173, 0, 330, 356, 0,
+	0, 174, 207, 199, 292, 656, 654, 645, 646, 228,
+	237, 309, 387, 279, 315, 177, 373, 338, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 328, 308, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 149, 159, 233,
+	0, 305, 204, 375, 0, 194, 0, 0, 0, 0,
+	0, 147, 160, 171, 178, 193, 198, 202, 0, 213,
+	216, 219, 220, 221, 226, 242, 247, 248, 249, 250,
+	269, 270, 273, 274, 277, 278, 285, 286, 287, 289,
+	652, 653, 296, 301, 303, 311, 317, 320, 321, 322,
+	324, 332, 333, 334, 335, 344, 349, 361, 362, 382,
+	384, 0, 0, 251, 298, 203, 189, 341, 318, 246,
+	316, 345, 280, 172, 299, 234, 168, 306, 206, 353,
+	377, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	196, 0, 0, 0, 0, 231, 0, 0, 185, 240,
+	238, 0, 0, 0, 284, 352, 0, 0, 0, 0,
+	235, 0, 0, 0, 340, 260, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 540, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 150, 0, 0, 0, 0, 210,
+	261, 163, 0, 0, 0, 222, 383, 0, 0, 0,
+	300, 0, 347, 212, 232, 167, 146, 161, 179, 211,
+	272, 0, 323, 0, 0, 0, 0, 0, 186, 0,
+	314, 281, 371, 0, 0, 288, 313, 236, 360, 0,
+	369, 370, 0, 0, 380, 385, 0, 0, 0, 337,
+	197, 0, 152, 0, 295, 192, 227, 0, 0, 0,
+	183, 0, 0, 0, 336, 358, 170, 354, 259, 265,
+	180, 182, 181, 162, 331, 357, 175, 184, 342, 319,
+	348, 191, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 376, 0, 326, 325, 0, 218, 0, 0, 0,
+	307, 0, 223, 151, 350, 388, 169, 229, 355, 0,
+	205, 271, 188, 282, 283, 201, 148, 302, 253, 254,
+	252, 255, 0, 0, 372, 0, 304, 153, 351, 1154,
+	176, 327, 329, 386, 312, 156, 366, 346, 257, 224,
+	225, 154, 0, 155, 310, 195, 209, 190, 275, 0,
+	208, 297, 363, 364, 187, 389, 164, 379, 158, 165,
+	378, 268, 0, 267, 381, 359, 367, 258, 244, 0,
+	157, 365, 256, 243, 230, 200, 214, 293, 239, 294,
+	215, 263, 262, 264, 241, 245, 0, 0, 0, 343,
+	374, 390, 173, 0, 330, 356, 0, 0, 174, 207,
+	199, 292, 266, 166, 217, 339, 228, 237, 309, 387,
+	279, 315, 177, 373, 338, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 328, 308, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 149, 159, 233, 0, 305, 204,
+	375, 0, 194, 0, 0, 0, 0, 0, 147, 160,
+	171, 178, 193, 198, 202, 0, 213, 216, 219, 220,
+	221, 226, 242, 247, 248, 249, 250, 269, 270, 273,
+	274, 277, 278, 285, 286, 287, 289, 290, 291, 296,
+	301, 303, 311, 317, 320, 321, 322, 324, 332, 333,
+	334, 335, 344, 349, 361, 362, 382, 384, 0, 0,
+	251, 298, 203, 189, 341, 318, 246, 316, 345, 280,
+	172, 299, 234, 168, 306, 206, 353, 377, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 276, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 196, 0, 0,
+	0, 0, 231, 0, 0, 185, 240, 238, 0, 0,
+	0, 284, 352, 0, 0, 0, 0, 235, 0, 0,
+	0, 340, 260, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 540, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 150, 0, 0, 0, 0, 210, 261, 163, 0,
+	0, 0, 222, 383, 0, 0, 0, 300, 0, 347,
+	212, 232, 167, 146, 161, 179, 211, 272, 0, 323,
+	0, 0, 0, 0, 0, 186, 0, 314, 281, 371,
+	0, 0, 288, 313, 236, 360, 0, 369, 370, 0,
+	0, 380, 385, 0, 0, 0, 337, 197, 0, 152,
+	0, 295, 192, 227, 0, 0, 0, 183, 0, 0,
+	0, 336, 358, 170, 354, 259, 265, 180, 182, 181,
+	162, 331, 357, 175, 184, 342, 319, 348, 191, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 376, 0,
+	326, 325, 0, 218, 0, 0, 0, 307, 0, 223,
+	151, 350, 388, 169, 229, 355, 0, 205, 271, 188,
+	282, 283, 201, 148, 302, 253, 254, 252, 255, 0,
+	0, 372, 0, 304, 153, 351, 642, 176, 327, 329,
+	386, 312, 156, 366, 346, 257, 224, 225, 154, 0,
+	155, 310, 195, 209, 190, 275, 0, 208, 297, 363,
+	364, 187, 389, 164, 379, 158, 655, 378, 268, 0,
+	267, 381, 359, 367, 258, 244, 0, 157, 365, 256,
+	243, 230, 200, 214, 293, 239, 294, 215, 263, 262,
+	264, 651, 245, 0, 0, 0, 343, 374, 390, 173,
+	0, 330, 356, 0, 0, 174, 207, 199, 292, 656,
+	654, 645, 646, 228, 237, 309, 387, 279, 315, 177,
+	373, 338, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	328, 308, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 149, 159, 233, 0, 305, 204, 375, 0, 194,
+	0, 0, 0, 0, 0, 147, 160, 171, 178, 193,
+	198, 202, 0, 213, 216, 219, 220, 221, 226, 242,
+	247, 248, 249, 250, 269, 270, 273, 274, 277, 278,
+	285, 286, 287, 289, 652, 653, 296, 301, 303, 311,
+	317, 320, 321, 322, 324, 332, 333, 334, 335, 344,
+	349, 361, 362, 382, 384, 0, 0, 251, 298, 203,
+	189, 341, 318, 246, 316, 345, 280, 172, 299, 234,
+	168, 306, 206, 43, 377, 89, 46, 47, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 70, 0, 0,
+	0, 0, 0, 95, 0, 0, 0, 48, 0, 0,
+	0, 0, 0, 492, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2210, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 492, 0, 0, 0, 0, 0, 0, 0, 2555,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1860, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 492, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 50, 91,
+	2554, 53, 56, 0, 1228, 1170, 0, 0, 0, 0,
+	0, 0, 0, 0, 2556, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	57, 94, 93, 486, 0, 0, 0, 55, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1170, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 486, 0, 68, 69, 1170, 2557, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	77, 0, 78, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 486, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2558, 92, 0, 61, 62, 72, 0, 73,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	90, 0, 0, 0, 0, 466, 467, 468, 469, 470,
+	474, 475, 479, 480, 489, 488, 487, 490, 491, 494,
+	493, 495, 471, 472, 473, 476, 477, 478, 481, 482,
+	485, 483, 484, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 96, 466, 467, 468, 469, 470, 474, 475,
+	479, 480, 489, 488, 487, 490, 491, 494, 493, 495,
+	471, 472, 473, 476, 477, 478, 481, 482, 485, 483,
+	484, 0, 0, 0, 0, 466, 467, 468, 469, 470,
+	474, 475, 479, 480, 489, 488, 487, 490, 491, 494,
+	493, 495, 471, 472, 473, 476, 477, 478, 481, 482,
+	485, 483, 484,
+}
+
+var yyPact = [...]int{
+	4111, -1000, -325, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1844, -1000, -1000, -1000, -1000, -1000, -1000, 928,
+	679, -1000, -1000, 309, 46, 30657, 690, 4130, 31631, -1000,
+	-1000, -1000, 213, 541, 31631, -165, -165, -1000, 457, 462,
+	1226, 1664, 1222, 89, -28, -43, 117, 19940, 19450, 31144,
+	31144, -302, -1000, -311, 31631, 677, -1000, 1894, 1897, -1000,
+	-1000, 586, 96, -1000, -1000, -1000, 22375, 188, -1000, -1000,
+	1818, 1841, 1577, -1000, 14090, 583, 583, 30170, 33579, -1000,
+	1893, 31631, 12626, -1000, 649, 31631, -112, 561, 561, 341,
+	561, 561, 675, -1000, 869, -1000, -1000, -1000, -1000, 31631,
+	23836, 563, 31144, 563, 563, 563, 563, 563, 563, 563,
+	31144, 31631, -1000, 740, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 31631, 1214, 1708, 903, 297, 9189, 9189, -1000, 902,
+	-1000, 312, 304, 334, 281, 128, 940, 1810, -1000, 1466,
+	1818, 1818, 9189, 9189, 9189, 9189, 9189, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 294, 626, 273, 188, 851, -1000,
+	-1000, 153, -1000, -1000, 153, -1000, 1705, 1702, 1128, 1689,
+	546, 1688, 1522, -11, -1000, 1213, 31631, -1000, -1000, 1102,
+	1095, 1544, 1390, 664, 1543, -1000, -1000, 1520, 1520, 1520,
+	1520, 1282, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 305, 1385, 1436, 1542,
+	1879, -1000, -1000, 1526, 31144, 625, 1785, 1785, -174, 31631,
+	-1000, 1478, 1799, 662, 31631, -1000, -68, 1456, 24323, -1000,
+	1512, -1000, -1000, -1000, 1233, 1693, 1008, 17012, 1638, -1000,
+	-1000, 981, 1878, -1000, 21401, 736, -1000, 17012, 3020, 1466,
+	-1000, -1000, 1466, -1000, -1000, -1000, 723, -1000, -1000, 18960,
+	18960, 18960, 18960, 18960, 18960, 18960, 18960, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1466, 1466, 1466, 1466, 1466, 1466, 1466, 1466, 1466,
+	1466, 1466, 1466, 1466, 1466, 1466, 1466, 1466, 1466, 1466,
+	1466, 1466, 1466, 1466, 1466, 1466, 1466, 1466, 1466, -1000,
+	-1000, 1466, -1000, 13603, 1466, 1466, 1466, 1466, 1466, 1466,
+	1466, 1466, 1466, 1466, 1466, 1466, 17012, 1466, 1466, 1466,
+	1466, 1466, 1466, 1466, 1466, 1466, 1466, 1466, 1466, 1466,
+	1466, 1466, 1466, 29683, 27735, 31631, 1399, 1396, -1000, -1000,
+	735, 1464, -58, 33092, -1000, -1000, -1000, -1000, 32118, 27248,
+	850, -1000, -1000, -1000, -1000, 1681, -1000, -1000, 733, -1000,
+	1844, -1000, -1000, 1323, 572, -1000, 4610, 34132, -1000, -1000,
+	-1000, 1519, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 31144, 1771, 542, 1212,
+	892, 1211, 1210, 1209, 23836, 23836, 561, 1208, 1463, 623,
+	1461, 603, 1262, 31631, 1744, 1541, 31631, 1207, 1206, 1205,
+	1203, 23836, 23836, -1000, -1000, 12135, -1000, 9189, 903, -1000,
+	1146, 17012, 561, 561, 9189, 9189, 9189, 31631, 31631, 31631,
+	-1000, -1000, -1000, -1000, 31631, -1000, -1000, 903, 903, 9189,
+	903, 889, 1875, 889, -1000, 889, -1000, -1000, 17012, -1000,
+	18960, 23349, 783, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1202, 292, -1000, -1000, -1000, -1000, -1000, -1000, 1201,
+	31631, -1000, -1000, 31631, 546, 546, -1000, 1144, 546, 1449,
+	-1000, 847, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 546, -1000, 16525, -296, -1000, -1000,
+	1460, -1000, 332, -1000, -1000, 544, 544, 697, 23836, 23836,
+	23836, -1000, 31144, -1000, -1000, -1000, 23836, 544, 185, 1457,
+	544, 23836, 23836, 18960, 17012, -1000, 1087, -1000, -1000, -1000,
+	1768, -1000, 26761, 149, 1577, -1000, -1000, 188, -1000, -1000,
+	31631, 9189, -74, 24323, 1456, 87, 10662, -1000, -1000, -1000,
+	1886, 795, 1398, -1000, -1000, 1455, -1000, 991, 731, 1091,
+	1600, -1000, -1000, -1000, 31631, -1000, 17012, 17012, 1075, -1000,
+	24810, -1000, -1000, -1000, -1000, 10171, 827, 18960, 988, 881,
+	18960, 18960, 18960, 18960, 18960, 18960, 18960, 18960, 18960, 18960,
+	18960, 18960, 18960, 18960, 18960, 1093, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1200, -1000, 188, 1152, 1152, 752,
+	752, 752, 752, 752, 752, 752, 25784, 1796, 15064, 15064,
+	15064, 1796, 1233, 1233, 15064, 15064, 15064, 15064, 15064, 15064,
+	1796, 1796, 15064, 1233, 1233, 1233, 1233, 15064, 15064, 15064,
+	15064, 1796, 15064, 15064, 15064, 1796, 1233, 1270, 1035, 13603,
+	15064, 15064, 15064, 1233, 17012, 17012, 16038, 15551, 17499, 15064,
+	15064, 1796, 880, 1035, 32118, -1000, -1000, 18473, -1000, -1000,
+	-1000, -1000, -1000, 1233, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 31144, 31144, 15064, 15064, 15064, 15064, 366, 31631,
+	-1000, 1420, 1511, -1000, -1000, -1000, 1768, 26274, 20914, -1000,
+	366, 1379, 27735, 31631, -1000, -1000, 27735, 31631, 9680, 32605,
+	1439, -1000, -56, -84, -58, -1000, -1000, 750, -1000, -1000,
+	-1000, 13113, -1000, 11153, 1818, 1577, 6734, 11644, -1000, 34132,
+	1519, -1000, -22, -1000, -1000, -1000, 1488, -1000, 1488, 254,
+	49, 1488, 1488, 1488, 1488, 1488, 34, 34, 34, 34,
+	50, -1000, -1000, -1000, -1000, -1000, 1518, 1516, -1000, 1488,
+	1488, 1488, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1494, 280, 1490, 1490, 1490, 1490, 1490, 392, 392,
+	-1000, 17012, 1524, -1000, 31631, 9189, 1737, 9189, 307, 1510,
+	1383, -1000, 179, 1380, 31631, -1000, 31631, 31631, 23836, 23836,
+	23836, 667, -1000, 31631, 1454, -1000, -1000, -1000, -1000, -1000,
+	1411, -1000, 1380, -1000, -1000, -1000, -1000, -1000, -1000, 1035,
+	1198, 1184, -1000, -1000, -1000, -1000, -1000, -1000, 890, -1000,
+	-1000, -1000, -1000, 903, 31631, 31631, 31631, 1755, 903, 1035,
+	774, 1525, 1466, 1233, -1000, -1000, 1183, -1000, 1452, 145,
+	1449, 1449, -1000, 1449, 546, 1152, 1449, -1000, 1321, 1730,
+	1143, 31631, -1000, 24323, -12, -1000, -94, 29196, -1000, -1000,
+	-1000, 29196, -1000, 151, -1000, 600, 1317, -1000, -1000, 29196,
+	132, 178, 29196, 1411, 1874, 3892, 1447, -1000, 31631, 1851,
+	111, -1000, -1000, -1000, -1000, -1000, 1796, 1233, 362, -1000,
+	-1000, -1000, -1000, 1466, -1000, -298, 1292, 729, -1000, 1606,
+	1008, 1008, 17012, -1000, -1000, -1000, 11153, 1819, -1000, 1623,
+	1622, 1554, -1000, -1000, 827, 829, -1000, -1000, 1051, -1000,
+	-1000, -1000, -1000, 727, 1466, -1000, 3892, -1000, -1000, -1000,
+	-1000, 988, 18960, 18960, 18960, 774, 3892, 3772, 1148, 2760,
+	752, 934, 934, 776, 776, 776, 776, 776, 810, 810,
+	-1000, -1000, -1000, 1233, -1000, -1000, -1000, 15064, 1310, -1000,
+	-1000, 1035, 722, 1310, 1310, 15064, -143, -143, 1233, 1310,
+	1310, 1310, 1233, 1310, 15064, 15064, 1310, -143, -143, -143,
+	-143, 1310, 1310, 1310, 1310, 15064, 1310, 1310, 1310, 15064,
+	-1000, 17012, -1000, 1233, 1310, 1310, 1310, -1000, 875, 972,
+	840, 1873, 1310, 836, 1872, 1310, 1232, 18960, 18960, 18960,
+	1310, 1310, 15064, 920, -1000, 17012, 1233, -1000, 1286, 1446,
+	1445, 1310, 1233, 1441, 1310, 1310, 274, 1466, -1000, 11153,
+	27735, 27735, 27735, 27735, 27735, -1000, 1572, 1568, -1000, 1564,
+	1561, 1597, 24323, 1314, 1233, 287, 26274, -1000, 1466, -1000,
+	22862, 682, 635, 633, 632, 1851, 27735, 1401, -1000, 1401,
+	-1000, 720, -1000, -1000, 32118, -58, -87, -1000, -1000, 1439,
+	-1000, 1101, -1000, -1000, 1035, -1000, 717, 1693, 1796, 1437,
+	6243, -1000, -1000, -1000, -1000, 572, -1000, -1000, -1000, 1507,
+	34100, -1000, 1640, 734, 767, 1161, 1629, -1000, -1000, 628,
+	-21, -1000, -1000, 1086, 34, 34, 1488, 1488, 244, 1488,
+	-1000, 34, -1000, -1000, -1000, 750, 1675, 750, 750, 750,
+	750, 34, 1142, 1142, -1000, -1000, -1000, -1000, 1082, -1000,
+	1494, -1000, 1079, -1000, -1000, -1000, -1000, -1000, -1000, 1001,
+	1540, 31144, 188, 1754, -1000, -1000, -1000, 1867, -1000, -1000,
+	611, 104, 23836, 195, 291, 239, -1000, 599, -1000, 597,
+	-1000, -1000, -1000, -1000, -1000, 9189, 31631, 23836, 9189, 9189,
+	1851, 1181, 1180, -1000, -1000, -1000, 889, 903, 1667, -1000,
+	-1000, 18960, 23836, -1000, 330, -1000, -1000, -339, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 366, 652, -1000, -1000, -55,
+	-1000, -1000, 596, 710, 709, 594, -1000, 114, 23836, -1000,
+	31144, 1871, 1869, 125, 1859, 23836, -1000, 1818, 17012, 1851,
+	1466, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1600,
+	-1000, 1433, -1000, -1000, 765, 763, 801, 404, 404, -1000,
+	808, 404, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	355, -1000, 255, -1000, 1749, 10662, 10662, 1592, -1000, -1000,
+	-1000, 31631, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 8698, 15064, -1000, 774, 3892, 3286, -1000, 18960, -1000,
+	1310, 15064, -143, 8207, -143, -143, 1310, -1000, 28709, -1000,
+	-143, -143, -143, -143, -143, -143, 1310, 1310, -143, -1000,
+	-1000, -1000, -1000, -143, -143, -143, -143, 1310, -143, -143,
+	-143, 1233, 1035, -1000, -1000, -1000, -1000, 550, 1093, 550,
+	18960, 18960, -1000, 18960, 18960, -1000, -1000, 18960, 3656, 3546,
+	3435, -185, -1000, 1429, 874, -1000, 17012, 1045, -1000, -1000,
+	18960, 18960, -1000, -1000, -1000, -1000, 1538, 11153, 1466, 7225,
+	-1000, 31144, 1431, -1000, 842, 841, 1511, 1515, 1536, 1415,
+	-1000, -1000, -1000, -1000, 1567, -1000, 1558, -1000, -1000, 1475,
+	-1000, -1000, 1432, 1466, 31144, 18960, 682, -1000, 1466, 1466,
+	1466, 1844, 1401, -1000, -1000, 766, -1000, -1000, -75, -93,
+	-1000, -1000, -1000, 10662, -1000, 6734, -1000, 6734, -1000, 25297,
+	565, 1642, 539, -1000, 1161, -1000, -1000, 1161, -1000, -1000,
+	-1000, 1492, -1000, 962, -1000, -1000, -1000, -1000, -1000, 18960,
+	1885, -1000, 1637, -1000, 1635, 1136, -1000, -1000, 1311, 750,
+	750, 34, -1000, -1000, 1488, -1000, 750, -1000, 823, -1000,
+	-1000, -1000, -1000, 750, 1307, -1000, 1305, 1427, -1000, 1294,
+	95, 31631, -1000, -1000, -1000, 1535, -1000, -1000, -1000, 1301,
+	1414, -1000, 4610, 1179, 1177, 1175, -193, 236, -1000, 23836,
+	137, 179, 429, 1174, 31631, 23836, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 903, -1000, 18960, 3892, 1380, -1000,
+	-1000, -1000, 34, 31631, -1000, 23836, 764, 28222, 23836, 124,
+	148, -1000, 23836, 23836, 1854, 23836, 1411, -1000, 1035, 1818,
+	17012, 1554, 362, -1000, 5262, -1000, 1161, 683, -1000, -1000,
+	423, -1000, 1629, -1000, 34052, 1172, -1000, 20128, 19638, 31144,
+	1634, 1632, 1170, 31631, 31144, -1000, -1000, 19148, 1169, 17012,
+	-1000, 31144, 31144, 87, 704, -1000, -1000, -1000, 1355, 14090,
+	-1000, -1000, 1233, -1000, 18960, 3892, -143, -1000, -1000, -1000,
+	-1000, 666, -1000, -1000, -143, -1000, 508, -1000, -1000, -1000,
+	-1000, -1000, -1000, -143, -143, -1000, -1000, -1000, -1000, -1000,
+	-143, -1000, -1000, -1000, -1000, 1233, 1488, 1488, -1000, 1488,
+	1490, -1000, 1488, 72, 1488, 71, 1233, 1233, 3207, 3189,
+	3140, 2968, 1286, 18960, 18960, 18960, 1466, -137, -1000, 1035,
+	17012, 2104, 1670, -1000, 1720, 1345, 1410, -1000, -1000, 14577,
+	1233, 1617, 1292, -1000, 22375, 1290, -1000, 1844, 11153, 17012,
+	17012, -1000, -1000, 17012, 1489, -1000, 17012, -1000, -1000, -1000,
+	-1000, 31144, 284, -1000, 17012, 1290, 912, -1000, 31144, 31144,
+	31144, 1818, -1000, -1000, -1000, -1000, 6243, -1000, 1288, -1000,
+	1488, 1488, 1630, -1000, 1629, 734, -1000, -1000, -1000, 31144,
+	-1000, 3892, -23, -1000, -1000, -1000, -1000, -1000, -1000, 750,
+	-1000, -1000, -1000, -1000, -1000, 34, 1133, 34, 1076, -1000,
+	1074, -1000, -1000, -246, 1487, -1000, 188, 31631, 127, 611,
+	-1000, 4610, 4610, 4610, 133, 131, -1000, 932, -1000, -1000,
+	-1000, 1132, 1130, 1124, 1380, 199, 931, 162, -1000, 1743,
+	-1000, -1000, -1000, 3892, -36, -1000, 148, -1000, -1000, -1000,
+	148, -1000, -1000, 173, 1411, 1411, 23836, 1411, -1000, 1270,
+	-1000, -1000, 19148, 529, 4610, 4610, -1000, 1524, 734, 1628,
+	539, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1168, -1000,
+	691, -1000, 592, 529, 19148, 1035, 708, 1727, 1466, 10662,
+	1848, 27735, -1000, -1000, -1000, 3892, -1000, 7716, -1000, 1233,
+	1833, -1000, -1000, -1000, -1000, -1000, 359, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 18960, 18960, 18960, 18960, -1000,
+	1286, 1286, 1286, 18960, 1233, 1122, 1035, 18960, 18960, 1627,
+	-1000, 1466, -1000, -1000, 1466, 219, -1000, 31144, 1818, -1000,
+	1035, 1035, 1035, 31144, 1035, 1266, -1000, 1466, 20427, -1000,
+	24323, 1260, 1260, 1260, -1000, 653, 25297, 1725, 1725, -1000,
+	1161, -1000, 1258, -1000, -1000, 750, -1000, 750, 1295, 1267,
+	-1000, 25297, -1000, 1807, -1000, 127, -1000, 1121, 257, 242,
+	-1000, 256, 250, 248, 246, 231, -1000, -1000, -1000, -1000,
+	331, 133, -1000, -29, 1073, 290, 1676, 131, -1000, 1070,
+	1066, 1050, 1039, 245, -1000, -1000, -1000, -1000, 238, 179,
+	-1000, 409, 1119, 1659, 1654, 1747, 1747, 119, 1411, 1851,
+	1430, 1261, -1000, -1000, 1167, -1000, -1000, 1477, 1161, 1476,
+	-1000, 1160, -1000, -1000, 31144, -1000, 529, 1718, 1717, 1466,
+	-1000, -1000, 1846, 1832, 1401, 14090, -1000, -1000, -1000, 17012,
+	-1000, -1000, 1286, 1286, 1286, 1286, -1000, -1000, -1000, 129,
+	-1000, -1000, 1286, 1286, 1884, -1000, 1410, 1466, -1000, 188,
+	-1000, -1000, 1256, -1000, 31144, -1000, -1000, 682, -1000, -1000,
+	-1000, 653, -1000, 1151, 808, 1118, -1000, -1000, -1000, -1000,
+	345, -1000, -1000, -1000, -1000, 1254, -1000, 311, 34067, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1663, 1662, 262, -1000, 1114,
+	1111, -1000, 415, 1115, 742, -149, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 329, 1150, -1000,
+	160, -1000, -1000, 547, 1644, 1648, 1831, -1000, 23836, -1000,
+	-1000, 1818, 27735, -1000, -1000, 628, 628, 25297, 1524, 25297,
+	-1000, -1000, -1000, 18960, -1000, 347, -1000, 17012, 17012, 1848,
+	-1000, 1393, -1000, -1000, -1000, -1000, 1233, 230, -211, -1000,
+	-1000, 11153, 1410, 1233, -1000, -1000, -1000, -1000, -1000, 1018,
+	-1000, 31631, 653, 277, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 176, 17012, 17012, 5752, 34067, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1009, -1000, -1000,
+	1099, 406, 156, -1000, 1746, -1000, 1475, 980, 1654, 1830,
+	1652, 1650, -1000, 1110, -194, -1000, 1401, 1252, 1473, 1247,
+	3892, 1241, -1000, 31144, -1000, 1035, 1389, -1000, 1035, 1846,
+	-1000, -153, -1000, 1591, -188, -224, 1388, -1000, -1000, 1471,
+	-1000, 653, 318, -1000, 975, 1032, 225, 207, 4260, -1000,
+	-1000, -1000, -1000, -327, -1000, 152, -1000, 146, -1000, -1000,
+	-1000, 1109, -1000, 1656, -1000, 1108, -1000, 1828, 1826, -1000,
+	194, 1848, 653, 25297, 653, -1000, 347, 1615, 17012, -1000,
+	1233, 265, 265, -1000, 1585, -1000, 31144, -1000, 1149, 1104,
+	1020, -1000, 17012, 34067, 1806, 1792, 1748, 1716, 10662, 5457,
+	-1000, -1000, -1000, -1000, -1000, 1107, 1106, 21888, 1846, -1000,
+	1239, -1000, -1000, 336, -1000, -1000, -1000, 157, -1000, -250,
+	271, -251, -1000, 1152, -1000, -200, 1237, 352, -1000, -1000,
+	916, 34067, -1000, 1024, -328, 442, 188, 593, 18960, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 120, 1380, -1000, 653,
+	326, 906, -1000, -1000, -1000, -1000, -1000, 31144, -214, 1533,
+	-1000, -1000, -1000, -1000, -1000, -1000, 34067, -1000, -330, 34067,
+	289, -1000, -1000, -1000, 5263, -1000, -1000, -1000, -1000, 204,
+	-1000, -1000, 3892, 23836, -1000, 1466, 157, -1000, -226, 1531,
+	1528, 1858, -1000, -331, 5147, -332, 554, 34067, 909, -1000,
+	17012, -1000, 593, -1000, 1380, 17986, -1000, -1000, -1000, 1883,
+	-1000, 1868, 540, 540, 4977, 882, 34067, -1000, -333, 552,
+	34067, -1000, 1016, -1000, 1286, 1233, -1000, -1000, -1000, 353,
+	1063, -1000, -1000, -1000, 4933, -1000, -336, 34067, -1000, -1000,
+	-1000, -1000, -1000, 530, 3694, -338, -1000, 524, 34067, -1000,
+}
+
+var yyPgo = [...]int{
+	0, 2323, 2321, 40, 2320, 149, 2317, 2315, 2308, 21,
+	15, 13, 23, 2307, 1999, 1988, 1986, 1984, 2305, 1982,
+	2304, 5, 2303, 2302, 1980, 2301, 2300, 1976, 1973, 1966,
+	1962, 2299, 2296, 2295, 1960, 1957, 2293, 2287, 2282, 2280,
+	155, 2271, 128, 2270, 2266, 2265, 2264, 20, 2263, 19,
+	2261, 2, 135, 150, 2258, 3, 2257, 2253, 10, 2251,
+	2250, 1950, 2249, 2248, 2247, 2246, 83, 2244, 1917, 1915,
+	2243, 2239, 1596, 204, 2238, 2237, 110, 1913, 1910, 2236,
+	2235, 1907, 1905, 1903, 2234, 151, 2231, 2230, 2228, 129,
+	90, 133, 2226, 2211, 2210, 101, 82, 2553, 122, 43,
+	103, 1417, 2207, 17, 62, 147, 2204, 125, 120, 2203,
+	143, 2202, 69, 235, 89, 2196, 2194, 159, 2193, 2190,
+	2182, 119, 2181, 2180, 2840, 2174, 2172, 139, 2170, 63,
+	54, 45, 2169, 2168, 2167, 2166, 2164, 26, 404, 2163,
+	2162, 124, 2160, 2159, 75, 2156, 2154, 178, 2153, 2152,
+	2149, 121, 42, 2146, 44, 2145, 53, 65, 2144, 58,
+	2142, 126, 2141, 2139, 46, 27, 2133, 70, 2130, 50,
+	2127, 127, 276, 1248, 2126, 28, 9, 11, 34, 60,
+	59, 113, 78, 91, 25, 31, 109, 88, 72, 35,
+	2121, 136, 2120, 76, 142, 123, 116, 140, 2119, 2116,
+	2115, 937, 2114, 107, 71, 2111, 105, 902, 148, 114,
+	2110, 85, 2108, 2107, 2106, 2105, 77, 117, 2104, 2096,
+	111, 134, 48, 684, 1605, 2209, 137, 141, 2084, 37,
+	2081, 2074, 3351, 93, 74, 92, 2073, 99, 36, 55,
+	2072, 2069, 2068, 2066, 2059, 2058, 1824, 2057, 2055, 2051,
+	2049, 230, 195, 2048, 2047, 115, 87, 2045, 2044, 2043,
+	2042, 112, 66, 132, 2041, 106, 108, 201, 2040, 68,
+	2039, 2038, 84, 38, 2037, 2036, 2033, 100, 95, 2028,
+	64, 33, 32, 61, 8, 81, 67, 2026, 29, 2025,
+	104, 12, 6, 4, 2024, 2022, 2020, 2019, 2018, 2016,
+	2015, 73, 2012, 2011, 57, 2010, 2009, 2008, 30, 2007,
+	2005, 2004, 130, 102, 39, 145, 296, 7, 2001, 1997,
+	18, 1992, 118, 1990, 47, 1978, 51, 1968, 1964, 56,
+	1954, 1946, 49, 1943, 1941, 146, 1107, 189, 1939, 144,
+	131, 52, 94, 1936, 1927, 0, 646, 152, 162, 1924,
+	1921,
+}
+
+var yyR1 = [...]int{
+	0, 344, 344, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 84, 3, 3, 8, 4, 4, 4,
+	5, 5, 106, 106, 105, 105, 110, 110, 182, 182,
+	182, 6, 6, 7, 7, 9, 9, 88, 88, 10,
+	11, 11, 11, 11, 347, 347, 119, 119, 117, 117,
+	118, 118, 186, 186, 12, 12, 12, 191, 191, 196,
+	196, 196, 199, 199, 199, 199, 177, 178, 14, 14,
+	14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
+	318, 318, 327, 327, 327, 328, 328, 326, 326, 326,
+	326, 326, 330, 330, 331, 331, 329, 329, 329, 329,
+	333, 333, 334, 334, 332, 332, 332, 332, 332, 332,
+	332, 332, 332, 332, 332, 332, 332, 332, 321, 321,
+	321, 34, 34, 34, 34, 35, 35, 35, 35, 35,
+	35, 335, 335, 335, 335, 336, 336, 337, 337, 338,
+	338, 339, 339, 339, 339, 340, 340, 340, 340, 340,
+	341, 341, 341, 341, 341, 341, 341, 342, 342, 343,
+	343, 2, 299, 299, 300, 300, 301, 301, 301, 301,
+	302, 302, 303, 303, 304, 304, 304, 304, 304, 304,
+	304, 304, 304, 304, 25, 219, 219, 320, 320, 320,
+	320, 314, 314, 314, 316, 316, 315, 315, 315, 317,
+	317, 322, 322, 322, 322, 322, 324, 324, 324, 324,
+	324, 324, 325, 325, 325, 323, 323, 59, 59, 60,
+	60, 60, 170, 170, 170, 13, 13, 13, 13, 13,
+	20, 22, 22, 48, 48, 49, 23, 23, 23, 23,
+	50, 50, 51, 26, 26, 26, 26, 26, 26, 57,
+	57, 57, 56, 56, 55, 55, 55, 55, 55, 55,
+	24, 24, 24, 24, 58, 58, 53, 53, 52, 54,
+	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
+	54, 27, 27, 27, 27, 27, 27, 19, 298, 298,
+	298, 47, 47, 21, 21, 21, 21, 21, 21, 21,
+	21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+	21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+	21, 21, 64, 274, 275, 275, 275, 275, 275, 275,
+	275, 275, 262, 262, 263, 239, 239, 239, 239, 239,
+	239, 239, 239, 238, 238, 238, 238, 242, 242, 240,
+	240, 240, 240, 240, 240, 240, 240, 240, 240, 241,
+	241, 241, 241, 241, 241, 241, 241, 243, 243, 243,
+	243, 243, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 244, 244, 244,
+	244, 244, 244, 244, 244, 244, 244, 245, 245, 245,
+	245, 245, 245, 245, 245, 261, 261, 246, 246, 255,
+	255, 256, 256, 256, 253, 253, 254, 254, 249, 250,
+	257, 251, 251, 251, 252, 252, 252, 260, 260, 260,
+	260, 247, 264, 264, 284, 284, 283, 283, 282, 282,
+	282, 273, 273, 279, 279, 279, 279, 279, 279, 279,
+	268, 268, 268, 267, 267, 269, 269, 272, 272, 281,
+	281, 280, 280, 265, 265, 265, 285, 285, 285, 285,
+	285, 266, 266, 266, 266, 286, 270, 270, 271, 271,
+	271, 292, 293, 291, 291, 291, 291, 291, 134, 134,
+	134, 276, 276, 276, 277, 277, 277, 278, 278, 278,
+	296, 296, 295, 295, 61, 61, 62, 67, 67, 66,
+	66, 66, 66, 66, 66, 66, 66, 66, 66, 66,
+	66, 66, 66, 66, 66, 66, 66, 66, 66, 66,
+	66, 66, 66, 66, 66, 66, 169, 169, 169, 63,
+	217, 217, 290, 290, 289, 287, 287, 288, 288, 15,
+	15, 65, 65, 319, 319, 16, 16, 16, 16, 16,
+	16, 16, 16, 16, 294, 294, 294, 17, 17, 68,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 69, 69, 69, 69, 69, 69, 69, 69,
+	69, 69, 102, 205, 205, 204, 204, 203, 203, 203,
+	206, 206, 206, 236, 236, 236, 70, 70, 80, 80,
+	83, 83, 83, 81, 82, 28, 29, 29, 29, 29,
+	30, 218, 218, 78, 78, 79, 79, 79, 79, 94,
+	94, 349, 349, 77, 77, 71, 71, 73, 73, 72,
+	72, 72, 74, 74, 75, 75, 76, 76, 76, 18,
+	350, 85, 86, 86, 87, 87, 87, 209, 209, 91,
+	91, 91, 89, 89, 89, 90, 90, 96, 96, 100,
+	100, 100, 100, 99, 99, 99, 99, 172, 172, 172,
+	173, 173, 174, 174, 174, 175, 175, 176, 176, 176,
+	176, 176, 142, 142, 228, 228, 228, 227, 227, 227,
+	227, 104, 104, 107, 107, 108, 108, 108, 108, 111,
+	155, 155, 128, 128, 129, 129, 129, 129, 129, 140,
+	140, 185, 185, 184, 184, 187, 187, 109, 109, 109,
+	109, 114, 114, 115, 115, 116, 116, 216, 216, 234,
+	234, 234, 120, 120, 120, 122, 121, 121, 121, 121,
+	123, 123, 125, 126, 126, 124, 124, 127, 130, 130,
+	130, 130, 131, 131, 101, 101, 101, 101, 101, 101,
+	101, 202, 202, 133, 133, 132, 132, 132, 132, 132,
+	132, 132, 132, 132, 132, 150, 150, 150, 150, 150,
+	150, 135, 135, 135, 135, 135, 135, 135, 95, 95,
+	151, 151, 151, 113, 112, 112, 98, 98, 97, 97,
+	152, 152, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 145, 145, 149,
+	149, 149, 149, 149, 149, 149, 149, 149, 149, 149,
+	149, 149, 149, 149, 149, 149, 148, 148, 148, 148,
+	148, 148, 148, 148, 148, 148, 148, 143, 143, 143,
+	143, 143, 143, 143, 143, 143, 143, 143, 143, 143,
+	143, 143, 143, 143, 143, 143, 143, 143, 144, 144,
+	144, 144, 144, 144, 144, 144, 144, 144, 144, 144,
+	144, 144, 144, 144, 144, 348, 348, 147, 146, 146,
+	146, 146, 146, 146, 92, 92, 92, 92, 92, 235,
+	235, 237, 237, 237, 237, 237, 237, 237, 237, 237,
+	237, 237, 237, 237, 162, 162, 93, 93, 160, 160,
+	161, 163, 163, 159, 159, 159, 137, 137, 137, 137,
+	137, 137, 137, 137, 139, 139, 139, 164, 164, 153,
+	153, 103, 165, 165, 166, 167, 167, 168, 168, 171,
+	179, 179, 179, 180, 180, 180, 180, 141, 141, 181,
+	181, 181, 136, 136, 136, 136, 136, 136, 136, 183,
+	183, 183, 183, 188, 188, 154, 154, 157, 157, 156,
+	158, 189, 189, 193, 193, 190, 190, 194, 194, 194,
+	194, 197, 197, 198, 198, 198, 195, 195, 195, 192,
+	192, 192, 231, 231, 231, 200, 200, 210, 210, 207,
+	207, 208, 208, 201, 201, 248, 248, 213, 213, 213,
+	213, 213, 213, 213, 213, 215, 215, 214, 214, 214,
+	211, 211, 211, 212, 212, 229, 229, 225, 225, 230,
+	230, 226, 226, 232, 232, 233, 233, 297, 297, 258,
+	258, 307, 307, 259, 259, 308, 308, 310, 310, 305,
+	305, 306, 306, 309, 309, 31, 311, 311, 312, 312,
+	313, 313, 313, 313, 32, 33, 33, 33, 36, 37,
+	37, 38, 38, 39, 39, 39, 39, 39, 40, 40,
+	41, 41, 41, 41, 41, 41, 42, 42, 43, 43,
+	43, 45, 46, 46, 46, 44, 44, 44, 44, 44,
+	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 222, 222, 222, 222, 222, 222, 222, 222, 222,
+	222, 222, 222, 222, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 223,
+	223, 223, 223, 223, 223, 223, 223, 223, 223, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
+	224, 224, 224, 224, 224, 224, 224, 224, 224, 345,
+	346, 220, 221, 221, 221,
+}
+
+var yyR2 = [...]int{
+	0, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 0, 11, 4, 7, 5, 1, 13, 3,
+	10, 12, 2, 3, 1, 3, 4, 7, 0, 1,
+	2, 1, 3, 1, 3, 7, 8, 1, 1, 9,
+	8, 7, 6, 6, 1, 1, 1, 3, 1, 3,
+	1, 3, 0, 4, 3, 4, 5, 1, 3, 3,
+	2, 2, 2, 2, 2, 1, 0, 0, 2, 3,
+	3, 11, 7, 9, 5, 5, 15, 11, 9, 4,
+	0, 3, 0, 2, 2, 1, 3, 1, 1, 2,
+	2, 2, 0, 2, 1, 2, 2, 2, 2, 2,
+	0, 1, 1, 2, 3, 3, 5, 3, 3, 4,
+	5, 4, 4, 2, 2, 2, 2, 2, 0, 2,
+	2, 9, 9, 5, 7, 7, 7, 7, 8, 4,
+	6, 2, 2, 2, 2, 1, 3, 0, 3, 1,
+	3, 0, 1, 1, 1, 1, 3, 1, 3, 3,
+	0, 2, 5, 5, 5, 7, 5, 0, 3, 0,
+	3, 4, 0, 1, 1, 3, 2, 3, 3, 3,
+	0, 1, 1, 2, 2, 2, 2, 1, 2, 2,
+	3, 3, 3, 3, 4, 0, 3, 1, 1, 1,
+	1, 3, 2, 1, 1, 3, 3, 2, 1, 1,
+	3, 1, 2, 5, 4, 6, 4, 3, 3, 6,
+	5, 5, 4, 3, 5, 1, 3, 1, 1, 1,
+	1, 1, 0, 2, 2, 1, 1, 1, 1, 1,
+	4, 5, 8, 1, 2, 5, 7, 10, 8, 11,
+	1, 2, 5, 5, 5, 5, 6, 3, 5, 1,
+	1, 1, 1, 3, 1, 1, 1, 2, 1, 1,
+	2, 4, 2, 4, 2, 3, 1, 3, 3, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 2, 4, 3, 2, 4, 3, 0, 2,
+	3, 1, 3, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 5, 4, 1, 1, 2, 3, 4, 3,
+	3, 3, 3, 3, 3, 0, 2, 3, 2, 2,
+	2, 2, 2, 3, 1, 1, 1, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	2, 3, 2, 2, 2, 2, 2, 1, 2, 2,
+	2, 1, 4, 4, 3, 3, 2, 4, 5, 2,
+	3, 4, 2, 2, 3, 3, 3, 3, 3, 4,
+	1, 1, 1, 1, 1, 6, 6, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 0, 3, 0,
+	5, 0, 3, 5, 0, 1, 0, 1, 2, 3,
+	1, 0, 3, 3, 0, 2, 2, 2, 1, 2,
+	1, 2, 5, 4, 0, 1, 1, 2, 2, 3,
+	2, 0, 1, 2, 4, 3, 5, 3, 2, 2,
+	1, 1, 1, 1, 1, 0, 1, 0, 1, 1,
+	3, 3, 3, 3, 3, 1, 10, 11, 11, 12,
+	12, 3, 3, 2, 1, 5, 1, 1, 0, 2,
+	2, 3, 3, 1, 1, 2, 2, 2, 0, 1,
+	2, 0, 1, 3, 1, 2, 3, 1, 1, 1,
+	0, 2, 0, 2, 1, 1, 5, 1, 3, 2,
+	5, 4, 3, 3, 3, 5, 3, 8, 10, 3,
+	3, 3, 3, 3, 5, 4, 5, 1, 2, 2,
+	4, 3, 6, 5, 3, 8, 0, 1, 2, 4,
+	0, 1, 1, 1, 7, 1, 3, 8, 8, 3,
+	3, 3, 5, 3, 5, 5, 6, 4, 4, 4,
+	4, 4, 4, 4, 0, 1, 1, 3, 2, 3,
+	4, 4, 3, 6, 6, 4, 4, 4, 4, 4,
+	4, 3, 3, 2, 6, 2, 4, 4, 4, 5,
+	7, 6, 3, 5, 4, 2, 4, 3, 2, 4,
+	5, 6, 2, 6, 6, 3, 3, 3, 3, 3,
+	3, 3, 3, 0, 1, 1, 1, 0, 2, 2,
+	0, 2, 2, 0, 1, 1, 2, 1, 1, 1,
+	2, 4, 4, 1, 1, 2, 3, 4, 4, 5,
+	3, 1, 1, 3, 3, 1, 1, 1, 1, 0,
+	3, 1, 1, 2, 2, 4, 5, 1, 1, 0,
+	1, 1, 0, 1, 1, 2, 1, 1, 1, 2,
+	0, 2, 0, 2, 1, 2, 2, 0, 1, 0,
+	1, 1, 0, 1, 1, 0, 1, 3, 5, 1,
+	1, 3, 5, 1, 2, 3, 5, 2, 4, 8,
+	0, 1, 0, 2, 2, 4, 1, 2, 2, 2,
+	2, 2, 1, 3, 0, 1, 2, 1, 1, 1,
+	1, 1, 3, 1, 1, 1, 4, 1, 3, 2,
+	2, 4, 2, 6, 1, 4, 6, 3, 2, 0,
+	3, 0, 3, 1, 3, 1, 3, 4, 4, 4,
+	3, 2, 4, 0, 1, 0, 2, 0, 1, 1,
+	1, 1, 1, 2, 2, 1, 2, 3, 2, 3,
+	2, 2, 3, 2, 1, 1, 3, 3, 0, 5,
+	5, 5, 0, 2, 1, 3, 3, 2, 3, 1,
+	2, 0, 3, 1, 1, 3, 3, 4, 4, 5,
+	3, 4, 5, 6, 2, 1, 2, 1, 2, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 0, 2,
+	1, 1, 1, 3, 1, 3, 0, 1, 1, 3,
+	1, 3, 1, 1, 1, 1, 1, 1, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 2, 2, 2, 2, 2, 2, 2,
+	3, 1, 1, 1, 1, 1, 1, 5, 6, 6,
+	6, 5, 5, 5, 6, 5, 5, 6, 5, 5,
+	5, 5, 6, 5, 5, 5, 4, 4, 5, 5,
+	5, 5, 5, 4, 4, 4, 4, 4, 4, 4,
+	3, 6, 6, 6, 8, 8, 8, 8, 4, 6,
+	7, 7, 7, 9, 4, 7, 5, 4, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 8, 8, 0, 2, 3, 4, 4,
+	4, 4, 4, 4, 0, 3, 4, 7, 3, 1,
+	1, 2, 3, 3, 1, 2, 2, 1, 2, 1,
+	2, 2, 1, 2, 0, 1, 0, 2, 1, 2,
+	4, 0, 2, 1, 3, 5, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 2, 2, 0, 3, 1,
+	3, 1, 0, 2, 1, 0, 3, 1, 3, 2,
+	0, 1, 1, 0, 2, 4, 4, 1, 1, 0,
+	2, 4, 2, 4, 1, 3, 5, 4, 6, 1,
+	3, 3, 5, 0, 5, 1, 3, 1, 2, 3,
+	1, 1, 3, 3, 3, 1, 3, 1, 2, 2,
+	3, 1, 1, 1, 1, 1, 3, 3, 3, 1,
+	2, 1, 1, 1, 1, 1, 1, 0, 1, 0,
+	2, 0, 3, 0, 1, 0, 3, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 0, 1, 1,
+	1, 1, 1, 0, 1, 0, 2, 1, 1, 1,
+	3, 1, 1, 1, 1, 1, 1, 0, 2, 0,
+	1, 0, 4, 0, 1, 0, 3, 0, 3, 0,
+	4, 0, 3, 0, 3, 3, 1, 3, 2, 4,
+	1, 2, 1, 2, 2, 2, 3, 3, 4, 2,
+	4, 3, 3, 1, 2, 4, 3, 3, 0, 1,
+	3, 5, 3, 6, 7, 10, 1, 1, 1, 1,
+	1, 4, 0, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 0, 0, 1, 1,
+}
+
+var yyChk = [...]int{
+	-1000, -344, -1, -3, -8, -9, -10, -11, -12, -14,
+	-61, -15, -16, -17, -68, -69, -70, -80, -81, -82,
+	-78, -77, -71, -18, -24, -27, -19, -84, -28, -29,
+	-30, -31, -32, -33, -34, -35, -36, -37, -38, -39,
+	-41, -45, -4, 6, 7, -88, 9, 10, 30, -64,
+	131, -62, -63, 134, 133, 170, 135, 163, 64, 265,
+	-83, 268, 269, -349, -218, 169, 168, 137, 216, 217,
+	20, 37, 270, 272, 31, 32, 185, 233, 235, 479,
+	480, 481, 482, 483, 225, 491, -5, -106, -6, 8,
+	363, 132, 266, 165, 164, 26, 415, -345, 68, 496,
+	-167, 15, -85, -350, -85, -85, -85, -85, -85, -274,
+	-216, 106, 68, 23, -212, 144, 87, 354, 140, -219,
+	229, 231, -210, -211, 193, 203, 44, 151, 150, 141,
+	229, 141, 142, 144, 354, 140, 178, 177, 229, 231,
+	479, 141, -124, -232, 72, -223, 154, 426, 254, 412,
+	132, 241, 190, 265, 279, 281, 273, 308, 296, 413,
+	427, 155, 211, 139, 294, 297, 341, 153, 491, 244,
+	204, 428, 488, 330, 336, 214, 268, 350, 429, 156,
+	208, 210, 209, 198, 215, 36, 166, 292, 250, 481,
+	285, 219, 193, 430, 420, 283, 28, 188, 431, 338,
+	313, 253, 432, 480, 417, 248, 493, 337, 288, 284,
+	137, 157, 151, 434, 314, 318, 435, 342, 234, 436,
+	437, 438, 143, 240, 277, 278, 439, 194, 344, 245,
+	312, 33, 152, 414, 490, 48, 175, 345, 38, 316,
+	37, 322, 440, 311, 306, 323, 484, 441, 442, 443,
+	444, 478, 258, 256, 257, 259, 310, 276, 305, 206,
+	53, 138, 320, 319, 321, 207, 340, 301, 299, 445,
+	446, 249, 158, 447, 448, 286, 18, 449, 450, 348,
+	487, 169, 251, 252, 42, 451, 452, 453, 173, 454,
+	455, 456, 339, 315, 317, 192, 457, 289, 479, 489,
+	148, 458, 255, 459, 264, 416, 492, 238, 382, 346,
+	282, 460, 272, 174, 168, 349, 485, 461, 483, 217,
+	462, 463, 464, 160, 465, 232, 231, 269, 381, 270,
+	332, 212, 466, 467, 468, 469, 202, 187, 352, 343,
+	52, 482, 216, 327, 470, 486, 275, 150, 218, 471,
+	242, 266, 43, 7, 205, 246, 333, 213, 203, 303,
+	176, 472, 473, 290, 291, 309, 274, 304, 267, 178,
+	179, 170, 262, 351, 328, 418, 229, 495, 298, 295,
+	182, 302, 474, 144, 475, 183, 271, 347, 243, 293,
+	329, 141, 124, 297, 341, 131, 331, 332, 338, -268,
+	339, 177, 180, -236, 141, -205, 330, 234, 255, 387,
+	183, 184, 72, 484, 487, 485, 486, 142, 143, 33,
+	343, 342, 334, -232, 145, 271, -94, 135, 171, -124,
+	-220, -72, 494, 322, -72, -220, -58, 72, 218, -58,
+	30, 72, 72, 36, 72, 270, 333, 333, 75, 416,
+	186, 21, -336, -317, 264, -335, -315, 8, 159, 6,
+	9, -320, 74, 72, -223, -224, 383, 384, 385, 386,
+	387, 400, 401, 402, 388, 389, 403, 404, 405, 390,
+	391, 406, 407, 409, 410, 408, 181, 394, 393, 392,
+	395, 396, 51, 398, 397, 399, 21, -336, -317, 264,
+	-225, 72, -223, -225, 479, 484, 485, 486, -124, 141,
+	6, -87, 5, -201, 144, 146, 267, -105, 425, -110,
+	-234, -232, -224, 74, -3, -180, 17, 16, -91, 54,
+	55, -86, -99, 115, -101, -232, -132, 89, -138, 29,
+	72, -223, 24, -137, -224, -133, -159, -158, -113, 124,
+	125, 126, 113, 114, 121, 90, 127, -145, -143, -144,
+	-146, -148, -149, 74, 73, 82, 75, 76, 77, 78,
+	83, 383, 384, 385, 386, 387, 400, 401, 402, 388,
+	389, 403, 404, 405, 390, 391, 406, 407, 409, 410,
+	408, 394, 393, 392, 395, 396, 398, 397, 399, 84,
+	85, -225, -156, -345, 58, 59, 171, 140, 364, 365,
+	366, 367, 368, 411, 161, 372, 92, 47, 353, 362,
+	361, 360, 358, 359, 355, 357, 356, 374, 375, 147,
+	354, 119, 363, -201, -201, 11, -117, -118, -124, -127,
+	-232, -190, 267, -197, -194, 342, 343, -195, -198, -192,
+	-159, 322, 455, 456, 341, 297, 340, -225, -232, -2,
+	-5, 6, -124, -275, -263, -266, -226, 156, -286, -225,
+	-222, 153, 247, 136, 162, 88, 419, 23, 25, 237,
+	325, 383, 91, 124, 384, 385, 386, 16, 20, 92,
+	123, 81, 364, 186, 387, 131, 62, 380, 355, 357,
+	353, 354, 331, 29, 10, 26, 164, 197, 22, 117,
+	133, 95, 96, 97, 167, 24, 165, 260, 85, 161,
+	433, 19, 65, 11, 180, 233, 13, 378, 379, 14,
+	230, 147, 146, 108, 195, 142, 60, 8, 127, 27,
+	105, 56, 388, 389, 422, 45, 185, 423, 58, 106,
+	17, 358, 359, 31, 411, 390, 172, 424, 391, 119,
+	200, 63, 50, 236, 89, 83, 34, 86, 66, 87,
+	15, 196, 61, 376, 239, 261, 177, 199, 425, 159,
+	107, 134, 363, 263, 235, 59, 140, 6, 373, 30,
+	163, 394, 393, 392, 395, 201, 57, 366, 367, 396,
+	476, 141, 94, 374, 375, 145, 84, 5, 44, 32,
+	9, 64, 67, 360, 361, 362, 47, 51, 398, 397,
+	399, 93, 12, 377, 415, 181, 142, -124, 363, -208,
+	147, -208, 178, 177, -208, -208, 141, 98, -65, -124,
+	-319, -314, -320, -207, 147, -225, -207, -207, -207, -207,
+	-207, -207, -207, -225, -124, 128, -124, 72, 30, -206,
+	106, 12, 354, 140, 72, 177, 180, 141, 178, 144,
+	-221, -345, -226, -221, -270, 11, 108, 181, 181, 181,
+	182, 181, -204, 333, 335, 179, 336, 337, 12, -102,
+	106, 19, -345, -180, -180, -221, -221, -221, -221, -221,
+	72, 270, 145, -79, -3, -11, -9, -10, -3, 98,
+	-73, 141, 333, -73, 30, 30, 74, 51, 30, -53,
+	-52, -54, 204, 205, 206, 207, 208, 209, 210, 211,
+	212, 213, 214, 215, 30, -298, 68, -258, 322, 72,
+	-311, -312, -124, 75, 75, 66, 66, 69, 145, 69,
+	66, -337, 68, -337, -337, -337, 71, 66, 69, 255,
+	66, 11, 66, 11, 67, -225, 145, -40, 21, -40,
+	487, 488, 346, -117, -85, -7, -5, -345, 21, 22,
+	141, -124, 346, 69, -105, 23, -345, -346, 70, -181,
+	19, 31, -141, 75, 78, -168, -171, -101, -232, 50,
+	-89, 21, 22, 80, 11, -228, 88, 87, 105, -227,
+	23, 72, -223, -224, 74, 128, -101, -135, 108, 89,
+	106, 107, 91, 110, 109, 120, 113, 114, 115, 116,
+	117, 118, 119, 111, 112, 123, 98, 99, 100, 101,
+	102, 103, 104, -202, -345, -113, -345, 129, 130, -138,
+	-138, -138, -138, -138, -138, -138, -138, -345, -345, -345,
+	-345, -345, -345, -345, -345, -345, -345, -345, -345, -345,
+	-345, -345, -345, -345, -345, -345, -345, -345, -345, -345,
+	-345, -345, -345, -345, -345, -345, -3, -152, -101, -345,
+	-345, -345, -345, -345, -345, -345, -345, -345, -345, -345,
+	-345, -345, -162, -101, -345, -348, -147, -345, -348, -147,
+	-348, -147, -348, -345, -348, -147, -348, -147, -348, -348,
+	-147, -348, -345, -345, -345, -345, -345, -345, -126, 27,
+	-124, -104, -107, -108, -109, -128, -112, -345, -124, -113,
+	-124, -117, -347, 69, 11, 67, -347, 69, 128, 69,
+	-191, -196, 344, 346, 267, -195, -195, -231, -225, 74,
+	29, 98, 30, 128, -167, -85, 70, 69, -266, 156,
+	153, -238, -242, -244, -243, -245, -240, -241, 294, 297,
+	301, 299, 295, 300, 124, 298, 303, 304, 305, 306,
+	307, 308, 309, 310, 311, 312, 313, 30, 166, 290,
+	291, 292, 293, 314, 315, 316, 317, 318, 319, 320,
+	321, 273, 296, 413, 274, 275, 276, 277, 278, 279,
+	281, 282, 283, 284, 285, 286, 287, 288, 72, -224,
+	-286, 68, -225, 23, 144, 72, 89, 72, 72, 72,
+	-323, -322, -314, -317, -208, 72, 69, 145, 69, 145,
+	71, -117, 24, 66, -119, -124, 72, 72, 72, 72,
+	-316, -314, -317, -233, -232, -222, -221, -206, 74, -101,
+	-208, -208, -221, -221, -221, -124, -124, -124, -124, -206,
+	-206, -221, -206, -203, 11, 108, 11, -203, -203, -101,
+	-138, -314, 356, 115, 72, 72, 270, 72, -117, -117,
+	-53, -53, 74, -53, 69, 98, -53, 70, -152, -297,
+	477, 69, -313, 23, 346, 347, 323, -339, 141, 180,
+	177, -339, -335, -316, -315, -314, -338, -225, -320, -339,
+	233, 69, -339, -316, -314, -138, -152, 75, -216, -42,
+	-225, 161, 50, -46, 492, 493, -91, -3, -124, -221,
+	347, 348, -110, -182, 478, 89, -183, -226, 9, 108,
+	69, 18, 69, -179, 25, 26, 128, -139, 51, 75,
+	78, -209, 49, -124, -101, -101, -150, 83, 89, 84,
+	85, -227, 115, -233, -226, -222, -138, -151, -156, -113,
+	79, 108, 106, 107, 91, -138, -138, -138, -138, -138,
+	-138, -138, -138, -138, -138, -138, -138, -138, -138, -138,
+	-235, 72, 74, 72, -137, -137, -225, -89, -97, -100,
+	115, -101, -232, -97, -97, -89, -346, -346, -100, -97,
+	-97, -97, -100, -97, -89, -89, -97, -346, -346, -346,
+	-346, -97, -97, -97, -97, -89, -97, -97, -97, -89,
+	-346, 69, -346, -3, -97, -97, -97, -346, -101, -101,
+	-159, 74, -97, -159, 74, -97, -138, 369, 370, 371,
+	-97, -97, -89, -160, -161, 93, -159, -346, -138, -225,
+	-225, -97, -98, -97, -97, -97, -186, 173, -124, 30,
+	69, -120, -122, -121, -123, 56, 60, 62, 57, 58,
+	59, 63, -216, -104, -111, 47, -345, -129, 173, -130,
+	23, -234, 64, 146, 65, -186, 67, -104, -124, -104,
+	-127, -232, 115, -194, -197, 69, 345, 347, 348, -191,
+	-252, 123, 66, 86, -101, -226, -233, -180, -91, -276,
+	-277, -278, -226, 74, 75, -263, -264, -265, -266, -279,
+	156, -285, 148, 150, 44, -267, 157, 142, 45, -239,
+	-253, 328, -246, 68, -246, -246, 302, 294, 297, 295,
+	-246, -246, -246, -246, -246, -251, 297, -251, -251, -251,
+	-251, 295, 68, 68, -246, -246, -246, -255, 68, -255,
+	289, -256, 68, -256, -256, -256, -256, -286, -286, -101,
+	-229, 67, -177, -124, -221, 24, -221, -59, 190, 162,
+	68, -318, 69, 29, -324, 230, -124, -124, -124, -314,
+	-314, -320, -294, 160, 155, -124, 69, 69, 72, 72,
+	-271, 11, 108, -206, -232, -232, -124, -140, 23, -206,
+	-95, 167, 67, -348, -346, 72, -74, -75, -76, 492,
+	493, 495, -52, -137, 70, -125, 27, 74, -312, -234,
+	322, 347, -340, 115, -225, -340, -343, 415, 145, 70,
+	69, -340, 251, 233, -340, 11, -232, -131, 12, -43,
+	-44, -42, 489, 490, 98, 99, 100, 101, 102, -89,
+	-346, -67, -66, -213, 136, 133, 134, 138, 139, -289,
+	325, 132, 297, 81, 29, 15, 364, 173, 418, 72,
+	174, -112, -345, 478, -346, 69, 128, 52, -141, -141,
+	-171, -200, 19, 11, 47, 47, -90, 57, 83, 84,
+	85, 128, -345, -151, -138, -138, -138, -95, 88, -346,
+	-97, 69, -346, 128, -346, -346, -97, -172, 376, -172,
+	-346, -346, -346, -346, -346, -346, -97, -97, -346, -172,
+	-172, -172, -172, -346, -346, -346, -346, -97, -346, -346,
+	-346, -98, -101, -346, -346, -346, -346, 69, 67, 23,
+	11, 11, -346, 11, 11, -346, -346, 11, -138, -138,
+	-138, -346, -346, -97, -163, -161, 95, -101, -346, -346,
+	69, 69, -346, -346, -346, -346, -136, 30, 47, -345,
+	-3, -345, -189, -193, -159, -222, -107, -108, -108, -107,
+	-108, 56, 56, 56, 61, 56, 61, 56, -121, -234,
+	-346, -346, -155, 189, -345, 34, -234, -130, 142, 142,
+	142, -131, -104, -131, -131, 128, -196, -199, 349, 346,
+	352, 72, 74, 128, -181, 69, -278, 98, -266, 68,
+	72, -272, -224, 45, -267, 142, 45, -267, -272, 72,
+	-272, 45, 83, 89, -249, -250, -257, -260, -247, 29,
+	66, 325, 148, 45, 44, 81, -254, 329, 75, -251,
+	-251, -246, -246, -246, 302, -246, -251, -252, 30, -252,
+	-252, -252, -252, -251, -261, 74, -261, 75, -255, 75,
+	70, 66, -225, -3, 23, -60, 8, 9, 10, -299,
+	-300, -301, 72, 108, 195, 196, -327, 263, -322, 231,
+	240, 88, 16, 415, 145, 145, -221, -124, -314, -221,
+	-221, -131, 72, 72, -203, -206, 34, -138, -317, 183,
+	184, -76, -186, 141, -313, 145, 128, 128, 145, 254,
+	-316, -225, 11, 11, 251, 11, -316, -180, -101, -131,
+	-345, -209, 69, -220, -217, -290, -267, -296, -265, -266,
+	-295, 149, 157, 173, 156, -217, -290, 156, 153, -267,
+	157, 148, 149, -214, -267, 145, 23, -217, -217, -273,
+	98, -217, 173, 23, -226, -226, 53, -124, -96, -178,
+	115, -226, -98, -95, 88, -138, -346, -100, -173, -172,
+	115, -233, -173, -173, -346, -225, -345, -172, -173, -173,
+	-172, -172, -172, -346, -346, -172, -173, -173, -173, -173,
+	-346, -173, -173, -173, -346, -237, 124, 294, 166, 292,
+	285, 312, 299, 327, 290, 328, -235, -237, -138, -138,
+	-138, -138, -138, 11, 11, 11, 412, -167, 97, -101,
+	94, -138, -138, -188, 66, -189, -154, -157, -156, -345,
+	-3, -346, -183, 6, 415, -187, -225, -131, 69, 98,
+	98, -115, -114, 66, 67, -116, 66, -114, 56, 56,
+	-185, 68, 69, -156, -345, -187, -138, -130, -345, -345,
+	-345, -167, -131, 346, 350, 351, -277, -278, -281, -280,
+	-225, -224, 148, -285, 157, 44, -285, -272, -272, 68,
+	83, -138, 9, 45, 45, 74, 70, -252, -252, -251,
+	-246, -252, 72, 124, -252, 70, 69, 70, 69, 70,
+	69, -134, 431, 89, -124, -177, -177, 66, 70, 69,
+	-238, 72, 72, 72, -330, 415, 236, -328, -326, 242,
+	243, 244, 245, 246, -317, 241, -324, 238, 74, 72,
+	-124, -314, -206, -138, -251, -124, -316, 115, 115, -225,
+	-316, 251, -342, 415, -316, -316, 11, -316, -180, -152,
+	-90, -66, 68, -262, 72, -224, -220, -272, -211, 148,
+	72, 72, -220, 72, -224, 72, -224, -225, 45, 45,
+	72, -124, -225, -262, 72, -101, -225, -225, -182, 128,
+	-131, 11, 69, -99, -346, -138, -173, 128, -173, -167,
+	173, -173, -173, -173, -346, -246, -246, -246, -256, -246,
+	278, -246, 278, -346, -346, 19, 19, 19, 19, -346,
+	-138, -138, -138, -345, -93, 373, -101, 69, 69, 28,
+	-188, 69, -346, -346, 47, -346, -346, 69, -167, -193,
+	-101, -101, -101, 68, -101, -184, -225, 189, -346, -130,
+	-216, -184, -184, -184, -180, 70, 69, -246, -246, 45,
+	-269, -267, -184, -144, -252, -251, 74, -251, 75, 75,
+	431, 68, -3, -124, -302, -303, -304, 81, 414, 89,
+	197, 198, 158, 199, 200, 201, -301, -238, -238, -238,
+	-333, -334, -332, 239, 260, 261, 247, -331, -329, 256,
+	257, 258, 259, 88, 74, 74, 74, -325, 230, 88,
+	239, 16, 23, -305, -204, -342, -342, 233, -316, -346,
+	-96, -262, -169, 161, 162, -238, -238, -229, -269, 45,
+	72, -215, 145, 23, 145, -169, -262, 30, 133, 27,
+	-112, -226, -164, 13, -104, -178, -178, 115, -346, 16,
+	-251, 72, -138, -138, -138, -138, -346, -346, -346, -138,
+	-346, 74, -138, -138, 45, -157, -154, 47, -3, -345,
+	-225, -180, -184, 70, 69, -156, -129, -234, -346, -346,
+	-346, -283, -282, 67, 152, 81, -280, -179, -179, -272,
+	70, -252, -252, 70, 70, -281, -177, 19, -177, -304,
+	74, 201, 197, 201, 201, 201, 201, 202, -321, 81,
+	237, -332, 248, 437, 232, 263, 75, 75, 262, 31,
+	32, -329, 75, 75, 75, 75, -326, 16, 415, -324,
+	238, 74, 74, -306, 38, -308, 39, -341, 23, -341,
+	251, -131, 11, 70, 72, -239, -239, 68, -272, 68,
+	72, -225, -169, 29, 29, -345, -165, 14, 16, -131,
+	-99, -152, -346, -346, -346, -346, -92, 108, 415, -346,
+	-346, 9, -154, -3, 70, -225, -130, -282, 72, -273,
+	74, 159, 70, 188, -21, -3, -9, -10, -11, -12,
+	-14, -61, -15, -16, -22, -23, -17, -68, -69, -83,
+	-81, -82, -78, -77, -26, -24, -27, -19, -28, -29,
+	-30, -34, -35, -25, 133, 92, 147, 219, 265, 36,
+	36, 193, 194, 74, 74, 29, 249, 127, 29, 75,
+	127, 380, 238, 74, 72, 239, -248, 146, -309, 43,
+	-307, -259, 42, 16, -314, -180, -104, -281, -229, -281,
+	-138, -287, -288, 173, -166, -101, -153, -103, -101, -164,
+	-178, -167, -346, 413, 63, 416, -189, -346, 75, -124,
+	-284, -283, 189, -177, -101, -101, 72, -57, -230, 222,
+	223, 224, -226, -47, -21, 75, 29, 75, 29, 252,
+	239, 23, -185, 75, -308, 16, -310, 40, 41, 74,
+	415, -131, 70, 68, 70, -346, 69, -225, 69, -165,
+	-174, 381, 382, 53, 414, 417, 68, -170, 191, 192,
+	-48, -49, 93, 94, 220, 221, 225, -238, 69, 496,
+	250, 250, 74, 38, 74, 16, 16, 231, -164, -284,
+	-281, -284, -288, 47, -103, -346, -175, 91, -176, 262,
+	380, -142, 75, 127, -175, 53, -184, -177, 72, 72,
+	97, 95, -49, -101, -47, 19, 19, 19, 29, -226,
+	97, -21, 74, 74, 29, 236, 21, -317, -165, 70,
+	175, -176, 457, 433, 189, 457, 433, -137, 415, 70,
+	-13, -20, -12, -9, -10, -11, 265, 92, -47, 94,
+	496, -58, 75, -3, -56, -55, 75, -58, 227, 89,
+	228, 72, -138, 253, -284, 176, 88, -225, 416, -292,
+	-293, 66, -177, -47, 496, -47, 97, 95, -50, -51,
+	96, -21, 69, 226, -317, -345, -176, 417, -293, 66,
+	-292, 66, 10, 9, 496, 97, 496, 147, -47, 97,
+	95, -51, -101, -55, -138, 172, -291, 160, 155, 158,
+	30, -291, 97, 92, 496, 147, -47, 94, -346, -346,
+	154, 29, 83, 97, 496, -47, 147, 97, 496, 147,
+}
+
+var yyDef = [...]int{
+	42, -2, 1, 3, 4, 5, 6, 7, 8, 9,
+	10, 11, 12, 13, 14, 15, 16, 17, 18, 19,
+	20, 21, 22, 23, 24, 25, 26, 27, 28, 29,
+	30, 31, 32, 33, 34, 35, 36, 37, 38, 39,
+	40, 41, -2, 700, 700, 700, 700, 700, 700, 787,
+	-2, 534, 535, 0, 0, 0, 0, -2, 657, 658,
+	659, 663, 664, 679, -2, 689, 689, 1621, 0, 311,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1163, 0, 0, 0, 47, 0, 0, 67,
+	68, 1093, 0, 682, 671, 672, 0, 0, 1619, 2,
+	1033, 0, 709, 702, 0, 1093, 1093, 0, 0, 98,
+	0, 0, 0, 788, 0, 0, 0, 1091, 1091, 0,
+	1091, 1091, 0, 1114, 0, 1088, 1110, 1111, 1112, 0,
+	0, 1089, 0, 1089, 1089, 1089, 1089, 1089, 1089, 1089,
+	0, 0, 598, 805, 1123, 1124, 1344, 1345, 1346, 1347,
+	1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355, 1356, 1357,
+	1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365, 1366, 1367,
+	1368, 1369, 1370, 1371, 1372, 1373, 1374, 1375, 1376, 1377,
+	1378, 1379, 1380, 1381, 1382, 1383, 1384, 1385, 1386, 1387,
+	1388, 1389, 1390, 1391, 1392, 1393, 1394, 1395, 1396, 1397,
+	1398, 1399, 1400, 1401, 1402, 1403, 1404, 1405, 1406, 1407,
+	1408, 1409, 1410, 1411, 1412, 1413, 1414, 1415, 1416, 1417,
+	1418, 1419, 1420, 1421, 1422, 1423, 1424, 1425, 1426, 1427,
+	1428, 1429, 1430, 1431, 1432, 1433, 1434, 1435, 1436, 1437,
+	1438, 1439, 1440, 1441, 1442, 1443, 1444, 1445, 1446, 1447,
+	1448, 1449, 1450, 1451, 1452, 1453, 1454, 1455, 1456, 1457,
+	1458, 1459, 1460, 1461, 1462, 1463, 1464, 1465, 1466, 1467,
+	1468, 1469, 1470, 1471, 1472, 1473, 1474, 1475, 1476, 1477,
+	1478, 1479, 1480, 1481, 1482, 1483, 1484, 1485, 1486, 1487,
+	1488, 1489, 1490, 1491, 1492, 1493, 1494, 1495, 1496, 1497,
+	1498, 1499, 1500, 1501, 1502, 1503, 1504, 1505, 1506, 1507,
+	1508, 1509, 1510, 1511, 1512, 1513, 1514, 1515, 1516, 1517,
+	1518, 1519, 1520, 1521, 1522, 1523, 1524, 1525, 1526, 1527,
+	1528, 1529, 1530, 1531, 1532, 1533, 1534, 1535, 1536, 1537,
+	1538, 1539, 1540, 1541, 1542, 1543, 1544, 1545, 1546, 1547,
+	1548, 1549, 1550, 1551, 1552, 1553, 1554, 1555, 1556, 1557,
+	1558, 1559, 1560, 1561, 1562, 1563, 1564, 1565, 1566, 1567,
+	1568, 1569, 1570, 1571, 1572, 1573, 1574, 1575, 1576, 1577,
+	1578, 1579, 1580, 1581, 1582, 1583, 1584, 1585, 1586, 1587,
+	1588, 0, 0, 0, 650, 0, 1622, 1622, 613, 0,
+	615, 0, 0, 0, 0, 0, 625, 628, 632, 0,
+	1033, 1033, 1622, 1622, 1622, 1622, 1622, 480, 481, 482,
+	654, 655, 644, 656, 0, 0, 0, 0, 0, 683,
+	684, 0, 690, 691, 0, 699, 290, 292, 0, 312,
+	0, 315, 318, 1129, 665, 0, 0, 1154, 1155, 0,
+	0, 0, 0, 0, 1502, 165, 229, 167, 167, 167,
+	167, 228, 217, 218, 219, 220, 1589, 1590, 1591, 1592,
+	1593, 1594, 1595, 1596, 1597, 1598, 1599, 1600, 1601, 1602,
+	1603, 1604, 1605, 1606, 1607, 1608, 1609, 1610, 1611, 1612,
+	1613, 1614, 1615, 1616, 1617, 1618, 0, 0, 0, 1502,
+	0, 1117, 1118, 1159, 0, 1164, 1168, 1168, 0, 0,
+	700, 0, 704, 0, 0, 1094, 660, 52, 0, 54,
+	0, 789, 790, 791, 0, 1039, 0, 0, 712, 710,
+	711, 701, 0, 723, 744, 0, 814, 0, 819, 821,
+	-2, -2, 0, 862, 863, 864, 865, 866, 867, 0,
+	0, 0, 0, 0, 0, 0, 0, 891, 892, 893,
+	894, 895, 896, 1006, 1007, 1008, 1009, 1010, 1011, 1012,
+	1013, 1589, 1590, 1591, 1592, 1593, 1594, 1595, 1596, 1597,
+	1598, 1599, 1600, 1601, 1602, 1603, 1604, 1605, 1606, 1607,
+	1608, 1610, 1611, 1612, 1613, 1614, 1616, 1617, 1618, 823,
+	824, 1003, 1060, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 994, 0, 965, 965,
+	965, 965, 965, 965, 965, 965, 965, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 78, 80,
+	805, 84, 1566, 0, 1065, -2, -2, 1067, 0, 0,
+	0, -2, -2, -2, -2, 1363, -2, 1003, 0, 99,
+	1025, 700, 100, 0, 354, 355, 0, 1377, 504, 1121,
+	1122, 1365, 1190, 1191, 1192, 1193, 1194, 1195, 1196, 1197,
+	1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205, 1206, 1207,
+	1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215, 1216, 1217,
+	1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225, 1226, 1227,
+	1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237,
+	1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247,
+	1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255, 1256, 1257,
+	1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265, 1266, 1267,
+	1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275, 1276, 1277,
+	1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285, 1286, 1287,
+	1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295, 1296, 1297,
+	1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305, 1306, 1307,
+	1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317,
+	1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325, 1326, 1327,
+	1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335, 1336, 1337,
+	1338, 1339, 1340, 1341, 1342, 1343, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1091, 0, 579, 0,
+	580, 0, 223, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1162, 597, 0, 599, 1622, 650, 602,
+	0, 0, 1091, 1091, 1622, 1622, 1622, 0, 0, 0,
+	611, 1623, 1624, 612, 0, 506, 507, 650, 650, 1622,
+	650, 647, 0, 647, 622, 647, 645, 646, 0, 627,
+	0, 0, 0, 635, 636, 637, 638, 639, 640, 641,
+	666, 0, 0, 673, 675, 676, 677, 678, 674, 0,
+	0, 687, 688, 0, 0, 0, 294, 0, 0, 314,
+	296, 0, 299, 300, 301, 302, 303, 304, 305, 306,
+	307, 308, 309, 310, 0, 317, 0, 1127, 1130, 670,
+	1145, 1146, 0, 1156, 1157, 171, 171, 0, 0, 0,
+	0, 161, 0, 162, 163, 164, 227, 171, 0, 0,
+	171, 0, 0, 0, 0, 1161, 0, 1166, 1169, 1167,
+	-2, 1172, 0, 1182, 709, 49, 63, 0, 705, 706,
+	0, 1622, 0, 0, 53, 58, 0, 62, 1620, 44,
+	0, 0, 1034, 1037, 1038, 1026, 1027, 1030, 0, 0,
+	707, 713, 714, 703, 0, 724, 0, 0, 0, 745,
+	0, 747, 748, 749, 750, 0, 817, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 841, 842, 843, 844,
+	845, 846, 847, 820, 0, 834, 0, 0, 0, 883,
+	884, 885, 886, 887, 888, 889, 0, 712, 0, 0,
+	0, 712, 0, 0, 0, 0, 0, 0, 0, 0,
+	712, 712, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 712, 0, 0, 0, 712, 0, 0, 860, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 712, 0, 995, 0, 948, 957, 0, 949, 958,
+	950, 959, 951, 0, 952, 960, 953, 961, 954, 955,
+	962, 956, 0, 0, 0, 856, 0, 0, 82, 0,
+	804, 0, 751, 753, 754, 755, -2, 0, 808, 854,
+	-2, 0, 0, 0, 74, 75, 0, 0, 0, 0,
+	85, 87, 0, 0, 1566, 1068, 1069, 454, 1082, 1083,
+	1084, 0, 1080, 0, 1033, 709, 521, 0, 356, 0,
+	0, 365, 444, 374, 375, 376, 437, 378, 437, 437,
+	0, 437, 437, 437, 437, 437, 451, 451, 451, 451,
+	451, 420, 421, 422, 423, 424, 0, 0, 397, 437,
+	437, 437, 401, 427, 428, 429, 430, 431, 432, 433,
+	434, 379, 380, 381, 382, 383, 384, 385, 386, 387,
+	388, 439, 439, 441, 441, 441, 441, 441, 0, 0,
+	503, 0, 1115, 96, 0, 1622, 0, 1622, 0, 0,
+	110, 245, 231, 109, 0, 216, 0, 0, 0, 0,
+	222, 594, 1090, 0, 587, 76, 588, 589, 590, 591,
+	592, 224, 593, 806, 1125, 1126, 600, 601, 651, 652,
+	0, 0, 605, 606, 607, 608, 609, 610, 508, 616,
+	617, 618, 624, 650, 0, 0, 0, 769, 650, 626,
+	848, 629, 965, 0, 668, 667, 0, 680, 685, 692,
+	291, 293, 295, 313, 0, 0, 316, 319, 0, 0,
+	0, 0, 1148, 0, 1150, 1152, 0, 0, 172, 173,
+	174, 0, 166, 189, 230, 0, 0, 169, 226, 0,
+	0, 0, 0, 159, 0, 1158, 1160, 1165, 0, 812,
+	0, 1176, 1177, 1181, 1183, 1184, 712, 0, 0, 569,
+	661, 662, 55, 0, 59, 0, 0, 1049, 1040, 0,
+	0, 0, 0, 1029, 1031, 1032, 0, 0, 1014, 0,
+	0, 715, 708, 46, 815, 816, 818, 835, 0, 837,
+	839, 746, 725, 0, 1004, -2, 825, 826, 850, 851,
+	852, 0, 0, 0, 0, 848, 830, 0, 868, 869,
+	870, 871, 872, 873, 874, 875, 876, 877, 878, 879,
+	882, 979, 980, 0, 880, 881, 890, 0, 0, 858,
+	719, 720, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 856,
+	853, 0, 1059, 0, 0, 0, 0, 930, 0, 0,
+	865, 1006, 0, 865, 1006, 0, 0, 0, 0, 0,
+	0, 0, 0, 1001, 998, 0, 0, 966, 0, 0,
+	0, 0, 0, 857, 0, 0, 0, 0, 803, 0,
+	0, 0, 0, 0, 0, 792, 0, 0, 795, 0,
+	0, 0, 0, 0, 0, 0, 0, 762, 1486, 764,
+	0, 808, 0, 0, 0, 812, 0, 812, 79, 812,
+	81, 0, 807, 1066, 0, 0, 0, 90, 91, 86,
+	1070, 0, 1076, 1077, 1078, 1004, 0, 1039, 712, 353,
+	522, 524, 527, 528, 529, 357, 359, 360, 361, 0,
+	-2, 495, 1498, 1545, -2, 487, 1409, -2, -2, 364,
+	446, 445, 377, 0, 451, 451, 437, 437, 437, 437,
+	406, 451, 409, 412, 413, 454, 0, 454, 454, 454,
+	454, 451, 0, 0, 398, 399, 400, 389, 0, 390,
+	439, 392, 0, 393, 394, 395, 396, 501, 502, 0,
+	0, 0, 0, 0, 104, 1092, 105, 0, 247, 248,
+	192, 112, 0, 0, 232, 0, 352, 0, 581, 0,
+	583, 221, 585, 595, 596, 1622, 0, 0, 1622, 1622,
+	812, 0, 0, 619, 648, 649, 647, 650, 0, 623,
+	642, 0, 0, 630, 0, 669, 686, 693, 694, 696,
+	697, 698, 297, 298, 320, 82, 0, 1128, 1147, 0,
+	1151, 1153, 0, 175, 177, 0, 153, 0, 0, 168,
+	0, 0, 0, 0, 0, 0, 1171, 1033, 0, 812,
+	0, 1178, 1179, 1180, 1185, 1186, 1187, 1188, 1189, 707,
+	64, 536, 537, 1621, -2, 570, 1107, 570, 570, 557,
+	471, 570, 1097, 1098, 1099, 1100, 1101, 1102, 1103, 1104,
+	0, 56, 0, 60, 0, 0, 0, 0, 1035, 1036,
+	1028, 0, 1085, 1086, 1015, 1016, 97, 716, 836, 838,
+	840, 0, 856, 827, 848, 831, 0, 828, 0, 822,
+	0, 0, 730, 0, 730, 730, 0, 916, 0, 917,
+	0, 730, 730, 0, 0, 0, 0, 0, 0, 923,
+	924, 925, 926, 730, 730, 730, 730, 0, 730, 730,
+	730, 0, 861, -2, 927, 928, 929, 0, 0, 0,
+	0, 0, 972, 0, 0, 973, 938, 0, 0, 0,
+	0, 0, 944, 1025, 0, 999, 0, 0, 947, 967,
+	0, 0, 968, 969, 970, 971, 1053, 0, 0, 0,
+	1044, 0, 812, 1061, 0, 0, 752, 783, 785, 0,
+	780, 793, 794, 796, 0, 798, 0, 800, 801, 771,
+	758, 855, 759, 0, 0, 0, 808, 768, 0, 0,
+	0, 1025, 812, 72, 73, 0, 88, 89, 0, 0,
+	95, 455, 456, 0, 191, 0, 525, 0, 358, 0,
+	488, 0, 0, 473, 487, 483, 484, 487, 478, 488,
+	479, 0, 366, 0, 368, 369, 370, 371, 372, 0,
+	0, 450, 0, 458, 460, 0, 373, 447, 0, 454,
+	454, 451, 404, 405, 437, 410, 454, 414, 0, 415,
+	416, 417, 418, 454, 0, 435, 0, 0, 391, 0,
+	518, 0, 1116, 96, 96, 0, 249, 250, 251, 0,
+	193, 194, 0, 0, 0, 0, 122, 0, 246, 0,
+	0, 0, 0, 0, 0, 0, 586, 77, 225, 603,
+	604, 614, 509, 510, 650, 621, 0, 849, 631, 633,
+	634, 695, 451, 0, 1149, 0, 0, 0, 0, 0,
+	187, 170, 0, 0, 0, 0, 160, 1173, 813, 1033,
+	0, 715, 0, 539, 0, 1621, 487, 0, 558, 559,
+	0, 571, 572, 573, 0, 0, 1621, 0, 0, 0,
+	572, 0, 0, 0, 0, 1108, 1109, 0, 0, 0,
+	472, 0, 0, 58, 1051, 1050, 1041, 45, 812, 0,
+	726, 1005, 0, 829, 0, 832, 730, 859, 901, 731,
+	721, 0, 902, 903, 730, 727, 1025, 918, 905, 906,
+	919, 920, 921, 730, 730, 922, 910, 909, 908, 911,
+	730, 914, 913, 915, 897, 0, 437, 437, 984, 437,
+	441, 987, 437, 989, 437, 992, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 996, 946, 1002,
+	0, 0, 0, 65, 0, 1053, 1042, 1055, 1057, 0,
+	0, 0, 0, -2, 1342, 0, 775, 1025, 0, 0,
+	0, 777, 784, 0, 0, 778, 0, 779, 797, 799,
+	756, 0, 0, 760, 0, 0, -2, 767, 0, 0,
+	0, 1033, 71, 92, 93, 94, 523, 526, 0, 489,
+	437, 437, 0, 493, 0, 485, 494, 475, 477, 0,
+	367, 448, 0, 457, 459, 461, 438, 402, 403, 454,
+	411, 407, 452, 453, 419, 451, 0, 451, 0, 442,
+	0, 505, 519, 0, 0, 102, 0, 0, 200, 0,
+	196, 0, 0, 0, 130, 0, 113, 114, 115, 117,
+	118, 0, 0, 0, 111, 0, 234, 0, 237, 238,
+	582, 584, 620, 770, 1139, 802, 187, 176, 178, 179,
+	187, 190, 154, 0, 155, 157, 0, 156, 1174, 0,
+	97, 538, 0, 566, 0, 0, 542, 1115, 485, 0,
+	-2, 543, 544, 549, 550, 551, 552, 553, 0, 564,
+	0, 546, 0, 566, 0, 561, 0, 0, 0, 0,
+	1017, 0, 97, 97, 898, 833, 900, 0, 904, 0,
+	0, 899, 907, 912, 931, 981, 451, 985, 986, 988,
+	990, 991, 993, 933, 932, 0, 0, 0, 0, 939,
+	0, 0, 0, 0, 0, 0, 1000, 0, 0, 0,
+	66, 0, 1058, -2, 0, 0, 83, 0, 1033, 1062,
+	1063, 1064, 781, 0, 786, 0, 773, 0, 808, 765,
+	0, 0, 0, 0, 70, 463, 0, 1030, 1030, 474,
+	487, 486, 0, 449, 408, 454, 436, 454, 0, 0,
+	520, 0, 96, 0, 96, 201, 202, 0, 0, 0,
+	207, 0, 0, 0, 0, 0, 195, 197, 198, 199,
+	148, 131, 132, 0, 0, 0, 0, 123, 124, 0,
+	0, 0, 0, 0, 119, 120, 121, 233, 0, 0,
+	236, 0, 0, 1141, 1135, 180, 180, 0, 158, 812,
+	0, 0, 541, 567, 0, 365, 365, 0, 487, 0,
+	560, 0, 1105, 1106, 0, 555, 566, 0, 0, 0,
+	57, 1052, 1022, 0, 812, 0, 717, 722, 728, 0,
+	982, 983, 0, 0, 0, 0, 940, 941, 942, 974,
+	945, 997, 0, 0, 0, 1056, 1043, 0, 1047, 0,
+	776, 69, 0, 772, 0, 761, 763, 808, 809, 810,
+	811, 462, 466, 0, 471, 0, 490, 491, 492, 476,
+	0, 425, 426, 440, 443, 0, 103, 0, 0, 203,
+	204, 205, 206, 208, 209, 0, 0, 0, 108, 0,
+	0, 133, 0, 0, 0, 0, 143, 144, 145, 146,
+	147, 125, 126, 127, 128, 129, 116, 0, 0, 235,
+	0, 240, 241, 1095, 1143, -2, 0, 151, 0, 152,
+	188, 1033, 0, 540, 568, 362, 363, 0, 1115, 0,
+	545, 554, 556, 0, 563, 0, 50, 0, 0, 1017,
+	97, 1025, 934, 936, 935, 937, 0, 0, 0, 963,
+	964, 0, 1046, 0, 782, 774, 766, 467, 468, 0,
+	470, 0, 464, 0, 96, 323, 324, 325, 326, 327,
+	328, 329, 330, 331, 332, 333, 334, 335, 336, 337,
+	338, 339, 340, 341, 342, 343, 344, 345, 346, 347,
+	348, 349, 350, 351, 0, 0, 0, 0, 0, 210,
+	211, 212, 213, 149, 150, 134, 135, 0, 137, 138,
+	0, 0, 0, 243, 0, 239, 771, 0, 1135, 0,
+	1137, 0, 1134, 0, 181, 1175, 812, 0, 0, 0,
+	562, 0, 575, 0, 1023, 1024, 1018, 1019, 1021, 1022,
+	718, 732, 943, 0, 0, 0, 1054, -2, 469, 0,
+	101, 465, 252, 107, 0, 0, 1117, 0, 0, 279,
+	280, 281, 1119, 0, 321, 0, 139, 0, 141, 142,
+	242, 0, 43, 0, 1142, 0, 1140, 0, 0, 1136,
+	0, 1017, 464, 0, 464, 574, 0, 0, 0, 51,
+	0, 0, 0, 975, 0, 978, 0, 96, 0, 0,
+	0, 263, 0, 0, 0, 0, 0, 277, 0, 0,
+	136, 140, 244, 1096, 1144, 0, 0, 0, 1022, 547,
+	0, 565, 576, 0, 1020, 729, 733, 0, 736, 0,
+	0, 0, 742, 0, 734, 976, 0, 0, 253, 254,
+	0, 0, 264, 0, 0, 0, 0, 0, 0, 1120,
+	214, 322, 1138, 1132, 182, 183, 184, 186, 48, 464,
+	0, 0, 737, 738, 739, 740, 741, 0, 0, 496,
+	96, 255, 256, 257, 258, 259, 0, 261, 0, 0,
+	0, 273, 274, 275, 0, 282, 284, 285, 286, 0,
+	288, 289, 278, 0, 548, 0, 0, 743, 0, 497,
+	498, 0, 106, 0, 0, 0, 0, 0, 0, 270,
+	0, 276, 0, 287, 185, 0, 735, 977, 499, 0,
+	500, 0, 0, 0, 0, 0, 265, 266, 0, 0,
+	0, 271, 0, 283, 0, 0, 511, 513, 514, 0,
+	0, 512, 260, 262, 0, 268, 0, 0, 577, 578,
+	515, 516, 517, 0, 0, 0, 267, 0, 272, 269,
+}
+
+var yyTok1 = [...]int{
+	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 90, 3, 3, 3, 118, 110, 3,
+	68, 70, 115, 113, 69, 114, 128, 116, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 496,
+	99, 98, 100, 3, 71, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 120, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 109, 3, 121,
+}
+
+var yyTok2 = [...]int{
+	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
+	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
+	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
+	32, 33, 34, 35, 36, 37, 38, 39, 40, 41,
+	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
+	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
+	62, 63, 64, 65, 66, 67, 72, 73, 74, 75,
+	76, 77, 78, 79, 80, 81, 82, 83, 84, 85,
+	86, 87, 88, 89, 91, 92, 93, 94, 95, 96,
+	97, 101, 102, 103, 104, 105, 106, 107, 108, 111,
+	112, 117, 119, 122, 123, 124, 125, 126, 127, 129,
+	130, 131, 132, 133, 134, 135, 136, 137, 138, 139,
+	140, 141, 142, 143, 144, 145, 146, 147, 148, 149,
+	150, 151, 152, 153, 154, 155, 156, 157, 158, 159,
+	160, 161, 162, 163, 164, 165, 166, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 181, 182, 183, 184, 185, 186, 187, 188, 189,
+	190, 191, 192, 193, 194, 195, 196, 197, 198, 199,
+	200, 201, 202, 203, 204, 205, 206, 207, 208, 209,
+	210, 211, 212, 213, 214, 215, 216, 217, 218, 219,
+	220, 221, 222, 223, 224, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 239,
+	240, 241, 242, 243, 244, 245, 246, 247, 248, 249,
+	250, 251, 252, 253, 254, 255, 256, 257, 258, 259,
+	260, 261, 262, 263, 264, 265, 266, 267, 268, 269,
+	270, 271, 272, 273, 274, 275,
+}
+
+var yyTok3 = [...]int{
+	57600, 276, 57601, 277, 57602, 278, 57603, 279, 57604, 280,
+	57605, 281, 57606, 282, 57607, 283, 57608, 284, 57609, 285,
+	57610, 286, 57611, 287, 57612, 288, 57613, 289, 57614, 290,
+	57615, 291, 57616, 292, 57617, 293, 57618, 294, 57619, 295,
+	57620, 296, 57621, 297, 57622, 298, 57623, 299, 57624, 300,
+	57625, 301, 57626, 302, 57627, 303, 57628, 304, 57629, 305,
+	57630, 306, 57631, 307, 57632, 308, 57633, 309, 57634, 310,
+	57635, 311, 57636, 312, 57637, 313, 57638, 314, 57639, 315,
+	57640, 316, 57641, 317, 57642, 318, 57643, 319, 57644, 320,
+	57645, 321, 57646, 322, 57647, 323, 57648, 324, 57649, 325,
+	57650, 326, 57651, 327, 57652, 328, 57653, 329, 57654, 330,
+	57655, 331, 57656, 332, 57657, 333, 57658, 334, 57659, 335,
+	57660, 336, 57661, 337, 57662, 338, 57663, 339, 57664, 340,
+	57665, 341, 57666, 342, 57667, 343, 57668, 344, 57669, 345,
+	57670, 346, 57671, 347, 57672, 348, 57673, 349, 57674, 350,
+	57675, 351, 57676, 352, 57677, 353, 57678, 354, 57679, 355,
+	57680, 356, 57681, 357, 57682, 358, 57683, 359, 57684, 360,
+	57685, 361, 57686, 362, 57687, 363, 57688, 364, 57689, 365,
+	57690, 366, 57691, 367, 57692, 368, 57693, 369, 57694, 370,
+	57695, 371, 57696, 372, 57697, 373, 57698, 374, 57699, 375,
+	57700, 376, 57701, 377, 57702, 378, 57703, 379, 57704, 380,
+	57705, 381, 57706, 382, 57707, 383, 57708, 384, 57709, 385,
+	57710, 386, 57711, 387, 57712, 388, 57713, 389, 57714, 390,
+	57715, 391, 57716, 392, 57717, 393, 57718, 394, 57719, 395,
+	57720, 396, 57721, 397, 57722, 398, 57723, 399, 57724, 400,
+	57725, 401, 57726, 402, 57727, 403, 57728, 404, 57729, 405,
+	57730, 406, 57731, 407, 57732, 408, 57733, 409, 57734, 410,
+	57735, 411, 57736, 412, 57737, 413, 57738, 414, 57739, 415,
+	57740, 416, 57741, 417, 57742, 418, 57743, 419, 57744, 420,
+	57745, 421, 57746, 422, 57747, 423, 57748, 424, 57749, 425,
+	57750, 426, 57751, 427, 57752, 428, 57753, 429, 57754, 430,
+	57755, 431, 57756, 432, 57757, 433, 57758, 434, 57759, 435,
+	57760, 436, 57761, 437, 57762, 438, 57763, 439, 57764, 440,
+	57765, 441, 57766, 442, 57767, 443, 57768, 444, 57769, 445,
+	57770, 446, 57771, 447, 57772, 448, 57773, 449, 57774, 450,
+	57775, 451, 57776, 452, 57777, 453, 57778, 454, 57779, 455,
+	57780, 456, 57781, 457, 57782, 458, 57783, 459, 57784, 460,
+	57785, 461, 57786, 462, 57787, 463, 57788, 464, 57789, 465,
+	57790, 466, 57791, 467, 57792, 468, 57793, 469, 57794, 470,
+	57795, 471, 57796, 472, 57797, 473, 57798, 474, 57799, 475,
+	57800, 476, 57801, 477, 57802, 478, 57803, 479, 57804, 480,
+	57805, 481, 57806, 482, 57807, 483, 57808, 484, 57809, 485,
+	57810, 486, 57811, 487, 57812, 488, 57813, 489, 57814, 490,
+	57815, 491, 57816, 492, 57817, 493, 57818, 494, 57819, 495,
+	0,
+}
+
+var yyErrorMessages = [...]struct {
+	state int
+	token int
+	msg   string
+}{}
+
+//line yaccpar:1
+
+/*	parser for yacc output	*/
+
+var (
+	yyDebug        = 0
+	yyErrorVerbose = false
+)
+
+type yyLexer interface {
+	Lex(lval *yySymType) int
+	Error(s string)
+}
+
+type yyParser interface {
+	Parse(yyLexer) int
+	Lookahead() int
+}
+
+type yyParserImpl struct {
+	lval  yySymType
+	stack [yyInitialStackSize]yySymType
+	char  int
+}
+
+func (p *yyParserImpl) Lookahead() int {
+	return p.char
+}
+
+func yyNewParser() yyParser {
+	return &yyParserImpl{}
+}
+
+const yyFlag = -1000
+
+func yyTokname(c int) string {
+	if c >= 1 && c-1 < len(yyToknames) {
+		if yyToknames[c-1] != "" {
+			return yyToknames[c-1]
+		}
+	}
+	return __yyfmt__.Sprintf("tok-%v", c)
+}
+
+func yyStatname(s int) string {
+	if s >= 0 && s < len(yyStatenames) {
+		if yyStatenames[s] != "" {
+			return yyStatenames[s]
+		}
+	}
+	return __yyfmt__.Sprintf("state-%v", s)
+}
+
+func yyErrorMessage(state, lookAhead int) string {
+	const TOKSTART = 4
+
+	if !yyErrorVerbose {
+		return "syntax error"
+	}
+
+	for _, e := range yyErrorMessages {
+		if e.state == state && e.token == lookAhead {
+			return "syntax error: " + e.msg
+		}
+	}
+
+	res := "syntax error: unexpected " + yyTokname(lookAhead)
+
+	// To match Bison, suggest at most four expected tokens.
+	expected := make([]int, 0, 4)
+
+	// Look for shiftable tokens.
+	base := yyPact[state]
+	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
+		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+	}
+
+	if yyDef[state] == -2 {
+		i := 0
+		for yyExca[i] != -1 || yyExca[i+1] != state {
+			i += 2
+		}
+
+		// Look for tokens that we accept or reduce.
+		for i += 2; yyExca[i] >= 0; i += 2 {
+			tok := yyExca[i]
+			if tok < TOKSTART || yyExca[i+1] == 0 {
+				continue
+			}
+			if len(expected) == cap(expected) {
+				return res
+			}
+			expected = append(expected, tok)
+		}
+
+		// If the default action is to accept or reduce, give up.
+		if yyExca[i+1] != 0 {
+			return res
+		}
+	}
+
+	for i, tok := range expected {
+		if i == 0 {
+			res += ", expecting "
+		} else {
+			res += " or "
+		}
+		res += yyTokname(tok)
+	}
+	return res
+}
+
+func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
+	token = 0
+	char = lex.Lex(lval)
+	if char <= 0 {
+		token = yyTok1[0]
+		goto out
+	}
+	if char < len(yyTok1) {
+		token = yyTok1[char]
+		goto out
+	}
+	if char >= yyPrivate {
+		if char < yyPrivate+len(yyTok2) {
+			token = yyTok2[char-yyPrivate]
+			goto out
+		}
+	}
+	for i := 0; i < len(yyTok3); i += 2 {
+		token = yyTok3[i+0]
+		if token == char {
+			token = yyTok3[i+1]
+			goto out
+		}
+	}
+
+out:
+	if token == 0 {
+		token = yyTok2[1] /* unknown char */
+	}
+	if yyDebug >= 3 {
+		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
+	}
+	return char, token
+}
+
+func yyParse(yylex yyLexer) int {
+	return yyNewParser().Parse(yylex)
+}
+
+func (yyrcvr *yyParserImpl) Parse(yylex yyLexer) int {
+	var yyn int
+	var yyVAL yySymType
+	var yyDollar []yySymType
+	_ = yyDollar // silence set and not used
+	yyS := yyrcvr.stack[:]
+
+	Nerrs := 0   /* number of errors */
+	Errflag := 0 /* error recovery flag */
+	yystate := 0
+	yyrcvr.char = -1
+	yytoken := -1 // yyrcvr.char translated into internal numbering
+	defer func() {
+		// Make sure we report no lookahead when not parsing.
+		yystate = -1
+		yyrcvr.char = -1
+		yytoken = -1
+	}()
+	yyp := -1
+	goto yystack
+
+ret0:
+	return 0
+
+ret1:
+	return 1
+
+yystack:
+	/* put a state and value onto the stack */
+	if yyDebug >= 4 {
+		__yyfmt__.Printf("char %v in %v\n", yyTokname(yytoken), yyStatname(yystate))
+	}
+
+	yyp++
+	if yyp >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyS[yyp] = yyVAL
+	yyS[yyp].yys = yystate
+
+yynewstate:
+	yyn = yyPact[yystate]
+	if yyn <= yyFlag {
+		goto yydefault /* simple state */
+	}
+	if yyrcvr.char < 0 {
+		yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+	}
+	yyn += yytoken
+	if yyn < 0 || yyn >= yyLast {
+		goto yydefault
+	}
+	yyn = yyAct[yyn]
+	if yyChk[yyn] == yytoken { /* valid shift */
+		yyrcvr.char = -1
+		yytoken = -1
+		yyVAL = yyrcvr.lval
+		yystate = yyn
+		if Errflag > 0 {
+			Errflag--
+		}
+		goto yystack
+	}
+
+yydefault:
+	/* default state action */
+	yyn = yyDef[yystate]
+	if yyn == -2 {
+		if yyrcvr.char < 0 {
+			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
+		}
+
+		/* look through exception table */
+		xi := 0
+		for {
+			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+				break
+			}
+			xi += 2
+		}
+		for xi += 2; ; xi += 2 {
+			yyn = yyExca[xi+0]
+			if yyn < 0 || yyn == yytoken {
+				break
+			}
+		}
+		yyn = yyExca[xi+1]
+		if yyn < 0 {
+			goto ret0
+		}
+	}
+	if yyn == 0 {
+		/* error ... attempt to resume parsing */
+		switch Errflag {
+		case 0: /* brand new error */
+			yylex.Error(yyErrorMessage(yystate, yytoken))
+			Nerrs++
+			if yyDebug >= 1 {
+				__yyfmt__.Printf("%s", yyStatname(yystate))
+				__yyfmt__.Printf(" saw %s\n", yyTokname(yytoken))
+			}
+			fallthrough
+
+		case 1, 2: /* incompletely recovered error ... try again */
+			Errflag = 3
+
+			/* find a state where "error" is a legal shift action */
+			for yyp >= 0 {
+				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				if yyn >= 0 && yyn < yyLast {
+					yystate = yyAct[yyn] /* simulate a shift of "error" */
+					if yyChk[yystate] == yyErrCode {
+						goto yystack
+					}
+				}
+
+				/* the current p has no shift on "error", pop stack */
+				if yyDebug >= 2 {
+					__yyfmt__.Printf("error recovery pops state %d\n", yyS[yyp].yys)
+				}
+				yyp--
+			}
+			/* there is no state on the stack with an error shift ... abort */
+			goto ret1
+
+		case 3: /* no shift yet; clobber input char */
+			if yyDebug >= 2 {
+				__yyfmt__.Printf("error recovery discards %s\n", yyTokname(yytoken))
+			}
+			if yytoken == yyEofCode {
+				goto ret1
+			}
+			yyrcvr.char = -1
+			yytoken = -1
+			goto yynewstate /* try again in the same state */
+		}
+	}
+
+	/* reduction by production yyn */
+	if yyDebug >= 2 {
+		__yyfmt__.Printf("reduce %v in:\n\t%v\n", yyn, yyStatname(yystate))
+	}
+
+	yynt := yyn
+	yypt := yyp
+	_ = yypt // guard against "declared and not used"
+
+	yyp -= yyR2[yyn]
+	// yyp is now the index of $0. Perform the default action. Iff the
+	// reduced production is ε, $1 is possibly out of range.
+	if yyp+1 >= len(yyS) {
+		nyys := make([]yySymType, len(yyS)*2)
+		copy(nyys, yyS)
+		yyS = nyys
+	}
+	yyVAL = yyS[yyp+1]
+
+	/* consult goto table to find next state */
+	yyn = yyR1[yyn]
+	yyg := yyPgo[yyn]
+	yyj := yyg + yyS[yyp].yys + 1
+
+	if yyj >= yyLast {
+		yystate = yyAct[yyg]
+	} else {
+		yystate = yyAct[yyj]
+		if yyChk[yystate] != -yyn {
+			yystate = yyAct[yyg]
+		}
+	}
+	// dummy call; replaced with literal code
+	switch yynt {
+
+	case 1:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:506
+		{
+			setParseTree(yylex, yyDollar[1].statement)
+		}
+	case 2:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:510
+		{
+			setParseTree(yylex, yyDollar[1].statement)
+			statementSeen(yylex)
+		}
+	case 3:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:517
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 42:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:559
+		{
+			setParseTree(yylex, nil)
+		}
+	case 43:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:565
+		{
+			yyVAL.statement = &Load{Local: yyDollar[3].boolVal, Infile: yyDollar[4].str, Table: yyDollar[5].tableName, Partition: yyDollar[6].partitions, Charset: yyDollar[7].str, Fields: yyDollar[8].Fields, Lines: yyDollar[9].Lines, IgnoreNum: yyDollar[10].sqlVal, Columns: yyDollar[11].columns}
+		}
+	case 44:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:571
+		{
+			yyDollar[1].selStmt.SetOrderBy(yyDollar[2].orderBy)
+			yyDollar[1].selStmt.SetLimit(yyDollar[3].limit)
+			yyDollar[1].selStmt.SetLock(yyDollar[4].str)
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 45:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:578
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
+		}
+	case 46:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:584
+		{
+			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
+		}
+	case 47:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:591
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 48:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line sql.y:595
+		{
+			yyVAL.selStmt = &Select{With: yyDollar[1].with, Comments: Comments(yyDollar[3].bytes2), Cache: yyDollar[4].str, Distinct: yyDollar[5].str, Hints: yyDollar[7].str, SelectExprs: yyDollar[8].selectExprs, From: yyDollar[10].tableExprs, Where: NewWhere(WhereStr, yyDollar[11].expr), GroupBy: GroupBy(yyDollar[12].exprs), Having: NewWhere(HavingStr, yyDollar[13].expr)}
+			if yyDollar[6].byt == 1 {
+				yyVAL.selStmt.(*Select).CalcFoundRows = true
+			}
+		}
+	case 49:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:602
+		{
+			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt}
+		}
+	case 50:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:608
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[6].str, SelectExprs: yyDollar[7].selectExprs, From: TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
+			if yyDollar[5].byt == 1 {
+				yyVAL.selStmt.(*Select).CalcFoundRows = true
+			}
+		}
+	case 51:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:615
+		{
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[6].str, SelectExprs: yyDollar[7].selectExprs, From: yyDollar[9].tableExprs, Where: NewWhere(WhereStr, yyDollar[10].expr), GroupBy: GroupBy(yyDollar[11].exprs), Having: NewWhere(HavingStr, yyDollar[12].expr)}
+			if yyDollar[5].byt == 1 {
+				yyVAL.selStmt.(*Select).CalcFoundRows = true
+			}
+		}
+	case 52:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:624
+		{
+			yyVAL.with = &With{Ctes: yyDollar[2].tableExprs}
+		}
+	case 53:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:628
+		{
+			yyVAL.with = &With{Ctes: yyDollar[3].tableExprs, Recursive: true}
+		}
+	case 54:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:634
+		{
+			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
+		}
+	case 55:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:638
+		{
+			yyVAL.tableExprs = append(yyDollar[1].tableExprs, yyDollar[3].tableExpr)
+		}
+	case 56:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:644
+		{
+			yyVAL.tableExpr = &CommonTableExpr{AliasedTableExpr: &AliasedTableExpr{Expr: yyDollar[4].simpleTableExpr, As: yyDollar[1].tableIdent}, Materialization: yyDollar[3].str}
+		}
+	case 57:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:648
+		{
+			yyVAL.tableExpr = &CommonTableExpr{AliasedTableExpr: &AliasedTableExpr{Expr: yyDollar[7].simpleTableExpr, As: yyDollar[1].tableIdent}, Columns: yyDollar[3].columns, Materialization: yyDollar[6].str}
+		}
+	case 58:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:653
+		{
+			yyVAL.str = ""
+		}
+	case 59:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:657
+		{
+			yyVAL.str = MaterializedStr
+		}
+	case 60:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:661
+		{
+			yyVAL.str = NotMaterializedStr
+		}
+	case 61:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:667
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 62:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:671
+		{
+			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 63:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:677
+		{
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 64:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:681
+		{
+			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
+		}
+	case 65:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:687
+		{
+			// insert_data returns a *Insert pre-filled with Columns & Values
+			ins := yyDollar[6].ins
+			ins.Action = yyDollar[1].str
+			ins.Comments = yyDollar[2].bytes2
+			ins.Ignore = yyDollar[3].str
+			ins.Table = yyDollar[4].tableName
+			ins.Partitions = yyDollar[5].partitions
+			ins.OnDup = OnDup(yyDollar[7].assignExprs)
+			yyVAL.statement = ins
+		}
+	case 66:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:699
+		{
+			cols := make(Columns, 0, len(yyDollar[7].assignExprs))
+			vals := make(ValTuple, 0, len(yyDollar[8].assignExprs))
+			for _, updateList := range yyDollar[7].assignExprs {
+				cols = append(cols, updateList.Name.Name)
+				vals = append(vals, updateList.Expr)
+			}
+			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].assignExprs)}
+		}
+	case 67:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:711
+		{
+			yyVAL.str = InsertStr
+		}
+	case 68:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:715
+		{
+			yyVAL.str = ReplaceStr
+		}
+	case 69:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:721
+		{
+			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].assignExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
+		}
+	case 70:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:727
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
+		}
+	case 71:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:731
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
+		}
+	case 72:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:735
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
+		}
+	case 73:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:739
+		{
+			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
+		}
+	case 74:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:744
+		{
+		}
+	case 75:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:745
+		{
+		}
+	case 76:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:749
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName.ToViewName()}
+		}
+	case 77:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:753
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName.ToViewName())
+		}
+	case 78:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:759
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
+		}
+	case 79:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:763
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
+		}
+	case 80:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:769
+		{
+			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
+		}
+	case 81:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:773
+		{
+			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
+		}
+	case 82:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:778
+		{
+			yyVAL.partitions = nil
+		}
+	case 83:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:782
+		{
+			yyVAL.partitions = yyDollar[3].partitions
+		}
+	case 84:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:788
+		{
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setVarExprs}
+		}
+	case 85:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:792
+		{
+			for i := 0; i < len(yyDollar[4].setVarExprs); i++ {
+				yyDollar[4].setVarExprs[i].Scope = SetScope_None
+			}
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setVarExprs}
+		}
+	case 86:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:799
+		{
+			for i := 0; i < len(yyDollar[5].setVarExprs); i++ {
+				yyDollar[5].setVarExprs[i].Scope = yyDollar[3].setScope
+			}
+			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[5].setVarExprs}
+		}
+	case 87:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:808
+		{
+			yyVAL.setVarExprs = SetVarExprs{yyDollar[1].setVarExpr}
+		}
+	case 88:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:812
+		{
+			yyVAL.setVarExprs = append(yyVAL.setVarExprs, yyDollar[3].setVarExpr)
+		}
+	case 89:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:818
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
+		}
+	case 90:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:822
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
+		}
+	case 91:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:826
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
+		}
+	case 92:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:832
+		{
+			yyVAL.str = IsolationLevelRepeatableRead
+		}
+	case 93:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:836
+		{
+			yyVAL.str = IsolationLevelReadCommitted
+		}
+	case 94:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:840
+		{
+			yyVAL.str = IsolationLevelReadUncommitted
+		}
+	case 95:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:844
+		{
+			yyVAL.str = IsolationLevelSerializable
+		}
+	case 96:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:849
+		{
+			yyVAL.int = yyPosition(yylex)
+		}
+	case 97:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:854
+		{
+			yyVAL.int = yyOldPosition(yylex)
+		}
+	case 98:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:860
+		{
+			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
+			if len(yyDollar[1].ddl.TableSpec.Constraints) > 0 {
+				yyDollar[1].ddl.ConstraintAction = AddStr
+			}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 99:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:869
+		{
+			yyDollar[1].ddl.OptSelect = &OptSelect{Select: yyDollar[3].selStmt}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 100:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:874
+		{
+			yyDollar[1].ddl.OptLike = &OptLike{LikeTable: yyDollar[3].tableName}
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 101:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:879
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName, IndexSpec: &IndexSpec{Action: CreateStr, ToName: yyDollar[4].colIdent, Using: yyDollar[5].colIdent, Type: yyDollar[2].str, Columns: yyDollar[9].indexColumns, Options: yyDollar[11].indexOptions}}
+		}
+	case 102:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:883
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, View: yyDollar[3].tableName.ToViewName(), ViewExpr: yyDollar[6].selStmt, SubStatementPositionStart: yyDollar[5].int, SubStatementPositionEnd: yyDollar[7].int - 1}
+		}
+	case 103:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:887
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, View: yyDollar[5].tableName.ToViewName(), ViewExpr: yyDollar[8].selStmt, SubStatementPositionStart: yyDollar[7].int, SubStatementPositionEnd: yyDollar[9].int - 1, OrReplace: true}
+		}
+	case 104:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:891
+		{
+			var ne bool
+			if yyDollar[3].byt != 0 {
+				ne = true
+			}
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes), IfNotExists: ne}
+		}
+	case 105:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:899
+		{
+			var ne bool
+			if yyDollar[3].byt != 0 {
+				ne = true
+			}
+			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes), IfNotExists: ne}
+		}
+	case 106:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line sql.y:907
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[8].tableName, TriggerSpec: &TriggerSpec{Name: string(yyDollar[4].bytes), Time: yyDollar[5].str, Event: yyDollar[6].str, Order: yyDollar[12].triggerOrder, Body: yyDollar[14].statement}, SubStatementPositionStart: yyDollar[13].int, SubStatementPositionEnd: yyDollar[15].int - 1}
+		}
+	case 107:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:911
+		{
+			yyVAL.statement = &DDL{Action: CreateStr, ProcedureSpec: &ProcedureSpec{Name: string(yyDollar[4].bytes), Definer: yyDollar[2].str, Params: yyDollar[6].procedureParams, Characteristics: yyDollar[8].characteristics, Body: yyDollar[10].statement}, SubStatementPositionStart: yyDollar[9].int, SubStatementPositionEnd: yyDollar[11].int - 1}
+		}
+	case 108:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:915
+		{
+			var notExists bool
+			if yyDollar[3].byt != 0 {
+				notExists = true
+			}
+			tlsOptions, err := NewTLSOptions(yyDollar[6].tlsOptionItems)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			accountLimits, err := NewAccountLimits(yyDollar[7].accountLimitItems)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			passwordOptions, locked := NewPasswordOptionsWithLock(yyDollar[8].passLockItems)
+			yyVAL.statement = &CreateUser{IfNotExists: notExists, Users: yyDollar[4].accountsWithAuth, DefaultRoles: yyDollar[5].accountNames, TLSOptions: tlsOptions, AccountLimits: accountLimits, PasswordOptions: passwordOptions, Locked: locked, Attribute: yyDollar[9].str}
+		}
+	case 109:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:934
+		{
+			var notExists bool
+			if yyDollar[3].byt != 0 {
+				notExists = true
+			}
+			yyVAL.statement = &CreateRole{IfNotExists: notExists, Roles: yyDollar[4].accountNames}
+		}
+	case 110:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:943
+		{
+			yyVAL.accountNames = nil
+		}
+	case 111:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:947
+		{
+			yyVAL.accountNames = yyDollar[3].accountNames
+		}
+	case 112:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:952
+		{
+			yyVAL.tlsOptionItems = nil
+		}
+	case 113:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:956
+		{
+			yyVAL.tlsOptionItems = nil
+		}
+	case 114:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:960
+		{
+			yyVAL.tlsOptionItems = yyDollar[2].tlsOptionItems
+		}
+	case 115:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:966
+		{
+			yyVAL.tlsOptionItems = []TLSOptionItem{yyDollar[1].tlsOptionItem}
+		}
+	case 116:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:970
+		{
+			yyVAL.tlsOptionItems = append(yyDollar[1].tlsOptionItems, yyDollar[3].tlsOptionItem)
+		}
+	case 117:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:976
+		{
+			yyVAL.tlsOptionItem = TLSOptionItem{TLSOptionItemType: TLSOptionItemType_SSL, ItemData: ""}
+		}
+	case 118:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:980
+		{
+			yyVAL.tlsOptionItem = TLSOptionItem{TLSOptionItemType: TLSOptionItemType_X509, ItemData: ""}
+		}
+	case 119:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:984
+		{
+			yyVAL.tlsOptionItem = TLSOptionItem{TLSOptionItemType: TLSOptionItemType_Cipher, ItemData: string(yyDollar[2].bytes)}
+		}
+	case 120:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:988
+		{
+			yyVAL.tlsOptionItem = TLSOptionItem{TLSOptionItemType: TLSOptionItemType_Issuer, ItemData: string(yyDollar[2].bytes)}
+		}
+	case 121:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:992
+		{
+			yyVAL.tlsOptionItem = TLSOptionItem{TLSOptionItemType: TLSOptionItemType_Subject, ItemData: string(yyDollar[2].bytes)}
+		}
+	case 122:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:997
+		{
+			yyVAL.accountLimitItems = nil
+		}
+	case 123:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1001
+		{
+			yyVAL.accountLimitItems = yyDollar[2].accountLimitItems
+		}
+	case 124:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1007
+		{
+			yyVAL.accountLimitItems = []AccountLimitItem{yyDollar[1].accountLimitItem}
+		}
+	case 125:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1011
+		{
+			yyVAL.accountLimitItems = append(yyDollar[1].accountLimitItems, yyDollar[2].accountLimitItem)
+		}
+	case 126:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1017
+		{
+			yyVAL.accountLimitItem = AccountLimitItem{AccountLimitItemType: AccountLimitItemType_Queries_PH, Count: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 127:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1021
+		{
+			yyVAL.accountLimitItem = AccountLimitItem{AccountLimitItemType: AccountLimitItemType_Updates_PH, Count: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 128:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1025
+		{
+			yyVAL.accountLimitItem = AccountLimitItem{AccountLimitItemType: AccountLimitItemType_Connections_PH, Count: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 129:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1029
+		{
+			yyVAL.accountLimitItem = AccountLimitItem{AccountLimitItemType: AccountLimitItemType_Connections, Count: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 130:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1034
+		{
+			yyVAL.passLockItems = nil
+		}
+	case 131:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1038
+		{
+			yyVAL.passLockItems = yyDollar[1].passLockItems
+		}
+	case 132:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1044
+		{
+			yyVAL.passLockItems = []PassLockItem{yyDollar[1].passLockItem}
+		}
+	case 133:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1048
+		{
+			yyVAL.passLockItems = append(yyDollar[1].passLockItems, yyDollar[2].passLockItem)
+		}
+	case 134:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1054
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassExpireDefault, Value: nil}
+		}
+	case 135:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1058
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassExpireNever, Value: nil}
+		}
+	case 136:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1062
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassExpireInterval, Value: NewIntVal(yyDollar[4].bytes)}
+		}
+	case 137:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1066
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassHistory, Value: nil}
+		}
+	case 138:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1070
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassHistory, Value: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 139:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1074
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassReuseInterval, Value: nil}
+		}
+	case 140:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1078
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassReuseInterval, Value: NewIntVal(yyDollar[4].bytes)}
+		}
+	case 141:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1082
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassReqCurrentDefault, Value: nil}
+		}
+	case 142:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1086
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassReqCurrentOptional, Value: nil}
+		}
+	case 143:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1090
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassFailedLogins, Value: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 144:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1094
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassLockTime, Value: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 145:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1098
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_PassLockTime, Value: nil}
+		}
+	case 146:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1102
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_AccountLock, Value: nil}
+		}
+	case 147:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1106
+		{
+			yyVAL.passLockItem = PassLockItem{PassLockItemType: PassLockItemType_AccountUnlock, Value: nil}
+		}
+	case 148:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1111
+		{
+			yyVAL.str = ""
+		}
+	case 149:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1115
+		{
+			comment := string(yyDollar[2].bytes)
+			yyVAL.str = `{"comment": "` + escapeDoubleQuotes(comment) + `"}`
+		}
+	case 150:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1120
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 151:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1126
+		{
+			allPriv := []Privilege{Privilege{Type: PrivilegeType_All, Columns: nil}}
+			yyVAL.statement = &GrantPrivilege{Privileges: allPriv, ObjectType: yyDollar[4].grantObjectType, PrivilegeLevel: yyDollar[5].privilegeLevel, To: yyDollar[7].accountNames, WithGrantOption: yyDollar[8].boolean, As: yyDollar[9].grantAssumption}
+		}
+	case 152:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:1131
+		{
+			yyVAL.statement = &GrantPrivilege{Privileges: yyDollar[2].grantPrivileges, ObjectType: yyDollar[4].grantObjectType, PrivilegeLevel: yyDollar[5].privilegeLevel, To: yyDollar[7].accountNames, WithGrantOption: yyDollar[8].boolean, As: yyDollar[9].grantAssumption}
+		}
+	case 153:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1135
+		{
+			yyVAL.statement = &GrantRole{Roles: yyDollar[2].accountNames, To: yyDollar[4].accountNames, WithAdminOption: yyDollar[5].boolean}
+		}
+	case 154:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1139
+		{
+			yyVAL.statement = &GrantProxy{On: yyDollar[4].accountName, To: yyDollar[6].accountNames, WithGrantOption: yyDollar[7].boolean}
+		}
+	case 155:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1145
+		{
+			allPriv := []Privilege{Privilege{Type: PrivilegeType_All, Columns: nil}}
+			yyVAL.statement = &RevokePrivilege{Privileges: allPriv, ObjectType: yyDollar[4].grantObjectType, PrivilegeLevel: yyDollar[5].privilegeLevel, From: yyDollar[7].accountNames}
+		}
+	case 156:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1150
+		{
+			yyVAL.statement = &RevokePrivilege{Privileges: yyDollar[2].grantPrivileges, ObjectType: yyDollar[4].grantObjectType, PrivilegeLevel: yyDollar[5].privilegeLevel, From: yyDollar[7].accountNames}
+		}
+	case 157:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1154
+		{
+			yyVAL.statement = &RevokeAllPrivileges{From: yyDollar[7].accountNames}
+		}
+	case 158:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1158
+		{
+			yyVAL.statement = &RevokeAllPrivileges{From: yyDollar[8].accountNames}
+		}
+	case 159:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1162
+		{
+			yyVAL.statement = &RevokeRole{Roles: yyDollar[2].accountNames, From: yyDollar[4].accountNames}
+		}
+	case 160:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1166
+		{
+			yyVAL.statement = &RevokeProxy{On: yyDollar[4].accountName, From: yyDollar[6].accountNames}
+		}
+	case 161:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1172
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Insert, Columns: yyDollar[2].strs}
+		}
+	case 162:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1176
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_References, Columns: yyDollar[2].strs}
+		}
+	case 163:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1180
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Select, Columns: yyDollar[2].strs}
+		}
+	case 164:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1184
+		{
+			yyVAL.grantPrivilege = Privilege{Type: PrivilegeType_Update, Columns: yyDollar[2].strs}
+		}
+	case 165:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1190
+		{
+			yyVAL.grantPrivileges = []Privilege{yyDollar[1].grantPrivilege}
+		}
+	case 166:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1194
+		{
+			yyVAL.grantPrivileges = append(yyDollar[1].grantPrivileges, yyDollar[3].grantPrivilege)
+		}
+	case 167:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1199
+		{
+			yyVAL.strs = nil
+		}
+	case 168:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1203
+		{
+			yyVAL.strs = yyDollar[2].strs
+		}
+	case 169:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1209
+		{
+			yyVAL.strs = []string{yyDollar[1].colIdent.String()}
+		}
+	case 170:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1213
+		{
+			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].colIdent.String())
+		}
+	case 171:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1218
+		{
+			yyVAL.grantObjectType = GrantObjectType_Any
+		}
+	case 172:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1222
+		{
+			yyVAL.grantObjectType = GrantObjectType_Table
+		}
+	case 173:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1226
+		{
+			yyVAL.grantObjectType = GrantObjectType_Function
+		}
+	case 174:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1230
+		{
+			yyVAL.grantObjectType = GrantObjectType_Procedure
+		}
+	case 175:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1236
+		{
+			yyVAL.privilegeLevel = PrivilegeLevel{Database: "", TableRoutine: "*"}
+		}
+	case 176:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1240
+		{
+			yyVAL.privilegeLevel = PrivilegeLevel{Database: "*", TableRoutine: "*"}
+		}
+	case 177:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1244
+		{
+			yyVAL.privilegeLevel = PrivilegeLevel{Database: "", TableRoutine: yyDollar[1].colIdent.String()}
+		}
+	case 178:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1248
+		{
+			yyVAL.privilegeLevel = PrivilegeLevel{Database: yyDollar[1].colIdent.String(), TableRoutine: "*"}
+		}
+	case 179:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1252
+		{
+			yyVAL.privilegeLevel = PrivilegeLevel{Database: yyDollar[1].colIdent.String(), TableRoutine: yyDollar[3].colIdent.String()}
+		}
+	case 180:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1257
+		{
+			yyVAL.grantAssumption = nil
+		}
+	case 181:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1261
+		{
+			yyVAL.grantAssumption = &GrantUserAssumption{Type: GrantUserAssumptionType_Default, User: yyDollar[2].accountName, Roles: nil}
+		}
+	case 182:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1265
+		{
+			yyVAL.grantAssumption = &GrantUserAssumption{Type: GrantUserAssumptionType_Default, User: yyDollar[2].accountName, Roles: nil}
+		}
+	case 183:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1269
+		{
+			yyVAL.grantAssumption = &GrantUserAssumption{Type: GrantUserAssumptionType_None, User: yyDollar[2].accountName, Roles: nil}
+		}
+	case 184:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1273
+		{
+			yyVAL.grantAssumption = &GrantUserAssumption{Type: GrantUserAssumptionType_All, User: yyDollar[2].accountName, Roles: nil}
+		}
+	case 185:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1277
+		{
+			yyVAL.grantAssumption = &GrantUserAssumption{Type: GrantUserAssumptionType_AllExcept, User: yyDollar[2].accountName, Roles: yyDollar[7].accountNames}
+		}
+	case 186:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1281
+		{
+			yyVAL.grantAssumption = &GrantUserAssumption{Type: GrantUserAssumptionType_Roles, User: yyDollar[2].accountName, Roles: yyDollar[5].accountNames}
+		}
+	case 187:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1286
+		{
+			yyVAL.boolean = false
+		}
+	case 188:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1290
+		{
+			yyVAL.boolean = true
+		}
+	case 189:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1295
+		{
+			yyVAL.boolean = false
+		}
+	case 190:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1299
+		{
+			yyVAL.boolean = true
+		}
+	case 191:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1306
+		{
+			yyDollar[1].selStmt.SetOrderBy(yyDollar[2].orderBy)
+			yyDollar[1].selStmt.SetLimit(yyDollar[3].limit)
+			yyDollar[1].selStmt.SetLock(yyDollar[4].str)
+			yyVAL.selStmt = yyDollar[1].selStmt
+		}
+	case 192:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1314
+		{
+			yyVAL.procedureParams = nil
+		}
+	case 193:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1318
+		{
+			yyVAL.procedureParams = yyDollar[1].procedureParams
+		}
+	case 194:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1324
+		{
+			yyVAL.procedureParams = []ProcedureParam{yyDollar[1].procedureParam}
+		}
+	case 195:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1328
+		{
+			yyVAL.procedureParams = append(yyVAL.procedureParams, yyDollar[3].procedureParam)
+		}
+	case 196:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1334
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_In, Name: string(yyDollar[1].bytes), Type: yyDollar[2].columnType}
+		}
+	case 197:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1338
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_In, Name: string(yyDollar[2].bytes), Type: yyDollar[3].columnType}
+		}
+	case 198:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1342
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_Inout, Name: string(yyDollar[2].bytes), Type: yyDollar[3].columnType}
+		}
+	case 199:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1346
+		{
+			yyVAL.procedureParam = ProcedureParam{Direction: ProcedureParamDirection_Out, Name: string(yyDollar[2].bytes), Type: yyDollar[3].columnType}
+		}
+	case 200:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1351
+		{
+			yyVAL.characteristics = nil
+		}
+	case 201:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1355
+		{
+			yyVAL.characteristics = yyDollar[1].characteristics
+		}
+	case 202:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1361
+		{
+			yyVAL.characteristics = []Characteristic{yyDollar[1].characteristic}
+		}
+	case 203:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1365
+		{
+			yyVAL.characteristics = append(yyVAL.characteristics, yyDollar[2].characteristic)
+		}
+	case 204:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1371
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_Comment, Comment: string(yyDollar[2].bytes)}
+		}
+	case 205:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1375
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_LanguageSql}
+		}
+	case 206:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1379
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_NotDeterministic}
+		}
+	case 207:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1383
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_Deterministic}
+		}
+	case 208:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1387
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_ContainsSql}
+		}
+	case 209:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1391
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_NoSql}
+		}
+	case 210:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1395
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_ReadsSqlData}
+		}
+	case 211:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1399
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_ModifiesSqlData}
+		}
+	case 212:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1403
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_SqlSecurityDefiner}
+		}
+	case 213:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1407
+		{
+			yyVAL.characteristic = Characteristic{Type: CharacteristicValue_SqlSecurityInvoker}
+		}
+	case 214:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1413
+		{
+			yyVAL.statement = &BeginEndBlock{Statements: yyDollar[2].statements}
+		}
+	case 215:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1418
+		{
+			yyVAL.str = ""
+		}
+	case 216:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1422
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 217:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1428
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 218:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1432
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 219:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1436
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 220:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1440
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 221:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1446
+		{
+			anyHost := false
+			if yyDollar[3].str == "%" {
+				anyHost = true
+			}
+			yyVAL.accountName = AccountName{Name: yyDollar[1].str, Host: yyDollar[3].str, AnyHost: anyHost}
+		}
+	case 222:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1454
+		{
+			yyVAL.accountName = AccountName{Name: yyDollar[1].str, Host: "", AnyHost: false}
+		}
+	case 223:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1458
+		{
+			yyVAL.accountName = AccountName{Name: yyDollar[1].str, Host: "", AnyHost: true}
+		}
+	case 224:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1464
+		{
+			yyVAL.accountNames = []AccountName{yyDollar[1].accountName}
+		}
+	case 225:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1468
+		{
+			yyVAL.accountNames = append(yyDollar[1].accountNames, yyDollar[3].accountName)
+		}
+	case 226:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1474
+		{
+			if len(yyDollar[1].str) == 0 {
+				yylex.Error("the anonymous user is not a valid role name")
+				return 1
+			}
+			yyVAL.accountName = AccountName{Name: yyDollar[1].str, Host: yyDollar[3].str, AnyHost: false}
+		}
+	case 227:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1482
+		{
+			if len(yyDollar[1].str) == 0 {
+				yylex.Error("the anonymous user is not a valid role name")
+				return 1
+			}
+			yyVAL.accountName = AccountName{Name: yyDollar[1].str, Host: "", AnyHost: false}
+		}
+	case 228:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1490
+		{
+			if len(yyDollar[1].str) == 0 {
+				yylex.Error("the anonymous user is not a valid role name")
+				return 1
+			}
+			yyVAL.accountName = AccountName{Name: yyDollar[1].str, Host: "", AnyHost: true}
+		}
+	case 229:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1500
+		{
+			yyVAL.accountNames = []AccountName{yyDollar[1].accountName}
+		}
+	case 230:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1504
+		{
+			yyVAL.accountNames = append(yyDollar[1].accountNames, yyDollar[3].accountName)
+		}
+	case 231:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1510
+		{
+			yyVAL.accountWithAuth = AccountWithAuth{AccountName: yyDollar[1].accountName}
+		}
+	case 232:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1514
+		{
+			yyVAL.accountWithAuth = AccountWithAuth{AccountName: yyDollar[1].accountName, Auth1: yyDollar[2].authentication}
+		}
+	case 233:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1518
+		{
+			yyVAL.accountWithAuth = AccountWithAuth{AccountName: yyDollar[1].accountName, Auth1: yyDollar[2].authentication, AuthInitial: yyDollar[5].authentication}
+		}
+	case 234:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1522
+		{
+			yyVAL.accountWithAuth = AccountWithAuth{AccountName: yyDollar[1].accountName, Auth1: yyDollar[2].authentication, Auth2: yyDollar[4].authentication}
+		}
+	case 235:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1526
+		{
+			yyVAL.accountWithAuth = AccountWithAuth{AccountName: yyDollar[1].accountName, Auth1: yyDollar[2].authentication, Auth2: yyDollar[4].authentication, Auth3: yyDollar[6].authentication}
+		}
+	case 236:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1532
+		{
+			yyVAL.authentication = &Authentication{RandomPassword: true}
+		}
+	case 237:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1536
+		{
+			yyVAL.authentication = &Authentication{Password: string(yyDollar[3].bytes)}
+		}
+	case 238:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1540
+		{
+			yyVAL.authentication = &Authentication{Plugin: string(yyDollar[3].bytes)}
+		}
+	case 239:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1544
+		{
+			yyVAL.authentication = &Authentication{Plugin: string(yyDollar[3].bytes), RandomPassword: true}
+		}
+	case 240:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1548
+		{
+			yyVAL.authentication = &Authentication{Plugin: string(yyDollar[3].bytes), Password: string(yyDollar[5].bytes)}
+		}
+	case 241:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1552
+		{
+			yyVAL.authentication = &Authentication{Plugin: string(yyDollar[3].bytes), Identity: string(yyDollar[5].bytes)}
+		}
+	case 242:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1558
+		{
+			yyVAL.authentication = &Authentication{RandomPassword: true}
+		}
+	case 243:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1562
+		{
+			yyVAL.authentication = &Authentication{Password: string(yyDollar[3].bytes)}
+		}
+	case 244:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1566
+		{
+			yyVAL.authentication = &Authentication{Plugin: string(yyDollar[3].bytes), Identity: string(yyDollar[5].bytes)}
+		}
+	case 245:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1572
+		{
+			yyVAL.accountsWithAuth = []AccountWithAuth{yyDollar[1].accountWithAuth}
+		}
+	case 246:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1576
+		{
+			yyVAL.accountsWithAuth = append(yyDollar[1].accountsWithAuth, yyDollar[3].accountWithAuth)
+		}
+	case 247:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1582
+		{
+			yyVAL.str = BeforeStr
+		}
+	case 248:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1586
+		{
+			yyVAL.str = AfterStr
+		}
+	case 249:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1592
+		{
+			yyVAL.str = InsertStr
+		}
+	case 250:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1596
+		{
+			yyVAL.str = UpdateStr
+		}
+	case 251:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1600
+		{
+			yyVAL.str = DeleteStr
+		}
+	case 252:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1605
+		{
+			yyVAL.triggerOrder = nil
+		}
+	case 253:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1609
+		{
+			yyVAL.triggerOrder = &TriggerOrder{PrecedesOrFollows: FollowsStr, OtherTriggerName: string(yyDollar[2].bytes)}
+		}
+	case 254:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1613
+		{
+			yyVAL.triggerOrder = &TriggerOrder{PrecedesOrFollows: PrecedesStr, OtherTriggerName: string(yyDollar[2].bytes)}
+		}
+	case 255:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1619
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 260:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1629
+		{
+			yyVAL.statement = &BeginEndBlock{Statements: yyDollar[2].statements}
+		}
+	case 261:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1635
+		{
+			yyVAL.statement = &CaseStatement{Expr: yyDollar[2].expr, Cases: yyDollar[3].caseStatementCases}
+		}
+	case 262:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1639
+		{
+			yyVAL.statement = &CaseStatement{Expr: yyDollar[2].expr, Cases: yyDollar[3].caseStatementCases, Else: yyDollar[5].statements}
+		}
+	case 263:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1645
+		{
+			yyVAL.caseStatementCases = []CaseStatementCase{yyDollar[1].caseStatementCase}
+		}
+	case 264:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1649
+		{
+			yyVAL.caseStatementCases = append(yyVAL.caseStatementCases, yyDollar[2].caseStatementCase)
+		}
+	case 265:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1655
+		{
+			yyVAL.caseStatementCase = CaseStatementCase{Case: yyDollar[2].expr, Statements: yyDollar[4].statements}
+		}
+	case 266:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:1661
+		{
+			conds := []IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}
+			yyVAL.statement = &IfStatement{Conditions: conds}
+		}
+	case 267:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:1666
+		{
+			conds := []IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}
+			yyVAL.statement = &IfStatement{Conditions: conds, Else: yyDollar[7].statements}
+		}
+	case 268:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:1671
+		{
+			conds := yyDollar[6].ifStatementConditions
+			conds = append([]IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}, conds...)
+			yyVAL.statement = &IfStatement{Conditions: conds}
+		}
+	case 269:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:1677
+		{
+			conds := yyDollar[6].ifStatementConditions
+			conds = append([]IfStatementCondition{IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}}, conds...)
+			yyVAL.statement = &IfStatement{Conditions: conds, Else: yyDollar[8].statements}
+		}
+	case 270:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1685
+		{
+			yyVAL.ifStatementConditions = []IfStatementCondition{yyDollar[1].ifStatementCondition}
+		}
+	case 271:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1689
+		{
+			yyVAL.ifStatementConditions = append(yyVAL.ifStatementConditions, yyDollar[2].ifStatementCondition)
+		}
+	case 272:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1695
+		{
+			yyVAL.ifStatementCondition = IfStatementCondition{Expr: yyDollar[2].expr, Statements: yyDollar[4].statements}
+		}
+	case 273:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1701
+		{
+			yyVAL.statement = &Declare{Condition: &DeclareCondition{Name: string(yyDollar[2].bytes), SqlStateValue: string(yyDollar[5].bytes)}}
+		}
+	case 274:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1705
+		{
+			yyVAL.statement = &Declare{Condition: &DeclareCondition{Name: string(yyDollar[2].bytes), MysqlErrorCode: NewIntVal(yyDollar[5].bytes)}}
+		}
+	case 275:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1709
+		{
+			yyVAL.statement = &Declare{Cursor: &DeclareCursor{Name: string(yyDollar[2].bytes), SelectStmt: yyDollar[5].selStmt}}
+		}
+	case 276:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1713
+		{
+			yyVAL.statement = &Declare{Handler: &DeclareHandler{Action: yyDollar[2].declareHandlerAction, ConditionValues: yyDollar[5].declareHandlerConditions, Statement: yyDollar[6].statement}}
+		}
+	case 277:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1717
+		{
+			yyVAL.statement = &Declare{Variables: &DeclareVariables{Names: yyDollar[2].colIdents, VarType: yyDollar[3].columnType}}
+		}
+	case 278:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1721
+		{
+			yyDollar[3].columnType.Default = yyDollar[5].expr
+			yyVAL.statement = &Declare{Variables: &DeclareVariables{Names: yyDollar[2].colIdents, VarType: yyDollar[3].columnType}}
+		}
+	case 279:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1728
+		{
+			yyVAL.declareHandlerAction = DeclareHandlerAction_Continue
+		}
+	case 280:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1732
+		{
+			yyVAL.declareHandlerAction = DeclareHandlerAction_Exit
+		}
+	case 281:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1736
+		{
+			yyVAL.declareHandlerAction = DeclareHandlerAction_Undo
+		}
+	case 282:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1742
+		{
+			yyVAL.declareHandlerConditions = []DeclareHandlerCondition{yyDollar[1].declareHandlerCondition}
+		}
+	case 283:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1746
+		{
+			yyVAL.declareHandlerConditions = append(yyVAL.declareHandlerConditions, yyDollar[3].declareHandlerCondition)
+		}
+	case 284:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1752
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_MysqlErrorCode, MysqlErrorCode: NewIntVal(yyDollar[1].bytes)}
+		}
+	case 285:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1756
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlState, String: string(yyDollar[1].bytes)}
+		}
+	case 286:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1760
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlWarning}
+		}
+	case 287:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1764
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_NotFound}
+		}
+	case 288:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1768
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_SqlException}
+		}
+	case 289:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1772
+		{
+			yyVAL.declareHandlerCondition = DeclareHandlerCondition{ValueType: DeclareHandlerCondition_ConditionName, String: string(yyDollar[1].bytes)}
+		}
+	case 290:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1778
+		{
+			yyVAL.statement = &Signal{SqlStateValue: string(yyDollar[2].bytes)}
+		}
+	case 291:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1782
+		{
+			yyVAL.statement = &Signal{SqlStateValue: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}
+		}
+	case 292:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1786
+		{
+			yyVAL.statement = &Signal{ConditionName: string(yyDollar[2].bytes)}
+		}
+	case 293:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1790
+		{
+			yyVAL.statement = &Signal{ConditionName: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}
+		}
+	case 294:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1796
+		{
+			yyVAL.bytes = yyDollar[2].bytes
+		}
+	case 295:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1800
+		{
+			yyVAL.bytes = yyDollar[3].bytes
+		}
+	case 296:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1806
+		{
+			yyVAL.signalInfos = []SignalInfo{yyDollar[1].signalInfo}
+		}
+	case 297:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1810
+		{
+			yyVAL.signalInfos = append(yyVAL.signalInfos, yyDollar[3].signalInfo)
+		}
+	case 298:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1816
+		{
+			yyVAL.signalInfo = SignalInfo{ConditionItemName: yyDollar[1].signalConditionItemName, Value: yyDollar[3].expr.(*SQLVal)}
+		}
+	case 299:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1822
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ClassOrigin
+		}
+	case 300:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1826
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_SubclassOrigin
+		}
+	case 301:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1830
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_MessageText
+		}
+	case 302:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1834
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_MysqlErrno
+		}
+	case 303:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1838
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ConstraintCatalog
+		}
+	case 304:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1842
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ConstraintSchema
+		}
+	case 305:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1846
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ConstraintName
+		}
+	case 306:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1850
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_CatalogName
+		}
+	case 307:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1854
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_SchemaName
+		}
+	case 308:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1858
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_TableName
+		}
+	case 309:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1862
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_ColumnName
+		}
+	case 310:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1866
+		{
+			yyVAL.signalConditionItemName = SignalConditionItemName_CursorName
+		}
+	case 311:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1872
+		{
+			yyVAL.statement = &Resignal{}
+		}
+	case 312:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1876
+		{
+			yyVAL.statement = &Resignal{Signal{SqlStateValue: string(yyDollar[2].bytes)}}
+		}
+	case 313:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1880
+		{
+			yyVAL.statement = &Resignal{Signal{SqlStateValue: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}}
+		}
+	case 314:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1884
+		{
+			yyVAL.statement = &Resignal{Signal{Info: yyDollar[3].signalInfos}}
+		}
+	case 315:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1888
+		{
+			yyVAL.statement = &Resignal{Signal{ConditionName: string(yyDollar[2].bytes)}}
+		}
+	case 316:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1892
+		{
+			yyVAL.statement = &Resignal{Signal{ConditionName: string(yyDollar[2].bytes), Info: yyDollar[4].signalInfos}}
+		}
+	case 317:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1898
+		{
+			yyVAL.statement = &Call{FuncName: string(yyDollar[2].bytes), Params: yyDollar[3].exprs}
+		}
+	case 318:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1903
+		{
+			yyVAL.exprs = nil
+		}
+	case 319:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1907
+		{
+			yyVAL.exprs = nil
+		}
+	case 320:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1911
+		{
+			yyVAL.exprs = yyDollar[2].exprs
+		}
+	case 321:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1917
+		{
+			yyVAL.statements = Statements{yyDollar[1].statement}
+		}
+	case 322:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1921
+		{
+			yyVAL.statements = append(yyVAL.statements, yyDollar[3].statement)
+		}
+	case 323:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1927
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 352:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1961
+		{
+			var ne bool
+			if yyDollar[4].byt != 0 {
+				ne = true
+			}
+
+			var neTemp bool
+			if yyDollar[2].byt != 0 {
+				neTemp = true
+			}
+
+			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[5].tableName, IfNotExists: ne, Temporary: neTemp}
+		}
+	case 353:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1977
+		{
+			yyVAL.TableSpec = yyDollar[2].TableSpec
+			yyVAL.TableSpec.Options = yyDollar[4].str
+		}
+	case 354:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1984
+		{
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
+		}
+	case 355:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1989
+		{
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddConstraint(yyDollar[1].constraintDefinition)
+		}
+	case 356:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1994
+		{
+			yyVAL.TableSpec = &TableSpec{}
+			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
+			yyVAL.TableSpec.AddConstraint(yyDollar[2].constraintDefinition)
+		}
+	case 357:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2000
+		{
+			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+		}
+	case 358:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2004
+		{
+			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+			yyVAL.TableSpec.AddConstraint(yyDollar[4].constraintDefinition)
+		}
+	case 359:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2009
+		{
+			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
+		}
+	case 360:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2013
+		{
+			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
+		}
+	case 361:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2017
+		{
+			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
+		}
+	case 362:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2023
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 363:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2031
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 364:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2041
+		{
+			if err := yyDollar[2].columnType.merge(yyDollar[3].columnType); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
+		}
+	case 365:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2050
+		{
+			yyVAL.columnType = ColumnType{}
+		}
+	case 366:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2054
+		{
+			opt := ColumnType{Null: BoolVal(true), NotNull: BoolVal(false), sawnull: true}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 367:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2063
+		{
+			opt := ColumnType{Null: BoolVal(false), NotNull: BoolVal(true), sawnull: true}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 368:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2072
+		{
+			opt := ColumnType{Default: yyDollar[2].optVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 369:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2081
+		{
+			opt := ColumnType{OnUpdate: yyDollar[2].optVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 370:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2090
+		{
+			opt := ColumnType{Autoincrement: yyDollar[2].boolVal, sawai: true}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 371:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2099
+		{
+			opt := ColumnType{KeyOpt: yyDollar[2].colKeyOpt}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 372:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2108
+		{
+			opt := ColumnType{Comment: yyDollar[2].sqlVal}
+			if err := yyDollar[1].columnType.merge(opt); err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 373:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2119
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
+			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
+		}
+	case 377:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2130
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+			yyVAL.columnType.Length = yyDollar[2].sqlVal
+		}
+	case 378:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2135
+		{
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 379:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2141
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 380:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2145
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 381:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2149
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 382:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2153
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 383:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2157
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 384:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2161
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 385:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2165
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 386:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2169
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 387:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2173
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 388:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2177
+		{
+			yyVAL.columnType = ColumnType{Type: "bigint", Unsigned: true, NotNull: true, Autoincrement: true, KeyOpt: colKeyUnique}
+		}
+	case 389:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2183
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 390:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2189
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 391:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2195
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
+			yyVAL.columnType.Length = yyDollar[3].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[3].LengthScaleOption.Scale
+		}
+	case 392:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2201
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 393:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2207
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 394:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2213
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 395:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2219
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 396:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2225
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 397:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2233
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 398:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2237
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 399:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2241
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 400:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2245
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 401:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2249
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 402:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2255
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 403:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2259
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 404:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2263
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 405:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2267
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 406:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2271
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 407:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2275
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 408:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2279
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal, Charset: yyDollar[4].str, Collate: yyDollar[5].str}
+		}
+	case 409:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2283
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 410:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2287
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Length: yyDollar[3].sqlVal}
+		}
+	case 411:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2291
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Length: yyDollar[4].sqlVal}
+		}
+	case 412:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2295
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 413:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2299
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 414:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2303
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 415:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2307
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 416:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2311
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 417:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2315
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 418:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2319
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+		}
+	case 419:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2323
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+		}
+	case 420:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2327
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 421:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2331
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 422:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2335
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 423:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2339
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 424:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2343
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 425:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2347
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+		}
+	case 426:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:2352
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+		}
+	case 427:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2358
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 428:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2362
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 429:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2366
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 430:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2370
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 431:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2374
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 432:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2378
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 433:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2382
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 434:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2386
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 435:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2392
+		{
+			yyVAL.strs = make([]string, 0, 4)
+			yyVAL.strs = append(yyVAL.strs, string(yyDollar[1].bytes))
+		}
+	case 436:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2397
+		{
+			yyVAL.strs = append(yyDollar[1].strs, string(yyDollar[3].bytes))
+		}
+	case 437:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2402
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 438:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2406
+		{
+			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 439:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2411
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{}
+		}
+	case 440:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2415
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
+		}
+	case 441:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2423
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{}
+		}
+	case 442:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2427
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+			}
+		}
+	case 443:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2433
+		{
+			yyVAL.LengthScaleOption = LengthScaleOption{
+				Length: NewIntVal(yyDollar[2].bytes),
+				Scale:  NewIntVal(yyDollar[4].bytes),
+			}
+		}
+	case 444:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2441
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 445:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2445
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 446:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2450
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 447:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2454
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 448:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2460
+		{
+			yyVAL.optVal = yyDollar[2].expr
+		}
+	case 449:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2466
+		{
+			yyVAL.optVal = yyDollar[3].expr
+		}
+	case 450:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2472
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 451:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2477
+		{
+			yyVAL.str = ""
+		}
+	case 452:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2481
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 453:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2485
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 454:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2490
+		{
+			yyVAL.str = ""
+		}
+	case 455:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2494
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 456:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2498
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 457:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2504
+		{
+			yyVAL.colKeyOpt = colKeyPrimary
+		}
+	case 458:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2508
+		{
+			yyVAL.colKeyOpt = colKey
+		}
+	case 459:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2512
+		{
+			yyVAL.colKeyOpt = colKeyUniqueKey
+		}
+	case 460:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2516
+		{
+			yyVAL.colKeyOpt = colKeyUnique
+		}
+	case 461:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2522
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
+		}
+	case 462:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2528
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
+		}
+	case 463:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2532
+		{
+			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
+		}
+	case 464:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2537
+		{
+			yyVAL.indexOptions = nil
+		}
+	case 465:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2541
+		{
+			yyVAL.indexOptions = yyDollar[1].indexOptions
+		}
+	case 466:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2547
+		{
+			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
+		}
+	case 467:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2551
+		{
+			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
+		}
+	case 468:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2557
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
+		}
+	case 469:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2561
+		{
+			// should not be string
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 470:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2566
+		{
+			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
+		}
+	case 471:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2572
+		{
+			yyVAL.str = ""
+		}
+	case 472:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2576
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 473:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2582
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
+		}
+	case 474:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2586
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Primary: true, Unique: true}
+		}
+	case 475:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2590
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
+		}
+	case 476:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2594
+		{
+			var name string
+			name = yyDollar[2].str
+			if name == "" {
+				name = yyDollar[5].str
+			}
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[3].bytes) + " " + string(yyDollar[4].str), Name: NewColIdent(name), Unique: true}
+		}
+	case 477:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2603
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
+		}
+	case 478:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2607
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
+		}
+	case 479:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2611
+		{
+			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
+		}
+	case 480:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2617
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 481:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2621
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 482:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2625
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 483:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2631
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 484:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2635
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 485:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2640
+		{
+			yyVAL.str = ""
+		}
+	case 486:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2644
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 487:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2649
+		{
+			yyVAL.str = ""
+		}
+	case 488:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2653
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 489:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2659
+		{
+			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
+		}
+	case 490:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2663
+		{
+			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
+		}
+	case 491:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2669
+		{
+			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal, Order: yyDollar[3].str}
+		}
+	case 492:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2673
+		{
+			yyVAL.indexColumn = &IndexColumn{Column: NewColIdent(string(yyDollar[1].bytes)), Length: yyDollar[2].sqlVal, Order: yyDollar[3].str}
+		}
+	case 493:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2679
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 494:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2683
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 495:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2687
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
+		}
+	case 496:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2693
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
+		}
+	case 497:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:2697
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
+		}
+	case 498:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:2701
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
+		}
+	case 499:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:2705
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
+		}
+	case 500:
+		yyDollar = yyS[yypt-12 : yypt+1]
+//line sql.y:2709
+		{
+			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[12].ReferenceAction, OnUpdate: yyDollar[11].ReferenceAction}
+		}
+	case 501:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2715
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 502:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2719
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
+		}
+	case 503:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2723
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[2].constraintInfo}
+		}
+	case 504:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2727
+		{
+			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
+		}
+	case 505:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2733
+		{
+			yyVAL.constraintInfo = &CheckConstraintDefinition{Expr: yyDollar[3].expr, Enforced: yyDollar[5].boolean}
+		}
+	case 506:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2739
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 507:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2743
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 508:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2748
+		{
+			yyVAL.str = ""
+		}
+	case 509:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2752
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 510:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2756
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 511:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2762
+		{
+			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
+		}
+	case 512:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2768
+		{
+			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
+		}
+	case 513:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2774
+		{
+			yyVAL.ReferenceAction = Restrict
+		}
+	case 514:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2778
+		{
+			yyVAL.ReferenceAction = Cascade
+		}
+	case 515:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2782
+		{
+			yyVAL.ReferenceAction = NoAction
+		}
+	case 516:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2786
+		{
+			yyVAL.ReferenceAction = SetDefault
+		}
+	case 517:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2790
+		{
+			yyVAL.ReferenceAction = SetNull
+		}
+	case 518:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2795
+		{
+			yyVAL.boolean = true
+		}
+	case 519:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2799
+		{
+			yyVAL.boolean = true
+		}
+	case 520:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2803
+		{
+			yyVAL.boolean = false
+		}
+	case 521:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2808
+		{
+			yyVAL.str = ""
+		}
+	case 522:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2812
+		{
+			yyVAL.str = " " + string(yyDollar[1].str)
+		}
+	case 523:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2816
+		{
+			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
+		}
+	case 524:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2824
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 525:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2828
+		{
+			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
+		}
+	case 526:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2832
+		{
+			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
+		}
+	case 527:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2838
+		{
+			yyVAL.str = yyDollar[1].colIdent.String()
+		}
+	case 528:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2842
+		{
+			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
+		}
+	case 529:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2846
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 530:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2851
+		{
+			yyVAL.str = ""
+		}
+	case 531:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2855
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 532:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2860
+		{
+			yyVAL.str = string("")
+		}
+	case 533:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2864
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 536:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2874
+		{
+			for i := 0; i < len(yyDollar[5].ddls); i++ {
+				if yyDollar[5].ddls[i].Action == RenameStr {
+					yyDollar[5].ddls[i].FromTables = append(TableNames{yyDollar[4].tableName}, yyDollar[5].ddls[i].FromTables...)
+				} else {
+					yyDollar[5].ddls[i].Table = yyDollar[4].tableName
+				}
+			}
+			yyVAL.statement = &MultiAlterDDL{Table: yyDollar[4].tableName, Statements: yyDollar[5].ddls}
+		}
+	case 537:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2887
+		{
+			yyVAL.ddls = []*DDL{yyDollar[1].ddl}
+		}
+	case 538:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2891
+		{
+			yyVAL.ddls = append(yyVAL.ddls, yyDollar[3].ddl)
+		}
+	case 539:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2897
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 540:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2901
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: AddStr, TableSpec: &TableSpec{}}
+			ddl.TableSpec.AddColumn(yyDollar[4].columnDefinition)
+			ddl.Column = yyDollar[4].columnDefinition.Name
+			yyVAL.ddl = ddl
+		}
+	case 541:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2908
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: AddStr, TableSpec: &TableSpec{}, ColumnOrder: yyDollar[4].columnOrder}
+			ddl.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+			ddl.Column = yyDollar[3].columnDefinition.Name
+			yyVAL.ddl = ddl
+		}
+	case 542:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2915
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 543:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2919
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ColumnAction: DropStr, Column: NewColIdent(string(yyDollar[3].bytes))}
+		}
+	case 544:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2923
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr}
+		}
+	case 545:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2927
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ColumnAction: RenameStr, Column: NewColIdent(string(yyDollar[3].bytes)), ToColumn: NewColIdent(string(yyDollar[5].bytes))}
+		}
+	case 546:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2931
+		{
+			// Change this to a rename statement
+			yyVAL.ddl = &DDL{Action: RenameStr, ToTables: TableNames{yyDollar[3].tableName}}
+		}
+	case 547:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:2936
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: CreateStr, ToName: NewColIdent(yyDollar[3].str), Using: yyDollar[4].colIdent, Columns: yyDollar[6].indexColumns, Options: yyDollar[8].indexOptions}}
+		}
+	case 548:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:2940
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: CreateStr, ToName: NewColIdent(yyDollar[5].str), Type: yyDollar[3].str, Using: yyDollar[6].colIdent, Columns: yyDollar[8].indexColumns, Options: yyDollar[10].indexOptions, ConstraintName: yyDollar[2].str}}
+		}
+	case 549:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2944
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[3].bytes)}}}}
+		}
+	case 550:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2949
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[3].bytes)}}}}
+		}
+	case 551:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2954
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[3].bytes), Details: &CheckConstraintDefinition{}}}}}
+		}
+	case 552:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2959
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[3].bytes), Details: &CheckConstraintDefinition{}}}}}
+		}
+	case 553:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:2964
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: DropStr, ToName: yyDollar[3].colIdent}}
+		}
+	case 554:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2968
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: RenameStr, FromName: yyDollar[3].colIdent, ToName: yyDollar[5].colIdent}}
+		}
+	case 555:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2972
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: ModifyStr, TableSpec: &TableSpec{}, ColumnOrder: yyDollar[4].columnOrder}
+			ddl.TableSpec.AddColumn(yyDollar[3].columnDefinition)
+			ddl.Column = yyDollar[3].columnDefinition.Name
+			yyVAL.ddl = ddl
+		}
+	case 556:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2979
+		{
+			ddl := &DDL{Action: AlterStr, ColumnAction: ChangeStr, TableSpec: &TableSpec{}, Column: NewColIdent(string(yyDollar[3].bytes)), ColumnOrder: yyDollar[5].columnOrder}
+			ddl.TableSpec.AddColumn(yyDollar[4].columnDefinition)
+			yyVAL.ddl = ddl
+		}
+	case 557:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:2985
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, PartitionSpec: yyDollar[1].partSpec}
+		}
+	case 558:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2989
+		{
+			ddl := &DDL{Action: AlterStr, ConstraintAction: AddStr, TableSpec: &TableSpec{}}
+			ddl.TableSpec.AddConstraint(yyDollar[2].constraintDefinition)
+			yyVAL.ddl = ddl
+		}
+	case 559:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2995
+		{
+			ddl := &DDL{Action: AlterStr, ConstraintAction: AddStr, TableSpec: &TableSpec{}}
+			ddl.TableSpec.AddConstraint(yyDollar[2].constraintDefinition)
+			yyVAL.ddl = ddl
+		}
+	case 560:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3001
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, ConstraintAction: DropStr, TableSpec: &TableSpec{Constraints: []*ConstraintDefinition{&ConstraintDefinition{Name: string(yyDollar[4].bytes), Details: &ForeignKeyDefinition{}}}}}
+		}
+	case 561:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3006
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, AutoIncSpec: &AutoIncSpec{Value: yyDollar[3].expr}}
+		}
+	case 562:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3010
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, DefaultSpec: &DefaultSpec{Action: SetStr, Column: yyDollar[3].colIdent, Value: yyDollar[6].expr}}
+		}
+	case 563:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3014
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, DefaultSpec: &DefaultSpec{Action: DropStr, Column: yyDollar[3].colIdent}}
+		}
+	case 564:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3018
+		{
+			yyVAL.ddl = &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: DropStr, Type: PrimaryStr}}
+		}
+	case 565:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3022
+		{
+			ddl := &DDL{Action: AlterStr, IndexSpec: &IndexSpec{Action: CreateStr}}
+			ddl.IndexSpec = &IndexSpec{Action: CreateStr, Using: NewColIdent(""), ToName: NewColIdent(yyDollar[2].str), Type: PrimaryStr, Columns: yyDollar[6].indexColumns, Options: yyDollar[8].indexOptions}
+			yyVAL.ddl = ddl
+		}
+	case 566:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3029
+		{
+			yyVAL.columnOrder = nil
+		}
+	case 567:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3033
+		{
+			yyVAL.columnOrder = &ColumnOrder{First: true}
+		}
+	case 568:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3037
+		{
+			yyVAL.columnOrder = &ColumnOrder{AfterColumn: NewColIdent(string(yyDollar[2].bytes))}
+		}
+	case 569:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3043
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
+		}
+	case 570:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3049
+		{
+		}
+	case 571:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3051
+		{
+		}
+	case 574:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3059
+		{
+			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
+		}
+	case 575:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3065
+		{
+			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
+		}
+	case 576:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3069
+		{
+			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
+		}
+	case 577:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3075
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
+		}
+	case 578:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3079
+		{
+			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
+		}
+	case 579:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3085
+		{
+			yyVAL.statement = yyDollar[3].ddl
+		}
+	case 580:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3089
+		{
+			yyVAL.statement = &RenameUser{Accounts: yyDollar[3].accountRenames}
+		}
+	case 581:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3095
+		{
+			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
+		}
+	case 582:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3099
+		{
+			yyVAL.ddl = yyDollar[1].ddl
+			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
+			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
+		}
+	case 583:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3107
+		{
+			yyVAL.accountRenames = []AccountRename{{From: yyDollar[1].accountName, To: yyDollar[3].accountName}}
+		}
+	case 584:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3111
+		{
+			yyVAL.accountRenames = append(yyDollar[1].accountRenames, AccountRename{From: yyDollar[3].accountName, To: yyDollar[5].accountName})
+		}
+	case 585:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3117
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
+		}
+	case 586:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3125
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName, IndexSpec: &IndexSpec{Action: DropStr, ToName: yyDollar[3].colIdent}}
+		}
+	case 587:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3129
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, FromViews: yyDollar[4].tableNames, IfExists: exists}
+		}
+	case 588:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3137
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes), IfExists: exists}
+		}
+	case 589:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3145
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes), IfExists: exists}
+		}
+	case 590:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3153
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, TriggerSpec: &TriggerSpec{Name: string(yyDollar[4].bytes)}, IfExists: exists}
+		}
+	case 591:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3161
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DDL{Action: DropStr, ProcedureSpec: &ProcedureSpec{Name: string(yyDollar[4].bytes)}, IfExists: exists}
+		}
+	case 592:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3169
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DropUser{IfExists: exists, AccountNames: yyDollar[4].accountNames}
+		}
+	case 593:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3177
+		{
+			var exists bool
+			if yyDollar[3].byt != 0 {
+				exists = true
+			}
+			yyVAL.statement = &DropRole{IfExists: exists, Roles: yyDollar[4].accountNames}
+		}
+	case 594:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3186
+		{
+
+		}
+	case 595:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3190
+		{
+			yyVAL.ReferenceAction = Restrict
+		}
+	case 596:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3194
+		{
+			yyVAL.ReferenceAction = Cascade
+		}
+	case 597:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3200
+		{
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
+		}
+	case 598:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3204
+		{
+			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
+		}
+	case 599:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3209
+		{
+			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName}
+		}
+	case 600:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3215
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 601:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3220
+		{
+			yyVAL.statement = &Show{Type: CharsetStr, Filter: yyDollar[4].showFilter}
+		}
+	case 602:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3224
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), Filter: yyDollar[3].showFilter}
+		}
+	case 603:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3228
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), IfNotExists: yyDollar[4].byt == 1, Database: string(yyDollar[5].bytes)}
+		}
+	case 604:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3232
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), IfNotExists: yyDollar[4].byt == 1, Database: string(yyDollar[5].bytes)}
+		}
+	case 605:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3237
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 606:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3241
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 607:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3245
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
+		}
+	case 608:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3249
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
+		}
+	case 609:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3253
+		{
+			yyVAL.statement = &Show{Type: CreateTriggerStr, Table: yyDollar[4].tableName}
+		}
+	case 610:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3257
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
+		}
+	case 611:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3261
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 612:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3265
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 613:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3269
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 614:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3273
+		{
+			yyVAL.statement = &Show{Type: IndexStr, Table: yyDollar[4].tableName, Database: yyDollar[5].str, ShowIndexFilterOpt: yyDollar[6].expr}
+		}
+	case 615:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3277
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 616:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3281
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Filter: yyDollar[4].showFilter}
+		}
+	case 617:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3285
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Filter: yyDollar[4].showFilter}
+		}
+	case 618:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3289
+		{
+			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
+		}
+	case 619:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3293
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Database: yyDollar[4].str, Filter: yyDollar[5].showFilter}
+		}
+	case 620:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:3297
+		{
+			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
+			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName, Full: yyDollar[2].boolean}
+		}
+	case 621:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3302
+		{
+			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[4].str, Filter: yyDollar[6].showFilter, AsOf: yyDollar[5].expr}
+			yyVAL.statement = &Show{Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt, Full: yyDollar[2].boolean}
+		}
+	case 622:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3307
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[3].bytes), Full: yyDollar[2].boolean}
+		}
+	case 623:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3311
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[3].bytes), ShowTablesOpt: &ShowTablesOpt{DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}, Full: yyDollar[2].boolean}
+		}
+	case 624:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3315
+		{
+			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes), Filter: yyDollar[4].showFilter}
+		}
+	case 625:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3319
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 626:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3323
+		{
+			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
+			showCollationFilterOpt := yyDollar[4].expr
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
+		}
+	case 627:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3329
+		{
+			// Cannot dereference $3 directly, or else the parser stackcannot be pooled. See yyParsePooled
+			cmp := yyDollar[3].expr.(*ComparisonExpr)
+			cmp.Left = &ColName{Name: NewColIdent("collation")}
+			var ex Expr = cmp
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &ex}
+		}
+	case 628:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3337
+		{
+			yyVAL.statement = &ShowGrants{}
+		}
+	case 629:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3341
+		{
+			an := yyDollar[4].accountName
+			yyVAL.statement = &ShowGrants{For: &an}
+		}
+	case 630:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3346
+		{
+			yyVAL.statement = &ShowGrants{CurrentUser: true}
+		}
+	case 631:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3350
+		{
+			an := yyDollar[4].accountName
+			yyVAL.statement = &ShowGrants{For: &an, Using: yyDollar[6].accountNames}
+		}
+	case 632:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3355
+		{
+			yyVAL.statement = &ShowPrivileges{}
+		}
+	case 633:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3359
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[6].bytes), CountStar: true}
+		}
+	case 634:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3363
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[6].bytes), CountStar: true}
+		}
+	case 635:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3367
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), Limit: yyDollar[3].limit}
+		}
+	case 636:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3371
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), Limit: yyDollar[3].limit}
+		}
+	case 637:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3381
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 638:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3385
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 639:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3389
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 640:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3393
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 641:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3397
+		{
+			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
+		}
+	case 642:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3403
+		{
+			yyVAL.expr = &ComparisonExpr{Operator: LikeStr, Right: yyDollar[2].expr, Escape: yyDollar[3].expr}
+		}
+	case 643:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3409
+		{
+			yyVAL.boolean = false
+		}
+	case 644:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3413
+		{
+			yyVAL.boolean = true
+		}
+	case 645:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3419
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 646:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3423
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 647:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3429
+		{
+			yyVAL.str = ""
+		}
+	case 648:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3433
+		{
+			yyVAL.str = yyDollar[2].tableIdent.v
+		}
+	case 649:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3437
+		{
+			yyVAL.str = yyDollar[2].tableIdent.v
+		}
+	case 650:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3443
+		{
+			yyVAL.showFilter = nil
+		}
+	case 651:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3447
+		{
+			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
+		}
+	case 652:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3451
+		{
+			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
+		}
+	case 653:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3457
+		{
+			yyVAL.str = ""
+		}
+	case 654:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3461
+		{
+			yyVAL.str = SessionStr
+		}
+	case 655:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3465
+		{
+			yyVAL.str = GlobalStr
+		}
+	case 656:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3471
+		{
+			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
+		}
+	case 657:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3475
+		{
+			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
+		}
+	case 658:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3481
+		{
+			yyVAL.statement = &Begin{}
+		}
+	case 659:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3485
+		{
+			yyVAL.statement = yyDollar[1].statement
+		}
+	case 660:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3491
+		{
+			yyVAL.statement = &Begin{}
+		}
+	case 661:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3495
+		{
+			yyVAL.statement = &Begin{TransactionCharacteristic: TxReadWrite}
+		}
+	case 662:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3499
+		{
+			yyVAL.statement = &Begin{TransactionCharacteristic: TxReadOnly}
+		}
+	case 663:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3505
+		{
+			yyVAL.statement = &Commit{}
+		}
+	case 664:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3511
+		{
+			yyVAL.statement = &Rollback{}
+		}
+	case 665:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3517
+		{
+			yyVAL.statement = &Savepoint{Identifier: string(yyDollar[2].bytes)}
+		}
+	case 666:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3523
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[3].bytes)}
+		}
+	case 667:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3527
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[4].bytes)}
+		}
+	case 668:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3531
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[4].bytes)}
+		}
+	case 669:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3535
+		{
+			yyVAL.statement = &RollbackSavepoint{Identifier: string(yyDollar[5].bytes)}
+		}
+	case 670:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3541
+		{
+			yyVAL.statement = &ReleaseSavepoint{Identifier: string(yyDollar[3].bytes)}
+		}
+	case 671:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3546
+		{
+		}
+	case 672:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3547
+		{
+		}
+	case 673:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3551
+		{
+			yyVAL.statement = &Explain{ExplainFormat: yyDollar[2].str, Statement: yyDollar[3].statement}
+		}
+	case 674:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3555
+		{
+			yyVAL.statement = &Explain{Analyze: true, ExplainFormat: TreeStr, Statement: yyDollar[3].selStmt}
+		}
+	case 675:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3561
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 679:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3569
+		{
+			yyVAL.str = ""
+		}
+	case 680:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3573
+		{
+			yyVAL.str = string(yyDollar[3].bytes)
+		}
+	case 683:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3584
+		{
+			yyVAL.statement = &Show{Type: "columns", OnTable: yyDollar[2].tableName}
+		}
+	case 684:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3588
+		{
+			yyVAL.statement = &OtherRead{}
+		}
+	case 685:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3594
+		{
+			yyVAL.statement = &TableMaintenance{Action: OptimizeStr, Local: yyDollar[2].boolean, Tables: yyDollar[4].tableNames}
+		}
+	case 686:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3598
+		{
+			yyVAL.statement = &TableMaintenance{Action: RepairStr, Local: yyDollar[2].boolean, Tables: yyDollar[4].tableNames, Options: yyDollar[5].strs}
+		}
+	case 687:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3604
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 688:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3606
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 689:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3609
+		{
+			yyVAL.boolean = false
+		}
+	case 690:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3613
+		{
+			yyVAL.boolean = true
+		}
+	case 691:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3617
+		{
+			yyVAL.boolean = true
+		}
+	case 692:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3622
+		{
+			yyVAL.strs = nil
+		}
+	case 694:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3629
+		{
+			yyVAL.strs = []string{yyDollar[1].str}
+		}
+	case 695:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3633
+		{
+			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
+		}
+	case 696:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3639
+		{
+			yyVAL.str = QuickStr
+		}
+	case 697:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3643
+		{
+			yyVAL.str = ExtendedStr
+		}
+	case 698:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3647
+		{
+			yyVAL.str = UseFrmStr
+		}
+	case 699:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3653
+		{
+			yyVAL.statement = &DDL{Action: FlushStr}
+		}
+	case 700:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3657
+		{
+			setAllowComments(yylex, true)
+		}
+	case 701:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3661
+		{
+			yyVAL.bytes2 = yyDollar[2].bytes2
+			setAllowComments(yylex, false)
+		}
+	case 702:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3667
+		{
+			yyVAL.bytes2 = nil
+		}
+	case 703:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3671
+		{
+			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
+		}
+	case 704:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3677
+		{
+			yyVAL.str = UnionStr
+		}
+	case 705:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3681
+		{
+			yyVAL.str = UnionAllStr
+		}
+	case 706:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3685
+		{
+			yyVAL.str = UnionDistinctStr
+		}
+	case 707:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3690
+		{
+			yyVAL.byt = 0
+		}
+	case 708:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3694
+		{
+			yyVAL.byt = 1
+		}
+	case 709:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3699
+		{
+			yyVAL.str = ""
+		}
+	case 710:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3703
+		{
+			yyVAL.str = SQLNoCacheStr
+		}
+	case 711:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3707
+		{
+			yyVAL.str = SQLCacheStr
+		}
+	case 712:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3712
+		{
+			yyVAL.str = ""
+		}
+	case 713:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3716
+		{
+			yyVAL.str = ""
+		}
+	case 714:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3720
+		{
+			yyVAL.str = DistinctStr
+		}
+	case 715:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3725
+		{
+			yyVAL.str = ""
+		}
+	case 716:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3729
+		{
+			yyVAL.str = StraightJoinHint
+		}
+	case 717:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3735
+		{
+			if ae, ok := yyDollar[2].selectExpr.(*AliasedExpr); ok {
+				ae.StartParsePos = yyDollar[1].int
+				ae.EndParsePos = yyDollar[3].int - 1
+			}
+			yyVAL.selectExprs = SelectExprs{yyDollar[2].selectExpr}
+		}
+	case 718:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3743
+		{
+			if ae, ok := yyDollar[4].selectExpr.(*AliasedExpr); ok {
+				ae.StartParsePos = yyDollar[3].int
+				ae.EndParsePos = yyDollar[5].int - 1
+			}
+			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[4].selectExpr)
+		}
+	case 719:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3754
+		{
+			yyVAL.selectExpr = &StarExpr{}
+		}
+	case 720:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3758
+		{
+			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr}
+		}
+	case 721:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3762
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
+		}
+	case 722:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3766
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
+		}
+	case 723:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3772
+		{
+			yyVAL.selectExpr = &StarExpr{}
+		}
+	case 724:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3776
+		{
+			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
+		}
+	case 725:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3780
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
+		}
+	case 726:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3784
+		{
+			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
+		}
+	case 727:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3790
+		{
+			yyVAL.over = &Over{WindowName: yyDollar[2].colIdent}
+		}
+	case 728:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3794
+		{
+			yyVAL.over = &Over{OrderBy: yyDollar[3].orderBy}
+		}
+	case 729:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:3798
+		{
+			yyVAL.over = &Over{PartitionBy: yyDollar[5].exprs, OrderBy: yyDollar[6].orderBy, Frame: yyDollar[7].frame}
+		}
+	case 730:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3804
+		{
+			yyVAL.over = nil
+		}
+	case 731:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3808
+		{
+			yyVAL.over = yyDollar[1].over
+		}
+	case 732:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3813
+		{
+			yyVAL.frame = nil
+		}
+	case 733:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3817
+		{
+			yyVAL.frame = &Frame{Unit: RowsUnit, Extent: yyDollar[2].frameExtent}
+		}
+	case 734:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3821
+		{
+			yyVAL.frame = &Frame{Unit: RangeUnit, Extent: yyDollar[2].frameExtent}
+		}
+	case 735:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3828
+		{
+			startBound := yyDollar[2].frameBound
+			endBound := yyDollar[4].frameBound
+			switch {
+			case startBound.Type == UnboundedFollowing:
+				yylex.Error("frame start cannot be UNBOUNDED FOLLOWING")
+				return 1
+			case endBound.Type == UnboundedPreceding:
+				yylex.Error("frame end cannot be UNBOUNDED PRECEDING")
+				return 1
+			case startBound.Type == CurrentRow && endBound.Type == ExprPreceding:
+				yylex.Error("frame starting from current row cannot have preceding rows")
+				return 1
+			case startBound.Type == ExprFollowing && endBound.Type == ExprPreceding:
+				yylex.Error("frame starting from following row cannot have preceding rows")
+				return 1
+			case startBound.Type == ExprFollowing && endBound.Type == CurrentRow:
+				yylex.Error("frame starting from following row cannot have preceding rows")
+				return 1
+			}
+			yyVAL.frameExtent = &FrameExtent{Start: startBound, End: endBound}
+		}
+	case 736:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3851
+		{
+			startBound := yyDollar[1].frameBound
+			switch {
+			case startBound.Type == UnboundedFollowing:
+				yylex.Error("frame start cannot be UNBOUNDED FOLLOWING")
+				return 1
+			case startBound.Type == ExprFollowing:
+				yylex.Error("frame starting from following row cannot end with current row")
+				return 1
+			}
+			yyVAL.frameExtent = &FrameExtent{Start: startBound}
+		}
+	case 737:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3866
+		{
+			yyVAL.frameBound = &FrameBound{Type: UnboundedPreceding}
+		}
+	case 738:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3870
+		{
+			yyVAL.frameBound = &FrameBound{Type: UnboundedFollowing}
+		}
+	case 739:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3874
+		{
+			yyVAL.frameBound = &FrameBound{Type: CurrentRow}
+		}
+	case 740:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3878
+		{
+			yyVAL.frameBound = &FrameBound{
+				Expr: yyDollar[1].expr,
+				Type: ExprPreceding,
+			}
+		}
+	case 741:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3885
+		{
+			yyVAL.frameBound = &FrameBound{
+				Expr: yyDollar[1].expr,
+				Type: ExprFollowing,
+			}
+		}
+	case 742:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3895
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 743:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3899
+		{
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
+		}
+	case 744:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3904
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 745:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3908
+		{
+			yyVAL.colIdent = yyDollar[1].colIdent
+		}
+	case 746:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3912
+		{
+			yyVAL.colIdent = yyDollar[2].colIdent
+		}
+	case 747:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3918
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 748:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3922
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 749:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3926
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 750:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3930
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 751:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3936
+		{
+			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
+		}
+	case 752:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3940
+		{
+			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
+		}
+	case 755:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3950
+		{
+			yyVAL.tableExpr = yyDollar[1].aliasedTableName
+		}
+	case 756:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3954
+		{
+			switch n := yyDollar[1].simpleTableExpr.(type) {
+			case *Subquery:
+				n.Columns = yyDollar[4].columns
+			case *ValuesStatement:
+				n.Columns = yyDollar[4].columns
+			}
+			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].simpleTableExpr, As: yyDollar[3].tableIdent}
+		}
+	case 757:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3964
+		{
+			// missed alias for subquery
+			yylex.Error("Every derived table must have its own alias")
+			return 1
+		}
+	case 758:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3970
+		{
+			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
+		}
+	case 759:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3976
+		{
+			yyVAL.simpleTableExpr = &ValuesStatement{Rows: yyDollar[2].values}
+		}
+	case 760:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3982
+		{
+			yyVAL.values = Values{yyDollar[2].valTuple}
+		}
+	case 761:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3986
+		{
+			yyVAL.values = append(yyVAL.values, yyDollar[4].valTuple)
+		}
+	case 762:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3992
+		{
+			yyVAL.aliasedTableName = yyDollar[2].aliasedTableName
+			yyVAL.aliasedTableName.Expr = yyDollar[1].tableName
+		}
+	case 763:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3997
+		{
+			yyVAL.aliasedTableName = yyDollar[6].aliasedTableName
+			yyVAL.aliasedTableName.Expr = yyDollar[1].tableName
+			yyVAL.aliasedTableName.Partitions = yyDollar[4].partitions
+		}
+	case 764:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4008
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{Hints: yyDollar[1].indexHints}
+		}
+	case 765:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4012
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{AsOf: &AsOf{Time: yyDollar[3].expr}, Hints: yyDollar[4].indexHints}
+		}
+	case 766:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4016
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{AsOf: &AsOf{Time: yyDollar[3].expr}, As: yyDollar[5].tableIdent, Hints: yyDollar[6].indexHints}
+		}
+	case 767:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4020
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
+		}
+	case 768:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4024
+		{
+			yyVAL.aliasedTableName = &AliasedTableExpr{As: yyDollar[1].tableIdent, Hints: yyDollar[2].indexHints}
+		}
+	case 769:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4035
+		{
+			yyVAL.expr = nil
+		}
+	case 770:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4039
+		{
+			yyVAL.expr = yyDollar[3].expr
+		}
+	case 771:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4044
+		{
+			yyVAL.columns = nil
+		}
+	case 772:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4048
+		{
+			yyVAL.columns = yyDollar[2].columns
+		}
+	case 773:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4054
+		{
+			yyVAL.columns = Columns{yyDollar[1].colIdent}
+		}
+	case 774:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4058
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
+		}
+	case 775:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4064
+		{
+			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
+		}
+	case 776:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4068
+		{
+			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
+		}
+	case 777:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4081
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 778:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4085
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 779:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4089
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
+		}
+	case 780:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4093
+		{
+			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
+		}
+	case 781:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4099
+		{
+			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
+		}
+	case 782:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4101
+		{
+			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
+		}
+	case 783:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4105
+		{
+			yyVAL.joinCondition = JoinCondition{}
+		}
+	case 784:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4107
+		{
+			yyVAL.joinCondition = yyDollar[1].joinCondition
+		}
+	case 785:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4111
+		{
+			yyVAL.joinCondition = JoinCondition{}
+		}
+	case 786:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4113
+		{
+			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
+		}
+	case 787:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4116
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 788:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4118
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 790:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4123
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 791:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4127
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 792:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4133
+		{
+			yyVAL.str = JoinStr
+		}
+	case 793:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4137
+		{
+			yyVAL.str = JoinStr
+		}
+	case 794:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4141
+		{
+			yyVAL.str = JoinStr
+		}
+	case 795:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4147
+		{
+			yyVAL.str = StraightJoinStr
+		}
+	case 796:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4153
+		{
+			yyVAL.str = LeftJoinStr
+		}
+	case 797:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4157
+		{
+			yyVAL.str = LeftJoinStr
+		}
+	case 798:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4161
+		{
+			yyVAL.str = RightJoinStr
+		}
+	case 799:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4165
+		{
+			yyVAL.str = RightJoinStr
+		}
+	case 800:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4171
+		{
+			yyVAL.str = NaturalJoinStr
+		}
+	case 801:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4175
+		{
+			if yyDollar[2].str == LeftJoinStr {
+				yyVAL.str = NaturalLeftJoinStr
+			} else {
+				yyVAL.str = NaturalRightJoinStr
+			}
+		}
+	case 802:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4185
+		{
+			yyVAL.tableName = yyDollar[3].tableName
+		}
+	case 803:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4191
+		{
+			yyVAL.tableName = yyDollar[2].tableName
+		}
+	case 804:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4195
+		{
+			yyVAL.tableName = yyDollar[1].tableName
+		}
+	case 805:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4201
+		{
+			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
+		}
+	case 806:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4205
+		{
+			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
+		}
+	case 807:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4211
+		{
+			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
+		}
+	case 808:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4216
+		{
+			yyVAL.indexHints = nil
+		}
+	case 809:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4220
+		{
+			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
+		}
+	case 810:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4224
+		{
+			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
+		}
+	case 811:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4228
+		{
+			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
+		}
+	case 812:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4233
+		{
+			yyVAL.expr = nil
+		}
+	case 813:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4237
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 814:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4243
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 815:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4247
+		{
+			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+		}
+	case 816:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4251
+		{
+			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
+		}
+	case 817:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4255
+		{
+			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
+		}
+	case 818:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4259
+		{
+			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
+		}
+	case 819:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4263
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 820:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4267
+		{
+			yyVAL.expr = &Default{ColName: yyDollar[2].str}
+		}
+	case 821:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4273
+		{
+			yyVAL.str = ""
+		}
+	case 822:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4277
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 823:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4283
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 824:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4287
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 825:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4293
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
+		}
+	case 826:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4297
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
+		}
+	case 827:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4301
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
+		}
+	case 828:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4305
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
+		}
+	case 829:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4309
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
+		}
+	case 830:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4313
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
+		}
+	case 831:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4317
+		{
+			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
+		}
+	case 832:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4321
+		{
+			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
+		}
+	case 833:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4325
+		{
+			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
+		}
+	case 834:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4329
+		{
+			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
+		}
+	case 835:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4335
+		{
+			yyVAL.str = IsNullStr
+		}
+	case 836:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4339
+		{
+			yyVAL.str = IsNotNullStr
+		}
+	case 837:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4343
+		{
+			yyVAL.str = IsTrueStr
+		}
+	case 838:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4347
+		{
+			yyVAL.str = IsNotTrueStr
+		}
+	case 839:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4351
+		{
+			yyVAL.str = IsFalseStr
+		}
+	case 840:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4355
+		{
+			yyVAL.str = IsNotFalseStr
+		}
+	case 841:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4361
+		{
+			yyVAL.str = EqualStr
+		}
+	case 842:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4365
+		{
+			yyVAL.str = LessThanStr
+		}
+	case 843:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4369
+		{
+			yyVAL.str = GreaterThanStr
+		}
+	case 844:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4373
+		{
+			yyVAL.str = LessEqualStr
+		}
+	case 845:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4377
+		{
+			yyVAL.str = GreaterEqualStr
+		}
+	case 846:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4381
+		{
+			yyVAL.str = NotEqualStr
+		}
+	case 847:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4385
+		{
+			yyVAL.str = NullSafeEqualStr
+		}
+	case 848:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4390
+		{
+			yyVAL.expr = nil
+		}
+	case 849:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4394
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 850:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4400
+		{
+			yyVAL.colTuple = yyDollar[1].valTuple
+		}
+	case 851:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4404
+		{
+			yyVAL.colTuple = yyDollar[1].subquery
+		}
+	case 852:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4408
+		{
+			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
+		}
+	case 853:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4414
+		{
+			yyVAL.subquery = &Subquery{Select: yyDollar[2].selStmt}
+		}
+	case 854:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4420
+		{
+			yyVAL.simpleTableExpr = yyDollar[1].subquery
+		}
+	case 855:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4424
+		{
+			yyVAL.simpleTableExpr = yyDollar[2].simpleTableExpr
+		}
+	case 856:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4430
+		{
+			yyVAL.selectExprs = nil
+		}
+	case 858:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4437
+		{
+			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
+		}
+	case 859:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4441
+		{
+			yyVAL.selectExprs = append(yyDollar[1].selectExprs, yyDollar[3].selectExpr)
+		}
+	case 860:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4447
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 861:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4451
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 862:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4457
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 863:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4461
+		{
+			yyVAL.expr = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 864:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4465
+		{
+			yyVAL.expr = yyDollar[1].boolVal
+		}
+	case 865:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4469
+		{
+			yyVAL.expr = yyDollar[1].colName
+		}
+	case 866:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4473
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 867:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4477
+		{
+			yyVAL.expr = yyDollar[1].subquery
+		}
+	case 868:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4481
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
+		}
+	case 869:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4485
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
+		}
+	case 870:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4489
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
+		}
+	case 871:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4493
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
+		}
+	case 872:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4497
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
+		}
+	case 873:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4501
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
+		}
+	case 874:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4505
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
+		}
+	case 875:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4509
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
+		}
+	case 876:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4513
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+		}
+	case 877:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4517
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
+		}
+	case 878:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4521
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
+		}
+	case 879:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4525
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
+		}
+	case 880:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4529
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
+		}
+	case 881:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4533
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
+		}
+	case 882:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4537
+		{
+			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
+		}
+	case 883:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4541
+		{
+			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
+		}
+	case 884:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4545
+		{
+			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
+		}
+	case 885:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4549
+		{
+			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
+		}
+	case 886:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4553
+		{
+			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
+				yyVAL.expr = num
+			} else {
+				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
+			}
+		}
+	case 887:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4561
+		{
+			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
+				// Handle double negative
+				if num.Val[0] == '-' {
+					num.Val = num.Val[1:]
+					yyVAL.expr = num
+				} else {
+					yyVAL.expr = NewIntVal(append([]byte("-"), num.Val...))
+				}
+			} else {
+				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
+			}
+		}
+	case 888:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4575
+		{
+			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
+		}
+	case 889:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4579
+		{
+			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
+		}
+	case 890:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4583
+		{
+			// This rule prevents the usage of INTERVAL
+			// as a function. If support is needed for that,
+			// we'll need to revisit this. The solution
+			// will be non-trivial because of grammar conflicts.
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
+		}
+	case 897:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4603
+		{
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: yyDollar[3].str == DistinctStr, Exprs: yyDollar[4].selectExprs}
+		}
+	case 898:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4607
+		{
+			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
+		}
+	case 899:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4617
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 900:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4621
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 901:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4625
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 902:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4629
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 903:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4633
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 904:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4637
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 905:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4641
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 906:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4645
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 907:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4649
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 908:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4653
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 909:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4657
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 910:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4661
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 911:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4665
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 912:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4669
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[4].selectExprs, Distinct: yyDollar[3].str == DistinctStr, Over: yyDollar[6].over}
+		}
+	case 913:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4673
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 914:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4677
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 915:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4681
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 916:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4690
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 917:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4694
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 918:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4698
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{yyDollar[3].selectExpr}, Over: yyDollar[5].over}
+		}
+	case 919:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4702
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 920:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4706
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: SelectExprs{yyDollar[3].selectExpr}, Over: yyDollar[5].over}
+		}
+	case 921:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4710
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 922:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4714
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].over}
+		}
+	case 923:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4718
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 924:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4722
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 925:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4726
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 926:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4730
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Over: yyDollar[4].over}
+		}
+	case 927:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4741
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 928:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4745
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 929:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4749
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 930:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4753
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 931:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4757
+		{
+			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+		}
+	case 932:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4761
+		{
+			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
+		}
+	case 933:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4765
+		{
+			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
+		}
+	case 934:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4769
+		{
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 935:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4773
+		{
+			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 936:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4777
+		{
+			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 937:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4781
+		{
+			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
+		}
+	case 938:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4785
+		{
+			yyVAL.expr = &TrimExpr{Pattern: NewStrVal([]byte(" ")), Str: yyDollar[3].expr, Dir: Both}
+		}
+	case 939:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:4789
+		{
+			yyVAL.expr = &TrimExpr{Pattern: yyDollar[3].expr, Str: yyDollar[5].expr, Dir: Both}
+		}
+	case 940:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:4793
+		{
+			yyVAL.expr = &TrimExpr{Pattern: yyDollar[4].expr, Str: yyDollar[6].expr, Dir: Leading}
+		}
+	case 941:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:4797
+		{
+			yyVAL.expr = &TrimExpr{Pattern: yyDollar[4].expr, Str: yyDollar[6].expr, Dir: Trailing}
+		}
+	case 942:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:4801
+		{
+			yyVAL.expr = &TrimExpr{Pattern: yyDollar[4].expr, Str: yyDollar[6].expr, Dir: Both}
+		}
+	case 943:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:4805
+		{
+			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
+		}
+	case 944:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4809
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 945:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:4813
+		{
+			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
+		}
+	case 946:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4817
+		{
+			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
+		}
+	case 947:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4821
+		{
+			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
+		}
+	case 948:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4831
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 949:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4835
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 950:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4839
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 951:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4844
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 952:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4849
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 953:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4854
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 954:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4860
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 955:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4865
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 956:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4869
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 957:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4874
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 958:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4878
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 959:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4882
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 960:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4887
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 961:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4892
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 962:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4897
+		{
+			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Fsp: yyDollar[2].expr}
+		}
+	case 963:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4901
+		{
+			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+		}
+	case 964:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:4905
+		{
+			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
+		}
+	case 967:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4916
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 968:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4926
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 969:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4930
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 970:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4934
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 971:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4938
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 972:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4942
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 973:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4946
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs}
+		}
+	case 974:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4952
+		{
+			yyVAL.str = ""
+		}
+	case 975:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4956
+		{
+			yyVAL.str = BooleanModeStr
+		}
+	case 976:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4960
+		{
+			yyVAL.str = NaturalLanguageModeStr
+		}
+	case 977:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:4964
+		{
+			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
+		}
+	case 978:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4968
+		{
+			yyVAL.str = QueryExpansionStr
+		}
+	case 979:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4974
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 980:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4978
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 981:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4984
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 982:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4988
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
+		}
+	case 983:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4992
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: string(yyDollar[3].bytes)}
+		}
+	case 984:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4996
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 985:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5000
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 986:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5004
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
+		}
+	case 987:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5010
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 988:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5014
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 989:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5018
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 990:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5022
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 991:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5026
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
+		}
+	case 992:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5030
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 993:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5034
+		{
+			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
+		}
+	case 994:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5039
+		{
+			yyVAL.expr = nil
+		}
+	case 995:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5043
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 996:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5048
+		{
+			yyVAL.str = string("")
+		}
+	case 997:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5052
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 998:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5058
+		{
+			yyVAL.whens = []*When{yyDollar[1].when}
+		}
+	case 999:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5062
+		{
+			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
+		}
+	case 1000:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5068
+		{
+			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
+		}
+	case 1001:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5073
+		{
+			yyVAL.expr = nil
+		}
+	case 1002:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5077
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 1003:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5083
+		{
+			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
+		}
+	case 1004:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5087
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
+		}
+	case 1005:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5091
+		{
+			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
+		}
+	case 1006:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5097
+		{
+			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+		}
+	case 1007:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5101
+		{
+			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
+		}
+	case 1008:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5105
+		{
+			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
+		}
+	case 1009:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5109
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 1010:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5113
+		{
+			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
+		}
+	case 1011:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5117
+		{
+			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
+		}
+	case 1012:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5121
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 1013:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5125
+		{
+			yyVAL.expr = &NullVal{}
+		}
+	case 1014:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5131
+		{
+			yyVAL.expr = NewIntVal([]byte("1"))
+		}
+	case 1015:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5135
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 1016:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5139
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 1017:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5144
+		{
+			yyVAL.exprs = nil
+		}
+	case 1018:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5148
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 1019:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5154
+		{
+			yyVAL.exprs = Exprs{yyDollar[1].expr}
+		}
+	case 1020:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5158
+		{
+			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
+		}
+	case 1021:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5164
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 1022:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5169
+		{
+			yyVAL.expr = nil
+		}
+	case 1023:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5173
+		{
+			yyVAL.expr = yyDollar[2].expr
+		}
+	case 1024:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5179
+		{
+			yyVAL.expr = yyDollar[1].expr
+		}
+	case 1025:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5184
+		{
+			yyVAL.orderBy = nil
+		}
+	case 1026:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5188
+		{
+			yyVAL.orderBy = yyDollar[3].orderBy
+		}
+	case 1027:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5194
+		{
+			yyVAL.orderBy = OrderBy{yyDollar[1].order}
+		}
+	case 1028:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5198
+		{
+			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
+		}
+	case 1029:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5204
+		{
+			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
+		}
+	case 1030:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5209
+		{
+			yyVAL.str = AscScr
+		}
+	case 1031:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5213
+		{
+			yyVAL.str = AscScr
+		}
+	case 1032:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5217
+		{
+			yyVAL.str = DescScr
+		}
+	case 1033:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5222
+		{
+			yyVAL.limit = nil
+		}
+	case 1034:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5226
+		{
+			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
+		}
+	case 1035:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5230
+		{
+			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
+		}
+	case 1036:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5234
+		{
+			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
+		}
+	case 1037:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5240
+		{
+			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
+		}
+	case 1038:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5244
+		{
+			yyVAL.expr = NewValArg(yyDollar[1].bytes)
+		}
+	case 1039:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5249
+		{
+			yyVAL.str = ""
+		}
+	case 1040:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5253
+		{
+			yyVAL.str = ForUpdateStr
+		}
+	case 1041:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5257
+		{
+			yyVAL.str = ShareModeStr
+		}
+	case 1042:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5270
+		{
+			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
+		}
+	case 1043:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5274
+		{
+			yyVAL.ins = &Insert{Columns: []ColIdent{}, Rows: yyDollar[4].values}
+		}
+	case 1044:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5278
+		{
+			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
+		}
+	case 1045:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5282
+		{
+			// Drop the redundant parenthesis.
+			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
+		}
+	case 1046:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5287
+		{
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
+		}
+	case 1047:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5291
+		{
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
+		}
+	case 1048:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:5295
+		{
+			// Drop the redundant parenthesis.
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
+		}
+	case 1049:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5302
+		{
+			yyVAL.columns = Columns{yyDollar[1].colIdent}
+		}
+	case 1050:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5306
+		{
+			yyVAL.columns = Columns{yyDollar[3].colIdent}
+		}
+	case 1051:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5310
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
+		}
+	case 1052:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5314
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
+		}
+	case 1053:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5319
+		{
+			yyVAL.assignExprs = nil
+		}
+	case 1054:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5323
+		{
+			yyVAL.assignExprs = yyDollar[5].assignExprs
+		}
+	case 1055:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5329
+		{
+			yyVAL.values = Values{yyDollar[1].valTuple}
+		}
+	case 1056:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5333
+		{
+			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
+		}
+	case 1057:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5339
+		{
+			yyVAL.valTuple = yyDollar[1].valTuple
+		}
+	case 1058:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5343
+		{
+			yyVAL.valTuple = ValTuple{}
+		}
+	case 1059:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5349
+		{
+			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
+		}
+	case 1060:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5355
+		{
+			if len(yyDollar[1].valTuple) == 1 {
+				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
+			} else {
+				yyVAL.expr = yyDollar[1].valTuple
+			}
+		}
+	case 1061:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5365
+		{
+			yyVAL.assignExprs = AssignmentExprs{yyDollar[1].assignExpr}
+		}
+	case 1062:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5369
+		{
+			yyVAL.assignExprs = append(yyDollar[1].assignExprs, yyDollar[3].assignExpr)
+		}
+	case 1063:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5375
+		{
+			yyVAL.assignExpr = &AssignmentExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
+		}
+	case 1064:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5378
+		{
+			yyVAL.assignExpr = &AssignmentExpr{Name: &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}, Expr: yyDollar[3].expr}
+		}
+	case 1065:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5384
+		{
+			yyVAL.setVarExprs = SetVarExprs{yyDollar[1].setVarExpr}
+		}
+	case 1066:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5388
+		{
+			yyVAL.setVarExprs = append(yyDollar[1].setVarExprs, yyDollar[3].setVarExpr)
+		}
+	case 1067:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5394
+		{
+			colName, scope, err := VarScopeForColName(yyDollar[1].setVarExpr.Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyDollar[1].setVarExpr.Name = colName
+			yyDollar[1].setVarExpr.Scope = scope
+			yyVAL.setVarExpr = yyDollar[1].setVarExpr
+		}
+	case 1068:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5405
+		{
+			_, scope, err := VarScopeForColName(yyDollar[2].setVarExpr.Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			} else if scope != SetScope_None {
+				yylex.Error(fmt.Sprintf("invalid system variable name `%s`", yyDollar[2].setVarExpr.Name.Name.val))
+				return 1
+			}
+			yyDollar[2].setVarExpr.Scope = yyDollar[1].setScope
+			yyVAL.setVarExpr = yyDollar[2].setVarExpr
+		}
+	case 1069:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5418
+		{
+			_, scope, err := VarScopeForColName(yyDollar[2].setVarExpr.Name)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			} else if scope != SetScope_None {
+				yylex.Error(fmt.Sprintf("invalid system variable name `%s`", yyDollar[2].setVarExpr.Name.Name.val))
+				return 1
+			}
+			yyDollar[2].setVarExpr.Scope = yyDollar[1].setScope
+			yyVAL.setVarExpr = yyDollar[2].setVarExpr
+		}
+	case 1070:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5431
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: NewColName(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr, Scope: SetScope_Session}
+		}
+	case 1071:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5437
+		{
+			yyVAL.setScope = SetScope_Global
+		}
+	case 1072:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5441
+		{
+			yyVAL.setScope = SetScope_Session
+		}
+	case 1073:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5447
+		{
+			yyVAL.setScope = SetScope_Session
+		}
+	case 1074:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5451
+		{
+			yyVAL.setScope = SetScope_Persist
+		}
+	case 1075:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5455
+		{
+			yyVAL.setScope = SetScope_PersistOnly
+		}
+	case 1076:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5461
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: NewStrVal(yyDollar[3].bytes), Scope: SetScope_None}
+		}
+	case 1077:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5465
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: NewStrVal(yyDollar[3].bytes), Scope: SetScope_None}
+		}
+	case 1078:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5469
+		{
+			yyVAL.setVarExpr = &SetVarExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr, Scope: SetScope_None}
+		}
+	case 1080:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5476
+		{
+			yyVAL.bytes = []byte("charset")
+		}
+	case 1082:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5483
+		{
+			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
+		}
+	case 1083:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5487
+		{
+			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
+		}
+	case 1084:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5491
+		{
+			yyVAL.expr = &Default{}
+		}
+	case 1087:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5500
+		{
+			yyVAL.byt = 0
+		}
+	case 1088:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5502
+		{
+			yyVAL.byt = 1
+		}
+	case 1089:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5505
+		{
+			yyVAL.byt = 0
+		}
+	case 1090:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5507
+		{
+			yyVAL.byt = 1
+		}
+	case 1091:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5510
+		{
+			yyVAL.byt = 0
+		}
+	case 1092:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5512
+		{
+			yyVAL.byt = 1
+		}
+	case 1093:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5515
+		{
+			yyVAL.str = ""
+		}
+	case 1094:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5517
+		{
+			yyVAL.str = IgnoreStr
+		}
+	case 1095:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5520
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 1096:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5522
+		{
+			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
+		}
+	case 1097:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5526
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1098:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5528
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1099:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5530
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1100:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5532
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1101:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5534
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1102:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5536
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1103:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5538
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1104:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5540
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1105:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5544
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1106:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5546
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1107:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5549
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1108:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5551
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1109:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5553
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 1110:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5557
+		{
+			yyVAL.str = UniqueStr
+		}
+	case 1111:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5559
+		{
+			yyVAL.str = FulltextStr
+		}
+	case 1112:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5561
+		{
+			yyVAL.str = SpatialStr
+		}
+	case 1113:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5564
+		{
+			yyVAL.str = ""
+		}
+	case 1114:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5566
+		{
+			yyVAL.str = yyDollar[1].str
+		}
+	case 1115:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5569
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 1116:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5571
+		{
+			yyVAL.colIdent = yyDollar[2].colIdent
+		}
+	case 1117:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5575
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1118:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5579
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1119:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5585
+		{
+			yyVAL.colIdents = []ColIdent{yyDollar[1].colIdent}
+		}
+	case 1120:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5589
+		{
+			yyVAL.colIdents = append(yyVAL.colIdents, yyDollar[3].colIdent)
+		}
+	case 1122:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5596
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 1123:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5602
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1124:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5606
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1126:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5613
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 1127:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5618
+		{
+			yyVAL.str = string("")
+		}
+	case 1128:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5620
+		{
+			yyVAL.str = string(yyDollar[2].bytes)
+		}
+	case 1129:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5623
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 1130:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5625
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 1131:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5628
+		{
+			yyVAL.EnclosedBy = nil
+		}
+	case 1132:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5632
+		{
+			yyVAL.EnclosedBy = &EnclosedBy{Optionally: yyDollar[1].boolVal, Delim: NewStrVal(yyDollar[4].bytes)}
+		}
+	case 1133:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5637
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 1134:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5641
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 1135:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5646
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 1136:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5650
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 1137:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5655
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 1138:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5659
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 1139:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5664
+		{
+			yyVAL.Fields = nil
+		}
+	case 1140:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5668
+		{
+			yyVAL.Fields = &Fields{TerminatedBy: yyDollar[2].sqlVal, EnclosedBy: yyDollar[3].EnclosedBy, EscapedBy: yyDollar[4].sqlVal}
+		}
+	case 1141:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5673
+		{
+			yyVAL.Lines = nil
+		}
+	case 1142:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5677
+		{
+			yyVAL.Lines = &Lines{StartingBy: yyDollar[2].sqlVal, TerminatedBy: yyDollar[3].sqlVal}
+		}
+	case 1143:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5682
+		{
+			yyVAL.sqlVal = nil
+		}
+	case 1144:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5686
+		{
+			yyVAL.sqlVal = NewStrVal(yyDollar[3].bytes)
+		}
+	case 1145:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5692
+		{
+			yyVAL.statement = &LockTables{Tables: yyDollar[3].tableAndLockTypes}
+		}
+	case 1146:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5698
+		{
+			yyVAL.tableAndLockTypes = TableAndLockTypes{yyDollar[1].tableAndLockType}
+		}
+	case 1147:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5702
+		{
+			yyVAL.tableAndLockTypes = append(yyDollar[1].tableAndLockTypes, yyDollar[3].tableAndLockType)
+		}
+	case 1148:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5708
+		{
+			yyVAL.tableAndLockType = &TableAndLockType{Table: &AliasedTableExpr{Expr: yyDollar[1].tableName}, Lock: yyDollar[2].lockType}
+		}
+	case 1149:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5712
+		{
+			yyVAL.tableAndLockType = &TableAndLockType{Table: &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[3].tableIdent}, Lock: yyDollar[4].lockType}
+		}
+	case 1150:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5718
+		{
+			yyVAL.lockType = LockRead
+		}
+	case 1151:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5722
+		{
+			yyVAL.lockType = LockReadLocal
+		}
+	case 1152:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5726
+		{
+			yyVAL.lockType = LockWrite
+		}
+	case 1153:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5730
+		{
+			yyVAL.lockType = LockLowPriorityWrite
+		}
+	case 1154:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5736
+		{
+			yyVAL.statement = &UnlockTables{}
+		}
+	case 1155:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5742
+		{
+			yyVAL.statement = &Kill{Connection: true, ConnID: NewIntVal(yyDollar[2].bytes)}
+		}
+	case 1156:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5746
+		{
+			yyVAL.statement = &Kill{ConnID: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 1157:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5750
+		{
+			yyVAL.statement = &Kill{Connection: true, ConnID: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 1158:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5756
+		{
+			yyVAL.statement = &Prepare{Name: yyDollar[2].colIdent, Expr: yyDollar[4].expr}
+		}
+	case 1159:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5762
+		{
+			yyVAL.statement = &Execute{Name: yyDollar[2].colIdent}
+		}
+	case 1160:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5766
+		{
+			yyVAL.statement = &Execute{Name: yyDollar[2].colIdent, VarList: yyDollar[4].exprs}
+		}
+	case 1161:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5772
+		{
+			yyVAL.statement = &Deallocate{Name: yyDollar[3].colIdent}
+		}
+	case 1162:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5776
+		{
+			yyVAL.statement = &Deallocate{Name: yyDollar[3].colIdent}
+		}
+	case 1163:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5782
+		{
+			yyVAL.statement = &AdminCommand{Command: ShutdownStr}
+		}
+	case 1164:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:5786
+		{
+			yyVAL.statement = &AdminCommand{Command: ResetMasterStr}
+		}
+	case 1165:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5790
+		{
+			yyVAL.statement = &AdminCommand{Command: ResetMasterStr, Args: []string{string(yyDollar[3].bytes), string(yyDollar[4].bytes)}}
+		}
+	case 1166:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5794
+		{
+			yyVAL.statement = &AdminCommand{Command: ResetReplicaStr, Args: yyDollar[3].strs}
+		}
+	case 1167:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5798
+		{
+			yyVAL.statement = &AdminCommand{Command: ResetReplicaStr, Args: yyDollar[3].strs}
+		}
+	case 1168:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5803
+		{
+			yyVAL.strs = nil
+		}
+	case 1169:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5807
+		{
+			yyVAL.strs = []string{string(yyDollar[1].bytes)}
+		}
+	case 1170:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5813
+		{
+			yyVAL.statement = &Handler{Action: HandlerOpenStr, Table: yyDollar[2].tableName}
+		}
+	case 1171:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:5817
+		{
+			yyVAL.statement = &Handler{Action: HandlerOpenStr, Table: yyDollar[2].tableName, Alias: yyDollar[5].tableIdent}
+		}
+	case 1172:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:5821
+		{
+			yyVAL.statement = &Handler{Action: HandlerCloseStr, Table: yyDollar[2].tableName}
+		}
+	case 1173:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:5825
+		{
+			yyVAL.statement = &Handler{Action: HandlerReadStr, Table: yyDollar[2].tableName, Mode: yyDollar[4].str, Where: NewWhere(WhereStr, yyDollar[5].expr), Limit: yyDollar[6].limit}
+		}
+	case 1174:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:5829
+		{
+			yyVAL.statement = &Handler{Action: HandlerReadStr, Table: yyDollar[2].tableName, Index: yyDollar[4].colIdent, Mode: yyDollar[5].str, Where: NewWhere(WhereStr, yyDollar[6].expr), Limit: yyDollar[7].limit}
+		}
+	case 1175:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line sql.y:5833
+		{
+			yyVAL.statement = &Handler{Action: HandlerReadStr, Table: yyDollar[2].tableName, Index: yyDollar[4].colIdent, Mode: yyDollar[5].str, Key: yyDollar[7].exprs, Where: NewWhere(WhereStr, yyDollar[9].expr), Limit: yyDollar[10].limit}
+		}
+	case 1176:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5839
+		{
+			yyVAL.str = HandlerFirstStr
+		}
+	case 1177:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5843
+		{
+			yyVAL.str = HandlerNextStr
+		}
+	case 1179:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5850
+		{
+			yyVAL.str = HandlerPrevStr
+		}
+	case 1180:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5854
+		{
+			yyVAL.str = HandlerLastStr
+		}
+	case 1181:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:5860
+		{
+			yyVAL.statement = &ChecksumTable{Tables: yyDollar[3].tableNames, Mode: yyDollar[4].str}
+		}
+	case 1182:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:5865
+		{
+			yyVAL.str = ""
+		}
+	case 1183:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5869
+		{
+			yyVAL.str = QuickStr
+		}
+	case 1184:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5873
+		{
+			yyVAL.str = ExtendedStr
+		}
+	case 1185:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5879
+		{
+			yyVAL.str = EqualStr
+		}
+	case 1186:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5883
+		{
+			yyVAL.str = LessThanStr
+		}
+	case 1187:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5887
+		{
+			yyVAL.str = GreaterThanStr
+		}
+	case 1188:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5891
+		{
+			yyVAL.str = LessEqualStr
+		}
+	case 1189:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:5895
+		{
+			yyVAL.str = GreaterEqualStr
+		}
+	case 1619:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6354
+		{
+			if incNesting(yylex) {
+				yylex.Error("max nesting level reached")
+				return 1
+			}
+		}
+	case 1620:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6363
+		{
+			decNesting(yylex)
+		}
+	case 1621:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6368
+		{
+			skipToEnd(yylex)
+		}
+	case 1622:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:6373
+		{
+			skipToEnd(yylex)
+		}
+	case 1623:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6377
+		{
+			skipToEnd(yylex)
+		}
+	case 1624:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:6381
+		{
+			skipToEnd(yylex)
+		}
+	}
+	goto yystack /* stack new state and value */
+}